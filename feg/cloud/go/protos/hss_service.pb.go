@@ -53,6 +53,16 @@ type HSSConfiguratorClient interface {
 	// Throws NOT_FOUND if the subscriber is missing.
 	//
 	GetSubscriberData(ctx context.Context, in *protos.SubscriberID, opts ...grpc.CallOption) (*protos.SubscriberData, error)
+	// Sends an Insert-Subscriber-Data request with the subscriber's current
+	// profile to the MME which served the subscriber's last Update-Location.
+	// Throws NOT_FOUND if the subscriber is missing or has no serving MME.
+	//
+	InsertSubscriberData(ctx context.Context, in *protos.SubscriberID, opts ...grpc.CallOption) (*protos1.Void, error)
+	// Sends a Delete-Subscriber-Data request to the MME which served the
+	// subscriber's last Update-Location.
+	// Throws NOT_FOUND if the subscriber is missing or has no serving MME.
+	//
+	DeleteSubscriberData(ctx context.Context, in *DeleteSubscriberDataRequest, opts ...grpc.CallOption) (*protos1.Void, error)
 }
 
 type hSSConfiguratorClient struct {
@@ -99,6 +109,24 @@ func (c *hSSConfiguratorClient) GetSubscriberData(ctx context.Context, in *proto
 	return out, nil
 }
 
+func (c *hSSConfiguratorClient) InsertSubscriberData(ctx context.Context, in *protos.SubscriberID, opts ...grpc.CallOption) (*protos1.Void, error) {
+	out := new(protos1.Void)
+	err := c.cc.Invoke(ctx, "/magma.feg.HSSConfigurator/InsertSubscriberData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *hSSConfiguratorClient) DeleteSubscriberData(ctx context.Context, in *DeleteSubscriberDataRequest, opts ...grpc.CallOption) (*protos1.Void, error) {
+	out := new(protos1.Void)
+	err := c.cc.Invoke(ctx, "/magma.feg.HSSConfigurator/DeleteSubscriberData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // HSSConfiguratorServer is the server API for HSSConfigurator service.
 type HSSConfiguratorServer interface {
 	// Adds a new subscriber to the store.
@@ -117,6 +145,16 @@ type HSSConfiguratorServer interface {
 	// Throws NOT_FOUND if the subscriber is missing.
 	//
 	GetSubscriberData(context.Context, *protos.SubscriberID) (*protos.SubscriberData, error)
+	// Sends an Insert-Subscriber-Data request with the subscriber's current
+	// profile to the MME which served the subscriber's last Update-Location.
+	// Throws NOT_FOUND if the subscriber is missing or has no serving MME.
+	//
+	InsertSubscriberData(context.Context, *protos.SubscriberID) (*protos1.Void, error)
+	// Sends a Delete-Subscriber-Data request to the MME which served the
+	// subscriber's last Update-Location.
+	// Throws NOT_FOUND if the subscriber is missing or has no serving MME.
+	//
+	DeleteSubscriberData(context.Context, *DeleteSubscriberDataRequest) (*protos1.Void, error)
 }
 
 func RegisterHSSConfiguratorServer(s *grpc.Server, srv HSSConfiguratorServer) {
@@ -195,6 +233,42 @@ func _HSSConfigurator_GetSubscriberData_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HSSConfigurator_InsertSubscriberData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(protos.SubscriberID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HSSConfiguratorServer).InsertSubscriberData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.feg.HSSConfigurator/InsertSubscriberData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HSSConfiguratorServer).InsertSubscriberData(ctx, req.(*protos.SubscriberID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HSSConfigurator_DeleteSubscriberData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSubscriberDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HSSConfiguratorServer).DeleteSubscriberData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.feg.HSSConfigurator/DeleteSubscriberData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HSSConfiguratorServer).DeleteSubscriberData(ctx, req.(*DeleteSubscriberDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _HSSConfigurator_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "magma.feg.HSSConfigurator",
 	HandlerType: (*HSSConfiguratorServer)(nil),
@@ -215,30 +289,40 @@ var _HSSConfigurator_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetSubscriberData",
 			Handler:    _HSSConfigurator_GetSubscriberData_Handler,
 		},
+		{
+			MethodName: "InsertSubscriberData",
+			Handler:    _HSSConfigurator_InsertSubscriberData_Handler,
+		},
+		{
+			MethodName: "DeleteSubscriberData",
+			Handler:    _HSSConfigurator_DeleteSubscriberData_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "feg/protos/hss_service.proto",
 }
 
 func init() {
-	proto.RegisterFile("feg/protos/hss_service.proto", fileDescriptor_hss_service_02002f09e13e02ae)
-}
-
-var fileDescriptor_hss_service_02002f09e13e02ae = []byte{
-	// 229 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x49, 0x4b, 0x4d, 0xd7,
-	0x2f, 0x28, 0xca, 0x2f, 0xc9, 0x2f, 0xd6, 0xcf, 0x28, 0x2e, 0x8e, 0x2f, 0x4e, 0x2d, 0x2a, 0xcb,
-	0x4c, 0x4e, 0xd5, 0x03, 0x0b, 0x09, 0x71, 0xe6, 0x26, 0xa6, 0xe7, 0x26, 0xea, 0xa5, 0xa5, 0xa6,
-	0x4b, 0x49, 0xe6, 0x17, 0x25, 0x5b, 0x14, 0xc1, 0x94, 0x26, 0xe7, 0xe7, 0xe6, 0xe6, 0xe7, 0x41,
-	0x54, 0x49, 0xc9, 0xe6, 0x94, 0xa4, 0xc2, 0x24, 0x8a, 0x4b, 0x93, 0x8a, 0x93, 0x8b, 0x32, 0x93,
-	0x52, 0x8b, 0x52, 0x92, 0x20, 0xd2, 0x46, 0x8b, 0x99, 0xb8, 0xf8, 0x3d, 0x82, 0x83, 0x9d, 0xf3,
-	0xf3, 0xd2, 0x32, 0xd3, 0x4b, 0x8b, 0x12, 0x4b, 0xf2, 0x8b, 0x84, 0xec, 0xb9, 0x78, 0x1d, 0x53,
-	0x52, 0x82, 0xe1, 0x8a, 0x85, 0x24, 0xf5, 0x20, 0x56, 0xe5, 0x94, 0xa4, 0xea, 0x21, 0x84, 0x5d,
-	0x12, 0x4b, 0x12, 0xa5, 0x04, 0xa1, 0x52, 0x60, 0x07, 0xe8, 0x85, 0xe5, 0x67, 0xa6, 0x28, 0x31,
-	0x08, 0x39, 0x70, 0x09, 0xb8, 0xa4, 0xe6, 0xa4, 0x96, 0xa4, 0x22, 0x99, 0x21, 0x8e, 0xd5, 0x0c,
-	0x4f, 0x17, 0xec, 0x26, 0x38, 0x71, 0x09, 0x84, 0x16, 0xa4, 0x24, 0xa2, 0x98, 0x40, 0xaa, 0x2b,
-	0x3c, 0xb9, 0x04, 0xdd, 0x53, 0x4b, 0x50, 0x55, 0xe2, 0x76, 0x06, 0x6e, 0xd3, 0x95, 0x18, 0x9c,
-	0xa4, 0xa3, 0x24, 0xc1, 0xb2, 0xfa, 0xa0, 0x08, 0x49, 0xce, 0xc9, 0x2f, 0x4d, 0xd1, 0x4f, 0xcf,
-	0x87, 0x86, 0x6a, 0x12, 0x1b, 0x98, 0x36, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0xc8, 0x49, 0x50,
-	0x99, 0xae, 0x01, 0x00, 0x00,
+	proto.RegisterFile("feg/protos/hss_service.proto", fileDescriptor_hss_service_13952349db2b5ed7)
+}
+
+var fileDescriptor_hss_service_13952349db2b5ed7 = []byte{
+	// 272 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x91, 0xc1, 0x4b, 0xc3, 0x30,
+	0x18, 0xc5, 0x07, 0x82, 0x60, 0x40, 0xdc, 0xca, 0x40, 0x5a, 0xf5, 0xb2, 0x83, 0xc7, 0x14, 0x14,
+	0xc4, 0x9b, 0x3a, 0x0b, 0xda, 0xab, 0x45, 0x0f, 0x5e, 0x46, 0x9a, 0x7c, 0x8d, 0x85, 0xb6, 0x5f,
+	0xfd, 0x92, 0x8a, 0xfe, 0x73, 0xfe, 0x6d, 0x62, 0xda, 0xcd, 0x29, 0x19, 0x08, 0x3b, 0x05, 0xde,
+	0x7b, 0xfc, 0xf2, 0xf8, 0x1e, 0x3b, 0x2e, 0x40, 0xc7, 0x2d, 0xa1, 0x45, 0x13, 0xbf, 0x18, 0xb3,
+	0x30, 0x40, 0x6f, 0xa5, 0x04, 0xee, 0xa4, 0x60, 0xaf, 0x16, 0xba, 0x16, 0xbc, 0x00, 0x1d, 0x85,
+	0x48, 0xf2, 0x92, 0x96, 0x51, 0x89, 0x75, 0x8d, 0x4d, 0x9f, 0x8a, 0x4e, 0x2a, 0x0b, 0x4b, 0xc3,
+	0x74, 0xb9, 0x91, 0x54, 0xe6, 0x40, 0x2a, 0x1f, 0xec, 0x68, 0xed, 0x0b, 0x73, 0x21, 0x16, 0x2d,
+	0xe1, 0xfb, 0x47, 0xef, 0x9d, 0x7d, 0xee, 0xb0, 0x83, 0xfb, 0x2c, 0xbb, 0xc5, 0xa6, 0x28, 0x75,
+	0x47, 0xc2, 0x22, 0x05, 0x57, 0x6c, 0xff, 0x46, 0xa9, 0x6c, 0x05, 0x0a, 0x42, 0xde, 0xd7, 0xa8,
+	0x2c, 0xf0, 0x1f, 0x39, 0x11, 0x56, 0x44, 0x93, 0xc1, 0x72, 0xe5, 0xf8, 0x13, 0x96, 0x6a, 0x36,
+	0x0a, 0xae, 0xd9, 0x38, 0x81, 0x0a, 0x2c, 0xac, 0x31, 0x0e, 0xbd, 0x8c, 0x34, 0xf1, 0x13, 0xe6,
+	0x6c, 0xfc, 0xd8, 0x2a, 0x61, 0x61, 0x8b, 0x16, 0x29, 0x9b, 0xdc, 0x81, 0xfd, 0x9d, 0xdc, 0x5c,
+	0x63, 0x33, 0x7d, 0x36, 0x0a, 0x12, 0x36, 0x4d, 0x1b, 0x03, 0xf4, 0x6f, 0x9a, 0xb7, 0x50, 0xc6,
+	0xa6, 0x7f, 0xcf, 0xe2, 0x28, 0xa7, 0x7c, 0xb5, 0x32, 0xf7, 0x05, 0x1e, 0xe0, 0xb5, 0x03, 0x63,
+	0xbd, 0xd0, 0xf9, 0xd1, 0x73, 0xe8, 0xd4, 0xf8, 0x7b, 0x64, 0x59, 0x61, 0xa7, 0x62, 0x8d, 0xc3,
+	0xda, 0xf9, 0xae, 0x7b, 0xcf, 0xbf, 0x06, 0x00, 0x91, 0x7d, 0xe9, 0x13, 0x65, 0x02, 0x00, 0x00,
 }
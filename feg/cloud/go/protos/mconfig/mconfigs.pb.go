@@ -19,6 +19,29 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+type PeerSelection int32
+
+const (
+	PeerSelection_FAILOVER    PeerSelection = 0
+	PeerSelection_ROUND_ROBIN PeerSelection = 1
+)
+
+var PeerSelection_name = map[int32]string{
+	0: "FAILOVER",
+	1: "ROUND_ROBIN",
+}
+var PeerSelection_value = map[string]int32{
+	"FAILOVER":    0,
+	"ROUND_ROBIN": 1,
+}
+
+func (x PeerSelection) String() string {
+	return proto.EnumName(PeerSelection_name, int32(x))
+}
+func (PeerSelection) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_mconfigs_5fa1aefae27ca220, []int{0}
+}
+
 type GyInitMethod int32
 
 const (
@@ -42,24 +65,54 @@ func (x GyInitMethod) String() string {
 	return proto.EnumName(GyInitMethod_name, int32(x))
 }
 func (GyInitMethod) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_mconfigs_39c9e9b2bcf19223, []int{0}
+	return fileDescriptor_mconfigs_5fa1aefae27ca220, []int{1}
+}
+
+type DiamRealmRoute_Action int32
+
+const (
+	DiamRealmRoute_LOCAL DiamRealmRoute_Action = 0
+	DiamRealmRoute_RELAY DiamRealmRoute_Action = 1
+)
+
+var DiamRealmRoute_Action_name = map[int32]string{
+	0: "LOCAL",
+	1: "RELAY",
+}
+var DiamRealmRoute_Action_value = map[string]int32{
+	"LOCAL": 0,
+	"RELAY": 1,
+}
+
+func (x DiamRealmRoute_Action) String() string {
+	return proto.EnumName(DiamRealmRoute_Action_name, int32(x))
+}
+func (DiamRealmRoute_Action) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_mconfigs_5fa1aefae27ca220, []int{2, 0}
 }
 
 // ------------------------------------------------------------------------------
 // FeG configs
 // ------------------------------------------------------------------------------
 type DiamClientConfig struct {
-	Protocol             string   `protobuf:"bytes,1,opt,name=protocol,proto3" json:"protocol,omitempty"`
-	Address              string   `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	Retransmits          uint32   `protobuf:"varint,3,opt,name=retransmits,proto3" json:"retransmits,omitempty"`
-	WatchdogInterval     uint32   `protobuf:"varint,4,opt,name=watchdog_interval,json=watchdogInterval,proto3" json:"watchdog_interval,omitempty"`
-	RetryCount           uint32   `protobuf:"varint,5,opt,name=retry_count,json=retryCount,proto3" json:"retry_count,omitempty"`
-	LocalAddress         string   `protobuf:"bytes,6,opt,name=local_address,json=localAddress,proto3" json:"local_address,omitempty"`
-	ProductName          string   `protobuf:"bytes,7,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
-	Realm                string   `protobuf:"bytes,8,opt,name=realm,proto3" json:"realm,omitempty"`
-	Host                 string   `protobuf:"bytes,9,opt,name=host,proto3" json:"host,omitempty"`
-	DestRealm            string   `protobuf:"bytes,10,opt,name=dest_realm,json=destRealm,proto3" json:"dest_realm,omitempty"`
-	DestHost             string   `protobuf:"bytes,11,opt,name=dest_host,json=destHost,proto3" json:"dest_host,omitempty"`
+	Protocol         string `protobuf:"bytes,1,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	Address          string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Retransmits      uint32 `protobuf:"varint,3,opt,name=retransmits,proto3" json:"retransmits,omitempty"`
+	WatchdogInterval uint32 `protobuf:"varint,4,opt,name=watchdog_interval,json=watchdogInterval,proto3" json:"watchdog_interval,omitempty"`
+	RetryCount       uint32 `protobuf:"varint,5,opt,name=retry_count,json=retryCount,proto3" json:"retry_count,omitempty"`
+	LocalAddress     string `protobuf:"bytes,6,opt,name=local_address,json=localAddress,proto3" json:"local_address,omitempty"`
+	ProductName      string `protobuf:"bytes,7,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
+	Realm            string `protobuf:"bytes,8,opt,name=realm,proto3" json:"realm,omitempty"`
+	Host             string `protobuf:"bytes,9,opt,name=host,proto3" json:"host,omitempty"`
+	DestRealm        string `protobuf:"bytes,10,opt,name=dest_realm,json=destRealm,proto3" json:"dest_realm,omitempty"`
+	DestHost         string `protobuf:"bytes,11,opt,name=dest_host,json=destHost,proto3" json:"dest_host,omitempty"`
+	// ordered list of servers to fail over to when the primary server is unreachable
+	AlternateServers []*DiamServerConfig `protobuf:"bytes,12,rep,name=alternate_servers,json=alternateServers,proto3" json:"alternate_servers,omitempty"`
+	PeerSelection    PeerSelection       `protobuf:"varint,13,opt,name=peer_selection,json=peerSelection,proto3,enum=magma.mconfig.PeerSelection" json:"peer_selection,omitempty"`
+	// Destination-Realm & Application-Id based routes, requests not matching any route are sent to the server
+	RealmRoutes []*DiamRealmRoute `protobuf:"bytes,14,rep,name=realm_routes,json=realmRoutes,proto3" json:"realm_routes,omitempty"`
+	// move sessions to another server when their server is unreachable (CC-Session-Failover)
+	SessionFailover      bool     `protobuf:"varint,15,opt,name=session_failover,json=sessionFailover,proto3" json:"session_failover,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -69,7 +122,7 @@ func (m *DiamClientConfig) Reset()         { *m = DiamClientConfig{} }
 func (m *DiamClientConfig) String() string { return proto.CompactTextString(m) }
 func (*DiamClientConfig) ProtoMessage()    {}
 func (*DiamClientConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_mconfigs_39c9e9b2bcf19223, []int{0}
+	return fileDescriptor_mconfigs_5fa1aefae27ca220, []int{0}
 }
 func (m *DiamClientConfig) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DiamClientConfig.Unmarshal(m, b)
@@ -166,12 +219,41 @@ func (m *DiamClientConfig) GetDestHost() string {
 	return ""
 }
 
+func (m *DiamClientConfig) GetAlternateServers() []*DiamServerConfig {
+	if m != nil {
+		return m.AlternateServers
+	}
+	return nil
+}
+
+func (m *DiamClientConfig) GetPeerSelection() PeerSelection {
+	if m != nil {
+		return m.PeerSelection
+	}
+	return PeerSelection_FAILOVER
+}
+
+func (m *DiamClientConfig) GetRealmRoutes() []*DiamRealmRoute {
+	if m != nil {
+		return m.RealmRoutes
+	}
+	return nil
+}
+
+func (m *DiamClientConfig) GetSessionFailover() bool {
+	if m != nil {
+		return m.SessionFailover
+	}
+	return false
+}
+
 type DiamServerConfig struct {
 	Protocol             string   `protobuf:"bytes,1,opt,name=protocol,proto3" json:"protocol,omitempty"`
 	Address              string   `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 	LocalAddress         string   `protobuf:"bytes,3,opt,name=local_address,json=localAddress,proto3" json:"local_address,omitempty"`
 	DestHost             string   `protobuf:"bytes,4,opt,name=dest_host,json=destHost,proto3" json:"dest_host,omitempty"`
 	DestRealm            string   `protobuf:"bytes,5,opt,name=dest_realm,json=destRealm,proto3" json:"dest_realm,omitempty"`
+	Weight               uint32   `protobuf:"varint,6,opt,name=weight,proto3" json:"weight,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -181,7 +263,7 @@ func (m *DiamServerConfig) Reset()         { *m = DiamServerConfig{} }
 func (m *DiamServerConfig) String() string { return proto.CompactTextString(m) }
 func (*DiamServerConfig) ProtoMessage()    {}
 func (*DiamServerConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_mconfigs_39c9e9b2bcf19223, []int{1}
+	return fileDescriptor_mconfigs_5fa1aefae27ca220, []int{1}
 }
 func (m *DiamServerConfig) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DiamServerConfig.Unmarshal(m, b)
@@ -236,6 +318,93 @@ func (m *DiamServerConfig) GetDestRealm() string {
 	return ""
 }
 
+func (m *DiamServerConfig) GetWeight() uint32 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
+type DiamRealmRoute struct {
+	Realm         string                `protobuf:"bytes,1,opt,name=realm,proto3" json:"realm,omitempty"`
+	ApplicationId uint32                `protobuf:"varint,2,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	Action        DiamRealmRoute_Action `protobuf:"varint,3,opt,name=action,proto3,enum=magma.mconfig.DiamRealmRoute_Action" json:"action,omitempty"`
+	Peers         []*DiamServerConfig   `protobuf:"bytes,4,rep,name=peers,proto3" json:"peers,omitempty"`
+	PeerSelection PeerSelection         `protobuf:"varint,5,opt,name=peer_selection,json=peerSelection,proto3,enum=magma.mconfig.PeerSelection" json:"peer_selection,omitempty"`
+	// PLMN IDs (MCC+MNC) of the realm's subscribers, the Destination-Realm of requests
+	// for their IMSIs is set to the realm or, for "*", derived from the PLMN ID (TS 23.003 19.2)
+	PlmnIds              []string `protobuf:"bytes,6,rep,name=plmn_ids,json=plmnIds,proto3" json:"plmn_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DiamRealmRoute) Reset()         { *m = DiamRealmRoute{} }
+func (m *DiamRealmRoute) String() string { return proto.CompactTextString(m) }
+func (*DiamRealmRoute) ProtoMessage()    {}
+func (*DiamRealmRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_mconfigs_5fa1aefae27ca220, []int{2}
+}
+func (m *DiamRealmRoute) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DiamRealmRoute.Unmarshal(m, b)
+}
+func (m *DiamRealmRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DiamRealmRoute.Marshal(b, m, deterministic)
+}
+func (dst *DiamRealmRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DiamRealmRoute.Merge(dst, src)
+}
+func (m *DiamRealmRoute) XXX_Size() int {
+	return xxx_messageInfo_DiamRealmRoute.Size(m)
+}
+func (m *DiamRealmRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_DiamRealmRoute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DiamRealmRoute proto.InternalMessageInfo
+
+func (m *DiamRealmRoute) GetRealm() string {
+	if m != nil {
+		return m.Realm
+	}
+	return ""
+}
+
+func (m *DiamRealmRoute) GetApplicationId() uint32 {
+	if m != nil {
+		return m.ApplicationId
+	}
+	return 0
+}
+
+func (m *DiamRealmRoute) GetAction() DiamRealmRoute_Action {
+	if m != nil {
+		return m.Action
+	}
+	return DiamRealmRoute_LOCAL
+}
+
+func (m *DiamRealmRoute) GetPeers() []*DiamServerConfig {
+	if m != nil {
+		return m.Peers
+	}
+	return nil
+}
+
+func (m *DiamRealmRoute) GetPeerSelection() PeerSelection {
+	if m != nil {
+		return m.PeerSelection
+	}
+	return PeerSelection_FAILOVER
+}
+
+func (m *DiamRealmRoute) GetPlmnIds() []string {
+	if m != nil {
+		return m.PlmnIds
+	}
+	return nil
+}
+
 type S6AConfig struct {
 	LogLevel protos.LogLevel   `protobuf:"varint,1,opt,name=log_level,json=logLevel,proto3,enum=magma.orc8r.LogLevel" json:"log_level,omitempty"`
 	Server   *DiamClientConfig `protobuf:"bytes,5,opt,name=server,proto3" json:"server,omitempty"`
@@ -252,7 +421,7 @@ func (m *S6AConfig) Reset()         { *m = S6AConfig{} }
 func (m *S6AConfig) String() string { return proto.CompactTextString(m) }
 func (*S6AConfig) ProtoMessage()    {}
 func (*S6AConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_mconfigs_39c9e9b2bcf19223, []int{2}
+	return fileDescriptor_mconfigs_5fa1aefae27ca220, []int{3}
 }
 func (m *S6AConfig) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_S6AConfig.Unmarshal(m, b)
@@ -311,7 +480,7 @@ func (m *GxConfig) Reset()         { *m = GxConfig{} }
 func (m *GxConfig) String() string { return proto.CompactTextString(m) }
 func (*GxConfig) ProtoMessage()    {}
 func (*GxConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_mconfigs_39c9e9b2bcf19223, []int{3}
+	return fileDescriptor_mconfigs_5fa1aefae27ca220, []int{4}
 }
 func (m *GxConfig) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GxConfig.Unmarshal(m, b)
@@ -350,7 +519,7 @@ func (m *GyConfig) Reset()         { *m = GyConfig{} }
 func (m *GyConfig) String() string { return proto.CompactTextString(m) }
 func (*GyConfig) ProtoMessage()    {}
 func (*GyConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_mconfigs_39c9e9b2bcf19223, []int{4}
+	return fileDescriptor_mconfigs_5fa1aefae27ca220, []int{5}
 }
 func (m *GyConfig) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GyConfig.Unmarshal(m, b)
@@ -401,7 +570,7 @@ func (m *SessionProxyConfig) Reset()         { *m = SessionProxyConfig{} }
 func (m *SessionProxyConfig) String() string { return proto.CompactTextString(m) }
 func (*SessionProxyConfig) ProtoMessage()    {}
 func (*SessionProxyConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_mconfigs_39c9e9b2bcf19223, []int{5}
+	return fileDescriptor_mconfigs_5fa1aefae27ca220, []int{6}
 }
 func (m *SessionProxyConfig) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SessionProxyConfig.Unmarshal(m, b)
@@ -471,7 +640,7 @@ func (m *SwxConfig) Reset()         { *m = SwxConfig{} }
 func (m *SwxConfig) String() string { return proto.CompactTextString(m) }
 func (*SwxConfig) ProtoMessage()    {}
 func (*SwxConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_mconfigs_39c9e9b2bcf19223, []int{6}
+	return fileDescriptor_mconfigs_5fa1aefae27ca220, []int{7}
 }
 func (m *SwxConfig) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SwxConfig.Unmarshal(m, b)
@@ -532,7 +701,7 @@ func (m *EapAkaConfig) Reset()         { *m = EapAkaConfig{} }
 func (m *EapAkaConfig) String() string { return proto.CompactTextString(m) }
 func (*EapAkaConfig) ProtoMessage()    {}
 func (*EapAkaConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_mconfigs_39c9e9b2bcf19223, []int{7}
+	return fileDescriptor_mconfigs_5fa1aefae27ca220, []int{8}
 }
 func (m *EapAkaConfig) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EapAkaConfig.Unmarshal(m, b)
@@ -587,7 +756,7 @@ func (m *EapAkaConfig_Timeouts) Reset()         { *m = EapAkaConfig_Timeouts{} }
 func (m *EapAkaConfig_Timeouts) String() string { return proto.CompactTextString(m) }
 func (*EapAkaConfig_Timeouts) ProtoMessage()    {}
 func (*EapAkaConfig_Timeouts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_mconfigs_39c9e9b2bcf19223, []int{7, 0}
+	return fileDescriptor_mconfigs_5fa1aefae27ca220, []int{8, 0}
 }
 func (m *EapAkaConfig_Timeouts) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EapAkaConfig_Timeouts.Unmarshal(m, b)
@@ -650,7 +819,7 @@ func (m *GatewayHealthConfig) Reset()         { *m = GatewayHealthConfig{} }
 func (m *GatewayHealthConfig) String() string { return proto.CompactTextString(m) }
 func (*GatewayHealthConfig) ProtoMessage()    {}
 func (*GatewayHealthConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_mconfigs_39c9e9b2bcf19223, []int{8}
+	return fileDescriptor_mconfigs_5fa1aefae27ca220, []int{9}
 }
 func (m *GatewayHealthConfig) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GatewayHealthConfig.Unmarshal(m, b)
@@ -716,17 +885,19 @@ type HSSConfig struct {
 	// If an IMSI if not found in sub_profiles, the default profile is used instead.
 	DefaultSubProfile *HSSConfig_SubscriptionProfile `protobuf:"bytes,5,opt,name=default_sub_profile,json=defaultSubProfile,proto3" json:"default_sub_profile,omitempty"`
 	// Whether to stream subscribers from the cloud subscriberdb service.
-	StreamSubscribers    bool     `protobuf:"varint,6,opt,name=stream_subscribers,json=streamSubscribers,proto3" json:"stream_subscribers,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	StreamSubscribers bool `protobuf:"varint,6,opt,name=stream_subscribers,json=streamSubscribers,proto3" json:"stream_subscribers,omitempty"`
+	// Whether to push updated subscriber profiles to their serving MMEs with IDRs.
+	PushSubscriberUpdates bool     `protobuf:"varint,7,opt,name=push_subscriber_updates,json=pushSubscriberUpdates,proto3" json:"push_subscriber_updates,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
+	XXX_unrecognized      []byte   `json:"-"`
+	XXX_sizecache         int32    `json:"-"`
 }
 
 func (m *HSSConfig) Reset()         { *m = HSSConfig{} }
 func (m *HSSConfig) String() string { return proto.CompactTextString(m) }
 func (*HSSConfig) ProtoMessage()    {}
 func (*HSSConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_mconfigs_39c9e9b2bcf19223, []int{9}
+	return fileDescriptor_mconfigs_5fa1aefae27ca220, []int{10}
 }
 func (m *HSSConfig) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_HSSConfig.Unmarshal(m, b)
@@ -788,6 +959,13 @@ func (m *HSSConfig) GetStreamSubscribers() bool {
 	return false
 }
 
+func (m *HSSConfig) GetPushSubscriberUpdates() bool {
+	if m != nil {
+		return m.PushSubscriberUpdates
+	}
+	return false
+}
+
 type HSSConfig_SubscriptionProfile struct {
 	// Maximum uplink bit rate (AMBR-UL)
 	MaxUlBitRate uint64 `protobuf:"varint,1,opt,name=max_ul_bit_rate,json=maxUlBitRate,proto3" json:"max_ul_bit_rate,omitempty"`
@@ -802,7 +980,7 @@ func (m *HSSConfig_SubscriptionProfile) Reset()         { *m = HSSConfig_Subscri
 func (m *HSSConfig_SubscriptionProfile) String() string { return proto.CompactTextString(m) }
 func (*HSSConfig_SubscriptionProfile) ProtoMessage()    {}
 func (*HSSConfig_SubscriptionProfile) Descriptor() ([]byte, []int) {
-	return fileDescriptor_mconfigs_39c9e9b2bcf19223, []int{9, 0}
+	return fileDescriptor_mconfigs_5fa1aefae27ca220, []int{10, 0}
 }
 func (m *HSSConfig_SubscriptionProfile) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_HSSConfig_SubscriptionProfile.Unmarshal(m, b)
@@ -839,6 +1017,7 @@ func (m *HSSConfig_SubscriptionProfile) GetMaxDlBitRate() uint64 {
 func init() {
 	proto.RegisterType((*DiamClientConfig)(nil), "magma.mconfig.DiamClientConfig")
 	proto.RegisterType((*DiamServerConfig)(nil), "magma.mconfig.DiamServerConfig")
+	proto.RegisterType((*DiamRealmRoute)(nil), "magma.mconfig.DiamRealmRoute")
 	proto.RegisterType((*S6AConfig)(nil), "magma.mconfig.S6aConfig")
 	proto.RegisterType((*GxConfig)(nil), "magma.mconfig.GxConfig")
 	proto.RegisterType((*GyConfig)(nil), "magma.mconfig.GyConfig")
@@ -850,85 +1029,105 @@ func init() {
 	proto.RegisterType((*HSSConfig)(nil), "magma.mconfig.HSSConfig")
 	proto.RegisterMapType((map[string]*HSSConfig_SubscriptionProfile)(nil), "magma.mconfig.HSSConfig.SubProfilesEntry")
 	proto.RegisterType((*HSSConfig_SubscriptionProfile)(nil), "magma.mconfig.HSSConfig.SubscriptionProfile")
+	proto.RegisterEnum("magma.mconfig.PeerSelection", PeerSelection_name, PeerSelection_value)
 	proto.RegisterEnum("magma.mconfig.GyInitMethod", GyInitMethod_name, GyInitMethod_value)
+	proto.RegisterEnum("magma.mconfig.DiamRealmRoute_Action", DiamRealmRoute_Action_name, DiamRealmRoute_Action_value)
 }
 
 func init() {
-	proto.RegisterFile("feg/protos/mconfig/mconfigs.proto", fileDescriptor_mconfigs_39c9e9b2bcf19223)
-}
-
-var fileDescriptor_mconfigs_39c9e9b2bcf19223 = []byte{
-	// 1147 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xdd, 0x4e, 0x1b, 0xc7,
-	0x17, 0xff, 0x7b, 0x81, 0xd8, 0x3e, 0x36, 0x89, 0x19, 0xf2, 0x4f, 0x0c, 0x49, 0x1b, 0xe2, 0xb4,
-	0x2a, 0x6d, 0x5a, 0x93, 0x52, 0x29, 0x45, 0xa8, 0x6a, 0x04, 0xc6, 0x05, 0x54, 0x20, 0x68, 0x97,
-	0x56, 0x6a, 0x55, 0x69, 0x35, 0xec, 0x8e, 0xed, 0x51, 0x66, 0x77, 0xdc, 0x99, 0x59, 0xb0, 0x7b,
-	0xd7, 0x57, 0xe8, 0x5b, 0xf4, 0xae, 0x17, 0x79, 0x93, 0xaa, 0xaf, 0xd0, 0xeb, 0x3e, 0x42, 0x35,
-	0x1f, 0x6b, 0x1b, 0x43, 0x90, 0x10, 0xbd, 0xda, 0x9d, 0xf3, 0xfb, 0x9d, 0x33, 0xe7, 0x6b, 0xce,
-	0x0c, 0x3c, 0xed, 0x90, 0xee, 0x5a, 0x5f, 0x70, 0xc5, 0xe5, 0x5a, 0x12, 0xf1, 0xb4, 0x43, 0xbb,
-	0xf9, 0x57, 0x36, 0x8d, 0x1c, 0xcd, 0x27, 0xb8, 0x9b, 0xe0, 0xa6, 0x93, 0x2e, 0x2f, 0x71, 0x11,
-	0x6d, 0x88, 0x5c, 0x27, 0xe2, 0x49, 0xc2, 0x53, 0xcb, 0x6c, 0xfc, 0xed, 0x41, 0x6d, 0x87, 0xe2,
-	0xa4, 0xc5, 0x28, 0x49, 0x55, 0xcb, 0xf0, 0xd1, 0x32, 0x94, 0x0c, 0x1a, 0x71, 0x56, 0x2f, 0xac,
-	0x14, 0x56, 0xcb, 0xfe, 0x68, 0x8d, 0xea, 0x50, 0xc4, 0x71, 0x2c, 0x88, 0x94, 0x75, 0xcf, 0x40,
-	0xf9, 0x12, 0xad, 0x40, 0x45, 0x10, 0x25, 0x70, 0x2a, 0x13, 0xaa, 0x64, 0x7d, 0x66, 0xa5, 0xb0,
-	0x3a, 0xef, 0x4f, 0x8a, 0xd0, 0x73, 0x58, 0x38, 0xc7, 0x2a, 0xea, 0xc5, 0xbc, 0x1b, 0xd2, 0x54,
-	0x11, 0x71, 0x86, 0x59, 0x7d, 0xd6, 0xf0, 0x6a, 0x39, 0xb0, 0xef, 0xe4, 0xe8, 0x89, 0x35, 0x37,
-	0x0c, 0x23, 0x9e, 0xa5, 0xaa, 0x3e, 0x67, 0x68, 0x60, 0x44, 0x2d, 0x2d, 0x41, 0xcf, 0x60, 0x9e,
-	0xf1, 0x08, 0xb3, 0x30, 0xf7, 0xe7, 0x8e, 0xf1, 0xa7, 0x6a, 0x84, 0x5b, 0xce, 0xa9, 0xa7, 0x50,
-	0xed, 0x0b, 0x1e, 0x67, 0x91, 0x0a, 0x53, 0x9c, 0x90, 0x7a, 0xd1, 0x70, 0x2a, 0x4e, 0x76, 0x84,
-	0x13, 0x82, 0xee, 0xc3, 0x9c, 0x20, 0x98, 0x25, 0xf5, 0x92, 0xc1, 0xec, 0x02, 0x21, 0x98, 0xed,
-	0x71, 0xa9, 0xea, 0x65, 0x23, 0x34, 0xff, 0xe8, 0x3d, 0x80, 0x98, 0x48, 0x15, 0x5a, 0x3a, 0x18,
-	0xa4, 0xac, 0x25, 0xbe, 0x51, 0x79, 0x04, 0x66, 0x11, 0x1a, 0xbd, 0x8a, 0xcd, 0x9b, 0x16, 0xec,
-	0x71, 0xa9, 0x1a, 0xbf, 0x17, 0x6c, 0xa2, 0x03, 0x22, 0xce, 0x88, 0xb8, 0x55, 0xa2, 0x2f, 0x05,
-	0x3e, 0x73, 0x45, 0xe0, 0x17, 0x9c, 0x99, 0xbd, 0xe8, 0xcc, 0x54, 0x20, 0x73, 0x53, 0x81, 0x34,
-	0xfe, 0x29, 0x40, 0x39, 0x78, 0x89, 0x9d, 0x93, 0xeb, 0x50, 0x66, 0xbc, 0x1b, 0x32, 0x72, 0x46,
-	0xac, 0x97, 0x77, 0xd7, 0xff, 0xdf, 0xb4, 0x0d, 0x66, 0xfa, 0xaa, 0x79, 0xc0, 0xbb, 0x07, 0x1a,
-	0xf4, 0x4b, 0xcc, 0xfd, 0xa1, 0x2f, 0xe1, 0x8e, 0x34, 0x81, 0x1a, 0xe3, 0x95, 0xf5, 0x27, 0xcd,
-	0x0b, 0x1d, 0xd9, 0x9c, 0x6e, 0x39, 0xdf, 0xd1, 0xd1, 0x26, 0x2c, 0x09, 0xf2, 0x73, 0xa6, 0x9d,
-	0xeb, 0x60, 0xca, 0x32, 0x41, 0x42, 0xd5, 0x13, 0x44, 0xf6, 0x38, 0x8b, 0x4d, 0x81, 0x3d, 0xff,
-	0xa1, 0x23, 0x7c, 0x63, 0xf1, 0x93, 0x1c, 0xd6, 0xba, 0x09, 0x4d, 0x69, 0x92, 0x25, 0x61, 0x6e,
-	0x63, 0xac, 0x5b, 0x34, 0xfd, 0xf3, 0xd0, 0x11, 0x7c, 0x8b, 0x8f, 0x74, 0x1b, 0x2d, 0x28, 0xed,
-	0x0e, 0x5c, 0xc0, 0x63, 0xe7, 0x0b, 0x37, 0x72, 0xbe, 0xf1, 0x6b, 0x01, 0x4a, 0xbb, 0xc3, 0x5b,
-	0x5a, 0x41, 0x5f, 0x41, 0x85, 0xa6, 0x54, 0x85, 0x09, 0x51, 0x3d, 0x1e, 0x9b, 0xe2, 0xdf, 0x5d,
-	0x7f, 0x34, 0xa5, 0xbd, 0x3b, 0xdc, 0x4f, 0xa9, 0x3a, 0x34, 0x14, 0x1f, 0xe8, 0xe8, 0xbf, 0xf1,
-	0x9b, 0x07, 0x28, 0x20, 0x52, 0x52, 0x9e, 0x1e, 0x0b, 0x3e, 0x18, 0xde, 0xa2, 0x88, 0x1f, 0x81,
-	0xd7, 0x1d, 0xb8, 0x02, 0x3e, 0x9c, 0xde, 0xdf, 0x25, 0xcb, 0xf7, 0xba, 0x03, 0x43, 0x1c, 0x9a,
-	0xea, 0x5c, 0x41, 0x1c, 0x8e, 0x88, 0xc3, 0xeb, 0xab, 0x5b, 0xbc, 0x45, 0x75, 0x4b, 0xd7, 0x57,
-	0xf7, 0x2f, 0xdd, 0xd0, 0xe7, 0x83, 0xff, 0xa4, 0xa1, 0xbd, 0x9b, 0x55, 0xf3, 0x73, 0xb8, 0x7f,
-	0x46, 0x04, 0xed, 0x0c, 0x43, 0x9c, 0xa9, 0x1e, 0x17, 0xf4, 0x17, 0xac, 0x28, 0x4f, 0xcd, 0x99,
-	0x2d, 0xf9, 0x8b, 0x16, 0xdb, 0x9a, 0x84, 0xd0, 0x2a, 0xdc, 0x6b, 0xe1, 0xa8, 0x47, 0x4e, 0x4e,
-	0x0e, 0x02, 0x12, 0xf1, 0x34, 0x96, 0x6e, 0x48, 0x4e, 0x8b, 0x1b, 0x7f, 0x7a, 0x50, 0x6d, 0xe3,
-	0xfe, 0xd6, 0x9b, 0xdb, 0x9c, 0xd5, 0xaf, 0xa1, 0xa8, 0x68, 0x42, 0x78, 0xa6, 0x5c, 0x6c, 0x1f,
-	0x4c, 0xc5, 0x36, 0xb9, 0x43, 0xf3, 0xc4, 0x52, 0xa5, 0x9f, 0x2b, 0xe9, 0x41, 0x75, 0xcc, 0x92,
-	0x74, 0x3f, 0xd6, 0x83, 0x68, 0x46, 0x0f, 0x2a, 0xb7, 0x5c, 0x7e, 0x5b, 0x80, 0x52, 0xce, 0xd7,
-	0xd7, 0x43, 0xab, 0x87, 0x19, 0x23, 0x69, 0x97, 0x1c, 0x4a, 0xe3, 0xdc, 0xbc, 0x3f, 0x29, 0x42,
-	0x2f, 0x60, 0xb1, 0x2d, 0x04, 0x17, 0x47, 0x5c, 0xd1, 0x0e, 0x8d, 0x4c, 0x32, 0x0e, 0xed, 0xf4,
-	0x9b, 0xf7, 0xaf, 0x82, 0xd0, 0x63, 0x28, 0xbb, 0x5e, 0x3f, 0xcc, 0x2f, 0x9c, 0xb1, 0x00, 0xbd,
-	0x84, 0x07, 0x6e, 0xa1, 0xf3, 0x4b, 0x52, 0xa5, 0x15, 0x49, 0x7c, 0x98, 0xa7, 0xf3, 0x1d, 0x68,
-	0xe3, 0x0f, 0x0f, 0x16, 0x77, 0xb1, 0x22, 0xe7, 0x78, 0xb8, 0x47, 0x30, 0x53, 0x3d, 0x97, 0xdc,
-	0xe7, 0xb0, 0xa0, 0x3b, 0x8f, 0x0a, 0x12, 0x87, 0xba, 0xba, 0x34, 0x22, 0x3a, 0x0e, 0x1d, 0x72,
-	0x2d, 0x07, 0x02, 0x27, 0x47, 0x2f, 0xe0, 0x7e, 0xd6, 0x8f, 0xb1, 0x22, 0xa3, 0x9b, 0x2e, 0x94,
-	0x24, 0xca, 0xa3, 0x41, 0x16, 0xcb, 0x2f, 0xbb, 0x80, 0x44, 0x12, 0x6d, 0x40, 0xdd, 0x69, 0x5c,
-	0x3e, 0x1b, 0x36, 0xb6, 0x07, 0x16, 0xbf, 0x74, 0x34, 0x5e, 0xc1, 0xe3, 0x88, 0xf1, 0x2c, 0x0e,
-	0x63, 0x2a, 0x23, 0x9e, 0xa6, 0x24, 0x52, 0x61, 0x9f, 0x08, 0xca, 0x63, 0xbb, 0xa7, 0x0d, 0x77,
-	0xc9, 0x70, 0x76, 0x46, 0x94, 0x63, 0xc3, 0x30, 0x5b, 0xbf, 0x82, 0xc7, 0xf6, 0x46, 0x79, 0x87,
-	0x01, 0x7b, 0xf9, 0x2e, 0x19, 0xce, 0x55, 0x06, 0x1a, 0x6f, 0x67, 0xa1, 0xbc, 0x17, 0x04, 0x37,
-	0x18, 0x7d, 0x93, 0xf7, 0xe0, 0xe8, 0xb0, 0xbc, 0x0f, 0x15, 0xa6, 0x88, 0x39, 0x29, 0x21, 0xef,
-	0x9b, 0x5c, 0x55, 0xfd, 0x32, 0x53, 0x44, 0x97, 0xe8, 0x75, 0x1f, 0xad, 0x40, 0x75, 0x84, 0xe3,
-	0xa4, 0x63, 0xd2, 0x52, 0xf5, 0xc1, 0x11, 0xb6, 0x92, 0x0e, 0x3a, 0x80, 0xaa, 0xcc, 0x4e, 0xc3,
-	0xbe, 0xe0, 0x1d, 0xca, 0x88, 0x0e, 0x7d, 0x66, 0xb5, 0xb2, 0xfe, 0xf1, 0x94, 0x03, 0x23, 0x57,
-	0x9b, 0x41, 0x76, 0x7a, 0xec, 0xb8, 0xed, 0x54, 0x89, 0xa1, 0x5f, 0x91, 0x63, 0x09, 0xfa, 0x09,
-	0x16, 0x63, 0xd2, 0xc1, 0x19, 0x53, 0xe1, 0x84, 0x55, 0x37, 0x12, 0x3f, 0xbd, 0xce, 0xa8, 0x8c,
-	0x04, 0xed, 0x2b, 0x3b, 0x84, 0xb5, 0x8e, 0xbf, 0xe0, 0x0c, 0x8d, 0x37, 0x44, 0x9f, 0x01, 0x92,
-	0x4a, 0x10, 0x9c, 0x68, 0xe3, 0x5a, 0xe1, 0x94, 0x08, 0xfb, 0x8a, 0x29, 0xf9, 0x0b, 0x16, 0x09,
-	0xc6, 0xc0, 0x72, 0x04, 0x8b, 0x57, 0x18, 0x46, 0x1f, 0xc2, 0xbd, 0x04, 0x0f, 0xc2, 0x8c, 0x85,
-	0xa7, 0x54, 0x85, 0x02, 0x2b, 0x62, 0xb2, 0x3e, 0xeb, 0x57, 0x13, 0x3c, 0xf8, 0x8e, 0x6d, 0x53,
-	0xe5, 0x63, 0x35, 0xa2, 0xc5, 0x13, 0x34, 0x6f, 0x44, 0xdb, 0xc9, 0x69, 0xcb, 0x0c, 0x6a, 0xd3,
-	0x29, 0x41, 0x35, 0x98, 0x79, 0x43, 0x86, 0xee, 0x81, 0xa2, 0x7f, 0xd1, 0x36, 0xcc, 0x9d, 0x61,
-	0x96, 0x11, 0x37, 0x30, 0x6e, 0x96, 0x09, 0xab, 0xba, 0xe9, 0x6d, 0x14, 0x3e, 0xd9, 0x84, 0xea,
-	0xe4, 0x45, 0x86, 0xaa, 0x50, 0xf2, 0xdb, 0x41, 0xdb, 0xff, 0xbe, 0xbd, 0x53, 0xfb, 0x1f, 0xba,
-	0x07, 0x95, 0xe3, 0xb6, 0x1f, 0x06, 0xed, 0x20, 0xd8, 0x7f, 0x7d, 0x54, 0x2b, 0xa0, 0x0a, 0x14,
-	0xb5, 0xe0, 0xdb, 0xf6, 0x0f, 0x35, 0x6f, 0xfb, 0xd9, 0x8f, 0x4f, 0xcd, 0xae, 0x6b, 0xfa, 0x39,
-	0x6c, 0x5a, 0x7b, 0xad, 0xcb, 0xa7, 0xde, 0xc5, 0xa7, 0x77, 0xcc, 0xfa, 0x8b, 0x7f, 0x03, 0x00,
-	0x00, 0xff, 0xff, 0xe8, 0x6f, 0x73, 0x89, 0x34, 0x0b, 0x00, 0x00,
+	proto.RegisterFile("feg/protos/mconfig/mconfigs.proto", fileDescriptor_mconfigs_5fa1aefae27ca220)
+}
+
+var fileDescriptor_mconfigs_5fa1aefae27ca220 = []byte{
+	// 1425 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xdd, 0x6e, 0x1b, 0xbb,
+	0x11, 0x8e, 0x64, 0x5b, 0x96, 0x46, 0x92, 0x2d, 0xd3, 0x49, 0xbc, 0x76, 0x9c, 0xc4, 0x51, 0x1a,
+	0xd4, 0x49, 0x5a, 0x39, 0x75, 0xd1, 0x34, 0x08, 0x82, 0xa6, 0xb2, 0xac, 0x38, 0x46, 0xe5, 0x1f,
+	0x50, 0x4e, 0x80, 0x14, 0x05, 0x16, 0xf4, 0x2e, 0x25, 0x2d, 0xb2, 0xbb, 0x54, 0x49, 0xae, 0x6d,
+	0xf5, 0xae, 0xaf, 0xd0, 0x27, 0xe9, 0x45, 0x5f, 0xa0, 0x7d, 0x85, 0xe2, 0xbc, 0xc2, 0xb9, 0x3a,
+	0x17, 0xe7, 0x11, 0x0e, 0xf8, 0xb3, 0x92, 0xac, 0x28, 0xc6, 0x71, 0x7c, 0xae, 0x44, 0xce, 0x7c,
+	0xc3, 0x9d, 0x99, 0x6f, 0x38, 0x1c, 0xc1, 0xa3, 0x0e, 0xed, 0x6e, 0xf5, 0x39, 0x93, 0x4c, 0x6c,
+	0x45, 0x1e, 0x8b, 0x3b, 0x41, 0x37, 0xfd, 0x15, 0x35, 0x2d, 0x47, 0xe5, 0x88, 0x74, 0x23, 0x52,
+	0xb3, 0xd2, 0xb5, 0x55, 0xc6, 0xbd, 0x57, 0x3c, 0xb5, 0xf1, 0x58, 0x14, 0xb1, 0xd8, 0x20, 0xab,
+	0xdf, 0xcf, 0x42, 0x65, 0x37, 0x20, 0x51, 0x23, 0x0c, 0x68, 0x2c, 0x1b, 0x1a, 0x8f, 0xd6, 0x20,
+	0xaf, 0xb5, 0x1e, 0x0b, 0x9d, 0xcc, 0x46, 0x66, 0xb3, 0x80, 0x87, 0x7b, 0xe4, 0xc0, 0x3c, 0xf1,
+	0x7d, 0x4e, 0x85, 0x70, 0xb2, 0x5a, 0x95, 0x6e, 0xd1, 0x06, 0x14, 0x39, 0x95, 0x9c, 0xc4, 0x22,
+	0x0a, 0xa4, 0x70, 0x66, 0x36, 0x32, 0x9b, 0x65, 0x3c, 0x2e, 0x42, 0xcf, 0x61, 0xe9, 0x9c, 0x48,
+	0xaf, 0xe7, 0xb3, 0xae, 0x1b, 0xc4, 0x92, 0xf2, 0x33, 0x12, 0x3a, 0xb3, 0x1a, 0x57, 0x49, 0x15,
+	0xfb, 0x56, 0x8e, 0x1e, 0x9a, 0xe3, 0x06, 0xae, 0xc7, 0x92, 0x58, 0x3a, 0x73, 0x1a, 0x06, 0x5a,
+	0xd4, 0x50, 0x12, 0xf4, 0x18, 0xca, 0x21, 0xf3, 0x48, 0xe8, 0xa6, 0xfe, 0xe4, 0xb4, 0x3f, 0x25,
+	0x2d, 0xac, 0x5b, 0xa7, 0x1e, 0x41, 0xa9, 0xcf, 0x99, 0x9f, 0x78, 0xd2, 0x8d, 0x49, 0x44, 0x9d,
+	0x79, 0x8d, 0x29, 0x5a, 0xd9, 0x21, 0x89, 0x28, 0xba, 0x0d, 0x73, 0x9c, 0x92, 0x30, 0x72, 0xf2,
+	0x5a, 0x67, 0x36, 0x08, 0xc1, 0x6c, 0x8f, 0x09, 0xe9, 0x14, 0xb4, 0x50, 0xaf, 0xd1, 0x7d, 0x00,
+	0x9f, 0x0a, 0xe9, 0x1a, 0x38, 0x68, 0x4d, 0x41, 0x49, 0xb0, 0x36, 0xb9, 0x07, 0x7a, 0xe3, 0x6a,
+	0xbb, 0xa2, 0xc9, 0x9b, 0x12, 0xbc, 0x57, 0xb6, 0x2d, 0x58, 0x22, 0xa1, 0xa4, 0x3c, 0x26, 0x92,
+	0xba, 0x82, 0xf2, 0x33, 0xca, 0x85, 0x53, 0xda, 0x98, 0xd9, 0x2c, 0x6e, 0x3f, 0xac, 0x5d, 0xa2,
+	0xab, 0xa6, 0xf8, 0x68, 0x6b, 0x84, 0xe1, 0x03, 0x57, 0x86, 0x96, 0x46, 0x2c, 0x50, 0x03, 0x16,
+	0xfa, 0x94, 0x72, 0x57, 0xd0, 0x90, 0x7a, 0x32, 0x60, 0xb1, 0x53, 0xde, 0xc8, 0x6c, 0x2e, 0x6c,
+	0xaf, 0x4f, 0x1c, 0x75, 0x4c, 0x29, 0x6f, 0xa7, 0x18, 0x5c, 0xee, 0x8f, 0x6f, 0xd1, 0x9f, 0xa1,
+	0xa4, 0x23, 0x71, 0x39, 0x4b, 0x24, 0x15, 0xce, 0x82, 0xf6, 0xe6, 0xfe, 0x14, 0x6f, 0x74, 0x7c,
+	0x58, 0xa1, 0x14, 0xa1, 0xe9, 0x5a, 0xa0, 0xa7, 0x50, 0x11, 0x54, 0x88, 0x80, 0xc5, 0x6e, 0x87,
+	0x04, 0x21, 0x3b, 0xa3, 0xdc, 0x59, 0xdc, 0xc8, 0x6c, 0xe6, 0xf1, 0xa2, 0x95, 0xbf, 0xb3, 0xe2,
+	0xea, 0x7f, 0x33, 0xa6, 0xd0, 0xc6, 0x03, 0xfb, 0xc6, 0x42, 0xfb, 0x82, 0xf8, 0x99, 0x29, 0xc4,
+	0x5f, 0x22, 0x63, 0x76, 0x82, 0x8c, 0xcb, 0x44, 0xce, 0x4d, 0x12, 0x79, 0x17, 0x72, 0xe7, 0x34,
+	0xe8, 0xf6, 0xa4, 0x2e, 0xa9, 0x32, 0xb6, 0xbb, 0xea, 0xff, 0xb2, 0xb0, 0x70, 0x39, 0x1d, 0xa3,
+	0xe2, 0xc9, 0x8c, 0x17, 0xcf, 0x13, 0x58, 0x20, 0xfd, 0x7e, 0x18, 0x78, 0x44, 0x25, 0xda, 0x0d,
+	0x7c, 0x1d, 0x42, 0x19, 0x97, 0xc7, 0xa4, 0xfb, 0x3e, 0x7a, 0x03, 0x39, 0x62, 0xd8, 0x9b, 0xd1,
+	0xec, 0xfd, 0xea, 0xca, 0xd4, 0xd7, 0xea, 0x86, 0x45, 0x6b, 0x83, 0xfe, 0x00, 0x73, 0x8a, 0x4f,
+	0xe1, 0xcc, 0xfe, 0xbc, 0x2a, 0x32, 0xe8, 0x29, 0xa5, 0x33, 0x77, 0xfd, 0xd2, 0x59, 0x85, 0x7c,
+	0x3f, 0x8c, 0x54, 0x64, 0xea, 0xda, 0xcd, 0x28, 0x76, 0xd4, 0x7e, 0xdf, 0x17, 0xd5, 0x07, 0x90,
+	0x33, 0x8e, 0xa2, 0x02, 0xcc, 0xb5, 0x8e, 0x1a, 0xf5, 0x56, 0xe5, 0x96, 0x5a, 0xe2, 0x66, 0xab,
+	0xfe, 0xa9, 0x92, 0xa9, 0xfe, 0x98, 0x81, 0x42, 0xfb, 0x25, 0xb1, 0x15, 0xb0, 0x0d, 0x85, 0x90,
+	0x75, 0xdd, 0x90, 0x9e, 0x51, 0x53, 0x02, 0x0b, 0xdb, 0x77, 0xac, 0x23, 0xba, 0x69, 0xd5, 0x5a,
+	0xac, 0xdb, 0x52, 0x4a, 0x9c, 0x0f, 0xed, 0x0a, 0xfd, 0x11, 0x72, 0xe6, 0x02, 0x69, 0xcf, 0xa7,
+	0x47, 0x3e, 0xde, 0xcf, 0xb0, 0x85, 0xa3, 0xd7, 0xb0, 0xca, 0xe9, 0xdf, 0x13, 0xc5, 0xbc, 0x2a,
+	0xd7, 0x84, 0x53, 0x57, 0xf6, 0x38, 0x15, 0x3d, 0x16, 0xfa, 0x9a, 0xea, 0x2c, 0x5e, 0xb1, 0x80,
+	0x77, 0x46, 0x7f, 0x92, 0xaa, 0x95, 0x6d, 0x14, 0xc4, 0x41, 0x94, 0x44, 0x6e, 0x7a, 0xc6, 0xc8,
+	0x76, 0x5e, 0xb3, 0xbb, 0x62, 0x01, 0xd8, 0xe8, 0x87, 0xb6, 0xd5, 0x06, 0xe4, 0xf7, 0x2e, 0x6c,
+	0xc0, 0x23, 0xe7, 0x33, 0xd7, 0x72, 0xbe, 0xfa, 0xcf, 0x0c, 0xe4, 0xf7, 0x06, 0x37, 0x3c, 0x05,
+	0xbd, 0x81, 0x62, 0x10, 0x07, 0xd2, 0x8d, 0xa8, 0xec, 0x31, 0x53, 0x96, 0x0b, 0xdb, 0xf7, 0x26,
+	0xac, 0xf7, 0x06, 0xfb, 0x71, 0x20, 0x0f, 0x34, 0x04, 0x43, 0x30, 0x5c, 0x57, 0xff, 0x95, 0x05,
+	0xd4, 0x36, 0x17, 0xfb, 0x98, 0xb3, 0x8b, 0xc1, 0x0d, 0x48, 0xfc, 0x35, 0x64, 0xbb, 0x17, 0x96,
+	0xc0, 0x95, 0xc9, 0xef, 0xdb, 0x64, 0xe1, 0x6c, 0xf7, 0x42, 0x03, 0x07, 0x4e, 0x6e, 0x3a, 0x70,
+	0x30, 0x04, 0x0e, 0xae, 0x66, 0x77, 0xfe, 0x06, 0xec, 0xe6, 0xaf, 0x66, 0xf7, 0x3b, 0x55, 0xd0,
+	0xe7, 0x17, 0xbf, 0x48, 0x41, 0x67, 0xaf, 0xc7, 0xe6, 0xef, 0xe0, 0xf6, 0x19, 0xe5, 0x41, 0x67,
+	0xe0, 0x92, 0x44, 0xf6, 0x18, 0x0f, 0xfe, 0x41, 0x86, 0xed, 0x24, 0x8f, 0x97, 0x8d, 0xae, 0x3e,
+	0xae, 0x42, 0x9b, 0xb0, 0xd8, 0x20, 0x5e, 0x8f, 0x9e, 0x9c, 0xb4, 0xda, 0xd4, 0x63, 0xb1, 0x2f,
+	0xec, 0x0b, 0x3c, 0x29, 0xae, 0xfe, 0x3f, 0x0b, 0xa5, 0x26, 0xe9, 0xd7, 0x3f, 0xdf, 0xe4, 0xae,
+	0xfe, 0x09, 0xe6, 0x65, 0x10, 0x51, 0x96, 0x48, 0x1b, 0xdb, 0x64, 0x8f, 0x1b, 0xff, 0x42, 0xed,
+	0xc4, 0x40, 0x05, 0x4e, 0x8d, 0xd4, 0x2b, 0x70, 0x6c, 0x1a, 0x8b, 0x33, 0x63, 0xfa, 0x8c, 0xdd,
+	0xae, 0xfd, 0x27, 0x03, 0xf9, 0x14, 0xaf, 0x66, 0x8f, 0x46, 0x8f, 0x84, 0x21, 0x8d, 0xbb, 0xf4,
+	0x40, 0x68, 0xe7, 0xca, 0x78, 0x5c, 0x84, 0x5e, 0xc0, 0x72, 0x93, 0x73, 0xc6, 0x0f, 0x99, 0x0c,
+	0x3a, 0xb6, 0x05, 0x1f, 0x08, 0xdb, 0x97, 0xa7, 0xa9, 0xd0, 0x3a, 0x14, 0x6c, 0xad, 0x1f, 0xa4,
+	0xd3, 0xcc, 0x48, 0x80, 0x5e, 0xc2, 0x5d, 0xbb, 0x51, 0xf9, 0xa5, 0xb1, 0x54, 0x86, 0xd4, 0x3f,
+	0x48, 0xd3, 0xf9, 0x15, 0x6d, 0xf5, 0xdf, 0x59, 0x58, 0xde, 0x23, 0x92, 0x9e, 0x93, 0xc1, 0x7b,
+	0x4a, 0x42, 0xd9, 0xb3, 0xc9, 0x7d, 0x0e, 0x4b, 0xaa, 0xf2, 0x02, 0x4e, 0x7d, 0x3d, 0x1e, 0x04,
+	0x1e, 0x55, 0x71, 0xa8, 0x90, 0x2b, 0xa9, 0xa2, 0x6d, 0xe5, 0xe8, 0x05, 0xdc, 0x4e, 0xfa, 0xbe,
+	0x9a, 0x24, 0xd2, 0x31, 0xca, 0x15, 0xd4, 0x4b, 0xa3, 0x41, 0x46, 0x97, 0x4e, 0x52, 0x6d, 0xea,
+	0x09, 0xf4, 0x0a, 0x1c, 0x6b, 0xf1, 0xe5, 0xdd, 0x30, 0xb1, 0xdd, 0x35, 0xfa, 0x2f, 0xae, 0xc6,
+	0x5b, 0x58, 0xf7, 0x42, 0x96, 0xf8, 0xae, 0x1f, 0x08, 0x8f, 0xc5, 0x31, 0xf5, 0xa4, 0xdb, 0xa7,
+	0x3c, 0x60, 0xbe, 0xf9, 0xa6, 0x09, 0x77, 0x55, 0x63, 0x76, 0x87, 0x90, 0x63, 0x8d, 0xd0, 0x9f,
+	0x7e, 0x0b, 0xeb, 0xe6, 0xb9, 0xfe, 0xca, 0x01, 0x66, 0xb2, 0x5b, 0xd5, 0x98, 0x69, 0x07, 0x54,
+	0x7f, 0x98, 0x85, 0xc2, 0xfb, 0x76, 0xfb, 0x1a, 0xad, 0xef, 0xd2, 0xbb, 0x97, 0x5e, 0x96, 0x07,
+	0x50, 0x0c, 0x25, 0xd5, 0x37, 0xc5, 0x65, 0x7d, 0x9d, 0xab, 0x12, 0x2e, 0x84, 0x92, 0x2a, 0x8a,
+	0x8e, 0xfa, 0x68, 0x03, 0x4a, 0x43, 0x3d, 0x89, 0x3a, 0x3a, 0x2d, 0x25, 0x0c, 0x16, 0x50, 0x8f,
+	0x3a, 0xa8, 0x05, 0x25, 0x91, 0x9c, 0xba, 0x7d, 0xce, 0x3a, 0x41, 0x48, 0xd3, 0x87, 0xf7, 0xe9,
+	0x84, 0x03, 0x43, 0x57, 0x6b, 0xed, 0xe4, 0xf4, 0xd8, 0x62, 0x9b, 0xb1, 0xe4, 0x03, 0x5c, 0x14,
+	0x23, 0x09, 0xfa, 0x1b, 0x2c, 0xfb, 0xb4, 0x43, 0x92, 0x50, 0xba, 0x63, 0xa7, 0xda, 0x96, 0xf8,
+	0x9b, 0xab, 0x0e, 0x15, 0x1e, 0x0f, 0xfa, 0xd2, 0x34, 0x61, 0x65, 0x83, 0x97, 0xec, 0x41, 0xa3,
+	0x0f, 0xa2, 0xdf, 0x02, 0x12, 0x92, 0x53, 0x12, 0xb9, 0xc2, 0x18, 0x9c, 0xaa, 0x51, 0x21, 0xa7,
+	0x1b, 0xc3, 0x92, 0xd1, 0xb4, 0x47, 0x0a, 0xf4, 0x12, 0x56, 0xfa, 0x89, 0xe8, 0x8d, 0x81, 0x5d,
+	0x53, 0x0f, 0x42, 0xb7, 0xce, 0x3c, 0xbe, 0xa3, 0xd4, 0x23, 0x8b, 0x0f, 0x46, 0xb9, 0xe6, 0xc1,
+	0xf2, 0x14, 0x87, 0xd0, 0x13, 0x58, 0x8c, 0xc8, 0x85, 0x9b, 0x84, 0xee, 0x69, 0x20, 0x5d, 0x4e,
+	0x24, 0xd5, 0x6c, 0xcd, 0xe2, 0x52, 0x44, 0x2e, 0x3e, 0x84, 0x3b, 0x81, 0xc4, 0x44, 0x0e, 0x61,
+	0xfe, 0x18, 0x2c, 0x3b, 0x84, 0xed, 0xa6, 0xb0, 0xb5, 0x10, 0x2a, 0x93, 0xa9, 0x44, 0x15, 0x98,
+	0xf9, 0x4c, 0x07, 0x76, 0xec, 0x52, 0x4b, 0xb4, 0x03, 0x73, 0x67, 0x24, 0x4c, 0xa8, 0x93, 0xfd,
+	0x86, 0x0c, 0x1a, 0xd3, 0xd7, 0xd9, 0x57, 0x99, 0x67, 0x35, 0x28, 0x5f, 0x9a, 0x7d, 0x50, 0x09,
+	0xf2, 0xef, 0xea, 0xfb, 0xad, 0xa3, 0x8f, 0x4d, 0x5c, 0xb9, 0x85, 0x16, 0xa1, 0x88, 0x8f, 0x3e,
+	0x1c, 0xee, 0xba, 0xf8, 0x68, 0x67, 0xff, 0xb0, 0x92, 0x79, 0xf6, 0x1a, 0x4a, 0xe3, 0x0f, 0xa6,
+	0x82, 0xe3, 0x66, 0xbb, 0x89, 0x3f, 0x36, 0x77, 0x0d, 0xfc, 0xb8, 0x89, 0xdd, 0x76, 0xb3, 0xdd,
+	0xde, 0x3f, 0x3a, 0xac, 0x64, 0x50, 0x11, 0xe6, 0x95, 0xe0, 0x2f, 0xcd, 0x4f, 0x95, 0xec, 0xce,
+	0xe3, 0xbf, 0x3e, 0xd2, 0x5e, 0x6e, 0xa9, 0xff, 0x74, 0xfa, 0x0a, 0x6d, 0x75, 0xd9, 0xc4, 0x9f,
+	0xbb, 0xd3, 0x9c, 0xde, 0xff, 0xfe, 0xa7, 0x01, 0x00, 0xb5, 0x58, 0x09, 0x7a, 0xf9, 0x0d, 0x00,
+	0x00,
 }
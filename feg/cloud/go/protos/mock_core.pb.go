@@ -48,7 +48,7 @@ func (x Reply_ServerBehavior) String() string {
 	return proto.EnumName(Reply_ServerBehavior_name, int32(x))
 }
 func (Reply_ServerBehavior) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_mock_core_8d0147927ebbdfdd, []int{0, 0}
+	return fileDescriptor_mock_core_89cf5ad0b8dbdb40, []int{0, 0}
 }
 
 type CreditInfo_UnitType int32
@@ -71,7 +71,7 @@ func (x CreditInfo_UnitType) String() string {
 	return proto.EnumName(CreditInfo_UnitType_name, int32(x))
 }
 func (CreditInfo_UnitType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_mock_core_8d0147927ebbdfdd, []int{5, 0}
+	return fileDescriptor_mock_core_89cf5ad0b8dbdb40, []int{6, 0}
 }
 
 type UsageMonitorCredit_MonitoringLevel int32
@@ -94,7 +94,7 @@ func (x UsageMonitorCredit_MonitoringLevel) String() string {
 	return proto.EnumName(UsageMonitorCredit_MonitoringLevel_name, int32(x))
 }
 func (UsageMonitorCredit_MonitoringLevel) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_mock_core_8d0147927ebbdfdd, []int{11, 0}
+	return fileDescriptor_mock_core_89cf5ad0b8dbdb40, []int{12, 0}
 }
 
 type Reply struct {
@@ -130,7 +130,7 @@ func (m *Reply) Reset()         { *m = Reply{} }
 func (m *Reply) String() string { return proto.CompactTextString(m) }
 func (*Reply) ProtoMessage()    {}
 func (*Reply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_mock_core_8d0147927ebbdfdd, []int{0}
+	return fileDescriptor_mock_core_89cf5ad0b8dbdb40, []int{0}
 }
 func (m *Reply) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Reply.Unmarshal(m, b)
@@ -777,7 +777,7 @@ func (m *ExpectedRequest) Reset()         { *m = ExpectedRequest{} }
 func (m *ExpectedRequest) String() string { return proto.CompactTextString(m) }
 func (*ExpectedRequest) ProtoMessage()    {}
 func (*ExpectedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_mock_core_8d0147927ebbdfdd, []int{1}
+	return fileDescriptor_mock_core_89cf5ad0b8dbdb40, []int{1}
 }
 func (m *ExpectedRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ExpectedRequest.Unmarshal(m, b)
@@ -1423,7 +1423,7 @@ func (m *RequestReply) Reset()         { *m = RequestReply{} }
 func (m *RequestReply) String() string { return proto.CompactTextString(m) }
 func (*RequestReply) ProtoMessage()    {}
 func (*RequestReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_mock_core_8d0147927ebbdfdd, []int{2}
+	return fileDescriptor_mock_core_89cf5ad0b8dbdb40, []int{2}
 }
 func (m *RequestReply) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RequestReply.Unmarshal(m, b)
@@ -1468,7 +1468,7 @@ func (m *ServerConfiguration) Reset()         { *m = ServerConfiguration{} }
 func (m *ServerConfiguration) String() string { return proto.CompactTextString(m) }
 func (*ServerConfiguration) ProtoMessage()    {}
 func (*ServerConfiguration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_mock_core_8d0147927ebbdfdd, []int{3}
+	return fileDescriptor_mock_core_89cf5ad0b8dbdb40, []int{3}
 }
 func (m *ServerConfiguration) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ServerConfiguration.Unmarshal(m, b)
@@ -1496,19 +1496,21 @@ func (m *ServerConfiguration) GetRequestReply() []*RequestReply {
 }
 
 type OCSConfig struct {
-	MaxUsageBytes        uint32   `protobuf:"varint,1,opt,name=max_usage_bytes,json=maxUsageBytes,proto3" json:"max_usage_bytes,omitempty"`
-	MaxUsageTime         uint32   `protobuf:"varint,2,opt,name=max_usage_time,json=maxUsageTime,proto3" json:"max_usage_time,omitempty"`
-	ValidityTime         uint32   `protobuf:"varint,3,opt,name=validity_time,json=validityTime,proto3" json:"validity_time,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	MaxUsageBytes uint32 `protobuf:"varint,1,opt,name=max_usage_bytes,json=maxUsageBytes,proto3" json:"max_usage_bytes,omitempty"`
+	MaxUsageTime  uint32 `protobuf:"varint,2,opt,name=max_usage_time,json=maxUsageTime,proto3" json:"max_usage_time,omitempty"`
+	ValidityTime  uint32 `protobuf:"varint,3,opt,name=validity_time,json=validityTime,proto3" json:"validity_time,omitempty"`
+	// Final-Unit-Indication to return along with the last granted units
+	FinalUnitIndication  *FinalUnitIndication `protobuf:"bytes,4,opt,name=final_unit_indication,json=finalUnitIndication,proto3" json:"final_unit_indication,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
 func (m *OCSConfig) Reset()         { *m = OCSConfig{} }
 func (m *OCSConfig) String() string { return proto.CompactTextString(m) }
 func (*OCSConfig) ProtoMessage()    {}
 func (*OCSConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_mock_core_8d0147927ebbdfdd, []int{4}
+	return fileDescriptor_mock_core_89cf5ad0b8dbdb40, []int{4}
 }
 func (m *OCSConfig) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_OCSConfig.Unmarshal(m, b)
@@ -1549,6 +1551,67 @@ func (m *OCSConfig) GetValidityTime() uint32 {
 	return 0
 }
 
+func (m *OCSConfig) GetFinalUnitIndication() *FinalUnitIndication {
+	if m != nil {
+		return m.FinalUnitIndication
+	}
+	return nil
+}
+
+type FinalUnitIndication struct {
+	FinalUnitAction      protos.ChargingCredit_FinalAction `protobuf:"varint,1,opt,name=final_unit_action,json=finalUnitAction,proto3,enum=magma.lte.ChargingCredit_FinalAction" json:"final_unit_action,omitempty"`
+	RestrictRules        []string                          `protobuf:"bytes,2,rep,name=restrict_rules,json=restrictRules,proto3" json:"restrict_rules,omitempty"`
+	RedirectServer       *protos.RedirectServer            `protobuf:"bytes,3,opt,name=redirect_server,json=redirectServer,proto3" json:"redirect_server,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                          `json:"-"`
+	XXX_unrecognized     []byte                            `json:"-"`
+	XXX_sizecache        int32                             `json:"-"`
+}
+
+func (m *FinalUnitIndication) Reset()         { *m = FinalUnitIndication{} }
+func (m *FinalUnitIndication) String() string { return proto.CompactTextString(m) }
+func (*FinalUnitIndication) ProtoMessage()    {}
+func (*FinalUnitIndication) Descriptor() ([]byte, []int) {
+	return fileDescriptor_mock_core_89cf5ad0b8dbdb40, []int{5}
+}
+func (m *FinalUnitIndication) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FinalUnitIndication.Unmarshal(m, b)
+}
+func (m *FinalUnitIndication) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FinalUnitIndication.Marshal(b, m, deterministic)
+}
+func (dst *FinalUnitIndication) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalUnitIndication.Merge(dst, src)
+}
+func (m *FinalUnitIndication) XXX_Size() int {
+	return xxx_messageInfo_FinalUnitIndication.Size(m)
+}
+func (m *FinalUnitIndication) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalUnitIndication.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinalUnitIndication proto.InternalMessageInfo
+
+func (m *FinalUnitIndication) GetFinalUnitAction() protos.ChargingCredit_FinalAction {
+	if m != nil {
+		return m.FinalUnitAction
+	}
+	return protos.ChargingCredit_TERMINATE
+}
+
+func (m *FinalUnitIndication) GetRestrictRules() []string {
+	if m != nil {
+		return m.RestrictRules
+	}
+	return nil
+}
+
+func (m *FinalUnitIndication) GetRedirectServer() *protos.RedirectServer {
+	if m != nil {
+		return m.RedirectServer
+	}
+	return nil
+}
+
 type CreditInfo struct {
 	Imsi                 string              `protobuf:"bytes,1,opt,name=imsi,proto3" json:"imsi,omitempty"`
 	ChargingKey          uint32              `protobuf:"varint,2,opt,name=charging_key,json=chargingKey,proto3" json:"charging_key,omitempty"`
@@ -1563,7 +1626,7 @@ func (m *CreditInfo) Reset()         { *m = CreditInfo{} }
 func (m *CreditInfo) String() string { return proto.CompactTextString(m) }
 func (*CreditInfo) ProtoMessage()    {}
 func (*CreditInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_mock_core_8d0147927ebbdfdd, []int{5}
+	return fileDescriptor_mock_core_89cf5ad0b8dbdb40, []int{6}
 }
 func (m *CreditInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreditInfo.Unmarshal(m, b)
@@ -1623,7 +1686,7 @@ func (m *ReAuthTarget) Reset()         { *m = ReAuthTarget{} }
 func (m *ReAuthTarget) String() string { return proto.CompactTextString(m) }
 func (*ReAuthTarget) ProtoMessage()    {}
 func (*ReAuthTarget) Descriptor() ([]byte, []int) {
-	return fileDescriptor_mock_core_8d0147927ebbdfdd, []int{6}
+	return fileDescriptor_mock_core_89cf5ad0b8dbdb40, []int{7}
 }
 func (m *ReAuthTarget) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReAuthTarget.Unmarshal(m, b)
@@ -1669,7 +1732,7 @@ func (m *ReAuthAnswer) Reset()         { *m = ReAuthAnswer{} }
 func (m *ReAuthAnswer) String() string { return proto.CompactTextString(m) }
 func (*ReAuthAnswer) ProtoMessage()    {}
 func (*ReAuthAnswer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_mock_core_8d0147927ebbdfdd, []int{7}
+	return fileDescriptor_mock_core_89cf5ad0b8dbdb40, []int{8}
 }
 func (m *ReAuthAnswer) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReAuthAnswer.Unmarshal(m, b)
@@ -1717,7 +1780,7 @@ func (m *AccountRules) Reset()         { *m = AccountRules{} }
 func (m *AccountRules) String() string { return proto.CompactTextString(m) }
 func (*AccountRules) ProtoMessage()    {}
 func (*AccountRules) Descriptor() ([]byte, []int) {
-	return fileDescriptor_mock_core_8d0147927ebbdfdd, []int{8}
+	return fileDescriptor_mock_core_89cf5ad0b8dbdb40, []int{9}
 }
 func (m *AccountRules) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AccountRules.Unmarshal(m, b)
@@ -1783,7 +1846,7 @@ func (m *RuleDefinition) Reset()         { *m = RuleDefinition{} }
 func (m *RuleDefinition) String() string { return proto.CompactTextString(m) }
 func (*RuleDefinition) ProtoMessage()    {}
 func (*RuleDefinition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_mock_core_8d0147927ebbdfdd, []int{9}
+	return fileDescriptor_mock_core_89cf5ad0b8dbdb40, []int{10}
 }
 func (m *RuleDefinition) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RuleDefinition.Unmarshal(m, b)
@@ -1871,7 +1934,7 @@ func (m *UsageMonitorInfo) Reset()         { *m = UsageMonitorInfo{} }
 func (m *UsageMonitorInfo) String() string { return proto.CompactTextString(m) }
 func (*UsageMonitorInfo) ProtoMessage()    {}
 func (*UsageMonitorInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_mock_core_8d0147927ebbdfdd, []int{10}
+	return fileDescriptor_mock_core_89cf5ad0b8dbdb40, []int{11}
 }
 func (m *UsageMonitorInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UsageMonitorInfo.Unmarshal(m, b)
@@ -1919,7 +1982,7 @@ func (m *UsageMonitorCredit) Reset()         { *m = UsageMonitorCredit{} }
 func (m *UsageMonitorCredit) String() string { return proto.CompactTextString(m) }
 func (*UsageMonitorCredit) ProtoMessage()    {}
 func (*UsageMonitorCredit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_mock_core_8d0147927ebbdfdd, []int{11}
+	return fileDescriptor_mock_core_89cf5ad0b8dbdb40, []int{12}
 }
 func (m *UsageMonitorCredit) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UsageMonitorCredit.Unmarshal(m, b)
@@ -1973,6 +2036,7 @@ func init() {
 	proto.RegisterType((*RequestReply)(nil), "magma.feg.RequestReply")
 	proto.RegisterType((*ServerConfiguration)(nil), "magma.feg.ServerConfiguration")
 	proto.RegisterType((*OCSConfig)(nil), "magma.feg.OCSConfig")
+	proto.RegisterType((*FinalUnitIndication)(nil), "magma.feg.FinalUnitIndication")
 	proto.RegisterType((*CreditInfo)(nil), "magma.feg.CreditInfo")
 	proto.RegisterType((*ReAuthTarget)(nil), "magma.feg.ReAuthTarget")
 	proto.RegisterType((*ReAuthAnswer)(nil), "magma.feg.ReAuthAnswer")
@@ -2450,128 +2514,134 @@ var _MockPCRF_serviceDesc = grpc.ServiceDesc{
 }
 
 func init() {
-	proto.RegisterFile("feg/protos/mock_core.proto", fileDescriptor_mock_core_8d0147927ebbdfdd)
+	proto.RegisterFile("feg/protos/mock_core.proto", fileDescriptor_mock_core_89cf5ad0b8dbdb40)
 }
 
-var fileDescriptor_mock_core_8d0147927ebbdfdd = []byte{
-	// 1889 bytes of a gzipped FileDescriptorProto
+var fileDescriptor_mock_core_89cf5ad0b8dbdb40 = []byte{
+	// 1987 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x58, 0x5b, 0x73, 0xdc, 0x48,
-	0x15, 0x9e, 0xf1, 0x2d, 0x9e, 0xe3, 0xb9, 0x28, 0x6d, 0x3b, 0x99, 0x38, 0x95, 0xc4, 0x4c, 0x60,
-	0x2b, 0x55, 0x0b, 0x76, 0x95, 0xa1, 0x8a, 0x2d, 0xb2, 0x40, 0x8d, 0xc7, 0xde, 0xb5, 0xc1, 0x76,
-	0x12, 0x8d, 0x4d, 0xd8, 0xbc, 0x08, 0x59, 0x3a, 0x1e, 0x0b, 0xeb, 0x96, 0xee, 0x96, 0x93, 0x79,
-	0xe1, 0x09, 0xde, 0x78, 0xe2, 0x89, 0x7f, 0xc1, 0x23, 0xf0, 0xef, 0xa8, 0xbe, 0x68, 0xd4, 0x1a,
-	0xc9, 0x9b, 0x2d, 0xf2, 0x34, 0xd3, 0xdf, 0xf9, 0xfa, 0xeb, 0xcb, 0x39, 0xfd, 0xb5, 0x24, 0xd8,
-	0xba, 0xc2, 0xc9, 0x6e, 0x4a, 0x13, 0x9e, 0xb0, 0xdd, 0x28, 0xf1, 0x6e, 0x1c, 0x2f, 0xa1, 0xb8,
-	0x23, 0x01, 0xd2, 0x8a, 0xdc, 0x49, 0xe4, 0xee, 0x5c, 0xe1, 0x64, 0xeb, 0x51, 0x42, 0xbd, 0xaf,
-	0x68, 0x4e, 0xf4, 0x92, 0x28, 0x4a, 0x62, 0xc5, 0xda, 0xda, 0x34, 0x14, 0x3c, 0x76, 0x75, 0xa9,
-	0xe1, 0x47, 0x21, 0xc7, 0x1c, 0x4e, 0x93, 0x30, 0xf0, 0xa6, 0x7e, 0x1e, 0xda, 0x36, 0x42, 0x0c,
-	0x19, 0x0b, 0x92, 0xd8, 0x89, 0xdc, 0xd8, 0x9d, 0x20, 0xd5, 0x8c, 0x27, 0x26, 0x23, 0xbb, 0x64,
-	0x1e, 0x0d, 0x2e, 0x91, 0xe6, 0x02, 0x83, 0x7f, 0xae, 0xc1, 0xb2, 0x8d, 0x69, 0x38, 0x25, 0x47,
-	0xd0, 0x63, 0x48, 0x6f, 0x91, 0x3a, 0x97, 0x78, 0xed, 0xde, 0x06, 0x09, 0xed, 0x37, 0xb7, 0x9b,
-	0x2f, 0xba, 0x7b, 0xcf, 0x76, 0x66, 0x93, 0xdf, 0x91, 0xd4, 0x9d, 0xb1, 0xe4, 0xed, 0x6b, 0x9a,
-	0xdd, 0x65, 0xa5, 0x36, 0x79, 0x06, 0x6b, 0x54, 0xf0, 0x1c, 0x1f, 0x43, 0x77, 0xda, 0x5f, 0xd8,
-	0x6e, 0xbe, 0x58, 0xb6, 0x41, 0x42, 0x07, 0x02, 0x21, 0xbf, 0x81, 0x8e, 0x1b, 0x22, 0xe5, 0x0e,
-	0xc5, 0xf7, 0x19, 0x32, 0xde, 0x5f, 0xdc, 0x6e, 0xbe, 0x58, 0xdb, 0x7b, 0x68, 0x0c, 0x34, 0x14,
-	0x71, 0x5b, 0x85, 0x8f, 0x1a, 0x76, 0xdb, 0x35, 0xda, 0xe4, 0x77, 0x70, 0xdf, 0x4f, 0x3e, 0xc4,
-	0x61, 0x10, 0xdf, 0x38, 0x59, 0x1c, 0x70, 0xdf, 0xe5, 0x6e, 0x7f, 0x49, 0x6a, 0x3c, 0x36, 0x34,
-	0x0e, 0x34, 0xe7, 0x42, 0x53, 0x8e, 0x1a, 0xb6, 0xe5, 0xcf, 0x61, 0xe4, 0xb7, 0xd0, 0xc5, 0x94,
-	0x39, 0x3e, 0x72, 0xd7, 0xbb, 0x76, 0x5c, 0xef, 0xa6, 0xbf, 0x5c, 0x99, 0xcc, 0xe1, 0xeb, 0xf1,
-	0x81, 0x8c, 0x0f, 0xbd, 0x1b, 0x31, 0x19, 0x4c, 0xd9, 0xac, 0x4d, 0xf6, 0xa1, 0x17, 0x44, 0x2c,
-	0x30, 0x15, 0x56, 0xa4, 0x42, 0xdf, 0x50, 0x38, 0x3e, 0x1d, 0x1f, 0x9b, 0x12, 0x1d, 0xd1, 0xa5,
-	0xd0, 0x78, 0x0b, 0x0f, 0xc2, 0xc4, 0x73, 0xb9, 0x48, 0x5f, 0x96, 0xfa, 0x2e, 0x47, 0xc7, 0xf5,
-	0x3c, 0x4c, 0x79, 0xff, 0x9e, 0x94, 0x32, 0x53, 0x70, 0xa2, 0x89, 0x17, 0x92, 0x37, 0x94, 0xb4,
-	0xa3, 0x86, 0xbd, 0x11, 0xd6, 0xe0, 0x75, 0xc2, 0x14, 0xff, 0x8c, 0x1e, 0xef, 0xaf, 0x7e, 0x42,
-	0xd8, 0x96, 0xb4, 0xaa, 0xb0, 0xc2, 0x85, 0x70, 0x14, 0x39, 0x41, 0x7c, 0x95, 0xd0, 0x48, 0xc9,
-	0xe7, 0xb9, 0x6c, 0x55, 0x84, 0x4f, 0x4f, 0x8f, 0x0b, 0x5e, 0x91, 0xd3, 0x8d, 0x28, 0xaa, 0xe2,
-	0x64, 0x08, 0xdd, 0xd4, 0x9d, 0x04, 0xf1, 0x64, 0x26, 0x08, 0x95, 0xdd, 0x7c, 0x2d, 0x09, 0x85,
-	0x52, 0x27, 0x35, 0x01, 0x72, 0x00, 0x3d, 0x8a, 0x21, 0xba, 0x0c, 0x67, 0x1a, 0x6b, 0x52, 0xe3,
-	0x51, 0xa9, 0x92, 0x25, 0xa3, 0x10, 0xe9, 0xd2, 0x12, 0x42, 0xce, 0x61, 0x53, 0xd4, 0x75, 0xe0,
-	0xa1, 0xe3, 0x5e, 0x26, 0x46, 0xb1, 0xb6, 0xa5, 0xd6, 0x53, 0x43, 0x6b, 0xac, 0x78, 0x43, 0x41,
-	0x2b, 0x04, 0xd7, 0x59, 0x15, 0x26, 0x7b, 0xd0, 0xa2, 0xc8, 0x90, 0xcb, 0x3a, 0xe9, 0x48, 0xa5,
-	0xf5, 0xd2, 0xac, 0x18, 0x72, 0x55, 0x22, 0xab, 0x54, 0xff, 0x27, 0xdf, 0x82, 0xa5, 0xfa, 0x04,
-	0xb1, 0x1f, 0xa8, 0x5c, 0xf4, 0xbb, 0xb2, 0xeb, 0xd6, 0x7c, 0xd7, 0xe3, 0x19, 0xe3, 0xa8, 0x61,
-	0xf7, 0x68, 0x19, 0x22, 0x5f, 0xc2, 0x0a, 0xe3, 0x2e, 0xcf, 0x58, 0xbf, 0x27, 0xbb, 0xdf, 0x37,
-	0xd7, 0x20, 0x03, 0x47, 0x0d, 0x5b, 0x53, 0xc8, 0x3b, 0x78, 0xe8, 0x51, 0x14, 0x15, 0x93, 0x1b,
-	0x0b, 0x45, 0x96, 0x26, 0x31, 0xc3, 0xbe, 0x25, 0x7b, 0x6f, 0xeb, 0xde, 0x21, 0xc7, 0x9d, 0x91,
-	0x64, 0x8e, 0x15, 0xd1, 0xd6, 0xbc, 0xa3, 0xa6, 0xbd, 0xe9, 0xd5, 0x05, 0x84, 0xb6, 0xae, 0xc6,
-	0x8a, 0xf6, 0xfd, 0x8a, 0xb6, 0xaa, 0xbb, 0x1a, 0xed, 0xac, 0x2e, 0x40, 0x3c, 0xd8, 0xca, 0x45,
-	0x39, 0xd2, 0x28, 0x88, 0x55, 0xd1, 0x6b, 0x79, 0x22, 0xe5, 0x9f, 0x1b, 0xf2, 0xba, 0xff, 0x79,
-	0xce, 0x35, 0x46, 0xe8, 0xb3, 0x3b, 0x62, 0x83, 0x11, 0x74, 0xcb, 0x26, 0x48, 0xd6, 0xa1, 0x67,
-	0x1f, 0xbe, 0x3e, 0xf9, 0xce, 0x39, 0x3e, 0x1b, 0x9f, 0x0f, 0xcf, 0xce, 0x4f, 0xbe, 0xb3, 0x1a,
-	0xa4, 0x0b, 0xa0, 0xc0, 0x93, 0xe1, 0xf9, 0xa1, 0xd5, 0x24, 0x6d, 0x58, 0x3d, 0x7b, 0xe5, 0x48,
-	0xc8, 0x5a, 0xd8, 0xef, 0xc0, 0x1a, 0x9b, 0x30, 0x27, 0x42, 0xc6, 0xdc, 0x09, 0xee, 0x77, 0xa1,
-	0x3d, 0xf9, 0x38, 0x99, 0xe6, 0xed, 0xc1, 0xbf, 0x01, 0x7a, 0x87, 0x1f, 0x53, 0xf4, 0x38, 0xfa,
-	0x46, 0xf9, 0x28, 0xe7, 0x14, 0xe5, 0xd3, 0xac, 0x94, 0x8f, 0x74, 0x4d, 0x5d, 0x3e, 0xae, 0xfe,
-	0x4f, 0x5e, 0x42, 0x3b, 0x77, 0x5b, 0x79, 0xf2, 0x17, 0x64, 0xb7, 0x07, 0x55, 0xb3, 0xd5, 0x07,
-	0x7e, 0xcd, 0x2d, 0x9a, 0xe2, 0x14, 0x18, 0xf6, 0x68, 0x14, 0xe0, 0x62, 0xe5, 0x14, 0xcc, 0x5c,
-	0xb2, 0x54, 0x84, 0xeb, 0x33, 0xb3, 0x34, 0x0a, 0xf1, 0x2d, 0x3c, 0x30, 0x3d, 0xd3, 0x90, 0x5d,
-	0xaa, 0xb8, 0x47, 0x61, 0x9d, 0x25, 0xdd, 0x8d, 0xc2, 0x41, 0x0d, 0xe1, 0x77, 0xf0, 0xb0, 0xea,
-	0x77, 0xea, 0xd8, 0x2e, 0x97, 0x0a, 0xab, 0xce, 0xf0, 0xf2, 0x83, 0xbb, 0x19, 0xd6, 0x05, 0xc4,
-	0xad, 0x35, 0x73, 0x26, 0xb9, 0x91, 0x2b, 0x95, 0x8b, 0x22, 0x37, 0x26, 0xbd, 0x93, 0xed, 0xd4,
-	0x68, 0x0b, 0x5b, 0xca, 0x0d, 0x25, 0x9f, 0xd3, 0xbd, 0x8a, 0x2d, 0x69, 0x2b, 0x31, 0x6c, 0x89,
-	0x95, 0x10, 0x51, 0xde, 0x5c, 0x6c, 0x1d, 0x45, 0x37, 0x9c, 0x2d, 0xd5, 0x4b, 0xa2, 0x34, 0x44,
-	0x8e, 0xda, 0xd5, 0x9f, 0x1b, 0x82, 0xe7, 0xa7, 0xe3, 0x63, 0xdb, 0xe0, 0x8e, 0x34, 0xf5, 0xa8,
-	0x61, 0xf7, 0x85, 0x50, 0x5d, 0x4c, 0xe4, 0x27, 0x13, 0x57, 0x10, 0x0f, 0x6e, 0x03, 0x3e, 0x35,
-	0xf3, 0x53, 0x75, 0xf7, 0x8b, 0xc3, 0xa1, 0xe6, 0x95, 0xf3, 0x93, 0x61, 0x15, 0x17, 0xee, 0x9e,
-	0xa1, 0x93, 0xc5, 0x14, 0x5d, 0xef, 0xda, 0xbd, 0x0c, 0xb1, 0xc6, 0xdd, 0x2f, 0x0e, 0x2f, 0x8a,
-	0xb8, 0x70, 0xf7, 0x0c, 0x0d, 0x40, 0x6c, 0x63, 0x96, 0x96, 0xaf, 0xfe, 0xaa, 0xbb, 0x5f, 0xa4,
-	0x73, 0x17, 0x7f, 0x37, 0x2b, 0x21, 0x65, 0x1f, 0x6e, 0xff, 0xff, 0x3e, 0xdc, 0xf9, 0x3c, 0x1f,
-	0xee, 0x7e, 0xda, 0x87, 0xdf, 0xc2, 0x83, 0x8a, 0x0f, 0xab, 0xea, 0xe9, 0x95, 0x72, 0x51, 0x63,
-	0xc3, 0xaa, 0x86, 0x9a, 0xf6, 0x86, 0x57, 0x83, 0xcb, 0x24, 0xcf, 0x9b, 0xb0, 0x12, 0xb6, 0x2a,
-	0xc2, 0x73, 0x1e, 0x3c, 0x13, 0xce, 0x6a, 0x70, 0xf2, 0x27, 0x78, 0x54, 0xe7, 0xc0, 0x4a, 0x5b,
-	0xf9, 0xfb, 0xe0, 0x7b, 0x0d, 0x38, 0x97, 0x7f, 0xc8, 0xea, 0x43, 0x9f, 0x72, 0xce, 0x10, 0xda,
-	0x9a, 0xa9, 0x1e, 0x6d, 0x7f, 0x01, 0xf7, 0xf2, 0xe1, 0x9b, 0x95, 0x7c, 0xcd, 0x59, 0xac, 0x9d,
-	0x53, 0xc9, 0x17, 0xb0, 0x2c, 0x9f, 0x59, 0xb5, 0x61, 0x5a, 0xf3, 0x8f, 0xc1, 0xb6, 0x0a, 0x0f,
-	0xc6, 0xb0, 0xae, 0xee, 0x82, 0x51, 0x12, 0x5f, 0x05, 0x93, 0x8c, 0xaa, 0x24, 0x7f, 0x0d, 0x1d,
-	0xad, 0xe4, 0x28, 0x99, 0xe6, 0xf6, 0xe2, 0x9c, 0x5d, 0x98, 0x93, 0xb4, 0xdb, 0xd4, 0x68, 0x0d,
-	0xfe, 0x02, 0xad, 0x57, 0xa3, 0xb1, 0x52, 0x24, 0x5f, 0x40, 0x2f, 0x72, 0x3f, 0x3a, 0x99, 0x58,
-	0x9c, 0x73, 0x39, 0xe5, 0xc8, 0xe4, 0x3a, 0x3a, 0x76, 0x27, 0x72, 0x3f, 0x5e, 0xc8, 0x2d, 0x10,
-	0x20, 0xf9, 0x31, 0x74, 0x0b, 0x1e, 0x0f, 0x22, 0x94, 0x53, 0xef, 0xd8, 0xed, 0x9c, 0x76, 0x1e,
-	0x44, 0x48, 0x9e, 0x43, 0xe7, 0xd6, 0x0d, 0x03, 0x5f, 0x9c, 0x6c, 0x49, 0x5a, 0x54, 0xa4, 0x1c,
-	0x14, 0xa4, 0xc1, 0x7f, 0x9b, 0x00, 0x23, 0x8a, 0x7e, 0xc0, 0xc5, 0x33, 0x1a, 0x21, 0xb0, 0x24,
-	0xfc, 0x56, 0x0e, 0xdb, 0xb2, 0xe5, 0x7f, 0xf2, 0x23, 0x68, 0x7b, 0xd7, 0x2e, 0x95, 0x8e, 0x78,
-	0x83, 0x53, 0x3d, 0xd6, 0x5a, 0x8e, 0xfd, 0x1e, 0xa7, 0xe4, 0x01, 0xac, 0xdc, 0x26, 0x61, 0xa6,
-	0xc7, 0x58, 0xb2, 0x75, 0x8b, 0xbc, 0x84, 0x96, 0x38, 0xbc, 0x0e, 0x9f, 0xa6, 0x28, 0x2d, 0xbf,
-	0x5b, 0xba, 0x49, 0x8a, 0x81, 0x77, 0xc4, 0x81, 0x3d, 0x9f, 0xa6, 0x68, 0xaf, 0x66, 0xfa, 0xdf,
-	0xe0, 0x19, 0xac, 0xe6, 0x28, 0x69, 0xc1, 0xb2, 0x5c, 0xba, 0xd5, 0x20, 0xab, 0xb0, 0x24, 0x66,
-	0x6e, 0x35, 0x07, 0x87, 0x22, 0xfd, 0xc3, 0x8c, 0x5f, 0x9f, 0xbb, 0x74, 0x82, 0xfc, 0xae, 0xc9,
-	0x8b, 0x3c, 0xc5, 0x13, 0x67, 0x42, 0x93, 0x2c, 0xcd, 0x27, 0xaf, 0xb0, 0x6f, 0x05, 0x34, 0x38,
-	0xcb, 0x65, 0x86, 0x31, 0xfb, 0x80, 0x94, 0x3c, 0x01, 0xc8, 0xcb, 0x3a, 0xf0, 0xb5, 0x58, 0x4b,
-	0x23, 0xc7, 0xbe, 0x7a, 0xeb, 0x61, 0x59, 0xc8, 0x1d, 0x2f, 0xf1, 0xf3, 0x9d, 0x07, 0x05, 0x8d,
-	0x12, 0x1f, 0x07, 0xff, 0x6a, 0x42, 0x7b, 0xe8, 0x79, 0x49, 0x16, 0x73, 0x3b, 0x0b, 0x91, 0xd5,
-	0xce, 0xeb, 0x09, 0x00, 0xcd, 0x42, 0x74, 0x62, 0x37, 0x42, 0xd6, 0x5f, 0xd8, 0x5e, 0x14, 0x83,
-	0x08, 0xe4, 0x4c, 0x00, 0xa2, 0x12, 0x64, 0xf8, 0x52, 0x3c, 0xdc, 0x2a, 0xce, 0xa2, 0xe4, 0x74,
-	0x04, 0xbc, 0xef, 0x32, 0xcd, 0x3b, 0x00, 0x4b, 0xf2, 0x7c, 0xbc, 0x0a, 0xe2, 0x40, 0xd4, 0x23,
-	0xeb, 0x2f, 0xc9, 0xfa, 0x2b, 0x3d, 0x03, 0x67, 0x21, 0x1e, 0xcc, 0x18, 0xb6, 0x94, 0x2e, 0xda,
-	0x6c, 0xf0, 0x8f, 0x45, 0xe8, 0x96, 0x39, 0xe4, 0xa7, 0x40, 0x74, 0x82, 0xd1, 0x99, 0x4d, 0x54,
-	0xaf, 0xc0, 0xca, 0x23, 0xb6, 0x9e, 0xef, 0x0f, 0xd8, 0x65, 0xf2, 0x14, 0x20, 0xa5, 0xe8, 0xa1,
-	0x8f, 0xb1, 0x97, 0x97, 0xa2, 0x81, 0x90, 0x9f, 0x40, 0x37, 0x4a, 0xe2, 0x80, 0x27, 0x34, 0xaf,
-	0xb3, 0x25, 0x39, 0x58, 0xa7, 0x40, 0x45, 0xa5, 0x7d, 0x09, 0xf7, 0xaf, 0xc2, 0xe4, 0x83, 0xe3,
-	0xa3, 0x78, 0xc3, 0x4d, 0xd5, 0x8a, 0x97, 0xe5, 0xd6, 0x58, 0x22, 0x70, 0x60, 0xe0, 0x33, 0xb2,
-	0xf1, 0xfa, 0xc2, 0xfa, 0x2b, 0x05, 0xd9, 0x78, 0x2d, 0x61, 0xe4, 0x0d, 0x6c, 0x88, 0x72, 0xa4,
-	0xe8, 0x71, 0xb3, 0x83, 0xbe, 0xbb, 0x9f, 0x1a, 0x46, 0x66, 0x6b, 0x9a, 0xf9, 0x56, 0xb3, 0x4e,
-	0xab, 0x20, 0x79, 0x09, 0xbd, 0xf7, 0x09, 0x2b, 0xa9, 0xa9, 0x8b, 0x9b, 0x18, 0x6a, 0xdf, 0x84,
-	0xc9, 0x87, 0x37, 0x09, 0xb3, 0xbb, 0xef, 0x13, 0x66, 0x74, 0x1e, 0x4c, 0xc1, 0x92, 0x67, 0xf9,
-	0x54, 0xad, 0xff, 0xce, 0xe3, 0xf9, 0x06, 0x36, 0x95, 0x11, 0xe8, 0x8d, 0x72, 0x3c, 0x79, 0xaa,
-	0x54, 0x51, 0xad, 0xed, 0x3d, 0x31, 0x6f, 0x4b, 0x43, 0x4f, 0x9d, 0x3d, 0x7b, 0x3d, 0xab, 0x60,
-	0x6c, 0xf0, 0xd7, 0x05, 0x20, 0x55, 0x6e, 0x4d, 0x8a, 0x9a, 0x75, 0x29, 0xfa, 0x23, 0x58, 0x06,
-	0x2d, 0xc4, 0x5b, 0x0c, 0x65, 0x41, 0x74, 0xf7, 0x7e, 0xf6, 0xbd, 0x73, 0xd9, 0x39, 0x9d, 0xf5,
-	0x3a, 0x11, 0x9d, 0xec, 0x5e, 0x54, 0x06, 0x64, 0x99, 0x21, 0xcf, 0x68, 0xac, 0xcd, 0x51, 0x99,
-	0xcd, 0x9a, 0xc2, 0x94, 0x35, 0x16, 0x4e, 0xb4, 0x64, 0x3a, 0xd1, 0x60, 0x0f, 0x7a, 0x73, 0xf2,
-	0xc4, 0x82, 0xb6, 0xbe, 0x92, 0x64, 0xdb, 0x6a, 0x90, 0x0e, 0xb4, 0x44, 0x49, 0xab, 0x66, 0x73,
-	0xef, 0xef, 0x4d, 0xd8, 0x38, 0x4d, 0xbc, 0x9b, 0x51, 0x42, 0xb1, 0xf0, 0xfc, 0x84, 0x92, 0x11,
-	0xb4, 0x55, 0x5b, 0xdd, 0x07, 0x64, 0xfe, 0x1d, 0x71, 0xee, 0x8a, 0xd8, 0xca, 0xef, 0x7d, 0xf9,
-	0x45, 0x68, 0xe7, 0x0f, 0x49, 0xe0, 0x0f, 0x1a, 0x64, 0x17, 0x96, 0xe5, 0x23, 0x04, 0xa9, 0x46,
-	0x6b, 0x3b, 0xec, 0xfd, 0x67, 0x01, 0xee, 0x89, 0xe9, 0xbc, 0x1a, 0x8d, 0xc9, 0x4b, 0xf1, 0x5e,
-	0xc2, 0x5f, 0x8d, 0xc6, 0x63, 0xe4, 0xe2, 0x8c, 0x31, 0xb2, 0x61, 0xcc, 0x61, 0x76, 0xa3, 0xd4,
-	0x8f, 0xfc, 0x4b, 0x68, 0x8d, 0x91, 0xeb, 0xa4, 0x6e, 0xd6, 0xfa, 0x71, 0x7d, 0xc7, 0x5f, 0x43,
-	0x47, 0x3d, 0x79, 0x68, 0x7b, 0x23, 0x0f, 0xcd, 0xeb, 0x7d, 0xf6, 0xd1, 0xe9, 0xf8, 0xa0, 0xbe,
-	0xfb, 0xaf, 0xc0, 0x1a, 0x85, 0xe8, 0xd2, 0x82, 0xc9, 0x7e, 0xe8, 0xe2, 0xc9, 0xd7, 0xb0, 0xa2,
-	0x4c, 0x9a, 0x94, 0x2f, 0xd6, 0xc2, 0xfe, 0xb7, 0xaa, 0x01, 0x65, 0xe8, 0x83, 0xc6, 0xde, 0xdf,
-	0x16, 0x60, 0x55, 0x6c, 0xdd, 0xeb, 0x91, 0xfd, 0xcd, 0xe7, 0xae, 0xe2, 0x2b, 0x58, 0x1d, 0xa3,
-	0x76, 0xf6, 0xd2, 0x97, 0x2c, 0xc3, 0xf2, 0xeb, 0x7b, 0x1e, 0x80, 0x35, 0x46, 0x6e, 0x16, 0x3e,
-	0x23, 0x8f, 0xef, 0x38, 0x12, 0x77, 0x27, 0xe1, 0x33, 0x76, 0x71, 0xff, 0xf1, 0xbb, 0x47, 0x12,
-	0xdd, 0xbd, 0xc2, 0xc9, 0xae, 0x17, 0x26, 0x99, 0xbf, 0x3b, 0x49, 0xf4, 0x57, 0xc3, 0xcb, 0x15,
-	0xf9, 0xfb, 0xf3, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0x41, 0x6b, 0x31, 0xda, 0xe0, 0x14, 0x00,
-	0x00,
+	0x15, 0x9e, 0xf1, 0x2d, 0x9e, 0xe3, 0xb9, 0xa5, 0x6d, 0x27, 0x13, 0xa7, 0x92, 0x98, 0x59, 0x76,
+	0x2b, 0x55, 0x0b, 0x76, 0x95, 0xa1, 0x8a, 0x2d, 0xb2, 0x40, 0x8d, 0xc7, 0xce, 0xda, 0x60, 0x3b,
+	0x89, 0xc6, 0x66, 0xd9, 0xbc, 0x08, 0x59, 0x3a, 0x1e, 0x0b, 0xeb, 0x96, 0xee, 0x96, 0x93, 0x79,
+	0x87, 0x37, 0x9e, 0x78, 0xe2, 0x5f, 0xf0, 0x08, 0xfc, 0x0d, 0xf8, 0x43, 0x54, 0x5f, 0x24, 0xb5,
+	0x46, 0xf2, 0x66, 0x8b, 0x3c, 0xcd, 0xf4, 0xd7, 0x5f, 0x7f, 0xea, 0xee, 0x73, 0xce, 0xd7, 0x2d,
+	0xc1, 0xd6, 0x15, 0x4e, 0x77, 0x13, 0x1a, 0xf3, 0x98, 0xed, 0x86, 0xb1, 0x7b, 0x63, 0xbb, 0x31,
+	0xc5, 0x1d, 0x09, 0x90, 0x56, 0xe8, 0x4c, 0x43, 0x67, 0xe7, 0x0a, 0xa7, 0x5b, 0x8f, 0x62, 0xea,
+	0x7e, 0x45, 0x33, 0xa2, 0x1b, 0x87, 0x61, 0x1c, 0x29, 0xd6, 0xd6, 0xa6, 0xa1, 0xe0, 0xb2, 0xab,
+	0x4b, 0x0d, 0x3f, 0x0a, 0x38, 0x66, 0x70, 0x12, 0x07, 0xbe, 0x3b, 0xf3, 0xb2, 0xae, 0x6d, 0xa3,
+	0x8b, 0x21, 0x63, 0x7e, 0x1c, 0xd9, 0xa1, 0x13, 0x39, 0x53, 0xa4, 0x9a, 0xf1, 0xc4, 0x64, 0xa4,
+	0x97, 0xcc, 0xa5, 0xfe, 0x25, 0xd2, 0x4c, 0x60, 0xf8, 0xf7, 0x35, 0x58, 0xb6, 0x30, 0x09, 0x66,
+	0xe4, 0x08, 0x7a, 0x0c, 0xe9, 0x2d, 0x52, 0xfb, 0x12, 0xaf, 0x9d, 0x5b, 0x3f, 0xa6, 0x83, 0xe6,
+	0x76, 0xf3, 0x79, 0x77, 0xef, 0xd9, 0x4e, 0x3e, 0xf9, 0x1d, 0x49, 0xdd, 0x99, 0x48, 0xde, 0xbe,
+	0xa6, 0x59, 0x5d, 0x56, 0x6a, 0x93, 0x67, 0xb0, 0x46, 0x05, 0xcf, 0xf6, 0x30, 0x70, 0x66, 0x83,
+	0x85, 0xed, 0xe6, 0xf3, 0x65, 0x0b, 0x24, 0x74, 0x20, 0x10, 0xf2, 0x6b, 0xe8, 0x38, 0x01, 0x52,
+	0x6e, 0x53, 0x7c, 0x97, 0x22, 0xe3, 0x83, 0xc5, 0xed, 0xe6, 0xf3, 0xb5, 0xbd, 0x87, 0xc6, 0x83,
+	0x46, 0xa2, 0xdf, 0x52, 0xdd, 0x47, 0x0d, 0xab, 0xed, 0x18, 0x6d, 0xf2, 0x5b, 0xb8, 0xef, 0xc5,
+	0xef, 0xa3, 0xc0, 0x8f, 0x6e, 0xec, 0x34, 0xf2, 0xb9, 0xe7, 0x70, 0x67, 0xb0, 0x24, 0x35, 0x1e,
+	0x1b, 0x1a, 0x07, 0x9a, 0x73, 0xa1, 0x29, 0x47, 0x0d, 0xab, 0xef, 0xcd, 0x61, 0xe4, 0x37, 0xd0,
+	0xc5, 0x84, 0xd9, 0x1e, 0x72, 0xc7, 0xbd, 0xb6, 0x1d, 0xf7, 0x66, 0xb0, 0x5c, 0x99, 0xcc, 0xe1,
+	0xeb, 0xc9, 0x81, 0xec, 0x1f, 0xb9, 0x37, 0x62, 0x32, 0x98, 0xb0, 0xbc, 0x4d, 0xf6, 0xa1, 0xe7,
+	0x87, 0xcc, 0x37, 0x15, 0x56, 0xa4, 0xc2, 0xc0, 0x50, 0x38, 0x3e, 0x9d, 0x1c, 0x9b, 0x12, 0x1d,
+	0x31, 0xa4, 0xd0, 0xf8, 0x16, 0x1e, 0x04, 0xb1, 0xeb, 0x70, 0x11, 0xbe, 0x34, 0xf1, 0x1c, 0x8e,
+	0xb6, 0xe3, 0xba, 0x98, 0xf0, 0xc1, 0x3d, 0x29, 0x65, 0x86, 0xe0, 0x44, 0x13, 0x2f, 0x24, 0x6f,
+	0x24, 0x69, 0x47, 0x0d, 0x6b, 0x23, 0xa8, 0xc1, 0xeb, 0x84, 0x29, 0xfe, 0x09, 0x5d, 0x3e, 0x58,
+	0xfd, 0x88, 0xb0, 0x25, 0x69, 0x55, 0x61, 0x85, 0x0b, 0xe1, 0x30, 0xb4, 0xfd, 0xe8, 0x2a, 0xa6,
+	0xa1, 0x92, 0xcf, 0x62, 0xd9, 0xaa, 0x08, 0x9f, 0x9e, 0x1e, 0x17, 0xbc, 0x22, 0xa6, 0x1b, 0x61,
+	0x58, 0xc5, 0xc9, 0x08, 0xba, 0x89, 0x33, 0xf5, 0xa3, 0x69, 0x2e, 0x08, 0x95, 0xdd, 0x7c, 0x2d,
+	0x09, 0x85, 0x52, 0x27, 0x31, 0x01, 0x72, 0x00, 0x3d, 0x8a, 0x01, 0x3a, 0x0c, 0x73, 0x8d, 0x35,
+	0xa9, 0xf1, 0xa8, 0x94, 0xc9, 0x92, 0x51, 0x88, 0x74, 0x69, 0x09, 0x21, 0xe7, 0xb0, 0x29, 0xf2,
+	0xda, 0x77, 0xd1, 0x76, 0x2e, 0x63, 0x23, 0x59, 0xdb, 0x52, 0xeb, 0xa9, 0xa1, 0x35, 0x51, 0xbc,
+	0x91, 0xa0, 0x15, 0x82, 0xeb, 0xac, 0x0a, 0x93, 0x3d, 0x68, 0x51, 0x64, 0xc8, 0x65, 0x9e, 0x74,
+	0xa4, 0xd2, 0x7a, 0x69, 0x56, 0x0c, 0xb9, 0x4a, 0x91, 0x55, 0xaa, 0xff, 0x93, 0x6f, 0xa0, 0xaf,
+	0xc6, 0xf8, 0x91, 0xe7, 0xab, 0x58, 0x0c, 0xba, 0x72, 0xe8, 0xd6, 0xfc, 0xd0, 0xe3, 0x9c, 0x71,
+	0xd4, 0xb0, 0x7a, 0xb4, 0x0c, 0x91, 0x2f, 0x61, 0x85, 0x71, 0x87, 0xa7, 0x6c, 0xd0, 0x93, 0xc3,
+	0xef, 0x9b, 0x6b, 0x90, 0x1d, 0x47, 0x0d, 0x4b, 0x53, 0xc8, 0x5b, 0x78, 0xe8, 0x52, 0x14, 0x19,
+	0x93, 0x19, 0x0b, 0x45, 0x96, 0xc4, 0x11, 0xc3, 0x41, 0x5f, 0x8e, 0xde, 0xd6, 0xa3, 0x03, 0x8e,
+	0x3b, 0x63, 0xc9, 0x9c, 0x28, 0xa2, 0xa5, 0x79, 0x47, 0x4d, 0x6b, 0xd3, 0xad, 0xeb, 0x10, 0xda,
+	0x3a, 0x1b, 0x2b, 0xda, 0xf7, 0x2b, 0xda, 0x2a, 0xef, 0x6a, 0xb4, 0xd3, 0xba, 0x0e, 0xe2, 0xc2,
+	0x56, 0x26, 0xca, 0x91, 0x86, 0x7e, 0xa4, 0x92, 0x5e, 0xcb, 0x13, 0x29, 0xff, 0x99, 0x21, 0xaf,
+	0xc7, 0x9f, 0x67, 0x5c, 0xe3, 0x09, 0x03, 0x76, 0x47, 0xdf, 0x70, 0x0c, 0xdd, 0xb2, 0x09, 0x92,
+	0x75, 0xe8, 0x59, 0x87, 0xaf, 0x4f, 0xbe, 0xb3, 0x8f, 0xcf, 0x26, 0xe7, 0xa3, 0xb3, 0xf3, 0x93,
+	0xef, 0xfa, 0x0d, 0xd2, 0x05, 0x50, 0xe0, 0xc9, 0xe8, 0xfc, 0xb0, 0xdf, 0x24, 0x6d, 0x58, 0x3d,
+	0x7b, 0x65, 0x4b, 0xa8, 0xbf, 0xb0, 0xdf, 0x81, 0x35, 0x36, 0x65, 0x76, 0x88, 0x8c, 0x39, 0x53,
+	0xdc, 0xef, 0x42, 0x7b, 0xfa, 0x61, 0x3a, 0xcb, 0xda, 0xc3, 0x7f, 0x02, 0xf4, 0x0e, 0x3f, 0x24,
+	0xe8, 0x72, 0xf4, 0x8c, 0xf4, 0x51, 0xce, 0x29, 0xd2, 0xa7, 0x59, 0x49, 0x1f, 0xe9, 0x9a, 0x3a,
+	0x7d, 0x1c, 0xfd, 0x9f, 0xbc, 0x80, 0x76, 0xe6, 0xb6, 0xb2, 0xf2, 0x17, 0xe4, 0xb0, 0x07, 0x55,
+	0xb3, 0xd5, 0x05, 0xbf, 0xe6, 0x14, 0x4d, 0x51, 0x05, 0x86, 0x3d, 0x1a, 0x09, 0xb8, 0x58, 0xa9,
+	0x82, 0xdc, 0x25, 0x4b, 0x49, 0xb8, 0x9e, 0x9b, 0x65, 0x01, 0x0b, 0xf7, 0x30, 0x3d, 0xd3, 0x90,
+	0x5d, 0xaa, 0xb8, 0x47, 0x61, 0x9d, 0x25, 0xdd, 0x8d, 0xc2, 0x41, 0x0d, 0xe1, 0xb7, 0xf0, 0xb0,
+	0xea, 0x77, 0xaa, 0x6c, 0x97, 0x4b, 0x89, 0x55, 0x67, 0x78, 0x59, 0xe1, 0x6e, 0x06, 0x75, 0x1d,
+	0xe2, 0xd4, 0xca, 0x9d, 0x49, 0x6e, 0xe4, 0x4a, 0xe5, 0xa0, 0xc8, 0x8c, 0x49, 0xef, 0x64, 0x3b,
+	0x31, 0xda, 0xc2, 0x96, 0x32, 0x43, 0xc9, 0xe6, 0x74, 0xaf, 0x62, 0x4b, 0xda, 0x4a, 0x0c, 0x5b,
+	0x62, 0x25, 0x44, 0xa4, 0x37, 0x17, 0x5b, 0x47, 0xd1, 0x09, 0xf2, 0xa5, 0xba, 0x71, 0x98, 0x04,
+	0xc8, 0x71, 0xb0, 0x5a, 0x4a, 0x6f, 0x21, 0x78, 0x7e, 0x3a, 0x39, 0xb6, 0x0c, 0xee, 0x58, 0x53,
+	0x8f, 0x1a, 0xd6, 0x40, 0x08, 0xd5, 0xf5, 0x89, 0xf8, 0xa4, 0xe2, 0x08, 0xe2, 0xfe, 0xad, 0xcf,
+	0x67, 0x66, 0x7c, 0xaa, 0xee, 0x7e, 0x71, 0x38, 0xd2, 0xbc, 0x72, 0x7c, 0x52, 0xac, 0xe2, 0xc2,
+	0xdd, 0x53, 0xb4, 0xd3, 0x88, 0xa2, 0xe3, 0x5e, 0x3b, 0x97, 0x01, 0xd6, 0xb8, 0xfb, 0xc5, 0xe1,
+	0x45, 0xd1, 0x2f, 0xdc, 0x3d, 0x45, 0x03, 0x10, 0xdb, 0x98, 0x26, 0xe5, 0xa3, 0xbf, 0xea, 0xee,
+	0x17, 0xc9, 0xdc, 0xc1, 0xdf, 0x4d, 0x4b, 0x48, 0xd9, 0x87, 0xdb, 0xff, 0xbf, 0x0f, 0x77, 0x3e,
+	0xcd, 0x87, 0xbb, 0x1f, 0xf7, 0xe1, 0x6f, 0xe1, 0x41, 0xc5, 0x87, 0x55, 0xf6, 0xf4, 0x4a, 0xb1,
+	0xa8, 0xb1, 0x61, 0x95, 0x43, 0x4d, 0x6b, 0xc3, 0xad, 0xc1, 0x65, 0x90, 0xe7, 0x4d, 0x58, 0x09,
+	0xf7, 0x2b, 0xc2, 0x73, 0x1e, 0x9c, 0x0b, 0xa7, 0x35, 0x38, 0xf9, 0x23, 0x3c, 0xaa, 0x73, 0x60,
+	0xa5, 0xad, 0xfc, 0x7d, 0xf8, 0xbd, 0x06, 0x9c, 0xc9, 0x3f, 0x64, 0xf5, 0x5d, 0x1f, 0x73, 0xce,
+	0x00, 0xda, 0x9a, 0xa9, 0xae, 0xb6, 0x3f, 0x87, 0x7b, 0xd9, 0xe3, 0x9b, 0x95, 0x78, 0xcd, 0x59,
+	0xac, 0x95, 0x51, 0xc9, 0x17, 0xb0, 0x2c, 0xef, 0xac, 0xda, 0x30, 0xfb, 0xf3, 0xd7, 0x60, 0x4b,
+	0x75, 0x0f, 0x27, 0xb0, 0xae, 0xce, 0x82, 0x71, 0x1c, 0x5d, 0xf9, 0xd3, 0x94, 0xaa, 0x20, 0x7f,
+	0x0d, 0x1d, 0xad, 0x64, 0x2b, 0x99, 0xe6, 0xf6, 0xe2, 0x9c, 0x5d, 0x98, 0x93, 0xb4, 0xda, 0xd4,
+	0x68, 0x0d, 0xff, 0xd3, 0x84, 0xd6, 0xab, 0xf1, 0x44, 0x49, 0x92, 0x2f, 0xa0, 0x17, 0x3a, 0x1f,
+	0xec, 0x54, 0xac, 0xce, 0xbe, 0x9c, 0x71, 0x64, 0x72, 0x21, 0x1d, 0xab, 0x13, 0x3a, 0x1f, 0x2e,
+	0xe4, 0x1e, 0x08, 0x90, 0xfc, 0x18, 0xba, 0x05, 0x8f, 0xfb, 0x21, 0xca, 0xb9, 0x77, 0xac, 0x76,
+	0x46, 0x3b, 0xf7, 0x43, 0x24, 0x9f, 0x41, 0xe7, 0xd6, 0x09, 0x7c, 0x4f, 0x94, 0xb6, 0x24, 0x2d,
+	0x2a, 0x52, 0x06, 0x4a, 0x92, 0x05, 0x9b, 0x57, 0x7e, 0xe4, 0x04, 0xb2, 0xca, 0xaa, 0x0e, 0x6d,
+	0x1a, 0xff, 0x4b, 0xc1, 0x13, 0x95, 0x55, 0xa4, 0xb8, 0xb5, 0x7e, 0x55, 0x05, 0x87, 0xff, 0x6d,
+	0xc2, 0x7a, 0x0d, 0x99, 0xbc, 0x81, 0xfb, 0xc6, 0xb3, 0x84, 0xed, 0xc4, 0x91, 0x7e, 0xf9, 0xf8,
+	0xdc, 0xcc, 0xee, 0x6b, 0x87, 0x0a, 0x3f, 0x1d, 0x53, 0xf4, 0x7c, 0xae, 0x1e, 0x3b, 0x92, 0x64,
+	0xab, 0x97, 0x3f, 0x4e, 0x01, 0xe4, 0x73, 0xe8, 0x52, 0x64, 0x9c, 0xfa, 0x2e, 0xb7, 0x69, 0x1a,
+	0x20, 0x1b, 0x2c, 0x6c, 0x2f, 0x3e, 0x6f, 0x59, 0x9d, 0x0c, 0xb5, 0x04, 0x28, 0x2e, 0xef, 0x42,
+	0x8c, 0xa2, 0xcb, 0x6d, 0xf5, 0x16, 0x33, 0x58, 0x2c, 0x99, 0x89, 0x78, 0xae, 0xa5, 0x19, 0x2a,
+	0xca, 0xe2, 0xa2, 0x68, 0xb6, 0x87, 0xff, 0x6e, 0x02, 0xa8, 0x29, 0x89, 0xeb, 0x2c, 0x21, 0xb0,
+	0x24, 0x8e, 0x26, 0x39, 0xff, 0x96, 0x25, 0xff, 0x93, 0x1f, 0x41, 0xdb, 0xd5, 0x93, 0xb7, 0x6f,
+	0x70, 0xa6, 0xa3, 0xb2, 0x96, 0x61, 0xbf, 0xc3, 0x19, 0x79, 0x00, 0x2b, 0xb7, 0x71, 0x90, 0xea,
+	0x68, 0x2c, 0x59, 0xba, 0x45, 0x5e, 0x40, 0x4b, 0xee, 0x0a, 0x9f, 0x25, 0x28, 0xf7, 0xbe, 0x5b,
+	0xda, 0xfb, 0xe2, 0xc1, 0x3b, 0x62, 0xf5, 0xe7, 0xb3, 0x04, 0xad, 0xd5, 0x54, 0xff, 0x1b, 0x3e,
+	0x83, 0xd5, 0x0c, 0x25, 0x2d, 0x58, 0x96, 0x49, 0xd2, 0x6f, 0x90, 0x55, 0x58, 0x12, 0x31, 0xee,
+	0x37, 0x87, 0x87, 0xa2, 0x52, 0x46, 0x29, 0xbf, 0x3e, 0x77, 0xe8, 0x14, 0xf9, 0x5d, 0x93, 0x17,
+	0x29, 0x1d, 0x4d, 0xed, 0x29, 0x8d, 0xd3, 0x24, 0x9b, 0xbc, 0xc2, 0xbe, 0x11, 0xd0, 0xf0, 0x2c,
+	0x93, 0x19, 0x45, 0xec, 0x3d, 0x52, 0xf2, 0x04, 0x20, 0x73, 0x00, 0xdf, 0xd3, 0x62, 0x2d, 0x8d,
+	0x1c, 0x7b, 0xea, 0x05, 0x91, 0xa5, 0x01, 0xb7, 0xdd, 0xd8, 0xcb, 0x72, 0x14, 0x14, 0x34, 0x8e,
+	0x3d, 0x1c, 0xfe, 0xa3, 0x09, 0xed, 0x91, 0xeb, 0xc6, 0x69, 0xa4, 0xe3, 0x54, 0x37, 0xaf, 0x27,
+	0x00, 0x22, 0xb2, 0x76, 0xe4, 0x84, 0x79, 0x78, 0x5b, 0x02, 0x39, 0x13, 0x80, 0xa8, 0x19, 0xd9,
+	0x7d, 0xe9, 0xb0, 0x8c, 0xb3, 0xa8, 0x53, 0x20, 0x0d, 0x70, 0xdf, 0x61, 0x9a, 0x77, 0x00, 0x7d,
+	0xc9, 0xf3, 0xf0, 0xca, 0x8f, 0x7c, 0x91, 0x3c, 0x6c, 0xb0, 0xb4, 0xbd, 0x68, 0xe4, 0x80, 0x2c,
+	0xd5, 0x34, 0xc0, 0x83, 0x9c, 0x61, 0xf5, 0x68, 0xa9, 0xcd, 0x86, 0x7f, 0x5b, 0x84, 0x6e, 0x99,
+	0x43, 0x7e, 0x02, 0x44, 0x07, 0x18, 0xed, 0x7c, 0xa2, 0x7a, 0x05, 0xfd, 0xac, 0xc7, 0xd2, 0xf3,
+	0xfd, 0x01, 0xbb, 0x4c, 0x9e, 0x02, 0x24, 0x14, 0x5d, 0xf4, 0x30, 0x72, 0xb3, 0xa2, 0x35, 0x10,
+	0x91, 0xf3, 0x61, 0x1c, 0xf9, 0x3c, 0xa6, 0x59, 0x9e, 0x2d, 0xc9, 0x87, 0x75, 0x0a, 0x54, 0x64,
+	0xda, 0x97, 0x70, 0xff, 0x2a, 0x88, 0xdf, 0xdb, 0x1e, 0x8a, 0x8f, 0x01, 0x89, 0x5a, 0xf1, 0xb2,
+	0xdc, 0x9a, 0xbe, 0xe8, 0x38, 0x30, 0xf0, 0x9c, 0x6c, 0xbc, 0xe9, 0xb1, 0xc1, 0x4a, 0x41, 0x36,
+	0xde, 0xe0, 0x18, 0x79, 0x03, 0x1b, 0x79, 0x35, 0x19, 0x03, 0xf4, 0x35, 0xe7, 0x69, 0x4d, 0x49,
+	0x19, 0xc3, 0xad, 0x75, 0x5a, 0x05, 0xc9, 0x0b, 0xe8, 0xbd, 0x8b, 0x59, 0x49, 0x4d, 0xdd, 0x71,
+	0x88, 0xa1, 0xf6, 0x32, 0x88, 0xdf, 0xbf, 0x89, 0x99, 0xd5, 0x7d, 0x17, 0x33, 0x63, 0xf0, 0x70,
+	0x06, 0x7d, 0xe9, 0x7a, 0xa7, 0x6a, 0xfd, 0x77, 0x96, 0xe7, 0x1b, 0xd8, 0x54, 0x96, 0xa9, 0x37,
+	0xca, 0x76, 0x65, 0x55, 0xa9, 0xa4, 0x5a, 0xdb, 0x7b, 0x62, 0x5e, 0x2c, 0x0c, 0x3d, 0x55, 0x7b,
+	0xd6, 0x7a, 0x5a, 0xc1, 0xd8, 0xf0, 0xcf, 0x0b, 0x40, 0xaa, 0xdc, 0x9a, 0x10, 0x35, 0xeb, 0x42,
+	0xf4, 0x07, 0xe8, 0x1b, 0xb4, 0x00, 0x6f, 0x31, 0x90, 0x09, 0xd1, 0xdd, 0xfb, 0xe9, 0xf7, 0xce,
+	0x65, 0xe7, 0x34, 0x1f, 0x75, 0x22, 0x06, 0x59, 0xbd, 0xb0, 0x0c, 0xc8, 0x34, 0x43, 0x9e, 0xd2,
+	0x48, 0x1f, 0x23, 0xca, 0x6c, 0xd6, 0x14, 0xa6, 0x0e, 0x91, 0xc2, 0x89, 0x96, 0x4c, 0x27, 0x1a,
+	0xee, 0x41, 0x6f, 0x4e, 0x9e, 0xf4, 0xa1, 0xad, 0x4f, 0x6f, 0xd9, 0xee, 0x37, 0x48, 0x07, 0x5a,
+	0x22, 0xa5, 0x55, 0xb3, 0xb9, 0xf7, 0xd7, 0x26, 0x6c, 0x9c, 0xc6, 0xee, 0xcd, 0x38, 0xa6, 0x58,
+	0x1c, 0x8f, 0x31, 0x25, 0x63, 0x68, 0xab, 0xb6, 0x32, 0x51, 0x32, 0xff, 0x3a, 0x3d, 0x77, 0x9a,
+	0x6e, 0x65, 0x57, 0x24, 0xf9, 0xf1, 0x6c, 0xe7, 0xf7, 0xb1, 0xef, 0x0d, 0x1b, 0x64, 0x57, 0x7c,
+	0xbb, 0x62, 0xc8, 0x49, 0xb5, 0xb7, 0x76, 0xc0, 0xde, 0xbf, 0x16, 0xe0, 0x9e, 0x98, 0xce, 0xab,
+	0xf1, 0x84, 0xbc, 0x10, 0xaf, 0x70, 0xfc, 0xd5, 0x78, 0x32, 0x41, 0x2e, 0x6a, 0x8c, 0x91, 0x0d,
+	0x63, 0x0e, 0xf9, 0xd9, 0x5b, 0xff, 0xe4, 0x5f, 0x40, 0x6b, 0x82, 0x5c, 0x07, 0x75, 0xb3, 0xd6,
+	0x8f, 0xeb, 0x07, 0xfe, 0x0a, 0x3a, 0xea, 0x92, 0xa6, 0xed, 0x8d, 0x3c, 0x34, 0x6f, 0x42, 0xf9,
+	0xf7, 0xb9, 0xe3, 0x83, 0xfa, 0xe1, 0xbf, 0x84, 0xfe, 0x38, 0x40, 0x87, 0x16, 0x4c, 0xf6, 0x43,
+	0x17, 0x4f, 0xbe, 0x86, 0x15, 0x65, 0xd2, 0xa4, 0x7c, 0x07, 0x29, 0xec, 0x7f, 0xab, 0xda, 0xa1,
+	0x0c, 0x7d, 0xd8, 0xd8, 0xfb, 0xcb, 0x02, 0xac, 0x8a, 0xad, 0x7b, 0x3d, 0xb6, 0x5e, 0x7e, 0xea,
+	0x2a, 0xbe, 0x82, 0xd5, 0x09, 0x6a, 0x67, 0x2f, 0x7d, 0xf4, 0x33, 0x2c, 0xbf, 0x7e, 0xe4, 0x01,
+	0xf4, 0x27, 0xc8, 0xcd, 0xc4, 0x67, 0xe4, 0xf1, 0x1d, 0x25, 0x71, 0x77, 0x10, 0x3e, 0x61, 0x17,
+	0xf7, 0x1f, 0xbf, 0x7d, 0x24, 0xd1, 0x5d, 0xf1, 0xad, 0xd6, 0x0d, 0xe2, 0xd4, 0xdb, 0x9d, 0xc6,
+	0xfa, 0x03, 0xeb, 0xe5, 0x8a, 0xfc, 0xfd, 0xd9, 0xff, 0x06, 0x00, 0xf2, 0xbf, 0x58, 0xc8, 0x0b,
+	0x16, 0x00, 0x00,
 }
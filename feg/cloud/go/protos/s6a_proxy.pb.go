@@ -117,7 +117,7 @@ func (x ErrorCode) String() string {
 	return proto.EnumName(ErrorCode_name, int32(x))
 }
 func (ErrorCode) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_s6a_proxy_a38dd4520bad63f1, []int{0}
+	return fileDescriptor_s6a_proxy_6be0f3e770b586bd, []int{0}
 }
 
 // Network Access Mode AVP (Section 7.3.21)
@@ -144,7 +144,7 @@ func (x UpdateLocationAnswer_NetworkAccessMode) String() string {
 	return proto.EnumName(UpdateLocationAnswer_NetworkAccessMode_name, int32(x))
 }
 func (UpdateLocationAnswer_NetworkAccessMode) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_s6a_proxy_a38dd4520bad63f1, []int{3, 0}
+	return fileDescriptor_s6a_proxy_6be0f3e770b586bd, []int{3, 0}
 }
 
 type UpdateLocationAnswer_APNConfiguration_PDNType int32
@@ -173,7 +173,7 @@ func (x UpdateLocationAnswer_APNConfiguration_PDNType) String() string {
 	return proto.EnumName(UpdateLocationAnswer_APNConfiguration_PDNType_name, int32(x))
 }
 func (UpdateLocationAnswer_APNConfiguration_PDNType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_s6a_proxy_a38dd4520bad63f1, []int{3, 0, 0}
+	return fileDescriptor_s6a_proxy_6be0f3e770b586bd, []int{3, 0, 0}
 }
 
 type CancelLocationRequest_CancellationType int32
@@ -205,7 +205,7 @@ func (x CancelLocationRequest_CancellationType) String() string {
 	return proto.EnumName(CancelLocationRequest_CancellationType_name, int32(x))
 }
 func (CancelLocationRequest_CancellationType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_s6a_proxy_a38dd4520bad63f1, []int{4, 0}
+	return fileDescriptor_s6a_proxy_6be0f3e770b586bd, []int{4, 0}
 }
 
 // Authentication Information Request (Section 7.2.5)
@@ -229,7 +229,7 @@ func (m *AuthenticationInformationRequest) Reset()         { *m = Authentication
 func (m *AuthenticationInformationRequest) String() string { return proto.CompactTextString(m) }
 func (*AuthenticationInformationRequest) ProtoMessage()    {}
 func (*AuthenticationInformationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_s6a_proxy_a38dd4520bad63f1, []int{0}
+	return fileDescriptor_s6a_proxy_6be0f3e770b586bd, []int{0}
 }
 func (m *AuthenticationInformationRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AuthenticationInformationRequest.Unmarshal(m, b)
@@ -299,7 +299,7 @@ func (m *AuthenticationInformationAnswer) Reset()         { *m = AuthenticationI
 func (m *AuthenticationInformationAnswer) String() string { return proto.CompactTextString(m) }
 func (*AuthenticationInformationAnswer) ProtoMessage()    {}
 func (*AuthenticationInformationAnswer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_s6a_proxy_a38dd4520bad63f1, []int{1}
+	return fileDescriptor_s6a_proxy_6be0f3e770b586bd, []int{1}
 }
 func (m *AuthenticationInformationAnswer) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AuthenticationInformationAnswer.Unmarshal(m, b)
@@ -352,7 +352,7 @@ func (m *AuthenticationInformationAnswer_EUTRANVector) String() string {
 }
 func (*AuthenticationInformationAnswer_EUTRANVector) ProtoMessage() {}
 func (*AuthenticationInformationAnswer_EUTRANVector) Descriptor() ([]byte, []int) {
-	return fileDescriptor_s6a_proxy_a38dd4520bad63f1, []int{1, 0}
+	return fileDescriptor_s6a_proxy_6be0f3e770b586bd, []int{1, 0}
 }
 func (m *AuthenticationInformationAnswer_EUTRANVector) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AuthenticationInformationAnswer_EUTRANVector.Unmarshal(m, b)
@@ -420,7 +420,7 @@ func (m *UpdateLocationRequest) Reset()         { *m = UpdateLocationRequest{} }
 func (m *UpdateLocationRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateLocationRequest) ProtoMessage()    {}
 func (*UpdateLocationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_s6a_proxy_a38dd4520bad63f1, []int{2}
+	return fileDescriptor_s6a_proxy_6be0f3e770b586bd, []int{2}
 }
 func (m *UpdateLocationRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateLocationRequest.Unmarshal(m, b)
@@ -491,7 +491,7 @@ func (m *UpdateLocationAnswer) Reset()         { *m = UpdateLocationAnswer{} }
 func (m *UpdateLocationAnswer) String() string { return proto.CompactTextString(m) }
 func (*UpdateLocationAnswer) ProtoMessage()    {}
 func (*UpdateLocationAnswer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_s6a_proxy_a38dd4520bad63f1, []int{3}
+	return fileDescriptor_s6a_proxy_6be0f3e770b586bd, []int{3}
 }
 func (m *UpdateLocationAnswer) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateLocationAnswer.Unmarshal(m, b)
@@ -579,7 +579,7 @@ func (m *UpdateLocationAnswer_APNConfiguration) Reset()         { *m = UpdateLoc
 func (m *UpdateLocationAnswer_APNConfiguration) String() string { return proto.CompactTextString(m) }
 func (*UpdateLocationAnswer_APNConfiguration) ProtoMessage()    {}
 func (*UpdateLocationAnswer_APNConfiguration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_s6a_proxy_a38dd4520bad63f1, []int{3, 0}
+	return fileDescriptor_s6a_proxy_6be0f3e770b586bd, []int{3, 0}
 }
 func (m *UpdateLocationAnswer_APNConfiguration) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateLocationAnswer_APNConfiguration.Unmarshal(m, b)
@@ -653,7 +653,7 @@ func (m *UpdateLocationAnswer_APNConfiguration_QoSProfile) String() string {
 }
 func (*UpdateLocationAnswer_APNConfiguration_QoSProfile) ProtoMessage() {}
 func (*UpdateLocationAnswer_APNConfiguration_QoSProfile) Descriptor() ([]byte, []int) {
-	return fileDescriptor_s6a_proxy_a38dd4520bad63f1, []int{3, 0, 0}
+	return fileDescriptor_s6a_proxy_6be0f3e770b586bd, []int{3, 0, 0}
 }
 func (m *UpdateLocationAnswer_APNConfiguration_QoSProfile) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateLocationAnswer_APNConfiguration_QoSProfile.Unmarshal(m, b)
@@ -719,7 +719,7 @@ func (m *UpdateLocationAnswer_AggregatedMaximumBitrate) String() string {
 }
 func (*UpdateLocationAnswer_AggregatedMaximumBitrate) ProtoMessage() {}
 func (*UpdateLocationAnswer_AggregatedMaximumBitrate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_s6a_proxy_a38dd4520bad63f1, []int{3, 1}
+	return fileDescriptor_s6a_proxy_6be0f3e770b586bd, []int{3, 1}
 }
 func (m *UpdateLocationAnswer_AggregatedMaximumBitrate) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateLocationAnswer_AggregatedMaximumBitrate.Unmarshal(m, b)
@@ -768,7 +768,7 @@ func (m *CancelLocationRequest) Reset()         { *m = CancelLocationRequest{} }
 func (m *CancelLocationRequest) String() string { return proto.CompactTextString(m) }
 func (*CancelLocationRequest) ProtoMessage()    {}
 func (*CancelLocationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_s6a_proxy_a38dd4520bad63f1, []int{4}
+	return fileDescriptor_s6a_proxy_6be0f3e770b586bd, []int{4}
 }
 func (m *CancelLocationRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CancelLocationRequest.Unmarshal(m, b)
@@ -815,7 +815,7 @@ func (m *CancelLocationAnswer) Reset()         { *m = CancelLocationAnswer{} }
 func (m *CancelLocationAnswer) String() string { return proto.CompactTextString(m) }
 func (*CancelLocationAnswer) ProtoMessage()    {}
 func (*CancelLocationAnswer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_s6a_proxy_a38dd4520bad63f1, []int{5}
+	return fileDescriptor_s6a_proxy_6be0f3e770b586bd, []int{5}
 }
 func (m *CancelLocationAnswer) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CancelLocationAnswer.Unmarshal(m, b)
@@ -855,7 +855,7 @@ func (m *PurgeUERequest) Reset()         { *m = PurgeUERequest{} }
 func (m *PurgeUERequest) String() string { return proto.CompactTextString(m) }
 func (*PurgeUERequest) ProtoMessage()    {}
 func (*PurgeUERequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_s6a_proxy_a38dd4520bad63f1, []int{6}
+	return fileDescriptor_s6a_proxy_6be0f3e770b586bd, []int{6}
 }
 func (m *PurgeUERequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PurgeUERequest.Unmarshal(m, b)
@@ -895,7 +895,7 @@ func (m *PurgeUEAnswer) Reset()         { *m = PurgeUEAnswer{} }
 func (m *PurgeUEAnswer) String() string { return proto.CompactTextString(m) }
 func (*PurgeUEAnswer) ProtoMessage()    {}
 func (*PurgeUEAnswer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_s6a_proxy_a38dd4520bad63f1, []int{7}
+	return fileDescriptor_s6a_proxy_6be0f3e770b586bd, []int{7}
 }
 func (m *PurgeUEAnswer) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PurgeUEAnswer.Unmarshal(m, b)
@@ -935,7 +935,7 @@ func (m *ResetRequest) Reset()         { *m = ResetRequest{} }
 func (m *ResetRequest) String() string { return proto.CompactTextString(m) }
 func (*ResetRequest) ProtoMessage()    {}
 func (*ResetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_s6a_proxy_a38dd4520bad63f1, []int{8}
+	return fileDescriptor_s6a_proxy_6be0f3e770b586bd, []int{8}
 }
 func (m *ResetRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ResetRequest.Unmarshal(m, b)
@@ -975,7 +975,7 @@ func (m *ResetAnswer) Reset()         { *m = ResetAnswer{} }
 func (m *ResetAnswer) String() string { return proto.CompactTextString(m) }
 func (*ResetAnswer) ProtoMessage()    {}
 func (*ResetAnswer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_s6a_proxy_a38dd4520bad63f1, []int{9}
+	return fileDescriptor_s6a_proxy_6be0f3e770b586bd, []int{9}
 }
 func (m *ResetAnswer) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ResetAnswer.Unmarshal(m, b)
@@ -1002,6 +1002,272 @@ func (m *ResetAnswer) GetErrorCode() ErrorCode {
 	return ErrorCode_UNDEFINED
 }
 
+// Insert Subscriber Data Request (Section 7.2.9)
+type InsertSubscriberDataRequest struct {
+	// Subscriber identifier
+	UserName string `protobuf:"bytes,1,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	// IDR-Flags 29.272 Table 7.3.103/1
+	IdrFlags uint32 `protobuf:"varint,2,opt,name=idr_flags,json=idrFlags,proto3" json:"idr_flags,omitempty"`
+	// Identifier of the default APN
+	DefaultContextId uint32 `protobuf:"varint,3,opt,name=default_context_id,json=defaultContextId,proto3" json:"default_context_id,omitempty"`
+	// Subscriber authorized aggregate bitrate, unset if the IDR doesn't update it
+	TotalAmbr *UpdateLocationAnswer_AggregatedMaximumBitrate `protobuf:"bytes,4,opt,name=total_ambr,json=totalAmbr,proto3" json:"total_ambr,omitempty"`
+	// Indicates to wipe other stored APNs
+	AllApnsIncluded bool `protobuf:"varint,5,opt,name=all_apns_included,json=allApnsIncluded,proto3" json:"all_apns_included,omitempty"`
+	// APN configurations
+	Apn               []*UpdateLocationAnswer_APNConfiguration `protobuf:"bytes,6,rep,name=apn,proto3" json:"apn,omitempty"`
+	Msisdn            []byte                                   `protobuf:"bytes,7,opt,name=msisdn,proto3" json:"msisdn,omitempty"`
+	NetworkAccessMode UpdateLocationAnswer_NetworkAccessMode   `protobuf:"varint,8,opt,name=network_access_mode,json=networkAccessMode,proto3,enum=magma.feg.UpdateLocationAnswer_NetworkAccessMode" json:"network_access_mode,omitempty"`
+	// Indicates the IDR updates network_access_mode
+	NetworkAccessModePresent bool     `protobuf:"varint,9,opt,name=network_access_mode_present,json=networkAccessModePresent,proto3" json:"network_access_mode_present,omitempty"`
+	XXX_NoUnkeyedLiteral     struct{} `json:"-"`
+	XXX_unrecognized         []byte   `json:"-"`
+	XXX_sizecache            int32    `json:"-"`
+}
+
+func (m *InsertSubscriberDataRequest) Reset()         { *m = InsertSubscriberDataRequest{} }
+func (m *InsertSubscriberDataRequest) String() string { return proto.CompactTextString(m) }
+func (*InsertSubscriberDataRequest) ProtoMessage()    {}
+func (*InsertSubscriberDataRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_s6a_proxy_6be0f3e770b586bd, []int{10}
+}
+func (m *InsertSubscriberDataRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InsertSubscriberDataRequest.Unmarshal(m, b)
+}
+func (m *InsertSubscriberDataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InsertSubscriberDataRequest.Marshal(b, m, deterministic)
+}
+func (dst *InsertSubscriberDataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InsertSubscriberDataRequest.Merge(dst, src)
+}
+func (m *InsertSubscriberDataRequest) XXX_Size() int {
+	return xxx_messageInfo_InsertSubscriberDataRequest.Size(m)
+}
+func (m *InsertSubscriberDataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InsertSubscriberDataRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InsertSubscriberDataRequest proto.InternalMessageInfo
+
+func (m *InsertSubscriberDataRequest) GetUserName() string {
+	if m != nil {
+		return m.UserName
+	}
+	return ""
+}
+
+func (m *InsertSubscriberDataRequest) GetIdrFlags() uint32 {
+	if m != nil {
+		return m.IdrFlags
+	}
+	return 0
+}
+
+func (m *InsertSubscriberDataRequest) GetDefaultContextId() uint32 {
+	if m != nil {
+		return m.DefaultContextId
+	}
+	return 0
+}
+
+func (m *InsertSubscriberDataRequest) GetTotalAmbr() *UpdateLocationAnswer_AggregatedMaximumBitrate {
+	if m != nil {
+		return m.TotalAmbr
+	}
+	return nil
+}
+
+func (m *InsertSubscriberDataRequest) GetAllApnsIncluded() bool {
+	if m != nil {
+		return m.AllApnsIncluded
+	}
+	return false
+}
+
+func (m *InsertSubscriberDataRequest) GetApn() []*UpdateLocationAnswer_APNConfiguration {
+	if m != nil {
+		return m.Apn
+	}
+	return nil
+}
+
+func (m *InsertSubscriberDataRequest) GetMsisdn() []byte {
+	if m != nil {
+		return m.Msisdn
+	}
+	return nil
+}
+
+func (m *InsertSubscriberDataRequest) GetNetworkAccessMode() UpdateLocationAnswer_NetworkAccessMode {
+	if m != nil {
+		return m.NetworkAccessMode
+	}
+	return UpdateLocationAnswer_PACKET_AND_CIRCUIT
+}
+
+func (m *InsertSubscriberDataRequest) GetNetworkAccessModePresent() bool {
+	if m != nil {
+		return m.NetworkAccessModePresent
+	}
+	return false
+}
+
+// Insert Subscriber Data Answer (Section 7.2.10)
+type InsertSubscriberDataAnswer struct {
+	// EPC error code on failure
+	ErrorCode ErrorCode `protobuf:"varint,1,opt,name=error_code,json=errorCode,proto3,enum=magma.feg.ErrorCode" json:"error_code,omitempty"`
+	// IDA-Flags 29.272 Table 7.3.47/1
+	IdaFlags             uint32   `protobuf:"varint,2,opt,name=ida_flags,json=idaFlags,proto3" json:"ida_flags,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InsertSubscriberDataAnswer) Reset()         { *m = InsertSubscriberDataAnswer{} }
+func (m *InsertSubscriberDataAnswer) String() string { return proto.CompactTextString(m) }
+func (*InsertSubscriberDataAnswer) ProtoMessage()    {}
+func (*InsertSubscriberDataAnswer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_s6a_proxy_6be0f3e770b586bd, []int{11}
+}
+func (m *InsertSubscriberDataAnswer) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InsertSubscriberDataAnswer.Unmarshal(m, b)
+}
+func (m *InsertSubscriberDataAnswer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InsertSubscriberDataAnswer.Marshal(b, m, deterministic)
+}
+func (dst *InsertSubscriberDataAnswer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InsertSubscriberDataAnswer.Merge(dst, src)
+}
+func (m *InsertSubscriberDataAnswer) XXX_Size() int {
+	return xxx_messageInfo_InsertSubscriberDataAnswer.Size(m)
+}
+func (m *InsertSubscriberDataAnswer) XXX_DiscardUnknown() {
+	xxx_messageInfo_InsertSubscriberDataAnswer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InsertSubscriberDataAnswer proto.InternalMessageInfo
+
+func (m *InsertSubscriberDataAnswer) GetErrorCode() ErrorCode {
+	if m != nil {
+		return m.ErrorCode
+	}
+	return ErrorCode_UNDEFINED
+}
+
+func (m *InsertSubscriberDataAnswer) GetIdaFlags() uint32 {
+	if m != nil {
+		return m.IdaFlags
+	}
+	return 0
+}
+
+// Delete Subscriber Data Request (Section 7.2.11)
+type DeleteSubscriberDataRequest struct {
+	// Subscriber identifier
+	UserName string `protobuf:"bytes,1,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	// DSR-Flags 29.272 Table 7.3.25/1
+	DsrFlags uint32 `protobuf:"varint,2,opt,name=dsr_flags,json=dsrFlags,proto3" json:"dsr_flags,omitempty"`
+	// Identifiers of the APN configurations to delete
+	ContextId            []uint32 `protobuf:"varint,3,rep,packed,name=context_id,json=contextId,proto3" json:"context_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteSubscriberDataRequest) Reset()         { *m = DeleteSubscriberDataRequest{} }
+func (m *DeleteSubscriberDataRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteSubscriberDataRequest) ProtoMessage()    {}
+func (*DeleteSubscriberDataRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_s6a_proxy_6be0f3e770b586bd, []int{12}
+}
+func (m *DeleteSubscriberDataRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteSubscriberDataRequest.Unmarshal(m, b)
+}
+func (m *DeleteSubscriberDataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteSubscriberDataRequest.Marshal(b, m, deterministic)
+}
+func (dst *DeleteSubscriberDataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteSubscriberDataRequest.Merge(dst, src)
+}
+func (m *DeleteSubscriberDataRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteSubscriberDataRequest.Size(m)
+}
+func (m *DeleteSubscriberDataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteSubscriberDataRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteSubscriberDataRequest proto.InternalMessageInfo
+
+func (m *DeleteSubscriberDataRequest) GetUserName() string {
+	if m != nil {
+		return m.UserName
+	}
+	return ""
+}
+
+func (m *DeleteSubscriberDataRequest) GetDsrFlags() uint32 {
+	if m != nil {
+		return m.DsrFlags
+	}
+	return 0
+}
+
+func (m *DeleteSubscriberDataRequest) GetContextId() []uint32 {
+	if m != nil {
+		return m.ContextId
+	}
+	return nil
+}
+
+// Delete Subscriber Data Answer (Section 7.2.12)
+type DeleteSubscriberDataAnswer struct {
+	// EPC error code on failure
+	ErrorCode ErrorCode `protobuf:"varint,1,opt,name=error_code,json=errorCode,proto3,enum=magma.feg.ErrorCode" json:"error_code,omitempty"`
+	// DSA-Flags 29.272 Table 7.3.26/1
+	DsaFlags             uint32   `protobuf:"varint,2,opt,name=dsa_flags,json=dsaFlags,proto3" json:"dsa_flags,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteSubscriberDataAnswer) Reset()         { *m = DeleteSubscriberDataAnswer{} }
+func (m *DeleteSubscriberDataAnswer) String() string { return proto.CompactTextString(m) }
+func (*DeleteSubscriberDataAnswer) ProtoMessage()    {}
+func (*DeleteSubscriberDataAnswer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_s6a_proxy_6be0f3e770b586bd, []int{13}
+}
+func (m *DeleteSubscriberDataAnswer) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteSubscriberDataAnswer.Unmarshal(m, b)
+}
+func (m *DeleteSubscriberDataAnswer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteSubscriberDataAnswer.Marshal(b, m, deterministic)
+}
+func (dst *DeleteSubscriberDataAnswer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteSubscriberDataAnswer.Merge(dst, src)
+}
+func (m *DeleteSubscriberDataAnswer) XXX_Size() int {
+	return xxx_messageInfo_DeleteSubscriberDataAnswer.Size(m)
+}
+func (m *DeleteSubscriberDataAnswer) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteSubscriberDataAnswer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteSubscriberDataAnswer proto.InternalMessageInfo
+
+func (m *DeleteSubscriberDataAnswer) GetErrorCode() ErrorCode {
+	if m != nil {
+		return m.ErrorCode
+	}
+	return ErrorCode_UNDEFINED
+}
+
+func (m *DeleteSubscriberDataAnswer) GetDsaFlags() uint32 {
+	if m != nil {
+		return m.DsaFlags
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*AuthenticationInformationRequest)(nil), "magma.feg.AuthenticationInformationRequest")
 	proto.RegisterType((*AuthenticationInformationAnswer)(nil), "magma.feg.AuthenticationInformationAnswer")
@@ -1017,6 +1283,10 @@ func init() {
 	proto.RegisterType((*PurgeUEAnswer)(nil), "magma.feg.PurgeUEAnswer")
 	proto.RegisterType((*ResetRequest)(nil), "magma.feg.ResetRequest")
 	proto.RegisterType((*ResetAnswer)(nil), "magma.feg.ResetAnswer")
+	proto.RegisterType((*InsertSubscriberDataRequest)(nil), "magma.feg.InsertSubscriberDataRequest")
+	proto.RegisterType((*InsertSubscriberDataAnswer)(nil), "magma.feg.InsertSubscriberDataAnswer")
+	proto.RegisterType((*DeleteSubscriberDataRequest)(nil), "magma.feg.DeleteSubscriberDataRequest")
+	proto.RegisterType((*DeleteSubscriberDataAnswer)(nil), "magma.feg.DeleteSubscriberDataAnswer")
 	proto.RegisterEnum("magma.feg.ErrorCode", ErrorCode_name, ErrorCode_value)
 	proto.RegisterEnum("magma.feg.UpdateLocationAnswer_NetworkAccessMode", UpdateLocationAnswer_NetworkAccessMode_name, UpdateLocationAnswer_NetworkAccessMode_value)
 	proto.RegisterEnum("magma.feg.UpdateLocationAnswer_APNConfiguration_PDNType", UpdateLocationAnswer_APNConfiguration_PDNType_name, UpdateLocationAnswer_APNConfiguration_PDNType_value)
@@ -1175,6 +1445,10 @@ type S6AGatewayServiceClient interface {
 	CancelLocation(ctx context.Context, in *CancelLocationRequest, opts ...grpc.CallOption) (*CancelLocationAnswer, error)
 	// Reset (Code 322)
 	Reset(ctx context.Context, in *ResetRequest, opts ...grpc.CallOption) (*ResetAnswer, error)
+	// Insert-Subscriber-Data (Code 319)
+	InsertSubscriberData(ctx context.Context, in *InsertSubscriberDataRequest, opts ...grpc.CallOption) (*InsertSubscriberDataAnswer, error)
+	// Delete-Subscriber-Data (Code 320)
+	DeleteSubscriberData(ctx context.Context, in *DeleteSubscriberDataRequest, opts ...grpc.CallOption) (*DeleteSubscriberDataAnswer, error)
 }
 
 type s6AGatewayServiceClient struct {
@@ -1203,12 +1477,34 @@ func (c *s6AGatewayServiceClient) Reset(ctx context.Context, in *ResetRequest, o
 	return out, nil
 }
 
+func (c *s6AGatewayServiceClient) InsertSubscriberData(ctx context.Context, in *InsertSubscriberDataRequest, opts ...grpc.CallOption) (*InsertSubscriberDataAnswer, error) {
+	out := new(InsertSubscriberDataAnswer)
+	err := c.cc.Invoke(ctx, "/magma.feg.S6aGatewayService/InsertSubscriberData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *s6AGatewayServiceClient) DeleteSubscriberData(ctx context.Context, in *DeleteSubscriberDataRequest, opts ...grpc.CallOption) (*DeleteSubscriberDataAnswer, error) {
+	out := new(DeleteSubscriberDataAnswer)
+	err := c.cc.Invoke(ctx, "/magma.feg.S6aGatewayService/DeleteSubscriberData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // S6AGatewayServiceServer is the server API for S6AGatewayService service.
 type S6AGatewayServiceServer interface {
 	// Cancel-Location (Code 317)
 	CancelLocation(context.Context, *CancelLocationRequest) (*CancelLocationAnswer, error)
 	// Reset (Code 322)
 	Reset(context.Context, *ResetRequest) (*ResetAnswer, error)
+	// Insert-Subscriber-Data (Code 319)
+	InsertSubscriberData(context.Context, *InsertSubscriberDataRequest) (*InsertSubscriberDataAnswer, error)
+	// Delete-Subscriber-Data (Code 320)
+	DeleteSubscriberData(context.Context, *DeleteSubscriberDataRequest) (*DeleteSubscriberDataAnswer, error)
 }
 
 func RegisterS6AGatewayServiceServer(s *grpc.Server, srv S6AGatewayServiceServer) {
@@ -1251,6 +1547,42 @@ func _S6AGatewayService_Reset_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _S6AGatewayService_InsertSubscriberData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InsertSubscriberDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(S6AGatewayServiceServer).InsertSubscriberData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.feg.S6aGatewayService/InsertSubscriberData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(S6AGatewayServiceServer).InsertSubscriberData(ctx, req.(*InsertSubscriberDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _S6AGatewayService_DeleteSubscriberData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSubscriberDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(S6AGatewayServiceServer).DeleteSubscriberData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.feg.S6aGatewayService/DeleteSubscriberData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(S6AGatewayServiceServer).DeleteSubscriberData(ctx, req.(*DeleteSubscriberDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _S6AGatewayService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "magma.feg.S6aGatewayService",
 	HandlerType: (*S6AGatewayServiceServer)(nil),
@@ -1263,120 +1595,138 @@ var _S6AGatewayService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Reset",
 			Handler:    _S6AGatewayService_Reset_Handler,
 		},
+		{
+			MethodName: "InsertSubscriberData",
+			Handler:    _S6AGatewayService_InsertSubscriberData_Handler,
+		},
+		{
+			MethodName: "DeleteSubscriberData",
+			Handler:    _S6AGatewayService_DeleteSubscriberData_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "feg/protos/s6a_proxy.proto",
 }
 
 func init() {
-	proto.RegisterFile("feg/protos/s6a_proxy.proto", fileDescriptor_s6a_proxy_a38dd4520bad63f1)
-}
-
-var fileDescriptor_s6a_proxy_a38dd4520bad63f1 = []byte{
-	// 1675 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xdb, 0x6e, 0xdb, 0xc8,
-	0xf9, 0xb7, 0x7c, 0x88, 0xad, 0xcf, 0xb2, 0x4d, 0xcf, 0xda, 0x96, 0xec, 0x64, 0x61, 0xff, 0x85,
-	0x7f, 0x11, 0xc3, 0xdb, 0x3a, 0x5b, 0xa7, 0x70, 0x0f, 0x7b, 0xd1, 0xa5, 0xc8, 0x49, 0xcc, 0x84,
-	0x22, 0xb9, 0x43, 0x52, 0xc6, 0x2e, 0x8a, 0x9d, 0x8e, 0xc9, 0xb1, 0x42, 0x84, 0x07, 0x85, 0xa4,
-	0x1c, 0xfb, 0x05, 0x0a, 0x14, 0xed, 0x03, 0x14, 0x68, 0x6f, 0xda, 0x02, 0xbd, 0xea, 0x09, 0xe8,
-	0x55, 0x4f, 0xdb, 0xc3, 0x13, 0xb4, 0x45, 0xf7, 0x21, 0xfa, 0x16, 0x05, 0x87, 0x94, 0x23, 0x29,
-	0x09, 0x92, 0x85, 0x7b, 0xa5, 0xe1, 0xef, 0x3b, 0xfd, 0xe6, 0xf7, 0x7d, 0x33, 0xa4, 0x60, 0xe7,
-	0x9c, 0xf7, 0xef, 0x0d, 0xd2, 0x24, 0x4f, 0xb2, 0x7b, 0xd9, 0x31, 0xa3, 0x83, 0x34, 0xb9, 0xbc,
-	0x3a, 0x14, 0x00, 0xaa, 0x47, 0xac, 0x1f, 0xb1, 0xc3, 0x73, 0xde, 0x6f, 0x7f, 0x6f, 0x16, 0xf6,
-	0xe4, 0x61, 0xfe, 0x84, 0xc7, 0x79, 0xe0, 0xb1, 0x3c, 0x48, 0x62, 0x2d, 0x3e, 0x4f, 0xd2, 0x48,
-	0x2c, 0x09, 0x7f, 0x36, 0xe4, 0x59, 0x8e, 0x6e, 0x43, 0x7d, 0x98, 0xf1, 0x94, 0xc6, 0x2c, 0xe2,
-	0xad, 0xda, 0x5e, 0x6d, 0xbf, 0x4e, 0x96, 0x0a, 0xc0, 0x60, 0x11, 0x47, 0xff, 0x07, 0x8d, 0x8b,
-	0x20, 0x0b, 0x72, 0xee, 0xd3, 0x41, 0x18, 0xc5, 0xad, 0xd9, 0xbd, 0xda, 0x7e, 0x83, 0x2c, 0x57,
-	0x98, 0x15, 0x46, 0x31, 0xfa, 0x36, 0xdc, 0x89, 0x87, 0x11, 0x4d, 0xcb, 0x74, 0xdc, 0xa7, 0x7c,
-	0x98, 0xa7, 0x2c, 0xa6, 0x17, 0xdc, 0xcb, 0x93, 0x34, 0x6b, 0xcd, 0xed, 0xd5, 0xf6, 0x57, 0xc8,
-	0x76, 0x3c, 0x8c, 0xc8, 0xc8, 0x05, 0x0b, 0x8f, 0x5e, 0xe9, 0x80, 0x3e, 0x84, 0x3b, 0x41, 0x14,
-	0x71, 0x3f, 0x60, 0x39, 0xa7, 0x29, 0xcf, 0x06, 0x49, 0x9c, 0x71, 0x3a, 0x48, 0xf9, 0x39, 0x4f,
-	0x53, 0xee, 0xb7, 0xe6, 0xf7, 0x6a, 0xfb, 0x4b, 0x64, 0xe7, 0xda, 0x87, 0x54, 0x2e, 0xd6, 0xc8,
-	0x03, 0xed, 0xc2, 0x72, 0xca, 0xb3, 0xab, 0xd8, 0xa3, 0x41, 0x7c, 0x9e, 0xb4, 0x16, 0x04, 0x49,
-	0x28, 0xa1, 0x62, 0xc7, 0xed, 0x1f, 0xcd, 0xc2, 0xee, 0x6b, 0x85, 0x90, 0xe3, 0xec, 0x39, 0x4f,
-	0xd1, 0x7d, 0x00, 0x9e, 0xa6, 0x49, 0x4a, 0xbd, 0xc4, 0x2f, 0x85, 0x58, 0x3d, 0xda, 0x38, 0xbc,
-	0x16, 0xf3, 0x10, 0x17, 0x46, 0x25, 0xf1, 0x39, 0xa9, 0xf3, 0xd1, 0x12, 0x7d, 0x0a, 0xab, 0x53,
-	0xdb, 0x9d, 0xdd, 0x9b, 0xdb, 0x5f, 0x3e, 0xfa, 0xfa, 0x58, 0xe0, 0x1b, 0x0a, 0x1f, 0x62, 0xd7,
-	0x21, 0xb2, 0x51, 0xaa, 0x41, 0x56, 0xf8, 0xb8, 0x36, 0x3b, 0xdf, 0x85, 0xc6, 0xb8, 0x19, 0x21,
-	0x98, 0x4f, 0x59, 0xec, 0x0b, 0x7a, 0x0d, 0x22, 0xd6, 0x05, 0x76, 0x99, 0xf2, 0xac, 0xea, 0x8d,
-	0x58, 0x17, 0x18, 0x1b, 0xe6, 0xb1, 0x10, 0xbf, 0x41, 0xc4, 0x1a, 0x6d, 0xc0, 0xc2, 0x53, 0x96,
-	0x45, 0x5c, 0x08, 0xda, 0x20, 0xe5, 0x43, 0xfb, 0xb7, 0x35, 0xd8, 0x74, 0x07, 0x3e, 0xcb, 0xb9,
-	0x9e, 0x78, 0xff, 0xd3, 0xc1, 0x78, 0x1f, 0x36, 0xb2, 0xa7, 0xc1, 0x80, 0x66, 0xc3, 0xb3, 0xcc,
-	0x4b, 0x83, 0x33, 0x9e, 0x52, 0x9f, 0xe5, 0x4c, 0x70, 0x5a, 0x22, 0xa8, 0xb0, 0xd9, 0xd7, 0x26,
-	0x95, 0xe5, 0x0c, 0x7d, 0x09, 0x56, 0x83, 0x38, 0xc8, 0x03, 0x16, 0x52, 0x96, 0xe7, 0xcc, 0x7b,
-	0x52, 0xf5, 0x7e, 0xa5, 0x42, 0x65, 0x01, 0xb6, 0xff, 0x51, 0x87, 0x8d, 0x49, 0xca, 0x37, 0x69,
-	0xe1, 0x97, 0x01, 0xf9, 0xfc, 0x9c, 0x0d, 0xc3, 0x9c, 0x7a, 0x49, 0x9c, 0xf3, 0xcb, 0x9c, 0x06,
-	0xbe, 0xd8, 0xcf, 0x0a, 0x91, 0x2a, 0x8b, 0x52, 0x1a, 0x34, 0x1f, 0x9d, 0x02, 0xe4, 0x49, 0x5e,
-	0x10, 0x8c, 0xce, 0x52, 0xb1, 0x95, 0xe5, 0xa3, 0x6f, 0x8c, 0x95, 0x78, 0x15, 0xaf, 0x43, 0xb9,
-	0xdf, 0x4f, 0x79, 0x9f, 0xe5, 0xdc, 0xef, 0xb2, 0xcb, 0x20, 0x1a, 0x46, 0x9d, 0x20, 0x4f, 0x8b,
-	0x49, 0xae, 0x8b, 0x5c, 0x72, 0x74, 0x96, 0xa2, 0x03, 0x58, 0x67, 0x61, 0x48, 0xd9, 0x20, 0xce,
-	0x68, 0x10, 0x7b, 0xe1, 0xd0, 0xbf, 0x1e, 0xfd, 0x35, 0x16, 0x86, 0xf2, 0x20, 0xce, 0xb4, 0x0a,
-	0x46, 0x1d, 0x98, 0x63, 0x83, 0xb8, 0xb5, 0x20, 0x46, 0xed, 0xfd, 0x37, 0x56, 0xb7, 0x0c, 0x25,
-	0x89, 0xcf, 0x83, 0xfe, 0x30, 0x2d, 0xfb, 0x5b, 0x04, 0xa3, 0x2d, 0xb8, 0x15, 0x65, 0x41, 0xe6,
-	0xc7, 0xad, 0x45, 0xd1, 0xba, 0xea, 0x09, 0x31, 0x78, 0x27, 0xe6, 0xf9, 0xf3, 0x24, 0x7d, 0x4a,
-	0x99, 0xe7, 0xf1, 0x2c, 0xa3, 0x51, 0x21, 0xe6, 0x92, 0x10, 0xf3, 0xab, 0x6f, 0xaa, 0x65, 0x94,
-	0xa1, 0xb2, 0x88, 0xec, 0x16, 0x4a, 0xaf, 0xc7, 0xd3, 0xd0, 0xce, 0xbf, 0xe7, 0x41, 0x9a, 0x26,
-	0x85, 0xde, 0x05, 0x18, 0x93, 0xbf, 0x26, 0xe4, 0xaf, 0x7b, 0xd7, 0xba, 0xbf, 0x07, 0xeb, 0x19,
-	0x4f, 0x2f, 0x02, 0x8f, 0xd3, 0x8c, 0x87, 0xdc, 0x2b, 0x62, 0x44, 0x93, 0xea, 0x44, 0xaa, 0x0c,
-	0xf6, 0x08, 0x47, 0xdf, 0x81, 0xe5, 0x67, 0x49, 0x56, 0xdc, 0x8a, 0xe7, 0x41, 0xc8, 0xab, 0x2e,
-	0x7d, 0xf0, 0x45, 0x75, 0x3a, 0xfc, 0x28, 0xb1, 0xad, 0x32, 0x05, 0x81, 0x67, 0x49, 0x56, 0xad,
-	0x91, 0x0e, 0xf3, 0xa2, 0xf9, 0xf3, 0x37, 0x6c, 0xbe, 0xc8, 0x82, 0x1e, 0xc1, 0xdc, 0xc0, 0x8f,
-	0xc5, 0x9d, 0xb5, 0xfa, 0x16, 0xc9, 0xa6, 0x39, 0x5a, 0xaa, 0xe1, 0x5c, 0x0d, 0x38, 0x29, 0x92,
-	0xec, 0x7c, 0x56, 0x03, 0x78, 0x41, 0x1a, 0x6d, 0xc3, 0x92, 0x17, 0xb2, 0x2c, 0x1b, 0x09, 0xba,
-	0x40, 0x16, 0xc5, 0xb3, 0xe6, 0x17, 0x27, 0x6d, 0x90, 0x06, 0x49, 0x1a, 0xe4, 0x57, 0x34, 0xe4,
-	0x17, 0x3c, 0xac, 0x06, 0x7e, 0x65, 0x84, 0xea, 0x05, 0x88, 0xee, 0xc3, 0xe6, 0x20, 0xe5, 0x3c,
-	0x1a, 0x14, 0xb5, 0xa8, 0xc7, 0x06, 0xec, 0x2c, 0x08, 0x83, 0xfc, 0xaa, 0x3a, 0xc3, 0x1b, 0x2f,
-	0x8c, 0xca, 0xb5, 0x0d, 0x7d, 0x13, 0x5a, 0x63, 0x41, 0x17, 0xc3, 0x30, 0xe6, 0xe9, 0x28, 0xae,
-	0x1c, 0xe8, 0xe6, 0x0b, 0x7b, 0x6f, 0xdc, 0xdc, 0xfe, 0x00, 0x16, 0xab, 0x0d, 0xa1, 0x25, 0x98,
-	0xd7, 0xac, 0xde, 0xd7, 0xa4, 0x99, 0x6a, 0x75, 0x2c, 0xd5, 0x10, 0xc0, 0xad, 0x02, 0xeb, 0x1d,
-	0x4b, 0xb3, 0x48, 0x82, 0x46, 0xb1, 0xa6, 0x26, 0xa1, 0xc2, 0x3a, 0xb7, 0x13, 0x43, 0xeb, 0x75,
-	0x5a, 0xa3, 0x7d, 0x90, 0x22, 0x76, 0x49, 0xcf, 0x58, 0xec, 0x3f, 0x0f, 0xfc, 0xfc, 0x09, 0x1d,
-	0x86, 0xd5, 0x8c, 0xad, 0x46, 0xec, 0xb2, 0x33, 0x82, 0xdd, 0xf0, 0x65, 0x4f, 0x7f, 0xa4, 0xcd,
-	0x84, 0xa7, 0x1a, 0xb6, 0x1f, 0xc1, 0xfa, 0x4b, 0xe3, 0x8e, 0xb6, 0x00, 0x59, 0xb2, 0xf2, 0x18,
-	0x3b, 0x54, 0x36, 0x54, 0xaa, 0x68, 0x44, 0x71, 0x35, 0x47, 0x9a, 0x41, 0x0d, 0x58, 0x22, 0xd8,
-	0xc6, 0xa4, 0x87, 0x55, 0xa9, 0x86, 0xd6, 0x60, 0xd9, 0x34, 0xf4, 0x8f, 0x69, 0xe9, 0x2a, 0xcd,
-	0xb6, 0x7f, 0x37, 0x0b, 0x9b, 0x0a, 0x8b, 0x3d, 0x1e, 0x7e, 0xa1, 0x5b, 0xf8, 0x53, 0x58, 0xf7,
-	0x44, 0x54, 0x28, 0x62, 0x68, 0x7e, 0x35, 0xe0, 0x82, 0xed, 0xe4, 0x51, 0x7d, 0x65, 0xe6, 0x0a,
-	0x2d, 0x23, 0xc5, 0x0c, 0x49, 0xde, 0x14, 0xd2, 0xfe, 0x49, 0x0d, 0xa4, 0x69, 0x37, 0xd4, 0x82,
-	0x8d, 0x6e, 0x17, 0x53, 0xd7, 0x52, 0x65, 0x07, 0x53, 0x8b, 0x98, 0x0a, 0x56, 0x5d, 0x82, 0xa5,
-	0x19, 0xb4, 0x0d, 0x9b, 0xf6, 0x43, 0xdb, 0x78, 0xd9, 0x54, 0x43, 0xb7, 0xa1, 0x69, 0xbb, 0x1d,
-	0x5b, 0x21, 0x9a, 0xe5, 0x68, 0xa6, 0x41, 0x4f, 0x35, 0xe7, 0x44, 0x25, 0xf2, 0xa9, 0xac, 0x4b,
-	0xb3, 0x45, 0xc6, 0xe9, 0x10, 0xaa, 0x9d, 0x3e, 0x90, 0xe6, 0xd0, 0x1d, 0x68, 0x69, 0x86, 0xe6,
-	0x68, 0xb2, 0x4e, 0x65, 0xc7, 0x91, 0x95, 0x93, 0xb1, 0xa4, 0xf3, 0xed, 0xc7, 0xb0, 0x31, 0xb9,
-	0xb5, 0x1b, 0xbc, 0x07, 0xda, 0x5f, 0x81, 0x55, 0x6b, 0x98, 0xf6, 0xb9, 0x8b, 0xdf, 0x46, 0xfa,
-	0xb6, 0x0a, 0x2b, 0x95, 0xfb, 0x4d, 0x8a, 0xde, 0x85, 0x06, 0xe1, 0x19, 0xcf, 0x47, 0x25, 0x9b,
-	0xb0, 0x28, 0x4a, 0x8a, 0x13, 0x3b, 0xb7, 0x5f, 0x27, 0xb7, 0x8a, 0x47, 0xcd, 0x6f, 0x77, 0x60,
-	0x59, 0x38, 0xde, 0xa0, 0xd8, 0xc1, 0xe7, 0xf3, 0x50, 0xbf, 0x36, 0xa0, 0x15, 0xa8, 0xbb, 0x86,
-	0x8a, 0x1f, 0x68, 0x06, 0x56, 0xa5, 0x19, 0xb4, 0x09, 0x52, 0xd7, 0xd5, 0x1d, 0x8d, 0x12, 0xd3,
-	0x35, 0x54, 0x2a, 0xbb, 0xce, 0x89, 0xf4, 0x9f, 0x45, 0xd4, 0x80, 0x45, 0xdb, 0x55, 0x14, 0x6c,
-	0xdb, 0xd2, 0x3f, 0xd7, 0xd0, 0x06, 0xac, 0xe9, 0x5a, 0x57, 0x73, 0xb0, 0x4a, 0x47, 0xe8, 0xbf,
-	0xd6, 0x50, 0x13, 0x90, 0x62, 0x76, 0xbb, 0xc5, 0xc0, 0xbb, 0x86, 0xed, 0x5a, 0x26, 0x71, 0xb0,
-	0x2a, 0xfd, 0xbe, 0x89, 0xb6, 0x60, 0xdd, 0x35, 0xe4, 0x8e, 0x8e, 0xa9, 0x63, 0x52, 0x15, 0xeb,
-	0x5a, 0x0f, 0x13, 0xe9, 0x0f, 0xcd, 0xa2, 0x16, 0xc1, 0xb2, 0xde, 0xa5, 0x86, 0xe9, 0xd0, 0xea,
-	0x50, 0xfc, 0xb1, 0x89, 0x56, 0x60, 0xc9, 0x31, 0x4d, 0xda, 0x71, 0xed, 0x8f, 0xa5, 0x3f, 0x35,
-	0x11, 0x82, 0x15, 0xdd, 0x34, 0x2d, 0xaa, 0x62, 0x07, 0x2b, 0x45, 0xc6, 0x3f, 0x37, 0x51, 0x0b,
-	0xde, 0x21, 0x58, 0xd5, 0x08, 0x56, 0x1c, 0xaa, 0x19, 0xaa, 0xa6, 0xc8, 0xc5, 0x34, 0x49, 0x9f,
-	0x35, 0xd1, 0x1d, 0x68, 0xca, 0x96, 0xa5, 0x57, 0x48, 0x49, 0xa4, 0x62, 0xf2, 0x17, 0x51, 0x51,
-	0x33, 0x7a, 0xb2, 0xae, 0xa9, 0x27, 0x54, 0x25, 0xb4, 0xa3, 0x39, 0xb6, 0xf4, 0xd7, 0x71, 0x98,
-	0xca, 0x3d, 0xab, 0x84, 0xff, 0xd6, 0x44, 0xeb, 0xd0, 0x70, 0x8d, 0xc7, 0x86, 0x79, 0x6a, 0x50,
-	0x0b, 0x63, 0x22, 0xfd, 0xbd, 0x4c, 0xef, 0x3a, 0x27, 0xd8, 0x70, 0x46, 0x15, 0x08, 0x7e, 0x54,
-	0xd2, 0xfa, 0xe9, 0x6e, 0x11, 0x60, 0xba, 0x0e, 0x35, 0x1f, 0x50, 0xdb, 0x92, 0x15, 0x2c, 0xfd,
-	0x6c, 0xb7, 0x60, 0x8f, 0x75, 0xac, 0x08, 0x57, 0xdd, 0xb4, 0x1d, 0xe9, 0xe7, 0xbb, 0xe8, 0x36,
-	0x6c, 0x15, 0x49, 0x4c, 0xa2, 0x7d, 0x32, 0x95, 0xe3, 0x07, 0x77, 0x45, 0x51, 0x1b, 0x13, 0x5a,
-	0x55, 0x96, 0xbe, 0x7f, 0x17, 0xbd, 0x0b, 0xad, 0x11, 0x0f, 0x6c, 0xd9, 0x74, 0xfc, 0x00, 0x49,
-	0xbf, 0x3c, 0x28, 0xba, 0x41, 0x64, 0x47, 0x88, 0x28, 0xeb, 0xba, 0x79, 0x8a, 0x55, 0xe9, 0x57,
-	0x07, 0x42, 0x22, 0x53, 0xee, 0x6a, 0xc6, 0xc3, 0x09, 0xcb, 0x0f, 0xef, 0x16, 0xed, 0xc0, 0x1f,
-	0xb9, 0x9a, 0xd5, 0xc5, 0x86, 0x73, 0x5d, 0xe6, 0xd7, 0x22, 0xc2, 0x35, 0x1e, 0x17, 0x55, 0x8a,
-	0x5e, 0x94, 0x81, 0x2a, 0x96, 0x7e, 0x73, 0x80, 0xfe, 0x1f, 0x76, 0xa7, 0x76, 0xad, 0xca, 0x8e,
-	0x4c, 0x5d, 0x43, 0xee, 0xc9, 0x9a, 0x5e, 0x74, 0x56, 0xfa, 0x7c, 0xef, 0xe8, 0xc7, 0xb3, 0xb0,
-	0x64, 0x1f, 0x33, 0xab, 0xf8, 0x13, 0x82, 0x2e, 0x60, 0xfb, 0xb5, 0x1f, 0xbc, 0xe8, 0xbd, 0xb7,
-	0xf9, 0x2c, 0xae, 0xce, 0xc2, 0xce, 0xc1, 0xdb, 0x7f, 0x43, 0xb7, 0x67, 0x90, 0x0b, 0xab, 0x93,
-	0x6f, 0x4c, 0xb4, 0xf7, 0xda, 0x97, 0xe9, 0xa8, 0xc2, 0xee, 0x1b, 0x5e, 0xb7, 0xed, 0x19, 0xf4,
-	0x21, 0x2c, 0x56, 0xc7, 0x1c, 0x6d, 0x8f, 0x79, 0x4f, 0xde, 0x14, 0x3b, 0xad, 0x97, 0x4d, 0xa3,
-	0x0c, 0x47, 0xbf, 0xa8, 0xc1, 0xba, 0x7d, 0xcc, 0x1e, 0xb2, 0x9c, 0x3f, 0x67, 0x57, 0x76, 0xf9,
-	0xad, 0x52, 0xd0, 0x9d, 0xbc, 0xba, 0x26, 0xe8, 0xbe, 0xf2, 0xc2, 0x9e, 0xa0, 0xfb, 0xaa, 0x7b,
-	0xaf, 0x3d, 0x83, 0xbe, 0x05, 0x0b, 0xe2, 0x9a, 0x40, 0xcd, 0x31, 0xdf, 0xf1, 0x1b, 0x66, 0x67,
-	0x6b, 0xda, 0x30, 0x8a, 0xed, 0xdc, 0xfe, 0x64, 0x5b, 0x98, 0xee, 0x15, 0x7f, 0x2e, 0xbd, 0x30,
-	0x19, 0xfa, 0xf7, 0xfa, 0x49, 0xf5, 0x2f, 0xf3, 0xec, 0x96, 0xf8, 0xbd, 0xff, 0xdf, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x77, 0x99, 0xb9, 0xc8, 0x7a, 0x0e, 0x00, 0x00,
+	proto.RegisterFile("feg/protos/s6a_proxy.proto", fileDescriptor_s6a_proxy_6be0f3e770b586bd)
+}
+
+var fileDescriptor_s6a_proxy_6be0f3e770b586bd = []byte{
+	// 1840 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0xdb, 0x72, 0xdc, 0x48,
+	0xf9, 0xf7, 0x1c, 0x1c, 0xcf, 0x7c, 0x1e, 0x3b, 0x72, 0xaf, 0x93, 0x19, 0x8f, 0xb3, 0x65, 0xff,
+	0xa7, 0xfe, 0x21, 0x2e, 0x2f, 0x38, 0x8b, 0x43, 0x85, 0x85, 0x2d, 0x8a, 0x95, 0x25, 0x25, 0x51,
+	0x32, 0x23, 0x69, 0x5b, 0x92, 0x5d, 0xbb, 0x45, 0x6d, 0xd3, 0x96, 0xda, 0x13, 0x55, 0x74, 0x98,
+	0x48, 0x1a, 0xc7, 0x7e, 0x01, 0x0a, 0x0a, 0x1e, 0x80, 0x2a, 0xb8, 0x01, 0x6e, 0x39, 0x55, 0x71,
+	0xc5, 0x69, 0x39, 0x3c, 0x01, 0x50, 0xec, 0x43, 0xf0, 0x06, 0x5c, 0x52, 0x6a, 0x69, 0x26, 0x33,
+	0x63, 0x3b, 0x4e, 0x30, 0x5c, 0x70, 0x35, 0xad, 0xef, 0xf4, 0xfb, 0x4e, 0xdd, 0x5f, 0xf7, 0x40,
+	0xfb, 0x88, 0xf5, 0xef, 0x0e, 0xe2, 0x28, 0x8d, 0x92, 0xbb, 0xc9, 0x7d, 0x4a, 0x06, 0x71, 0x74,
+	0x72, 0xba, 0xc3, 0x09, 0xa8, 0x1e, 0xd0, 0x7e, 0x40, 0x77, 0x8e, 0x58, 0xbf, 0xf3, 0xad, 0x32,
+	0x6c, 0x8a, 0xc3, 0xf4, 0x29, 0x0b, 0x53, 0xcf, 0xa1, 0xa9, 0x17, 0x85, 0x6a, 0x78, 0x14, 0xc5,
+	0x01, 0x5f, 0x62, 0xf6, 0x7c, 0xc8, 0x92, 0x14, 0xad, 0x43, 0x7d, 0x98, 0xb0, 0x98, 0x84, 0x34,
+	0x60, 0xad, 0xd2, 0x66, 0x69, 0xab, 0x8e, 0x6b, 0x19, 0x41, 0xa3, 0x01, 0x43, 0xff, 0x07, 0x8d,
+	0x63, 0x2f, 0xf1, 0x52, 0xe6, 0x92, 0x81, 0x1f, 0x84, 0xad, 0xf2, 0x66, 0x69, 0xab, 0x81, 0x17,
+	0x0b, 0x9a, 0xe1, 0x07, 0x21, 0xfa, 0x3a, 0xdc, 0x0a, 0x87, 0x01, 0x89, 0x73, 0x73, 0xcc, 0x25,
+	0x6c, 0x98, 0xc6, 0x34, 0x24, 0xc7, 0xcc, 0x49, 0xa3, 0x38, 0x69, 0x55, 0x36, 0x4b, 0x5b, 0x4b,
+	0x78, 0x2d, 0x1c, 0x06, 0x78, 0x24, 0xa2, 0x70, 0x89, 0xfd, 0x5c, 0x00, 0x7d, 0x00, 0xb7, 0xbc,
+	0x20, 0x60, 0xae, 0x47, 0x53, 0x46, 0x62, 0x96, 0x0c, 0xa2, 0x30, 0x61, 0x64, 0x10, 0xb3, 0x23,
+	0x16, 0xc7, 0xcc, 0x6d, 0x55, 0x37, 0x4b, 0x5b, 0x35, 0xdc, 0x1e, 0xcb, 0xe0, 0x42, 0xc4, 0x18,
+	0x49, 0xa0, 0x0d, 0x58, 0x8c, 0x59, 0x72, 0x1a, 0x3a, 0xc4, 0x0b, 0x8f, 0xa2, 0xd6, 0x3c, 0x77,
+	0x12, 0x72, 0x52, 0x16, 0x71, 0xe7, 0xfb, 0x65, 0xd8, 0xb8, 0x30, 0x11, 0x62, 0x98, 0xbc, 0x60,
+	0x31, 0xba, 0x07, 0xc0, 0xe2, 0x38, 0x8a, 0x89, 0x13, 0xb9, 0x79, 0x22, 0x96, 0x77, 0x57, 0x77,
+	0xc6, 0xc9, 0xdc, 0x51, 0x32, 0xa6, 0x14, 0xb9, 0x0c, 0xd7, 0xd9, 0x68, 0x89, 0x3e, 0x81, 0xe5,
+	0x99, 0x70, 0xcb, 0x9b, 0x95, 0xad, 0xc5, 0xdd, 0x2f, 0x4f, 0x28, 0x5e, 0x02, 0xbc, 0xa3, 0xd8,
+	0x16, 0x16, 0xb5, 0x3c, 0x1b, 0x78, 0x89, 0x4d, 0xe6, 0xa6, 0xfd, 0x4d, 0x68, 0x4c, 0xb2, 0x11,
+	0x82, 0x6a, 0x4c, 0x43, 0x97, 0xbb, 0xd7, 0xc0, 0x7c, 0x9d, 0xd1, 0x4e, 0x62, 0x96, 0x14, 0xb5,
+	0xe1, 0xeb, 0x8c, 0x46, 0x87, 0x69, 0xc8, 0x93, 0xdf, 0xc0, 0x7c, 0x8d, 0x56, 0x61, 0xfe, 0x19,
+	0x4d, 0x02, 0xc6, 0x13, 0xda, 0xc0, 0xf9, 0x47, 0xe7, 0x97, 0x25, 0xb8, 0x61, 0x0f, 0x5c, 0x9a,
+	0xb2, 0x6e, 0xe4, 0xfc, 0x47, 0x1b, 0xe3, 0x5d, 0x58, 0x4d, 0x9e, 0x79, 0x03, 0x92, 0x0c, 0x0f,
+	0x13, 0x27, 0xf6, 0x0e, 0x59, 0x4c, 0x5c, 0x9a, 0x52, 0xee, 0x53, 0x0d, 0xa3, 0x8c, 0x67, 0x8e,
+	0x59, 0x32, 0x4d, 0x29, 0xba, 0x0d, 0xcb, 0x5e, 0xe8, 0xa5, 0x1e, 0xf5, 0x09, 0x4d, 0x53, 0xea,
+	0x3c, 0x2d, 0x6a, 0xbf, 0x54, 0x50, 0x45, 0x4e, 0xec, 0xfc, 0xa5, 0x0e, 0xab, 0xd3, 0x2e, 0x5f,
+	0xa5, 0x84, 0x9f, 0x07, 0xe4, 0xb2, 0x23, 0x3a, 0xf4, 0x53, 0xe2, 0x44, 0x61, 0xca, 0x4e, 0x52,
+	0xe2, 0xb9, 0x3c, 0x9e, 0x25, 0x2c, 0x14, 0x1c, 0x29, 0x67, 0xa8, 0x2e, 0x3a, 0x00, 0x48, 0xa3,
+	0x34, 0x73, 0x30, 0x38, 0x8c, 0x79, 0x28, 0x8b, 0xbb, 0xef, 0x4d, 0x40, 0x9c, 0xe7, 0xd7, 0x8e,
+	0xd8, 0xef, 0xc7, 0xac, 0x4f, 0x53, 0xe6, 0xf6, 0xe8, 0x89, 0x17, 0x0c, 0x83, 0x3d, 0x2f, 0x8d,
+	0xb3, 0x4e, 0xae, 0x73, 0x5b, 0x62, 0x70, 0x18, 0xa3, 0x6d, 0x58, 0xa1, 0xbe, 0x4f, 0xe8, 0x20,
+	0x4c, 0x88, 0x17, 0x3a, 0xfe, 0xd0, 0x1d, 0xb7, 0xfe, 0x75, 0xea, 0xfb, 0xe2, 0x20, 0x4c, 0xd4,
+	0x82, 0x8c, 0xf6, 0xa0, 0x42, 0x07, 0x61, 0x6b, 0x9e, 0xb7, 0xda, 0xbb, 0x97, 0xa2, 0x1b, 0x9a,
+	0x14, 0x85, 0x47, 0x5e, 0x7f, 0x18, 0xe7, 0xf5, 0xcd, 0x94, 0xd1, 0x4d, 0xb8, 0x16, 0x24, 0x5e,
+	0xe2, 0x86, 0xad, 0x05, 0x5e, 0xba, 0xe2, 0x0b, 0x51, 0x78, 0x2b, 0x64, 0xe9, 0x8b, 0x28, 0x7e,
+	0x46, 0xa8, 0xe3, 0xb0, 0x24, 0x21, 0x41, 0x96, 0xcc, 0x1a, 0x4f, 0xe6, 0x17, 0x2f, 0xc3, 0xd2,
+	0x72, 0x55, 0x91, 0x6b, 0xf6, 0xb2, 0x4c, 0xaf, 0x84, 0xb3, 0xa4, 0xf6, 0xdf, 0xab, 0x20, 0xcc,
+	0x3a, 0x85, 0xde, 0x06, 0x98, 0x48, 0x7f, 0x89, 0xa7, 0xbf, 0xee, 0x8c, 0xf3, 0xfe, 0x0e, 0xac,
+	0x24, 0x2c, 0x3e, 0xf6, 0x1c, 0x46, 0x12, 0xe6, 0x33, 0x27, 0xd3, 0xe1, 0x45, 0xaa, 0x63, 0xa1,
+	0x60, 0x98, 0x23, 0x3a, 0xfa, 0x06, 0x2c, 0x3e, 0x8f, 0x92, 0xec, 0x54, 0x3c, 0xf2, 0x7c, 0x56,
+	0x54, 0xe9, 0xfd, 0x37, 0xcd, 0xd3, 0xce, 0x87, 0x91, 0x69, 0xe4, 0x26, 0x30, 0x3c, 0x8f, 0x92,
+	0x62, 0x8d, 0xba, 0x50, 0xe5, 0xc5, 0xaf, 0x5e, 0xb1, 0xf8, 0xdc, 0x0a, 0x7a, 0x0c, 0x95, 0x81,
+	0x1b, 0xf2, 0x33, 0x6b, 0x79, 0xf7, 0xbd, 0x37, 0xf6, 0xd1, 0x90, 0x35, 0xeb, 0x74, 0xc0, 0x70,
+	0x66, 0xa4, 0xfd, 0x69, 0x09, 0xe0, 0xa5, 0xd3, 0x68, 0x0d, 0x6a, 0x8e, 0x4f, 0x93, 0x64, 0x94,
+	0xd0, 0x79, 0xbc, 0xc0, 0xbf, 0x55, 0x37, 0xdb, 0x69, 0x83, 0xd8, 0x8b, 0x62, 0x2f, 0x3d, 0x25,
+	0x3e, 0x3b, 0x66, 0x7e, 0xd1, 0xf0, 0x4b, 0x23, 0x6a, 0x37, 0x23, 0xa2, 0x7b, 0x70, 0x63, 0x10,
+	0x33, 0x16, 0x0c, 0x32, 0x2c, 0xe2, 0xd0, 0x01, 0x3d, 0xf4, 0x7c, 0x2f, 0x3d, 0x2d, 0xf6, 0xf0,
+	0xea, 0x4b, 0xa6, 0x34, 0xe6, 0xa1, 0xaf, 0x40, 0x6b, 0x42, 0xe9, 0x78, 0xe8, 0x87, 0x2c, 0x1e,
+	0xe9, 0xe5, 0x0d, 0xdd, 0x7c, 0xc9, 0xdf, 0x9f, 0x64, 0x77, 0xde, 0x87, 0x85, 0x22, 0x20, 0x54,
+	0x83, 0xaa, 0x6a, 0xec, 0x7f, 0x49, 0x98, 0x2b, 0x56, 0xf7, 0x85, 0x12, 0x02, 0xb8, 0x96, 0xd1,
+	0xf6, 0xef, 0x0b, 0x65, 0x24, 0x40, 0x23, 0x5b, 0x13, 0x1d, 0x13, 0xce, 0xad, 0xb4, 0x43, 0x68,
+	0x5d, 0x94, 0x6b, 0xb4, 0x05, 0x42, 0x40, 0x4f, 0xc8, 0x21, 0x0d, 0xdd, 0x17, 0x9e, 0x9b, 0x3e,
+	0x25, 0x43, 0xbf, 0xe8, 0xb1, 0xe5, 0x80, 0x9e, 0xec, 0x8d, 0xc8, 0xb6, 0x7f, 0x56, 0xd2, 0x1d,
+	0xe5, 0x66, 0x4a, 0x52, 0xf6, 0x3b, 0x8f, 0x61, 0xe5, 0x4c, 0xbb, 0xa3, 0x9b, 0x80, 0x0c, 0x51,
+	0x7a, 0xa2, 0x58, 0x44, 0xd4, 0x64, 0x22, 0xa9, 0x58, 0xb2, 0x55, 0x4b, 0x98, 0x43, 0x0d, 0xa8,
+	0x61, 0xc5, 0x54, 0xf0, 0xbe, 0x22, 0x0b, 0x25, 0x74, 0x1d, 0x16, 0x75, 0xad, 0xfb, 0x11, 0xc9,
+	0x45, 0x85, 0x72, 0xe7, 0x57, 0x65, 0xb8, 0x21, 0xd1, 0xd0, 0x61, 0xfe, 0x1b, 0x9d, 0xc2, 0x9f,
+	0xc0, 0x8a, 0xc3, 0xb5, 0x7c, 0xae, 0x43, 0xd2, 0xd3, 0x01, 0x6b, 0x95, 0xcf, 0x6c, 0xd5, 0x73,
+	0x2d, 0xef, 0x48, 0x13, 0x9a, 0xbc, 0x87, 0x04, 0x67, 0x86, 0xd2, 0xf9, 0x61, 0x09, 0x84, 0x59,
+	0x31, 0xd4, 0x82, 0xd5, 0x5e, 0x4f, 0x21, 0xb6, 0x21, 0x8b, 0x96, 0x42, 0x0c, 0xac, 0x4b, 0x8a,
+	0x6c, 0x63, 0x45, 0x98, 0x43, 0x6b, 0x70, 0xc3, 0x7c, 0x68, 0x6a, 0x67, 0x59, 0x25, 0xb4, 0x0e,
+	0x4d, 0xd3, 0xde, 0x33, 0x25, 0xac, 0x1a, 0x96, 0xaa, 0x6b, 0xe4, 0x40, 0xb5, 0x1e, 0xc9, 0x58,
+	0x3c, 0x10, 0xbb, 0x42, 0x39, 0xb3, 0x38, 0xab, 0x42, 0xd4, 0x83, 0x07, 0x42, 0x05, 0xdd, 0x82,
+	0x96, 0xaa, 0xa9, 0x96, 0x2a, 0x76, 0x89, 0x68, 0x59, 0xa2, 0xf4, 0x68, 0xc2, 0x68, 0xb5, 0xf3,
+	0x04, 0x56, 0xa7, 0x43, 0xbb, 0xc2, 0x1c, 0xe8, 0x7c, 0x01, 0x96, 0x8d, 0x61, 0xdc, 0x67, 0xb6,
+	0xf2, 0x3a, 0xa9, 0xef, 0xc8, 0xb0, 0x54, 0x88, 0x5f, 0x05, 0xf4, 0x0e, 0x34, 0x30, 0x4b, 0x58,
+	0x3a, 0x82, 0x6c, 0xc2, 0x02, 0x87, 0xe4, 0x3b, 0xb6, 0xb2, 0x55, 0xc7, 0xd7, 0xb2, 0x4f, 0xd5,
+	0xed, 0xec, 0xc1, 0x22, 0x17, 0xbc, 0x0a, 0xd8, 0xb7, 0xab, 0xb0, 0xae, 0x86, 0x09, 0x8b, 0xd3,
+	0xe9, 0xb9, 0xfb, 0x5a, 0xad, 0xb6, 0x0e, 0x75, 0xcf, 0x8d, 0xc9, 0x91, 0x4f, 0xfb, 0x49, 0xb1,
+	0x21, 0x6a, 0x9e, 0x1b, 0x3f, 0xc8, 0xbe, 0x2f, 0x98, 0xa1, 0x95, 0xd7, 0x9a, 0xa1, 0xd5, 0xff,
+	0xf2, 0x0c, 0x9d, 0x7f, 0xe5, 0x0c, 0xbd, 0xf6, 0xbf, 0x39, 0x43, 0xd1, 0xd7, 0x60, 0xfd, 0x1c,
+	0x88, 0xec, 0xd6, 0x9c, 0xb0, 0x30, 0x6d, 0xd5, 0x79, 0xd0, 0xad, 0x33, 0x7a, 0x46, 0xce, 0xef,
+	0x84, 0xd0, 0x3e, 0xaf, 0x13, 0xae, 0x72, 0x8f, 0xe2, 0x0d, 0x42, 0x67, 0x1b, 0x84, 0xf2, 0x06,
+	0xe9, 0x1c, 0xc3, 0xba, 0xcc, 0x7c, 0x96, 0xb2, 0x7f, 0xaf, 0xf3, 0xdc, 0x64, 0xa6, 0xf3, 0xdc,
+	0xa4, 0xe8, 0xbc, 0xe9, 0x6b, 0x43, 0x65, 0xb3, 0x32, 0x75, 0x6d, 0xc8, 0xe2, 0x3c, 0x0f, 0xf7,
+	0x8a, 0x71, 0xba, 0x09, 0x9d, 0x75, 0x27, 0x8f, 0x73, 0xfb, 0xb3, 0x2a, 0xd4, 0xc7, 0x5a, 0x68,
+	0x09, 0xea, 0xb6, 0x26, 0x2b, 0x0f, 0x54, 0x4d, 0x91, 0x85, 0x39, 0x74, 0x03, 0x84, 0x9e, 0xdd,
+	0xb5, 0x54, 0x82, 0x75, 0x5b, 0x93, 0x89, 0x68, 0x5b, 0x8f, 0x84, 0x7f, 0x2c, 0xa0, 0x06, 0x2c,
+	0x98, 0xb6, 0x24, 0x29, 0xa6, 0x29, 0xfc, 0xf5, 0x3a, 0x5a, 0x85, 0xeb, 0x5d, 0xb5, 0xa7, 0x5a,
+	0x8a, 0x4c, 0x46, 0xd4, 0xbf, 0x5d, 0x47, 0x4d, 0x40, 0x92, 0xde, 0xeb, 0x65, 0x33, 0xc5, 0xd6,
+	0x4c, 0xdb, 0xd0, 0xb1, 0xa5, 0xc8, 0xc2, 0xaf, 0x9b, 0xe8, 0x26, 0xac, 0xd8, 0x9a, 0xb8, 0xd7,
+	0x55, 0x88, 0xa5, 0x13, 0x59, 0xe9, 0xaa, 0xfb, 0x0a, 0x16, 0x7e, 0xd3, 0xcc, 0xb0, 0xb0, 0x22,
+	0x76, 0x7b, 0x44, 0xd3, 0x2d, 0x52, 0xcc, 0x9d, 0xdf, 0x36, 0xd1, 0x12, 0xd4, 0x2c, 0x5d, 0x27,
+	0x7b, 0xb6, 0xf9, 0x91, 0xf0, 0xbb, 0x26, 0x42, 0xb0, 0xd4, 0xd5, 0x75, 0x83, 0xc8, 0x8a, 0xa5,
+	0x48, 0x99, 0xc5, 0xdf, 0x37, 0x51, 0x0b, 0xde, 0xc2, 0x8a, 0xac, 0x62, 0x45, 0xb2, 0x88, 0xaa,
+	0xc9, 0xaa, 0x24, 0x66, 0x07, 0xb6, 0xf0, 0x69, 0x13, 0xdd, 0x82, 0xa6, 0x68, 0x18, 0xdd, 0x82,
+	0x92, 0x3b, 0x52, 0x78, 0xf2, 0x07, 0x8e, 0xa8, 0x6a, 0xfb, 0x62, 0x57, 0x95, 0x1f, 0x11, 0x19,
+	0x93, 0x3d, 0xd5, 0x32, 0x85, 0x3f, 0x4e, 0x92, 0x89, 0xb8, 0x6f, 0xe4, 0xe4, 0x3f, 0x35, 0xd1,
+	0x0a, 0x34, 0x6c, 0xed, 0x89, 0xa6, 0x1f, 0x68, 0xc4, 0x50, 0x14, 0x2c, 0xfc, 0x39, 0x37, 0x6f,
+	0x5b, 0x8f, 0x14, 0xcd, 0x1a, 0x21, 0x60, 0xe5, 0x71, 0xee, 0xd6, 0x8f, 0x36, 0x32, 0x05, 0xdd,
+	0xb6, 0x88, 0xfe, 0x80, 0x98, 0x86, 0x28, 0x29, 0xc2, 0x8f, 0x37, 0x32, 0xef, 0x95, 0xae, 0x22,
+	0x71, 0xd1, 0xae, 0x6e, 0x5a, 0xc2, 0x4f, 0x36, 0xd0, 0x3a, 0xdc, 0xcc, 0x8c, 0xe8, 0x58, 0xfd,
+	0x78, 0xc6, 0xc6, 0x77, 0xef, 0x70, 0x50, 0x53, 0xc1, 0xa4, 0x40, 0x16, 0xbe, 0x73, 0x07, 0xbd,
+	0x0d, 0xad, 0x91, 0x1f, 0x8a, 0x61, 0x92, 0xc9, 0x19, 0x25, 0xfc, 0x74, 0x3b, 0xab, 0x06, 0x16,
+	0x2d, 0x9e, 0x44, 0xb1, 0xdb, 0xd5, 0x0f, 0x14, 0x59, 0xf8, 0xd9, 0x36, 0x4f, 0x91, 0x2e, 0xf6,
+	0x54, 0xed, 0xe1, 0x14, 0xe7, 0x7b, 0x77, 0xb2, 0x72, 0x28, 0x1f, 0xda, 0xaa, 0xd1, 0x53, 0x34,
+	0x6b, 0x0c, 0xf3, 0x73, 0xae, 0x61, 0x6b, 0x4f, 0x32, 0x94, 0xac, 0x16, 0xb9, 0xa2, 0xac, 0x08,
+	0xbf, 0xd8, 0x46, 0xff, 0x0f, 0x1b, 0x33, 0x51, 0xcb, 0xa2, 0x25, 0x12, 0x5b, 0x13, 0xf7, 0x45,
+	0xb5, 0x9b, 0x55, 0x56, 0xf8, 0x6c, 0x73, 0xf7, 0x07, 0x65, 0xa8, 0x99, 0xf7, 0xa9, 0x91, 0xbd,
+	0xf3, 0xd1, 0x31, 0xac, 0x5d, 0xf8, 0xa6, 0x44, 0xef, 0xbc, 0xce, 0xcb, 0xb3, 0xd8, 0x77, 0xed,
+	0xed, 0xd7, 0x7f, 0xa6, 0x76, 0xe6, 0x90, 0x0d, 0xcb, 0xd3, 0x07, 0x16, 0xda, 0xbc, 0xf0, 0x2c,
+	0x1b, 0x21, 0x6c, 0x5c, 0x72, 0xda, 0x75, 0xe6, 0xd0, 0x07, 0xb0, 0x50, 0x4c, 0x52, 0xb4, 0x36,
+	0x21, 0x3d, 0x3d, 0x8c, 0xdb, 0xad, 0xb3, 0xac, 0x91, 0x85, 0xdd, 0x7f, 0x96, 0x61, 0xc5, 0xbc,
+	0x4f, 0x1f, 0xd2, 0x94, 0xbd, 0xa0, 0xa7, 0x66, 0xfe, 0x1c, 0xc8, 0xdc, 0x9d, 0xbe, 0x1d, 0x4c,
+	0xb9, 0x7b, 0xee, 0x9d, 0xa8, 0xbd, 0x71, 0xa1, 0xc4, 0xd8, 0xdd, 0xaf, 0xc2, 0x3c, 0x9f, 0xc4,
+	0xa8, 0x39, 0x21, 0x3b, 0x39, 0xc4, 0xdb, 0x37, 0x67, 0x19, 0x63, 0xdd, 0x3e, 0xac, 0x9e, 0x77,
+	0xec, 0xa2, 0xcf, 0x4d, 0x68, 0xbc, 0x62, 0x42, 0xb7, 0x6f, 0x5f, 0x22, 0x37, 0x09, 0x74, 0xde,
+	0xb9, 0x37, 0x05, 0xf4, 0x8a, 0x03, 0xb9, 0x7d, 0xfb, 0x12, 0xb9, 0x11, 0xd0, 0xde, 0xfa, 0xc7,
+	0x6b, 0x5c, 0xf2, 0x6e, 0xf6, 0x8f, 0x94, 0xe3, 0x47, 0x43, 0xf7, 0x6e, 0x3f, 0x2a, 0xfe, 0x9a,
+	0x3a, 0xbc, 0xc6, 0x7f, 0xef, 0xfd, 0x6b, 0x00, 0x69, 0x7b, 0x02, 0x82, 0xaf, 0x12, 0x00, 0x00,
 }
@@ -29,17 +29,20 @@ type SwxErrorCode int32
 
 const (
 	SwxErrorCode_ERROR_UNDEFINED               SwxErrorCode = 0
+	SwxErrorCode_USER_UNKNOWN                  SwxErrorCode = 5001
 	SwxErrorCode_IDENTITY_ALREADY_REGISTERED   SwxErrorCode = 5005
 	SwxErrorCode_USER_NO_NON_3GPP_SUBSCRIPTION SwxErrorCode = 5450
 )
 
 var SwxErrorCode_name = map[int32]string{
 	0:    "ERROR_UNDEFINED",
+	5001: "USER_UNKNOWN",
 	5005: "IDENTITY_ALREADY_REGISTERED",
 	5450: "USER_NO_NON_3GPP_SUBSCRIPTION",
 }
 var SwxErrorCode_value = map[string]int32{
 	"ERROR_UNDEFINED":               0,
+	"USER_UNKNOWN":                  5001,
 	"IDENTITY_ALREADY_REGISTERED":   5005,
 	"USER_NO_NON_3GPP_SUBSCRIPTION": 5450,
 }
@@ -48,7 +51,7 @@ func (x SwxErrorCode) String() string {
 	return proto.EnumName(SwxErrorCode_name, int32(x))
 }
 func (SwxErrorCode) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_swx_proxy_2ee495aa9eae97d6, []int{0}
+	return fileDescriptor_swx_proxy_125d5526c57b787e, []int{0}
 }
 
 type AuthenticationScheme int32
@@ -56,22 +59,55 @@ type AuthenticationScheme int32
 const (
 	AuthenticationScheme_EAP_AKA       AuthenticationScheme = 0
 	AuthenticationScheme_EAP_AKA_PRIME AuthenticationScheme = 1
+	// GSM triplets, HSS returns EAP-AKA vectors instead for USIM subscribers
+	AuthenticationScheme_EAP_SIM AuthenticationScheme = 2
 )
 
 var AuthenticationScheme_name = map[int32]string{
 	0: "EAP_AKA",
 	1: "EAP_AKA_PRIME",
+	2: "EAP_SIM",
 }
 var AuthenticationScheme_value = map[string]int32{
 	"EAP_AKA":       0,
 	"EAP_AKA_PRIME": 1,
+	"EAP_SIM":       2,
 }
 
 func (x AuthenticationScheme) String() string {
 	return proto.EnumName(AuthenticationScheme_name, int32(x))
 }
 func (AuthenticationScheme) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_swx_proxy_2ee495aa9eae97d6, []int{1}
+	return fileDescriptor_swx_proxy_125d5526c57b787e, []int{1}
+}
+
+type RegistrationTerminationRequest_ReasonCode int32
+
+const (
+	RegistrationTerminationRequest_PERMANENT_TERMINATION RegistrationTerminationRequest_ReasonCode = 0
+	RegistrationTerminationRequest_NEW_SERVER_ASSIGNED   RegistrationTerminationRequest_ReasonCode = 1
+	RegistrationTerminationRequest_SERVER_CHANGE         RegistrationTerminationRequest_ReasonCode = 2
+	RegistrationTerminationRequest_REMOVE_S_CSCF         RegistrationTerminationRequest_ReasonCode = 3
+)
+
+var RegistrationTerminationRequest_ReasonCode_name = map[int32]string{
+	0: "PERMANENT_TERMINATION",
+	1: "NEW_SERVER_ASSIGNED",
+	2: "SERVER_CHANGE",
+	3: "REMOVE_S_CSCF",
+}
+var RegistrationTerminationRequest_ReasonCode_value = map[string]int32{
+	"PERMANENT_TERMINATION": 0,
+	"NEW_SERVER_ASSIGNED":   1,
+	"SERVER_CHANGE":         2,
+	"REMOVE_S_CSCF":         3,
+}
+
+func (x RegistrationTerminationRequest_ReasonCode) String() string {
+	return proto.EnumName(RegistrationTerminationRequest_ReasonCode_name, int32(x))
+}
+func (RegistrationTerminationRequest_ReasonCode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_swx_proxy_125d5526c57b787e, []int{4, 0}
 }
 
 // AuthenticationRequest (Section 8.2.2.1)
@@ -80,7 +116,7 @@ type AuthenticationRequest struct {
 	UserName string `protobuf:"bytes,1,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
 	// Number of authentication vectors requested
 	SipNumAuthVectors uint32 `protobuf:"varint,2,opt,name=sip_num_auth_vectors,json=sipNumAuthVectors,proto3" json:"sip_num_auth_vectors,omitempty"`
-	// EAP-AKA or EAP-AKA'
+	// EAP-AKA, EAP-AKA' or EAP-SIM
 	AuthenticationScheme AuthenticationScheme `protobuf:"varint,3,opt,name=authentication_scheme,json=authenticationScheme,proto3,enum=magma.feg.AuthenticationScheme" json:"authentication_scheme,omitempty"`
 	// Concatenation of RAND and AUTS in the case of resync
 	ResyncInfo []byte `protobuf:"bytes,4,opt,name=resync_info,json=resyncInfo,proto3" json:"resync_info,omitempty"`
@@ -95,7 +131,7 @@ func (m *AuthenticationRequest) Reset()         { *m = AuthenticationRequest{} }
 func (m *AuthenticationRequest) String() string { return proto.CompactTextString(m) }
 func (*AuthenticationRequest) ProtoMessage()    {}
 func (*AuthenticationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_swx_proxy_2ee495aa9eae97d6, []int{0}
+	return fileDescriptor_swx_proxy_125d5526c57b787e, []int{0}
 }
 func (m *AuthenticationRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AuthenticationRequest.Unmarshal(m, b)
@@ -166,7 +202,7 @@ func (m *AuthenticationAnswer) Reset()         { *m = AuthenticationAnswer{} }
 func (m *AuthenticationAnswer) String() string { return proto.CompactTextString(m) }
 func (*AuthenticationAnswer) ProtoMessage()    {}
 func (*AuthenticationAnswer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_swx_proxy_2ee495aa9eae97d6, []int{1}
+	return fileDescriptor_swx_proxy_125d5526c57b787e, []int{1}
 }
 func (m *AuthenticationAnswer) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AuthenticationAnswer.Unmarshal(m, b)
@@ -207,15 +243,15 @@ func (m *AuthenticationAnswer) GetUserProfile() *AuthenticationAnswer_UserProfil
 	return nil
 }
 
-// Only for EAP-AKA/EAP-AKA'
+// EAP-AKA/EAP-AKA' quintets or EAP-SIM triplets
 type AuthenticationAnswer_SIPAuthVector struct {
-	// Contains one of EAP-AKA or EAP-AKA'
+	// Contains one of EAP-AKA, EAP-AKA' or EAP-SIM
 	AuthenticationScheme AuthenticationScheme `protobuf:"varint,1,opt,name=authentication_scheme,json=authenticationScheme,proto3,enum=magma.feg.AuthenticationScheme" json:"authentication_scheme,omitempty"`
-	// Concatenation of challenge RAND and token AUTN
+	// Concatenation of challenge RAND and token AUTN (RAND only for EAP-SIM)
 	RandAutn []byte `protobuf:"bytes,2,opt,name=rand_autn,json=randAutn,proto3" json:"rand_autn,omitempty"`
-	// Expected response
+	// Expected response (SRES for EAP-SIM)
 	Xres []byte `protobuf:"bytes,3,opt,name=xres,proto3" json:"xres,omitempty"`
-	// Confidentiality Key
+	// Confidentiality Key (Kc for EAP-SIM)
 	ConfidentialityKey []byte `protobuf:"bytes,4,opt,name=confidentiality_key,json=confidentialityKey,proto3" json:"confidentiality_key,omitempty"`
 	// Integrity Key
 	IntegrityKey         []byte   `protobuf:"bytes,5,opt,name=integrity_key,json=integrityKey,proto3" json:"integrity_key,omitempty"`
@@ -228,7 +264,7 @@ func (m *AuthenticationAnswer_SIPAuthVector) Reset()         { *m = Authenticati
 func (m *AuthenticationAnswer_SIPAuthVector) String() string { return proto.CompactTextString(m) }
 func (*AuthenticationAnswer_SIPAuthVector) ProtoMessage()    {}
 func (*AuthenticationAnswer_SIPAuthVector) Descriptor() ([]byte, []int) {
-	return fileDescriptor_swx_proxy_2ee495aa9eae97d6, []int{1, 0}
+	return fileDescriptor_swx_proxy_125d5526c57b787e, []int{1, 0}
 }
 func (m *AuthenticationAnswer_SIPAuthVector) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AuthenticationAnswer_SIPAuthVector.Unmarshal(m, b)
@@ -295,7 +331,7 @@ func (m *AuthenticationAnswer_UserProfile) Reset()         { *m = Authentication
 func (m *AuthenticationAnswer_UserProfile) String() string { return proto.CompactTextString(m) }
 func (*AuthenticationAnswer_UserProfile) ProtoMessage()    {}
 func (*AuthenticationAnswer_UserProfile) Descriptor() ([]byte, []int) {
-	return fileDescriptor_swx_proxy_2ee495aa9eae97d6, []int{1, 1}
+	return fileDescriptor_swx_proxy_125d5526c57b787e, []int{1, 1}
 }
 func (m *AuthenticationAnswer_UserProfile) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AuthenticationAnswer_UserProfile.Unmarshal(m, b)
@@ -336,7 +372,7 @@ func (m *RegistrationRequest) Reset()         { *m = RegistrationRequest{} }
 func (m *RegistrationRequest) String() string { return proto.CompactTextString(m) }
 func (*RegistrationRequest) ProtoMessage()    {}
 func (*RegistrationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_swx_proxy_2ee495aa9eae97d6, []int{2}
+	return fileDescriptor_swx_proxy_125d5526c57b787e, []int{2}
 }
 func (m *RegistrationRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RegistrationRequest.Unmarshal(m, b)
@@ -375,7 +411,7 @@ func (m *RegistrationAnswer) Reset()         { *m = RegistrationAnswer{} }
 func (m *RegistrationAnswer) String() string { return proto.CompactTextString(m) }
 func (*RegistrationAnswer) ProtoMessage()    {}
 func (*RegistrationAnswer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_swx_proxy_2ee495aa9eae97d6, []int{3}
+	return fileDescriptor_swx_proxy_125d5526c57b787e, []int{3}
 }
 func (m *RegistrationAnswer) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RegistrationAnswer.Unmarshal(m, b)
@@ -395,6 +431,201 @@ func (m *RegistrationAnswer) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RegistrationAnswer proto.InternalMessageInfo
 
+// RegistrationTerminationRequest (Section 8.2.2.2)
+type RegistrationTerminationRequest struct {
+	// Subscriber identifier
+	UserName string `protobuf:"bytes,1,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	// Deregistration-Reason Reason-Code (Section 8.2.3.3)
+	ReasonCode RegistrationTerminationRequest_ReasonCode `protobuf:"varint,2,opt,name=reason_code,json=reasonCode,proto3,enum=magma.feg.RegistrationTerminationRequest_ReasonCode" json:"reason_code,omitempty"`
+	// Deregistration-Reason Reason-Info
+	ReasonInfo           string   `protobuf:"bytes,3,opt,name=reason_info,json=reasonInfo,proto3" json:"reason_info,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RegistrationTerminationRequest) Reset()         { *m = RegistrationTerminationRequest{} }
+func (m *RegistrationTerminationRequest) String() string { return proto.CompactTextString(m) }
+func (*RegistrationTerminationRequest) ProtoMessage()    {}
+func (*RegistrationTerminationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_swx_proxy_125d5526c57b787e, []int{4}
+}
+func (m *RegistrationTerminationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RegistrationTerminationRequest.Unmarshal(m, b)
+}
+func (m *RegistrationTerminationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RegistrationTerminationRequest.Marshal(b, m, deterministic)
+}
+func (dst *RegistrationTerminationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegistrationTerminationRequest.Merge(dst, src)
+}
+func (m *RegistrationTerminationRequest) XXX_Size() int {
+	return xxx_messageInfo_RegistrationTerminationRequest.Size(m)
+}
+func (m *RegistrationTerminationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegistrationTerminationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegistrationTerminationRequest proto.InternalMessageInfo
+
+func (m *RegistrationTerminationRequest) GetUserName() string {
+	if m != nil {
+		return m.UserName
+	}
+	return ""
+}
+
+func (m *RegistrationTerminationRequest) GetReasonCode() RegistrationTerminationRequest_ReasonCode {
+	if m != nil {
+		return m.ReasonCode
+	}
+	return RegistrationTerminationRequest_PERMANENT_TERMINATION
+}
+
+func (m *RegistrationTerminationRequest) GetReasonInfo() string {
+	if m != nil {
+		return m.ReasonInfo
+	}
+	return ""
+}
+
+// RegistrationTerminationAnswer (Section 8.2.2.2)
+type RegistrationTerminationAnswer struct {
+	ErrorCode            SwxErrorCode `protobuf:"varint,1,opt,name=error_code,json=errorCode,proto3,enum=magma.feg.SwxErrorCode" json:"error_code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *RegistrationTerminationAnswer) Reset()         { *m = RegistrationTerminationAnswer{} }
+func (m *RegistrationTerminationAnswer) String() string { return proto.CompactTextString(m) }
+func (*RegistrationTerminationAnswer) ProtoMessage()    {}
+func (*RegistrationTerminationAnswer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_swx_proxy_125d5526c57b787e, []int{5}
+}
+func (m *RegistrationTerminationAnswer) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RegistrationTerminationAnswer.Unmarshal(m, b)
+}
+func (m *RegistrationTerminationAnswer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RegistrationTerminationAnswer.Marshal(b, m, deterministic)
+}
+func (dst *RegistrationTerminationAnswer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegistrationTerminationAnswer.Merge(dst, src)
+}
+func (m *RegistrationTerminationAnswer) XXX_Size() int {
+	return xxx_messageInfo_RegistrationTerminationAnswer.Size(m)
+}
+func (m *RegistrationTerminationAnswer) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegistrationTerminationAnswer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegistrationTerminationAnswer proto.InternalMessageInfo
+
+func (m *RegistrationTerminationAnswer) GetErrorCode() SwxErrorCode {
+	if m != nil {
+		return m.ErrorCode
+	}
+	return SwxErrorCode_ERROR_UNDEFINED
+}
+
+// PushProfileRequest (Section 8.2.2.4)
+type PushProfileRequest struct {
+	// Subscriber identifier
+	UserName string `protobuf:"bytes,1,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	// Non-3GPP-IP-Access of the updated profile, the user's sessions should be
+	// terminated when the access is no longer allowed
+	Non3GppIpAccessAllowed bool `protobuf:"varint,2,opt,name=non3gpp_ip_access_allowed,json=non3gppIpAccessAllowed,proto3" json:"non3gpp_ip_access_allowed,omitempty"`
+	// MSISDN from the updated profile
+	Msisdn               string   `protobuf:"bytes,3,opt,name=msisdn,proto3" json:"msisdn,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PushProfileRequest) Reset()         { *m = PushProfileRequest{} }
+func (m *PushProfileRequest) String() string { return proto.CompactTextString(m) }
+func (*PushProfileRequest) ProtoMessage()    {}
+func (*PushProfileRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_swx_proxy_125d5526c57b787e, []int{6}
+}
+func (m *PushProfileRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PushProfileRequest.Unmarshal(m, b)
+}
+func (m *PushProfileRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PushProfileRequest.Marshal(b, m, deterministic)
+}
+func (dst *PushProfileRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PushProfileRequest.Merge(dst, src)
+}
+func (m *PushProfileRequest) XXX_Size() int {
+	return xxx_messageInfo_PushProfileRequest.Size(m)
+}
+func (m *PushProfileRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PushProfileRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PushProfileRequest proto.InternalMessageInfo
+
+func (m *PushProfileRequest) GetUserName() string {
+	if m != nil {
+		return m.UserName
+	}
+	return ""
+}
+
+func (m *PushProfileRequest) GetNon3GppIpAccessAllowed() bool {
+	if m != nil {
+		return m.Non3GppIpAccessAllowed
+	}
+	return false
+}
+
+func (m *PushProfileRequest) GetMsisdn() string {
+	if m != nil {
+		return m.Msisdn
+	}
+	return ""
+}
+
+// PushProfileAnswer (Section 8.2.2.4)
+type PushProfileAnswer struct {
+	ErrorCode            SwxErrorCode `protobuf:"varint,1,opt,name=error_code,json=errorCode,proto3,enum=magma.feg.SwxErrorCode" json:"error_code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *PushProfileAnswer) Reset()         { *m = PushProfileAnswer{} }
+func (m *PushProfileAnswer) String() string { return proto.CompactTextString(m) }
+func (*PushProfileAnswer) ProtoMessage()    {}
+func (*PushProfileAnswer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_swx_proxy_125d5526c57b787e, []int{7}
+}
+func (m *PushProfileAnswer) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PushProfileAnswer.Unmarshal(m, b)
+}
+func (m *PushProfileAnswer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PushProfileAnswer.Marshal(b, m, deterministic)
+}
+func (dst *PushProfileAnswer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PushProfileAnswer.Merge(dst, src)
+}
+func (m *PushProfileAnswer) XXX_Size() int {
+	return xxx_messageInfo_PushProfileAnswer.Size(m)
+}
+func (m *PushProfileAnswer) XXX_DiscardUnknown() {
+	xxx_messageInfo_PushProfileAnswer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PushProfileAnswer proto.InternalMessageInfo
+
+func (m *PushProfileAnswer) GetErrorCode() SwxErrorCode {
+	if m != nil {
+		return m.ErrorCode
+	}
+	return SwxErrorCode_ERROR_UNDEFINED
+}
+
 func init() {
 	proto.RegisterType((*AuthenticationRequest)(nil), "magma.feg.AuthenticationRequest")
 	proto.RegisterType((*AuthenticationAnswer)(nil), "magma.feg.AuthenticationAnswer")
@@ -402,8 +633,13 @@ func init() {
 	proto.RegisterType((*AuthenticationAnswer_UserProfile)(nil), "magma.feg.AuthenticationAnswer.UserProfile")
 	proto.RegisterType((*RegistrationRequest)(nil), "magma.feg.RegistrationRequest")
 	proto.RegisterType((*RegistrationAnswer)(nil), "magma.feg.RegistrationAnswer")
+	proto.RegisterType((*RegistrationTerminationRequest)(nil), "magma.feg.RegistrationTerminationRequest")
+	proto.RegisterType((*RegistrationTerminationAnswer)(nil), "magma.feg.RegistrationTerminationAnswer")
+	proto.RegisterType((*PushProfileRequest)(nil), "magma.feg.PushProfileRequest")
+	proto.RegisterType((*PushProfileAnswer)(nil), "magma.feg.PushProfileAnswer")
 	proto.RegisterEnum("magma.feg.SwxErrorCode", SwxErrorCode_name, SwxErrorCode_value)
 	proto.RegisterEnum("magma.feg.AuthenticationScheme", AuthenticationScheme_name, AuthenticationScheme_value)
+	proto.RegisterEnum("magma.feg.RegistrationTerminationRequest_ReasonCode", RegistrationTerminationRequest_ReasonCode_name, RegistrationTerminationRequest_ReasonCode_value)
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -550,51 +786,169 @@ var _SwxProxy_serviceDesc = grpc.ServiceDesc{
 	Metadata: "feg/protos/swx_proxy.proto",
 }
 
+// SwxGatewayServiceClient is the client API for SwxGatewayService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type SwxGatewayServiceClient interface {
+	// Registration-Termination (Code 304), the user's non-3GPP sessions should be terminated
+	RegistrationTermination(ctx context.Context, in *RegistrationTerminationRequest, opts ...grpc.CallOption) (*RegistrationTerminationAnswer, error)
+	// Push-Profile (Code 305), the user's profile was changed on the HSS
+	PushProfile(ctx context.Context, in *PushProfileRequest, opts ...grpc.CallOption) (*PushProfileAnswer, error)
+}
+
+type swxGatewayServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewSwxGatewayServiceClient(cc *grpc.ClientConn) SwxGatewayServiceClient {
+	return &swxGatewayServiceClient{cc}
+}
+
+func (c *swxGatewayServiceClient) RegistrationTermination(ctx context.Context, in *RegistrationTerminationRequest, opts ...grpc.CallOption) (*RegistrationTerminationAnswer, error) {
+	out := new(RegistrationTerminationAnswer)
+	err := c.cc.Invoke(ctx, "/magma.feg.SwxGatewayService/RegistrationTermination", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *swxGatewayServiceClient) PushProfile(ctx context.Context, in *PushProfileRequest, opts ...grpc.CallOption) (*PushProfileAnswer, error) {
+	out := new(PushProfileAnswer)
+	err := c.cc.Invoke(ctx, "/magma.feg.SwxGatewayService/PushProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SwxGatewayServiceServer is the server API for SwxGatewayService service.
+type SwxGatewayServiceServer interface {
+	// Registration-Termination (Code 304), the user's non-3GPP sessions should be terminated
+	RegistrationTermination(context.Context, *RegistrationTerminationRequest) (*RegistrationTerminationAnswer, error)
+	// Push-Profile (Code 305), the user's profile was changed on the HSS
+	PushProfile(context.Context, *PushProfileRequest) (*PushProfileAnswer, error)
+}
+
+func RegisterSwxGatewayServiceServer(s *grpc.Server, srv SwxGatewayServiceServer) {
+	s.RegisterService(&_SwxGatewayService_serviceDesc, srv)
+}
+
+func _SwxGatewayService_RegistrationTermination_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegistrationTerminationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwxGatewayServiceServer).RegistrationTermination(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.feg.SwxGatewayService/RegistrationTermination",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwxGatewayServiceServer).RegistrationTermination(ctx, req.(*RegistrationTerminationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SwxGatewayService_PushProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PushProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SwxGatewayServiceServer).PushProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.feg.SwxGatewayService/PushProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SwxGatewayServiceServer).PushProfile(ctx, req.(*PushProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _SwxGatewayService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "magma.feg.SwxGatewayService",
+	HandlerType: (*SwxGatewayServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RegistrationTermination",
+			Handler:    _SwxGatewayService_RegistrationTermination_Handler,
+		},
+		{
+			MethodName: "PushProfile",
+			Handler:    _SwxGatewayService_PushProfile_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "feg/protos/swx_proxy.proto",
+}
+
 func init() {
-	proto.RegisterFile("feg/protos/swx_proxy.proto", fileDescriptor_swx_proxy_2ee495aa9eae97d6)
-}
-
-var fileDescriptor_swx_proxy_2ee495aa9eae97d6 = []byte{
-	// 641 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xcf, 0x6e, 0xda, 0x40,
-	0x10, 0xc6, 0xe3, 0xfc, 0x2b, 0x0c, 0x90, 0x92, 0x85, 0x54, 0x14, 0x94, 0x06, 0x51, 0x55, 0x45,
-	0xa9, 0x0a, 0x12, 0x91, 0x7a, 0x77, 0x82, 0x1b, 0x59, 0x34, 0xc6, 0x5a, 0x43, 0xab, 0xf4, 0xb2,
-	0x72, 0x61, 0x20, 0xab, 0xc6, 0x6b, 0xba, 0x6b, 0x07, 0x78, 0x88, 0x4a, 0x7d, 0xae, 0x3e, 0x4b,
-	0x4f, 0xbd, 0xf6, 0x52, 0xd9, 0x90, 0x04, 0xa2, 0xd0, 0x54, 0x6a, 0x4f, 0xf6, 0x7e, 0x33, 0xfe,
-	0x76, 0xe6, 0x37, 0xeb, 0x85, 0xe2, 0x00, 0x87, 0xf5, 0x91, 0xf4, 0x03, 0x5f, 0xd5, 0xd5, 0x78,
-	0xc2, 0x46, 0xd2, 0x9f, 0x4c, 0x6b, 0xb1, 0x40, 0x92, 0x9e, 0x3b, 0xf4, 0xdc, 0xda, 0x00, 0x87,
-	0x95, 0x6f, 0xeb, 0xb0, 0xa7, 0x87, 0xc1, 0x05, 0x8a, 0x80, 0xf7, 0xdc, 0x80, 0xfb, 0x82, 0xe2,
-	0x97, 0x10, 0x55, 0x40, 0x4a, 0x90, 0x0c, 0x15, 0x4a, 0x26, 0x5c, 0x0f, 0x0b, 0x5a, 0x59, 0xab,
-	0x26, 0x69, 0x22, 0x12, 0x2c, 0xd7, 0x43, 0x52, 0x87, 0xbc, 0xe2, 0x23, 0x26, 0x42, 0x8f, 0xb9,
-	0x61, 0x70, 0xc1, 0xae, 0xb0, 0x17, 0xf8, 0x52, 0x15, 0xd6, 0xcb, 0x5a, 0x35, 0x43, 0x77, 0x15,
-	0x1f, 0x59, 0xa1, 0x17, 0xf9, 0xbe, 0x9f, 0x05, 0x48, 0x07, 0xf6, 0xdc, 0xa5, 0x6d, 0x98, 0xea,
-	0x5d, 0xa0, 0x87, 0x85, 0x8d, 0xb2, 0x56, 0xdd, 0x69, 0x1c, 0xd4, 0x6e, 0x4a, 0xaa, 0x2d, 0x97,
-	0xe3, 0xc4, 0x69, 0x34, 0xef, 0xde, 0xa3, 0x92, 0x03, 0x48, 0x49, 0x54, 0x53, 0xd1, 0x63, 0x5c,
-	0x0c, 0xfc, 0xc2, 0x66, 0x59, 0xab, 0xa6, 0x29, 0xcc, 0x24, 0x53, 0x0c, 0x7c, 0xd2, 0x80, 0x3d,
-	0x89, 0x81, 0xe4, 0x78, 0x85, 0x2c, 0xee, 0x66, 0x24, 0xfd, 0x01, 0xbf, 0xc4, 0xc2, 0x56, 0x59,
-	0xab, 0x26, 0x68, 0xee, 0x3a, 0xd8, 0x55, 0x28, 0xed, 0x59, 0xa8, 0xf2, 0x73, 0x03, 0xf2, 0xcb,
-	0x35, 0xe8, 0x42, 0x8d, 0x51, 0xfe, 0x99, 0xc8, 0x07, 0xc8, 0x46, 0x44, 0xee, 0xd0, 0xd8, 0xa8,
-	0xa6, 0x1a, 0xaf, 0x57, 0xf6, 0x36, 0xf3, 0xad, 0x39, 0xa6, 0x7d, 0x8b, 0x8a, 0xee, 0x28, 0x3e,
-	0x5a, 0x24, 0x67, 0x41, 0x7a, 0xa9, 0xf2, 0x08, 0x58, 0xaa, 0xf1, 0xea, 0x21, 0xd3, 0x85, 0x8e,
-	0x68, 0x2a, 0xbc, 0x5d, 0x14, 0x7f, 0x68, 0x90, 0x59, 0xda, 0x71, 0xf5, 0x6c, 0xb4, 0x7f, 0x99,
-	0x4d, 0x09, 0x92, 0xd2, 0x15, 0xfd, 0x88, 0x88, 0x88, 0xcf, 0x45, 0x9a, 0x26, 0x22, 0x41, 0x0f,
-	0x03, 0x41, 0x08, 0x6c, 0x4e, 0x24, 0xaa, 0xb8, 0x99, 0x34, 0x8d, 0xdf, 0x49, 0x1d, 0x72, 0x3d,
-	0x5f, 0x0c, 0x78, 0x3f, 0xb2, 0x72, 0x2f, 0x79, 0x30, 0x65, 0x9f, 0x71, 0x3a, 0x1f, 0x2a, 0xb9,
-	0x13, 0x6a, 0xe1, 0x94, 0x3c, 0x87, 0x0c, 0x17, 0x01, 0x0e, 0xe5, 0x75, 0xea, 0x56, 0x9c, 0x9a,
-	0xbe, 0x11, 0x5b, 0x38, 0x2d, 0xbe, 0x80, 0xd4, 0x02, 0x0a, 0xf2, 0x04, 0xb6, 0x3d, 0xc5, 0x55,
-	0x5f, 0xcc, 0x07, 0x38, 0x5f, 0x55, 0x1a, 0x90, 0xa3, 0x38, 0xe4, 0x2a, 0x90, 0x7f, 0xfd, 0x13,
-	0x54, 0xf2, 0x40, 0x16, 0xbf, 0x99, 0x81, 0x3f, 0xe4, 0x90, 0x76, 0xc6, 0x13, 0x43, 0x4a, 0x5f,
-	0x9e, 0xf8, 0x7d, 0x24, 0x39, 0x78, 0x6c, 0x50, 0xda, 0xa6, 0xac, 0x6b, 0x35, 0x8d, 0xb7, 0xa6,
-	0x65, 0x34, 0xb3, 0x6b, 0xa4, 0x0c, 0x25, 0xb3, 0x69, 0x58, 0x1d, 0xb3, 0x73, 0xce, 0xf4, 0x77,
-	0xd4, 0xd0, 0x9b, 0xe7, 0x8c, 0x1a, 0xa7, 0xa6, 0xd3, 0x31, 0xa8, 0xd1, 0xcc, 0x7e, 0x7d, 0x49,
-	0x2a, 0xb0, 0xdf, 0x75, 0x0c, 0xca, 0xac, 0x36, 0xb3, 0xda, 0x16, 0x3b, 0x3a, 0xb5, 0x6d, 0xe6,
-	0x74, 0x8f, 0x9d, 0x13, 0x6a, 0xda, 0x1d, 0xb3, 0x6d, 0x65, 0xbf, 0x1f, 0x1e, 0xbe, 0xb9, 0x7b,
-	0x50, 0xe7, 0xe8, 0x53, 0xf0, 0xc8, 0xd0, 0x6d, 0xa6, 0xb7, 0xf4, 0xec, 0x1a, 0xd9, 0x85, 0xcc,
-	0x7c, 0xc1, 0x6c, 0x6a, 0x9e, 0x19, 0x59, 0xad, 0xf1, 0x4b, 0x83, 0x84, 0x33, 0x9e, 0xd8, 0xd1,
-	0x95, 0x40, 0x1c, 0x48, 0x2f, 0x98, 0x20, 0x29, 0xaf, 0x1c, 0xf7, 0x1c, 0x4a, 0xf1, 0xe0, 0x81,
-	0xb3, 0x57, 0x59, 0x23, 0x2d, 0x48, 0xcc, 0xd0, 0xa0, 0x24, 0xcf, 0x16, 0xd2, 0xef, 0x61, 0x5c,
-	0xdc, 0x5f, 0x11, 0xbf, 0x31, 0x3b, 0x03, 0x68, 0xa2, 0xfc, 0x5f, 0x76, 0xc7, 0xa5, 0x8f, 0x4f,
-	0xe3, 0x8c, 0x7a, 0x74, 0x43, 0xf6, 0x2e, 0xfd, 0xb0, 0x5f, 0x1f, 0xfa, 0xf3, 0xab, 0xf2, 0xd3,
-	0x76, 0xfc, 0x3c, 0xfa, 0x1d, 0x00, 0x00, 0xff, 0xff, 0xa7, 0x6a, 0xf1, 0xd6, 0x3f, 0x05, 0x00,
-	0x00,
+	proto.RegisterFile("feg/protos/swx_proxy.proto", fileDescriptor_swx_proxy_125d5526c57b787e)
+}
+
+var fileDescriptor_swx_proxy_125d5526c57b787e = []byte{
+	// 928 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x41, 0x6f, 0xe2, 0x46,
+	0x14, 0x8e, 0xc3, 0xee, 0x96, 0x3c, 0x48, 0x4a, 0x26, 0xc9, 0x26, 0x21, 0xcd, 0x06, 0xb9, 0xaa,
+	0x96, 0xa6, 0x2a, 0x48, 0xa4, 0xaa, 0xd4, 0xa3, 0x17, 0x66, 0xa9, 0xc5, 0x62, 0xac, 0x31, 0x24,
+	0xda, 0x5e, 0x46, 0xae, 0x19, 0x88, 0x55, 0xb0, 0xdd, 0x19, 0x3b, 0x80, 0xd4, 0x63, 0x2f, 0x3d,
+	0x54, 0xea, 0x1f, 0xe8, 0x1f, 0xea, 0xb5, 0x7f, 0xa3, 0xa7, 0x5e, 0x7b, 0xa9, 0x3c, 0x36, 0x89,
+	0x49, 0x97, 0x4d, 0xa4, 0xed, 0x89, 0x99, 0xf7, 0xde, 0x7c, 0xf3, 0xde, 0xf7, 0xde, 0x37, 0x18,
+	0xca, 0x23, 0x36, 0xae, 0x07, 0xdc, 0x0f, 0x7d, 0x51, 0x17, 0xb3, 0x39, 0x0d, 0xb8, 0x3f, 0x5f,
+	0xd4, 0xa4, 0x01, 0x6d, 0x4d, 0xed, 0xf1, 0xd4, 0xae, 0x8d, 0xd8, 0x58, 0xfd, 0x6d, 0x13, 0x0e,
+	0xb4, 0x28, 0xbc, 0x66, 0x5e, 0xe8, 0x3a, 0x76, 0xe8, 0xfa, 0x1e, 0x61, 0x3f, 0x46, 0x4c, 0x84,
+	0xe8, 0x04, 0xb6, 0x22, 0xc1, 0x38, 0xf5, 0xec, 0x29, 0x3b, 0x52, 0x2a, 0x4a, 0x75, 0x8b, 0xe4,
+	0x63, 0x83, 0x61, 0x4f, 0x19, 0xaa, 0xc3, 0xbe, 0x70, 0x03, 0xea, 0x45, 0x53, 0x6a, 0x47, 0xe1,
+	0x35, 0xbd, 0x61, 0x4e, 0xe8, 0x73, 0x71, 0xb4, 0x59, 0x51, 0xaa, 0xdb, 0x64, 0x57, 0xb8, 0x81,
+	0x11, 0x4d, 0x63, 0xdc, 0xcb, 0xc4, 0x81, 0xfa, 0x70, 0x60, 0xaf, 0x5c, 0x43, 0x85, 0x73, 0xcd,
+	0xa6, 0xec, 0x28, 0x57, 0x51, 0xaa, 0x3b, 0x8d, 0xb3, 0xda, 0x6d, 0x4a, 0xb5, 0xd5, 0x74, 0x2c,
+	0x19, 0x46, 0xf6, 0xed, 0x77, 0x58, 0xd1, 0x19, 0x14, 0x38, 0x13, 0x0b, 0xcf, 0xa1, 0xae, 0x37,
+	0xf2, 0x8f, 0x9e, 0x54, 0x94, 0x6a, 0x91, 0x40, 0x62, 0xd2, 0xbd, 0x91, 0x8f, 0x1a, 0x70, 0xc0,
+	0x59, 0xc8, 0x5d, 0x76, 0xc3, 0xa8, 0xac, 0x26, 0xe0, 0xfe, 0xc8, 0x9d, 0xb0, 0xa3, 0xa7, 0x15,
+	0xa5, 0x9a, 0x27, 0x7b, 0x4b, 0xe7, 0x40, 0x30, 0x6e, 0x26, 0x2e, 0xf5, 0xef, 0x1c, 0xec, 0xaf,
+	0xe6, 0xa0, 0x79, 0x62, 0xc6, 0xf8, 0xfb, 0x19, 0xb9, 0x82, 0x52, 0xcc, 0xc8, 0x3d, 0x36, 0x72,
+	0xd5, 0x42, 0xe3, 0xcb, 0xb5, 0xb5, 0x25, 0xb8, 0x35, 0x4b, 0x37, 0xef, 0xa8, 0x22, 0x3b, 0xc2,
+	0x0d, 0xb2, 0xcc, 0x19, 0x50, 0x5c, 0xc9, 0x3c, 0x26, 0xac, 0xd0, 0xf8, 0xe2, 0x21, 0xd0, 0x4c,
+	0x45, 0xa4, 0x10, 0xdd, 0x6d, 0xca, 0x7f, 0x29, 0xb0, 0xbd, 0x72, 0xe3, 0xfa, 0xde, 0x28, 0x1f,
+	0xd2, 0x9b, 0x13, 0xd8, 0xe2, 0xb6, 0x37, 0x8c, 0x19, 0xf1, 0xe4, 0x5c, 0x14, 0x49, 0x3e, 0x36,
+	0x68, 0x51, 0xe8, 0x21, 0x04, 0x4f, 0xe6, 0x9c, 0x09, 0x59, 0x4c, 0x91, 0xc8, 0x35, 0xaa, 0xc3,
+	0x9e, 0xe3, 0x7b, 0x23, 0x77, 0x18, 0x43, 0xd9, 0x13, 0x37, 0x5c, 0xd0, 0x1f, 0xd8, 0x22, 0x6d,
+	0x2a, 0xba, 0xe7, 0xea, 0xb0, 0x05, 0xfa, 0x14, 0xb6, 0x5d, 0x2f, 0x64, 0x63, 0xbe, 0x0c, 0x7d,
+	0x2a, 0x43, 0x8b, 0xb7, 0xc6, 0x0e, 0x5b, 0x94, 0x3f, 0x83, 0x42, 0x86, 0x0a, 0xf4, 0x1c, 0x9e,
+	0x4d, 0x85, 0x2b, 0x86, 0x5e, 0xda, 0xc0, 0x74, 0xa7, 0x36, 0x60, 0x8f, 0xb0, 0xb1, 0x2b, 0x42,
+	0xfe, 0x68, 0x11, 0xa8, 0xfb, 0x80, 0xb2, 0x67, 0x12, 0xe2, 0xd5, 0xdf, 0x37, 0xe1, 0x45, 0xd6,
+	0xdc, 0x67, 0x7c, 0xea, 0x7a, 0x8f, 0x97, 0xd6, 0x20, 0x9e, 0x69, 0x5b, 0xf8, 0x1e, 0x75, 0xfc,
+	0x21, 0x93, 0xcc, 0xed, 0x34, 0xbe, 0xca, 0xf4, 0xe0, 0xfd, 0xe0, 0x35, 0x22, 0x0f, 0x37, 0xfd,
+	0x21, 0x8b, 0x95, 0xb0, 0x5c, 0x27, 0x52, 0x91, 0xb0, 0x52, 0x2a, 0x39, 0x79, 0x6b, 0x1a, 0x10,
+	0x4b, 0x45, 0x1d, 0x01, 0xdc, 0x1d, 0x45, 0xc7, 0x70, 0x60, 0x62, 0xd2, 0xd5, 0x0c, 0x6c, 0xf4,
+	0x69, 0x1f, 0x93, 0xae, 0x6e, 0x68, 0x7d, 0xbd, 0x67, 0x94, 0x36, 0xd0, 0x21, 0xec, 0x19, 0xf8,
+	0x8a, 0x5a, 0x98, 0x5c, 0x62, 0x42, 0x35, 0xcb, 0xd2, 0xdb, 0x06, 0x6e, 0x95, 0x14, 0xb4, 0x0b,
+	0xdb, 0xa9, 0xb1, 0xf9, 0xad, 0x66, 0xb4, 0x71, 0x69, 0x33, 0x36, 0x11, 0xdc, 0xed, 0x5d, 0x62,
+	0x6a, 0xd1, 0xa6, 0xd5, 0x7c, 0x5d, 0xca, 0xa9, 0x57, 0x70, 0xba, 0xa6, 0x82, 0x54, 0x66, 0x5f,
+	0x03, 0x30, 0xce, 0x7d, 0x9e, 0xd4, 0x9f, 0xcc, 0xe0, 0x61, 0xa6, 0x7e, 0x6b, 0x36, 0xc7, 0xb1,
+	0x5f, 0x96, 0xb8, 0xc5, 0x96, 0x4b, 0xf5, 0x67, 0x05, 0x90, 0x19, 0x89, 0xeb, 0xe5, 0xd4, 0x3f,
+	0x86, 0xec, 0x6f, 0xe0, 0xd8, 0xf3, 0xbd, 0x8b, 0x71, 0x10, 0xd0, 0x58, 0xbc, 0x8e, 0xc3, 0x84,
+	0xa0, 0xf6, 0x64, 0xe2, 0xcf, 0xd8, 0x50, 0x52, 0x9f, 0x27, 0xcf, 0xd3, 0x00, 0x3d, 0xd0, 0xa4,
+	0x5b, 0x4b, 0xbc, 0x99, 0x49, 0xca, 0xad, 0x4c, 0x52, 0x07, 0x76, 0x33, 0x59, 0x7c, 0x58, 0x4d,
+	0xe7, 0x3f, 0x41, 0x31, 0xeb, 0x42, 0x7b, 0xf0, 0x31, 0x26, 0xa4, 0x47, 0xe8, 0xc0, 0x68, 0xe1,
+	0xd7, 0x7a, 0xcc, 0xfb, 0x06, 0xda, 0x85, 0xe2, 0xc0, 0xc2, 0xb1, 0xad, 0x63, 0xf4, 0xae, 0x8c,
+	0xd2, 0x2f, 0x2f, 0x51, 0x05, 0x4e, 0xf4, 0x16, 0x36, 0xfa, 0x7a, 0xff, 0x2d, 0xd5, 0xde, 0x10,
+	0xac, 0xb5, 0xde, 0x52, 0x82, 0xdb, 0xba, 0xd5, 0xc7, 0x04, 0xb7, 0x4a, 0xbf, 0xbe, 0x44, 0x2a,
+	0x9c, 0xca, 0x43, 0x46, 0x8f, 0x1a, 0x3d, 0x83, 0x5e, 0xb4, 0x4d, 0x93, 0x5a, 0x83, 0x57, 0x56,
+	0x93, 0xe8, 0xa6, 0x6c, 0xf4, 0x1f, 0xe7, 0xe7, 0xcd, 0xfb, 0x0f, 0x61, 0x2a, 0xed, 0x02, 0x7c,
+	0x84, 0x35, 0x93, 0x6a, 0x1d, 0x4d, 0xde, 0xbe, 0x9d, 0x6e, 0xa8, 0x49, 0xf4, 0x2e, 0x2e, 0x29,
+	0x4b, 0xbf, 0xa5, 0x77, 0x4b, 0x9b, 0x8d, 0x7f, 0x14, 0xc8, 0x5b, 0xb3, 0xb9, 0x19, 0xff, 0xff,
+	0x20, 0x0b, 0x8a, 0x19, 0x44, 0x86, 0x2a, 0x6b, 0xdf, 0x96, 0xb4, 0x7d, 0xe5, 0xb3, 0x07, 0x1e,
+	0x3a, 0x75, 0x03, 0x75, 0x20, 0x9f, 0x4c, 0x14, 0xe3, 0xe8, 0xc5, 0x1a, 0xa1, 0x2c, 0xe1, 0x4e,
+	0xd7, 0xf8, 0x6f, 0xc1, 0xba, 0x00, 0x2d, 0xc6, 0xff, 0x2f, 0xb8, 0xc6, 0x9f, 0x0a, 0xec, 0x5a,
+	0xb3, 0x79, 0xdb, 0x0e, 0xd9, 0xcc, 0x5e, 0x58, 0x8c, 0xdf, 0xb8, 0x0e, 0x43, 0x01, 0x1c, 0xae,
+	0xd1, 0x00, 0xfa, 0xfc, 0xd1, 0x4a, 0x2f, 0x57, 0x1f, 0x0e, 0xbd, 0x2d, 0xeb, 0x0d, 0x14, 0x32,
+	0x53, 0x89, 0xb2, 0x79, 0xff, 0x57, 0x33, 0xe5, 0x4f, 0xde, 0xed, 0x5e, 0xa2, 0xbd, 0x3a, 0xf9,
+	0xee, 0x58, 0x06, 0xd4, 0xe3, 0x8f, 0x0c, 0x67, 0xe2, 0x47, 0xc3, 0xfa, 0xd8, 0x4f, 0xbf, 0x36,
+	0xbe, 0x7f, 0x26, 0x7f, 0x2f, 0xfe, 0x1d, 0x00, 0x9e, 0x9f, 0x68, 0xe5, 0x82, 0x08, 0x00, 0x00,
 }
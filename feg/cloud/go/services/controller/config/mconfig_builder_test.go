@@ -143,6 +143,19 @@ func TestControllerBuilder_Build(t *testing.T) {
 	defaultGwCfg.(*config_protos.Config).Gy.Server.DestRealm = "mno.com"
 	defaultGwCfg.(*config_protos.Config).Gx.Server.DestHost = "pcrf.mno.com"
 	defaultGwCfg.(*config_protos.Config).Gx.Server.DestRealm = "mno.com"
+	defaultGwCfg.(*config_protos.Config).Gx.Server.AlternateServers = []*config_protos.DiamServerConfig{
+		{Protocol: "tcp", Address: "pcrf2.mno.com:3868", DestHost: "pcrf2.mno.com", DestRealm: "mno.com", Weight: 2},
+	}
+	defaultGwCfg.(*config_protos.Config).Gx.Server.PeerSelection = config_protos.PeerSelection_ROUND_ROBIN
+	defaultGwCfg.(*config_protos.Config).Gx.Server.SessionFailover = true
+	defaultGwCfg.(*config_protos.Config).S6A.Server.RealmRoutes = []*config_protos.DiamRealmRoute{
+		{
+			Realm:   "*",
+			Action:  config_protos.DiamRealmRoute_RELAY,
+			Peers:   []*config_protos.DiamServerConfig{{Protocol: "sctp", Address: "dea.ipx.org:3868"}},
+			PlmnIds: []string{"00102"},
+		},
+	}
 	defaultGwCfg.(*config_protos.Config).Swx.Server.Address = "127.0.0.1:9999"
 	defaultGwCfg.(*config_protos.Config).Swx.Server.LocalAddress = ":12123"
 	defaultGwCfg.(*config_protos.Config).Health.UpdateFailureThreshold = 4
@@ -154,6 +167,19 @@ func TestControllerBuilder_Build(t *testing.T) {
 	expected["session_proxy"].(*mconfig.SessionProxyConfig).Gy.Server.DestRealm = "mno.com"
 	expected["session_proxy"].(*mconfig.SessionProxyConfig).Gx.Server.DestHost = "pcrf.mno.com"
 	expected["session_proxy"].(*mconfig.SessionProxyConfig).Gx.Server.DestRealm = "mno.com"
+	expected["session_proxy"].(*mconfig.SessionProxyConfig).Gx.Server.AlternateServers = []*mconfig.DiamServerConfig{
+		{Protocol: "tcp", Address: "pcrf2.mno.com:3868", DestHost: "pcrf2.mno.com", DestRealm: "mno.com", Weight: 2},
+	}
+	expected["session_proxy"].(*mconfig.SessionProxyConfig).Gx.Server.PeerSelection = mconfig.PeerSelection_ROUND_ROBIN
+	expected["session_proxy"].(*mconfig.SessionProxyConfig).Gx.Server.SessionFailover = true
+	expected["s6a_proxy"].(*mconfig.S6AConfig).Server.RealmRoutes = []*mconfig.DiamRealmRoute{
+		{
+			Realm:   "*",
+			Action:  mconfig.DiamRealmRoute_RELAY,
+			Peers:   []*mconfig.DiamServerConfig{{Protocol: "sctp", Address: "dea.ipx.org:3868"}},
+			PlmnIds: []string{"00102"},
+		},
+	}
 	expected["swx_proxy"].(*mconfig.SwxConfig).Server.Address = "127.0.0.1:9999"
 	expected["swx_proxy"].(*mconfig.SwxConfig).Server.LocalAddress = ":12123"
 	expected["health"].(*mconfig.GatewayHealthConfig).UpdateFailureThreshold = 4
@@ -175,6 +201,10 @@ func TestControllerBuilder_Build(t *testing.T) {
 	expected["session_proxy"].(*mconfig.SessionProxyConfig).Gy.Server.DestRealm = ""
 	expected["session_proxy"].(*mconfig.SessionProxyConfig).Gx.Server.DestHost = ""
 	expected["session_proxy"].(*mconfig.SessionProxyConfig).Gx.Server.DestRealm = ""
+	expected["session_proxy"].(*mconfig.SessionProxyConfig).Gx.Server.AlternateServers = nil
+	expected["session_proxy"].(*mconfig.SessionProxyConfig).Gx.Server.PeerSelection = mconfig.PeerSelection_FAILOVER
+	expected["session_proxy"].(*mconfig.SessionProxyConfig).Gx.Server.SessionFailover = false
+	expected["s6a_proxy"].(*mconfig.S6AConfig).Server.RealmRoutes = nil
 	expected["swx_proxy"].(*mconfig.SwxConfig).Server.Address = ""
 	expected["swx_proxy"].(*mconfig.SwxConfig).Server.LocalAddress = ""
 	expected["health"].(*mconfig.GatewayHealthConfig).UpdateFailureThreshold = 3
@@ -66,10 +66,32 @@ func TestSetNetworkConfigs(t *testing.T) {
 	config := feg_protos.NewDefaultNetworkConfig()
 	config.S6A.Server.Address = "192.168.11.22:555"
 	config.Gx.Server.DestHost = "pcrf.mno.com"
+	config.Gx.Server.AlternateServers = []*feg_protos.DiamServerConfig{
+		{Protocol: "tcp", Address: "pcrf2.mno.com:3868", DestHost: "pcrf2.mno.com", Weight: 2},
+	}
+	config.Gx.Server.PeerSelection = feg_protos.PeerSelection_ROUND_ROBIN
+	config.Gx.Server.SessionFailover = true
+	config.S6A.Server.RealmRoutes = []*feg_protos.DiamRealmRoute{
+		{Realm: "partner.org", Action: feg_protos.DiamRealmRoute_LOCAL, PlmnIds: []string{"00102"}},
+		{
+			Realm:         "*",
+			Action:        feg_protos.DiamRealmRoute_RELAY,
+			Peers:         []*feg_protos.DiamServerConfig{{Protocol: "sctp", Address: "dea.ipx.org:3868"}},
+			PeerSelection: feg_protos.PeerSelection_ROUND_ROBIN,
+		},
+	}
 	config.Gy.Server.DestHost = "ocs.mno.com"
 	config.ServedNetworkIds = []string{"lte_network_A", "lte_network_B"}
 	swaggerConfig := &models.NetworkFederationConfigs{}
 	swaggerConfig.FromServiceModel(config)
+	assert.Equal(t, models.DiameterClientConfigsPeerSelectionRoundRobin, swaggerConfig.Gx.Server.PeerSelection)
+	assert.Equal(t, "pcrf2.mno.com:3868", swaggerConfig.Gx.Server.AlternateServers[0].Address)
+	assert.Equal(t, models.DiameterClientConfigsPeerSelectionFailover, swaggerConfig.Gy.Server.PeerSelection)
+	roundTrip, err := swaggerConfig.ToServiceModel()
+	assert.NoError(t, err)
+	assert.Equal(t, models.DiameterRealmRouteActionRelay, swaggerConfig.S6a.Server.RealmRoutes[1].Action)
+	assert.Equal(t, config.Gx.Server, roundTrip.(*feg_protos.Config).Gx.Server)
+	assert.Equal(t, config.S6A.Server, roundTrip.(*feg_protos.Config).S6A.Server)
 	assert.Len(t, swaggerConfig.ServedNetworkIds, 2)
 	assert.Subset(t, swaggerConfig.ServedNetworkIds, config.ServedNetworkIds)
 	marshaledCfg, err := swaggerConfig.MarshalBinary()
@@ -12,6 +12,7 @@ package models
 import (
 	"fmt"
 	"reflect"
+	"strings"
 
 	"github.com/go-openapi/strfmt"
 
@@ -50,6 +51,18 @@ func (m *NetworkFederationConfigs) ToServiceModel() (interface{}, error) {
 	protos.FillIn(m.Swx, magmadConfig.Swx)
 	protos.FillIn(m.Health, magmadConfig.Health)
 	protos.FillIn(m.EapAka, magmadConfig.EapAka)
+	if m.S6a != nil {
+		diamClientConfigsToProto(m.S6a.Server, magmadConfig.S6A.Server)
+	}
+	if m.Gx != nil {
+		diamClientConfigsToProto(m.Gx.Server, magmadConfig.Gx.Server)
+	}
+	if m.Gy != nil {
+		diamClientConfigsToProto(m.Gy.Server, magmadConfig.Gy.Server)
+	}
+	if m.Swx != nil {
+		diamClientConfigsToProto(m.Swx.Server, magmadConfig.Swx.Server)
+	}
 	if err := fegprotos.ValidateNetworkConfig(magmadConfig); err != nil {
 		return nil, err
 	}
@@ -115,6 +128,10 @@ func (m *NetworkFederationConfigs) FromServiceModel(magmadModel interface{}) err
 	protos.FillIn(magmadConfig.Swx, m.Swx)
 	protos.FillIn(magmadConfig.Health, m.Health)
 	protos.FillIn(magmadConfig.EapAka, m.EapAka)
+	diamClientConfigsFromProto(magmadConfig.GetS6A().GetServer(), m.S6a.Server)
+	diamClientConfigsFromProto(magmadConfig.GetGx().GetServer(), m.Gx.Server)
+	diamClientConfigsFromProto(magmadConfig.GetGy().GetServer(), m.Gy.Server)
+	diamClientConfigsFromProto(magmadConfig.GetSwx().GetServer(), m.Swx.Server)
 	if m.ServedNetworkIds == nil {
 		m.ServedNetworkIds = []string{}
 	}
@@ -151,6 +168,18 @@ func (m *GatewayFegConfigs) ToServiceModel() (interface{}, error) {
 	protos.FillIn(m.Swx, magmadConfig.Swx)
 	protos.FillIn(m.Health, magmadConfig.Health)
 	protos.FillIn(m.EapAka, magmadConfig.EapAka)
+	if m.S6a != nil {
+		diamClientConfigsToProto(m.S6a.Server, magmadConfig.S6A.Server)
+	}
+	if m.Gx != nil {
+		diamClientConfigsToProto(m.Gx.Server, magmadConfig.Gx.Server)
+	}
+	if m.Gy != nil {
+		diamClientConfigsToProto(m.Gy.Server, magmadConfig.Gy.Server)
+	}
+	if m.Swx != nil {
+		diamClientConfigsToProto(m.Swx.Server, magmadConfig.Swx.Server)
+	}
 	if err := fegprotos.ValidateGatewayConfig(magmadConfig); err != nil {
 		return nil, err
 	}
@@ -216,8 +245,92 @@ func (m *GatewayFegConfigs) FromServiceModel(magmadModel interface{}) error {
 	protos.FillIn(magmadConfig.Swx, m.Swx)
 	protos.FillIn(magmadConfig.Health, m.Health)
 	protos.FillIn(magmadConfig.EapAka, m.EapAka)
+	diamClientConfigsFromProto(magmadConfig.GetS6A().GetServer(), m.S6a.Server)
+	diamClientConfigsFromProto(magmadConfig.GetGx().GetServer(), m.Gx.Server)
+	diamClientConfigsFromProto(magmadConfig.GetGy().GetServer(), m.Gy.Server)
+	diamClientConfigsFromProto(magmadConfig.GetSwx().GetServer(), m.Swx.Server)
 	if m.ServedNetworkIds == nil {
 		m.ServedNetworkIds = []string{}
 	}
 	return nil
 }
+
+// diamClientConfigsToProto converts the diameter client fields protos.FillIn can't
+// fill in: the lists of alternate servers & realm routes and the enums
+func diamClientConfigsToProto(m *DiameterClientConfigs, config *fegprotos.DiamClientConfig) {
+	if m == nil || config == nil {
+		return
+	}
+	config.AlternateServers = diamServerConfigsToProto(m.AlternateServers)
+	config.PeerSelection = peerSelectionToProto(m.PeerSelection)
+	config.RealmRoutes = nil
+	for _, route := range m.RealmRoutes {
+		if route == nil {
+			continue
+		}
+		protoRoute := &fegprotos.DiamRealmRoute{
+			Realm:         route.Realm,
+			ApplicationId: route.ApplicationID,
+			Action:        fegprotos.DiamRealmRoute_Action(fegprotos.DiamRealmRoute_Action_value[strings.ToUpper(route.Action)]),
+			Peers:         diamServerConfigsToProto(route.Peers),
+			PeerSelection: peerSelectionToProto(route.PeerSelection),
+		}
+		if len(route.PlmnIds) > 0 {
+			protoRoute.PlmnIds = route.PlmnIds
+		}
+		config.RealmRoutes = append(config.RealmRoutes, protoRoute)
+	}
+}
+
+// diamClientConfigsFromProto is the reverse of diamClientConfigsToProto
+func diamClientConfigsFromProto(config *fegprotos.DiamClientConfig, m *DiameterClientConfigs) {
+	if m == nil || config == nil {
+		return
+	}
+	m.AlternateServers = diamServerConfigsFromProto(config.GetAlternateServers())
+	m.PeerSelection = strings.ToLower(config.GetPeerSelection().String())
+	m.RealmRoutes = nil
+	for _, route := range config.GetRealmRoutes() {
+		if route == nil {
+			continue
+		}
+		m.RealmRoutes = append(m.RealmRoutes, &DiameterRealmRoute{
+			Realm:         route.GetRealm(),
+			ApplicationID: route.GetApplicationId(),
+			Action:        strings.ToLower(route.GetAction().String()),
+			Peers:         diamServerConfigsFromProto(route.GetPeers()),
+			PeerSelection: strings.ToLower(route.GetPeerSelection().String()),
+			PlmnIds:       append([]string{}, route.GetPlmnIds()...),
+		})
+	}
+}
+
+func peerSelectionToProto(selection string) fegprotos.PeerSelection {
+	return fegprotos.PeerSelection(fegprotos.PeerSelection_value[strings.ToUpper(selection)])
+}
+
+func diamServerConfigsToProto(servers []*DiameterServerConfigs) []*fegprotos.DiamServerConfig {
+	var res []*fegprotos.DiamServerConfig
+	for _, server := range servers {
+		if server == nil {
+			continue
+		}
+		protoServer := &fegprotos.DiamServerConfig{}
+		protos.FillIn(server, protoServer)
+		res = append(res, protoServer)
+	}
+	return res
+}
+
+func diamServerConfigsFromProto(protoServers []*fegprotos.DiamServerConfig) []*DiameterServerConfigs {
+	var res []*DiameterServerConfigs
+	for _, protoServer := range protoServers {
+		if protoServer == nil {
+			continue
+		}
+		server := &DiameterServerConfigs{}
+		protos.FillIn(protoServer, server)
+		res = append(res, server)
+	}
+	return res
+}
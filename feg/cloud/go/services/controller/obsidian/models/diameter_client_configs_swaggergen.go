@@ -7,6 +7,7 @@ package models
 
 import (
 	"encoding/json"
+	"strconv"
 
 	strfmt "github.com/go-openapi/strfmt"
 
@@ -23,6 +24,9 @@ type DiameterClientConfigs struct {
 	// Pattern: [^\:]+(:[0-9]{1,5})?
 	Address string `json:"address,omitempty"`
 
+	// Ordered list of servers to fail over to when the server is unreachable
+	AlternateServers []*DiameterServerConfigs `json:"alternate_servers"`
+
 	// dest host
 	DestHost string `json:"dest_host,omitempty"`
 
@@ -37,6 +41,10 @@ type DiameterClientConfigs struct {
 	// Pattern: [0-9a-f\:\.]*(:[0-9]{1,5})?
 	LocalAddress string `json:"local_address,omitempty"`
 
+	// How requests are distributed across the server and its alternates
+	// Enum: [failover round_robin]
+	PeerSelection string `json:"peer_selection,omitempty"`
+
 	// product name
 	// Min Length: 1
 	ProductName string `json:"product_name,omitempty"`
@@ -49,12 +57,18 @@ type DiameterClientConfigs struct {
 	// Min Length: 1
 	Realm string `json:"realm,omitempty"`
 
+	// Destination-Realm and Application-Id based routes, requests not matching any route are sent to the server
+	RealmRoutes []*DiameterRealmRoute `json:"realm_routes"`
+
 	// retransmits
 	Retransmits uint32 `json:"retransmits,omitempty"`
 
 	// retry count
 	RetryCount uint32 `json:"retry_count,omitempty"`
 
+	// Move sessions to another server when their server is unreachable
+	SessionFailover bool `json:"session_failover,omitempty"`
+
 	// watchdog interval
 	WatchdogInterval uint32 `json:"watchdog_interval,omitempty"`
 }
@@ -67,6 +81,10 @@ func (m *DiameterClientConfigs) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateAlternateServers(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateHost(formats); err != nil {
 		res = append(res, err)
 	}
@@ -75,6 +93,10 @@ func (m *DiameterClientConfigs) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validatePeerSelection(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateProductName(formats); err != nil {
 		res = append(res, err)
 	}
@@ -87,6 +109,10 @@ func (m *DiameterClientConfigs) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateRealmRoutes(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -106,6 +132,31 @@ func (m *DiameterClientConfigs) validateAddress(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *DiameterClientConfigs) validateAlternateServers(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.AlternateServers) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.AlternateServers); i++ {
+		if swag.IsZero(m.AlternateServers[i]) { // not required
+			continue
+		}
+
+		if m.AlternateServers[i] != nil {
+			if err := m.AlternateServers[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("alternate_servers" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *DiameterClientConfigs) validateHost(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.Host) { // not required
@@ -132,6 +183,49 @@ func (m *DiameterClientConfigs) validateLocalAddress(formats strfmt.Registry) er
 	return nil
 }
 
+var diameterClientConfigsTypePeerSelectionPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["failover","round_robin"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		diameterClientConfigsTypePeerSelectionPropEnum = append(diameterClientConfigsTypePeerSelectionPropEnum, v)
+	}
+}
+
+const (
+
+	// DiameterClientConfigsPeerSelectionFailover captures enum value "failover"
+	DiameterClientConfigsPeerSelectionFailover string = "failover"
+
+	// DiameterClientConfigsPeerSelectionRoundRobin captures enum value "round_robin"
+	DiameterClientConfigsPeerSelectionRoundRobin string = "round_robin"
+)
+
+// prop value enum
+func (m *DiameterClientConfigs) validatePeerSelectionEnum(path, location string, value string) error {
+	if err := validate.Enum(path, location, value, diameterClientConfigsTypePeerSelectionPropEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *DiameterClientConfigs) validatePeerSelection(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.PeerSelection) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validatePeerSelectionEnum("peer_selection", "body", m.PeerSelection); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *DiameterClientConfigs) validateProductName(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.ProductName) { // not required
@@ -213,6 +307,31 @@ func (m *DiameterClientConfigs) validateRealm(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *DiameterClientConfigs) validateRealmRoutes(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.RealmRoutes) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.RealmRoutes); i++ {
+		if swag.IsZero(m.RealmRoutes[i]) { // not required
+			continue
+		}
+
+		if m.RealmRoutes[i] != nil {
+			if err := m.RealmRoutes[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("realm_routes" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *DiameterClientConfigs) MarshalBinary() ([]byte, error) {
 	if m == nil {
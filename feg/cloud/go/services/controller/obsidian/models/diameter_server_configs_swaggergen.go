@@ -36,6 +36,9 @@ type DiameterServerConfigs struct {
 	// protocol
 	// Enum: [tcp tcp4 tcp6 sctp sctp4 sctp6]
 	Protocol string `json:"protocol,omitempty"`
+
+	// Relative weight for round robin peer selection
+	Weight uint32 `json:"weight,omitempty"`
 }
 
 // Validate validates this diameter server configs
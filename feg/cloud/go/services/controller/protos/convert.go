@@ -19,6 +19,7 @@ func (config *DiamServerConfig) ToMconfig() *mconfig.DiamServerConfig {
 		LocalAddress: config.GetLocalAddress(),
 		DestRealm:    config.GetDestRealm(),
 		DestHost:     config.GetDestHost(),
+		Weight:       config.GetWeight(),
 	}
 }
 
@@ -36,9 +37,45 @@ func (config *DiamClientConfig) ToMconfig() *mconfig.DiamClientConfig {
 		Host:             config.GetHost(),
 		DestRealm:        config.GetDestRealm(),
 		DestHost:         config.GetDestHost(),
+		AlternateServers: serversToMconfig(config.GetAlternateServers()),
+		PeerSelection:    mconfig.PeerSelection(config.GetPeerSelection()),
+		RealmRoutes:      realmRoutesToMconfig(config.GetRealmRoutes()),
+		SessionFailover:  config.GetSessionFailover(),
 	}
 }
 
+// ToMconfig copies diameter realm route controller proto to a managed config proto & returns it
+func (route *DiamRealmRoute) ToMconfig() *mconfig.DiamRealmRoute {
+	return &mconfig.DiamRealmRoute{
+		Realm:         route.GetRealm(),
+		ApplicationId: route.GetApplicationId(),
+		Action:        mconfig.DiamRealmRoute_Action(route.GetAction()),
+		Peers:         serversToMconfig(route.GetPeers()),
+		PeerSelection: mconfig.PeerSelection(route.GetPeerSelection()),
+		PlmnIds:       route.GetPlmnIds(),
+	}
+}
+
+func realmRoutesToMconfig(routes []*DiamRealmRoute) []*mconfig.DiamRealmRoute {
+	var res []*mconfig.DiamRealmRoute
+	for _, route := range routes {
+		if route != nil {
+			res = append(res, route.ToMconfig())
+		}
+	}
+	return res
+}
+
+func serversToMconfig(servers []*DiamServerConfig) []*mconfig.DiamServerConfig {
+	var res []*mconfig.DiamServerConfig
+	for _, server := range servers {
+		if server != nil {
+			res = append(res, server.ToMconfig())
+		}
+	}
+	return res
+}
+
 // ToMconfig copies controller subscription profile proto to a a new managed config proto & returns it
 func (profile *HSSConfig_SubscriptionProfile) ToMconfig() *mconfig.HSSConfig_SubscriptionProfile {
 	return &mconfig.HSSConfig_SubscriptionProfile{
@@ -18,6 +18,29 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+type PeerSelection int32
+
+const (
+	PeerSelection_FAILOVER    PeerSelection = 0
+	PeerSelection_ROUND_ROBIN PeerSelection = 1
+)
+
+var PeerSelection_name = map[int32]string{
+	0: "FAILOVER",
+	1: "ROUND_ROBIN",
+}
+var PeerSelection_value = map[string]int32{
+	"FAILOVER":    0,
+	"ROUND_ROBIN": 1,
+}
+
+func (x PeerSelection) String() string {
+	return proto.EnumName(PeerSelection_name, int32(x))
+}
+func (PeerSelection) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_feg_config_78461b317a0ab8bc, []int{0}
+}
+
 type GyInitMethod int32
 
 const (
@@ -41,21 +64,51 @@ func (x GyInitMethod) String() string {
 	return proto.EnumName(GyInitMethod_name, int32(x))
 }
 func (GyInitMethod) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_feg_config_f5b2e950ceb84de0, []int{0}
+	return fileDescriptor_feg_config_78461b317a0ab8bc, []int{1}
+}
+
+type DiamRealmRoute_Action int32
+
+const (
+	DiamRealmRoute_LOCAL DiamRealmRoute_Action = 0
+	DiamRealmRoute_RELAY DiamRealmRoute_Action = 1
+)
+
+var DiamRealmRoute_Action_name = map[int32]string{
+	0: "LOCAL",
+	1: "RELAY",
+}
+var DiamRealmRoute_Action_value = map[string]int32{
+	"LOCAL": 0,
+	"RELAY": 1,
+}
+
+func (x DiamRealmRoute_Action) String() string {
+	return proto.EnumName(DiamRealmRoute_Action_name, int32(x))
+}
+func (DiamRealmRoute_Action) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_feg_config_78461b317a0ab8bc, []int{2, 0}
 }
 
 type DiamClientConfig struct {
-	Protocol             string   `protobuf:"bytes,1,opt,name=protocol,proto3" json:"protocol,omitempty"`
-	Address              string   `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	Retransmits          uint32   `protobuf:"varint,3,opt,name=retransmits,proto3" json:"retransmits,omitempty"`
-	WatchdogInterval     uint32   `protobuf:"varint,4,opt,name=watchdog_interval,json=watchdogInterval,proto3" json:"watchdog_interval,omitempty"`
-	RetryCount           uint32   `protobuf:"varint,5,opt,name=retry_count,json=retryCount,proto3" json:"retry_count,omitempty"`
-	LocalAddress         string   `protobuf:"bytes,6,opt,name=local_address,json=localAddress,proto3" json:"local_address,omitempty"`
-	ProductName          string   `protobuf:"bytes,7,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
-	Realm                string   `protobuf:"bytes,8,opt,name=realm,proto3" json:"realm,omitempty"`
-	Host                 string   `protobuf:"bytes,9,opt,name=host,proto3" json:"host,omitempty"`
-	DestRealm            string   `protobuf:"bytes,10,opt,name=dest_realm,json=destRealm,proto3" json:"dest_realm,omitempty"`
-	DestHost             string   `protobuf:"bytes,11,opt,name=dest_host,json=destHost,proto3" json:"dest_host,omitempty"`
+	Protocol         string `protobuf:"bytes,1,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	Address          string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Retransmits      uint32 `protobuf:"varint,3,opt,name=retransmits,proto3" json:"retransmits,omitempty"`
+	WatchdogInterval uint32 `protobuf:"varint,4,opt,name=watchdog_interval,json=watchdogInterval,proto3" json:"watchdog_interval,omitempty"`
+	RetryCount       uint32 `protobuf:"varint,5,opt,name=retry_count,json=retryCount,proto3" json:"retry_count,omitempty"`
+	LocalAddress     string `protobuf:"bytes,6,opt,name=local_address,json=localAddress,proto3" json:"local_address,omitempty"`
+	ProductName      string `protobuf:"bytes,7,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
+	Realm            string `protobuf:"bytes,8,opt,name=realm,proto3" json:"realm,omitempty"`
+	Host             string `protobuf:"bytes,9,opt,name=host,proto3" json:"host,omitempty"`
+	DestRealm        string `protobuf:"bytes,10,opt,name=dest_realm,json=destRealm,proto3" json:"dest_realm,omitempty"`
+	DestHost         string `protobuf:"bytes,11,opt,name=dest_host,json=destHost,proto3" json:"dest_host,omitempty"`
+	// ordered list of servers to fail over to when the server is unreachable
+	AlternateServers []*DiamServerConfig `protobuf:"bytes,12,rep,name=alternate_servers,json=alternateServers,proto3" json:"alternate_servers,omitempty"`
+	PeerSelection    PeerSelection       `protobuf:"varint,13,opt,name=peer_selection,json=peerSelection,proto3,enum=feg.PeerSelection" json:"peer_selection,omitempty"`
+	// Destination-Realm & Application-Id based routes, requests not matching any route are sent to the server
+	RealmRoutes []*DiamRealmRoute `protobuf:"bytes,14,rep,name=realm_routes,json=realmRoutes,proto3" json:"realm_routes,omitempty"`
+	// move sessions to another server when their server is unreachable (CC-Session-Failover)
+	SessionFailover      bool     `protobuf:"varint,15,opt,name=session_failover,json=sessionFailover,proto3" json:"session_failover,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -65,7 +118,7 @@ func (m *DiamClientConfig) Reset()         { *m = DiamClientConfig{} }
 func (m *DiamClientConfig) String() string { return proto.CompactTextString(m) }
 func (*DiamClientConfig) ProtoMessage()    {}
 func (*DiamClientConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_feg_config_f5b2e950ceb84de0, []int{0}
+	return fileDescriptor_feg_config_78461b317a0ab8bc, []int{0}
 }
 func (m *DiamClientConfig) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DiamClientConfig.Unmarshal(m, b)
@@ -162,12 +215,41 @@ func (m *DiamClientConfig) GetDestHost() string {
 	return ""
 }
 
+func (m *DiamClientConfig) GetAlternateServers() []*DiamServerConfig {
+	if m != nil {
+		return m.AlternateServers
+	}
+	return nil
+}
+
+func (m *DiamClientConfig) GetPeerSelection() PeerSelection {
+	if m != nil {
+		return m.PeerSelection
+	}
+	return PeerSelection_FAILOVER
+}
+
+func (m *DiamClientConfig) GetRealmRoutes() []*DiamRealmRoute {
+	if m != nil {
+		return m.RealmRoutes
+	}
+	return nil
+}
+
+func (m *DiamClientConfig) GetSessionFailover() bool {
+	if m != nil {
+		return m.SessionFailover
+	}
+	return false
+}
+
 type DiamServerConfig struct {
 	Protocol             string   `protobuf:"bytes,1,opt,name=protocol,proto3" json:"protocol,omitempty"`
 	Address              string   `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 	LocalAddress         string   `protobuf:"bytes,3,opt,name=local_address,json=localAddress,proto3" json:"local_address,omitempty"`
 	DestHost             string   `protobuf:"bytes,4,opt,name=dest_host,json=destHost,proto3" json:"dest_host,omitempty"`
 	DestRealm            string   `protobuf:"bytes,5,opt,name=dest_realm,json=destRealm,proto3" json:"dest_realm,omitempty"`
+	Weight               uint32   `protobuf:"varint,6,opt,name=weight,proto3" json:"weight,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -177,7 +259,7 @@ func (m *DiamServerConfig) Reset()         { *m = DiamServerConfig{} }
 func (m *DiamServerConfig) String() string { return proto.CompactTextString(m) }
 func (*DiamServerConfig) ProtoMessage()    {}
 func (*DiamServerConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_feg_config_f5b2e950ceb84de0, []int{1}
+	return fileDescriptor_feg_config_78461b317a0ab8bc, []int{1}
 }
 func (m *DiamServerConfig) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DiamServerConfig.Unmarshal(m, b)
@@ -232,6 +314,93 @@ func (m *DiamServerConfig) GetDestRealm() string {
 	return ""
 }
 
+func (m *DiamServerConfig) GetWeight() uint32 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
+type DiamRealmRoute struct {
+	Realm         string                `protobuf:"bytes,1,opt,name=realm,proto3" json:"realm,omitempty"`
+	ApplicationId uint32                `protobuf:"varint,2,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	Action        DiamRealmRoute_Action `protobuf:"varint,3,opt,name=action,proto3,enum=feg.DiamRealmRoute_Action" json:"action,omitempty"`
+	Peers         []*DiamServerConfig   `protobuf:"bytes,4,rep,name=peers,proto3" json:"peers,omitempty"`
+	PeerSelection PeerSelection         `protobuf:"varint,5,opt,name=peer_selection,json=peerSelection,proto3,enum=feg.PeerSelection" json:"peer_selection,omitempty"`
+	// PLMN IDs (MCC+MNC) of the realm's subscribers, the Destination-Realm of requests
+	// for their IMSIs is set to the realm or, for "*", derived from the PLMN ID (TS 23.003 19.2)
+	PlmnIds              []string `protobuf:"bytes,6,rep,name=plmn_ids,json=plmnIds,proto3" json:"plmn_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DiamRealmRoute) Reset()         { *m = DiamRealmRoute{} }
+func (m *DiamRealmRoute) String() string { return proto.CompactTextString(m) }
+func (*DiamRealmRoute) ProtoMessage()    {}
+func (*DiamRealmRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_feg_config_78461b317a0ab8bc, []int{2}
+}
+func (m *DiamRealmRoute) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DiamRealmRoute.Unmarshal(m, b)
+}
+func (m *DiamRealmRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DiamRealmRoute.Marshal(b, m, deterministic)
+}
+func (dst *DiamRealmRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DiamRealmRoute.Merge(dst, src)
+}
+func (m *DiamRealmRoute) XXX_Size() int {
+	return xxx_messageInfo_DiamRealmRoute.Size(m)
+}
+func (m *DiamRealmRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_DiamRealmRoute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DiamRealmRoute proto.InternalMessageInfo
+
+func (m *DiamRealmRoute) GetRealm() string {
+	if m != nil {
+		return m.Realm
+	}
+	return ""
+}
+
+func (m *DiamRealmRoute) GetApplicationId() uint32 {
+	if m != nil {
+		return m.ApplicationId
+	}
+	return 0
+}
+
+func (m *DiamRealmRoute) GetAction() DiamRealmRoute_Action {
+	if m != nil {
+		return m.Action
+	}
+	return DiamRealmRoute_LOCAL
+}
+
+func (m *DiamRealmRoute) GetPeers() []*DiamServerConfig {
+	if m != nil {
+		return m.Peers
+	}
+	return nil
+}
+
+func (m *DiamRealmRoute) GetPeerSelection() PeerSelection {
+	if m != nil {
+		return m.PeerSelection
+	}
+	return PeerSelection_FAILOVER
+}
+
+func (m *DiamRealmRoute) GetPlmnIds() []string {
+	if m != nil {
+		return m.PlmnIds
+	}
+	return nil
+}
+
 type S6AConfig struct {
 	Server               *DiamClientConfig `protobuf:"bytes,1,opt,name=server,proto3" json:"server,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
@@ -243,7 +412,7 @@ func (m *S6AConfig) Reset()         { *m = S6AConfig{} }
 func (m *S6AConfig) String() string { return proto.CompactTextString(m) }
 func (*S6AConfig) ProtoMessage()    {}
 func (*S6AConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_feg_config_f5b2e950ceb84de0, []int{2}
+	return fileDescriptor_feg_config_78461b317a0ab8bc, []int{3}
 }
 func (m *S6AConfig) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_S6AConfig.Unmarshal(m, b)
@@ -281,7 +450,7 @@ func (m *GxConfig) Reset()         { *m = GxConfig{} }
 func (m *GxConfig) String() string { return proto.CompactTextString(m) }
 func (*GxConfig) ProtoMessage()    {}
 func (*GxConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_feg_config_f5b2e950ceb84de0, []int{3}
+	return fileDescriptor_feg_config_78461b317a0ab8bc, []int{4}
 }
 func (m *GxConfig) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GxConfig.Unmarshal(m, b)
@@ -320,7 +489,7 @@ func (m *GyConfig) Reset()         { *m = GyConfig{} }
 func (m *GyConfig) String() string { return proto.CompactTextString(m) }
 func (*GyConfig) ProtoMessage()    {}
 func (*GyConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_feg_config_f5b2e950ceb84de0, []int{4}
+	return fileDescriptor_feg_config_78461b317a0ab8bc, []int{5}
 }
 func (m *GyConfig) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GyConfig.Unmarshal(m, b)
@@ -368,7 +537,7 @@ func (m *SwxConfig) Reset()         { *m = SwxConfig{} }
 func (m *SwxConfig) String() string { return proto.CompactTextString(m) }
 func (*SwxConfig) ProtoMessage()    {}
 func (*SwxConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_feg_config_f5b2e950ceb84de0, []int{5}
+	return fileDescriptor_feg_config_78461b317a0ab8bc, []int{6}
 }
 func (m *SwxConfig) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SwxConfig.Unmarshal(m, b)
@@ -430,7 +599,7 @@ func (m *HSSConfig) Reset()         { *m = HSSConfig{} }
 func (m *HSSConfig) String() string { return proto.CompactTextString(m) }
 func (*HSSConfig) ProtoMessage()    {}
 func (*HSSConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_feg_config_f5b2e950ceb84de0, []int{6}
+	return fileDescriptor_feg_config_78461b317a0ab8bc, []int{7}
 }
 func (m *HSSConfig) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_HSSConfig.Unmarshal(m, b)
@@ -506,7 +675,7 @@ func (m *HSSConfig_SubscriptionProfile) Reset()         { *m = HSSConfig_Subscri
 func (m *HSSConfig_SubscriptionProfile) String() string { return proto.CompactTextString(m) }
 func (*HSSConfig_SubscriptionProfile) ProtoMessage()    {}
 func (*HSSConfig_SubscriptionProfile) Descriptor() ([]byte, []int) {
-	return fileDescriptor_feg_config_f5b2e950ceb84de0, []int{6, 0}
+	return fileDescriptor_feg_config_78461b317a0ab8bc, []int{7, 0}
 }
 func (m *HSSConfig_SubscriptionProfile) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_HSSConfig_SubscriptionProfile.Unmarshal(m, b)
@@ -568,7 +737,7 @@ func (m *HealthConfig) Reset()         { *m = HealthConfig{} }
 func (m *HealthConfig) String() string { return proto.CompactTextString(m) }
 func (*HealthConfig) ProtoMessage()    {}
 func (*HealthConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_feg_config_f5b2e950ceb84de0, []int{7}
+	return fileDescriptor_feg_config_78461b317a0ab8bc, []int{8}
 }
 func (m *HealthConfig) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_HealthConfig.Unmarshal(m, b)
@@ -663,7 +832,7 @@ func (m *EapAkaConfig) Reset()         { *m = EapAkaConfig{} }
 func (m *EapAkaConfig) String() string { return proto.CompactTextString(m) }
 func (*EapAkaConfig) ProtoMessage()    {}
 func (*EapAkaConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_feg_config_f5b2e950ceb84de0, []int{8}
+	return fileDescriptor_feg_config_78461b317a0ab8bc, []int{9}
 }
 func (m *EapAkaConfig) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EapAkaConfig.Unmarshal(m, b)
@@ -711,7 +880,7 @@ func (m *EapAkaConfig_Timeouts) Reset()         { *m = EapAkaConfig_Timeouts{} }
 func (m *EapAkaConfig_Timeouts) String() string { return proto.CompactTextString(m) }
 func (*EapAkaConfig_Timeouts) ProtoMessage()    {}
 func (*EapAkaConfig_Timeouts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_feg_config_f5b2e950ceb84de0, []int{8, 0}
+	return fileDescriptor_feg_config_78461b317a0ab8bc, []int{9, 0}
 }
 func (m *EapAkaConfig_Timeouts) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EapAkaConfig_Timeouts.Unmarshal(m, b)
@@ -778,7 +947,7 @@ func (m *Config) Reset()         { *m = Config{} }
 func (m *Config) String() string { return proto.CompactTextString(m) }
 func (*Config) ProtoMessage()    {}
 func (*Config) Descriptor() ([]byte, []int) {
-	return fileDescriptor_feg_config_f5b2e950ceb84de0, []int{9}
+	return fileDescriptor_feg_config_78461b317a0ab8bc, []int{10}
 }
 func (m *Config) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Config.Unmarshal(m, b)
@@ -857,6 +1026,7 @@ func (m *Config) GetEapAka() *EapAkaConfig {
 func init() {
 	proto.RegisterType((*DiamClientConfig)(nil), "feg.DiamClientConfig")
 	proto.RegisterType((*DiamServerConfig)(nil), "feg.DiamServerConfig")
+	proto.RegisterType((*DiamRealmRoute)(nil), "feg.DiamRealmRoute")
 	proto.RegisterType((*S6AConfig)(nil), "feg.S6aConfig")
 	proto.RegisterType((*GxConfig)(nil), "feg.GxConfig")
 	proto.RegisterType((*GyConfig)(nil), "feg.GyConfig")
@@ -868,85 +1038,102 @@ func init() {
 	proto.RegisterType((*EapAkaConfig)(nil), "feg.EapAkaConfig")
 	proto.RegisterType((*EapAkaConfig_Timeouts)(nil), "feg.EapAkaConfig.Timeouts")
 	proto.RegisterType((*Config)(nil), "feg.Config")
+	proto.RegisterEnum("feg.PeerSelection", PeerSelection_name, PeerSelection_value)
 	proto.RegisterEnum("feg.GyInitMethod", GyInitMethod_name, GyInitMethod_value)
-}
-
-func init() { proto.RegisterFile("feg_config.proto", fileDescriptor_feg_config_f5b2e950ceb84de0) }
-
-var fileDescriptor_feg_config_f5b2e950ceb84de0 = []byte{
-	// 1170 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xdd, 0x6e, 0x1b, 0x45,
-	0x14, 0xc6, 0x3f, 0x89, 0xed, 0xb3, 0x4e, 0xe3, 0x4c, 0x4a, 0xba, 0x0d, 0x94, 0x1a, 0x23, 0x44,
-	0x28, 0x34, 0x2a, 0x01, 0x55, 0x6d, 0xc4, 0x4d, 0x9a, 0x98, 0x36, 0x82, 0xa4, 0xd1, 0x6c, 0x8a,
-	0x04, 0x37, 0xa3, 0xf1, 0xee, 0xd8, 0x1e, 0x65, 0xff, 0x98, 0x99, 0x4d, 0x62, 0x5e, 0x01, 0x6e,
-	0x11, 0xcf, 0xc0, 0x25, 0x12, 0xcf, 0xc4, 0x73, 0xa0, 0x3d, 0xb3, 0xbb, 0x76, 0x93, 0x48, 0xa0,
-	0x70, 0xe5, 0x9d, 0xf3, 0x7d, 0xdf, 0xcc, 0x37, 0xc7, 0x67, 0xce, 0x0c, 0xf4, 0xc6, 0x62, 0xc2,
-	0xfc, 0x24, 0x1e, 0xcb, 0xc9, 0x76, 0xaa, 0x12, 0x93, 0x90, 0xc6, 0x58, 0x4c, 0x06, 0x7f, 0xd7,
-	0xa1, 0x77, 0x20, 0x79, 0xb4, 0x1f, 0x4a, 0x11, 0x9b, 0x7d, 0xc4, 0xc9, 0x26, 0xb4, 0x91, 0xe2,
-	0x27, 0xa1, 0x5b, 0xeb, 0xd7, 0xb6, 0x3a, 0xb4, 0x1a, 0x13, 0x17, 0x5a, 0x3c, 0x08, 0x94, 0xd0,
-	0xda, 0xad, 0x23, 0x54, 0x0e, 0x49, 0x1f, 0x1c, 0x25, 0x8c, 0xe2, 0xb1, 0x8e, 0xa4, 0xd1, 0x6e,
-	0xa3, 0x5f, 0xdb, 0x5a, 0xa1, 0x8b, 0x21, 0xf2, 0x19, 0xac, 0x5d, 0x70, 0xe3, 0x4f, 0x83, 0x64,
-	0xc2, 0x64, 0x6c, 0x84, 0x3a, 0xe7, 0xa1, 0xdb, 0x44, 0x5e, 0xaf, 0x04, 0x0e, 0x8b, 0x38, 0x79,
-	0x68, 0xa7, 0x9b, 0x31, 0x3f, 0xc9, 0x62, 0xe3, 0x2e, 0x21, 0x0d, 0x30, 0xb4, 0x9f, 0x47, 0xc8,
-	0x47, 0xb0, 0x12, 0x26, 0x3e, 0x0f, 0x59, 0xe9, 0x67, 0x19, 0xfd, 0x74, 0x31, 0xb8, 0x57, 0x98,
-	0xfa, 0x10, 0xba, 0xa9, 0x4a, 0x82, 0xcc, 0x37, 0x2c, 0xe6, 0x91, 0x70, 0x5b, 0xc8, 0x71, 0x8a,
-	0xd8, 0x31, 0x8f, 0x04, 0xb9, 0x0b, 0x4b, 0x4a, 0xf0, 0x30, 0x72, 0xdb, 0x88, 0xd9, 0x01, 0x21,
-	0xd0, 0x9c, 0x26, 0xda, 0xb8, 0x1d, 0x0c, 0xe2, 0x37, 0x79, 0x00, 0x10, 0x08, 0x6d, 0x98, 0xa5,
-	0x03, 0x22, 0x9d, 0x3c, 0x42, 0x51, 0xf2, 0x1e, 0xe0, 0x80, 0xa1, 0xce, 0xb1, 0x79, 0xcb, 0x03,
-	0xaf, 0x12, 0x6d, 0x06, 0x7f, 0xd4, 0x6c, 0xa2, 0x3d, 0xa1, 0xce, 0x85, 0xfa, 0x5f, 0x89, 0xbe,
-	0xb6, 0xf1, 0xc6, 0x0d, 0x1b, 0x7f, 0xcb, 0x4c, 0xf3, 0x6d, 0x33, 0x57, 0x36, 0xb2, 0x74, 0x65,
-	0x23, 0x83, 0x5d, 0xe8, 0x78, 0x4f, 0x79, 0xe1, 0xf1, 0x31, 0x2c, 0x6b, 0xf4, 0x8c, 0x0e, 0x9d,
-	0x9d, 0x77, 0xb7, 0xc7, 0x62, 0xb2, 0x7d, 0xb5, 0x66, 0x68, 0x41, 0x1a, 0x3c, 0x87, 0xf6, 0xcb,
-	0xcb, 0xdb, 0x49, 0x23, 0x68, 0xbf, 0x9c, 0xdd, 0x4a, 0x4a, 0x76, 0xc0, 0x91, 0xb1, 0x34, 0x2c,
-	0x12, 0x66, 0x9a, 0x04, 0x98, 0xb0, 0x3b, 0x3b, 0x6b, 0xa8, 0x79, 0x39, 0x3b, 0x8c, 0xa5, 0x39,
-	0x42, 0x80, 0x82, 0xac, 0xbe, 0x07, 0xbf, 0xd7, 0xa0, 0xe3, 0x5d, 0xdc, 0xce, 0x2b, 0xf9, 0x02,
-	0xee, 0x9e, 0x0b, 0x25, 0xc7, 0x33, 0xc6, 0x33, 0x33, 0x4d, 0x94, 0xfc, 0x99, 0x1b, 0x99, 0xc4,
-	0xb8, 0x72, 0x9b, 0xae, 0x5b, 0x6c, 0x6f, 0x11, 0x22, 0x5b, 0xb0, 0xba, 0xcf, 0xfd, 0xa9, 0x38,
-	0x3d, 0xfd, 0xce, 0x13, 0x7e, 0x12, 0x07, 0xe5, 0x19, 0xb9, 0x1a, 0x1e, 0xfc, 0xda, 0x84, 0xce,
-	0x2b, 0xcf, 0xfb, 0x57, 0x67, 0x8b, 0xb5, 0x54, 0x39, 0xfb, 0x00, 0x9c, 0xd0, 0x08, 0xb4, 0xc5,
-	0x92, 0x14, 0x0d, 0x75, 0x69, 0x27, 0x34, 0x22, 0x77, 0xf3, 0x3a, 0x25, 0x7d, 0xe8, 0x56, 0x38,
-	0x8f, 0xc6, 0xe8, 0xa1, 0x4b, 0xa1, 0x20, 0xec, 0x45, 0x63, 0xf2, 0x02, 0xba, 0x3a, 0x1b, 0xb1,
-	0x54, 0x25, 0x63, 0x19, 0x0a, 0xed, 0x36, 0xfb, 0x8d, 0x2d, 0x67, 0xe7, 0x21, 0x2e, 0x5b, 0xd9,
-	0xda, 0xf6, 0xb2, 0xd1, 0x49, 0xc1, 0x18, 0xc6, 0x46, 0xcd, 0xa8, 0xa3, 0xe7, 0x11, 0x42, 0x61,
-	0x3d, 0x10, 0x63, 0x9e, 0x85, 0x86, 0x2d, 0xcc, 0x85, 0xa5, 0xe6, 0xec, 0x0c, 0xae, 0x4f, 0xa5,
-	0x7d, 0x25, 0xd3, 0x3c, 0x4d, 0xc5, 0x0c, 0x74, 0xad, 0x90, 0xcf, 0x97, 0x21, 0x8f, 0x81, 0x68,
-	0xa3, 0x04, 0x8f, 0xf2, 0x29, 0x73, 0xc1, 0x48, 0x28, 0x7b, 0xea, 0xdb, 0x74, 0xcd, 0x22, 0xde,
-	0x1c, 0xd8, 0xf4, 0x61, 0xfd, 0x86, 0x89, 0xc9, 0xc7, 0xb0, 0x1a, 0xf1, 0x4b, 0x96, 0x85, 0x6c,
-	0x24, 0x0d, 0x53, 0xdc, 0x08, 0xcc, 0x6b, 0x93, 0x76, 0x23, 0x7e, 0xf9, 0x26, 0x7c, 0x21, 0x0d,
-	0xe5, 0xa6, 0xa2, 0x05, 0x0b, 0xb4, 0x7a, 0x45, 0x3b, 0x28, 0x69, 0x9b, 0x23, 0xe8, 0x5d, 0x4d,
-	0x04, 0xe9, 0x41, 0xe3, 0x4c, 0xcc, 0x8a, 0x03, 0x9d, 0x7f, 0x92, 0x67, 0xb0, 0x74, 0xce, 0xc3,
-	0xcc, 0x4e, 0xf1, 0xdf, 0xf6, 0x6f, 0x05, 0xbb, 0xf5, 0x67, 0xb5, 0xc1, 0x2f, 0x4d, 0xe8, 0xbe,
-	0x12, 0x3c, 0x34, 0xd3, 0xa2, 0x22, 0x3e, 0x81, 0xd5, 0x29, 0x8e, 0x59, 0xfe, 0x9f, 0x4b, 0x5f,
-	0x68, 0xb7, 0xd6, 0x6f, 0x6c, 0x75, 0xe8, 0x1d, 0x1b, 0xf6, 0x8a, 0x28, 0x79, 0x02, 0x77, 0xb3,
-	0x34, 0xe0, 0x46, 0x54, 0xed, 0x96, 0x69, 0xe1, 0xdb, 0x86, 0xb2, 0x42, 0x89, 0xc5, 0xca, 0x8e,
-	0xeb, 0x09, 0x5f, 0x93, 0xe7, 0x70, 0xdf, 0x0f, 0x93, 0x2c, 0x60, 0x81, 0xd4, 0x7c, 0x14, 0x0a,
-	0x96, 0x0a, 0x25, 0x93, 0xc0, 0xca, 0x6c, 0xb9, 0x6e, 0x20, 0xe1, 0xc0, 0xe2, 0x27, 0x08, 0x97,
-	0x52, 0xdb, 0x96, 0x6e, 0x92, 0xda, 0x2e, 0xbf, 0x81, 0x84, 0xeb, 0xd2, 0x67, 0xe0, 0x16, 0x3e,
-	0xc7, 0x5c, 0x86, 0x99, 0x12, 0xcc, 0x4c, 0x95, 0xd0, 0xd3, 0x24, 0x0c, 0x8a, 0xc6, 0xbf, 0x61,
-	0xf1, 0x6f, 0x2c, 0x7c, 0x5a, 0xa2, 0x64, 0x17, 0xee, 0x2b, 0xf1, 0x53, 0x96, 0x37, 0xb3, 0xeb,
-	0xd2, 0xbc, 0x34, 0xea, 0xf4, 0x5e, 0x41, 0xb8, 0x49, 0x1b, 0xc9, 0x58, 0x46, 0x59, 0xc4, 0xca,
-	0x39, 0xe6, 0xda, 0x16, 0x2e, 0x7b, 0xaf, 0x20, 0x50, 0x8b, 0xbf, 0xa5, 0xf5, 0xd3, 0x8c, 0x65,
-	0x46, 0x86, 0xc5, 0xf9, 0x5e, 0xd0, 0xb6, 0xed, 0xba, 0x7e, 0x9a, 0xbd, 0x99, 0xe3, 0x73, 0xed,
-	0xd7, 0xb0, 0x19, 0x89, 0x28, 0x51, 0x33, 0xc6, 0xcf, 0xb9, 0x0c, 0x31, 0x57, 0x73, 0x71, 0x07,
-	0xc5, 0xae, 0x65, 0xec, 0x95, 0x84, 0x4a, 0x3d, 0xf8, 0xad, 0x0e, 0xdd, 0x21, 0x4f, 0xf7, 0xce,
-	0xca, 0x06, 0xfd, 0x15, 0xb4, 0x8c, 0x8c, 0x44, 0x92, 0x99, 0xa2, 0x41, 0x6c, 0x62, 0x79, 0x2d,
-	0x72, 0xb6, 0x4f, 0x2d, 0x41, 0xd3, 0x92, 0x9a, 0x5f, 0x2f, 0x27, 0x61, 0x14, 0x1f, 0x06, 0x79,
-	0x35, 0xe4, 0xb5, 0x53, 0x0e, 0x37, 0xff, 0xaa, 0x41, 0xbb, 0xe4, 0xe7, 0x97, 0xfa, 0xfe, 0x94,
-	0x87, 0xa1, 0x88, 0x27, 0xe2, 0x48, 0xe3, 0x02, 0x2b, 0x74, 0x31, 0x44, 0x9e, 0xc0, 0xfa, 0x50,
-	0xa9, 0x44, 0x1d, 0x27, 0x46, 0x8e, 0xa5, 0x8f, 0x7b, 0x3d, 0x2a, 0x4b, 0xec, 0x26, 0x88, 0xbc,
-	0x0f, 0x1d, 0x4f, 0x68, 0x6d, 0x79, 0xb6, 0xa6, 0xe6, 0x01, 0xf2, 0x14, 0x36, 0x8a, 0x41, 0xde,
-	0x8f, 0x44, 0x6c, 0x72, 0xa1, 0x08, 0x8e, 0xaa, 0x1a, 0xba, 0x19, 0x1d, 0xfc, 0x59, 0x87, 0xe5,
-	0x22, 0x23, 0x7d, 0x68, 0xe8, 0xa7, 0x1c, 0xf9, 0xce, 0xce, 0x1d, 0xcc, 0x46, 0x75, 0x9f, 0xd1,
-	0x1c, 0x22, 0x0f, 0xa0, 0x3e, 0xb9, 0x2c, 0xba, 0xd1, 0x8a, 0xbd, 0x26, 0x8a, 0x8b, 0x80, 0xd6,
-	0x27, 0x97, 0x08, 0xcf, 0xb0, 0x7c, 0x2a, 0x78, 0x56, 0xc1, 0x33, 0xf2, 0x39, 0x10, 0x6c, 0xb6,
-	0x01, 0x8b, 0x85, 0xb9, 0x48, 0xd4, 0x19, 0x93, 0x81, 0x76, 0x5b, 0x98, 0xc6, 0x9e, 0x45, 0x8e,
-	0x2d, 0x70, 0x18, 0xe4, 0x29, 0x6c, 0x4c, 0xb5, 0xc6, 0xa2, 0x28, 0xdd, 0x54, 0x47, 0x9f, 0xe6,
-	0x10, 0xfa, 0xbd, 0xb8, 0xc4, 0x7f, 0xbe, 0xf2, 0x5b, 0x5e, 0x4c, 0x34, 0x87, 0xc8, 0xa7, 0xb0,
-	0x6c, 0x8f, 0x36, 0xbe, 0x3a, 0x9c, 0xe2, 0x6a, 0x5b, 0x6c, 0x0a, 0xb4, 0x20, 0x90, 0x47, 0xd0,
-	0x12, 0x3c, 0x65, 0xfc, 0x8c, 0xe3, 0x1b, 0xa4, 0xe4, 0x2e, 0x96, 0x03, 0x5d, 0x16, 0x38, 0x7a,
-	0xb4, 0x0b, 0xdd, 0xc5, 0xeb, 0x91, 0x74, 0xa1, 0x4d, 0x87, 0xde, 0x90, 0x7e, 0x3f, 0x3c, 0xe8,
-	0xbd, 0x43, 0x56, 0xc1, 0x39, 0x19, 0x52, 0xe6, 0x0d, 0x3d, 0xef, 0xf0, 0xf5, 0x71, 0xaf, 0x46,
-	0x1c, 0x68, 0xe5, 0x81, 0x6f, 0x87, 0x3f, 0xf4, 0xea, 0x2f, 0xda, 0x3f, 0x2e, 0xe3, 0x5b, 0x45,
-	0x8f, 0xec, 0xef, 0x97, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0x3e, 0xa0, 0xc4, 0x15, 0x63, 0x0a,
-	0x00, 0x00,
+	proto.RegisterEnum("feg.DiamRealmRoute_Action", DiamRealmRoute_Action_name, DiamRealmRoute_Action_value)
+}
+
+func init() { proto.RegisterFile("feg_config.proto", fileDescriptor_feg_config_78461b317a0ab8bc) }
+
+var fileDescriptor_feg_config_78461b317a0ab8bc = []byte{
+	// 1416 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xdb, 0x6e, 0x1b, 0x47,
+	0x12, 0x35, 0x2f, 0xa2, 0xc8, 0xe2, 0x45, 0x54, 0xcb, 0x2b, 0x8f, 0xb5, 0x6b, 0x9b, 0xcb, 0x85,
+	0xb1, 0xb2, 0x1d, 0x0b, 0x8e, 0x12, 0x08, 0xb6, 0x90, 0x17, 0x4a, 0xa2, 0x6d, 0x22, 0xba, 0xa1,
+	0x47, 0x36, 0xe0, 0xbc, 0x34, 0x9a, 0x33, 0x4d, 0x72, 0xa0, 0xb9, 0x65, 0xba, 0x47, 0x12, 0xf3,
+	0x0b, 0xc9, 0x6b, 0x90, 0x1f, 0xc8, 0x0f, 0x04, 0xc8, 0x0f, 0xe4, 0x2d, 0x9f, 0x15, 0xf4, 0x65,
+	0xc8, 0x91, 0x44, 0x24, 0x86, 0x9e, 0x38, 0x5d, 0xe7, 0x9c, 0xee, 0x62, 0x55, 0x75, 0x55, 0x43,
+	0x7b, 0xc4, 0xc6, 0xc4, 0x89, 0xc2, 0x91, 0x37, 0xde, 0x8a, 0x93, 0x48, 0x44, 0xa8, 0x34, 0x62,
+	0xe3, 0xee, 0x9f, 0x65, 0x68, 0x1f, 0x78, 0x34, 0xd8, 0xf7, 0x3d, 0x16, 0x8a, 0x7d, 0x85, 0xa3,
+	0x0d, 0xa8, 0x2a, 0x8a, 0x13, 0xf9, 0x56, 0xa1, 0x53, 0xd8, 0xac, 0xe1, 0xd9, 0x1a, 0x59, 0xb0,
+	0x4c, 0x5d, 0x37, 0x61, 0x9c, 0x5b, 0x45, 0x05, 0x65, 0x4b, 0xd4, 0x81, 0x7a, 0xc2, 0x44, 0x42,
+	0x43, 0x1e, 0x78, 0x82, 0x5b, 0xa5, 0x4e, 0x61, 0xb3, 0x89, 0xf3, 0x26, 0xf4, 0x02, 0x56, 0x2f,
+	0xa9, 0x70, 0x26, 0x6e, 0x34, 0x26, 0x5e, 0x28, 0x58, 0x72, 0x41, 0x7d, 0xab, 0xac, 0x78, 0xed,
+	0x0c, 0x18, 0x18, 0x3b, 0x7a, 0xa2, 0xb7, 0x9b, 0x12, 0x27, 0x4a, 0x43, 0x61, 0x2d, 0x29, 0x1a,
+	0x28, 0xd3, 0xbe, 0xb4, 0xa0, 0xff, 0x41, 0xd3, 0x8f, 0x1c, 0xea, 0x93, 0xcc, 0x9f, 0x8a, 0xf2,
+	0xa7, 0xa1, 0x8c, 0x3d, 0xe3, 0xd4, 0x7f, 0xa1, 0x11, 0x27, 0x91, 0x9b, 0x3a, 0x82, 0x84, 0x34,
+	0x60, 0xd6, 0xb2, 0xe2, 0xd4, 0x8d, 0xed, 0x98, 0x06, 0x0c, 0xdd, 0x87, 0xa5, 0x84, 0x51, 0x3f,
+	0xb0, 0xaa, 0x0a, 0xd3, 0x0b, 0x84, 0xa0, 0x3c, 0x89, 0xb8, 0xb0, 0x6a, 0xca, 0xa8, 0xbe, 0xd1,
+	0x23, 0x00, 0x97, 0x71, 0x41, 0x34, 0x1d, 0x14, 0x52, 0x93, 0x16, 0xac, 0x24, 0xff, 0x06, 0xb5,
+	0x20, 0x4a, 0x57, 0xd7, 0x71, 0x93, 0x86, 0xf7, 0x52, 0xbb, 0x07, 0xab, 0xd4, 0x17, 0x2c, 0x09,
+	0xa9, 0x60, 0x84, 0xb3, 0xe4, 0x82, 0x25, 0xdc, 0x6a, 0x74, 0x4a, 0x9b, 0xf5, 0xed, 0x7f, 0x6d,
+	0x8d, 0xd8, 0x78, 0x4b, 0x66, 0xc1, 0x56, 0x76, 0x9d, 0x05, 0xdc, 0x9e, 0xf1, 0xb5, 0x99, 0xa3,
+	0x37, 0xd0, 0x8a, 0x19, 0x4b, 0x08, 0x67, 0x3e, 0x73, 0x84, 0x17, 0x85, 0x56, 0xb3, 0x53, 0xd8,
+	0x6c, 0x6d, 0x23, 0xb5, 0xc1, 0x29, 0x63, 0x89, 0x9d, 0x21, 0xb8, 0x19, 0xe7, 0x97, 0x68, 0x07,
+	0x1a, 0xca, 0x6b, 0x92, 0x44, 0xa9, 0x60, 0xdc, 0x6a, 0xa9, 0x93, 0xd7, 0x66, 0x27, 0xab, 0x7f,
+	0x80, 0x25, 0x26, 0x53, 0x96, 0x7d, 0x73, 0xf4, 0x0c, 0xda, 0x9c, 0x71, 0xee, 0x45, 0x21, 0x19,
+	0x51, 0xcf, 0x8f, 0x2e, 0x58, 0x62, 0xad, 0x74, 0x0a, 0x9b, 0x55, 0xbc, 0x62, 0xec, 0x6f, 0x8d,
+	0xb9, 0xfb, 0x47, 0x41, 0x97, 0x52, 0xfe, 0x4f, 0xdc, 0xb1, 0x94, 0x6e, 0xa5, 0xb6, 0xb4, 0x20,
+	0xb5, 0xd7, 0xc2, 0x5d, 0xbe, 0x11, 0xee, 0xeb, 0xa9, 0x5a, 0xba, 0x99, 0xaa, 0x75, 0xa8, 0x5c,
+	0x32, 0x6f, 0x3c, 0x11, 0xaa, 0x68, 0x9a, 0xd8, 0xac, 0xba, 0xbf, 0x16, 0xa1, 0x75, 0x3d, 0x1c,
+	0xf3, 0xf2, 0x28, 0xe4, 0xcb, 0xe3, 0x29, 0xb4, 0x68, 0x1c, 0xfb, 0x9e, 0x43, 0x65, 0x78, 0x89,
+	0xe7, 0xaa, 0xbf, 0xd0, 0xc4, 0xcd, 0x9c, 0x75, 0xe0, 0xa2, 0x6d, 0xa8, 0x50, 0x9d, 0xa9, 0x92,
+	0xca, 0xd4, 0xc6, 0x82, 0x80, 0x6f, 0xf5, 0x74, 0xc6, 0x0c, 0x13, 0xbd, 0x80, 0x25, 0x99, 0x3b,
+	0x6e, 0x95, 0xff, 0xae, 0x3a, 0x34, 0x67, 0x41, 0x49, 0x2c, 0x7d, 0x6e, 0x49, 0x3c, 0x84, 0x6a,
+	0xec, 0x07, 0xd2, 0x77, 0x79, 0x75, 0x4a, 0x32, 0xfe, 0x72, 0x3d, 0x70, 0x79, 0xf7, 0x31, 0x54,
+	0xb4, 0x53, 0xa8, 0x06, 0x4b, 0x87, 0x27, 0xfb, 0xbd, 0xc3, 0xf6, 0x3d, 0xf9, 0x89, 0xfb, 0x87,
+	0xbd, 0x4f, 0xed, 0x42, 0x77, 0x17, 0x6a, 0xf6, 0x0e, 0x35, 0x29, 0x7e, 0x09, 0x15, 0x5d, 0xcf,
+	0x2a, 0x42, 0x79, 0x87, 0xf3, 0x4d, 0x05, 0x1b, 0x52, 0xf7, 0x0d, 0x54, 0xdf, 0x5d, 0xdd, 0x4d,
+	0x1a, 0x40, 0xf5, 0xdd, 0xf4, 0x4e, 0x52, 0xb4, 0x0d, 0x75, 0x2f, 0xf4, 0x04, 0x09, 0x98, 0x98,
+	0x44, 0x3a, 0x59, 0xad, 0xed, 0x55, 0xa5, 0x79, 0x37, 0x1d, 0x84, 0x9e, 0x38, 0x52, 0x00, 0x06,
+	0x6f, 0xf6, 0xdd, 0xfd, 0xa5, 0x00, 0x35, 0xfb, 0xf2, 0x6e, 0xbe, 0xa2, 0x2f, 0xe1, 0xfe, 0x05,
+	0x4b, 0xbc, 0xd1, 0x94, 0xd0, 0x54, 0x4c, 0xa2, 0xc4, 0xfb, 0x41, 0xd5, 0x84, 0x3a, 0xb9, 0x8a,
+	0xd7, 0x34, 0xd6, 0xcb, 0x43, 0x68, 0x13, 0x56, 0xf6, 0xa9, 0x33, 0x61, 0x67, 0x67, 0x87, 0x36,
+	0x73, 0xa2, 0xd0, 0xcd, 0x9a, 0xe8, 0x4d, 0x73, 0xf7, 0xa7, 0x32, 0xd4, 0xde, 0xdb, 0xf6, 0x3f,
+	0x7a, 0x76, 0xad, 0x62, 0x32, 0xcf, 0x1e, 0x43, 0xdd, 0x17, 0x4c, 0xb9, 0x45, 0xa2, 0x58, 0x39,
+	0xd4, 0xc0, 0x35, 0x5f, 0x30, 0xe9, 0xcd, 0x49, 0x8c, 0x3a, 0xd0, 0x98, 0xe1, 0x34, 0x18, 0x29,
+	0x1f, 0x1a, 0x18, 0x0c, 0xa1, 0x17, 0x8c, 0xd0, 0x1e, 0x34, 0x78, 0x3a, 0x24, 0x71, 0x12, 0x8d,
+	0x3c, 0x9f, 0x65, 0x85, 0xfa, 0x44, 0x1d, 0x3b, 0x73, 0x6b, 0xcb, 0x4e, 0x87, 0xa7, 0x86, 0xd1,
+	0x0f, 0x45, 0x32, 0xc5, 0x75, 0x3e, 0xb7, 0x20, 0x0c, 0x6b, 0x2e, 0x1b, 0xd1, 0xd4, 0x17, 0x24,
+	0xb7, 0x97, 0xaa, 0xde, 0xfa, 0x76, 0xf7, 0xf6, 0x56, 0xdc, 0x49, 0xbc, 0x58, 0x86, 0xc9, 0xec,
+	0x80, 0x57, 0x8d, 0x7c, 0x7e, 0x0c, 0x7a, 0x09, 0x88, 0x8b, 0x84, 0xd1, 0x80, 0x70, 0x2d, 0x18,
+	0xca, 0x6b, 0x54, 0x51, 0x11, 0x5f, 0xd5, 0x88, 0x3d, 0x07, 0x36, 0x1c, 0x58, 0x5b, 0xb0, 0x31,
+	0x7a, 0x0a, 0x2b, 0x01, 0xbd, 0x22, 0xa9, 0x4f, 0x86, 0x9e, 0x20, 0x09, 0x15, 0x4c, 0xc5, 0xb5,
+	0x8c, 0x1b, 0x01, 0xbd, 0xfa, 0xe0, 0xef, 0x79, 0x02, 0x53, 0x31, 0xa3, 0xb9, 0x39, 0x5a, 0x71,
+	0x46, 0x3b, 0xc8, 0x68, 0x1b, 0x43, 0x68, 0xdf, 0x0c, 0x04, 0x6a, 0x43, 0xe9, 0x9c, 0x4d, 0x4d,
+	0x43, 0x91, 0x9f, 0xe8, 0x35, 0x2c, 0x5d, 0x50, 0x3f, 0xd5, 0x5b, 0x7c, 0xde, 0xff, 0xd7, 0x82,
+	0xdd, 0xe2, 0xeb, 0x42, 0xf7, 0xc7, 0x32, 0x34, 0xde, 0x33, 0xea, 0x8b, 0x89, 0xa9, 0x88, 0xff,
+	0xc3, 0xca, 0x44, 0xad, 0xd5, 0xa4, 0xf1, 0x1c, 0xc6, 0xad, 0x82, 0xba, 0xe1, 0x2d, 0x6d, 0xb6,
+	0x8d, 0x15, 0xbd, 0x82, 0xfb, 0x69, 0xec, 0xca, 0x91, 0x94, 0xcd, 0x63, 0xc2, 0x99, 0xc3, 0x4d,
+	0x33, 0x43, 0x1a, 0xcb, 0x46, 0xb2, 0xcd, 0x1c, 0xd9, 0x70, 0x1e, 0x3a, 0x7e, 0x94, 0xba, 0xc4,
+	0xf5, 0x38, 0x1d, 0xfa, 0x8c, 0xc4, 0x2c, 0xf1, 0x22, 0x57, 0xcb, 0x74, 0xb9, 0xae, 0x2b, 0xc2,
+	0x81, 0xc6, 0x4f, 0x15, 0x9c, 0x49, 0x75, 0x57, 0x5f, 0x24, 0xd5, 0xcf, 0x80, 0x75, 0x45, 0xb8,
+	0x2d, 0x7d, 0x0d, 0x96, 0xf1, 0x53, 0x4e, 0xa1, 0x34, 0x61, 0x44, 0x4c, 0x12, 0xc6, 0x27, 0x91,
+	0xef, 0x9a, 0x97, 0xc1, 0xba, 0xc6, 0xdf, 0x6a, 0xf8, 0x2c, 0x43, 0xd1, 0x2e, 0x3c, 0x4c, 0xd8,
+	0xf7, 0xa9, 0x9c, 0x05, 0xb7, 0xa5, 0xb2, 0x34, 0x8a, 0xf8, 0x81, 0x21, 0x2c, 0xd2, 0x06, 0x5e,
+	0xe8, 0x05, 0x69, 0x40, 0xb2, 0x3d, 0xe6, 0xda, 0x65, 0x75, 0xec, 0x03, 0x43, 0xc0, 0x1a, 0xbf,
+	0xa6, 0x75, 0xe2, 0x94, 0xa4, 0xc2, 0xf3, 0xcd, 0xfd, 0xce, 0x69, 0xab, 0xfa, 0x5c, 0x27, 0x4e,
+	0x3f, 0xcc, 0xf1, 0xb9, 0xf6, 0x1b, 0xd8, 0x08, 0x58, 0x10, 0x25, 0x53, 0x42, 0x2f, 0xa8, 0xe7,
+	0xab, 0x58, 0xcd, 0xc5, 0x35, 0x25, 0xb6, 0x34, 0xa3, 0x97, 0x11, 0x66, 0xea, 0xee, 0xcf, 0x45,
+	0x68, 0xf4, 0x69, 0xdc, 0x3b, 0xcf, 0x1a, 0xf4, 0xd7, 0xb0, 0x2c, 0xbc, 0x80, 0x45, 0xa9, 0x30,
+	0x0d, 0x42, 0x4f, 0xa1, 0x3c, 0x67, 0xeb, 0x4c, 0x13, 0x38, 0xce, 0xa8, 0x72, 0x3a, 0x9f, 0xea,
+	0x71, 0x60, 0x15, 0xf5, 0x74, 0x30, 0xcb, 0x8d, 0xdf, 0x0b, 0x50, 0xcd, 0xf8, 0xf2, 0xd5, 0xb7,
+	0x3f, 0xa1, 0xbe, 0xcf, 0xc2, 0x31, 0x3b, 0xe2, 0xea, 0x80, 0x26, 0xce, 0x9b, 0xd0, 0x2b, 0x58,
+	0xeb, 0x27, 0x49, 0x94, 0x1c, 0x47, 0xc2, 0x1b, 0x99, 0xd1, 0x78, 0x94, 0x95, 0xd8, 0x22, 0x08,
+	0xfd, 0x07, 0x6a, 0xb6, 0x7e, 0x5c, 0x1c, 0x65, 0x35, 0x35, 0x37, 0xa0, 0x1d, 0x58, 0x37, 0x0b,
+	0xd9, 0x8f, 0x58, 0x28, 0xa4, 0x90, 0xb9, 0x47, 0xb3, 0x1a, 0x5a, 0x8c, 0x76, 0x7f, 0x2b, 0x42,
+	0xc5, 0x44, 0xa4, 0x03, 0x25, 0xbe, 0x43, 0x15, 0xbf, 0xbe, 0xdd, 0x52, 0xd1, 0x98, 0xcd, 0x33,
+	0x2c, 0x21, 0xf4, 0x08, 0x8a, 0xe3, 0x2b, 0xd3, 0x8d, 0x9a, 0x7a, 0x4c, 0x98, 0x41, 0x80, 0x8b,
+	0xe3, 0x2b, 0x05, 0x4f, 0xad, 0x4a, 0x1e, 0x9e, 0xce, 0xe0, 0x29, 0xfa, 0x02, 0x90, 0x6a, 0xb6,
+	0x2e, 0x09, 0x99, 0xb8, 0x8c, 0x92, 0x73, 0x35, 0x64, 0x97, 0x55, 0x18, 0xdb, 0x1a, 0x39, 0xd6,
+	0xc0, 0xc0, 0x95, 0x21, 0x2c, 0x4d, 0x38, 0xb7, 0xaa, 0x39, 0x6f, 0x66, 0x57, 0x1f, 0x4b, 0x48,
+	0xf9, 0x7b, 0x79, 0x65, 0xd5, 0x72, 0x8c, 0xd9, 0x60, 0xc2, 0x12, 0x42, 0xcf, 0xa0, 0xa2, 0xaf,
+	0xb6, 0x7a, 0x96, 0xd6, 0xcd, 0x68, 0xcb, 0x37, 0x05, 0x6c, 0x08, 0xe8, 0x39, 0x2c, 0x33, 0x1a,
+	0x13, 0x7a, 0x4e, 0xad, 0x7a, 0x8e, 0x9b, 0x2f, 0x07, 0x5c, 0x61, 0x6a, 0xf5, 0x7c, 0x0b, 0x9a,
+	0xd7, 0xde, 0x10, 0xa8, 0x01, 0xd5, 0xb7, 0xbd, 0xc1, 0xe1, 0xc9, 0xc7, 0x3e, 0x6e, 0xdf, 0x43,
+	0x2b, 0x50, 0xc7, 0x27, 0x1f, 0x8e, 0x0f, 0x08, 0x3e, 0xd9, 0x1b, 0x1c, 0xb7, 0x0b, 0xcf, 0x77,
+	0xa1, 0x91, 0x1f, 0xa7, 0x92, 0x8e, 0xfb, 0x76, 0x1f, 0x7f, 0xec, 0x1f, 0x68, 0xfa, 0x69, 0x1f,
+	0x13, 0xbb, 0x6f, 0xdb, 0x83, 0x93, 0xe3, 0x76, 0x01, 0xd5, 0x61, 0x59, 0x1a, 0xbe, 0xed, 0x7f,
+	0x6a, 0x17, 0xf7, 0xaa, 0xdf, 0x55, 0xd4, 0xd3, 0x90, 0x0f, 0xf5, 0xef, 0x57, 0x7f, 0x0d, 0x00,
+	0xb8, 0x25, 0x60, 0xaa, 0xb4, 0x0c, 0x00, 0x00,
 }
@@ -38,6 +38,7 @@ func main() {
 	}
 	protos.RegisterS6AGatewayServiceServer(srv.GrpcServer, servicer)
 	protos.RegisterCSFBGatewayServiceServer(srv.GrpcServer, servicer)
+	protos.RegisterSwxGatewayServiceServer(srv.GrpcServer, servicer)
 	lteprotos.RegisterSessionProxyResponderServer(srv.GrpcServer, servicer)
 	// create and run GW_TO_FEG httpserver
 	gwToFeGServer := gw_to_feg_relay.NewGatewayToFegServer()
@@ -0,0 +1,82 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package servicers
+
+import (
+	"context"
+	"fmt"
+
+	fegprotos "magma/feg/cloud/go/protos"
+	"magma/orc8r/cloud/go/services/dispatcher/gateway_registry"
+)
+
+// InsertSubscriberData relays the InsertSubscriberDataRequest to a corresponding
+// dispatcher service instance, who will in turn relay the request to the
+// corresponding gateway
+func (srv *FegToGwRelayServer) InsertSubscriberData(
+	ctx context.Context,
+	req *fegprotos.InsertSubscriberDataRequest,
+) (*fegprotos.InsertSubscriberDataAnswer, error) {
+	if err := validateFegContext(ctx); err != nil {
+		return nil, err
+	}
+	return srv.InsertSubscriberDataUnverified(ctx, req)
+}
+
+// InsertSubscriberDataUnverified called directly in test server for unit test.
+// Skip identity check
+func (srv *FegToGwRelayServer) InsertSubscriberDataUnverified(
+	ctx context.Context,
+	req *fegprotos.InsertSubscriberDataRequest,
+) (*fegprotos.InsertSubscriberDataAnswer, error) {
+	client, ctx, err := getS6aGatewayClient(req.UserName)
+	if err != nil {
+		return &fegprotos.InsertSubscriberDataAnswer{ErrorCode: fegprotos.ErrorCode_USER_UNKNOWN}, err
+	}
+	return client.InsertSubscriberData(ctx, req)
+}
+
+// DeleteSubscriberData relays the DeleteSubscriberDataRequest to a corresponding
+// dispatcher service instance, who will in turn relay the request to the
+// corresponding gateway
+func (srv *FegToGwRelayServer) DeleteSubscriberData(
+	ctx context.Context,
+	req *fegprotos.DeleteSubscriberDataRequest,
+) (*fegprotos.DeleteSubscriberDataAnswer, error) {
+	if err := validateFegContext(ctx); err != nil {
+		return nil, err
+	}
+	return srv.DeleteSubscriberDataUnverified(ctx, req)
+}
+
+// DeleteSubscriberDataUnverified called directly in test server for unit test.
+// Skip identity check
+func (srv *FegToGwRelayServer) DeleteSubscriberDataUnverified(
+	ctx context.Context,
+	req *fegprotos.DeleteSubscriberDataRequest,
+) (*fegprotos.DeleteSubscriberDataAnswer, error) {
+	client, ctx, err := getS6aGatewayClient(req.UserName)
+	if err != nil {
+		return &fegprotos.DeleteSubscriberDataAnswer{ErrorCode: fegprotos.ErrorCode_USER_UNKNOWN}, err
+	}
+	return client.DeleteSubscriberData(ctx, req)
+}
+
+// getS6aGatewayClient returns S6a client & context of the gateway serving the given IMSI
+func getS6aGatewayClient(imsi string) (fegprotos.S6AGatewayServiceClient, context.Context, error) {
+	hwId, err := getHwIDFromIMSI(imsi)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to get HwID from IMSI %v. err: %v", imsi, err)
+	}
+	conn, ctx, err := gateway_registry.GetGatewayConnection(gateway_registry.GwS6aService, hwId)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to get connection to the gateway ID: %s", hwId)
+	}
+	return fegprotos.NewS6AGatewayServiceClient(conn), ctx, nil
+}
@@ -0,0 +1,85 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package servicers
+
+import (
+	"context"
+
+	fegprotos "magma/feg/cloud/go/protos"
+	"magma/orc8r/cloud/go/services/dispatcher/gateway_registry"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RegistrationTermination relays the RegistrationTerminationRequest to a corresponding
+// dispatcher service instance, who will in turn relay the request to the
+// corresponding gateway
+func (srv *FegToGwRelayServer) RegistrationTermination(
+	ctx context.Context,
+	req *fegprotos.RegistrationTerminationRequest,
+) (*fegprotos.RegistrationTerminationAnswer, error) {
+	if err := validateFegContext(ctx); err != nil {
+		return nil, err
+	}
+	return srv.RegistrationTerminationUnverified(ctx, req)
+}
+
+// RegistrationTerminationUnverified called directly in test server for unit test.
+// Skip identity check
+func (srv *FegToGwRelayServer) RegistrationTerminationUnverified(
+	ctx context.Context,
+	req *fegprotos.RegistrationTerminationRequest,
+) (*fegprotos.RegistrationTerminationAnswer, error) {
+	client, ctx, err := getSwxGatewayClient(req.UserName)
+	if err != nil {
+		return nil, err
+	}
+	return client.RegistrationTermination(ctx, req)
+}
+
+// PushProfile relays the PushProfileRequest to a corresponding
+// dispatcher service instance, who will in turn relay the request to the
+// corresponding gateway
+func (srv *FegToGwRelayServer) PushProfile(
+	ctx context.Context,
+	req *fegprotos.PushProfileRequest,
+) (*fegprotos.PushProfileAnswer, error) {
+	if err := validateFegContext(ctx); err != nil {
+		return nil, err
+	}
+	return srv.PushProfileUnverified(ctx, req)
+}
+
+// PushProfileUnverified called directly in test server for unit test.
+// Skip identity check
+func (srv *FegToGwRelayServer) PushProfileUnverified(
+	ctx context.Context,
+	req *fegprotos.PushProfileRequest,
+) (*fegprotos.PushProfileAnswer, error) {
+	client, ctx, err := getSwxGatewayClient(req.UserName)
+	if err != nil {
+		return nil, err
+	}
+	return client.PushProfile(ctx, req)
+}
+
+// getSwxGatewayClient returns SWx client & context of the AAA gateway service serving the given IMSI.
+// It returns a NotFound error if no gateway serves the IMSI.
+func getSwxGatewayClient(imsi string) (fegprotos.SwxGatewayServiceClient, context.Context, error) {
+	hwId, err := getHwIDFromIMSI(imsi)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.NotFound, "unable to get HwID from IMSI %v. err: %v", imsi, err)
+	}
+	conn, ctx, err := gateway_registry.GetGatewayConnection(gateway_registry.GwAAAService, hwId)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Unavailable, "unable to get connection to the gateway ID: %s", hwId)
+	}
+	return fegprotos.NewSwxGatewayServiceClient(conn), ctx, nil
+}
@@ -32,8 +32,37 @@ func (srv *testFegProxyServer) CancelLocation(
 	return srv.CancelLocationUnverified(ctx, req)
 }
 
+func (srv *testFegProxyServer) InsertSubscriberData(
+	ctx context.Context,
+	req *protos.InsertSubscriberDataRequest,
+) (*protos.InsertSubscriberDataAnswer, error) {
+	return srv.InsertSubscriberDataUnverified(ctx, req)
+}
+
+func (srv *testFegProxyServer) DeleteSubscriberData(
+	ctx context.Context,
+	req *protos.DeleteSubscriberDataRequest,
+) (*protos.DeleteSubscriberDataAnswer, error) {
+	return srv.DeleteSubscriberDataUnverified(ctx, req)
+}
+
+func (srv *testFegProxyServer) RegistrationTermination(
+	ctx context.Context,
+	req *protos.RegistrationTerminationRequest,
+) (*protos.RegistrationTerminationAnswer, error) {
+	return srv.RegistrationTerminationUnverified(ctx, req)
+}
+
+func (srv *testFegProxyServer) PushProfile(
+	ctx context.Context,
+	req *protos.PushProfileRequest,
+) (*protos.PushProfileAnswer, error) {
+	return srv.PushProfileUnverified(ctx, req)
+}
+
 func StartTestService(t *testing.T) {
 	srv, lis := test_utils.NewTestService(t, feg.ModuleName, feg_relay.ServiceName)
 	protos.RegisterS6AGatewayServiceServer(srv.GrpcServer, &testFegProxyServer{})
+	protos.RegisterSwxGatewayServiceServer(srv.GrpcServer, &testFegProxyServer{})
 	go srv.RunTest(lis)
 }
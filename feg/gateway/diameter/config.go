@@ -16,6 +16,8 @@ import (
 	"net"
 	"os"
 	"strings"
+
+	"magma/feg/cloud/go/protos/mconfig"
 )
 
 const (
@@ -33,7 +35,8 @@ const (
 	DestHostFlag  = "dest_host"
 	DestRealmFlag = "dest_realm"
 
-	DefaultWatchdogIntervalSeconds = 3
+	DefaultWatchdogIntervalSeconds  = 3
+	DefaultPeerRetryIntervalSeconds = 10
 )
 
 // Diameter flags
@@ -58,6 +61,7 @@ type DiameterServerConfig struct {
 	DiameterServerConnConfig
 	DestHost  string
 	DestRealm string
+	Weight    uint // relative weight for round robin peer selection, 0 is treated as 1
 }
 
 // DiameterClientConfig holds information for connecting with a diameter server
@@ -69,7 +73,11 @@ type DiameterClientConfig struct {
 	AuthAppID        uint32
 	Retransmits      uint
 	WatchdogInterval uint
-	RetryCount       uint // number of times to reconnect after connection lost
+	RetryCount       uint                    // number of times to reconnect after connection lost
+	PeerSelection    PeerSelection           // how requests are distributed across the server & its alternates
+	AlternateServers []*DiameterServerConfig // ordered list of servers to fail over to
+	RealmRoutes      []*RealmRoute           // Destination-Realm & Application-Id based routes
+	SessionFailover  bool                    // move sessions of an unreachable server to another server
 }
 
 func (cfg *DiameterServerConfig) Validate() error {
@@ -123,6 +131,53 @@ func (srcCfg *DiameterClientConfig) FillInDefaults() *DiameterClientConfig {
 	return &cfg
 }
 
+// GetAlternateServerConfigs returns the alternate servers of the given managed
+// client config in their configured order
+func GetAlternateServerConfigs(cfg *mconfig.DiamClientConfig) []*DiameterServerConfig {
+	return getServerConfigs(cfg.GetAlternateServers())
+}
+
+// GetRealmRoutes returns the realm routes of the given managed client config
+func GetRealmRoutes(cfg *mconfig.DiamClientConfig) []*RealmRoute {
+	var routes []*RealmRoute
+	for _, r := range cfg.GetRealmRoutes() {
+		if r == nil || len(r.GetRealm()) == 0 {
+			continue
+		}
+		route := &RealmRoute{
+			Realm:   r.GetRealm(),
+			AppID:   r.GetApplicationId(),
+			Action:  RouteLocal,
+			PLMNIDs: r.GetPlmnIds(),
+		}
+		if r.GetAction() == mconfig.DiamRealmRoute_RELAY {
+			route.Action = RouteRelay
+			route.Peers = NewServerGroup(PeerSelection(r.GetPeerSelection()), getServerConfigs(r.GetPeers())...)
+		}
+		routes = append(routes, route)
+	}
+	return routes
+}
+
+func getServerConfigs(cfgs []*mconfig.DiamServerConfig) []*DiameterServerConfig {
+	var servers []*DiameterServerConfig
+	for _, cfg := range cfgs {
+		if cfg == nil || len(cfg.GetAddress()) == 0 {
+			continue
+		}
+		servers = append(servers, &DiameterServerConfig{
+			DiameterServerConnConfig: DiameterServerConnConfig{
+				Addr:      cfg.GetAddress(),
+				Protocol:  cfg.GetProtocol(),
+				LocalAddr: cfg.GetLocalAddress()},
+			DestHost:  cfg.GetDestHost(),
+			DestRealm: cfg.GetDestRealm(),
+			Weight:    uint(cfg.GetWeight()),
+		})
+	}
+	return servers
+}
+
 // getUint64FlagValue looks up the flag and either returns its uint64 value
 // or an error.
 func getUint64FlagValue(flagName string) (uint64, error) {
@@ -13,6 +13,7 @@ import (
 	"net"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/fiorix/go-diameter/diam"
 	"github.com/fiorix/go-diameter/diam/avp"
@@ -26,8 +27,9 @@ import (
 type messageTypeEnum uint8
 
 const (
-	requestMessage messageTypeEnum = 1
-	answerMessage  messageTypeEnum = 2
+	requestMessage      messageTypeEnum = 1
+	answerMessage       messageTypeEnum = 2
+	relayRequestMessage messageTypeEnum = 3
 )
 
 // Connection is representing a diameter connection that you can
@@ -38,6 +40,12 @@ type Connection struct {
 	server   *DiameterServerConfig
 	client   *sm.Client
 	mutex    sync.Mutex
+
+	// peer liveness, guarded by its own mutex so it can be queried while a dial is in progress
+	alive       bool      // true while the connection is established & not closed by the watchdog
+	lastFailure time.Time // time of the last dial, write or watchdog failure, zero if none
+	lastDial    time.Time // time of the last dial attempt
+	stateMutex  sync.Mutex
 }
 
 func newConnection(client *sm.Client, server *DiameterServerConfig) *Connection {
@@ -68,6 +76,19 @@ func (c *Connection) SendRequestToServer(message *diam.Message, retryCount uint,
 	return c.sendMessageWithRetries(message, requestMessage, retryCount, server)
 }
 
+// RelayRequestToServer sends the request to the given relay agent peer, keeping
+// the request's Destination-Realm set to the given realm
+func (c *Connection) RelayRequestToServer(
+	message *diam.Message, retryCount uint, server *DiameterServerConfig, realm string) error {
+
+	relayServer := &DiameterServerConfig{
+		DiameterServerConnConfig: server.DiameterServerConnConfig,
+		DestHost:                 server.DestHost,
+		DestRealm:                realm,
+	}
+	return c.sendMessageWithRetries(message, relayRequestMessage, retryCount, relayServer)
+}
+
 func (c *Connection) sendMessageWithRetries(
 	message *diam.Message, messageType messageTypeEnum, retryCount uint, server *DiameterServerConfig) error {
 
@@ -105,6 +126,8 @@ func (c *Connection) sendMessage(
 		if err != nil {
 			return err
 		}
+	} else if messageType == relayRequestMessage {
+		message = addRelayDestinationToMessage(message, server.DestRealm, server)
 	}
 
 	// It's possible that the connection is closed here in contention for the
@@ -140,19 +163,98 @@ func (c *Connection) getDiamConnection() (diam.Conn, *smpeer.Metadata, error) {
 				"Invalid " + c.server.Protocol + " local address '" + c.server.LocalAddr + "':" + err.Error())
 		}
 	}
+	c.stateMutex.Lock()
+	c.lastDial = time.Now()
+	c.stateMutex.Unlock()
 	conn, err := c.client.DialExt(c.server.Protocol, c.server.Addr, 0, localAddr)
 	if err != nil {
+		c.markDown()
 		return nil, nil, err
 	}
 	metadata, ok := smpeer.FromContext(conn.Context())
 	if !ok {
 		conn.Close()
+		c.markDown()
 		return nil, nil, errors.New("Could not obtain metadata from connection")
 	}
 	c.conn, c.metadata = conn, metadata
+	c.markUp()
+	go c.monitorConnection(conn)
 	return conn, metadata, nil
 }
 
+// IsAlive returns true if the connection to the peer is established and
+// has not been closed by the peer or the DWR/DWA watchdog
+func (c *Connection) IsAlive() bool {
+	c.stateMutex.Lock()
+	defer c.stateMutex.Unlock()
+	return c.alive
+}
+
+// isAvailable returns true if the peer is alive or no failure has been seen yet
+func (c *Connection) isAvailable() bool {
+	c.stateMutex.Lock()
+	defer c.stateMutex.Unlock()
+	return c.alive || c.lastFailure.IsZero()
+}
+
+// shouldReconnect returns true and records a new dial attempt if the peer is down
+// and wasn't dialed for at least the given interval
+func (c *Connection) shouldReconnect(interval time.Duration) bool {
+	c.stateMutex.Lock()
+	defer c.stateMutex.Unlock()
+	if c.alive || time.Since(c.lastDial) < interval {
+		return false
+	}
+	c.lastDial = time.Now()
+	return true
+}
+
+// reconnect discards a connection closed by the watchdog (if any) and dials the peer again
+func (c *Connection) reconnect() {
+	c.mutex.Lock()
+	if c.conn != nil && !c.IsAlive() {
+		c.conn.Close()
+		c.conn, c.metadata = nil, nil
+	}
+	c.mutex.Unlock()
+	if _, _, err := c.getDiamConnection(); err != nil {
+		glog.V(2).Infof("Diameter peer %s://%s is still unreachable: %v", c.server.Protocol, c.server.Addr, err)
+	} else {
+		glog.Infof("Diameter peer %s://%s is reachable again", c.server.Protocol, c.server.Addr)
+	}
+}
+
+// monitorConnection marks the peer down once the given connection is closed,
+// either by the peer or by the watchdog after unanswered DWRs
+func (c *Connection) monitorConnection(conn diam.Conn) {
+	notifier, ok := conn.(diam.CloseNotifier)
+	if !ok {
+		return
+	}
+	<-notifier.CloseNotify()
+	c.mutex.Lock()
+	current := c.conn
+	c.mutex.Unlock()
+	if conn == current {
+		glog.Warningf("Diameter connection to %s://%s closed", c.server.Protocol, c.server.Addr)
+		c.markDown()
+	}
+}
+
+func (c *Connection) markUp() {
+	c.stateMutex.Lock()
+	c.alive = true
+	c.stateMutex.Unlock()
+}
+
+func (c *Connection) markDown() {
+	c.stateMutex.Lock()
+	c.alive = false
+	c.lastFailure = time.Now()
+	c.stateMutex.Unlock()
+}
+
 // destroyConnection closes a bad connection. If the connection
 // passed is the same as the one stored in the locked connection, it is nullified.
 // If the passed diam connection is not the same, this probably means another go routine
@@ -167,6 +269,7 @@ func (c *Connection) destroyConnection(conn diam.Conn) {
 	if conn == c.conn {
 		c.conn = nil
 		c.metadata = nil
+		c.markDown()
 	}
 }
 
@@ -179,6 +282,9 @@ func (c *Connection) cleanupConnection() {
 		c.conn = nil
 		c.metadata = nil
 	}
+	c.stateMutex.Lock()
+	c.alive = false
+	c.stateMutex.Unlock()
 }
 
 // addDestinationToMessage adds the destination host/realm AVPs to the message
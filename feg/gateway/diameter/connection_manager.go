@@ -13,7 +13,9 @@ import (
 	"sync"
 	"time"
 
+	"github.com/fiorix/go-diameter/diam"
 	"github.com/fiorix/go-diameter/diam/sm"
+	"github.com/golang/glog"
 )
 
 // ConnectionManager holds a map of connections keyed by the server ip/protocol
@@ -52,6 +54,69 @@ func (cm *ConnectionManager) GetConnection(client *sm.Client, server *DiameterSe
 	return conn, nil
 }
 
+// SendRequestToGroup sends the request to the server of the group selected for the
+// given session (an empty session ID selects a server for this request only).
+// Requests matching a relay route of the group's realm routing table are sent to
+// the route's peers instead.
+// If sending fails, the server is considered down and the request is sent to the
+// next available server of the group until all servers were tried, unless the
+// session is bound to the server and the group's session failover is disabled.
+// The key identifies the request in the group's AnswerReceived/AnswerTimedOut,
+// nil if answers are not tracked.
+func (cm *ConnectionManager) SendRequestToGroup(
+	client *sm.Client, group *ServerGroup, sessionID string, key interface{}, message *diam.Message, retryCount uint) error {
+
+	if group == nil || len(group.servers) == 0 {
+		return errors.New("ConnectionManager: Empty Server Group")
+	}
+	routed, relayRealm := group.route(message)
+	retryInterval := routed.getRetryInterval()
+	tried := map[*DiameterServerConfig]bool{}
+	untried := func(server *DiameterServerConfig) bool { return !tried[server] }
+	available := func(server *DiameterServerConfig) bool {
+		if tried[server] {
+			return false
+		}
+		conn, err := cm.GetConnection(client, server)
+		if err != nil {
+			return false
+		}
+		if conn.isAvailable() {
+			return true
+		}
+		// the server is down, check in the background if it's back to fail back to it
+		if conn.shouldReconnect(retryInterval) {
+			go conn.reconnect()
+		}
+		return false
+	}
+	err := errors.New("ConnectionManager: No server available for the session")
+	for len(tried) < len(routed.servers) {
+		server := routed.selectServer(sessionID, available, untried)
+		if server == nil {
+			break
+		}
+		tried[server] = true
+		var conn *Connection
+		conn, err = cm.GetConnection(client, server)
+		if err != nil {
+			return err
+		}
+		if len(relayRealm) > 0 {
+			err = conn.RelayRequestToServer(message, retryCount, server, relayRealm)
+		} else {
+			err = conn.SendRequestToServer(message, retryCount, server)
+		}
+		if err == nil {
+			routed.bindSession(sessionID, server)
+			group.requestSent(key, conn)
+			return nil
+		}
+		glog.Warningf("Failed to send diameter request to %s://%s: %v", server.Protocol, server.Addr, err)
+	}
+	return err
+}
+
 // CleanupAllConnections does exactly that
 func (cm *ConnectionManager) CleanupAllConnections() {
 	cm.rwl.Lock()
@@ -14,6 +14,7 @@ import (
 	"net"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/fiorix/go-diameter/diam"
@@ -46,6 +47,7 @@ type Client struct {
 	requestTracker *RequestTracker
 	cfg            *DiameterClientConfig
 	originStateID  uint32
+	groupRequests  sync.Map // key of a request sent to a server group -> *ServerGroup
 }
 
 // OriginHost returns client's config Host
@@ -191,6 +193,33 @@ func (client *Client) SendRequest(
 	return err
 }
 
+// SendRequestToGroup is like SendRequest, but sends the request to the server of
+// the group the given session is bound to, failing over to the group's next
+// available server if the session's server is unreachable
+// Input: group - servers to select from
+//				sessionID - session to keep on the same server, empty for stateless requests
+// 				done - channel to send the answer to when received
+//				message - request to send
+//				key - something to uniquely identify the request
+// Output: error if message sending to all servers failed, nil otherwise
+func (client *Client) SendRequestToGroup(
+	group *ServerGroup,
+	sessionID string,
+	done chan interface{},
+	message *diam.Message,
+	key interface{},
+) error {
+	client.requestTracker.RegisterRequest(key, done)
+	m := client.AddOriginAVPsToMessage(message)
+	err := client.connMan.SendRequestToGroup(client.smClient, group, sessionID, key, m, client.cfg.RetryCount)
+	if err != nil {
+		client.requestTracker.DeregisterRequest(key)
+	} else {
+		client.groupRequests.Store(key, group)
+	}
+	return err
+}
+
 // AddOriginAVPsToMessage adds the host/realm to the message
 func (client *Client) AddOriginAVPsToMessage(message *diam.Message) *diam.Message {
 	message.NewAVP(avp.OriginHost, avp.Mbit, 0, datatype.DiameterIdentity(client.cfg.Host))
@@ -212,6 +241,10 @@ func (client *Client) AddOriginAVPsToMessage(message *diam.Message) *diam.Messag
 // Input: key identifying request
 func (client *Client) IgnoreAnswer(key interface{}) {
 	client.requestTracker.DeregisterRequest(key)
+	if group, ok := client.groupRequests.Load(key); ok {
+		client.groupRequests.Delete(key)
+		group.(*ServerGroup).AnswerTimedOut(key)
+	}
 }
 
 // RegisterAnswerHandlerForAppID registers a function to be called when an answer message
@@ -227,6 +260,10 @@ func (client *Client) RegisterAnswerHandlerForAppID(command uint32, appID uint32
 		if answerKey.Key == nil {
 			return
 		}
+		if group, ok := client.groupRequests.Load(answerKey.Key); ok {
+			client.groupRequests.Delete(answerKey.Key)
+			group.(*ServerGroup).AnswerReceived(answerKey.Key)
+		}
 		doneChan := client.requestTracker.DeregisterRequest(answerKey.Key)
 		doneChan <- answerKey.Answer
 	})
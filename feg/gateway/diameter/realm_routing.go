@@ -0,0 +1,193 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package diameter
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/fiorix/go-diameter/diam"
+	"github.com/fiorix/go-diameter/diam/avp"
+	"github.com/fiorix/go-diameter/diam/datatype"
+)
+
+// AnyRealm is the realm of routes matching any Destination-Realm
+const AnyRealm = "*"
+
+// RouteAction defines what is done with requests matching a RealmRoute
+type RouteAction uint8
+
+const (
+	// RouteLocal sends matching requests to the interface's own servers
+	RouteLocal RouteAction = iota
+	// RouteRelay relays matching requests to the route's peers, keeping their Destination-Realm
+	RouteRelay
+)
+
+// RealmRoute is a Diameter Routing Agent style routing table entry
+type RealmRoute struct {
+	Realm   string // Destination-Realm to match, AnyRealm matches all realms
+	AppID   uint32 // Application-Id to match, 0 matches all applications
+	Action  RouteAction
+	Peers   *ServerGroup // relay peers, unused for local routes
+	PLMNIDs []string     // MCC+MNC of the subscribers whose requests are sent to the realm
+}
+
+// RealmRoutingTable selects the route of a request by its Destination-Realm & Application-Id
+type RealmRoutingTable struct {
+	routes []*RealmRoute
+}
+
+// NewRealmRoutingTable creates a routing table of the given routes, nil routes are skipped
+func NewRealmRoutingTable(routes ...*RealmRoute) *RealmRoutingTable {
+	table := &RealmRoutingTable{}
+	for _, route := range routes {
+		if route != nil {
+			table.routes = append(table.routes, route)
+		}
+	}
+	return table
+}
+
+// Lookup returns the best matching route for the given realm & application or nil if
+// no route matches. Realm matches take precedence over application matches & an
+// exact match is preferred over a wildcard one.
+func (t *RealmRoutingTable) Lookup(realm string, appID uint32) *RealmRoute {
+	if t == nil {
+		return nil
+	}
+	var (
+		best      *RealmRoute
+		bestScore = -1
+	)
+	for _, route := range t.routes {
+		score := 0
+		if strings.EqualFold(route.Realm, realm) {
+			score += 2
+		} else if route.Realm != AnyRealm {
+			continue
+		}
+		if route.AppID == appID {
+			score++
+		} else if route.AppID != 0 {
+			continue
+		}
+		if score > bestScore {
+			best, bestScore = route, score
+		}
+	}
+	return best
+}
+
+// RealmForIMSI returns the Destination-Realm of requests for the given IMSI: the
+// realm of the route with the longest PLMN ID matching the IMSI or, if that route
+// matches any realm, the EPC realm of the PLMN. Returns an empty string if no
+// route has a matching PLMN ID.
+func (t *RealmRoutingTable) RealmForIMSI(imsi string) string {
+	if t == nil {
+		return ""
+	}
+	var (
+		best     *RealmRoute
+		bestPLMN string
+	)
+	for _, route := range t.routes {
+		for _, plmn := range route.PLMNIDs {
+			if len(plmn) > len(bestPLMN) && strings.HasPrefix(imsi, plmn) {
+				best, bestPLMN = route, plmn
+			}
+		}
+	}
+	if best == nil {
+		return ""
+	}
+	if best.Realm == AnyRealm {
+		return PLMNRealm(bestPLMN)
+	}
+	return best.Realm
+}
+
+// PLMNRealm returns the EPC home network realm of the given 5 or 6 digit PLMN ID
+// as defined in 3GPP TS 23.003 19.2: epc.mnc<MNC>.mcc<MCC>.3gppnetwork.org
+func PLMNRealm(plmnID string) string {
+	if len(plmnID) < 5 || len(plmnID) > 6 {
+		return ""
+	}
+	mnc := plmnID[3:]
+	if len(mnc) == 2 {
+		mnc = "0" + mnc
+	}
+	return fmt.Sprintf("epc.mnc%s.mcc%s.3gppnetwork.org", mnc, plmnID[:3])
+}
+
+// AddDestinationRealmForIMSI sets the Destination-Realm of the request for the given
+// IMSI to the realm of the IMSI's PLMN (see RealmRoutingTable.RealmForIMSI), so that
+// the request is routed by its subscriber's home realm. The message is left unchanged
+// if the group has no routing table or none of its routes matches the IMSI's PLMN.
+func (g *ServerGroup) AddDestinationRealmForIMSI(message *diam.Message, imsi string) *diam.Message {
+	if g == nil || message == nil {
+		return message
+	}
+	realm := g.routes.RealmForIMSI(imsi)
+	if len(realm) == 0 {
+		return message
+	}
+	realmAVP, err := message.FindAVP(avp.DestinationRealm, 0)
+	if err != nil || realmAVP == nil {
+		message.NewAVP(avp.DestinationRealm, avp.Mbit, 0, datatype.DiameterIdentity(realm))
+	} else {
+		realmAVP.Data = datatype.DiameterIdentity(realm)
+	}
+	return message
+}
+
+// route returns the server group to send the message to and the realm to relay
+// it to, the realm is empty if the message is sent to the group's own servers
+func (g *ServerGroup) route(message *diam.Message) (*ServerGroup, string) {
+	if g.routes == nil {
+		return g, ""
+	}
+	realm := ""
+	if realmAVP, err := message.FindAVP(avp.DestinationRealm, 0); err == nil && realmAVP != nil {
+		if identity, ok := realmAVP.Data.(datatype.DiameterIdentity); ok {
+			realm = string(identity)
+		}
+	}
+	if len(realm) == 0 && len(g.servers) > 0 {
+		realm = g.servers[0].DestRealm
+	}
+	route := g.routes.Lookup(realm, message.Header.ApplicationID)
+	if route == nil || route.Action != RouteRelay || len(route.Peers.Servers()) == 0 {
+		return g, ""
+	}
+	return route.Peers, realm
+}
+
+// addRelayDestinationToMessage sets the Destination-Realm of a relayed request to the
+// given realm. Destination-Host is only added if the relay peer has one configured,
+// otherwise the relay agent would consider itself the final destination of the request.
+func addRelayDestinationToMessage(message *diam.Message, realm string, server *DiameterServerConfig) *diam.Message {
+	if len(realm) > 0 {
+		realmAVP, err := message.FindAVP(avp.DestinationRealm, 0)
+		if err != nil || realmAVP == nil {
+			message.NewAVP(avp.DestinationRealm, avp.Mbit, 0, datatype.DiameterIdentity(realm))
+		} else {
+			realmAVP.Data = datatype.DiameterIdentity(realm)
+		}
+	}
+	if server != nil && len(server.DestHost) > 0 {
+		hostAVP, err := message.FindAVP(avp.DestinationHost, 0)
+		if err != nil || hostAVP == nil {
+			message.NewAVP(avp.DestinationHost, avp.Mbit, 0, datatype.DiameterIdentity(server.DestHost))
+		} else {
+			hostAVP.Data = datatype.DiameterIdentity(server.DestHost)
+		}
+	}
+	return message
+}
@@ -0,0 +1,141 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package diameter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/fiorix/go-diameter/diam"
+	"github.com/fiorix/go-diameter/diam/avp"
+	"github.com/fiorix/go-diameter/diam/datatype"
+	"github.com/stretchr/testify/assert"
+
+	"magma/feg/cloud/go/protos/mconfig"
+)
+
+func TestRealmRoutingTableLookup(t *testing.T) {
+	defaultRoute := &RealmRoute{Realm: AnyRealm, Action: RouteRelay}
+	anyRealmS6a := &RealmRoute{Realm: AnyRealm, AppID: diam.TGPP_S6A_APP_ID, Action: RouteRelay}
+	partner := &RealmRoute{Realm: "partner.org", Action: RouteRelay}
+	partnerGy := &RealmRoute{Realm: "partner.org", AppID: diam.CHARGING_CONTROL_APP_ID, Action: RouteLocal}
+	table := NewRealmRoutingTable(defaultRoute, nil, anyRealmS6a, partner, partnerGy)
+
+	assert.Equal(t, partnerGy, table.Lookup("partner.org", diam.CHARGING_CONTROL_APP_ID))
+	assert.Equal(t, partnerGy, table.Lookup("PARTNER.org", diam.CHARGING_CONTROL_APP_ID))
+	assert.Equal(t, partner, table.Lookup("partner.org", diam.TGPP_S6A_APP_ID))
+	assert.Equal(t, anyRealmS6a, table.Lookup("other.org", diam.TGPP_S6A_APP_ID))
+	assert.Equal(t, defaultRoute, table.Lookup("other.org", diam.GX_CHARGING_CONTROL_APP_ID))
+
+	table = NewRealmRoutingTable(partner)
+	assert.Nil(t, table.Lookup("other.org", diam.GX_CHARGING_CONTROL_APP_ID))
+	assert.Nil(t, (*RealmRoutingTable)(nil).Lookup("partner.org", 0))
+}
+
+func TestRealmForIMSI(t *testing.T) {
+	partner := &RealmRoute{Realm: "partner.org", Action: RouteRelay, PLMNIDs: []string{"00102"}}
+	roaming := &RealmRoute{Realm: AnyRealm, Action: RouteRelay, PLMNIDs: []string{"310", "310410"}}
+	table := NewRealmRoutingTable(partner, roaming)
+
+	assert.Equal(t, "partner.org", table.RealmForIMSI("001020000000001"))
+	assert.Equal(t, "epc.mnc410.mcc310.3gppnetwork.org", table.RealmForIMSI("310410000000001"))
+	assert.Equal(t, "", table.RealmForIMSI("310260000000001")) // "310" isn't a valid PLMN ID
+	assert.Equal(t, "", table.RealmForIMSI("001010000000001"))
+	assert.Equal(t, "", (*RealmRoutingTable)(nil).RealmForIMSI("001020000000001"))
+
+	assert.Equal(t, "epc.mnc001.mcc001.3gppnetwork.org", PLMNRealm("00101"))
+	assert.Equal(t, "epc.mnc410.mcc310.3gppnetwork.org", PLMNRealm("310410"))
+	assert.Equal(t, "", PLMNRealm("0010"))
+
+	group := NewServerGroup(PeerSelectionFailover, newTestServerConfig("s1:1", 0))
+	group.SetRoutingTable(table)
+	m := group.AddDestinationRealmForIMSI(newTestCCRequest(), "001020000000001")
+	realm, err := m.FindAVP(avp.DestinationRealm, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, datatype.DiameterIdentity("partner.org"), realm.Data)
+
+	m = group.AddDestinationRealmForIMSI(newTestCCRequest(), "001010000000001")
+	_, err = m.FindAVP(avp.DestinationRealm, 0)
+	assert.Error(t, err)
+	assert.NotNil(t, (*ServerGroup)(nil).AddDestinationRealmForIMSI(newTestCCRequest(), "001020000000001"))
+}
+
+func TestGetRealmRoutes(t *testing.T) {
+	routes := GetRealmRoutes(&mconfig.DiamClientConfig{
+		RealmRoutes: []*mconfig.DiamRealmRoute{
+			{Realm: "home.org", Action: mconfig.DiamRealmRoute_LOCAL},
+			{
+				Realm:         "partner.org",
+				ApplicationId: diam.TGPP_S6A_APP_ID,
+				Action:        mconfig.DiamRealmRoute_RELAY,
+				Peers: []*mconfig.DiamServerConfig{
+					{Address: "dea1:3868", Protocol: "sctp"},
+					{Address: ""},
+					{Address: "dea2:3868", Protocol: "sctp", Weight: 2},
+				},
+				PeerSelection: mconfig.PeerSelection_ROUND_ROBIN,
+			},
+			{Action: mconfig.DiamRealmRoute_RELAY},
+		},
+	})
+	assert.Len(t, routes, 2)
+	assert.Equal(t, &RealmRoute{Realm: "home.org", Action: RouteLocal}, routes[0])
+	relay := routes[1]
+	assert.Equal(t, "partner.org", relay.Realm)
+	assert.Equal(t, uint32(diam.TGPP_S6A_APP_ID), relay.AppID)
+	assert.Equal(t, RouteRelay, relay.Action)
+	assert.Equal(t, PeerSelectionRoundRobin, relay.Peers.selection)
+	if assert.Len(t, relay.Peers.Servers(), 2) {
+		assert.Equal(t, "dea1:3868", relay.Peers.Servers()[0].Addr)
+		assert.Equal(t, uint(2), relay.Peers.Servers()[1].Weight)
+	}
+}
+
+// TestRelayRequest verifies that requests for a relayed realm are sent to the
+// realm's relay peer with their Destination-Realm and without a Destination-Host
+func TestRelayRequest(t *testing.T) {
+	localReceived, relayReceived := make(chan *diam.Message, 1), make(chan *diam.Message, 1)
+	local := startTestCCServer(t, localReceived)
+	local.DestHost, local.DestRealm = "ocs.home.org", "home.org"
+	relay := startTestCCServer(t, relayReceived)
+
+	group := NewClientServerGroup(
+		&DiameterClientConfig{RealmRoutes: []*RealmRoute{
+			{Realm: "partner.org", Action: RouteRelay, Peers: NewServerGroup(PeerSelectionFailover, relay)},
+		}},
+		local)
+	cli := newTestCCClient()
+	connMan := NewConnectionManager()
+
+	receive := func(received chan *diam.Message) *diam.Message {
+		select {
+		case m := <-received:
+			return m
+		case <-time.After(time.Second):
+			t.Fatal("Request timeout")
+		}
+		return nil
+	}
+	// requests without a matching relay route go to the group's own server
+	assert.NoError(t, connMan.SendRequestToGroup(cli, group, "", nil, newTestCCRequest(), 0))
+	m := receive(localReceived)
+	realm, err := m.FindAVP(avp.DestinationRealm, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, datatype.DiameterIdentity("home.org"), realm.Data)
+
+	req := newTestCCRequest()
+	req.NewAVP(avp.DestinationRealm, avp.Mbit, 0, datatype.DiameterIdentity("partner.org"))
+	assert.NoError(t, connMan.SendRequestToGroup(cli, group, "", nil, req, 0))
+	m = receive(relayReceived)
+	realm, err = m.FindAVP(avp.DestinationRealm, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, datatype.DiameterIdentity("partner.org"), realm.Data)
+	_, err = m.FindAVP(avp.DestinationHost, 0)
+	assert.Error(t, err)
+}
@@ -0,0 +1,309 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package diameter
+
+import (
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+)
+
+// PeerSelection defines how a ServerGroup distributes requests across its servers
+type PeerSelection uint8
+
+const (
+	// PeerSelectionFailover sends all new sessions to the first reachable server in configured order
+	PeerSelectionFailover PeerSelection = iota
+	// PeerSelectionRoundRobin distributes new sessions across all reachable servers by their weights
+	PeerSelectionRoundRobin
+)
+
+const (
+	// DefaultSessionTimeout is how long a session's server binding is kept after
+	// the session's last request if the session is never released
+	DefaultSessionTimeout = time.Hour * 24
+	// DefaultMaxAnswerTimeouts is the number of consecutive unanswered requests
+	// after which a server is considered down
+	DefaultMaxAnswerTimeouts = 3
+
+	sessionPurgeInterval = time.Minute
+)
+
+// ServerGroup is an ordered list of diameter servers serving the same interface.
+// New sessions are assigned to a reachable server according to the group's
+// PeerSelection; established sessions stick to their server until the session
+// is released or expires. Sessions are moved to another server when their
+// server becomes unreachable only if session failover is enabled (see
+// CC-Session-Failover, RFC 4006 8.4), stateless requests are always failed over.
+type ServerGroup struct {
+	servers           []*DiameterServerConfig
+	selection         PeerSelection
+	retryInterval     time.Duration
+	sessionFailover   bool
+	sessionTimeout    time.Duration
+	sessions          map[string]*sessionBinding // session ID -> server the session is bound to
+	lastPurge         time.Time                  // time expired sessions were last removed
+	currentWeights    []int                      // smooth weighted round robin state
+	routes            *RealmRoutingTable         // relay routes of the group, nil if none
+	pending           map[interface{}]*Connection
+	answerTimeouts    map[*Connection]uint // consecutive unanswered requests per connection
+	maxAnswerTimeouts uint
+	mutex             sync.Mutex
+}
+
+type sessionBinding struct {
+	server   *DiameterServerConfig
+	lastUsed time.Time
+}
+
+// NewServerGroup creates a new server group for the given servers, nil servers are skipped
+func NewServerGroup(selection PeerSelection, servers ...*DiameterServerConfig) *ServerGroup {
+	group := &ServerGroup{
+		selection:         selection,
+		retryInterval:     time.Second * DefaultPeerRetryIntervalSeconds,
+		sessionTimeout:    DefaultSessionTimeout,
+		sessions:          map[string]*sessionBinding{},
+		pending:           map[interface{}]*Connection{},
+		answerTimeouts:    map[*Connection]uint{},
+		maxAnswerTimeouts: DefaultMaxAnswerTimeouts,
+	}
+	for _, server := range servers {
+		if server != nil {
+			group.servers = append(group.servers, server)
+		}
+	}
+	group.currentWeights = make([]int, len(group.servers))
+	return group
+}
+
+// NewClientServerGroup creates a server group of the given servers followed by
+// the client config's alternate servers
+func NewClientServerGroup(clientCfg *DiameterClientConfig, servers ...*DiameterServerConfig) *ServerGroup {
+	if clientCfg == nil {
+		return NewServerGroup(PeerSelectionFailover, servers...)
+	}
+	all := make([]*DiameterServerConfig, 0, len(servers)+len(clientCfg.AlternateServers))
+	all = append(append(all, servers...), clientCfg.AlternateServers...)
+	group := NewServerGroup(clientCfg.PeerSelection, all...)
+	group.sessionFailover = clientCfg.SessionFailover
+	if len(clientCfg.RealmRoutes) > 0 {
+		group.routes = NewRealmRoutingTable(clientCfg.RealmRoutes...)
+	}
+	return group
+}
+
+// Servers returns the group's servers in configured order
+func (g *ServerGroup) Servers() []*DiameterServerConfig {
+	if g == nil {
+		return nil
+	}
+	return g.servers
+}
+
+// SetRoutingTable sets the realm routing table used to relay requests of the group
+// to other peers, requests not matching any relay route are sent to the group's servers
+func (g *ServerGroup) SetRoutingTable(routes *RealmRoutingTable) {
+	g.routes = routes
+}
+
+// SetRetryInterval sets how often an unreachable server of the group is
+// reconnected to, to detect that it is back up
+func (g *ServerGroup) SetRetryInterval(interval time.Duration) {
+	g.mutex.Lock()
+	g.retryInterval = interval
+	g.mutex.Unlock()
+}
+
+// SetSessionFailover sets whether sessions bound to an unreachable server are
+// moved to another server of the group, otherwise their requests fail until
+// the server is back
+func (g *ServerGroup) SetSessionFailover(enabled bool) {
+	g.mutex.Lock()
+	g.sessionFailover = enabled
+	g.mutex.Unlock()
+}
+
+// SetSessionTimeout sets how long a session's server binding is kept after the
+// session's last request, bindings of sessions which are never released (no
+// termination request) are removed after it
+func (g *ServerGroup) SetSessionTimeout(timeout time.Duration) {
+	g.mutex.Lock()
+	g.sessionTimeout = timeout
+	g.mutex.Unlock()
+}
+
+// AnswerReceived must be called when the answer to the request sent to the
+// group with the given key is received, it resets the server's timeout count
+func (g *ServerGroup) AnswerReceived(key interface{}) {
+	if g == nil {
+		return
+	}
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	if conn, ok := g.pending[key]; ok {
+		delete(g.pending, key)
+		delete(g.answerTimeouts, conn)
+	}
+}
+
+// AnswerTimedOut must be called when the answer to the request sent to the
+// group with the given key is not received in time. The server is marked
+// down after DefaultMaxAnswerTimeouts consecutive timeouts so that requests
+// fail over to other servers until it answers again.
+func (g *ServerGroup) AnswerTimedOut(key interface{}) {
+	if g == nil {
+		return
+	}
+	g.mutex.Lock()
+	conn, ok := g.pending[key]
+	if !ok {
+		g.mutex.Unlock()
+		return
+	}
+	delete(g.pending, key)
+	g.answerTimeouts[conn]++
+	down := g.answerTimeouts[conn] >= g.maxAnswerTimeouts
+	if down {
+		delete(g.answerTimeouts, conn)
+	}
+	g.mutex.Unlock()
+	if down {
+		glog.Warningf("Diameter peer %s://%s did not answer %d consecutive requests, marking it down",
+			conn.server.Protocol, conn.server.Addr, g.maxAnswerTimeouts)
+		conn.markDown()
+	}
+}
+
+// requestSent records that the request with the given key was sent over conn
+func (g *ServerGroup) requestSent(key interface{}, conn *Connection) {
+	if key == nil {
+		return
+	}
+	g.mutex.Lock()
+	g.pending[key] = conn
+	g.mutex.Unlock()
+}
+
+// ReleaseSession removes the server binding of the given session, it should be
+// called once the session is terminated
+func (g *ServerGroup) ReleaseSession(sessionID string) {
+	if g == nil || len(sessionID) == 0 {
+		return
+	}
+	g.mutex.Lock()
+	delete(g.sessions, sessionID)
+	g.mutex.Unlock()
+}
+
+// getRetryInterval returns the group's retry interval
+func (g *ServerGroup) getRetryInterval() time.Duration {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	return g.retryInterval
+}
+
+// selectServer returns the server to send the given session's next request to.
+// The session's bound server is returned while it's available. Otherwise, if
+// the session is new, stateless or session failover is enabled, a server is
+// selected among the available ones, or among the untried ones in configured
+// order if none is available.
+// Returns nil if no server can be selected.
+func (g *ServerGroup) selectServer(
+	sessionID string, available, untried func(*DiameterServerConfig) bool) *DiameterServerConfig {
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	now := time.Now()
+	g.purgeSessions(now)
+	if binding, isBound := g.sessions[sessionID]; isBound {
+		if available(binding.server) || (!g.sessionFailover && untried(binding.server)) {
+			binding.lastUsed = now
+			return binding.server
+		}
+		if !g.sessionFailover {
+			return nil
+		}
+	}
+	var server *DiameterServerConfig
+	if g.selection == PeerSelectionRoundRobin {
+		server = g.nextWeighted(available)
+	} else {
+		server = g.first(available)
+	}
+	if server == nil {
+		// none of the servers is known to be reachable, try them in order
+		server = g.first(untried)
+	}
+	return server
+}
+
+// bindSession binds the given session to the server its request was sent to,
+// the session's next requests are sent to the same server
+func (g *ServerGroup) bindSession(sessionID string, server *DiameterServerConfig) {
+	if len(sessionID) == 0 {
+		return
+	}
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	binding, isBound := g.sessions[sessionID]
+	if isBound && binding.server != server {
+		glog.Warningf("Diameter session %s: failing over from %s to %s", sessionID, binding.server.Addr, server.Addr)
+	}
+	g.sessions[sessionID] = &sessionBinding{server: server, lastUsed: time.Now()}
+}
+
+// first returns the first server of the group matching the predicate
+func (g *ServerGroup) first(predicate func(*DiameterServerConfig) bool) *DiameterServerConfig {
+	for _, s := range g.servers {
+		if predicate(s) {
+			return s
+		}
+	}
+	return nil
+}
+
+// purgeSessions removes the bindings of sessions idle for longer than the
+// session timeout, at most once per sessionPurgeInterval
+func (g *ServerGroup) purgeSessions(now time.Time) {
+	if now.Sub(g.lastPurge) < sessionPurgeInterval {
+		return
+	}
+	g.lastPurge = now
+	for id, binding := range g.sessions {
+		if now.Sub(binding.lastUsed) > g.sessionTimeout {
+			delete(g.sessions, id)
+		}
+	}
+}
+
+// nextWeighted implements smooth weighted round robin over the available servers
+func (g *ServerGroup) nextWeighted(available func(*DiameterServerConfig) bool) *DiameterServerConfig {
+	best, total := -1, 0
+	for i, s := range g.servers {
+		if !available(s) {
+			continue
+		}
+		weight := int(s.Weight)
+		if weight == 0 {
+			weight = 1
+		}
+		g.currentWeights[i] += weight
+		total += weight
+		if best < 0 || g.currentWeights[i] > g.currentWeights[best] {
+			best = i
+		}
+	}
+	if best < 0 {
+		return nil
+	}
+	g.currentWeights[best] -= total
+	return g.servers[best]
+}
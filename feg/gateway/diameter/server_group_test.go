@@ -0,0 +1,253 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package diameter
+
+import (
+	"log"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/fiorix/go-diameter/diam"
+	"github.com/fiorix/go-diameter/diam/avp"
+	"github.com/fiorix/go-diameter/diam/datatype"
+	"github.com/fiorix/go-diameter/diam/dict"
+	"github.com/fiorix/go-diameter/diam/sm"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestServerConfig(addr string, weight uint) *DiameterServerConfig {
+	return &DiameterServerConfig{
+		DiameterServerConnConfig: DiameterServerConnConfig{Addr: addr, Protocol: "tcp"},
+		Weight:                   weight,
+	}
+}
+
+// selectAndBind selects the session's server like SendRequestToGroup does when
+// the request is sent successfully
+func selectAndBind(
+	group *ServerGroup, sessionID string, available func(*DiameterServerConfig) bool) *DiameterServerConfig {
+
+	server := group.selectServer(sessionID, available, func(*DiameterServerConfig) bool { return false })
+	if server != nil {
+		group.bindSession(sessionID, server)
+	}
+	return server
+}
+
+func TestServerGroupFailoverSelection(t *testing.T) {
+	s1, s2, s3 := newTestServerConfig("s1:1", 0), newTestServerConfig("s2:1", 0), newTestServerConfig("s3:1", 0)
+	group := NewServerGroup(PeerSelectionFailover, s1, nil, s2, s3)
+	assert.Equal(t, []*DiameterServerConfig{s1, s2, s3}, group.Servers())
+
+	down := map[*DiameterServerConfig]bool{}
+	available := func(s *DiameterServerConfig) bool { return !down[s] }
+
+	assert.Equal(t, s1, selectAndBind(group, "session1", available))
+	assert.Equal(t, s1, selectAndBind(group, "", available))
+
+	// primary goes down: new & stateless requests fail over in configured order,
+	// established sessions stick to their server
+	down[s1] = true
+	assert.Nil(t, selectAndBind(group, "session1", available))
+	assert.Equal(t, s2, selectAndBind(group, "session2", available))
+	assert.Equal(t, s2, selectAndBind(group, "", available))
+
+	// with session failover, established sessions move to the next server
+	group.SetSessionFailover(true)
+	assert.Equal(t, s2, selectAndBind(group, "session1", available))
+
+	// primary is back: new sessions fail back, established sessions stay on their server
+	down[s1] = false
+	assert.Equal(t, s2, selectAndBind(group, "session1", available))
+	assert.Equal(t, s1, selectAndBind(group, "session3", available))
+
+	// released sessions are selected again
+	group.ReleaseSession("session1")
+	assert.Equal(t, s1, selectAndBind(group, "session1", available))
+
+	down[s1], down[s2], down[s3] = true, true, true
+	assert.Nil(t, selectAndBind(group, "session4", available))
+
+	// when no server is known to be reachable, untried servers are selected in order
+	tried := map[*DiameterServerConfig]bool{s1: true}
+	untried := func(s *DiameterServerConfig) bool { return !tried[s] }
+	assert.Equal(t, s2, group.selectServer("session4", available, untried))
+}
+
+func TestServerGroupSessionTimeout(t *testing.T) {
+	s1, s2 := newTestServerConfig("s1:1", 0), newTestServerConfig("s2:1", 0)
+	group := NewServerGroup(PeerSelectionFailover, s1, s2)
+	group.SetSessionTimeout(time.Millisecond)
+	available := func(s *DiameterServerConfig) bool { return true }
+
+	assert.Equal(t, s1, selectAndBind(group, "session1", available))
+	assert.Len(t, group.sessions, 1)
+	time.Sleep(time.Millisecond * 5)
+	// purging runs at most once per interval
+	selectAndBind(group, "", available)
+	assert.Len(t, group.sessions, 1)
+
+	// next selection after the interval purges sessions idle for longer than the timeout
+	group.lastPurge = time.Now().Add(-sessionPurgeInterval)
+	selectAndBind(group, "", available)
+	assert.Empty(t, group.sessions)
+}
+
+func TestServerGroupAnswerTimeouts(t *testing.T) {
+	server := newTestServerConfig("s1:1", 0)
+	group := NewServerGroup(PeerSelectionFailover, server)
+	conn := &Connection{server: server, alive: true}
+
+	for i := 0; i < DefaultMaxAnswerTimeouts-1; i++ {
+		group.requestSent(i, conn)
+		group.AnswerTimedOut(i)
+	}
+	assert.True(t, conn.IsAlive())
+	// an answer resets the count of consecutive timeouts
+	group.requestSent("answered", conn)
+	group.AnswerReceived("answered")
+	group.requestSent("late", conn)
+	group.AnswerTimedOut("late")
+	assert.True(t, conn.IsAlive())
+
+	for i := 0; i < DefaultMaxAnswerTimeouts-1; i++ {
+		group.requestSent(i, conn)
+		group.AnswerTimedOut(i)
+	}
+	assert.False(t, conn.IsAlive())
+	assert.False(t, conn.isAvailable())
+	assert.Empty(t, group.pending)
+}
+
+func TestServerGroupRoundRobinSelection(t *testing.T) {
+	s1, s2 := newTestServerConfig("s1:1", 2), newTestServerConfig("s2:1", 1)
+	group := NewServerGroup(PeerSelectionRoundRobin, s1, s2)
+	down := map[*DiameterServerConfig]bool{}
+	available := func(s *DiameterServerConfig) bool { return !down[s] }
+
+	counts := map[*DiameterServerConfig]int{}
+	for i := 0; i < 9; i++ {
+		counts[selectAndBind(group, "", available)]++
+	}
+	assert.Equal(t, 6, counts[s1])
+	assert.Equal(t, 3, counts[s2])
+
+	// sessions stick to their selected server
+	first := selectAndBind(group, "session1", available)
+	for i := 0; i < 3; i++ {
+		assert.Equal(t, first, selectAndBind(group, "session1", available))
+	}
+	down[s1] = true
+	for i := 0; i < 3; i++ {
+		assert.Equal(t, s2, selectAndBind(group, "", available))
+	}
+}
+
+func TestNewClientServerGroup(t *testing.T) {
+	primary, alt := newTestServerConfig("s1:1", 0), newTestServerConfig("s2:1", 0)
+	group := NewClientServerGroup(
+		&DiameterClientConfig{PeerSelection: PeerSelectionRoundRobin, AlternateServers: []*DiameterServerConfig{alt}},
+		primary)
+	assert.Equal(t, []*DiameterServerConfig{primary, alt}, group.Servers())
+	assert.Equal(t, PeerSelectionRoundRobin, group.selection)
+
+	group = NewClientServerGroup(nil, primary)
+	assert.Equal(t, []*DiameterServerConfig{primary}, group.Servers())
+	assert.Equal(t, PeerSelectionFailover, group.selection)
+}
+
+// TestSendRequestToGroup verifies that requests are sent to the next server of
+// the group when the primary server is unreachable
+func TestSendRequestToGroup(t *testing.T) {
+	// reserve a local port with nothing listening on it for the unreachable primary server
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	primary := newTestServerConfig(l.Addr().String(), 0)
+	l.Close()
+
+	received := make(chan *diam.Message, 1)
+	alternate := startTestCCServer(t, received)
+	cli := newTestCCClient()
+
+	connMan := NewConnectionManager()
+	group := NewServerGroup(PeerSelectionFailover, primary, alternate)
+	for i := 0; i < 2; i++ {
+		assert.NoError(t, connMan.SendRequestToGroup(cli, group, "session1", nil, newTestCCRequest(), 0))
+		select {
+		case <-received:
+		case <-time.After(time.Second):
+			t.Fatal("SendRequestToGroup timeout")
+		}
+	}
+	primaryConn, err := connMan.GetConnection(cli, primary)
+	assert.NoError(t, err)
+	assert.False(t, primaryConn.IsAlive())
+	alternateConn, err := connMan.GetConnection(cli, alternate)
+	assert.NoError(t, err)
+	assert.True(t, alternateConn.IsAlive())
+
+	connMan.DisableFor(time.Second)
+	assert.Error(t, connMan.SendRequestToGroup(cli, group, "session1", nil, newTestCCRequest(), 0))
+}
+
+const (
+	testHost  = datatype.DiameterIdentity("test.test.com")
+	testRealm = datatype.DiameterIdentity("test.com")
+)
+
+// startTestCCServer starts a diameter server forwarding received CCRs to the given
+// channel and returns its config
+func startTestCCServer(t *testing.T, received chan *diam.Message) *DiameterServerConfig {
+	serverMux := sm.New(&sm.Settings{
+		OriginHost:  testHost,
+		OriginRealm: testRealm,
+		VendorID:    datatype.Unsigned32(Vendor3GPP),
+		ProductName: datatype.UTF8String("test server"),
+	})
+	serverMux.HandleIdx(
+		diam.CommandIndex{AppID: diam.CHARGING_CONTROL_APP_ID, Code: diam.CreditControl, Request: true},
+		diam.HandlerFunc(func(_ diam.Conn, m *diam.Message) { received <- m }))
+	listener, err := diam.MultistreamListen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Could not create server socket: %v", err)
+	}
+	server := newTestServerConfig(listener.Addr().String(), 0)
+	go func() {
+		srv := &diam.Server{Network: "tcp", Addr: server.Addr, Handler: serverMux}
+		if err := srv.Serve(listener); err != nil {
+			log.Printf("Test server stopped: %v", err)
+		}
+	}()
+	return server
+}
+
+func newTestCCClient() *sm.Client {
+	return &sm.Client{
+		Dict: dict.Default,
+		Handler: sm.New(&sm.Settings{
+			OriginHost:  testHost,
+			OriginRealm: testRealm,
+			VendorID:    datatype.Unsigned32(Vendor3GPP),
+			ProductName: datatype.UTF8String("test client"),
+		}),
+		MaxRetransmits:     1,
+		RetransmitInterval: time.Second,
+		AuthApplicationID: []*diam.AVP{
+			diam.NewAVP(avp.AuthApplicationID, avp.Mbit, 0, datatype.Unsigned32(diam.CHARGING_CONTROL_APP_ID)),
+		},
+	}
+}
+
+func newTestCCRequest() *diam.Message {
+	m := diam.NewRequest(diam.CreditControl, diam.CHARGING_CONTROL_APP_ID, nil)
+	m.NewAVP(avp.OriginHost, avp.Mbit, 0, testHost)
+	m.NewAVP(avp.OriginRealm, avp.Mbit, 0, testRealm)
+	return m
+}
@@ -223,6 +223,14 @@ func TestPolicyDBBaseNamesWithMockUpdates(t *testing.T) {
 	listener.Update(&orcprotos.DataUpdateBatch{Updates: updates, Resync: true})
 	ruleIDs = dbClient.GetRuleIDsForBaseNames([]string{"base_1", "base_2"})
 	assert.ElementsMatch(t, ruleIDs, []string{"rule11", "rule12", "rule31"})
+
+	// Incremental updates modify & delete only the listed keys
+	updates = []*orcprotos.DataUpdate{
+		{Key: "base_3", Value: rs2},
+	}
+	listener.Update(&orcprotos.DataUpdateBatch{Updates: updates, DeletedKeys: []string{"base_1"}, Cursor: "c1"})
+	ruleIDs = dbClient.GetRuleIDsForBaseNames([]string{"base_1", "base_2", "base_3"})
+	assert.ElementsMatch(t, ruleIDs, []string{"rule31", "rule21", "rule22"})
 }
 
 func TestPolicyDBRulesWithMockUpdates(t *testing.T) {
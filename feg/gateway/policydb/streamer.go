@@ -51,6 +51,15 @@ func (listener *storedObjectListener) ReportError(e error) error {
 
 func (listener *storedObjectListener) Update(ub *orcprotos.DataUpdateBatch) bool {
 	if !ub.GetResync() {
+		// Incremental update, apply changes & deletions only
+		for _, u := range ub.GetUpdates() {
+			listener.set(u)
+		}
+		for _, key := range ub.GetDeletedKeys() {
+			if err := listener.streamMap.Delete(key); err != nil {
+				glog.Errorf("Streamer deletion Error: %v for %s '%s'", err, listener.name, key)
+			}
+		}
 		return true
 	}
 
@@ -61,14 +70,7 @@ func (listener *storedObjectListener) Update(ub *orcprotos.DataUpdateBatch) bool
 	}
 
 	for _, u := range ub.GetUpdates() {
-		messageSet := proto.Clone(listener.protoBuf)
-		if err := proto.Unmarshal(u.GetValue(), messageSet); err != nil {
-			glog.Errorf("Streamer Unmarshal Error: %v for %s '%s'", err, listener.name, u.GetKey())
-			continue
-		}
-		if err := listener.streamMap.Set(u.GetKey(), messageSet); err != nil {
-			glog.Errorf("Streamer store Error: %v for %s '%s'", err, listener.name, u.GetKey())
-		}
+		listener.set(u)
 		delete(currMap, u.GetKey())
 	}
 	for key, _ := range currMap {
@@ -79,3 +81,14 @@ func (listener *storedObjectListener) Update(ub *orcprotos.DataUpdateBatch) bool
 	}
 	return true
 }
+
+func (listener *storedObjectListener) set(u *orcprotos.DataUpdate) {
+	messageSet := proto.Clone(listener.protoBuf)
+	if err := proto.Unmarshal(u.GetValue(), messageSet); err != nil {
+		glog.Errorf("Streamer Unmarshal Error: %v for %s '%s'", err, listener.name, u.GetKey())
+		return
+	}
+	if err := listener.streamMap.Set(u.GetKey(), messageSet); err != nil {
+		glog.Errorf("Streamer store Error: %v for %s '%s'", err, listener.name, u.GetKey())
+	}
+}
@@ -25,6 +25,8 @@ const (
 	FEG_HELLO     = "FEG_HELLO"
 	EAP           = "EAP"
 	EAP_AKA       = "EAP_AKA"
+	EAP_AKA_PRIME = "EAP_AKA_PRIME"
+	EAP_SIM       = "EAP_SIM"
 	RADIUS        = "RADIUS"
 	MOCK_VLR      = "MOCK_VLR"
 	MOCK_OCS      = "MOCK_OCS"
@@ -63,6 +65,8 @@ func init() {
 	addLocalService(RADIUS, 9108)
 	addLocalService(EAP, 9109)
 	addLocalService(EAP_AKA, 9123)
+	addLocalService(EAP_AKA_PRIME, 9124)
+	addLocalService(EAP_SIM, 9125)
 	addLocalService(SWX_PROXY, 9110)
 
 	addLocalService(MOCK_OCS, 9201)
@@ -26,6 +26,8 @@ import (
 	eap_protos "magma/feg/gateway/services/eap/protos"
 	"magma/feg/gateway/services/eap/providers/aka/servicers"
 	_ "magma/feg/gateway/services/eap/providers/aka/servicers/handlers"
+	aka_prime_servicers "magma/feg/gateway/services/eap/providers/aka_prime/servicers"
+	_ "magma/feg/gateway/services/eap/providers/aka_prime/servicers/handlers"
 	eap_test "magma/feg/gateway/services/eap/test"
 	"magma/orc8r/cloud/go/test_utils"
 )
@@ -125,8 +127,10 @@ func TestEAPPeerNak(t *testing.T) {
 		eap.ResponseCode, 236,
 		append([]byte{eap_client.EapMethodIdentity}, []byte("6001010000000091@wlan.mnc001.mcc001.3gppnetwork.org")...))
 	permIdReq := []byte{0x01, 237, 0x00, 0x0c, 0x17, 0x05, 0x00, 0x00, 0x0a, 0x01, 0x00, 0x00}
+	akaPrimePermIdReq := []byte{0x01, 238, 0x00, 0x0c, 0x32, 0x05, 0x00, 0x00, 0x0a, 0x01, 0x00, 0x00}
+	unsupportedNak := []byte{0x02, 237, 0x00, 0x06, 0x03, 99}
 	akaPrimeNak := []byte{0x02, 237, 0x00, 0x06, 0x03, 50}
-	akaAkaPrimeNak := []byte{0x02, 236, 0x00, 0x07, 0x03, 50, 23}
+	akaAkaPrimeNak := []byte{0x02, 236, 0x00, 0x07, 0x03, 23, 50}
 
 	eapSrv, eapLis := test_utils.NewTestService(t, registry.ModuleName, registry.EAP_AKA)
 	servicer, err := servicers.NewEapAkaService(nil)
@@ -137,6 +141,15 @@ func TestEAPPeerNak(t *testing.T) {
 	eap_protos.RegisterEapServiceServer(eapSrv.GrpcServer, servicer)
 	go eapSrv.RunTest(eapLis)
 
+	eapPrimeSrv, eapPrimeLis := test_utils.NewTestService(t, registry.ModuleName, registry.EAP_AKA_PRIME)
+	primeServicer, err := aka_prime_servicers.NewEapAkaPrimeService(nil, "")
+	if err != nil {
+		t.Fatalf("failed to create EAP AKA' Service: %v", err)
+		return
+	}
+	eap_protos.RegisterEapServiceServer(eapPrimeSrv.GrpcServer, primeServicer)
+	go eapPrimeSrv.RunTest(eapPrimeLis)
+
 	rtrSrv, rtrLis := test_utils.NewTestService(t, registry.ModuleName, registry.EAP)
 	eap_protos.RegisterEapRouterServer(rtrSrv.GrpcServer, &testEapRouter{supportedMethods: eap_client.SupportedTypes()})
 	go rtrSrv.RunTest(rtrLis)
@@ -151,12 +164,21 @@ func TestEAPPeerNak(t *testing.T) {
 	if !reflect.DeepEqual([]byte(peap.GetPayload()), permIdReq) {
 		t.Fatalf("Unexpected Identity Responsen\tReceived: %.3v\n\tExpected: %.3v", peap.GetPayload(), permIdReq)
 	}
-	peap, err = client.Handle(&eap_protos.Eap{Payload: akaPrimeNak, Ctx: peap.Ctx})
+	peap, err = client.Handle(&eap_protos.Eap{Payload: unsupportedNak, Ctx: peap.Ctx})
 	if err != nil {
 		t.Fatalf("Unexpected Error: %v", err)
 	}
 	if !reflect.DeepEqual([]byte(peap.GetPayload()), failureEAP) {
-		t.Fatalf("Unexpected AKA' Nak Response\n\tReceived: %.3v\n\tExpected: %.3v", peap.GetPayload(), failureEAP)
+		t.Fatalf("Unexpected Unsupported Nak Response\n\tReceived: %.3v\n\tExpected: %.3v",
+			peap.GetPayload(), failureEAP)
+	}
+	peap, err = client.Handle(&eap_protos.Eap{Payload: akaPrimeNak, Ctx: eapCtx})
+	if err != nil {
+		t.Fatalf("Unexpected Error: %v", err)
+	}
+	if !reflect.DeepEqual([]byte(peap.GetPayload()), akaPrimePermIdReq) {
+		t.Fatalf("Unexpected AKA' Nak Response\n\tReceived: %.3v\n\tExpected: %.3v",
+			peap.GetPayload(), akaPrimePermIdReq)
 	}
 	peap, err = client.Handle(&eap_protos.Eap{Payload: akaAkaPrimeNak, Ctx: eapCtx})
 	if err != nil {
@@ -0,0 +1,59 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+// package aka_prime implements EAP-AKA' provider (RFC 5448)
+package aka_prime
+
+import (
+	"magma/feg/gateway/services/eap"
+	"magma/feg/gateway/services/eap/protos"
+	"magma/feg/gateway/services/eap/providers/aka"
+)
+
+const (
+	TYPE           = uint8(protos.EapType_AKAPrime)
+	MIN_PACKET_LEN = eap.EapSubtype
+)
+
+const (
+	// AKA' specific Attributes, all other attributes & subtypes are shared with EAP-AKA (see aka package)
+	AT_KDF_INPUT eap.AttrType = 23
+	AT_KDF       eap.AttrType = 24
+)
+
+const (
+	// Key Derivation Functions (RFC 5448, section 3.1 & IANA EAP-AKA' AT_KDF Values)
+	KDF_AKA_PRIME uint16 = 1 // EAP-AKA' with CK'/IK'
+)
+
+const (
+	// Identity prefixes of EAP-AKA' Identities (3GPP TS 23.003, 19.3.2)
+	PermanentIdPrefix = '6'
+	PseudonymPrefix   = '7'
+	ReauthIdPrefix    = '8'
+)
+
+const (
+	// CK'/IK' Derivation Consts (3GPP TS 33.402, Annex A.2)
+	FC_CK_IK_PRIME = 0x20
+	SQN_XOR_AK_LEN = 6
+
+	// Key Lengths (RFC 5448, section 3.3)
+	K_ENCR_LEN = 16
+	K_AUT_LEN  = 32
+	K_RE_LEN   = 32
+	MSK_LEN    = 64
+	EMSK_LEN   = 64
+	MK_LEN     = K_ENCR_LEN + K_AUT_LEN + K_RE_LEN + MSK_LEN + EMSK_LEN
+
+	MAC_LEN         = aka.MAC_LEN
+	AT_KDF_ATTR_LEN = aka.ATT_HDR_LEN
+
+	// DefaultNetworkName is the Access Network Identity for non-3GPP WLAN access (3GPP TS 24.302, 8.1.1)
+	DefaultNetworkName = "WLAN"
+)
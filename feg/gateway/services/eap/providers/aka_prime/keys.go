@@ -0,0 +1,107 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package aka_prime
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"fmt"
+
+	"magma/feg/gateway/services/eap"
+	"magma/feg/gateway/services/eap/providers/aka"
+)
+
+const eapAkaPrimeKeyLabel = "EAP-AKA'"
+
+// MakeCKIKPrime derives CK' & IK' from CK, IK, AUTN & Access Network Name,
+// see RFC 5448, section 3.3 & 3GPP TS 33.402, Annex A.2:
+//   CK' || IK' = HMAC-SHA-256(CK || IK, S), S = FC || P0 || L0 || P1 || L1
+//   FC = 0x20, P0 = Network Name, P1 = SQN xor AK (the first 6 bytes of AUTN)
+func MakeCKIKPrime(CK, IK, autn []byte, networkName string) (CKPrime, IKPrime []byte, err error) {
+	if len(autn) < SQN_XOR_AK_LEN {
+		return nil, nil, fmt.Errorf("Invalid AUTN length: %d", len(autn))
+	}
+	nl := len(networkName)
+	s := make([]byte, 0, 1+nl+2+SQN_XOR_AK_LEN+2)
+	s = append(s, FC_CK_IK_PRIME)
+	s = append(s, networkName...)
+	s = append(s, byte(nl>>8), byte(nl))
+	s = append(s, autn[:SQN_XOR_AK_LEN]...)
+	s = append(s, 0, SQN_XOR_AK_LEN)
+
+	h := hmac.New(sha256.New, append(append(make([]byte, 0, len(CK)+len(IK)), CK...), IK...))
+	h.Write(s)
+	key := h.Sum(nil)
+	return key[:16], key[16:], nil
+}
+
+// PRFPrime implements RFC 5448, section 3.4.1 PRF' function & returns resLen bytes of its output:
+//   PRF'(K,S) = T1 | T2 | T3 | T4 | ...
+//   T1 = HMAC-SHA-256 (K, S | 0x01)
+//   Tn = HMAC-SHA-256 (K, Tn-1 | S | n)
+func PRFPrime(K, S []byte, resLen int) []byte {
+	res := make([]byte, 0, resLen+sha256.Size)
+	h := hmac.New(sha256.New, K)
+	var t []byte
+	for n := 1; len(res) < resLen; n++ {
+		h.Reset()
+		h.Write(t)
+		h.Write(S)
+		h.Write([]byte{byte(n)})
+		t = h.Sum(nil)
+		res = append(res, t...)
+	}
+	return res[:resLen]
+}
+
+// MakeAKAPrimeKeys returns generated K_encr, K_aut, K_re, MSK & EMSK keys for AKA' Authentication
+// (RFC 5448, section 3.3): MK = PRF'(IK'|CK',"EAP-AKA'"|Identity)
+func MakeAKAPrimeKeys(identity, IKPrime, CKPrime []byte) (K_encr, K_aut, K_re, MSK, EMSK []byte) {
+	key := append(append(make([]byte, 0, len(IKPrime)+len(CKPrime)), IKPrime...), CKPrime...)
+	s := append(append(make([]byte, 0, len(eapAkaPrimeKeyLabel)+len(identity)), eapAkaPrimeKeyLabel...), identity...)
+	mk := PRFPrime(key, s, MK_LEN)
+	K_encr, mk = mk[:K_ENCR_LEN], mk[K_ENCR_LEN:]
+	K_aut, mk = mk[:K_AUT_LEN], mk[K_AUT_LEN:]
+	K_re, mk = mk[:K_RE_LEN], mk[K_RE_LEN:]
+	MSK, EMSK = mk[:MSK_LEN], mk[MSK_LEN:]
+	return
+}
+
+// GenMac calculates AKA' MAC given data & K_aut - HMAC-SHA-256-128 (see RFC 5448, section 3.4.1)
+func GenMac(data, K_aut []byte) []byte {
+	h := hmac.New(sha256.New, K_aut)
+	h.Write(data)
+	return h.Sum(nil)[:MAC_LEN]
+}
+
+// AppendMac appends AT_MAC attribute to eap packet, signs the packet & returns the new, signed packet
+// returns error if provided EAP Packet was malformed
+func AppendMac(p eap.Packet, K_aut []byte) (eap.Packet, error) {
+	p = p.Truncate()
+	atMacOffset := len(p) + aka.ATT_HDR_LEN
+	p, err := p.Append(eap.NewAttribute(aka.AT_MAC, append([]byte{0, 0}, make([]byte, MAC_LEN)...)))
+	if err != nil {
+		return p, err
+	}
+	mac := GenMac(p, K_aut)
+	// Set AT_MAC
+	copy(p[atMacOffset:], mac)
+	return p, nil
+}
+
+// NewKdfInputAttr returns AT_KDF_INPUT attribute with the given Network Name (see RFC 5448, section 3.1)
+func NewKdfInputAttr(networkName string) eap.Attribute {
+	nl := len(networkName)
+	return eap.NewAttribute(AT_KDF_INPUT, append([]byte{byte(nl >> 8), byte(nl)}, networkName...))
+}
+
+// NewKdfAttr returns AT_KDF attribute with the given Key Derivation Function (see RFC 5448, section 3.2)
+func NewKdfAttr(kdf uint16) eap.Attribute {
+	return eap.NewAttribute(AT_KDF, []byte{byte(kdf >> 8), byte(kdf)})
+}
@@ -0,0 +1,78 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+package aka_prime
+
+import (
+	"encoding/hex"
+	"reflect"
+	"testing"
+)
+
+// RFC 5448, Appendix C, Test Case 1
+const (
+	tc1Identity    = "0555444333222111"
+	tc1NetworkName = "WLAN"
+	tc1Autn        = "bb52e91c747ac3ab2a5c23d15ee351d5"
+	tc1IK          = "9744871ad32bf9bbd1dd5ce54e3e2e5a"
+	tc1CK          = "5349fbe098649f948f5d2e973a81c00f"
+	tc1CKPrime     = "0093962d0dd84aa5684b045c9edffa04"
+	tc1IKPrime     = "ccfc230ca74fcc96c0a5d61164f5a76c"
+	tc1K_encr      = "766fa0a6c317174b812d52fbcd11a179"
+	tc1K_aut       = "0842ea722ff6835bfa2032499fc3ec23c2f0e388b4f07543ffc677f1696d71ea"
+	tc1K_re        = "cf83aa8bc7e0aced892acc98e76a9b2095b558c7795c7094715cb3393aa7d17a"
+	tc1MSK         = "67c42d9aa56c1b79e295e3459fc3d187d42be0bf818d3070e362c5e967a4d544" +
+		"e8ecfe19358ab3039aff03b7c930588c055babee58a02650b067ec4e9347c75a"
+	tc1EMSK = "f861703cd775590e16c7679ea3874ada866311de290764d760cf76df647ea01c" +
+		"313f69924bdd7650ca9bac141ea075c4ef9e8029c0e290cdbad5638b63bc23fb"
+)
+
+func unhex(t *testing.T, s string) []byte {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+func TestAKAPrimeKeys(t *testing.T) {
+	CKPrime, IKPrime, err := MakeCKIKPrime(unhex(t, tc1CK), unhex(t, tc1IK), unhex(t, tc1Autn), tc1NetworkName)
+	if err != nil {
+		t.Fatalf("MakeCKIKPrime Error: %v", err)
+	}
+	K_encr, K_aut, K_re, MSK, EMSK := MakeAKAPrimeKeys([]byte(tc1Identity), IKPrime, CKPrime)
+
+	for _, k := range []struct{ name, expected, received string }{
+		{"CK'", tc1CKPrime, hex.EncodeToString(CKPrime)},
+		{"IK'", tc1IKPrime, hex.EncodeToString(IKPrime)},
+		{"K_encr", tc1K_encr, hex.EncodeToString(K_encr)},
+		{"K_aut", tc1K_aut, hex.EncodeToString(K_aut)},
+		{"K_re", tc1K_re, hex.EncodeToString(K_re)},
+		{"MSK", tc1MSK, hex.EncodeToString(MSK)},
+		{"EMSK", tc1EMSK, hex.EncodeToString(EMSK)},
+	} {
+		if k.expected != k.received {
+			t.Errorf("Unexpected %s\n\tReceived: %s\n\tExpected: %s", k.name, k.received, k.expected)
+		}
+	}
+	if _, _, err = MakeCKIKPrime(unhex(t, tc1CK), unhex(t, tc1IK), []byte{1, 2}, tc1NetworkName); err == nil {
+		t.Fatal("Expected error for short AUTN")
+	}
+}
+
+func TestKdfAttributes(t *testing.T) {
+	a := NewKdfInputAttr(tc1NetworkName)
+	expected := []byte{byte(AT_KDF_INPUT), 2, 0, 4, 'W', 'L', 'A', 'N'}
+	if !reflect.DeepEqual(a.Marshaled(), expected) {
+		t.Fatalf("Unexpected AT_KDF_INPUT\n\tReceived: %v\n\tExpected: %v", a.Marshaled(), expected)
+	}
+	a = NewKdfAttr(KDF_AKA_PRIME)
+	expected = []byte{byte(AT_KDF), 1, 0, 1}
+	if !reflect.DeepEqual(a.Marshaled(), expected) {
+		t.Fatalf("Unexpected AT_KDF\n\tReceived: %v\n\tExpected: %v", a.Marshaled(), expected)
+	}
+}
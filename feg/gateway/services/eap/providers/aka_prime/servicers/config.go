@@ -0,0 +1,34 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package servicers
+
+import (
+	"github.com/golang/glog"
+
+	"magma/feg/gateway/services/eap/providers/aka_prime"
+	"magma/orc8r/cloud/go/service/config"
+)
+
+const EapAkaPrimeServiceName = "eap_aka_prime"
+
+// GetNetworkName returns Access Network Identity configured in eap_aka_prime.yml service config
+// or DefaultNetworkName if not configured
+func GetNetworkName() string {
+	// moduleName is "" since all feg configs lie in /etc/magma/configs without a module name
+	configMap, err := config.GetServiceConfig("", EapAkaPrimeServiceName)
+	if err != nil {
+		glog.Errorf("%s Service Configs Load Error: %v", EapAkaPrimeServiceName, err)
+		return aka_prime.DefaultNetworkName
+	}
+	name, err := configMap.GetStringParam("network_name")
+	if err != nil || len(name) == 0 {
+		return aka_prime.DefaultNetworkName
+	}
+	return name
+}
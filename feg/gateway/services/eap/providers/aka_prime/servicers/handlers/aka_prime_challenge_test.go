@@ -0,0 +1,219 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+package handlers
+
+import (
+	"reflect"
+	"testing"
+
+	"golang.org/x/net/context"
+
+	"magma/feg/cloud/go/protos"
+	"magma/feg/gateway/registry"
+	"magma/feg/gateway/services/eap"
+	eap_protos "magma/feg/gateway/services/eap/protos"
+	"magma/feg/gateway/services/eap/providers/aka"
+	"magma/feg/gateway/services/eap/providers/aka_prime"
+	"magma/feg/gateway/services/eap/providers/aka_prime/servicers"
+	"magma/orc8r/cloud/go/test_utils"
+)
+
+const (
+	testIdentity = "6001010000000055@wlan.mnc001.mcc001.3gppnetwork.org"
+	testRandAutn = "\x01\x23\x45\x67\x89\xab\xcd\xef\x01\x23\x45\x67\x89\xab\xcd\xef" +
+		"\x54\xab\x64\x4a\x90\x51\xb9\xb9\x5e\x85\xc1\x22\x3e\x0e\xf1\x4c"
+	testXres   = "\x29\x5c\x00\xea\xe3\x88\x93\x0d"
+	testCK     = "\xa8\x35\xcf\x22\xb0\xf4\x3e\x15\x19\xd6\xfd\x23\x4c\x00\xd7\x93"
+	testIK     = "\xd5\x37\x0f\x13\x79\x6f\x2f\x61\x5c\xbe\x15\xef\x9f\x42\x0a\x98"
+	testMsisdn = "5100001234"
+)
+
+type testSwxProxy struct{}
+
+// Authenticate returns a single EAP-AKA auth vector for any user
+func (s testSwxProxy) Authenticate(
+	ctx context.Context,
+	req *protos.AuthenticationRequest,
+) (*protos.AuthenticationAnswer, error) {
+	return &protos.AuthenticationAnswer{
+		UserName: req.GetUserName(),
+		SipAuthVectors: []*protos.AuthenticationAnswer_SIPAuthVector{
+			{
+				AuthenticationScheme: req.AuthenticationScheme,
+				RandAutn:             []byte(testRandAutn),
+				Xres:                 []byte(testXres),
+				ConfidentialityKey:   []byte(testCK),
+				IntegrityKey:         []byte(testIK),
+			},
+		},
+		UserProfile: &protos.AuthenticationAnswer_UserProfile{Msisdn: testMsisdn},
+	}, nil
+}
+
+// Register is a no-op SAR implementation
+func (s testSwxProxy) Register(context.Context, *protos.RegistrationRequest) (*protos.RegistrationAnswer, error) {
+	return &protos.RegistrationAnswer{}, nil
+}
+
+// Deregister is a no-op SAR implementation
+func (s testSwxProxy) Deregister(context.Context, *protos.RegistrationRequest) (*protos.RegistrationAnswer, error) {
+	return &protos.RegistrationAnswer{}, nil
+}
+
+func newTestPacket(t *testing.T, identifier uint8, subtype aka.Subtype, attrs ...eap.Attribute) eap.Packet {
+	var err error
+	p := eap.NewPacket(eap.ResponseCode, identifier, []byte{aka_prime.TYPE, byte(subtype), 0, 0})
+	for _, a := range attrs {
+		if p, err = p.Append(a); err != nil {
+			t.Fatalf("Error appending attribute %v: %v", a, err)
+		}
+	}
+	return p
+}
+
+func newIdentityAttr(identity string) eap.Attribute {
+	l := len(identity)
+	return eap.NewAttribute(aka.AT_IDENTITY, append([]byte{byte(l >> 8), byte(l)}, identity...))
+}
+
+func startChallenge(t *testing.T, akaPrimeSrv *servicers.EapAkaPrimeSrv, eapCtx *eap_protos.EapContext) eap.Packet {
+	p, err := identityResponse(
+		akaPrimeSrv, eapCtx, newTestPacket(t, 1, aka.SubtypeIdentity, newIdentityAttr(testIdentity)))
+	if err != nil {
+		t.Fatalf("Unexpected identityResponse error: %v", err)
+	}
+	if len(eapCtx.SessionId) == 0 {
+		t.Fatal("Empty Session ID")
+	}
+	if p[eap.EapMsgCode] != eap.RequestCode || p.Type() != aka_prime.TYPE ||
+		aka.Subtype(p[eap.EapSubtype]) != aka.SubtypeChallenge {
+		t.Fatalf("Unexpected identityResponse EAP: %v", p)
+	}
+	return p
+}
+
+func TestAkaPrimeChallenge(t *testing.T) {
+	srv, lis := test_utils.NewTestService(t, registry.ModuleName, registry.SWX_PROXY)
+	protos.RegisterSwxProxyServer(srv.GrpcServer, testSwxProxy{})
+	go srv.RunTest(lis)
+
+	akaPrimeSrv, _ := servicers.NewEapAkaPrimeService(nil, "")
+	eapCtx := &eap_protos.EapContext{}
+	p := startChallenge(t, akaPrimeSrv, eapCtx)
+
+	CKPrime, IKPrime, err := aka_prime.MakeCKIKPrime(
+		[]byte(testCK), []byte(testIK), []byte(testRandAutn)[aka.RAND_LEN:], aka_prime.DefaultNetworkName)
+	if err != nil {
+		t.Fatalf("MakeCKIKPrime Error: %v", err)
+	}
+	_, K_aut, _, MSK, _ := aka_prime.MakeAKAPrimeKeys([]byte(testIdentity), IKPrime, CKPrime)
+
+	// Verify challenge attributes & MAC
+	scanner, err := eap.NewAttributeScanner(p)
+	if err != nil {
+		t.Fatalf("Challenge Attribute Scanner Error: %v", err)
+	}
+	var atMac eap.Attribute
+	attrs := map[eap.AttrType][]byte{}
+	for a, err := scanner.Next(); err == nil; a, err = scanner.Next() {
+		attrs[a.Type()] = a.Value()
+		if a.Type() == aka.AT_MAC {
+			atMac = a
+		}
+	}
+	for typ, expected := range map[eap.AttrType][]byte{
+		aka.AT_RAND:            append([]byte{0, 0}, testRandAutn[:aka.RAND_LEN]...),
+		aka.AT_AUTN:            append([]byte{0, 0}, testRandAutn[aka.RAND_LEN:]...),
+		aka_prime.AT_KDF:       {0, byte(aka_prime.KDF_AKA_PRIME)},
+		aka_prime.AT_KDF_INPUT: append([]byte{0, 4}, aka_prime.DefaultNetworkName...),
+	} {
+		if !reflect.DeepEqual(attrs[typ], expected) {
+			t.Fatalf("Unexpected Attribute %d\n\tReceived: %v\n\tExpected: %v", typ, attrs[typ], expected)
+		}
+	}
+	if atMac == nil {
+		t.Fatal("Missing AT_MAC")
+	}
+	challengeMac := append([]byte{}, atMac.Value()[2:]...)
+	copy(atMac.Value()[2:], make([]byte, aka_prime.MAC_LEN))
+	if expected := aka_prime.GenMac(p, K_aut); !reflect.DeepEqual(challengeMac, expected) {
+		t.Fatalf("Invalid Challenge MAC\n\tReceived: %v\n\tExpected: %v", challengeMac, expected)
+	}
+
+	// Create & sign Challenge Response
+	resp := newTestPacket(t, p.Identifier(), aka.SubtypeChallenge,
+		eap.NewAttribute(aka.AT_RES, append([]byte{0, 64}, testXres...)))
+	resp, err = aka_prime.AppendMac(resp, K_aut)
+	if err != nil {
+		t.Fatalf("AppendMac Error: %v", err)
+	}
+	p, err = challengeResponse(akaPrimeSrv, eapCtx, resp)
+	if err != nil {
+		t.Fatalf("Unexpected challengeResponse error: %v", err)
+	}
+	successEAP := []byte{eap.SuccessCode, resp.Identifier(), 0, 4}
+	if !reflect.DeepEqual([]byte(p), successEAP) {
+		t.Fatalf("Unexpected challengeResponse EAP\n\tReceived: %v\n\tExpected: %v", p, successEAP)
+	}
+	if !reflect.DeepEqual(eapCtx.Msk, MSK) {
+		t.Fatalf("Unexpected MSK\n\tReceived: %v\n\tExpected: %v", eapCtx.Msk, MSK)
+	}
+	if eapCtx.Imsi != "001010000000055" || eapCtx.Msisdn != testMsisdn || eapCtx.Identity != testIdentity {
+		t.Fatalf("Unexpected EAP Context: %+v", *eapCtx)
+	}
+}
+
+func TestAkaPrimeChallengeFailures(t *testing.T) {
+	srv, lis := test_utils.NewTestService(t, registry.ModuleName, registry.SWX_PROXY)
+	protos.RegisterSwxProxyServer(srv.GrpcServer, testSwxProxy{})
+	go srv.RunTest(lis)
+
+	akaPrimeSrv, _ := servicers.NewEapAkaPrimeService(nil, aka_prime.DefaultNetworkName)
+
+	// KDF Negotiation: the peer asks for an unsupported KDF
+	eapCtx := &eap_protos.EapContext{}
+	p := startChallenge(t, akaPrimeSrv, eapCtx)
+	p, err := challengeResponse(akaPrimeSrv, eapCtx,
+		newTestPacket(t, p.Identifier(), aka.SubtypeChallenge, aka_prime.NewKdfAttr(2)))
+	if err != nil {
+		t.Fatalf("Unexpected challengeResponse error: %v", err)
+	}
+	if aka.Subtype(p[eap.EapSubtype]) != aka.SubtypeNotification {
+		t.Fatalf("Expected AKA' Notification, received: %v", p)
+	}
+
+	// Invalid MAC
+	eapCtx = &eap_protos.EapContext{}
+	p = startChallenge(t, akaPrimeSrv, eapCtx)
+	resp := newTestPacket(t, p.Identifier(), aka.SubtypeChallenge,
+		eap.NewAttribute(aka.AT_RES, append([]byte{0, 64}, testXres...)))
+	resp, _ = aka_prime.AppendMac(resp, make([]byte, aka_prime.K_AUT_LEN))
+	p, err = challengeResponse(akaPrimeSrv, eapCtx, resp)
+	if err != nil {
+		t.Fatalf("Unexpected challengeResponse error: %v", err)
+	}
+	if aka.Subtype(p[eap.EapSubtype]) != aka.SubtypeNotification {
+		t.Fatalf("Expected AKA' Notification, received: %v", p)
+	}
+	if len(eapCtx.Msk) != 0 {
+		t.Fatalf("Unexpected MSK for failed authentication: %v", eapCtx.Msk)
+	}
+}
+
+func TestAkaPrimeIdentity(t *testing.T) {
+	fullId, imsi, err := getIMSIIdentity(newIdentityAttr(testIdentity))
+	if err != nil {
+		t.Fatalf("getIMSIIdentity Error: %v", err)
+	}
+	if fullId != testIdentity || imsi != "6001010000000055" {
+		t.Fatalf("Unexpected Identity: %s, IMSI: %s", fullId, imsi)
+	}
+	if _, _, err = getIMSIIdentity(newIdentityAttr("7001010000000055@wlan")); err == nil {
+		t.Fatal("Expected error for non-permanent 16 digit identity")
+	}
+}
@@ -0,0 +1,104 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package handlers
+
+import (
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	swx_protos "magma/feg/cloud/go/protos"
+	"magma/feg/gateway/services/eap"
+	"magma/feg/gateway/services/eap/providers/aka"
+	akaservicers "magma/feg/gateway/services/eap/providers/aka/servicers"
+	"magma/feg/gateway/services/eap/providers/aka_prime"
+	"magma/feg/gateway/services/eap/providers/aka_prime/metrics"
+	"magma/feg/gateway/services/eap/providers/aka_prime/servicers"
+	"magma/feg/gateway/services/swx_proxy"
+)
+
+// createChallengeRequest retrieves AKA vector from HSS via SWx Proxy, derives CK'/IK' & AKA' keys
+// and returns EAP-Request/AKA'-Challenge (see https://tools.ietf.org/html/rfc5448#section-3)
+// CK & IK are requested using EAP-AKA scheme & CK'/IK' are derived locally using the configured
+// Access Network Identity, the same Network Name is sent to the peer in AT_KDF_INPUT
+func createChallengeRequest(
+	s *servicers.EapAkaPrimeSrv,
+	lockedCtx *akaservicers.UserCtx,
+	identifier uint8,
+	resyncInfo []byte) (eap.Packet, error) {
+
+	metrics.SwxRequests.Inc()
+	swxStartTime := time.Now()
+
+	ans, err := swx_proxy.Authenticate(
+		&swx_protos.AuthenticationRequest{
+			UserName:             string(lockedCtx.Imsi),
+			SipNumAuthVectors:    1,
+			AuthenticationScheme: swx_protos.AuthenticationScheme_EAP_AKA,
+			ResyncInfo:           resyncInfo,
+			RetrieveUserProfile:  true,
+		})
+
+	metrics.SWxLatency.Observe(time.Since(swxStartTime).Seconds())
+
+	if err != nil {
+		metrics.SwxFailures.Inc()
+		errCode := codes.Internal
+		if se, ok := err.(interface{ GRPCStatus() *status.Status }); ok {
+			errCode = se.GRPCStatus().Code()
+		}
+		return aka_prime.EapErrorResPacket(identifier, aka.NOTIFICATION_FAILURE, errCode, "%v", err)
+	}
+	if ans == nil || len(ans.SipAuthVectors) == 0 {
+		return aka_prime.EapErrorResPacket(
+			identifier, aka.NOTIFICATION_FAILURE, codes.Internal, "Missing SWx Auth Vector: %+v", ans)
+	}
+	av := ans.SipAuthVectors[0] // Use first vector for now
+	ra := av.GetRandAutn()
+	if len(ra) < aka.RandAutnLen {
+		return aka_prime.EapErrorResPacket(
+			identifier,
+			aka.NOTIFICATION_FAILURE,
+			codes.Internal,
+			"Invalid SWx RandAutn len (%d, expected: %d) in Response: %+v",
+			len(ra), aka.RandAutnLen, *ans)
+	}
+	autn := ra[aka.RAND_LEN:aka.RandAutnLen]
+	networkName := s.NetworkName()
+	CKPrime, IKPrime, err := aka_prime.MakeCKIKPrime(av.GetConfidentialityKey(), av.GetIntegrityKey(), autn, networkName)
+	if err != nil {
+		return aka_prime.EapErrorResPacket(identifier, aka.NOTIFICATION_FAILURE, codes.Internal, "%v", err)
+	}
+
+	identifier++
+
+	lockedCtx.Identifier = identifier
+	lockedCtx.Rand = ra[:aka.RAND_LEN]
+	lockedCtx.Xres = av.GetXres()
+	lockedCtx.Profile = ans.GetUserProfile()
+	_, lockedCtx.K_aut, _, lockedCtx.MSK, _ = aka_prime.MakeAKAPrimeKeys([]byte(lockedCtx.Identity), IKPrime, CKPrime)
+
+	p := eap.NewPacket(eap.RequestCode, identifier, []byte{aka_prime.TYPE, byte(aka.SubtypeChallenge), 0, 0})
+	for _, a := range []eap.Attribute{
+		eap.NewAttribute(aka.AT_RAND, append([]byte{0, 0}, lockedCtx.Rand...)),
+		eap.NewAttribute(aka.AT_AUTN, append([]byte{0, 0}, autn...)),
+		aka_prime.NewKdfAttr(aka_prime.KDF_AKA_PRIME),
+		aka_prime.NewKdfInputAttr(networkName),
+	} {
+		if p, err = p.Append(a); err != nil {
+			return aka_prime.EapErrorResPacket(identifier, aka.NOTIFICATION_FAILURE, codes.Internal, "%v", err)
+		}
+	}
+	// Sign the challenge & set AT_MAC
+	if p, err = aka_prime.AppendMac(p, lockedCtx.K_aut); err != nil {
+		return aka_prime.EapErrorResPacket(identifier, aka.NOTIFICATION_FAILURE, codes.Internal, "%v", err)
+	}
+	return p, nil
+}
@@ -0,0 +1,43 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+// package servicers implements EAP-AKA' GRPC service
+package servicers
+
+import (
+	"magma/feg/cloud/go/protos/mconfig"
+	"magma/feg/gateway/services/eap/providers/aka/servicers"
+	"magma/feg/gateway/services/eap/providers/aka_prime"
+)
+
+// EapAkaPrimeSrv is EAP-AKA' service, it shares session & user context management with EAP-AKA service
+type EapAkaPrimeSrv struct {
+	*servicers.EapAkaSrv
+
+	// networkName is the Access Network Identity used for CK'/IK' derivation & sent in AT_KDF_INPUT - Read Only
+	networkName string
+}
+
+// NewEapAkaPrimeService creates new Aka' Service 'object'
+// EAP-AKA' uses the same timeouts & PLMN ID filters as EAP-AKA, networkName is the Access Network Identity
+// (see 3GPP TS 24.302, 8.1.1), if empty - DefaultNetworkName is used
+func NewEapAkaPrimeService(config *mconfig.EapAkaConfig, networkName string) (*EapAkaPrimeSrv, error) {
+	akaSrv, err := servicers.NewEapAkaService(config)
+	if err != nil {
+		return nil, err
+	}
+	if len(networkName) == 0 {
+		networkName = aka_prime.DefaultNetworkName
+	}
+	return &EapAkaPrimeSrv{EapAkaSrv: akaSrv, networkName: networkName}, nil
+}
+
+// NetworkName returns Access Network Identity used by the service
+func (s *EapAkaPrimeSrv) NetworkName() string {
+	return s.networkName
+}
@@ -0,0 +1,54 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+// package servicers implements EAP-AKA' GRPC service
+package servicers
+
+import (
+	"log"
+	"sync"
+
+	"magma/feg/gateway/services/eap"
+	"magma/feg/gateway/services/eap/protos"
+	"magma/feg/gateway/services/eap/providers/aka"
+)
+
+// Handler - is an AKA' Subtype
+type Handler func(srvr *EapAkaPrimeSrv, ctx *protos.EapContext, req eap.Packet) (eap.Packet, error)
+
+var akaPrimeHandlers struct {
+	rwl sync.RWMutex
+	hm  map[aka.Subtype]Handler
+}
+
+func AddHandler(st aka.Subtype, h Handler) {
+	if h == nil {
+		return
+	}
+	akaPrimeHandlers.rwl.Lock()
+	if akaPrimeHandlers.hm == nil {
+		akaPrimeHandlers.hm = map[aka.Subtype]Handler{}
+	}
+	oldh, ok := akaPrimeHandlers.hm[st]
+	if ok && oldh != nil {
+		log.Printf("WARNING: EAP AKA' Handler for subtype %d => %+v is already registered, will overwrite with %+v",
+			st, oldh, h)
+	}
+	akaPrimeHandlers.hm[st] = h
+	akaPrimeHandlers.rwl.Unlock()
+}
+
+func GetHandler(st aka.Subtype) Handler {
+	akaPrimeHandlers.rwl.RLock()
+	defer akaPrimeHandlers.rwl.RUnlock()
+	res, ok := akaPrimeHandlers.hm[st]
+	if ok {
+		return res
+	}
+	return nil
+}
@@ -11,8 +11,12 @@ package registry
 
 import (
 	"magma/feg/gateway/services/eap/providers/aka"
+	"magma/feg/gateway/services/eap/providers/aka_prime"
+	"magma/feg/gateway/services/eap/providers/sim"
 )
 
 func init() {
 	Register(aka.New())
+	Register(aka_prime.New())
+	Register(sim.New())
 }
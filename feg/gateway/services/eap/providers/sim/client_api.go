@@ -0,0 +1,79 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+// Package sim implements EAP-SIM provider
+package sim
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/golang/glog"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+
+	"magma/feg/gateway/registry"
+	"magma/feg/gateway/services/eap/protos"
+	"magma/feg/gateway/services/eap/providers"
+)
+
+// SIM Provider Implementation
+type providerImpl struct{} // singleton for now
+
+func New() providers.Method {
+	return providerImpl{}
+}
+
+// Wrapper to provide a wrapper for GRPC Client to extend it with Cleanup
+// functionality
+type simClient struct {
+	protos.EapServiceClient
+	cc *grpc.ClientConn
+}
+
+func (cl *simClient) Cleanup() {
+	if cl != nil && cl.cc != nil {
+		cl.cc.Close()
+	}
+}
+
+// getSIMClient is a utility function to get a RPC connection to the EAP-SIM service
+func getSIMClient() (*simClient, error) {
+	conn, err := registry.GetConnection(registry.EAP_SIM)
+	if err != nil {
+		errMsg := fmt.Sprintf("EAP-SIM client initialization error: %s", err)
+		glog.Error(errMsg)
+		return nil, errors.New(errMsg)
+	}
+	return &simClient{
+		protos.NewEapServiceClient(conn),
+		conn,
+	}, err
+}
+
+// String returns EAP SIM Provider name/info
+func (providerImpl) String() string {
+	return "<Magma EAP-SIM Method Provider>"
+}
+
+// EAPType returns EAP SIM Type - 18
+func (providerImpl) EAPType() uint8 {
+	return TYPE
+}
+
+// Handle handles passed EAP-SIM payload & returns corresponding result
+func (providerImpl) Handle(msg *protos.Eap) (*protos.Eap, error) {
+	if msg == nil {
+		return nil, errors.New("Invalid EAP SIM Message")
+	}
+	cli, err := getSIMClient()
+	if err != nil {
+		return nil, err
+	}
+	return cli.Handle(context.Background(), msg)
+}
@@ -0,0 +1,113 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+// package sim implements EAP-SIM provider (RFC 4186)
+package sim
+
+import (
+	"time"
+
+	"magma/feg/gateway/services/eap"
+	"magma/feg/gateway/services/eap/protos"
+)
+
+const (
+	TYPE           = uint8(protos.EapType_SIM)
+	MIN_PACKET_LEN = eap.EapSubtype
+	VERSION        = uint16(1)
+)
+
+const (
+	// SIM Attributes
+	AT_RAND              eap.AttrType = 1
+	AT_PADDING           eap.AttrType = 6
+	AT_NONCE_MT          eap.AttrType = 7
+	AT_PERMANENT_ID_REQ  eap.AttrType = 10
+	AT_MAC               eap.AttrType = 11
+	AT_NOTIFICATION      eap.AttrType = 12
+	AT_ANY_ID_REQ        eap.AttrType = 13
+	AT_IDENTITY          eap.AttrType = 14
+	AT_VERSION_LIST      eap.AttrType = 15
+	AT_SELECTED_VERSION  eap.AttrType = 16
+	AT_FULLAUTH_ID_REQ   eap.AttrType = 17
+	AT_COUNTER           eap.AttrType = 19
+	AT_COUNTER_TOO_SMALL eap.AttrType = 20
+	AT_NONCE_S           eap.AttrType = 21
+	AT_CLIENT_ERROR_CODE eap.AttrType = 22
+	AT_IV                eap.AttrType = 129
+	AT_ENCR_DATA         eap.AttrType = 130
+	AT_NEXT_PSEUDONYM    eap.AttrType = 132
+	AT_NEXT_REAUTH_ID    eap.AttrType = 133
+	AT_RESULT_IND        eap.AttrType = 135
+)
+
+const (
+	// SIM Notification Codes
+	NOTIFICATION_FAILURE_AUTH   uint16 = 0
+	NOTIFICATION_FAILURE        uint16 = 16384
+	NOTIFICATION_SUCCESS        uint16 = 32768
+	NOTIFICATION_ACCESS_DENIED  uint16 = 1026
+	NOTIFICATION_NOT_SUBSCRIBED uint16 = 1031
+)
+
+type Subtype uint8
+
+const (
+	// SIM Subtypes
+	SubtypeStart            Subtype = 10
+	SubtypeChallenge        Subtype = 11
+	SubtypeNotification     Subtype = 12
+	SubtypeReauthentication Subtype = 13
+	SubtypeClientError      Subtype = 14
+)
+
+type SimState int16
+
+const (
+	// Processing/handling States
+	StateNone          SimState = iota
+	StateCreated                // newly created
+	StateStart                  // SIM/Start Request was sent to UE
+	StateChallenge              // Auth Challenge was returned to UE
+	StateReauth                 // Fast Re-authentication Request was returned to UE
+	StateAuthenticated          // UE is successfully authenticated
+)
+
+const (
+	// Identity prefixes of EAP-SIM Identities (3GPP TS 23.003, 19.3.2)
+	PermanentIdPrefix = '1'
+	PseudonymPrefix   = '3'
+	ReauthIdPrefix    = '5'
+)
+
+const (
+	ATT_HDR_LEN = 4
+	RAND_LEN    = 16
+	SRES_LEN    = 4
+	KC_LEN      = 8
+	MAC_LEN     = 16
+	NONCE_LEN   = 16
+	IV_LEN      = 16
+	MK_LEN      = 20
+
+	K_ENCR_LEN = 16
+	K_AUT_LEN  = 16
+	MSK_LEN    = 64
+	EMSK_LEN   = 64
+
+	// Number of GSM triplets used in one EAP-SIM Challenge, RFC 4186 allows 2 or 3
+	MinTriplets     = 2
+	DefaultTriplets = 3
+
+	DefaultStartTimeout                = time.Second * 20
+	DefaultChallengeTimeout            = time.Second * 20
+	DefaultErrorNotificationTimeout    = time.Second * 10
+	DefaultSessionTimeout              = time.Hour * 12
+	DefaultSessionAuthenticatedTimeout = time.Second * 5
+	DefaultReauthIdTimeout             = time.Hour * 12
+)
@@ -0,0 +1,57 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+// Package main implements Magma EAP SIM Service
+package main
+
+import (
+	"flag"
+	"log"
+
+	"magma/feg/cloud/go/protos/mconfig"
+	managed_configs "magma/feg/gateway/mconfig"
+	"magma/feg/gateway/registry"
+	"magma/feg/gateway/services/eap/protos"
+	"magma/feg/gateway/services/eap/providers/sim/servicers"
+	_ "magma/feg/gateway/services/eap/providers/sim/servicers/handlers"
+	"magma/orc8r/cloud/go/service"
+)
+
+// EapAkaServiceName is the name of EAP-AKA managed configs shared by EAP-SIM service
+const EapAkaServiceName = "eap_aka"
+
+func init() {
+	flag.Parse()
+}
+
+func main() {
+	// Create the EAP SIM Provider service
+	srv, err := service.NewServiceWithOptions(registry.ModuleName, registry.EAP_SIM)
+	if err != nil {
+		log.Fatalf("Error creating EAP SIM service: %s", err)
+	}
+
+	akaConfigs := &mconfig.EapAkaConfig{}
+	err = managed_configs.GetServiceConfigs(EapAkaServiceName, akaConfigs)
+	if err != nil {
+		log.Printf("Error getting EAP AKA service configs: %s", err)
+		akaConfigs = nil
+	}
+	servicer, err := servicers.NewEapSimService(akaConfigs, servicers.GetSimConfig())
+	if err != nil {
+		log.Fatalf("failed to create EAP SIM Service: %v", err)
+		return
+	}
+	protos.RegisterEapServiceServer(srv.GrpcServer, servicer)
+
+	// Run the service
+	err = srv.Run()
+	if err != nil {
+		log.Fatalf("Error running EAP SIM service: %s", err)
+	}
+}
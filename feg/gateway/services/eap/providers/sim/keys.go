@@ -0,0 +1,172 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package sim
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/binary"
+	"fmt"
+
+	"magma/feg/gateway/services/eap"
+	"magma/feg/gateway/services/eap/providers/aka"
+)
+
+// MK calculates & returns SIM Master Key (RFC 4186, section 7):
+// MK = SHA1(Identity|n*Kc| NONCE_MT| Version List| Selected Version)
+func MK(identity []byte, kcs [][]byte, nonceMt []byte, versionList []uint16, selectedVersion uint16) []byte {
+	d := sha1.New()
+	d.Write(identity)
+	for _, kc := range kcs {
+		d.Write(kc)
+	}
+	d.Write(nonceMt)
+	for _, v := range versionList {
+		d.Write([]byte{byte(v >> 8), byte(v)})
+	}
+	d.Write([]byte{byte(selectedVersion >> 8), byte(selectedVersion)})
+	return d.Sum(nil)
+}
+
+// MakeKeys returns K_encr, K_aut, MSK & EMSK keys generated from the given Master Key (RFC 4186, section 7)
+func MakeKeys(mk []byte) (K_encr, K_aut, MSK, EMSK []byte) {
+	x := aka.XSum(mk)
+	return x[:16], x[16:32], x[32:96], x[96:160]
+}
+
+// MakeReauthKeys returns MSK & EMSK for Fast Re-authentication (RFC 4186, section 7):
+// XKEY' = SHA1(Identity|counter|NONCE_S| MK), MSK & EMSK are the first 128 bytes of PRF(XKEY')
+func MakeReauthKeys(identity []byte, counter uint16, nonceS, mk []byte) (MSK, EMSK []byte) {
+	d := sha1.New()
+	d.Write(identity)
+	d.Write([]byte{byte(counter >> 8), byte(counter)})
+	d.Write(nonceS)
+	d.Write(mk)
+	x := aka.XSum(d.Sum(nil))
+	return x[:64], x[64:128]
+}
+
+// GenMac calculates SIM MAC of the EAP packet followed by the extra data (NONCE_MT, SRES-es or NONCE_S)
+// given K_aut (see: https://tools.ietf.org/html/rfc4186#section-10.14)
+func GenMac(p eap.Packet, extra, K_aut []byte) []byte {
+	h := hmac.New(sha1.New, K_aut)
+	h.Write(p)
+	h.Write(extra)
+	return h.Sum(nil)[:MAC_LEN]
+}
+
+// AppendMac appends AT_MAC attribute to eap packet, signs the packet with K_aut & extra data and returns
+// the new, signed packet, returns error if provided EAP Packet was malformed
+func AppendMac(p eap.Packet, extra, K_aut []byte) (eap.Packet, error) {
+	p = p.Truncate()
+	atMacOffset := len(p) + ATT_HDR_LEN
+	p, err := p.Append(eap.NewAttribute(AT_MAC, append([]byte{0, 0}, make([]byte, MAC_LEN)...)))
+	if err != nil {
+		return p, err
+	}
+	mac := GenMac(p, extra, K_aut)
+	// Set AT_MAC
+	copy(p[atMacOffset:], mac)
+	return p, nil
+}
+
+// TripletFromQuintet converts UMTS authentication vector (quintet) into GSM triplet's SRES & Kc using
+// 3GPP TS 33.102, 6.8.1.2 conversion functions:
+//
+//	c2: SRES = XRES1 xor XRES2 xor XRES3 xor XRES4 (XRES is zero padded to 128 bits)
+//	c3: Kc = CK1 xor CK2 xor IK1 xor IK2
+func TripletFromQuintet(xres, CK, IK []byte) (sres, kc []byte, err error) {
+	if len(xres) > 16 || len(CK) != 16 || len(IK) != 16 {
+		return nil, nil, fmt.Errorf(
+			"Invalid quintet lengths: XRES: %d, CK: %d, IK: %d", len(xres), len(CK), len(IK))
+	}
+	paddedXres := make([]byte, 16)
+	copy(paddedXres, xres)
+	sres = make([]byte, SRES_LEN)
+	for i := 0; i < 16; i += SRES_LEN {
+		for j := 0; j < SRES_LEN; j++ {
+			sres[j] ^= paddedXres[i+j]
+		}
+	}
+	kc = make([]byte, KC_LEN)
+	for i := 0; i < KC_LEN; i++ {
+		kc[i] = CK[i] ^ CK[i+KC_LEN] ^ IK[i] ^ IK[i+KC_LEN]
+	}
+	return sres, kc, nil
+}
+
+// EncryptAttributes encrypts given attributes (AES-CBC, K_encr) for AT_ENCR_DATA, the attributes are padded
+// with AT_PADDING if needed. Returns random IV for AT_IV & encrypted data (see RFC 4186, section 10.12)
+func EncryptAttributes(attrs []eap.Attribute, K_encr []byte) (iv, encrData []byte, err error) {
+	var plain []byte
+	for _, a := range attrs {
+		plain = append(plain, a.Marshaled()...)
+	}
+	if padLen := (aes.BlockSize - len(plain)%aes.BlockSize) % aes.BlockSize; padLen > 0 {
+		padding := make([]byte, padLen)
+		padding[0], padding[1] = byte(AT_PADDING), byte(padLen/4)
+		plain = append(plain, padding...)
+	}
+	block, err := aes.NewCipher(K_encr)
+	if err != nil {
+		return nil, nil, err
+	}
+	iv = make([]byte, IV_LEN)
+	if _, err = rand.Read(iv); err != nil {
+		return nil, nil, err
+	}
+	encrData = make([]byte, len(plain))
+	cipher.NewCBCEncrypter(block, iv).CryptBlocks(encrData, plain)
+	return iv, encrData, nil
+}
+
+// DecryptAttributes decrypts AT_ENCR_DATA value (without the reserved bytes) & returns the decrypted
+// attributes as an EAP-SIM packet of the given subtype, so the attributes can be scanned by eap.AttributeScanner
+func DecryptAttributes(iv, encrData, K_encr []byte, subtype Subtype) (eap.Packet, error) {
+	if len(iv) != IV_LEN || len(encrData) == 0 || len(encrData)%aes.BlockSize != 0 {
+		return nil, fmt.Errorf("Invalid AT_IV (%d) or AT_ENCR_DATA (%d) length", len(iv), len(encrData))
+	}
+	block, err := aes.NewCipher(K_encr)
+	if err != nil {
+		return nil, err
+	}
+	plain := make([]byte, len(encrData))
+	cipher.NewCBCDecrypter(block, iv).CryptBlocks(plain, encrData)
+	return eap.NewPacket(eap.ResponseCode, 0, append([]byte{TYPE, byte(subtype), 0, 0}, plain...)), nil
+}
+
+// NewVersionListAttr returns AT_VERSION_LIST attribute with the given versions (see RFC 4186, section 10.2)
+func NewVersionListAttr(versions ...uint16) eap.Attribute {
+	val := make([]byte, 2+2*len(versions))
+	binary.BigEndian.PutUint16(val, uint16(2*len(versions)))
+	for i, v := range versions {
+		binary.BigEndian.PutUint16(val[2+2*i:], v)
+	}
+	return eap.NewAttribute(AT_VERSION_LIST, val)
+}
+
+// NewCounterAttr returns AT_COUNTER (or AT_COUNTER_TOO_SMALL) attribute with the given counter value
+func NewCounterAttr(typ eap.AttrType, counter uint16) eap.Attribute {
+	return eap.NewAttribute(typ, []byte{byte(counter >> 8), byte(counter)})
+}
+
+// NewIdentityAttr returns AT_IDENTITY or AT_NEXT_REAUTH_ID/AT_NEXT_PSEUDONYM attribute with the given identity
+func NewIdentityAttr(typ eap.AttrType, identity string) eap.Attribute {
+	l := len(identity)
+	return eap.NewAttribute(typ, append([]byte{byte(l >> 8), byte(l)}, identity...))
+}
+
+// NewPaddedAttr returns an attribute of the given type with 2 reserved bytes followed by the value
+// (AT_RAND, AT_NONCE_MT, AT_NONCE_S, AT_IV, AT_ENCR_DATA)
+func NewPaddedAttr(typ eap.AttrType, value []byte) eap.Attribute {
+	return eap.NewAttribute(typ, append([]byte{0, 0}, value...))
+}
@@ -0,0 +1,120 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+package sim
+
+import (
+	"encoding/hex"
+	"reflect"
+	"testing"
+
+	"magma/feg/gateway/services/eap"
+)
+
+// RFC 4186, Appendix A Test Vectors
+const (
+	testIdentity = "1244070100000001@eapsim.foo"
+	testKc1      = "a0a1a2a3a4a5a6a7"
+	testKc2      = "b0b1b2b3b4b5b6b7"
+	testKc3      = "c0c1c2c3c4c5c6c7"
+	testNonceMt  = "0123456789abcdeffedcba9876543210"
+	testMK       = "e576d5ca332e9930018bf1baee2763c795b3c712"
+	testK_encr   = "536e5ebc4465582aa6a8ec9986ebb620"
+	testK_aut    = "25af1942efcbf4bc72b3943421f2a974"
+	testMSK      = "39d45aeaf4e30601983e972b6cfd46d1c363773365690d09cd44976b525f47d3" +
+		"a60a985e955c53b090b2e4b73719196a402542968fd14a888f46b9a7886e4488"
+	testEMSK = "5949eab0fff69d52315c6c634fd14a7f0d52023d56f79698fa6596abeed4f93f" +
+		"bb48eb534d985414ceed0d9a8ed33c387c9dfdab92ffbdf240fcecf65a2c93b9"
+)
+
+func unhex(t *testing.T, s string) []byte {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+func TestSIMKeys(t *testing.T) {
+	mk := MK([]byte(testIdentity),
+		[][]byte{unhex(t, testKc1), unhex(t, testKc2), unhex(t, testKc3)},
+		unhex(t, testNonceMt),
+		[]uint16{VERSION},
+		VERSION)
+	K_encr, K_aut, MSK, EMSK := MakeKeys(mk)
+
+	for _, k := range []struct{ name, expected, received string }{
+		{"MK", testMK, hex.EncodeToString(mk)},
+		{"K_encr", testK_encr, hex.EncodeToString(K_encr)},
+		{"K_aut", testK_aut, hex.EncodeToString(K_aut)},
+		{"MSK", testMSK, hex.EncodeToString(MSK)},
+		{"EMSK", testEMSK, hex.EncodeToString(EMSK)},
+	} {
+		if k.expected != k.received {
+			t.Errorf("Unexpected %s\n\tReceived: %s\n\tExpected: %s", k.name, k.received, k.expected)
+		}
+	}
+	// Re-authentication keys must differ for different counters & nonces
+	msk1, _ := MakeReauthKeys([]byte("5012345678@eapsim.foo"), 1, unhex(t, testNonceMt), mk)
+	msk2, _ := MakeReauthKeys([]byte("5012345678@eapsim.foo"), 2, unhex(t, testNonceMt), mk)
+	if len(msk1) != MSK_LEN || reflect.DeepEqual(msk1, msk2) {
+		t.Fatalf("Invalid Re-authentication MSKs: %x, %x", msk1, msk2)
+	}
+}
+
+func TestTripletFromQuintet(t *testing.T) {
+	sres, kc, err := TripletFromQuintet(
+		unhex(t, "0102030405060708"),
+		unhex(t, "000102030405060708090a0b0c0d0e0f"),
+		unhex(t, "0f0e0d0c0b0a09080706050403020100"))
+	if err != nil {
+		t.Fatalf("TripletFromQuintet Error: %v", err)
+	}
+	if expected := unhex(t, "0404040c"); !reflect.DeepEqual(sres, expected) {
+		t.Fatalf("Unexpected SRES: %x, expected: %x", sres, expected)
+	}
+	if expected := make([]byte, KC_LEN); !reflect.DeepEqual(kc, expected) {
+		t.Fatalf("Unexpected Kc: %x, expected: %x", kc, expected)
+	}
+	if _, _, err = TripletFromQuintet(nil, []byte{1}, []byte{2}); err == nil {
+		t.Fatal("Expected error for invalid quintet")
+	}
+}
+
+func TestEncryptAttributes(t *testing.T) {
+	K_encr := unhex(t, testK_encr)
+	iv, encrData, err := EncryptAttributes([]eap.Attribute{
+		NewCounterAttr(AT_COUNTER, 5),
+		NewPaddedAttr(AT_NONCE_S, unhex(t, testNonceMt)),
+		NewIdentityAttr(AT_NEXT_REAUTH_ID, "5reauth@eapsim.foo.bar"),
+	}, K_encr)
+	if err != nil {
+		t.Fatalf("EncryptAttributes Error: %v", err)
+	}
+	if len(encrData)%16 != 0 {
+		t.Fatalf("Invalid encrypted data length: %d", len(encrData))
+	}
+	p, err := DecryptAttributes(iv, encrData, K_encr, SubtypeReauthentication)
+	if err != nil {
+		t.Fatalf("DecryptAttributes Error: %v", err)
+	}
+	scanner, err := eap.NewAttributeScanner(p)
+	if err != nil {
+		t.Fatalf("NewAttributeScanner Error: %v", err)
+	}
+	var types []eap.AttrType
+	for a, err := scanner.Next(); err == nil; a, err = scanner.Next() {
+		types = append(types, a.Type())
+		if a.Type() == AT_COUNTER && !reflect.DeepEqual(a.Value(), []byte{0, 5}) {
+			t.Fatalf("Unexpected AT_COUNTER: %v", a.Value())
+		}
+	}
+	expected := []eap.AttrType{AT_COUNTER, AT_NONCE_S, AT_NEXT_REAUTH_ID, AT_PADDING}
+	if !reflect.DeepEqual(types, expected) {
+		t.Fatalf("Unexpected decrypted attributes: %v, expected: %v", types, expected)
+	}
+}
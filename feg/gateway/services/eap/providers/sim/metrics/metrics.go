@@ -0,0 +1,107 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+// Package metrics defines EAP-SIM service metrics, the metric names are prefixed with 'sim_'
+// to avoid collisions with the EAP-AKA metrics registered by the shared AKA package
+package metrics
+
+import "github.com/prometheus/client_golang/prometheus"
+
+// Prometheus counters are monotonically increasing
+// Counters reset to zero on service restart
+var (
+	// Generic service counters
+	Requests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "sim_requests_total",
+		Help: "Total number of EAP-SIM Handle requests",
+	})
+	FailedRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "sim_failed_requests_total",
+		Help: "Total number of failed EAP-SIM Handle requests",
+	})
+	FailureNotifications = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "sim_failure_notifications_total",
+		Help: "Total number of Notification Failures Returned to peers",
+	})
+	SwxRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "sim_swx_requests_total",
+		Help: "Total number of SWx Proxy RPC Requests sent",
+	})
+	SwxFailures = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "sim_swx_failures_total",
+		Help: "Total number of SWx Proxy RPC Failures",
+	})
+	SessionTimeouts = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "sim_session_timeouts_total",
+		Help: "Total number of EAP-SIM Session Timeouts",
+	})
+	ReauthIdentities = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "sim_reauth_identities",
+		Help: "Number of currently valid EAP-SIM Fast Re-authentication Identities",
+	})
+
+	// Method Handlers metrics
+	StartRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "sim_start_requests_total",
+		Help: "Total number of calls to SIM Start Handler",
+	})
+	FailedStartRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "sim_failed_start_requests_total",
+		Help: "Total number of failed calls to SIM Start Handler",
+	})
+	ChallengeRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "sim_challenge_requests_total",
+		Help: "Total number of calls to SIM Challenge Handler",
+	})
+	FailedChallengeRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "sim_failed_challenge_requests_total",
+		Help: "Total number of failed calls to SIM Challenge Handler",
+	})
+	ReauthRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "sim_reauth_requests_total",
+		Help: "Total number of calls to SIM Re-authentication Handler",
+	})
+	FailedReauthRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "sim_failed_reauth_requests_total",
+		Help: "Total number of failed calls to SIM Re-authentication Handler",
+	})
+
+	// Peer initiated failures
+	PeerClientError = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "sim_peer_client_errors_total",
+		Help: "Total number of SIM SubtypeClientError calls from peer",
+	})
+	PeerNotification = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "sim_peer_notifications_total",
+		Help: "Total number of SIM SubtypeNotification from peer",
+	})
+	PeerFailures = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "sim_peer_failures_total",
+		Help: "Total number of SIM Errors/Failures originated from peers",
+	})
+
+	// Latencies
+	SWxLatency = prometheus.NewSummary(prometheus.SummaryOpts{
+		Name:       "sim_swx_proxy_lat",
+		Help:       "Latency of SWx Proxy requests (seconds).",
+		Objectives: map[float64]float64{0.5: 0.05, 0.9: 0.01, 0.99: 0.001},
+	})
+	AuthLatency = prometheus.NewSummary(prometheus.SummaryOpts{
+		Name:       "sim_auth_lat",
+		Help:       "Latency of EAP-SIM Authentication round (seconds). Only calculated for completed authentications.",
+		Objectives: map[float64]float64{0.5: 0.05, 0.9: 0.01, 0.99: 0.001},
+	})
+)
+
+func init() {
+	prometheus.MustRegister(Requests, FailedRequests, FailureNotifications,
+		SwxRequests, SwxFailures, SessionTimeouts, ReauthIdentities,
+		StartRequests, FailedStartRequests, ChallengeRequests, FailedChallengeRequests,
+		ReauthRequests, FailedReauthRequests,
+		PeerClientError, PeerNotification, PeerFailures, SWxLatency, AuthLatency)
+}
@@ -0,0 +1,55 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package servicers
+
+import (
+	"time"
+
+	"github.com/golang/glog"
+
+	"magma/feg/gateway/services/eap/providers/sim"
+	"magma/orc8r/cloud/go/service/config"
+)
+
+const EapSimServiceName = "eap_sim"
+
+// SimConfig holds EAP-SIM specific service parameters
+type SimConfig struct {
+	// NumTriplets is the number of GSM triplets used in SIM Challenge (2 or 3)
+	NumTriplets int
+	// FastReauth enables issuing of fast re-authentication identities
+	FastReauth bool
+	// ReauthIdTimeout is the lifetime of a fast re-authentication identity
+	ReauthIdTimeout time.Duration
+}
+
+// GetSimConfig returns EAP-SIM parameters configured in eap_sim.yml service config or the defaults if not configured
+func GetSimConfig() *SimConfig {
+	cfg := &SimConfig{
+		NumTriplets:     sim.DefaultTriplets,
+		FastReauth:      true,
+		ReauthIdTimeout: sim.DefaultReauthIdTimeout,
+	}
+	// moduleName is "" since all feg configs lie in /etc/magma/configs without a module name
+	configMap, err := config.GetServiceConfig("", EapSimServiceName)
+	if err != nil {
+		glog.Errorf("%s Service Configs Load Error: %v", EapSimServiceName, err)
+		return cfg
+	}
+	if n, err := configMap.GetIntParam("num_triplets"); err == nil && n >= sim.MinTriplets && n <= sim.DefaultTriplets {
+		cfg.NumTriplets = n
+	}
+	if fastReauth, err := configMap.GetBoolParam("fast_reauth"); err == nil {
+		cfg.FastReauth = fastReauth
+	}
+	if tout, err := configMap.GetIntParam("reauth_id_timeout_sec"); err == nil && tout > 0 {
+		cfg.ReauthIdTimeout = time.Second * time.Duration(tout)
+	}
+	return cfg
+}
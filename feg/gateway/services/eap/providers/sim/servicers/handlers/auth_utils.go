@@ -0,0 +1,283 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package handlers
+
+import (
+	"bytes"
+	"crypto/rand"
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	swx_protos "magma/feg/cloud/go/protos"
+	"magma/feg/gateway/services/eap"
+	"magma/feg/gateway/services/eap/providers/aka"
+	"magma/feg/gateway/services/eap/providers/sim"
+	"magma/feg/gateway/services/eap/providers/sim/metrics"
+	"magma/feg/gateway/services/eap/providers/sim/servicers"
+	"magma/feg/gateway/services/swx_proxy"
+)
+
+// maxSwxAttempts limits the number of SWx requests used to collect all triplets of one SIM Challenge,
+// SWx Proxy may return fewer vectors than requested (for example, cached vectors are returned one at a time)
+const maxSwxAttempts = 2 * sim.DefaultTriplets
+
+// createChallengeRequest retrieves GSM triplets for the user, derives SIM keys & returns EAP-Request/SIM/Challenge
+// see https://tools.ietf.org/html/rfc4186#section-9.3
+func createChallengeRequest(
+	s *servicers.EapSimSrv,
+	lockedCtx *servicers.UserCtx,
+	identifier uint8,
+	nonceMt []byte) (eap.Packet, error) {
+
+	n := s.NumTriplets()
+	rands, srese, kcs, profile, errCode, err := getTriplets(lockedCtx.Imsi, n)
+	if err != nil {
+		return sim.EapErrorResPacket(identifier, sim.NOTIFICATION_FAILURE, errCode, "%v", err)
+	}
+	identifier++
+
+	lockedCtx.Identifier = identifier
+	lockedCtx.Nonce = nonceMt
+	lockedCtx.Sres = bytes.Join(srese, nil)
+	lockedCtx.Profile = profile
+	lockedCtx.MK = sim.MK([]byte(lockedCtx.Identity), kcs, nonceMt, []uint16{sim.VERSION}, sim.VERSION)
+	lockedCtx.K_encr, lockedCtx.K_aut, lockedCtx.MSK, _ = sim.MakeKeys(lockedCtx.MK)
+	lockedCtx.Counter = 0
+	lockedCtx.NextReauthId = ""
+
+	p := eap.NewPacket(eap.RequestCode, identifier, []byte{sim.TYPE, byte(sim.SubtypeChallenge), 0, 0})
+	p, err = p.Append(sim.NewPaddedAttr(sim.AT_RAND, bytes.Join(rands, nil)))
+	if err != nil {
+		return sim.EapErrorResPacket(identifier, sim.NOTIFICATION_FAILURE, codes.Internal, "%v", err)
+	}
+	if s.FastReauthEnabled() {
+		p, err = appendNextReauthId(p, lockedCtx, lockedCtx.Identity, nil)
+		if err != nil {
+			return sim.EapErrorResPacket(identifier, sim.NOTIFICATION_FAILURE, codes.Internal, "%v", err)
+		}
+	}
+	p, err = sim.AppendMac(p, nonceMt, lockedCtx.K_aut)
+	if err != nil {
+		return sim.EapErrorResPacket(identifier, sim.NOTIFICATION_FAILURE, codes.Internal, "%v", err)
+	}
+	return p, nil
+}
+
+// createReauthRequest initializes user CTX from the fast re-authentication context & returns
+// EAP-Request/SIM/Re-authentication, see https://tools.ietf.org/html/rfc4186#section-9.5
+func createReauthRequest(
+	s *servicers.EapSimSrv,
+	lockedCtx *servicers.UserCtx,
+	identifier uint8,
+	reauthId string,
+	rctx *servicers.ReauthCtx) (eap.Packet, error) {
+
+	nonceS := make([]byte, sim.NONCE_LEN)
+	if _, err := rand.Read(nonceS); err != nil {
+		return sim.EapErrorResPacket(identifier, sim.NOTIFICATION_FAILURE, codes.Internal, "%v", err)
+	}
+	identifier++
+
+	lockedCtx.Identifier = identifier
+	lockedCtx.Identity = rctx.Identity
+	lockedCtx.ReauthId = reauthId
+	lockedCtx.Profile = rctx.Profile
+	lockedCtx.Nonce = nonceS
+	lockedCtx.MK, lockedCtx.K_encr, lockedCtx.K_aut = rctx.MK, rctx.K_encr, rctx.K_aut
+	lockedCtx.Counter = rctx.Counter
+	lockedCtx.NextReauthId = ""
+
+	p := eap.NewPacket(eap.RequestCode, identifier, []byte{sim.TYPE, byte(sim.SubtypeReauthentication), 0, 0})
+	p, err := appendNextReauthId(
+		p, lockedCtx, rctx.Identity,
+		[]eap.Attribute{
+			sim.NewCounterAttr(sim.AT_COUNTER, rctx.Counter),
+			sim.NewPaddedAttr(sim.AT_NONCE_S, nonceS)})
+	if err != nil {
+		return sim.EapErrorResPacket(identifier, sim.NOTIFICATION_FAILURE, codes.Internal, "%v", err)
+	}
+	// AT_MAC of SIM/Re-authentication Request is calculated over the EAP packet only
+	p, err = sim.AppendMac(p, nil, lockedCtx.K_aut)
+	if err != nil {
+		return sim.EapErrorResPacket(identifier, sim.NOTIFICATION_FAILURE, codes.Internal, "%v", err)
+	}
+	return p, nil
+}
+
+// appendNextReauthId generates new fast re-authentication identity & appends it along with given attributes
+// to the EAP packet as encrypted AT_ENCR_DATA & corresponding AT_IV
+func appendNextReauthId(
+	p eap.Packet, lockedCtx *servicers.UserCtx, identity string, attrs []eap.Attribute) (eap.Packet, error) {
+
+	reauthId, err := servicers.NewReauthId(identity)
+	if err != nil {
+		return p, err
+	}
+	iv, encrData, err := sim.EncryptAttributes(
+		append(attrs, sim.NewIdentityAttr(sim.AT_NEXT_REAUTH_ID, reauthId)), lockedCtx.K_encr)
+	if err != nil {
+		return p, err
+	}
+	p, err = p.Append(sim.NewPaddedAttr(sim.AT_IV, iv))
+	if err != nil {
+		return p, err
+	}
+	p, err = p.Append(sim.NewPaddedAttr(sim.AT_ENCR_DATA, encrData))
+	if err == nil {
+		lockedCtx.NextReauthId = reauthId
+	}
+	return p, err
+}
+
+// getTriplets retrieves n GSM triplets (RAND, SRES & Kc) with distinct RANDs for the given IMSI from HSS
+// via SWx Proxy. HSS returns GSM triplets of SIM subscribers & UMTS authentication vectors of USIM
+// subscribers, the latter are converted to triplets (see 3GPP TS 33.102, 6.8.1.2)
+func getTriplets(imsi aka.IMSI, n int) (
+	rands, srese, kcs [][]byte,
+	profile *swx_protos.AuthenticationAnswer_UserProfile,
+	errCode codes.Code,
+	err error) {
+
+	for attempt := 0; attempt < maxSwxAttempts && len(rands) < n; attempt++ {
+		metrics.SwxRequests.Inc()
+		swxStartTime := time.Now()
+
+		ans, err := swx_proxy.Authenticate(
+			&swx_protos.AuthenticationRequest{
+				UserName:             string(imsi),
+				SipNumAuthVectors:    uint32(n - len(rands)),
+				AuthenticationScheme: swx_protos.AuthenticationScheme_EAP_SIM,
+				RetrieveUserProfile:  true,
+			})
+
+		metrics.SWxLatency.Observe(time.Since(swxStartTime).Seconds())
+
+		if err != nil {
+			metrics.SwxFailures.Inc()
+			errCode = codes.Internal
+			if se, ok := err.(interface{ GRPCStatus() *status.Status }); ok {
+				errCode = se.GRPCStatus().Code()
+			}
+			return nil, nil, nil, nil, errCode, err
+		}
+		if ans == nil || len(ans.SipAuthVectors) == 0 {
+			return nil, nil, nil, nil, codes.Internal, fmt.Errorf("Missing SWx Auth Vectors for IMSI: %s", imsi)
+		}
+		if ans.GetUserProfile() != nil {
+			profile = ans.GetUserProfile()
+		}
+	vectorLoop:
+		for _, av := range ans.SipAuthVectors {
+			r, sres, kc, err := tripletFromVector(av)
+			if err != nil {
+				return nil, nil, nil, nil, codes.Internal, fmt.Errorf("%v for IMSI: %s", err, imsi)
+			}
+			for _, existing := range rands {
+				if bytes.Equal(existing, r) {
+					continue vectorLoop // RANDs of one challenge must be distinct, RFC 4186, section 9.3
+				}
+			}
+			rands, srese, kcs = append(rands, r), append(srese, sres), append(kcs, kc)
+			if len(rands) == n {
+				break
+			}
+		}
+	}
+	if len(rands) < n {
+		return nil, nil, nil, nil, codes.Unavailable, fmt.Errorf(
+			"Failed to retrieve %d distinct triplets for IMSI: %s, got: %d", n, imsi, len(rands))
+	}
+	return rands, srese, kcs, profile, codes.OK, nil
+}
+
+// tripletFromVector returns the GSM triplet of a SWx EAP-SIM vector or the triplet converted from a USIM's
+// EAP-AKA vector
+func tripletFromVector(av *swx_protos.AuthenticationAnswer_SIPAuthVector) (r, sres, kc []byte, err error) {
+	switch av.GetAuthenticationScheme() {
+	case swx_protos.AuthenticationScheme_EAP_SIM:
+		r, sres, kc = av.GetRandAutn(), av.GetXres(), av.GetConfidentialityKey()
+		if len(r) != sim.RAND_LEN || len(sres) != sim.SRES_LEN || len(kc) != sim.KC_LEN {
+			return nil, nil, nil, fmt.Errorf(
+				"Invalid SWx triplet (RAND len %d, SRES len %d, Kc len %d)", len(r), len(sres), len(kc))
+		}
+		return r, sres, kc, nil
+	case swx_protos.AuthenticationScheme_EAP_AKA:
+		ra := av.GetRandAutn()
+		if len(ra) < aka.RandAutnLen {
+			return nil, nil, nil, fmt.Errorf("Invalid SWx RandAutn len (%d, expected: %d)", len(ra), aka.RandAutnLen)
+		}
+		sres, kc, err = sim.TripletFromQuintet(av.GetXres(), av.GetConfidentialityKey(), av.GetIntegrityKey())
+		return ra[:sim.RAND_LEN], sres, kc, err
+	default:
+		return nil, nil, nil, fmt.Errorf("Unexpected SWx Authentication Scheme: %v", av.GetAuthenticationScheme())
+	}
+}
+
+// getIdentity returns identity string of AT_IDENTITY attribute, see https://tools.ietf.org/html/rfc4186#section-10.5
+func getIdentity(a eap.Attribute) (string, error) {
+	if a.Type() != sim.AT_IDENTITY {
+		return "", fmt.Errorf("Unexpected Attr Type: %d, AT_IDENTITY expected", a.Type())
+	}
+	if a.Len() <= sim.ATT_HDR_LEN {
+		return "", fmt.Errorf("AT_IDENTITY is too short: %d", a.Len())
+	}
+	val := a.Value()
+	actualLen2 := int(val[0])<<8 + int(val[1]) + 2
+	if actualLen2 > len(val) {
+		return "", fmt.Errorf("Corrupt AT_IDENTITY Attribute: actual len %d > data len %d", actualLen2-2, len(val))
+	}
+	return string(val[2:actualLen2]), nil
+}
+
+// getPermanentIMSI returns IMSI of a permanent EAP-SIM identity ('1' prefixed IMSI, optionally followed by realm)
+func getPermanentIMSI(identity string) (aka.IMSI, error) {
+	if len(identity) == 0 || identity[0] != sim.PermanentIdPrefix {
+		return "", fmt.Errorf("'%s' is not an EAP-SIM permanent identity", identity)
+	}
+	imsi := aka.IMSI(identity[1:])
+	if atIdx := strings.Index(string(imsi), "@"); atIdx >= 0 {
+		imsi = imsi[:atIdx]
+	}
+	return imsi, imsi.Validate()
+}
+
+// verifyMac verifies AT_MAC of the given EAP packet (AT_MAC value is zeroed in the packet during the check)
+func verifyMac(p eap.Packet, atMac eap.Attribute, extra, K_aut []byte) (ueMac, mac []byte, err error) {
+	macBytes := atMac.Marshaled()
+	if len(macBytes) < sim.ATT_HDR_LEN+sim.MAC_LEN {
+		return nil, nil, fmt.Errorf("Malformed AT_MAC")
+	}
+	ueMac = make([]byte, len(macBytes)-sim.ATT_HDR_LEN)
+	copy(ueMac, macBytes[sim.ATT_HDR_LEN:])
+	for i := sim.ATT_HDR_LEN; i < len(macBytes); i++ {
+		macBytes[i] = 0
+	}
+	mac = sim.GenMac(p, extra, K_aut)
+	if !bytes.Equal(ueMac, mac) {
+		return ueMac, mac, fmt.Errorf("Invalid MAC")
+	}
+	return ueMac, mac, nil
+}
+
+func successPacket(identifier uint8) eap.Packet {
+	// RFC 3748 p4.2 EAP Success packet
+	//  0                   1                   2                   3
+	//  0 1 2 3 4 5 6 7 8 9 0 1 2 3 4 5 6 7 8 9 0 1 2 3 4 5 6 7 8 9 0 1
+	// +-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
+	// |     Code      |  Identifier   |            Length             |
+	// +-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+-+
+	return []byte{
+		eap.SuccessCode, // Code
+		identifier,      // Identifier
+		0, 4}            // Length
+}
@@ -0,0 +1,148 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+// Package handlers provided SIM Response handlers for supported SIM subtypes
+package handlers
+
+import (
+	"io"
+	"log"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"magma/feg/gateway/services/eap"
+	"magma/feg/gateway/services/eap/protos"
+	"magma/feg/gateway/services/eap/providers/sim"
+	"magma/feg/gateway/services/eap/providers/sim/metrics"
+	"magma/feg/gateway/services/eap/providers/sim/servicers"
+)
+
+func init() {
+	servicers.AddHandler(sim.SubtypeChallenge, challengeResponse)
+}
+
+// challengeResponse implements handler for SIM Challenge Response,
+// see https://tools.ietf.org/html/rfc4186#section-9.4 for details
+func challengeResponse(s *servicers.EapSimSrv, ctx *protos.EapContext, req eap.Packet) (eap.Packet, error) {
+	var (
+		success    bool
+		ctxCreated time.Time
+	)
+	metrics.ChallengeRequests.Inc()
+	defer func() {
+		if !ctxCreated.IsZero() {
+			metrics.AuthLatency.Observe(time.Since(ctxCreated).Seconds())
+		}
+		if !success {
+			metrics.FailedChallengeRequests.Inc()
+		}
+	}()
+
+	identifier := req.Identifier()
+	if ctx == nil {
+		return sim.EapErrorResPacket(identifier, sim.NOTIFICATION_FAILURE, codes.InvalidArgument, "Nil CTX")
+	}
+	if len(ctx.SessionId) == 0 {
+		return sim.EapErrorResPacket(identifier, sim.NOTIFICATION_FAILURE, codes.InvalidArgument, "Missing Session ID")
+	}
+	sessionId := ctx.SessionId
+	imsi, uc, ok := s.FindSession(sessionId)
+	if !ok {
+		return sim.EapErrorResPacket(identifier, sim.NOTIFICATION_FAILURE, codes.FailedPrecondition,
+			"No Session found for ID: %s", ctx.SessionId)
+	}
+	if uc == nil {
+		s.UpdateSessionTimeout(sessionId, s.NotificationTimeout())
+		return sim.EapErrorResPacket(identifier, sim.NOTIFICATION_FAILURE, codes.FailedPrecondition,
+			"No IMSI '%s' found for SessionID: %s", imsi, ctx.SessionId)
+	}
+	ctxCreated = uc.CreatedTime()
+
+	state, _ := uc.State()
+	if state != sim.StateChallenge {
+		s.UpdateSessionUnlockCtx(uc, s.NotificationTimeout())
+		return sim.EapErrorResPacket(identifier, sim.NOTIFICATION_FAILURE, codes.FailedPrecondition,
+			"SIM Challenge Response: Unexpected user state: %d for IMSI: %s, Session: %s",
+			state, imsi, ctx.SessionId)
+	}
+
+	p := make([]byte, len(req))
+	copy(p, req)
+	scanner, err := eap.NewAttributeScanner(p)
+	if err != nil {
+		s.UpdateSessionUnlockCtx(uc, s.NotificationTimeout())
+		return sim.EapErrorResPacket(identifier, sim.NOTIFICATION_FAILURE, codes.Aborted, "%v", err)
+	}
+
+	var a, atMac eap.Attribute
+
+	for a, err = scanner.Next(); err == nil; a, err = scanner.Next() {
+		switch a.Type() {
+		case sim.AT_MAC:
+			atMac = a
+		case sim.AT_RESULT_IND: // Ignore result indications for now
+		default:
+			log.Printf("INFO: Unexpected EAP-SIM Challenge Response Attribute type %d", a.Type())
+		}
+	}
+	if err != io.EOF {
+		s.UpdateSessionUnlockCtx(uc, s.NotificationTimeout())
+		return sim.EapErrorResPacket(identifier, sim.NOTIFICATION_FAILURE, codes.InvalidArgument, "%v", err)
+	}
+	if atMac == nil {
+		s.UpdateSessionUnlockCtx(uc, s.NotificationTimeout())
+		return sim.EapErrorResPacket(identifier, sim.NOTIFICATION_FAILURE, codes.InvalidArgument, "Missing AT_MAC")
+	}
+
+	// Verify MAC, the peer's AT_MAC is calculated over the EAP packet & n*SRES
+	ueMac, mac, err := verifyMac(p, atMac, uc.Sres, uc.K_aut)
+	if err != nil {
+		s.UpdateSessionUnlockCtx(uc, s.NotificationTimeout())
+		log.Printf(
+			"Invalid MAC for Session ID: %s; IMSI: %s; UE MAC: %x; Expected MAC: %x; EAP: %x",
+			ctx.SessionId, imsi, ueMac, mac, req)
+		return sim.EapErrorResPacketWithMac(
+			identifier, sim.NOTIFICATION_FAILURE_AUTH, uc.K_aut, codes.Unauthenticated,
+			"Invalid MAC for Session ID: %s; IMSI: %s", ctx.SessionId, imsi)
+	}
+	success = true
+
+	// Save fast re-authentication context for the next authentication of the peer
+	if len(uc.NextReauthId) > 0 {
+		s.AddReauthCtx(uc.NextReauthId, &servicers.ReauthCtx{
+			Identity: uc.Identity,
+			Imsi:     imsi,
+			Profile:  uc.Profile,
+			MK:       uc.MK,
+			K_encr:   uc.K_encr,
+			K_aut:    uc.K_aut,
+			Counter:  1,
+		})
+	}
+	// All good, set IMSI, MSK & Identity for farther use by Radius and return SuccessCode
+	setAuthenticated(ctx, uc)
+
+	// Keep session & User Ctx around for some time after authentication and then clean them up
+	uc.Unlock()
+	s.ResetSessionTimeout(sessionId, s.SessionAuthenticatedTimeout())
+
+	return successPacket(identifier), nil
+}
+
+// setAuthenticated sets IMSI, MSISDN, MSK & Identity of the authenticated user into EAP CTX
+// & moves the user CTX into authenticated state
+func setAuthenticated(ctx *protos.EapContext, lockedCtx *servicers.UserCtx) {
+	ctx.Imsi = string(lockedCtx.Imsi)
+	if lockedCtx.Profile != nil {
+		ctx.Msisdn = lockedCtx.Profile.Msisdn
+	}
+	ctx.Msk = lockedCtx.MSK
+	ctx.Identity = lockedCtx.Identity
+	lockedCtx.SetState(sim.StateAuthenticated)
+}
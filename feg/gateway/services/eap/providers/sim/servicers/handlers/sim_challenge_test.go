@@ -0,0 +1,314 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+package handlers
+
+import (
+	"reflect"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+
+	"magma/feg/cloud/go/protos"
+	"magma/feg/gateway/registry"
+	"magma/feg/gateway/services/eap"
+	eap_protos "magma/feg/gateway/services/eap/protos"
+	"magma/feg/gateway/services/eap/providers/sim"
+	"magma/feg/gateway/services/eap/providers/sim/servicers"
+	"magma/orc8r/cloud/go/test_utils"
+)
+
+const (
+	testRealm    = "@wlan.mnc001.mcc001.3gppnetwork.org"
+	testIdentity = "1001010000000055" + testRealm
+	testImsi     = "001010000000055"
+	testMsisdn   = "12345678"
+	// testSimImsi is a SIM (not USIM) subscriber, HSS returns GSM triplets for it
+	testSimImsi = "001010000000066"
+)
+
+var (
+	testXres  = []byte("\x29\x5c\x00\xea\xe3\x88\x93\x0d")
+	testCK    = []byte("\xa8\x35\xcf\x22\xb0\xf4\x3e\x15\x19\xd6\xfd\x23\x4c\x00\xd7\x93")
+	testIK    = []byte("\xd5\x37\x0f\x13\x79\x6f\x2f\x61\x5c\xbe\x15\xef\x9f\x42\x0a\x98")
+	testNonce = []byte("\x01\x23\x45\x67\x89\xab\xcd\xef\xfe\xdc\xba\x98\x76\x54\x32\x10")
+	testSres  = []byte("\x9b\x36\x4d\x21")
+	testKc    = []byte("\x4f\xe1\x7a\x0c\x52\x8d\x33\xb6")
+)
+
+// testSwxProxy returns one authentication vector with unique RAND per call, similar to SWx Proxy
+// serving vectors from its cache. Like HSS, it returns GSM triplets for SIM subscribers & EAP-AKA
+// vectors for USIM subscribers
+type testSwxProxy struct {
+	calls *int32
+}
+
+// Authenticate sends MAR (code 303) over diameter connection,
+// waits (blocks) for MAA & returns its RPC representation
+func (s testSwxProxy) Authenticate(
+	ctx context.Context,
+	req *protos.AuthenticationRequest,
+) (*protos.AuthenticationAnswer, error) {
+	n := atomic.AddInt32(s.calls, 1)
+	if req.GetUserName() == testSimImsi {
+		return &protos.AuthenticationAnswer{
+			UserName: req.GetUserName(),
+			SipAuthVectors: []*protos.AuthenticationAnswer_SIPAuthVector{
+				{
+					AuthenticationScheme: protos.AuthenticationScheme_EAP_SIM,
+					RandAutn:             testRand(n),
+					Xres:                 testSres,
+					ConfidentialityKey:   testKc,
+				},
+			},
+		}, nil
+	}
+	return &protos.AuthenticationAnswer{
+		UserName: req.GetUserName(),
+		SipAuthVectors: []*protos.AuthenticationAnswer_SIPAuthVector{
+			{
+				AuthenticationScheme: protos.AuthenticationScheme_EAP_AKA,
+				RandAutn: append(
+					testRand(n),
+					"\x54\xab\x64\x4a\x90\x51\xb9\xb9\x5e\x85\xc1\x22\x3e\x0e\xf1\x4c"...),
+				Xres:               testXres,
+				ConfidentialityKey: testCK,
+				IntegrityKey:       testIK,
+			},
+		},
+		UserProfile: &protos.AuthenticationAnswer_UserProfile{Msisdn: testMsisdn},
+	}, nil
+}
+
+// Register sends SAR (code 301) over diameter connection,
+// waits (blocks) for SAA & returns its RPC representation
+func (s testSwxProxy) Register(
+	ctx context.Context,
+	req *protos.RegistrationRequest,
+) (*protos.RegistrationAnswer, error) {
+	return &protos.RegistrationAnswer{}, nil
+}
+
+// Deregister sends SAR (code 301) over diameter connection,
+// waits (blocks) for SAA & returns its RPC representation
+func (s testSwxProxy) Deregister(
+	ctx context.Context,
+	req *protos.RegistrationRequest,
+) (*protos.RegistrationAnswer, error) {
+	return &protos.RegistrationAnswer{}, nil
+}
+
+func testRand(n int32) []byte {
+	return []byte{byte(n), 0x23, 0x45, 0x67, 0x89, 0xab, 0xcd, 0xef, 0x01, 0x23, 0x45, 0x67, 0x89, 0xab, 0xcd, 0xef}
+}
+
+func startTestSwxProxy(t *testing.T) {
+	srv, lis := test_utils.NewTestService(t, registry.ModuleName, registry.SWX_PROXY)
+	protos.RegisterSwxProxyServer(srv.GrpcServer, testSwxProxy{calls: new(int32)})
+	go srv.RunTest(lis)
+}
+
+// newStartResp returns EAP-Response/SIM/Start with the given identity & optional NONCE_MT
+func newStartResp(t *testing.T, identifier uint8, identity string, nonceMt []byte) eap.Packet {
+	p := eap.NewPacket(eap.ResponseCode, identifier, []byte{sim.TYPE, byte(sim.SubtypeStart), 0, 0})
+	var err error
+	if nonceMt != nil {
+		if p, err = p.Append(sim.NewPaddedAttr(sim.AT_NONCE_MT, nonceMt)); err != nil {
+			t.Fatal(err)
+		}
+		if p, err = p.Append(eap.NewAttribute(sim.AT_SELECTED_VERSION, []byte{0, 1})); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if p, err = p.Append(sim.NewIdentityAttr(sim.AT_IDENTITY, identity)); err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+// getAttributes returns a map of the packet's attributes keyed by attribute type
+func getAttributes(t *testing.T, p eap.Packet) map[eap.AttrType]eap.Attribute {
+	scanner, err := eap.NewAttributeScanner(p)
+	if err != nil {
+		t.Fatalf("Attribute Scanner error: %v", err)
+	}
+	res := map[eap.AttrType]eap.Attribute{}
+	for a, err := scanner.Next(); err == nil; a, err = scanner.Next() {
+		res[a.Type()] = a
+	}
+	return res
+}
+
+// checkMac verifies AT_MAC of the packet received from the server
+func checkMac(t *testing.T, p eap.Packet, extra, K_aut []byte) {
+	pc := make([]byte, len(p))
+	copy(pc, p)
+	atMac, ok := getAttributes(t, pc)[sim.AT_MAC]
+	if !ok {
+		t.Fatalf("Missing AT_MAC in %v", p)
+	}
+	if _, _, err := verifyMac(pc, atMac, extra, K_aut); err != nil {
+		t.Fatalf("AT_MAC verification failed for %v: %v", p, err)
+	}
+}
+
+// decryptAttributes returns decrypted attributes of the packet's AT_ENCR_DATA
+func decryptAttributes(t *testing.T, p eap.Packet, K_encr []byte) map[eap.AttrType]eap.Attribute {
+	attrs := getAttributes(t, p)
+	atIv, atEncrData := attrs[sim.AT_IV], attrs[sim.AT_ENCR_DATA]
+	if atIv == nil || atEncrData == nil {
+		t.Fatalf("Missing AT_IV | AT_ENCR_DATA in %v", p)
+	}
+	decrypted, err := sim.DecryptAttributes(
+		atIv.Value()[2:], atEncrData.Value()[2:], K_encr, sim.Subtype(p[eap.EapSubtype]))
+	if err != nil {
+		t.Fatalf("DecryptAttributes error: %v", err)
+	}
+	return getAttributes(t, decrypted)
+}
+
+func identityValue(t *testing.T, a eap.Attribute) string {
+	if a == nil {
+		t.Fatal("Nil identity attribute")
+	}
+	val := a.Value()
+	return string(val[2 : 2+int(val[0])<<8+int(val[1])])
+}
+
+// fullAuth runs SIM Start & Challenge round trips and returns the keys & next re-authentication identity
+func fullAuth(t *testing.T, simSrv *servicers.EapSimSrv) (MK, K_encr, K_aut []byte, reauthId string) {
+	eapCtx := &eap_protos.EapContext{}
+	p, err := startResponse(simSrv, eapCtx, newStartResp(t, 1, testIdentity, testNonce))
+	if err != nil {
+		t.Fatalf("Unexpected startResponse error: %v", err)
+	}
+	if len(eapCtx.SessionId) == 0 {
+		t.Fatal("Empty Session ID")
+	}
+	if p.Type() != sim.TYPE || sim.Subtype(p[eap.EapSubtype]) != sim.SubtypeChallenge || p.Identifier() != 2 {
+		t.Fatalf("Unexpected startResponse EAP: %v", p)
+	}
+	attrs := getAttributes(t, p)
+	atRand := attrs[sim.AT_RAND]
+	if atRand == nil || atRand.Len() != sim.ATT_HDR_LEN+sim.DefaultTriplets*sim.RAND_LEN {
+		t.Fatalf("Invalid AT_RAND: %v", atRand)
+	}
+	// Peer side key derivation
+	sres, kc, err := sim.TripletFromQuintet(testXres, testCK, testIK)
+	if err != nil {
+		t.Fatalf("TripletFromQuintet error: %v", err)
+	}
+	var kcs [][]byte
+	var srese []byte
+	for i := 0; i < sim.DefaultTriplets; i++ {
+		kcs = append(kcs, kc)
+		srese = append(srese, sres...)
+	}
+	MK = sim.MK([]byte(testIdentity), kcs, testNonce, []uint16{sim.VERSION}, sim.VERSION)
+	K_encr, K_aut, MSK, _ := sim.MakeKeys(MK)
+	checkMac(t, p, testNonce, K_aut)
+
+	reauthId = identityValue(t, decryptAttributes(t, p, K_encr)[sim.AT_NEXT_REAUTH_ID])
+	if len(reauthId) < 2 || reauthId[0] != sim.ReauthIdPrefix || !strings.HasSuffix(reauthId, testRealm) {
+		t.Fatalf("Invalid Re-authentication ID: %s", reauthId)
+	}
+	if simSrv.IsReauthId(reauthId) {
+		t.Fatalf("Re-authentication ID %s must not be active before successful authentication", reauthId)
+	}
+
+	resp := eap.NewPacket(eap.ResponseCode, 2, []byte{sim.TYPE, byte(sim.SubtypeChallenge), 0, 0})
+	resp, err = sim.AppendMac(resp, srese, K_aut)
+	if err != nil {
+		t.Fatalf("AppendMac error: %v", err)
+	}
+	p, err = challengeResponse(simSrv, eapCtx, resp)
+	if err != nil {
+		t.Fatalf("Unexpected challengeResponse error: %v", err)
+	}
+	if !reflect.DeepEqual([]byte(p), []byte{eap.SuccessCode, 2, 0, 4}) {
+		t.Fatalf("Unexpected challengeResponse EAP\n\tReceived: %v\n\tExpected Success", p)
+	}
+	if eapCtx.Imsi != testImsi || eapCtx.Msisdn != testMsisdn || eapCtx.Identity != testIdentity {
+		t.Fatalf("Unexpected EAP CTX: %+v", *eapCtx)
+	}
+	if !reflect.DeepEqual(eapCtx.Msk, MSK) {
+		t.Fatalf("Unexpected MSK\n\tReceived: %x\n\tExpected: %x", eapCtx.Msk, MSK)
+	}
+	if !simSrv.IsReauthId(reauthId) {
+		t.Fatalf("Re-authentication ID %s is not active after successful authentication", reauthId)
+	}
+	return MK, K_encr, K_aut, reauthId
+}
+
+func TestSimChallengeResp(t *testing.T) {
+	startTestSwxProxy(t)
+	simSrv, _ := servicers.NewEapSimService(nil, nil)
+	fullAuth(t, simSrv)
+
+	// Invalid MAC must result in SIM Notification
+	eapCtx := &eap_protos.EapContext{}
+	p, err := startResponse(simSrv, eapCtx, newStartResp(t, 1, testIdentity, testNonce))
+	if err != nil {
+		t.Fatalf("Unexpected startResponse error: %v", err)
+	}
+	resp := eap.NewPacket(eap.ResponseCode, 2, []byte{sim.TYPE, byte(sim.SubtypeChallenge), 0, 0})
+	resp, _ = sim.AppendMac(resp, nil, make([]byte, sim.K_AUT_LEN))
+	p, err = challengeResponse(simSrv, eapCtx, resp)
+	if err != nil {
+		t.Fatalf("Unexpected challengeResponse error: %v", err)
+	}
+	if p.Type() != sim.TYPE || sim.Subtype(p[eap.EapSubtype]) != sim.SubtypeNotification {
+		t.Fatalf("Expected SIM Notification, received: %v", p)
+	}
+}
+
+func TestGetTriplets(t *testing.T) {
+	startTestSwxProxy(t)
+
+	// SIM subscriber's triplets are used as is
+	rands, srese, kcs, _, errCode, err := getTriplets(testSimImsi, sim.DefaultTriplets)
+	if err != nil || errCode != codes.OK {
+		t.Fatalf("Unexpected getTriplets error: %v (%v)", err, errCode)
+	}
+	if len(rands) != sim.DefaultTriplets {
+		t.Fatalf("Unexpected number of triplets: %d", len(rands))
+	}
+	for i := range rands {
+		if !reflect.DeepEqual(srese[i], testSres) || !reflect.DeepEqual(kcs[i], testKc) {
+			t.Fatalf("Unexpected SIM triplet SRES: %x, Kc: %x", srese[i], kcs[i])
+		}
+	}
+
+	// USIM subscriber's vectors are converted to triplets
+	expectedSres, expectedKc, _ := sim.TripletFromQuintet(testXres, testCK, testIK)
+	rands, srese, kcs, _, errCode, err = getTriplets(testImsi, sim.DefaultTriplets)
+	if err != nil || errCode != codes.OK {
+		t.Fatalf("Unexpected getTriplets error: %v (%v)", err, errCode)
+	}
+	for i := range rands {
+		if !reflect.DeepEqual(srese[i], expectedSres) || !reflect.DeepEqual(kcs[i], expectedKc) {
+			t.Fatalf("Unexpected USIM triplet SRES: %x, Kc: %x", srese[i], kcs[i])
+		}
+	}
+}
+
+func TestSimStartUnknownIdentity(t *testing.T) {
+	simSrv, _ := servicers.NewEapSimService(nil, nil)
+	for _, identity := range []string{"5unknown@wlan.org", "3pseudonym@wlan.org"} {
+		p, err := startResponse(simSrv, &eap_protos.EapContext{}, newStartResp(t, 1, identity, nil))
+		if err != nil {
+			t.Fatalf("Unexpected startResponse error: %v", err)
+		}
+		expected := sim.NewStartReq(2, sim.AT_PERMANENT_ID_REQ)
+		if !reflect.DeepEqual(p, expected) {
+			t.Fatalf("Unexpected startResponse EAP for '%s'\n\tReceived: %v\n\tExpected: %v", identity, p, expected)
+		}
+	}
+}
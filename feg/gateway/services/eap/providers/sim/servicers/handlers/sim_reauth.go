@@ -0,0 +1,205 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+// Package handlers provided SIM Response handlers for supported SIM subtypes
+package handlers
+
+import (
+	"io"
+	"log"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"magma/feg/gateway/services/eap"
+	"magma/feg/gateway/services/eap/protos"
+	"magma/feg/gateway/services/eap/providers/sim"
+	"magma/feg/gateway/services/eap/providers/sim/metrics"
+	"magma/feg/gateway/services/eap/providers/sim/servicers"
+)
+
+func init() {
+	servicers.AddHandler(sim.SubtypeReauthentication, reauthResponse)
+}
+
+// reauthResponse implements handler for SIM Re-authentication Response,
+// see https://tools.ietf.org/html/rfc4186#section-9.6 for details
+func reauthResponse(s *servicers.EapSimSrv, ctx *protos.EapContext, req eap.Packet) (eap.Packet, error) {
+	var (
+		success    bool
+		ctxCreated time.Time
+	)
+	metrics.ReauthRequests.Inc()
+	defer func() {
+		if !ctxCreated.IsZero() {
+			metrics.AuthLatency.Observe(time.Since(ctxCreated).Seconds())
+		}
+		if !success {
+			metrics.FailedReauthRequests.Inc()
+		}
+	}()
+
+	identifier := req.Identifier()
+	if ctx == nil {
+		return sim.EapErrorResPacket(identifier, sim.NOTIFICATION_FAILURE, codes.InvalidArgument, "Nil CTX")
+	}
+	if len(ctx.SessionId) == 0 {
+		return sim.EapErrorResPacket(identifier, sim.NOTIFICATION_FAILURE, codes.InvalidArgument, "Missing Session ID")
+	}
+	sessionId := ctx.SessionId
+	imsi, uc, ok := s.FindSession(sessionId)
+	if !ok {
+		return sim.EapErrorResPacket(identifier, sim.NOTIFICATION_FAILURE, codes.FailedPrecondition,
+			"No Session found for ID: %s", ctx.SessionId)
+	}
+	if uc == nil {
+		s.UpdateSessionTimeout(sessionId, s.NotificationTimeout())
+		return sim.EapErrorResPacket(identifier, sim.NOTIFICATION_FAILURE, codes.FailedPrecondition,
+			"No IMSI '%s' found for SessionID: %s", imsi, ctx.SessionId)
+	}
+	ctxCreated = uc.CreatedTime()
+
+	state, _ := uc.State()
+	if state != sim.StateReauth {
+		s.UpdateSessionUnlockCtx(uc, s.NotificationTimeout())
+		return sim.EapErrorResPacket(identifier, sim.NOTIFICATION_FAILURE, codes.FailedPrecondition,
+			"SIM Re-authentication Response: Unexpected user state: %d for IMSI: %s, Session: %s",
+			state, imsi, ctx.SessionId)
+	}
+
+	p := make([]byte, len(req))
+	copy(p, req)
+	scanner, err := eap.NewAttributeScanner(p)
+	if err != nil {
+		s.UpdateSessionUnlockCtx(uc, s.NotificationTimeout())
+		return sim.EapErrorResPacket(identifier, sim.NOTIFICATION_FAILURE, codes.Aborted, "%v", err)
+	}
+
+	var a, atMac, atIv, atEncrData eap.Attribute
+
+	for a, err = scanner.Next(); err == nil; a, err = scanner.Next() {
+		switch a.Type() {
+		case sim.AT_MAC:
+			atMac = a
+		case sim.AT_IV:
+			atIv = a
+		case sim.AT_ENCR_DATA:
+			atEncrData = a
+		case sim.AT_RESULT_IND: // Ignore result indications for now
+		default:
+			log.Printf("INFO: Unexpected EAP-SIM Re-authentication Response Attribute type %d", a.Type())
+		}
+	}
+	if err != io.EOF {
+		s.UpdateSessionUnlockCtx(uc, s.NotificationTimeout())
+		return sim.EapErrorResPacket(identifier, sim.NOTIFICATION_FAILURE, codes.InvalidArgument, "%v", err)
+	}
+	if atMac == nil || atIv == nil || atEncrData == nil {
+		s.UpdateSessionUnlockCtx(uc, s.NotificationTimeout())
+		return sim.EapErrorResPacket(
+			identifier, sim.NOTIFICATION_FAILURE, codes.InvalidArgument, "Missing AT_MAC | AT_IV | AT_ENCR_DATA")
+	}
+
+	// Verify MAC, the peer's AT_MAC is calculated over the EAP packet & NONCE_S
+	ueMac, mac, err := verifyMac(p, atMac, uc.Nonce, uc.K_aut)
+	if err != nil {
+		s.UpdateSessionUnlockCtx(uc, s.NotificationTimeout())
+		log.Printf(
+			"Invalid MAC for Session ID: %s; IMSI: %s; UE MAC: %x; Expected MAC: %x; EAP: %x",
+			ctx.SessionId, imsi, ueMac, mac, req)
+		return sim.EapErrorResPacketWithMac(
+			identifier, sim.NOTIFICATION_FAILURE_AUTH, uc.K_aut, codes.Unauthenticated,
+			"Invalid MAC for Session ID: %s; IMSI: %s", ctx.SessionId, imsi)
+	}
+
+	counter, counterTooSmall, err := decryptCounter(atIv, atEncrData, uc.K_encr)
+	if err != nil {
+		s.UpdateSessionUnlockCtx(uc, s.NotificationTimeout())
+		return sim.EapErrorResPacketWithMac(
+			identifier, sim.NOTIFICATION_FAILURE_AUTH, uc.K_aut, codes.InvalidArgument,
+			"Invalid AT_ENCR_DATA for Session ID: %s; IMSI: %s: %v", ctx.SessionId, imsi, err)
+	}
+	if counterTooSmall {
+		// The peer rejected the counter, fall back to full authentication, see RFC 4186, section 5.5
+		log.Printf("EAP-SIM AT_COUNTER_TOO_SMALL for Session ID: %s; IMSI: %s; counter: %d",
+			ctx.SessionId, imsi, uc.Counter)
+		uc.SetState(sim.StateCreated)
+		s.UpdateSessionUnlockCtx(uc, s.StartTimeout())
+		return sim.NewStartReq(identifier+1, sim.AT_PERMANENT_ID_REQ), nil
+	}
+	if counter != uc.Counter {
+		s.UpdateSessionUnlockCtx(uc, s.NotificationTimeout())
+		return sim.EapErrorResPacketWithMac(
+			identifier, sim.NOTIFICATION_FAILURE_AUTH, uc.K_aut, codes.Unauthenticated,
+			"Invalid AT_COUNTER %d (expected: %d) for Session ID: %s; IMSI: %s",
+			counter, uc.Counter, ctx.SessionId, imsi)
+	}
+	success = true
+
+	uc.MSK, _ = sim.MakeReauthKeys([]byte(uc.ReauthId), uc.Counter, uc.Nonce, uc.MK)
+
+	// Save fast re-authentication context for the next re-authentication of the peer
+	if len(uc.NextReauthId) > 0 {
+		s.AddReauthCtx(uc.NextReauthId, &servicers.ReauthCtx{
+			Identity: uc.Identity,
+			Imsi:     imsi,
+			Profile:  uc.Profile,
+			MK:       uc.MK,
+			K_encr:   uc.K_encr,
+			K_aut:    uc.K_aut,
+			Counter:  uc.Counter + 1,
+		})
+	}
+	// All good, set IMSI, MSK & Identity for farther use by Radius and return SuccessCode
+	setAuthenticated(ctx, uc)
+
+	// Keep session & User Ctx around for some time after authentication and then clean them up
+	uc.Unlock()
+	s.ResetSessionTimeout(sessionId, s.SessionAuthenticatedTimeout())
+
+	return successPacket(identifier), nil
+}
+
+// decryptCounter decrypts AT_ENCR_DATA of SIM Re-authentication Response & returns its AT_COUNTER value
+// and a flag indicating presence of AT_COUNTER_TOO_SMALL
+func decryptCounter(atIv, atEncrData eap.Attribute, K_encr []byte) (uint16, bool, error) {
+	iv, encrData := atIv.Value(), atEncrData.Value()
+	if len(iv) < 2 || len(encrData) < 2 {
+		return 0, false, io.ErrShortBuffer
+	}
+	decrypted, err := sim.DecryptAttributes(iv[2:], encrData[2:], K_encr, sim.SubtypeReauthentication)
+	if err != nil {
+		return 0, false, err
+	}
+	scanner, err := eap.NewAttributeScanner(decrypted)
+	if err != nil {
+		return 0, false, err
+	}
+	var (
+		a                           eap.Attribute
+		counter                     uint16
+		hasCounter, counterTooSmall bool
+	)
+	for a, err = scanner.Next(); err == nil; a, err = scanner.Next() {
+		switch a.Type() {
+		case sim.AT_COUNTER:
+			if v := a.Value(); len(v) >= 2 {
+				counter, hasCounter = uint16(v[0])<<8|uint16(v[1]), true
+			}
+		case sim.AT_COUNTER_TOO_SMALL:
+			counterTooSmall = true
+		}
+	}
+	if err != io.EOF {
+		return 0, false, err
+	}
+	if !hasCounter {
+		return 0, false, io.ErrUnexpectedEOF
+	}
+	return counter, counterTooSmall, nil
+}
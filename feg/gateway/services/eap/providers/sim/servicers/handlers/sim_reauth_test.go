@@ -0,0 +1,128 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+package handlers
+
+import (
+	"reflect"
+	"testing"
+
+	"magma/feg/gateway/services/eap"
+	eap_protos "magma/feg/gateway/services/eap/protos"
+	"magma/feg/gateway/services/eap/providers/sim"
+	"magma/feg/gateway/services/eap/providers/sim/servicers"
+)
+
+// newReauthResp returns EAP-Response/SIM/Re-authentication with encrypted AT_COUNTER (and optional
+// AT_COUNTER_TOO_SMALL) signed with K_aut & NONCE_S
+func newReauthResp(
+	t *testing.T, identifier uint8, counter uint16, tooSmall bool, nonceS, K_encr, K_aut []byte) eap.Packet {
+
+	attrs := []eap.Attribute{sim.NewCounterAttr(sim.AT_COUNTER, counter)}
+	if tooSmall {
+		attrs = append(attrs, eap.NewAttribute(sim.AT_COUNTER_TOO_SMALL, []byte{0, 0}))
+	}
+	iv, encrData, err := sim.EncryptAttributes(attrs, K_encr)
+	if err != nil {
+		t.Fatalf("EncryptAttributes error: %v", err)
+	}
+	p := eap.NewPacket(eap.ResponseCode, identifier, []byte{sim.TYPE, byte(sim.SubtypeReauthentication), 0, 0})
+	if p, err = p.Append(sim.NewPaddedAttr(sim.AT_IV, iv)); err != nil {
+		t.Fatal(err)
+	}
+	if p, err = p.Append(sim.NewPaddedAttr(sim.AT_ENCR_DATA, encrData)); err != nil {
+		t.Fatal(err)
+	}
+	if p, err = sim.AppendMac(p, nonceS, K_aut); err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+// reauth runs SIM Start & Re-authentication request, returns the request's NONCE_S & next re-authentication ID
+func reauth(
+	t *testing.T,
+	simSrv *servicers.EapSimSrv,
+	eapCtx *eap_protos.EapContext,
+	reauthId string,
+	expectedCounter uint16,
+	K_encr, K_aut []byte) (nonceS []byte, nextReauthId string) {
+
+	p, err := startResponse(simSrv, eapCtx, newStartResp(t, 1, reauthId, nil))
+	if err != nil {
+		t.Fatalf("Unexpected startResponse error: %v", err)
+	}
+	if p.Type() != sim.TYPE || sim.Subtype(p[eap.EapSubtype]) != sim.SubtypeReauthentication || p.Identifier() != 2 {
+		t.Fatalf("Unexpected startResponse EAP: %v", p)
+	}
+	if simSrv.IsReauthId(reauthId) {
+		t.Fatalf("Re-authentication ID %s must not be reusable", reauthId)
+	}
+	checkMac(t, p, nil, K_aut)
+	attrs := decryptAttributes(t, p, K_encr)
+	atCounter, atNonceS := attrs[sim.AT_COUNTER], attrs[sim.AT_NONCE_S]
+	if atCounter == nil || atNonceS == nil {
+		t.Fatalf("Missing encrypted AT_COUNTER | AT_NONCE_S in %v", p)
+	}
+	if counter := uint16(atCounter.Value()[0])<<8 | uint16(atCounter.Value()[1]); counter != expectedCounter {
+		t.Fatalf("Unexpected AT_COUNTER: %d, expected: %d", counter, expectedCounter)
+	}
+	nonceS = atNonceS.Value()[2:]
+	if len(nonceS) != sim.NONCE_LEN {
+		t.Fatalf("Invalid NONCE_S length: %d", len(nonceS))
+	}
+	nextReauthId = identityValue(t, attrs[sim.AT_NEXT_REAUTH_ID])
+	if nextReauthId == reauthId {
+		t.Fatalf("Re-authentication ID %s was not rotated", reauthId)
+	}
+	return nonceS, nextReauthId
+}
+
+func TestSimFastReauth(t *testing.T) {
+	startTestSwxProxy(t)
+	simSrv, _ := servicers.NewEapSimService(nil, nil)
+	MK, K_encr, K_aut, reauthId := fullAuth(t, simSrv)
+
+	for counter := uint16(1); counter <= 2; counter++ {
+		eapCtx := &eap_protos.EapContext{}
+		nonceS, nextReauthId := reauth(t, simSrv, eapCtx, reauthId, counter, K_encr, K_aut)
+
+		p, err := reauthResponse(simSrv, eapCtx, newReauthResp(t, 2, counter, false, nonceS, K_encr, K_aut))
+		if err != nil {
+			t.Fatalf("Unexpected reauthResponse error: %v", err)
+		}
+		if !reflect.DeepEqual([]byte(p), []byte{eap.SuccessCode, 2, 0, 4}) {
+			t.Fatalf("Unexpected reauthResponse EAP\n\tReceived: %v\n\tExpected Success", p)
+		}
+		MSK, _ := sim.MakeReauthKeys([]byte(reauthId), counter, nonceS, MK)
+		if !reflect.DeepEqual(eapCtx.Msk, MSK) {
+			t.Fatalf("Unexpected MSK\n\tReceived: %x\n\tExpected: %x", eapCtx.Msk, MSK)
+		}
+		if eapCtx.Imsi != testImsi || eapCtx.Msisdn != testMsisdn || eapCtx.Identity != testIdentity {
+			t.Fatalf("Unexpected EAP CTX: %+v", *eapCtx)
+		}
+		if !simSrv.IsReauthId(nextReauthId) {
+			t.Fatalf("Next Re-authentication ID %s is not active", nextReauthId)
+		}
+		reauthId = nextReauthId
+	}
+
+	// Peer rejects the counter, server must fall back to full authentication
+	eapCtx := &eap_protos.EapContext{}
+	nonceS, nextReauthId := reauth(t, simSrv, eapCtx, reauthId, 3, K_encr, K_aut)
+	p, err := reauthResponse(simSrv, eapCtx, newReauthResp(t, 2, 3, true, nonceS, K_encr, K_aut))
+	if err != nil {
+		t.Fatalf("Unexpected reauthResponse error: %v", err)
+	}
+	expected := sim.NewStartReq(3, sim.AT_PERMANENT_ID_REQ)
+	if !reflect.DeepEqual(p, expected) {
+		t.Fatalf("Unexpected reauthResponse EAP\n\tReceived: %v\n\tExpected: %v", p, expected)
+	}
+	if simSrv.IsReauthId(nextReauthId) {
+		t.Fatalf("Re-authentication ID %s must not be active after AT_COUNTER_TOO_SMALL", nextReauthId)
+	}
+}
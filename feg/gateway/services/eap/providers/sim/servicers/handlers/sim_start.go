@@ -0,0 +1,153 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+// Package handlers provided SIM Response handlers for supported SIM subtypes
+package handlers
+
+import (
+	"fmt"
+	"io"
+	"log"
+
+	"google.golang.org/grpc/codes"
+
+	"magma/feg/gateway/services/eap"
+	"magma/feg/gateway/services/eap/protos"
+	"magma/feg/gateway/services/eap/providers/sim"
+	"magma/feg/gateway/services/eap/providers/sim/metrics"
+	"magma/feg/gateway/services/eap/providers/sim/servicers"
+)
+
+func init() {
+	servicers.AddHandler(sim.SubtypeStart, startResponse)
+}
+
+// startResponse implements handler for EAP-Response/SIM/Start, see https://tools.ietf.org/html/rfc4186#section-9.2
+// If the peer presents a known fast re-authentication identity, startResponse initiates fast re-authentication,
+// otherwise it retrieves triplets for the peer's permanent identity and returns SIM Challenge
+func startResponse(s *servicers.EapSimSrv, ctx *protos.EapContext, req eap.Packet) (eap.Packet, error) {
+	var success bool
+	metrics.StartRequests.Inc()
+	defer func() {
+		if !success {
+			metrics.FailedStartRequests.Inc()
+		}
+	}()
+	identifier := req.Identifier()
+	if ctx == nil {
+		return sim.EapErrorResPacket(identifier, sim.NOTIFICATION_FAILURE, codes.InvalidArgument, "Nil CTX")
+	}
+	if len(ctx.SessionId) == 0 {
+		ctx.SessionId = eap.CreateSessionId()
+		log.Printf("Missing Session ID for EAP: %x; Generated new SID: %s", req, ctx.SessionId)
+	}
+	scanner, err := eap.NewAttributeScanner(req)
+	if err != nil {
+		s.UpdateSessionTimeout(ctx.SessionId, s.NotificationTimeout())
+		return sim.EapErrorResPacket(identifier, sim.NOTIFICATION_FAILURE, codes.Aborted, "%v", err)
+	}
+	var (
+		a, atIdentity, atNonceMt, atSelectedVersion eap.Attribute
+	)
+	for a, err = scanner.Next(); err == nil; a, err = scanner.Next() {
+		switch a.Type() {
+		case sim.AT_IDENTITY:
+			atIdentity = a
+		case sim.AT_NONCE_MT:
+			atNonceMt = a
+		case sim.AT_SELECTED_VERSION:
+			atSelectedVersion = a
+		default:
+			log.Printf("INFO: Unexpected EAP-SIM Start Response Attribute type %d", a.Type())
+		}
+	}
+	if err != io.EOF {
+		s.UpdateSessionTimeout(ctx.SessionId, s.NotificationTimeout())
+		return sim.EapErrorResPacket(identifier, sim.NOTIFICATION_FAILURE, codes.InvalidArgument, "%v", err)
+	}
+	if atIdentity == nil {
+		s.UpdateSessionTimeout(ctx.SessionId, s.NotificationTimeout())
+		return sim.EapErrorResPacket(
+			identifier, sim.NOTIFICATION_FAILURE, codes.FailedPrecondition, "Missing AT_IDENTITY Attribute")
+	}
+	identity, err := getIdentity(atIdentity)
+	if err == nil && len(identity) == 0 {
+		err = fmt.Errorf("Empty AT_IDENTITY")
+	}
+	if err != nil {
+		s.UpdateSessionTimeout(ctx.SessionId, s.NotificationTimeout())
+		return sim.EapErrorResPacket(identifier, sim.NOTIFICATION_FAILURE, codes.InvalidArgument, "%v", err)
+	}
+
+	// Peer uses its fast re-authentication identity, see https://tools.ietf.org/html/rfc4186#section-5.2
+	if atNonceMt == nil && atSelectedVersion == nil && identity[0] == sim.ReauthIdPrefix {
+		if rctx, ok := s.FindAndRemoveReauthCtx(identity); ok {
+			ctx.Imsi = string(rctx.Imsi)
+			uc := s.InitSession(ctx.SessionId, rctx.Imsi) // we have Locked User Ctx after this call
+			p, err := createReauthRequest(s, uc, identifier, identity, rctx)
+			if success = err == nil; success {
+				uc.SetState(sim.StateReauth)
+				s.UpdateSessionUnlockCtx(uc, s.ChallengeTimeout())
+			} else {
+				s.UpdateSessionUnlockCtx(uc, s.NotificationTimeout())
+			}
+			return p, err
+		}
+		log.Printf("Unknown EAP-SIM Re-authentication Identity '%s', requesting permanent identity", identity)
+	}
+	if identity[0] != sim.PermanentIdPrefix {
+		// Unknown pseudonym or re-authentication identity, fall back to full authentication
+		success = true
+		s.UpdateSessionTimeout(ctx.SessionId, s.StartTimeout())
+		return sim.NewStartReq(identifier+1, sim.AT_PERMANENT_ID_REQ), nil
+	}
+
+	// Full authentication, see https://tools.ietf.org/html/rfc4186#section-9.3
+	if atNonceMt == nil || atSelectedVersion == nil {
+		s.UpdateSessionTimeout(ctx.SessionId, s.NotificationTimeout())
+		return sim.EapErrorResPacket(
+			identifier, sim.NOTIFICATION_FAILURE, codes.InvalidArgument, "Missing AT_NONCE_MT | AT_SELECTED_VERSION")
+	}
+	nonceMt := atNonceMt.Value()
+	if len(nonceMt) != sim.NONCE_LEN+2 {
+		s.UpdateSessionTimeout(ctx.SessionId, s.NotificationTimeout())
+		return sim.EapErrorResPacket(
+			identifier, sim.NOTIFICATION_FAILURE, codes.InvalidArgument, "Invalid AT_NONCE_MT length: %d", len(nonceMt))
+	}
+	nonceMt = append([]byte{}, nonceMt[2:]...) // skip reserved bytes
+	if v := atSelectedVersion.Value(); len(v) < 2 || (uint16(v[0])<<8|uint16(v[1])) != sim.VERSION {
+		s.UpdateSessionTimeout(ctx.SessionId, s.NotificationTimeout())
+		return sim.EapErrorResPacket(
+			identifier, sim.NOTIFICATION_FAILURE, codes.InvalidArgument, "Unsupported AT_SELECTED_VERSION: %v", v)
+	}
+	imsi, err := getPermanentIMSI(identity)
+	if err != nil {
+		s.UpdateSessionTimeout(ctx.SessionId, s.NotificationTimeout())
+		return sim.EapErrorResPacket(identifier, sim.NOTIFICATION_FAILURE, codes.InvalidArgument, "%v", err)
+	}
+	if !s.CheckPlmnId(imsi) {
+		s.UpdateSessionTimeout(ctx.SessionId, s.NotificationTimeout())
+		return sim.EapErrorResPacket(
+			identifier,
+			sim.NOTIFICATION_FAILURE,
+			codes.PermissionDenied,
+			"PLMN ID of IMSI: %s is not whitelisted", imsi)
+	}
+	ctx.Imsi = string(imsi)                  // set IMSI
+	uc := s.InitSession(ctx.SessionId, imsi) // we have Locked User Ctx after this call
+	uc.Identity = identity
+	uc.SetState(sim.StateStart)
+	p, err := createChallengeRequest(s, uc, identifier, nonceMt)
+	if success = err == nil && p.Type() == sim.TYPE && p[eap.EapSubtype] == byte(sim.SubtypeChallenge); success {
+		uc.SetState(sim.StateChallenge)
+		s.UpdateSessionUnlockCtx(uc, s.ChallengeTimeout())
+	} else {
+		s.UpdateSessionUnlockCtx(uc, s.NotificationTimeout())
+	}
+	return p, err
+}
@@ -0,0 +1,108 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+// package servicers implements EAP-SIM GRPC service
+package servicers
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"magma/feg/gateway/services/eap/providers/sim"
+	"magma/feg/gateway/services/eap/providers/sim/metrics"
+)
+
+const reauthIdRandLen = 8
+
+// NewReauthId generates a new fast re-authentication identity for the given permanent identity,
+// the generated identity retains realm of the permanent identity (see RFC 4186, section 4.2.1.4)
+func NewReauthId(identity string) (string, error) {
+	b := make([]byte, reauthIdRandLen)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	id := string(sim.ReauthIdPrefix) + hex.EncodeToString(b)
+	if atIdx := strings.Index(identity, "@"); atIdx >= 0 {
+		id += identity[atIdx:]
+	}
+	return id, nil
+}
+
+// AddReauthCtx stores fast re-authentication context for the given re-authentication identity &
+// schedules its removal after ReauthIdTimeout
+func (s *EapSimSrv) AddReauthCtx(reauthId string, rctx *ReauthCtx) {
+	if rctx == nil || len(reauthId) == 0 {
+		return
+	}
+	var oldTimer *time.Timer
+	rctx.timer = time.AfterFunc(s.ReauthIdTimeout(), func() {
+		s.removeReauthCtx(reauthId, rctx)
+	})
+	s.rwl.Lock()
+	oldCtx, exist := s.reauthCtxs[reauthId]
+	s.reauthCtxs[reauthId] = rctx
+	if exist && oldCtx != nil {
+		oldTimer, oldCtx.timer = oldCtx.timer, nil
+	}
+	s.rwl.Unlock()
+
+	if oldTimer != nil {
+		oldTimer.Stop()
+	} else {
+		metrics.ReauthIdentities.Inc()
+	}
+}
+
+// FindAndRemoveReauthCtx finds and removes fast re-authentication context of the given re-authentication
+// identity. Re-authentication identities are 'one time' identities and must not be reused (RFC 4186, section 4.3)
+func (s *EapSimSrv) FindAndRemoveReauthCtx(reauthId string) (*ReauthCtx, bool) {
+	var timer *time.Timer
+	s.rwl.Lock()
+	rctx, exist := s.reauthCtxs[reauthId]
+	if exist {
+		delete(s.reauthCtxs, reauthId)
+		if rctx != nil {
+			timer, rctx.timer = rctx.timer, nil
+		}
+	}
+	s.rwl.Unlock()
+
+	if timer != nil {
+		timer.Stop()
+	}
+	if exist {
+		metrics.ReauthIdentities.Dec()
+	}
+	return rctx, exist && rctx != nil
+}
+
+// IsReauthId returns true if the given identity is a known fast re-authentication identity
+func (s *EapSimSrv) IsReauthId(identity string) bool {
+	if len(identity) == 0 || identity[0] != sim.ReauthIdPrefix {
+		return false
+	}
+	s.rwl.RLock()
+	_, exist := s.reauthCtxs[identity]
+	s.rwl.RUnlock()
+	return exist
+}
+
+func (s *EapSimSrv) removeReauthCtx(reauthId string, myCtx *ReauthCtx) {
+	var removed bool
+	s.rwl.Lock()
+	if rctx, exist := s.reauthCtxs[reauthId]; exist && rctx == myCtx {
+		delete(s.reauthCtxs, reauthId)
+		removed = true
+	}
+	s.rwl.Unlock()
+	if removed {
+		metrics.ReauthIdentities.Dec()
+	}
+}
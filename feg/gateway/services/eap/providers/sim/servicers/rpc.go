@@ -0,0 +1,85 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+// package servicers implements EAP-SIM GRPC service
+package servicers
+
+import (
+	"io"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+
+	"magma/feg/gateway/services/eap"
+	"magma/feg/gateway/services/eap/client"
+	"magma/feg/gateway/services/eap/protos"
+	"magma/feg/gateway/services/eap/providers/sim"
+	"magma/feg/gateway/services/eap/providers/sim/metrics"
+)
+
+// Handle implements SIM handler RPC
+func (s *EapSimSrv) Handle(ctx context.Context, req *protos.Eap) (*protos.Eap, error) {
+	failure := true
+	metrics.Requests.Inc()
+	defer func() {
+		if failure {
+			metrics.FailedRequests.Inc()
+		}
+	}()
+
+	p := eap.Packet(req.GetPayload())
+	eapCtx := req.GetCtx()
+	if eapCtx == nil {
+		eapCtx = &protos.EapContext{}
+	}
+	if p == nil {
+		return sim.EapErrorRes(0, sim.NOTIFICATION_FAILURE, codes.InvalidArgument, eapCtx, "Nil Request")
+	}
+	err := p.Validate()
+	if err != nil {
+		identifier := byte(0)
+		if err != io.ErrShortBuffer {
+			identifier = p.Identifier()
+		}
+		return sim.EapErrorRes(identifier, sim.NOTIFICATION_FAILURE, codes.InvalidArgument, eapCtx, "%v", err)
+	}
+	identifier := p.Identifier()
+	method := p.Type()
+	if method == client.EapMethodIdentity {
+		failure = false
+		return &protos.Eap{Payload: sim.NewStartReq(identifier+1, s.StartIdentityReq()), Ctx: eapCtx}, nil
+	}
+	if method != sim.TYPE {
+		return sim.EapErrorRes(
+			identifier, sim.NOTIFICATION_FAILURE, codes.Unimplemented, eapCtx, "Wrong EAP Method: %d", method)
+	}
+	if len(p) < sim.MIN_PACKET_LEN {
+		return sim.EapErrorRes(
+			identifier, sim.NOTIFICATION_FAILURE, codes.InvalidArgument, eapCtx,
+			"EAP-SIM Packet is too short: %d", len(p))
+	}
+	h := GetHandler(sim.Subtype(p[eap.EapSubtype]))
+	if h == nil {
+		return sim.EapErrorRes(
+			identifier, sim.NOTIFICATION_FAILURE, codes.NotFound, eapCtx,
+			"Unsuported Subtype: %d", p[eap.EapSubtype])
+	}
+	rp, err := h(s, eapCtx, p)
+	failure = err != nil
+	return &protos.Eap{Payload: rp, Ctx: eapCtx}, err
+}
+
+// StartIdentityReq returns identity request attribute type for the initial SIM/Start request:
+// AT_ANY_ID_REQ if fast re-authentication is enabled (so the peer can present its re-authentication identity)
+// and AT_PERMANENT_ID_REQ otherwise
+func (s *EapSimSrv) StartIdentityReq() eap.AttrType {
+	if s.FastReauthEnabled() {
+		return sim.AT_ANY_ID_REQ
+	}
+	return sim.AT_PERMANENT_ID_REQ
+}
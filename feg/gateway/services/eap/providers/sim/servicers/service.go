@@ -0,0 +1,500 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+// package servicers implements EAP-SIM GRPC service
+package servicers
+
+import (
+	"log"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"magma/feg/cloud/go/protos"
+	"magma/feg/cloud/go/protos/mconfig"
+	"magma/feg/gateway/services/eap/providers/aka"
+	"magma/feg/gateway/services/eap/providers/sim"
+	"magma/feg/gateway/services/eap/providers/sim/metrics"
+)
+
+type UserCtx struct {
+	mu        sync.Mutex
+	created   time.Time
+	state     sim.SimState
+	stateTime time.Time
+	locked    bool
+	// Identity is the identity used for key derivation - permanent or fast re-authentication identity
+	Identity   string
+	Imsi       aka.IMSI
+	Profile    *protos.AuthenticationAnswer_UserProfile
+	Identifier uint8
+	// Nonce is NONCE_MT of full authentication or NONCE_S of fast re-authentication
+	Nonce,
+	// Sres is concatenation of all SRES-es of the Challenge's triplets
+	Sres,
+	MK,
+	K_encr,
+	K_aut,
+	MSK []byte
+	// Counter is the fast re-authentication counter
+	Counter uint16
+	// ReauthId is the fast re-authentication identity used for the current re-authentication (if any)
+	ReauthId string
+	// NextReauthId is the fast re-authentication identity sent to the peer in AT_NEXT_REAUTH_ID (if any)
+	NextReauthId string
+	SessionId    string
+}
+
+// ReauthCtx is a fast re-authentication context of a successfully authenticated user, see RFC 4186, section 5
+type ReauthCtx struct {
+	// Identity is the permanent identity of the user
+	Identity string
+	Imsi     aka.IMSI
+	Profile  *protos.AuthenticationAnswer_UserProfile
+	MK,
+	K_encr,
+	K_aut []byte
+	Counter uint16
+	timer   *time.Timer
+}
+
+type SessionCtx struct {
+	*UserCtx
+	CleanupTimer *time.Timer
+}
+
+type touts struct {
+	startTimeout,
+	challengeTimeout,
+	errorNotificationTimeout,
+	sessionTimeout,
+	sessionAuthenticatedTimeout,
+	reauthIdTimeout time.Duration
+}
+
+type plmnIdVal struct {
+	l5 bool
+	b6 byte
+}
+
+type EapSimSrv struct {
+	rwl sync.RWMutex // R/W lock synchronizing maps access
+	// Map of UE Sessions keyed by sessionId
+	sessions map[string]*SessionCtx
+	// Map of fast re-authentication contexts keyed by re-authentication identity
+	reauthCtxs map[string]*ReauthCtx
+
+	// PLMN IDs map, if not empty -> serve only IMSIs with specified PLMN IDs - Read Only
+	plmnIds map[string]plmnIdVal
+
+	timeouts touts
+
+	// Number of triplets used in SIM Challenge (2 or 3) - Read Only
+	numTriplets int
+	// Fast re-authentication is enabled - Read Only
+	fastReauth bool
+}
+
+var defaultTimeouts = touts{
+	startTimeout:                sim.DefaultStartTimeout,
+	challengeTimeout:            sim.DefaultChallengeTimeout,
+	errorNotificationTimeout:    sim.DefaultErrorNotificationTimeout,
+	sessionTimeout:              sim.DefaultSessionTimeout,
+	sessionAuthenticatedTimeout: sim.DefaultSessionAuthenticatedTimeout,
+	reauthIdTimeout:             sim.DefaultReauthIdTimeout,
+}
+
+func (s *EapSimSrv) StartTimeout() time.Duration {
+	return time.Duration(atomic.LoadInt64((*int64)(&s.timeouts.startTimeout)))
+}
+
+func (s *EapSimSrv) SetStartTimeout(tout time.Duration) {
+	atomic.StoreInt64((*int64)(&s.timeouts.startTimeout), int64(tout))
+}
+
+func (s *EapSimSrv) ReauthIdTimeout() time.Duration {
+	return time.Duration(atomic.LoadInt64((*int64)(&s.timeouts.reauthIdTimeout)))
+}
+
+func (s *EapSimSrv) SetReauthIdTimeout(tout time.Duration) {
+	atomic.StoreInt64((*int64)(&s.timeouts.reauthIdTimeout), int64(tout))
+}
+
+// NumTriplets returns number of triplets used in SIM Challenge
+func (s *EapSimSrv) NumTriplets() int {
+	return s.numTriplets
+}
+
+// FastReauthEnabled returns true if fast re-authentication identities are issued to peers
+func (s *EapSimSrv) FastReauthEnabled() bool {
+	return s.fastReauth
+}
+
+func (s *EapSimSrv) ChallengeTimeout() time.Duration {
+	return time.Duration(atomic.LoadInt64((*int64)(&s.timeouts.challengeTimeout)))
+}
+
+func (s *EapSimSrv) SetChallengeTimeout(tout time.Duration) {
+	atomic.StoreInt64((*int64)(&s.timeouts.challengeTimeout), int64(tout))
+}
+
+func (s *EapSimSrv) NotificationTimeout() time.Duration {
+	return time.Duration(atomic.LoadInt64((*int64)(&s.timeouts.errorNotificationTimeout)))
+}
+
+func (s *EapSimSrv) SetNotificationTimeout(tout time.Duration) {
+	atomic.StoreInt64((*int64)(&s.timeouts.errorNotificationTimeout), int64(tout))
+}
+
+func (s *EapSimSrv) SessionTimeout() time.Duration {
+	return time.Duration(atomic.LoadInt64((*int64)(&s.timeouts.sessionTimeout)))
+}
+
+func (s *EapSimSrv) SetSessionTimeout(tout time.Duration) {
+	atomic.StoreInt64((*int64)(&s.timeouts.sessionTimeout), int64(tout))
+}
+
+func (s *EapSimSrv) SessionAuthenticatedTimeout() time.Duration {
+	return time.Duration(atomic.LoadInt64((*int64)(&s.timeouts.sessionAuthenticatedTimeout)))
+}
+
+func (s *EapSimSrv) SetSessionAuthenticatedTimeout(tout time.Duration) {
+	atomic.StoreInt64((*int64)(&s.timeouts.sessionAuthenticatedTimeout), int64(tout))
+}
+
+// NewEapSimService creates new SIM Service 'object'
+// EAP-SIM uses the same timeouts & PLMN ID filters as EAP-AKA (config), simConfig provides EAP-SIM specific
+// parameters, if nil - the defaults are used
+func NewEapSimService(config *mconfig.EapAkaConfig, simConfig *SimConfig) (*EapSimSrv, error) {
+	service := &EapSimSrv{
+		sessions:    map[string]*SessionCtx{},
+		reauthCtxs:  map[string]*ReauthCtx{},
+		plmnIds:     map[string]plmnIdVal{},
+		timeouts:    defaultTimeouts,
+		numTriplets: sim.DefaultTriplets,
+		fastReauth:  true,
+	}
+	if simConfig != nil {
+		if simConfig.NumTriplets >= sim.MinTriplets && simConfig.NumTriplets <= sim.DefaultTriplets {
+			service.numTriplets = simConfig.NumTriplets
+		}
+		service.fastReauth = simConfig.FastReauth
+		if simConfig.ReauthIdTimeout > 0 {
+			service.SetReauthIdTimeout(simConfig.ReauthIdTimeout)
+		}
+	}
+	if config != nil {
+		if config.Timeout != nil {
+			if config.Timeout.ChallengeMs > 0 {
+				service.SetChallengeTimeout(time.Millisecond * time.Duration(config.Timeout.ChallengeMs))
+			}
+			if config.Timeout.ErrorNotificationMs > 0 {
+				service.SetNotificationTimeout(time.Millisecond * time.Duration(config.Timeout.ErrorNotificationMs))
+			}
+			if config.Timeout.SessionMs > 0 {
+				service.SetSessionTimeout(time.Millisecond * time.Duration(config.Timeout.SessionMs))
+			}
+			if config.Timeout.SessionAuthenticatedMs > 0 {
+				service.SetSessionAuthenticatedTimeout(
+					time.Millisecond * time.Duration(config.Timeout.SessionAuthenticatedMs))
+			}
+		}
+		for _, plmnid := range config.PlmnIds {
+			l := len(plmnid)
+			switch l {
+			case 5:
+				service.plmnIds[plmnid] = plmnIdVal{l5: true}
+			case 6:
+				plmnid5 := plmnid[:5]
+				val, _ := service.plmnIds[plmnid5]
+				val.b6 = plmnid[5]
+				service.plmnIds[plmnid5] = val
+			}
+		}
+	}
+	return service, nil
+}
+
+// CheckPlmnId returns true either if there is no PLMN ID filters (whitelist) configured or
+// one the configured PLMN IDs matches passed IMSI
+func (s *EapSimSrv) CheckPlmnId(imsi aka.IMSI) bool {
+	if len(s.plmnIds) == 0 {
+		return true
+	}
+	if val, ok := s.plmnIds[string(imsi)[:5]]; ok && (val.l5 || (len(imsi) > 5 && val.b6 == imsi[6])) {
+		return true
+	}
+	return false
+}
+
+// Unlock - unlocks the CTX
+func (lockedCtx *UserCtx) Unlock() {
+	if !lockedCtx.locked {
+		panic("Expected locked")
+	}
+	lockedCtx.locked = false
+	lockedCtx.mu.Unlock()
+}
+
+// State returns current CTX state (CTX must be locked)
+func (lockedCtx *UserCtx) State() (sim.SimState, time.Time) {
+	if !lockedCtx.locked {
+		panic("Expected locked")
+	}
+	return lockedCtx.state, lockedCtx.stateTime
+}
+
+// SetState updates current CTX state (CTX must be locked)
+func (lockedCtx *UserCtx) SetState(s sim.SimState) {
+	if !lockedCtx.locked {
+		panic("Expected locked")
+	}
+	lockedCtx.state, lockedCtx.stateTime = s, time.Now()
+}
+
+// CreatedTime returns time of CTX creation
+func (lockedCtx *UserCtx) CreatedTime() time.Time {
+	return lockedCtx.created
+}
+
+// Lifetime returns duration in seconds of the CTX existence
+func (lockedCtx *UserCtx) Lifetime() float64 {
+	return time.Since(lockedCtx.created).Seconds()
+}
+
+// InitSession either creates new or updates existing session & user ctx,
+// it session ID into the CTX and initializes session map as well as users map
+// Returns Locked User Ctx
+func (s *EapSimSrv) InitSession(sessionId string, imsi aka.IMSI) (lockedUserContext *UserCtx) {
+	var (
+		oldSessionTimer *time.Timer
+	)
+	// create new session with long session wide timeout
+	t := time.Now()
+	newSession := &SessionCtx{UserCtx: &UserCtx{
+		created: t, Imsi: imsi, state: sim.StateCreated, stateTime: t, locked: true, SessionId: sessionId}}
+
+	newSession.mu.Lock()
+
+	newSession.CleanupTimer = time.AfterFunc(s.SessionTimeout(), func() {
+		sessionTimeoutCleanup(s, sessionId, newSession)
+	})
+	uc := newSession.UserCtx
+
+	s.rwl.Lock()
+	if oldSession, ok := s.sessions[sessionId]; ok && oldSession != nil {
+		oldSessionTimer, oldSession.CleanupTimer = oldSession.CleanupTimer, nil
+	}
+	s.sessions[sessionId] = newSession
+	s.rwl.Unlock()
+
+	if oldSessionTimer != nil {
+		oldSessionTimer.Stop()
+	}
+	return uc
+}
+
+// UpdateSessionUnlockCtx sets session ID into the CTX and initializes session map & session timeout
+func (s *EapSimSrv) UpdateSessionUnlockCtx(lockedCtx *UserCtx, timeout time.Duration) {
+	if !lockedCtx.locked {
+		panic("Expected locked")
+	}
+	var (
+		oldSession, newSession *SessionCtx
+		exist                  bool
+		oldTimer               *time.Timer
+	)
+	newSession = &SessionCtx{UserCtx: lockedCtx}
+	sessionId := lockedCtx.SessionId
+	lockedCtx.Unlock()
+
+	newSession.CleanupTimer = time.AfterFunc(timeout, func() {
+		sessionTimeoutCleanup(s, sessionId, newSession)
+	})
+
+	s.rwl.Lock()
+
+	oldSession, exist = s.sessions[sessionId]
+	s.sessions[sessionId] = newSession
+	if exist && oldSession != nil {
+		oldSession.UserCtx = nil
+		if oldSession.CleanupTimer != nil {
+			oldTimer, oldSession.CleanupTimer = oldSession.CleanupTimer, nil
+		}
+	}
+	s.rwl.Unlock()
+
+	if oldTimer != nil {
+		oldTimer.Stop()
+	}
+}
+
+// UpdateSessionTimeout finds a session with specified ID, if found - cancels its current timeout
+// & schedules the new one. Returns true if the session was found
+func (s *EapSimSrv) UpdateSessionTimeout(sessionId string, timeout time.Duration) bool {
+	var (
+		newSession *SessionCtx
+		exist      bool
+		oldTimer   *time.Timer
+	)
+
+	s.rwl.Lock()
+
+	oldSession, exist := s.sessions[sessionId]
+	if exist {
+		if oldSession == nil {
+			exist = false
+		} else {
+			oldTimer, oldSession.CleanupTimer = oldSession.CleanupTimer, nil
+			newSession, oldSession.UserCtx = &SessionCtx{UserCtx: oldSession.UserCtx}, nil
+			s.sessions[sessionId] = newSession
+			newSession.CleanupTimer = time.AfterFunc(timeout, func() {
+				sessionTimeoutCleanup(s, sessionId, newSession)
+			})
+		}
+	}
+	s.rwl.Unlock()
+
+	if oldTimer != nil {
+		oldTimer.Stop()
+	}
+	return exist
+}
+
+func sessionTimeoutCleanup(s *EapSimSrv, sessionId string, mySessionCtx *SessionCtx) {
+	metrics.SessionTimeouts.Inc()
+	if s == nil {
+		log.Printf("ERROR: Nil EAP-SIM Server for session ID: %s", sessionId)
+		return
+	}
+	var (
+		imsi aka.IMSI
+		uc   *UserCtx
+	)
+
+	s.rwl.Lock()
+	sessionCtx, exist := s.sessions[sessionId]
+	if exist {
+		if sessionCtx != nil {
+			imsi = sessionCtx.Imsi
+			if sessionCtx == mySessionCtx {
+				delete(s.sessions, sessionId)
+				uc = sessionCtx.UserCtx
+			}
+		} else {
+			exist = false
+		}
+	}
+	s.rwl.Unlock()
+
+	if exist && uc != nil {
+		uc.mu.Lock()
+		state := uc.state
+		uc.mu.Unlock()
+		if state != sim.StateAuthenticated {
+			log.Printf("EAP-SIM Session %s timeout for IMSI: %s", sessionId, imsi)
+		}
+	}
+}
+
+// FindSession finds and returns IMSI of a session and a flag indication if the find succeeded
+// If found, FindSession tries to stop outstanding session timer
+func (s *EapSimSrv) FindSession(sessionId string) (aka.IMSI, *UserCtx, bool) {
+	var (
+		imsi      aka.IMSI
+		lockedCtx *UserCtx
+		timer     *time.Timer
+	)
+	s.rwl.RLock()
+	sessionCtx, exist := s.sessions[sessionId]
+	if exist && sessionCtx != nil {
+		lockedCtx, timer, sessionCtx.CleanupTimer = sessionCtx.UserCtx, sessionCtx.CleanupTimer, nil
+	}
+	s.rwl.RUnlock()
+
+	if lockedCtx != nil {
+		lockedCtx.mu.Lock()
+		lockedCtx.SessionId = sessionId // just in case - should always match
+		imsi = lockedCtx.Imsi
+		lockedCtx.locked = true
+	}
+
+	if timer != nil {
+		timer.Stop()
+	}
+	return imsi, lockedCtx, exist
+}
+
+// RemoveSession removes session ID from the session map and attempts to cancel corresponding timer
+// It also removes associated with the session user CTX if any
+// returns associated with the session IMSI or an empty string
+func (s *EapSimSrv) RemoveSession(sessionId string) aka.IMSI {
+	var (
+		timer *time.Timer
+		imsi  aka.IMSI
+	)
+	s.rwl.Lock()
+	sessionCtx, exist := s.sessions[sessionId]
+	if exist {
+		delete(s.sessions, sessionId)
+		if sessionCtx != nil {
+			imsi, timer, sessionCtx.CleanupTimer, sessionCtx.UserCtx =
+				sessionCtx.Imsi, sessionCtx.CleanupTimer, nil, nil
+		}
+	}
+	s.rwl.Unlock()
+
+	if timer != nil {
+		timer.Stop()
+	}
+	return imsi
+}
+
+// FindAndRemoveSession finds returns IMSI of a session and a flag indication if the find succeeded
+// then it deletes the session ID from the map
+func (s *EapSimSrv) FindAndRemoveSession(sessionId string) (aka.IMSI, bool) {
+	var (
+		imsi  aka.IMSI
+		timer *time.Timer
+	)
+	s.rwl.Lock()
+	sessionCtx, exist := s.sessions[sessionId]
+	if exist {
+		delete(s.sessions, sessionId)
+		if sessionCtx != nil {
+			imsi, timer, sessionCtx.CleanupTimer = sessionCtx.Imsi, sessionCtx.CleanupTimer, nil
+		}
+	}
+	s.rwl.Unlock()
+	if timer != nil {
+		timer.Stop()
+	}
+	return imsi, exist
+}
+
+// ResetSessionTimeout finds a session with specified ID, if found - attempts to cancel its current timeout
+// (best effort) & schedules the new one. ResetSessionTimeout does not guarantee that the old timeout cleanup
+// won't be executed
+func (s *EapSimSrv) ResetSessionTimeout(sessionId string, newTimeout time.Duration) {
+	var oldTimer *time.Timer
+
+	s.rwl.Lock()
+	session, exist := s.sessions[sessionId]
+	if exist {
+		if session != nil {
+			oldTimer, session.CleanupTimer = session.CleanupTimer, time.AfterFunc(newTimeout, func() {
+				sessionTimeoutCleanup(s, sessionId, session)
+			})
+		}
+	}
+	s.rwl.Unlock()
+
+	if oldTimer != nil {
+		oldTimer.Stop()
+	}
+}
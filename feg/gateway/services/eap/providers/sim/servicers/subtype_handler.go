@@ -0,0 +1,54 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+// package servicers implements EAP-SIM GRPC service
+package servicers
+
+import (
+	"log"
+	"sync"
+
+	"magma/feg/gateway/services/eap"
+	"magma/feg/gateway/services/eap/protos"
+	"magma/feg/gateway/services/eap/providers/sim"
+)
+
+// Handler - is a SIM Subtype
+type Handler func(srvr *EapSimSrv, ctx *protos.EapContext, req eap.Packet) (eap.Packet, error)
+
+var simHandlers struct {
+	rwl sync.RWMutex
+	hm  map[sim.Subtype]Handler
+}
+
+func AddHandler(st sim.Subtype, h Handler) {
+	if h == nil {
+		return
+	}
+	simHandlers.rwl.Lock()
+	if simHandlers.hm == nil {
+		simHandlers.hm = map[sim.Subtype]Handler{}
+	}
+	oldh, ok := simHandlers.hm[st]
+	if ok && oldh != nil {
+		log.Printf("WARNING: EAP SIM Handler for subtype %d => %+v is already registered, will overwrite with %+v",
+			st, oldh, h)
+	}
+	simHandlers.hm[st] = h
+	simHandlers.rwl.Unlock()
+}
+
+func GetHandler(st sim.Subtype) Handler {
+	simHandlers.rwl.RLock()
+	defer simHandlers.rwl.RUnlock()
+	res, ok := simHandlers.hm[st]
+	if ok {
+		return res
+	}
+	return nil
+}
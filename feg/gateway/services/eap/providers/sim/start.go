@@ -0,0 +1,33 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package sim
+
+import (
+	"magma/feg/gateway/services/eap"
+)
+
+// NewStartReq returns EAP-Request/SIM/Start with AT_VERSION_LIST & the given identity request attribute
+// (see https://tools.ietf.org/html/rfc4186#section-9.2)
+func NewStartReq(identifier uint8, identityReq eap.AttrType) eap.Packet {
+	return []byte{
+		eap.RequestCode,
+		identifier,
+		0, 20, // EAP Len
+		TYPE,
+		byte(SubtypeStart),
+		0, 0,
+		byte(AT_VERSION_LIST),
+		2,    // Attr Len
+		0, 2, // Actual Version List Length
+		byte(VERSION >> 8), byte(VERSION), // Supported Version
+		0, 0, // padding
+		byte(identityReq),
+		1,
+		0, 0} // reserved
+}
@@ -40,11 +40,16 @@ func (s SwxProxy) Authenticate(
 		return &protos.AuthenticationAnswer{},
 			status.Errorf(codes.PermissionDenied, "Unknown User: "+req.GetUserName())
 	}
+	// Test units are USIMs, so EAP-SIM requests are answered with EAP-AKA vectors
+	scheme := req.AuthenticationScheme
+	if scheme == protos.AuthenticationScheme_EAP_SIM {
+		scheme = protos.AuthenticationScheme_EAP_AKA
+	}
 	res := &protos.AuthenticationAnswer{
 		UserName: req.GetUserName(),
 		SipAuthVectors: []*protos.AuthenticationAnswer_SIPAuthVector{
 			&protos.AuthenticationAnswer_SIPAuthVector{
-				AuthenticationScheme: req.AuthenticationScheme,
+				AuthenticationScheme: scheme,
 				RandAutn:             v.RandAutn,
 				Xres:                 v.Xres,
 				ConfidentialityKey:   v.ConfidentialityKey,
@@ -6,13 +6,15 @@ This source code is licensed under the BSD-style license found in the
 LICENSE file in the root directory of this source tree.
 */
 
+// Package main implements Magma RADIUS (Access & Accounting) service
 package main
 
 import (
 	"flag"
 
+	"magma/feg/cloud/go/protos"
 	"magma/feg/gateway/registry"
-	"magma/feg/gateway/services/radius/metrics"
+	"magma/feg/gateway/services/radius/servicers"
 	"magma/orc8r/cloud/go/service"
 
 	"github.com/golang/glog"
@@ -29,12 +31,24 @@ func main() {
 		glog.Fatalf("Error creating RADIUS service: %s", err)
 	}
 
-	// The actual service TBD ...
+	// Start RADIUS Access & Accounting servers, EAP messages are bridged to the EAP router's
+	// providers, accounting sessions are relayed to session proxy & their subscribers
+	// are recorded in the cloud directory
+	radiusServer := servicers.NewRadiusServer(
+		servicers.GetRadiusConfig(),
+		servicers.NewEapClient(),
+		servicers.NewSessionClient(),
+		servicers.NewDirectoryClient())
+	err = radiusServer.Start()
+	if err != nil {
+		glog.Fatalf("Error starting RADIUS server: %s", err)
+	}
 
-	// Example of incrementing a metric counter
-	metrics.TotalRequests.Inc()
+	// HSS initiated SWx requests relayed to the gateway serving the user terminate
+	// its accounting sessions
+	protos.RegisterSwxGatewayServiceServer(srv.GrpcServer, radiusServer)
 
-	// Run the service - for Radius has only built in FB303 Service
+	// Run the service
 	err = srv.Run()
 	if err != nil {
 		glog.Fatalf("Error running RADIUS service: %s", err)
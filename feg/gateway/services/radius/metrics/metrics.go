@@ -13,17 +13,48 @@ import "github.com/prometheus/client_golang/prometheus"
 // Prometheus counters are monotonically increasing
 // Counters reset to zero on service restart
 var (
-	//These metrics are intended as an example
 	TotalRequests = prometheus.NewCounter(prometheus.CounterOpts{
-		Name: "requests_total",
+		Name: "radius_requests_total",
 		Help: "Total number of requests",
 	})
 	RequestFailures = prometheus.NewCounter(prometheus.CounterOpts{
-		Name: "failures_total",
+		Name: "radius_failures_total",
 		Help: "Total number of request failures",
 	})
+	DiscardedRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "radius_discarded_requests_total",
+		Help: "Total number of silently discarded malformed or unauthenticated requests",
+	})
+	AccessAccepts = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "radius_access_accepts_total",
+		Help: "Total number of Access-Accept responses",
+	})
+	AccessRejects = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "radius_access_rejects_total",
+		Help: "Total number of Access-Reject responses",
+	})
+	AccessChallenges = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "radius_access_challenges_total",
+		Help: "Total number of Access-Challenge responses",
+	})
+	AccountingRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "radius_accounting_requests_total",
+		Help: "Total number of Accounting-Requests by Acct-Status-Type",
+	}, []string{"status_type"})
+	ActiveSessions = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "radius_active_sessions",
+		Help: "Number of active accounting sessions",
+	})
 )
 
 func init() {
-	prometheus.MustRegister(TotalRequests, RequestFailures)
+	prometheus.MustRegister(
+		TotalRequests,
+		RequestFailures,
+		DiscardedRequests,
+		AccessAccepts,
+		AccessRejects,
+		AccessChallenges,
+		AccountingRequests,
+		ActiveSessions)
 }
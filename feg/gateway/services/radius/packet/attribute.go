@@ -0,0 +1,177 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package packet
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"net"
+
+	"magma/feg/gateway/services/eap"
+)
+
+// Get returns value of the first attribute of type t or nil if not found
+func (p *Packet) Get(t AttrType) []byte {
+	for _, a := range p.Attributes {
+		if a.Type == t {
+			return a.Value
+		}
+	}
+	return nil
+}
+
+// Has returns true if the packet has at least one attribute of type t
+func (p *Packet) Has(t AttrType) bool {
+	for _, a := range p.Attributes {
+		if a.Type == t {
+			return true
+		}
+	}
+	return false
+}
+
+// GetString returns string value of the first attribute of type t or an empty string if not found
+func (p *Packet) GetString(t AttrType) string {
+	return string(p.Get(t))
+}
+
+// GetUint32 returns integer value of the first attribute of type t & true or 0, false if
+// the attribute is not found or malformed
+func (p *Packet) GetUint32(t AttrType) (uint32, bool) {
+	v := p.Get(t)
+	if len(v) != 4 {
+		return 0, false
+	}
+	return binary.BigEndian.Uint32(v), true
+}
+
+// GetIP returns IPv4 address value of the first attribute of type t or nil if not found or malformed
+func (p *Packet) GetIP(t AttrType) net.IP {
+	v := p.Get(t)
+	if len(v) != net.IPv4len {
+		return nil
+	}
+	return net.IPv4(v[0], v[1], v[2], v[3])
+}
+
+// Add appends a new attribute to the packet
+func (p *Packet) Add(t AttrType, value []byte) {
+	p.Attributes = append(p.Attributes, Attribute{Type: t, Value: value})
+}
+
+// AddString appends a new string attribute to the packet
+func (p *Packet) AddString(t AttrType, value string) {
+	p.Add(t, []byte(value))
+}
+
+// AddUint32 appends a new integer attribute to the packet
+func (p *Packet) AddUint32(t AttrType, value uint32) {
+	v := make([]byte, 4)
+	binary.BigEndian.PutUint32(v, value)
+	p.Add(t, v)
+}
+
+// Del removes all attributes of type t from the packet
+func (p *Packet) Del(t AttrType) {
+	attrs := p.Attributes[:0]
+	for _, a := range p.Attributes {
+		if a.Type != t {
+			attrs = append(attrs, a)
+		}
+	}
+	p.Attributes = attrs
+}
+
+// EapMessage returns concatenated value of all EAP-Message attributes (RFC 3579, 3.1) or nil if none is present
+func (p *Packet) EapMessage() eap.Packet {
+	var res []byte
+	for _, a := range p.Attributes {
+		if a.Type == EAPMessage {
+			res = append(res, a.Value...)
+		}
+	}
+	return res
+}
+
+// AddEapMessage splits EAP packet into as many EAP-Message attributes as needed & appends them to the packet
+func (p *Packet) AddEapMessage(msg eap.Packet) {
+	for len(msg) > MaxAttrValueLen {
+		p.Add(EAPMessage, msg[:MaxAttrValueLen])
+		msg = msg[MaxAttrValueLen:]
+	}
+	p.Add(EAPMessage, msg)
+}
+
+// AddMessageAuthenticator appends zeroed Message-Authenticator attribute to the packet,
+// the attribute's value will be calculated by EncodeRequest/EncodeResponse
+func (p *Packet) AddMessageAuthenticator() {
+	p.Del(MessageAuthenticator)
+	p.Add(MessageAuthenticator, make([]byte, MessageAuthenticatorLen))
+}
+
+// AddVendorSpecific appends RFC 2865, 5.26 Vendor-Specific attribute with a single vendor sub-attribute
+func (p *Packet) AddVendorSpecific(vendorId uint32, vendorType uint8, value []byte) error {
+	if len(value)+VendorSpecificHdrLen > MaxAttrValueLen {
+		return fmt.Errorf("Vendor %d attribute %d is too long: %d", vendorId, vendorType, len(value))
+	}
+	v := make([]byte, VendorSpecificHdrLen, VendorSpecificHdrLen+len(value))
+	binary.BigEndian.PutUint32(v, vendorId)
+	v[4], v[5] = vendorType, uint8(len(value)+AttrHeaderLen)
+	p.Add(VendorSpecific, append(v, value...))
+	return nil
+}
+
+// GetVendorSpecific returns value of the first vendor sub-attribute of the given vendor & type or nil if not found
+func (p *Packet) GetVendorSpecific(vendorId uint32, vendorType uint8) []byte {
+	for _, a := range p.Attributes {
+		if a.Type != VendorSpecific || len(a.Value) < VendorSpecificHdrLen ||
+			binary.BigEndian.Uint32(a.Value) != vendorId {
+			continue
+		}
+		for sub := a.Value[4:]; len(sub) >= AttrHeaderLen; {
+			sl := int(sub[1])
+			if sl < AttrHeaderLen || sl > len(sub) {
+				break
+			}
+			if sub[0] == vendorType {
+				return sub[AttrHeaderLen:sl]
+			}
+			sub = sub[sl:]
+		}
+	}
+	return nil
+}
+
+// AddMSMPPEKeys appends RFC 2548 MS-MPPE-Recv-Key & MS-MPPE-Send-Key attributes derived from EAP MSK
+// (RFC 3748, 7.10: first 32 bytes of MSK - Recv Key, next 32 bytes - Send Key).
+// p.Authenticator must be set to the corresponding Access-Request's Authenticator
+func (p *Packet) AddMSMPPEKeys(msk, secret []byte) error {
+	if len(msk) < 2*MSMPPEKeyLen {
+		return fmt.Errorf("MSK is too short: %d, must be at least %d bytes", len(msk), 2*MSMPPEKeyLen)
+	}
+	for _, k := range []struct {
+		vendorType uint8
+		key        []byte
+	}{
+		{VendorMSMPPERecvKey, msk[:MSMPPEKeyLen]},
+		{VendorMSMPPESendKey, msk[MSMPPEKeyLen : 2*MSMPPEKeyLen]},
+	} {
+		salt := make([]byte, MSMPPESaltLen)
+		if _, err := rand.Read(salt); err != nil {
+			return err
+		}
+		salt[0] |= 0x80 // RFC 2548, 2.4.2: the most significant bit of the Salt field MUST be set
+		value := append(salt, eap.EncodeMsMppeKey(salt, k.key, p.Authenticator[:], secret)...)
+		if err := p.AddVendorSpecific(VendorMicrosoft, k.vendorType, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+// Package packet implements RADIUS (RFC 2865, RFC 2866 & RFC 3579) packet encoding & decoding
+package packet
+
+// Code is RADIUS packet type code
+type Code uint8
+
+const (
+	// RADIUS Packet Codes
+	AccessRequest      Code = 1
+	AccessAccept       Code = 2
+	AccessReject       Code = 3
+	AccountingRequest  Code = 4
+	AccountingResponse Code = 5
+	AccessChallenge    Code = 11
+)
+
+// AttrType is RADIUS attribute type
+type AttrType uint8
+
+const (
+	// RADIUS Attribute Types
+	UserName             AttrType = 1
+	UserPassword         AttrType = 2
+	NASIPAddress         AttrType = 4
+	NASPort              AttrType = 5
+	ServiceType          AttrType = 6
+	FramedIPAddress      AttrType = 8
+	ReplyMessage         AttrType = 18
+	State                AttrType = 24
+	Class                AttrType = 25
+	VendorSpecific       AttrType = 26
+	SessionTimeout       AttrType = 27
+	CalledStationId      AttrType = 30
+	CallingStationId     AttrType = 31
+	NASIdentifier        AttrType = 32
+	AcctStatusType       AttrType = 40
+	AcctInputOctets      AttrType = 42
+	AcctOutputOctets     AttrType = 43
+	AcctSessionId        AttrType = 44
+	AcctSessionTime      AttrType = 46
+	AcctInputPackets     AttrType = 47
+	AcctOutputPackets    AttrType = 48
+	AcctTerminateCause   AttrType = 49
+	AcctInputGigawords   AttrType = 52
+	AcctOutputGigawords  AttrType = 53
+	EventTimestamp       AttrType = 55
+	NASPortType          AttrType = 61
+	EAPMessage           AttrType = 79
+	MessageAuthenticator AttrType = 80
+)
+
+const (
+	// Acct-Status-Type Values (RFC 2866, 5.1)
+	AcctStatusStart         uint32 = 1
+	AcctStatusStop          uint32 = 2
+	AcctStatusInterimUpdate uint32 = 3
+	AcctStatusAccountingOn  uint32 = 7
+	AcctStatusAccountingOff uint32 = 8
+)
+
+const (
+	// Microsoft Vendor Specific Attributes (RFC 2548)
+	VendorMicrosoft      uint32 = 311
+	VendorMSMPPESendKey  uint8  = 16
+	VendorMSMPPERecvKey  uint8  = 17
+	MSMPPEKeyLen                = 32
+	MSMPPESaltLen               = 2
+	VendorSpecificHdrLen        = 6
+)
+
+const (
+	// Packet Offsets & Lengths
+	PacketCode int = iota
+	PacketIdentifier
+	PacketLenHigh
+	PacketLenLow
+	PacketAuthenticator
+
+	AuthenticatorLen        = 16
+	HeaderLen               = PacketAuthenticator + AuthenticatorLen
+	AttrHeaderLen           = 2
+	MaxAttrValueLen         = 253
+	MaxPacketLen            = 4096
+	MessageAuthenticatorLen = 16
+)
@@ -0,0 +1,212 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+// Package packet implements RADIUS (RFC 2865, RFC 2866 & RFC 3579) packet encoding & decoding
+package packet
+
+import (
+	"crypto/hmac"
+	"crypto/md5"
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// Attribute represents a single RADIUS attribute
+type Attribute struct {
+	Type  AttrType
+	Value []byte
+}
+
+// Packet represents decoded RADIUS Packet
+type Packet struct {
+	Code          Code
+	Identifier    uint8
+	Authenticator [AuthenticatorLen]byte
+	Attributes    []Attribute
+}
+
+// Parse decodes RADIUS packet from the given datagram
+func Parse(b []byte) (*Packet, error) {
+	l := len(b)
+	if l < HeaderLen {
+		return nil, io.ErrShortBuffer
+	}
+	pl := int(binary.BigEndian.Uint16(b[PacketLenHigh:]))
+	if pl < HeaderLen || pl > MaxPacketLen {
+		return nil, fmt.Errorf("Invalid RADIUS packet length: %d", pl)
+	}
+	if l < pl {
+		return nil, fmt.Errorf("Invalid RADIUS packet: bytes received %d are below specified length %d", l, pl)
+	}
+	p := &Packet{Code: Code(b[PacketCode]), Identifier: b[PacketIdentifier]}
+	copy(p.Authenticator[:], b[PacketAuthenticator:HeaderLen])
+	for attrs := b[HeaderLen:pl]; len(attrs) > 0; {
+		if len(attrs) < AttrHeaderLen {
+			return nil, fmt.Errorf("Truncated RADIUS attribute header")
+		}
+		al := int(attrs[1])
+		if al < AttrHeaderLen || al > len(attrs) {
+			return nil, fmt.Errorf("Invalid length %d of RADIUS attribute %d", al, attrs[0])
+		}
+		val := make([]byte, al-AttrHeaderLen)
+		copy(val, attrs[AttrHeaderLen:al])
+		p.Attributes = append(p.Attributes, Attribute{Type: AttrType(attrs[0]), Value: val})
+		attrs = attrs[al:]
+	}
+	return p, nil
+}
+
+// NewResponse creates a new response packet for the request with the same Identifier & Authenticator
+// The Authenticator will be replaced by the Response Authenticator when the response is encoded
+func NewResponse(req *Packet, code Code) *Packet {
+	return &Packet{Code: code, Identifier: req.Identifier, Authenticator: req.Authenticator}
+}
+
+// Encode returns wire representation of the packet with current Authenticator value
+func (p *Packet) Encode() ([]byte, error) {
+	l := HeaderLen
+	for _, a := range p.Attributes {
+		if len(a.Value) > MaxAttrValueLen {
+			return nil, fmt.Errorf("RADIUS attribute %d is too long: %d", a.Type, len(a.Value))
+		}
+		l += AttrHeaderLen + len(a.Value)
+	}
+	if l > MaxPacketLen {
+		return nil, fmt.Errorf("RADIUS packet is too long: %d", l)
+	}
+	b := make([]byte, HeaderLen, l)
+	b[PacketCode], b[PacketIdentifier] = byte(p.Code), p.Identifier
+	binary.BigEndian.PutUint16(b[PacketLenHigh:], uint16(l))
+	copy(b[PacketAuthenticator:], p.Authenticator[:])
+	for _, a := range p.Attributes {
+		b = append(b, byte(a.Type), byte(len(a.Value)+AttrHeaderLen))
+		b = append(b, a.Value...)
+	}
+	return b, nil
+}
+
+// EncodeResponse encodes a response packet signing it with the shared secret: it fills in
+// Message-Authenticator (if the attribute is present) & sets the Response Authenticator,
+// see RFC 2865, 3 & RFC 3579, 3.2
+// p.Authenticator must be set to the corresponding request's Authenticator (see NewResponse)
+func (p *Packet) EncodeResponse(secret []byte) ([]byte, error) {
+	b, err := p.Encode()
+	if err != nil {
+		return nil, err
+	}
+	signMessageAuthenticator(b, secret)
+	hash := md5.New()
+	hash.Write(b)
+	hash.Write(secret)
+	copy(b[PacketAuthenticator:HeaderLen], hash.Sum(nil))
+	return b, nil
+}
+
+// EncodeRequest encodes an Access or Accounting request & signs it with the shared secret.
+// Access-Request gets a random Request Authenticator (unless already set) & Message-Authenticator if present,
+// Accounting-Request gets RFC 2866, 3 Request Authenticator
+func (p *Packet) EncodeRequest(secret []byte) ([]byte, error) {
+	if p.Code == AccountingRequest {
+		p.Authenticator = [AuthenticatorLen]byte{}
+		b, err := p.Encode()
+		if err != nil {
+			return nil, err
+		}
+		hash := md5.New()
+		hash.Write(b)
+		hash.Write(secret)
+		copy(b[PacketAuthenticator:HeaderLen], hash.Sum(nil))
+		copy(p.Authenticator[:], b[PacketAuthenticator:HeaderLen])
+		return b, nil
+	}
+	if p.Authenticator == [AuthenticatorLen]byte{} {
+		if _, err := rand.Read(p.Authenticator[:]); err != nil {
+			return nil, err
+		}
+	}
+	b, err := p.Encode()
+	if err != nil {
+		return nil, err
+	}
+	signMessageAuthenticator(b, secret)
+	return b, nil
+}
+
+// VerifyAccountingRequest verifies RFC 2866, 3 Request Authenticator of encoded Accounting-Request
+func VerifyAccountingRequest(b []byte, secret []byte) bool {
+	if len(b) < HeaderLen {
+		return false
+	}
+	pl := int(binary.BigEndian.Uint16(b[PacketLenHigh:]))
+	if pl < HeaderLen || pl > len(b) {
+		return false
+	}
+	hash := md5.New()
+	hash.Write(b[:PacketAuthenticator])
+	hash.Write(make([]byte, AuthenticatorLen))
+	hash.Write(b[HeaderLen:pl])
+	hash.Write(secret)
+	return hmac.Equal(hash.Sum(nil), b[PacketAuthenticator:HeaderLen])
+}
+
+// VerifyMessageAuthenticator verifies RFC 3579, 3.2 Message-Authenticator of encoded request packet,
+// returns false if the packet has no Message-Authenticator attribute
+func VerifyMessageAuthenticator(b []byte, secret []byte) bool {
+	offset := messageAuthenticatorOffset(b)
+	if offset <= 0 {
+		return false
+	}
+	pl := int(binary.BigEndian.Uint16(b[PacketLenHigh:]))
+	received := make([]byte, MessageAuthenticatorLen)
+	copy(received, b[offset:offset+MessageAuthenticatorLen])
+
+	zeroed := make([]byte, pl)
+	copy(zeroed, b[:pl])
+	copy(zeroed[offset:offset+MessageAuthenticatorLen], make([]byte, MessageAuthenticatorLen))
+	mac := hmac.New(md5.New, secret)
+	mac.Write(zeroed)
+	return hmac.Equal(mac.Sum(nil), received)
+}
+
+// signMessageAuthenticator calculates & sets Message-Authenticator value of the encoded packet if
+// the packet has Message-Authenticator attribute
+func signMessageAuthenticator(b []byte, secret []byte) {
+	offset := messageAuthenticatorOffset(b)
+	if offset <= 0 {
+		return
+	}
+	copy(b[offset:offset+MessageAuthenticatorLen], make([]byte, MessageAuthenticatorLen))
+	mac := hmac.New(md5.New, secret)
+	mac.Write(b)
+	copy(b[offset:], mac.Sum(nil))
+}
+
+// messageAuthenticatorOffset returns the offset of Message-Authenticator value in the encoded packet
+// or -1 if not found
+func messageAuthenticatorOffset(b []byte) int {
+	if len(b) < HeaderLen {
+		return -1
+	}
+	pl := int(binary.BigEndian.Uint16(b[PacketLenHigh:]))
+	if pl > len(b) {
+		return -1
+	}
+	for i := HeaderLen; i+AttrHeaderLen <= pl; {
+		al := int(b[i+1])
+		if al < AttrHeaderLen || i+al > pl {
+			return -1
+		}
+		if AttrType(b[i]) == MessageAuthenticator && al == AttrHeaderLen+MessageAuthenticatorLen {
+			return i + AttrHeaderLen
+		}
+		i += al
+	}
+	return -1
+}
@@ -0,0 +1,106 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package packet_test
+
+import (
+	"bytes"
+	"testing"
+
+	"magma/feg/gateway/services/eap"
+	"magma/feg/gateway/services/radius/packet"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var secret = []byte("1qaz2wsx")
+
+func TestPacketEncodeParse(t *testing.T) {
+	req := &packet.Packet{Code: packet.AccessRequest, Identifier: 7}
+	req.AddString(packet.UserName, "0001010000000001@wlan.mnc001.mcc001.3gppnetwork.org")
+	req.AddUint32(packet.NASPort, 12)
+	longEap := eap.NewPacket(eap.ResponseCode, 3, bytes.Repeat([]byte{0x17}, 600))
+	req.AddEapMessage(longEap)
+	req.AddMessageAuthenticator()
+
+	b, err := req.EncodeRequest(secret)
+	assert.NoError(t, err)
+	assert.True(t, packet.VerifyMessageAuthenticator(b, secret))
+	assert.False(t, packet.VerifyMessageAuthenticator(b, []byte("wrong secret")))
+
+	parsed, err := packet.Parse(b)
+	assert.NoError(t, err)
+	assert.Equal(t, packet.AccessRequest, parsed.Code)
+	assert.Equal(t, uint8(7), parsed.Identifier)
+	assert.Equal(t, req.Authenticator, parsed.Authenticator)
+	assert.Equal(t, "0001010000000001@wlan.mnc001.mcc001.3gppnetwork.org", parsed.GetString(packet.UserName))
+	port, ok := parsed.GetUint32(packet.NASPort)
+	assert.True(t, ok)
+	assert.Equal(t, uint32(12), port)
+	assert.Equal(t, []byte(longEap), []byte(parsed.EapMessage()))
+	assert.Nil(t, parsed.Get(packet.State))
+
+	// Corrupted packet must fail Message-Authenticator check
+	b[len(b)-20] ^= 0xFF
+	assert.False(t, packet.VerifyMessageAuthenticator(b, secret))
+
+	_, err = packet.Parse(b[:packet.HeaderLen-1])
+	assert.Error(t, err)
+	_, err = packet.Parse(b[:len(b)-1])
+	assert.Error(t, err)
+}
+
+func TestAccountingRequestAuthenticator(t *testing.T) {
+	req := &packet.Packet{Code: packet.AccountingRequest, Identifier: 1}
+	req.AddUint32(packet.AcctStatusType, packet.AcctStatusStart)
+	req.AddString(packet.AcctSessionId, "5A3B-1")
+	b, err := req.EncodeRequest(secret)
+	assert.NoError(t, err)
+	assert.True(t, packet.VerifyAccountingRequest(b, secret))
+	assert.False(t, packet.VerifyAccountingRequest(b, []byte("wrong secret")))
+}
+
+func TestResponseAuthenticator(t *testing.T) {
+	req := &packet.Packet{Code: packet.AccessRequest, Identifier: 42}
+	req.AddEapMessage(eap.NewPacket(eap.ResponseCode, 1, []byte{1, 'a'}))
+	req.AddMessageAuthenticator()
+	_, err := req.EncodeRequest(secret)
+	assert.NoError(t, err)
+
+	resp := packet.NewResponse(req, packet.AccessAccept)
+	resp.AddEapMessage(eap.NewPacket(eap.SuccessCode, 1, nil))
+	msk := bytes.Repeat([]byte{0xAB}, 64)
+	assert.NoError(t, resp.AddMSMPPEKeys(msk, secret))
+	resp.AddMessageAuthenticator()
+	b, err := resp.EncodeResponse(secret)
+	assert.NoError(t, err)
+
+	// Response Authenticator = MD5(Code+ID+Length+RequestAuth+Attributes+Secret)
+	check := make([]byte, len(b))
+	copy(check, b)
+	copy(check[packet.PacketAuthenticator:packet.HeaderLen], req.Authenticator[:])
+	verify, err := packet.Parse(check)
+	assert.NoError(t, err)
+	reencoded, err := verify.EncodeResponse(secret)
+	assert.NoError(t, err)
+	assert.Equal(t, b, reencoded)
+
+	parsed, err := packet.Parse(b)
+	assert.NoError(t, err)
+	recvKey := parsed.GetVendorSpecific(packet.VendorMicrosoft, packet.VendorMSMPPERecvKey)
+	sendKey := parsed.GetVendorSpecific(packet.VendorMicrosoft, packet.VendorMSMPPESendKey)
+	assert.Len(t, recvKey, packet.MSMPPESaltLen+48)
+	assert.Len(t, sendKey, packet.MSMPPESaltLen+48)
+	assert.NotZero(t, recvKey[0]&0x80)
+	assert.Equal(t,
+		eap.EncodeMsMppeKey(recvKey[:2], msk[:32], req.Authenticator[:], secret), recvKey[packet.MSMPPESaltLen:])
+	assert.Equal(t,
+		eap.EncodeMsMppeKey(sendKey[:2], msk[32:], req.Authenticator[:], secret), sendKey[packet.MSMPPESaltLen:])
+
+	assert.Error(t, resp.AddMSMPPEKeys(msk[:10], secret))
+}
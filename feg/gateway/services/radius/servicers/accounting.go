@@ -0,0 +1,417 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package servicers
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+
+	"magma/feg/gateway/services/radius/metrics"
+	"magma/feg/gateway/services/radius/packet"
+	"magma/lte/cloud/go/protos"
+
+	"github.com/golang/glog"
+	"golang.org/x/net/context"
+)
+
+// acctSession is an accounting session mapped onto Gx/Gy session of session proxy
+type acctSession struct {
+	// mu is held while the session is created, so that the requests for the session received
+	// in the meantime wait for its creation
+	mu sync.Mutex
+	// created is set once session proxy created the session
+	created       bool
+	sid           string
+	sessionId     string
+	nasId         string
+	apn           string
+	ueIpv4        string
+	msisdn        []byte
+	requestNumber uint32
+	// chargingKeys are rating groups granted by OCS, RADIUS reports session wide usage only,
+	// so all of it is reported against the first granted charging key
+	chargingKeys []uint32
+	// monitoringKeys are session level usage monitors installed by PCRF
+	monitoringKeys []string
+	// bytesTx & bytesRx are the totals already reported to session proxy
+	bytesTx, bytesRx uint64
+}
+
+// HandleAccountingRequest maps Accounting-Request Start, Interim-Update & Stop onto session proxy's
+// create, update & terminate session calls and returns Accounting-Response or nil if the request
+// could not be recorded & must not be acknowledged (RFC 2866, 2)
+func (s *RadiusServer) HandleAccountingRequest(from string, req *packet.Packet) *packet.Packet {
+	status, ok := req.GetUint32(packet.AcctStatusType)
+	if !ok {
+		metrics.DiscardedRequests.Inc()
+		glog.Errorf("Discarding Accounting-Request %d from %s: missing Acct-Status-Type", req.Identifier, from)
+		return nil
+	}
+	metrics.AccountingRequests.WithLabelValues(strconv.FormatUint(uint64(status), 10)).Inc()
+
+	var err error
+	switch status {
+	case packet.AcctStatusAccountingOn, packet.AcctStatusAccountingOff:
+		// The NAS (re)started or is about to shut down, all its sessions are gone
+		s.terminateNasSessions(nasIdentifier(from, req))
+	case packet.AcctStatusStart, packet.AcctStatusInterimUpdate, packet.AcctStatusStop:
+		acctSessionId := req.GetString(packet.AcctSessionId)
+		if len(acctSessionId) == 0 {
+			metrics.DiscardedRequests.Inc()
+			glog.Errorf("Discarding Accounting-Request %d from %s: missing Acct-Session-Id", req.Identifier, from)
+			return nil
+		}
+		imsi := imsiFromRequest(req)
+		if len(imsi) == 0 {
+			metrics.DiscardedRequests.Inc()
+			glog.Errorf("Discarding Accounting-Request %d from %s: unknown subscriber IMSI for session %s",
+				req.Identifier, from, acctSessionId)
+			return nil
+		}
+		sessionId := fmt.Sprintf("%s%s-%s", ClassImsiPrefix, imsi, acctSessionId)
+		switch status {
+		case packet.AcctStatusStart:
+			_, err = s.startSession(from, sessionId, imsi, req)
+		case packet.AcctStatusInterimUpdate:
+			err = s.updateSession(from, sessionId, imsi, req)
+		default:
+			err = s.stopSession(sessionId, req)
+		}
+	default:
+		glog.Warningf("Unsupported Acct-Status-Type %d in Accounting-Request %d from %s",
+			status, req.Identifier, from)
+	}
+	if err != nil {
+		metrics.RequestFailures.Inc()
+		glog.Errorf("Failed to process Accounting-Request %d from %s: %v", req.Identifier, from, err)
+		return nil
+	}
+	return packet.NewResponse(req, packet.AccountingResponse)
+}
+
+// startSession creates a new Gx/Gy session for the accounting session, if the session already exists
+// startSession returns the existing session once it's created
+func (s *RadiusServer) startSession(from, sessionId, imsi string, req *packet.Packet) (*acctSession, error) {
+	session := &acctSession{
+		sid:           ClassImsiPrefix + imsi,
+		sessionId:     sessionId,
+		nasId:         nasIdentifier(from, req),
+		apn:           req.GetString(packet.CalledStationId),
+		msisdn:        msisdnFromRequest(req),
+		requestNumber: 1,
+	}
+	// Register the session before creating it, so that concurrent or retransmitted requests
+	// for the session don't create it again
+	session.mu.Lock()
+	s.acctMu.Lock()
+	if existing, ok := s.acctSessions[sessionId]; ok {
+		s.acctMu.Unlock()
+		session.mu.Unlock()
+		existing.mu.Lock()
+		created := existing.created
+		existing.mu.Unlock()
+		if !created {
+			return nil, fmt.Errorf("Failed to create session %s", sessionId)
+		}
+		return existing, nil
+	}
+	s.acctSessions[sessionId] = session
+	metrics.ActiveSessions.Set(float64(len(s.acctSessions)))
+	s.acctMu.Unlock()
+	defer session.mu.Unlock()
+
+	if ip := req.GetIP(packet.FramedIPAddress); ip != nil {
+		session.ueIpv4 = ip.String()
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), s.cfg.RequestTimeout)
+	defer cancel()
+	resp, err := s.sessionClient.CreateSession(ctx, &protos.CreateSessionRequest{
+		Subscriber: &protos.SubscriberID{Id: session.sid, Type: protos.SubscriberID_IMSI},
+		SessionId:  sessionId,
+		UeIpv4:     session.ueIpv4,
+		Apn:        session.apn,
+		Msisdn:     session.msisdn,
+	})
+	if err != nil {
+		s.acctMu.Lock()
+		if s.acctSessions[sessionId] == session {
+			delete(s.acctSessions, sessionId)
+			metrics.ActiveSessions.Set(float64(len(s.acctSessions)))
+		}
+		s.acctMu.Unlock()
+		return nil, fmt.Errorf("CreateSession error for session %s: %v", sessionId, err)
+	}
+	for _, credit := range resp.GetCredits() {
+		if credit.GetSuccess() {
+			session.chargingKeys = append(session.chargingKeys, credit.GetChargingKey())
+		}
+	}
+	for _, monitor := range resp.GetUsageMonitors() {
+		credit := monitor.GetCredit()
+		if monitor.GetSuccess() && credit != nil && credit.GetLevel() == protos.MonitoringLevel_SESSION_LEVEL {
+			session.monitoringKeys = append(session.monitoringKeys, credit.GetMonitoringKey())
+		}
+	}
+	session.created = true
+	go s.updateLocation(imsi)
+	return session, nil
+}
+
+// updateLocation records this gateway as the one serving the IMSI, so HSS initiated
+// SWx requests for the subscriber are relayed to this RADIUS server
+func (s *RadiusServer) updateLocation(imsi string) {
+	ctx, cancel := context.WithTimeout(context.Background(), s.cfg.RequestTimeout)
+	defer cancel()
+	if err := s.directoryClient.UpdateLocation(ctx, imsi); err != nil {
+		glog.Errorf("Failed to update directory location of %s: %v", imsi, err)
+	}
+}
+
+// updateSession reports usage since the last report, if the session is not found (Accounting-Start was lost
+// or the server restarted) it creates the session first
+func (s *RadiusServer) updateSession(from, sessionId, imsi string, req *packet.Packet) error {
+	session, err := s.startSession(from, sessionId, imsi, req)
+	if err != nil {
+		return err
+	}
+	session.mu.Lock()
+	defer session.mu.Unlock()
+
+	bytesTx, bytesRx := usageFromRequest(req)
+	deltaTx, deltaRx := session.usageDelta(bytesTx, bytesRx)
+	if len(session.chargingKeys) == 0 && len(session.monitoringKeys) == 0 {
+		session.bytesTx, session.bytesRx = bytesTx, bytesRx
+		return nil
+	}
+	update := &protos.UpdateSessionRequest{}
+	if len(session.chargingKeys) > 0 {
+		update.Updates = []*protos.CreditUsageUpdate{{
+			Usage: &protos.CreditUsage{
+				BytesTx:     deltaTx,
+				BytesRx:     deltaRx,
+				ChargingKey: session.chargingKeys[0],
+				Type:        protos.CreditUsage_THRESHOLD,
+			},
+			SessionId:     session.sessionId,
+			RequestNumber: session.requestNumber,
+			Sid:           session.sid,
+			Msisdn:        session.msisdn,
+			UeIpv4:        session.ueIpv4,
+			Apn:           session.apn,
+		}}
+	}
+	for _, key := range session.monitoringKeys {
+		update.UsageMonitors = append(update.UsageMonitors, &protos.UsageMonitoringUpdateRequest{
+			Update: &protos.UsageMonitorUpdate{
+				MonitoringKey: key,
+				Level:         protos.MonitoringLevel_SESSION_LEVEL,
+				BytesTx:       deltaTx,
+				BytesRx:       deltaRx,
+			},
+			SessionId:     session.sessionId,
+			RequestNumber: session.requestNumber,
+			Sid:           session.sid,
+			UeIpv4:        session.ueIpv4,
+		})
+	}
+	session.requestNumber++
+
+	ctx, cancel := context.WithTimeout(context.Background(), s.cfg.RequestTimeout)
+	defer cancel()
+	resp, err := s.sessionClient.UpdateSession(ctx, update)
+	if err != nil {
+		return fmt.Errorf("UpdateSession error for session %s: %v", sessionId, err)
+	}
+	for _, credit := range resp.GetResponses() {
+		if !credit.GetSuccess() {
+			glog.Warningf("Credit update failed for session %s, charging key %d",
+				sessionId, credit.GetChargingKey())
+		}
+	}
+	session.bytesTx, session.bytesRx = bytesTx, bytesRx
+	return nil
+}
+
+// stopSession reports the final usage & terminates Gx/Gy session
+func (s *RadiusServer) stopSession(sessionId string, req *packet.Packet) error {
+	session := s.removeSession(sessionId)
+	if session == nil {
+		glog.Warningf("Accounting-Stop for unknown session %s", sessionId)
+		return nil
+	}
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	if !session.created {
+		return nil
+	}
+	bytesTx, bytesRx := usageFromRequest(req)
+	return s.terminate(session, bytesTx, bytesRx)
+}
+
+// terminateNasSessions terminates all sessions of the given NAS
+func (s *RadiusServer) terminateNasSessions(nasId string) {
+	terminated := s.terminateSessions(func(session *acctSession) bool { return session.nasId == nasId })
+	if terminated > 0 {
+		glog.Infof("Terminated %d sessions of NAS %s", terminated, nasId)
+	}
+}
+
+// terminateSubscriberSessions terminates all sessions of the given IMSI
+func (s *RadiusServer) terminateSubscriberSessions(imsi string) int {
+	sid := ClassImsiPrefix + strings.TrimPrefix(imsi, ClassImsiPrefix)
+	return s.terminateSessions(func(session *acctSession) bool { return session.sid == sid })
+}
+
+// terminateSessions removes & terminates all sessions matching the filter and
+// returns their number
+func (s *RadiusServer) terminateSessions(filter func(session *acctSession) bool) int {
+	var sessions []*acctSession
+	s.acctMu.Lock()
+	for id, session := range s.acctSessions {
+		if filter(session) {
+			sessions = append(sessions, session)
+			delete(s.acctSessions, id)
+		}
+	}
+	metrics.ActiveSessions.Set(float64(len(s.acctSessions)))
+	s.acctMu.Unlock()
+
+	for _, session := range sessions {
+		session.mu.Lock()
+		if session.created {
+			if err := s.terminate(session, session.bytesTx, session.bytesRx); err != nil {
+				glog.Error(err)
+			}
+		}
+		session.mu.Unlock()
+	}
+	return len(sessions)
+}
+
+// terminate sends session termination with the final usage totals, the session must be locked
+func (s *RadiusServer) terminate(session *acctSession, bytesTx, bytesRx uint64) error {
+	deltaTx, deltaRx := session.usageDelta(bytesTx, bytesRx)
+	terminate := &protos.SessionTerminateRequest{
+		Sid:           session.sid,
+		SessionId:     session.sessionId,
+		Apn:           session.apn,
+		RequestNumber: session.requestNumber,
+		UeIpv4:        session.ueIpv4,
+		Msisdn:        session.msisdn,
+	}
+	if len(session.chargingKeys) > 0 {
+		terminate.CreditUsages = []*protos.CreditUsage{{
+			BytesTx:     deltaTx,
+			BytesRx:     deltaRx,
+			ChargingKey: session.chargingKeys[0],
+			Type:        protos.CreditUsage_TERMINATED,
+		}}
+	}
+	for _, key := range session.monitoringKeys {
+		terminate.MonitorUsages = append(terminate.MonitorUsages, &protos.UsageMonitorUpdate{
+			MonitoringKey: key,
+			Level:         protos.MonitoringLevel_SESSION_LEVEL,
+			BytesTx:       deltaTx,
+			BytesRx:       deltaRx,
+		})
+	}
+	session.requestNumber++
+
+	ctx, cancel := context.WithTimeout(context.Background(), s.cfg.RequestTimeout)
+	defer cancel()
+	_, err := s.sessionClient.TerminateSession(ctx, terminate)
+	if err != nil {
+		return fmt.Errorf("TerminateSession error for session %s: %v", session.sessionId, err)
+	}
+	return nil
+}
+
+func (s *RadiusServer) removeSession(sessionId string) *acctSession {
+	s.acctMu.Lock()
+	defer s.acctMu.Unlock()
+	session, ok := s.acctSessions[sessionId]
+	if ok {
+		delete(s.acctSessions, sessionId)
+		metrics.ActiveSessions.Set(float64(len(s.acctSessions)))
+	}
+	return session
+}
+
+// usageDelta returns usage since the last report, NAS counters may be reset, in which case
+// the new totals are reported as is
+func (session *acctSession) usageDelta(bytesTx, bytesRx uint64) (uint64, uint64) {
+	deltaTx, deltaRx := bytesTx, bytesRx
+	if bytesTx >= session.bytesTx {
+		deltaTx = bytesTx - session.bytesTx
+	}
+	if bytesRx >= session.bytesRx {
+		deltaRx = bytesRx - session.bytesRx
+	}
+	return deltaTx, deltaRx
+}
+
+// usageFromRequest returns total octets sent (Acct-Input) & received (Acct-Output) by the user
+func usageFromRequest(req *packet.Packet) (bytesTx, bytesRx uint64) {
+	in, _ := req.GetUint32(packet.AcctInputOctets)
+	inGw, _ := req.GetUint32(packet.AcctInputGigawords)
+	out, _ := req.GetUint32(packet.AcctOutputOctets)
+	outGw, _ := req.GetUint32(packet.AcctOutputGigawords)
+	return uint64(inGw)<<32 | uint64(in), uint64(outGw)<<32 | uint64(out)
+}
+
+// imsiFromRequest returns subscriber's IMSI from the Class attribute echoed by the NAS or
+// from the permanent identity in User-Name
+func imsiFromRequest(req *packet.Packet) string {
+	for _, a := range req.Attributes {
+		if a.Type == packet.Class && strings.HasPrefix(string(a.Value), ClassImsiPrefix) {
+			return strings.TrimPrefix(string(a.Value), ClassImsiPrefix)
+		}
+	}
+	identity := req.GetString(packet.UserName)
+	if idx := strings.IndexByte(identity, '@'); idx >= 0 {
+		identity = identity[:idx]
+	}
+	// Only permanent EAP-AKA ('0'), EAP-SIM ('1') & EAP-AKA' ('6') identities carry IMSI
+	if len(identity) < 2 || (identity[0] != '0' && identity[0] != '1' && identity[0] != '6') {
+		return ""
+	}
+	for _, c := range identity[1:] {
+		if c < '0' || c > '9' {
+			return ""
+		}
+	}
+	return identity[1:]
+}
+
+// msisdnFromRequest returns subscriber's MSISDN from the Class attribute echoed by the NAS
+func msisdnFromRequest(req *packet.Packet) []byte {
+	for _, a := range req.Attributes {
+		if a.Type == packet.Class && strings.HasPrefix(string(a.Value), ClassMsisdnPrefix) {
+			return []byte(strings.TrimPrefix(string(a.Value), ClassMsisdnPrefix))
+		}
+	}
+	return nil
+}
+
+// nasIdentifier returns NAS-Identifier, NAS-IP-Address or the source address of the NAS
+func nasIdentifier(from string, req *packet.Packet) string {
+	if id := req.GetString(packet.NASIdentifier); len(id) > 0 {
+		return id
+	}
+	if ip := req.GetIP(packet.NASIPAddress); ip != nil {
+		return ip.String()
+	}
+	if host, _, err := net.SplitHostPort(from); err == nil {
+		return host
+	}
+	return from
+}
@@ -0,0 +1,191 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package servicers
+
+import (
+	"time"
+
+	"magma/feg/gateway/services/eap"
+	eap_client "magma/feg/gateway/services/eap/client"
+	"magma/feg/gateway/services/eap/protos"
+	"magma/feg/gateway/services/radius/metrics"
+	"magma/feg/gateway/services/radius/packet"
+
+	"github.com/golang/glog"
+)
+
+const (
+	// Class attribute value prefixes used to pass subscriber's identifiers to accounting
+	ClassImsiPrefix   = "IMSI"
+	ClassMsisdnPrefix = "MSISDN"
+)
+
+// authState is a state of multi round EAP authentication kept between Access-Challenge & next Access-Request
+type authState struct {
+	ctx   *protos.EapContext
+	timer *time.Timer
+}
+
+// HandleAccessRequest bridges EAP-Message of Access-Request to EAP router & returns corresponding
+// Access-Challenge, Access-Accept or Access-Reject
+func (s *RadiusServer) HandleAccessRequest(req *packet.Packet) *packet.Packet {
+	eapMsg := req.EapMessage()
+	if len(eapMsg) == 0 {
+		glog.Errorf("Rejecting Access-Request %d without EAP-Message: only EAP authentication is supported",
+			req.Identifier)
+		return s.reject(req, nil)
+	}
+	if err := eapMsg.Validate(); err != nil {
+		glog.Errorf("Rejecting Access-Request %d with invalid EAP-Message: %v", req.Identifier, err)
+		return s.reject(req, eapMsg.Failure())
+	}
+	var eapCtx *protos.EapContext
+	if state := req.GetString(packet.State); len(state) > 0 {
+		eapCtx = s.takeAuthState(state)
+	} else {
+		eapCtx = &protos.EapContext{SessionId: eap.CreateSessionId()}
+	}
+	var (
+		resp *protos.Eap
+		err  error
+	)
+	msg := &protos.Eap{Payload: eapMsg, Ctx: eapCtx}
+	if eapMsg.Type() == eap_client.EapMethodIdentity {
+		resp, err = s.eapClient.HandleIdentity(s.methodForIdentity(eapMsg.TypeData()), msg)
+	} else {
+		resp, err = s.eapClient.Handle(msg)
+	}
+	if err != nil {
+		glog.Errorf("EAP Error for session %s: %v", eapCtx.GetSessionId(), err)
+		if resp == nil || len(resp.GetPayload()) == 0 {
+			return s.reject(req, eapMsg.Failure())
+		}
+	}
+	if resp.GetCtx() != nil {
+		eapCtx = resp.GetCtx()
+	}
+	respMsg := eap.Packet(resp.GetPayload())
+	if respMsg.Validate() != nil {
+		return s.reject(req, eapMsg.Failure())
+	}
+	switch respMsg[eap.EapMsgCode] {
+	case eap.RequestCode:
+		s.saveAuthState(eapCtx)
+		return s.challenge(req, respMsg, eapCtx)
+	case eap.SuccessCode:
+		return s.accept(req, respMsg, eapCtx)
+	default:
+		return s.reject(req, respMsg)
+	}
+}
+
+// methodForIdentity returns EAP method type derived from the identity prefix
+// (RFC 4186, 4.2.1.5; RFC 4187, 4.1.1.6 & RFC 5448, 3) if the method is supported,
+// or the default EAP method otherwise
+func (s *RadiusServer) methodForIdentity(identity []byte) uint8 {
+	if len(identity) == 0 {
+		return s.cfg.DefaultEapMethod
+	}
+	var method uint8
+	switch identity[0] {
+	case '0', '2', '4':
+		method = uint8(protos.EapType_AKA)
+	case '1', '3', '5':
+		method = uint8(protos.EapType_SIM)
+	case '6', '7', '8':
+		method = uint8(protos.EapType_AKAPrime)
+	default:
+		return s.cfg.DefaultEapMethod
+	}
+	for _, supported := range s.eapClient.SupportedTypes() {
+		if supported == method {
+			return method
+		}
+	}
+	return s.cfg.DefaultEapMethod
+}
+
+func (s *RadiusServer) challenge(req *packet.Packet, msg eap.Packet, eapCtx *protos.EapContext) *packet.Packet {
+	metrics.AccessChallenges.Inc()
+	resp := packet.NewResponse(req, packet.AccessChallenge)
+	resp.AddEapMessage(msg)
+	resp.AddString(packet.State, eapCtx.GetSessionId())
+	resp.AddUint32(packet.SessionTimeout, uint32(s.cfg.AuthStateTimeout/time.Second))
+	resp.AddMessageAuthenticator()
+	return resp
+}
+
+func (s *RadiusServer) accept(req *packet.Packet, msg eap.Packet, eapCtx *protos.EapContext) *packet.Packet {
+	resp := packet.NewResponse(req, packet.AccessAccept)
+	resp.AddEapMessage(msg)
+	if err := resp.AddMSMPPEKeys(eapCtx.GetMsk(), s.cfg.Secret); err != nil {
+		glog.Errorf("Rejecting authenticated session %s: %v", eapCtx.GetSessionId(), err)
+		return s.reject(req, msg.Failure())
+	}
+	if len(eapCtx.GetIdentity()) > 0 {
+		resp.AddString(packet.UserName, eapCtx.GetIdentity())
+	}
+	if len(eapCtx.GetImsi()) > 0 {
+		resp.AddString(packet.Class, ClassImsiPrefix+eapCtx.GetImsi())
+	}
+	if len(eapCtx.GetMsisdn()) > 0 {
+		resp.AddString(packet.Class, ClassMsisdnPrefix+eapCtx.GetMsisdn())
+	}
+	resp.AddMessageAuthenticator()
+	metrics.AccessAccepts.Inc()
+	return resp
+}
+
+func (s *RadiusServer) reject(req *packet.Packet, msg eap.Packet) *packet.Packet {
+	metrics.AccessRejects.Inc()
+	resp := packet.NewResponse(req, packet.AccessReject)
+	if len(msg) > 0 {
+		resp.AddEapMessage(msg)
+	}
+	resp.AddMessageAuthenticator()
+	return resp
+}
+
+// saveAuthState stores EAP context until the next Access-Request of the session or the state timeout
+func (s *RadiusServer) saveAuthState(eapCtx *protos.EapContext) {
+	sessionId := eapCtx.GetSessionId()
+	state := &authState{ctx: eapCtx}
+	state.timer = time.AfterFunc(s.cfg.AuthStateTimeout, func() {
+		s.authMu.Lock()
+		if current, ok := s.authStates[sessionId]; ok && current == state {
+			delete(s.authStates, sessionId)
+		}
+		s.authMu.Unlock()
+	})
+	s.authMu.Lock()
+	old := s.authStates[sessionId]
+	s.authStates[sessionId] = state
+	s.authMu.Unlock()
+
+	if old != nil {
+		old.timer.Stop()
+	}
+}
+
+// takeAuthState removes & returns EAP context of the session, if the state is not found (expired or
+// created by another instance) takeAuthState returns a new context for the session ID
+func (s *RadiusServer) takeAuthState(sessionId string) *protos.EapContext {
+	s.authMu.Lock()
+	state, ok := s.authStates[sessionId]
+	if ok {
+		delete(s.authStates, sessionId)
+	}
+	s.authMu.Unlock()
+
+	if !ok {
+		return &protos.EapContext{SessionId: sessionId}
+	}
+	state.timer.Stop()
+	return state.ctx
+}
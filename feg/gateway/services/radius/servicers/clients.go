@@ -0,0 +1,109 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package servicers
+
+import (
+	"golang.org/x/net/context"
+
+	"magma/feg/gateway/registry"
+	eap_client "magma/feg/gateway/services/eap/client"
+	"magma/feg/gateway/services/eap/protos"
+	"magma/feg/gateway/services/session_proxy"
+	lteprotos "magma/lte/cloud/go/protos"
+	orcprotos "magma/orc8r/cloud/go/protos"
+	"magma/orc8r/cloud/go/services/directoryd"
+)
+
+// EapClient is an interface to EAP router & registered EAP providers
+type EapClient interface {
+	// HandleIdentity passes EAP Identity Response to the given method provider
+	HandleIdentity(method uint8, msg *protos.Eap) (*protos.Eap, error)
+	// Handle passes EAP Response to the provider of its method type
+	Handle(msg *protos.Eap) (*protos.Eap, error)
+	// SupportedTypes returns list of registered EAP method types
+	SupportedTypes() []uint8
+}
+
+// SessionClient is an interface to session proxy's Gx/Gy session control
+type SessionClient interface {
+	CreateSession(context.Context, *lteprotos.CreateSessionRequest) (*lteprotos.CreateSessionResponse, error)
+	UpdateSession(context.Context, *lteprotos.UpdateSessionRequest) (*lteprotos.UpdateSessionResponse, error)
+	TerminateSession(
+		context.Context, *lteprotos.SessionTerminateRequest) (*lteprotos.SessionTerminateResponse, error)
+}
+
+// DirectoryClient is an interface to the cloud directory of the gateways serving subscribers
+type DirectoryClient interface {
+	// UpdateLocation records this gateway as the one serving the IMSI, so HSS initiated
+	// requests for the subscriber are relayed to it
+	UpdateLocation(ctx context.Context, imsi string) error
+}
+
+type eapRouterClient struct{}
+
+// NewEapClient returns EapClient using local EAP router & registered EAP providers
+func NewEapClient() EapClient {
+	return eapRouterClient{}
+}
+
+func (eapRouterClient) HandleIdentity(method uint8, msg *protos.Eap) (*protos.Eap, error) {
+	return eap_client.HandleIdentityResponse(method, msg)
+}
+
+func (eapRouterClient) Handle(msg *protos.Eap) (*protos.Eap, error) {
+	return eap_client.Handle(msg)
+}
+
+func (eapRouterClient) SupportedTypes() []uint8 {
+	return eap_client.SupportedTypes()
+}
+
+type sessionProxyClient struct{}
+
+// NewSessionClient returns SessionClient using session_proxy service
+func NewSessionClient() SessionClient {
+	return sessionProxyClient{}
+}
+
+func (sessionProxyClient) CreateSession(
+	ctx context.Context, req *lteprotos.CreateSessionRequest) (*lteprotos.CreateSessionResponse, error) {
+	return session_proxy.CreateSession(ctx, req)
+}
+
+func (sessionProxyClient) UpdateSession(
+	ctx context.Context, req *lteprotos.UpdateSessionRequest) (*lteprotos.UpdateSessionResponse, error) {
+	return session_proxy.UpdateSession(ctx, req)
+}
+
+func (sessionProxyClient) TerminateSession(
+	ctx context.Context, req *lteprotos.SessionTerminateRequest) (*lteprotos.SessionTerminateResponse, error) {
+	return session_proxy.TerminateSession(ctx, req)
+}
+
+type cloudDirectoryClient struct{}
+
+// NewDirectoryClient returns DirectoryClient using the cloud directoryd service
+func NewDirectoryClient() DirectoryClient {
+	return cloudDirectoryClient{}
+}
+
+func (cloudDirectoryClient) UpdateLocation(ctx context.Context, imsi string) error {
+	conn, err := registry.NewCloudRegistry().GetCloudConnection(directoryd.ServiceName)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	_, err = orcprotos.NewDirectoryServiceClient(conn).UpdateLocation(ctx, &orcprotos.UpdateDirectoryLocationRequest{
+		Table: orcprotos.TableID_IMSI_TO_HWID,
+		Id:    ClassImsiPrefix + imsi,
+		// directoryd records the calling gateway's hardware ID as the location
+		Record: &orcprotos.LocationRecord{},
+	})
+	return err
+}
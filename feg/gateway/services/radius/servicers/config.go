@@ -0,0 +1,80 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package servicers
+
+import (
+	"time"
+
+	"magma/feg/gateway/services/eap/protos"
+	"magma/orc8r/cloud/go/service/config"
+
+	"github.com/golang/glog"
+)
+
+const (
+	RadiusServiceName = "radius"
+
+	DefaultAuthAddr         = ":1812"
+	DefaultAcctAddr         = ":1813"
+	DefaultEapMethod        = uint8(protos.EapType_AKA)
+	DefaultRequestTimeout   = time.Second * 3
+	DefaultAuthStateTimeout = time.Second * 30
+)
+
+// RadiusConfig holds RADIUS server configuration
+type RadiusConfig struct {
+	// Secret is the RADIUS shared secret used by all NAS clients
+	Secret []byte
+	// AuthAddr & AcctAddr are UDP addresses of Access & Accounting servers
+	AuthAddr,
+	AcctAddr string
+	// DefaultEapMethod is EAP method used for identities without a recognized method prefix
+	DefaultEapMethod uint8
+	// RequestTimeout is the timeout of calls to session proxy
+	RequestTimeout time.Duration
+	// AuthStateTimeout is the lifetime of an idle multi round EAP authentication state
+	AuthStateTimeout time.Duration
+}
+
+// GetRadiusConfig returns RADIUS server configuration loaded from radius.yml service config,
+// missing parameters are set to their defaults
+func GetRadiusConfig() *RadiusConfig {
+	cfg := &RadiusConfig{
+		AuthAddr:         DefaultAuthAddr,
+		AcctAddr:         DefaultAcctAddr,
+		DefaultEapMethod: DefaultEapMethod,
+		RequestTimeout:   DefaultRequestTimeout,
+		AuthStateTimeout: DefaultAuthStateTimeout,
+	}
+	// moduleName is "" since all feg configs lie in /etc/magma/configs without a module name
+	configMap, err := config.GetServiceConfig("", RadiusServiceName)
+	if err != nil {
+		glog.Errorf("%s Service Configs Load Error: %v", RadiusServiceName, err)
+		return cfg
+	}
+	if secret, err := configMap.GetStringParam("secret"); err == nil {
+		cfg.Secret = []byte(secret)
+	}
+	if addr, err := configMap.GetStringParam("auth_address"); err == nil && len(addr) > 0 {
+		cfg.AuthAddr = addr
+	}
+	if addr, err := configMap.GetStringParam("acct_address"); err == nil && len(addr) > 0 {
+		cfg.AcctAddr = addr
+	}
+	if method, err := configMap.GetIntParam("default_eap_method"); err == nil && method > 0 {
+		cfg.DefaultEapMethod = uint8(method)
+	}
+	if ms, err := configMap.GetIntParam("request_timeout_ms"); err == nil && ms > 0 {
+		cfg.RequestTimeout = time.Millisecond * time.Duration(ms)
+	}
+	if ms, err := configMap.GetIntParam("auth_state_timeout_ms"); err == nil && ms > 0 {
+		cfg.AuthStateTimeout = time.Millisecond * time.Duration(ms)
+	}
+	return cfg
+}
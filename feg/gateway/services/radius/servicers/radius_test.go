@@ -0,0 +1,350 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package servicers_test
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"sync"
+	"testing"
+
+	fegprotos "magma/feg/cloud/go/protos"
+	"magma/feg/gateway/services/eap"
+	"magma/feg/gateway/services/eap/protos"
+	"magma/feg/gateway/services/radius/packet"
+	"magma/feg/gateway/services/radius/servicers"
+	lteprotos "magma/lte/cloud/go/protos"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+)
+
+const (
+	testImsi   = "001010000000001"
+	testMsisdn = "5100001234"
+	nasAddr    = "10.0.0.1:5555"
+)
+
+var secret = []byte("1qaz2wsx")
+
+// testEapClient simulates a two round EAP method: Identity -> Challenge -> Success
+type testEapClient struct {
+	identityMethods []uint8
+}
+
+func (c *testEapClient) HandleIdentity(method uint8, msg *protos.Eap) (*protos.Eap, error) {
+	c.identityMethods = append(c.identityMethods, method)
+	p := eap.Packet(msg.Payload)
+	msg.Ctx.Identity = string(p.TypeData())
+	return &protos.Eap{
+		Payload: eap.NewPacket(eap.RequestCode, p.Identifier()+1, []byte{method, 1, 0, 0}),
+		Ctx:     msg.Ctx,
+	}, nil
+}
+
+func (c *testEapClient) Handle(msg *protos.Eap) (*protos.Eap, error) {
+	p := eap.Packet(msg.Payload)
+	if !bytes.Equal(p.TypeData(), []byte{1, 0, 0, 'o', 'k'}) {
+		return &protos.Eap{Payload: p.Failure(), Ctx: msg.Ctx}, fmt.Errorf("Invalid Challenge Response")
+	}
+	msg.Ctx.Imsi = testImsi
+	msg.Ctx.Msisdn = testMsisdn
+	msg.Ctx.Msk = bytes.Repeat([]byte{0x5A}, 64)
+	return &protos.Eap{Payload: eap.NewPacket(eap.SuccessCode, p.Identifier(), nil), Ctx: msg.Ctx}, nil
+}
+
+func (c *testEapClient) SupportedTypes() []uint8 {
+	return []uint8{uint8(protos.EapType_AKA)}
+}
+
+type testSessionClient struct {
+	// createStarted & createDone, if set, pause CreateSession until the test lets it finish
+	createStarted, createDone chan struct{}
+
+	mu         sync.Mutex
+	creates    []*lteprotos.CreateSessionRequest
+	updates    []*lteprotos.UpdateSessionRequest
+	terminates []*lteprotos.SessionTerminateRequest
+}
+
+func (c *testSessionClient) CreateSession(
+	_ context.Context, req *lteprotos.CreateSessionRequest) (*lteprotos.CreateSessionResponse, error) {
+	if c.createStarted != nil {
+		c.createStarted <- struct{}{}
+		<-c.createDone
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.creates = append(c.creates, req)
+	return &lteprotos.CreateSessionResponse{
+		Credits: []*lteprotos.CreditUpdateResponse{{Success: true, Sid: req.Subscriber.Id, ChargingKey: 1}},
+		UsageMonitors: []*lteprotos.UsageMonitoringUpdateResponse{{
+			Success: true,
+			Credit: &lteprotos.UsageMonitoringCredit{
+				MonitoringKey: "mk1",
+				Level:         lteprotos.MonitoringLevel_SESSION_LEVEL,
+			},
+		}},
+	}, nil
+}
+
+func (c *testSessionClient) UpdateSession(
+	_ context.Context, req *lteprotos.UpdateSessionRequest) (*lteprotos.UpdateSessionResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.updates = append(c.updates, req)
+	return &lteprotos.UpdateSessionResponse{}, nil
+}
+
+func (c *testSessionClient) TerminateSession(
+	_ context.Context, req *lteprotos.SessionTerminateRequest) (*lteprotos.SessionTerminateResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.terminates = append(c.terminates, req)
+	return &lteprotos.SessionTerminateResponse{Sid: req.Sid, SessionId: req.SessionId}, nil
+}
+
+// testDirectoryClient sends the IMSIs of the location updates to its channel
+type testDirectoryClient chan string
+
+func (c testDirectoryClient) UpdateLocation(_ context.Context, imsi string) error {
+	c <- imsi
+	return nil
+}
+
+func newServer() (*servicers.RadiusServer, *testEapClient, *testSessionClient) {
+	eapClient, sessionClient := &testEapClient{}, &testSessionClient{}
+	directoryClient := make(testDirectoryClient, 16)
+	return servicers.NewRadiusServer(&servicers.RadiusConfig{Secret: secret}, eapClient, sessionClient, directoryClient),
+		eapClient, sessionClient
+}
+
+func accessRequest(t *testing.T, id uint8, eapMsg eap.Packet, state []byte) []byte {
+	req := &packet.Packet{Code: packet.AccessRequest, Identifier: id}
+	req.AddString(packet.UserName, "0"+testImsi)
+	req.AddEapMessage(eapMsg)
+	if state != nil {
+		req.Add(packet.State, state)
+	}
+	req.AddMessageAuthenticator()
+	b, err := req.EncodeRequest(secret)
+	assert.NoError(t, err)
+	return b
+}
+
+func parseResponse(t *testing.T, req, resp []byte) *packet.Packet {
+	assert.NotEmpty(t, resp)
+	// Verify Response Authenticator
+	reqPacket, err := packet.Parse(req)
+	assert.NoError(t, err)
+	p, err := packet.Parse(resp)
+	assert.NoError(t, err)
+	p.Authenticator = reqPacket.Authenticator
+	b, err := p.EncodeResponse(secret)
+	assert.NoError(t, err)
+	assert.Equal(t, resp, b)
+	p, err = packet.Parse(resp)
+	assert.NoError(t, err)
+	return p
+}
+
+func TestEapAuthentication(t *testing.T) {
+	srv, eapClient, _ := newServer()
+
+	identity := eap.NewPacket(eap.ResponseCode, 1, append([]byte{1}, []byte("0"+testImsi)...))
+	req := accessRequest(t, 1, identity, nil)
+	challenge := parseResponse(t, req, srv.HandlePacket(nasAddr, req))
+	assert.Equal(t, packet.AccessChallenge, challenge.Code)
+	assert.Equal(t, []uint8{uint8(protos.EapType_AKA)}, eapClient.identityMethods)
+	state := challenge.Get(packet.State)
+	assert.NotEmpty(t, state)
+	assert.Equal(t, uint8(eap.RequestCode), challenge.EapMessage()[eap.EapMsgCode])
+
+	// Retransmitted request must be answered with the same response
+	assert.Equal(t, srv.HandlePacket(nasAddr, req), srv.HandlePacket(nasAddr, req))
+
+	// Unauthenticated request must be discarded
+	badReq := accessRequest(t, 2, identity, nil)
+	badReq[len(badReq)-1] ^= 0xFF
+	assert.Empty(t, srv.HandlePacket(nasAddr, badReq))
+
+	chalResp := eap.NewPacket(eap.ResponseCode, 2, []byte{uint8(protos.EapType_AKA), 1, 0, 0, 'o', 'k'})
+	req = accessRequest(t, 3, chalResp, state)
+	accept := parseResponse(t, req, srv.HandlePacket(nasAddr, req))
+	assert.Equal(t, packet.AccessAccept, accept.Code)
+	assert.Equal(t, []byte{eap.SuccessCode, 2, 0, 4}, []byte(accept.EapMessage()))
+	assert.Equal(t, "0"+testImsi, accept.GetString(packet.UserName))
+	assert.NotNil(t, accept.GetVendorSpecific(packet.VendorMicrosoft, packet.VendorMSMPPERecvKey))
+	assert.NotNil(t, accept.GetVendorSpecific(packet.VendorMicrosoft, packet.VendorMSMPPESendKey))
+	var classes []string
+	for _, a := range accept.Attributes {
+		if a.Type == packet.Class {
+			classes = append(classes, string(a.Value))
+		}
+	}
+	assert.Equal(t, []string{"IMSI" + testImsi, "MSISDN" + testMsisdn}, classes)
+	assert.True(t, packet.VerifyMessageAuthenticator(responseWithRequestAuth(t, req, accept), secret))
+
+	// Wrong challenge response
+	req = accessRequest(t, 4, identity, nil)
+	challenge = parseResponse(t, req, srv.HandlePacket(nasAddr, req))
+	badResp := eap.NewPacket(eap.ResponseCode, 2, []byte{uint8(protos.EapType_AKA), 1, 0, 0, 'n', 'o'})
+	req = accessRequest(t, 5, badResp, challenge.Get(packet.State))
+	reject := parseResponse(t, req, srv.HandlePacket(nasAddr, req))
+	assert.Equal(t, packet.AccessReject, reject.Code)
+	assert.Equal(t, uint8(eap.FailureCode), reject.EapMessage()[eap.EapMsgCode])
+}
+
+func responseWithRequestAuth(t *testing.T, req []byte, resp *packet.Packet) []byte {
+	reqPacket, err := packet.Parse(req)
+	assert.NoError(t, err)
+	resp.Authenticator = reqPacket.Authenticator
+	b, err := resp.Encode()
+	assert.NoError(t, err)
+	return b
+}
+
+func accountingRequest(t *testing.T, id uint8, status uint32, in, out uint32) []byte {
+	req := &packet.Packet{Code: packet.AccountingRequest, Identifier: id}
+	req.AddUint32(packet.AcctStatusType, status)
+	req.AddString(packet.AcctSessionId, "ACCT-1")
+	req.AddString(packet.UserName, "0"+testImsi+"@wlan.mnc001.mcc001.3gppnetwork.org")
+	req.AddString(packet.Class, "IMSI"+testImsi)
+	req.AddString(packet.Class, "MSISDN"+testMsisdn)
+	req.AddString(packet.CalledStationId, "00-11-22-33-44-55:magma")
+	req.AddString(packet.NASIdentifier, "ap1")
+	req.Add(packet.FramedIPAddress, net.ParseIP("192.168.1.10").To4())
+	req.AddUint32(packet.AcctInputOctets, in)
+	req.AddUint32(packet.AcctOutputOctets, out)
+	b, err := req.EncodeRequest(secret)
+	assert.NoError(t, err)
+	return b
+}
+
+func TestAccounting(t *testing.T) {
+	srv, _, sessionClient := newServer()
+	expectedSessionId := "IMSI" + testImsi + "-ACCT-1"
+
+	req := accountingRequest(t, 1, packet.AcctStatusStart, 0, 0)
+	resp := parseResponse(t, req, srv.HandlePacket(nasAddr, req))
+	assert.Equal(t, packet.AccountingResponse, resp.Code)
+	assert.Len(t, sessionClient.creates, 1)
+	create := sessionClient.creates[0]
+	assert.Equal(t, "IMSI"+testImsi, create.Subscriber.Id)
+	assert.Equal(t, expectedSessionId, create.SessionId)
+	assert.Equal(t, "192.168.1.10", create.UeIpv4)
+	assert.Equal(t, "00-11-22-33-44-55:magma", create.Apn)
+	assert.Equal(t, []byte(testMsisdn), create.Msisdn)
+
+	// Invalid authenticator must be discarded
+	badReq := accountingRequest(t, 2, packet.AcctStatusInterimUpdate, 100, 200)
+	badReq[packet.PacketAuthenticator] ^= 0xFF
+	assert.Empty(t, srv.HandlePacket(nasAddr, badReq))
+
+	req = accountingRequest(t, 3, packet.AcctStatusInterimUpdate, 100, 200)
+	parseResponse(t, req, srv.HandlePacket(nasAddr, req))
+	req = accountingRequest(t, 4, packet.AcctStatusInterimUpdate, 150, 500)
+	parseResponse(t, req, srv.HandlePacket(nasAddr, req))
+	assert.Len(t, sessionClient.updates, 2)
+	update := sessionClient.updates[1]
+	assert.Len(t, update.Updates, 1)
+	assert.Equal(t, uint64(50), update.Updates[0].Usage.BytesTx)
+	assert.Equal(t, uint64(300), update.Updates[0].Usage.BytesRx)
+	assert.Equal(t, uint32(1), update.Updates[0].Usage.ChargingKey)
+	assert.Equal(t, uint32(2), update.Updates[0].RequestNumber)
+	assert.Len(t, update.UsageMonitors, 1)
+	assert.Equal(t, "mk1", update.UsageMonitors[0].Update.MonitoringKey)
+	assert.Equal(t, uint64(50), update.UsageMonitors[0].Update.BytesTx)
+
+	req = accountingRequest(t, 5, packet.AcctStatusStop, 200, 600)
+	parseResponse(t, req, srv.HandlePacket(nasAddr, req))
+	assert.Len(t, sessionClient.terminates, 1)
+	terminate := sessionClient.terminates[0]
+	assert.Equal(t, expectedSessionId, terminate.SessionId)
+	assert.Equal(t, uint32(3), terminate.RequestNumber)
+	assert.Len(t, terminate.CreditUsages, 1)
+	assert.Equal(t, uint64(50), terminate.CreditUsages[0].BytesTx)
+	assert.Equal(t, uint64(100), terminate.CreditUsages[0].BytesRx)
+	assert.Equal(t, lteprotos.CreditUsage_TERMINATED, terminate.CreditUsages[0].Type)
+
+	// Interim without Start creates the session, Accounting-On terminates all NAS sessions
+	req = accountingRequest(t, 6, packet.AcctStatusInterimUpdate, 10, 10)
+	parseResponse(t, req, srv.HandlePacket(nasAddr, req))
+	assert.Len(t, sessionClient.creates, 2)
+	on := &packet.Packet{Code: packet.AccountingRequest, Identifier: 7}
+	on.AddUint32(packet.AcctStatusType, packet.AcctStatusAccountingOn)
+	on.AddString(packet.NASIdentifier, "ap1")
+	b, err := on.EncodeRequest(secret)
+	assert.NoError(t, err)
+	parseResponse(t, b, srv.HandlePacket(nasAddr, b))
+	assert.Len(t, sessionClient.terminates, 2)
+}
+
+func TestAccountingRetransmissions(t *testing.T) {
+	srv, _, sessionClient := newServer()
+	sessionClient.createStarted, sessionClient.createDone = make(chan struct{}), make(chan struct{})
+
+	start := accountingRequest(t, 1, packet.AcctStatusStart, 0, 0)
+	startResp, interimResp := make(chan []byte, 1), make(chan []byte, 1)
+	go func() { startResp <- srv.HandlePacket(nasAddr, start) }()
+	<-sessionClient.createStarted
+
+	// Retransmission of the request in progress is discarded
+	assert.Empty(t, srv.HandlePacket(nasAddr, start))
+	// New request for the session being created waits for its creation instead of creating it again
+	interim := accountingRequest(t, 2, packet.AcctStatusInterimUpdate, 100, 200)
+	go func() { interimResp <- srv.HandlePacket(nasAddr, interim) }()
+
+	close(sessionClient.createDone)
+	parseResponse(t, start, <-startResp)
+	parseResponse(t, interim, <-interimResp)
+	assert.Len(t, sessionClient.creates, 1)
+	assert.Len(t, sessionClient.updates, 1)
+
+	// Retransmission of the answered request gets the cached response
+	parseResponse(t, start, srv.HandlePacket(nasAddr, start))
+	assert.Len(t, sessionClient.creates, 1)
+}
+
+func TestSwxTermination(t *testing.T) {
+	sessionClient, directoryClient := &testSessionClient{}, make(testDirectoryClient, 1)
+	srv := servicers.NewRadiusServer(
+		&servicers.RadiusConfig{Secret: secret}, &testEapClient{}, sessionClient, directoryClient)
+	req := accountingRequest(t, 1, packet.AcctStatusStart, 0, 0)
+	parseResponse(t, req, srv.HandlePacket(nasAddr, req))
+	// The gateway is recorded as the one serving the subscriber, so SWx requests are relayed to it
+	assert.Equal(t, testImsi, <-directoryClient)
+
+	// Profile updates which keep non-3GPP access allowed don't affect the session
+	_, err := srv.PushProfile(
+		context.Background(), &fegprotos.PushProfileRequest{UserName: testImsi, Non3GppIpAccessAllowed: true})
+	assert.NoError(t, err)
+	assert.Empty(t, sessionClient.terminates)
+
+	_, err = srv.RegistrationTermination(context.Background(), &fegprotos.RegistrationTerminationRequest{UserName: testImsi})
+	assert.NoError(t, err)
+	assert.Len(t, sessionClient.terminates, 1)
+	assert.Equal(t, "IMSI"+testImsi+"-ACCT-1", sessionClient.terminates[0].SessionId)
+
+	// The terminated session is recreated by the next interim update
+	req = accountingRequest(t, 2, packet.AcctStatusInterimUpdate, 10, 10)
+	parseResponse(t, req, srv.HandlePacket(nasAddr, req))
+	assert.Len(t, sessionClient.creates, 2)
+	_, err = srv.PushProfile(context.Background(), &fegprotos.PushProfileRequest{UserName: testImsi})
+	assert.NoError(t, err)
+	assert.Len(t, sessionClient.terminates, 2)
+}
+
+func TestStartWithoutSecret(t *testing.T) {
+	srv := servicers.NewRadiusServer(
+		&servicers.RadiusConfig{AuthAddr: "127.0.0.1:0", AcctAddr: "127.0.0.1:0"},
+		&testEapClient{}, &testSessionClient{}, make(testDirectoryClient))
+	assert.EqualError(t, srv.Start(), "RADIUS shared secret is not configured")
+}
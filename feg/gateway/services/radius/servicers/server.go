@@ -0,0 +1,250 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+// Package servicers implements RADIUS Access & Accounting servers
+package servicers
+
+import (
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"magma/feg/gateway/services/radius/metrics"
+	"magma/feg/gateway/services/radius/packet"
+
+	"github.com/golang/glog"
+)
+
+const (
+	// duplicateDetectionWindow is a time span during which retransmitted requests are answered with
+	// the cached response instead of being processed again (RFC 5080, 2.2.2)
+	duplicateDetectionWindow = time.Second * 5
+)
+
+// cachedResponse is the response to a recently received request, a nil response means that the request
+// is still being processed
+type cachedResponse struct {
+	response []byte
+	expires  time.Time
+}
+
+// cacheExpiry records when a cached response expires, expiries are queued in the order the responses were
+// cached & since all responses are kept for the same duplicateDetectionWindow, the queue is sorted by time
+type cacheExpiry struct {
+	key     string
+	expires time.Time
+}
+
+// RadiusServer implements RADIUS Access (RFC 2865 & RFC 3579) & Accounting (RFC 2866) servers
+type RadiusServer struct {
+	cfg             *RadiusConfig
+	eapClient       EapClient
+	sessionClient   SessionClient
+	directoryClient DirectoryClient
+
+	authMu     sync.Mutex
+	authStates map[string]*authState // in progress EAP authentications keyed by RADIUS State
+
+	acctMu       sync.Mutex
+	acctSessions map[string]*acctSession // active accounting sessions keyed by session ID
+
+	respMu    sync.Mutex
+	responses map[string]cachedResponse // recent responses keyed by NAS address, Identifier & Authenticator
+	expiries  []cacheExpiry             // expiries of the cached responses, oldest first
+}
+
+// NewRadiusServer creates a new RADIUS server 'object'
+func NewRadiusServer(
+	cfg *RadiusConfig, eapClient EapClient, sessionClient SessionClient, directoryClient DirectoryClient) *RadiusServer {
+
+	if cfg == nil {
+		cfg = &RadiusConfig{}
+	}
+	if cfg.DefaultEapMethod == 0 {
+		cfg.DefaultEapMethod = DefaultEapMethod
+	}
+	if cfg.RequestTimeout <= 0 {
+		cfg.RequestTimeout = DefaultRequestTimeout
+	}
+	if cfg.AuthStateTimeout <= 0 {
+		cfg.AuthStateTimeout = DefaultAuthStateTimeout
+	}
+	return &RadiusServer{
+		cfg:             cfg,
+		eapClient:       eapClient,
+		sessionClient:   sessionClient,
+		directoryClient: directoryClient,
+		authStates:      map[string]*authState{},
+		acctSessions:    map[string]*acctSession{},
+		responses:       map[string]cachedResponse{},
+	}
+}
+
+// Start starts Access & Accounting UDP listeners in their own goroutines
+func (s *RadiusServer) Start() error {
+	if len(s.cfg.Secret) == 0 {
+		return fmt.Errorf("RADIUS shared secret is not configured")
+	}
+	authConn, err := net.ListenPacket("udp", s.cfg.AuthAddr)
+	if err != nil {
+		return fmt.Errorf("Failed to listen on RADIUS Access address %s: %v", s.cfg.AuthAddr, err)
+	}
+	acctConn, err := net.ListenPacket("udp", s.cfg.AcctAddr)
+	if err != nil {
+		authConn.Close()
+		return fmt.Errorf("Failed to listen on RADIUS Accounting address %s: %v", s.cfg.AcctAddr, err)
+	}
+	glog.Infof("RADIUS Access server listening on %s, Accounting server listening on %s",
+		authConn.LocalAddr(), acctConn.LocalAddr())
+	go s.Serve(authConn)
+	go s.Serve(acctConn)
+	return nil
+}
+
+// Serve reads RADIUS requests from conn & writes back the responses until conn is closed
+func (s *RadiusServer) Serve(conn net.PacketConn) error {
+	defer conn.Close()
+	buf := make([]byte, packet.MaxPacketLen)
+	for {
+		n, addr, err := conn.ReadFrom(buf)
+		if err != nil {
+			if ne, ok := err.(net.Error); ok && ne.Temporary() {
+				continue
+			}
+			glog.Errorf("RADIUS server on %s stopped: %v", conn.LocalAddr(), err)
+			return err
+		}
+		req := make([]byte, n)
+		copy(req, buf[:n])
+		go func(req []byte, addr net.Addr) {
+			resp := s.HandlePacket(addr.String(), req)
+			if len(resp) == 0 {
+				return
+			}
+			if _, err := conn.WriteTo(resp, addr); err != nil {
+				glog.Errorf("Failed to send RADIUS response to %s: %v", addr, err)
+			}
+		}(req, addr)
+	}
+}
+
+// HandlePacket processes a RADIUS request received from the NAS with address 'from' & returns encoded response
+// or nil if the request must be silently discarded
+func (s *RadiusServer) HandlePacket(from string, raw []byte) []byte {
+	metrics.TotalRequests.Inc()
+	req, err := packet.Parse(raw)
+	if err != nil {
+		metrics.DiscardedRequests.Inc()
+		glog.Errorf("Discarding malformed RADIUS packet from %s: %v", from, err)
+		return nil
+	}
+	cacheKey := fmt.Sprintf("%s|%d|%x", from, req.Identifier, req.Authenticator)
+	if resp, duplicate := s.reserveResponse(cacheKey); duplicate {
+		if resp == nil {
+			glog.V(2).Infof("Discarding retransmitted RADIUS request %d from %s: still in progress", req.Identifier, from)
+		} else {
+			glog.V(2).Infof("Resending cached response for retransmitted RADIUS request %d from %s", req.Identifier, from)
+		}
+		return resp
+	}
+	b := s.handleRequest(from, raw, req)
+	if b == nil {
+		// Let the NAS retry the request which wasn't answered
+		s.releaseResponse(cacheKey)
+		return nil
+	}
+	s.cacheResponse(cacheKey, b)
+	return b
+}
+
+// handleRequest processes a parsed RADIUS request & returns encoded response or nil if the request
+// must be silently discarded
+func (s *RadiusServer) handleRequest(from string, raw []byte, req *packet.Packet) []byte {
+	var resp *packet.Packet
+	switch req.Code {
+	case packet.AccessRequest:
+		// RFC 3579, 3.2: Access-Request with EAP-Message must be protected by a valid Message-Authenticator
+		if (req.Has(packet.EAPMessage) || req.Has(packet.MessageAuthenticator)) &&
+			!packet.VerifyMessageAuthenticator(raw, s.cfg.Secret) {
+			metrics.DiscardedRequests.Inc()
+			glog.Errorf("Discarding Access-Request %d from %s: invalid Message-Authenticator", req.Identifier, from)
+			return nil
+		}
+		resp = s.HandleAccessRequest(req)
+	case packet.AccountingRequest:
+		if !packet.VerifyAccountingRequest(raw, s.cfg.Secret) {
+			metrics.DiscardedRequests.Inc()
+			glog.Errorf("Discarding Accounting-Request %d from %s: invalid Authenticator", req.Identifier, from)
+			return nil
+		}
+		resp = s.HandleAccountingRequest(from, req)
+	default:
+		metrics.DiscardedRequests.Inc()
+		glog.Errorf("Discarding RADIUS packet with unsupported code %d from %s", req.Code, from)
+		return nil
+	}
+	if resp == nil {
+		return nil
+	}
+	b, err := resp.EncodeResponse(s.cfg.Secret)
+	if err != nil {
+		metrics.RequestFailures.Inc()
+		glog.Errorf("Failed to encode RADIUS response for request %d from %s: %v", req.Identifier, from, err)
+		return nil
+	}
+	return b
+}
+
+// reserveResponse returns the cached response & true if the request was already received, otherwise it marks
+// the request as in progress so that its retransmissions received in the meantime are discarded
+func (s *RadiusServer) reserveResponse(key string) ([]byte, bool) {
+	now := time.Now()
+	s.respMu.Lock()
+	defer s.respMu.Unlock()
+	s.purgeExpiredResponses(now)
+	if cached, ok := s.responses[key]; ok {
+		return cached.response, true
+	}
+	s.addResponse(key, nil, now)
+	return nil, false
+}
+
+// releaseResponse forgets the request which was discarded or failed
+func (s *RadiusServer) releaseResponse(key string) {
+	s.respMu.Lock()
+	delete(s.responses, key)
+	s.respMu.Unlock()
+}
+
+func (s *RadiusServer) cacheResponse(key string, resp []byte) {
+	now := time.Now()
+	s.respMu.Lock()
+	s.addResponse(key, resp, now)
+	s.respMu.Unlock()
+}
+
+// addResponse caches the response until duplicateDetectionWindow elapses, respMu must be locked
+func (s *RadiusServer) addResponse(key string, resp []byte, now time.Time) {
+	expires := now.Add(duplicateDetectionWindow)
+	s.responses[key] = cachedResponse{response: resp, expires: expires}
+	s.expiries = append(s.expiries, cacheExpiry{key: key, expires: expires})
+}
+
+// purgeExpiredResponses removes the responses which expired as of now, respMu must be locked
+func (s *RadiusServer) purgeExpiredResponses(now time.Time) {
+	n := 0
+	for ; n < len(s.expiries) && now.After(s.expiries[n].expires); n++ {
+		expiry := s.expiries[n]
+		// The response may have been released or cached again since
+		if cached, ok := s.responses[expiry.key]; ok && !cached.expires.After(expiry.expires) {
+			delete(s.responses, expiry.key)
+		}
+	}
+	s.expiries = s.expiries[n:]
+}
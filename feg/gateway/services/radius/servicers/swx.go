@@ -0,0 +1,45 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package servicers
+
+import (
+	fegprotos "magma/feg/cloud/go/protos"
+
+	"github.com/golang/glog"
+	"golang.org/x/net/context"
+)
+
+// RegistrationTermination handles HSS initiated SWx Registration-Termination
+// relayed by swx_proxy, it terminates the user's accounting sessions.
+// The NAS isn't notified, the Wi-Fi client is disconnected once its session
+// can no longer be updated.
+func (s *RadiusServer) RegistrationTermination(
+	ctx context.Context,
+	req *fegprotos.RegistrationTerminationRequest,
+) (*fegprotos.RegistrationTerminationAnswer, error) {
+	terminated := s.terminateSubscriberSessions(req.GetUserName())
+	glog.Infof(
+		"Terminated %d sessions of %s on RTR with reason %s",
+		terminated, req.GetUserName(), req.GetReasonCode())
+	return &fegprotos.RegistrationTerminationAnswer{}, nil
+}
+
+// PushProfile handles HSS initiated SWx Push-Profile relayed by swx_proxy,
+// it terminates the user's accounting sessions if the user is no longer
+// allowed non-3GPP IP access, other profile changes apply to new sessions
+func (s *RadiusServer) PushProfile(
+	ctx context.Context,
+	req *fegprotos.PushProfileRequest,
+) (*fegprotos.PushProfileAnswer, error) {
+	if !req.GetNon3GppIpAccessAllowed() {
+		terminated := s.terminateSubscriberSessions(req.GetUserName())
+		glog.Infof("Terminated %d sessions of %s, non-3GPP access is no longer allowed", terminated, req.GetUserName())
+	}
+	return &fegprotos.PushProfileAnswer{}, nil
+}
@@ -28,10 +28,6 @@ import (
 
 // sendAIR - sends AIR with given Session ID (sid)
 func (s *s6aProxy) sendAIR(sid string, req *protos.AuthenticationInformationRequest, retryCount uint) error {
-	c, err := s.connMan.GetConnection(s.smClient, s.serverCfg)
-	if err != nil {
-		return err
-	}
 	var irp uint32
 	if req.ImmediateResponsePreferred {
 		irp = 1
@@ -60,8 +56,9 @@ func (s *s6aProxy) sendAIR(sid string, req *protos.AuthenticationInformationRequ
 		authInfo.AddAVP(resyncInfo)
 	}
 	m.NewAVP(avp.RequestedEUTRANAuthenticationInfo, avp.Vbit|avp.Mbit, diameter.Vendor3GPP, authInfo)
+	s.serverGroup.AddDestinationRealmForIMSI(m, req.UserName)
 
-	err = c.SendRequest(m, retryCount)
+	err := s.connMan.SendRequestToGroup(s.smClient, s.serverGroup, "", sid, m, retryCount)
 	if err != nil {
 		err = Error(codes.DataLoss, err)
 	}
@@ -77,6 +74,7 @@ func handleAIA(s *s6aProxy) diam.HandlerFunc {
 			log.Printf("AIA Unmarshal failed for remote %s & message %s: %s", c.RemoteAddr(), m, err)
 			return
 		}
+		s.serverGroup.AnswerReceived(aia.SessionID)
 		ch := s.requestTracker.DeregisterRequest(aia.SessionID)
 		if ch != nil {
 			ch <- &aia
@@ -144,6 +142,7 @@ func (s *s6aProxy) AuthenticationInformationImpl(
 				err = Errorf(codes.Aborted, "AIR for Session ID: %s is canceled", sid)
 			}
 		case <-time.After(time.Second * TIMEOUT_SECONDS):
+			s.serverGroup.AnswerTimedOut(sid)
 			err = Errorf(codes.DeadlineExceeded, "AIR Timed Out for Session ID: %s", sid)
 			metrics.S6aTimeouts.Inc()
 		}
@@ -72,6 +72,9 @@ func GetS6aProxyConfigs() (*diameter.DiameterClientConfig, *diameter.DiameterSer
 			Retransmits:      uint(configsPtr.Server.Retransmits),
 			WatchdogInterval: uint(configsPtr.Server.WatchdogInterval),
 			RetryCount:       uint(configsPtr.Server.RetryCount),
+			PeerSelection:    diameter.PeerSelection(configsPtr.Server.PeerSelection),
+			AlternateServers: diameter.GetAlternateServerConfigs(configsPtr.Server),
+			RealmRoutes:      diameter.GetRealmRoutes(configsPtr.Server),
 		},
 		&diameter.DiameterServerConfig{DiameterServerConnConfig: diameter.DiameterServerConnConfig{
 			Addr:      diameter.GetValueOrEnv(diameter.AddrFlag, HSSAddrEnv, configsPtr.Server.Address),
@@ -0,0 +1,79 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+// Package servicers implements S6a GRPC proxy service which sends AIR, ULR messages over diameter connection,
+// waits (blocks) for diameter's AIAs, ULAs & returns their RPC representation
+// It also handles DSR, sends sync rpc request to gateway, then returns a DSA over diameter connection.
+package servicers
+
+import (
+	"magma/feg/cloud/go/protos"
+	"magma/feg/gateway/diameter"
+	"magma/feg/gateway/services/s6a_proxy"
+
+	"github.com/fiorix/go-diameter/diam"
+	"github.com/fiorix/go-diameter/diam/avp"
+	"github.com/fiorix/go-diameter/diam/datatype"
+	"github.com/golang/glog"
+)
+
+// S6a DSR
+func handleDSR(s *s6aProxy) diam.HandlerFunc {
+	return func(c diam.Conn, m *diam.Message) {
+		glog.V(2).Infof("handling DSR\n")
+		var dsr DSR
+		err := m.Unmarshal(&dsr)
+		if err != nil {
+			glog.Errorf("DSR Unmarshal failed for remote %s & message %s: %s", c.RemoteAddr(), m, err)
+			return
+		}
+		in := &protos.DeleteSubscriberDataRequest{
+			UserName:  dsr.UserName,
+			DsrFlags:  dsr.DSRFlags,
+			ContextId: dsr.ContextIdentifiers,
+		}
+		var res *protos.DeleteSubscriberDataAnswer
+		var retries = MaxSyncRPCRetries
+		for ; retries >= 0; retries-- {
+			res, err = s6a_proxy.GWS6AProxyDeleteSubscriberData(in)
+			if err == nil {
+				break
+			}
+			if !isRetryableSyncRPCError(err) {
+				glog.Errorf("Failed to forward DSR to gateway. err: %v\n", err)
+				break
+			}
+			glog.Errorf("Failed to forward DSR to gateway. err: %v. Retries left: %v\n", err, retries)
+			waitSyncRPCRetry(retries)
+		}
+		if err != nil || res == nil {
+			res = &protos.DeleteSubscriberDataAnswer{ErrorCode: protos.ErrorCode_UNABLE_TO_DELIVER}
+		}
+		err = s.sendDSA(c, m, res, &dsr, MaxDiamClRetries)
+		if err != nil {
+			glog.Errorf("Failed to send DSA: %v", err)
+		} else {
+			glog.V(2).Infof("Successfully sent DSA\n")
+		}
+	}
+}
+
+func (s *s6aProxy) sendDSA(
+	c diam.Conn, m *diam.Message, res *protos.DeleteSubscriberDataAnswer, dsr *DSR, retries uint) error {
+
+	ans := newAnswer(m, res.ErrorCode)
+	// SessionID is required to be the AVP in position 1
+	ans.InsertAVP(diam.NewAVP(avp.SessionID, avp.Mbit, 0, datatype.UTF8String(dsr.SessionID)))
+	ans.NewAVP(avp.AuthSessionState, avp.Mbit, 0, datatype.Enumerated(dsr.AuthSessionState))
+	s.addDiamOriginAVPs(ans)
+	if res.DsaFlags != 0 {
+		ans.NewAVP(avp.DSAFlags, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Unsigned32(res.DsaFlags))
+	}
+	_, err := ans.WriteToWithRetry(c, retries)
+	return err
+}
@@ -0,0 +1,101 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+// Package servicers implements S6a GRPC proxy service which sends AIR, ULR messages over diameter connection,
+// waits (blocks) for diameter's AIAs, ULAs & returns their RPC representation
+// It also handles IDR, sends sync rpc request to gateway, then returns an IDA over diameter connection.
+package servicers
+
+import (
+	"magma/feg/cloud/go/protos"
+	"magma/feg/gateway/diameter"
+	"magma/feg/gateway/services/s6a_proxy"
+
+	"github.com/fiorix/go-diameter/diam"
+	"github.com/fiorix/go-diameter/diam/avp"
+	"github.com/fiorix/go-diameter/diam/datatype"
+	"github.com/golang/glog"
+)
+
+// S6a IDR
+func handleIDR(s *s6aProxy) diam.HandlerFunc {
+	return func(c diam.Conn, m *diam.Message) {
+		glog.V(2).Infof("handling IDR\n")
+		var idr IDR
+		err := m.Unmarshal(&idr)
+		if err != nil {
+			glog.Errorf("IDR Unmarshal failed for remote %s & message %s: %s", c.RemoteAddr(), m, err)
+			return
+		}
+		var res *protos.InsertSubscriberDataAnswer
+		var retries = MaxSyncRPCRetries
+		for ; retries >= 0; retries-- {
+			res, err = s6a_proxy.GWS6AProxyInsertSubscriberData(buildInsertSubscriberDataRequest(&idr))
+			if err == nil {
+				break
+			}
+			if !isRetryableSyncRPCError(err) {
+				glog.Errorf("Failed to forward IDR to gateway. err: %v\n", err)
+				break
+			}
+			glog.Errorf("Failed to forward IDR to gateway. err: %v. Retries left: %v\n", err, retries)
+			waitSyncRPCRetry(retries)
+		}
+		if err != nil || res == nil {
+			res = &protos.InsertSubscriberDataAnswer{ErrorCode: protos.ErrorCode_UNABLE_TO_DELIVER}
+		}
+		err = s.sendIDA(c, m, res, &idr, MaxDiamClRetries)
+		if err != nil {
+			glog.Errorf("Failed to send IDA: %v", err)
+		} else {
+			glog.V(2).Infof("Successfully sent IDA\n")
+		}
+	}
+}
+
+// buildInsertSubscriberDataRequest converts IDR into its RPC representation,
+// the subscription data missing from the IDR is left unset
+func buildInsertSubscriberDataRequest(idr *IDR) *protos.InsertSubscriberDataRequest {
+	data := &idr.SubscriptionData
+	req := &protos.InsertSubscriberDataRequest{
+		UserName: idr.UserName,
+		IdrFlags: idr.IDRFlags,
+		Msisdn:   data.MSISDN.Serialize(),
+	}
+	if data.AMBR != nil {
+		req.TotalAmbr = &protos.UpdateLocationAnswer_AggregatedMaximumBitrate{
+			MaxBandwidthUl: data.AMBR.MaxRequestedBandwidthUL,
+			MaxBandwidthDl: data.AMBR.MaxRequestedBandwidthDL,
+		}
+	}
+	if data.NetworkAccessMode != nil {
+		req.NetworkAccessMode = protos.UpdateLocationAnswer_NetworkAccessMode(*data.NetworkAccessMode)
+		req.NetworkAccessModePresent = true
+	}
+	if profile := data.APNConfigurationProfile; profile != nil {
+		req.DefaultContextId = profile.ContextIdentifier
+		req.AllApnsIncluded = profile.AllAPNConfigurationsIncludedIndicator == 0
+		req.Apn = convertAPNConfigs(profile.APNConfigs)
+	}
+	return req
+}
+
+func (s *s6aProxy) sendIDA(
+	c diam.Conn, m *diam.Message, res *protos.InsertSubscriberDataAnswer, idr *IDR, retries uint) error {
+
+	ans := newAnswer(m, res.ErrorCode)
+	// SessionID is required to be the AVP in position 1
+	ans.InsertAVP(diam.NewAVP(avp.SessionID, avp.Mbit, 0, datatype.UTF8String(idr.SessionID)))
+	ans.NewAVP(avp.AuthSessionState, avp.Mbit, 0, datatype.Enumerated(idr.AuthSessionState))
+	s.addDiamOriginAVPs(ans)
+	if res.IdaFlags != 0 {
+		ans.NewAVP(avp.IDAFlags, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Unsigned32(res.IdaFlags))
+	}
+	_, err := ans.WriteToWithRetry(c, retries)
+	return err
+}
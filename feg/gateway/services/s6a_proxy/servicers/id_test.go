@@ -0,0 +1,60 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package servicers
+
+import (
+	"testing"
+
+	"magma/feg/cloud/go/protos"
+	"magma/feg/gateway/diameter"
+
+	"github.com/fiorix/go-diameter/diam"
+	"github.com/fiorix/go-diameter/diam/avp"
+	"github.com/fiorix/go-diameter/diam/datatype"
+	"github.com/fiorix/go-diameter/diam/dict"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildInsertSubscriberDataRequest(t *testing.T) {
+	// Partial IDR only updating the MSISDN
+	req := unmarshalIDR(t, []*diam.AVP{
+		diam.NewAVP(avp.MSISDN, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.OctetString("12345")),
+	})
+	assert.Equal(t, "sub1", req.UserName)
+	assert.Equal(t, []byte("12345"), req.Msisdn)
+	assert.Nil(t, req.TotalAmbr)
+	assert.False(t, req.NetworkAccessModePresent)
+	assert.False(t, req.AllApnsIncluded)
+	assert.Empty(t, req.Apn)
+
+	// NAM PACKET_AND_CIRCUIT is the zero value, only the flag tells it's updated
+	req = unmarshalIDR(t, []*diam.AVP{
+		diam.NewAVP(avp.NetworkAccessMode, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Enumerated(0)),
+		diam.NewAVP(avp.AMBR, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, &diam.GroupedAVP{
+			AVP: []*diam.AVP{
+				diam.NewAVP(avp.MaxRequestedBandwidthUL, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Unsigned32(100)),
+				diam.NewAVP(avp.MaxRequestedBandwidthDL, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Unsigned32(200)),
+			},
+		}),
+	})
+	assert.Empty(t, req.Msisdn)
+	assert.Equal(t, &protos.UpdateLocationAnswer_AggregatedMaximumBitrate{MaxBandwidthUl: 100, MaxBandwidthDl: 200}, req.TotalAmbr)
+	assert.True(t, req.NetworkAccessModePresent)
+	assert.Equal(t, protos.UpdateLocationAnswer_PACKET_AND_CIRCUIT, req.NetworkAccessMode)
+	assert.Empty(t, req.Apn)
+}
+
+func unmarshalIDR(t *testing.T, subscriptionData []*diam.AVP) *protos.InsertSubscriberDataRequest {
+	m := diam.NewRequest(diam.InsertSubscriberData, diam.TGPP_S6A_APP_ID, dict.Default)
+	m.NewAVP(avp.UserName, avp.Mbit, 0, datatype.UTF8String("sub1"))
+	m.NewAVP(avp.SubscriptionData, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, &diam.GroupedAVP{AVP: subscriptionData})
+	var idr IDR
+	assert.NoError(t, m.Unmarshal(&idr))
+	return buildInsertSubscriberDataRequest(&idr)
+}
@@ -26,17 +26,14 @@ import (
 
 // sendPUR - sends PUR with given Session ID (sid)
 func (s *s6aProxy) sendPUR(sid string, req *protos.PurgeUERequest, retryCount uint) error {
-	c, err := s.connMan.GetConnection(s.smClient, s.serverCfg)
-	if err != nil {
-		return err
-	}
 	m := diameter.NewProxiableRequest(diam.PurgeUE, diam.TGPP_S6A_APP_ID, dict.Default)
 	m.NewAVP(avp.SessionID, avp.Mbit, 0, datatype.UTF8String(sid))
 	m.NewAVP(avp.AuthSessionState, avp.Mbit, 0, datatype.Enumerated(1))
 	s.addDiamOriginAVPs(m)
 	m.NewAVP(avp.UserName, avp.Mbit, 0, datatype.UTF8String(req.UserName))
 
-	err = c.SendRequest(m, retryCount)
+	s.serverGroup.AddDestinationRealmForIMSI(m, req.UserName)
+	err := s.connMan.SendRequestToGroup(s.smClient, s.serverGroup, "", sid, m, retryCount)
 	if err != nil {
 		err = Error(codes.DataLoss, err)
 	}
@@ -52,6 +49,7 @@ func handlePUA(s *s6aProxy) diam.HandlerFunc {
 			log.Printf("PUA Unmarshal failed for remote %s & message %s: %s", c.RemoteAddr(), m, err)
 			return
 		}
+		s.serverGroup.AnswerReceived(pua.SessionID)
 		ch := s.requestTracker.DeregisterRequest(pua.SessionID)
 		if ch != nil {
 			ch <- &pua
@@ -100,6 +98,7 @@ func (s *s6aProxy) PurgeUEImpl(req *protos.PurgeUERequest) (*protos.PurgeUEAnswe
 				err = Errorf(codes.Aborted, "PUR for Session ID: %s is canceled", sid)
 			}
 		case <-time.After(time.Second * TIMEOUT_SECONDS):
+			s.serverGroup.AnswerTimedOut(sid)
 			err = Errorf(codes.DeadlineExceeded, "PUR Timed Out for Session ID: %s", sid)
 		}
 	}
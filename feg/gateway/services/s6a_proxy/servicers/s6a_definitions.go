@@ -312,6 +312,104 @@ type RSR struct {
 	UserId                      []datatype.UTF8String       `avp:"User-Id"`
 }
 
+// IDR is Go representation of Insert-Subscriber-Data-Request message
+//
+// < Insert-Subscriber-Data-Request> ::= < Diameter Header: 319, REQ, PXY, 16777251 >
+//
+// < Session-Id >
+// [ DRMP ]
+// [ Vendor-Specific-Application-Id ]
+// { Auth-Session-State }
+// { Origin-Host }
+// { Origin-Realm }
+// { Destination-Host }
+// { Destination-Realm }
+// { User-Name }
+// *[ Supported-Features ]
+// { Subscription-Data }
+// [ IDR-Flags ]
+// *[ AVP ]
+// *[ Proxy-Info ]
+// *[ Route-Record ]
+type IDR struct {
+	SessionID                   string                      `avp:"Session-Id"`
+	VendorSpecificApplicationId VendorSpecificApplicationId `avp:"Vendor-Specific-Application-Id"`
+	AuthSessionState            int32                       `avp:"Auth-Session-State"`
+	OriginHost                  datatype.DiameterIdentity   `avp:"Origin-Host"`
+	OriginRealm                 datatype.DiameterIdentity   `avp:"Origin-Realm"`
+	DestinationHost             datatype.DiameterIdentity   `avp:"Destination-Host"`
+	DestinationRealm            datatype.DiameterIdentity   `avp:"Destination-Realm"`
+	UserName                    string                      `avp:"User-Name"`
+	SupportedFeatures           []SupportedFeatures         `avp:"Supported-Features"`
+	SubscriptionData            IDRSubscriptionData         `avp:"Subscription-Data"`
+	IDRFlags                    uint32                      `avp:"IDR-Flags"`
+}
+
+// IDRSubscriptionData is the Subscription-Data of an IDR, which only includes
+// the updated subscription data. The optional AVPs are nil if not updated.
+type IDRSubscriptionData struct {
+	MSISDN                  datatype.OctetString     `avp:"MSISDN"`
+	NetworkAccessMode       *int32                   `avp:"Network-Access-Mode"`
+	AMBR                    *AMBR                    `avp:"AMBR"`
+	APNConfigurationProfile *APNConfigurationProfile `avp:"APN-Configuration-Profile"`
+}
+
+// IDA is Go representation of Insert-Subscriber-Data-Answer message
+type IDA struct {
+	SessionID          string                    `avp:"Session-Id"`
+	ResultCode         uint32                    `avp:"Result-Code"`
+	ExperimentalResult ExperimentalResult        `avp:"Experimental-Result"`
+	AuthSessionState   int32                     `avp:"Auth-Session-State"`
+	OriginHost         datatype.DiameterIdentity `avp:"Origin-Host"`
+	OriginRealm        datatype.DiameterIdentity `avp:"Origin-Realm"`
+	IDAFlags           uint32                    `avp:"IDA-Flags"`
+}
+
+// DSR is Go representation of Delete-Subscriber-Data-Request message
+//
+// < Delete-Subscriber-Data-Request > ::= < Diameter Header: 320, REQ, PXY, 16777251 >
+//
+// < Session-Id >
+// [ DRMP ]
+// [ Vendor-Specific-Application-Id ]
+// { Auth-Session-State }
+// { Origin-Host }
+// { Origin-Realm }
+// { Destination-Host }
+// { Destination-Realm }
+// { User-Name }
+// *[ Supported-Features ]
+// { DSR-Flags }
+// *[ Context-Identifier ]
+// [ Trace-Reference ]
+// *[ AVP ]
+// *[ Proxy-Info ]
+// *[ Route-Record ]
+type DSR struct {
+	SessionID                   string                      `avp:"Session-Id"`
+	VendorSpecificApplicationId VendorSpecificApplicationId `avp:"Vendor-Specific-Application-Id"`
+	AuthSessionState            int32                       `avp:"Auth-Session-State"`
+	OriginHost                  datatype.DiameterIdentity   `avp:"Origin-Host"`
+	OriginRealm                 datatype.DiameterIdentity   `avp:"Origin-Realm"`
+	DestinationHost             datatype.DiameterIdentity   `avp:"Destination-Host"`
+	DestinationRealm            datatype.DiameterIdentity   `avp:"Destination-Realm"`
+	UserName                    string                      `avp:"User-Name"`
+	SupportedFeatures           []SupportedFeatures         `avp:"Supported-Features"`
+	DSRFlags                    uint32                      `avp:"DSR-Flags"`
+	ContextIdentifiers          []uint32                    `avp:"Context-Identifier"`
+}
+
+// DSA is Go representation of Delete-Subscriber-Data-Answer message
+type DSA struct {
+	SessionID          string                    `avp:"Session-Id"`
+	ResultCode         uint32                    `avp:"Result-Code"`
+	ExperimentalResult ExperimentalResult        `avp:"Experimental-Result"`
+	AuthSessionState   int32                     `avp:"Auth-Session-State"`
+	OriginHost         datatype.DiameterIdentity `avp:"Origin-Host"`
+	OriginRealm        datatype.DiameterIdentity `avp:"Origin-Realm"`
+	DSAFlags           uint32                    `avp:"DSA-Flags"`
+}
+
 // RequestedEUTRANAuthInfo contains the information needed for authentication requests
 // for E-UTRAN.
 type RequestedEUTRANAuthInfo struct {
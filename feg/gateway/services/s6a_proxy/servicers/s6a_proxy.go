@@ -37,6 +37,7 @@ const (
 type s6aProxy struct {
 	clientCfg      *diameter.DiameterClientConfig
 	serverCfg      *diameter.DiameterServerConfig
+	serverGroup    *diameter.ServerGroup
 	smClient       *sm.Client
 	connMan        *diameter.ConnectionManager
 	requestTracker *diameter.RequestTracker
@@ -96,12 +97,16 @@ func NewS6aProxy(
 	}
 
 	connMan := diameter.NewConnectionManager()
-	// create connection in connection map
-	connMan.GetConnection(smClient, serverCfg)
+	serverGroup := diameter.NewClientServerGroup(clientCfg, serverCfg)
+	// create connections in connection map
+	for _, server := range serverGroup.Servers() {
+		connMan.GetConnection(smClient, server)
+	}
 
 	proxy := &s6aProxy{
 		clientCfg:      clientCfg,
 		serverCfg:      serverCfg,
+		serverGroup:    serverGroup,
 		smClient:       smClient,
 		connMan:        connMan,
 		requestTracker: diameter.NewRequestTracker(),
@@ -127,6 +132,14 @@ func NewS6aProxy(
 		diam.CommandIndex{AppID: diam.TGPP_S6A_APP_ID, Code: diam.Reset, Request: true},
 		handleRSR(proxy))
 
+	mux.HandleIdx(
+		diam.CommandIndex{AppID: diam.TGPP_S6A_APP_ID, Code: diam.InsertSubscriberData, Request: true},
+		handleIDR(proxy))
+
+	mux.HandleIdx(
+		diam.CommandIndex{AppID: diam.TGPP_S6A_APP_ID, Code: diam.DeleteSubscriberData, Request: true},
+		handleDSR(proxy))
+
 	return proxy, nil
 }
 
@@ -11,10 +11,16 @@ LICENSE file in the root directory of this source tree.
 package servicers
 
 import (
-	"github.com/fiorix/go-diameter/diam/avp"
-	"github.com/fiorix/go-diameter/diam/datatype"
+	"time"
+
+	"magma/feg/cloud/go/protos"
+	"magma/feg/gateway/diameter"
 
 	"github.com/fiorix/go-diameter/diam"
+	"github.com/fiorix/go-diameter/diam/avp"
+	"github.com/fiorix/go-diameter/diam/datatype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func (s *s6aProxy) addDiamOriginAVPs(m *diam.Message) {
@@ -24,3 +30,60 @@ func (s *s6aProxy) addDiamOriginAVPs(m *diam.Message) {
 		m.NewAVP(avp.OriginStateID, avp.Mbit, 0, datatype.Unsigned32(s.originStateID))
 	}
 }
+
+// newAnswer creates an answer for the given request with either a Result-Code or,
+// for 3GPP specific failures (29.272 7.4.3 & 7.4.4), an Experimental-Result AVP
+func newAnswer(m *diam.Message, code protos.ErrorCode) *diam.Message {
+	switch code {
+	case protos.ErrorCode_UNDEFINED:
+		return m.Answer(diam.Success)
+	case protos.ErrorCode_USER_UNKNOWN,
+		protos.ErrorCode_UNKNOWN_EPS_SUBSCRIPTION,
+		protos.ErrorCode_RAT_NOT_ALLOWED,
+		protos.ErrorCode_ROAMING_NOT_ALLOWED,
+		protos.ErrorCode_EQUIPMENT_UNKNOWN,
+		protos.ErrorCode_UNKOWN_SERVING_NODE,
+		protos.ErrorCode_AUTHENTICATION_DATA_UNAVAILABLE:
+		ans := diam.NewMessage(
+			m.Header.CommandCode,
+			m.Header.CommandFlags&^diam.RequestFlag, // Reset the Request bit.
+			m.Header.ApplicationID,
+			m.Header.HopByHopID,
+			m.Header.EndToEndID,
+			m.Dictionary(),
+		)
+		ans.NewAVP(avp.ExperimentalResult, avp.Mbit, 0, &diam.GroupedAVP{
+			AVP: []*diam.AVP{
+				diam.NewAVP(avp.VendorID, avp.Mbit, 0, datatype.Unsigned32(diameter.Vendor3GPP)),
+				diam.NewAVP(avp.ExperimentalResultCode, avp.Mbit, 0, datatype.Unsigned32(code)),
+			},
+		})
+		return ans
+	default:
+		return m.Answer(uint32(code))
+	}
+}
+
+// SyncRPCRetryBackoff is the delay before the first retry of a request
+// forwarded to the gateway, it's doubled on every further retry
+const SyncRPCRetryBackoff = 250 * time.Millisecond
+
+// isRetryableSyncRPCError returns whether forwarding a request to the gateway
+// failed transiently, other errors (e.g. an unknown subscriber or a gateway
+// which doesn't implement the RPC) would fail again on retry
+func isRetryableSyncRPCError(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return true
+	default:
+		return false
+	}
+}
+
+// waitSyncRPCRetry waits before the next retry of a request forwarded to the
+// gateway, it returns immediately when no retries are left
+func waitSyncRPCRetry(retriesLeft int) {
+	if retriesLeft > 0 {
+		time.Sleep(SyncRPCRetryBackoff << uint(MaxSyncRPCRetries-retriesLeft))
+	}
+}
@@ -0,0 +1,87 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+// Package session_proxy provides a thin client for using session proxy service.
+// This can be used by apps to discover and contact the service, without knowing about
+// the RPC implementation.
+package session_proxy
+
+import (
+	"errors"
+	"fmt"
+
+	"magma/feg/gateway/registry"
+	"magma/lte/cloud/go/protos"
+
+	"github.com/golang/glog"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+// Wrapper for GRPC Client
+// functionality
+type sessionProxyClient struct {
+	protos.CentralSessionControllerClient
+	cc *grpc.ClientConn
+}
+
+// getSessionProxyClient is a utility function to get a RPC connection to the
+// Session Proxy service
+func getSessionProxyClient() (*sessionProxyClient, error) {
+	conn, err := registry.GetConnection(registry.SESSION_PROXY)
+	if err != nil {
+		errMsg := fmt.Sprintf("Session Proxy client initialization error: %s", err)
+		glog.Error(errMsg)
+		return nil, errors.New(errMsg)
+	}
+	return &sessionProxyClient{
+		protos.NewCentralSessionControllerClient(conn),
+		conn,
+	}, err
+}
+
+// CreateSession sends CCR-I on Gx (and Gy if the session has charging keys),
+// waits (blocks) for the answers & returns their RPC representation
+func CreateSession(ctx context.Context, req *protos.CreateSessionRequest) (*protos.CreateSessionResponse, error) {
+	if req == nil || req.GetSubscriber() == nil {
+		return nil, errors.New("Invalid CreateSessionRequest: missing subscriber")
+	}
+	cli, err := getSessionProxyClient()
+	if err != nil {
+		return nil, err
+	}
+	return cli.CreateSession(ctx, req)
+}
+
+// UpdateSession sends CCR-U for the reported usage, waits (blocks) for the answers
+// & returns their RPC representation
+func UpdateSession(ctx context.Context, req *protos.UpdateSessionRequest) (*protos.UpdateSessionResponse, error) {
+	if req == nil {
+		return nil, errors.New("Nil UpdateSessionRequest")
+	}
+	cli, err := getSessionProxyClient()
+	if err != nil {
+		return nil, err
+	}
+	return cli.UpdateSession(ctx, req)
+}
+
+// TerminateSession sends CCR-T on Gx & Gy, waits (blocks) for the answers
+// & returns their RPC representation
+func TerminateSession(
+	ctx context.Context, req *protos.SessionTerminateRequest) (*protos.SessionTerminateResponse, error) {
+
+	if req == nil || len(req.GetSid()) == 0 {
+		return nil, errors.New("Invalid SessionTerminateRequest: missing SID")
+	}
+	cli, err := getSessionProxyClient()
+	if err != nil {
+		return nil, err
+	}
+	return cli.TerminateSession(ctx, req)
+}
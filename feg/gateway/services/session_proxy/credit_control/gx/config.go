@@ -96,6 +96,10 @@ func GetGxClientConfiguration() *diameter.DiameterClientConfig {
 		AppID:            diam.GX_CHARGING_CONTROL_APP_ID,
 		WatchdogInterval: diameter.DefaultWatchdogIntervalSeconds,
 		RetryCount:       uint(retries),
+		PeerSelection:    diameter.PeerSelection(gxCfg.GetPeerSelection()),
+		AlternateServers: diameter.GetAlternateServerConfigs(gxCfg),
+		RealmRoutes:      diameter.GetRealmRoutes(gxCfg),
+		SessionFailover:  gxCfg.GetSessionFailover(),
 	}
 }
 
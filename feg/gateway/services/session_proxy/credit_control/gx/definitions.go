@@ -23,9 +23,41 @@ const (
 
 type EventTrigger uint32
 
+// 3GPP 29.212 Section 5.3.7
 const (
+	SGSNChangeTrigger                      EventTrigger = 0
+	QoSChangeTrigger                       EventTrigger = 1
+	RATChangeTrigger                       EventTrigger = 2
+	TFTChangeTrigger                       EventTrigger = 3
+	PLMNChangeTrigger                      EventTrigger = 4
+	LossOfBearerTrigger                    EventTrigger = 5
+	RecoveryOfBearerTrigger                EventTrigger = 6
+	IPCANChangeTrigger                     EventTrigger = 7
+	QoSChangeExceedingAuthorizationTrigger EventTrigger = 11
+	RAIChangeTrigger                       EventTrigger = 12
+	UserLocationChangeTrigger              EventTrigger = 13
+	NoEventTriggers                        EventTrigger = 14
+	OutOfCreditTrigger                     EventTrigger = 15
+	ReallocationOfCreditTrigger            EventTrigger = 16
+	RevalidationTimeoutTrigger             EventTrigger = 17
+	UEIPAddressAllocateTrigger             EventTrigger = 18
+	UEIPAddressReleaseTrigger              EventTrigger = 19
+	DefaultEPSBearerQoSChangeTrigger       EventTrigger = 20
+	ANGWChangeTrigger                      EventTrigger = 21
+	SuccessfulResourceAllocationTrigger    EventTrigger = 22
+	ResourceModificationRequestTrigger     EventTrigger = 23
+	PGWTraceControlTrigger                 EventTrigger = 24
+	UETimeZoneChangeTrigger                EventTrigger = 25
+	TAIChangeTrigger                       EventTrigger = 26
+	ECGIChangeTrigger                      EventTrigger = 27
+	ChargingCorrelationExchangeTrigger     EventTrigger = 28
+	APNAMBRModificationFailureTrigger      EventTrigger = 29
+	UserCSGInformationChangeTrigger        EventTrigger = 30
 	// USAGE_REPORT
-	UsageReportTrigger       EventTrigger = 33
+	UsageReportTrigger EventTrigger = 33
+	// USAGE_REPORT reported to 29.212 release 9.1 compliant PCRFs, it's the
+	// value of TAI_CHANGE in later releases so TAI changes can't be reported to
+	// them. Event triggers received from PCRFs are never translated.
 	PCRF91UsageReportTrigger EventTrigger = 26
 )
 
@@ -45,6 +77,7 @@ type CreditControlRequest struct {
 	GcID          string
 	Qos           *QosRequestInfo
 	UsageReports  []*UsageReport
+	EventTriggers []EventTrigger // events to report in CCR-U in addition to usage
 }
 
 type QosRequestInfo struct {
@@ -64,6 +97,8 @@ type CreditControlAnswer struct {
 	RequestNumber          uint32
 	RuleInstallAVP         []*RuleInstallAVP
 	UsageMonitors          []*UsageMonitoringInfo
+	EventTriggers          []EventTrigger
+	RevalidationTime       *time.Time
 }
 
 type UsageReport struct {
@@ -144,9 +179,11 @@ type CCADiameterMessage struct {
 		VendorId               uint32 `avp:"Vendor-Id"`
 		ExperimentalResultCode uint32 `avp:"Experimental-Result-Code"`
 	} `avp:"Experimental-Result"`
-	RequestType   uint32                 `avp:"CC-Request-Type"`
-	RuleInstalls  []*RuleInstallAVP      `avp:"Charging-Rule-Install"`
-	UsageMonitors []*UsageMonitoringInfo `avp:"Usage-Monitoring-Information"`
+	RequestType      uint32                 `avp:"CC-Request-Type"`
+	RuleInstalls     []*RuleInstallAVP      `avp:"Charging-Rule-Install"`
+	UsageMonitors    []*UsageMonitoringInfo `avp:"Usage-Monitoring-Information"`
+	EventTriggers    []EventTrigger         `avp:"Event-Trigger"`
+	RevalidationTime *time.Time             `avp:"Revalidation-Time"`
 }
 
 //<RA-Request> ::= 	< Diameter Header: 258, REQ, PXY >
@@ -42,7 +42,8 @@ type PolicyClient interface {
 // allowed AVPs, and purposes are different
 type GxClient struct {
 	diamClient      *diameter.Client
-	pcrf91Compliant bool // to support PCRF which is 29.212 release 9.1 compliant
+	serverGroup     *diameter.ServerGroup // nil if requests are sent to the given server only
+	pcrf91Compliant bool                  // to support PCRF which is 29.212 release 9.1 compliant
 }
 
 // NewConnectedGxClient contructs a new GxClient with the magma diameter settings
@@ -50,12 +51,14 @@ func NewConnectedGxClient(
 	diamClient *diameter.Client,
 	reAuthHandler ReAuthHandler,
 ) *GxClient {
-	diamClient.RegisterAnswerHandlerForAppID(diam.CreditControl, diam.GX_CHARGING_CONTROL_APP_ID, ccaHandler)
-	registerReAuthHandler(reAuthHandler, diamClient)
-
-	return &GxClient{
+	gxClient := &GxClient{
 		diamClient:      diamClient,
 		pcrf91Compliant: *pcrf91Compliant || isThruthy(os.Getenv(PCRF91CompliantEnv))}
+
+	diamClient.RegisterAnswerHandlerForAppID(
+		diam.CreditControl, diam.GX_CHARGING_CONTROL_APP_ID, getCCAHandler())
+	registerReAuthHandler(reAuthHandler, diamClient)
+	return gxClient
 }
 
 func isThruthy(value string) bool {
@@ -77,10 +80,13 @@ func NewGxClient(
 	reAuthHandler ReAuthHandler,
 ) *GxClient {
 	diamClient := diameter.NewClient(clientCfg)
-	for _, server := range servers {
+	serverGroup := diameter.NewClientServerGroup(clientCfg, servers...)
+	for _, server := range serverGroup.Servers() {
 		diamClient.BeginConnection(server)
 	}
-	return NewConnectedGxClient(diamClient, reAuthHandler)
+	gxClient := NewConnectedGxClient(diamClient, reAuthHandler)
+	gxClient.serverGroup = serverGroup
+	return gxClient
 }
 
 // SendCreditControlRequest sends a Gx Credit Control Requests to the
@@ -106,8 +112,16 @@ func (gxClient *GxClient) SendCreditControlRequest(
 	}
 
 	glog.V(2).Infof("Sending Gx CCR message\n%s\n", message)
+	gxClient.serverGroup.AddDestinationRealmForIMSI(message, request.IMSI)
 	key := credit_control.GetRequestKey(credit_control.Gx, request.SessionID, request.RequestNumber)
-	return gxClient.diamClient.SendRequest(server, done, message, key)
+	if gxClient.serverGroup == nil {
+		return gxClient.diamClient.SendRequest(server, done, message, key)
+	}
+	err = gxClient.diamClient.SendRequestToGroup(gxClient.serverGroup, request.SessionID, done, message, key)
+	if request.Type == credit_control.CRTTerminate {
+		gxClient.serverGroup.ReleaseSession(request.SessionID)
+	}
+	return err
 }
 
 // GetAnswer returns a *CreditControlAnswer from the given interface channel
@@ -286,30 +300,10 @@ func (gxClient *GxClient) getInitAvps(m *diam.Message, request *CreditControlReq
 			},
 		})
 
-		var arpAVP *diam.AVP
-		if gxClient.pcrf91Compliant {
-			// PCRF is 29.212 release 9.1 compliant
-			arpAVP = diam.NewAVP(avp.AllocationRetentionPriority, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, &diam.GroupedAVP{
-				AVP: []*diam.AVP{
-					diam.NewAVP(avp.PriorityLevel, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Unsigned32(request.Qos.PriLevel)),
-					diam.NewAVP(avp.PreemptionCapability, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Enumerated(request.Qos.PreCapability)),
-					diam.NewAVP(avp.PreemptionVulnerability, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Enumerated(request.Qos.PreVulnerability)),
-				},
-			})
-		} else {
-			// PCRF is NOT 29.212 release 9.1 compliant
-			arpAVP = diam.NewAVP(avp.AllocationRetentionPriority, avp.Vbit, diameter.Vendor3GPP, &diam.GroupedAVP{
-				AVP: []*diam.AVP{
-					diam.NewAVP(avp.PriorityLevel, avp.Vbit, diameter.Vendor3GPP, datatype.Unsigned32(request.Qos.PriLevel)),
-					diam.NewAVP(avp.PreemptionCapability, avp.Vbit, diameter.Vendor3GPP, datatype.Enumerated(request.Qos.PreCapability)),
-					diam.NewAVP(avp.PreemptionVulnerability, avp.Vbit, diameter.Vendor3GPP, datatype.Enumerated(request.Qos.PreVulnerability)),
-				},
-			})
-		}
 		m.NewAVP(avp.DefaultEPSBearerQoS, avp.Vbit, diameter.Vendor3GPP, &diam.GroupedAVP{
 			AVP: []*diam.AVP{
 				diam.NewAVP(avp.QoSClassIdentifier, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Enumerated(request.Qos.QosClassIdentifier)),
-				arpAVP,
+				gxClient.getARPAVP(request.Qos),
 			},
 		})
 	}
@@ -318,17 +312,32 @@ func (gxClient *GxClient) getInitAvps(m *diam.Message, request *CreditControlReq
 }
 
 // getAdditionalAvps retrieves any extra AVPs based on the type of request.
-// For update and terminate, it returns the used credit AVPs
+// For update and terminate, it returns the used credit AVPs, for update it
+// also returns the reported event triggers & the changed session information
 func (gxClient *GxClient) getAdditionalAvps(request *CreditControlRequest) ([]*diam.AVP, error) {
-	if request.Type == credit_control.CRTInit || len(request.UsageReports) == 0 {
+	if request.Type == credit_control.CRTInit ||
+		(len(request.UsageReports) == 0 && len(request.EventTriggers) == 0) {
 		return []*diam.AVP{}, nil
 	}
-	avpList := make([]*diam.AVP, 0, len(request.UsageReports)+1)
+	avpList := make([]*diam.AVP, 0, len(request.UsageReports)+len(request.EventTriggers)+1)
 	for _, usage := range request.UsageReports {
 		avpList = append(avpList, getUsageMonitoringAVP(usage))
 	}
 	if request.Type == credit_control.CRTUpdate {
-		avpList = append(avpList, gxClient.getUsageReportEventTrigger())
+		if len(request.UsageReports) > 0 {
+			avpList = append(avpList, gxClient.getEventTriggerAVP(UsageReportTrigger))
+		}
+		for _, trigger := range request.EventTriggers {
+			if trigger == UsageReportTrigger && len(request.UsageReports) > 0 {
+				continue // already added
+			}
+			if trigger == TAIChangeTrigger && gxClient.pcrf91Compliant {
+				glog.V(2).Infof("Not reporting TAI change of session %s to 29.212 release 9.1 PCRF", request.SessionID)
+				continue
+			}
+			avpList = append(avpList, gxClient.getEventTriggerAVP(trigger))
+		}
+		avpList = append(avpList, gxClient.getEventInfoAVPs(request)...)
 	}
 
 	return avpList, nil
@@ -350,10 +359,56 @@ func getUsageMonitoringAVP(usage *UsageReport) *diam.AVP {
 	})
 }
 
-func (gxClient *GxClient) getUsageReportEventTrigger() *diam.AVP {
-	var urt = UsageReportTrigger
+func (gxClient *GxClient) getEventTriggerAVP(trigger EventTrigger) *diam.AVP {
+	if trigger == UsageReportTrigger && gxClient.pcrf91Compliant {
+		trigger = PCRF91UsageReportTrigger
+	}
+	return diam.NewAVP(avp.EventTrigger, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Enumerated(trigger))
+}
+
+// getEventInfoAVPs returns the current location, PLMN & QoS of the session
+// to be reported along with the event triggers in CCR-U
+func (gxClient *GxClient) getEventInfoAVPs(request *CreditControlRequest) []*diam.AVP {
+	var avpList []*diam.AVP
+	if len(request.UserLocation) > 0 {
+		avpList = append(avpList, diam.NewAVP(
+			avp.TGPPUserLocationInfo, avp.Vbit, diameter.Vendor3GPP, datatype.OctetString(string(request.UserLocation))))
+	}
+	if len(request.PlmnID) > 0 {
+		avpList = append(avpList, diam.NewAVP(
+			avp.TGPPSGSNMCCMNC, avp.Vbit, diameter.Vendor3GPP, datatype.UTF8String(request.PlmnID)))
+	}
+	if request.Qos != nil {
+		avpList = append(avpList, diam.NewAVP(avp.QoSInformation, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, &diam.GroupedAVP{
+			AVP: []*diam.AVP{
+				diam.NewAVP(avp.QoSClassIdentifier, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Enumerated(request.Qos.QosClassIdentifier)),
+				gxClient.getARPAVP(request.Qos),
+				diam.NewAVP(avp.APNAggregateMaxBitrateDL, avp.Vbit, diameter.Vendor3GPP, datatype.Unsigned32(request.Qos.ApnAggMaxBitRateDL)),
+				diam.NewAVP(avp.APNAggregateMaxBitrateUL, avp.Vbit, diameter.Vendor3GPP, datatype.Unsigned32(request.Qos.ApnAggMaxBitRateUL)),
+			},
+		}))
+	}
+	return avpList
+}
+
+// getARPAVP returns the Allocation-Retention-Priority AVP of the QoS
+func (gxClient *GxClient) getARPAVP(qos *QosRequestInfo) *diam.AVP {
 	if gxClient.pcrf91Compliant {
-		urt = PCRF91UsageReportTrigger
+		// PCRF is 29.212 release 9.1 compliant
+		return diam.NewAVP(avp.AllocationRetentionPriority, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, &diam.GroupedAVP{
+			AVP: []*diam.AVP{
+				diam.NewAVP(avp.PriorityLevel, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Unsigned32(qos.PriLevel)),
+				diam.NewAVP(avp.PreemptionCapability, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Enumerated(qos.PreCapability)),
+				diam.NewAVP(avp.PreemptionVulnerability, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Enumerated(qos.PreVulnerability)),
+			},
+		})
 	}
-	return diam.NewAVP(avp.EventTrigger, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Enumerated(urt))
+	// PCRF is NOT 29.212 release 9.1 compliant
+	return diam.NewAVP(avp.AllocationRetentionPriority, avp.Vbit, diameter.Vendor3GPP, &diam.GroupedAVP{
+		AVP: []*diam.AVP{
+			diam.NewAVP(avp.PriorityLevel, avp.Vbit, diameter.Vendor3GPP, datatype.Unsigned32(qos.PriLevel)),
+			diam.NewAVP(avp.PreemptionCapability, avp.Vbit, diameter.Vendor3GPP, datatype.Enumerated(qos.PreCapability)),
+			diam.NewAVP(avp.PreemptionVulnerability, avp.Vbit, diameter.Vendor3GPP, datatype.Enumerated(qos.PreVulnerability)),
+		},
+	})
 }
@@ -11,6 +11,7 @@ package gx_test
 
 import (
 	"log"
+	"os"
 	"testing"
 	"time"
 
@@ -22,6 +23,9 @@ import (
 	"magma/lte/cloud/go/protos"
 
 	"github.com/fiorix/go-diameter/diam"
+	"github.com/fiorix/go-diameter/diam/avp"
+	"github.com/fiorix/go-diameter/diam/datatype"
+	"github.com/fiorix/go-diameter/diam/sm"
 	"github.com/stretchr/testify/assert"
 	"golang.org/x/net/context"
 )
@@ -248,6 +252,149 @@ func TestGxClientUsageMonitoring(t *testing.T) {
 	}
 }
 
+// TestGxClientEventTriggers tests the event triggers & revalidation time of
+// CCAs and the event triggers & session information of CCR-Us on the wire
+func TestGxClientEventTriggers(t *testing.T) {
+	clientConfig := getClientConfig()
+	revalidationTime := time.Unix(1600000000, 0).UTC()
+	serverConfig, requests := startRecordingPCRF(t, clientConfig, []gx.EventTrigger{
+		gx.RevalidationTimeoutTrigger, gx.TAIChangeTrigger, gx.UsageReportTrigger,
+	}, revalidationTime)
+	gxClient := gx.NewGxClient(clientConfig, []*diameter.DiameterServerConfig{serverConfig}, getMockReAuthHandler())
+	done := make(chan interface{}, 10)
+
+	init := &gx.CreditControlRequest{
+		SessionID:     "1",
+		Type:          credit_control.CRTInit,
+		IMSI:          testIMSI1,
+		RequestNumber: 1,
+		IPAddr:        "192.168.1.1",
+	}
+	assert.NoError(t, gxClient.SendCreditControlRequest(serverConfig, done, init))
+	answer := gx.GetAnswer(done)
+	assert.Equal(t, []gx.EventTrigger{gx.RevalidationTimeoutTrigger, gx.TAIChangeTrigger, gx.UsageReportTrigger}, answer.EventTriggers)
+	if assert.NotNil(t, answer.RevalidationTime) {
+		assert.True(t, revalidationTime.Equal(*answer.RevalidationTime))
+	}
+	<-requests
+
+	qos := &gx.QosRequestInfo{
+		ApnAggMaxBitRateUL: 1000,
+		ApnAggMaxBitRateDL: 2000,
+		QosClassIdentifier: 9,
+		PriLevel:           15,
+		PreCapability:      1,
+		PreVulnerability:   0,
+	}
+	update := &gx.CreditControlRequest{
+		SessionID:     "1",
+		Type:          credit_control.CRTUpdate,
+		IMSI:          testIMSI1,
+		RequestNumber: 2,
+		IPAddr:        "192.168.1.1",
+		PlmnID:        "00101",
+		Qos:           qos,
+		EventTriggers: []gx.EventTrigger{gx.TAIChangeTrigger, gx.DefaultEPSBearerQoSChangeTrigger},
+		UsageReports:  []*gx.UsageReport{{MonitoringKey: "mkey", Level: gx.SessionLevel, TotalOctets: 10}},
+	}
+	assert.NoError(t, gxClient.SendCreditControlRequest(serverConfig, done, update))
+	gx.GetAnswer(done)
+	ccr := <-requests
+	assert.ElementsMatch(t, []gx.EventTrigger{gx.UsageReportTrigger, gx.TAIChangeTrigger, gx.DefaultEPSBearerQoSChangeTrigger}, ccr.EventTriggers)
+	assert.Equal(t, "00101", ccr.PlmnID)
+	if assert.NotNil(t, ccr.QosInfo) {
+		assert.Equal(t, int32(9), ccr.QosInfo.Qci)
+		assert.Equal(t, uint32(15), ccr.QosInfo.ARP.PriorityLevel)
+		assert.Equal(t, int32(1), ccr.QosInfo.ARP.PreemptionCapability)
+		assert.Equal(t, int32(0), ccr.QosInfo.ARP.PreemptionVulnerability)
+		assert.Equal(t, uint32(1000), ccr.QosInfo.ApnAggMaxBitRateUL)
+		assert.Equal(t, uint32(2000), ccr.QosInfo.ApnAggMaxBitRateDL)
+	}
+
+	// 29.212 release 9.1 PCRFs get their USAGE_REPORT value & no TAI changes,
+	// their CCAs' event triggers are not translated
+	os.Setenv(gx.PCRF91CompliantEnv, "1")
+	defer os.Unsetenv(gx.PCRF91CompliantEnv)
+	gxClient91 := gx.NewGxClient(clientConfig, []*diameter.DiameterServerConfig{serverConfig}, getMockReAuthHandler())
+	init.SessionID, update.SessionID = "2", "2"
+	assert.NoError(t, gxClient91.SendCreditControlRequest(serverConfig, done, init))
+	answer = gx.GetAnswer(done)
+	assert.Equal(t, []gx.EventTrigger{gx.RevalidationTimeoutTrigger, gx.TAIChangeTrigger, gx.UsageReportTrigger}, answer.EventTriggers)
+	<-requests
+	assert.NoError(t, gxClient91.SendCreditControlRequest(serverConfig, done, update))
+	gx.GetAnswer(done)
+	ccr = <-requests
+	assert.ElementsMatch(t, []gx.EventTrigger{gx.PCRF91UsageReportTrigger, gx.DefaultEPSBearerQoSChangeTrigger}, ccr.EventTriggers)
+}
+
+type recordedCCR struct {
+	SessionID     string                    `avp:"Session-Id"`
+	RequestType   uint32                    `avp:"CC-Request-Type"`
+	RequestNumber uint32                    `avp:"CC-Request-Number"`
+	OriginHost    datatype.DiameterIdentity `avp:"Origin-Host"`
+	OriginRealm   datatype.DiameterIdentity `avp:"Origin-Realm"`
+	EventTriggers []gx.EventTrigger         `avp:"Event-Trigger"`
+	PlmnID        string                    `avp:"TGPP-SGSN-MCC-MNC"`
+	QosInfo       *struct {
+		Qci int32 `avp:"QoS-Class-Identifier"`
+		ARP struct {
+			PriorityLevel           uint32 `avp:"Priority-Level"`
+			PreemptionCapability    int32  `avp:"Pre-emption-Capability"`
+			PreemptionVulnerability int32  `avp:"Pre-emption-Vulnerability"`
+		} `avp:"Allocation-Retention-Priority"`
+		ApnAggMaxBitRateUL uint32 `avp:"APN-Aggregate-Max-Bitrate-UL"`
+		ApnAggMaxBitRateDL uint32 `avp:"APN-Aggregate-Max-Bitrate-DL"`
+	} `avp:"QoS-Information"`
+}
+
+// startRecordingPCRF starts a PCRF which records the received CCRs & answers
+// them with the event triggers & revalidation time
+func startRecordingPCRF(
+	t *testing.T,
+	client *diameter.DiameterClientConfig,
+	eventTriggers []gx.EventTrigger,
+	revalidationTime time.Time,
+) (*diameter.DiameterServerConfig, <-chan *recordedCCR) {
+	requests := make(chan *recordedCCR, 10)
+	mux := sm.New(&sm.Settings{
+		OriginHost:       datatype.DiameterIdentity(client.Host),
+		OriginRealm:      datatype.DiameterIdentity(client.Realm),
+		VendorID:         datatype.Unsigned32(diameter.Vendor3GPP),
+		ProductName:      datatype.UTF8String(client.ProductName),
+		OriginStateID:    datatype.Unsigned32(time.Now().Unix()),
+		FirmwareRevision: 1,
+	})
+	mux.HandleIdx(
+		diam.CommandIndex{AppID: diam.GX_CHARGING_CONTROL_APP_ID, Code: diam.CreditControl, Request: true},
+		diam.HandlerFunc(func(c diam.Conn, m *diam.Message) {
+			ccr := &recordedCCR{}
+			assert.NoError(t, m.Unmarshal(ccr))
+			requests <- ccr
+			a := m.Answer(diam.Success)
+			a.NewAVP(avp.SessionID, avp.Mbit, 0, datatype.UTF8String(ccr.SessionID))
+			a.NewAVP(avp.OriginHost, avp.Mbit, 0, ccr.OriginHost)
+			a.NewAVP(avp.OriginRealm, avp.Mbit, 0, ccr.OriginRealm)
+			a.NewAVP(avp.CCRequestType, avp.Mbit, 0, datatype.Enumerated(ccr.RequestType))
+			a.NewAVP(avp.CCRequestNumber, avp.Mbit, 0, datatype.Unsigned32(ccr.RequestNumber))
+			for _, trigger := range eventTriggers {
+				a.NewAVP(avp.EventTrigger, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Enumerated(trigger))
+			}
+			a.NewAVP(avp.RevalidationTime, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Time(revalidationTime))
+			_, err := a.WriteTo(c)
+			assert.NoError(t, err)
+		}))
+	lis, err := diam.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := &diam.Server{Network: "tcp", Addr: lis.Addr().String(), Handler: mux}
+	go server.Serve(lis)
+	return &diameter.DiameterServerConfig{DiameterServerConnConfig: diameter.DiameterServerConnConfig{
+		Addr:     lis.Addr().String(),
+		Protocol: "tcp"},
+	}, requests
+}
+
 func getClientConfig() *diameter.DiameterClientConfig {
 	return &diameter.DiameterClientConfig{
 		Host:        "test.test.com",
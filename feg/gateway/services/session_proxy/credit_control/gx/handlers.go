@@ -22,27 +22,31 @@ import (
 	"magma/feg/gateway/services/session_proxy/relay"
 )
 
-// ccaHandler parses a CCADiameterMessage received over Gx and returns the
-// `KeyAndAnswer` packed inside the CCA message.
-func ccaHandler(message *diam.Message) diameter.KeyAndAnswer {
-	var cca CCADiameterMessage
-	glog.V(2).Infof("Received Gx CCA message:\n%s\n", message)
-	if err := message.Unmarshal(&cca); err != nil {
-		metrics.GxUnparseableMsg.Inc()
-		glog.Errorf("Received unparseable CCA over Gx")
-		return diameter.KeyAndAnswer{}
-	}
-	sid := diameter.DecodeSessionID(cca.SessionID)
-	return diameter.KeyAndAnswer{
-		Key: credit_control.GetRequestKey(credit_control.Gx, sid, cca.RequestNumber),
-		Answer: &CreditControlAnswer{
-			ResultCode:             cca.ResultCode,
-			ExperimentalResultCode: cca.ExperimentalResult.ExperimentalResultCode,
-			SessionID:              sid,
-			RequestNumber:          cca.RequestNumber,
-			RuleInstallAVP:         cca.RuleInstalls,
-			UsageMonitors:          cca.UsageMonitors[:],
-		},
+// getCCAHandler returns a handler which parses a CCADiameterMessage received
+// over Gx and returns the `KeyAndAnswer` packed inside the CCA message.
+func getCCAHandler() diameter.AnswerHandler {
+	return func(message *diam.Message) diameter.KeyAndAnswer {
+		var cca CCADiameterMessage
+		glog.V(2).Infof("Received Gx CCA message:\n%s\n", message)
+		if err := message.Unmarshal(&cca); err != nil {
+			metrics.GxUnparseableMsg.Inc()
+			glog.Errorf("Received unparseable CCA over Gx")
+			return diameter.KeyAndAnswer{}
+		}
+		sid := diameter.DecodeSessionID(cca.SessionID)
+		return diameter.KeyAndAnswer{
+			Key: credit_control.GetRequestKey(credit_control.Gx, sid, cca.RequestNumber),
+			Answer: &CreditControlAnswer{
+				ResultCode:             cca.ResultCode,
+				ExperimentalResultCode: cca.ExperimentalResult.ExperimentalResultCode,
+				SessionID:              sid,
+				RequestNumber:          cca.RequestNumber,
+				RuleInstallAVP:         cca.RuleInstalls,
+				UsageMonitors:          cca.UsageMonitors[:],
+				EventTriggers:          cca.EventTriggers,
+				RevalidationTime:       cca.RevalidationTime,
+			},
+		}
 	}
 }
 
@@ -131,6 +131,10 @@ func GetGyClientConfiguration() *diameter.DiameterClientConfig {
 		AppID:            diam.CHARGING_CONTROL_APP_ID,
 		WatchdogInterval: diameter.DefaultWatchdogIntervalSeconds,
 		RetryCount:       uint(retries),
+		PeerSelection:    diameter.PeerSelection(gyCfg.GetPeerSelection()),
+		AlternateServers: diameter.GetAlternateServerConfigs(gyCfg),
+		RealmRoutes:      diameter.GetRealmRoutes(gyCfg),
+		SessionFailover:  gyCfg.GetSessionFailover(),
 	}
 }
 
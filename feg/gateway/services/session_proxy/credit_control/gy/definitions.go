@@ -70,13 +70,24 @@ type QosRequestInfo struct {
 	ApnAggMaxBitRateDL uint32
 }
 
+type RedirectAddressType uint8
+
+const (
+	IPV4Address RedirectAddressType = 0x0
+	IPV6Address RedirectAddressType = 0x1
+	URL         RedirectAddressType = 0x2
+	SIPURI      RedirectAddressType = 0x3
+)
+
 type ReceivedCredits struct {
-	ResultCode   uint32
-	RatingGroup  uint32
-	GrantedUnits *credit_control.GrantedServiceUnit
-	ValidityTime uint32
-	IsFinal      bool
-	FinalAction  FinalUnitAction // unused if IsFinal is false
+	ResultCode     uint32
+	RatingGroup    uint32
+	GrantedUnits   *credit_control.GrantedServiceUnit
+	ValidityTime   uint32
+	IsFinal        bool
+	FinalAction    FinalUnitAction // unused if IsFinal is false
+	RedirectServer *RedirectServer // set only if FinalAction is Redirect
+	RestrictRules  []string        // set only if FinalAction is RestrictAccess
 }
 
 type CreditControlAnswer struct {
@@ -86,8 +97,15 @@ type CreditControlAnswer struct {
 	Credits       []*ReceivedCredits
 }
 
+type RedirectServer struct {
+	RedirectAddressType   RedirectAddressType `avp:"Redirect-Address-Type"`
+	RedirectServerAddress string              `avp:"Redirect-Server-Address"`
+}
+
 type FinalUnitIndication struct {
-	Action FinalUnitAction `avp:"Final-Unit-Action"`
+	Action         FinalUnitAction `avp:"Final-Unit-Action"`
+	FilterID       []string        `avp:"Filter-Id"`
+	RedirectServer *RedirectServer `avp:"Redirect-Server"`
 }
 
 type MSCCDiameterMessage struct {
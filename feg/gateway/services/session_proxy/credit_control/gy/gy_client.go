@@ -45,7 +45,8 @@ type ReAuthHandler func(request *ReAuthRequest) *ReAuthAnswer
 // GyClient holds the relevant state for sending and receiving diameter calls
 // over Gy
 type GyClient struct {
-	diamClient *diameter.Client
+	diamClient  *diameter.Client
+	serverGroup *diameter.ServerGroup // nil if requests are sent to the given server only
 }
 
 var apnOverwrite string
@@ -68,10 +69,13 @@ func NewGyClient(
 	reAuthHandler ReAuthHandler,
 ) *GyClient {
 	diamClient := diameter.NewClient(clientCfg)
-	for _, server := range servers {
+	serverGroup := diameter.NewClientServerGroup(clientCfg, servers...)
+	for _, server := range serverGroup.Servers() {
 		diamClient.BeginConnection(server)
 	}
-	return NewConnectedGyClient(diamClient, reAuthHandler)
+	gyClient := NewConnectedGyClient(diamClient, reAuthHandler)
+	gyClient.serverGroup = serverGroup
+	return gyClient
 }
 
 // SendCreditControlRequest sends a Credit Control Request to the
@@ -106,8 +110,16 @@ func (gyClient *GyClient) SendCreditControlRequest(
 	}
 
 	glog.V(2).Infof("Sending Gy CCR message:\n%s\n", message)
+	gyClient.serverGroup.AddDestinationRealmForIMSI(message, request.IMSI)
 	key := credit_control.GetRequestKey(credit_control.Gy, request.SessionID, request.RequestNumber)
-	return gyClient.diamClient.SendRequest(server, done, message, key)
+	if gyClient.serverGroup == nil {
+		return gyClient.diamClient.SendRequest(server, done, message, key)
+	}
+	err = gyClient.diamClient.SendRequestToGroup(gyClient.serverGroup, request.SessionID, done, message, key)
+	if request.Type == credit_control.CRTTerminate {
+		gyClient.serverGroup.ReleaseSession(request.SessionID)
+	}
+	return err
 }
 
 // GetAnswer returns a *CreditControlAnswer from the given interface channel
@@ -362,6 +374,8 @@ func getReceivedCredits(cca *CCADiameterMessage) []*ReceivedCredits {
 		if mscc.FinalUnitIndication != nil {
 			receivedCredits.IsFinal = true
 			receivedCredits.FinalAction = mscc.FinalUnitIndication.Action
+			receivedCredits.RedirectServer = mscc.FinalUnitIndication.RedirectServer
+			receivedCredits.RestrictRules = mscc.FinalUnitIndication.FilterID
 		}
 		creditList = append(creditList, receivedCredits)
 	}
@@ -185,6 +185,76 @@ func TestGyClientOutOfCredit(t *testing.T) {
 	assert.Equal(t, gy.Terminate, update.Credits[0].FinalAction)
 }
 
+func TestGyClientOutOfCreditRedirect(t *testing.T) {
+	serverConfig := &diameter.DiameterServerConfig{DiameterServerConnConfig: diameter.DiameterServerConnConfig{
+		Addr:     "127.0.0.1:0",
+		Protocol: "tcp"},
+	}
+	clientConfig := getClientConfig()
+	serverConfig, ocs := startServer(clientConfig, serverConfig, gy.PerSessionInit)
+	ocs.SetOCSSettings(
+		context.Background(),
+		&fegprotos.OCSConfig{
+			MaxUsageBytes: returnedOctets,
+			MaxUsageTime:  1000,
+			ValidityTime:  validityTime,
+			FinalUnitIndication: &fegprotos.FinalUnitIndication{
+				FinalUnitAction: protos.ChargingCredit_REDIRECT,
+				RestrictRules:   []string{"restrict-rule-1", "restrict-rule-2"},
+				RedirectServer: &protos.RedirectServer{
+					RedirectAddressType:   protos.RedirectServer_URL,
+					RedirectServerAddress: "http://www.example.com/topup",
+				},
+			},
+		},
+	)
+	gyClient := gy.NewGyClient(
+		clientConfig,
+		[]*diameter.DiameterServerConfig{serverConfig},
+		getReAuthHandler(),
+	)
+
+	// send init
+	ccrInit := &gy.CreditControlRequest{
+		SessionID:     "1",
+		Type:          credit_control.CRTInit,
+		IMSI:          testIMSI1,
+		RequestNumber: 0,
+		Credits:       nil,
+		UeIPV4:        "192.168.1.1",
+		SpgwIPV4:      "10.10.10.10",
+	}
+	done := make(chan interface{}, 1000)
+	assert.NoError(t, gyClient.SendCreditControlRequest(serverConfig, done, ccrInit))
+	gy.GetAnswer(done)
+
+	// send request with (total credits - used credits) < max usage (final units)
+	ccrUpdate := &gy.CreditControlRequest{
+		SessionID:     "1",
+		Type:          credit_control.CRTUpdate,
+		IMSI:          testIMSI1,
+		RequestNumber: 1,
+		Credits: []*gy.UsedCredits{{
+			RatingGroup:  1,
+			InputOctets:  999990,
+			OutputOctets: 0,
+			TotalOctets:  999990,
+		}},
+	}
+
+	assert.NoError(t, gyClient.SendCreditControlRequest(serverConfig, done, ccrUpdate))
+	update := gy.GetAnswer(done)
+	assert.Equal(t, uint64(10), *update.Credits[0].GrantedUnits.TotalOctets)
+	assert.True(t, update.Credits[0].IsFinal)
+	assert.Equal(t, gy.Redirect, update.Credits[0].FinalAction)
+	assert.Equal(t, []string{"restrict-rule-1", "restrict-rule-2"}, update.Credits[0].RestrictRules)
+	assert.Equal(
+		t,
+		&gy.RedirectServer{RedirectAddressType: gy.URL, RedirectServerAddress: "http://www.example.com/topup"},
+		update.Credits[0].RedirectServer,
+	)
+}
+
 func TestGyClientPerKeyInit(t *testing.T) {
 	serverConfig := &diameter.DiameterServerConfig{DiameterServerConnConfig: diameter.DiameterServerConnConfig{
 		Addr:     "127.0.0.1:0",
@@ -297,13 +297,22 @@ func getInitialCreditResponsesFromCCA(
 func getSingleChargingCreditFromCCA(
 	credits *gy.ReceivedCredits,
 ) *protos.ChargingCredit {
-	return &protos.ChargingCredit{
-		GrantedUnits: credits.GrantedUnits.ToProto(),
-		Type:         protos.ChargingCredit_BYTES,
-		ValidityTime: credits.ValidityTime,
-		IsFinal:      credits.IsFinal,
-		FinalAction:  protos.ChargingCredit_FinalAction(credits.FinalAction),
+	credit := &protos.ChargingCredit{
+		GrantedUnits:  credits.GrantedUnits.ToProto(),
+		Type:          protos.ChargingCredit_BYTES,
+		ValidityTime:  credits.ValidityTime,
+		IsFinal:       credits.IsFinal,
+		FinalAction:   protos.ChargingCredit_FinalAction(credits.FinalAction),
+		RestrictRules: credits.RestrictRules,
 	}
+	if credits.RedirectServer != nil {
+		credit.RedirectServer = &protos.RedirectServer{
+			RedirectAddressType: protos.RedirectServer_RedirectAddressType(
+				credits.RedirectServer.RedirectAddressType),
+			RedirectServerAddress: credits.RedirectServer.RedirectServerAddress,
+		}
+	}
+	return credit
 }
 
 // getUpdateRequestsFromUsage returns a slice of CCRs from usage update protos
@@ -20,6 +20,7 @@ import (
 	"magma/lte/cloud/go/protos"
 
 	"github.com/golang/glog"
+	"github.com/golang/protobuf/ptypes/timestamp"
 )
 
 func (srv *CentralSessionController) sendInitialGxRequest(imsi string, pReq *protos.CreateSessionRequest) (*gx.CreditControlAnswer, error) {
@@ -109,6 +110,28 @@ func getPolicyRulesFromDefinitions(ruleDefs []*gx.RuleDefinition) []*protos.Poli
 	return policyRules
 }
 
+// getEventTriggersFromCCA returns the event triggers armed by the PCRF in a CCA
+func getEventTriggersFromCCA(gxCCA *gx.CreditControlAnswer) []protos.EventTrigger {
+	if len(gxCCA.EventTriggers) == 0 {
+		return nil
+	}
+	triggers := make([]protos.EventTrigger, 0, len(gxCCA.EventTriggers))
+	for _, trigger := range gxCCA.EventTriggers {
+		triggers = append(triggers, protos.EventTrigger(trigger))
+	}
+	return triggers
+}
+
+// getRevalidationTimeFromCCA returns the Revalidation-Time of a CCA if present
+func getRevalidationTimeFromCCA(gxCCA *gx.CreditControlAnswer) *timestamp.Timestamp {
+	revalidationTime, err := gx.ConvertToProtoTimestamp(gxCCA.RevalidationTime)
+	if err != nil {
+		glog.Errorf("Cannot convert revalidation time for session %s: %s", gxCCA.SessionID, err)
+		return nil
+	}
+	return revalidationTime
+}
+
 func getUsageMonitorsFromCCA(imsi string, sessionID string, gxCCA *gx.CreditControlAnswer) []*protos.UsageMonitoringUpdateResponse {
 	monitors := make([]*protos.UsageMonitoringUpdateResponse, 0, len(gxCCA.UsageMonitors))
 	for _, monitor := range gxCCA.UsageMonitors {
@@ -145,14 +168,32 @@ func getUsageMonitorCreditFromAVP(monitor *gx.UsageMonitoringInfo) *protos.Usage
 func getGxUpdateRequestsFromUsage(updates []*protos.UsageMonitoringUpdateRequest) []*gx.CreditControlRequest {
 	requests := []*gx.CreditControlRequest{}
 	for _, update := range updates {
-		requests = append(requests, &gx.CreditControlRequest{
+		request := &gx.CreditControlRequest{
 			SessionID:     update.SessionId,
 			RequestNumber: update.RequestNumber,
 			Type:          credit_control.CRTUpdate,
 			IMSI:          removeSidPrefix(update.Sid),
 			IPAddr:        update.UeIpv4,
-			UsageReports:  []*gx.UsageReport{getGxUsageReportFromUsageUpdate(update.Update)},
-		})
+			UserLocation:  update.UserLocation,
+			PlmnID:        update.PlmnId,
+		}
+		if update.Update != nil {
+			request.UsageReports = []*gx.UsageReport{getGxUsageReportFromUsageUpdate(update.Update)}
+		}
+		for _, trigger := range update.EventTriggers {
+			request.EventTriggers = append(request.EventTriggers, gx.EventTrigger(trigger))
+		}
+		if qos := update.GetQosInfo(); qos != nil {
+			request.Qos = &gx.QosRequestInfo{
+				ApnAggMaxBitRateDL: qos.GetApnAmbrDl(),
+				ApnAggMaxBitRateUL: qos.GetApnAmbrUl(),
+				QosClassIdentifier: qos.GetQosClassId(),
+				PriLevel:           qos.GetPriorityLevel(),
+				PreCapability:      qos.GetPreemptionCapability(),
+				PreVulnerability:   qos.GetPreemptionVulnerability(),
+			}
+		}
+		requests = append(requests, request)
 	}
 	return requests
 }
@@ -276,15 +317,18 @@ func addMissingGxResponses(
 	leftoverRequests map[credit_control.RequestKey]*gx.CreditControlRequest,
 ) []*protos.UsageMonitoringUpdateResponse {
 	for _, ccr := range leftoverRequests {
-		responses = append(responses, &protos.UsageMonitoringUpdateResponse{
+		response := &protos.UsageMonitoringUpdateResponse{
 			Success:   false,
 			SessionId: ccr.SessionID,
 			Sid:       addSidPrefix(ccr.IMSI),
-			Credit: &protos.UsageMonitoringCredit{
+		}
+		if len(ccr.UsageReports) > 0 {
+			response.Credit = &protos.UsageMonitoringCredit{
 				MonitoringKey: ccr.UsageReports[0].MonitoringKey,
 				Level:         protos.MonitoringLevel(ccr.UsageReports[0].Level),
-			},
-		})
+			}
+		}
+		responses = append(responses, response)
 		metrics.UpdateGxRecentRequestMetrics(fmt.Errorf("Gx update failure"))
 	}
 	return responses
@@ -296,11 +340,17 @@ func getSingleUsageMonitorResponseFromCCA(
 	request *gx.CreditControlRequest,
 ) *protos.UsageMonitoringUpdateResponse {
 	res := &protos.UsageMonitoringUpdateResponse{
-		Success:   answer.ResultCode == diameter.SuccessCode,
-		SessionId: request.SessionID,
-		Sid:       addSidPrefix(request.IMSI)}
+		Success:          answer.ResultCode == diameter.SuccessCode,
+		SessionId:        request.SessionID,
+		Sid:              addSidPrefix(request.IMSI),
+		EventTriggers:    getEventTriggersFromCCA(answer),
+		RevalidationTime: getRevalidationTimeFromCCA(answer)}
 
 	if len(answer.UsageMonitors) == 0 {
+		if len(request.UsageReports) == 0 {
+			// event only update, there is no usage monitor to respond to
+			return res
+		}
 		glog.Infof("No usage monitor response in CCA for subscriber %s", request.IMSI)
 		res.Credit =
 			&protos.UsageMonitoringCredit{
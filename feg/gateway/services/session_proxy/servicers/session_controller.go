@@ -135,10 +135,12 @@ func (srv *CentralSessionController) CreateSession(
 	)
 
 	return &protos.CreateSessionResponse{
-		Credits:       credits,
-		StaticRules:   staticRules,
-		DynamicRules:  dynamicRules,
-		UsageMonitors: getUsageMonitorsFromCCA(imsi, sessionID, gxCCAInit),
+		Credits:          credits,
+		StaticRules:      staticRules,
+		DynamicRules:     dynamicRules,
+		UsageMonitors:    getUsageMonitorsFromCCA(imsi, sessionID, gxCCAInit),
+		EventTriggers:    getEventTriggersFromCCA(gxCCAInit),
+		RevalidationTime: getRevalidationTimeFromCCA(gxCCAInit),
 	}, nil
 }
 
@@ -513,6 +513,72 @@ func TestGxUsageMonitoring(t *testing.T) {
 	assert.Equal(t, protos.MonitoringLevel_SESSION_LEVEL, update.Credit.Level)
 }
 
+func TestGxEventTriggers(t *testing.T) {
+	mocks := &sessionMocks{
+		gy:       &MockCreditClient{},
+		gx:       &MockPolicyClient{},
+		policydb: &MockPolicyDBClient{},
+	}
+	srv := servicers.NewCentralSessionController(
+		mocks.gy,
+		mocks.gx,
+		mocks.policydb,
+		getTestConfig(gy.PerSessionInit),
+	)
+	ctx := context.Background()
+	revalidationTime := time.Unix(1550000000, 0)
+
+	// Event only update is sent with the new location & no usage report
+	mocks.gx.On(
+		"SendCreditControlRequest",
+		mock.Anything,
+		mock.Anything,
+		mock.MatchedBy(func(request *gx.CreditControlRequest) bool {
+			return request.Type == credit_control.CRTUpdate &&
+				len(request.UsageReports) == 0 &&
+				assert.ObjectsAreEqual(
+					[]gx.EventTrigger{gx.UserLocationChangeTrigger, gx.RevalidationTimeoutTrigger},
+					request.EventTriggers) &&
+				string(request.UserLocation) == "new-location"
+		}),
+	).Return(nil).Run(func(args mock.Arguments) {
+		done := args.Get(1).(chan interface{})
+		request := args.Get(2).(*gx.CreditControlRequest)
+		done <- &gx.CreditControlAnswer{
+			ResultCode:       uint32(diameter.SuccessCode),
+			SessionID:        request.SessionID,
+			RequestNumber:    request.RequestNumber,
+			EventTriggers:    []gx.EventTrigger{gx.UserLocationChangeTrigger, gx.RevalidationTimeoutTrigger},
+			RevalidationTime: &revalidationTime,
+		}
+	}).Times(1)
+
+	updateResponse, err := srv.UpdateSession(ctx, &protos.UpdateSessionRequest{
+		UsageMonitors: []*protos.UsageMonitoringUpdateRequest{{
+			SessionId:     "sid1",
+			RequestNumber: 1,
+			Sid:           IMSI1,
+			EventTriggers: []protos.EventTrigger{
+				protos.EventTrigger_USER_LOCATION_CHANGE, protos.EventTrigger_REVALIDATION_TIMEOUT,
+			},
+			UserLocation: []byte("new-location"),
+		}},
+	})
+	assert.NoError(t, err)
+	mocks.gx.AssertExpectations(t)
+	assert.Equal(t, 1, len(updateResponse.UsageMonitorResponses))
+	update := updateResponse.UsageMonitorResponses[0]
+	assert.True(t, update.Success)
+	assert.Equal(t, IMSI1, update.Sid)
+	assert.Nil(t, update.Credit)
+	assert.Equal(
+		t,
+		[]protos.EventTrigger{protos.EventTrigger_USER_LOCATION_CHANGE, protos.EventTrigger_REVALIDATION_TIMEOUT},
+		update.EventTriggers,
+	)
+	assert.Equal(t, &timestamp.Timestamp{Seconds: 1550000000}, update.RevalidationTime)
+}
+
 func TestGetHealthStatus(t *testing.T) {
 	err := initMconfig()
 	assert.NoError(t, err)
@@ -133,6 +133,19 @@ func (swxCache *Impl) Put(ans *protos.AuthenticationAnswer) *protos.Authenticati
 	return &res
 }
 
+// Remove removes all cached vectors of the given user, returns the removed answer or nil if none were cached
+func (swxCache *Impl) Remove(imsi string) *protos.AuthenticationAnswer {
+	swxCache.mu.Lock()
+	defer swxCache.mu.Unlock()
+	ent, found := swxCache.data.vectors[imsi]
+	if !found {
+		return nil
+	}
+	delete(swxCache.data.vectors, imsi)
+	heap.Remove(&swxCache.data, ent.idx)
+	return ent.ans
+}
+
 // ClearAll removes all cached entities & re-initializes the cache
 func (swxCache *Impl) ClearAll() {
 	swxCache.mu.Lock()
@@ -72,3 +72,28 @@ func TestSwxCacheGC(t *testing.T) {
 	_, err = srv.StopService(context.Background(), &orcprotos.Void{})
 	assert.NoError(t, err)
 }
+
+func TestSwxCacheRemove(t *testing.T) {
+	cache, done := cache.NewExt(time.Minute, time.Hour)
+	defer func() { done <- struct{}{} }()
+
+	vectors := []*protos.AuthenticationAnswer_SIPAuthVector{
+		{AuthenticationScheme: protos.AuthenticationScheme_EAP_AKA, RandAutn: []byte("1")},
+		{AuthenticationScheme: protos.AuthenticationScheme_EAP_AKA, RandAutn: []byte("2")},
+		{AuthenticationScheme: protos.AuthenticationScheme_EAP_AKA, RandAutn: []byte("3")},
+	}
+	authRes := cache.Put(&protos.AuthenticationAnswer{UserName: test.BASE_IMSI, SipAuthVectors: vectors})
+	assert.Equal(t, 1, len(authRes.GetSipAuthVectors()))
+	assert.Equal(t, []byte("1"), authRes.SipAuthVectors[0].GetRandAutn())
+
+	removed := cache.Remove(test.BASE_IMSI)
+	assert.Equal(t, 2, len(removed.GetSipAuthVectors()))
+	assert.Equal(t, (*protos.AuthenticationAnswer)(nil), cache.Get(test.BASE_IMSI))
+	assert.Equal(t, (*protos.AuthenticationAnswer)(nil), cache.Remove(test.BASE_IMSI))
+
+	// cache should remain usable after removal
+	cache.Put(&protos.AuthenticationAnswer{UserName: test.BASE_IMSI, SipAuthVectors: vectors[1:]})
+	authRes = cache.Get(test.BASE_IMSI)
+	assert.Equal(t, 1, len(authRes.GetSipAuthVectors()))
+	assert.Equal(t, []byte("3"), authRes.SipAuthVectors[0].GetRandAutn())
+}
@@ -0,0 +1,56 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package swx_proxy
+
+import (
+	"errors"
+	"fmt"
+
+	"magma/feg/cloud/go/protos"
+	"magma/feg/cloud/go/services/feg_relay"
+	"magma/feg/gateway/registry"
+
+	"github.com/golang/glog"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+func getRelayConn() (*grpc.ClientConn, error) {
+	conn, err := registry.NewCloudRegistry().GetCloudConnection(feg_relay.ServiceName)
+	if err != nil {
+		errMsg := fmt.Sprintf("Failed to establish connection to cloud FegToGwRelayClient: %s", err)
+		glog.Error(errMsg)
+		return nil, errors.New(errMsg)
+	}
+	return conn, nil
+}
+
+// GWSwxProxyRegistrationTermination forwards RTR to Controller
+func GWSwxProxyRegistrationTermination(
+	in *protos.RegistrationTerminationRequest) (*protos.RegistrationTerminationAnswer, error) {
+
+	conn, err := getRelayConn()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	client := protos.NewSwxGatewayServiceClient(conn)
+	return client.RegistrationTermination(context.Background(), in)
+}
+
+// GWSwxProxyPushProfile forwards PPR to Controller
+func GWSwxProxyPushProfile(in *protos.PushProfileRequest) (*protos.PushProfileAnswer, error) {
+	conn, err := getRelayConn()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	client := protos.NewSwxGatewayServiceClient(conn)
+	return client.PushProfile(context.Background(), in)
+}
@@ -29,6 +29,18 @@ var (
 		Name: "sar_send_failures_total",
 		Help: "Total number of SAR requests that failed to send to HSS",
 	})
+	RTRRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "rtr_requests_total",
+		Help: "Total number of RTR requests received from HSS",
+	})
+	PPRRequests = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "ppr_requests_total",
+		Help: "Total number of PPR requests received from HSS",
+	})
+	GwRelayFailures = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "swx_gw_relay_failures_total",
+		Help: "Total number of HSS initiated requests that failed to reach the gateway",
+	})
 	SwxTimeouts = prometheus.NewCounter(prometheus.CounterOpts{
 		Name: "swx_timeouts_total",
 		Help: "Total number of swx timeouts",
@@ -63,6 +75,6 @@ var (
 
 func init() {
 	prometheus.MustRegister(MARRequests, MARSendFailures, SARRequests,
-		SARSendFailures, SwxTimeouts, SwxUnparseableMsg, SwxInvalidSessions,
+		SARSendFailures, RTRRequests, PPRRequests, GwRelayFailures, SwxTimeouts, SwxUnparseableMsg, SwxInvalidSessions,
 		SwxResultCodes, SwxExperimentalResultCodes, UnauthorizedAuthAttempts)
 }
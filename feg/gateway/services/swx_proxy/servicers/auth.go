@@ -67,7 +67,7 @@ func (s *swxProxy) AuthenticateImpl(req *protos.AuthenticationRequest) (*protos.
 	if err != nil {
 		return res, status.Errorf(codes.InvalidArgument, err.Error())
 	}
-	err = s.sendDiameterMsg(marMsg, MAX_DIAM_RETRIES)
+	err = s.sendDiameterMsg(sid, marMsg, MAX_DIAM_RETRIES)
 	if err != nil {
 		metrics.MARSendFailures.Inc()
 		err = status.Errorf(codes.Internal, "Error while sending MAR with SID %s: %s", sid, err)
@@ -120,6 +120,7 @@ func (s *swxProxy) AuthenticateImpl(req *protos.AuthenticationRequest) (*protos.
 		}
 
 	case <-time.After(time.Second * TIMEOUT_SECONDS):
+		s.serverGroup.AnswerTimedOut(sid)
 		metrics.SwxTimeouts.Inc()
 		err = status.Errorf(codes.DeadlineExceeded, "MAA Timed Out for Session ID: %s", sid)
 		glog.Error(err)
@@ -169,6 +170,7 @@ func (s *swxProxy) createMAR(sid string, req *protos.AuthenticationRequest) (*di
 	msg.NewAVP(avp.OriginHost, avp.Mbit, 0, datatype.DiameterIdentity(s.config.ClientCfg.Host))
 	msg.NewAVP(avp.OriginRealm, avp.Mbit, 0, datatype.DiameterIdentity(s.config.ClientCfg.Realm))
 	msg.NewAVP(avp.UserName, avp.Mbit, 0, datatype.UTF8String(req.GetUserName()))
+	s.serverGroup.AddDestinationRealmForIMSI(msg, req.GetUserName())
 	msg.NewAVP(avp.AuthSessionState, avp.Mbit, 0, datatype.Enumerated(1))
 	msg.NewAVP(avp.SIPNumberAuthItems, avp.Mbit|avp.Vbit, uint32(diameter.Vendor3GPP), datatype.Unsigned32(req.GetSipNumAuthVectors()))
 	msg.NewAVP(avp.RATType, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Enumerated(RadioAccessTechnologyType_WLAN))
@@ -194,6 +196,7 @@ func handleMAA(s *swxProxy) diam.HandlerFunc {
 			glog.Errorf("MAA Unmarshal failed for remote %s & message %s: %s", c.RemoteAddr(), m, err)
 			return
 		}
+		s.serverGroup.AnswerReceived(maa.SessionID)
 		ch := s.requestTracker.DeregisterRequest(maa.SessionID)
 		if ch != nil {
 			ch <- &maa
@@ -248,6 +251,8 @@ func convertStringToAuthScheme(maaScheme string) (protos.AuthenticationScheme, e
 		return protos.AuthenticationScheme_EAP_AKA, nil
 	case SipAuthScheme_EAP_AKA_PRIME:
 		return protos.AuthenticationScheme_EAP_AKA_PRIME, nil
+	case SipAuthScheme_EAP_SIM:
+		return protos.AuthenticationScheme_EAP_SIM, nil
 	default:
 		return protos.AuthenticationScheme_EAP_AKA, fmt.Errorf("Unrecognized Authentication Scheme returned: %s", maaScheme)
 	}
@@ -259,6 +264,8 @@ func convertAuthSchemeToString(scheme protos.AuthenticationScheme) (string, erro
 		return SipAuthScheme_EAP_AKA, nil
 	case protos.AuthenticationScheme_EAP_AKA_PRIME:
 		return SipAuthScheme_EAP_AKA_PRIME, nil
+	case protos.AuthenticationScheme_EAP_SIM:
+		return SipAuthScheme_EAP_SIM, nil
 	default:
 		return "", fmt.Errorf("Unrecognized Authentication Scheme returned: %v", scheme)
 	}
@@ -75,6 +75,9 @@ func GetSwxProxyConfig() *SwxProxyConfig {
 			Retransmits:      uint(configsPtr.GetServer().GetRetransmits()),
 			WatchdogInterval: uint(configsPtr.GetServer().GetWatchdogInterval()),
 			RetryCount:       uint(configsPtr.GetServer().GetRetryCount()),
+			PeerSelection:    diameter.PeerSelection(configsPtr.GetServer().GetPeerSelection()),
+			AlternateServers: diameter.GetAlternateServerConfigs(configsPtr.GetServer()),
+			RealmRoutes:      diameter.GetRealmRoutes(configsPtr.GetServer()),
 		},
 		ServerCfg: &diameter.DiameterServerConfig{DiameterServerConnConfig: diameter.DiameterServerConnConfig{
 			Addr:      diameter.GetValueOrEnv(diameter.AddrFlag, HSSAddrEnv, configsPtr.GetServer().GetAddress()),
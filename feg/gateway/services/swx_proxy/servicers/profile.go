@@ -0,0 +1,62 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package servicers
+
+import (
+	"magma/feg/cloud/go/protos"
+	"magma/feg/gateway/services/swx_proxy"
+	"magma/feg/gateway/services/swx_proxy/metrics"
+
+	"github.com/fiorix/go-diameter/diam"
+	"github.com/golang/glog"
+)
+
+// handlePPR handles HSS initiated Push-Profile-Request (code 305),
+// invalidates the user's cached vectors, relays the updated profile to the gateway
+// serving the user & answers the HSS with PPA
+func handlePPR(s *swxProxy) diam.HandlerFunc {
+	return func(c diam.Conn, m *diam.Message) {
+		glog.V(2).Infof("handling PPR\n")
+		var ppr PPR
+		err := m.Unmarshal(&ppr)
+		if err != nil {
+			metrics.SwxUnparseableMsg.Inc()
+			glog.Errorf("PPR Unmarshal failed for remote %s & message %s: %s", c.RemoteAddr(), m, err)
+			return
+		}
+		metrics.PPRRequests.Inc()
+		s.cache.Remove(ppr.UserName)
+
+		in := &protos.PushProfileRequest{
+			UserName:               ppr.UserName,
+			Non3GppIpAccessAllowed: ppr.UserData.Non3GPPIPAccess == Non3GPPIPAccess_ENABLED,
+			Msisdn:                 string(ppr.UserData.SubscriptionId.SubscriptionIdData),
+		}
+		var res *protos.PushProfileAnswer
+		var retries = MAX_SYNC_RPC_RETRIES
+		for ; retries >= 0; retries-- {
+			res, err = swx_proxy.GWSwxProxyPushProfile(in)
+			if err == nil {
+				break
+			}
+			if !isRetryableRelayError(err) {
+				glog.Errorf("Failed to forward PPR to gateway. err: %v\n", err)
+				break
+			}
+			glog.Errorf("Failed to forward PPR to gateway. err: %v. Retries left: %v\n", err, retries)
+			waitRelayRetry(retries)
+		}
+		err = s.sendAnswer(c, newRelayAnswer(m, res.GetErrorCode(), err), ppr.SessionID, MAX_DIAM_RETRIES)
+		if err != nil {
+			glog.Errorf("Failed to send PPA: %v", err)
+		} else {
+			glog.V(2).Infof("Successfully sent PPA\n")
+		}
+	}
+}
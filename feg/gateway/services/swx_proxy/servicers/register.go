@@ -48,7 +48,7 @@ func (s *swxProxy) sendSAR(userName string, serverAssignmentType uint32) (*SAA,
 	defer s.requestTracker.DeregisterRequest(sid)
 
 	sarMsg := s.createSAR(sid, userName, serverAssignmentType)
-	err := s.sendDiameterMsg(sarMsg, MAX_DIAM_RETRIES)
+	err := s.sendDiameterMsg(sid, sarMsg, MAX_DIAM_RETRIES)
 	if err != nil {
 		metrics.SARSendFailures.Inc()
 		glog.Errorf("Error while sending SAR with SID %s: %s", sid, err)
@@ -80,6 +80,7 @@ func (s *swxProxy) sendSAR(userName string, serverAssignmentType uint32) (*SAA,
 		return saa, err
 
 	case <-time.After(time.Second * TIMEOUT_SECONDS):
+		s.serverGroup.AnswerTimedOut(sid)
 		metrics.SwxTimeouts.Inc()
 		err = status.Errorf(codes.DeadlineExceeded, "SAA Timed Out for Session ID: %s", sid)
 		glog.Error(err)
@@ -102,6 +103,7 @@ func (s *swxProxy) createSAR(sid, userName string, saType uint32) *diam.Message
 	msg.NewAVP(avp.OriginRealm, avp.Mbit, 0, datatype.DiameterIdentity(s.config.ClientCfg.Realm))
 
 	msg.NewAVP(avp.UserName, avp.Mbit, 0, datatype.UTF8String(userName))
+	s.serverGroup.AddDestinationRealmForIMSI(msg, userName)
 	msg.NewAVP(avp.AuthSessionState, avp.Mbit, 0, datatype.Enumerated(1))
 	msg.NewAVP(avp.ServerAssignmentType, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Enumerated(saType))
 	return msg
@@ -116,6 +118,7 @@ func handleSAA(s *swxProxy) diam.HandlerFunc {
 			glog.Errorf("SAA Unmarshal failed for remote %s & message %s: %s", c.RemoteAddr(), m, err)
 			return
 		}
+		s.serverGroup.AnswerReceived(saa.SessionID)
 		ch := s.requestTracker.DeregisterRequest(saa.SessionID)
 		if ch != nil {
 			ch <- &saa
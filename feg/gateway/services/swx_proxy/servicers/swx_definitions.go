@@ -25,6 +25,7 @@ const (
 	// 3GPP 29.273 8.1.2.1.1/2
 	SipAuthScheme_EAP_AKA       = "EAP-AKA"
 	SipAuthScheme_EAP_AKA_PRIME = "EAP-AKA'"
+	SipAuthScheme_EAP_SIM       = "EAP-SIM"
 
 	// END_USER_E164 - Subscription-ID Type indicating that the identifier is
 	// in international E.164 format (eg. MSISDN).
@@ -118,3 +119,32 @@ type SubscriptionId struct {
 	SubscriptionIdType datatype.Enumerated `avp:"Subscription-Id-Type"`
 	SubscriptionIdData datatype.UTF8String `avp:"Subscription-Id-Data"`
 }
+
+// 3GPP 29.273 8.2.2.2 - Registration Termination Request
+type RTR struct {
+	SessionID            string                      `avp:"Session-Id"`
+	VendorSpecificAppId  VendorSpecificApplicationId `avp:"Vendor-Specific-Application-Id"`
+	AuthSessionState     int32                       `avp:"Auth-Session-State"`
+	OriginHost           datatype.DiameterIdentity   `avp:"Origin-Host"`
+	OriginRealm          datatype.DiameterIdentity   `avp:"Origin-Realm"`
+	UserName             string                      `avp:"User-Name"`
+	DeregistrationReason DeregistrationReason        `avp:"Deregistration-Reason"`
+}
+
+// 3GPP 29.273 8.2.3.3 - Deregistration-Reason
+type DeregistrationReason struct {
+	ReasonCode datatype.Enumerated `avp:"Reason-Code"`
+	ReasonInfo string              `avp:"Reason-Info"`
+}
+
+// 3GPP 29.273 8.2.2.4 - Push Profile Request
+type PPR struct {
+	SessionID            string                      `avp:"Session-Id"`
+	VendorSpecificAppId  VendorSpecificApplicationId `avp:"Vendor-Specific-Application-Id"`
+	AuthSessionState     int32                       `avp:"Auth-Session-State"`
+	OriginHost           datatype.DiameterIdentity   `avp:"Origin-Host"`
+	OriginRealm          datatype.DiameterIdentity   `avp:"Origin-Realm"`
+	UserName             string                      `avp:"User-Name"`
+	UserData             Non3GPPUserData             `avp:"Non-3GPP-User-Data"`
+	AAAFailureIndication uint32                      `avp:"AAA-Failure-Indication"`
+}
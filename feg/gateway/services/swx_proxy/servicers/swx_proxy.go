@@ -8,6 +8,7 @@ LICENSE file in the root directory of this source tree.
 
 // Package servicers implements Swx GRPC proxy service which sends MAR/SAR messages over
 // diameter connection, waits (blocks) for diameter's MAA/SAAs returns their RPC representation
+// It also handles HSS initiated RTR & PPR, relays them to the gateway, then answers with RTA & PPA.
 package servicers
 
 import (
@@ -35,6 +36,7 @@ type swxProxy struct {
 	config         *SwxProxyConfig
 	smClient       *sm.Client
 	connMan        *diameter.ConnectionManager
+	serverGroup    *diameter.ServerGroup
 	requestTracker *diameter.RequestTracker
 	originStateID  uint32
 	cache          *cache.Impl
@@ -106,13 +108,17 @@ func NewSwxProxyWithCache(config *SwxProxyConfig, cache *cache.Impl) (*swxProxy,
 	}
 
 	connMan := diameter.NewConnectionManager()
-	// create connection in connection map
-	connMan.GetConnection(smClient, config.ServerCfg)
+	serverGroup := diameter.NewClientServerGroup(config.ClientCfg, config.ServerCfg)
+	// create connections in connection map
+	for _, server := range serverGroup.Servers() {
+		connMan.GetConnection(smClient, server)
+	}
 
 	proxy := &swxProxy{
 		config:         config,
 		smClient:       smClient,
 		connMan:        connMan,
+		serverGroup:    serverGroup,
 		requestTracker: diameter.NewRequestTracker(),
 		originStateID:  originStateID,
 		cache:          cache,
@@ -123,6 +129,12 @@ func NewSwxProxyWithCache(config *SwxProxyConfig, cache *cache.Impl) (*swxProxy,
 	mux.HandleIdx(
 		diam.CommandIndex{AppID: diam.TGPP_SWX_APP_ID, Code: diam.ServerAssignment, Request: false},
 		handleSAA(proxy))
+	mux.HandleIdx(
+		diam.CommandIndex{AppID: diam.TGPP_SWX_APP_ID, Code: diam.RegistrationTermination, Request: true},
+		handleRTR(proxy))
+	mux.HandleIdx(
+		diam.CommandIndex{AppID: diam.TGPP_SWX_APP_ID, Code: diam.PushProfile, Request: true},
+		handlePPR(proxy))
 
 	return proxy, nil
 }
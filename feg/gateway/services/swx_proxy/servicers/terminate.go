@@ -0,0 +1,105 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package servicers
+
+import (
+	"time"
+
+	"magma/feg/cloud/go/protos"
+	"magma/feg/gateway/services/swx_proxy"
+	"magma/feg/gateway/services/swx_proxy/metrics"
+
+	"github.com/fiorix/go-diameter/diam"
+	"github.com/golang/glog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	MAX_SYNC_RPC_RETRIES = 3
+	// Delay before the first retry of a request relayed to the gateway, doubled on every further retry
+	SYNC_RPC_RETRY_BACKOFF = 250 * time.Millisecond
+)
+
+// handleRTR handles HSS initiated Registration-Termination-Request (code 304),
+// invalidates the user's cached vectors, relays the request to the gateway serving
+// the user so its non-3GPP sessions are terminated & answers the HSS with RTA
+func handleRTR(s *swxProxy) diam.HandlerFunc {
+	return func(c diam.Conn, m *diam.Message) {
+		glog.V(2).Infof("handling RTR\n")
+		var rtr RTR
+		err := m.Unmarshal(&rtr)
+		if err != nil {
+			metrics.SwxUnparseableMsg.Inc()
+			glog.Errorf("RTR Unmarshal failed for remote %s & message %s: %s", c.RemoteAddr(), m, err)
+			return
+		}
+		metrics.RTRRequests.Inc()
+		s.cache.Remove(rtr.UserName)
+
+		in := &protos.RegistrationTerminationRequest{
+			UserName: rtr.UserName,
+			ReasonCode: protos.RegistrationTerminationRequest_ReasonCode(
+				rtr.DeregistrationReason.ReasonCode),
+			ReasonInfo: rtr.DeregistrationReason.ReasonInfo,
+		}
+		var res *protos.RegistrationTerminationAnswer
+		var retries = MAX_SYNC_RPC_RETRIES
+		for ; retries >= 0; retries-- {
+			res, err = swx_proxy.GWSwxProxyRegistrationTermination(in)
+			if err == nil {
+				break
+			}
+			if !isRetryableRelayError(err) {
+				glog.Errorf("Failed to forward RTR to gateway. err: %v\n", err)
+				break
+			}
+			glog.Errorf("Failed to forward RTR to gateway. err: %v. Retries left: %v\n", err, retries)
+			waitRelayRetry(retries)
+		}
+		err = s.sendAnswer(c, newRelayAnswer(m, res.GetErrorCode(), err), rtr.SessionID, MAX_DIAM_RETRIES)
+		if err != nil {
+			glog.Errorf("Failed to send RTA: %v", err)
+		} else {
+			glog.V(2).Infof("Successfully sent RTA\n")
+		}
+	}
+}
+
+// newRelayAnswer creates an answer to the HSS initiated request m from the result of its relay to the gateway
+func newRelayAnswer(m *diam.Message, code protos.SwxErrorCode, relayErr error) *diam.Message {
+	if relayErr != nil {
+		if status.Code(relayErr) == codes.NotFound {
+			// the user is not served by any gateway, there is no session to terminate or update
+			return m.Answer(diam.Success)
+		}
+		metrics.GwRelayFailures.Inc()
+		return m.Answer(diam.UnableToDeliver)
+	}
+	return newAnswer(m, code)
+}
+
+// isRetryableRelayError returns whether relaying a request to the gateway failed
+// transiently, e.g. the gateway is not connected to the cloud right now
+func isRetryableRelayError(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return true
+	default:
+		return false
+	}
+}
+
+// waitRelayRetry waits before the next retry of a request relayed to the gateway,
+// it returns immediately when no retries are left
+func waitRelayRetry(retriesLeft int) {
+	if retriesLeft > 0 {
+		time.Sleep(SYNC_RPC_RETRY_BACKOFF << uint(MAX_SYNC_RPC_RETRIES-retriesLeft))
+	}
+}
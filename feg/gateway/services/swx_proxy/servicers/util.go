@@ -9,19 +9,60 @@ LICENSE file in the root directory of this source tree.
 package servicers
 
 import (
+	"magma/feg/cloud/go/protos"
+	"magma/feg/gateway/diameter"
+
 	"github.com/fiorix/go-diameter/diam"
+	"github.com/fiorix/go-diameter/diam/avp"
+	"github.com/fiorix/go-diameter/diam/datatype"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
-func (s *swxProxy) sendDiameterMsg(msg *diam.Message, retryCount uint) error {
-	conn, err := s.connMan.GetConnection(s.smClient, s.config.ServerCfg)
-	if err != nil {
-		return err
-	}
-	err = conn.SendRequest(msg, retryCount)
+func (s *swxProxy) sendDiameterMsg(sid string, msg *diam.Message, retryCount uint) error {
+	err := s.connMan.SendRequestToGroup(s.smClient, s.serverGroup, "", sid, msg, retryCount)
 	if err != nil {
 		err = status.Errorf(codes.DataLoss, err.Error())
 	}
 	return err
 }
+
+// newAnswer creates an answer to the HSS initiated request m, SwxErrorCode values
+// are 3GPP failures and are reported in Experimental-Result AVP
+func newAnswer(m *diam.Message, code protos.SwxErrorCode) *diam.Message {
+	if code == protos.SwxErrorCode_ERROR_UNDEFINED {
+		return m.Answer(diam.Success)
+	}
+	ans := diam.NewMessage(
+		m.Header.CommandCode,
+		m.Header.CommandFlags&^diam.RequestFlag, // Reset the Request bit.
+		m.Header.ApplicationID,
+		m.Header.HopByHopID,
+		m.Header.EndToEndID,
+		m.Dictionary(),
+	)
+	ans.NewAVP(avp.ExperimentalResult, avp.Mbit, 0, &diam.GroupedAVP{
+		AVP: []*diam.AVP{
+			diam.NewAVP(avp.VendorID, avp.Mbit, 0, datatype.Unsigned32(diameter.Vendor3GPP)),
+			diam.NewAVP(avp.ExperimentalResultCode, avp.Mbit, 0, datatype.Unsigned32(code)),
+		},
+	})
+	return ans
+}
+
+// sendAnswer adds common SWx answer AVPs to ans and writes it to the HSS connection
+func (s *swxProxy) sendAnswer(c diam.Conn, ans *diam.Message, sid string, retries uint) error {
+	// SessionID is required to be the AVP in position 1
+	ans.InsertAVP(diam.NewAVP(avp.SessionID, avp.Mbit, 0, datatype.UTF8String(sid)))
+	ans.NewAVP(avp.VendorSpecificApplicationID, avp.Mbit, 0, &diam.GroupedAVP{
+		AVP: []*diam.AVP{
+			diam.NewAVP(avp.AuthApplicationID, avp.Mbit, 0, datatype.Unsigned32(diam.TGPP_SWX_APP_ID)),
+			diam.NewAVP(avp.VendorID, avp.Mbit, 0, datatype.Unsigned32(diameter.Vendor3GPP)),
+		},
+	})
+	ans.NewAVP(avp.AuthSessionState, avp.Mbit, 0, datatype.Enumerated(AuthSessionState_NO_STATE_MAINTAINED))
+	ans.NewAVP(avp.OriginHost, avp.Mbit, 0, datatype.DiameterIdentity(s.config.ClientCfg.Host))
+	ans.NewAVP(avp.OriginRealm, avp.Mbit, 0, datatype.DiameterIdentity(s.config.ClientCfg.Realm))
+	_, err := ans.WriteToWithRetry(c, retries)
+	return err
+}
@@ -41,6 +41,7 @@ var (
 	hssDefaultLteAuthAmf  = []byte("\x80\x00")
 	hssDefaultLteAuthOp   = []byte("\xcd\xc2\x02\xd5\x12> \xf6+mgj\xc7,\xb3\x18")
 	streamSubscribersFlag = flag.Bool("stream_subscribers", false, "Whether to stream subscribers from the cloud")
+	pushUpdatesFlag       = flag.Bool("push_subscriber_updates", false, "Whether to push updated subscribers to their MMEs with IDRs")
 )
 
 func init() {
@@ -76,8 +77,9 @@ func GetHSSConfig() (*mconfig.HSSConfig, error) {
 				MaxUlBitRate: diameter.GetValueUint64(maxUlBitRateFlag, defaultMaxUlBitRate),
 				MaxDlBitRate: diameter.GetValueUint64(maxDlBitRateFlag, defaultMaxDlBitRate),
 			},
-			SubProfiles:       make(map[string]*mconfig.HSSConfig_SubscriptionProfile),
-			StreamSubscribers: *streamSubscribersFlag,
+			SubProfiles:           make(map[string]*mconfig.HSSConfig_SubscriptionProfile),
+			StreamSubscribers:     *streamSubscribersFlag,
+			PushSubscriberUpdates: *pushUpdatesFlag,
 		}, err
 	}
 
@@ -96,8 +98,9 @@ func GetHSSConfig() (*mconfig.HSSConfig, error) {
 			MaxUlBitRate: diameter.GetValueUint64(maxUlBitRateFlag, configsPtr.DefaultSubProfile.MaxUlBitRate),
 			MaxDlBitRate: diameter.GetValueUint64(maxDlBitRateFlag, configsPtr.DefaultSubProfile.MaxDlBitRate),
 		},
-		SubProfiles:       configsPtr.SubProfiles,
-		StreamSubscribers: configsPtr.StreamSubscribers || *streamSubscribersFlag,
+		SubProfiles:           configsPtr.SubProfiles,
+		StreamSubscribers:     configsPtr.StreamSubscribers || *streamSubscribersFlag,
+		PushSubscriberUpdates: configsPtr.PushSubscriberUpdates || *pushUpdatesFlag,
 	}, nil
 }
 
@@ -9,6 +9,7 @@ LICENSE file in the root directory of this source tree.
 package servicers
 
 import (
+	"sync"
 	"time"
 
 	"magma/feg/cloud/go/protos/mconfig"
@@ -21,6 +22,7 @@ import (
 	"github.com/fiorix/go-diameter/diam"
 	"github.com/fiorix/go-diameter/diam/datatype"
 	"github.com/fiorix/go-diameter/diam/sm"
+	"github.com/golang/glog"
 	"golang.org/x/net/context"
 )
 
@@ -35,6 +37,10 @@ type HomeSubscriberServer struct {
 	// authSqnInd is an index used in the array scheme described by 3GPP TS 33.102 Appendix C.1.2 and C.2.2.
 	// SQN consists of two parts (SQN = SEQ||IND).
 	AuthSqnInd uint64
+
+	// servingMMEs maps IMSIs to the MMEs which sent their last ULRs
+	servingMMEs map[string]*servingMME
+	mmeMu       sync.Mutex
 }
 
 // NewHomeSubscriberServer initializes a HomeSubscriberServer with an empty accounts map.
@@ -45,9 +51,10 @@ func NewHomeSubscriberServer(store storage.SubscriberStore, config *mconfig.HSSC
 		return nil, err
 	}
 	return &HomeSubscriberServer{
-		store:    store,
-		Config:   config,
-		Milenage: milenage,
+		store:       store,
+		Config:      config,
+		Milenage:    milenage,
+		servingMMEs: map[string]*servingMME{},
 	}, nil
 }
 
@@ -73,11 +80,24 @@ func (srv *HomeSubscriberServer) GetSubscriberData(ctx context.Context, req *lte
 
 // UpdateSubscriber changes the data stored for an existing subscriber.
 // If the subscriber cannot be found, an error is returned instead.
+// If push_subscriber_updates is configured and the subscriber is served by an
+// MME, the updated profile is pushed to it with an IDR.
 // Input: The new subscriber data to store
 func (srv *HomeSubscriberServer) UpdateSubscriber(ctx context.Context, req *lteprotos.SubscriberData) (*protos.Void, error) {
 	err := srv.store.UpdateSubscriber(req)
-	err = storage.ConvertStorageErrorToGrpcStatus(err)
-	return &protos.Void{}, err
+	if err != nil {
+		return &protos.Void{}, storage.ConvertStorageErrorToGrpcStatus(err)
+	}
+	if !srv.Config.GetPushSubscriberUpdates() {
+		return &protos.Void{}, nil
+	}
+	imsi := req.GetSid().GetId()
+	if _, err := srv.getServingMME(imsi); err == nil {
+		if err = srv.sendIDR(imsi); err != nil {
+			glog.Errorf("Failed to send IDR for updated subscriber %s: %v", imsi, err)
+		}
+	}
+	return &protos.Void{}, nil
 }
 
 // DeleteSubscriber deletes a subscriber by their Id.
@@ -106,9 +126,11 @@ func (srv *HomeSubscriberServer) Start(started chan struct{}) error {
 	mux := sm.New(settings)
 	mux.HandleFunc("ALL", handleUnknownMessage) // default handler
 	mux.Handle(diam.AIR, srv.handleMessage(NewAIA))
-	mux.Handle(diam.ULR, srv.handleMessage(NewULA))
+	mux.Handle(diam.ULR, srv.handleULR())
 	mux.Handle(diam.MAR, srv.handleMessage(NewMAA))
 	mux.Handle(diam.SAR, srv.handleMessage(NewSAA))
+	mux.HandleFunc(diam.IDA, handleIDA)
+	mux.HandleFunc(diam.DSA, handleDSA)
 
 	server := &diam.Server{
 		Network: serverCfg.Protocol,
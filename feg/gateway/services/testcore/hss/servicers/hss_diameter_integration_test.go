@@ -153,8 +153,13 @@ func getTestHSSDiameterServer(t *testing.T) *hss.HomeSubscriberServer {
 // Inputs: The client handler function receives messages from the server
 // Outputs: a diameter connection to the server or an error
 func getConnectionToTestHSS(t *testing.T, clientHandler diam.HandlerFunc) (diam.Conn, error) {
-	hss := getTestHSSDiameterServer(t)
+	return connectToTestHSS(getTestHSSDiameterServer(t), clientHandler)
+}
 
+// connectToTestHSS creates a diameter connection to the given running test HSS
+// Inputs: The client handler function receives messages from the server
+// Outputs: a diameter connection to the server or an error
+func connectToTestHSS(server *hss.HomeSubscriberServer, clientHandler diam.HandlerFunc) (diam.Conn, error) {
 	// Create a client to receive the server's messages.
 	clientMux := sm.New(&sm.Settings{
 		OriginHost:       datatype.DiameterIdentity("magma.com"),
@@ -181,6 +186,6 @@ func getConnectionToTestHSS(t *testing.T, clientHandler diam.HandlerFunc) (diam.
 			}),
 		},
 	}
-	serverCfg := hss.Config.Server
+	serverCfg := server.Config.Server
 	return client.DialNetwork(serverCfg.Protocol, serverCfg.Address)
 }
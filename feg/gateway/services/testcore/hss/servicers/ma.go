@@ -70,7 +70,14 @@ func NewMAA(srv *HomeSubscriberServer, msg *diam.Message) (*diam.Message, error)
 		return ConvertAuthErrorToFailureMessage(err, msg, mar.SessionID, srv.Config.Server), err
 	}
 
-	if mar.AuthData.AuthScheme != swx.SipAuthScheme_EAP_AKA {
+	switch mar.AuthData.AuthScheme {
+	case swx.SipAuthScheme_EAP_AKA:
+	case swx.SipAuthScheme_EAP_SIM:
+		// SIM subscribers get GSM triplets, USIM subscribers get EAP-AKA vectors
+		if triplets := getGSMTriplets(subscriber, mar.NumberAuthItems); len(triplets) > 0 {
+			return srv.NewSuccessfulSimMAA(msg, mar.SessionID, datatype.UTF8String(mar.UserName), triplets), nil
+		}
+	default:
 		err = fmt.Errorf("Unsupported SIP authentication scheme: %s", mar.AuthData.AuthScheme)
 		return ConstructFailureAnswer(msg, mar.SessionID, srv.Config.Server, uint32(diam.UnableToComply)), err
 	}
@@ -114,6 +121,63 @@ func (srv *HomeSubscriberServer) NewSuccessfulMAA(msg *diam.Message, sessionID d
 	return maa
 }
 
+// NewSuccessfulSimMAA outputs a successful multimedia authentication answer (MAA) with GSM triplets
+// to reply to an EAP-SIM multimedia authentication request (MAR) message.
+func (srv *HomeSubscriberServer) NewSuccessfulSimMAA(msg *diam.Message, sessionID datatype.UTF8String, userName datatype.UTF8String, triplets []gsmTriplet) *diam.Message {
+	maa := ConstructSuccessAnswer(msg, sessionID, srv.Config.Server, diam.TGPP_SWX_APP_ID)
+	for itemNumber, triplet := range triplets {
+		maa.NewAVP(avp.SIPAuthDataItem, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, &diam.GroupedAVP{
+			AVP: []*diam.AVP{
+				diam.NewAVP(avp.SIPItemNumber, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Unsigned32(itemNumber)),
+				diam.NewAVP(avp.SIPAuthenticationScheme, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.UTF8String(swx.SipAuthScheme_EAP_SIM)),
+				diam.NewAVP(avp.SIPAuthenticate, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.OctetString(triplet.rand)),
+				diam.NewAVP(avp.SIPAuthorization, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.OctetString(triplet.sres)),
+				diam.NewAVP(avp.ConfidentialityKey, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.OctetString(triplet.kc)),
+			},
+		})
+	}
+	maa.NewAVP(avp.SIPNumberAuthItems, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Unsigned32(len(triplets)))
+	maa.NewAVP(avp.UserName, avp.Mbit, 0, userName)
+	return maa
+}
+
+// gsmTriplet is a GSM authentication triplet, see 3GPP TS 43.020 3.3.1
+type gsmTriplet struct {
+	rand, sres, kc []byte
+}
+
+const (
+	gsmRandLen    = 16
+	gsmSresLen    = 4
+	gsmKcLen      = 8
+	gsmTripletLen = gsmRandLen + gsmSresLen + gsmKcLen
+)
+
+// getGSMTriplets returns up to `numTriplets` precomputed GSM triplets of an active GSM subscription
+// or nil if the subscriber has none.
+func getGSMTriplets(subscriber *lteprotos.SubscriberData, numTriplets uint32) []gsmTriplet {
+	gsm := subscriber.GetGsm()
+	if gsm.GetState() != lteprotos.GSMSubscription_ACTIVE ||
+		gsm.GetAuthAlgo() != lteprotos.GSMSubscription_PRECOMPUTED_AUTH_TUPLES {
+		return nil
+	}
+	var triplets []gsmTriplet
+	for _, tuple := range gsm.GetAuthTuples() {
+		if uint32(len(triplets)) == numTriplets {
+			break
+		}
+		if len(tuple) != gsmTripletLen {
+			continue
+		}
+		triplets = append(triplets, gsmTriplet{
+			rand: tuple[:gsmRandLen],
+			sres: tuple[gsmRandLen : gsmRandLen+gsmSresLen],
+			kc:   tuple[gsmRandLen+gsmSresLen:],
+		})
+	}
+	return triplets
+}
+
 // GenerateSIPAuthVectors generates `numVectors` SIP auth vectors for the subscriber.
 // The vectors and the next value of lteAuthNextSeq are returned (or an error).
 func (srv *HomeSubscriberServer) GenerateSIPAuthVectors(subscriber *lteprotos.SubscriberData, numVectors uint32) ([]*crypto.SIPAuthVector, uint64, error) {
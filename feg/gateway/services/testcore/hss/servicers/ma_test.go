@@ -106,6 +106,48 @@ func TestNewMAA_MultipleVectors(t *testing.T) {
 	checkSIPAuthVectors(t, maa, 3)
 }
 
+func TestNewMAA_EapSim(t *testing.T) {
+	server := test.NewTestHomeSubscriberServer(t)
+
+	// USIM subscribers get EAP-AKA vectors
+	mar := createMARWithScheme("sub1", 2, definitions.SipAuthScheme_EAP_SIM)
+	response, err := hss.NewMAA(server, mar)
+	assert.NoError(t, err)
+	var maa definitions.MAA
+	err = response.Unmarshal(&maa)
+	assert.NoError(t, err)
+	checkSIPAuthVectors(t, maa, 2)
+
+	// SIM subscribers get their GSM triplets
+	subscriber, err := server.GetSubscriberData(context.Background(), &lteprotos.SubscriberID{Id: "sub1"})
+	assert.NoError(t, err)
+	triplet1 := []byte("rand0123456789ABsres" + "kc012345")
+	triplet2 := []byte("rand9876543210ABsres" + "kc543210")
+	subscriber.Gsm = &lteprotos.GSMSubscription{
+		State:      lteprotos.GSMSubscription_ACTIVE,
+		AuthTuples: [][]byte{triplet1, triplet2, []byte("invalid")},
+	}
+	_, err = server.UpdateSubscriber(context.Background(), subscriber)
+	assert.NoError(t, err)
+
+	mar = createMARWithScheme("sub1", 3, definitions.SipAuthScheme_EAP_SIM)
+	response, err = hss.NewMAA(server, mar)
+	assert.NoError(t, err)
+	maa = definitions.MAA{}
+	err = response.Unmarshal(&maa)
+	assert.NoError(t, err)
+	assert.Equal(t, diam.Success, int(maa.ResultCode))
+	assert.Equal(t, uint32(2), maa.SIPNumberAuthItems)
+	assert.Len(t, maa.SIPAuthDataItems, 2)
+	for i, triplet := range [][]byte{triplet1, triplet2} {
+		item := maa.SIPAuthDataItems[i]
+		assert.Equal(t, definitions.SipAuthScheme_EAP_SIM, item.AuthScheme)
+		assert.Equal(t, triplet[:16], item.Authenticate.Serialize())
+		assert.Equal(t, triplet[16:20], item.Authorization.Serialize())
+		assert.Equal(t, triplet[20:], item.ConfidentialityKey.Serialize())
+	}
+}
+
 func TestNewMAA_MissingAVP(t *testing.T) {
 	mar := createBaseMAR()
 	mar.NewAVP(avp.RATType, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Unsigned32(definitions.RadioAccessTechnologyType_WLAN))
@@ -230,13 +272,21 @@ func createMARWithSingleAuthItem(userName string) *diam.Message {
 }
 
 func createMARExtended(userName string, numberAuthItems uint32, ratType uint32) *diam.Message {
+	return createMARWithSchemeAndRAT(userName, numberAuthItems, definitions.SipAuthScheme_EAP_AKA, ratType)
+}
+
+func createMARWithScheme(userName string, numberAuthItems uint32, scheme string) *diam.Message {
+	return createMARWithSchemeAndRAT(userName, numberAuthItems, scheme, definitions.RadioAccessTechnologyType_WLAN)
+}
+
+func createMARWithSchemeAndRAT(userName string, numberAuthItems uint32, scheme string, ratType uint32) *diam.Message {
 	mar := createBaseMAR()
 	mar.NewAVP(avp.UserName, avp.Mbit, 0, datatype.UTF8String(userName))
 	mar.NewAVP(avp.RATType, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Unsigned32(ratType))
 	mar.NewAVP(avp.SIPNumberAuthItems, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Unsigned32(numberAuthItems))
 	mar.NewAVP(avp.SIPAuthDataItem, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, &diam.GroupedAVP{
 		AVP: []*diam.AVP{
-			diam.NewAVP(avp.SIPAuthenticationScheme, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.UTF8String("EAP-AKA")),
+			diam.NewAVP(avp.SIPAuthenticationScheme, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.UTF8String(scheme)),
 		},
 	})
 	return mar
@@ -0,0 +1,173 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package servicers
+
+import (
+	"magma/feg/cloud/go/protos"
+	"magma/feg/gateway/diameter"
+	s6a "magma/feg/gateway/services/s6a_proxy/servicers"
+	swx "magma/feg/gateway/services/swx_proxy/servicers"
+	"magma/feg/gateway/services/testcore/hss/storage"
+	lteprotos "magma/lte/cloud/go/protos"
+	orcprotos "magma/orc8r/cloud/go/protos"
+
+	"github.com/fiorix/go-diameter/diam"
+	"github.com/fiorix/go-diameter/diam/avp"
+	"github.com/fiorix/go-diameter/diam/datatype"
+	"github.com/fiorix/go-diameter/diam/dict"
+	"github.com/golang/glog"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// servingMME holds the diameter connection & identity of the MME which sent
+// the last Update-Location for a subscriber
+type servingMME struct {
+	conn  diam.Conn
+	host  datatype.DiameterIdentity
+	realm datatype.DiameterIdentity
+}
+
+// InsertSubscriberData sends an IDR with the subscriber's current profile to the
+// MME serving the subscriber.
+// Input: The id of the subscriber whose data should be pushed.
+func (srv *HomeSubscriberServer) InsertSubscriberData(ctx context.Context, req *lteprotos.SubscriberID) (*orcprotos.Void, error) {
+	err := srv.sendIDR(req.GetId())
+	return &orcprotos.Void{}, err
+}
+
+// DeleteSubscriberData sends a DSR to the MME serving the subscriber.
+// Input: The subscriber id, DSR-Flags & the APN context identifiers to delete.
+func (srv *HomeSubscriberServer) DeleteSubscriberData(ctx context.Context, req *protos.DeleteSubscriberDataRequest) (*orcprotos.Void, error) {
+	err := srv.sendDSR(req)
+	return &orcprotos.Void{}, err
+}
+
+// handleULR records the connection of the MME which sent the ULR before
+// replying with a ULA, so subscriber data changes can later be pushed to it.
+func (srv *HomeSubscriberServer) handleULR() diam.HandlerFunc {
+	replyHandler := srv.handleMessage(NewULA)
+	return func(conn diam.Conn, msg *diam.Message) {
+		var ulr s6a.ULR
+		if msg != nil && msg.Unmarshal(&ulr) == nil && len(ulr.UserName) > 0 {
+			srv.mmeMu.Lock()
+			srv.servingMMEs[string(ulr.UserName)] = &servingMME{conn: conn, host: ulr.OriginHost, realm: ulr.OriginRealm}
+			srv.mmeMu.Unlock()
+		}
+		replyHandler(conn, msg)
+	}
+}
+
+// handleIDA logs the result of an IDR sent to an MME.
+func handleIDA(_ diam.Conn, msg *diam.Message) {
+	var ida s6a.IDA
+	if err := msg.Unmarshal(&ida); err != nil {
+		glog.Errorf("IDA Unmarshal failed for message %s: %v", msg, err)
+		return
+	}
+	logSubscriberDataAnswer("IDA", ida.SessionID, ida.ResultCode, ida.ExperimentalResult.ExperimentalResultCode)
+}
+
+// handleDSA logs the result of a DSR sent to an MME.
+func handleDSA(_ diam.Conn, msg *diam.Message) {
+	var dsa s6a.DSA
+	if err := msg.Unmarshal(&dsa); err != nil {
+		glog.Errorf("DSA Unmarshal failed for message %s: %v", msg, err)
+		return
+	}
+	logSubscriberDataAnswer("DSA", dsa.SessionID, dsa.ResultCode, dsa.ExperimentalResult.ExperimentalResultCode)
+}
+
+func logSubscriberDataAnswer(cmd, sessionID string, resultCode, experimentalResultCode uint32) {
+	if resultCode != diam.Success {
+		glog.Errorf("%s for session %s failed; Result-Code: %d, Experimental-Result-Code: %d",
+			cmd, sessionID, resultCode, experimentalResultCode)
+		return
+	}
+	glog.V(2).Infof("%s for session %s succeeded", cmd, sessionID)
+}
+
+func (srv *HomeSubscriberServer) sendIDR(imsi string) error {
+	subscriber, err := srv.store.GetSubscriberData(imsi)
+	if err != nil {
+		return storage.ConvertStorageErrorToGrpcStatus(err)
+	}
+	profile, ok := srv.Config.SubProfiles[subscriber.SubProfile]
+	if !ok || profile == nil {
+		profile = srv.Config.DefaultSubProfile
+		if profile == nil {
+			return status.Errorf(codes.FailedPrecondition,
+				"unknown subscriber profile: %s and default profile was not initialized", subscriber.SubProfile)
+		}
+	}
+	mme, err := srv.getServingMME(imsi)
+	if err != nil {
+		return err
+	}
+	idr := srv.newSubscriberDataRequest(diam.InsertSubscriberData, imsi, mme)
+	idr.AddAVP(newSubscriptionDataAVP(profile))
+	return writeSubscriberDataRequest(idr, mme)
+}
+
+func (srv *HomeSubscriberServer) sendDSR(req *protos.DeleteSubscriberDataRequest) error {
+	imsi := req.GetUserName()
+	if _, err := srv.store.GetSubscriberData(imsi); err != nil {
+		return storage.ConvertStorageErrorToGrpcStatus(err)
+	}
+	mme, err := srv.getServingMME(imsi)
+	if err != nil {
+		return err
+	}
+	dsr := srv.newSubscriberDataRequest(diam.DeleteSubscriberData, imsi, mme)
+	dsr.NewAVP(avp.DSRFlags, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Unsigned32(req.GetDsrFlags()))
+	for _, contextID := range req.GetContextId() {
+		dsr.NewAVP(avp.ContextIdentifier, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Unsigned32(contextID))
+	}
+	return writeSubscriberDataRequest(dsr, mme)
+}
+
+func (srv *HomeSubscriberServer) getServingMME(imsi string) (*servingMME, error) {
+	srv.mmeMu.Lock()
+	defer srv.mmeMu.Unlock()
+	mme, ok := srv.servingMMEs[imsi]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "no serving MME for subscriber %s", imsi)
+	}
+	return mme, nil
+}
+
+// newSubscriberDataRequest creates an IDR or DSR with all the mandatory AVPs
+// except for the command specific ones.
+func (srv *HomeSubscriberServer) newSubscriberDataRequest(cmd uint32, imsi string, mme *servingMME) *diam.Message {
+	serverCfg := srv.Config.Server
+	req := diam.NewRequest(cmd, diam.TGPP_S6A_APP_ID, dict.Default)
+	req.NewAVP(avp.SessionID, avp.Mbit, 0, datatype.UTF8String(diameter.GenSessionID(serverCfg.DestHost, "s6a")))
+	req.NewAVP(avp.VendorSpecificApplicationID, avp.Mbit, 0, &diam.GroupedAVP{
+		AVP: []*diam.AVP{
+			diam.NewAVP(avp.VendorID, avp.Mbit, 0, datatype.Unsigned32(diameter.Vendor3GPP)),
+			diam.NewAVP(avp.AuthApplicationID, avp.Mbit, 0, datatype.Unsigned32(diam.TGPP_S6A_APP_ID)),
+		},
+	})
+	req.NewAVP(avp.AuthSessionState, avp.Mbit, 0, datatype.Enumerated(swx.AuthSessionState_NO_STATE_MAINTAINED))
+	req.NewAVP(avp.OriginHost, avp.Mbit, 0, datatype.DiameterIdentity(serverCfg.DestHost))
+	req.NewAVP(avp.OriginRealm, avp.Mbit, 0, datatype.DiameterIdentity(serverCfg.DestRealm))
+	req.NewAVP(avp.DestinationHost, avp.Mbit, 0, mme.host)
+	req.NewAVP(avp.DestinationRealm, avp.Mbit, 0, mme.realm)
+	req.NewAVP(avp.UserName, avp.Mbit, 0, datatype.UTF8String(imsi))
+	return req
+}
+
+func writeSubscriberDataRequest(req *diam.Message, mme *servingMME) error {
+	glog.V(2).Infof("Sending subscriber data request: %s", req)
+	if _, err := req.WriteTo(mme.conn); err != nil {
+		return status.Errorf(codes.Unavailable, "failed to send %s: %v", req.Header, err)
+	}
+	return nil
+}
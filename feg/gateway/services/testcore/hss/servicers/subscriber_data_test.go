@@ -0,0 +1,124 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package servicers_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"magma/feg/cloud/go/protos"
+	s6a "magma/feg/gateway/services/s6a_proxy/servicers"
+	hss "magma/feg/gateway/services/testcore/hss/servicers"
+	"magma/feg/gateway/services/testcore/hss/servicers/test"
+	lteprotos "magma/lte/cloud/go/protos"
+
+	"github.com/fiorix/go-diameter/diam"
+	"github.com/fiorix/go-diameter/diam/datatype"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestHomeSubscriberServer_InsertSubscriberData(t *testing.T) {
+	server := getTestHSSDiameterServer(t)
+
+	// No ULR was received for the subscriber yet
+	_, err := server.InsertSubscriberData(context.Background(), &lteprotos.SubscriberID{Id: "sub1"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	requests := connectAndAttach(t, server, "sub1")
+	_, err = server.InsertSubscriberData(context.Background(), &lteprotos.SubscriberID{Id: "sub1"})
+	assert.NoError(t, err)
+
+	msg := waitForRequest(t, requests)
+	assert.Equal(t, uint32(diam.InsertSubscriberData), msg.Header.CommandCode)
+	var idr s6a.IDR
+	assert.NoError(t, msg.Unmarshal(&idr))
+	assert.Equal(t, "sub1", idr.UserName)
+	assert.Equal(t, datatype.DiameterIdentity("magma.com"), idr.DestinationHost)
+	assert.Equal(t, datatype.DiameterIdentity("magma.com"), idr.DestinationRealm)
+	assert.Equal(t, datatype.OctetString("12345"), idr.SubscriptionData.MSISDN)
+	assert.Equal(t, uint32(test.DefaultMaxUlBitRate), idr.SubscriptionData.AMBR.MaxRequestedBandwidthUL)
+	assert.Equal(t, uint32(test.DefaultMaxDlBitRate), idr.SubscriptionData.AMBR.MaxRequestedBandwidthDL)
+	assert.Equal(t, 1, len(idr.SubscriptionData.APNConfigurationProfile.APNConfigs))
+
+	// Updated profiles are only pushed to the serving MME if configured
+	sub, err := server.GetSubscriberData(context.Background(), &lteprotos.SubscriberID{Id: "sub1"})
+	assert.NoError(t, err)
+	_, err = server.UpdateSubscriber(context.Background(), sub)
+	assert.NoError(t, err)
+	select {
+	case msg = <-requests:
+		assert.Fail(t, "unexpected HSS request", "%v", msg)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	server.Config.PushSubscriberUpdates = true
+	_, err = server.UpdateSubscriber(context.Background(), sub)
+	assert.NoError(t, err)
+	msg = waitForRequest(t, requests)
+	assert.Equal(t, uint32(diam.InsertSubscriberData), msg.Header.CommandCode)
+
+	_, err = server.InsertSubscriberData(context.Background(), &lteprotos.SubscriberID{Id: "sub_unknown"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestHomeSubscriberServer_DeleteSubscriberData(t *testing.T) {
+	server := getTestHSSDiameterServer(t)
+	requests := connectAndAttach(t, server, "sub1")
+
+	_, err := server.DeleteSubscriberData(
+		context.Background(),
+		&protos.DeleteSubscriberDataRequest{UserName: "sub1", DsrFlags: 1, ContextId: []uint32{1, 2}})
+	assert.NoError(t, err)
+
+	msg := waitForRequest(t, requests)
+	assert.Equal(t, uint32(diam.DeleteSubscriberData), msg.Header.CommandCode)
+	var dsr s6a.DSR
+	assert.NoError(t, msg.Unmarshal(&dsr))
+	assert.Equal(t, "sub1", dsr.UserName)
+	assert.Equal(t, uint32(1), dsr.DSRFlags)
+	assert.Equal(t, []uint32{1, 2}, dsr.ContextIdentifiers)
+}
+
+// connectAndAttach connects a test client to the HSS & sends a ULR for the given
+// subscriber, making the client the subscriber's serving MME.
+// It returns a channel which receives all requests sent to the client by the HSS.
+func connectAndAttach(t *testing.T, server *hss.HomeSubscriberServer, userName string) chan *diam.Message {
+	answers := make(chan *diam.Message, 1)
+	requests := make(chan *diam.Message, 4)
+	conn, err := connectToTestHSS(server, func(conn diam.Conn, msg *diam.Message) {
+		if msg.Header.CommandFlags&diam.RequestFlag != 0 {
+			requests <- msg
+		} else {
+			answers <- msg
+		}
+	})
+	assert.NoError(t, err)
+
+	_, err = createULR(userName).WriteTo(conn)
+	assert.NoError(t, err)
+	select {
+	case <-answers:
+	case <-time.After(time.Second):
+		assert.Fail(t, "service timed out before receiving ULA")
+	}
+	return requests
+}
+
+func waitForRequest(t *testing.T, requests chan *diam.Message) *diam.Message {
+	select {
+	case msg := <-requests:
+		return msg
+	case <-time.After(time.Second):
+		assert.FailNow(t, "timed out waiting for HSS request")
+	}
+	return nil
+}
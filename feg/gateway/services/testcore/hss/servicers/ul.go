@@ -85,7 +85,13 @@ func NewULA(srv *HomeSubscriberServer, msg *diam.Message) (*diam.Message, error)
 func (srv *HomeSubscriberServer) NewSuccessfulULA(msg *diam.Message, sessionID datatype.UTF8String, profile *mconfig.HSSConfig_SubscriptionProfile) *diam.Message {
 	ula := ConstructSuccessAnswer(msg, sessionID, srv.Config.Server, diam.TGPP_S6A_APP_ID)
 	ula.NewAVP(avp.ULAFlags, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Unsigned32(ulaFlags))
-	ula.NewAVP(avp.SubscriptionData, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, &diam.GroupedAVP{
+	ula.AddAVP(newSubscriptionDataAVP(profile))
+	return ula
+}
+
+// newSubscriptionDataAVP creates a Subscription-Data AVP for the given subscriber profile.
+func newSubscriptionDataAVP(profile *mconfig.HSSConfig_SubscriptionProfile) *diam.AVP {
+	return diam.NewAVP(avp.SubscriptionData, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, &diam.GroupedAVP{
 		AVP: []*diam.AVP{
 			diam.NewAVP(avp.MSISDN, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.OctetString(msisdn)),
 			diam.NewAVP(avp.AccessRestrictionData, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Unsigned32(accessRestrictionData)),
@@ -130,7 +136,6 @@ func (srv *HomeSubscriberServer) NewSuccessfulULA(msg *diam.Message, sessionID d
 			}),
 		},
 	})
-	return ula
 }
 
 // ValidateULR returns an error if the message is missing any mandatory AVPs.
@@ -51,11 +51,12 @@ type SubscriberAccount struct {
 }
 
 type OCSConfig struct {
-	MaxUsageBytes uint32
-	MaxUsageTime  uint32
-	ValidityTime  uint32
-	ServerConfig  *diameter.DiameterServerConfig
-	GyInitMethod  gy.InitMethod
+	MaxUsageBytes       uint32
+	MaxUsageTime        uint32
+	ValidityTime        uint32
+	ServerConfig        *diameter.DiameterServerConfig
+	GyInitMethod        gy.InitMethod
+	FinalUnitIndication *protos.FinalUnitIndication // Terminate action is sent if nil
 }
 
 // OCSDiamServer wraps an OCS storing subscriber accounts and their credit
@@ -187,6 +188,7 @@ func (srv *OCSDiamServer) CreateAccount(
 // Input: *uint32 optional maximum bytes to return in a CCA
 //			  *uint32 optional maximum time to return in a CCA
 //			  *uint32 optional credit validity time to return in a CCA
+//			  *FinalUnitIndication optional final unit action to return with final credits
 func (srv *OCSDiamServer) SetOCSSettings(
 	ctx context.Context,
 	ocsConfig *protos.OCSConfig,
@@ -195,6 +197,7 @@ func (srv *OCSDiamServer) SetOCSSettings(
 	config.MaxUsageBytes = ocsConfig.MaxUsageBytes
 	config.MaxUsageTime = ocsConfig.MaxUsageTime
 	config.ValidityTime = ocsConfig.ValidityTime
+	config.FinalUnitIndication = ocsConfig.FinalUnitIndication
 	return &orcprotos.Void{}, nil
 }
 
@@ -345,6 +348,7 @@ func getCCRHandler(srv *OCSDiamServer) diam.HandlerFunc {
 				srv.ocsConfig.ValidityTime,
 				returnBytes,
 				final,
+				srv.ocsConfig.FinalUnitIndication,
 			))
 		}
 
@@ -352,7 +356,13 @@ func getCCRHandler(srv *OCSDiamServer) diam.HandlerFunc {
 	}
 }
 
-func getGrantedUnitAVP(ratingGroup uint32, validityTime uint32, returnBytes uint32, isFinal bool) *diam.AVP {
+func getGrantedUnitAVP(
+	ratingGroup uint32,
+	validityTime uint32,
+	returnBytes uint32,
+	isFinal bool,
+	fui *protos.FinalUnitIndication,
+) *diam.AVP {
 	creditGroup := &diam.GroupedAVP{
 		AVP: []*diam.AVP{
 			diam.NewAVP(avp.GrantedServiceUnit, avp.Mbit, 0, &diam.GroupedAVP{
@@ -365,18 +375,38 @@ func getGrantedUnitAVP(ratingGroup uint32, validityTime uint32, returnBytes uint
 		},
 	}
 	if isFinal {
-		creditGroup.AddAVP(
-			diam.NewAVP(avp.FinalUnitIndication, avp.Mbit, 0, &diam.GroupedAVP{
-				AVP: []*diam.AVP{
-					// TODO support other final unit actions
-					diam.NewAVP(avp.FinalUnitAction, avp.Mbit, 0, datatype.Enumerated(TerminateAction)),
-				},
-			}),
-		)
+		creditGroup.AddAVP(getFinalUnitIndicationAVP(fui))
 	}
 	return diam.NewAVP(avp.MultipleServicesCreditControl, avp.Mbit, 0, creditGroup)
 }
 
+// getFinalUnitIndicationAVP returns Final-Unit-Indication AVP for the given
+// configuration, nil configuration results in a terminate action
+func getFinalUnitIndicationAVP(fui *protos.FinalUnitIndication) *diam.AVP {
+	if fui == nil {
+		fui = &protos.FinalUnitIndication{FinalUnitAction: TerminateAction}
+	}
+	fuiGroup := &diam.GroupedAVP{
+		AVP: []*diam.AVP{
+			diam.NewAVP(avp.FinalUnitAction, avp.Mbit, 0, datatype.Enumerated(fui.FinalUnitAction)),
+		},
+	}
+	for _, rule := range fui.RestrictRules {
+		fuiGroup.AddAVP(diam.NewAVP(avp.FilterID, avp.Mbit, 0, datatype.UTF8String(rule)))
+	}
+	if fui.RedirectServer != nil {
+		fuiGroup.AddAVP(diam.NewAVP(avp.RedirectServer, avp.Mbit, 0, &diam.GroupedAVP{
+			AVP: []*diam.AVP{
+				diam.NewAVP(avp.RedirectAddressType, avp.Mbit, 0,
+					datatype.Enumerated(fui.RedirectServer.RedirectAddressType)),
+				diam.NewAVP(avp.RedirectServerAddress, avp.Mbit, 0,
+					datatype.UTF8String(fui.RedirectServer.RedirectServerAddress)),
+			},
+		}))
+	}
+	return diam.NewAVP(avp.FinalUnitIndication, avp.Mbit, 0, fuiGroup)
+}
+
 func shouldReturnCredit(requestType credit_control.CreditRequestType) bool {
 	return requestType == credit_control.CRTUpdate || requestType == credit_control.CRTInit
 }
@@ -45,6 +45,8 @@ type OCSSettingsJSON struct {
 	MaxUsageBytes *uint32 `json:"max_usage_bytes,omitempty"`
 	MaxUsageTime  *uint32 `json:"max_usage_time,omitempty"`
 	ValidityTime  *uint32 `json:"validity_time,omitempty"`
+
+	FinalUnitIndication *protos.FinalUnitIndication `json:"final_unit_indication,omitempty"`
 }
 
 // NewOCSRestServer initializes a new REST server and diam server.
@@ -192,6 +194,11 @@ func postResetHandler(srv *OCSRestServer) http.HandlerFunc {
 // 	"max_usage_bytes": 2048, // bytes, optional
 // 	"max_usage_time": 1000, // seconds, optional
 // 	"validity_time": 3600, // seconds, optional
+// 	"final_unit_indication": { // optional, terminate action is used if missing
+// 		"final_unit_action": 1, // 0 - TERMINATE, 1 - REDIRECT, 2 - RESTRICT_ACCESS
+// 		"restrict_rules": ["restrict-rule"],
+// 		"redirect_server": {"redirect_address_type": 2, "redirect_server_address": "http://topup.portal"},
+// 	},
 // }
 func postSettingsHandler(srv *OCSRestServer) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
@@ -208,6 +215,8 @@ func postSettingsHandler(srv *OCSRestServer) http.HandlerFunc {
 				MaxUsageBytes: *settings.MaxUsageBytes,
 				MaxUsageTime:  *settings.MaxUsageTime,
 				ValidityTime:  *settings.ValidityTime,
+
+				FinalUnitIndication: settings.FinalUnitIndication,
 			},
 		)
 		glog.V(2).Infof("Updated OCS settings")
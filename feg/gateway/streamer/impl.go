@@ -31,6 +31,9 @@ const (
 type listener struct {
 	Listener
 	done int32
+	// cursor of the last update batch applied by the listener, used to resume
+	// incremental streams (accessed by the listener's streaming routine only)
+	cursor string
 }
 
 type streamerClient struct {
@@ -106,6 +109,9 @@ func (cl *streamerClient) streamUpdates(l *listener) {
 				}
 				if !l.Update(updatesBatch) {
 					l.setDone() // Listener indicated not to continue streaming, cleanup and return
+				} else if len(updatesBatch.GetCursor()) > 0 {
+					// Incremental stream, the cloud will push next changes over the open stream
+					l.cursor = updatesBatch.GetCursor()
 				} else {
 					time.Sleep(StreamingInterval)
 				}
@@ -127,7 +133,7 @@ func (cl *streamerClient) startStreaming(l *listener) (*grpc.ClientConn, protos.
 	}
 	grpcStreamerClient, err := protos.NewStreamerClient(conn).GetUpdates(
 		context.Background(),
-		&protos.StreamRequest{GatewayId: "", StreamName: l.GetName()},
+		&protos.StreamRequest{GatewayId: "", StreamName: l.GetName(), Cursor: l.cursor},
 	)
 	if err != nil {
 		conn.Close()
@@ -8,6 +8,7 @@ package avp
 
 // Diameter AVP types.
 const (
+	AAAFailureIndication                       = 1518
 	AccessNetworkChargingAddress               = 501
 	AccessNetworkChargingIdentifierGx          = 1022
 	AccessNetworkChargingIdentifierValue       = 503
@@ -172,6 +173,7 @@ const (
 	DeferredLocationEventType                  = 1230
 	DeliveryReportRequested                    = 1216
 	DeliveryStatus                             = 2104
+	DeregistrationReason                       = 615
 	DestinationHost                            = 293
 	DestinationInterface                       = 2002
 	DestinationRealm                           = 283
@@ -181,6 +183,8 @@ const (
 	DomainName                                 = 1200
 	DRMContent                                 = 1221
 	DRMP                                       = 301
+	DSAFlags                                   = 1422
+	DSRFlags                                   = 1421
 	DynamicAddressFlag                         = 2051
 	DynamicAddressFlagExtension                = 2068
 	EarlyMediaDescription                      = 1272
@@ -253,6 +257,8 @@ const (
 	HostIPAddress                              = 257
 	HPLMNODB                                   = 1418
 	ICSIndicator                               = 1491
+	IDAFlags                                   = 1441
+	IDRFlags                                   = 1490
 	IdleTimeout                                = 28
 	IMEI                                       = 1402
 	ImmediateResponsePreferred                 = 1412
@@ -475,7 +481,9 @@ const (
 	RATType                                    = 1032
 	ReadReplyReportRequested                   = 1222
 	RealTimeTariffInformation                  = 2305
+	ReasonCode                                 = 616
 	ReasonHeader                               = 3401
+	ReasonInfo                                 = 617
 	ReAuthRequestType                          = 285
 	ReceivedTalkBurstTime                      = 1284
 	ReceivedTalkBurstVolume                    = 1285
@@ -511,6 +519,7 @@ const (
 	RequiredMBMSBearerCapabilities             = 901
 	RestrictionFilterRule                      = 438
 	ResultCode                                 = 268
+	RevalidationTime                           = 1042
 	ResynchronizationInfo                      = 1411
 	RoamingRestrictedDueToUnsupportedFeature   = 1457
 	RoleOfNode                                 = 829
@@ -16,11 +16,15 @@ const (
 	CapabilitiesExchange      = 257
 	CreditControl             = 272
 	DeviceWatchdog            = 280
+	DeleteSubscriberData      = 320
 	DisconnectPeer            = 282
+	InsertSubscriberData      = 319
 	MultimediaAuthentication  = 303
 	Notify                    = 323
 	PurgeUE                   = 321
+	PushProfile               = 305
 	ReAuth                    = 258
+	RegistrationTermination   = 304
 	Reset                     = 322
 	ServerAssignment          = 301
 	SessionTermination        = 275
@@ -45,18 +49,26 @@ const (
 	CLR = "CLR"
 	DPA = "DPA"
 	DPR = "DPR"
+	DSA = "DSA"
+	DSR = "DSR"
 	DWA = "DWA"
 	DWR = "DWR"
+	IDA = "IDA"
+	IDR = "IDR"
 	MAA = "MAA"
 	MAR = "MAR"
 	NOA = "NOA"
 	NOR = "NOR"
+	PPA = "PPA"
+	PPR = "PPR"
 	PUA = "PUA"
 	PUR = "PUR"
 	RAA = "RAA"
 	RAR = "RAR"
 	RSA = "RSA"
 	RSR = "RSR"
+	RTA = "RTA"
+	RTR = "RTR"
 	SAA = "SAA"
 	SAR = "SAR"
 	STA = "STA"
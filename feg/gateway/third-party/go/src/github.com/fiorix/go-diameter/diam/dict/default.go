@@ -784,8 +784,8 @@ var creditcontrolXML = `<?xml version="1.0" encoding="UTF-8"?>
 			<!-- http://tools.ietf.org/html/rfc4006#section-8.34 -->
 			<data type="Grouped">
 				<rule avp="Final-Unit-Action" required="true" max="1"/>
-				<rule avp="Restriction-Filter-Rule" required="false" max="1"/>
-				<rule avp="Filter-Id" required="false" max="1"/>
+				<rule avp="Restriction-Filter-Rule" required="false"/>
+				<rule avp="Filter-Id" required="false"/>
 				<rule avp="Redirect-Server" required="false" max="1"/>
 			</data>
 		</avp>
@@ -1066,6 +1066,8 @@ var gxcreditcontrolXML = `<?xml version="1.0" encoding="UTF-8"?>
                 <rule avp="QoS-Information" required="false" max="1"/>
                 <rule avp="TGPP-SGSN-MCC-MNC" required="false" max="1"/>
                 <rule avp="TGPP-User-Location-Info" required="false" max="1"/>
+                <rule avp="Event-Trigger" required="false"/>
+                <rule avp="Usage-Monitoring-Information" required="false"/>
             </request>
             <answer>
                 <!-- 3GPP 29.212 Section 5.6.3 -->
@@ -1083,6 +1085,7 @@ var gxcreditcontrolXML = `<?xml version="1.0" encoding="UTF-8"?>
                 <rule avp="Charging-Rule-Remove" required="false"/>
                 <rule avp="Usage-Monitoring-Information" required="false"/>
                 <rule avp="Event-Trigger" required="false"/>
+                <rule avp="Revalidation-Time" required="false" max="1"/>
             </answer>
         </command>
 
@@ -1199,6 +1202,11 @@ var gxcreditcontrolXML = `<?xml version="1.0" encoding="UTF-8"?>
             </data>
         </avp>
 
+        <avp name="Revalidation-Time" code="1042" must="M,V" may="P" may-encrypt="Y" vendor-id="10415">
+            <!-- 3GPP 29.212 Section 5.3.41 -->
+            <data type="Time"/>
+        </avp>
+
         <avp name="Precedence" code="1010" must="M,V" may="P" may-encrypt="y" vendor-id="10415">
             <!-- 3GPP 29.212 -->
             <data type="Unsigned32"/>
@@ -4978,6 +4986,148 @@ var tgpps6aXML = `<?xml version="1.0" encoding="UTF-8"?>
             </answer>
         </command>
 
+        <command code="319" short="ID" name="Insert-Subscriber-Data">
+            <!--
+              < Insert-Subscriber-Data-Request> ::= < Diameter Header: 319, REQ, PXY, 16777251 >
+                < Session-Id >
+                [ DRMP ]
+                [ Vendor-Specific-Application-Id ]
+                { Auth-Session-State }
+                { Origin-Host }
+                { Origin-Realm }
+                { Destination-Host }
+                { Destination-Realm }
+                { User-Name }
+                *[ Supported-Features ]
+                { Subscription-Data }
+                [ IDR-Flags ]
+                *[ AVP ]
+                *[ Proxy-Info ]
+                *[ Route-Record ]
+            -->
+            <request>
+                <rule avp="Session-Id" required="true" max="1" />
+                <rule avp="DRMP" required="false" max="1" />
+                <rule avp="Vendor-Specific-Application-Id" required="false" max="1" />
+                <rule avp="Auth-Session-State" required="true" max="1" />
+                <rule avp="Origin-Host" required="true" max="1" />
+                <rule avp="Origin-Realm" required="true" max="1" />
+                <rule avp="Destination-Host" required="true" max="1" />
+                <rule avp="Destination-Realm" required="true" max="1" />
+                <rule avp="User-Name" required="true" max="1" />
+                <rule avp="Supported-Features" required="false" />
+                <rule avp="Subscription-Data" required="true" max="1" />
+                <rule avp="IDR-Flags" required="false" max="1" />
+                <rule avp="Proxy-Info" required="false" />
+                <rule avp="Route-Record" required="false" />
+            </request>
+            <!--
+              < Insert-Subscriber-Data-Answer> ::= < Diameter Header: 319, PXY, 16777251 >
+                < Session-Id >
+                [ DRMP ]
+                [ Vendor-Specific-Application-Id ]
+                *[ Supported-Features ]
+                [ Result-Code ]
+                [ Experimental-Result ]
+                { Auth-Session-State }
+                { Origin-Host }
+                { Origin-Realm }
+                [ RAT-Type ]
+                [ IDA-Flags ]
+                *[ AVP ]
+                [ Failed-AVP ]
+                *[ Proxy-Info ]
+                *[ Route-Record ]
+            -->
+            <answer>
+                <rule avp="Session-Id" required="true" max="1" />
+                <rule avp="DRMP" required="false" max="1" />
+                <rule avp="Vendor-Specific-Application-Id" required="false" max="1" />
+                <rule avp="Supported-Features" required="false" />
+                <rule avp="Result-Code" required="false" max="1" />
+                <rule avp="Experimental-Result" required="false" max="1" />
+                <rule avp="Auth-Session-State" required="true" max="1" />
+                <rule avp="Origin-Host" required="true" max="1" />
+                <rule avp="Origin-Realm" required="true" max="1" />
+                <rule avp="RAT-Type" required="false" max="1" />
+                <rule avp="IDA-Flags" required="false" max="1" />
+                <rule avp="Failed-AVP" required="false" max="1" />
+                <rule avp="Proxy-Info" required="false" />
+                <rule avp="Route-Record" required="false" />
+            </answer>
+        </command>
+
+        <command code="320" short="DS" name="Delete-Subscriber-Data">
+            <!--
+              < Delete-Subscriber-Data-Request > ::= < Diameter Header: 320, REQ, PXY, 16777251 >
+                < Session-Id >
+                [ DRMP ]
+                [ Vendor-Specific-Application-Id ]
+                { Auth-Session-State }
+                { Origin-Host }
+                { Origin-Realm }
+                { Destination-Host }
+                { Destination-Realm }
+                { User-Name }
+                *[ Supported-Features ]
+                { DSR-Flags }
+                *[ Context-Identifier ]
+                [ Trace-Reference ]
+                *[ AVP ]
+                *[ Proxy-Info ]
+                *[ Route-Record ]
+            -->
+            <request>
+                <rule avp="Session-Id" required="true" max="1" />
+                <rule avp="DRMP" required="false" max="1" />
+                <rule avp="Vendor-Specific-Application-Id" required="false" max="1" />
+                <rule avp="Auth-Session-State" required="true" max="1" />
+                <rule avp="Origin-Host" required="true" max="1" />
+                <rule avp="Origin-Realm" required="true" max="1" />
+                <rule avp="Destination-Host" required="true" max="1" />
+                <rule avp="Destination-Realm" required="true" max="1" />
+                <rule avp="User-Name" required="true" max="1" />
+                <rule avp="Supported-Features" required="false" />
+                <rule avp="DSR-Flags" required="true" max="1" />
+                <rule avp="Context-Identifier" required="false" />
+                <rule avp="Trace-Reference" required="false" max="1" />
+                <rule avp="Proxy-Info" required="false" />
+                <rule avp="Route-Record" required="false" />
+            </request>
+            <!--
+              < Delete-Subscriber-Data-Answer> ::= < Diameter Header: 320, PXY, 16777251 >
+                < Session-Id >
+                [ DRMP ]
+                [ Vendor-Specific-Application-Id ]
+                *[ Supported-Features ]
+                [ Result-Code ]
+                [ Experimental-Result ]
+                { Auth-Session-State }
+                { Origin-Host }
+                { Origin-Realm }
+                [ DSA-Flags ]
+                *[ AVP ]
+                [ Failed-AVP ]
+                *[ Proxy-Info ]
+                *[ Route-Record ]
+            -->
+            <answer>
+                <rule avp="Session-Id" required="true" max="1" />
+                <rule avp="DRMP" required="false" max="1" />
+                <rule avp="Vendor-Specific-Application-Id" required="false" max="1" />
+                <rule avp="Supported-Features" required="false" />
+                <rule avp="Result-Code" required="false" max="1" />
+                <rule avp="Experimental-Result" required="false" max="1" />
+                <rule avp="Auth-Session-State" required="true" max="1" />
+                <rule avp="Origin-Host" required="true" max="1" />
+                <rule avp="Origin-Realm" required="true" max="1" />
+                <rule avp="DSA-Flags" required="false" max="1" />
+                <rule avp="Failed-AVP" required="false" max="1" />
+                <rule avp="Proxy-Info" required="false" />
+                <rule avp="Route-Record" required="false" />
+            </answer>
+        </command>
+
         <command code="321" short="PU" name="Purge-UE">
             <!--
                 < Purge-UE-Request> ::=	< Diameter Header: 321, REQ, PXY, 16777251 >
@@ -5806,6 +5956,22 @@ var tgpps6aXML = `<?xml version="1.0" encoding="UTF-8"?>
             <data type="Unsigned32"/>
         </avp>
 
+        <avp name="IDR-Flags" code="1490" must="V" must-not="M" may-encrypt="N" vendor-id="10415">
+            <data type="Unsigned32"/>
+        </avp>
+
+        <avp name="IDA-Flags" code="1441" must="M,V" may-encrypt="N" vendor-id="10415">
+            <data type="Unsigned32"/>
+        </avp>
+
+        <avp name="DSR-Flags" code="1421" must="M,V" may-encrypt="N" vendor-id="10415">
+            <data type="Unsigned32"/>
+        </avp>
+
+        <avp name="DSA-Flags" code="1422" must="M,V" may-encrypt="N" vendor-id="10415">
+            <data type="Unsigned32"/>
+        </avp>
+
         <avp name="UE-SRVCC-Capability" code="1615" must="V" must-not="M" may-encrypt="N" vendor-id="10415">
             <data type="Enumerated">
                 <item code="0" name="UE-SRVCC-NOT-SUPPORTED"/>
@@ -5985,6 +6151,91 @@ var tgppswxXML = `<?xml version="1.0" encoding="UTF-8"?>
             </answer>
         </command>
 
+        <command code="304" short="RT" name="Registration-Termination">
+            <request>
+                <!-- http://www.qtc.jp/3GPP/Specs/29273-920.pdf Section 8.2.2.2 -->
+                <rule avp="Session-Id" required="true" max="1"/>
+                <rule avp="Vendor-Specific-Application-Id" required="true" max="1"/>
+                <rule avp="Auth-Session-State" required="true" max="1"/>
+                <rule avp="Origin-Host" required="true" max="1"/>
+                <rule avp="Origin-Realm" required="true" max="1"/>
+                <rule avp="Destination-Host" required="true" max="1"/>
+                <rule avp="Destination-Realm" required="true" max="1"/>
+                <rule avp="User-Name" required="true" max="1"/>
+                <rule avp="Deregistration-Reason" required="true" max="1"/>
+                <rule avp="Supported-Features" required="false"/>
+                <rule avp="AVP" required="false"/>
+            </request>
+            <answer>
+                <!-- http://www.qtc.jp/3GPP/Specs/29273-920.pdf Section 8.2.2.2 -->
+                <rule avp="Session-Id" required="true" max="1"/>
+                <rule avp="Vendor-Specific-Application-Id" required="true" max="1"/>
+                <rule avp="Result-Code" required="false" max="1"/>
+                <rule avp="Experimental-Result" required="false" max="1"/>
+                <rule avp="Auth-Session-State" required="true" max="1"/>
+                <rule avp="Origin-Host" required="true" max="1"/>
+                <rule avp="Origin-Realm" required="true" max="1"/>
+                <rule avp="Supported-Features" required="false"/>
+                <rule avp="AVP" required="false"/>
+            </answer>
+        </command>
+
+        <command code="305" short="PP" name="Push-Profile">
+            <request>
+                <!-- http://www.qtc.jp/3GPP/Specs/29273-920.pdf Section 8.2.2.4 -->
+                <rule avp="Session-Id" required="true" max="1"/>
+                <rule avp="Vendor-Specific-Application-Id" required="true" max="1"/>
+                <rule avp="Auth-Session-State" required="true" max="1"/>
+                <rule avp="Origin-Host" required="true" max="1"/>
+                <rule avp="Origin-Realm" required="true" max="1"/>
+                <rule avp="Destination-Host" required="true" max="1"/>
+                <rule avp="Destination-Realm" required="true" max="1"/>
+                <rule avp="User-Name" required="true" max="1"/>
+                <rule avp="Non-3GPP-User-Data" required="false" max="1"/>
+                <rule avp="AAA-Failure-Indication" required="false" max="1"/>
+                <rule avp="Supported-Features" required="false"/>
+                <rule avp="AVP" required="false"/>
+            </request>
+            <answer>
+                <!-- http://www.qtc.jp/3GPP/Specs/29273-920.pdf Section 8.2.2.4 -->
+                <rule avp="Session-Id" required="true" max="1"/>
+                <rule avp="Vendor-Specific-Application-Id" required="true" max="1"/>
+                <rule avp="Result-Code" required="false" max="1"/>
+                <rule avp="Experimental-Result" required="false" max="1"/>
+                <rule avp="Auth-Session-State" required="true" max="1"/>
+                <rule avp="Origin-Host" required="true" max="1"/>
+                <rule avp="Origin-Realm" required="true" max="1"/>
+                <rule avp="Supported-Features" required="false"/>
+                <rule avp="AVP" required="false"/>
+            </answer>
+        </command>
+
+        <avp name="Deregistration-Reason" code="615" must="M,V" may-encrypt="N" vendor-id="10415">
+            <!-- http://www.qtc.jp/3GPP/Specs/29273-920.pdf Section 8.2.3.3 -->
+            <data type="Grouped">
+                <rule avp="Reason-Code" required="true" max="1"/>
+                <rule avp="Reason-Info" required="false" max="1"/>
+            </data>
+        </avp>
+
+        <avp name="Reason-Code" code="616" must="M,V" may-encrypt="N" vendor-id="10415">
+            <data type="Enumerated">
+                <item code="0" name="PERMANENT_TERMINATION"/>
+                <item code="1" name="NEW_SERVER_ASSIGNED"/>
+                <item code="2" name="SERVER_CHANGE"/>
+                <item code="3" name="REMOVE_S-CSCF"/>
+            </data>
+        </avp>
+
+        <avp name="Reason-Info" code="617" must="M,V" may-encrypt="N" vendor-id="10415">
+            <data type="UTF8String"/>
+        </avp>
+
+        <avp name="AAA-Failure-Indication" code="1518" must="V" must-not="M" may-encrypt="N" vendor-id="10415">
+            <!-- http://www.qtc.jp/3GPP/Specs/29273-920.pdf Section 8.2.3.21 -->
+            <data type="Unsigned32"/>
+        </avp>
+
         <avp name="RAT-Type" code="1032" must="M,V" may="P" may-encrypt="Y" vendor-id="10415">
             <!-- http://www.qtc.jp/3GPP/Specs/29273-920.pdf Section 5.2.3.6 -->
             <data type="Enumerated">
@@ -27,13 +27,13 @@ func main() {
 	// setting up flags of the CLI
 	helpPtr := flag.Bool("help", false, "[optional] Display this help message")
 	cmdPtr := flag.String("rpcCall", "", "[required] The RPC call on the service. "+
-		"{CLR|RSR}")
+		"{CLR|RSR|DSR}")
 
 	// setting up helper message of the CLI
 	flag.Usage = func() {
 		fmt.Println("Usage:")
 		fmt.Println("	gw_s6a_service_cli [-h] " +
-			"-rpcCall={CLR|RSR} <args for flags if any>")
+			"-rpcCall={CLR|RSR|DSR} <args for flags if any>")
 		fmt.Println("Flags: ")
 		fmt.Printf("	%s: %s\n", "rpcCall", flag.Lookup("rpcCall").Usage)
 		fmt.Printf("	%s: %s\n", "help   ", flag.Lookup("help").Usage)
@@ -88,6 +88,30 @@ func handleCommands(cmd string) error {
 		}
 		fmt.Printf("Got rsa: %+v\n", rsa)
 		return nil
+	case "DSR":
+		if len(flag.Args()) < 2 {
+			printSendDSRUsage()
+			return fmt.Errorf("invalid args")
+		}
+		req := &protos.DeleteSubscriberDataRequest{UserName: flag.Arg(0)}
+		dsrFlags, err := strconv.ParseUint(flag.Arg(1), 10, 32)
+		if err != nil {
+			return fmt.Errorf("dsrFlags is not an unsigned integer")
+		}
+		req.DsrFlags = uint32(dsrFlags)
+		for _, ctxIdStr := range flag.Args()[2:] {
+			ctxId, err := strconv.ParseUint(ctxIdStr, 10, 32)
+			if err != nil {
+				return fmt.Errorf("context id %s is not an unsigned integer", ctxIdStr)
+			}
+			req.ContextId = append(req.ContextId, uint32(ctxId))
+		}
+		dsa, err := s6a_proxy.GWS6AProxyDeleteSubscriberData(req)
+		if err != nil {
+			return fmt.Errorf("err sending DSR: %v", err)
+		}
+		fmt.Printf("Got dsa: %+v\n", dsa)
+		return nil
 	default:
 		flag.Usage()
 		return fmt.Errorf("command %s is not supported", cmd)
@@ -104,6 +128,12 @@ func printSendCLRUsage() {
 	fmt.Println("Example: gw_s6a_service_cli -rpcCall=CLR user123 0")
 }
 
+func printSendDSRUsage() {
+	fmt.Println("Specify a username, DSR flags and optional APN context ids" +
+		" at the end of the command")
+	fmt.Println("Example: gw_s6a_service_cli -rpcCall=DSR user123 8192 1 2")
+}
+
 func sendCLR(username string, clType int) error {
 	cla, err := s6a_proxy.GWS6AProxyCancelLocation(
 		&protos.CancelLocationRequest{
@@ -51,7 +51,112 @@ func (x ReAuthResult) String() string {
 	return proto.EnumName(ReAuthResult_name, int32(x))
 }
 func (ReAuthResult) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_session_manager_ec9068544b9ee644, []int{0}
+	return fileDescriptor_session_manager_cd1c2856b053082f, []int{0}
+}
+
+// Gx Event-Trigger values (3GPP 29.212 Section 5.3.7)
+type EventTrigger int32
+
+const (
+	EventTrigger_SGSN_CHANGE                        EventTrigger = 0
+	EventTrigger_QOS_CHANGE                         EventTrigger = 1
+	EventTrigger_RAT_CHANGE                         EventTrigger = 2
+	EventTrigger_TFT_CHANGE                         EventTrigger = 3
+	EventTrigger_PLMN_CHANGE                        EventTrigger = 4
+	EventTrigger_LOSS_OF_BEARER                     EventTrigger = 5
+	EventTrigger_RECOVERY_OF_BEARER                 EventTrigger = 6
+	EventTrigger_IP_CAN_CHANGE                      EventTrigger = 7
+	EventTrigger_QOS_CHANGE_EXCEEDING_AUTHORIZATION EventTrigger = 11
+	EventTrigger_RAI_CHANGE                         EventTrigger = 12
+	EventTrigger_USER_LOCATION_CHANGE               EventTrigger = 13
+	EventTrigger_NO_EVENT_TRIGGERS                  EventTrigger = 14
+	EventTrigger_OUT_OF_CREDIT                      EventTrigger = 15
+	EventTrigger_REALLOCATION_OF_CREDIT             EventTrigger = 16
+	EventTrigger_REVALIDATION_TIMEOUT               EventTrigger = 17
+	EventTrigger_UE_IP_ADDRESS_ALLOCATE             EventTrigger = 18
+	EventTrigger_UE_IP_ADDRESS_RELEASE              EventTrigger = 19
+	EventTrigger_DEFAULT_EPS_BEARER_QOS_CHANGE      EventTrigger = 20
+	EventTrigger_AN_GW_CHANGE                       EventTrigger = 21
+	EventTrigger_SUCCESSFUL_RESOURCE_ALLOCATION     EventTrigger = 22
+	EventTrigger_RESOURCE_MODIFICATION_REQUEST      EventTrigger = 23
+	EventTrigger_PGW_TRACE_CONTROL                  EventTrigger = 24
+	EventTrigger_UE_TIME_ZONE_CHANGE                EventTrigger = 25
+	EventTrigger_TAI_CHANGE                         EventTrigger = 26
+	EventTrigger_ECGI_CHANGE                        EventTrigger = 27
+	EventTrigger_CHARGING_CORRELATION_EXCHANGE      EventTrigger = 28
+	EventTrigger_APN_AMBR_MODIFICATION_FAILURE      EventTrigger = 29
+	EventTrigger_USER_CSG_INFORMATION_CHANGE        EventTrigger = 30
+	EventTrigger_USAGE_REPORT                       EventTrigger = 33
+)
+
+var EventTrigger_name = map[int32]string{
+	0:  "SGSN_CHANGE",
+	1:  "QOS_CHANGE",
+	2:  "RAT_CHANGE",
+	3:  "TFT_CHANGE",
+	4:  "PLMN_CHANGE",
+	5:  "LOSS_OF_BEARER",
+	6:  "RECOVERY_OF_BEARER",
+	7:  "IP_CAN_CHANGE",
+	11: "QOS_CHANGE_EXCEEDING_AUTHORIZATION",
+	12: "RAI_CHANGE",
+	13: "USER_LOCATION_CHANGE",
+	14: "NO_EVENT_TRIGGERS",
+	15: "OUT_OF_CREDIT",
+	16: "REALLOCATION_OF_CREDIT",
+	17: "REVALIDATION_TIMEOUT",
+	18: "UE_IP_ADDRESS_ALLOCATE",
+	19: "UE_IP_ADDRESS_RELEASE",
+	20: "DEFAULT_EPS_BEARER_QOS_CHANGE",
+	21: "AN_GW_CHANGE",
+	22: "SUCCESSFUL_RESOURCE_ALLOCATION",
+	23: "RESOURCE_MODIFICATION_REQUEST",
+	24: "PGW_TRACE_CONTROL",
+	25: "UE_TIME_ZONE_CHANGE",
+	26: "TAI_CHANGE",
+	27: "ECGI_CHANGE",
+	28: "CHARGING_CORRELATION_EXCHANGE",
+	29: "APN_AMBR_MODIFICATION_FAILURE",
+	30: "USER_CSG_INFORMATION_CHANGE",
+	33: "USAGE_REPORT",
+}
+var EventTrigger_value = map[string]int32{
+	"SGSN_CHANGE":                        0,
+	"QOS_CHANGE":                         1,
+	"RAT_CHANGE":                         2,
+	"TFT_CHANGE":                         3,
+	"PLMN_CHANGE":                        4,
+	"LOSS_OF_BEARER":                     5,
+	"RECOVERY_OF_BEARER":                 6,
+	"IP_CAN_CHANGE":                      7,
+	"QOS_CHANGE_EXCEEDING_AUTHORIZATION": 11,
+	"RAI_CHANGE":                         12,
+	"USER_LOCATION_CHANGE":               13,
+	"NO_EVENT_TRIGGERS":                  14,
+	"OUT_OF_CREDIT":                      15,
+	"REALLOCATION_OF_CREDIT":             16,
+	"REVALIDATION_TIMEOUT":               17,
+	"UE_IP_ADDRESS_ALLOCATE":             18,
+	"UE_IP_ADDRESS_RELEASE":              19,
+	"DEFAULT_EPS_BEARER_QOS_CHANGE":      20,
+	"AN_GW_CHANGE":                       21,
+	"SUCCESSFUL_RESOURCE_ALLOCATION":     22,
+	"RESOURCE_MODIFICATION_REQUEST":      23,
+	"PGW_TRACE_CONTROL":                  24,
+	"UE_TIME_ZONE_CHANGE":                25,
+	"TAI_CHANGE":                         26,
+	"ECGI_CHANGE":                        27,
+	"CHARGING_CORRELATION_EXCHANGE":      28,
+	"APN_AMBR_MODIFICATION_FAILURE":      29,
+	"USER_CSG_INFORMATION_CHANGE":        30,
+	"USAGE_REPORT":                       33,
+}
+
+func (x EventTrigger) String() string {
+	return proto.EnumName(EventTrigger_name, int32(x))
+}
+func (EventTrigger) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_session_manager_cd1c2856b053082f, []int{1}
 }
 
 type MonitoringLevel int32
@@ -74,7 +179,7 @@ func (x MonitoringLevel) String() string {
 	return proto.EnumName(MonitoringLevel_name, int32(x))
 }
 func (MonitoringLevel) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_session_manager_ec9068544b9ee644, []int{1}
+	return fileDescriptor_session_manager_cd1c2856b053082f, []int{2}
 }
 
 type ChargingReAuthRequest_Type int32
@@ -97,7 +202,7 @@ func (x ChargingReAuthRequest_Type) String() string {
 	return proto.EnumName(ChargingReAuthRequest_Type_name, int32(x))
 }
 func (ChargingReAuthRequest_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_session_manager_ec9068544b9ee644, []int{5, 0}
+	return fileDescriptor_session_manager_cd1c2856b053082f, []int{5, 0}
 }
 
 type ChargingReAuthAnswer_Result int32
@@ -126,7 +231,7 @@ func (x ChargingReAuthAnswer_Result) String() string {
 	return proto.EnumName(ChargingReAuthAnswer_Result_name, int32(x))
 }
 func (ChargingReAuthAnswer_Result) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_session_manager_ec9068544b9ee644, []int{6, 0}
+	return fileDescriptor_session_manager_cd1c2856b053082f, []int{6, 0}
 }
 
 type PolicyReAuthAnswer_FailureCode int32
@@ -226,7 +331,7 @@ func (x PolicyReAuthAnswer_FailureCode) String() string {
 	return proto.EnumName(PolicyReAuthAnswer_FailureCode_name, int32(x))
 }
 func (PolicyReAuthAnswer_FailureCode) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_session_manager_ec9068544b9ee644, []int{8, 0}
+	return fileDescriptor_session_manager_cd1c2856b053082f, []int{8, 0}
 }
 
 type ChargingCredit_UnitType int32
@@ -249,7 +354,7 @@ func (x ChargingCredit_UnitType) String() string {
 	return proto.EnumName(ChargingCredit_UnitType_name, int32(x))
 }
 func (ChargingCredit_UnitType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_session_manager_ec9068544b9ee644, []int{11, 0}
+	return fileDescriptor_session_manager_cd1c2856b053082f, []int{11, 0}
 }
 
 type ChargingCredit_FinalAction int32
@@ -275,7 +380,36 @@ func (x ChargingCredit_FinalAction) String() string {
 	return proto.EnumName(ChargingCredit_FinalAction_name, int32(x))
 }
 func (ChargingCredit_FinalAction) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_session_manager_ec9068544b9ee644, []int{11, 1}
+	return fileDescriptor_session_manager_cd1c2856b053082f, []int{11, 1}
+}
+
+type RedirectServer_RedirectAddressType int32
+
+const (
+	RedirectServer_IPV4    RedirectServer_RedirectAddressType = 0
+	RedirectServer_IPV6    RedirectServer_RedirectAddressType = 1
+	RedirectServer_URL     RedirectServer_RedirectAddressType = 2
+	RedirectServer_SIP_URI RedirectServer_RedirectAddressType = 3
+)
+
+var RedirectServer_RedirectAddressType_name = map[int32]string{
+	0: "IPV4",
+	1: "IPV6",
+	2: "URL",
+	3: "SIP_URI",
+}
+var RedirectServer_RedirectAddressType_value = map[string]int32{
+	"IPV4":    0,
+	"IPV6":    1,
+	"URL":     2,
+	"SIP_URI": 3,
+}
+
+func (x RedirectServer_RedirectAddressType) String() string {
+	return proto.EnumName(RedirectServer_RedirectAddressType_name, int32(x))
+}
+func (RedirectServer_RedirectAddressType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_session_manager_cd1c2856b053082f, []int{12, 0}
 }
 
 type CreditUsage_UpdateType int32
@@ -319,7 +453,7 @@ func (x CreditUsage_UpdateType) String() string {
 	return proto.EnumName(CreditUsage_UpdateType_name, int32(x))
 }
 func (CreditUsage_UpdateType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_session_manager_ec9068544b9ee644, []int{12, 0}
+	return fileDescriptor_session_manager_cd1c2856b053082f, []int{13, 0}
 }
 
 type CreditUpdateResponse_ResponseType int32
@@ -339,7 +473,7 @@ func (x CreditUpdateResponse_ResponseType) String() string {
 	return proto.EnumName(CreditUpdateResponse_ResponseType_name, int32(x))
 }
 func (CreditUpdateResponse_ResponseType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_session_manager_ec9068544b9ee644, []int{14, 0}
+	return fileDescriptor_session_manager_cd1c2856b053082f, []int{15, 0}
 }
 
 type UsageMonitoringCredit_Action int32
@@ -362,7 +496,7 @@ func (x UsageMonitoringCredit_Action) String() string {
 	return proto.EnumName(UsageMonitoringCredit_Action_name, int32(x))
 }
 func (UsageMonitoringCredit_Action) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_session_manager_ec9068544b9ee644, []int{16, 0}
+	return fileDescriptor_session_manager_cd1c2856b053082f, []int{17, 0}
 }
 
 type RuleRecord struct {
@@ -379,7 +513,7 @@ func (m *RuleRecord) Reset()         { *m = RuleRecord{} }
 func (m *RuleRecord) String() string { return proto.CompactTextString(m) }
 func (*RuleRecord) ProtoMessage()    {}
 func (*RuleRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_session_manager_ec9068544b9ee644, []int{0}
+	return fileDescriptor_session_manager_cd1c2856b053082f, []int{0}
 }
 func (m *RuleRecord) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RuleRecord.Unmarshal(m, b)
@@ -438,7 +572,7 @@ func (m *RuleRecordTable) Reset()         { *m = RuleRecordTable{} }
 func (m *RuleRecordTable) String() string { return proto.CompactTextString(m) }
 func (*RuleRecordTable) ProtoMessage()    {}
 func (*RuleRecordTable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_session_manager_ec9068544b9ee644, []int{1}
+	return fileDescriptor_session_manager_cd1c2856b053082f, []int{1}
 }
 func (m *RuleRecordTable) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RuleRecordTable.Unmarshal(m, b)
@@ -485,7 +619,7 @@ func (m *LocalCreateSessionRequest) Reset()         { *m = LocalCreateSessionReq
 func (m *LocalCreateSessionRequest) String() string { return proto.CompactTextString(m) }
 func (*LocalCreateSessionRequest) ProtoMessage()    {}
 func (*LocalCreateSessionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_session_manager_ec9068544b9ee644, []int{2}
+	return fileDescriptor_session_manager_cd1c2856b053082f, []int{2}
 }
 func (m *LocalCreateSessionRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LocalCreateSessionRequest.Unmarshal(m, b)
@@ -585,7 +719,7 @@ func (m *LocalCreateSessionResponse) Reset()         { *m = LocalCreateSessionRe
 func (m *LocalCreateSessionResponse) String() string { return proto.CompactTextString(m) }
 func (*LocalCreateSessionResponse) ProtoMessage()    {}
 func (*LocalCreateSessionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_session_manager_ec9068544b9ee644, []int{3}
+	return fileDescriptor_session_manager_cd1c2856b053082f, []int{3}
 }
 func (m *LocalCreateSessionResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LocalCreateSessionResponse.Unmarshal(m, b)
@@ -615,7 +749,7 @@ func (m *LocalEndSessionResponse) Reset()         { *m = LocalEndSessionResponse
 func (m *LocalEndSessionResponse) String() string { return proto.CompactTextString(m) }
 func (*LocalEndSessionResponse) ProtoMessage()    {}
 func (*LocalEndSessionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_session_manager_ec9068544b9ee644, []int{4}
+	return fileDescriptor_session_manager_cd1c2856b053082f, []int{4}
 }
 func (m *LocalEndSessionResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LocalEndSessionResponse.Unmarshal(m, b)
@@ -649,7 +783,7 @@ func (m *ChargingReAuthRequest) Reset()         { *m = ChargingReAuthRequest{} }
 func (m *ChargingReAuthRequest) String() string { return proto.CompactTextString(m) }
 func (*ChargingReAuthRequest) ProtoMessage()    {}
 func (*ChargingReAuthRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_session_manager_ec9068544b9ee644, []int{5}
+	return fileDescriptor_session_manager_cd1c2856b053082f, []int{5}
 }
 func (m *ChargingReAuthRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChargingReAuthRequest.Unmarshal(m, b)
@@ -708,7 +842,7 @@ func (m *ChargingReAuthAnswer) Reset()         { *m = ChargingReAuthAnswer{} }
 func (m *ChargingReAuthAnswer) String() string { return proto.CompactTextString(m) }
 func (*ChargingReAuthAnswer) ProtoMessage()    {}
 func (*ChargingReAuthAnswer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_session_manager_ec9068544b9ee644, []int{6}
+	return fileDescriptor_session_manager_cd1c2856b053082f, []int{6}
 }
 func (m *ChargingReAuthAnswer) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChargingReAuthAnswer.Unmarshal(m, b)
@@ -750,7 +884,7 @@ func (m *PolicyReAuthRequest) Reset()         { *m = PolicyReAuthRequest{} }
 func (m *PolicyReAuthRequest) String() string { return proto.CompactTextString(m) }
 func (*PolicyReAuthRequest) ProtoMessage()    {}
 func (*PolicyReAuthRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_session_manager_ec9068544b9ee644, []int{7}
+	return fileDescriptor_session_manager_cd1c2856b053082f, []int{7}
 }
 func (m *PolicyReAuthRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PolicyReAuthRequest.Unmarshal(m, b)
@@ -818,7 +952,7 @@ func (m *PolicyReAuthAnswer) Reset()         { *m = PolicyReAuthAnswer{} }
 func (m *PolicyReAuthAnswer) String() string { return proto.CompactTextString(m) }
 func (*PolicyReAuthAnswer) ProtoMessage()    {}
 func (*PolicyReAuthAnswer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_session_manager_ec9068544b9ee644, []int{8}
+	return fileDescriptor_session_manager_cd1c2856b053082f, []int{8}
 }
 func (m *PolicyReAuthAnswer) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PolicyReAuthAnswer.Unmarshal(m, b)
@@ -871,7 +1005,7 @@ func (m *CreditUnit) Reset()         { *m = CreditUnit{} }
 func (m *CreditUnit) String() string { return proto.CompactTextString(m) }
 func (*CreditUnit) ProtoMessage()    {}
 func (*CreditUnit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_session_manager_ec9068544b9ee644, []int{9}
+	return fileDescriptor_session_manager_cd1c2856b053082f, []int{9}
 }
 func (m *CreditUnit) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreditUnit.Unmarshal(m, b)
@@ -918,7 +1052,7 @@ func (m *GrantedUnits) Reset()         { *m = GrantedUnits{} }
 func (m *GrantedUnits) String() string { return proto.CompactTextString(m) }
 func (*GrantedUnits) ProtoMessage()    {}
 func (*GrantedUnits) Descriptor() ([]byte, []int) {
-	return fileDescriptor_session_manager_ec9068544b9ee644, []int{10}
+	return fileDescriptor_session_manager_cd1c2856b053082f, []int{10}
 }
 func (m *GrantedUnits) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GrantedUnits.Unmarshal(m, b)
@@ -960,21 +1094,25 @@ func (m *GrantedUnits) GetRx() *CreditUnit {
 }
 
 type ChargingCredit struct {
-	Type                 ChargingCredit_UnitType    `protobuf:"varint,2,opt,name=type,proto3,enum=magma.lte.ChargingCredit_UnitType" json:"type,omitempty"`
-	ValidityTime         uint32                     `protobuf:"varint,3,opt,name=validity_time,json=validityTime,proto3" json:"validity_time,omitempty"`
-	IsFinal              bool                       `protobuf:"varint,4,opt,name=is_final,json=isFinal,proto3" json:"is_final,omitempty"`
-	FinalAction          ChargingCredit_FinalAction `protobuf:"varint,5,opt,name=final_action,json=finalAction,proto3,enum=magma.lte.ChargingCredit_FinalAction" json:"final_action,omitempty"`
-	GrantedUnits         *GrantedUnits              `protobuf:"bytes,6,opt,name=granted_units,json=grantedUnits,proto3" json:"granted_units,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
-	XXX_unrecognized     []byte                     `json:"-"`
-	XXX_sizecache        int32                      `json:"-"`
+	Type         ChargingCredit_UnitType    `protobuf:"varint,2,opt,name=type,proto3,enum=magma.lte.ChargingCredit_UnitType" json:"type,omitempty"`
+	ValidityTime uint32                     `protobuf:"varint,3,opt,name=validity_time,json=validityTime,proto3" json:"validity_time,omitempty"`
+	IsFinal      bool                       `protobuf:"varint,4,opt,name=is_final,json=isFinal,proto3" json:"is_final,omitempty"`
+	FinalAction  ChargingCredit_FinalAction `protobuf:"varint,5,opt,name=final_action,json=finalAction,proto3,enum=magma.lte.ChargingCredit_FinalAction" json:"final_action,omitempty"`
+	GrantedUnits *GrantedUnits              `protobuf:"bytes,6,opt,name=granted_units,json=grantedUnits,proto3" json:"granted_units,omitempty"`
+	// Redirect-Server of a REDIRECT final action
+	RedirectServer *RedirectServer `protobuf:"bytes,7,opt,name=redirect_server,json=redirectServer,proto3" json:"redirect_server,omitempty"`
+	// Filter-Ids of the rules to apply for a RESTRICT_ACCESS final action
+	RestrictRules        []string `protobuf:"bytes,8,rep,name=restrict_rules,json=restrictRules,proto3" json:"restrict_rules,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ChargingCredit) Reset()         { *m = ChargingCredit{} }
 func (m *ChargingCredit) String() string { return proto.CompactTextString(m) }
 func (*ChargingCredit) ProtoMessage()    {}
 func (*ChargingCredit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_session_manager_ec9068544b9ee644, []int{11}
+	return fileDescriptor_session_manager_cd1c2856b053082f, []int{11}
 }
 func (m *ChargingCredit) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChargingCredit.Unmarshal(m, b)
@@ -1029,6 +1167,66 @@ func (m *ChargingCredit) GetGrantedUnits() *GrantedUnits {
 	return nil
 }
 
+func (m *ChargingCredit) GetRedirectServer() *RedirectServer {
+	if m != nil {
+		return m.RedirectServer
+	}
+	return nil
+}
+
+func (m *ChargingCredit) GetRestrictRules() []string {
+	if m != nil {
+		return m.RestrictRules
+	}
+	return nil
+}
+
+type RedirectServer struct {
+	RedirectAddressType   RedirectServer_RedirectAddressType `protobuf:"varint,1,opt,name=redirect_address_type,json=redirectAddressType,proto3,enum=magma.lte.RedirectServer_RedirectAddressType" json:"redirect_address_type,omitempty"`
+	RedirectServerAddress string                             `protobuf:"bytes,2,opt,name=redirect_server_address,json=redirectServerAddress,proto3" json:"redirect_server_address,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}                           `json:"-"`
+	XXX_unrecognized      []byte                             `json:"-"`
+	XXX_sizecache         int32                              `json:"-"`
+}
+
+func (m *RedirectServer) Reset()         { *m = RedirectServer{} }
+func (m *RedirectServer) String() string { return proto.CompactTextString(m) }
+func (*RedirectServer) ProtoMessage()    {}
+func (*RedirectServer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_session_manager_cd1c2856b053082f, []int{12}
+}
+func (m *RedirectServer) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RedirectServer.Unmarshal(m, b)
+}
+func (m *RedirectServer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RedirectServer.Marshal(b, m, deterministic)
+}
+func (dst *RedirectServer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RedirectServer.Merge(dst, src)
+}
+func (m *RedirectServer) XXX_Size() int {
+	return xxx_messageInfo_RedirectServer.Size(m)
+}
+func (m *RedirectServer) XXX_DiscardUnknown() {
+	xxx_messageInfo_RedirectServer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RedirectServer proto.InternalMessageInfo
+
+func (m *RedirectServer) GetRedirectAddressType() RedirectServer_RedirectAddressType {
+	if m != nil {
+		return m.RedirectAddressType
+	}
+	return RedirectServer_IPV4
+}
+
+func (m *RedirectServer) GetRedirectServerAddress() string {
+	if m != nil {
+		return m.RedirectServerAddress
+	}
+	return ""
+}
+
 type CreditUsage struct {
 	BytesTx              uint64                 `protobuf:"varint,1,opt,name=bytes_tx,json=bytesTx,proto3" json:"bytes_tx,omitempty"`
 	BytesRx              uint64                 `protobuf:"varint,2,opt,name=bytes_rx,json=bytesRx,proto3" json:"bytes_rx,omitempty"`
@@ -1044,7 +1242,7 @@ func (m *CreditUsage) Reset()         { *m = CreditUsage{} }
 func (m *CreditUsage) String() string { return proto.CompactTextString(m) }
 func (*CreditUsage) ProtoMessage()    {}
 func (*CreditUsage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_session_manager_ec9068544b9ee644, []int{12}
+	return fileDescriptor_session_manager_cd1c2856b053082f, []int{13}
 }
 func (m *CreditUsage) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreditUsage.Unmarshal(m, b)
@@ -1121,7 +1319,7 @@ func (m *CreditUsageUpdate) Reset()         { *m = CreditUsageUpdate{} }
 func (m *CreditUsageUpdate) String() string { return proto.CompactTextString(m) }
 func (*CreditUsageUpdate) ProtoMessage()    {}
 func (*CreditUsageUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_session_manager_ec9068544b9ee644, []int{13}
+	return fileDescriptor_session_manager_cd1c2856b053082f, []int{14}
 }
 func (m *CreditUsageUpdate) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreditUsageUpdate.Unmarshal(m, b)
@@ -1240,7 +1438,7 @@ func (m *CreditUpdateResponse) Reset()         { *m = CreditUpdateResponse{} }
 func (m *CreditUpdateResponse) String() string { return proto.CompactTextString(m) }
 func (*CreditUpdateResponse) ProtoMessage()    {}
 func (*CreditUpdateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_session_manager_ec9068544b9ee644, []int{14}
+	return fileDescriptor_session_manager_cd1c2856b053082f, []int{15}
 }
 func (m *CreditUpdateResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreditUpdateResponse.Unmarshal(m, b)
@@ -1310,7 +1508,7 @@ func (m *UsageMonitorUpdate) Reset()         { *m = UsageMonitorUpdate{} }
 func (m *UsageMonitorUpdate) String() string { return proto.CompactTextString(m) }
 func (*UsageMonitorUpdate) ProtoMessage()    {}
 func (*UsageMonitorUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_session_manager_ec9068544b9ee644, []int{15}
+	return fileDescriptor_session_manager_cd1c2856b053082f, []int{16}
 }
 func (m *UsageMonitorUpdate) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UsageMonitorUpdate.Unmarshal(m, b)
@@ -1373,7 +1571,7 @@ func (m *UsageMonitoringCredit) Reset()         { *m = UsageMonitoringCredit{} }
 func (m *UsageMonitoringCredit) String() string { return proto.CompactTextString(m) }
 func (*UsageMonitoringCredit) ProtoMessage()    {}
 func (*UsageMonitoringCredit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_session_manager_ec9068544b9ee644, []int{16}
+	return fileDescriptor_session_manager_cd1c2856b053082f, []int{17}
 }
 func (m *UsageMonitoringCredit) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UsageMonitoringCredit.Unmarshal(m, b)
@@ -1423,21 +1621,27 @@ func (m *UsageMonitoringCredit) GetGrantedUnits() *GrantedUnits {
 
 // A request to update a usage monitor given its usage and session information
 type UsageMonitoringUpdateRequest struct {
-	Update               *UsageMonitorUpdate `protobuf:"bytes,1,opt,name=update,proto3" json:"update,omitempty"`
-	SessionId            string              `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	RequestNumber        uint32              `protobuf:"varint,3,opt,name=request_number,json=requestNumber,proto3" json:"request_number,omitempty"`
-	Sid                  string              `protobuf:"bytes,4,opt,name=sid,proto3" json:"sid,omitempty"`
-	UeIpv4               string              `protobuf:"bytes,5,opt,name=ue_ipv4,json=ueIpv4,proto3" json:"ue_ipv4,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+	Update        *UsageMonitorUpdate `protobuf:"bytes,1,opt,name=update,proto3" json:"update,omitempty"`
+	SessionId     string              `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	RequestNumber uint32              `protobuf:"varint,3,opt,name=request_number,json=requestNumber,proto3" json:"request_number,omitempty"`
+	Sid           string              `protobuf:"bytes,4,opt,name=sid,proto3" json:"sid,omitempty"`
+	UeIpv4        string              `protobuf:"bytes,5,opt,name=ue_ipv4,json=ueIpv4,proto3" json:"ue_ipv4,omitempty"`
+	// Armed events which occurred, USAGE_REPORT is implied by a set update
+	EventTriggers []EventTrigger `protobuf:"varint,6,rep,packed,name=event_triggers,json=eventTriggers,proto3,enum=magma.lte.EventTrigger" json:"event_triggers,omitempty"`
+	// Current session info for location, RAT & QoS change events
+	UserLocation         []byte                 `protobuf:"bytes,7,opt,name=user_location,json=userLocation,proto3" json:"user_location,omitempty"`
+	PlmnId               string                 `protobuf:"bytes,8,opt,name=plmn_id,json=plmnId,proto3" json:"plmn_id,omitempty"`
+	QosInfo              *QosInformationRequest `protobuf:"bytes,9,opt,name=qos_info,json=qosInfo,proto3" json:"qos_info,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
 }
 
 func (m *UsageMonitoringUpdateRequest) Reset()         { *m = UsageMonitoringUpdateRequest{} }
 func (m *UsageMonitoringUpdateRequest) String() string { return proto.CompactTextString(m) }
 func (*UsageMonitoringUpdateRequest) ProtoMessage()    {}
 func (*UsageMonitoringUpdateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_session_manager_ec9068544b9ee644, []int{17}
+	return fileDescriptor_session_manager_cd1c2856b053082f, []int{18}
 }
 func (m *UsageMonitoringUpdateRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UsageMonitoringUpdateRequest.Unmarshal(m, b)
@@ -1492,12 +1696,44 @@ func (m *UsageMonitoringUpdateRequest) GetUeIpv4() string {
 	return ""
 }
 
+func (m *UsageMonitoringUpdateRequest) GetEventTriggers() []EventTrigger {
+	if m != nil {
+		return m.EventTriggers
+	}
+	return nil
+}
+
+func (m *UsageMonitoringUpdateRequest) GetUserLocation() []byte {
+	if m != nil {
+		return m.UserLocation
+	}
+	return nil
+}
+
+func (m *UsageMonitoringUpdateRequest) GetPlmnId() string {
+	if m != nil {
+		return m.PlmnId
+	}
+	return ""
+}
+
+func (m *UsageMonitoringUpdateRequest) GetQosInfo() *QosInformationRequest {
+	if m != nil {
+		return m.QosInfo
+	}
+	return nil
+}
+
 // Response to a usage monitor update with the credit received and session info
 type UsageMonitoringUpdateResponse struct {
-	Credit               *UsageMonitoringCredit `protobuf:"bytes,1,opt,name=credit,proto3" json:"credit,omitempty"`
-	SessionId            string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	Sid                  string                 `protobuf:"bytes,3,opt,name=sid,proto3" json:"sid,omitempty"`
-	Success              bool                   `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
+	Credit    *UsageMonitoringCredit `protobuf:"bytes,1,opt,name=credit,proto3" json:"credit,omitempty"`
+	SessionId string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Sid       string                 `protobuf:"bytes,3,opt,name=sid,proto3" json:"sid,omitempty"`
+	Success   bool                   `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
+	// Event triggers armed by the PCRF, replace the previously armed ones if set
+	EventTriggers []EventTrigger `protobuf:"varint,5,rep,packed,name=event_triggers,json=eventTriggers,proto3,enum=magma.lte.EventTrigger" json:"event_triggers,omitempty"`
+	// Time by which a REVALIDATION_TIMEOUT update has to be sent
+	RevalidationTime     *timestamp.Timestamp `protobuf:"bytes,6,opt,name=revalidation_time,json=revalidationTime,proto3" json:"revalidation_time,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
 	XXX_unrecognized     []byte                 `json:"-"`
 	XXX_sizecache        int32                  `json:"-"`
@@ -1507,7 +1743,7 @@ func (m *UsageMonitoringUpdateResponse) Reset()         { *m = UsageMonitoringUp
 func (m *UsageMonitoringUpdateResponse) String() string { return proto.CompactTextString(m) }
 func (*UsageMonitoringUpdateResponse) ProtoMessage()    {}
 func (*UsageMonitoringUpdateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_session_manager_ec9068544b9ee644, []int{18}
+	return fileDescriptor_session_manager_cd1c2856b053082f, []int{19}
 }
 func (m *UsageMonitoringUpdateResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UsageMonitoringUpdateResponse.Unmarshal(m, b)
@@ -1555,6 +1791,20 @@ func (m *UsageMonitoringUpdateResponse) GetSuccess() bool {
 	return false
 }
 
+func (m *UsageMonitoringUpdateResponse) GetEventTriggers() []EventTrigger {
+	if m != nil {
+		return m.EventTriggers
+	}
+	return nil
+}
+
+func (m *UsageMonitoringUpdateResponse) GetRevalidationTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.RevalidationTime
+	}
+	return nil
+}
+
 // QoS Information to be sent in CCR-Init message
 type QosInformationRequest struct {
 	ApnAmbrDl               uint32   `protobuf:"varint,1,opt,name=apn_ambr_dl,json=apnAmbrDl,proto3" json:"apn_ambr_dl,omitempty"`
@@ -1572,7 +1822,7 @@ func (m *QosInformationRequest) Reset()         { *m = QosInformationRequest{} }
 func (m *QosInformationRequest) String() string { return proto.CompactTextString(m) }
 func (*QosInformationRequest) ProtoMessage()    {}
 func (*QosInformationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_session_manager_ec9068544b9ee644, []int{19}
+	return fileDescriptor_session_manager_cd1c2856b053082f, []int{20}
 }
 func (m *QosInformationRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_QosInformationRequest.Unmarshal(m, b)
@@ -1656,7 +1906,7 @@ func (m *CreateSessionRequest) Reset()         { *m = CreateSessionRequest{} }
 func (m *CreateSessionRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateSessionRequest) ProtoMessage()    {}
 func (*CreateSessionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_session_manager_ec9068544b9ee644, []int{20}
+	return fileDescriptor_session_manager_cd1c2856b053082f, []int{21}
 }
 func (m *CreateSessionRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateSessionRequest.Unmarshal(m, b)
@@ -1766,6 +2016,8 @@ type CreateSessionResponse struct {
 	UsageMonitors        []*UsageMonitoringUpdateResponse `protobuf:"bytes,6,rep,name=usage_monitors,json=usageMonitors,proto3" json:"usage_monitors,omitempty"`
 	StaticRules          []*StaticRuleInstall             `protobuf:"bytes,7,rep,name=static_rules,json=staticRules,proto3" json:"static_rules,omitempty"`
 	DynamicRules         []*DynamicRuleInstall            `protobuf:"bytes,8,rep,name=dynamic_rules,json=dynamicRules,proto3" json:"dynamic_rules,omitempty"`
+	EventTriggers        []EventTrigger                   `protobuf:"varint,9,rep,packed,name=event_triggers,json=eventTriggers,proto3,enum=magma.lte.EventTrigger" json:"event_triggers,omitempty"`
+	RevalidationTime     *timestamp.Timestamp           `protobuf:"bytes,10,opt,name=revalidation_time,json=revalidationTime,proto3" json:"revalidation_time,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                         `json:"-"`
 	XXX_unrecognized     []byte                           `json:"-"`
 	XXX_sizecache        int32                            `json:"-"`
@@ -1775,7 +2027,7 @@ func (m *CreateSessionResponse) Reset()         { *m = CreateSessionResponse{} }
 func (m *CreateSessionResponse) String() string { return proto.CompactTextString(m) }
 func (*CreateSessionResponse) ProtoMessage()    {}
 func (*CreateSessionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_session_manager_ec9068544b9ee644, []int{21}
+	return fileDescriptor_session_manager_cd1c2856b053082f, []int{22}
 }
 func (m *CreateSessionResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateSessionResponse.Unmarshal(m, b)
@@ -1830,20 +2082,34 @@ func (m *CreateSessionResponse) GetDynamicRules() []*DynamicRuleInstall {
 	return nil
 }
 
+func (m *CreateSessionResponse) GetEventTriggers() []EventTrigger {
+	if m != nil {
+		return m.EventTriggers
+	}
+	return nil
+}
+
+func (m *CreateSessionResponse) GetRevalidationTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.RevalidationTime
+	}
+	return nil
+}
+
 type StaticRuleInstall struct {
-	RuleId               string               `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	RuleId               string                 `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
 	ActivationTime       *timestamp.Timestamp `protobuf:"bytes,2,opt,name=activation_time,json=activationTime,proto3" json:"activation_time,omitempty"`
 	DeactivationTime     *timestamp.Timestamp `protobuf:"bytes,3,opt,name=deactivation_time,json=deactivationTime,proto3" json:"deactivation_time,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
 }
 
 func (m *StaticRuleInstall) Reset()         { *m = StaticRuleInstall{} }
 func (m *StaticRuleInstall) String() string { return proto.CompactTextString(m) }
 func (*StaticRuleInstall) ProtoMessage()    {}
 func (*StaticRuleInstall) Descriptor() ([]byte, []int) {
-	return fileDescriptor_session_manager_ec9068544b9ee644, []int{22}
+	return fileDescriptor_session_manager_cd1c2856b053082f, []int{23}
 }
 func (m *StaticRuleInstall) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StaticRuleInstall.Unmarshal(m, b)
@@ -1885,19 +2151,19 @@ func (m *StaticRuleInstall) GetDeactivationTime() *timestamp.Timestamp {
 }
 
 type DynamicRuleInstall struct {
-	PolicyRule           *PolicyRule          `protobuf:"bytes,1,opt,name=policy_rule,json=policyRule,proto3" json:"policy_rule,omitempty"`
+	PolicyRule           *PolicyRule            `protobuf:"bytes,1,opt,name=policy_rule,json=policyRule,proto3" json:"policy_rule,omitempty"`
 	ActivationTime       *timestamp.Timestamp `protobuf:"bytes,2,opt,name=activation_time,json=activationTime,proto3" json:"activation_time,omitempty"`
 	DeactivationTime     *timestamp.Timestamp `protobuf:"bytes,3,opt,name=deactivation_time,json=deactivationTime,proto3" json:"deactivation_time,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
 }
 
 func (m *DynamicRuleInstall) Reset()         { *m = DynamicRuleInstall{} }
 func (m *DynamicRuleInstall) String() string { return proto.CompactTextString(m) }
 func (*DynamicRuleInstall) ProtoMessage()    {}
 func (*DynamicRuleInstall) Descriptor() ([]byte, []int) {
-	return fileDescriptor_session_manager_ec9068544b9ee644, []int{23}
+	return fileDescriptor_session_manager_cd1c2856b053082f, []int{24}
 }
 func (m *DynamicRuleInstall) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DynamicRuleInstall.Unmarshal(m, b)
@@ -1950,7 +2216,7 @@ func (m *UpdateSessionRequest) Reset()         { *m = UpdateSessionRequest{} }
 func (m *UpdateSessionRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateSessionRequest) ProtoMessage()    {}
 func (*UpdateSessionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_session_manager_ec9068544b9ee644, []int{24}
+	return fileDescriptor_session_manager_cd1c2856b053082f, []int{25}
 }
 func (m *UpdateSessionRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateSessionRequest.Unmarshal(m, b)
@@ -1996,7 +2262,7 @@ func (m *UpdateSessionResponse) Reset()         { *m = UpdateSessionResponse{} }
 func (m *UpdateSessionResponse) String() string { return proto.CompactTextString(m) }
 func (*UpdateSessionResponse) ProtoMessage()    {}
 func (*UpdateSessionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_session_manager_ec9068544b9ee644, []int{25}
+	return fileDescriptor_session_manager_cd1c2856b053082f, []int{26}
 }
 func (m *UpdateSessionResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateSessionResponse.Unmarshal(m, b)
@@ -2042,7 +2308,7 @@ func (m *SessionTerminateResponse) Reset()         { *m = SessionTerminateRespon
 func (m *SessionTerminateResponse) String() string { return proto.CompactTextString(m) }
 func (*SessionTerminateResponse) ProtoMessage()    {}
 func (*SessionTerminateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_session_manager_ec9068544b9ee644, []int{26}
+	return fileDescriptor_session_manager_cd1c2856b053082f, []int{27}
 }
 func (m *SessionTerminateResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SessionTerminateResponse.Unmarshal(m, b)
@@ -2099,7 +2365,7 @@ func (m *SessionTerminateRequest) Reset()         { *m = SessionTerminateRequest
 func (m *SessionTerminateRequest) String() string { return proto.CompactTextString(m) }
 func (*SessionTerminateRequest) ProtoMessage()    {}
 func (*SessionTerminateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_session_manager_ec9068544b9ee644, []int{27}
+	return fileDescriptor_session_manager_cd1c2856b053082f, []int{28}
 }
 func (m *SessionTerminateRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SessionTerminateRequest.Unmarshal(m, b)
@@ -2224,6 +2490,7 @@ func init() {
 	proto.RegisterType((*CreditUnit)(nil), "magma.lte.CreditUnit")
 	proto.RegisterType((*GrantedUnits)(nil), "magma.lte.GrantedUnits")
 	proto.RegisterType((*ChargingCredit)(nil), "magma.lte.ChargingCredit")
+	proto.RegisterType((*RedirectServer)(nil), "magma.lte.RedirectServer")
 	proto.RegisterType((*CreditUsage)(nil), "magma.lte.CreditUsage")
 	proto.RegisterType((*CreditUsageUpdate)(nil), "magma.lte.CreditUsageUpdate")
 	proto.RegisterType((*CreditUpdateResponse)(nil), "magma.lte.CreditUpdateResponse")
@@ -2241,12 +2508,14 @@ func init() {
 	proto.RegisterType((*SessionTerminateResponse)(nil), "magma.lte.SessionTerminateResponse")
 	proto.RegisterType((*SessionTerminateRequest)(nil), "magma.lte.SessionTerminateRequest")
 	proto.RegisterEnum("magma.lte.ReAuthResult", ReAuthResult_name, ReAuthResult_value)
+	proto.RegisterEnum("magma.lte.EventTrigger", EventTrigger_name, EventTrigger_value)
 	proto.RegisterEnum("magma.lte.MonitoringLevel", MonitoringLevel_name, MonitoringLevel_value)
 	proto.RegisterEnum("magma.lte.ChargingReAuthRequest_Type", ChargingReAuthRequest_Type_name, ChargingReAuthRequest_Type_value)
 	proto.RegisterEnum("magma.lte.ChargingReAuthAnswer_Result", ChargingReAuthAnswer_Result_name, ChargingReAuthAnswer_Result_value)
 	proto.RegisterEnum("magma.lte.PolicyReAuthAnswer_FailureCode", PolicyReAuthAnswer_FailureCode_name, PolicyReAuthAnswer_FailureCode_value)
 	proto.RegisterEnum("magma.lte.ChargingCredit_UnitType", ChargingCredit_UnitType_name, ChargingCredit_UnitType_value)
 	proto.RegisterEnum("magma.lte.ChargingCredit_FinalAction", ChargingCredit_FinalAction_name, ChargingCredit_FinalAction_value)
+	proto.RegisterEnum("magma.lte.RedirectServer_RedirectAddressType", RedirectServer_RedirectAddressType_name, RedirectServer_RedirectAddressType_value)
 	proto.RegisterEnum("magma.lte.CreditUsage_UpdateType", CreditUsage_UpdateType_name, CreditUsage_UpdateType_value)
 	proto.RegisterEnum("magma.lte.CreditUpdateResponse_ResponseType", CreditUpdateResponse_ResponseType_name, CreditUpdateResponse_ResponseType_value)
 	proto.RegisterEnum("magma.lte.UsageMonitoringCredit_Action", UsageMonitoringCredit_Action_name, UsageMonitoringCredit_Action_value)
@@ -2626,192 +2895,226 @@ var _CentralSessionController_serviceDesc = grpc.ServiceDesc{
 }
 
 func init() {
-	proto.RegisterFile("lte/protos/session_manager.proto", fileDescriptor_session_manager_ec9068544b9ee644)
-}
-
-var fileDescriptor_session_manager_ec9068544b9ee644 = []byte{
-	// 2925 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x39, 0x4b, 0x73, 0xdb, 0xd6,
-	0xd5, 0x22, 0x29, 0xf1, 0x71, 0x48, 0x4a, 0xf0, 0xb5, 0x65, 0x51, 0xb4, 0x1d, 0x2b, 0x70, 0x9c,
-	0x38, 0xf9, 0x32, 0xd4, 0x57, 0xe5, 0x51, 0xa7, 0x69, 0xeb, 0x52, 0x20, 0x24, 0xa1, 0x26, 0x01,
-	0xfa, 0x12, 0x94, 0x93, 0xcc, 0xb4, 0xb7, 0x10, 0x09, 0x2b, 0x98, 0x80, 0x04, 0x0d, 0x80, 0x8a,
-	0xf4, 0x07, 0x3a, 0xed, 0xae, 0x8b, 0x74, 0xdb, 0x4d, 0xdb, 0xdf, 0xd0, 0x4e, 0x37, 0x9d, 0x6e,
-	0x3a, 0x5d, 0x76, 0xd5, 0xce, 0xf4, 0x3f, 0x74, 0xa6, 0x9b, 0x76, 0xdd, 0xb9, 0x0f, 0x10, 0xe0,
-	0xcb, 0xb4, 0x33, 0x93, 0x99, 0xae, 0x48, 0x9c, 0x73, 0xee, 0xb9, 0xf7, 0xbc, 0xcf, 0x3d, 0x17,
-	0xf6, 0xdc, 0xd0, 0xde, 0x1f, 0xf9, 0x5e, 0xe8, 0x05, 0xfb, 0x81, 0x1d, 0x04, 0x8e, 0x37, 0x24,
-	0x03, 0x6b, 0x68, 0x9d, 0xdb, 0x7e, 0x8d, 0x81, 0x51, 0x61, 0x60, 0x9d, 0x0f, 0xac, 0x9a, 0x1b,
-	0xda, 0xd5, 0x5d, 0xcf, 0xef, 0x3d, 0xf4, 0x23, 0xf2, 0x9e, 0x37, 0x18, 0x78, 0x43, 0x4e, 0x55,
-	0xdd, 0x4d, 0xf0, 0x19, 0x79, 0xae, 0xd3, 0xbb, 0xea, 0x9f, 0x09, 0xd4, 0x9d, 0xe4, 0x16, 0xe3,
-	0xb3, 0xa0, 0xe7, 0x3b, 0x67, 0xb6, 0x3f, 0x41, 0xdf, 0x3d, 0xf7, 0xbc, 0x73, 0x57, 0x50, 0x9c,
-	0x8d, 0x9f, 0xed, 0x87, 0xce, 0xc0, 0x0e, 0x42, 0x6b, 0x30, 0xe2, 0x04, 0xf2, 0x00, 0x00, 0x8f,
-	0x5d, 0x1b, 0xdb, 0x3d, 0xcf, 0xef, 0x23, 0x09, 0x32, 0x81, 0xd3, 0xaf, 0xa4, 0xf6, 0x52, 0x0f,
-	0x0a, 0x98, 0xfe, 0x45, 0x3b, 0x90, 0xf3, 0xc7, 0xae, 0x4d, 0x9c, 0x7e, 0x25, 0xcd, 0xa0, 0x59,
-	0xfa, 0xa9, 0xf5, 0xd1, 0x2e, 0xe4, 0xcf, 0xae, 0x42, 0x3b, 0x20, 0xe1, 0x65, 0x25, 0xb3, 0x97,
-	0x7a, 0xb0, 0x8e, 0x73, 0xec, 0xdb, 0xbc, 0x8c, 0x51, 0xfe, 0x65, 0x65, 0x3d, 0x81, 0xc2, 0x97,
-	0xf2, 0x21, 0x6c, 0xc5, 0xdb, 0x99, 0xd6, 0x99, 0x6b, 0xa3, 0x7d, 0xc8, 0xf9, 0xec, 0x33, 0xa8,
-	0xa4, 0xf6, 0x32, 0x0f, 0x8a, 0x07, 0xdb, 0xb5, 0x89, 0x52, 0x6a, 0x31, 0x31, 0x8e, 0xa8, 0xe4,
-	0xbf, 0xa7, 0x61, 0xb7, 0xe9, 0xf5, 0x2c, 0x57, 0xf1, 0x6d, 0x2b, 0xb4, 0x3b, 0x5c, 0xb1, 0xd8,
-	0x7e, 0x3e, 0xb6, 0x83, 0x10, 0xbd, 0x1d, 0x8b, 0x50, 0x3c, 0xd8, 0x49, 0xb0, 0xea, 0x4c, 0xb4,
-	0xa3, 0x35, 0x26, 0xb2, 0x8d, 0x6d, 0xe2, 0x8c, 0x2e, 0xde, 0x8f, 0x64, 0x1b, 0xdb, 0xda, 0xe8,
-	0xe2, 0x7d, 0x74, 0x0b, 0x0a, 0xc1, 0xe8, 0xfc, 0x4b, 0x8e, 0xca, 0x30, 0x54, 0x9e, 0x02, 0x18,
-	0x52, 0x82, 0x8c, 0x35, 0x1a, 0x32, 0xc1, 0x0a, 0x98, 0xfe, 0x45, 0x08, 0xd6, 0x9d, 0x81, 0xed,
-	0x54, 0xb2, 0x0c, 0xc4, 0xfe, 0x53, 0xde, 0x23, 0x77, 0x30, 0xa4, 0x7a, 0xcb, 0x71, 0xde, 0xf4,
-	0x53, 0xeb, 0xa3, 0x3d, 0x28, 0x39, 0x83, 0xc0, 0x21, 0x11, 0x36, 0xcf, 0xb0, 0x40, 0x61, 0x6d,
-	0x4e, 0x71, 0x0f, 0xca, 0xe3, 0xc0, 0xf6, 0x89, 0xeb, 0xf5, 0xac, 0xd0, 0xf1, 0x86, 0x95, 0xc2,
-	0x5e, 0xea, 0x41, 0x09, 0x97, 0x28, 0xb0, 0x29, 0x60, 0xe8, 0x63, 0xc8, 0x3f, 0xf7, 0x02, 0xe2,
-	0x0c, 0x9f, 0x79, 0x15, 0x60, 0xb2, 0xee, 0x25, 0x64, 0x7d, 0xe2, 0x05, 0xda, 0xf0, 0x99, 0xe7,
-	0x0f, 0x18, 0xb1, 0x50, 0x0d, 0xce, 0x3d, 0xe7, 0x60, 0x74, 0x13, 0xb2, 0x83, 0xc0, 0x09, 0xfa,
-	0xc3, 0x4a, 0x91, 0xb1, 0x16, 0x5f, 0xf2, 0x6d, 0xa8, 0x2e, 0x52, 0x6c, 0x30, 0xf2, 0x86, 0x81,
-	0x2d, 0xef, 0xc2, 0x0e, 0xc3, 0xaa, 0xc3, 0xfe, 0x2c, 0xea, 0x6f, 0x29, 0xd8, 0x56, 0x3e, 0xb7,
-	0xfc, 0x73, 0x67, 0x78, 0x8e, 0xed, 0xfa, 0x38, 0xfc, 0x3c, 0x32, 0xc7, 0x1d, 0x80, 0xc8, 0xf3,
-	0x27, 0x8e, 0x55, 0x10, 0x10, 0xad, 0x8f, 0x5e, 0x87, 0x52, 0x4f, 0xac, 0x23, 0x5f, 0xd8, 0x57,
-	0xcc, 0x0e, 0x65, 0x5c, 0x8c, 0x60, 0x8f, 0xed, 0xab, 0xc8, 0x27, 0x33, 0xb1, 0x4f, 0x7e, 0x04,
-	0xeb, 0xe1, 0xd5, 0xc8, 0x66, 0x26, 0xd8, 0x3c, 0xb8, 0x9f, 0x90, 0x7b, 0xe1, 0x19, 0x6a, 0xe6,
-	0xd5, 0xc8, 0xc6, 0x6c, 0x89, 0x5c, 0x83, 0x75, 0xfa, 0x85, 0x10, 0x6c, 0x76, 0x34, 0xfd, 0xb8,
-	0xa9, 0x92, 0x8e, 0x8a, 0x4f, 0x35, 0x45, 0x95, 0xd6, 0x28, 0x4c, 0xd5, 0x4d, 0x0d, 0x53, 0x58,
-	0xa7, 0xa3, 0x19, 0xba, 0x94, 0x92, 0x7f, 0x97, 0x82, 0x1b, 0xd3, 0x4c, 0xeb, 0xc3, 0xe0, 0x4b,
-	0xdb, 0x47, 0xdf, 0x87, 0xac, 0x6f, 0x07, 0x63, 0x37, 0x64, 0x32, 0x6d, 0x1e, 0xbc, 0xb9, 0xf4,
-	0x14, 0x7c, 0x41, 0x0d, 0x33, 0x6a, 0x2c, 0x56, 0xc9, 0x04, 0xb2, 0x1c, 0x82, 0x6e, 0x80, 0xd4,
-	0x6d, 0x37, 0xea, 0xa6, 0x4a, 0x34, 0x5d, 0x33, 0xb5, 0xba, 0xa9, 0x36, 0xa4, 0x35, 0xb4, 0x0d,
-	0xd7, 0x04, 0x54, 0x37, 0x4c, 0xa2, 0xab, 0x6a, 0x43, 0x6d, 0x48, 0x29, 0x0a, 0x16, 0x87, 0x63,
-	0xf0, 0x23, 0xa3, 0xab, 0x37, 0xa4, 0x34, 0xba, 0x06, 0x65, 0xc3, 0x3c, 0x51, 0x31, 0x39, 0xaa,
-	0x6b, 0xcd, 0x2e, 0x56, 0xa5, 0x8c, 0xfc, 0x8b, 0x34, 0x5c, 0x6f, 0xb3, 0x5c, 0xf1, 0x4a, 0x06,
-	0x61, 0xbe, 0x1c, 0x38, 0x22, 0x20, 0xd8, 0x7f, 0xf4, 0x26, 0x6c, 0xd1, 0xa0, 0x0f, 0x48, 0xe8,
-	0x11, 0xdf, 0x1e, 0x78, 0x17, 0x76, 0x25, 0xb3, 0x97, 0x79, 0x50, 0xc0, 0x65, 0x06, 0x36, 0x3d,
-	0xcc, 0x80, 0xe8, 0x08, 0xa4, 0x09, 0x9d, 0x33, 0x0c, 0x42, 0xcb, 0x75, 0x2b, 0x59, 0x16, 0xd2,
-	0xb7, 0x93, 0x71, 0x18, 0x5a, 0xa1, 0xd3, 0xa3, 0x81, 0xad, 0x71, 0x1a, 0xbc, 0x29, 0xd8, 0x88,
-	0x6f, 0x74, 0x0a, 0x95, 0xfe, 0xd5, 0xd0, 0x1a, 0x38, 0x3d, 0x32, 0xc7, 0x2f, 0xc7, 0xf8, 0xdd,
-	0x49, 0xf0, 0x6b, 0x70, 0xd2, 0x24, 0xc3, 0xed, 0x7e, 0x0c, 0x8b, 0xf9, 0xca, 0xbf, 0xcc, 0x03,
-	0x4a, 0xaa, 0x44, 0x98, 0x72, 0x85, 0x46, 0xf6, 0x27, 0x96, 0x4e, 0x33, 0x4b, 0x27, 0x73, 0x4a,
-	0xa4, 0xda, 0xa4, 0x69, 0xd1, 0x13, 0x28, 0x3d, 0xb3, 0x1c, 0xd7, 0xee, 0xf3, 0xd3, 0x33, 0x5d,
-	0x15, 0x0f, 0x6a, 0x89, 0x65, 0xf3, 0x87, 0xa8, 0x1d, 0xb1, 0x15, 0xec, 0xc0, 0xea, 0x30, 0xf4,
-	0xaf, 0x70, 0xf1, 0x59, 0x0c, 0xa9, 0x3a, 0x20, 0xcd, 0x12, 0xd0, 0xb8, 0xa0, 0x11, 0x23, 0x72,
-	0xf5, 0x17, 0xf6, 0x15, 0x7a, 0x04, 0x1b, 0x17, 0x96, 0x3b, 0xb6, 0xc5, 0x41, 0xdf, 0x5e, 0xbd,
-	0xe3, 0xd8, 0xb7, 0x15, 0xaf, 0x6f, 0x63, 0xbe, 0xee, 0x3b, 0xe9, 0x87, 0x29, 0xf9, 0x5f, 0x1b,
-	0x50, 0x4c, 0xa0, 0x10, 0x40, 0xb6, 0xab, 0x77, 0x3b, 0x13, 0xa7, 0xd4, 0x1f, 0xeb, 0xc6, 0x53,
-	0x9d, 0xe0, 0x6e, 0x53, 0x25, 0x7a, 0xbd, 0xa5, 0x4a, 0x29, 0x74, 0x13, 0x10, 0xae, 0x9b, 0x9a,
-	0x7e, 0x4c, 0x8e, 0xb1, 0xd1, 0x6d, 0x13, 0x15, 0x63, 0x03, 0x4b, 0x69, 0x74, 0x1b, 0x2a, 0x22,
-	0xba, 0x88, 0xd6, 0xa0, 0xa1, 0x75, 0xa4, 0xa9, 0x58, 0x60, 0x33, 0x68, 0x07, 0xae, 0x1f, 0x3f,
-	0x25, 0x6d, 0x45, 0x3d, 0x22, 0xad, 0x7a, 0xf3, 0xa8, 0xab, 0x2b, 0x26, 0x8d, 0xb9, 0x75, 0x54,
-	0x81, 0x1b, 0x58, 0xed, 0x18, 0x5d, 0xac, 0xa8, 0x1d, 0xd2, 0xd4, 0x5a, 0x9a, 0x59, 0x67, 0x98,
-	0x0d, 0x54, 0x85, 0x9b, 0xad, 0xfa, 0x27, 0x44, 0xc7, 0xe4, 0x50, 0xad, 0x63, 0x15, 0x77, 0x08,
-	0x56, 0xeb, 0xca, 0x89, 0xda, 0x90, 0xb2, 0xc9, 0xb3, 0x71, 0x24, 0xd1, 0x1a, 0x52, 0x8e, 0x82,
-	0x5b, 0x5a, 0x87, 0xc6, 0x7a, 0x02, 0x9c, 0xa7, 0x47, 0x8b, 0xc0, 0x47, 0x4d, 0xe3, 0x29, 0xd1,
-	0xf4, 0x23, 0x03, 0xb7, 0xf8, 0x3e, 0x05, 0x74, 0x17, 0x6e, 0x45, 0x27, 0x20, 0xf5, 0x66, 0xd3,
-	0x50, 0x18, 0x62, 0x12, 0x5c, 0x40, 0x09, 0xba, 0x7a, 0xa7, 0xab, 0x28, 0x6a, 0xa7, 0x73, 0xd4,
-	0x6d, 0x92, 0x27, 0x46, 0x87, 0x9c, 0xd6, 0x9b, 0x5a, 0x83, 0x73, 0x28, 0xa2, 0xd7, 0xa0, 0xaa,
-	0xe9, 0x8a, 0x81, 0xb1, 0xaa, 0x98, 0xf3, 0x3b, 0x94, 0xe8, 0xb1, 0xda, 0x1d, 0x62, 0x1a, 0x44,
-	0xe9, 0x90, 0x93, 0xba, 0xde, 0x30, 0x4e, 0x55, 0x2c, 0x95, 0xd1, 0x1b, 0xb0, 0x67, 0x36, 0x8e,
-	0x48, 0xbd, 0xdd, 0x6e, 0x6a, 0x62, 0xd3, 0x39, 0xcd, 0x6d, 0xa2, 0xeb, 0xb0, 0xa5, 0x1b, 0x91,
-	0x38, 0x3c, 0x05, 0x6c, 0x51, 0x75, 0x1e, 0x69, 0x4d, 0x53, 0xc5, 0x04, 0xab, 0x1d, 0x13, 0x6b,
-	0x4c, 0x9b, 0x1d, 0x49, 0x42, 0x12, 0x94, 0xea, 0x3a, 0x39, 0x7e, 0xca, 0x8e, 0xaf, 0x36, 0xa4,
-	0x6b, 0xe8, 0x1e, 0xdc, 0x8d, 0x84, 0xc7, 0x6a, 0x43, 0x63, 0x67, 0xa4, 0x86, 0x52, 0x31, 0xa9,
-	0x37, 0x1a, 0x58, 0xed, 0x74, 0x24, 0x44, 0x25, 0x50, 0x5a, 0x44, 0xd5, 0x1b, 0xa4, 0xdb, 0x51,
-	0x71, 0x94, 0x26, 0x49, 0x43, 0xd5, 0x35, 0xb5, 0x21, 0x5d, 0xa7, 0x47, 0x55, 0x5a, 0x44, 0xa1,
-	0x0c, 0x4c, 0xa2, 0x18, 0xba, 0x89, 0x8d, 0x26, 0xcb, 0x49, 0xe2, 0xf0, 0x87, 0x4d, 0x55, 0xba,
-	0x81, 0xee, 0xc0, 0xae, 0xd2, 0x22, 0xf5, 0xae, 0x79, 0x62, 0x60, 0xed, 0x33, 0x2e, 0x11, 0x56,
-	0x7f, 0xa8, 0x2a, 0x34, 0xcb, 0x6d, 0x53, 0x49, 0x94, 0x16, 0xdf, 0x40, 0x18, 0x4f, 0xba, 0x49,
-	0x13, 0xa2, 0xd2, 0x22, 0xc2, 0xa3, 0xc4, 0xa1, 0x77, 0xa8, 0xed, 0xb1, 0xd1, 0x65, 0x30, 0xe6,
-	0x7b, 0x9c, 0x0b, 0xd5, 0x66, 0x05, 0xbd, 0x09, 0xf2, 0xc4, 0x2f, 0x05, 0x4d, 0x9d, 0xd9, 0x66,
-	0x4a, 0xeb, 0xbb, 0x54, 0xeb, 0xba, 0x41, 0xf4, 0x43, 0xed, 0xc8, 0x68, 0x91, 0x4e, 0xb7, 0xdd,
-	0x36, 0xb0, 0x29, 0x55, 0xe5, 0x47, 0x00, 0x8a, 0x6f, 0xf7, 0x9d, 0xb0, 0x3b, 0x74, 0x42, 0xda,
-	0xbd, 0x38, 0x01, 0xb9, 0xb0, 0x5c, 0x91, 0x0c, 0xf2, 0x38, 0xe7, 0x04, 0xa7, 0xf4, 0x93, 0xd6,
-	0xcd, 0x0b, 0xcf, 0x1d, 0x0f, 0x78, 0x84, 0xad, 0x63, 0xf1, 0x25, 0xff, 0x3c, 0x05, 0xa5, 0x63,
-	0xdf, 0x1a, 0x86, 0x76, 0x9f, 0xb2, 0x08, 0xd0, 0xff, 0xc1, 0x46, 0xe8, 0x85, 0x96, 0x2b, 0xda,
-	0x90, 0x64, 0x47, 0x13, 0xef, 0x84, 0x39, 0x0d, 0xba, 0x0f, 0xe9, 0xf0, 0x92, 0x71, 0x5c, 0x4a,
-	0x99, 0x0e, 0x2f, 0x29, 0x99, 0xcf, 0x5b, 0xad, 0xe5, 0x64, 0xfe, 0xa5, 0xfc, 0xef, 0x34, 0x6c,
-	0x46, 0x05, 0x88, 0xa3, 0xd0, 0x87, 0xa2, 0x5e, 0xf2, 0xb4, 0x20, 0x2f, 0xa8, 0x54, 0x9c, 0xb0,
-	0x46, 0x99, 0xc4, 0xc5, 0x92, 0x36, 0x22, 0x4c, 0x0d, 0x4e, 0x78, 0x45, 0x68, 0xdf, 0xc8, 0x36,
-	0x2f, 0xe3, 0x52, 0x04, 0x34, 0x9d, 0x81, 0x2d, 0xd4, 0xf5, 0xcc, 0x19, 0x5a, 0x2e, 0x2b, 0xc8,
-	0x4c, 0x5d, 0x47, 0xf4, 0x13, 0x9d, 0x40, 0x89, 0xc1, 0x89, 0xd5, 0x63, 0x7d, 0xcc, 0xc6, 0xd2,
-	0x7a, 0x2d, 0xf6, 0x67, 0xcb, 0xea, 0x8c, 0x18, 0x17, 0x9f, 0xc5, 0x1f, 0xe8, 0xbb, 0x50, 0x3e,
-	0xe7, 0xfa, 0x25, 0x63, 0xaa, 0x60, 0xd6, 0x6a, 0x4d, 0xb7, 0x77, 0x49, 0xfd, 0xe3, 0xd2, 0x79,
-	0xe2, 0x4b, 0x96, 0x21, 0x1f, 0x49, 0x86, 0x0a, 0xb0, 0x71, 0xf8, 0xa9, 0xa9, 0x76, 0xa4, 0x35,
-	0x54, 0x84, 0x5c, 0x47, 0x55, 0x0c, 0xbd, 0xd1, 0x91, 0x52, 0xf2, 0x23, 0x28, 0x26, 0x76, 0x47,
-	0x65, 0x28, 0x98, 0x2a, 0x6e, 0x69, 0x7a, 0xdd, 0xa4, 0xad, 0x41, 0x09, 0xf2, 0x51, 0xa4, 0x48,
-	0x29, 0xea, 0xb5, 0x51, 0x8c, 0x09, 0x3f, 0x93, 0xd2, 0xf2, 0xcf, 0x32, 0x50, 0x14, 0xa6, 0x08,
-	0xac, 0x73, 0x7b, 0xaa, 0x3f, 0x4e, 0x2d, 0xef, 0x8f, 0xd3, 0x53, 0xfd, 0xf1, 0x5c, 0x3f, 0xb4,
-	0x3e, 0xdf, 0x0f, 0x7d, 0x20, 0xac, 0xc9, 0xb5, 0xf9, 0xfa, 0xbc, 0x27, 0xd0, 0xed, 0x6b, 0xdd,
-	0x51, 0xdf, 0x0a, 0xed, 0x84, 0x31, 0xef, 0xc3, 0xe6, 0xc0, 0x1b, 0x3a, 0xa1, 0xe7, 0x47, 0xbc,
-	0x79, 0xbb, 0x5a, 0x8e, 0xa1, 0x8f, 0xed, 0x2b, 0xf9, 0x4f, 0x29, 0x80, 0x78, 0x2d, 0xd3, 0xc3,
-	0x09, 0x56, 0x3b, 0x27, 0x46, 0x93, 0x16, 0x80, 0x1c, 0x64, 0x9e, 0x9c, 0x50, 0x15, 0x6c, 0x02,
-	0x4c, 0xf4, 0x43, 0x1b, 0x90, 0xeb, 0xb0, 0xf5, 0xa4, 0x6b, 0x98, 0x75, 0xa2, 0x7e, 0x72, 0x52,
-	0xef, 0x76, 0x28, 0x30, 0x43, 0x43, 0x96, 0x25, 0x45, 0xcd, 0xfc, 0x94, 0x98, 0x5a, 0x8b, 0x66,
-	0xb0, 0x4f, 0xda, 0x1a, 0x56, 0x1b, 0xd2, 0x3a, 0x0d, 0x72, 0xde, 0xb1, 0xf0, 0x65, 0xe6, 0xa7,
-	0x6d, 0x55, 0xda, 0x40, 0xb7, 0x60, 0x47, 0xc4, 0x3d, 0xb5, 0x8b, 0xc6, 0xd2, 0x85, 0x72, 0x52,
-	0xd7, 0x8f, 0x55, 0x29, 0xcb, 0xd5, 0x4e, 0x53, 0x09, 0xc1, 0xea, 0x93, 0x2e, 0xe3, 0x93, 0xa3,
-	0x4d, 0x5b, 0xdb, 0x30, 0x9a, 0x89, 0x7d, 0xf3, 0xf2, 0x3f, 0xd3, 0x70, 0x2d, 0xa1, 0x0b, 0x2e,
-	0x0e, 0x7a, 0x17, 0x36, 0xc6, 0xf4, 0x53, 0xc4, 0xe4, 0xcd, 0xc5, 0x8a, 0xc3, 0x9c, 0x68, 0xa6,
-	0x29, 0x48, 0xcf, 0x36, 0x05, 0xf7, 0x61, 0xd3, 0xe7, 0x0d, 0x15, 0x19, 0x8e, 0x07, 0x67, 0xb6,
-	0x2f, 0x62, 0xa3, 0x2c, 0xa0, 0x3a, 0x03, 0x46, 0xbd, 0xeb, 0x7a, 0xdc, 0xbb, 0xc6, 0xad, 0xf7,
-	0x46, 0xb2, 0xf5, 0x4e, 0xde, 0x45, 0xb2, 0xcb, 0xef, 0x22, 0xb9, 0xc5, 0x77, 0x91, 0xfc, 0xfc,
-	0x5d, 0xa4, 0xb0, 0xf8, 0x2e, 0x02, 0x2f, 0xbc, 0x8b, 0x14, 0x57, 0xdf, 0x45, 0x4a, 0xf3, 0x77,
-	0x11, 0xf9, 0x3f, 0xb4, 0x49, 0xe6, 0x2a, 0x64, 0xaa, 0x8e, 0xae, 0x05, 0xa8, 0x02, 0xb9, 0x60,
-	0xdc, 0xeb, 0xd9, 0x41, 0x10, 0x65, 0x52, 0xf1, 0x19, 0x29, 0x26, 0x1d, 0x2b, 0x66, 0xd6, 0xf3,
-	0x33, 0xf3, 0x9e, 0xff, 0x2d, 0xc8, 0xf6, 0xd8, 0x36, 0x4c, 0xa1, 0xc5, 0x83, 0xdd, 0xa5, 0x99,
-	0x04, 0x0b, 0x42, 0xf4, 0x83, 0xa9, 0x60, 0x79, 0x77, 0xde, 0xe6, 0x53, 0x07, 0xae, 0x45, 0x7f,
-	0x12, 0x37, 0x86, 0x2a, 0x94, 0x92, 0x50, 0xd6, 0x0f, 0xb1, 0xc6, 0x5c, 0x5a, 0x93, 0x7f, 0x9d,
-	0x02, 0xc4, 0xbc, 0xa6, 0xc5, 0x63, 0x48, 0x78, 0xda, 0x7c, 0xa8, 0xa5, 0x16, 0x84, 0x1a, 0xfa,
-	0x7f, 0xd8, 0x70, 0xed, 0x0b, 0xdb, 0x15, 0x79, 0xb9, 0x9a, 0x38, 0x5c, 0x6b, 0x42, 0xd8, 0xa4,
-	0x14, 0x98, 0x13, 0x7e, 0xcd, 0x3b, 0xf7, 0x57, 0x69, 0xd8, 0x4e, 0x9e, 0x32, 0x2e, 0x0c, 0x8f,
-	0x20, 0x2b, 0x52, 0x33, 0xbf, 0xc4, 0xbc, 0x95, 0x38, 0xc2, 0xc2, 0x15, 0x35, 0x91, 0x9c, 0xc5,
-	0xb2, 0x05, 0x92, 0xa6, 0x5f, 0x28, 0x69, 0xe6, 0x65, 0x25, 0x9d, 0x4b, 0xf8, 0x1b, 0xaf, 0x92,
-	0xf0, 0xef, 0x41, 0x56, 0xe4, 0xf1, 0x12, 0xe4, 0x69, 0x6f, 0xa2, 0xe9, 0x5d, 0x95, 0x67, 0xfc,
-	0x86, 0xd6, 0x61, 0xad, 0x49, 0x4a, 0xfe, 0x73, 0x0a, 0x6e, 0xcf, 0x08, 0x19, 0x79, 0x03, 0xbf,
-	0x29, 0x7d, 0x00, 0xd9, 0x31, 0x03, 0x88, 0x8c, 0x71, 0x67, 0x89, 0x76, 0xc4, 0x2a, 0x41, 0xfc,
-	0x8d, 0x65, 0x8e, 0x44, 0x86, 0xd8, 0x48, 0x66, 0x08, 0xf9, 0x37, 0x29, 0xb8, 0xb3, 0x44, 0x10,
-	0x11, 0x87, 0x0f, 0x27, 0x81, 0x93, 0x9a, 0x1b, 0x15, 0x2c, 0xb4, 0xf3, 0x24, 0x7e, 0x56, 0x08,
-	0x33, 0x7f, 0x37, 0x4f, 0x84, 0xfc, 0xfa, 0x54, 0xc8, 0xd3, 0x0b, 0xe9, 0xf6, 0xc2, 0xb9, 0x04,
-	0x7a, 0x0d, 0x8a, 0xd6, 0x68, 0x48, 0xac, 0xc1, 0x99, 0x4f, 0xfa, 0xbc, 0x67, 0x2a, 0xe3, 0x82,
-	0x35, 0x1a, 0xd6, 0x07, 0x67, 0x7e, 0xc3, 0x9d, 0xc2, 0x8f, 0x5d, 0x31, 0x23, 0x88, 0xf0, 0x5d,
-	0xda, 0x40, 0x6d, 0x8e, 0x7c, 0xc7, 0xf3, 0x69, 0x9f, 0x12, 0xfb, 0x59, 0x19, 0x97, 0x23, 0x28,
-	0x73, 0x2d, 0xf4, 0x1e, 0x6c, 0x8f, 0x7c, 0xdb, 0x1e, 0x8c, 0xe8, 0xde, 0xa4, 0x67, 0x8d, 0xac,
-	0x33, 0xc7, 0x75, 0xc2, 0xa8, 0xc8, 0xde, 0x88, 0x91, 0xca, 0x04, 0x87, 0x3e, 0x82, 0x4a, 0x62,
-	0xd1, 0xc5, 0xd8, 0x1d, 0xda, 0x7e, 0xb4, 0x6e, 0x83, 0xad, 0xdb, 0x89, 0xf1, 0xa7, 0x49, 0x34,
-	0xcd, 0xae, 0xcf, 0xbd, 0x80, 0xf4, 0x5c, 0x2b, 0x08, 0xa8, 0xf6, 0xb2, 0x8c, 0x1c, 0x9e, 0x7b,
-	0x81, 0x42, 0x41, 0x5a, 0x5f, 0xfe, 0x2a, 0xc3, 0x12, 0xe7, 0xfc, 0x10, 0xeb, 0xdb, 0x00, 0xf1,
-	0x30, 0x6f, 0xd5, 0x2c, 0x2b, 0x41, 0xba, 0xca, 0x5e, 0x09, 0x1f, 0xca, 0x2c, 0xaf, 0x32, 0xeb,
-	0x8b, 0xab, 0xcc, 0xc6, 0x7c, 0x95, 0xc9, 0x2d, 0xae, 0x32, 0xf9, 0x17, 0x56, 0x99, 0xc2, 0xea,
-	0x2a, 0x03, 0x2b, 0x26, 0x5e, 0xc5, 0xaf, 0x3f, 0xf1, 0x2a, 0x4d, 0x95, 0xdd, 0xeb, 0xb0, 0x71,
-	0xde, 0xa3, 0x87, 0x2a, 0x73, 0x49, 0xce, 0x7b, 0x5a, 0x5f, 0xfe, 0x6b, 0x1a, 0xb6, 0x17, 0x8e,
-	0xc0, 0xd0, 0x47, 0x90, 0xe3, 0x81, 0x11, 0xcd, 0x2a, 0xef, 0xae, 0xa8, 0x28, 0x38, 0xa2, 0x8f,
-	0x86, 0x28, 0xe4, 0xcc, 0x0a, 0x6c, 0x32, 0xb4, 0x06, 0x36, 0xcd, 0x69, 0x93, 0x21, 0xca, 0xa1,
-	0x15, 0xd8, 0x3a, 0x05, 0x22, 0x03, 0x36, 0x59, 0x07, 0x42, 0x44, 0x0a, 0x0d, 0xc4, 0x08, 0xe5,
-	0xc1, 0xf2, 0x98, 0x9d, 0xd9, 0xb2, 0x3c, 0x4e, 0xa0, 0x03, 0xf4, 0x08, 0x4a, 0x01, 0x1b, 0xb9,
-	0x88, 0x71, 0x44, 0xee, 0x25, 0x26, 0x32, 0xc5, 0x60, 0x02, 0x0a, 0xd0, 0x21, 0x94, 0xa7, 0xc6,
-	0x31, 0x95, 0xfc, 0xcb, 0xcc, 0x60, 0x4a, 0xc9, 0x19, 0x8c, 0xfc, 0x87, 0x14, 0x5c, 0x9b, 0xdb,
-	0x26, 0x39, 0x5c, 0x4e, 0x4d, 0x0d, 0x97, 0x15, 0xd8, 0xa2, 0x15, 0xe6, 0x82, 0x19, 0x93, 0xdf,
-	0x3d, 0xf8, 0xfd, 0xa8, 0x5a, 0xe3, 0x03, 0xed, 0x5a, 0x34, 0xd0, 0xae, 0x99, 0xd1, 0x40, 0x1b,
-	0x6f, 0xc6, 0x4b, 0xd8, 0xcd, 0xe4, 0x18, 0xae, 0xf5, 0xed, 0x59, 0x36, 0x99, 0x95, 0x6c, 0xa4,
-	0xe4, 0x22, 0x0a, 0x96, 0xff, 0x91, 0x02, 0x34, 0x2f, 0x21, 0xfa, 0x10, 0x8a, 0x7c, 0x18, 0xcf,
-	0xd4, 0xb2, 0xe0, 0xaa, 0x27, 0x86, 0x2e, 0x63, 0xd7, 0xc6, 0x30, 0x9a, 0xfc, 0xff, 0x1f, 0x13,
-	0xee, 0x57, 0x29, 0xb8, 0xc1, 0x1d, 0x68, 0x26, 0x07, 0x7d, 0x08, 0x39, 0x5e, 0xd1, 0x22, 0x5f,
-	0xbf, 0xbd, 0xb8, 0x63, 0x16, 0xde, 0x17, 0x11, 0x23, 0x7d, 0xce, 0x81, 0xf9, 0x00, 0xec, 0xad,
-	0xd5, 0x0e, 0xcc, 0x83, 0x76, 0xda, 0x7f, 0xe5, 0xdf, 0xa7, 0x60, 0x7b, 0xe6, 0x80, 0x22, 0x1a,
-	0xbf, 0x07, 0x05, 0x5f, 0xfc, 0x7f, 0xe9, 0x78, 0x8c, 0x57, 0xa0, 0x9f, 0xc0, 0xce, 0xd4, 0x41,
-	0x49, 0xcc, 0x2c, 0xf3, 0x8a, 0x21, 0xb7, 0x9d, 0x3c, 0x72, 0x04, 0x0d, 0xe4, 0xc7, 0x50, 0x11,
-	0x67, 0x36, 0x6d, 0x7f, 0xe0, 0x0c, 0x93, 0x35, 0x79, 0xfe, 0xa9, 0xe5, 0xc5, 0xb9, 0x5b, 0xfe,
-	0x4b, 0x06, 0x76, 0xe6, 0xb9, 0x71, 0x5b, 0xbd, 0x2a, 0xb3, 0x28, 0xa5, 0x67, 0xe2, 0x94, 0x3e,
-	0xdf, 0x97, 0xac, 0x2f, 0xea, 0x4b, 0x3e, 0x86, 0x32, 0xcf, 0x68, 0x84, 0x89, 0xcc, 0x93, 0xd8,
-	0xf2, 0xdb, 0x54, 0xa9, 0x17, 0x7f, 0x04, 0xa8, 0x31, 0x69, 0x17, 0xa3, 0xd5, 0xd9, 0xb9, 0x54,
-	0xb2, 0xa0, 0xb3, 0x8a, 0xba, 0x49, 0xc1, 0x25, 0x51, 0xc4, 0x72, 0x53, 0x45, 0x2c, 0x4e, 0xf2,
-	0xf9, 0xa9, 0x24, 0x3f, 0x55, 0xdc, 0x0a, 0x33, 0xc5, 0x2d, 0x2a, 0x65, 0xb0, 0xb8, 0x94, 0x15,
-	0x5f, 0x58, 0xca, 0x4a, 0xab, 0x4b, 0x59, 0x79, 0xbe, 0x94, 0xbd, 0x63, 0xd3, 0x3b, 0x45, 0x3c,
-	0x39, 0xfe, 0x86, 0x9e, 0x00, 0xde, 0x79, 0x08, 0x5b, 0x33, 0xed, 0x35, 0xa5, 0x8a, 0x16, 0x37,
-	0xd5, 0x53, 0xb5, 0xc9, 0x9f, 0x3d, 0xda, 0x8a, 0xc2, 0x87, 0x6a, 0x1c, 0x96, 0x3a, 0xf8, 0x69,
-	0x1a, 0xae, 0xb3, 0xb7, 0x1e, 0xe1, 0x70, 0x2d, 0xfe, 0x68, 0x89, 0x0e, 0x61, 0x0b, 0xdb, 0x23,
-	0xcf, 0x0f, 0x69, 0x22, 0xa3, 0xf9, 0x3c, 0x40, 0xd5, 0x85, 0xaf, 0x75, 0xec, 0x69, 0xaf, 0x7a,
-	0x4d, 0xe0, 0xd8, 0xcb, 0x66, 0xed, 0xd4, 0x73, 0xfa, 0xf2, 0x1a, 0xfa, 0x31, 0x94, 0xa7, 0x8a,
-	0x2b, 0x7a, 0x23, 0xc1, 0x61, 0xe9, 0xbb, 0x5e, 0xf5, 0xfe, 0x0a, 0x2a, 0xf1, 0x12, 0xb5, 0x86,
-	0x1e, 0x03, 0xc4, 0x2f, 0x54, 0x68, 0x59, 0xd7, 0x54, 0x95, 0x67, 0xf9, 0x2d, 0x78, 0xd6, 0x5a,
-	0x3b, 0xf8, 0x63, 0x0a, 0xb6, 0x05, 0xb4, 0xed, 0x7b, 0x97, 0x57, 0x1c, 0xd5, 0xb7, 0x7d, 0xd4,
-	0x8d, 0xc7, 0x6c, 0xdc, 0x96, 0x68, 0x6f, 0xd5, 0x43, 0x54, 0xf5, 0xee, 0x8a, 0x47, 0x22, 0x79,
-	0x0d, 0x19, 0x50, 0x4a, 0xce, 0xea, 0xd1, 0x6b, 0x4b, 0x86, 0xf8, 0x11, 0xcb, 0x3b, 0x2f, 0x1c,
-	0xf2, 0xcb, 0x6b, 0x07, 0xbf, 0x4d, 0x43, 0x45, 0xb1, 0x87, 0xa1, 0x3f, 0x31, 0xa6, 0xe2, 0x0d,
-	0x43, 0xdf, 0x73, 0x5d, 0xdb, 0x47, 0xe6, 0xac, 0x2d, 0x66, 0xf2, 0xe7, 0xbc, 0x19, 0xf6, 0x96,
-	0x13, 0x4c, 0x2c, 0x60, 0x42, 0x79, 0x2a, 0x61, 0x4f, 0x71, 0x5d, 0x54, 0x6b, 0xa6, 0xb8, 0x2e,
-	0xcc, 0xf5, 0xf2, 0x1a, 0xfa, 0x11, 0x48, 0x93, 0xbc, 0x17, 0x31, 0x4e, 0x1a, 0x71, 0x49, 0x6e,
-	0xac, 0xde, 0x7b, 0x21, 0x4d, 0xc4, 0xfe, 0xf0, 0xd6, 0x67, 0xbb, 0x8c, 0x6e, 0xdf, 0x0d, 0xed,
-	0xfd, 0x9e, 0xeb, 0x8d, 0xfb, 0xfb, 0xe7, 0x9e, 0x78, 0x59, 0x3f, 0xcb, 0xb2, 0xdf, 0xf7, 0xfe,
-	0x1b, 0x00, 0x00, 0xff, 0xff, 0x17, 0x15, 0xde, 0x4e, 0xd1, 0x1f, 0x00, 0x00,
+	proto.RegisterFile("lte/protos/session_manager.proto", fileDescriptor_session_manager_cd1c2856b053082f)
+}
+
+var fileDescriptor_session_manager_cd1c2856b053082f = []byte{
+	// 3466 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x3a, 0x4d, 0x73, 0x23, 0x49,
+	0x56, 0x96, 0x64, 0x49, 0xf6, 0x93, 0x64, 0x57, 0xa7, 0xdb, 0x6d, 0x59, 0xd3, 0xee, 0xf6, 0xd4,
+	0x6c, 0xcf, 0xf6, 0x0e, 0x8b, 0x1b, 0xbc, 0xb3, 0xcd, 0x0c, 0x0b, 0xdb, 0x94, 0x4b, 0x29, 0xbb,
+	0x68, 0xa9, 0x4a, 0x9d, 0x55, 0x72, 0xf7, 0x4c, 0x04, 0x24, 0x65, 0xa9, 0xda, 0x5b, 0xb1, 0x92,
+	0x4a, 0x53, 0x55, 0xf2, 0xb6, 0x8f, 0x5c, 0x08, 0xb8, 0x71, 0x58, 0xae, 0x5c, 0x08, 0x8e, 0x9c,
+	0x21, 0xb8, 0x10, 0xdc, 0x38, 0x71, 0x84, 0x80, 0x13, 0x3f, 0x00, 0x22, 0x38, 0x71, 0x26, 0xf2,
+	0xa3, 0x54, 0x25, 0x4b, 0x6a, 0x4d, 0x0f, 0xb1, 0x11, 0x9c, 0xa4, 0x7c, 0xf9, 0xf2, 0xe5, 0xcb,
+	0x97, 0xf9, 0xbe, 0x0b, 0x8e, 0x87, 0xb1, 0xf7, 0x6c, 0x12, 0x06, 0x71, 0x10, 0x3d, 0x8b, 0xbc,
+	0x28, 0xf2, 0x83, 0x31, 0x1d, 0xb9, 0x63, 0xf7, 0xda, 0x0b, 0x4f, 0x38, 0x18, 0x6d, 0x8f, 0xdc,
+	0xeb, 0x91, 0x7b, 0x32, 0x8c, 0xbd, 0xc6, 0x61, 0x10, 0xf6, 0xbf, 0x08, 0x13, 0xf4, 0x7e, 0x30,
+	0x1a, 0x05, 0x63, 0x81, 0xd5, 0x38, 0xcc, 0xd0, 0x99, 0x04, 0x43, 0xbf, 0x7f, 0x3b, 0xb8, 0x92,
+	0x53, 0x47, 0xd9, 0x2d, 0xa6, 0x57, 0x51, 0x3f, 0xf4, 0xaf, 0xbc, 0x70, 0x36, 0xfd, 0xf8, 0x3a,
+	0x08, 0xae, 0x87, 0x12, 0xe3, 0x6a, 0xfa, 0xf6, 0x59, 0xec, 0x8f, 0xbc, 0x28, 0x76, 0x47, 0x13,
+	0x81, 0xa0, 0x8e, 0x00, 0xc8, 0x74, 0xe8, 0x11, 0xaf, 0x1f, 0x84, 0x03, 0xa4, 0x40, 0x21, 0xf2,
+	0x07, 0xf5, 0xdc, 0x71, 0xee, 0xe9, 0x36, 0x61, 0x7f, 0xd1, 0x01, 0x94, 0xc3, 0xe9, 0xd0, 0xa3,
+	0xfe, 0xa0, 0x9e, 0xe7, 0xd0, 0x12, 0x1b, 0x1a, 0x03, 0x74, 0x08, 0x5b, 0x57, 0xb7, 0xb1, 0x17,
+	0xd1, 0xf8, 0x5d, 0xbd, 0x70, 0x9c, 0x7b, 0xba, 0x49, 0xca, 0x7c, 0xec, 0xbc, 0x4b, 0xa7, 0xc2,
+	0x77, 0xf5, 0xcd, 0xcc, 0x14, 0x79, 0xa7, 0x9e, 0xc1, 0x6e, 0xba, 0x9d, 0xe3, 0x5e, 0x0d, 0x3d,
+	0xf4, 0x0c, 0xca, 0x21, 0x1f, 0x46, 0xf5, 0xdc, 0x71, 0xe1, 0x69, 0xe5, 0x74, 0xff, 0x64, 0x26,
+	0x94, 0x93, 0x14, 0x99, 0x24, 0x58, 0xea, 0xbf, 0xe6, 0xe1, 0xb0, 0x1d, 0xf4, 0xdd, 0xa1, 0x1e,
+	0x7a, 0x6e, 0xec, 0xd9, 0x42, 0xb0, 0xc4, 0xfb, 0x66, 0xea, 0x45, 0x31, 0xfa, 0x41, 0x7a, 0x84,
+	0xca, 0xe9, 0x41, 0x86, 0x94, 0x3d, 0x93, 0x8e, 0xd1, 0x9c, 0x9d, 0x6d, 0xea, 0x51, 0x7f, 0x72,
+	0xf3, 0x79, 0x72, 0xb6, 0xa9, 0x67, 0x4c, 0x6e, 0x3e, 0x47, 0x1f, 0xc1, 0x76, 0x34, 0xb9, 0xfe,
+	0x85, 0x98, 0x2a, 0xf0, 0xa9, 0x2d, 0x06, 0xe0, 0x93, 0x0a, 0x14, 0xdc, 0xc9, 0x98, 0x1f, 0x6c,
+	0x9b, 0xb0, 0xbf, 0x08, 0xc1, 0xa6, 0x3f, 0xf2, 0xfc, 0x7a, 0x89, 0x83, 0xf8, 0x7f, 0x46, 0x7b,
+	0x32, 0x1c, 0x8d, 0x99, 0xdc, 0xca, 0x82, 0x36, 0x1b, 0x1a, 0x03, 0x74, 0x0c, 0x55, 0x7f, 0x14,
+	0xf9, 0x34, 0x99, 0xdd, 0xe2, 0xb3, 0xc0, 0x60, 0x5d, 0x81, 0xf1, 0x09, 0xd4, 0xa6, 0x91, 0x17,
+	0xd2, 0x61, 0xd0, 0x77, 0x63, 0x3f, 0x18, 0xd7, 0xb7, 0x8f, 0x73, 0x4f, 0xab, 0xa4, 0xca, 0x80,
+	0x6d, 0x09, 0x43, 0x3f, 0x81, 0xad, 0x6f, 0x82, 0x88, 0xfa, 0xe3, 0xb7, 0x41, 0x1d, 0xf8, 0x59,
+	0x8f, 0x33, 0x67, 0x7d, 0x15, 0x44, 0xc6, 0xf8, 0x6d, 0x10, 0x8e, 0xdc, 0x38, 0x15, 0x0d, 0x29,
+	0x7f, 0x23, 0xc0, 0xe8, 0x01, 0x94, 0x46, 0x91, 0x1f, 0x0d, 0xc6, 0xf5, 0x0a, 0x27, 0x2d, 0x47,
+	0xea, 0x43, 0x68, 0x2c, 0x13, 0x6c, 0x34, 0x09, 0xc6, 0x91, 0xa7, 0x1e, 0xc2, 0x01, 0x9f, 0xc5,
+	0xe3, 0xc1, 0xdd, 0xa9, 0x7f, 0xc9, 0xc1, 0xbe, 0xfe, 0x33, 0x37, 0xbc, 0xf6, 0xc7, 0xd7, 0xc4,
+	0xd3, 0xa6, 0xf1, 0xcf, 0x92, 0xeb, 0x38, 0x02, 0x48, 0x5e, 0xfe, 0xec, 0x61, 0x6d, 0x4b, 0x88,
+	0x31, 0x40, 0x1f, 0x43, 0xb5, 0x2f, 0xd7, 0xd1, 0x9f, 0x7b, 0xb7, 0xfc, 0x1e, 0x6a, 0xa4, 0x92,
+	0xc0, 0x5e, 0x7a, 0xb7, 0xc9, 0x9b, 0x2c, 0xa4, 0x6f, 0xf2, 0x4b, 0xd8, 0x8c, 0x6f, 0x27, 0x1e,
+	0xbf, 0x82, 0x9d, 0xd3, 0x27, 0x99, 0x73, 0x2f, 0xe5, 0xe1, 0xc4, 0xb9, 0x9d, 0x78, 0x84, 0x2f,
+	0x51, 0x4f, 0x60, 0x93, 0x8d, 0x10, 0x82, 0x1d, 0xdb, 0x30, 0xcf, 0xdb, 0x98, 0xda, 0x98, 0x5c,
+	0x1a, 0x3a, 0x56, 0x36, 0x18, 0x0c, 0x9b, 0x8e, 0x41, 0x18, 0xcc, 0xb6, 0x0d, 0xcb, 0x54, 0x72,
+	0xea, 0xdf, 0xe6, 0xe0, 0xfe, 0x3c, 0x51, 0x6d, 0x1c, 0xfd, 0xc2, 0x0b, 0xd1, 0x4f, 0xa1, 0x14,
+	0x7a, 0xd1, 0x74, 0x18, 0xf3, 0x33, 0xed, 0x9c, 0x7e, 0xba, 0x92, 0x0b, 0xb1, 0xe0, 0x84, 0x70,
+	0x6c, 0x22, 0x57, 0xa9, 0x14, 0x4a, 0x02, 0x82, 0xee, 0x83, 0xd2, 0xeb, 0x36, 0x35, 0x07, 0x53,
+	0xc3, 0x34, 0x1c, 0x43, 0x73, 0x70, 0x53, 0xd9, 0x40, 0xfb, 0x70, 0x4f, 0x42, 0x4d, 0xcb, 0xa1,
+	0x26, 0xc6, 0x4d, 0xdc, 0x54, 0x72, 0x0c, 0x2c, 0x99, 0xe3, 0xf0, 0x96, 0xd5, 0x33, 0x9b, 0x4a,
+	0x1e, 0xdd, 0x83, 0x9a, 0xe5, 0x5c, 0x60, 0x42, 0x5b, 0x9a, 0xd1, 0xee, 0x11, 0xac, 0x14, 0xd4,
+	0x3f, 0xcf, 0xc3, 0x5e, 0x97, 0xdb, 0x8a, 0x0f, 0xba, 0x10, 0xfe, 0x96, 0x23, 0x5f, 0x2a, 0x04,
+	0xff, 0x8f, 0x3e, 0x85, 0x5d, 0xa6, 0xf4, 0x11, 0x8d, 0x03, 0x1a, 0x7a, 0xa3, 0xe0, 0xc6, 0xab,
+	0x17, 0x8e, 0x0b, 0x4f, 0xb7, 0x49, 0x8d, 0x83, 0x9d, 0x80, 0x70, 0x20, 0x6a, 0x81, 0x32, 0xc3,
+	0xf3, 0xc7, 0x51, 0xec, 0x0e, 0x87, 0xf5, 0x12, 0x57, 0xe9, 0x87, 0x59, 0x3d, 0x8c, 0xdd, 0xd8,
+	0xef, 0x33, 0xc5, 0x36, 0x04, 0x0e, 0xd9, 0x91, 0x64, 0xe4, 0x18, 0x5d, 0x42, 0x7d, 0x70, 0x3b,
+	0x76, 0x47, 0x7e, 0x9f, 0x2e, 0xd0, 0x2b, 0x73, 0x7a, 0x47, 0x19, 0x7a, 0x4d, 0x81, 0x9a, 0x25,
+	0xb8, 0x3f, 0x48, 0x61, 0x29, 0x5d, 0xf5, 0x2f, 0xb6, 0x00, 0x65, 0x45, 0x22, 0xaf, 0x72, 0x8d,
+	0x44, 0x9e, 0xcd, 0x6e, 0x3a, 0xcf, 0x6f, 0x3a, 0x6b, 0x53, 0x12, 0xd1, 0x66, 0xaf, 0x16, 0xbd,
+	0x82, 0xea, 0x5b, 0xd7, 0x1f, 0x7a, 0x03, 0xc1, 0x3d, 0x97, 0x55, 0xe5, 0xf4, 0x24, 0xb3, 0x6c,
+	0x91, 0x89, 0x93, 0x16, 0x5f, 0xc1, 0x19, 0xc6, 0xe3, 0x38, 0xbc, 0x25, 0x95, 0xb7, 0x29, 0xa4,
+	0xe1, 0x83, 0x72, 0x17, 0x81, 0xe9, 0x05, 0xd3, 0x18, 0x69, 0xab, 0x7f, 0xee, 0xdd, 0xa2, 0x17,
+	0x50, 0xbc, 0x71, 0x87, 0x53, 0x4f, 0x32, 0xfa, 0x83, 0xf5, 0x3b, 0x4e, 0x43, 0x4f, 0x0f, 0x06,
+	0x1e, 0x11, 0xeb, 0x7e, 0x3b, 0xff, 0x45, 0x4e, 0xfd, 0xef, 0x22, 0x54, 0x32, 0x53, 0x08, 0xa0,
+	0xd4, 0x33, 0x7b, 0xf6, 0xec, 0x51, 0x9a, 0x2f, 0x4d, 0xeb, 0xb5, 0x49, 0x49, 0xaf, 0x8d, 0xa9,
+	0xa9, 0x75, 0xb0, 0x92, 0x43, 0x0f, 0x00, 0x11, 0xcd, 0x31, 0xcc, 0x73, 0x7a, 0x4e, 0xac, 0x5e,
+	0x97, 0x62, 0x42, 0x2c, 0xa2, 0xe4, 0xd1, 0x43, 0xa8, 0x4b, 0xed, 0xa2, 0x46, 0x93, 0xa9, 0x56,
+	0xcb, 0xc0, 0x44, 0xce, 0x16, 0xd0, 0x01, 0xec, 0x9d, 0xbf, 0xa6, 0x5d, 0x1d, 0xb7, 0x68, 0x47,
+	0x6b, 0xb7, 0x7a, 0xa6, 0xee, 0x30, 0x9d, 0xdb, 0x44, 0x75, 0xb8, 0x4f, 0xb0, 0x6d, 0xf5, 0x88,
+	0x8e, 0x6d, 0xda, 0x36, 0x3a, 0x86, 0xa3, 0xf1, 0x99, 0x22, 0x6a, 0xc0, 0x83, 0x8e, 0xf6, 0x86,
+	0x9a, 0x84, 0x9e, 0x61, 0x8d, 0x60, 0x62, 0x53, 0x82, 0x35, 0xfd, 0x02, 0x37, 0x95, 0x52, 0x96,
+	0x37, 0x31, 0x49, 0x8d, 0xa6, 0x52, 0x66, 0xe0, 0x8e, 0x61, 0x33, 0x5d, 0xcf, 0x80, 0xb7, 0x18,
+	0x6b, 0x09, 0xb8, 0xd5, 0xb6, 0x5e, 0x53, 0xc3, 0x6c, 0x59, 0xa4, 0x23, 0xf6, 0xd9, 0x46, 0x8f,
+	0xe1, 0xa3, 0x84, 0x03, 0xaa, 0xb5, 0xdb, 0x96, 0xce, 0x27, 0x66, 0xca, 0x05, 0x0c, 0xa1, 0x67,
+	0xda, 0x3d, 0x5d, 0xc7, 0xb6, 0xdd, 0xea, 0xb5, 0xe9, 0x2b, 0xcb, 0xa6, 0x97, 0x5a, 0xdb, 0x68,
+	0x0a, 0x0a, 0x15, 0xf4, 0x08, 0x1a, 0x86, 0xa9, 0x5b, 0x84, 0x60, 0xdd, 0x59, 0xdc, 0xa1, 0xca,
+	0xd8, 0xea, 0xda, 0xd4, 0xb1, 0xa8, 0x6e, 0xd3, 0x0b, 0xcd, 0x6c, 0x5a, 0x97, 0x98, 0x28, 0x35,
+	0xf4, 0x3d, 0x38, 0x76, 0x9a, 0x2d, 0xaa, 0x75, 0xbb, 0x6d, 0x43, 0x6e, 0xba, 0x20, 0xb9, 0x1d,
+	0xb4, 0x07, 0xbb, 0xa6, 0x95, 0x1c, 0x47, 0x98, 0x80, 0x5d, 0x26, 0xce, 0x96, 0xd1, 0x76, 0x30,
+	0xa1, 0x04, 0xdb, 0x0e, 0x31, 0xb8, 0x34, 0x6d, 0x45, 0x41, 0x0a, 0x54, 0x35, 0x93, 0x9e, 0xbf,
+	0xe6, 0xec, 0xe3, 0xa6, 0x72, 0x0f, 0x7d, 0x02, 0x8f, 0x93, 0xc3, 0x13, 0xdc, 0x34, 0x38, 0x8f,
+	0xec, 0xa2, 0x30, 0xa1, 0x5a, 0xb3, 0x49, 0xb0, 0x6d, 0x2b, 0x88, 0x9d, 0x40, 0xef, 0x50, 0x6c,
+	0x36, 0x69, 0xcf, 0xc6, 0x24, 0x31, 0x93, 0xb4, 0x89, 0x4d, 0x03, 0x37, 0x95, 0x3d, 0xc6, 0xaa,
+	0xde, 0xa1, 0x3a, 0x23, 0xe0, 0x50, 0xdd, 0x32, 0x1d, 0x62, 0xb5, 0xb9, 0x4d, 0x92, 0xcc, 0x9f,
+	0xb5, 0xb1, 0x72, 0x1f, 0x1d, 0xc1, 0xa1, 0xde, 0xa1, 0x5a, 0xcf, 0xb9, 0xb0, 0x88, 0xf1, 0xb5,
+	0x38, 0x11, 0xc1, 0xbf, 0x8f, 0x75, 0x66, 0xe5, 0xf6, 0xd9, 0x49, 0xf4, 0x8e, 0xd8, 0x40, 0x5e,
+	0x9e, 0xf2, 0x80, 0x19, 0x44, 0xbd, 0x43, 0xe5, 0x8b, 0x92, 0x4c, 0x1f, 0xb0, 0xbb, 0x27, 0x56,
+	0x8f, 0xc3, 0xf8, 0xdb, 0x13, 0x54, 0x98, 0x34, 0xeb, 0xe8, 0x53, 0x50, 0x67, 0xef, 0x52, 0xe2,
+	0x68, 0xfc, 0x6e, 0xe6, 0xa4, 0x7e, 0xc8, 0xa4, 0x6e, 0x5a, 0xd4, 0x3c, 0x33, 0x5a, 0x56, 0x87,
+	0xda, 0xbd, 0x6e, 0xd7, 0x22, 0x8e, 0xd2, 0x50, 0x5f, 0x00, 0xe8, 0xa1, 0x37, 0xf0, 0xe3, 0xde,
+	0xd8, 0x8f, 0x59, 0xf4, 0xe2, 0x47, 0xf4, 0xc6, 0x1d, 0x4a, 0x63, 0xb0, 0x45, 0xca, 0x7e, 0x74,
+	0xc9, 0x86, 0xcc, 0x6f, 0xde, 0x04, 0xc3, 0xe9, 0x48, 0x68, 0xd8, 0x26, 0x91, 0x23, 0xf5, 0xcf,
+	0x72, 0x50, 0x3d, 0x0f, 0xdd, 0x71, 0xec, 0x0d, 0x18, 0x89, 0x08, 0xfd, 0x1a, 0x14, 0xe3, 0x20,
+	0x76, 0x87, 0x32, 0x0c, 0xc9, 0x46, 0x34, 0xe9, 0x4e, 0x44, 0xe0, 0xa0, 0x27, 0x90, 0x8f, 0xdf,
+	0xd5, 0xf3, 0xef, 0xc3, 0xcc, 0xc7, 0xef, 0x18, 0x5a, 0x28, 0x42, 0xad, 0xd5, 0x68, 0xe1, 0x3b,
+	0xf5, 0x3f, 0x0a, 0xb0, 0x93, 0x38, 0x20, 0x31, 0x85, 0x9e, 0x4b, 0x7f, 0x29, 0xcc, 0x82, 0xba,
+	0xc4, 0x53, 0x09, 0xc4, 0x13, 0x46, 0x24, 0x75, 0x96, 0x2c, 0x10, 0xe1, 0x62, 0xf0, 0xe3, 0x5b,
+	0xca, 0xe2, 0x46, 0xbe, 0x79, 0x8d, 0x54, 0x13, 0xa0, 0xe3, 0x8f, 0x3c, 0x29, 0xae, 0xb7, 0xfe,
+	0xd8, 0x1d, 0xd6, 0x37, 0x13, 0x71, 0xb5, 0xd8, 0x10, 0x5d, 0x40, 0x95, 0xc3, 0xa9, 0xdb, 0xe7,
+	0x71, 0x4c, 0x71, 0xa5, 0xbf, 0x96, 0xfb, 0xf3, 0x65, 0x1a, 0x47, 0x26, 0x95, 0xb7, 0xe9, 0x00,
+	0xfd, 0x0e, 0xd4, 0xae, 0x85, 0x7c, 0xe9, 0x94, 0x09, 0xb8, 0x5e, 0x5a, 0x08, 0xef, 0xb2, 0xf2,
+	0x27, 0xd5, 0xeb, 0xcc, 0x08, 0x9d, 0xc1, 0x2e, 0xa3, 0x1f, 0x7a, 0xfd, 0x98, 0x46, 0x5e, 0x78,
+	0xe3, 0x85, 0x3c, 0x26, 0xab, 0x9c, 0x1e, 0xce, 0x99, 0x72, 0x81, 0x61, 0x73, 0x04, 0xb2, 0x13,
+	0xce, 0x8d, 0xd1, 0x13, 0xd8, 0x09, 0xbd, 0x28, 0x0e, 0xfd, 0x7e, 0x2c, 0xcd, 0xfa, 0x96, 0x74,
+	0x81, 0x12, 0xca, 0x2d, 0xb3, 0xaa, 0xc2, 0x56, 0x22, 0x44, 0xb4, 0x0d, 0xc5, 0xb3, 0xaf, 0x1c,
+	0x6c, 0x2b, 0x1b, 0xa8, 0x02, 0x65, 0x1b, 0xeb, 0x96, 0xd9, 0xb4, 0x95, 0x9c, 0xfa, 0x02, 0x2a,
+	0x99, 0x83, 0xa2, 0x1a, 0x6c, 0x3b, 0x98, 0x74, 0x0c, 0x53, 0x73, 0x58, 0x14, 0x52, 0x85, 0xad,
+	0x44, 0x29, 0x95, 0x1c, 0x53, 0x90, 0x44, 0x9d, 0xe5, 0x93, 0x56, 0xf2, 0xea, 0x7f, 0xe6, 0x60,
+	0x67, 0x9e, 0x5d, 0xe4, 0xc2, 0xfe, 0xec, 0x88, 0xee, 0x60, 0x10, 0x7a, 0x51, 0x44, 0xf9, 0x9d,
+	0x8b, 0xe8, 0xe4, 0xd7, 0x57, 0x1e, 0x74, 0x36, 0xd4, 0xc4, 0x2a, 0x7e, 0xfd, 0x7b, 0xe1, 0x22,
+	0x10, 0x3d, 0x87, 0x83, 0x3b, 0x52, 0x4c, 0x76, 0x92, 0xc1, 0xc2, 0xfe, 0xbc, 0xc8, 0xe4, 0x5a,
+	0xf5, 0x05, 0xec, 0x2d, 0xd9, 0x03, 0x6d, 0xc1, 0xa6, 0xd1, 0xbd, 0xfc, 0x5c, 0xd9, 0x90, 0xff,
+	0x9e, 0x2b, 0x39, 0x54, 0x86, 0x42, 0x8f, 0xb4, 0x95, 0x3c, 0x97, 0x97, 0xd1, 0xa5, 0x3d, 0x62,
+	0x28, 0x05, 0xf5, 0x4f, 0x0b, 0x50, 0x91, 0x8f, 0x3c, 0x72, 0xaf, 0xbd, 0xb9, 0xcc, 0x23, 0xb7,
+	0x3a, 0xf3, 0xc8, 0xcf, 0x65, 0x1e, 0x0b, 0x91, 0xe6, 0xe6, 0x62, 0xa4, 0xf9, 0x63, 0xa9, 0x27,
+	0xe2, 0x9d, 0x7e, 0xbc, 0xa8, 0x63, 0x6c, 0xfb, 0x93, 0xde, 0x64, 0xe0, 0xc6, 0x5e, 0x46, 0x4d,
+	0x9e, 0xc0, 0xce, 0x28, 0x18, 0xfb, 0x71, 0x10, 0x26, 0xb4, 0x45, 0x22, 0x50, 0x4b, 0xa1, 0x2f,
+	0xbd, 0x5b, 0xf5, 0x1f, 0x73, 0x00, 0xe9, 0x5a, 0x7e, 0xed, 0x17, 0x04, 0xdb, 0x17, 0x56, 0x9b,
+	0xb9, 0xd6, 0x32, 0x14, 0x5e, 0x5d, 0xb0, 0x1b, 0xdf, 0x01, 0x98, 0x3d, 0x07, 0x16, 0xda, 0xed,
+	0xc1, 0xee, 0xab, 0x9e, 0xe5, 0x68, 0x14, 0xbf, 0xb9, 0xd0, 0x7a, 0x36, 0x03, 0x16, 0x98, 0x31,
+	0xe4, 0xee, 0xc6, 0x70, 0xbe, 0xa2, 0x8e, 0xd1, 0x61, 0xbe, 0xe1, 0x4d, 0xd7, 0x20, 0xb8, 0xa9,
+	0x6c, 0x32, 0xf3, 0x29, 0x62, 0x41, 0xb1, 0xcc, 0xf9, 0xaa, 0x8b, 0x95, 0x22, 0xfa, 0x08, 0x0e,
+	0xa4, 0x45, 0x65, 0xcf, 0xd0, 0xe0, 0x86, 0x58, 0xbf, 0xd0, 0xcc, 0x73, 0xac, 0x94, 0xc4, 0x2b,
+	0x63, 0x46, 0x9a, 0x12, 0xfc, 0xaa, 0xc7, 0xe9, 0x94, 0x59, 0x38, 0xdc, 0xb5, 0xac, 0x76, 0x66,
+	0xdf, 0x2d, 0xf5, 0xbf, 0xf2, 0x70, 0x2f, 0x23, 0x0b, 0x71, 0x1c, 0xf4, 0x43, 0x28, 0x4e, 0xd9,
+	0x50, 0x5a, 0xbb, 0x07, 0xcb, 0x05, 0x47, 0x04, 0xd2, 0x9d, 0x70, 0x2b, 0x7f, 0x37, 0xdc, 0xe2,
+	0x8a, 0xc6, 0x43, 0x55, 0x3a, 0x9e, 0x8e, 0xae, 0xbc, 0x50, 0x5a, 0x9d, 0x9a, 0x84, 0x9a, 0x1c,
+	0x98, 0x64, 0x05, 0x9b, 0x69, 0x56, 0x90, 0x26, 0x35, 0xc5, 0x6c, 0x52, 0x93, 0xcd, 0xf2, 0x4a,
+	0xab, 0xb3, 0xbc, 0xf2, 0xf2, 0x2c, 0x6f, 0x6b, 0x31, 0xcb, 0xdb, 0x5e, 0x9e, 0xe5, 0xc1, 0x7b,
+	0xb3, 0xbc, 0xca, 0xfa, 0x2c, 0xaf, 0xba, 0x98, 0xe5, 0xa9, 0xff, 0xc3, 0xd2, 0x0f, 0x21, 0x42,
+	0x2e, 0xea, 0x24, 0xe1, 0x42, 0x75, 0x28, 0x47, 0xd3, 0x7e, 0x9f, 0x29, 0x9f, 0xf4, 0x51, 0x72,
+	0x98, 0x08, 0x26, 0x9f, 0x0a, 0xe6, 0xee, 0xcb, 0x2f, 0x2c, 0xbe, 0xfc, 0xdf, 0x84, 0x52, 0x9f,
+	0x6f, 0x53, 0xdf, 0x5c, 0x30, 0x8c, 0xf3, 0x36, 0x9a, 0x48, 0x44, 0xf4, 0x7b, 0x73, 0xca, 0xf2,
+	0xc3, 0xc5, 0x3b, 0x9f, 0x63, 0xf8, 0x24, 0xf9, 0x93, 0xc9, 0xc5, 0x1a, 0x50, 0xcd, 0x42, 0x79,
+	0xa4, 0xc9, 0x53, 0x1e, 0x65, 0x43, 0xfd, 0xab, 0x1c, 0x20, 0xfe, 0x6a, 0x3a, 0x42, 0x87, 0xe4,
+	0x4b, 0x5b, 0x54, 0xb5, 0xdc, 0x12, 0x55, 0x43, 0xbf, 0x01, 0xc5, 0xa1, 0x77, 0xe3, 0x0d, 0xa5,
+	0xc7, 0x6b, 0x64, 0x98, 0xeb, 0xcc, 0x10, 0xdb, 0x0c, 0x83, 0x08, 0xc4, 0xef, 0x58, 0xcd, 0xf8,
+	0x65, 0x1e, 0xf6, 0xb3, 0x5c, 0xa6, 0x2e, 0xf7, 0x05, 0x94, 0xa4, 0xd3, 0x13, 0x06, 0xf8, 0xfb,
+	0x19, 0x16, 0x96, 0xae, 0x38, 0x91, 0x6e, 0x4f, 0x2e, 0x5b, 0x72, 0xd2, 0xfc, 0x7b, 0x4f, 0x5a,
+	0xf8, 0xb6, 0x27, 0x5d, 0x70, 0xa5, 0xc5, 0x0f, 0x70, 0xa5, 0xea, 0x27, 0x50, 0x92, 0x6e, 0xab,
+	0x0a, 0x5b, 0x2c, 0xea, 0x33, 0xcc, 0x1e, 0x16, 0x0e, 0xae, 0x69, 0xd8, 0x3c, 0xe8, 0xcb, 0xa9,
+	0x7f, 0x5c, 0x80, 0x87, 0x77, 0x0e, 0x99, 0xbc, 0x06, 0x91, 0x83, 0xfe, 0x18, 0x4a, 0x53, 0x0e,
+	0x90, 0x16, 0xe3, 0x68, 0x85, 0x74, 0xe4, 0x2a, 0x89, 0xfc, 0x2b, 0xb3, 0x1c, 0x19, 0x0b, 0x51,
+	0x9c, 0xb3, 0x10, 0x3f, 0x85, 0x1d, 0xef, 0xc6, 0x1b, 0xc7, 0x34, 0x0e, 0xfd, 0xeb, 0x6b, 0x2f,
+	0x8c, 0x78, 0x3a, 0x3b, 0x9f, 0x02, 0x62, 0x86, 0xe0, 0x88, 0x79, 0x52, 0xf3, 0x32, 0xa3, 0x68,
+	0x51, 0xc7, 0xcb, 0x4b, 0x2a, 0x39, 0x19, 0x1b, 0xb2, 0x35, 0x67, 0x43, 0xb2, 0x25, 0x9e, 0xed,
+	0x0f, 0x2c, 0xf1, 0xa8, 0x7f, 0x93, 0x87, 0xa3, 0x15, 0x77, 0x20, 0x4d, 0xc8, 0x17, 0x33, 0x9d,
+	0xcf, 0x2d, 0x10, 0x5f, 0xfa, 0x44, 0x67, 0xaa, 0xbf, 0xe6, 0x1e, 0x16, 0x0b, 0x36, 0x19, 0x6b,
+	0xb5, 0x39, 0x6f, 0xad, 0x16, 0x25, 0x5c, 0xfc, 0x20, 0x09, 0x9f, 0xc3, 0xbd, 0xd0, 0xe3, 0xf1,
+	0x28, 0x17, 0x83, 0x08, 0x53, 0x45, 0x70, 0xd8, 0x38, 0x11, 0xb5, 0xcf, 0x93, 0xa4, 0xf6, 0x79,
+	0xe2, 0x24, 0xb5, 0x4f, 0xa2, 0x64, 0x17, 0x31, 0x30, 0x2b, 0x97, 0xec, 0x2f, 0x15, 0x29, 0x7a,
+	0x04, 0x15, 0x77, 0x32, 0xa6, 0xee, 0xe8, 0x2a, 0xa4, 0x03, 0x11, 0xd1, 0xd7, 0xc8, 0xb6, 0x3b,
+	0x19, 0x6b, 0xa3, 0xab, 0xb0, 0x39, 0x9c, 0x9b, 0x9f, 0x0e, 0xeb, 0xf9, 0xb9, 0xf9, 0x1e, 0x0b,
+	0xef, 0x77, 0x26, 0xa1, 0x1f, 0x84, 0x2c, 0x8a, 0x4e, 0x75, 0xb5, 0x46, 0x6a, 0x09, 0x94, 0xab,
+	0x27, 0xfa, 0x11, 0xec, 0x4f, 0x42, 0xcf, 0x1b, 0x4d, 0xf8, 0x39, 0xfa, 0xee, 0xc4, 0xbd, 0xf2,
+	0x87, 0x7e, 0x9c, 0x04, 0x2a, 0xf7, 0xd3, 0x49, 0x7d, 0x36, 0x87, 0xbe, 0x84, 0x7a, 0x66, 0xd1,
+	0xcd, 0x74, 0x38, 0xf6, 0xc2, 0x64, 0x5d, 0x91, 0xaf, 0x3b, 0x48, 0xe7, 0x2f, 0xb3, 0xd3, 0xcc,
+	0x43, 0xb1, 0xd7, 0xd5, 0x1f, 0xba, 0x51, 0xc4, 0xae, 0xb1, 0xc4, 0xd1, 0xe1, 0x9b, 0x20, 0xd2,
+	0x19, 0xc8, 0x18, 0xa8, 0xbf, 0x2c, 0xc0, 0xfd, 0x3b, 0x95, 0x40, 0x21, 0x91, 0xdf, 0x02, 0x48,
+	0x4b, 0xcd, 0xeb, 0x2a, 0xad, 0x19, 0xd4, 0x75, 0x0f, 0x27, 0xa3, 0x87, 0x85, 0xd5, 0x9e, 0x7a,
+	0x73, 0xb9, 0xa7, 0x2e, 0x2e, 0x7a, 0xea, 0xf2, 0x72, 0x4f, 0xbd, 0xf5, 0x5e, 0x4f, 0xbd, 0xbd,
+	0xde, 0x53, 0xc3, 0x9a, 0x7a, 0x6c, 0xe5, 0xbb, 0xd7, 0x63, 0xab, 0x73, 0xa1, 0xcb, 0x1e, 0x14,
+	0xaf, 0xfb, 0x8c, 0xa9, 0x9a, 0x38, 0xc9, 0x75, 0xdf, 0x18, 0xa8, 0xff, 0x56, 0x80, 0xfd, 0xa5,
+	0x05, 0x5a, 0xf4, 0x25, 0x94, 0x85, 0x86, 0x26, 0x95, 0xf4, 0xc7, 0x6b, 0xbc, 0x32, 0x49, 0xf0,
+	0x93, 0x12, 0x1f, 0xbd, 0x72, 0x23, 0x8f, 0x8e, 0xdd, 0x91, 0x27, 0x14, 0x51, 0x96, 0xf8, 0xce,
+	0xdc, 0xc8, 0x33, 0x19, 0x10, 0x59, 0xb0, 0xc3, 0xa3, 0x38, 0x2a, 0xdd, 0x50, 0x24, 0x0b, 0x7c,
+	0x4f, 0x57, 0x1b, 0x8f, 0x3b, 0x5b, 0xd6, 0xa6, 0x99, 0xe9, 0x08, 0xbd, 0x80, 0x6a, 0xc4, 0x0b,
+	0x82, 0x32, 0xab, 0x2a, 0x7f, 0x8b, 0x7a, 0x61, 0x25, 0x9a, 0x81, 0x58, 0x72, 0x57, 0x9b, 0x2b,
+	0x16, 0xf2, 0xbc, 0x6c, 0x6d, 0x85, 0xb0, 0x9a, 0xad, 0x10, 0x2e, 0xb1, 0x42, 0xdb, 0xff, 0x77,
+	0x2b, 0x04, 0xdf, 0xc1, 0x0a, 0xfd, 0x7d, 0x0e, 0xee, 0x2d, 0x9c, 0x37, 0xdb, 0x83, 0xc9, 0xcd,
+	0xf5, 0x60, 0x74, 0xd8, 0x65, 0xe1, 0xc2, 0x4d, 0x66, 0xd7, 0xfc, 0xda, 0x5d, 0x77, 0xd2, 0x25,
+	0x0c, 0xc8, 0x98, 0x1f, 0x78, 0x77, 0xc9, 0x14, 0xd6, 0x33, 0x9f, 0x5d, 0xc4, 0x99, 0xff, 0xf7,
+	0x1c, 0xa0, 0x45, 0x51, 0xa3, 0xe7, 0x50, 0x11, 0x3d, 0x2b, 0x7e, 0x3f, 0x4b, 0x2a, 0x22, 0xb2,
+	0x36, 0xc9, 0x3a, 0x3d, 0x30, 0x99, 0xfd, 0xff, 0x7f, 0x76, 0xb8, 0xbf, 0xcc, 0xc1, 0x7d, 0xf1,
+	0x92, 0xef, 0x18, 0xc3, 0xe7, 0x50, 0x16, 0xe1, 0x49, 0xa2, 0x74, 0x0f, 0x97, 0xa7, 0x3f, 0x52,
+	0x0d, 0x12, 0x64, 0x64, 0x2e, 0x68, 0x92, 0xa8, 0x13, 0x7f, 0x7f, 0xbd, 0x26, 0x09, 0xeb, 0x31,
+	0xaf, 0x48, 0xea, 0xdf, 0xe5, 0x60, 0xff, 0x0e, 0x83, 0xd2, 0x2c, 0xfc, 0x2e, 0x6c, 0x87, 0xf2,
+	0xff, 0xb7, 0x36, 0x0c, 0xe9, 0x0a, 0xf4, 0x47, 0x70, 0x30, 0xc7, 0x28, 0x4d, 0x89, 0x15, 0x3e,
+	0x50, 0xf7, 0xf7, 0xb3, 0x2c, 0x27, 0xd0, 0x48, 0x7d, 0x09, 0x75, 0xc9, 0xb3, 0xe3, 0x85, 0x23,
+	0x7f, 0x9c, 0x59, 0xb2, 0xa4, 0x23, 0xf9, 0x7e, 0x27, 0xa2, 0xfe, 0x53, 0x01, 0x0e, 0x16, 0xa9,
+	0x89, 0xbb, 0xfa, 0x50, 0x62, 0x89, 0x6f, 0x29, 0xa4, 0xbe, 0x65, 0x31, 0xc8, 0xdc, 0x5c, 0x16,
+	0x64, 0xfe, 0x04, 0x6a, 0xc2, 0xb4, 0x52, 0x7e, 0x64, 0x61, 0x4d, 0x57, 0xa7, 0xc6, 0xd5, 0x7e,
+	0x3a, 0x88, 0x50, 0x73, 0x16, 0xfb, 0x27, 0xab, 0x4b, 0x0b, 0x36, 0x6d, 0x49, 0x98, 0x9c, 0xa4,
+	0x06, 0x92, 0x4a, 0xc6, 0x9b, 0x96, 0xe7, 0xbc, 0x69, 0xea, 0x6d, 0xb6, 0xe6, 0xbc, 0xcd, 0x9c,
+	0x97, 0xdd, 0xbe, 0xe3, 0x65, 0x13, 0x9f, 0x0a, 0xcb, 0x7d, 0x6a, 0xe5, 0xbd, 0x3e, 0xb5, 0xba,
+	0xde, 0xa7, 0xd6, 0x16, 0x7d, 0xea, 0x67, 0x1e, 0x54, 0x45, 0xc7, 0xe2, 0x57, 0xda, 0x29, 0xfb,
+	0xec, 0x9f, 0x8b, 0x50, 0xcd, 0x5a, 0x77, 0xb4, 0x0b, 0x15, 0xfb, 0xdc, 0x9e, 0xd5, 0x47, 0x36,
+	0x58, 0x4d, 0x86, 0x55, 0xf8, 0xe5, 0x98, 0xd7, 0x68, 0x88, 0xe6, 0x24, 0xe3, 0x3c, 0x1b, 0x3b,
+	0xad, 0xd9, 0xb8, 0xc0, 0x08, 0x74, 0xdb, 0x9d, 0x19, 0x81, 0x4d, 0x56, 0x4b, 0x69, 0x5b, 0xb6,
+	0x4d, 0xad, 0x96, 0x2c, 0xdb, 0x2b, 0x45, 0xde, 0x35, 0xc1, 0x3a, 0x2b, 0xfc, 0x7f, 0x95, 0x81,
+	0x97, 0x18, 0x87, 0x46, 0x97, 0xea, 0xda, 0x6c, 0x79, 0x99, 0xd5, 0xb7, 0xd3, 0xfd, 0x29, 0x7e,
+	0xa3, 0x63, 0xdc, 0xe4, 0x45, 0xee, 0x6c, 0x5d, 0x5d, 0xa9, 0x08, 0xbe, 0x8c, 0x64, 0x5d, 0x95,
+	0x75, 0x52, 0x78, 0x6d, 0x7d, 0xd6, 0xc1, 0x90, 0x33, 0x35, 0x59, 0x09, 0xc7, 0x97, 0xd8, 0x74,
+	0xa8, 0x43, 0x8c, 0xf3, 0x73, 0x4c, 0x6c, 0x65, 0x87, 0x4b, 0xa7, 0xe7, 0x30, 0x76, 0x44, 0x61,
+	0x5f, 0xd9, 0xe5, 0x75, 0x77, 0x9c, 0x69, 0x82, 0xa4, 0x73, 0x8a, 0xe8, 0xd4, 0xa4, 0x7d, 0x0f,
+	0x5e, 0x8a, 0xb2, 0x7a, 0x8e, 0x72, 0x8f, 0xad, 0xea, 0x61, 0x6a, 0x74, 0x93, 0x86, 0x42, 0xd2,
+	0x46, 0xc1, 0x0a, 0x42, 0x87, 0xb0, 0x3f, 0x3f, 0x47, 0x70, 0x1b, 0x6b, 0x36, 0x56, 0xf6, 0xd0,
+	0xc7, 0x70, 0xd4, 0xc4, 0x2d, 0xad, 0xd7, 0x76, 0x28, 0xee, 0xda, 0x49, 0x8b, 0x23, 0x23, 0xfb,
+	0xfb, 0x69, 0x3b, 0x43, 0x42, 0xf6, 0x91, 0x0a, 0x8f, 0x32, 0xad, 0x98, 0x25, 0x8d, 0x1b, 0xe5,
+	0x01, 0x23, 0x3c, 0x9b, 0xe8, 0x58, 0x4d, 0xa3, 0x95, 0xb4, 0x57, 0x58, 0xc1, 0x0b, 0xdb, 0x8e,
+	0x72, 0xc0, 0x5b, 0x32, 0xe7, 0xaf, 0xa9, 0x43, 0x34, 0x1d, 0x27, 0x0d, 0x0d, 0xa5, 0xce, 0xfa,
+	0x2a, 0x3d, 0xcc, 0x4f, 0x46, 0xbf, 0xb6, 0x4c, 0x9c, 0x6c, 0x7b, 0xc8, 0x2f, 0x3d, 0x15, 0x76,
+	0x83, 0x5d, 0x3a, 0xd6, 0xcf, 0x67, 0x80, 0x8f, 0xd8, 0x9e, 0xfa, 0x85, 0x46, 0xce, 0x45, 0xd1,
+	0x8d, 0x10, 0xdc, 0x16, 0x5b, 0xe2, 0x37, 0x12, 0xe5, 0x21, 0x43, 0xd1, 0xba, 0x26, 0xd5, 0x3a,
+	0x67, 0x64, 0x9e, 0xad, 0xe4, 0x75, 0x1e, 0xf1, 0x56, 0x13, 0xbb, 0x43, 0xdd, 0x3e, 0xcf, 0x76,
+	0x33, 0x92, 0x6d, 0x1e, 0x31, 0x81, 0xf4, 0x6c, 0xed, 0x9c, 0x75, 0x44, 0x78, 0x3f, 0xe3, 0xe3,
+	0xcf, 0xbe, 0x80, 0xdd, 0x3b, 0xc9, 0x3f, 0xbb, 0xd8, 0x44, 0x1b, 0xda, 0xf8, 0x12, 0xb7, 0x45,
+	0xbb, 0xbb, 0xab, 0xeb, 0xa2, 0x99, 0x22, 0x60, 0xb9, 0xd3, 0x3f, 0xc9, 0xc3, 0x1e, 0xef, 0xf1,
+	0x4b, 0x0b, 0xda, 0x11, 0x1f, 0xab, 0xb0, 0x0a, 0x3a, 0xf1, 0x26, 0x41, 0xc8, 0xab, 0xdc, 0x2c,
+	0x40, 0x89, 0x50, 0x63, 0xe9, 0x57, 0x1a, 0xfc, 0x93, 0x8e, 0xc6, 0x3d, 0x39, 0xc7, 0xbf, 0x68,
+	0x39, 0xb9, 0x0c, 0xfc, 0x81, 0xba, 0x81, 0xfe, 0x10, 0x6a, 0x73, 0x61, 0x2b, 0xfa, 0x5e, 0x86,
+	0xc2, 0xca, 0xef, 0x39, 0x1a, 0x4f, 0xd6, 0x60, 0xc9, 0x2f, 0x10, 0x36, 0xd0, 0x4b, 0x80, 0xf4,
+	0xcb, 0x04, 0xb4, 0x2a, 0x1f, 0x69, 0xa8, 0x77, 0xe9, 0x2d, 0xf9, 0x9c, 0x61, 0xe3, 0xf4, 0x1f,
+	0x72, 0xb0, 0x2f, 0xa1, 0xdd, 0x30, 0x78, 0x77, 0x2b, 0xa6, 0x06, 0x5e, 0x88, 0x7a, 0x69, 0x7b,
+	0x45, 0x18, 0x27, 0x74, 0xbc, 0xee, 0x03, 0x84, 0xc6, 0xe3, 0x35, 0x1f, 0x07, 0xa8, 0x1b, 0xc8,
+	0x82, 0x6a, 0xb6, 0x47, 0x8b, 0x1e, 0xad, 0x68, 0xde, 0x26, 0x24, 0x8f, 0xde, 0xdb, 0xdc, 0x55,
+	0x37, 0x4e, 0xff, 0x3a, 0x0f, 0x75, 0xdd, 0x1b, 0xc7, 0xe1, 0xec, 0x32, 0xf5, 0x60, 0x1c, 0x87,
+	0xc1, 0x70, 0xe8, 0x85, 0xc8, 0xb9, 0x7b, 0x17, 0x77, 0x02, 0x82, 0xc5, 0x6b, 0x38, 0x5e, 0x8d,
+	0x30, 0xbb, 0x01, 0x07, 0x6a, 0x73, 0x11, 0xc8, 0x1c, 0xd5, 0x65, 0xc1, 0x53, 0xe3, 0x78, 0x35,
+	0xc2, 0x8c, 0xea, 0x1f, 0x80, 0x32, 0x73, 0xe4, 0x09, 0xe1, 0xec, 0x25, 0xae, 0x70, 0xf6, 0x8d,
+	0x4f, 0xde, 0x8b, 0x93, 0x90, 0x3f, 0xfb, 0xe8, 0xeb, 0x43, 0x8e, 0xf7, 0x8c, 0x7d, 0x48, 0xd5,
+	0x1f, 0x06, 0xd3, 0xc1, 0xb3, 0xeb, 0x40, 0x7e, 0x51, 0x75, 0x55, 0xe2, 0xbf, 0x3f, 0xfa, 0xdf,
+	0x01, 0x00, 0x8c, 0xfd, 0xda, 0x65, 0xc9, 0x25, 0x00, 0x00,
 }
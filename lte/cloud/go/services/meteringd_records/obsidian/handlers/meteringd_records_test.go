@@ -27,6 +27,7 @@ import (
 	orcprotos "magma/orc8r/cloud/go/protos"
 	"magma/orc8r/cloud/go/service/middleware/unary/test_utils"
 	magmad_test_init "magma/orc8r/cloud/go/services/magmad/test_init"
+	streamer_test_init "magma/orc8r/cloud/go/services/streamer/test_init"
 
 	"github.com/stretchr/testify/assert"
 	"golang.org/x/net/context"
@@ -57,6 +58,7 @@ func TestMeteringdRecords(t *testing.T) {
 	plugin.RegisterPluginForTests(t, &pluginimpl.BaseOrchestratorPlugin{})
 	magmad_test_init.StartTestService(t)
 	sdb_test_init.StartTestService(t)
+	streamer_test_init.StartTestService(t)
 	meteringd_records_test_init.StartTestService(t)
 	restPort := tests.StartObsidian(t)
 
@@ -24,6 +24,13 @@ import (
 
 const ServiceName = "POLICYDB"
 
+const (
+	// PoliciesStreamName is the name of the stream of policy rules streamed to gateways
+	PoliciesStreamName = "policydb"
+	// BaseNamesStreamName is the name of the stream of charging rule base names streamed to gateways
+	BaseNamesStreamName = "base_names"
+)
+
 // Utility function to get a RPC connection to the policydb service
 func getPolicydbClient() (
 	protos.PolicyDBControllerClient, error) {
@@ -15,6 +15,7 @@ import (
 	"magma/lte/cloud/go/services/policydb"
 	policydb_test_init "magma/lte/cloud/go/services/policydb/test_init"
 	orcprotos "magma/orc8r/cloud/go/protos"
+	streamer_test_init "magma/orc8r/cloud/go/services/streamer/test_init"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -25,6 +26,7 @@ const (
 
 func TestPolicyDBControllerClientMethods(t *testing.T) {
 	policydb_test_init.StartTestService(t)
+	streamer_test_init.StartTestService(t)
 
 	// Something that doesn't exist will throw an error
 	_, err := policydb.GetRule(testNetworkId, "doesn't exist")
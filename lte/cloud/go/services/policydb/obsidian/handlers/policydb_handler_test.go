@@ -21,6 +21,7 @@ import (
 	"magma/orc8r/cloud/go/plugin"
 	"magma/orc8r/cloud/go/pluginimpl"
 	magmad_test_init "magma/orc8r/cloud/go/services/magmad/test_init"
+	streamer_test_init "magma/orc8r/cloud/go/services/streamer/test_init"
 )
 
 func TestPolicyRules(t *testing.T) {
@@ -28,6 +29,7 @@ func TestPolicyRules(t *testing.T) {
 	plugin.RegisterPluginForTests(t, &pluginimpl.BaseOrchestratorPlugin{})
 	magmad_test_init.StartTestService(t)
 	policydb_test_init.StartTestService(t)
+	streamer_test_init.StartTestService(t)
 	restPort := tests.StartObsidian(t)
 
 	testUrlRoot := fmt.Sprintf(
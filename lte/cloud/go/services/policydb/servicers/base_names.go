@@ -17,6 +17,7 @@ package servicers
 
 import (
 	"magma/lte/cloud/go/protos"
+	"magma/lte/cloud/go/services/policydb"
 	"magma/orc8r/cloud/go/datastore"
 	orcprotos "magma/orc8r/cloud/go/protos"
 
@@ -76,7 +77,7 @@ func (srv *PolicyDBServer) AddBaseName(
 		glog.Errorf("Error persisting Base Name %s: %s", lookup.GetName(), err)
 		return res, status.Errorf(codes.Aborted, "Error adding Base Name: %s", err)
 	}
-	return res, nil
+	return res, notifyStreamUpdate(policydb.BaseNamesStreamName, lookup.GetNetworkID().GetId(), lookup.GetName())
 }
 
 // DeleteBaseName deletes an existing Charging Rule Base Name and its Record
@@ -89,7 +90,7 @@ func (srv *PolicyDBServer) DeleteBaseName(
 		glog.Errorf("Error deleting rule %s: %s", lookup.GetName(), err)
 		return &orcprotos.Void{}, status.Errorf(codes.Aborted, "Deletion error!")
 	}
-	return &orcprotos.Void{}, nil
+	return &orcprotos.Void{}, notifyStreamUpdate(policydb.BaseNamesStreamName, lookup.GetNetworkID().GetId(), lookup.GetName())
 }
 
 // GetBaseName returns the ChargingRuleBaseNameRecord given the base name and the network.
@@ -101,6 +102,9 @@ func (srv *PolicyDBServer) GetBaseName(
 	table := datastore.GetTableName(lookup.GetNetworkID().GetId(), CHARGING_RULE_BASE_NAME_TABLE)
 	res := new(protos.ChargingRuleNameSet)
 	marshaled, _, err := srv.store.Get(table, lookup.GetName())
+	if err == datastore.ErrNotFound {
+		return res, status.Errorf(codes.NotFound, "Base Name %s not found", lookup.GetName())
+	}
 	if err != nil {
 		glog.Errorf("Error fetching Base Name %s: %s", lookup.GetName(), err)
 		return res, status.Errorf(codes.Aborted, "Error fetching rule")
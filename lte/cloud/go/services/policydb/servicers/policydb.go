@@ -17,8 +17,10 @@ package servicers
 
 import (
 	"magma/lte/cloud/go/protos"
+	"magma/lte/cloud/go/services/policydb"
 	"magma/orc8r/cloud/go/datastore"
 	orcprotos "magma/orc8r/cloud/go/protos"
+	"magma/orc8r/cloud/go/services/streamer"
 
 	"github.com/golang/glog"
 	"github.com/golang/protobuf/proto"
@@ -63,7 +65,7 @@ func (srv *PolicyDBServer) AddRule(
 		return &orcprotos.Void{}, status.Errorf(
 			codes.Aborted, "Error adding rule: %s", err)
 	}
-	return &orcprotos.Void{}, nil
+	return &orcprotos.Void{}, notifyStreamUpdate(policydb.PoliciesStreamName, ruleData.NetworkId.Id, ruleID)
 }
 
 func (srv *PolicyDBServer) DeleteRule(
@@ -77,7 +79,7 @@ func (srv *PolicyDBServer) DeleteRule(
 		glog.Errorf("Error deleting rule %s: %s", ruleID, err)
 		return &orcprotos.Void{}, status.Errorf(codes.Aborted, "Deletion error!")
 	}
-	return &orcprotos.Void{}, nil
+	return &orcprotos.Void{}, notifyStreamUpdate(policydb.PoliciesStreamName, lookup.NetworkId.Id, ruleID)
 }
 
 func (srv *PolicyDBServer) UpdateRule(
@@ -99,7 +101,7 @@ func (srv *PolicyDBServer) UpdateRule(
 		glog.Errorf("Error persisting rule %s: %s", ruleId, err)
 		return &orcprotos.Void{}, status.Errorf(codes.Aborted, "Error updating rule")
 	}
-	return &orcprotos.Void{}, nil
+	return &orcprotos.Void{}, notifyStreamUpdate(policydb.PoliciesStreamName, ruleData.NetworkId.Id, ruleId)
 }
 
 func (srv *PolicyDBServer) GetRule(
@@ -111,6 +113,9 @@ func (srv *PolicyDBServer) GetRule(
 	table := datastore.GetTableName(lookup.NetworkId.Id, POLICY_TABLE)
 
 	value, _, err := srv.store.Get(table, ruleID)
+	if err == datastore.ErrNotFound {
+		return &rule, status.Errorf(codes.NotFound, "Rule %s not found", ruleID)
+	}
 	if err != nil {
 		glog.Errorf("Error fetching rule %s: %s", &rule, err)
 		return &rule, status.Errorf(codes.Aborted, "Error fetching rule")
@@ -152,3 +157,13 @@ func (srv *PolicyDBServer) ListRules(
 	}
 	return &protos.PolicyRuleSet{Rules: rules}, nil
 }
+
+// notifyStreamUpdate records the change of the item with the given key of the
+// stream in the streamer, so that it's streamed to the gateways of the network
+func notifyStreamUpdate(streamName string, networkID string, key string) error {
+	if err := streamer.NotifyStreamUpdate(streamName, networkID, []string{key}); err != nil {
+		glog.Errorf("Error notifying %s stream update: %s", streamName, err)
+		return status.Errorf(codes.Unavailable, "Error notifying %s stream update: %s", streamName, err)
+	}
+	return nil
+}
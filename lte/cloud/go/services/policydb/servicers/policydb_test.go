@@ -14,13 +14,17 @@ import (
 	"magma/lte/cloud/go/protos"
 	"magma/lte/cloud/go/services/policydb/servicers"
 	orcprotos "magma/orc8r/cloud/go/protos"
+	streamer_test_init "magma/orc8r/cloud/go/services/streamer/test_init"
 	"magma/orc8r/cloud/go/test_utils"
 
 	"github.com/stretchr/testify/assert"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestPolicydb(t *testing.T) {
+	streamer_test_init.StartTestService(t)
 	ds := test_utils.NewMockDatastore()
 	ctx := context.Background()
 
@@ -71,7 +75,7 @@ func TestPolicydb(t *testing.T) {
 	_, err = srv.DeleteRule(ctx, &lookup)
 	assert.NoError(t, err)
 	_, err = srv.GetRule(ctx, &lookup)
-	assert.Error(t, err) // rule already removed
+	assert.Equal(t, codes.NotFound, status.Code(err)) // rule already removed
 
 	ruleSet, err := srv.ListRules(ctx, &networkId)
 	assert.NoError(t, err)
@@ -122,7 +126,7 @@ func TestPolicydb(t *testing.T) {
 
 	// Get Non-existant Base Name
 	_, err = srv.GetBaseName(ctx, bnLookup2)
-	assert.Error(t, err)
+	assert.Equal(t, codes.NotFound, status.Code(err))
 
 	// Get Base Name
 	bnRecord, err := srv.GetBaseName(ctx, bnLookup1)
@@ -9,21 +9,24 @@ LICENSE file in the root directory of this source tree.
 package streamer
 
 import (
+	"magma/lte/cloud/go/protos"
 	"magma/lte/cloud/go/services/policydb"
-	"magma/orc8r/cloud/go/protos"
+	orcprotos "magma/orc8r/cloud/go/protos"
 	"magma/orc8r/cloud/go/services/magmad"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes/any"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type PoliciesProvider struct{}
 
 func (provider *PoliciesProvider) GetStreamName() string {
-	return "policydb"
+	return policydb.PoliciesStreamName
 }
 
-func (provider *PoliciesProvider) GetUpdates(gatewayId string, extraArgs *any.Any) ([]*protos.DataUpdate, error) {
+func (provider *PoliciesProvider) GetUpdates(gatewayId string, extraArgs *any.Any) ([]*orcprotos.DataUpdate, error) {
 	networkId, err := magmad.FindGatewayNetworkId(gatewayId)
 	if err != nil {
 		return nil, err
@@ -33,14 +36,14 @@ func (provider *PoliciesProvider) GetUpdates(gatewayId string, extraArgs *any.An
 		return nil, err
 	}
 
-	ret := make([]*protos.DataUpdate, 0, len(policies))
+	ret := make([]*orcprotos.DataUpdate, 0, len(policies))
 	for _, policy := range policies {
 		marshaledPolicy, err := proto.Marshal(policy)
 		if err != nil {
 			return nil, err
 		}
 
-		update := new(protos.DataUpdate)
+		update := new(orcprotos.DataUpdate)
 		update.Key = policy.Id
 		update.Value = marshaledPolicy
 		ret = append(ret, update)
@@ -48,13 +51,49 @@ func (provider *PoliciesProvider) GetUpdates(gatewayId string, extraArgs *any.An
 	return ret, nil
 }
 
+func (provider *PoliciesProvider) GetNetworkId(gatewayId string) (string, error) {
+	return magmad.FindGatewayNetworkId(gatewayId)
+}
+
+func (provider *PoliciesProvider) GetUpdatesOf(
+	gatewayId string,
+	extraArgs *any.Any,
+	ruleIds []string,
+) ([]*orcprotos.DataUpdate, error) {
+	networkId, err := magmad.FindGatewayNetworkId(gatewayId)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]*orcprotos.DataUpdate, 0, len(ruleIds))
+	for _, ruleId := range ruleIds {
+		policy, err := policydb.GetRule(networkId, ruleId)
+		if status.Code(err) == codes.NotFound {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		marshaledPolicy, err := proto.Marshal(policy)
+		if err != nil {
+			return nil, err
+		}
+
+		update := new(orcprotos.DataUpdate)
+		update.Key = ruleId
+		update.Value = marshaledPolicy
+		ret = append(ret, update)
+	}
+	return ret, nil
+}
+
 type BaseNamesProvider struct{}
 
 func (provider *BaseNamesProvider) GetStreamName() string {
-	return "base_names"
+	return policydb.BaseNamesStreamName
 }
 
-func (provider *BaseNamesProvider) GetUpdates(gatewayId string, extraArgs *any.Any) ([]*protos.DataUpdate, error) {
+func (provider *BaseNamesProvider) GetUpdates(gatewayId string, extraArgs *any.Any) ([]*orcprotos.DataUpdate, error) {
 	networkId, err := magmad.FindGatewayNetworkId(gatewayId)
 	if err != nil {
 		return nil, err
@@ -64,17 +103,53 @@ func (provider *BaseNamesProvider) GetUpdates(gatewayId string, extraArgs *any.A
 		return nil, err
 	}
 
-	ret := make([]*protos.DataUpdate, 0, len(baseNameRecords))
+	ret := make([]*orcprotos.DataUpdate, 0, len(baseNameRecords))
 	for _, baseNameRecord := range baseNameRecords {
 		marshaledBaseNameSet, err := proto.Marshal(baseNameRecord.GetRuleNamesSet())
 		if err != nil {
 			return nil, err
 		}
 
-		update := new(protos.DataUpdate)
+		update := new(orcprotos.DataUpdate)
 		update.Key = baseNameRecord.GetName()
 		update.Value = marshaledBaseNameSet
 		ret = append(ret, update)
 	}
 	return ret, nil
 }
+
+func (provider *BaseNamesProvider) GetNetworkId(gatewayId string) (string, error) {
+	return magmad.FindGatewayNetworkId(gatewayId)
+}
+
+func (provider *BaseNamesProvider) GetUpdatesOf(
+	gatewayId string,
+	extraArgs *any.Any,
+	baseNames []string,
+) ([]*orcprotos.DataUpdate, error) {
+	networkId, err := magmad.FindGatewayNetworkId(gatewayId)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]*orcprotos.DataUpdate, 0, len(baseNames))
+	for _, baseName := range baseNames {
+		ruleNames, err := policydb.GetBaseName(networkId, baseName)
+		if status.Code(err) == codes.NotFound {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		marshaledBaseNameSet, err := proto.Marshal(&protos.ChargingRuleNameSet{RuleNames: ruleNames})
+		if err != nil {
+			return nil, err
+		}
+
+		update := new(orcprotos.DataUpdate)
+		update.Key = baseName
+		update.Value = marshaledBaseNameSet
+		ret = append(ret, update)
+	}
+	return ret, nil
+}
@@ -24,6 +24,9 @@ import (
 
 const ServiceName = "SUBSCRIBERDB"
 
+// StreamName is the name of the stream of subscribers streamed to gateways
+const StreamName = "subscriberdb"
+
 // Utility function to get a RPC connection to the subscriberdb service
 func getSubscriberdbClient() (
 	lteprotos.SubscriberDBControllerClient, error) {
@@ -15,12 +15,14 @@ import (
 	"magma/lte/cloud/go/services/subscriberdb"
 	"magma/lte/cloud/go/services/subscriberdb/test_init"
 	orcprotos "magma/orc8r/cloud/go/protos"
+	streamer_test_init "magma/orc8r/cloud/go/services/streamer/test_init"
 
 	"github.com/stretchr/testify/assert"
 )
 
 func TestSubscriberdb(t *testing.T) {
 	test_init.StartTestService(t)
+	streamer_test_init.StartTestService(t)
 
 	networkId := &orcprotos.NetworkID{Id: "test"}
 	sid := &protos.SubscriberID{Id: "12345"}
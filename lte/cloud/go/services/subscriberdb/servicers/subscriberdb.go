@@ -19,10 +19,15 @@ import (
 	"fmt"
 
 	"magma/lte/cloud/go/protos"
+	"magma/lte/cloud/go/services/subscriberdb"
 	"magma/lte/cloud/go/services/subscriberdb/storage"
 	orcprotos "magma/orc8r/cloud/go/protos"
+	"magma/orc8r/cloud/go/services/streamer"
 
+	"github.com/golang/glog"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type SubscriberDBServer struct {
@@ -43,7 +48,11 @@ func (srv *SubscriberDBServer) AddSubscriber(
 	if err := validateSubscriberData(subs); err != nil {
 		return nil, err
 	}
-	return srv.store.AddSubscriber(subs)
+	res, err := srv.store.AddSubscriber(subs)
+	if err != nil {
+		return res, err
+	}
+	return res, notifyStreamUpdate(subs.NetworkId.Id, subs.Sid)
 }
 
 func (srv *SubscriberDBServer) DeleteSubscriber(
@@ -53,7 +62,11 @@ func (srv *SubscriberDBServer) DeleteSubscriber(
 	if err := validateSubscriberLookup(lookup); err != nil {
 		return nil, err
 	}
-	return srv.store.DeleteSubscriber(lookup)
+	res, err := srv.store.DeleteSubscriber(lookup)
+	if err != nil {
+		return res, err
+	}
+	return res, notifyStreamUpdate(lookup.NetworkId.Id, lookup.Sid)
 }
 
 func (srv *SubscriberDBServer) UpdateSubscriber(
@@ -63,7 +76,11 @@ func (srv *SubscriberDBServer) UpdateSubscriber(
 	if err := validateSubscriberData(subs); err != nil {
 		return nil, err
 	}
-	return srv.store.UpdateSubscriber(subs)
+	res, err := srv.store.UpdateSubscriber(subs)
+	if err != nil {
+		return res, err
+	}
+	return res, notifyStreamUpdate(subs.NetworkId.Id, subs.Sid)
 }
 
 func (srv *SubscriberDBServer) GetSubscriberData(
@@ -121,3 +138,14 @@ func validateSubscriberData(subs *protos.SubscriberData) error {
 	}
 	return nil
 }
+
+// notifyStreamUpdate records the change of the subscriber in the streamer, so
+// that it's streamed to the gateways of the network
+func notifyStreamUpdate(networkID string, sid *protos.SubscriberID) error {
+	err := streamer.NotifyStreamUpdate(subscriberdb.StreamName, networkID, []string{protos.SidString(sid)})
+	if err != nil {
+		glog.Errorf("Error notifying %s stream update: %s", subscriberdb.StreamName, err)
+		return status.Errorf(codes.Unavailable, "Error notifying %s stream update: %s", subscriberdb.StreamName, err)
+	}
+	return nil
+}
@@ -15,6 +15,7 @@ import (
 	"magma/lte/cloud/go/services/subscriberdb/servicers"
 	"magma/lte/cloud/go/services/subscriberdb/storage"
 	orcprotos "magma/orc8r/cloud/go/protos"
+	streamer_test_init "magma/orc8r/cloud/go/services/streamer/test_init"
 	"magma/orc8r/cloud/go/test_utils"
 
 	"github.com/stretchr/testify/assert"
@@ -22,6 +23,7 @@ import (
 )
 
 func TestSubscriberdb(t *testing.T) {
+	streamer_test_init.StartTestService(t)
 	ds := test_utils.NewMockDatastore()
 	subscriberDBStore, err := storage.NewSubscriberDBStorage(ds)
 	assert.NoError(t, err)
@@ -15,12 +15,14 @@ import (
 
 	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes/any"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type SubscribersProvider struct{}
 
 func (provider *SubscribersProvider) GetStreamName() string {
-	return "subscriberdb"
+	return subscriberdb.StreamName
 }
 
 func (provider *SubscribersProvider) GetUpdates(gatewayId string, extraArgs *any.Any) ([]*protos.DataUpdate, error) {
@@ -51,3 +53,39 @@ func (provider *SubscribersProvider) GetUpdates(gatewayId string, extraArgs *any
 	}
 	return ret, nil
 }
+
+func (provider *SubscribersProvider) GetNetworkId(gatewayId string) (string, error) {
+	return magmad.FindGatewayNetworkId(gatewayId)
+}
+
+func (provider *SubscribersProvider) GetUpdatesOf(
+	gatewayId string,
+	extraArgs *any.Any,
+	subscriberIds []string,
+) ([]*protos.DataUpdate, error) {
+	networkId, err := magmad.FindGatewayNetworkId(gatewayId)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]*protos.DataUpdate, 0, len(subscriberIds))
+	for _, subscriberId := range subscriberIds {
+		subscriberData, err := subscriberdb.GetSubscriber(networkId, subscriberId)
+		if status.Code(err) == codes.NotFound {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		marshaledSubscriber, err := proto.Marshal(subscriberData)
+		if err != nil {
+			return nil, err
+		}
+
+		update := new(protos.DataUpdate)
+		update.Key = subscriberId
+		update.Value = marshaledSubscriber
+		ret = append(ret, update)
+	}
+	return ret, nil
+}
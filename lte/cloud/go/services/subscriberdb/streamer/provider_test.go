@@ -85,4 +85,26 @@ func TestSubscriberdbStreamer(t *testing.T) {
 	s1j, _ := json.Marshal(s1)
 	s2j, _ := json.Marshal(s2)
 	t.Logf("\nReceived Subscribers:\n\t%s\n\t%s", string(s1j), string(s2j))
+
+	// Changes of the subscribers are streamed as deltas
+	sub1.Lte = &protos.LTESubscription{State: protos.LTESubscription_ACTIVE}
+	err = sdb.UpdateSubscriber(testNetworkId, &sub1)
+	assert.NoError(t, err)
+	err = sdb.DeleteSubscriber(testNetworkId, protos.SidString(&sid2))
+	assert.NoError(t, err)
+
+	updates := []*orcprotos.DataUpdate{}
+	deletedKeys := []string{}
+	for len(updates) < 1 || len(deletedKeys) < 1 {
+		updateBatch, err = streamerClient.Recv()
+		assert.NoError(t, err)
+		assert.False(t, updateBatch.GetResync())
+		updates = append(updates, updateBatch.GetUpdates()...)
+		deletedKeys = append(deletedKeys, updateBatch.GetDeletedKeys()...)
+	}
+	assert.Equal(t, protos.SidString(&sid1), updates[len(updates)-1].GetKey())
+	err = proto.Unmarshal(updates[len(updates)-1].Value, &s1)
+	assert.NoError(t, err)
+	assert.Equal(t, protos.LTESubscription_ACTIVE, s1.GetLte().GetState())
+	assert.Equal(t, []string{protos.SidString(&sid2)}, deletedKeys)
 }
@@ -75,3 +75,13 @@ MESSAGE_DEF(
   s6a_purge_ue_ans_t,
   s6a_purge_ue_ans)
 MESSAGE_DEF(S6A_RESET_REQ, MESSAGE_PRIORITY_MED, s6a_reset_req_t, s6a_reset_req)
+MESSAGE_DEF(
+  S6A_INSERT_SUBSCRIBER_DATA_REQ,
+  MESSAGE_PRIORITY_MED,
+  s6a_insert_subscriber_data_req_t,
+  s6a_insert_subscriber_data_req)
+MESSAGE_DEF(
+  S6A_DELETE_SUBSCRIBER_DATA_REQ,
+  MESSAGE_PRIORITY_MED,
+  s6a_delete_subscriber_data_req_t,
+  s6a_delete_subscriber_data_req)
@@ -35,6 +35,7 @@
 #ifndef FILE_S6A_MESSAGES_TYPES_SEEN
 #define FILE_S6A_MESSAGES_TYPES_SEEN
 
+#include <stdbool.h>
 #include <stdint.h>
 
 #include "3gpp_23.003.h"
@@ -156,4 +157,22 @@ typedef struct s6a_reset_req_s {
   /* RESET ALL. Partial Reset TBD*/
 } s6a_reset_req_t;
 
+typedef struct s6a_insert_subscriber_data_req_s {
+  char imsi[IMSI_BCD_DIGITS_MAX + 1];
+  uint8_t imsi_length;
+  /* Updated subscription data, APNs are only updated if nb_apns > 0 */
+  subscription_data_t subscription_data;
+  /* Whether the IDR updates the subscribed AMBR & network access mode */
+  bool subscribed_ambr_present;
+  bool access_mode_present;
+} s6a_insert_subscriber_data_req_t;
+
+typedef struct s6a_delete_subscriber_data_req_s {
+  char imsi[IMSI_BCD_DIGITS_MAX + 1];
+  uint8_t imsi_length;
+  /* Context identifiers of the APN configurations to delete */
+  uint8_t nb_context_ids;
+  context_identifier_t context_ids[MAX_APN_PER_UE];
+} s6a_delete_subscriber_data_req_t;
+
 #endif /* FILE_S6A_MESSAGES_TYPES_SEEN */
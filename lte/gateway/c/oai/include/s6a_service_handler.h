@@ -23,6 +23,8 @@
 
 #include <sys/types.h>
 
+#include "s6a_messages_types.h"
+
 /*
  * Sends a S6A_CANCEL_LOCATION_REQ message to MME.
  */
@@ -31,3 +33,13 @@ int delete_subscriber_request(const char *imsi, const uint imsi_len);
  * Sends a S6A_RESET_REQ message to MME.
  */
 void handle_reset_request(void);
+/*
+ * Sends a S6A_INSERT_SUBSCRIBER_DATA_REQ message to MME.
+ */
+int insert_subscriber_data_request(
+  const s6a_insert_subscriber_data_req_t *const idr_p);
+/*
+ * Sends a S6A_DELETE_SUBSCRIBER_DATA_REQ message to MME.
+ */
+int delete_subscriber_data_request(
+  const s6a_delete_subscriber_data_req_t *const dsr_p);
@@ -56,6 +56,12 @@ int mme_app_handle_s6a_update_location_ans(
 int mme_app_handle_s6a_cancel_location_req(
   const s6a_cancel_location_req_t *const clr_pP);
 
+int mme_app_handle_s6a_insert_subscriber_data_req(
+  const s6a_insert_subscriber_data_req_t *const idr_pP);
+
+int mme_app_handle_s6a_delete_subscriber_data_req(
+  const s6a_delete_subscriber_data_req_t *const dsr_pP);
+
 int mme_app_handle_nas_pdn_connectivity_req(
   itti_nas_pdn_connectivity_req_t *const nas_pdn_connectivity_req_p);
 
@@ -38,6 +38,7 @@
 #include "assertions.h"
 #include "common_types.h"
 #include "conversions.h"
+#include "dynamic_memory_check.h"
 #include "intertask_interface.h"
 #include "common_defs.h"
 #include "mme_config.h"
@@ -482,6 +483,174 @@ int mme_app_handle_s6a_cancel_location_req(
   OAILOG_FUNC_RETURN(LOG_MME_APP, rc);
 }
 
+/*
+ * Replaces the APN configurations of the profile with the modified ones of the
+ * same context identifiers and adds the new ones
+ */
+static void mme_app_merge_apn_configurations(
+  apn_config_profile_t *const profile,
+  const apn_config_profile_t *const modified)
+{
+  profile->context_identifier = modified->context_identifier;
+  for (uint8_t i = 0; i < modified->nb_apns; i++) {
+    const apn_configuration_t *apn = &modified->apn_configuration[i];
+    uint8_t j = 0;
+    while (
+      j < profile->nb_apns && profile->apn_configuration[j].context_identifier !=
+                                apn->context_identifier) {
+      j++;
+    }
+    if (j == MAX_APN_PER_UE) {
+      OAILOG_ERROR(
+        LOG_MME_APP,
+        "Can't add APN configuration %u, the UE already has %d APNs\n",
+        apn->context_identifier,
+        MAX_APN_PER_UE);
+      continue;
+    }
+    memcpy(
+      &profile->apn_configuration[j], apn, sizeof(apn_configuration_t));
+    if (j == profile->nb_apns) {
+      profile->nb_apns++;
+    }
+  }
+}
+
+/*
+ * Applies the subscription data of an HSS initiated Insert Subscriber Data
+ * Request to the UE context. The updated data is used for the PDN connections
+ * established afterwards, active PDN connections aren't modified.
+ */
+int mme_app_handle_s6a_insert_subscriber_data_req(
+  const s6a_insert_subscriber_data_req_t *const idr_pP)
+{
+  uint64_t imsi = 0;
+  struct ue_mm_context_s *ue_context_p = NULL;
+  const subscription_data_t *subscription_data = NULL;
+
+  OAILOG_FUNC_IN(LOG_MME_APP);
+  DevAssert(idr_pP);
+
+  IMSI_STRING_TO_IMSI64((char *) idr_pP->imsi, &imsi);
+  OAILOG_DEBUG(
+    LOG_MME_APP,
+    "S6a Insert Subscriber Data Request for imsi " IMSI_64_FMT "\n",
+    imsi);
+
+  if (
+    (ue_context_p = mme_ue_context_exists_imsi(
+       &mme_app_desc.mme_ue_contexts, imsi)) == NULL) {
+    OAILOG_ERROR(
+      LOG_MME_APP,
+      "IMSI is not present in the MME context for imsi " IMSI_64_FMT "\n",
+      imsi);
+    OAILOG_FUNC_RETURN(LOG_MME_APP, RETURNerror);
+  }
+
+  subscription_data = &idr_pP->subscription_data;
+  if (idr_pP->subscribed_ambr_present) {
+    memcpy(
+      &ue_context_p->subscribed_ue_ambr,
+      &subscription_data->subscribed_ambr,
+      sizeof(ambr_t));
+  }
+  if (subscription_data->msisdn_length != 0) {
+    bdestroy_wrapper(&ue_context_p->msisdn);
+    ue_context_p->msisdn = blk2bstr(
+      subscription_data->msisdn, subscription_data->msisdn_length);
+  }
+  if (idr_pP->access_mode_present) {
+    ue_context_p->network_access_mode = subscription_data->access_mode;
+  }
+  if (subscription_data->apn_config_profile.nb_apns > 0) {
+    if (
+      subscription_data->apn_config_profile.all_apn_conf_ind ==
+      ALL_APN_CONFIGURATIONS_INCLUDED) {
+      memcpy(
+        &ue_context_p->apn_config_profile,
+        &subscription_data->apn_config_profile,
+        sizeof(apn_config_profile_t));
+    } else {
+      mme_app_merge_apn_configurations(
+        &ue_context_p->apn_config_profile,
+        &subscription_data->apn_config_profile);
+    }
+  }
+  OAILOG_INFO(
+    LOG_MME_APP,
+    "Updated subscription data of imsi " IMSI_64_FMT
+    ", UL rate %" PRIu64 " and DL rate %" PRIu64 "\n",
+    imsi,
+    ue_context_p->subscribed_ue_ambr.br_ul,
+    ue_context_p->subscribed_ue_ambr.br_dl);
+  unlock_ue_contexts(ue_context_p);
+  OAILOG_FUNC_RETURN(LOG_MME_APP, RETURNok);
+}
+
+/*
+ * Removes the APN configurations deleted by an HSS initiated Delete Subscriber
+ * Data Request from the UE context. Active PDN connections to the deleted APNs
+ * are kept until they are released.
+ */
+int mme_app_handle_s6a_delete_subscriber_data_req(
+  const s6a_delete_subscriber_data_req_t *const dsr_pP)
+{
+  uint64_t imsi = 0;
+  struct ue_mm_context_s *ue_context_p = NULL;
+  apn_config_profile_t *apn_config_profile = NULL;
+  uint8_t nb_apns = 0;
+
+  OAILOG_FUNC_IN(LOG_MME_APP);
+  DevAssert(dsr_pP);
+
+  IMSI_STRING_TO_IMSI64((char *) dsr_pP->imsi, &imsi);
+  OAILOG_DEBUG(
+    LOG_MME_APP,
+    "S6a Delete Subscriber Data Request for imsi " IMSI_64_FMT "\n",
+    imsi);
+
+  if (
+    (ue_context_p = mme_ue_context_exists_imsi(
+       &mme_app_desc.mme_ue_contexts, imsi)) == NULL) {
+    OAILOG_ERROR(
+      LOG_MME_APP,
+      "IMSI is not present in the MME context for imsi " IMSI_64_FMT "\n",
+      imsi);
+    OAILOG_FUNC_RETURN(LOG_MME_APP, RETURNerror);
+  }
+
+  apn_config_profile = &ue_context_p->apn_config_profile;
+  for (uint8_t i = 0; i < apn_config_profile->nb_apns; i++) {
+    bool deleted = false;
+    for (uint8_t j = 0; j < dsr_pP->nb_context_ids; j++) {
+      if (
+        apn_config_profile->apn_configuration[i].context_identifier ==
+        dsr_pP->context_ids[j]) {
+        deleted = true;
+        break;
+      }
+    }
+    if (deleted) {
+      continue;
+    }
+    if (nb_apns != i) {
+      memcpy(
+        &apn_config_profile->apn_configuration[nb_apns],
+        &apn_config_profile->apn_configuration[i],
+        sizeof(apn_configuration_t));
+    }
+    nb_apns++;
+  }
+  OAILOG_INFO(
+    LOG_MME_APP,
+    "Deleted %u APN configurations of imsi " IMSI_64_FMT "\n",
+    apn_config_profile->nb_apns - nb_apns,
+    imsi);
+  apn_config_profile->nb_apns = nb_apns;
+  unlock_ue_contexts(ue_context_p);
+  OAILOG_FUNC_RETURN(LOG_MME_APP, RETURNok);
+}
+
 int mme_app_send_s6a_cancel_location_ans(
   int cla_result,
   const char *imsi,
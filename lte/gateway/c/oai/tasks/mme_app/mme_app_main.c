@@ -180,6 +180,16 @@ void *mme_app_thread(void *args)
           &received_message_p->ittiMsg.s6a_reset_req);
       } break;
 
+      case S6A_INSERT_SUBSCRIBER_DATA_REQ: {
+        mme_app_handle_s6a_insert_subscriber_data_req(
+          &received_message_p->ittiMsg.s6a_insert_subscriber_data_req);
+      } break;
+
+      case S6A_DELETE_SUBSCRIBER_DATA_REQ: {
+        mme_app_handle_s6a_delete_subscriber_data_req(
+          &received_message_p->ittiMsg.s6a_delete_subscriber_data_req);
+      } break;
+
       case S11_CREATE_SESSION_RESPONSE: {
         mme_app_handle_create_sess_resp(
           &received_message_p->ittiMsg.s11_create_session_response);
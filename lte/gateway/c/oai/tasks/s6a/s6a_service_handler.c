@@ -52,3 +52,29 @@ void handle_reset_request(void)
   itti_send_msg_to_task(TASK_MME_APP, INSTANCE_DEFAULT, message_p);
   return;
 }
+
+int insert_subscriber_data_request(
+  const s6a_insert_subscriber_data_req_t *const idr_p)
+{
+  // send it to MME module for further processing
+  MessageDef *message_p = NULL;
+  message_p = itti_alloc_new_message(TASK_S6A, S6A_INSERT_SUBSCRIBER_DATA_REQ);
+  memcpy(
+    &message_p->ittiMsg.s6a_insert_subscriber_data_req,
+    idr_p,
+    sizeof(s6a_insert_subscriber_data_req_t));
+  return itti_send_msg_to_task(TASK_MME_APP, INSTANCE_DEFAULT, message_p);
+}
+
+int delete_subscriber_data_request(
+  const s6a_delete_subscriber_data_req_t *const dsr_p)
+{
+  // send it to MME module for further processing
+  MessageDef *message_p = NULL;
+  message_p = itti_alloc_new_message(TASK_S6A, S6A_DELETE_SUBSCRIBER_DATA_REQ);
+  memcpy(
+    &message_p->ittiMsg.s6a_delete_subscriber_data_req,
+    dsr_p,
+    sizeof(s6a_delete_subscriber_data_req_t));
+  return itti_send_msg_to_task(TASK_MME_APP, INSTANCE_DEFAULT, message_p);
+}
@@ -26,6 +26,7 @@
 
 extern "C" {
 #include "s6a_service_handler.h"
+#include "s6a_messages_types.h"
 #include "log.h"
 }
 #include "S6aProxyImpl.h"
@@ -43,6 +44,10 @@ using grpc::StatusCode;
 namespace magma {
 using namespace feg;
 
+static void convert_proto_msg_to_itti_subscription_data(
+  const InsertSubscriberDataRequest *request,
+  subscription_data_t *subscription_data);
+
 S6aProxyImpl::S6aProxyImpl() {}
 
 Status S6aProxyImpl::CancelLocation(
@@ -79,4 +84,125 @@ Status S6aProxyImpl::Reset(
   return Status::OK;
 }
 
+Status S6aProxyImpl::InsertSubscriberData(
+  ServerContext *context,
+  const InsertSubscriberDataRequest *request,
+  InsertSubscriberDataAnswer *response)
+{
+  auto imsi = request->user_name();
+  OAILOG_INFO(LOG_MME_APP, "Received IDR for %s\n", imsi.c_str());
+  if (imsi.length() > IMSI_BCD_DIGITS_MAX) {
+    return Status(StatusCode::INVALID_ARGUMENT, "Invalid IMSI");
+  }
+  s6a_insert_subscriber_data_req_t idr = {0};
+  memcpy(idr.imsi, imsi.c_str(), imsi.length());
+  idr.imsi_length = imsi.length();
+  convert_proto_msg_to_itti_subscription_data(
+    request, &idr.subscription_data);
+  idr.subscribed_ambr_present = request->has_total_ambr();
+  idr.access_mode_present = request->network_access_mode_present();
+  // Send message to MME_APP for further processing
+  insert_subscriber_data_request(&idr);
+  // return success regardless of MME_APP processing status, like for CLRs
+  response->set_error_code(ErrorCode::SUCCESS);
+  return Status::OK;
+}
+
+Status S6aProxyImpl::DeleteSubscriberData(
+  ServerContext *context,
+  const DeleteSubscriberDataRequest *request,
+  DeleteSubscriberDataAnswer *response)
+{
+  auto imsi = request->user_name();
+  OAILOG_INFO(LOG_MME_APP, "Received DSR for %s\n", imsi.c_str());
+  if (imsi.length() > IMSI_BCD_DIGITS_MAX) {
+    return Status(StatusCode::INVALID_ARGUMENT, "Invalid IMSI");
+  }
+  s6a_delete_subscriber_data_req_t dsr = {0};
+  memcpy(dsr.imsi, imsi.c_str(), imsi.length());
+  dsr.imsi_length = imsi.length();
+  while (dsr.nb_context_ids < request->context_id_size() &&
+         dsr.nb_context_ids < MAX_APN_PER_UE) {
+    dsr.context_ids[dsr.nb_context_ids] =
+      request->context_id(dsr.nb_context_ids);
+    ++dsr.nb_context_ids;
+  }
+  // Send message to MME_APP for further processing
+  delete_subscriber_data_request(&dsr);
+  // return success regardless of MME_APP processing status, like for CLRs
+  response->set_error_code(ErrorCode::SUCCESS);
+  return Status::OK;
+}
+
+static void convert_proto_msg_to_itti_subscription_data(
+  const InsertSubscriberDataRequest *request,
+  subscription_data_t *subscription_data)
+{
+  subscription_data->subscribed_ambr.br_ul =
+    request->total_ambr().max_bandwidth_ul();
+  subscription_data->subscribed_ambr.br_dl =
+    request->total_ambr().max_bandwidth_dl();
+  if (request->msisdn().length() <= MSISDN_LENGTH) {
+    memcpy(
+      subscription_data->msisdn,
+      request->msisdn().c_str(),
+      request->msisdn().length());
+    subscription_data->msisdn_length = request->msisdn().length();
+  }
+  if (
+    request->network_access_mode() ==
+    UpdateLocationAnswer_NetworkAccessMode_PACKET_AND_CIRCUIT) {
+    subscription_data->access_mode = NAM_PACKET_AND_CIRCUIT;
+  } else if (
+    request->network_access_mode() ==
+    UpdateLocationAnswer_NetworkAccessMode_RESERVED) {
+    subscription_data->access_mode = NAM_RESERVED;
+  } else {
+    subscription_data->access_mode = NAM_ONLY_PACKET;
+  }
+
+  // apn configuration
+  apn_config_profile_t *profile = &subscription_data->apn_config_profile;
+  profile->context_identifier = request->default_context_id();
+  if (request->all_apns_included()) {
+    profile->all_apn_conf_ind = ALL_APN_CONFIGURATIONS_INCLUDED;
+  } else {
+    profile->all_apn_conf_ind = MODIFIED_ADDED_APN_CONFIGURATIONS_INCLUDED;
+  }
+  uint8_t idx = 0;
+  while (idx < request->apn_size() && idx < MAX_APN_PER_UE) {
+    auto apn = request->apn(idx);
+    apn_configuration_t *itti_apn = &profile->apn_configuration[idx];
+
+    itti_apn->context_identifier = apn.context_id();
+    itti_apn->pdn_type = (pdn_type_t) apn.pdn();
+    auto service_sel = apn.service_selection();
+    if (service_sel.length() > APN_MAX_LENGTH) {
+      itti_apn->service_selection_length = APN_MAX_LENGTH;
+    } else {
+      itti_apn->service_selection_length = service_sel.length();
+    }
+    memcpy(
+      itti_apn->service_selection,
+      service_sel.c_str(),
+      itti_apn->service_selection_length);
+
+    // Qos profile
+    itti_apn->subscribed_qos.qci = (qci_t) apn.qos_profile().class_id();
+    itti_apn->subscribed_qos.allocation_retention_priority.priority_level =
+      apn.qos_profile().priority_level();
+    itti_apn->subscribed_qos.allocation_retention_priority
+      .pre_emp_vulnerability = (pre_emption_vulnerability_t) apn.qos_profile()
+                                 .preemption_vulnerability();
+    itti_apn->subscribed_qos.allocation_retention_priority.pre_emp_capability =
+      (pre_emption_capability_t) apn.qos_profile().preemption_capability();
+
+    //apn ambr
+    itti_apn->ambr.br_ul = apn.ambr().max_bandwidth_ul();
+    itti_apn->ambr.br_dl = apn.ambr().max_bandwidth_dl();
+    ++idx;
+  }
+  profile->nb_apns = idx;
+}
+
 } // namespace magma
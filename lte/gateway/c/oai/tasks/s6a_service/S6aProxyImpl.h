@@ -32,6 +32,10 @@ namespace magma {
 namespace feg {
 class CancelLocationAnswer;
 class CancelLocationRequest;
+class DeleteSubscriberDataAnswer;
+class DeleteSubscriberDataRequest;
+class InsertSubscriberDataAnswer;
+class InsertSubscriberDataRequest;
 class ResetAnswer;
 class ResetRequest;
 }  // namespace feg
@@ -72,6 +76,32 @@ class S6aProxyImpl final : public S6aGatewayService::Service {
     ServerContext *context,
     const ResetRequest *request,
     ResetAnswer *response) override;
+  /*
+       * Insert Subscriber Data Request
+       * S6a Command Code: 319
+       *
+       * @param context: the grpc Server context
+       * @param request: InsertSubscriberDataRequest
+       * @param response (out): InsertSubscriberDataAnswer
+       * @return grpc Status instance
+       */
+  Status InsertSubscriberData(
+    ServerContext *context,
+    const InsertSubscriberDataRequest *request,
+    InsertSubscriberDataAnswer *response) override;
+  /*
+       * Delete Subscriber Data Request
+       * S6a Command Code: 320
+       *
+       * @param context: the grpc Server context
+       * @param request: DeleteSubscriberDataRequest
+       * @param response (out): DeleteSubscriberDataAnswer
+       * @return grpc Status instance
+       */
+  Status DeleteSubscriberData(
+    ServerContext *context,
+    const DeleteSubscriberDataRequest *request,
+    DeleteSubscriberDataAnswer *response) override;
 };
 
 } // namespace magma
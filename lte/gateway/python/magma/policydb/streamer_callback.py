@@ -40,9 +40,19 @@ class PolicyDBStreamerCallback(StreamerClient.Callback):
                 policy_ids.add(policy.id)
             logging.debug("Resync with policies: %s", ','.join(policy_ids))
             self._remove_old_policies(policy_ids)
-            self._policy_dict.send_update_notification()
         else:
-            pass
+            for update in updates:
+                policy = PolicyRule()
+                policy.ParseFromString(update.value)
+                self._store_policy_rule(policy)
+        self._policy_dict.send_update_notification()
+
+    def process_deletes(self, stream_name, deleted_keys):
+        logging.info("Processing %d policy deletions", len(deleted_keys))
+        for rule_id in deleted_keys:
+            if rule_id in self._policy_dict:
+                del self._policy_dict[rule_id]
+        self._policy_dict.send_update_notification()
 
     def _store_policy_rule(self, policy):
         self._policy_dict[policy.id] = policy
@@ -16,6 +16,8 @@ from lte.protos.subscriberdb_pb2 import SubscriberData
 
 from magma.common.service_registry import ServiceRegistry
 from magma.common.streamer import StreamerClient
+from magma.subscriberdb.sid import SIDUtils
+from magma.subscriberdb.store.base import SubscriberNotFoundError
 
 
 class SubscriberDBStreamerCallback(StreamerClient.Callback):
@@ -50,8 +52,29 @@ class SubscriberDBStreamerCallback(StreamerClient.Callback):
             logging.debug("Resync with subscribers: %s", ','.join(keys))
             self._store.resync(subscribers)
         else:
-            # TODO: implement updates
-            pass
+            for update in updates:
+                sub = SubscriberData()
+                sub.ParseFromString(update.value)
+                self._add_or_update_subscriber(sub)
+
+    def process_deletes(self, stream_name, deleted_keys):
+        logging.info("Processing %d subscriber deletions", len(deleted_keys))
+        for sub_id in deleted_keys:
+            self._store.delete_subscriber(sub_id)
+        self.detach_subscribers(deleted_keys)
+
+    def _add_or_update_subscriber(self, sub):
+        """
+        Adds the streamed subscriber or updates the stored one, keeping
+        its current state like the resync does
+        """
+        sid = SIDUtils.to_str(sub.sid)
+        try:
+            with self._store.edit_subscriber(sid) as subscriber_data:
+                sub.state.CopyFrom(subscriber_data.state)
+                subscriber_data.CopyFrom(sub)
+        except SubscriberNotFoundError:
+            self._store.add_subscriber(sub)
 
     def detach_deleted_subscribers(self, old_sub_ids, new_sub_ids):
         """
@@ -66,14 +89,23 @@ class SubscriberDBStreamerCallback(StreamerClient.Callback):
         """
         deleted_sub_ids = [sub_id for sub_id in old_sub_ids
                            if sub_id not in set(new_sub_ids)]
-        if len(deleted_sub_ids) == 0:
+        self.detach_subscribers(deleted_sub_ids)
+
+    def detach_subscribers(self, sub_ids):
+        """
+        Sends grpc DeleteSubscriber request to mme to detach the deleted
+        subscribers.
+        :param sub_ids: a list of deleted subscriber ids
+        :return: n/a
+        """
+        if len(sub_ids) == 0:
             return
         # send detach request to mme for all deleted subscribers.
         chan = ServiceRegistry.get_rpc_channel('s6a_service',
                                                ServiceRegistry.LOCAL)
         client = S6aServiceStub(chan)
         req = DeleteSubscriberRequest()
-        req.imsi_list.extend(deleted_sub_ids)
+        req.imsi_list.extend(sub_ids)
         future = client.DeleteSubscriber.future(req)
         future.add_done_callback(lambda future:
                                  self._loop.call_soon_threadsafe(
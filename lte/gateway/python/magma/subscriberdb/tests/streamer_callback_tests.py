@@ -14,6 +14,9 @@ import unittest
 import unittest.mock
 
 from lte.protos.s6a_service_pb2 import DeleteSubscriberRequest
+from lte.protos.subscriberdb_pb2 import LTESubscription, SubscriberData
+from orc8r.protos.streamer_pb2 import DataUpdate
+from magma.subscriberdb.sid import SIDUtils
 from magma.subscriberdb.store.sqlite import SqliteStore
 from magma.subscriberdb.streamer_callback import SubscriberDBStreamerCallback
 
@@ -45,6 +48,7 @@ class SubscriberDBStreamerCallbackTests(unittest.TestCase):
 
     def setUp(self):
         store = SqliteStore('file::memory:')
+        self._store = store
         self._streamer_callback = \
             SubscriberDBStreamerCallback(store, loop=asyncio.new_event_loop())
         ServiceRegistry.add_service('test', '0.0.0.0', 0)
@@ -85,6 +89,44 @@ class SubscriberDBStreamerCallbackTests(unittest.TestCase):
                 imsi_list=["IMSI101", "IMSI303"]
             ))
 
+    @unittest.mock.patch('magma.subscriberdb.streamer_callback.S6aServiceStub')
+    def test_process_delta_updates(self, s6a_service_mock_stub):
+        """
+        Test if the streamer_callback applies incremental updates and
+        detaches deleted subscribers.
+        """
+        mock = unittest.mock.Mock()
+        mock.DeleteSubscriber.future.side_effect = [unittest.mock.Mock()]
+        s6a_service_mock_stub.side_effect = [mock]
+
+        def _update(sid, state=LTESubscription.ACTIVE):
+            sub = SubscriberData(sid=SIDUtils.to_pb(sid),
+                                 lte=LTESubscription(state=state))
+            return DataUpdate(key=sid, value=sub.SerializeToString())
+
+        self._streamer_callback.process_update(
+            'subscriberdb', [_update('IMSI101'), _update('IMSI202')], True)
+        with self._store.edit_subscriber('IMSI101') as sub:
+            sub.state.lte_auth_next_seq = 7
+
+        # Updated subscribers keep their state, new ones are added
+        self._streamer_callback.process_update(
+            'subscriberdb',
+            [_update('IMSI101', LTESubscription.INACTIVE), _update('IMSI303')],
+            False)
+        self.assertEqual(sorted(self._store.list_subscribers()),
+                         ['IMSI101', 'IMSI202', 'IMSI303'])
+        sub = self._store.get_subscriber_data('IMSI101')
+        self.assertEqual(sub.lte.state, LTESubscription.INACTIVE)
+        self.assertEqual(sub.state.lte_auth_next_seq, 7)
+
+        # Deleted subscribers are removed and detached
+        self._streamer_callback.process_deletes('subscriberdb', ['IMSI202'])
+        self.assertEqual(sorted(self._store.list_subscribers()),
+                         ['IMSI101', 'IMSI303'])
+        mock.DeleteSubscriber.future.assert_called_once_with(
+            DeleteSubscriberRequest(imsi_list=['IMSI202']))
+
 
 if __name__ == "__main__":
     unittest.main()
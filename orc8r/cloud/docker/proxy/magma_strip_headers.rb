@@ -0,0 +1,21 @@
+# Copyright (c) 2018-present, Facebook, Inc.
+# All rights reserved.
+#
+# This source code is licensed under the BSD-style license found in the
+# LICENSE file in the root directory of this source tree. An additional grant
+# of patent rights can be found in the PATENTS file in the same directory.
+
+# Nghttpx can run mcruby scripts as part of each request handling:
+# See: https://nghttp2.org/documentation/nghttpx.1.html?highlight=mruby-file#mruby-scripting
+
+class App
+  def on_req(env)
+    # Clients of the open proxy are not verified, so blank any client cert
+    # headers they send. Backend services must authenticate such requests by
+    # other means (e.g. obsidian's OIDC bearer tokens).
+    env.req.set_header("x-magma-client-cert-cn", "")
+    env.req.set_header("x-magma-client-cert-serial", "")
+  end
+end
+
+App.new
@@ -70,6 +70,10 @@ def main() -> None:
     context["controller_hostname"] = os.environ["CONTROLLER_HOSTNAME"]
     context["proxy_backends"] = os.environ["PROXY_BACKENDS"]
     context["obsidian_port"] = OBSIDIAN_PORT
+    # Expose the REST API on the open proxy, obsidian must be configured
+    # with an OIDC issuer to authenticate the requests
+    context["obsidian_token_auth"] = \
+        os.environ.get("OBSIDIAN_TOKEN_AUTH", "").lower() in ("1", "true")
 
     # Generate the nghttpx config
     conf = _generate_config(args.proxy_type, context)
@@ -77,6 +77,19 @@ func integration(t *testing.T, fact blobstore.BlobStorageFactory) {
 	assert.NoError(t, err)
 	assert.Equal(t, []string{"k1", "k2"}, listActual)
 
+	listActual, err = store.ListKeysPage("network1", "t1", "", 1)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"k1"}, listActual)
+	listActual, err = store.ListKeysPage("network1", "t1", "k1", 1)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"k2"}, listActual)
+	listActual, err = store.ListKeysPage("network1", "t1", "k2", 1)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{}, listActual)
+	listActual, err = store.ListKeysPage("network1", "t1", "", 0)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"k1", "k2"}, listActual)
+
 	getManyActual, err = store.GetMany("network1", []storage.TypeAndKey{
 		{Type: "t1", Key: "k1"},
 		{Type: "t1", Key: "k2"},
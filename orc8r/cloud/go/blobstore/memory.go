@@ -124,6 +124,25 @@ func (store *memoryBlobStorage) ListKeys(networkID string, typeVal string) ([]st
 	return store.updateKeysWithLocalChangesUnsafe(networkID, typeVal, keySet)
 }
 
+// ListKeysPage lists the keys like ListKeys and returns the given page of
+// them
+func (store *memoryBlobStorage) ListKeysPage(networkID string, typeVal string, afterKey string, limit uint64) ([]string, error) {
+	keys, err := store.ListKeys(networkID, typeVal)
+	if err != nil {
+		return nil, err
+	}
+	// Keys are sorted
+	start := sort.SearchStrings(keys, afterKey)
+	if start < len(keys) && keys[start] == afterKey {
+		start++
+	}
+	keys = keys[start:]
+	if limit > 0 && uint64(len(keys)) > limit {
+		keys = keys[:limit]
+	}
+	return keys, nil
+}
+
 func (store *memoryBlobStorage) Get(networkID string, id storage.TypeAndKey) (Blob, error) {
 	multiRet, err := store.GetMany(networkID, []storage.TypeAndKey{id})
 	if err != nil {
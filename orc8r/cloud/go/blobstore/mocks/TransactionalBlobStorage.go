@@ -128,6 +128,29 @@ func (_m *TransactionalBlobStorage) ListKeys(networkID string, typeVal string) (
 	return r0, r1
 }
 
+// ListKeysPage provides a mock function with given fields: networkID, typeVal, afterKey, limit
+func (_m *TransactionalBlobStorage) ListKeysPage(networkID string, typeVal string, afterKey string, limit uint64) ([]string, error) {
+	ret := _m.Called(networkID, typeVal, afterKey, limit)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(string, string, string, uint64) []string); ok {
+		r0 = rf(networkID, typeVal, afterKey, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string, string, uint64) error); ok {
+		r1 = rf(networkID, typeVal, afterKey, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Rollback provides a mock function with given fields:
 func (_m *TransactionalBlobStorage) Rollback() error {
 	ret := _m.Called()
@@ -110,6 +110,38 @@ func (store *sqlBlobStorage) ListKeys(networkID string, typeVal string) ([]strin
 	return ret, nil
 }
 
+func (store *sqlBlobStorage) ListKeysPage(networkID string, typeVal string, afterKey string, limit uint64) ([]string, error) {
+	ret := []string{}
+	if err := store.validateTx(); err != nil {
+		return ret, err
+	}
+
+	builder := store.builder.Select("key").From(store.tableName).
+		Where(sq.And{
+			sq.Eq{"network_id": networkID, "type": typeVal},
+			sq.Gt{"key": afterKey},
+		}).
+		OrderBy("key")
+	if limit > 0 {
+		builder = builder.Limit(limit)
+	}
+	rows, err := builder.RunWith(store.tx).Query()
+	if err != nil {
+		return ret, err
+	}
+	defer sql_utils.CloseRowsLogOnError(rows, "ListKeysPage")
+
+	for rows.Next() {
+		var key string
+		err = rows.Scan(&key)
+		if err != nil {
+			return []string{}, err
+		}
+		ret = append(ret, key)
+	}
+	return ret, nil
+}
+
 func (store *sqlBlobStorage) Get(networkID string, id storage.TypeAndKey) (Blob, error) {
 	multiRet, err := store.GetMany(networkID, []storage.TypeAndKey{id})
 	if err != nil {
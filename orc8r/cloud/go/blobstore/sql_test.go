@@ -55,6 +55,39 @@ func TestSqlBlobStorage_ListKeys(t *testing.T) {
 	runCase(t, queryError)
 }
 
+func TestSqlBlobStorage_ListKeysPage(t *testing.T) {
+	happyPath := &testCase{
+		setup: func(mock sqlmock.Sqlmock) {
+			mock.ExpectQuery("SELECT key FROM network_table WHERE \\(network_id = \\$1 AND type = \\$2 AND key > \\$3\\) ORDER BY key LIMIT 2").
+				WithArgs("network", "type", "key1").
+				WillReturnRows(
+					sqlmock.NewRows([]string{"key"}).AddRow("key2").AddRow("key3"),
+				)
+		},
+		run: func(store blobstore.TransactionalBlobStorage) (interface{}, error) {
+			return store.ListKeysPage("network", "type", "key1", 2)
+		},
+		expectedError:  nil,
+		expectedResult: []string{"key2", "key3"},
+	}
+
+	queryError := &testCase{
+		setup: func(mock sqlmock.Sqlmock) {
+			mock.ExpectQuery("SELECT key FROM network_table").
+				WithArgs("network", "type", "").
+				WillReturnError(errors.New("Mock query error"))
+		},
+		run: func(store blobstore.TransactionalBlobStorage) (interface{}, error) {
+			return store.ListKeysPage("network", "type", "", 0)
+		},
+		expectedError:  errors.New("Mock query error"),
+		expectedResult: nil,
+	}
+
+	runCase(t, happyPath)
+	runCase(t, queryError)
+}
+
 func TestSqlBlobStorage_Get(t *testing.T) {
 	happyPath := &testCase{
 		setup: func(mock sqlmock.Sqlmock) {
@@ -44,6 +44,11 @@ type TransactionalBlobStorage interface {
 	// ListKeys returns all the blob keys stored for the network and type.
 	ListKeys(networkID string, typeVal string) ([]string, error)
 
+	// ListKeysPage returns up to limit blob keys stored for the network and
+	// type which sort after afterKey, in ascending order. A limit of 0
+	// returns all keys after afterKey.
+	ListKeysPage(networkID string, typeVal string, afterKey string, limit uint64) ([]string, error)
+
 	// Get loads a specific blob from storage.
 	// If there is no blob matching the given ID, ErrNotFound from
 	// magma/orc8r/cloud/go/errors will be returned.
@@ -39,4 +39,13 @@ type Api interface {
 	ListKeys(table string) ([]string, error)
 	DeleteTable(table string) error
 	DoesKeyExist(table string, key string) (bool, error)
+	// PutIfAbsent puts the value only if there's no record for the key yet and
+	// returns whether it was put
+	PutIfAbsent(table string, key string, value []byte) (bool, error)
+	// PutIfValue replaces the value of the key only if it still holds the
+	// expected value and returns whether it was replaced
+	PutIfValue(table string, key string, expected []byte, value []byte) (bool, error)
+	// DeleteIfValue deletes the record of the key only if it holds the given
+	// value and returns whether it was deleted
+	DeleteIfValue(table string, key string, value []byte) (bool, error)
 }
@@ -37,6 +37,27 @@ func (_m *Api) Delete(table string, key string) error {
 	return r0
 }
 
+// DeleteIfValue provides a mock function with given fields: table, key, value
+func (_m *Api) DeleteIfValue(table string, key string, value []byte) (bool, error) {
+	ret := _m.Called(table, key, value)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(string, string, []byte) bool); ok {
+		r0 = rf(table, key, value)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string, []byte) error); ok {
+		r1 = rf(table, key, value)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DeleteMany provides a mock function with given fields: table, keys
 func (_m *Api) DeleteMany(table string, keys []string) (map[string]error, error) {
 	ret := _m.Called(table, keys)
@@ -185,6 +206,48 @@ func (_m *Api) Put(table string, key string, value []byte) error {
 	return r0
 }
 
+// PutIfAbsent provides a mock function with given fields: table, key, value
+func (_m *Api) PutIfAbsent(table string, key string, value []byte) (bool, error) {
+	ret := _m.Called(table, key, value)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(string, string, []byte) bool); ok {
+		r0 = rf(table, key, value)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string, []byte) error); ok {
+		r1 = rf(table, key, value)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PutIfValue provides a mock function with given fields: table, key, expected, value
+func (_m *Api) PutIfValue(table string, key string, expected []byte, value []byte) (bool, error) {
+	ret := _m.Called(table, key, expected, value)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(string, string, []byte, []byte) bool); ok {
+		r0 = rf(table, key, expected, value)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string, []byte, []byte) error); ok {
+		r1 = rf(table, key, expected, value)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // PutMany provides a mock function with given fields: table, valuesToPut
 func (_m *Api) PutMany(table string, valuesToPut map[string][]byte) (map[string]error, error) {
 	ret := _m.Called(table, valuesToPut)
@@ -205,6 +205,64 @@ func (store *SqlDb) Delete(table string, key string) error {
 	return err
 }
 
+func (store *SqlDb) PutIfAbsent(table string, key string, value []byte) (bool, error) {
+	txFn := func(tx *sql.Tx) (interface{}, error) {
+		res, err := store.builder.Insert(table).
+			Columns("key", "value").
+			Values(key, value).
+			OnConflict(nil, "key").
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return false, err
+		}
+		rowsAffected, err := res.RowsAffected()
+		return rowsAffected == 1, err
+	}
+	ret, err := sql_utils.ExecInTx(store.db, getInitFn(table), txFn)
+	if err != nil {
+		return false, err
+	}
+	return ret.(bool), nil
+}
+
+func (store *SqlDb) PutIfValue(table string, key string, expected []byte, value []byte) (bool, error) {
+	txFn := func(tx *sql.Tx) (interface{}, error) {
+		res, err := store.builder.Update(table).
+			Set("value", value).
+			Set("generation_number", sq.Expr("generation_number + 1")).
+			Where(sq.Eq{"key": key, "value": expected}).
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return false, err
+		}
+		rowsAffected, err := res.RowsAffected()
+		return rowsAffected == 1, err
+	}
+	ret, err := sql_utils.ExecInTx(store.db, getInitFn(table), txFn)
+	if err != nil {
+		return false, err
+	}
+	return ret.(bool), nil
+}
+
+func (store *SqlDb) DeleteIfValue(table string, key string, value []byte) (bool, error) {
+	txFn := func(tx *sql.Tx) (interface{}, error) {
+		res, err := store.builder.Delete(table).Where(sq.Eq{"key": key, "value": value}).RunWith(tx).Exec()
+		if err != nil {
+			return false, err
+		}
+		rowsAffected, err := res.RowsAffected()
+		return rowsAffected == 1, err
+	}
+	ret, err := sql_utils.ExecInTx(store.db, getInitFn(table), txFn)
+	if err != nil {
+		return false, err
+	}
+	return ret.(bool), nil
+}
+
 func (store *SqlDb) DeleteMany(table string, keys []string) (map[string]error, error) {
 	txFn := func(tx *sql.Tx) (interface{}, error) {
 		return store.builder.Delete(table).Where(sq.Eq{"key": keys}).RunWith(tx).Exec()
@@ -148,3 +148,45 @@ func TestDatastoreBulkOperations(t *testing.T) {
 	assert.Equal(t, expectedDbRows, dbRows)
 
 }
+
+func TestDatastoreConditionalOperations(t *testing.T) {
+	ds, err := datastore.NewSqlDb("sqlite3", ":memory:", sql_utils.GetSqlBuilder())
+	assert.NoError(t, err)
+
+	put, err := ds.PutIfAbsent("test", "key1", []byte("value1"))
+	assert.NoError(t, err)
+	assert.True(t, put)
+	put, err = ds.PutIfAbsent("test", "key1", []byte("value2"))
+	assert.NoError(t, err)
+	assert.False(t, put)
+	value, _, err := ds.Get("test", "key1")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("value1"), value)
+
+	put, err = ds.PutIfValue("test", "key1", []byte("value2"), []byte("value3"))
+	assert.NoError(t, err)
+	assert.False(t, put)
+	put, err = ds.PutIfValue("test", "key1", []byte("value1"), []byte("value2"))
+	assert.NoError(t, err)
+	assert.True(t, put)
+	value, generation, err := ds.Get("test", "key1")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("value2"), value)
+	assert.Equal(t, uint64(1), generation)
+	put, err = ds.PutIfValue("test", "key2", []byte("value1"), []byte("value2"))
+	assert.NoError(t, err)
+	assert.False(t, put)
+
+	deleted, err := ds.DeleteIfValue("test", "key1", []byte("value1"))
+	assert.NoError(t, err)
+	assert.False(t, deleted)
+	deleted, err = ds.DeleteIfValue("test", "key1", []byte("value2"))
+	assert.NoError(t, err)
+	assert.True(t, deleted)
+	deleted, err = ds.DeleteIfValue("test", "key1", []byte("value2"))
+	assert.NoError(t, err)
+	assert.False(t, deleted)
+	exists, err := ds.DoesKeyExist("test", "key1")
+	assert.NoError(t, err)
+	assert.False(t, exists)
+}
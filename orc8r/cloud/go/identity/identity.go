@@ -47,6 +47,13 @@ func NewGatewayWildcard() *protos.Identity {
 	return protos.NewGatewayWildcardIdentity()
 }
 
+// NewNetworkGatewayWildcard returns Gateway Wildcard identity scoped to the
+// gateways of the given network
+// see protos/identity_helper.go
+func NewNetworkGatewayWildcard(networkId string) *protos.Identity {
+	return protos.NewNetworkGatewayWildcardIdentity(networkId)
+}
+
 // NewOperatorWildcard returns Operator Wildcard identity
 // see protos/identity_helper.go
 func NewOperatorWildcard() *protos.Identity {
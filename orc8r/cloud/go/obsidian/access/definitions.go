@@ -18,3 +18,16 @@ const (
 	// Client Certificate Serial Number Header
 	CLIENT_CERT_SN_KEY = "X-Magma-Client-Cert-Serial"
 )
+
+// Bearer token authentication, used instead of client certificates when an
+// OIDC issuer is configured (see SetTokenVerifier)
+const (
+	// Authorization Header
+	AUTHORIZATION_KEY = "Authorization"
+	// Bearer authentication scheme prefix of Authorization header value
+	BEARER_PREFIX = "Bearer "
+)
+
+// OPERATOR_CONTEXT_KEY is the echo.Context key of the request's Operator
+// Identity, set by the access Middleware once the Operator is identified
+const OPERATOR_CONTEXT_KEY = "magma-operator"
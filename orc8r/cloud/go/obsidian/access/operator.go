@@ -21,7 +21,9 @@ import (
 )
 
 // RequestOperator returns Identity of request's Operator (client)
-// If either the request is missing TLS certificate headers or the certificate's
+// If bearer token authentication is enabled & the request has an Authorization
+// header, the Operator is identified by the verified token's operator claim.
+// Otherwise, if either the request is missing TLS certificate headers or the certificate's
 // SN is not found by Certifier or one of certificate & its identity checks fail
 // - nil will be returned & the corresponding error logged
 func RequestOperator(c echo.Context) (*protos.Identity, error) {
@@ -34,6 +36,10 @@ func RequestOperator(c echo.Context) (*protos.Identity, error) {
 		glog.Error("Nil HTTP Request")
 		return nil, fmt.Errorf("Internal Server Error (Request)")
 	}
+	// Bearer token (if enabled) takes precedence over client certificate
+	if token, ok := requestBearerToken(c); ok {
+		return tokenOperator(c, token)
+	}
 
 	// Get Certificate SN header value
 	// TBD: to optimize - use map directly
@@ -76,3 +82,13 @@ func RequestOperator(c echo.Context) (*protos.Identity, error) {
 	// all checks are OK, return it
 	return certInfo.Id, nil
 }
+
+// ContextOperator returns the request's Operator Identity previously found by
+// the access Middleware or nil if the Operator wasn't identified
+func ContextOperator(c echo.Context) *protos.Identity {
+	if c == nil {
+		return nil
+	}
+	oper, _ := c.Get(OPERATOR_CONTEXT_KEY).(*protos.Identity)
+	return oper
+}
@@ -53,6 +53,7 @@ func Middleware(next echo.HandlerFunc) echo.HandlerFunc {
 				http.StatusUnauthorized,
 				"Missing Client Credentials")
 		}
+		c.Set(OPERATOR_CONTEXT_KEY, oper)
 
 		// Bypass farther identity Checks for static docs GET and Channels GET,
 		// having an operator cert should be enough
@@ -0,0 +1,119 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package tests
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"magma/orc8r/cloud/go/obsidian/access"
+	"magma/orc8r/cloud/go/security/oidc"
+	oidc_test_utils "magma/orc8r/cloud/go/security/oidc/test_utils"
+	magmadh "magma/orc8r/cloud/go/services/magmad/obsidian/handlers"
+)
+
+func TestMiddlewareBearerToken(t *testing.T) {
+	operCertSn, _ := MockAccessControl(t)
+
+	provider, err := oidc_test_utils.NewProvider("")
+	assert.NoError(t, err)
+	defer provider.Close()
+	verifier, err := oidc.NewVerifier(provider.Issuer(), oidc_test_utils.TestAudience, "")
+	assert.NoError(t, err)
+	access.SetTokenVerifier(verifier, "sub")
+	defer access.SetTokenVerifier(nil, "")
+
+	e := startTestMidlewareServer(t)
+	listener := WaitForTestServer(t, e)
+	if listener == nil {
+		return // WaitForTestServer should have 'logged' error already
+	}
+	urlPrefix := "http://" + listener.Addr().String()
+	readURL := urlPrefix + magmadh.RegisterNetwork + "/" + TEST_NETWORK_ID
+
+	operToken, err := provider.IssueToken(TEST_OPERATOR_ID, nil)
+	assert.NoError(t, err)
+	superToken, err := provider.IssueToken(TEST_SUPER_OPERATOR_ID, nil)
+	assert.NoError(t, err)
+
+	// Token operator is subject to the operator's ACL
+	s, err := SendTokenRequest("GET", readURL, operToken)
+	assert.NoError(t, err)
+	assert.Equal(t, 200, s)
+	s, err = SendTokenRequest("PUT", readURL, operToken)
+	assert.NoError(t, err)
+	assert.Equal(t, 403, s)
+	s, err = SendTokenRequest(
+		"PUT", urlPrefix+magmadh.RegisterNetwork+"/"+WRITE_TEST_NETWORK_ID, operToken)
+	assert.NoError(t, err)
+	assert.Equal(t, 200, s)
+	s, err = SendTokenRequest("PUT", urlPrefix+"/malformed/url", operToken)
+	assert.NoError(t, err)
+	assert.Equal(t, 403, s)
+	s, err = SendTokenRequest("PUT", urlPrefix+"/malformed/url", superToken)
+	assert.NoError(t, err)
+	assert.Equal(t, 200, s)
+
+	// Operator without ACL
+	unknownToken, err := provider.IssueToken("eve", nil)
+	assert.NoError(t, err)
+	s, err = SendTokenRequest("GET", readURL, unknownToken)
+	assert.NoError(t, err)
+	assert.Equal(t, 403, s)
+
+	// Invalid tokens
+	expiredToken, err := provider.IssueToken(
+		TEST_SUPER_OPERATOR_ID, map[string]interface{}{"exp": time.Now().Add(-time.Hour).Unix()})
+	assert.NoError(t, err)
+	wrongAudToken, err := provider.IssueToken(
+		TEST_SUPER_OPERATOR_ID, map[string]interface{}{"aud": "other-client"})
+	assert.NoError(t, err)
+	otherProvider, err := oidc_test_utils.NewProvider("")
+	assert.NoError(t, err)
+	defer otherProvider.Close()
+	otherKeyToken, err := otherProvider.Sign(oidc_test_utils.TestKeyID, map[string]interface{}{
+		"iss": provider.Issuer(),
+		"sub": TEST_SUPER_OPERATOR_ID,
+		"aud": oidc_test_utils.TestAudience,
+		"exp": time.Now().Add(time.Hour).Unix(),
+	})
+	assert.NoError(t, err)
+	for _, token := range []string{expiredToken, wrongAudToken, otherKeyToken, "", "garbage"} {
+		s, err = SendTokenRequest("GET", readURL, token)
+		assert.NoError(t, err)
+		assert.Equal(t, 401, s)
+	}
+	s, err = sendRequest("GET", readURL, access.AUTHORIZATION_KEY, "Basic Ym9iOmJvYg==")
+	assert.NoError(t, err)
+	assert.Equal(t, 401, s)
+
+	// Client certificates are still accepted without an Authorization header
+	s, err = SendRequest("GET", readURL, operCertSn)
+	assert.NoError(t, err)
+	assert.Equal(t, 200, s)
+
+	// Operator claim other than sub
+	access.SetTokenVerifier(verifier, "preferred_username")
+	token, err := provider.IssueToken("user-1", map[string]interface{}{"preferred_username": TEST_OPERATOR_ID})
+	assert.NoError(t, err)
+	s, err = SendTokenRequest("GET", readURL, token)
+	assert.NoError(t, err)
+	assert.Equal(t, 200, s)
+	s, err = SendTokenRequest("GET", readURL, operToken)
+	assert.NoError(t, err)
+	assert.Equal(t, 401, s)
+
+	// Tokens are ignored if bearer token authentication is disabled
+	access.SetTokenVerifier(nil, "")
+	s, err = SendTokenRequest("GET", readURL, superToken)
+	assert.NoError(t, err)
+	assert.Equal(t, 401, s)
+}
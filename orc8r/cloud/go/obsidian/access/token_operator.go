@@ -0,0 +1,80 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package access
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"magma/orc8r/cloud/go/identity"
+	"magma/orc8r/cloud/go/protos"
+	"magma/orc8r/cloud/go/security/oidc"
+
+	"github.com/golang/glog"
+	"github.com/labstack/echo"
+)
+
+var (
+	tokenVerifierMu sync.RWMutex
+	tokenVerifier   *oidc.Verifier
+	operatorClaim   string
+)
+
+// SetTokenVerifier enables OIDC bearer token authentication of operators. The
+// Operator ID of a request with a valid token is the value of the token's
+// operatorClaim claim. A nil verifier disables bearer token authentication.
+func SetTokenVerifier(verifier *oidc.Verifier, claim string) {
+	tokenVerifierMu.Lock()
+	defer tokenVerifierMu.Unlock()
+	tokenVerifier = verifier
+	operatorClaim = claim
+}
+
+func getTokenVerifier() (*oidc.Verifier, string) {
+	tokenVerifierMu.RLock()
+	defer tokenVerifierMu.RUnlock()
+	return tokenVerifier, operatorClaim
+}
+
+// requestBearerToken returns the request's bearer token if bearer token
+// authentication is enabled & the request has an Authorization header
+func requestBearerToken(c echo.Context) (string, bool) {
+	if verifier, _ := getTokenVerifier(); verifier == nil {
+		return "", false
+	}
+	authz := c.Request().Header.Get(AUTHORIZATION_KEY)
+	if len(authz) == 0 {
+		return "", false
+	}
+	if len(authz) < len(BEARER_PREFIX) || !strings.EqualFold(authz[:len(BEARER_PREFIX)], BEARER_PREFIX) {
+		return "", true
+	}
+	return strings.TrimSpace(authz[len(BEARER_PREFIX):]), true
+}
+
+// tokenOperator returns Operator Identity of the verified bearer token
+func tokenOperator(c echo.Context, token string) (*protos.Identity, error) {
+	if len(token) == 0 {
+		glog.Warning(LogDecorator(c)("Invalid Authorization header"))
+		return nil, fmt.Errorf("Invalid Authorization Header, expected Bearer token")
+	}
+	verifier, claim := getTokenVerifier()
+	claims, err := verifier.Verify(token)
+	if err != nil {
+		glog.Error(LogDecorator(c)("Bearer token verification error '%s'", err))
+		return nil, fmt.Errorf("Invalid Bearer Token: %s", err)
+	}
+	opId, err := claims.GetString(claim)
+	if err != nil {
+		glog.Error(LogDecorator(c)("Bearer token operator claim error '%s'", err))
+		return nil, fmt.Errorf("Invalid Bearer Token: %s", err)
+	}
+	return identity.NewOperator(opId), nil
+}
@@ -0,0 +1,104 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package audit
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"magma/orc8r/cloud/go/obsidian/audit/models"
+	"magma/orc8r/cloud/go/obsidian/handlers"
+
+	"github.com/labstack/echo"
+)
+
+// GetObsidianHandlers returns the audit log REST handlers, served from the
+// given store
+func GetObsidianHandlers(store Store) []handlers.Handler {
+	return []handlers.Handler{
+		{Path: handlers.AUDIT_ROOT, Methods: handlers.GET, HandlerFunc: GetAuditLogHandler(store)},
+	}
+}
+
+// GetAuditLogHandler returns the handler of audit log queries, see
+// swagger/swagger.yml for supported query parameters
+func GetAuditLogHandler(store Store) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		filter, err := getFilter(c)
+		if err != nil {
+			return handlers.HttpError(err, http.StatusBadRequest)
+		}
+		records, nextPageToken, err := store.Query(filter)
+		if err != nil {
+			return handlers.HttpError(err, http.StatusInternalServerError)
+		}
+		ret := &models.AuditLog{
+			Records:       make([]*models.AuditRecord, 0, len(records)),
+			NextPageToken: nextPageToken,
+		}
+		for _, record := range records {
+			ret.Records = append(ret.Records, recordToModel(record))
+		}
+		return c.JSON(http.StatusOK, ret)
+	}
+}
+
+func getFilter(c echo.Context) (Filter, error) {
+	filter := Filter{
+		Operator:  c.QueryParam("operator"),
+		NetworkID: c.QueryParam("network_id"),
+		PageToken: c.QueryParam("page_token"),
+	}
+	var err error
+	if filter.StartTime, err = getTimeParam(c, "start"); err != nil {
+		return filter, err
+	}
+	if filter.EndTime, err = getTimeParam(c, "end"); err != nil {
+		return filter, err
+	}
+	if pageSize := c.QueryParam("page_size"); len(pageSize) > 0 {
+		size, err := strconv.ParseUint(pageSize, 10, 32)
+		if err != nil || size > MaxPageSize {
+			return filter, fmt.Errorf("Invalid page_size %s, must be between 0 and %d", pageSize, MaxPageSize)
+		}
+		filter.PageSize = uint32(size)
+	}
+	return filter, nil
+}
+
+// getTimeParam parses Unix epoch query parameter, returns zero time if the
+// parameter is missing
+func getTimeParam(c echo.Context, name string) (time.Time, error) {
+	param := c.QueryParam(name)
+	if len(param) == 0 {
+		return time.Time{}, nil
+	}
+	secs, err := strconv.ParseInt(param, 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("Invalid %s time %s, expected Unix epoch", name, param)
+	}
+	return time.Unix(secs, 0), nil
+}
+
+func recordToModel(record *Record) *models.AuditRecord {
+	return &models.AuditRecord{
+		ID:          record.ID,
+		Timestamp:   record.Timestamp.Unix(),
+		Operator:    record.Operator,
+		Method:      record.Method,
+		Path:        record.Path,
+		NetworkID:   record.NetworkID,
+		RequestHash: record.RequestHash,
+		RequestBody: record.RequestBody,
+		Status:      int64(record.Status),
+		Error:       record.Error,
+	}
+}
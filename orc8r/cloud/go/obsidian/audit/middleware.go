@@ -0,0 +1,182 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package audit
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"magma/orc8r/cloud/go/obsidian/access"
+
+	"github.com/golang/glog"
+	"github.com/labstack/echo"
+)
+
+// MaxRecordedBodySize is the maximum size of a request body stored in an
+// audit record, larger bodies are truncated (the record's hash always covers
+// the full body)
+const MaxRecordedBodySize = 64 * 1024
+
+// MaxAuditedBodySize is the maximum size of a request body buffered for
+// redaction. The rest of larger bodies is streamed to the handler, they're
+// not recorded & their hash only covers the part read by the handler.
+const MaxAuditedBodySize = 1024 * 1024
+
+// RedactedValue replaces the values of RedactedFields in recorded request bodies
+const RedactedValue = "REDACTED"
+
+// RedactedFields are the JSON fields of request bodies holding secrets (e.g.
+// subscriber keys), their values are never stored in audit records
+var RedactedFields = map[string]bool{
+	"auth_key":      true,
+	"auth_opc":      true,
+	"lte_auth_op":   true,
+	"password":      true,
+	"secret":        true,
+	"client_secret": true,
+	"private_key":   true,
+}
+
+// Middleware returns echo middleware which records every mutating (POST, PUT
+// & DELETE) REST call into the given store. The middleware should be used
+// before (outside of) the access middleware to also record denied requests.
+func Middleware(store Store) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+			if !isMutating(req.Method) {
+				return next(c)
+			}
+			record := &Record{
+				Timestamp: time.Now(),
+				Method:    req.Method,
+				Path:      req.URL.Path,
+			}
+			bodyHash := sha256.New()
+			if req.Body != nil {
+				body, err := ioutil.ReadAll(io.LimitReader(req.Body, MaxAuditedBodySize+1))
+				if err != nil {
+					glog.Error(access.LogDecorator(c)("Audit request body read error: %s", err))
+				}
+				bodyHash.Write(body)
+				if len(body) <= MaxAuditedBodySize {
+					req.Body = ioutil.NopCloser(bytes.NewReader(body))
+					record.RequestBody = recordedBody(body)
+				} else {
+					req.Body = readCloser{
+						Reader: io.MultiReader(bytes.NewReader(body), io.TeeReader(req.Body, bodyHash)),
+						Closer: req.Body,
+					}
+				}
+			}
+
+			if err := next(c); err != nil {
+				c.Error(err)
+				record.Error = errorMessage(err)
+			}
+			if req.Body != nil {
+				record.RequestHash = hex.EncodeToString(bodyHash.Sum(nil))
+			}
+
+			record.Status = c.Response().Status
+			record.NetworkID = c.Param("network_id")
+			record.Operator = requestOperatorID(c)
+			if err := store.Write(record); err != nil {
+				glog.Error(access.LogDecorator(c)("Audit record write error: %s", err))
+			}
+			return nil
+		}
+	}
+}
+
+// readCloser streams a request body which is too large to be buffered
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+// recordedBody returns the body to store in an audit record: JSON bodies with
+// the values of RedactedFields replaced, truncated to MaxRecordedBodySize.
+// Bodies which aren't JSON can't be redacted & are not stored.
+func recordedBody(body []byte) string {
+	var value interface{}
+	if err := json.Unmarshal(body, &value); err != nil {
+		return ""
+	}
+	if redact(value) {
+		redacted, err := json.Marshal(value)
+		if err != nil {
+			return ""
+		}
+		body = redacted
+	}
+	if len(body) > MaxRecordedBodySize {
+		body = body[:MaxRecordedBodySize]
+	}
+	return string(body)
+}
+
+// redact replaces the values of RedactedFields in the decoded JSON value and
+// returns whether any value was replaced
+func redact(value interface{}) bool {
+	redacted := false
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for field, fieldValue := range v {
+			if RedactedFields[field] {
+				v[field] = RedactedValue
+				redacted = true
+			} else if redact(fieldValue) {
+				redacted = true
+			}
+		}
+	case []interface{}:
+		for _, elem := range v {
+			if redact(elem) {
+				redacted = true
+			}
+		}
+	}
+	return redacted
+}
+
+func isMutating(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+func errorMessage(err error) string {
+	if httpErr, ok := err.(*echo.HTTPError); ok {
+		return fmt.Sprint(httpErr.Message)
+	}
+	return err.Error()
+}
+
+// requestOperatorID returns ID of the Operator identified by the access
+// middleware or, if the access middleware is not used (TLS mode), the CN of
+// the client certificate
+func requestOperatorID(c echo.Context) string {
+	if oper := access.ContextOperator(c); oper != nil {
+		return oper.GetOperator()
+	}
+	if req := c.Request(); req.TLS != nil && len(req.TLS.PeerCertificates) > 0 {
+		return req.TLS.PeerCertificates[0].Subject.CommonName
+	}
+	return ""
+}
@@ -0,0 +1,204 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package audit_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"magma/orc8r/cloud/go/identity"
+	"magma/orc8r/cloud/go/obsidian/access"
+	"magma/orc8r/cloud/go/obsidian/audit"
+	"magma/orc8r/cloud/go/obsidian/audit/models"
+	"magma/orc8r/cloud/go/obsidian/handlers"
+
+	"github.com/labstack/echo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMiddleware(t *testing.T) {
+	store := newTestStore(t)
+
+	e := echo.New()
+	e.Use(audit.Middleware(store))
+	// Mock access middleware
+	e.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if oper := c.Request().Header.Get("X-Test-Operator"); len(oper) > 0 {
+				c.Set(access.OPERATOR_CONTEXT_KEY, identity.NewOperator(oper))
+			}
+			if c.Request().Header.Get("X-Test-Deny") == "true" {
+				return echo.NewHTTPError(http.StatusForbidden, "Access Denied")
+			}
+			return next(c)
+		}
+	})
+	var handlerBody string
+	subscriberHandler := func(c echo.Context) error {
+		body, err := ioutil.ReadAll(c.Request().Body)
+		assert.NoError(t, err)
+		handlerBody = string(body)
+		return c.NoContent(http.StatusOK)
+	}
+	e.GET("/magma/networks/:network_id/subscribers/:subscriber_id", subscriberHandler)
+	e.PUT("/magma/networks/:network_id/subscribers/:subscriber_id", subscriberHandler)
+	e.POST("/magma/networks/:network_id/subscribers/:subscriber_id", func(c echo.Context) error {
+		return c.NoContent(http.StatusCreated)
+	})
+	e.DELETE("/magma/networks/:network_id/subscribers/:subscriber_id", func(c echo.Context) error {
+		return handlers.HttpError(errNotFound, http.StatusNotFound)
+	})
+	for _, handler := range audit.GetObsidianHandlers(store) {
+		e.GET(handler.Path, handler.HandlerFunc)
+	}
+
+	subscriberURL := "/magma/networks/net1/subscribers/IMSI001010000000001"
+	body := `{"id":"IMSI001010000000001","lte":{"state":"ACTIVE"},"apn":"internet"}`
+	assert.Equal(t, http.StatusOK, serve(e, "GET", subscriberURL, "", map[string]string{"X-Test-Operator": "op1"}))
+	assert.Equal(t, http.StatusOK, serve(e, "PUT", subscriberURL, body, map[string]string{"X-Test-Operator": "op1"}))
+	// Handler still gets the full body
+	assert.Equal(t, body, handlerBody)
+	assert.Equal(t, http.StatusForbidden, serve(e, "PUT", subscriberURL, body,
+		map[string]string{"X-Test-Operator": "op2", "X-Test-Deny": "true"}))
+	assert.Equal(t, http.StatusNotFound, serve(e, "DELETE", subscriberURL, "", nil))
+	largeBody := `{"apn":"` + strings.Repeat("x", audit.MaxRecordedBodySize) + `"}`
+	assert.Equal(t, http.StatusOK, serve(e, "PUT", subscriberURL, largeBody, map[string]string{"X-Test-Operator": "op1"}))
+	// secrets are redacted, non JSON bodies are not recorded
+	secretBody := `{"id":"IMSI001010000000001","lte":{"auth_key":"AAAAAAAAAAAAAAAAAAAAAA==","auth_opc":"AAAAAAAAAAAAAAAAAAAAAA=="}}`
+	assert.Equal(t, http.StatusCreated, serve(e, "POST", subscriberURL, secretBody, map[string]string{"X-Test-Operator": "op3"}))
+	assert.Equal(t, http.StatusCreated, serve(e, "POST", subscriberURL, "auth_key=secret", map[string]string{"X-Test-Operator": "op3"}))
+
+	records, _, err := store.Query(audit.Filter{})
+	assert.NoError(t, err)
+	// GETs are not recorded
+	assert.Len(t, records, 6)
+	for _, rec := range records {
+		assert.Equal(t, subscriberURL, rec.Path)
+		assert.Equal(t, "net1", rec.NetworkID)
+	}
+	byStatus := map[int]*audit.Record{}
+	var redacted, notJSON *audit.Record
+	for _, rec := range records {
+		byStatus[rec.Status] = rec
+		if rec.Status == http.StatusCreated && len(rec.RequestBody) > 0 {
+			redacted = rec
+		} else if rec.Status == http.StatusCreated {
+			notJSON = rec
+		}
+	}
+	if assert.NotNil(t, redacted) && assert.NotNil(t, notJSON) {
+		assert.Equal(t,
+			`{"id":"IMSI001010000000001","lte":{"auth_key":"REDACTED","auth_opc":"REDACTED"}}`,
+			redacted.RequestBody)
+		assert.Len(t, redacted.RequestHash, 64)
+		assert.Len(t, notJSON.RequestHash, 64)
+	}
+	assert.Equal(t, "op2", byStatus[http.StatusForbidden].Operator)
+	assert.Equal(t, "PUT", byStatus[http.StatusForbidden].Method)
+	assert.Equal(t, body, byStatus[http.StatusForbidden].RequestBody)
+	assert.Equal(t, "Access Denied", byStatus[http.StatusForbidden].Error)
+	assert.Len(t, byStatus[http.StatusForbidden].RequestHash, 64)
+	assert.Equal(t, "", byStatus[http.StatusNotFound].Operator)
+	assert.Equal(t, "DELETE", byStatus[http.StatusNotFound].Method)
+	assert.Equal(t, errNotFound.Error(), byStatus[http.StatusNotFound].Error)
+
+	var large, small *audit.Record
+	for _, rec := range records {
+		if rec.Status == http.StatusOK {
+			if len(rec.RequestBody) == audit.MaxRecordedBodySize {
+				large = rec
+			} else {
+				small = rec
+			}
+		}
+	}
+	assert.NotNil(t, large)
+	assert.NotNil(t, small)
+	assert.Equal(t, "op1", small.Operator)
+	assert.Equal(t, body, small.RequestBody)
+	assert.NotEqual(t, small.RequestHash, large.RequestHash)
+
+	// REST query
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest("GET", handlers.AUDIT_ROOT+"?operator=op1&page_size=1", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	auditLog := &models.AuditLog{}
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), auditLog))
+	assert.Len(t, auditLog.Records, 1)
+	assert.NotEmpty(t, auditLog.NextPageToken)
+	assert.Equal(t, "op1", auditLog.Records[0].Operator)
+
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest("GET",
+		handlers.AUDIT_ROOT+"?operator=op1&page_size=1&page_token="+auditLog.NextPageToken, nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	nextLog := &models.AuditLog{}
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), nextLog))
+	assert.Len(t, nextLog.Records, 1)
+	assert.Empty(t, nextLog.NextPageToken)
+	assert.NotEqual(t, auditLog.Records[0].ID, nextLog.Records[0].ID)
+
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest("GET", handlers.AUDIT_ROOT+"?network_id=net2", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), nextLog))
+	assert.Empty(t, nextLog.Records)
+
+	for _, query := range []string{"?start=yesterday", "?end=1.5", "?page_size=-1", "?page_size=5000"} {
+		rec = httptest.NewRecorder()
+		e.ServeHTTP(rec, httptest.NewRequest("GET", handlers.AUDIT_ROOT+query, nil))
+		assert.Equal(t, http.StatusBadRequest, rec.Code, query)
+	}
+}
+
+func TestMiddlewareLargeBody(t *testing.T) {
+	store := newTestStore(t)
+
+	e := echo.New()
+	e.Use(audit.Middleware(store))
+	var handlerBody string
+	e.PUT("/magma/networks/:network_id/subscribers/:subscriber_id", func(c echo.Context) error {
+		body, err := ioutil.ReadAll(c.Request().Body)
+		assert.NoError(t, err)
+		handlerBody = string(body)
+		return c.NoContent(http.StatusOK)
+	})
+
+	// Bodies too large to be buffered are streamed to the handler and not recorded
+	largeBody := `{"apn":"` + strings.Repeat("x", audit.MaxAuditedBodySize) + `"}`
+	assert.Equal(t, http.StatusOK, serve(e, "PUT", "/magma/networks/net1/subscribers/IMSI001010000000001", largeBody, nil))
+	assert.Equal(t, largeBody, handlerBody)
+
+	records, _, err := store.Query(audit.Filter{})
+	assert.NoError(t, err)
+	if assert.Len(t, records, 1) {
+		assert.Equal(t, "", records[0].RequestBody)
+		hash := sha256.Sum256([]byte(largeBody))
+		assert.Equal(t, hex.EncodeToString(hash[:]), records[0].RequestHash)
+	}
+}
+
+var errNotFound = errors.New("Subscriber not found")
+
+func serve(e *echo.Echo, method, url, body string, headers map[string]string) int {
+	req := httptest.NewRequest(method, url, strings.NewReader(body))
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	return rec.Code
+}
@@ -0,0 +1,216 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package audit
+
+import (
+	"database/sql"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"magma/orc8r/cloud/go/sql_utils"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+)
+
+const (
+	TableName = "obsidian_audit_log"
+
+	idCol          = "id"
+	timestampCol   = "ts"
+	operatorCol    = "operator"
+	methodCol      = "method"
+	pathCol        = "path"
+	networkCol     = "network_id"
+	requestHashCol = "request_hash"
+	requestBodyCol = "request_body"
+	statusCol      = "status"
+	errorCol       = "error"
+)
+
+type sqlStore struct {
+	db      *sql.DB
+	builder sql_utils.StatementBuilder
+}
+
+// NewSQLStore returns an audit Store backed by the given SQL DB, the audit
+// table is created if it doesn't exist yet
+func NewSQLStore(db *sql.DB, builder sql_utils.StatementBuilder) (Store, error) {
+	store := &sqlStore{db: db, builder: builder}
+	_, err := sql_utils.ExecInTx(db, initTable, func(*sql.Tx) (interface{}, error) { return nil, nil })
+	if err != nil {
+		return nil, fmt.Errorf("Failed to initialize audit table: %s", err)
+	}
+	return store, nil
+}
+
+func initTable(tx *sql.Tx) error {
+	queryFormat := `
+		CREATE TABLE IF NOT EXISTS %s
+		(
+			%s text PRIMARY KEY,
+			%s BIGINT NOT NULL,
+			%s text,
+			%s text NOT NULL,
+			%s text NOT NULL,
+			%s text,
+			%s text,
+			%s text,
+			%s INTEGER NOT NULL,
+			%s text
+		)
+	`
+	_, err := tx.Exec(fmt.Sprintf(
+		queryFormat, TableName,
+		idCol, timestampCol, operatorCol, methodCol, pathCol, networkCol,
+		requestHashCol, requestBodyCol, statusCol, errorCol,
+	))
+	return err
+}
+
+func (store *sqlStore) Write(record *Record) error {
+	if record == nil {
+		return fmt.Errorf("Nil audit record")
+	}
+	record.ID = uuid.New().String()
+	if record.Timestamp.IsZero() {
+		record.Timestamp = time.Now()
+	}
+	_, err := store.builder.Insert(TableName).
+		Columns(
+			idCol, timestampCol, operatorCol, methodCol, pathCol, networkCol,
+			requestHashCol, requestBodyCol, statusCol, errorCol,
+		).
+		Values(
+			record.ID, toMillis(record.Timestamp), record.Operator, record.Method, record.Path,
+			record.NetworkID, record.RequestHash, record.RequestBody, record.Status, record.Error,
+		).
+		RunWith(store.db).
+		Exec()
+	if err != nil {
+		return fmt.Errorf("Failed to write audit record: %s", err)
+	}
+	return nil
+}
+
+func (store *sqlStore) Query(filter Filter) ([]*Record, string, error) {
+	pageSize := filter.PageSize
+	if pageSize == 0 {
+		pageSize = DefaultPageSize
+	}
+	if pageSize > MaxPageSize {
+		pageSize = MaxPageSize
+	}
+
+	where := sq.And{}
+	if len(filter.Operator) > 0 {
+		where = append(where, sq.Eq{operatorCol: filter.Operator})
+	}
+	if len(filter.NetworkID) > 0 {
+		where = append(where, sq.Eq{networkCol: filter.NetworkID})
+	}
+	if !filter.StartTime.IsZero() {
+		where = append(where, sq.GtOrEq{timestampCol: toMillis(filter.StartTime)})
+	}
+	if !filter.EndTime.IsZero() {
+		where = append(where, sq.Lt{timestampCol: toMillis(filter.EndTime)})
+	}
+	if len(filter.PageToken) > 0 {
+		ts, id, err := decodePageToken(filter.PageToken)
+		if err != nil {
+			return nil, "", err
+		}
+		// Records are sorted by (timestamp, ID) descending, continue after
+		// the last record of the previous page
+		where = append(where, sq.Or{
+			sq.Lt{timestampCol: ts},
+			sq.And{sq.Eq{timestampCol: ts}, sq.Lt{idCol: id}},
+		})
+	}
+
+	// Select one extra record to find out if there is a next page
+	rows, err := store.builder.
+		Select(
+			idCol, timestampCol, operatorCol, methodCol, pathCol, networkCol,
+			requestHashCol, requestBodyCol, statusCol, errorCol,
+		).
+		From(TableName).
+		Where(where).
+		OrderBy(timestampCol+" DESC", idCol+" DESC").
+		Limit(uint64(pageSize) + 1).
+		RunWith(store.db).
+		Query()
+	if err != nil {
+		return nil, "", fmt.Errorf("Failed to query audit records: %s", err)
+	}
+	defer sql_utils.CloseRowsLogOnError(rows, "Query")
+
+	records := []*Record{}
+	for rows.Next() {
+		record := &Record{}
+		var ts int64
+		var operator, networkID, requestHash, requestBody, errMsg sql.NullString
+		err = rows.Scan(
+			&record.ID, &ts, &operator, &record.Method, &record.Path, &networkID,
+			&requestHash, &requestBody, &record.Status, &errMsg,
+		)
+		if err != nil {
+			return nil, "", fmt.Errorf("Failed to scan audit record: %s", err)
+		}
+		record.Timestamp = fromMillis(ts)
+		record.Operator = operator.String
+		record.NetworkID = networkID.String
+		record.RequestHash = requestHash.String
+		record.RequestBody = requestBody.String
+		record.Error = errMsg.String
+		records = append(records, record)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, "", fmt.Errorf("Failed to read audit records: %s", err)
+	}
+
+	nextPageToken := ""
+	if len(records) > int(pageSize) {
+		records = records[:pageSize]
+		last := records[len(records)-1]
+		nextPageToken = encodePageToken(toMillis(last.Timestamp), last.ID)
+	}
+	return records, nextPageToken, nil
+}
+
+func encodePageToken(ts int64, id string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf("%d:%s", ts, id)))
+}
+
+func decodePageToken(token string) (int64, string, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, "", fmt.Errorf("Invalid page token: %s", err)
+	}
+	parts := strings.SplitN(string(decoded), ":", 2)
+	if len(parts) != 2 {
+		return 0, "", fmt.Errorf("Invalid page token: %s", token)
+	}
+	ts, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return 0, "", fmt.Errorf("Invalid page token: %s", token)
+	}
+	return ts, parts[1], nil
+}
+
+func toMillis(t time.Time) int64 {
+	return t.UnixNano() / int64(time.Millisecond)
+}
+
+func fromMillis(ms int64) time.Time {
+	return time.Unix(0, ms*int64(time.Millisecond))
+}
@@ -0,0 +1,120 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package audit_test
+
+import (
+	"testing"
+	"time"
+
+	"magma/orc8r/cloud/go/obsidian/audit"
+	"magma/orc8r/cloud/go/sql_utils"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestStore(t *testing.T) audit.Store {
+	db, err := sql_utils.Open("sqlite3", ":memory:")
+	assert.NoError(t, err)
+	store, err := audit.NewSQLStore(db, sql_utils.GetSqlBuilder())
+	assert.NoError(t, err)
+	return store
+}
+
+func TestSQLStore(t *testing.T) {
+	store := newTestStore(t)
+
+	records, token, err := store.Query(audit.Filter{})
+	assert.NoError(t, err)
+	assert.Empty(t, records)
+	assert.Empty(t, token)
+
+	start := time.Unix(1556841600, 0)
+	for i := 0; i < 5; i++ {
+		rec := &audit.Record{
+			Timestamp:   start.Add(time.Duration(i) * time.Minute),
+			Operator:    "op1",
+			Method:      "PUT",
+			Path:        "/magma/networks/net1/subscribers/IMSI001010000000001",
+			NetworkID:   "net1",
+			RequestHash: "hash",
+			RequestBody: `{"apn":"internet"}`,
+			Status:      200,
+		}
+		if i%2 == 1 {
+			rec.Operator = "op2"
+			rec.NetworkID = "net2"
+			rec.Status = 403
+			rec.Error = "Access Denied"
+		}
+		assert.NoError(t, store.Write(rec))
+		assert.NotEmpty(t, rec.ID)
+	}
+	// Records with the same timestamp
+	for i := 0; i < 3; i++ {
+		assert.NoError(t, store.Write(&audit.Record{
+			Timestamp: start.Add(time.Hour), Operator: "admin", Method: "DELETE", Path: "/magma/networks/net3", Status: 204,
+		}))
+	}
+
+	// All records, newest first
+	records, token, err = store.Query(audit.Filter{})
+	assert.NoError(t, err)
+	assert.Empty(t, token)
+	assert.Len(t, records, 8)
+	for i := 1; i < len(records); i++ {
+		assert.False(t, records[i].Timestamp.After(records[i-1].Timestamp))
+	}
+	assert.Equal(t, "admin", records[0].Operator)
+	last := records[7]
+	assert.Equal(t, start.Unix(), last.Timestamp.Unix())
+	assert.Equal(t, "op1", last.Operator)
+	assert.Equal(t, "PUT", last.Method)
+	assert.Equal(t, "/magma/networks/net1/subscribers/IMSI001010000000001", last.Path)
+	assert.Equal(t, "net1", last.NetworkID)
+	assert.Equal(t, "hash", last.RequestHash)
+	assert.Equal(t, `{"apn":"internet"}`, last.RequestBody)
+	assert.Equal(t, 200, last.Status)
+	assert.Empty(t, last.Error)
+
+	// Pagination
+	var paged []*audit.Record
+	token = ""
+	for {
+		var page []*audit.Record
+		page, token, err = store.Query(audit.Filter{PageSize: 3, PageToken: token})
+		assert.NoError(t, err)
+		assert.True(t, len(page) <= 3)
+		paged = append(paged, page...)
+		if len(token) == 0 {
+			break
+		}
+	}
+	assert.Equal(t, records, paged)
+	_, _, err = store.Query(audit.Filter{PageToken: "garbage"})
+	assert.Error(t, err)
+
+	// Filters
+	records, _, err = store.Query(audit.Filter{Operator: "op2"})
+	assert.NoError(t, err)
+	assert.Len(t, records, 2)
+	assert.Equal(t, "Access Denied", records[0].Error)
+	records, _, err = store.Query(audit.Filter{NetworkID: "net1"})
+	assert.NoError(t, err)
+	assert.Len(t, records, 3)
+	records, _, err = store.Query(audit.Filter{
+		StartTime: start.Add(time.Minute),
+		EndTime:   start.Add(4 * time.Minute),
+	})
+	assert.NoError(t, err)
+	assert.Len(t, records, 3)
+	records, _, err = store.Query(audit.Filter{Operator: "op1", StartTime: start.Add(time.Minute)})
+	assert.NoError(t, err)
+	assert.Len(t, records, 2)
+}
@@ -0,0 +1,72 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+// Package audit implements a durable audit log of all mutating (POST, PUT &
+// DELETE) REST calls served by obsidian: an echo middleware recording the
+// calls, a SQL backed store of the records & a REST handler querying them
+package audit
+
+import (
+	"time"
+)
+
+const (
+	// DefaultPageSize is the number of records returned by a query which
+	// doesn't specify a page size
+	DefaultPageSize = 100
+	// MaxPageSize is the maximum number of records returned by a single query
+	MaxPageSize = 1000
+)
+
+// Record is a single audit log entry
+type Record struct {
+	ID        string
+	Timestamp time.Time
+	// Operator is the ID of the request's operator, empty if the operator
+	// could not be identified
+	Operator  string
+	Method    string
+	Path      string
+	NetworkID string
+	// RequestHash is the hex encoded SHA-256 of the full request body
+	RequestHash string
+	// RequestBody is the JSON request body with secrets redacted, truncated to
+	// MaxRecordedBodySize
+	RequestBody string
+	Status      int
+	Error       string
+}
+
+// Filter selects records returned by Store.Query. Empty fields match all
+// records
+type Filter struct {
+	Operator  string
+	NetworkID string
+	// StartTime is inclusive, EndTime is exclusive
+	StartTime time.Time
+	EndTime   time.Time
+
+	// PageSize is the maximum number of records to return, DefaultPageSize
+	// is used if 0
+	PageSize uint32
+	// PageToken is the token of the page to return, as returned by a
+	// previous Query. Empty for the first page
+	PageToken string
+}
+
+// Store is the durable storage of audit records
+type Store interface {
+	// Write appends the record to the audit log, Record's ID is assigned by
+	// the store
+	Write(record *Record) error
+
+	// Query returns a page of records matching the filter, newest first &
+	// the token of the next page. The returned token is empty if there are
+	// no more records
+	Query(filter Filter) ([]*Record, string, error)
+}
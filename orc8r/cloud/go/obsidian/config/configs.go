@@ -21,4 +21,5 @@ const (
 	DefaultStaticFolder  = "/var/opt/magma/static"
 	StaticURLPrefix      = "/apidocs"
 	ServiceName          = "OBSIDIAN"
+	DefaultOperatorClaim = "sub"
 )
@@ -21,3 +21,19 @@ var (
 	AllowAnyClientCert bool
 	StaticFolder       string
 )
+
+// OIDC bearer token authentication settings, tokens are not accepted if
+// OIDCIssuer is empty
+var (
+	OIDCIssuer        string
+	OIDCClientID      string
+	OIDCJWKSURL       string
+	OIDCOperatorClaim string
+)
+
+// Audit log settings, mutating REST calls are not recorded if AuditLog is false
+var (
+	AuditLog      bool
+	AuditDBDriver string
+	AuditDBSource string
+)
@@ -56,6 +56,8 @@ const (
 	MAGMA_CHANNELS_URL_PART   = "channels"
 	MAGMA_PROMETHEUS_URL_PART = "prometheus"
 	MAGMA_GRAPHITE_URL_PART   = "graphite"
+	MAGMA_ROLES_URL_PART      = "roles"
+	MAGMA_AUDIT_URL_PART      = "audit"
 	// "/magma"
 	REST_ROOT = URL_SEP + MAGMA_URL_ROOT
 	// "/magma/networks"
@@ -64,6 +66,10 @@ const (
 	OPERATORS_ROOT = REST_ROOT + URL_SEP + MAGMA_OPERATORS_URL_PART
 	// "/magma/channels"
 	CHANNELS_ROOT = REST_ROOT + URL_SEP + MAGMA_CHANNELS_URL_PART
+	// "/magma/roles"
+	ROLES_ROOT = REST_ROOT + URL_SEP + MAGMA_ROLES_URL_PART
+	// "/magma/audit"
+	AUDIT_ROOT = REST_ROOT + URL_SEP + MAGMA_AUDIT_URL_PART
 	// "/magma/network/{network_id}/prometheus
 	PROMETHEUS_ROOT = REST_ROOT + URL_SEP + "networks" + URL_SEP + ":network_id" + URL_SEP + MAGMA_PROMETHEUS_URL_PART
 	// "/magma/network/{network_id}/graphite
@@ -51,6 +51,33 @@ func main() {
 		"Folder containing the static files served",
 	)
 
+	// OIDC bearer token settings
+	flag.StringVar(
+		&config.OIDCIssuer, "oidc_issuer",
+		datastore.GetEnvWithDefault("OIDC_ISSUER", ""),
+		"OIDC issuer URL, enables bearer token authentication of operators",
+	)
+	flag.StringVar(
+		&config.OIDCClientID, "oidc_client_id",
+		datastore.GetEnvWithDefault("OIDC_CLIENT_ID", ""),
+		"OIDC client ID, required audience of bearer tokens if not empty",
+	)
+	flag.StringVar(
+		&config.OIDCJWKSURL, "oidc_jwks_url",
+		datastore.GetEnvWithDefault("OIDC_JWKS_URL", ""),
+		"OIDC issuer's JWKS URL (discovered from the issuer if empty)",
+	)
+	flag.StringVar(
+		&config.OIDCOperatorClaim, "oidc_operator_claim",
+		datastore.GetEnvWithDefault("OIDC_OPERATOR_CLAIM", config.DefaultOperatorClaim),
+		"Bearer token claim of the Operator ID",
+	)
+
+	// Audit log settings
+	flag.BoolVar(&config.AuditLog, "audit_log", true, "Record all mutating REST calls in the audit log")
+	flag.StringVar(&config.AuditDBDriver, "audit_db_driver", datastore.SQL_DRIVER, "Audit Log DB Driver")
+	flag.StringVar(&config.AuditDBSource, "audit_db_source", datastore.DATABASE_SOURCE, "Audit Log DB Source")
+
 	srv, err := service.NewOrchestratorService(orc8r.ModuleName, config.ServiceName)
 	if err != nil {
 		log.Fatalf("Error creating service: %s", err)
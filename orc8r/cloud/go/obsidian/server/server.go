@@ -19,17 +19,40 @@ import (
 	"github.com/labstack/echo"
 
 	"magma/orc8r/cloud/go/obsidian/access"
+	"magma/orc8r/cloud/go/obsidian/audit"
 	"magma/orc8r/cloud/go/obsidian/config"
 	"magma/orc8r/cloud/go/obsidian/handlers"
 	"magma/orc8r/cloud/go/obsidian/metrics"
+	"magma/orc8r/cloud/go/security/oidc"
+	"magma/orc8r/cloud/go/sql_utils"
 )
 
 func Start() {
 	e := echo.New()
 
+	var auditStore audit.Store
+	if config.AuditLog {
+		db, err := sql_utils.Open(config.AuditDBDriver, config.AuditDBSource)
+		if err != nil {
+			log.Fatalf("ERROR opening audit log DB: %s", err)
+		}
+		auditStore, err = audit.NewSQLStore(db, sql_utils.GetSqlBuilder())
+		if err != nil {
+			log.Fatalf("ERROR creating audit log store: %s", err)
+		}
+		if err := handlers.RegisterAll(audit.GetObsidianHandlers(auditStore)); err != nil {
+			log.Fatalf("ERROR registering audit log handlers: %s", err)
+		}
+	}
+
 	handlers.AttachAll(e)
 	// metrics middleware is used before all other middlewares
 	e.Use(metrics.CollectStats)
+	// audit middleware is used before access middleware to record denied
+	// requests as well
+	if auditStore != nil {
+		e.Use(audit.Middleware(auditStore))
+	}
 	// Serve static pages for the API docs
 	e.Static(config.StaticURLPrefix, config.StaticFolder+"/apidocs")
 	e.Static(config.StaticURLPrefix+"/swagger-ui/dist",
@@ -99,6 +122,17 @@ func Start() {
 		}
 		err = e.StartServer(e.TLSServer)
 	} else {
+		if len(config.OIDCIssuer) > 0 {
+			if len(config.OIDCClientID) == 0 {
+				log.Fatalf("ERROR OIDC client ID (OIDC_CLIENT_ID) is required with OIDC issuer '%s'", config.OIDCIssuer)
+			}
+			verifier, err := oidc.NewVerifier(config.OIDCIssuer, config.OIDCClientID, config.OIDCJWKSURL)
+			if err != nil {
+				log.Fatalf("ERROR creating OIDC token verifier: %s", err)
+			}
+			access.SetTokenVerifier(verifier, config.OIDCOperatorClaim)
+			log.Printf("Accepting OIDC bearer tokens of '%s'", config.OIDCIssuer)
+		}
 		e.Use(access.Middleware)
 		err = e.Start(portStr)
 	}
@@ -29,24 +29,28 @@ const (
 	ChallengeKey_ECHO                  ChallengeKey_KeyType = 0
 	ChallengeKey_SOFTWARE_RSA_SHA256   ChallengeKey_KeyType = 1
 	ChallengeKey_SOFTWARE_ECDSA_SHA256 ChallengeKey_KeyType = 2
+	// TPM 2.0 attestation key (AK), the challenge is signed in a TPM2_Quote
+	ChallengeKey_TPM2_ATTESTATION_KEY ChallengeKey_KeyType = 3
 )
 
 var ChallengeKey_KeyType_name = map[int32]string{
 	0: "ECHO",
 	1: "SOFTWARE_RSA_SHA256",
 	2: "SOFTWARE_ECDSA_SHA256",
+	3: "TPM2_ATTESTATION_KEY",
 }
 var ChallengeKey_KeyType_value = map[string]int32{
 	"ECHO":                  0,
 	"SOFTWARE_RSA_SHA256":   1,
 	"SOFTWARE_ECDSA_SHA256": 2,
+	"TPM2_ATTESTATION_KEY":  3,
 }
 
 func (x ChallengeKey_KeyType) String() string {
 	return proto.EnumName(ChallengeKey_KeyType_name, int32(x))
 }
 func (ChallengeKey_KeyType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_bootstrapper_6370f262c5f2dbf0, []int{1, 0}
+	return fileDescriptor_bootstrapper_31448f15cdea3d39, []int{1, 0}
 }
 
 type Challenge struct {
@@ -61,7 +65,7 @@ func (m *Challenge) Reset()         { *m = Challenge{} }
 func (m *Challenge) String() string { return proto.CompactTextString(m) }
 func (*Challenge) ProtoMessage()    {}
 func (*Challenge) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bootstrapper_6370f262c5f2dbf0, []int{0}
+	return fileDescriptor_bootstrapper_31448f15cdea3d39, []int{0}
 }
 func (m *Challenge) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Challenge.Unmarshal(m, b)
@@ -100,8 +104,12 @@ func (m *Challenge) GetChallenge() []byte {
 // --------------------------------------------------------------------------
 type ChallengeKey struct {
 	KeyType ChallengeKey_KeyType `protobuf:"varint,1,opt,name=key_type,json=keyType,proto3,enum=magma.orc8r.ChallengeKey_KeyType" json:"key_type,omitempty"`
-	// Public key encoded in DER format
-	Key                  []byte   `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// Public key encoded in DER format, DER encoded AK certificate for
+	// TPM2_ATTESTATION_KEY
+	Key []byte `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// DER encoded intermediate certificates chaining the AK certificate to a
+	// trusted TPM root (e.g. the EK certificate issuer chain)
+	CertificateChain     [][]byte `protobuf:"bytes,3,rep,name=certificate_chain,json=certificateChain,proto3" json:"certificate_chain,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -111,7 +119,7 @@ func (m *ChallengeKey) Reset()         { *m = ChallengeKey{} }
 func (m *ChallengeKey) String() string { return proto.CompactTextString(m) }
 func (*ChallengeKey) ProtoMessage()    {}
 func (*ChallengeKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bootstrapper_6370f262c5f2dbf0, []int{1}
+	return fileDescriptor_bootstrapper_31448f15cdea3d39, []int{1}
 }
 func (m *ChallengeKey) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChallengeKey.Unmarshal(m, b)
@@ -145,6 +153,13 @@ func (m *ChallengeKey) GetKey() []byte {
 	return nil
 }
 
+func (m *ChallengeKey) GetCertificateChain() [][]byte {
+	if m != nil {
+		return m.CertificateChain
+	}
+	return nil
+}
+
 type Response struct {
 	HwId      *AccessGatewayID `protobuf:"bytes,1,opt,name=hw_id,json=hwId,proto3" json:"hw_id,omitempty"`
 	Challenge []byte           `protobuf:"bytes,2,opt,name=challenge,proto3" json:"challenge,omitempty"`
@@ -152,6 +167,7 @@ type Response struct {
 	//	*Response_EchoResponse
 	//	*Response_RsaResponse
 	//	*Response_EcdsaResponse
+	//	*Response_TpmResponse
 	Response             isResponse_Response `protobuf_oneof:"response"`
 	Csr                  *CSR                `protobuf:"bytes,6,opt,name=csr,proto3" json:"csr,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
@@ -163,7 +179,7 @@ func (m *Response) Reset()         { *m = Response{} }
 func (m *Response) String() string { return proto.CompactTextString(m) }
 func (*Response) ProtoMessage()    {}
 func (*Response) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bootstrapper_6370f262c5f2dbf0, []int{2}
+	return fileDescriptor_bootstrapper_31448f15cdea3d39, []int{2}
 }
 func (m *Response) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Response.Unmarshal(m, b)
@@ -213,12 +229,18 @@ type Response_EcdsaResponse struct {
 	EcdsaResponse *Response_ECDSA `protobuf:"bytes,5,opt,name=ecdsa_response,json=ecdsaResponse,proto3,oneof"`
 }
 
+type Response_TpmResponse struct {
+	TpmResponse *Response_TPM `protobuf:"bytes,7,opt,name=tpm_response,json=tpmResponse,proto3,oneof"`
+}
+
 func (*Response_EchoResponse) isResponse_Response() {}
 
 func (*Response_RsaResponse) isResponse_Response() {}
 
 func (*Response_EcdsaResponse) isResponse_Response() {}
 
+func (*Response_TpmResponse) isResponse_Response() {}
+
 func (m *Response) GetResponse() isResponse_Response {
 	if m != nil {
 		return m.Response
@@ -247,6 +269,13 @@ func (m *Response) GetEcdsaResponse() *Response_ECDSA {
 	return nil
 }
 
+func (m *Response) GetTpmResponse() *Response_TPM {
+	if x, ok := m.GetResponse().(*Response_TpmResponse); ok {
+		return x.TpmResponse
+	}
+	return nil
+}
+
 func (m *Response) GetCsr() *CSR {
 	if m != nil {
 		return m.Csr
@@ -260,6 +289,7 @@ func (*Response) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) erro
 		(*Response_EchoResponse)(nil),
 		(*Response_RsaResponse)(nil),
 		(*Response_EcdsaResponse)(nil),
+		(*Response_TpmResponse)(nil),
 	}
 }
 
@@ -282,6 +312,11 @@ func _Response_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.EcdsaResponse); err != nil {
 			return err
 		}
+	case *Response_TpmResponse:
+		b.EncodeVarint(7<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.TpmResponse); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("Response.Response has unexpected type %T", x)
@@ -316,6 +351,14 @@ func _Response_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffe
 		err := b.DecodeMessage(msg)
 		m.Response = &Response_EcdsaResponse{msg}
 		return true, err
+	case 7: // response.tpm_response
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Response_TPM)
+		err := b.DecodeMessage(msg)
+		m.Response = &Response_TpmResponse{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -340,6 +383,11 @@ func _Response_OneofSizer(msg proto.Message) (n int) {
 		n += 1 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *Response_TpmResponse:
+		s := proto.Size(x.TpmResponse)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -358,7 +406,7 @@ func (m *Response_Echo) Reset()         { *m = Response_Echo{} }
 func (m *Response_Echo) String() string { return proto.CompactTextString(m) }
 func (*Response_Echo) ProtoMessage()    {}
 func (*Response_Echo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bootstrapper_6370f262c5f2dbf0, []int{2, 0}
+	return fileDescriptor_bootstrapper_31448f15cdea3d39, []int{2, 0}
 }
 func (m *Response_Echo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Response_Echo.Unmarshal(m, b)
@@ -396,7 +444,7 @@ func (m *Response_RSA) Reset()         { *m = Response_RSA{} }
 func (m *Response_RSA) String() string { return proto.CompactTextString(m) }
 func (*Response_RSA) ProtoMessage()    {}
 func (*Response_RSA) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bootstrapper_6370f262c5f2dbf0, []int{2, 1}
+	return fileDescriptor_bootstrapper_31448f15cdea3d39, []int{2, 1}
 }
 func (m *Response_RSA) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Response_RSA.Unmarshal(m, b)
@@ -435,7 +483,7 @@ func (m *Response_ECDSA) Reset()         { *m = Response_ECDSA{} }
 func (m *Response_ECDSA) String() string { return proto.CompactTextString(m) }
 func (*Response_ECDSA) ProtoMessage()    {}
 func (*Response_ECDSA) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bootstrapper_6370f262c5f2dbf0, []int{2, 2}
+	return fileDescriptor_bootstrapper_31448f15cdea3d39, []int{2, 2}
 }
 func (m *Response_ECDSA) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Response_ECDSA.Unmarshal(m, b)
@@ -469,6 +517,55 @@ func (m *Response_ECDSA) GetS() []byte {
 	return nil
 }
 
+type Response_TPM struct {
+	// Marshaled TPMS_ATTEST of a TPM2_Quote with the SHA256 digest of the
+	// challenge as qualifying data
+	Attest []byte `protobuf:"bytes,1,opt,name=attest,proto3" json:"attest,omitempty"`
+	// Marshaled TPMT_SIGNATURE of attest by the attestation key
+	Signature            []byte   `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Response_TPM) Reset()         { *m = Response_TPM{} }
+func (m *Response_TPM) String() string { return proto.CompactTextString(m) }
+func (*Response_TPM) ProtoMessage()    {}
+func (*Response_TPM) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bootstrapper_31448f15cdea3d39, []int{2, 3}
+}
+func (m *Response_TPM) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Response_TPM.Unmarshal(m, b)
+}
+func (m *Response_TPM) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Response_TPM.Marshal(b, m, deterministic)
+}
+func (dst *Response_TPM) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Response_TPM.Merge(dst, src)
+}
+func (m *Response_TPM) XXX_Size() int {
+	return xxx_messageInfo_Response_TPM.Size(m)
+}
+func (m *Response_TPM) XXX_DiscardUnknown() {
+	xxx_messageInfo_Response_TPM.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Response_TPM proto.InternalMessageInfo
+
+func (m *Response_TPM) GetAttest() []byte {
+	if m != nil {
+		return m.Attest
+	}
+	return nil
+}
+
+func (m *Response_TPM) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Challenge)(nil), "magma.orc8r.Challenge")
 	proto.RegisterType((*ChallengeKey)(nil), "magma.orc8r.ChallengeKey")
@@ -476,6 +573,7 @@ func init() {
 	proto.RegisterType((*Response_Echo)(nil), "magma.orc8r.Response.Echo")
 	proto.RegisterType((*Response_RSA)(nil), "magma.orc8r.Response.RSA")
 	proto.RegisterType((*Response_ECDSA)(nil), "magma.orc8r.Response.ECDSA")
+	proto.RegisterType((*Response_TPM)(nil), "magma.orc8r.Response.TPM")
 	proto.RegisterEnum("magma.orc8r.ChallengeKey_KeyType", ChallengeKey_KeyType_name, ChallengeKey_KeyType_value)
 }
 
@@ -591,41 +689,46 @@ var _Bootstrapper_serviceDesc = grpc.ServiceDesc{
 }
 
 func init() {
-	proto.RegisterFile("orc8r/protos/bootstrapper.proto", fileDescriptor_bootstrapper_6370f262c5f2dbf0)
-}
-
-var fileDescriptor_bootstrapper_6370f262c5f2dbf0 = []byte{
-	// 509 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0x5d, 0x6f, 0xda, 0x30,
-	0x14, 0x4d, 0x0a, 0x6d, 0xe9, 0x25, 0xad, 0x90, 0xa7, 0x6e, 0x34, 0x30, 0xad, 0x4b, 0x5f, 0xfa,
-	0x14, 0x34, 0xa6, 0x4d, 0x7b, 0x98, 0xa6, 0x85, 0x8f, 0x42, 0xd5, 0x87, 0x4a, 0x4e, 0xa5, 0x49,
-	0x7b, 0x41, 0xa9, 0xb9, 0x0b, 0x11, 0x14, 0x67, 0xb6, 0x11, 0xca, 0x3f, 0xd9, 0x3f, 0xd8, 0xff,
-	0xd8, 0x2f, 0x9b, 0x62, 0x42, 0x42, 0x24, 0xd6, 0x97, 0x3d, 0xc5, 0xf6, 0x39, 0xf7, 0x9c, 0x9b,
-	0x63, 0x5f, 0x78, 0xc3, 0x05, 0xfb, 0x24, 0x3a, 0xb1, 0xe0, 0x8a, 0xcb, 0xce, 0x23, 0xe7, 0x4a,
-	0x2a, 0x11, 0xc4, 0x31, 0x0a, 0x57, 0x9f, 0x91, 0xfa, 0x53, 0x10, 0x3e, 0x05, 0xae, 0xa6, 0xd9,
-	0xed, 0x12, 0x9b, 0xa1, 0x50, 0xd1, 0x8f, 0x68, 0x4b, 0xb5, 0x5b, 0x25, 0x34, 0x9a, 0xe2, 0x52,
-	0x45, 0x2a, 0xd9, 0x80, 0x4e, 0x08, 0x27, 0xfd, 0x59, 0xb0, 0x58, 0xe0, 0x32, 0x44, 0xf2, 0x19,
-	0x6a, 0x73, 0x4c, 0x26, 0x2a, 0x89, 0xb1, 0x69, 0x5e, 0x9a, 0xd7, 0x67, 0xdd, 0xb7, 0xee, 0x8e,
-	0x8f, 0x9b, 0x33, 0xef, 0x30, 0x71, 0xef, 0x30, 0x79, 0x48, 0x62, 0xa4, 0xc7, 0xf3, 0xcd, 0x82,
-	0xb4, 0xe1, 0x84, 0x6d, 0x09, 0xcd, 0x83, 0x4b, 0xf3, 0xda, 0xa2, 0xc5, 0x81, 0xf3, 0xdb, 0x04,
-	0x6b, 0xb7, 0xfe, 0x3f, 0xcd, 0x1a, 0x50, 0x99, 0x63, 0x92, 0xd9, 0xa4, 0x4b, 0x67, 0x04, 0xc7,
-	0x19, 0x8b, 0xd4, 0xa0, 0x3a, 0xec, 0x8f, 0xef, 0x1b, 0x06, 0x79, 0x05, 0x2f, 0xfc, 0xfb, 0x9b,
-	0x87, 0x6f, 0x1e, 0x1d, 0x4e, 0xa8, 0xef, 0x4d, 0xfc, 0xb1, 0xd7, 0xfd, 0xf0, 0xb1, 0x61, 0x92,
-	0x0b, 0x38, 0xcf, 0x81, 0x61, 0x7f, 0x50, 0x40, 0x07, 0xce, 0x9f, 0x0a, 0xd4, 0x28, 0xca, 0x98,
-	0x2f, 0x25, 0x92, 0x77, 0x70, 0x38, 0x5b, 0x4f, 0xa2, 0xa9, 0x6e, 0xb1, 0xde, 0x6d, 0x97, 0x5a,
-	0xf4, 0x18, 0x43, 0x29, 0x47, 0x81, 0xc2, 0x75, 0x90, 0xdc, 0x0e, 0x68, 0x75, 0xb6, 0xbe, 0x9d,
-	0x3e, 0x9f, 0x03, 0xf1, 0xe0, 0x14, 0xd9, 0x8c, 0x4f, 0x44, 0xe6, 0xd0, 0xac, 0x68, 0x61, 0xbb,
-	0x24, 0xbc, 0xb5, 0x77, 0x87, 0x6c, 0xc6, 0xc7, 0x06, 0xb5, 0xd2, 0x92, 0xbc, 0xa7, 0x2f, 0x60,
-	0x09, 0x19, 0x14, 0x0a, 0x55, 0xad, 0x70, 0xb1, 0x5f, 0x81, 0xfa, 0xde, 0xd8, 0xa0, 0x75, 0x21,
-	0x83, 0xbc, 0x7e, 0x00, 0x67, 0xc8, 0xa6, 0xbb, 0x0a, 0x87, 0x5a, 0xa1, 0xf5, 0x8f, 0x1e, 0xd2,
-	0x78, 0xc6, 0x06, 0x3d, 0xd5, 0x45, 0xb9, 0x8a, 0x03, 0x15, 0x26, 0x45, 0xf3, 0x48, 0x97, 0x36,
-	0xca, 0x57, 0xe7, 0x53, 0x9a, 0x82, 0xb6, 0x03, 0xd5, 0xf4, 0x0f, 0x88, 0x0d, 0xb5, 0xdc, 0xcb,
-	0xd4, 0x89, 0xe4, 0x7b, 0xfb, 0x0a, 0x2a, 0xd4, 0xf7, 0xd2, 0xd4, 0x64, 0x14, 0x2e, 0x03, 0xb5,
-	0x12, 0x5b, 0x4e, 0x71, 0x60, 0x5f, 0xc1, 0xa1, 0x6e, 0x83, 0x58, 0x60, 0x8a, 0x0c, 0x36, 0x45,
-	0xba, 0x93, 0x59, 0xc4, 0xa6, 0xec, 0x41, 0xe1, 0xd2, 0xfd, 0x65, 0x82, 0xd5, 0xdb, 0x19, 0x1b,
-	0x72, 0x03, 0xd6, 0x08, 0x55, 0xf1, 0xd6, 0x9f, 0xbd, 0x49, 0xfb, 0xe5, 0xfe, 0xa7, 0xe8, 0x18,
-	0xe4, 0x2b, 0xd4, 0x29, 0xfe, 0x5c, 0xa1, 0x54, 0x7e, 0x14, 0x2e, 0xc9, 0xf9, 0xde, 0xcc, 0xec,
-	0x66, 0xb9, 0x7e, 0x33, 0x91, 0x2c, 0x50, 0xe8, 0x18, 0xbd, 0xd7, 0xdf, 0x5b, 0x1a, 0xec, 0x6c,
-	0xe6, 0x92, 0x2d, 0xf8, 0x6a, 0xda, 0x09, 0x79, 0x36, 0xa0, 0x8f, 0x47, 0xfa, 0xfb, 0xfe, 0x6f,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x97, 0xaa, 0x0d, 0x91, 0x03, 0x04, 0x00, 0x00,
+	proto.RegisterFile("orc8r/protos/bootstrapper.proto", fileDescriptor_bootstrapper_31448f15cdea3d39)
+}
+
+var fileDescriptor_bootstrapper_31448f15cdea3d39 = []byte{
+	// 591 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xdd, 0x4e, 0xd4, 0x40,
+	0x14, 0xde, 0xd2, 0x05, 0x96, 0xb3, 0x85, 0xac, 0xa3, 0x60, 0x29, 0x18, 0xb1, 0xdc, 0x90, 0x98,
+	0xec, 0xc6, 0x35, 0x1a, 0x13, 0x8d, 0xb1, 0x2c, 0x0b, 0x4b, 0x08, 0x42, 0xa6, 0x4d, 0x8c, 0xde,
+	0x34, 0xc3, 0xec, 0xd8, 0x36, 0xb0, 0x6d, 0x9d, 0x19, 0x42, 0xfa, 0x26, 0xbe, 0x8d, 0x6f, 0xe4,
+	0x33, 0x98, 0x0e, 0xdd, 0xfe, 0x18, 0xdc, 0x1b, 0xaf, 0xb6, 0x73, 0xbe, 0xf3, 0x7d, 0xdf, 0x39,
+	0x67, 0xe7, 0x0c, 0x3c, 0x4f, 0x38, 0x7d, 0xc7, 0x07, 0x29, 0x4f, 0x64, 0x22, 0x06, 0x57, 0x49,
+	0x22, 0x85, 0xe4, 0x24, 0x4d, 0x19, 0xef, 0xab, 0x18, 0xea, 0xce, 0x48, 0x30, 0x23, 0x7d, 0x95,
+	0x66, 0xed, 0x36, 0xb2, 0x29, 0xe3, 0x32, 0xfa, 0x1e, 0xcd, 0x53, 0xad, 0x9d, 0x06, 0x1a, 0x4d,
+	0x59, 0x2c, 0x23, 0x99, 0xdd, 0x83, 0x76, 0x00, 0x6b, 0xa3, 0x90, 0xdc, 0xdc, 0xb0, 0x38, 0x60,
+	0xe8, 0x03, 0x74, 0xae, 0x59, 0xe6, 0xcb, 0x2c, 0x65, 0xa6, 0xb6, 0xa7, 0x1d, 0x6c, 0x0c, 0x5f,
+	0xf4, 0x6b, 0x3e, 0xfd, 0x32, 0xf3, 0x8c, 0x65, 0xfd, 0x33, 0x96, 0x79, 0x59, 0xca, 0xf0, 0xea,
+	0xf5, 0xfd, 0x07, 0xda, 0x85, 0x35, 0x3a, 0x4f, 0x30, 0x97, 0xf6, 0xb4, 0x03, 0x03, 0x57, 0x01,
+	0xfb, 0xb7, 0x06, 0x46, 0x9d, 0xff, 0x9f, 0x66, 0x3d, 0xd0, 0xaf, 0x59, 0x56, 0xd8, 0xe4, 0x9f,
+	0xe8, 0x25, 0x3c, 0x2a, 0x3a, 0xa7, 0x44, 0x32, 0x9f, 0x86, 0x24, 0x8a, 0x4d, 0x7d, 0x4f, 0x3f,
+	0x30, 0x70, 0xaf, 0x06, 0x8c, 0xf2, 0xb8, 0x4d, 0x60, 0xb5, 0x90, 0x44, 0x1d, 0x68, 0x8f, 0x47,
+	0x93, 0x8b, 0x5e, 0x0b, 0x3d, 0x85, 0xc7, 0xee, 0xc5, 0xb1, 0xf7, 0xc5, 0xc1, 0x63, 0x1f, 0xbb,
+	0x8e, 0xef, 0x4e, 0x9c, 0xe1, 0x9b, 0xb7, 0x3d, 0x0d, 0x6d, 0xc3, 0x66, 0x09, 0x8c, 0x47, 0x47,
+	0x15, 0xb4, 0x84, 0x4c, 0x78, 0xe2, 0x5d, 0x9e, 0x0f, 0x7d, 0xc7, 0xf3, 0xc6, 0xae, 0xe7, 0x78,
+	0xa7, 0x17, 0x9f, 0xfd, 0xb3, 0xf1, 0xd7, 0x9e, 0x6e, 0xff, 0x6a, 0x43, 0x07, 0x33, 0x91, 0x26,
+	0xb1, 0x60, 0xe8, 0x15, 0x2c, 0x87, 0x77, 0x7e, 0x34, 0x55, 0x9d, 0x76, 0x87, 0xbb, 0x8d, 0x4e,
+	0x1d, 0x4a, 0x99, 0x10, 0x27, 0x44, 0xb2, 0x3b, 0x92, 0x9d, 0x1e, 0xe1, 0x76, 0x78, 0x77, 0x3a,
+	0x5d, 0x3c, 0x4e, 0xe4, 0xc0, 0x3a, 0xa3, 0x61, 0xe2, 0xf3, 0xc2, 0xc1, 0xd4, 0x95, 0xb0, 0xd5,
+	0x10, 0x9e, 0xdb, 0xf7, 0xc7, 0x34, 0x4c, 0x26, 0x2d, 0x6c, 0xe4, 0x94, 0xb2, 0xa6, 0x8f, 0x60,
+	0x70, 0x41, 0x2a, 0x85, 0xb6, 0x52, 0xd8, 0x7e, 0x58, 0x01, 0xbb, 0xce, 0xa4, 0x85, 0xbb, 0x5c,
+	0x90, 0x92, 0x7f, 0x04, 0x1b, 0x8c, 0x4e, 0xeb, 0x0a, 0xcb, 0x4a, 0x61, 0xe7, 0x1f, 0x35, 0xe4,
+	0x83, 0x9b, 0xb4, 0xf0, 0xba, 0x22, 0xd5, 0xab, 0x90, 0xe9, 0xac, 0xd2, 0x58, 0x5d, 0x54, 0x85,
+	0x77, 0x79, 0x9e, 0x57, 0x21, 0xd3, 0x59, 0xc9, 0xb7, 0x41, 0xa7, 0x82, 0x9b, 0x2b, 0x8a, 0xd6,
+	0x6b, 0xde, 0x20, 0x17, 0xe3, 0x1c, 0xb4, 0x6c, 0x68, 0xe7, 0x13, 0x40, 0x16, 0x74, 0x4a, 0x1f,
+	0x4d, 0x4d, 0xb4, 0x3c, 0x5b, 0xfb, 0xa0, 0x63, 0xd7, 0xc9, 0xa7, 0x2e, 0xa2, 0x20, 0x26, 0xf2,
+	0x96, 0xcf, 0x73, 0xaa, 0x80, 0xb5, 0x0f, 0xcb, 0xaa, 0x0d, 0x64, 0x80, 0xc6, 0x0b, 0x58, 0xe3,
+	0xf9, 0x49, 0x14, 0x7f, 0x91, 0x26, 0xac, 0xf7, 0xa0, 0x7b, 0x97, 0xe7, 0x68, 0x0b, 0x56, 0x88,
+	0x94, 0x4c, 0xc8, 0x22, 0xaf, 0x38, 0x35, 0x1d, 0x96, 0xfe, 0x72, 0x38, 0x84, 0xaa, 0xc4, 0xe1,
+	0x4f, 0x0d, 0x8c, 0xc3, 0xda, 0xea, 0xa3, 0x63, 0x30, 0x4e, 0x98, 0xac, 0xf6, 0x75, 0xe1, 0x35,
+	0xb2, 0xb6, 0x1e, 0x5e, 0x27, 0xbb, 0x85, 0x3e, 0x41, 0x17, 0xb3, 0x1f, 0xb7, 0x4c, 0x48, 0x37,
+	0x0a, 0x62, 0xb4, 0xf9, 0xe0, 0xb0, 0x2d, 0xb3, 0xc9, 0xaf, 0x56, 0xc8, 0x6e, 0x1d, 0x3e, 0xfb,
+	0xb6, 0xa3, 0xc0, 0xc1, 0xfd, 0xdb, 0x42, 0x6f, 0x92, 0xdb, 0xe9, 0x20, 0x48, 0x8a, 0x47, 0xe6,
+	0x6a, 0x45, 0xfd, 0xbe, 0xfe, 0x33, 0x00, 0x76, 0xdd, 0x18, 0x0b, 0xc7, 0x04, 0x00, 0x00,
 }
@@ -40,7 +40,7 @@ func (x CertType) String() string {
 	return proto.EnumName(CertType_name, int32(x))
 }
 func (CertType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_certifier_843fba4d086aefbc, []int{0}
+	return fileDescriptor_certifier_20400b8ab8ae7d80, []int{0}
 }
 
 type CSR struct {
@@ -57,7 +57,7 @@ func (m *CSR) Reset()         { *m = CSR{} }
 func (m *CSR) String() string { return proto.CompactTextString(m) }
 func (*CSR) ProtoMessage()    {}
 func (*CSR) Descriptor() ([]byte, []int) {
-	return fileDescriptor_certifier_843fba4d086aefbc, []int{0}
+	return fileDescriptor_certifier_20400b8ab8ae7d80, []int{0}
 }
 func (m *CSR) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CSR.Unmarshal(m, b)
@@ -106,10 +106,12 @@ func (m *CSR) GetCertType() CertType {
 }
 
 type Certificate struct {
-	Sn                   *Certificate_SN      `protobuf:"bytes,1,opt,name=sn,proto3" json:"sn,omitempty"`
-	NotBefore            *timestamp.Timestamp `protobuf:"bytes,2,opt,name=not_before,json=notBefore,proto3" json:"not_before,omitempty"`
-	NotAfter             *timestamp.Timestamp `protobuf:"bytes,3,opt,name=not_after,json=notAfter,proto3" json:"not_after,omitempty"`
-	CertDer              []byte               `protobuf:"bytes,4,opt,name=cert_der,json=certDer,proto3" json:"cert_der,omitempty"`
+	Sn        *Certificate_SN      `protobuf:"bytes,1,opt,name=sn,proto3" json:"sn,omitempty"`
+	NotBefore *timestamp.Timestamp `protobuf:"bytes,2,opt,name=not_before,json=notBefore,proto3" json:"not_before,omitempty"`
+	NotAfter  *timestamp.Timestamp `protobuf:"bytes,3,opt,name=not_after,json=notAfter,proto3" json:"not_after,omitempty"`
+	CertDer   []byte               `protobuf:"bytes,4,opt,name=cert_der,json=certDer,proto3" json:"cert_der,omitempty"`
+	// time after which the certificate should be renewed
+	RenewAfter           *timestamp.Timestamp `protobuf:"bytes,5,opt,name=renew_after,json=renewAfter,proto3" json:"renew_after,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
@@ -119,7 +121,7 @@ func (m *Certificate) Reset()         { *m = Certificate{} }
 func (m *Certificate) String() string { return proto.CompactTextString(m) }
 func (*Certificate) ProtoMessage()    {}
 func (*Certificate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_certifier_843fba4d086aefbc, []int{1}
+	return fileDescriptor_certifier_20400b8ab8ae7d80, []int{1}
 }
 func (m *Certificate) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Certificate.Unmarshal(m, b)
@@ -167,6 +169,13 @@ func (m *Certificate) GetCertDer() []byte {
 	return nil
 }
 
+func (m *Certificate) GetRenewAfter() *timestamp.Timestamp {
+	if m != nil {
+		return m.RenewAfter
+	}
+	return nil
+}
+
 type Certificate_SN struct {
 	Sn                   string   `protobuf:"bytes,1,opt,name=sn,proto3" json:"sn,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -178,7 +187,7 @@ func (m *Certificate_SN) Reset()         { *m = Certificate_SN{} }
 func (m *Certificate_SN) String() string { return proto.CompactTextString(m) }
 func (*Certificate_SN) ProtoMessage()    {}
 func (*Certificate_SN) Descriptor() ([]byte, []int) {
-	return fileDescriptor_certifier_843fba4d086aefbc, []int{1, 0}
+	return fileDescriptor_certifier_20400b8ab8ae7d80, []int{1, 0}
 }
 func (m *Certificate_SN) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Certificate_SN.Unmarshal(m, b)
@@ -216,7 +225,7 @@ func (m *CACert) Reset()         { *m = CACert{} }
 func (m *CACert) String() string { return proto.CompactTextString(m) }
 func (*CACert) ProtoMessage()    {}
 func (*CACert) Descriptor() ([]byte, []int) {
-	return fileDescriptor_certifier_843fba4d086aefbc, []int{2}
+	return fileDescriptor_certifier_20400b8ab8ae7d80, []int{2}
 }
 func (m *CACert) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CACert.Unmarshal(m, b)
@@ -252,34 +261,35 @@ func init() {
 }
 
 func init() {
-	proto.RegisterFile("orc8r/protos/certifier.proto", fileDescriptor_certifier_843fba4d086aefbc)
-}
-
-var fileDescriptor_certifier_843fba4d086aefbc = []byte{
-	// 399 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x5d, 0xab, 0xd3, 0x30,
-	0x18, 0xc7, 0x6d, 0x37, 0xd6, 0xf5, 0xe9, 0xe1, 0x70, 0x08, 0x8a, 0x3d, 0xdd, 0x51, 0xcb, 0x40,
-	0x18, 0x0a, 0x29, 0x1c, 0x2f, 0x3c, 0x5e, 0x6e, 0xad, 0x82, 0x20, 0x43, 0xb2, 0xea, 0x85, 0x37,
-	0xa5, 0x6b, 0xd3, 0x12, 0x58, 0x9b, 0x92, 0x66, 0xc2, 0x3e, 0x97, 0x1f, 0xc8, 0xaf, 0x22, 0x49,
-	0x53, 0xd1, 0x0d, 0xce, 0x55, 0x93, 0x3e, 0xbf, 0xf0, 0x7f, 0xe1, 0x81, 0x3b, 0x2e, 0x8a, 0x07,
-	0x11, 0x75, 0x82, 0x4b, 0xde, 0x47, 0x05, 0x15, 0x92, 0x55, 0x8c, 0x0a, 0xac, 0x7f, 0x20, 0xaf,
-	0xc9, 0xeb, 0x26, 0xc7, 0x9a, 0x09, 0x16, 0xff, 0xa1, 0xac, 0xa4, 0xad, 0x64, 0xf2, 0x34, 0x90,
-	0xc1, 0xab, 0x9a, 0xf3, 0xfa, 0x40, 0x87, 0xe9, 0xfe, 0x58, 0x45, 0x92, 0x35, 0xb4, 0x97, 0x79,
-	0xd3, 0x19, 0xe0, 0xe5, 0x39, 0x50, 0x1e, 0x45, 0x2e, 0x19, 0x6f, 0x87, 0xf9, 0xf2, 0x97, 0x05,
-	0x93, 0x78, 0x47, 0xd0, 0x6b, 0xb0, 0x59, 0xe9, 0x5b, 0xa1, 0xb5, 0xf2, 0xee, 0x9f, 0xe1, 0x7f,
-	0xf4, 0xf1, 0x67, 0xa3, 0x48, 0x6c, 0x56, 0xa2, 0x07, 0x80, 0x9f, 0xf9, 0x81, 0x95, 0x99, 0xd2,
-	0xf1, 0x6d, 0x8d, 0xdf, 0xe2, 0x41, 0x03, 0x8f, 0x1a, 0x38, 0x31, 0x1a, 0xc4, 0xd5, 0x70, 0xca,
-	0x1a, 0x8a, 0x9e, 0x83, 0x53, 0xf4, 0x22, 0x2b, 0xa9, 0xf0, 0x27, 0xa1, 0xb5, 0xba, 0x22, 0xb3,
-	0xa2, 0x17, 0x09, 0x15, 0xe8, 0x1e, 0x5c, 0x95, 0x3f, 0x93, 0xa7, 0x8e, 0xfa, 0xd3, 0xd0, 0x5a,
-	0x5d, 0x9f, 0x19, 0x88, 0xa9, 0x90, 0xe9, 0xa9, 0xa3, 0x64, 0x5e, 0x98, 0xd3, 0xf2, 0xb7, 0x05,
-	0x5e, 0x3c, 0x94, 0x56, 0xe4, 0x92, 0xa2, 0xb7, 0x60, 0xf7, 0xad, 0x71, 0xbf, 0xb8, 0x78, 0x6c,
-	0x28, 0xbc, 0xdb, 0x12, 0xbb, 0x6f, 0xd1, 0x07, 0x80, 0x96, 0xcb, 0x6c, 0x4f, 0x2b, 0x2e, 0xc6,
-	0x0c, 0xc1, 0x45, 0x86, 0x74, 0x2c, 0x92, 0xb8, 0x2d, 0x97, 0x1b, 0x0d, 0xa3, 0xf7, 0xa0, 0x2e,
-	0x59, 0x5e, 0x49, 0x13, 0xe3, 0xf1, 0x97, 0xf3, 0x96, 0xcb, 0xb5, 0x62, 0xd1, 0x2d, 0x68, 0xf3,
-	0x3a, 0xfe, 0x54, 0xc7, 0x77, 0xd4, 0x3d, 0xa1, 0x22, 0x78, 0x0a, 0xf6, 0x6e, 0x8b, 0xae, 0xff,
-	0x26, 0x70, 0x95, 0xc9, 0xe5, 0x1d, 0xcc, 0xe2, 0xb5, 0x32, 0x8f, 0x10, 0x4c, 0x15, 0xaa, 0x67,
-	0x57, 0x44, 0x9f, 0xdf, 0x84, 0x30, 0x1f, 0x5b, 0x41, 0x1e, 0x38, 0xc9, 0xc7, 0x4f, 0xeb, 0x6f,
-	0x5f, 0xd2, 0x9b, 0x27, 0xc8, 0x81, 0xc9, 0xf7, 0xaf, 0xdb, 0x1b, 0x6b, 0xf3, 0xe2, 0xc7, 0x42,
-	0xd7, 0x10, 0x0d, 0xdb, 0x53, 0x1c, 0xf8, 0xb1, 0x8c, 0x6a, 0x6e, 0xd6, 0x68, 0x3f, 0xd3, 0xdf,
-	0x77, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0xf5, 0xa8, 0xb0, 0xa6, 0x88, 0x02, 0x00, 0x00,
+	proto.RegisterFile("orc8r/protos/certifier.proto", fileDescriptor_certifier_20400b8ab8ae7d80)
+}
+
+var fileDescriptor_certifier_20400b8ab8ae7d80 = []byte{
+	// 413 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0x61, 0x8b, 0xd3, 0x30,
+	0x18, 0xc7, 0x6d, 0x37, 0xb7, 0xf5, 0xe9, 0x71, 0x1c, 0x41, 0xb1, 0xd7, 0x9d, 0x5a, 0x06, 0xc2,
+	0x50, 0x48, 0xe1, 0x7c, 0xe1, 0x89, 0xaf, 0x76, 0x9d, 0x82, 0x20, 0x43, 0xb2, 0xea, 0x0b, 0xdf,
+	0x94, 0xae, 0x4d, 0x4b, 0x60, 0x4d, 0x4a, 0x9a, 0x29, 0xfb, 0x2e, 0x7e, 0x0b, 0xbf, 0xa0, 0x24,
+	0x4d, 0x45, 0x6f, 0xe0, 0xbd, 0x6a, 0xd2, 0xfc, 0xf2, 0xfc, 0x9f, 0xdf, 0x43, 0xe0, 0x4a, 0xc8,
+	0xe2, 0x46, 0xc6, 0xad, 0x14, 0x4a, 0x74, 0x71, 0x41, 0xa5, 0x62, 0x15, 0xa3, 0x12, 0x9b, 0x1f,
+	0xc8, 0x6f, 0xf2, 0xba, 0xc9, 0xb1, 0x61, 0xc2, 0xf9, 0x3f, 0x28, 0x2b, 0x29, 0x57, 0x4c, 0x1d,
+	0x7b, 0x32, 0x7c, 0x5e, 0x0b, 0x51, 0xef, 0x69, 0x7f, 0xba, 0x3b, 0x54, 0xb1, 0x62, 0x0d, 0xed,
+	0x54, 0xde, 0xb4, 0x16, 0x78, 0x76, 0x17, 0x28, 0x0f, 0x32, 0x57, 0x4c, 0xf0, 0xfe, 0x7c, 0xf1,
+	0xcb, 0x81, 0x51, 0xb2, 0x25, 0xe8, 0x05, 0xb8, 0xac, 0x0c, 0x9c, 0xc8, 0x59, 0xfa, 0xd7, 0x8f,
+	0xf1, 0x5f, 0xf9, 0xf8, 0xa3, 0x4d, 0x24, 0x2e, 0x2b, 0xd1, 0x0d, 0xc0, 0xf7, 0x7c, 0xcf, 0xca,
+	0x4c, 0xe7, 0x04, 0xae, 0xc1, 0x2f, 0x71, 0x9f, 0x81, 0x87, 0x0c, 0xbc, 0xb6, 0x19, 0xc4, 0x33,
+	0x70, 0xca, 0x1a, 0x8a, 0x9e, 0xc0, 0xb4, 0xe8, 0x64, 0x56, 0x52, 0x19, 0x8c, 0x22, 0x67, 0x79,
+	0x46, 0x26, 0x45, 0x27, 0xd7, 0x54, 0xa2, 0x6b, 0xf0, 0xb4, 0x7f, 0xa6, 0x8e, 0x2d, 0x0d, 0xc6,
+	0x91, 0xb3, 0x3c, 0xbf, 0xd3, 0x40, 0x42, 0xa5, 0x4a, 0x8f, 0x2d, 0x25, 0xb3, 0xc2, 0xae, 0x16,
+	0x3f, 0x5d, 0xf0, 0x93, 0x7e, 0x68, 0x45, 0xae, 0x28, 0x7a, 0x05, 0x6e, 0xc7, 0x6d, 0xf7, 0xf3,
+	0x93, 0xcb, 0x96, 0xc2, 0xdb, 0x0d, 0x71, 0x3b, 0x8e, 0xde, 0x02, 0x70, 0xa1, 0xb2, 0x1d, 0xad,
+	0x84, 0x1c, 0x1c, 0xc2, 0x13, 0x87, 0x74, 0x18, 0x24, 0xf1, 0xb8, 0x50, 0xb7, 0x06, 0x46, 0x6f,
+	0x40, 0x6f, 0xb2, 0xbc, 0x52, 0x56, 0xe3, 0xff, 0x37, 0x67, 0x5c, 0xa8, 0x95, 0x66, 0xd1, 0x25,
+	0x98, 0xe6, 0x8d, 0xfe, 0xd8, 0xe8, 0x4f, 0xf5, 0x5e, 0xfb, 0xbf, 0x03, 0x5f, 0x52, 0x4e, 0x7f,
+	0xd8, 0xaa, 0x0f, 0xef, 0xad, 0x0a, 0x06, 0x37, 0x75, 0xc3, 0x47, 0xe0, 0x6e, 0x37, 0xe8, 0xfc,
+	0x8f, 0xbe, 0xa7, 0x0d, 0x17, 0x57, 0x30, 0x49, 0x56, 0xda, 0x1c, 0x21, 0x18, 0xeb, 0x1c, 0x73,
+	0x76, 0x46, 0xcc, 0xfa, 0x65, 0x04, 0xb3, 0x61, 0xa4, 0xc8, 0x87, 0xe9, 0xfa, 0xfd, 0x87, 0xd5,
+	0x97, 0x4f, 0xe9, 0xc5, 0x03, 0x34, 0x85, 0xd1, 0xd7, 0xcf, 0x9b, 0x0b, 0xe7, 0xf6, 0xe9, 0xb7,
+	0xb9, 0x99, 0x61, 0xdc, 0x3f, 0xbd, 0x62, 0x2f, 0x0e, 0x65, 0x5c, 0x0b, 0xfb, 0x06, 0x77, 0x13,
+	0xf3, 0x7d, 0xfd, 0x7b, 0x00, 0x06, 0xce, 0x36, 0x72, 0xc5, 0x02, 0x00, 0x00,
 }
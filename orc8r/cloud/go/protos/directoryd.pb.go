@@ -28,22 +28,26 @@ type TableID int32
 const (
 	TableID_IMSI_TO_HWID     TableID = 0
 	TableID_HWID_TO_HOSTNAME TableID = 1
+	// Dispatcher replica holding the SyncRPC stream of a gateway
+	TableID_HWID_TO_DISPATCHER TableID = 2
 )
 
 var TableID_name = map[int32]string{
 	0: "IMSI_TO_HWID",
 	1: "HWID_TO_HOSTNAME",
+	2: "HWID_TO_DISPATCHER",
 }
 var TableID_value = map[string]int32{
-	"IMSI_TO_HWID":     0,
-	"HWID_TO_HOSTNAME": 1,
+	"IMSI_TO_HWID":       0,
+	"HWID_TO_HOSTNAME":   1,
+	"HWID_TO_DISPATCHER": 2,
 }
 
 func (x TableID) String() string {
 	return proto.EnumName(TableID_name, int32(x))
 }
 func (TableID) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_directoryd_f529f21e91423ef9, []int{0}
+	return fileDescriptor_directoryd_55f7b3073a0e4aa3, []int{0}
 }
 
 type GetLocationRequest struct {
@@ -58,7 +62,7 @@ func (m *GetLocationRequest) Reset()         { *m = GetLocationRequest{} }
 func (m *GetLocationRequest) String() string { return proto.CompactTextString(m) }
 func (*GetLocationRequest) ProtoMessage()    {}
 func (*GetLocationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_directoryd_f529f21e91423ef9, []int{0}
+	return fileDescriptor_directoryd_55f7b3073a0e4aa3, []int{0}
 }
 func (m *GetLocationRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetLocationRequest.Unmarshal(m, b)
@@ -104,7 +108,7 @@ func (m *DeleteLocationRequest) Reset()         { *m = DeleteLocationRequest{} }
 func (m *DeleteLocationRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteLocationRequest) ProtoMessage()    {}
 func (*DeleteLocationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_directoryd_f529f21e91423ef9, []int{1}
+	return fileDescriptor_directoryd_55f7b3073a0e4aa3, []int{1}
 }
 func (m *DeleteLocationRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteLocationRequest.Unmarshal(m, b)
@@ -150,7 +154,7 @@ func (m *LocationRecord) Reset()         { *m = LocationRecord{} }
 func (m *LocationRecord) String() string { return proto.CompactTextString(m) }
 func (*LocationRecord) ProtoMessage()    {}
 func (*LocationRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_directoryd_f529f21e91423ef9, []int{2}
+	return fileDescriptor_directoryd_55f7b3073a0e4aa3, []int{2}
 }
 func (m *LocationRecord) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LocationRecord.Unmarshal(m, b)
@@ -190,7 +194,7 @@ func (m *UpdateDirectoryLocationRequest) Reset()         { *m = UpdateDirectoryL
 func (m *UpdateDirectoryLocationRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateDirectoryLocationRequest) ProtoMessage()    {}
 func (*UpdateDirectoryLocationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_directoryd_f529f21e91423ef9, []int{3}
+	return fileDescriptor_directoryd_55f7b3073a0e4aa3, []int{3}
 }
 func (m *UpdateDirectoryLocationRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateDirectoryLocationRequest.Unmarshal(m, b)
@@ -388,31 +392,32 @@ var _DirectoryService_serviceDesc = grpc.ServiceDesc{
 }
 
 func init() {
-	proto.RegisterFile("orc8r/protos/directoryd.proto", fileDescriptor_directoryd_f529f21e91423ef9)
-}
-
-var fileDescriptor_directoryd_f529f21e91423ef9 = []byte{
-	// 345 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0x4f, 0x4f, 0xc2, 0x30,
-	0x18, 0xc6, 0xd9, 0x8c, 0xa8, 0x2f, 0x66, 0x99, 0x0d, 0x26, 0x38, 0x82, 0x92, 0x9d, 0x08, 0x9a,
-	0x2d, 0xc2, 0xc5, 0xab, 0x66, 0x46, 0x97, 0x88, 0x98, 0x0d, 0x35, 0xf1, 0x42, 0x46, 0xdb, 0x90,
-	0x25, 0x1b, 0xc5, 0x52, 0x4c, 0xbc, 0xf9, 0x15, 0xfc, 0xc6, 0x86, 0x6e, 0x0e, 0x1a, 0x09, 0x5c,
-	0x3c, 0x6d, 0x7b, 0xf6, 0xf4, 0xd7, 0xf7, 0xcf, 0x03, 0x0d, 0xc6, 0xf1, 0x15, 0x77, 0xa7, 0x9c,
-	0x09, 0x36, 0x73, 0x49, 0xcc, 0x29, 0x16, 0x8c, 0x7f, 0x12, 0x47, 0x2a, 0xa8, 0x92, 0x46, 0xe3,
-	0x34, 0x72, 0xa4, 0xc9, 0x3a, 0x51, 0xbc, 0x98, 0xa5, 0x29, 0x9b, 0x64, 0x3e, 0xfb, 0x09, 0xd0,
-	0x1d, 0x15, 0x0f, 0x0c, 0x47, 0x22, 0x66, 0x93, 0x80, 0xbe, 0xcf, 0xe9, 0x4c, 0x20, 0x03, 0xf4,
-	0x98, 0xd4, 0xb4, 0xa6, 0xd6, 0x3a, 0x08, 0xf4, 0x98, 0xa0, 0x36, 0xec, 0x8a, 0x68, 0x94, 0xd0,
-	0x9a, 0xde, 0xd4, 0x5a, 0x46, 0xa7, 0xea, 0xac, 0xd0, 0x9d, 0xc1, 0xe2, 0x8f, 0xef, 0x05, 0x99,
-	0xc5, 0x0e, 0xe1, 0xd8, 0xa3, 0x09, 0x15, 0xf4, 0x3f, 0xa1, 0x17, 0x60, 0x2c, 0x71, 0x98, 0x71,
-	0x82, 0x2c, 0xd8, 0x4f, 0x72, 0x25, 0x67, 0x16, 0xdf, 0xf6, 0xb7, 0x06, 0xa7, 0xcf, 0x53, 0x12,
-	0x09, 0xea, 0xfd, 0xce, 0x65, 0x5b, 0x31, 0x5d, 0x28, 0x73, 0x09, 0x96, 0xd5, 0x54, 0x3a, 0x75,
-	0xa5, 0x1a, 0xf5, 0xee, 0x20, 0xb7, 0x2e, 0x3b, 0xd8, 0xd9, 0xda, 0x41, 0xfb, 0x12, 0xf6, 0x72,
-	0x05, 0x99, 0x70, 0xe8, 0xf7, 0x42, 0x7f, 0x38, 0xe8, 0x0f, 0xef, 0x5f, 0x7d, 0xcf, 0x2c, 0xa1,
-	0x2a, 0x98, 0x8b, 0x37, 0xa9, 0xf4, 0xc3, 0xc1, 0xe3, 0x75, 0xef, 0xd6, 0xd4, 0x3a, 0x5f, 0x3a,
-	0x98, 0x45, 0x03, 0x21, 0xe5, 0x1f, 0x31, 0xa6, 0xa8, 0x07, 0x95, 0x95, 0x85, 0xa1, 0x33, 0xe5,
-	0xce, 0xbf, 0xab, 0xb4, 0x36, 0x35, 0x62, 0x97, 0x50, 0x00, 0x46, 0x36, 0xa9, 0x82, 0x78, 0xae,
-	0x1c, 0xd8, 0x3c, 0x46, 0xeb, 0x48, 0x31, 0xbf, 0xb0, 0x78, 0xc1, 0xf4, 0xc1, 0x50, 0x13, 0x80,
-	0x6c, 0xc5, 0xb6, 0x36, 0x1e, 0x6b, 0x51, 0x37, 0x8d, 0xb7, 0xba, 0x54, 0xdd, 0x2c, 0xc1, 0x38,
-	0x61, 0x73, 0xe2, 0x8e, 0x59, 0x1e, 0xe5, 0x51, 0x59, 0x3e, 0xbb, 0x3f, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0x94, 0x33, 0x66, 0x34, 0x0d, 0x03, 0x00, 0x00,
+	proto.RegisterFile("orc8r/protos/directoryd.proto", fileDescriptor_directoryd_55f7b3073a0e4aa3)
+}
+
+var fileDescriptor_directoryd_55f7b3073a0e4aa3 = []byte{
+	// 359 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0x4f, 0x4f, 0xea, 0x40,
+	0x14, 0xc5, 0x69, 0x5f, 0x1e, 0xef, 0x79, 0x31, 0x4d, 0x9d, 0xa0, 0xc1, 0x12, 0x94, 0x74, 0x45,
+	0xd0, 0xb4, 0x09, 0x6c, 0xdc, 0xa2, 0x25, 0xd2, 0x44, 0x84, 0xb4, 0x55, 0x13, 0x37, 0xa4, 0x74,
+	0x26, 0xa4, 0x49, 0xcb, 0xe0, 0x30, 0x98, 0xb8, 0xf3, 0x2b, 0xf8, 0x8d, 0x0d, 0xd3, 0xf2, 0x67,
+	0x22, 0x81, 0x8d, 0xab, 0xb6, 0xa7, 0xa7, 0xbf, 0xde, 0x73, 0xe7, 0x40, 0x8d, 0xb2, 0xe8, 0x86,
+	0xd9, 0x33, 0x46, 0x39, 0x9d, 0xdb, 0x38, 0x66, 0x24, 0xe2, 0x94, 0x7d, 0x60, 0x4b, 0x28, 0xa8,
+	0x94, 0x86, 0x93, 0x34, 0xb4, 0x84, 0xc9, 0x38, 0x97, 0xbc, 0x11, 0x4d, 0x53, 0x3a, 0xcd, 0x7c,
+	0xe6, 0x10, 0xd0, 0x3d, 0xe1, 0x0f, 0x34, 0x0a, 0x79, 0x4c, 0xa7, 0x1e, 0x79, 0x5b, 0x90, 0x39,
+	0x47, 0x1a, 0xa8, 0x31, 0xae, 0x28, 0x75, 0xa5, 0x71, 0xe4, 0xa9, 0x31, 0x46, 0x4d, 0xf8, 0xcb,
+	0xc3, 0x71, 0x42, 0x2a, 0x6a, 0x5d, 0x69, 0x68, 0xad, 0xb2, 0xb5, 0x45, 0xb7, 0x82, 0xe5, 0x1b,
+	0xd7, 0xf1, 0x32, 0x8b, 0xe9, 0xc3, 0xa9, 0x43, 0x12, 0xc2, 0xc9, 0x6f, 0x42, 0xaf, 0x41, 0xdb,
+	0xe0, 0x22, 0xca, 0x30, 0x32, 0xe0, 0x7f, 0x92, 0x2b, 0x39, 0x73, 0xfd, 0x6c, 0x7e, 0x29, 0x70,
+	0xf1, 0x34, 0xc3, 0x21, 0x27, 0xce, 0x6a, 0x2f, 0x87, 0x86, 0x69, 0x43, 0x91, 0x09, 0xb0, 0x98,
+	0xa6, 0xd4, 0xaa, 0x4a, 0xd3, 0xc8, 0xff, 0xf6, 0x72, 0xeb, 0x26, 0xc1, 0x9f, 0x83, 0x09, 0x9a,
+	0x2e, 0xfc, 0xcb, 0x15, 0xa4, 0xc3, 0xb1, 0xdb, 0xf7, 0xdd, 0x51, 0x30, 0x18, 0xf5, 0x5e, 0x5c,
+	0x47, 0x2f, 0xa0, 0x32, 0xe8, 0xcb, 0x3b, 0xa1, 0x0c, 0xfc, 0xe0, 0xb1, 0xd3, 0xef, 0xea, 0x0a,
+	0x3a, 0x03, 0xb4, 0x52, 0x1d, 0xd7, 0x1f, 0x76, 0x82, 0xbb, 0x5e, 0xd7, 0xd3, 0xd5, 0xd6, 0xa7,
+	0x0a, 0xfa, 0x3a, 0x98, 0x4f, 0xd8, 0x7b, 0x1c, 0x11, 0xd4, 0x87, 0xd2, 0xd6, 0x41, 0xa2, 0x4b,
+	0x69, 0x96, 0x9f, 0x47, 0x6c, 0xec, 0x0b, 0x68, 0x16, 0x90, 0x07, 0x5a, 0xb6, 0xc1, 0x35, 0xf1,
+	0x4a, 0xfa, 0x60, 0xff, 0x7a, 0x8d, 0x13, 0xc9, 0xfc, 0x4c, 0xe3, 0x25, 0xd3, 0x05, 0x4d, 0x6e,
+	0x06, 0x32, 0x25, 0xdb, 0xce, 0xda, 0xec, 0x44, 0xdd, 0xd6, 0x5e, 0xab, 0x42, 0xb5, 0xb3, 0x66,
+	0x47, 0x09, 0x5d, 0x60, 0x7b, 0x42, 0xf3, 0x8a, 0x8f, 0x8b, 0xe2, 0xda, 0xfe, 0x1e, 0x00, 0xd0,
+	0x1a, 0xfd, 0xff, 0x25, 0x03, 0x00, 0x00,
 }
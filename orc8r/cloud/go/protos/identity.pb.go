@@ -41,7 +41,7 @@ func (x Identity_Wildcard_Type) String() string {
 	return proto.EnumName(Identity_Wildcard_Type_name, int32(x))
 }
 func (Identity_Wildcard_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_identity_34334eb05f9241db, []int{0, 0, 0}
+	return fileDescriptor_identity_664c4da27a32af46, []int{0, 0, 0}
 }
 
 type Identity struct {
@@ -63,7 +63,7 @@ func (m *Identity) Reset()         { *m = Identity{} }
 func (m *Identity) String() string { return proto.CompactTextString(m) }
 func (*Identity) ProtoMessage()    {}
 func (*Identity) Descriptor() ([]byte, []int) {
-	return fileDescriptor_identity_34334eb05f9241db, []int{0}
+	return fileDescriptor_identity_664c4da27a32af46, []int{0}
 }
 func (m *Identity) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Identity.Unmarshal(m, b)
@@ -251,17 +251,20 @@ func _Identity_OneofSizer(msg proto.Message) (n int) {
 }
 
 type Identity_Wildcard struct {
-	Type                 Identity_Wildcard_Type `protobuf:"varint,1,opt,name=type,proto3,enum=magma.orc8r.Identity_Wildcard_Type" json:"type,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
-	XXX_unrecognized     []byte                 `json:"-"`
-	XXX_sizecache        int32                  `json:"-"`
+	Type Identity_Wildcard_Type `protobuf:"varint,1,opt,name=type,proto3,enum=magma.orc8r.Identity_Wildcard_Type" json:"type,omitempty"`
+	// network_id scopes a Gateway wildcard to the gateways of a network,
+	// an empty network_id matches the gateways of all networks
+	NetworkId            string   `protobuf:"bytes,2,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Identity_Wildcard) Reset()         { *m = Identity_Wildcard{} }
 func (m *Identity_Wildcard) String() string { return proto.CompactTextString(m) }
 func (*Identity_Wildcard) ProtoMessage()    {}
 func (*Identity_Wildcard) Descriptor() ([]byte, []int) {
-	return fileDescriptor_identity_34334eb05f9241db, []int{0, 0}
+	return fileDescriptor_identity_664c4da27a32af46, []int{0, 0}
 }
 func (m *Identity_Wildcard) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Identity_Wildcard.Unmarshal(m, b)
@@ -288,6 +291,13 @@ func (m *Identity_Wildcard) GetType() Identity_Wildcard_Type {
 	return Identity_Wildcard_Gateway
 }
 
+func (m *Identity_Wildcard) GetNetworkId() string {
+	if m != nil {
+		return m.NetworkId
+	}
+	return ""
+}
+
 type Identity_Gateway struct {
 	HardwareId           string   `protobuf:"bytes,1,opt,name=hardware_id,json=hardwareId,proto3" json:"hardware_id,omitempty"`
 	NetworkId            string   `protobuf:"bytes,2,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
@@ -301,7 +311,7 @@ func (m *Identity_Gateway) Reset()         { *m = Identity_Gateway{} }
 func (m *Identity_Gateway) String() string { return proto.CompactTextString(m) }
 func (*Identity_Gateway) ProtoMessage()    {}
 func (*Identity_Gateway) Descriptor() ([]byte, []int) {
-	return fileDescriptor_identity_34334eb05f9241db, []int{0, 1}
+	return fileDescriptor_identity_664c4da27a32af46, []int{0, 1}
 }
 func (m *Identity_Gateway) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Identity_Gateway.Unmarshal(m, b)
@@ -354,7 +364,7 @@ func (m *Identity_List) Reset()         { *m = Identity_List{} }
 func (m *Identity_List) String() string { return proto.CompactTextString(m) }
 func (*Identity_List) ProtoMessage()    {}
 func (*Identity_List) Descriptor() ([]byte, []int) {
-	return fileDescriptor_identity_34334eb05f9241db, []int{0, 2}
+	return fileDescriptor_identity_664c4da27a32af46, []int{0, 2}
 }
 func (m *Identity_List) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Identity_List.Unmarshal(m, b)
@@ -397,7 +407,7 @@ func (m *AccessGatewayID) Reset()         { *m = AccessGatewayID{} }
 func (m *AccessGatewayID) String() string { return proto.CompactTextString(m) }
 func (*AccessGatewayID) ProtoMessage()    {}
 func (*AccessGatewayID) Descriptor() ([]byte, []int) {
-	return fileDescriptor_identity_34334eb05f9241db, []int{1}
+	return fileDescriptor_identity_664c4da27a32af46, []int{1}
 }
 func (m *AccessGatewayID) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AccessGatewayID.Unmarshal(m, b)
@@ -434,32 +444,32 @@ func init() {
 }
 
 func init() {
-	proto.RegisterFile("orc8r/protos/identity.proto", fileDescriptor_identity_34334eb05f9241db)
-}
-
-var fileDescriptor_identity_34334eb05f9241db = []byte{
-	// 356 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x4f, 0x4b, 0xeb, 0x40,
-	0x14, 0xc5, 0x9b, 0x3f, 0xef, 0x25, 0xbd, 0x79, 0xf4, 0x95, 0x01, 0x21, 0xa4, 0x56, 0x6b, 0xdd,
-	0xd4, 0x4d, 0x82, 0x75, 0xa1, 0x82, 0x1b, 0x8b, 0x60, 0x03, 0xa2, 0x10, 0x44, 0xc1, 0x8d, 0x8c,
-	0x99, 0x21, 0x1d, 0x4c, 0x3b, 0x61, 0x32, 0x25, 0xe4, 0x9b, 0xfa, 0x71, 0x24, 0x93, 0x49, 0x71,
-	0x51, 0x5c, 0x85, 0x39, 0xe7, 0x77, 0xef, 0x3d, 0x37, 0x5c, 0x18, 0x71, 0x91, 0x5e, 0x89, 0xa8,
-	0x10, 0x5c, 0xf2, 0x32, 0x62, 0x84, 0x6e, 0x24, 0x93, 0x75, 0xa8, 0xde, 0xc8, 0x5b, 0xe3, 0x6c,
-	0x8d, 0x43, 0x85, 0x4c, 0xbf, 0x2c, 0x70, 0x63, 0xed, 0xa3, 0x6b, 0x70, 0x32, 0x2c, 0x69, 0x85,
-	0x6b, 0xdf, 0x98, 0x18, 0x33, 0x6f, 0x3e, 0x0e, 0x7f, 0xb0, 0x61, 0xc7, 0x85, 0xf7, 0x2d, 0xb4,
-	0xec, 0x25, 0x1d, 0x8f, 0x0e, 0xc1, 0xe5, 0x05, 0x15, 0x58, 0x72, 0xe1, 0x9b, 0x13, 0x63, 0xd6,
-	0x5f, 0xf6, 0x92, 0x9d, 0x82, 0x02, 0x70, 0x36, 0x54, 0x56, 0x5c, 0x7c, 0xfa, 0x96, 0x36, 0x3b,
-	0x01, 0xdd, 0x80, 0x5b, 0xb1, 0x9c, 0xa4, 0x58, 0x10, 0xdf, 0x53, 0x53, 0x8f, 0xf6, 0x4f, 0x7d,
-	0xd5, 0x54, 0xd3, 0xb9, 0xab, 0x08, 0x4a, 0x70, 0x3b, 0x1d, 0x5d, 0x82, 0x2d, 0xeb, 0x82, 0xaa,
-	0xec, 0x83, 0xf9, 0xe9, 0xef, 0x5d, 0xc2, 0xe7, 0xba, 0xa0, 0x89, 0x2a, 0x98, 0x86, 0x60, 0x37,
-	0x2f, 0xe4, 0x81, 0xa3, 0x57, 0x1b, 0xf6, 0xd0, 0x3f, 0x70, 0x9f, 0x74, 0xfe, 0xa1, 0xd1, 0x58,
-	0x8f, 0x6d, 0xe0, 0xa1, 0x19, 0xac, 0x76, 0x1c, 0x3a, 0x06, 0x6f, 0x85, 0x05, 0xa9, 0xb0, 0xa0,
-	0xef, 0x8c, 0xa8, 0xd1, 0xfd, 0x04, 0x3a, 0x29, 0x26, 0x68, 0x0c, 0xa0, 0x37, 0x6d, 0x7c, 0xf5,
-	0x6b, 0x92, 0xbe, 0x56, 0x5a, 0x3b, 0xe7, 0x19, 0x4b, 0x71, 0xde, 0xd8, 0x56, 0x6b, 0x6b, 0x25,
-	0x26, 0xc1, 0x39, 0xd8, 0x0f, 0xac, 0x94, 0xe8, 0x0c, 0xec, 0x9c, 0x95, 0xd2, 0x37, 0x26, 0xd6,
-	0xcc, 0x9b, 0x1f, 0xec, 0x5d, 0x2d, 0x51, 0xc8, 0xc2, 0x81, 0x3f, 0x2f, 0x38, 0xdf, 0xd2, 0xe9,
-	0x09, 0xfc, 0xbf, 0x4d, 0x53, 0x5a, 0x96, 0x3a, 0x6b, 0x7c, 0x87, 0x06, 0x60, 0xee, 0x42, 0x9a,
-	0x8c, 0x2c, 0xc6, 0x6f, 0x23, 0xd5, 0x29, 0x6a, 0xef, 0x25, 0xcd, 0xf9, 0x96, 0x44, 0x19, 0xd7,
-	0x87, 0xf3, 0xf1, 0x57, 0x7d, 0x2f, 0xbe, 0x03, 0x00, 0x00, 0xff, 0xff, 0x15, 0x69, 0xd5, 0x8d,
-	0x4f, 0x02, 0x00, 0x00,
+	proto.RegisterFile("orc8r/protos/identity.proto", fileDescriptor_identity_664c4da27a32af46)
+}
+
+var fileDescriptor_identity_664c4da27a32af46 = []byte{
+	// 359 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0x4f, 0x4b, 0xe3, 0x40,
+	0x18, 0xc6, 0x9b, 0x3f, 0xbb, 0x49, 0xdf, 0x2c, 0xdd, 0x32, 0xb0, 0x10, 0xd2, 0xed, 0x6e, 0xad,
+	0x97, 0x7a, 0x49, 0xb0, 0x1e, 0x54, 0xf0, 0x62, 0x11, 0x6c, 0x40, 0x14, 0x82, 0x28, 0x78, 0x91,
+	0x31, 0x33, 0xa4, 0x83, 0x69, 0x27, 0x4c, 0xa6, 0x84, 0x7c, 0x0d, 0x3f, 0xad, 0x47, 0xc9, 0x64,
+	0x52, 0x3c, 0x14, 0x3d, 0x85, 0x3c, 0xcf, 0x6f, 0x9e, 0xf7, 0x79, 0xe1, 0x85, 0x11, 0x17, 0xe9,
+	0x99, 0x88, 0x0a, 0xc1, 0x25, 0x2f, 0x23, 0x46, 0xe8, 0x46, 0x32, 0x59, 0x87, 0xea, 0x1f, 0x79,
+	0x6b, 0x9c, 0xad, 0x71, 0xa8, 0x90, 0xe9, 0xbb, 0x05, 0x6e, 0xac, 0x7d, 0x74, 0x0e, 0x4e, 0x86,
+	0x25, 0xad, 0x70, 0xed, 0x1b, 0x13, 0x63, 0xe6, 0xcd, 0xc7, 0xe1, 0x27, 0x36, 0xec, 0xb8, 0xf0,
+	0xba, 0x85, 0x96, 0xbd, 0xa4, 0xe3, 0xd1, 0x5f, 0x70, 0x79, 0x41, 0x05, 0x96, 0x5c, 0xf8, 0xe6,
+	0xc4, 0x98, 0xf5, 0x97, 0xbd, 0x64, 0xa7, 0xa0, 0x00, 0x9c, 0x0d, 0x95, 0x15, 0x17, 0xaf, 0xbe,
+	0xa5, 0xcd, 0x4e, 0x40, 0x17, 0xe0, 0x56, 0x2c, 0x27, 0x29, 0x16, 0xc4, 0xf7, 0xd4, 0xd4, 0x7f,
+	0xfb, 0xa7, 0x3e, 0x6a, 0xaa, 0x49, 0xee, 0x5e, 0x04, 0x6f, 0x06, 0xb8, 0x9d, 0x81, 0x4e, 0xc1,
+	0x96, 0x75, 0x41, 0x55, 0xf9, 0xc1, 0xfc, 0xf0, 0xeb, 0x98, 0xf0, 0xbe, 0x2e, 0x68, 0xa2, 0x1e,
+	0xa0, 0x31, 0x80, 0xae, 0xf3, 0xcc, 0x48, 0xdb, 0x3f, 0xe9, 0x6b, 0x25, 0x26, 0xd3, 0x10, 0xec,
+	0x06, 0x46, 0x1e, 0x38, 0x7a, 0xf5, 0x61, 0x0f, 0xfd, 0x02, 0xf7, 0x4e, 0xef, 0x37, 0x34, 0x1a,
+	0xeb, 0xb6, 0xe5, 0x87, 0x66, 0xb0, 0xda, 0x71, 0xe8, 0x3f, 0x78, 0x2b, 0x2c, 0x48, 0x85, 0x05,
+	0x6d, 0xa2, 0x0d, 0x15, 0x0d, 0x9d, 0x14, 0x93, 0x6f, 0x46, 0x37, 0x76, 0xce, 0x33, 0x96, 0xe2,
+	0xbc, 0xb1, 0xad, 0xd6, 0xd6, 0x4a, 0x4c, 0x82, 0x63, 0xb0, 0x6f, 0x58, 0x29, 0xd1, 0x11, 0xd8,
+	0x39, 0x2b, 0xa5, 0x6f, 0x4c, 0xac, 0x99, 0x37, 0xff, 0xb3, 0x77, 0xf3, 0x44, 0x21, 0x0b, 0x07,
+	0x7e, 0x3c, 0xe0, 0x7c, 0x4b, 0xa7, 0x07, 0xf0, 0xfb, 0x32, 0x4d, 0x69, 0x59, 0xea, 0xae, 0xf1,
+	0x15, 0x1a, 0x80, 0xb9, 0x2b, 0x69, 0x32, 0xb2, 0x18, 0x3f, 0x8d, 0x54, 0x52, 0xd4, 0xde, 0x53,
+	0x9a, 0xf3, 0x2d, 0x89, 0x32, 0xae, 0x0f, 0xeb, 0xe5, 0xa7, 0xfa, 0x9e, 0x7c, 0x0c, 0x00, 0xbb,
+	0x74, 0xc5, 0xeb, 0x6f, 0x02, 0x00, 0x00,
 }
@@ -79,6 +79,14 @@ func NewGatewayWildcardIdentity() *Identity {
 		Value: &Identity_Wildcard_{Wildcard: &Identity_Wildcard{Type: Identity_Wildcard_Gateway}}}
 }
 
+// NewNetworkGatewayWildcardIdentity returns Gateway wildcard Identity matching
+// the gateways of the given network only
+func NewNetworkGatewayWildcardIdentity(networkId string) *Identity {
+	return &Identity{
+		Value: &Identity_Wildcard_{
+			Wildcard: &Identity_Wildcard{Type: Identity_Wildcard_Gateway, NetworkId: networkId}}}
+}
+
 // NewOperatorWildcardIdentity returns Operator wildcard Identity
 func NewOperatorWildcardIdentity() *Identity {
 	return &Identity{
@@ -286,10 +294,14 @@ func (nid *Identity_Network) ToCommonName() *string {
 	return nil
 }
 
-// ToCommonName receiver for Wildcard Identity
+// ToCommonName receiver for Wildcard Identity, network scoped wildcards'
+// common names are qualified by their network: "Gateway@MyNetworkId"
 func (wc *Identity_Wildcard_) ToCommonName() *string {
 	if wc != nil && wc.Wildcard != nil {
 		if tn, ok := Identity_Wildcard_Type_name[int32(wc.Wildcard.Type)]; ok {
+			if len(wc.Wildcard.NetworkId) > 0 {
+				tn += "@" + wc.Wildcard.NetworkId
+			}
 			return &tn // return Type Name (Network, Operator, etc.)
 		}
 	}
@@ -305,13 +317,17 @@ func (gw *Identity_Gateway) Registered() bool {
 }
 
 // A helper to verify if a given entity matches the wildcard pattern
-// For now only match all (*) is supported
+// For now only match all (*) is supported, optionally scoped to the gateways
+// of a network
 func (wildcard *Identity) Match(entity *Identity) bool {
 	if wildcard != nil && entity != nil {
 		wc, ok := wildcard.Value.(*Identity_Wildcard_)
 		if ok && wc != nil && wc.Wildcard != nil {
-			switch entity.Value.(type) {
+			switch ent := entity.Value.(type) {
 			case *Identity_Gateway_:
+				if len(wc.Wildcard.NetworkId) > 0 && wc.Wildcard.NetworkId != ent.Gateway.GetNetworkId() {
+					return false
+				}
 				return wc.Wildcard.Type == Identity_Wildcard_Gateway
 			case *Identity_Operator:
 				return wc.Wildcard.Type == Identity_Wildcard_Operator
@@ -23,25 +23,29 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
-// Where to log to. Currently only supports scribe.
+// Where to log to.
 type LoggerDestination int32
 
 const (
 	LoggerDestination_SCRIBE LoggerDestination = 0
+	// Elasticsearch bulk API or an NDJSON HTTP input (e.g. Fluentd, Fluent Bit)
+	LoggerDestination_ELASTIC LoggerDestination = 1
 )
 
 var LoggerDestination_name = map[int32]string{
 	0: "SCRIBE",
+	1: "ELASTIC",
 }
 var LoggerDestination_value = map[string]int32{
-	"SCRIBE": 0,
+	"SCRIBE":  0,
+	"ELASTIC": 1,
 }
 
 func (x LoggerDestination) String() string {
 	return proto.EnumName(LoggerDestination_name, int32(x))
 }
 func (LoggerDestination) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_logging_service_003ad728b7935a55, []int{0}
+	return fileDescriptor_logging_service_30d9a0e4779079fa, []int{0}
 }
 
 type LogEntry struct {
@@ -68,7 +72,7 @@ func (m *LogEntry) Reset()         { *m = LogEntry{} }
 func (m *LogEntry) String() string { return proto.CompactTextString(m) }
 func (*LogEntry) ProtoMessage()    {}
 func (*LogEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_logging_service_003ad728b7935a55, []int{0}
+	return fileDescriptor_logging_service_30d9a0e4779079fa, []int{0}
 }
 func (m *LogEntry) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LogEntry.Unmarshal(m, b)
@@ -149,7 +153,7 @@ func (m *LogRequest) Reset()         { *m = LogRequest{} }
 func (m *LogRequest) String() string { return proto.CompactTextString(m) }
 func (*LogRequest) ProtoMessage()    {}
 func (*LogRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_logging_service_003ad728b7935a55, []int{1}
+	return fileDescriptor_logging_service_30d9a0e4779079fa, []int{1}
 }
 func (m *LogRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LogRequest.Unmarshal(m, b)
@@ -266,35 +270,36 @@ var _LoggingService_serviceDesc = grpc.ServiceDesc{
 }
 
 func init() {
-	proto.RegisterFile("orc8r/protos/logging_service.proto", fileDescriptor_logging_service_003ad728b7935a55)
-}
-
-var fileDescriptor_logging_service_003ad728b7935a55 = []byte{
-	// 415 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x52, 0xc1, 0x6e, 0x13, 0x31,
-	0x10, 0xed, 0xd6, 0xc9, 0x26, 0x99, 0x48, 0x51, 0x3b, 0x80, 0x6a, 0x16, 0x51, 0x42, 0xc4, 0x21,
-	0xe2, 0xb0, 0x2b, 0xa5, 0x97, 0x52, 0x71, 0x40, 0x2d, 0x39, 0x44, 0x0a, 0x1c, 0x1c, 0x89, 0x03,
-	0x97, 0xc8, 0x6c, 0x2c, 0xd7, 0x22, 0xeb, 0x09, 0x5e, 0x27, 0x55, 0x4f, 0x48, 0x7c, 0x39, 0x8a,
-	0xb7, 0xa9, 0x36, 0x25, 0x12, 0x27, 0xfb, 0x8d, 0xdf, 0x7b, 0x9e, 0x19, 0x3d, 0x18, 0x90, 0xcb,
-	0x2f, 0x5d, 0xb6, 0x72, 0xe4, 0xa9, 0xcc, 0x96, 0xa4, 0xb5, 0xb1, 0x7a, 0x5e, 0x2a, 0xb7, 0x31,
-	0xb9, 0x4a, 0x43, 0x19, 0xbb, 0x85, 0xd4, 0x85, 0x4c, 0x03, 0x33, 0x79, 0xb9, 0x27, 0xc8, 0xa9,
-	0x28, 0xc8, 0x56, 0xbc, 0xc1, 0x1f, 0x06, 0xed, 0x29, 0xe9, 0xb1, 0xf5, 0xee, 0x1e, 0x13, 0x68,
-	0xe7, 0xd2, 0x2b, 0x4d, 0xee, 0x9e, 0x47, 0xfd, 0x68, 0xd8, 0x11, 0x8f, 0x18, 0x11, 0x1a, 0xde,
-	0x14, 0x8a, 0xb3, 0x7e, 0x34, 0x64, 0x22, 0xdc, 0xf1, 0x19, 0x34, 0x6f, 0xef, 0xe6, 0x66, 0xc1,
-	0x1b, 0x81, 0xdc, 0xb8, 0xbd, 0x9b, 0x2c, 0xf0, 0x06, 0xc0, 0x92, 0x2b, 0xe4, 0x72, 0x5e, 0xc8,
-	0x15, 0x6f, 0xf6, 0xd9, 0xb0, 0x3b, 0x7a, 0x97, 0xd6, 0xda, 0x49, 0x77, 0xff, 0xa5, 0x5f, 0x03,
-	0xef, 0x8b, 0x5c, 0x05, 0x28, 0x3a, 0x76, 0x87, 0xf1, 0x0a, 0x5a, 0xc6, 0xfa, 0xe0, 0x10, 0x07,
-	0x87, 0xb7, 0x87, 0x1d, 0x26, 0xd6, 0x3f, 0xca, 0x63, 0x13, 0x00, 0x9e, 0x41, 0xcb, 0xcb, 0xed,
-	0x3e, 0x3c, 0x6f, 0xf5, 0xd9, 0xb0, 0x23, 0x62, 0x2f, 0xf5, 0x4c, 0x79, 0x3c, 0xaf, 0x3a, 0xdb,
-	0xa8, 0xdc, 0x93, 0xe3, 0xed, 0xf0, 0x56, 0xab, 0x24, 0x1f, 0xa1, 0xb7, 0xdf, 0x11, 0x9e, 0x00,
-	0xfb, 0xa9, 0x76, 0xbb, 0xd8, 0x5e, 0xf1, 0x39, 0x34, 0x37, 0x72, 0xb9, 0x56, 0xfc, 0x38, 0xd4,
-	0x2a, 0x70, 0x75, 0x7c, 0x19, 0x25, 0x1f, 0xa0, 0x5b, 0xeb, 0xe6, 0x7f, 0x52, 0x56, 0x93, 0x0e,
-	0x7e, 0x03, 0x4c, 0x49, 0x0b, 0xf5, 0x6b, 0xad, 0x4a, 0x8f, 0x19, 0xb4, 0xb6, 0x16, 0x46, 0x95,
-	0x3c, 0x0a, 0xb3, 0xbf, 0x38, 0x38, 0xbb, 0xd8, 0xb1, 0xf0, 0x13, 0x74, 0x3f, 0xab, 0xd2, 0x1b,
-	0x2b, 0xbd, 0x21, 0x1b, 0xec, 0x7b, 0xa3, 0xf3, 0xa7, 0x22, 0xad, 0x5c, 0x8d, 0x25, 0xea, 0x92,
-	0xf7, 0x6f, 0xe0, 0xf4, 0x1f, 0x06, 0x02, 0xc4, 0xb3, 0x1b, 0x31, 0xb9, 0x1e, 0x9f, 0x1c, 0x8d,
-	0xc6, 0xd0, 0x9b, 0x56, 0x39, 0x9b, 0x55, 0x31, 0xc3, 0x0b, 0x60, 0x53, 0xd2, 0x78, 0xf6, 0xf4,
-	0x9b, 0x87, 0x29, 0x92, 0xd3, 0xbd, 0x87, 0x6f, 0x64, 0x16, 0x83, 0xa3, 0xeb, 0xd7, 0xdf, 0x5f,
-	0x85, 0x6a, 0x56, 0x05, 0x32, 0x5f, 0xd2, 0x7a, 0x91, 0x69, 0x7a, 0x48, 0xe6, 0x8f, 0x38, 0x9c,
-	0x17, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x92, 0xa0, 0x62, 0xe2, 0xe1, 0x02, 0x00, 0x00,
+	proto.RegisterFile("orc8r/protos/logging_service.proto", fileDescriptor_logging_service_30d9a0e4779079fa)
+}
+
+var fileDescriptor_logging_service_30d9a0e4779079fa = []byte{
+	// 423 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x52, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0x5e, 0x96, 0x36, 0x69, 0x5f, 0xa4, 0xaa, 0x7b, 0x80, 0x16, 0x82, 0x98, 0x4a, 0xc5, 0xa1,
+	0x42, 0x28, 0x91, 0xba, 0xcb, 0x98, 0x38, 0xc0, 0x4a, 0x0f, 0x95, 0x02, 0x07, 0x17, 0x71, 0xe0,
+	0x52, 0x99, 0xd4, 0xf2, 0x2c, 0x1a, 0xbf, 0xe2, 0xb8, 0x9d, 0x76, 0x42, 0xe2, 0x97, 0xa3, 0x3a,
+	0xeb, 0x94, 0x8e, 0x49, 0x9c, 0xec, 0xef, 0xf9, 0xfb, 0x3e, 0xbf, 0xf7, 0xf4, 0xc1, 0x90, 0x4c,
+	0x71, 0x61, 0xb2, 0xb5, 0x21, 0x4b, 0x55, 0xb6, 0x22, 0x29, 0x95, 0x96, 0x8b, 0x4a, 0x98, 0xad,
+	0x2a, 0x44, 0xea, 0xca, 0x18, 0x95, 0x5c, 0x96, 0x3c, 0x75, 0xcc, 0xe4, 0xf9, 0x81, 0xa0, 0xa0,
+	0xb2, 0x24, 0x5d, 0xf3, 0x86, 0x7f, 0x7c, 0xe8, 0xe4, 0x24, 0xa7, 0xda, 0x9a, 0x5b, 0x4c, 0xa0,
+	0x53, 0x70, 0x2b, 0x24, 0x99, 0xdb, 0xd8, 0x1b, 0x78, 0xa3, 0x2e, 0xbb, 0xc7, 0x88, 0xd0, 0xb2,
+	0xaa, 0x14, 0xb1, 0x3f, 0xf0, 0x46, 0x3e, 0x73, 0x77, 0x7c, 0x02, 0xed, 0xeb, 0x9b, 0x85, 0x5a,
+	0xc6, 0x2d, 0x47, 0x6e, 0x5d, 0xdf, 0xcc, 0x96, 0x38, 0x01, 0xd0, 0x64, 0x4a, 0xbe, 0x5a, 0x94,
+	0x7c, 0x1d, 0xb7, 0x07, 0xfe, 0x28, 0x1a, 0xbf, 0x4e, 0x1b, 0xed, 0xa4, 0xfb, 0xff, 0xd2, 0x2f,
+	0x8e, 0xf7, 0x99, 0xaf, 0x1d, 0x64, 0x5d, 0xbd, 0xc7, 0x78, 0x09, 0xa1, 0xd2, 0xd6, 0x39, 0x04,
+	0xce, 0xe1, 0xd5, 0xe3, 0x0e, 0x33, 0x6d, 0xef, 0xe5, 0x81, 0x72, 0x00, 0x4f, 0x21, 0xb4, 0x7c,
+	0xb7, 0x0f, 0x1b, 0x87, 0x03, 0x7f, 0xd4, 0x65, 0x81, 0xe5, 0x72, 0x2e, 0x2c, 0x9e, 0xd5, 0x9d,
+	0x6d, 0x45, 0x61, 0xc9, 0xc4, 0x1d, 0xf7, 0xd6, 0xa8, 0x24, 0xef, 0xa1, 0x77, 0xd8, 0x11, 0xf6,
+	0xc1, 0xff, 0x29, 0xf6, 0xbb, 0xd8, 0x5d, 0xf1, 0x29, 0xb4, 0xb7, 0x7c, 0xb5, 0x11, 0xf1, 0xb1,
+	0xab, 0xd5, 0xe0, 0xf2, 0xf8, 0xc2, 0x4b, 0xde, 0x41, 0xd4, 0xe8, 0xe6, 0x7f, 0x52, 0xbf, 0x21,
+	0x1d, 0xfe, 0x06, 0xc8, 0x49, 0x32, 0xf1, 0x6b, 0x23, 0x2a, 0x8b, 0x19, 0x84, 0x3b, 0x0b, 0x25,
+	0xaa, 0xd8, 0x73, 0xb3, 0x3f, 0x7b, 0x74, 0x76, 0xb6, 0x67, 0xe1, 0x07, 0x88, 0x3e, 0x89, 0xca,
+	0x2a, 0xcd, 0xad, 0x22, 0xed, 0xec, 0x7b, 0xe3, 0xb3, 0x87, 0x22, 0x29, 0x4c, 0x83, 0xc5, 0x9a,
+	0x92, 0x37, 0x6f, 0xe1, 0xe4, 0x1f, 0x06, 0x02, 0x04, 0xf3, 0x09, 0x9b, 0x5d, 0x4d, 0xfb, 0x47,
+	0x18, 0x41, 0x38, 0xcd, 0x3f, 0xce, 0xbf, 0xce, 0x26, 0x7d, 0x6f, 0x3c, 0x85, 0x5e, 0x5e, 0x87,
+	0x6e, 0x5e, 0x67, 0x0e, 0xcf, 0xc1, 0xcf, 0x49, 0xe2, 0xe9, 0xc3, 0x3f, 0xef, 0x46, 0x4a, 0x4e,
+	0x0e, 0x1e, 0xbe, 0x91, 0x5a, 0x0e, 0x8f, 0xae, 0x5e, 0x7e, 0x7f, 0xe1, 0xaa, 0x59, 0x9d, 0xce,
+	0x62, 0x45, 0x9b, 0x65, 0x26, 0xe9, 0x2e, 0xa6, 0x3f, 0x02, 0x77, 0x9e, 0xff, 0x1d, 0x00, 0xc0,
+	0x2b, 0x1e, 0x62, 0xee, 0x02, 0x00, 0x00,
 }
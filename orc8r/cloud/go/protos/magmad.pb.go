@@ -47,7 +47,7 @@ func (x NetworkInterface_Status) String() string {
 	return proto.EnumName(NetworkInterface_Status_name, int32(x))
 }
 func (NetworkInterface_Status) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_magmad_2d8fc06d9226d13d, []int{19, 0}
+	return fileDescriptor_magmad_4177ea4cbc381815, []int{19, 0}
 }
 
 // RequestedAction is an emergency/last resort operation request for an
@@ -76,7 +76,7 @@ func (x CheckinResponse_RequestedAction) String() string {
 	return proto.EnumName(CheckinResponse_RequestedAction_name, int32(x))
 }
 func (CheckinResponse_RequestedAction) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_magmad_2d8fc06d9226d13d, []int{25, 0}
+	return fileDescriptor_magmad_4177ea4cbc381815, []int{25, 0}
 }
 
 type PingParams struct {
@@ -91,7 +91,7 @@ func (m *PingParams) Reset()         { *m = PingParams{} }
 func (m *PingParams) String() string { return proto.CompactTextString(m) }
 func (*PingParams) ProtoMessage()    {}
 func (*PingParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_magmad_2d8fc06d9226d13d, []int{0}
+	return fileDescriptor_magmad_4177ea4cbc381815, []int{0}
 }
 func (m *PingParams) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PingParams.Unmarshal(m, b)
@@ -138,7 +138,7 @@ func (m *TracerouteParams) Reset()         { *m = TracerouteParams{} }
 func (m *TracerouteParams) String() string { return proto.CompactTextString(m) }
 func (*TracerouteParams) ProtoMessage()    {}
 func (*TracerouteParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_magmad_2d8fc06d9226d13d, []int{1}
+	return fileDescriptor_magmad_4177ea4cbc381815, []int{1}
 }
 func (m *TracerouteParams) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TracerouteParams.Unmarshal(m, b)
@@ -191,7 +191,7 @@ func (m *NetworkTestRequest) Reset()         { *m = NetworkTestRequest{} }
 func (m *NetworkTestRequest) String() string { return proto.CompactTextString(m) }
 func (*NetworkTestRequest) ProtoMessage()    {}
 func (*NetworkTestRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_magmad_2d8fc06d9226d13d, []int{2}
+	return fileDescriptor_magmad_4177ea4cbc381815, []int{2}
 }
 func (m *NetworkTestRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NetworkTestRequest.Unmarshal(m, b)
@@ -241,7 +241,7 @@ func (m *PingResult) Reset()         { *m = PingResult{} }
 func (m *PingResult) String() string { return proto.CompactTextString(m) }
 func (*PingResult) ProtoMessage()    {}
 func (*PingResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_magmad_2d8fc06d9226d13d, []int{3}
+	return fileDescriptor_magmad_4177ea4cbc381815, []int{3}
 }
 func (m *PingResult) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PingResult.Unmarshal(m, b)
@@ -316,7 +316,7 @@ func (m *TracerouteProbe) Reset()         { *m = TracerouteProbe{} }
 func (m *TracerouteProbe) String() string { return proto.CompactTextString(m) }
 func (*TracerouteProbe) ProtoMessage()    {}
 func (*TracerouteProbe) Descriptor() ([]byte, []int) {
-	return fileDescriptor_magmad_2d8fc06d9226d13d, []int{4}
+	return fileDescriptor_magmad_4177ea4cbc381815, []int{4}
 }
 func (m *TracerouteProbe) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TracerouteProbe.Unmarshal(m, b)
@@ -369,7 +369,7 @@ func (m *TracerouteHop) Reset()         { *m = TracerouteHop{} }
 func (m *TracerouteHop) String() string { return proto.CompactTextString(m) }
 func (*TracerouteHop) ProtoMessage()    {}
 func (*TracerouteHop) Descriptor() ([]byte, []int) {
-	return fileDescriptor_magmad_2d8fc06d9226d13d, []int{5}
+	return fileDescriptor_magmad_4177ea4cbc381815, []int{5}
 }
 func (m *TracerouteHop) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TracerouteHop.Unmarshal(m, b)
@@ -416,7 +416,7 @@ func (m *TracerouteResult) Reset()         { *m = TracerouteResult{} }
 func (m *TracerouteResult) String() string { return proto.CompactTextString(m) }
 func (*TracerouteResult) ProtoMessage()    {}
 func (*TracerouteResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_magmad_2d8fc06d9226d13d, []int{6}
+	return fileDescriptor_magmad_4177ea4cbc381815, []int{6}
 }
 func (m *TracerouteResult) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TracerouteResult.Unmarshal(m, b)
@@ -469,7 +469,7 @@ func (m *NetworkTestResponse) Reset()         { *m = NetworkTestResponse{} }
 func (m *NetworkTestResponse) String() string { return proto.CompactTextString(m) }
 func (*NetworkTestResponse) ProtoMessage()    {}
 func (*NetworkTestResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_magmad_2d8fc06d9226d13d, []int{7}
+	return fileDescriptor_magmad_4177ea4cbc381815, []int{7}
 }
 func (m *NetworkTestResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NetworkTestResponse.Unmarshal(m, b)
@@ -514,7 +514,7 @@ func (m *GetGatewayIdResponse) Reset()         { *m = GetGatewayIdResponse{} }
 func (m *GetGatewayIdResponse) String() string { return proto.CompactTextString(m) }
 func (*GetGatewayIdResponse) ProtoMessage()    {}
 func (*GetGatewayIdResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_magmad_2d8fc06d9226d13d, []int{8}
+	return fileDescriptor_magmad_4177ea4cbc381815, []int{8}
 }
 func (m *GetGatewayIdResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetGatewayIdResponse.Unmarshal(m, b)
@@ -552,7 +552,7 @@ func (m *RestartServicesRequest) Reset()         { *m = RestartServicesRequest{}
 func (m *RestartServicesRequest) String() string { return proto.CompactTextString(m) }
 func (*RestartServicesRequest) ProtoMessage()    {}
 func (*RestartServicesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_magmad_2d8fc06d9226d13d, []int{9}
+	return fileDescriptor_magmad_4177ea4cbc381815, []int{9}
 }
 func (m *RestartServicesRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RestartServicesRequest.Unmarshal(m, b)
@@ -591,7 +591,7 @@ func (m *GenericCommandParams) Reset()         { *m = GenericCommandParams{} }
 func (m *GenericCommandParams) String() string { return proto.CompactTextString(m) }
 func (*GenericCommandParams) ProtoMessage()    {}
 func (*GenericCommandParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_magmad_2d8fc06d9226d13d, []int{10}
+	return fileDescriptor_magmad_4177ea4cbc381815, []int{10}
 }
 func (m *GenericCommandParams) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GenericCommandParams.Unmarshal(m, b)
@@ -636,7 +636,7 @@ func (m *GenericCommandResponse) Reset()         { *m = GenericCommandResponse{}
 func (m *GenericCommandResponse) String() string { return proto.CompactTextString(m) }
 func (*GenericCommandResponse) ProtoMessage()    {}
 func (*GenericCommandResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_magmad_2d8fc06d9226d13d, []int{11}
+	return fileDescriptor_magmad_4177ea4cbc381815, []int{11}
 }
 func (m *GenericCommandResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GenericCommandResponse.Unmarshal(m, b)
@@ -674,7 +674,7 @@ func (m *TailLogsRequest) Reset()         { *m = TailLogsRequest{} }
 func (m *TailLogsRequest) String() string { return proto.CompactTextString(m) }
 func (*TailLogsRequest) ProtoMessage()    {}
 func (*TailLogsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_magmad_2d8fc06d9226d13d, []int{12}
+	return fileDescriptor_magmad_4177ea4cbc381815, []int{12}
 }
 func (m *TailLogsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TailLogsRequest.Unmarshal(m, b)
@@ -712,7 +712,7 @@ func (m *LogLine) Reset()         { *m = LogLine{} }
 func (m *LogLine) String() string { return proto.CompactTextString(m) }
 func (*LogLine) ProtoMessage()    {}
 func (*LogLine) Descriptor() ([]byte, []int) {
-	return fileDescriptor_magmad_2d8fc06d9226d13d, []int{13}
+	return fileDescriptor_magmad_4177ea4cbc381815, []int{13}
 }
 func (m *LogLine) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LogLine.Unmarshal(m, b)
@@ -755,7 +755,7 @@ func (m *DiskPartition) Reset()         { *m = DiskPartition{} }
 func (m *DiskPartition) String() string { return proto.CompactTextString(m) }
 func (*DiskPartition) ProtoMessage()    {}
 func (*DiskPartition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_magmad_2d8fc06d9226d13d, []int{14}
+	return fileDescriptor_magmad_4177ea4cbc381815, []int{14}
 }
 func (m *DiskPartition) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DiskPartition.Unmarshal(m, b)
@@ -834,7 +834,7 @@ func (m *SystemStatus) Reset()         { *m = SystemStatus{} }
 func (m *SystemStatus) String() string { return proto.CompactTextString(m) }
 func (*SystemStatus) ProtoMessage()    {}
 func (*SystemStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_magmad_2d8fc06d9226d13d, []int{15}
+	return fileDescriptor_magmad_4177ea4cbc381815, []int{15}
 }
 func (m *SystemStatus) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SystemStatus.Unmarshal(m, b)
@@ -959,7 +959,7 @@ func (m *Package) Reset()         { *m = Package{} }
 func (m *Package) String() string { return proto.CompactTextString(m) }
 func (*Package) ProtoMessage()    {}
 func (*Package) Descriptor() ([]byte, []int) {
-	return fileDescriptor_magmad_2d8fc06d9226d13d, []int{16}
+	return fileDescriptor_magmad_4177ea4cbc381815, []int{16}
 }
 func (m *Package) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Package.Unmarshal(m, b)
@@ -1005,7 +1005,7 @@ func (m *ConfigInfo) Reset()         { *m = ConfigInfo{} }
 func (m *ConfigInfo) String() string { return proto.CompactTextString(m) }
 func (*ConfigInfo) ProtoMessage()    {}
 func (*ConfigInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_magmad_2d8fc06d9226d13d, []int{17}
+	return fileDescriptor_magmad_4177ea4cbc381815, []int{17}
 }
 func (m *ConfigInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConfigInfo.Unmarshal(m, b)
@@ -1052,7 +1052,7 @@ func (m *PlatformInfo) Reset()         { *m = PlatformInfo{} }
 func (m *PlatformInfo) String() string { return proto.CompactTextString(m) }
 func (*PlatformInfo) ProtoMessage()    {}
 func (*PlatformInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_magmad_2d8fc06d9226d13d, []int{18}
+	return fileDescriptor_magmad_4177ea4cbc381815, []int{18}
 }
 func (m *PlatformInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PlatformInfo.Unmarshal(m, b)
@@ -1127,7 +1127,7 @@ func (m *NetworkInterface) Reset()         { *m = NetworkInterface{} }
 func (m *NetworkInterface) String() string { return proto.CompactTextString(m) }
 func (*NetworkInterface) ProtoMessage()    {}
 func (*NetworkInterface) Descriptor() ([]byte, []int) {
-	return fileDescriptor_magmad_2d8fc06d9226d13d, []int{19}
+	return fileDescriptor_magmad_4177ea4cbc381815, []int{19}
 }
 func (m *NetworkInterface) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NetworkInterface.Unmarshal(m, b)
@@ -1200,7 +1200,7 @@ func (m *Route) Reset()         { *m = Route{} }
 func (m *Route) String() string { return proto.CompactTextString(m) }
 func (*Route) ProtoMessage()    {}
 func (*Route) Descriptor() ([]byte, []int) {
-	return fileDescriptor_magmad_2d8fc06d9226d13d, []int{20}
+	return fileDescriptor_magmad_4177ea4cbc381815, []int{20}
 }
 func (m *Route) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Route.Unmarshal(m, b)
@@ -1262,7 +1262,7 @@ func (m *NetworkInfo) Reset()         { *m = NetworkInfo{} }
 func (m *NetworkInfo) String() string { return proto.CompactTextString(m) }
 func (*NetworkInfo) ProtoMessage()    {}
 func (*NetworkInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_magmad_2d8fc06d9226d13d, []int{21}
+	return fileDescriptor_magmad_4177ea4cbc381815, []int{21}
 }
 func (m *NetworkInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NetworkInfo.Unmarshal(m, b)
@@ -1314,7 +1314,7 @@ func (m *CPUInfo) Reset()         { *m = CPUInfo{} }
 func (m *CPUInfo) String() string { return proto.CompactTextString(m) }
 func (*CPUInfo) ProtoMessage()    {}
 func (*CPUInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_magmad_2d8fc06d9226d13d, []int{22}
+	return fileDescriptor_magmad_4177ea4cbc381815, []int{22}
 }
 func (m *CPUInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CPUInfo.Unmarshal(m, b)
@@ -1376,7 +1376,7 @@ func (m *MachineInfo) Reset()         { *m = MachineInfo{} }
 func (m *MachineInfo) String() string { return proto.CompactTextString(m) }
 func (*MachineInfo) ProtoMessage()    {}
 func (*MachineInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_magmad_2d8fc06d9226d13d, []int{23}
+	return fileDescriptor_magmad_4177ea4cbc381815, []int{23}
 }
 func (m *MachineInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MachineInfo.Unmarshal(m, b)
@@ -1442,7 +1442,7 @@ func (m *CheckinRequest) Reset()         { *m = CheckinRequest{} }
 func (m *CheckinRequest) String() string { return proto.CompactTextString(m) }
 func (*CheckinRequest) ProtoMessage()    {}
 func (*CheckinRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_magmad_2d8fc06d9226d13d, []int{24}
+	return fileDescriptor_magmad_4177ea4cbc381815, []int{24}
 }
 func (m *CheckinRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CheckinRequest.Unmarshal(m, b)
@@ -1541,7 +1541,7 @@ func (m *CheckinResponse) Reset()         { *m = CheckinResponse{} }
 func (m *CheckinResponse) String() string { return proto.CompactTextString(m) }
 func (*CheckinResponse) ProtoMessage()    {}
 func (*CheckinResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_magmad_2d8fc06d9226d13d, []int{25}
+	return fileDescriptor_magmad_4177ea4cbc381815, []int{25}
 }
 func (m *CheckinResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CheckinResponse.Unmarshal(m, b)
@@ -1580,18 +1580,23 @@ type GatewayStatus struct {
 	// the last checkin
 	Time uint64 `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
 	// Last checkin info that was received from the gateway
-	Checkin              *CheckinRequest `protobuf:"bytes,2,opt,name=checkin,proto3" json:"checkin,omitempty"`
-	CertExpirationTime   int64           `protobuf:"varint,3,opt,name=cert_expiration_time,json=certExpirationTime,proto3" json:"cert_expiration_time,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	Checkin            *CheckinRequest `protobuf:"bytes,2,opt,name=checkin,proto3" json:"checkin,omitempty"`
+	CertExpirationTime int64           `protobuf:"varint,3,opt,name=cert_expiration_time,json=certExpirationTime,proto3" json:"cert_expiration_time,omitempty"`
+	// Set once the gateway missed too many checkin intervals, cleared by its next
+	// checkin
+	Offline bool `protobuf:"varint,4,opt,name=offline,proto3" json:"offline,omitempty"`
+	// Unix time (in milliseconds) the gateway was flagged offline at
+	OfflineSince         uint64   `protobuf:"varint,5,opt,name=offline_since,json=offlineSince,proto3" json:"offline_since,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *GatewayStatus) Reset()         { *m = GatewayStatus{} }
 func (m *GatewayStatus) String() string { return proto.CompactTextString(m) }
 func (*GatewayStatus) ProtoMessage()    {}
 func (*GatewayStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_magmad_2d8fc06d9226d13d, []int{26}
+	return fileDescriptor_magmad_4177ea4cbc381815, []int{26}
 }
 func (m *GatewayStatus) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GatewayStatus.Unmarshal(m, b)
@@ -1632,6 +1637,20 @@ func (m *GatewayStatus) GetCertExpirationTime() int64 {
 	return 0
 }
 
+func (m *GatewayStatus) GetOffline() bool {
+	if m != nil {
+		return m.Offline
+	}
+	return false
+}
+
+func (m *GatewayStatus) GetOfflineSince() uint64 {
+	if m != nil {
+		return m.OfflineSince
+	}
+	return 0
+}
+
 type GatewayStatusRequest struct {
 	// Gateway's network id
 	NetworkId string `protobuf:"bytes,1,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
@@ -1646,7 +1665,7 @@ func (m *GatewayStatusRequest) Reset()         { *m = GatewayStatusRequest{} }
 func (m *GatewayStatusRequest) String() string { return proto.CompactTextString(m) }
 func (*GatewayStatusRequest) ProtoMessage()    {}
 func (*GatewayStatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_magmad_2d8fc06d9226d13d, []int{27}
+	return fileDescriptor_magmad_4177ea4cbc381815, []int{27}
 }
 func (m *GatewayStatusRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GatewayStatusRequest.Unmarshal(m, b)
@@ -1680,6 +1699,252 @@ func (m *GatewayStatusRequest) GetLogicalId() string {
 	return ""
 }
 
+// Condensed checkin kept in the status history of a gateway
+type CheckinHistoryEntry struct {
+	// Unix time (the number of milliseconds elapsed since January 1, 1970 UTC) of
+	// the checkin
+	Time uint64 `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
+	// Packages installed on the gateway
+	Packages []*Package `protobuf:"bytes,2,rep,name=packages,proto3" json:"packages,omitempty"`
+	// Running kernel version
+	KernelVersion string `protobuf:"bytes,3,opt,name=kernel_version,json=kernelVersion,proto3" json:"kernel_version,omitempty"`
+	// Gateway's IP address on VPN
+	VpnIp                string        `protobuf:"bytes,4,opt,name=vpn_ip,json=vpnIp,proto3" json:"vpn_ip,omitempty"`
+	SystemStatus         *SystemStatus `protobuf:"bytes,5,opt,name=system_status,json=systemStatus,proto3" json:"system_status,omitempty"`
+	CertExpirationTime   int64         `protobuf:"varint,6,opt,name=cert_expiration_time,json=certExpirationTime,proto3" json:"cert_expiration_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *CheckinHistoryEntry) Reset()         { *m = CheckinHistoryEntry{} }
+func (m *CheckinHistoryEntry) String() string { return proto.CompactTextString(m) }
+func (*CheckinHistoryEntry) ProtoMessage()    {}
+func (*CheckinHistoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_magmad_4177ea4cbc381815, []int{28}
+}
+func (m *CheckinHistoryEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CheckinHistoryEntry.Unmarshal(m, b)
+}
+func (m *CheckinHistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CheckinHistoryEntry.Marshal(b, m, deterministic)
+}
+func (dst *CheckinHistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckinHistoryEntry.Merge(dst, src)
+}
+func (m *CheckinHistoryEntry) XXX_Size() int {
+	return xxx_messageInfo_CheckinHistoryEntry.Size(m)
+}
+func (m *CheckinHistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckinHistoryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckinHistoryEntry proto.InternalMessageInfo
+
+func (m *CheckinHistoryEntry) GetTime() uint64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+func (m *CheckinHistoryEntry) GetPackages() []*Package {
+	if m != nil {
+		return m.Packages
+	}
+	return nil
+}
+
+func (m *CheckinHistoryEntry) GetKernelVersion() string {
+	if m != nil {
+		return m.KernelVersion
+	}
+	return ""
+}
+
+func (m *CheckinHistoryEntry) GetVpnIp() string {
+	if m != nil {
+		return m.VpnIp
+	}
+	return ""
+}
+
+func (m *CheckinHistoryEntry) GetSystemStatus() *SystemStatus {
+	if m != nil {
+		return m.SystemStatus
+	}
+	return nil
+}
+
+func (m *CheckinHistoryEntry) GetCertExpirationTime() int64 {
+	if m != nil {
+		return m.CertExpirationTime
+	}
+	return 0
+}
+
+type GatewayStatusHistoryRequest struct {
+	// Gateway's network id
+	NetworkId string `protobuf:"bytes,1,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+	// Gateway's logical id
+	LogicalId string `protobuf:"bytes,2,opt,name=logical_id,json=logicalId,proto3" json:"logical_id,omitempty"`
+	// Optional bounds (unix time in milliseconds) of the returned checkins
+	StartTime            uint64   `protobuf:"varint,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime              uint64   `protobuf:"varint,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GatewayStatusHistoryRequest) Reset()         { *m = GatewayStatusHistoryRequest{} }
+func (m *GatewayStatusHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*GatewayStatusHistoryRequest) ProtoMessage()    {}
+func (*GatewayStatusHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_magmad_4177ea4cbc381815, []int{29}
+}
+func (m *GatewayStatusHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GatewayStatusHistoryRequest.Unmarshal(m, b)
+}
+func (m *GatewayStatusHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GatewayStatusHistoryRequest.Marshal(b, m, deterministic)
+}
+func (dst *GatewayStatusHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatewayStatusHistoryRequest.Merge(dst, src)
+}
+func (m *GatewayStatusHistoryRequest) XXX_Size() int {
+	return xxx_messageInfo_GatewayStatusHistoryRequest.Size(m)
+}
+func (m *GatewayStatusHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatewayStatusHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GatewayStatusHistoryRequest proto.InternalMessageInfo
+
+func (m *GatewayStatusHistoryRequest) GetNetworkId() string {
+	if m != nil {
+		return m.NetworkId
+	}
+	return ""
+}
+
+func (m *GatewayStatusHistoryRequest) GetLogicalId() string {
+	if m != nil {
+		return m.LogicalId
+	}
+	return ""
+}
+
+func (m *GatewayStatusHistoryRequest) GetStartTime() uint64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *GatewayStatusHistoryRequest) GetEndTime() uint64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
+type GatewayStatusHistory struct {
+	// Checkins ordered from the oldest to the newest
+	Entries              []*CheckinHistoryEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *GatewayStatusHistory) Reset()         { *m = GatewayStatusHistory{} }
+func (m *GatewayStatusHistory) String() string { return proto.CompactTextString(m) }
+func (*GatewayStatusHistory) ProtoMessage()    {}
+func (*GatewayStatusHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_magmad_4177ea4cbc381815, []int{30}
+}
+func (m *GatewayStatusHistory) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GatewayStatusHistory.Unmarshal(m, b)
+}
+func (m *GatewayStatusHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GatewayStatusHistory.Marshal(b, m, deterministic)
+}
+func (dst *GatewayStatusHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatewayStatusHistory.Merge(dst, src)
+}
+func (m *GatewayStatusHistory) XXX_Size() int {
+	return xxx_messageInfo_GatewayStatusHistory.Size(m)
+}
+func (m *GatewayStatusHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatewayStatusHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GatewayStatusHistory proto.InternalMessageInfo
+
+func (m *GatewayStatusHistory) GetEntries() []*CheckinHistoryEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+// Position of the gateway's checkins in its bounded status history
+type GatewayStatusHistoryIndex struct {
+	// Slot the next checkin will be written to
+	NextSlot uint32 `protobuf:"varint,1,opt,name=next_slot,json=nextSlot,proto3" json:"next_slot,omitempty"`
+	// Number of used slots
+	NumEntries uint32 `protobuf:"varint,2,opt,name=num_entries,json=numEntries,proto3" json:"num_entries,omitempty"`
+	// Number of slots the history was written with
+	Capacity             uint32   `protobuf:"varint,3,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GatewayStatusHistoryIndex) Reset()         { *m = GatewayStatusHistoryIndex{} }
+func (m *GatewayStatusHistoryIndex) String() string { return proto.CompactTextString(m) }
+func (*GatewayStatusHistoryIndex) ProtoMessage()    {}
+func (*GatewayStatusHistoryIndex) Descriptor() ([]byte, []int) {
+	return fileDescriptor_magmad_4177ea4cbc381815, []int{31}
+}
+func (m *GatewayStatusHistoryIndex) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GatewayStatusHistoryIndex.Unmarshal(m, b)
+}
+func (m *GatewayStatusHistoryIndex) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GatewayStatusHistoryIndex.Marshal(b, m, deterministic)
+}
+func (dst *GatewayStatusHistoryIndex) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatewayStatusHistoryIndex.Merge(dst, src)
+}
+func (m *GatewayStatusHistoryIndex) XXX_Size() int {
+	return xxx_messageInfo_GatewayStatusHistoryIndex.Size(m)
+}
+func (m *GatewayStatusHistoryIndex) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatewayStatusHistoryIndex.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GatewayStatusHistoryIndex proto.InternalMessageInfo
+
+func (m *GatewayStatusHistoryIndex) GetNextSlot() uint32 {
+	if m != nil {
+		return m.NextSlot
+	}
+	return 0
+}
+
+func (m *GatewayStatusHistoryIndex) GetNumEntries() uint32 {
+	if m != nil {
+		return m.NumEntries
+	}
+	return 0
+}
+
+func (m *GatewayStatusHistoryIndex) GetCapacity() uint32 {
+	if m != nil {
+		return m.Capacity
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*PingParams)(nil), "magma.orc8r.PingParams")
 	proto.RegisterType((*TracerouteParams)(nil), "magma.orc8r.TracerouteParams")
@@ -1709,6 +1974,10 @@ func init() {
 	proto.RegisterType((*CheckinResponse)(nil), "magma.orc8r.CheckinResponse")
 	proto.RegisterType((*GatewayStatus)(nil), "magma.orc8r.GatewayStatus")
 	proto.RegisterType((*GatewayStatusRequest)(nil), "magma.orc8r.GatewayStatusRequest")
+	proto.RegisterType((*CheckinHistoryEntry)(nil), "magma.orc8r.CheckinHistoryEntry")
+	proto.RegisterType((*GatewayStatusHistoryRequest)(nil), "magma.orc8r.GatewayStatusHistoryRequest")
+	proto.RegisterType((*GatewayStatusHistory)(nil), "magma.orc8r.GatewayStatusHistory")
+	proto.RegisterType((*GatewayStatusHistoryIndex)(nil), "magma.orc8r.GatewayStatusHistoryIndex")
 	proto.RegisterEnum("magma.orc8r.NetworkInterface_Status", NetworkInterface_Status_name, NetworkInterface_Status_value)
 	proto.RegisterEnum("magma.orc8r.CheckinResponse_RequestedAction", CheckinResponse_RequestedAction_name, CheckinResponse_RequestedAction_value)
 }
@@ -2138,6 +2407,8 @@ type CheckindClient interface {
 	Checkin(ctx context.Context, in *CheckinRequest, opts ...grpc.CallOption) (*CheckinResponse, error)
 	// Gateway real time status retrieval from the GW's network table
 	GetStatus(ctx context.Context, in *GatewayStatusRequest, opts ...grpc.CallOption) (*GatewayStatus, error)
+	// Bounded history of the GW's checkins
+	GetStatusHistory(ctx context.Context, in *GatewayStatusHistoryRequest, opts ...grpc.CallOption) (*GatewayStatusHistory, error)
 	// Removes GW status record from the GW's network table
 	DeleteGatewayStatus(ctx context.Context, in *GatewayStatusRequest, opts ...grpc.CallOption) (*Void, error)
 	// Deletes the network's status table (the table must be emptied prior to removal)
@@ -2173,6 +2444,15 @@ func (c *checkindClient) GetStatus(ctx context.Context, in *GatewayStatusRequest
 	return out, nil
 }
 
+func (c *checkindClient) GetStatusHistory(ctx context.Context, in *GatewayStatusHistoryRequest, opts ...grpc.CallOption) (*GatewayStatusHistory, error) {
+	out := new(GatewayStatusHistory)
+	err := c.cc.Invoke(ctx, "/magma.orc8r.Checkind/GetStatusHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *checkindClient) DeleteGatewayStatus(ctx context.Context, in *GatewayStatusRequest, opts ...grpc.CallOption) (*Void, error) {
 	out := new(Void)
 	err := c.cc.Invoke(ctx, "/magma.orc8r.Checkind/DeleteGatewayStatus", in, out, opts...)
@@ -2206,6 +2486,8 @@ type CheckindServer interface {
 	Checkin(context.Context, *CheckinRequest) (*CheckinResponse, error)
 	// Gateway real time status retrieval from the GW's network table
 	GetStatus(context.Context, *GatewayStatusRequest) (*GatewayStatus, error)
+	// Bounded history of the GW's checkins
+	GetStatusHistory(context.Context, *GatewayStatusHistoryRequest) (*GatewayStatusHistory, error)
 	// Removes GW status record from the GW's network table
 	DeleteGatewayStatus(context.Context, *GatewayStatusRequest) (*Void, error)
 	// Deletes the network's status table (the table must be emptied prior to removal)
@@ -2255,6 +2537,24 @@ func _Checkind_GetStatus_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Checkind_GetStatusHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GatewayStatusHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CheckindServer).GetStatusHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.orc8r.Checkind/GetStatusHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CheckindServer).GetStatusHistory(ctx, req.(*GatewayStatusHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Checkind_DeleteGatewayStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GatewayStatusRequest)
 	if err := dec(in); err != nil {
@@ -2321,6 +2621,10 @@ var _Checkind_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetStatus",
 			Handler:    _Checkind_GetStatus_Handler,
 		},
+		{
+			MethodName: "GetStatusHistory",
+			Handler:    _Checkind_GetStatusHistory_Handler,
+		},
 		{
 			MethodName: "DeleteGatewayStatus",
 			Handler:    _Checkind_DeleteGatewayStatus_Handler,
@@ -2338,136 +2642,149 @@ var _Checkind_serviceDesc = grpc.ServiceDesc{
 	Metadata: "orc8r/protos/magmad.proto",
 }
 
-func init() { proto.RegisterFile("orc8r/protos/magmad.proto", fileDescriptor_magmad_2d8fc06d9226d13d) }
-
-var fileDescriptor_magmad_2d8fc06d9226d13d = []byte{
-	// 2046 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x58, 0xcd, 0x6e, 0x1b, 0xc9,
-	0x11, 0xd6, 0x50, 0xfc, 0x11, 0x8b, 0x3f, 0xa2, 0xdb, 0x5a, 0x2f, 0x4d, 0x5b, 0xb0, 0x3d, 0xce,
-	0x8f, 0x17, 0xd9, 0x48, 0x86, 0xbc, 0xde, 0x5d, 0xec, 0x2e, 0x1c, 0xc8, 0x92, 0x6c, 0x13, 0x96,
-	0x64, 0xa2, 0x49, 0x7b, 0x91, 0xbd, 0x0c, 0x5a, 0x33, 0x4d, 0x6a, 0x20, 0xce, 0xf4, 0xa4, 0xbb,
-	0x49, 0xdb, 0xc8, 0x35, 0xc9, 0x29, 0xc7, 0x24, 0x40, 0x5e, 0x22, 0x87, 0x9c, 0xf2, 0x24, 0x79,
-	0x82, 0xe4, 0x0d, 0xf2, 0x00, 0x41, 0xff, 0xcc, 0x70, 0x86, 0xa6, 0x14, 0x27, 0x39, 0x71, 0xba,
-	0xfe, 0xba, 0xaa, 0xba, 0xea, 0xab, 0x6e, 0xc2, 0x4d, 0xc6, 0xfd, 0xaf, 0xf9, 0x6e, 0xc2, 0x99,
-	0x64, 0x62, 0x37, 0x22, 0x93, 0x88, 0x04, 0x3b, 0x7a, 0x85, 0x1a, 0x7a, 0xb5, 0xa3, 0x05, 0x7a,
-	0x45, 0x39, 0x9f, 0x45, 0x11, 0x8b, 0x8d, 0x5c, 0xaf, 0x57, 0x34, 0xe1, 0xb3, 0x78, 0x1c, 0x4e,
-	0x2c, 0x6f, 0xbb, 0xc0, 0x13, 0x94, 0xcf, 0x43, 0x9f, 0x3e, 0x7a, 0xf8, 0xc8, 0xb2, 0x6f, 0x4f,
-	0x18, 0x9b, 0x4c, 0xa9, 0xe1, 0x9f, 0xcd, 0xc6, 0xbb, 0x42, 0xf2, 0x99, 0x2f, 0x0d, 0xd7, 0x7d,
-	0x09, 0x30, 0x08, 0xe3, 0xc9, 0x80, 0x70, 0x12, 0x09, 0x74, 0x1b, 0xe0, 0x9c, 0x09, 0xe9, 0x31,
-	0xee, 0x85, 0x49, 0xd7, 0xb9, 0xeb, 0x3c, 0xa8, 0xe3, 0x0d, 0x45, 0x79, 0xc5, 0xfb, 0x09, 0xba,
-	0x03, 0x8d, 0x78, 0x16, 0x79, 0x09, 0xf1, 0x2f, 0xa8, 0x14, 0xdd, 0xd2, 0x5d, 0xe7, 0x41, 0x05,
-	0x43, 0x3c, 0x8b, 0x06, 0x86, 0xe2, 0xce, 0xa0, 0x33, 0xe2, 0xc4, 0xa7, 0x9c, 0xcd, 0x24, 0xfd,
-	0x28, 0x93, 0x37, 0x61, 0x23, 0x22, 0xef, 0xbc, 0x73, 0x96, 0xa4, 0xf6, 0x6a, 0x11, 0x79, 0xf7,
-	0x82, 0x25, 0x02, 0x3d, 0x80, 0xce, 0xd9, 0x7b, 0x49, 0x85, 0x97, 0x50, 0x6e, 0xf7, 0xec, 0xae,
-	0x6b, 0x91, 0xb6, 0xa6, 0x0f, 0x28, 0x37, 0xfb, 0xba, 0xbf, 0x71, 0x00, 0x9d, 0x52, 0xf9, 0x96,
-	0xf1, 0x8b, 0x11, 0x15, 0x12, 0xd3, 0x5f, 0xcd, 0xa8, 0x90, 0xe8, 0xe7, 0x50, 0x49, 0xc2, 0x78,
-	0x22, 0xba, 0xce, 0xdd, 0xf5, 0x07, 0x8d, 0xbd, 0x4f, 0x77, 0x72, 0xb9, 0xde, 0x59, 0x04, 0x8d,
-	0x8d, 0x14, 0xfa, 0x05, 0x34, 0x64, 0xe6, 0xbc, 0xf2, 0x46, 0x29, 0x6d, 0x17, 0x94, 0x96, 0x83,
-	0xc3, 0x79, 0x0d, 0xf7, 0x9f, 0x8e, 0xc9, 0x25, 0xa6, 0x62, 0x36, 0x95, 0xff, 0x67, 0x2e, 0xd1,
-	0x16, 0x54, 0x28, 0xe7, 0x8c, 0xeb, 0x98, 0xeb, 0xd8, 0x2c, 0xd0, 0x2e, 0x5c, 0xb7, 0x2a, 0x9e,
-	0xe4, 0x24, 0x16, 0x51, 0x28, 0x25, 0x0d, 0xba, 0x65, 0xad, 0x8e, 0x2c, 0x6b, 0xb4, 0xe0, 0xa0,
-	0xcf, 0xa0, 0x93, 0x2a, 0x70, 0xea, 0xd3, 0x70, 0x4e, 0x83, 0x6e, 0x45, 0x4b, 0x6f, 0x5a, 0x3a,
-	0xb6, 0x64, 0xf4, 0x13, 0xd8, 0x24, 0xf3, 0x89, 0xc7, 0xa9, 0x48, 0x58, 0x2c, 0xa8, 0x17, 0x89,
-	0x6e, 0xf5, 0xae, 0xf3, 0xa0, 0x84, 0x5b, 0x64, 0xae, 0x82, 0xd2, 0xd4, 0x13, 0xe1, 0x8e, 0x60,
-	0x33, 0x97, 0x08, 0xce, 0xce, 0x28, 0xea, 0x81, 0x8e, 0x2c, 0x26, 0x11, 0xcd, 0x47, 0xaa, 0xd6,
-	0xa8, 0x0d, 0xa5, 0x30, 0xd1, 0x01, 0xd6, 0x71, 0x29, 0x4c, 0xd0, 0x27, 0x50, 0xe5, 0x52, 0x2a,
-	0xeb, 0xeb, 0xda, 0x7a, 0x85, 0x4b, 0x79, 0x22, 0xdc, 0xef, 0xa1, 0xb5, 0xb0, 0xfa, 0x82, 0x25,
-	0xa8, 0x03, 0xeb, 0x61, 0xf0, 0x4e, 0x9b, 0xab, 0x60, 0xf5, 0x89, 0xbe, 0x80, 0x6a, 0xa2, 0xb6,
-	0x4b, 0x0f, 0xe7, 0xf6, 0x65, 0x87, 0xa3, 0x84, 0xb0, 0x95, 0x75, 0xe7, 0xf9, 0xa2, 0xb4, 0x67,
-	0x93, 0x25, 0xd7, 0xc9, 0x27, 0xb7, 0x78, 0x62, 0xa5, 0xa5, 0x13, 0xdb, 0x81, 0xb2, 0x2e, 0xd3,
-	0x75, 0xbd, 0x77, 0xef, 0x92, 0xbd, 0x5f, 0xb0, 0x04, 0x6b, 0x39, 0xf7, 0xb7, 0x0e, 0x5c, 0x2f,
-	0x54, 0xa5, 0x49, 0xe0, 0x7f, 0x2e, 0x4b, 0xe3, 0xe3, 0xff, 0x54, 0x96, 0x56, 0xb5, 0x50, 0x96,
-	0x8f, 0x61, 0xeb, 0x39, 0x95, 0xcf, 0x89, 0xa4, 0x6f, 0xc9, 0xfb, 0x7e, 0x90, 0xf9, 0xb1, 0x0d,
-	0x30, 0x31, 0x44, 0x2f, 0x0c, 0x6c, 0x22, 0xea, 0x93, 0x54, 0xcc, 0xfd, 0x02, 0x6e, 0x60, 0x2a,
-	0x24, 0xe1, 0x72, 0x68, 0x10, 0x45, 0xa4, 0x7d, 0xd5, 0x83, 0x0d, 0x0b, 0x32, 0x26, 0x86, 0x3a,
-	0xce, 0xd6, 0x2e, 0x51, 0x9b, 0xc5, 0x94, 0x87, 0xfe, 0x01, 0x8b, 0x22, 0x12, 0x07, 0x16, 0x05,
-	0xba, 0x50, 0xf3, 0x0d, 0xc1, 0xee, 0x94, 0x2e, 0xd1, 0x2e, 0x54, 0x13, 0x2d, 0xa3, 0x13, 0xae,
-	0xf2, 0x61, 0xf0, 0x6a, 0x27, 0xc5, 0xab, 0x9d, 0xa1, 0xc6, 0x2b, 0x6c, 0xc5, 0xdc, 0x13, 0xb8,
-	0x51, 0xdc, 0x22, 0x8b, 0xe8, 0x11, 0x6c, 0xa4, 0xc5, 0xab, 0x77, 0xb9, 0xc2, 0x58, 0x26, 0xe8,
-	0xfe, 0x0c, 0x36, 0x47, 0x24, 0x9c, 0x1e, 0xb3, 0x49, 0x16, 0x60, 0x17, 0x6a, 0x36, 0xa0, 0xd4,
-	0x59, 0xbb, 0x74, 0xb7, 0xa1, 0x76, 0xcc, 0x26, 0xc7, 0x61, 0x4c, 0x11, 0x82, 0xf2, 0x34, 0x8c,
-	0x53, 0x09, 0xfd, 0xed, 0xfe, 0xce, 0x81, 0xd6, 0x61, 0x28, 0x2e, 0x06, 0x84, 0xcb, 0x50, 0x86,
-	0x2c, 0x46, 0x37, 0xa0, 0x1a, 0xd0, 0x9c, 0x25, 0xbb, 0x52, 0xed, 0x1f, 0xb1, 0x59, 0x2c, 0xbd,
-	0x84, 0x85, 0xb1, 0xb4, 0xb5, 0x06, 0x9a, 0x34, 0x50, 0x14, 0x55, 0xa1, 0x92, 0x49, 0x32, 0xd5,
-	0x4d, 0x52, 0xc6, 0x66, 0xa1, 0x36, 0x9d, 0x09, 0xdb, 0xef, 0x65, 0xac, 0xbf, 0x15, 0x6d, 0xcc,
-	0x29, 0xd5, 0x5d, 0x5d, 0xc6, 0xfa, 0xdb, 0xfd, 0xcb, 0x3a, 0x34, 0x87, 0xef, 0x85, 0xa4, 0xd1,
-	0x50, 0x12, 0x39, 0x13, 0x4a, 0x48, 0x86, 0xb6, 0x39, 0xcb, 0x58, 0x7f, 0x2b, 0xec, 0xf5, 0x93,
-	0x99, 0x37, 0x13, 0x94, 0x5b, 0xe5, 0x9a, 0x9f, 0xcc, 0x5e, 0x0b, 0xca, 0x55, 0x6d, 0x28, 0x96,
-	0xd0, 0x26, 0x34, 0x0a, 0x94, 0x71, 0xdd, 0x4f, 0x66, 0xc6, 0x66, 0xaa, 0x19, 0x06, 0x53, 0xda,
-	0xad, 0x65, 0x9a, 0xfd, 0x60, 0x4a, 0xd1, 0x2d, 0xa8, 0x47, 0x34, 0xf2, 0x8c, 0xef, 0xa0, 0x79,
-	0x1b, 0x11, 0x8d, 0x46, 0xda, 0xfd, 0xfb, 0xd0, 0x52, 0x4c, 0x32, 0x27, 0xe1, 0x94, 0x9c, 0x4d,
-	0x69, 0xb7, 0xa1, 0x05, 0x9a, 0x11, 0x8d, 0xf6, 0x53, 0x9a, 0x1e, 0x09, 0x34, 0xf2, 0x74, 0x9c,
-	0x4d, 0x63, 0x3c, 0xa2, 0xd1, 0x6b, 0x15, 0xaa, 0x65, 0xe9, 0x70, 0x5b, 0x19, 0xeb, 0x19, 0xa7,
-	0xba, 0x9a, 0xc5, 0x5b, 0x92, 0xd8, 0x8d, 0x37, 0x8d, 0xc7, 0x8a, 0x62, 0x76, 0xbe, 0x05, 0x7a,
-	0x61, 0xac, 0x76, 0x8c, 0x5b, 0x8a, 0xa0, 0xcd, 0xa6, 0x4c, 0x6d, 0xf7, 0xda, 0x82, 0xa9, 0x0d,
-	0xdf, 0x81, 0xc6, 0x2c, 0x51, 0xf9, 0xf2, 0x04, 0xf5, 0x45, 0xb7, 0xad, 0xd9, 0x60, 0x48, 0x43,
-	0xea, 0x0b, 0x74, 0x00, 0x9b, 0x41, 0x28, 0x2e, 0xbc, 0x24, 0x3d, 0x74, 0xd1, 0x45, 0x2b, 0x20,
-	0xa2, 0x50, 0x17, 0xb8, 0x1d, 0xe4, 0x97, 0xc2, 0xfd, 0x0a, 0x6a, 0x0a, 0xf8, 0xc9, 0x44, 0x17,
-	0x56, 0x0e, 0x47, 0xf5, 0xb7, 0xaa, 0xc8, 0x39, 0xe5, 0x22, 0x64, 0xb1, 0x2d, 0x95, 0x74, 0xe9,
-	0x7e, 0x03, 0x70, 0xa0, 0x2f, 0x03, 0xfd, 0x78, 0xcc, 0xd0, 0xe7, 0x80, 0xec, 0xdd, 0xc0, 0xf3,
-	0x39, 0x25, 0x92, 0x06, 0x1e, 0x91, 0xf6, 0xd0, 0x3b, 0x96, 0x73, 0x60, 0x18, 0xfb, 0xd2, 0xfd,
-	0x97, 0x03, 0xcd, 0xc1, 0x94, 0xc8, 0x31, 0xe3, 0x91, 0x56, 0xff, 0x04, 0xaa, 0xf3, 0x24, 0x5e,
-	0x8c, 0xab, 0xca, 0x3c, 0x89, 0xfb, 0x09, 0x7a, 0x08, 0x1b, 0x89, 0x71, 0x2e, 0xc5, 0x9f, 0xad,
-	0x22, 0x68, 0x19, 0x26, 0xce, 0xa4, 0xd0, 0x8f, 0xa1, 0x7d, 0x41, 0x79, 0x4c, 0xa7, 0x5e, 0xea,
-	0xb6, 0x99, 0x62, 0x2d, 0x43, 0x7d, 0x63, 0x88, 0xe8, 0x1b, 0xb8, 0x59, 0x14, 0x13, 0x5e, 0x18,
-	0x0b, 0x49, 0xa6, 0x53, 0x5d, 0xe3, 0x0a, 0x5a, 0x3e, 0x2d, 0x68, 0x88, 0x7e, 0xca, 0x46, 0x5f,
-	0x43, 0xc3, 0x46, 0x1a, 0xc6, 0x63, 0xa6, 0x0b, 0x78, 0x19, 0x4c, 0x17, 0x89, 0xc1, 0xe0, 0x67,
-	0xdf, 0xee, 0x9f, 0x4a, 0xd0, 0xb1, 0xc0, 0xdc, 0x8f, 0x25, 0xe5, 0x63, 0xe2, 0x53, 0xf4, 0x10,
-	0xb6, 0x62, 0x43, 0xf3, 0xc2, 0x94, 0xb8, 0xc0, 0x45, 0x14, 0x2f, 0xc9, 0xf7, 0x03, 0xf4, 0x1d,
-	0x54, 0x85, 0x6e, 0x2e, 0x7d, 0x24, 0xed, 0xbd, 0x1f, 0x15, 0xf6, 0x5e, 0xde, 0x60, 0xc7, 0x34,
-	0x22, 0xb6, 0x3a, 0x1a, 0x00, 0x88, 0xef, 0x91, 0x20, 0xe0, 0x54, 0x08, 0x9b, 0x1e, 0x88, 0x88,
-	0xbf, 0x6f, 0x28, 0xe8, 0x1e, 0x34, 0xc3, 0x24, 0xe5, 0x53, 0x61, 0xd3, 0xd1, 0x08, 0x93, 0xfd,
-	0x94, 0xa4, 0xb2, 0x1c, 0x26, 0xf3, 0x2f, 0x73, 0x42, 0x15, 0x2d, 0xd4, 0x52, 0xd4, 0x4c, 0xcc,
-	0xfd, 0x29, 0x54, 0x2d, 0x0a, 0x34, 0xa0, 0xf6, 0xfa, 0xf4, 0xe5, 0xe9, 0xab, 0xef, 0x4f, 0x3b,
-	0x6b, 0xa8, 0x0a, 0xa5, 0xd7, 0x83, 0x8e, 0x83, 0x36, 0xa0, 0x7c, 0xa8, 0x28, 0x25, 0xf7, 0xcf,
-	0x0e, 0x54, 0xb0, 0x1a, 0x1a, 0xca, 0x72, 0x40, 0x85, 0x0c, 0x63, 0xa2, 0xca, 0x73, 0x51, 0x10,
-	0xad, 0x1c, 0xb5, 0x9f, 0x14, 0x46, 0x48, 0x3a, 0x30, 0xb3, 0x11, 0x92, 0xa8, 0xaa, 0x9d, 0xd0,
-	0x38, 0x22, 0xe2, 0xc2, 0xc6, 0x97, 0x2e, 0x2f, 0xcd, 0x76, 0xf9, 0xb2, 0x6c, 0xbb, 0x7f, 0x70,
-	0xa0, 0x91, 0xe5, 0x74, 0xcc, 0xd0, 0x31, 0xa0, 0x0f, 0x2c, 0xa4, 0x23, 0x75, 0xfb, 0xca, 0x93,
-	0xc0, 0xd7, 0x96, 0xcd, 0x0b, 0xf4, 0x15, 0xb4, 0xd4, 0xb4, 0x0c, 0xe3, 0x89, 0x27, 0x35, 0x30,
-	0x99, 0x32, 0x47, 0x05, 0x43, 0x3a, 0x35, 0xb8, 0x69, 0x05, 0x47, 0x4a, 0xce, 0xfd, 0xa3, 0x03,
-	0xb5, 0x83, 0xc1, 0x6b, 0xed, 0x92, 0x02, 0x4d, 0xc6, 0xa9, 0xe7, 0x2b, 0x14, 0xb7, 0x4d, 0x57,
-	0x57, 0x94, 0x03, 0x45, 0x50, 0xf7, 0x59, 0x79, 0xce, 0x29, 0x09, 0xcc, 0x8d, 0x56, 0x31, 0x74,
-	0xca, 0xca, 0xb8, 0x6d, 0xe9, 0x03, 0xca, 0x0f, 0x18, 0xa7, 0xc8, 0x85, 0x26, 0xe1, 0xfe, 0x79,
-	0x28, 0xa9, 0x2f, 0x67, 0x9c, 0xda, 0xe4, 0x15, 0x68, 0x6a, 0xb3, 0x88, 0x05, 0x74, 0xea, 0x69,
-	0xac, 0x30, 0x79, 0xab, 0x6b, 0xca, 0x29, 0x89, 0xa8, 0xfb, 0x6b, 0x68, 0x9c, 0x10, 0xff, 0x3c,
-	0x8c, 0xa9, 0x76, 0x6d, 0xd7, 0x02, 0xb6, 0xea, 0x14, 0x33, 0x19, 0x8b, 0x1d, 0x6c, 0x43, 0x30,
-	0x30, 0xae, 0x14, 0xbe, 0x85, 0xe6, 0x22, 0xbd, 0x63, 0x66, 0x67, 0x73, 0x77, 0x75, 0x62, 0xc7,
-	0x0c, 0x37, 0xe2, 0xc5, 0xc2, 0xfd, 0xfb, 0x3a, 0xb4, 0x0f, 0xce, 0xa9, 0x7f, 0x11, 0xc6, 0xe9,
-	0x48, 0xbd, 0xfa, 0xb2, 0x81, 0xf6, 0xb2, 0x5e, 0x5a, 0xd7, 0x1b, 0x15, 0xa1, 0xd3, 0x5e, 0x40,
-	0x96, 0x3a, 0xe8, 0x09, 0xb4, 0xcc, 0x7c, 0xf2, 0xac, 0x6a, 0x59, 0xab, 0xde, 0x2c, 0xaa, 0xe6,
-	0x86, 0x20, 0x6e, 0x8a, 0xfc, 0x48, 0x7c, 0x02, 0xad, 0xc4, 0x82, 0x9f, 0x89, 0x71, 0x63, 0x85,
-	0x7e, 0x1e, 0x1e, 0x71, 0x33, 0xc9, 0x83, 0xe5, 0xb7, 0xd0, 0x8c, 0x4c, 0x8a, 0x8d, 0x7a, 0x7d,
-	0x45, 0x8a, 0x72, 0x67, 0x80, 0x1b, 0x51, 0xee, 0x40, 0x76, 0xe0, 0x9a, 0x96, 0xf3, 0x92, 0x8b,
-	0x89, 0x57, 0x80, 0xf6, 0xa7, 0xa5, 0xae, 0x83, 0x37, 0x35, 0x73, 0x70, 0x31, 0x49, 0x91, 0xf2,
-	0x66, 0x86, 0xcc, 0x95, 0x4c, 0xc8, 0xa2, 0xf3, 0x67, 0x1f, 0x60, 0x6d, 0x35, 0x13, 0x59, 0xc2,
-	0xdb, 0x27, 0x57, 0xe1, 0x6d, 0x4d, 0x61, 0x87, 0xd6, 0xba, 0x0c, 0x73, 0xdd, 0xbf, 0x3a, 0xb0,
-	0x99, 0x1d, 0xac, 0xbd, 0x74, 0x1d, 0x42, 0x95, 0xf8, 0x0a, 0x0f, 0xf4, 0xa9, 0xb6, 0xf7, 0x3e,
-	0x2f, 0x16, 0x56, 0x51, 0x7a, 0xc7, 0xd6, 0x03, 0x0d, 0xf6, 0xb5, 0x0e, 0xb6, 0xba, 0xd9, 0xfd,
-	0xa4, 0xb4, 0xb8, 0x9f, 0xb8, 0x47, 0xb0, 0xb9, 0x24, 0xae, 0xb0, 0xea, 0xf4, 0xd5, 0xe9, 0x51,
-	0x67, 0x0d, 0x6d, 0x41, 0x07, 0x1f, 0x0d, 0x47, 0xfb, 0x78, 0xe4, 0x0d, 0x8f, 0xf0, 0x9b, 0xfe,
-	0xc1, 0xd1, 0xb0, 0xe3, 0x20, 0x04, 0xed, 0x8c, 0xfa, 0xcb, 0xe1, 0xe8, 0xe8, 0xa4, 0x53, 0x72,
-	0x7f, 0xef, 0x40, 0xcb, 0xde, 0x7e, 0xaf, 0xb8, 0x0c, 0x3d, 0x86, 0x9a, 0x6f, 0x7c, 0xb5, 0xb5,
-	0x7e, 0x6b, 0x75, 0x1c, 0xda, 0x1f, 0x9c, 0xca, 0x2a, 0x20, 0xf3, 0x29, 0x97, 0x1e, 0x7d, 0x97,
-	0x84, 0xdc, 0x80, 0xa5, 0x36, 0xad, 0xca, 0x78, 0x1d, 0x23, 0xc5, 0x3b, 0xca, 0x58, 0x23, 0x15,
-	0xd5, 0x08, 0xb6, 0x0a, 0xde, 0xe4, 0x3a, 0x24, 0xeb, 0xb8, 0xac, 0x43, 0xd2, 0xae, 0x0a, 0x14,
-	0x7b, 0xca, 0x26, 0xa1, 0x4f, 0xa6, 0x8a, 0x6d, 0xa1, 0xd6, 0x52, 0xfa, 0xc1, 0xde, 0xdf, 0x2a,
-	0x50, 0x3d, 0xd1, 0x7f, 0x2c, 0x28, 0x2c, 0x1b, 0xe6, 0xaf, 0xed, 0xe8, 0x5a, 0x21, 0x92, 0x37,
-	0x2c, 0x0c, 0x7a, 0x1f, 0x92, 0xdc, 0x35, 0xf4, 0x25, 0x34, 0x87, 0x92, 0x25, 0xff, 0xb5, 0xde,
-	0x43, 0xa8, 0x62, 0x7a, 0xc6, 0x98, 0xfc, 0x68, 0x8d, 0x97, 0xea, 0x64, 0x0b, 0x6f, 0x0b, 0x74,
-	0xbf, 0x08, 0xb5, 0x2b, 0x5f, 0x1e, 0xab, 0x8d, 0x3d, 0x01, 0x18, 0x52, 0x69, 0x66, 0xbd, 0x40,
-	0xc5, 0x63, 0xb3, 0x99, 0xb6, 0xcc, 0x4b, 0xf5, 0x9f, 0x2f, 0xf4, 0x57, 0x84, 0x70, 0x95, 0x49,
-	0x77, 0x0d, 0xbd, 0x81, 0x4d, 0x3c, 0x8b, 0x73, 0x2f, 0x3d, 0x81, 0xee, 0xac, 0xc2, 0xc9, 0xdc,
-	0x5f, 0x13, 0xbd, 0xbb, 0x97, 0x0b, 0xd8, 0x67, 0xc9, 0x1a, 0x7a, 0x06, 0xcd, 0xfc, 0xbb, 0x6d,
-	0x95, 0x67, 0xf7, 0x8a, 0x9e, 0xad, 0x78, 0xe5, 0xb9, 0x6b, 0xe8, 0x07, 0x68, 0x17, 0xdf, 0x4b,
-	0x68, 0x59, 0xed, 0xc3, 0xf7, 0x5a, 0xef, 0xfe, 0x15, 0x22, 0x39, 0xdb, 0x4f, 0x61, 0x23, 0x7d,
-	0x3c, 0xa1, 0xa5, 0xd7, 0x78, 0xf1, 0x4d, 0xd5, 0x2b, 0xce, 0x1b, 0xfb, 0x88, 0x72, 0xd7, 0x1e,
-	0x3a, 0x7b, 0xff, 0x28, 0xc1, 0x86, 0x6d, 0xaf, 0x00, 0x3d, 0x83, 0x9a, 0xfd, 0x46, 0x57, 0x35,
-	0x60, 0xef, 0xf6, 0x55, 0x28, 0xe3, 0xae, 0xa1, 0x63, 0xa8, 0x3f, 0xa7, 0xd2, 0xf6, 0xfb, 0xbd,
-	0x55, 0x07, 0x58, 0xe8, 0xbe, 0x5e, 0xef, 0x72, 0x11, 0x77, 0x0d, 0x9d, 0xc0, 0xf5, 0x43, 0x3a,
-	0xa5, 0x92, 0x16, 0x71, 0xe4, 0x23, 0xec, 0xae, 0xac, 0xb8, 0xef, 0xa0, 0x65, 0xcc, 0xd9, 0x83,
-	0x47, 0x37, 0x56, 0xce, 0xd5, 0xc3, 0xd5, 0xda, 0x8f, 0xa1, 0x7c, 0x1c, 0x0a, 0x79, 0xa9, 0xd2,
-	0xf5, 0x02, 0xbd, 0x7f, 0xa8, 0x84, 0xdd, 0xb5, 0xa7, 0xdb, 0x3f, 0xdc, 0xd2, 0xf4, 0x5d, 0xf3,
-	0x6f, 0xa1, 0x3f, 0x65, 0xb3, 0x60, 0x77, 0xc2, 0xec, 0xdf, 0x86, 0x67, 0x55, 0xfd, 0xfb, 0xe8,
-	0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x60, 0xa5, 0x1f, 0x1e, 0xac, 0x14, 0x00, 0x00,
+func init() { proto.RegisterFile("orc8r/protos/magmad.proto", fileDescriptor_magmad_4177ea4cbc381815) }
+
+var fileDescriptor_magmad_4177ea4cbc381815 = []byte{
+	// 2242 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4b, 0x6f, 0x1b, 0xc9,
+	0x11, 0xd6, 0x50, 0x7c, 0xa9, 0x48, 0x4a, 0x74, 0x5b, 0xeb, 0xa5, 0x68, 0x0b, 0x2b, 0xcf, 0xe6,
+	0xa1, 0x45, 0x36, 0x92, 0x21, 0xef, 0x0b, 0xce, 0xc2, 0x81, 0x2c, 0xc9, 0x36, 0x61, 0x49, 0x16,
+	0x9a, 0xb4, 0x17, 0xd9, 0xcb, 0xa0, 0x35, 0xd3, 0xa4, 0x06, 0xe2, 0x4c, 0x4f, 0xba, 0x9b, 0xb2,
+	0x84, 0x5c, 0x93, 0x5c, 0x72, 0x4d, 0x02, 0xe4, 0x4f, 0xe4, 0x90, 0x53, 0xfe, 0x41, 0x0e, 0xb9,
+	0xe7, 0x1f, 0xe4, 0x1f, 0xe4, 0x07, 0x04, 0xfd, 0x98, 0xe1, 0x8c, 0x44, 0x6a, 0xbd, 0x79, 0x9c,
+	0xc8, 0xae, 0x57, 0x57, 0x55, 0x57, 0x7f, 0x55, 0xd3, 0xb0, 0xc6, 0xb8, 0xff, 0x15, 0xdf, 0x4e,
+	0x38, 0x93, 0x4c, 0x6c, 0x47, 0x64, 0x14, 0x91, 0x60, 0x4b, 0xaf, 0x50, 0x43, 0xaf, 0xb6, 0xb4,
+	0x40, 0xb7, 0x28, 0xe7, 0xb3, 0x28, 0x62, 0xb1, 0x91, 0xeb, 0x76, 0x8b, 0x26, 0x7c, 0x16, 0x0f,
+	0xc3, 0x91, 0xe5, 0xad, 0x17, 0x78, 0x82, 0xf2, 0x8b, 0xd0, 0xa7, 0x8f, 0x1f, 0x3d, 0xb6, 0xec,
+	0x07, 0x23, 0xc6, 0x46, 0x63, 0x6a, 0xf8, 0xa7, 0x93, 0xe1, 0xb6, 0x90, 0x7c, 0xe2, 0x4b, 0xc3,
+	0x75, 0x5f, 0x01, 0x9c, 0x84, 0xf1, 0xe8, 0x84, 0x70, 0x12, 0x09, 0xf4, 0x00, 0xe0, 0x8c, 0x09,
+	0xe9, 0x31, 0xee, 0x85, 0x49, 0xc7, 0xd9, 0x70, 0x36, 0x97, 0x70, 0x5d, 0x51, 0x5e, 0xf3, 0x5e,
+	0x82, 0x3e, 0x82, 0x46, 0x3c, 0x89, 0xbc, 0x84, 0xf8, 0xe7, 0x54, 0x8a, 0x4e, 0x69, 0xc3, 0xd9,
+	0xac, 0x60, 0x88, 0x27, 0xd1, 0x89, 0xa1, 0xb8, 0x13, 0x68, 0x0f, 0x38, 0xf1, 0x29, 0x67, 0x13,
+	0x49, 0xdf, 0xcb, 0xe4, 0x1a, 0xd4, 0x23, 0x72, 0xe9, 0x9d, 0xb1, 0x24, 0xb5, 0x57, 0x8b, 0xc8,
+	0xe5, 0x4b, 0x96, 0x08, 0xb4, 0x09, 0xed, 0xd3, 0x2b, 0x49, 0x85, 0x97, 0x50, 0x6e, 0xf7, 0xec,
+	0x2c, 0x6a, 0x91, 0x65, 0x4d, 0x3f, 0xa1, 0xdc, 0xec, 0xeb, 0xfe, 0xda, 0x01, 0x74, 0x4c, 0xe5,
+	0x3b, 0xc6, 0xcf, 0x07, 0x54, 0x48, 0x4c, 0x7f, 0x39, 0xa1, 0x42, 0xa2, 0x9f, 0x42, 0x25, 0x09,
+	0xe3, 0x91, 0xe8, 0x38, 0x1b, 0x8b, 0x9b, 0x8d, 0x9d, 0x0f, 0xb7, 0x72, 0xb9, 0xde, 0x9a, 0x06,
+	0x8d, 0x8d, 0x14, 0xfa, 0x39, 0x34, 0x64, 0xe6, 0xbc, 0xf2, 0x46, 0x29, 0xad, 0x17, 0x94, 0xae,
+	0x07, 0x87, 0xf3, 0x1a, 0xee, 0x3f, 0x1d, 0x93, 0x4b, 0x4c, 0xc5, 0x64, 0x2c, 0xff, 0xcb, 0x5c,
+	0xa2, 0x55, 0xa8, 0x50, 0xce, 0x19, 0xd7, 0x31, 0x2f, 0x61, 0xb3, 0x40, 0xdb, 0x70, 0xd7, 0xaa,
+	0x78, 0x92, 0x93, 0x58, 0x44, 0xa1, 0x94, 0x34, 0xe8, 0x94, 0xb5, 0x3a, 0xb2, 0xac, 0xc1, 0x94,
+	0x83, 0x3e, 0x81, 0x76, 0xaa, 0xc0, 0xa9, 0x4f, 0xc3, 0x0b, 0x1a, 0x74, 0x2a, 0x5a, 0x7a, 0xc5,
+	0xd2, 0xb1, 0x25, 0xa3, 0x1f, 0xc1, 0x0a, 0xb9, 0x18, 0x79, 0x9c, 0x8a, 0x84, 0xc5, 0x82, 0x7a,
+	0x91, 0xe8, 0x54, 0x37, 0x9c, 0xcd, 0x12, 0x6e, 0x91, 0x8b, 0x11, 0xb6, 0xd4, 0x23, 0xe1, 0x0e,
+	0x60, 0x25, 0x97, 0x08, 0xce, 0x4e, 0x29, 0xea, 0x82, 0x8e, 0x2c, 0x26, 0x11, 0xcd, 0x47, 0xaa,
+	0xd6, 0x68, 0x19, 0x4a, 0x61, 0xa2, 0x03, 0x5c, 0xc2, 0xa5, 0x30, 0x41, 0x1f, 0x40, 0x95, 0x4b,
+	0xa9, 0xac, 0x2f, 0x6a, 0xeb, 0x15, 0x2e, 0xe5, 0x91, 0x70, 0xbf, 0x81, 0xd6, 0xd4, 0xea, 0x4b,
+	0x96, 0xa0, 0x36, 0x2c, 0x86, 0xc1, 0xa5, 0x36, 0x57, 0xc1, 0xea, 0x2f, 0xfa, 0x0c, 0xaa, 0x89,
+	0xda, 0x2e, 0x3d, 0x9c, 0x07, 0xf3, 0x0e, 0x47, 0x09, 0x61, 0x2b, 0xeb, 0x5e, 0xe4, 0x8b, 0xd2,
+	0x9e, 0x4d, 0x96, 0x5c, 0x27, 0x9f, 0xdc, 0xe2, 0x89, 0x95, 0xae, 0x9d, 0xd8, 0x16, 0x94, 0x75,
+	0x99, 0x2e, 0xea, 0xbd, 0xbb, 0x73, 0xf6, 0x7e, 0xc9, 0x12, 0xac, 0xe5, 0xdc, 0xdf, 0x38, 0x70,
+	0xb7, 0x50, 0x95, 0x26, 0x81, 0xdf, 0x5d, 0x96, 0xc6, 0xc7, 0xff, 0xa8, 0x2c, 0xad, 0x6a, 0xa1,
+	0x2c, 0x3f, 0x87, 0xd5, 0x17, 0x54, 0xbe, 0x20, 0x92, 0xbe, 0x23, 0x57, 0xbd, 0x20, 0xf3, 0x63,
+	0x1d, 0x60, 0x64, 0x88, 0x5e, 0x18, 0xd8, 0x44, 0x2c, 0x8d, 0x52, 0x31, 0xf7, 0x33, 0xb8, 0x87,
+	0xa9, 0x90, 0x84, 0xcb, 0xbe, 0x41, 0x14, 0x91, 0xde, 0xab, 0x2e, 0xd4, 0x2d, 0xc8, 0x98, 0x18,
+	0x96, 0x70, 0xb6, 0x76, 0x89, 0xda, 0x2c, 0xa6, 0x3c, 0xf4, 0xf7, 0x58, 0x14, 0x91, 0x38, 0xb0,
+	0x28, 0xd0, 0x81, 0x9a, 0x6f, 0x08, 0x76, 0xa7, 0x74, 0x89, 0xb6, 0xa1, 0x9a, 0x68, 0x19, 0x9d,
+	0x70, 0x95, 0x0f, 0x83, 0x57, 0x5b, 0x29, 0x5e, 0x6d, 0xf5, 0x35, 0x5e, 0x61, 0x2b, 0xe6, 0x1e,
+	0xc1, 0xbd, 0xe2, 0x16, 0x59, 0x44, 0x8f, 0xa1, 0x9e, 0x16, 0x6f, 0xc7, 0xb9, 0xdd, 0x58, 0x26,
+	0xe8, 0xfe, 0x04, 0x56, 0x06, 0x24, 0x1c, 0x1f, 0xb2, 0x51, 0x16, 0x60, 0x07, 0x6a, 0x36, 0xa0,
+	0xd4, 0x59, 0xbb, 0x74, 0xd7, 0xa1, 0x76, 0xc8, 0x46, 0x87, 0x61, 0x4c, 0x11, 0x82, 0xf2, 0x38,
+	0x8c, 0x53, 0x09, 0xfd, 0xdf, 0xfd, 0xad, 0x03, 0xad, 0xfd, 0x50, 0x9c, 0x9f, 0x10, 0x2e, 0x43,
+	0x19, 0xb2, 0x18, 0xdd, 0x83, 0x6a, 0x40, 0x73, 0x96, 0xec, 0x4a, 0x5d, 0xff, 0x88, 0x4d, 0x62,
+	0xe9, 0x25, 0x2c, 0x8c, 0xa5, 0xad, 0x35, 0xd0, 0xa4, 0x13, 0x45, 0x51, 0x15, 0x2a, 0x99, 0x24,
+	0x63, 0x7d, 0x49, 0xca, 0xd8, 0x2c, 0xd4, 0xa6, 0x13, 0x61, 0xef, 0x7b, 0x19, 0xeb, 0xff, 0x8a,
+	0x36, 0xe4, 0x94, 0xea, 0x5b, 0x5d, 0xc6, 0xfa, 0xbf, 0xfb, 0xe7, 0x45, 0x68, 0xf6, 0xaf, 0x84,
+	0xa4, 0x51, 0x5f, 0x12, 0x39, 0x11, 0x4a, 0x48, 0x86, 0xf6, 0x72, 0x96, 0xb1, 0xfe, 0xaf, 0xb0,
+	0xd7, 0x4f, 0x26, 0xde, 0x44, 0x50, 0x6e, 0x95, 0x6b, 0x7e, 0x32, 0x79, 0x23, 0x28, 0x57, 0xb5,
+	0xa1, 0x58, 0x42, 0x9b, 0xd0, 0x28, 0x50, 0xc6, 0x4b, 0x7e, 0x32, 0x31, 0x36, 0x53, 0xcd, 0x30,
+	0x18, 0xd3, 0x4e, 0x2d, 0xd3, 0xec, 0x05, 0x63, 0x8a, 0xee, 0xc3, 0x52, 0x44, 0x23, 0xcf, 0xf8,
+	0x0e, 0x9a, 0x57, 0x8f, 0x68, 0x34, 0xd0, 0xee, 0x7f, 0x0c, 0x2d, 0xc5, 0x24, 0x17, 0x24, 0x1c,
+	0x93, 0xd3, 0x31, 0xed, 0x34, 0xb4, 0x40, 0x33, 0xa2, 0xd1, 0x6e, 0x4a, 0xd3, 0x2d, 0x81, 0x46,
+	0x9e, 0x8e, 0xb3, 0x69, 0x8c, 0x47, 0x34, 0x7a, 0xa3, 0x42, 0xb5, 0x2c, 0x1d, 0x6e, 0x2b, 0x63,
+	0x3d, 0xe7, 0x54, 0x57, 0xb3, 0x78, 0x47, 0x12, 0xbb, 0xf1, 0x8a, 0xf1, 0x58, 0x51, 0xcc, 0xce,
+	0xf7, 0x41, 0x2f, 0x8c, 0xd5, 0xb6, 0x71, 0x4b, 0x11, 0xb4, 0xd9, 0x94, 0xa9, 0xed, 0xde, 0x99,
+	0x32, 0xb5, 0xe1, 0x8f, 0xa0, 0x31, 0x49, 0x54, 0xbe, 0x3c, 0x41, 0x7d, 0xd1, 0x59, 0xd6, 0x6c,
+	0x30, 0xa4, 0x3e, 0xf5, 0x05, 0xda, 0x83, 0x95, 0x20, 0x14, 0xe7, 0x5e, 0x92, 0x1e, 0xba, 0xe8,
+	0xa0, 0x19, 0x10, 0x51, 0xa8, 0x0b, 0xbc, 0x1c, 0xe4, 0x97, 0xc2, 0xfd, 0x12, 0x6a, 0x0a, 0xf8,
+	0xc9, 0x48, 0x17, 0x56, 0x0e, 0x47, 0xf5, 0x7f, 0x55, 0x91, 0x17, 0x94, 0x8b, 0x90, 0xc5, 0xb6,
+	0x54, 0xd2, 0xa5, 0xfb, 0x04, 0x60, 0x4f, 0x0f, 0x03, 0xbd, 0x78, 0xc8, 0xd0, 0xa7, 0x80, 0xec,
+	0x6c, 0xe0, 0xf9, 0x9c, 0x12, 0x49, 0x03, 0x8f, 0x48, 0x7b, 0xe8, 0x6d, 0xcb, 0xd9, 0x33, 0x8c,
+	0x5d, 0xe9, 0xfe, 0xcb, 0x81, 0xe6, 0xc9, 0x98, 0xc8, 0x21, 0xe3, 0x91, 0x56, 0xff, 0x00, 0xaa,
+	0x17, 0x49, 0x3c, 0x6d, 0x57, 0x95, 0x8b, 0x24, 0xee, 0x25, 0xe8, 0x11, 0xd4, 0x13, 0xe3, 0x5c,
+	0x8a, 0x3f, 0xab, 0x45, 0xd0, 0x32, 0x4c, 0x9c, 0x49, 0xa1, 0x1f, 0xc2, 0xf2, 0x39, 0xe5, 0x31,
+	0x1d, 0x7b, 0xa9, 0xdb, 0xa6, 0x8b, 0xb5, 0x0c, 0xf5, 0xad, 0x21, 0xa2, 0x27, 0xb0, 0x56, 0x14,
+	0x13, 0x5e, 0x18, 0x0b, 0x49, 0xc6, 0x63, 0x5d, 0xe3, 0x0a, 0x5a, 0x3e, 0x2c, 0x68, 0x88, 0x5e,
+	0xca, 0x46, 0x5f, 0x41, 0xc3, 0x46, 0x1a, 0xc6, 0x43, 0xd6, 0xa9, 0xd8, 0xfb, 0x9e, 0xf7, 0x6b,
+	0x9a, 0x18, 0x0c, 0x7e, 0xf6, 0xdf, 0xfd, 0x63, 0x09, 0xda, 0x16, 0x98, 0x7b, 0xb1, 0xa4, 0x7c,
+	0x48, 0x7c, 0x8a, 0x1e, 0xc1, 0x6a, 0x6c, 0x68, 0x5e, 0x98, 0x12, 0xa7, 0xb8, 0x88, 0xe2, 0x6b,
+	0xf2, 0xbd, 0x00, 0x7d, 0x0d, 0x55, 0xa1, 0x2f, 0x97, 0x3e, 0x92, 0xe5, 0x9d, 0x1f, 0x14, 0xf6,
+	0xbe, 0xbe, 0xc1, 0x96, 0xb9, 0x88, 0xd8, 0xea, 0x68, 0x00, 0x20, 0xbe, 0x47, 0x82, 0x80, 0x53,
+	0x21, 0x6c, 0x7a, 0x20, 0x22, 0xfe, 0xae, 0xa1, 0xa0, 0x87, 0xd0, 0x0c, 0x93, 0x94, 0x4f, 0x85,
+	0x4d, 0x47, 0x23, 0x4c, 0x76, 0x53, 0x92, 0xca, 0x72, 0x98, 0x5c, 0x7c, 0x91, 0x13, 0xaa, 0x68,
+	0xa1, 0x96, 0xa2, 0x66, 0x62, 0xee, 0x8f, 0xa1, 0x6a, 0x51, 0xa0, 0x01, 0xb5, 0x37, 0xc7, 0xaf,
+	0x8e, 0x5f, 0x7f, 0x73, 0xdc, 0x5e, 0x40, 0x55, 0x28, 0xbd, 0x39, 0x69, 0x3b, 0xa8, 0x0e, 0xe5,
+	0x7d, 0x45, 0x29, 0xb9, 0x7f, 0x72, 0xa0, 0x82, 0x55, 0xd3, 0x50, 0x96, 0x03, 0x2a, 0x64, 0x18,
+	0x13, 0x55, 0x9e, 0xd3, 0x82, 0x68, 0xe5, 0xa8, 0xbd, 0xa4, 0xd0, 0x42, 0xd2, 0x86, 0x99, 0xb5,
+	0x90, 0x44, 0x55, 0xed, 0x88, 0xc6, 0x11, 0x11, 0xe7, 0x36, 0xbe, 0x74, 0x39, 0x37, 0xdb, 0xe5,
+	0x79, 0xd9, 0x76, 0x7f, 0xef, 0x40, 0x23, 0xcb, 0xe9, 0x90, 0xa1, 0x43, 0x40, 0x37, 0x2c, 0xa4,
+	0x2d, 0x75, 0xfd, 0xd6, 0x93, 0xc0, 0x77, 0xae, 0x9b, 0x17, 0xe8, 0x4b, 0x68, 0xa9, 0x6e, 0x19,
+	0xc6, 0x23, 0x4f, 0x6a, 0x60, 0x32, 0x65, 0x8e, 0x0a, 0x86, 0x74, 0x6a, 0x70, 0xd3, 0x0a, 0x0e,
+	0x94, 0x9c, 0xfb, 0x07, 0x07, 0x6a, 0x7b, 0x27, 0x6f, 0xb4, 0x4b, 0x0a, 0x34, 0x19, 0xa7, 0x9e,
+	0xaf, 0x50, 0xdc, 0x5e, 0xba, 0x25, 0x45, 0xd9, 0x53, 0x04, 0x35, 0xcf, 0xca, 0x33, 0x4e, 0x49,
+	0x60, 0x26, 0x5a, 0xc5, 0xd0, 0x29, 0x2b, 0xe3, 0x65, 0x4b, 0x3f, 0xa1, 0x7c, 0x8f, 0x71, 0x8a,
+	0x5c, 0x68, 0x12, 0xee, 0x9f, 0x85, 0x92, 0xfa, 0x72, 0xc2, 0xa9, 0x4d, 0x5e, 0x81, 0xa6, 0x36,
+	0x8b, 0x58, 0x40, 0xc7, 0x9e, 0xc6, 0x0a, 0x93, 0xb7, 0x25, 0x4d, 0x39, 0x26, 0x11, 0x75, 0x7f,
+	0x05, 0x8d, 0x23, 0xe2, 0x9f, 0x85, 0x31, 0xd5, 0xae, 0x6d, 0x5b, 0xc0, 0x56, 0x37, 0xc5, 0x74,
+	0xc6, 0xe2, 0x0d, 0xb6, 0x21, 0x18, 0x18, 0x57, 0x0a, 0x3f, 0x83, 0xe6, 0x34, 0xbd, 0x43, 0x66,
+	0x7b, 0x73, 0x67, 0x76, 0x62, 0x87, 0x0c, 0x37, 0xe2, 0xe9, 0xc2, 0xfd, 0xc7, 0x22, 0x2c, 0xef,
+	0x9d, 0x51, 0xff, 0x3c, 0x8c, 0xd3, 0x96, 0x7a, 0xfb, 0xb0, 0x81, 0x76, 0xb2, 0xbb, 0xb4, 0xb8,
+	0xe1, 0xdc, 0x80, 0x4e, 0x3b, 0x80, 0x5c, 0xbb, 0x41, 0x4f, 0xa1, 0x65, 0xfa, 0x93, 0x67, 0x55,
+	0xcb, 0x5a, 0x75, 0xad, 0xa8, 0x9a, 0x6b, 0x82, 0xb8, 0x29, 0x72, 0x2b, 0xa5, 0x9f, 0x58, 0xf0,
+	0x33, 0x31, 0xd6, 0x67, 0xe8, 0xe7, 0xe1, 0x11, 0x37, 0x93, 0xdc, 0x4a, 0xa5, 0x28, 0x32, 0x29,
+	0x36, 0xea, 0x4b, 0x33, 0x52, 0x94, 0x3b, 0x03, 0xdc, 0x88, 0xa6, 0x0b, 0xb4, 0x05, 0x77, 0xb4,
+	0x9c, 0x97, 0x9c, 0x8f, 0xbc, 0x02, 0xb4, 0x3f, 0x2b, 0x75, 0x1c, 0xbc, 0xa2, 0x99, 0x27, 0xe7,
+	0xa3, 0x14, 0x29, 0xd7, 0x32, 0x64, 0xae, 0x64, 0x42, 0x16, 0x9d, 0x3f, 0xb9, 0x81, 0xb5, 0xd5,
+	0x4c, 0xe4, 0x1a, 0xde, 0x3e, 0xbd, 0x0d, 0x6f, 0x6b, 0x1b, 0x8b, 0x56, 0x6b, 0x1e, 0xe6, 0xba,
+	0x7f, 0x71, 0x60, 0x25, 0x3b, 0x58, 0x3b, 0x74, 0xed, 0x43, 0x95, 0xf8, 0x0a, 0x0f, 0xf4, 0xa9,
+	0x2e, 0xef, 0x7c, 0x5a, 0x2c, 0xac, 0xa2, 0xf4, 0x96, 0xad, 0x07, 0x1a, 0xec, 0x6a, 0x1d, 0x6c,
+	0x75, 0xb3, 0xf9, 0xa4, 0x34, 0x9d, 0x4f, 0xdc, 0x03, 0x58, 0xb9, 0x26, 0xae, 0xb0, 0xea, 0xf8,
+	0xf5, 0xf1, 0x41, 0x7b, 0x01, 0xad, 0x42, 0x1b, 0x1f, 0xf4, 0x07, 0xbb, 0x78, 0xe0, 0xf5, 0x0f,
+	0xf0, 0xdb, 0xde, 0xde, 0x41, 0xbf, 0xed, 0x20, 0x04, 0xcb, 0x19, 0xf5, 0x17, 0xfd, 0xc1, 0xc1,
+	0x51, 0xbb, 0xe4, 0xfe, 0xdd, 0x81, 0x96, 0x9d, 0x7e, 0x6f, 0x19, 0x86, 0x3e, 0x87, 0x9a, 0x6f,
+	0x7c, 0xb5, 0xb5, 0x7e, 0x7f, 0x76, 0x1c, 0xda, 0x1f, 0x9c, 0xca, 0x2a, 0x20, 0xf3, 0x29, 0x97,
+	0x1e, 0xbd, 0x4c, 0x42, 0x6e, 0xc0, 0x52, 0x9b, 0x56, 0x65, 0xbc, 0x88, 0x91, 0xe2, 0x1d, 0x64,
+	0xac, 0x41, 0x68, 0x5a, 0x39, 0x1b, 0x0e, 0xf5, 0xe8, 0xa8, 0x0a, 0xb6, 0x8e, 0xd3, 0xa5, 0x9a,
+	0x8e, 0xec, 0x5f, 0x4f, 0x84, 0xb1, 0x9f, 0x4e, 0x74, 0x4d, 0x4b, 0xec, 0x2b, 0x9a, 0x3b, 0x80,
+	0xd5, 0x42, 0x30, 0xb9, 0x0b, 0x96, 0x5d, 0xd8, 0xec, 0x82, 0xa5, 0x97, 0x32, 0x50, 0xec, 0x31,
+	0x1b, 0x85, 0x3e, 0x19, 0x2b, 0xb6, 0x45, 0x6a, 0x4b, 0xe9, 0x05, 0xee, 0xef, 0x4a, 0x70, 0xd7,
+	0x86, 0xf8, 0x32, 0x14, 0x92, 0xf1, 0xab, 0x83, 0x58, 0xf2, 0xab, 0x99, 0x99, 0xfa, 0xbf, 0x4d,
+	0x03, 0xd3, 0xe9, 0xa3, 0x9c, 0x9f, 0x3e, 0x6e, 0xdc, 0xf3, 0xca, 0xf7, 0xbb, 0xe7, 0xf3, 0x8e,
+	0xa8, 0x3a, 0xef, 0x88, 0x14, 0xa8, 0xdf, 0x2f, 0x24, 0xd9, 0xe6, 0xe4, 0x7f, 0x92, 0x6b, 0xc5,
+	0xd6, 0x9f, 0x55, 0xd3, 0x42, 0x51, 0x93, 0xaa, 0xa2, 0x0c, 0xec, 0x54, 0x4e, 0xe3, 0xc0, 0x30,
+	0xcd, 0x98, 0x5f, 0xa3, 0x71, 0xa0, 0xfd, 0xc2, 0xb0, 0x3a, 0xcb, 0x2d, 0xf4, 0x04, 0x6a, 0x34,
+	0x96, 0x3c, 0xcc, 0x1a, 0xe0, 0xc6, 0xac, 0xda, 0xcd, 0x1f, 0x2c, 0x4e, 0x15, 0xdc, 0x09, 0xac,
+	0xcd, 0xb2, 0xd9, 0x8b, 0x03, 0x7a, 0xa9, 0x06, 0xe3, 0x98, 0x5e, 0x4a, 0x4f, 0x8c, 0x99, 0x69,
+	0x68, 0x2d, 0x5c, 0x57, 0x84, 0xfe, 0x98, 0xc9, 0xf4, 0x05, 0x23, 0xdd, 0xb9, 0xa4, 0xd9, 0xea,
+	0x05, 0xe3, 0xc0, 0x50, 0xd4, 0x77, 0xa2, 0x4f, 0x12, 0xe2, 0x87, 0xf2, 0x4a, 0x87, 0xd9, 0xc2,
+	0xd9, 0x7a, 0xe7, 0xaf, 0x15, 0xa8, 0x1e, 0xe9, 0x87, 0x30, 0xd5, 0x7b, 0xfb, 0xf9, 0xcf, 0x4c,
+	0x74, 0xa7, 0xe0, 0xfd, 0x5b, 0x16, 0x06, 0xdd, 0x9b, 0x24, 0x77, 0x01, 0x7d, 0x01, 0xcd, 0xbe,
+	0x64, 0xc9, 0xf7, 0xd6, 0x7b, 0x04, 0x55, 0x4c, 0x4f, 0x19, 0x93, 0xef, 0xad, 0xf1, 0x4a, 0x21,
+	0x51, 0xe1, 0x5b, 0x18, 0x7d, 0x5c, 0x90, 0x9b, 0xfd, 0xa5, 0x3c, 0xdb, 0xd8, 0x53, 0x80, 0x3e,
+	0x95, 0x66, 0x36, 0x15, 0xa8, 0x08, 0x33, 0xf6, 0x28, 0x2c, 0x73, 0xae, 0xfe, 0x8b, 0xa9, 0xfe,
+	0x8c, 0x10, 0x6e, 0x33, 0xe9, 0x2e, 0xa0, 0xb7, 0xb0, 0x82, 0x27, 0x71, 0xee, 0x65, 0x42, 0xa0,
+	0x8f, 0x66, 0xf5, 0xf5, 0xdc, 0x53, 0x5a, 0x77, 0x63, 0xbe, 0x80, 0xfd, 0x8c, 0x5e, 0x40, 0xcf,
+	0xa1, 0x99, 0x7f, 0x67, 0x98, 0xe5, 0xd9, 0xc3, 0xa2, 0x67, 0x33, 0x5e, 0x25, 0xdc, 0x05, 0xf4,
+	0x2d, 0x2c, 0x17, 0xbf, 0xef, 0xd1, 0x75, 0xb5, 0x9b, 0xef, 0x0b, 0xdd, 0x8f, 0x6f, 0x11, 0xc9,
+	0xd9, 0x7e, 0x06, 0xf5, 0xf4, 0x63, 0x1f, 0x5d, 0x7b, 0x3d, 0x2a, 0xbe, 0x01, 0x74, 0x8b, 0x98,
+	0x66, 0x3f, 0xfa, 0xdd, 0x85, 0x47, 0xce, 0xce, 0xdf, 0x16, 0xa1, 0x6e, 0xaf, 0x54, 0x80, 0x9e,
+	0x43, 0xcd, 0xfe, 0x47, 0xb7, 0x35, 0x8c, 0xee, 0x83, 0xdb, 0xba, 0xa2, 0xbb, 0x80, 0x0e, 0x61,
+	0xe9, 0x05, 0x95, 0x16, 0xb1, 0x1e, 0xce, 0x3a, 0xc0, 0x02, 0xdc, 0x77, 0xbb, 0xf3, 0x45, 0xdc,
+	0x05, 0x44, 0xa0, 0x9d, 0x59, 0x4b, 0x41, 0x62, 0x73, 0xbe, 0x46, 0x11, 0xde, 0xba, 0x0f, 0xbf,
+	0x53, 0xd2, 0x5d, 0x40, 0x47, 0x70, 0x77, 0x9f, 0x8e, 0xa9, 0xa4, 0x05, 0xfe, 0xfb, 0xb8, 0x3e,
+	0xb3, 0xa8, 0xbf, 0x86, 0x96, 0x31, 0x67, 0x6b, 0x0b, 0xdd, 0x9b, 0x39, 0x6a, 0xee, 0xcf, 0xd6,
+	0xfe, 0x1c, 0xca, 0x87, 0xa1, 0x90, 0x73, 0x95, 0xee, 0x16, 0xe8, 0xbd, 0x7d, 0x25, 0xec, 0x2e,
+	0x3c, 0x5b, 0xff, 0xf6, 0xbe, 0xa6, 0x6f, 0x6b, 0xfa, 0xb6, 0x3f, 0x66, 0x93, 0x60, 0x7b, 0xc4,
+	0xec, 0x4b, 0xfa, 0x69, 0x55, 0xff, 0x3e, 0xfe, 0xf7, 0x00, 0xb2, 0xf1, 0xe5, 0xf4, 0xbf, 0x17,
+	0x00, 0x00,
 }
@@ -35,7 +35,7 @@ func (m *StateID) Reset()         { *m = StateID{} }
 func (m *StateID) String() string { return proto.CompactTextString(m) }
 func (*StateID) ProtoMessage()    {}
 func (*StateID) Descriptor() ([]byte, []int) {
-	return fileDescriptor_state_40967c0a9a3f0140, []int{0}
+	return fileDescriptor_state_1b114053faaf1c7f, []int{0}
 }
 func (m *StateID) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StateID.Unmarshal(m, b)
@@ -81,7 +81,7 @@ func (m *GetStatesRequest) Reset()         { *m = GetStatesRequest{} }
 func (m *GetStatesRequest) String() string { return proto.CompactTextString(m) }
 func (*GetStatesRequest) ProtoMessage()    {}
 func (*GetStatesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_state_40967c0a9a3f0140, []int{1}
+	return fileDescriptor_state_1b114053faaf1c7f, []int{1}
 }
 func (m *GetStatesRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetStatesRequest.Unmarshal(m, b)
@@ -126,7 +126,7 @@ func (m *GetStatesResponse) Reset()         { *m = GetStatesResponse{} }
 func (m *GetStatesResponse) String() string { return proto.CompactTextString(m) }
 func (*GetStatesResponse) ProtoMessage()    {}
 func (*GetStatesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_state_40967c0a9a3f0140, []int{2}
+	return fileDescriptor_state_1b114053faaf1c7f, []int{2}
 }
 func (m *GetStatesResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetStatesResponse.Unmarshal(m, b)
@@ -164,7 +164,7 @@ func (m *ReportStatesRequest) Reset()         { *m = ReportStatesRequest{} }
 func (m *ReportStatesRequest) String() string { return proto.CompactTextString(m) }
 func (*ReportStatesRequest) ProtoMessage()    {}
 func (*ReportStatesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_state_40967c0a9a3f0140, []int{3}
+	return fileDescriptor_state_1b114053faaf1c7f, []int{3}
 }
 func (m *ReportStatesRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReportStatesRequest.Unmarshal(m, b)
@@ -191,6 +191,128 @@ func (m *ReportStatesRequest) GetStates() []*State {
 	return nil
 }
 
+type ListStatesRequest struct {
+	NetworkID string `protobuf:"bytes,1,opt,name=networkID,proto3" json:"networkID,omitempty"`
+	Type      string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// reporterID only lists the states reported by the gateway with this
+	// hardware ID if set
+	ReporterID string `protobuf:"bytes,3,opt,name=reporterID,proto3" json:"reporterID,omitempty"`
+	// pageSize limits the number of listed states, ordered by deviceID.
+	// 0 lists all states.
+	PageSize uint32 `protobuf:"varint,4,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	// pageToken is the nextPageToken of the previous page
+	PageToken            string   `protobuf:"bytes,5,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListStatesRequest) Reset()         { *m = ListStatesRequest{} }
+func (m *ListStatesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListStatesRequest) ProtoMessage()    {}
+func (*ListStatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_state_1b114053faaf1c7f, []int{4}
+}
+func (m *ListStatesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListStatesRequest.Unmarshal(m, b)
+}
+func (m *ListStatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListStatesRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListStatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListStatesRequest.Merge(dst, src)
+}
+func (m *ListStatesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListStatesRequest.Size(m)
+}
+func (m *ListStatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListStatesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListStatesRequest proto.InternalMessageInfo
+
+func (m *ListStatesRequest) GetNetworkID() string {
+	if m != nil {
+		return m.NetworkID
+	}
+	return ""
+}
+
+func (m *ListStatesRequest) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *ListStatesRequest) GetReporterID() string {
+	if m != nil {
+		return m.ReporterID
+	}
+	return ""
+}
+
+func (m *ListStatesRequest) GetPageSize() uint32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ListStatesRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+type ListStatesResponse struct {
+	States []*State `protobuf:"bytes,1,rep,name=states,proto3" json:"states,omitempty"`
+	// nextPageToken is empty if this is the last page
+	NextPageToken        string   `protobuf:"bytes,2,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListStatesResponse) Reset()         { *m = ListStatesResponse{} }
+func (m *ListStatesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListStatesResponse) ProtoMessage()    {}
+func (*ListStatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_state_1b114053faaf1c7f, []int{5}
+}
+func (m *ListStatesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListStatesResponse.Unmarshal(m, b)
+}
+func (m *ListStatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListStatesResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListStatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListStatesResponse.Merge(dst, src)
+}
+func (m *ListStatesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListStatesResponse.Size(m)
+}
+func (m *ListStatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListStatesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListStatesResponse proto.InternalMessageInfo
+
+func (m *ListStatesResponse) GetStates() []*State {
+	if m != nil {
+		return m.States
+	}
+	return nil
+}
+
+func (m *ListStatesResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
 type DeleteStatesRequest struct {
 	NetworkID            string     `protobuf:"bytes,1,opt,name=networkID,proto3" json:"networkID,omitempty"`
 	Ids                  []*StateID `protobuf:"bytes,2,rep,name=ids,proto3" json:"ids,omitempty"`
@@ -203,7 +325,7 @@ func (m *DeleteStatesRequest) Reset()         { *m = DeleteStatesRequest{} }
 func (m *DeleteStatesRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteStatesRequest) ProtoMessage()    {}
 func (*DeleteStatesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_state_40967c0a9a3f0140, []int{4}
+	return fileDescriptor_state_1b114053faaf1c7f, []int{6}
 }
 func (m *DeleteStatesRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteStatesRequest.Unmarshal(m, b)
@@ -242,6 +364,8 @@ func init() {
 	proto.RegisterType((*GetStatesRequest)(nil), "magma.orc8r.GetStatesRequest")
 	proto.RegisterType((*GetStatesResponse)(nil), "magma.orc8r.GetStatesResponse")
 	proto.RegisterType((*ReportStatesRequest)(nil), "magma.orc8r.ReportStatesRequest")
+	proto.RegisterType((*ListStatesRequest)(nil), "magma.orc8r.ListStatesRequest")
+	proto.RegisterType((*ListStatesResponse)(nil), "magma.orc8r.ListStatesResponse")
 	proto.RegisterType((*DeleteStatesRequest)(nil), "magma.orc8r.DeleteStatesRequest")
 }
 
@@ -260,6 +384,7 @@ type StateServiceClient interface {
 	GetStates(ctx context.Context, in *GetStatesRequest, opts ...grpc.CallOption) (*GetStatesResponse, error)
 	ReportStates(ctx context.Context, in *ReportStatesRequest, opts ...grpc.CallOption) (*Void, error)
 	DeleteStates(ctx context.Context, in *DeleteStatesRequest, opts ...grpc.CallOption) (*Void, error)
+	ListStates(ctx context.Context, in *ListStatesRequest, opts ...grpc.CallOption) (*ListStatesResponse, error)
 }
 
 type stateServiceClient struct {
@@ -297,11 +422,21 @@ func (c *stateServiceClient) DeleteStates(ctx context.Context, in *DeleteStatesR
 	return out, nil
 }
 
+func (c *stateServiceClient) ListStates(ctx context.Context, in *ListStatesRequest, opts ...grpc.CallOption) (*ListStatesResponse, error) {
+	out := new(ListStatesResponse)
+	err := c.cc.Invoke(ctx, "/magma.orc8r.StateService/ListStates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // StateServiceServer is the server API for StateService service.
 type StateServiceServer interface {
 	GetStates(context.Context, *GetStatesRequest) (*GetStatesResponse, error)
 	ReportStates(context.Context, *ReportStatesRequest) (*Void, error)
 	DeleteStates(context.Context, *DeleteStatesRequest) (*Void, error)
+	ListStates(context.Context, *ListStatesRequest) (*ListStatesResponse, error)
 }
 
 func RegisterStateServiceServer(s *grpc.Server, srv StateServiceServer) {
@@ -362,6 +497,24 @@ func _StateService_DeleteStates_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StateService_ListStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StateServiceServer).ListStates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.orc8r.StateService/ListStates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StateServiceServer).ListStates(ctx, req.(*ListStatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _StateService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "magma.orc8r.StateService",
 	HandlerType: (*StateServiceServer)(nil),
@@ -378,34 +531,44 @@ var _StateService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteStates",
 			Handler:    _StateService_DeleteStates_Handler,
 		},
+		{
+			MethodName: "ListStates",
+			Handler:    _StateService_ListStates_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "orc8r/protos/state.proto",
 }
 
-func init() { proto.RegisterFile("orc8r/protos/state.proto", fileDescriptor_state_40967c0a9a3f0140) }
-
-var fileDescriptor_state_40967c0a9a3f0140 = []byte{
-	// 322 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x52, 0xc1, 0x4e, 0x83, 0x40,
-	0x14, 0x94, 0xd6, 0x54, 0xfb, 0xda, 0x83, 0x7d, 0xf5, 0x80, 0x68, 0x4d, 0xc3, 0xc1, 0x34, 0x1e,
-	0xc0, 0xb4, 0x17, 0x3d, 0x19, 0x0d, 0xc6, 0x34, 0xf1, 0x44, 0x13, 0x63, 0xf4, 0x84, 0xf0, 0xd2,
-	0x10, 0x0b, 0x8b, 0xbb, 0x5b, 0x8d, 0xbf, 0xec, 0x57, 0x18, 0x76, 0x49, 0x05, 0xc4, 0x83, 0x07,
-	0x4f, 0xb0, 0xf3, 0x66, 0x26, 0x3b, 0xb3, 0x0f, 0x4c, 0xc6, 0xc3, 0x73, 0xee, 0x66, 0x9c, 0x49,
-	0x26, 0x5c, 0x21, 0x03, 0x49, 0x8e, 0x3a, 0x60, 0x2f, 0x09, 0x96, 0x49, 0xe0, 0xa8, 0xb9, 0x75,
-	0x50, 0xa1, 0x85, 0x2c, 0x49, 0x58, 0xaa, 0x79, 0xd6, 0xa8, 0xea, 0x40, 0xfc, 0x2d, 0x0e, 0x69,
-	0x76, 0x36, 0xd3, 0x63, 0xfb, 0x02, 0x76, 0x16, 0xb9, 0xeb, 0xdc, 0x43, 0x84, 0x6d, 0xf9, 0x91,
-	0x91, 0x69, 0x8c, 0x8d, 0x49, 0xd7, 0x57, 0xff, 0x68, 0xc1, 0x6e, 0x44, 0xb9, 0x62, 0xee, 0x99,
-	0x2d, 0x85, 0x6f, 0xce, 0xf6, 0x03, 0xec, 0xdd, 0x92, 0x54, 0x6a, 0xe1, 0xd3, 0xeb, 0x9a, 0x84,
-	0xc4, 0x23, 0xe8, 0xa6, 0x24, 0xdf, 0x19, 0x7f, 0x99, 0x7b, 0x85, 0xd1, 0x37, 0x80, 0x27, 0xd0,
-	0x8e, 0x23, 0x61, 0xb6, 0xc6, 0xed, 0x49, 0x6f, 0xba, 0xef, 0x94, 0x12, 0x38, 0xc5, 0x25, 0xfc,
-	0x9c, 0x60, 0x5f, 0xc2, 0xa0, 0xe4, 0x2c, 0x32, 0x96, 0x0a, 0xc2, 0x53, 0xe8, 0xa8, 0xfc, 0xc2,
-	0x34, 0x94, 0x1e, 0x7f, 0xea, 0xfd, 0x82, 0x61, 0x5f, 0xc1, 0xd0, 0xa7, 0x8c, 0xf1, 0xda, 0xed,
-	0xfe, 0x62, 0xf1, 0x04, 0x43, 0x8f, 0x56, 0x24, 0xe9, 0x1f, 0x02, 0x4e, 0x3f, 0x0d, 0xe8, 0x2b,
-	0x60, 0xa1, 0xdf, 0x03, 0xef, 0xa0, 0xbb, 0x49, 0x8c, 0xa3, 0x8a, 0xb0, 0xde, 0xb1, 0x75, 0xfc,
-	0xdb, 0x58, 0x17, 0x65, 0x6f, 0xe1, 0x0d, 0xf4, 0xcb, 0xf1, 0x71, 0x5c, 0x51, 0x34, 0x34, 0x63,
-	0x0d, 0x2a, 0x8c, 0x7b, 0x16, 0x47, 0xda, 0xa6, 0x5c, 0x41, 0xcd, 0xa6, 0xa1, 0x9d, 0x46, 0x9b,
-	0xeb, 0xd1, 0xe3, 0xa1, 0x42, 0x5d, 0xbd, 0x89, 0xe1, 0x8a, 0xad, 0x23, 0x77, 0xc9, 0x8a, 0x95,
-	0x7c, 0xee, 0xa8, 0xef, 0xec, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x0c, 0xd7, 0xb9, 0x90, 0xeb, 0x02,
-	0x00, 0x00,
+func init() { proto.RegisterFile("orc8r/protos/state.proto", fileDescriptor_state_1b114053faaf1c7f) }
+
+var fileDescriptor_state_1b114053faaf1c7f = []byte{
+	// 420 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x53, 0x4f, 0xef, 0x93, 0x40,
+	0x10, 0x15, 0xfa, 0xf3, 0xa7, 0x9d, 0xb6, 0x89, 0xdd, 0x7a, 0x58, 0xd1, 0x56, 0x42, 0x8c, 0x69,
+	0x3c, 0x80, 0x69, 0x2f, 0x7a, 0x32, 0x1a, 0x8c, 0x21, 0x69, 0xa2, 0xa1, 0xc6, 0x18, 0x3d, 0x21,
+	0x8c, 0x0d, 0x69, 0x61, 0x71, 0x77, 0xeb, 0xbf, 0x2f, 0xe3, 0x07, 0xf1, 0xcb, 0x19, 0x76, 0x29,
+	0x85, 0xfe, 0x39, 0xf4, 0xe0, 0x09, 0x76, 0x66, 0xde, 0xdb, 0x37, 0x6f, 0x66, 0x81, 0x32, 0x1e,
+	0x3f, 0xe3, 0x5e, 0xc1, 0x99, 0x64, 0xc2, 0x13, 0x32, 0x92, 0xe8, 0xaa, 0x03, 0xe9, 0x65, 0xd1,
+	0x2a, 0x8b, 0x5c, 0x95, 0xb7, 0xee, 0xb5, 0xca, 0x62, 0x96, 0x65, 0x2c, 0xd7, 0x75, 0xd6, 0xb8,
+	0xcd, 0x80, 0xfc, 0x7b, 0x1a, 0xe3, 0xfc, 0xe9, 0x5c, 0xa7, 0x9d, 0xe7, 0x70, 0x6b, 0x59, 0xb2,
+	0x06, 0x3e, 0x21, 0x70, 0x25, 0x7f, 0x15, 0x48, 0x0d, 0xdb, 0x98, 0x76, 0x43, 0xf5, 0x4f, 0x2c,
+	0xb8, 0x9d, 0x60, 0x89, 0x08, 0x7c, 0x6a, 0xaa, 0x78, 0x7d, 0x76, 0x3e, 0xc2, 0x9d, 0x37, 0x28,
+	0x15, 0x5a, 0x84, 0xf8, 0x6d, 0x8b, 0x42, 0x92, 0x07, 0xd0, 0xcd, 0x51, 0xfe, 0x60, 0x7c, 0x1d,
+	0xf8, 0x15, 0xd1, 0x3e, 0x40, 0x1e, 0x43, 0x27, 0x4d, 0x04, 0x35, 0xed, 0xce, 0xb4, 0x37, 0xbb,
+	0xeb, 0x36, 0x3a, 0x70, 0x2b, 0x11, 0x61, 0x59, 0xe0, 0xbc, 0x80, 0x61, 0x83, 0x59, 0x14, 0x2c,
+	0x17, 0x48, 0x9e, 0xc0, 0xb5, 0xea, 0x5f, 0x50, 0x43, 0xe1, 0xc9, 0x31, 0x3e, 0xac, 0x2a, 0x9c,
+	0x97, 0x30, 0x0a, 0xb1, 0x60, 0xfc, 0x40, 0xdd, 0x25, 0x14, 0x7f, 0x0c, 0x18, 0x2e, 0x52, 0x71,
+	0x51, 0x7f, 0x3b, 0x07, 0xcd, 0x86, 0x83, 0x13, 0x00, 0xae, 0xa4, 0x20, 0x0f, 0x7c, 0xda, 0x51,
+	0x99, 0x46, 0xa4, 0x74, 0xb8, 0x88, 0x56, 0xb8, 0x4c, 0x7f, 0x23, 0xbd, 0xb2, 0x8d, 0xe9, 0x20,
+	0xac, 0xcf, 0xe5, 0x6d, 0xe5, 0xff, 0x7b, 0xb6, 0xc6, 0x9c, 0xde, 0xd4, 0xb7, 0xd5, 0x01, 0xe7,
+	0x2b, 0x90, 0xa6, 0xc0, 0xcb, 0x6d, 0x22, 0x8f, 0x60, 0x90, 0xe3, 0x4f, 0xf9, 0xae, 0xbe, 0x43,
+	0x0b, 0x6f, 0x07, 0x9d, 0xcf, 0x30, 0xf2, 0x71, 0x83, 0x12, 0xff, 0xc3, 0xa8, 0x67, 0x7f, 0x4d,
+	0xe8, 0xab, 0xc0, 0x52, 0x6f, 0x26, 0x59, 0x40, 0xb7, 0x9e, 0x3d, 0x19, 0xb7, 0x80, 0x87, 0xdb,
+	0x66, 0x4d, 0xce, 0xa5, 0xb5, 0x17, 0xce, 0x0d, 0xf2, 0x1a, 0xfa, 0xcd, 0x45, 0x20, 0x76, 0x0b,
+	0x71, 0x62, 0x47, 0xac, 0x61, 0xab, 0xe2, 0x03, 0x4b, 0x13, 0x4d, 0xd3, 0xb4, 0xe0, 0x80, 0xe6,
+	0x84, 0x3b, 0xa7, 0x69, 0xde, 0x02, 0xec, 0x27, 0x46, 0xda, 0xea, 0x8f, 0x76, 0xcd, 0x7a, 0x78,
+	0x36, 0xbf, 0x6b, 0xef, 0xd5, 0xf8, 0xd3, 0x7d, 0x55, 0xe3, 0xe9, 0x47, 0x1e, 0x6f, 0xd8, 0x36,
+	0xf1, 0x56, 0xac, 0x7a, 0xed, 0x5f, 0xae, 0xd5, 0x77, 0xfe, 0x6f, 0x00, 0x67, 0x27, 0xe4, 0x9a,
+	0x46, 0x04, 0x00, 0x00,
 }
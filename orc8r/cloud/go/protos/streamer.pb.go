@@ -32,14 +32,21 @@ const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 // between the cloud and the gateway while abstracting the details of how
 // its implemented in the cloud and what the gateway does with the updates.
 //
-// - The gateways call the GetUpdates() streaming API with a StreamRequest
-//   indicating the stream name and the offset to continue streaming from.
-// - The cloud sends a stream of DataUpdateBatch containing a batch of updates.
-// - If resync is true, then the gateway can cleanup all its data and add
-//   all the keys (the batch is guaranteed to contain only unique keys).
-// - If resync is false, then the gateway can update the keys, or add new
-//   ones if the key is not already present.
-// - Key deletions are not yet supported (#15109350)
+//   - The gateways call the GetUpdates() streaming API with a StreamRequest
+//     indicating the stream name and the offset to continue streaming from.
+//   - The cloud sends a stream of DataUpdateBatch containing a batch of updates.
+//   - If resync is true, then the gateway can cleanup all its data and add
+//     all the keys (the batch is guaranteed to contain only unique keys).
+//   - If resync is false, then the gateway can update the keys, or add new
+//     ones if the key is not already present, and remove deleted_keys.
+//   - Streams of providers supporting incremental updates stay open, the cloud
+//     pushes a new batch whenever the stream's data changes. Each batch carries
+//     a cursor, the gateway may pass the last applied cursor in StreamRequest
+//     to receive only the changes since then. Cursors are positions in the
+//     changelog of the stream and the gateway's network kept by the cloud, so
+//     they're valid on every streamer replica. If the cursor is unknown or
+//     older than the changelog, the cloud falls back to a resync.
+//
 // --------------------------------------------------------------------------
 type StreamRequest struct {
 	GatewayId string `protobuf:"bytes,1,opt,name=gatewayId,proto3" json:"gatewayId,omitempty"`
@@ -47,7 +54,10 @@ type StreamRequest struct {
 	StreamName string `protobuf:"bytes,2,opt,name=stream_name,json=streamName,proto3" json:"stream_name,omitempty"`
 	// Any extra data to send up with the stream request. This value will be
 	// different per stream provider.
-	ExtraArgs            *any.Any `protobuf:"bytes,3,opt,name=extra_args,json=extraArgs,proto3" json:"extra_args,omitempty"`
+	ExtraArgs *any.Any `protobuf:"bytes,3,opt,name=extra_args,json=extraArgs,proto3" json:"extra_args,omitempty"`
+	// Cursor of the last update batch applied by the gateway (optional).
+	// If empty or expired, the first batch of the stream is a resync.
+	Cursor               string   `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -57,7 +67,7 @@ func (m *StreamRequest) Reset()         { *m = StreamRequest{} }
 func (m *StreamRequest) String() string { return proto.CompactTextString(m) }
 func (*StreamRequest) ProtoMessage()    {}
 func (*StreamRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_streamer_875df42a7991f84c, []int{0}
+	return fileDescriptor_streamer_26de085c5d2920f8, []int{0}
 }
 func (m *StreamRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StreamRequest.Unmarshal(m, b)
@@ -98,6 +108,13 @@ func (m *StreamRequest) GetExtraArgs() *any.Any {
 	return nil
 }
 
+func (m *StreamRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
 type DataUpdate struct {
 	// Unique key for each item
 	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
@@ -113,7 +130,7 @@ func (m *DataUpdate) Reset()         { *m = DataUpdate{} }
 func (m *DataUpdate) String() string { return proto.CompactTextString(m) }
 func (*DataUpdate) ProtoMessage()    {}
 func (*DataUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_streamer_875df42a7991f84c, []int{1}
+	return fileDescriptor_streamer_26de085c5d2920f8, []int{1}
 }
 func (m *DataUpdate) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DataUpdate.Unmarshal(m, b)
@@ -151,7 +168,13 @@ type DataUpdateBatch struct {
 	Updates []*DataUpdate `protobuf:"bytes,1,rep,name=updates,proto3" json:"updates,omitempty"`
 	// If resync is true, the updates would be a snapshot of all the
 	// contents in the cloud.
-	Resync               bool     `protobuf:"varint,2,opt,name=resync,proto3" json:"resync,omitempty"`
+	Resync bool `protobuf:"varint,2,opt,name=resync,proto3" json:"resync,omitempty"`
+	// Cursor of the stream state after the batch is applied, the gateway can
+	// use it in the next StreamRequest to continue from this batch.
+	// Empty for streams which don't support incremental updates.
+	Cursor string `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// Keys deleted since the previous batch, always empty on resync.
+	DeletedKeys          []string `protobuf:"bytes,4,rep,name=deleted_keys,json=deletedKeys,proto3" json:"deleted_keys,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -161,7 +184,7 @@ func (m *DataUpdateBatch) Reset()         { *m = DataUpdateBatch{} }
 func (m *DataUpdateBatch) String() string { return proto.CompactTextString(m) }
 func (*DataUpdateBatch) ProtoMessage()    {}
 func (*DataUpdateBatch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_streamer_875df42a7991f84c, []int{2}
+	return fileDescriptor_streamer_26de085c5d2920f8, []int{2}
 }
 func (m *DataUpdateBatch) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DataUpdateBatch.Unmarshal(m, b)
@@ -195,10 +218,144 @@ func (m *DataUpdateBatch) GetResync() bool {
 	return false
 }
 
+func (m *DataUpdateBatch) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+func (m *DataUpdateBatch) GetDeletedKeys() []string {
+	if m != nil {
+		return m.DeletedKeys
+	}
+	return nil
+}
+
+type StreamUpdateNotification struct {
+	// Name of the stream whose data changed
+	StreamName string `protobuf:"bytes,1,opt,name=stream_name,json=streamName,proto3" json:"stream_name,omitempty"`
+	// Network whose data of the stream changed
+	NetworkId string `protobuf:"bytes,2,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+	// Keys of the added, updated or deleted items
+	Keys                 []string `protobuf:"bytes,3,rep,name=keys,proto3" json:"keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StreamUpdateNotification) Reset()         { *m = StreamUpdateNotification{} }
+func (m *StreamUpdateNotification) String() string { return proto.CompactTextString(m) }
+func (*StreamUpdateNotification) ProtoMessage()    {}
+func (*StreamUpdateNotification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_streamer_26de085c5d2920f8, []int{3}
+}
+func (m *StreamUpdateNotification) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StreamUpdateNotification.Unmarshal(m, b)
+}
+func (m *StreamUpdateNotification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StreamUpdateNotification.Marshal(b, m, deterministic)
+}
+func (dst *StreamUpdateNotification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamUpdateNotification.Merge(dst, src)
+}
+func (m *StreamUpdateNotification) XXX_Size() int {
+	return xxx_messageInfo_StreamUpdateNotification.Size(m)
+}
+func (m *StreamUpdateNotification) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamUpdateNotification.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamUpdateNotification proto.InternalMessageInfo
+
+func (m *StreamUpdateNotification) GetStreamName() string {
+	if m != nil {
+		return m.StreamName
+	}
+	return ""
+}
+
+func (m *StreamUpdateNotification) GetNetworkId() string {
+	if m != nil {
+		return m.NetworkId
+	}
+	return ""
+}
+
+func (m *StreamUpdateNotification) GetKeys() []string {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+// Changelog of a stream in a network, persisted by the streamer. Only the
+// latest changes are kept, older cursors fall back to a resync.
+type StreamChangelog struct {
+	// Random ID of the changelog, cursors of another changelog of the same
+	// stream and network (e.g. after the network was recreated) are rejected
+	Epoch string `protobuf:"bytes,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// Version of the first kept change, the version of keys[i] is
+	// first_version + i
+	FirstVersion uint64 `protobuf:"varint,2,opt,name=first_version,json=firstVersion,proto3" json:"first_version,omitempty"`
+	// Keys of the changed items, in order of the changes
+	Keys                 []string `protobuf:"bytes,3,rep,name=keys,proto3" json:"keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StreamChangelog) Reset()         { *m = StreamChangelog{} }
+func (m *StreamChangelog) String() string { return proto.CompactTextString(m) }
+func (*StreamChangelog) ProtoMessage()    {}
+func (*StreamChangelog) Descriptor() ([]byte, []int) {
+	return fileDescriptor_streamer_26de085c5d2920f8, []int{4}
+}
+func (m *StreamChangelog) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StreamChangelog.Unmarshal(m, b)
+}
+func (m *StreamChangelog) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StreamChangelog.Marshal(b, m, deterministic)
+}
+func (dst *StreamChangelog) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamChangelog.Merge(dst, src)
+}
+func (m *StreamChangelog) XXX_Size() int {
+	return xxx_messageInfo_StreamChangelog.Size(m)
+}
+func (m *StreamChangelog) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamChangelog.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamChangelog proto.InternalMessageInfo
+
+func (m *StreamChangelog) GetEpoch() string {
+	if m != nil {
+		return m.Epoch
+	}
+	return ""
+}
+
+func (m *StreamChangelog) GetFirstVersion() uint64 {
+	if m != nil {
+		return m.FirstVersion
+	}
+	return 0
+}
+
+func (m *StreamChangelog) GetKeys() []string {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*StreamRequest)(nil), "magma.orc8r.StreamRequest")
 	proto.RegisterType((*DataUpdate)(nil), "magma.orc8r.DataUpdate")
 	proto.RegisterType((*DataUpdateBatch)(nil), "magma.orc8r.DataUpdateBatch")
+	proto.RegisterType((*StreamUpdateNotification)(nil), "magma.orc8r.StreamUpdateNotification")
+	proto.RegisterType((*StreamChangelog)(nil), "magma.orc8r.StreamChangelog")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -216,6 +373,12 @@ type StreamerClient interface {
 	// Get the stream of updates from the cloud.
 	// The RPC call would be kept open to push new updates as they happen.
 	GetUpdates(ctx context.Context, in *StreamRequest, opts ...grpc.CallOption) (Streamer_GetUpdatesClient, error)
+	// Record the change of a stream's data in a network in the changelog of the
+	// stream and wake up the open streams of the network, so they push the
+	// changed data to their gateways right away. Called by the cloud services
+	// writing the streams' data after every write, gateways are not allowed to
+	// call it.
+	NotifyStreamUpdate(ctx context.Context, in *StreamUpdateNotification, opts ...grpc.CallOption) (*Void, error)
 }
 
 type streamerClient struct {
@@ -258,11 +421,26 @@ func (x *streamerGetUpdatesClient) Recv() (*DataUpdateBatch, error) {
 	return m, nil
 }
 
+func (c *streamerClient) NotifyStreamUpdate(ctx context.Context, in *StreamUpdateNotification, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/magma.orc8r.Streamer/NotifyStreamUpdate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // StreamerServer is the server API for Streamer service.
 type StreamerServer interface {
 	// Get the stream of updates from the cloud.
 	// The RPC call would be kept open to push new updates as they happen.
 	GetUpdates(*StreamRequest, Streamer_GetUpdatesServer) error
+	// Record the change of a stream's data in a network in the changelog of the
+	// stream and wake up the open streams of the network, so they push the
+	// changed data to their gateways right away. Called by the cloud services
+	// writing the streams' data after every write, gateways are not allowed to
+	// call it.
+	NotifyStreamUpdate(context.Context, *StreamUpdateNotification) (*Void, error)
 }
 
 func RegisterStreamerServer(s *grpc.Server, srv StreamerServer) {
@@ -290,10 +468,33 @@ func (x *streamerGetUpdatesServer) Send(m *DataUpdateBatch) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Streamer_NotifyStreamUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StreamUpdateNotification)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamerServer).NotifyStreamUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.orc8r.Streamer/NotifyStreamUpdate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamerServer).NotifyStreamUpdate(ctx, req.(*StreamUpdateNotification))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Streamer_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "magma.orc8r.Streamer",
 	HandlerType: (*StreamerServer)(nil),
-	Methods:     []grpc.MethodDesc{},
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "NotifyStreamUpdate",
+			Handler:    _Streamer_NotifyStreamUpdate_Handler,
+		},
+	},
 	Streams: []grpc.StreamDesc{
 		{
 			StreamName:    "GetUpdates",
@@ -305,29 +506,40 @@ var _Streamer_serviceDesc = grpc.ServiceDesc{
 }
 
 func init() {
-	proto.RegisterFile("orc8r/protos/streamer.proto", fileDescriptor_streamer_875df42a7991f84c)
-}
-
-var fileDescriptor_streamer_875df42a7991f84c = []byte{
-	// 312 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xc1, 0x4f, 0xf2, 0x40,
-	0x10, 0xc5, 0xbf, 0x7e, 0x44, 0x84, 0xa9, 0x46, 0xb3, 0x21, 0x5a, 0x01, 0x23, 0xe9, 0x89, 0xd3,
-	0x56, 0xc1, 0x83, 0x57, 0x88, 0x89, 0xd1, 0x83, 0x87, 0x25, 0x78, 0x30, 0x26, 0x64, 0x28, 0xe3,
-	0x9a, 0x48, 0xbb, 0xb8, 0xbb, 0x55, 0x7b, 0xf6, 0x1f, 0x37, 0xee, 0x96, 0x20, 0x07, 0x4f, 0xed,
-	0x9b, 0xfd, 0xcd, 0xbc, 0xc9, 0x1b, 0xe8, 0x28, 0x9d, 0x5e, 0xe9, 0x64, 0xa5, 0x95, 0x55, 0x26,
-	0x31, 0x56, 0x13, 0x66, 0xa4, 0xb9, 0xd3, 0x2c, 0xcc, 0x50, 0x66, 0xc8, 0x1d, 0xd2, 0x3e, 0x91,
-	0x4a, 0xc9, 0x25, 0x79, 0x74, 0x5e, 0x3c, 0x27, 0x98, 0x97, 0x9e, 0x8b, 0xbf, 0x02, 0xd8, 0x9f,
-	0xb8, 0x56, 0x41, 0x6f, 0x05, 0x19, 0xcb, 0xba, 0xd0, 0x94, 0x68, 0xe9, 0x03, 0xcb, 0xdb, 0x45,
-	0x14, 0xf4, 0x82, 0x7e, 0x53, 0x6c, 0x0a, 0xec, 0x0c, 0x42, 0xef, 0x34, 0xcb, 0x31, 0xa3, 0xe8,
-	0xbf, 0x7b, 0x07, 0x5f, 0xba, 0xc7, 0x8c, 0xd8, 0x10, 0x80, 0x3e, 0xad, 0xc6, 0x19, 0x6a, 0x69,
-	0xa2, 0x5a, 0x2f, 0xe8, 0x87, 0x83, 0x16, 0xf7, 0x0b, 0xf0, 0xf5, 0x02, 0x7c, 0x94, 0x97, 0xa2,
-	0xe9, 0xb8, 0x91, 0x96, 0x26, 0xbe, 0x04, 0xb8, 0x46, 0x8b, 0xd3, 0xd5, 0x02, 0x2d, 0xb1, 0x43,
-	0xa8, 0xbd, 0x52, 0x59, 0x79, 0xff, 0xfc, 0xb2, 0x16, 0xec, 0xbc, 0xe3, 0xb2, 0xf0, 0x7e, 0x7b,
-	0xc2, 0x8b, 0xf8, 0x09, 0x0e, 0x36, 0x5d, 0x63, 0xb4, 0xe9, 0x0b, 0xbb, 0x80, 0xdd, 0xc2, 0x49,
-	0x13, 0x05, 0xbd, 0x5a, 0x3f, 0x1c, 0x1c, 0xf3, 0x5f, 0x41, 0xf0, 0x0d, 0x2e, 0xd6, 0x1c, 0x3b,
-	0x82, 0xba, 0x26, 0x53, 0xe6, 0xa9, 0x1b, 0xde, 0x10, 0x95, 0x1a, 0x3c, 0x40, 0x63, 0x52, 0x65,
-	0xca, 0xee, 0x00, 0x6e, 0xc8, 0x4e, 0xab, 0x8e, 0xf6, 0xd6, 0xcc, 0xad, 0xf4, 0xda, 0xdd, 0x3f,
-	0xfc, 0xdc, 0x7a, 0xf1, 0xbf, 0xf3, 0x60, 0x7c, 0xfa, 0xd8, 0x71, 0x48, 0xe2, 0xcf, 0x97, 0x2e,
-	0x55, 0xb1, 0x48, 0xa4, 0xaa, 0xee, 0x38, 0xaf, 0xbb, 0xef, 0xf0, 0x3b, 0x00, 0x00, 0xff, 0xff,
-	0x75, 0x62, 0xfb, 0xc6, 0xde, 0x01, 0x00, 0x00,
+	proto.RegisterFile("orc8r/protos/streamer.proto", fileDescriptor_streamer_26de085c5d2920f8)
+}
+
+var fileDescriptor_streamer_26de085c5d2920f8 = []byte{
+	// 483 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0xc7, 0x17, 0x52, 0xc6, 0xfa, 0xd2, 0x69, 0x60, 0x4d, 0x90, 0x65, 0x9b, 0x28, 0x41, 0x48,
+	0x3d, 0x25, 0xd0, 0x71, 0xe0, 0xba, 0x81, 0x84, 0x06, 0xd2, 0x84, 0x32, 0x6d, 0x07, 0x2e, 0xc1,
+	0x4b, 0x5e, 0xdd, 0xa8, 0x89, 0x5d, 0x6c, 0x67, 0x23, 0x9f, 0x84, 0x03, 0x5f, 0x80, 0x8f, 0x89,
+	0x6a, 0x7b, 0x6a, 0xcb, 0xb6, 0x53, 0xfc, 0xfe, 0xfe, 0xe7, 0xbd, 0xbf, 0x7e, 0xb6, 0x61, 0x5f,
+	0xc8, 0xe2, 0x83, 0x4c, 0xe7, 0x52, 0x68, 0xa1, 0x52, 0xa5, 0x25, 0xd2, 0x06, 0x65, 0x62, 0x6a,
+	0x12, 0x34, 0x94, 0x35, 0x34, 0x31, 0x96, 0x68, 0x8f, 0x09, 0xc1, 0x6a, 0xb4, 0xd6, 0xab, 0x76,
+	0x92, 0x52, 0xde, 0x59, 0x5f, 0xb4, 0xb7, 0xd6, 0xa4, 0x10, 0x4d, 0x23, 0xb8, 0xdd, 0x8a, 0xff,
+	0x78, 0xb0, 0x7d, 0x6e, 0xba, 0x66, 0xf8, 0xb3, 0x45, 0xa5, 0xc9, 0x01, 0xf4, 0x19, 0xd5, 0x78,
+	0x43, 0xbb, 0xd3, 0x32, 0xf4, 0x86, 0xde, 0xa8, 0x9f, 0x2d, 0x05, 0xf2, 0x12, 0x02, 0x1b, 0x22,
+	0xe7, 0xb4, 0xc1, 0xf0, 0x91, 0xd9, 0x07, 0x2b, 0x9d, 0xd1, 0x06, 0xc9, 0x11, 0x00, 0xfe, 0xd2,
+	0x92, 0xe6, 0x54, 0x32, 0x15, 0xfa, 0x43, 0x6f, 0x14, 0x8c, 0x77, 0x13, 0x9b, 0x2d, 0xb9, 0xcd,
+	0x96, 0x1c, 0xf3, 0x2e, 0xeb, 0x1b, 0xdf, 0xb1, 0x64, 0x8a, 0x3c, 0x87, 0xcd, 0xa2, 0x95, 0x4a,
+	0xc8, 0xb0, 0x67, 0x1a, 0xba, 0x2a, 0x7e, 0x0f, 0xf0, 0x89, 0x6a, 0x7a, 0x31, 0x2f, 0xa9, 0x46,
+	0xf2, 0x14, 0xfc, 0x19, 0x76, 0x2e, 0xd3, 0x62, 0x49, 0x76, 0xe1, 0xf1, 0x35, 0xad, 0x5b, 0x9b,
+	0x63, 0x90, 0xd9, 0x22, 0xfe, 0xed, 0xc1, 0xce, 0xf2, 0xb7, 0x13, 0xaa, 0x8b, 0x29, 0x79, 0x07,
+	0x4f, 0x5a, 0x53, 0xaa, 0xd0, 0x1b, 0xfa, 0xa3, 0x60, 0xfc, 0x22, 0x59, 0x81, 0x97, 0x2c, 0xed,
+	0xd9, 0xad, 0x6f, 0x11, 0x4a, 0xa2, 0xea, 0x78, 0x61, 0xba, 0x6f, 0x65, 0xae, 0x5a, 0x09, 0xeb,
+	0xaf, 0x86, 0x25, 0xaf, 0x60, 0x50, 0x62, 0x8d, 0x1a, 0xcb, 0x7c, 0x86, 0x9d, 0x0a, 0x7b, 0x43,
+	0x7f, 0xd4, 0xcf, 0x02, 0xa7, 0x7d, 0xc5, 0x4e, 0xc5, 0x1c, 0x42, 0x0b, 0xdb, 0xce, 0x3a, 0x13,
+	0xba, 0x9a, 0x54, 0x05, 0xd5, 0x95, 0xe0, 0xff, 0x93, 0xf5, 0xee, 0x90, 0x3d, 0x04, 0xe0, 0xa8,
+	0x6f, 0x84, 0x9c, 0xe5, 0x55, 0xe9, 0xc8, 0xf7, 0x9d, 0x72, 0x5a, 0x12, 0x02, 0x3d, 0x33, 0xd6,
+	0x37, 0x63, 0xcd, 0x3a, 0xfe, 0x01, 0x3b, 0x76, 0xde, 0xc7, 0x29, 0xe5, 0x0c, 0x6b, 0xc1, 0x16,
+	0xc8, 0x70, 0x2e, 0x8a, 0xa9, 0x1b, 0x60, 0x0b, 0xf2, 0x1a, 0xb6, 0x27, 0x95, 0x54, 0x3a, 0xbf,
+	0x46, 0xa9, 0x2a, 0xc1, 0x4d, 0xfb, 0x5e, 0x36, 0x30, 0xe2, 0xa5, 0xd5, 0xee, 0x9b, 0x30, 0xfe,
+	0xeb, 0xc1, 0xd6, 0xb9, 0xbb, 0x95, 0xe4, 0x0b, 0xc0, 0x67, 0xd4, 0x17, 0x8e, 0x5f, 0xb4, 0x46,
+	0x78, 0xed, 0x92, 0x45, 0x07, 0x0f, 0xd0, 0x37, 0x87, 0x15, 0x6f, 0xbc, 0xf5, 0xc8, 0x37, 0x20,
+	0x06, 0x4f, 0xb7, 0x0a, 0x8c, 0xbc, 0xb9, 0xa7, 0xe7, 0x5d, 0x96, 0xd1, 0xb3, 0x35, 0xdb, 0xa5,
+	0xa8, 0xca, 0x78, 0xe3, 0xe4, 0xf0, 0xfb, 0xbe, 0x51, 0x53, 0xfb, 0x1a, 0x8a, 0x5a, 0xb4, 0x65,
+	0xca, 0x84, 0x7b, 0x16, 0x57, 0x9b, 0xe6, 0x7b, 0xf4, 0x6f, 0x00, 0x66, 0x7a, 0x8b, 0x0f, 0x72,
+	0x03, 0x00, 0x00,
 }
@@ -12,7 +12,9 @@ package cert
 import (
 	"crypto/tls"
 	"crypto/x509"
+	"encoding/pem"
 	"fmt"
+	"io/ioutil"
 	"math/big"
 	"strings"
 )
@@ -36,6 +38,23 @@ func LoadCertAndPrivKey(certFile, keyFile string) (
 	return
 }
 
+// LoadCert loads & parses the first PEM encoded certificate of certFile
+func LoadCert(certFile string) (*x509.Certificate, error) {
+	certPEM, err := ioutil.ReadFile(certFile)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to read certificate (%s): %s", certFile, err)
+	}
+	block, _ := pem.Decode(certPEM)
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, fmt.Errorf("Failed to find PEM certificate in %s", certFile)
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to parse cert (%s): %s", certFile, err)
+	}
+	return cert, nil
+}
+
 // SerialToString converts big.Int to hexadecimal string with uppercace letters
 // (A,B,C,D,E,F), without base prefix ("0x") and without leading zeros
 func SerialToString(certSerialNumber *big.Int) string {
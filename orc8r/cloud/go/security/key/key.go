@@ -19,6 +19,7 @@ import (
 	"io/ioutil"
 	"os"
 	"reflect"
+	"strings"
 )
 
 // returns 'public part' of the passed asymmetric encryption algo key 'priv'
@@ -72,15 +73,19 @@ func WriteKey(keyFile string, priv interface{}) error {
 	return nil
 }
 
-// read and parse private key from 'keyFile', return the 'priv' key
-// in the form of either *rsa.PrivateKey or *ecdsa.PrivateKey
+// read and parse PKCS#1, SEC 1 or PKCS#8 private key from 'keyFile', return
+// the 'priv' key in the form of either *rsa.PrivateKey or *ecdsa.PrivateKey
 func ReadKey(keyFile string) (priv interface{}, err error) {
 	byteKey, err := ioutil.ReadFile(keyFile)
 	if err != nil {
 		return nil, fmt.Errorf("Failed to open %s for reading: %s", keyFile, err)
 	}
 
-	pemKey, _ := pem.Decode(byteKey)
+	// skip leading non key blocks, e.g. EC PARAMETERS written by openssl
+	pemKey, rest := pem.Decode(byteKey)
+	for pemKey != nil && !strings.HasSuffix(pemKey.Type, "PRIVATE KEY") {
+		pemKey, rest = pem.Decode(rest)
+	}
 	if pemKey == nil {
 		return nil, fmt.Errorf("Failed to find PEM block in file %s", keyFile)
 	}
@@ -90,6 +95,8 @@ func ReadKey(keyFile string) (priv interface{}, err error) {
 		priv, err = x509.ParsePKCS1PrivateKey(pemKey.Bytes)
 	case "EC PRIVATE KEY":
 		priv, err = x509.ParseECPrivateKey(pemKey.Bytes)
+	case "PRIVATE KEY":
+		priv, err = x509.ParsePKCS8PrivateKey(pemKey.Bytes)
 	default:
 		err = fmt.Errorf("Key type %s is not supported.", pemKey.Type)
 		priv = nil
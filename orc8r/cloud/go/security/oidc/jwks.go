@@ -0,0 +1,348 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package oidc
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+)
+
+const (
+	// DiscoveryPath is the OpenID Provider configuration path relative to the issuer
+	DiscoveryPath = "/.well-known/openid-configuration"
+
+	maxResponseSize = 1 << 20
+)
+
+var (
+	// KeysCacheTTL is the time after which the JWKS is refetched
+	KeysCacheTTL = time.Hour
+	// MinKeysRefreshInterval rate limits JWKS refetches, e.g. caused by
+	// unknown key IDs
+	MinKeysRefreshInterval = time.Minute
+)
+
+// JSONWebKey is a JWK (RFC 7517) of an RSA or EC public key
+type JSONWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid,omitempty"`
+	Use string `json:"use,omitempty"`
+	Alg string `json:"alg,omitempty"`
+	// RSA
+	N string `json:"n,omitempty"`
+	E string `json:"e,omitempty"`
+	// EC
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	Y   string `json:"y,omitempty"`
+}
+
+// JSONWebKeySet is a JWK Set (RFC 7517, section 5)
+type JSONWebKeySet struct {
+	Keys []JSONWebKey `json:"keys"`
+}
+
+// NewJSONWebKey returns the JWK of an RSA or ECDSA public key
+func NewJSONWebKey(kid string, pub crypto.PublicKey) (JSONWebKey, error) {
+	switch k := pub.(type) {
+	case *rsa.PublicKey:
+		return JSONWebKey{
+			Kty: "RSA",
+			Kid: kid,
+			Use: "sig",
+			N:   encodeSegment(k.N.Bytes()),
+			E:   encodeSegment(big.NewInt(int64(k.E)).Bytes()),
+		}, nil
+	case *ecdsa.PublicKey:
+		size := (k.Curve.Params().BitSize + 7) / 8
+		return JSONWebKey{
+			Kty: "EC",
+			Kid: kid,
+			Use: "sig",
+			Crv: k.Curve.Params().Name,
+			X:   encodeSegment(padBytes(k.X.Bytes(), size)),
+			Y:   encodeSegment(padBytes(k.Y.Bytes(), size)),
+		}, nil
+	default:
+		return JSONWebKey{}, fmt.Errorf("Unsupported public key type: %T", pub)
+	}
+}
+
+// PublicKey returns the public key of the JWK
+func (jwk JSONWebKey) PublicKey() (crypto.PublicKey, error) {
+	switch jwk.Kty {
+	case "RSA":
+		n, err := decodeSegment(jwk.N)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid RSA modulus: %s", err)
+		}
+		e, err := decodeSegment(jwk.E)
+		if err != nil || len(e) == 0 || len(e) > 4 {
+			return nil, fmt.Errorf("Invalid RSA exponent")
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch jwk.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("Unsupported EC curve: %s", jwk.Crv)
+		}
+		x, err := decodeSegment(jwk.X)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid EC x coordinate: %s", err)
+		}
+		y, err := decodeSegment(jwk.Y)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid EC y coordinate: %s", err)
+		}
+		pub := &ecdsa.PublicKey{Curve: curve, X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}
+		if !curve.IsOnCurve(pub.X, pub.Y) {
+			return nil, fmt.Errorf("EC point is not on curve %s", jwk.Crv)
+		}
+		return pub, nil
+	default:
+		return nil, fmt.Errorf("Unsupported key type: %s", jwk.Kty)
+	}
+}
+
+// KeySet is a JWKS fetched from a URL & cached. If the URL is not known, it is
+// discovered from the issuer's OpenID Provider configuration.
+type KeySet struct {
+	mu      sync.Mutex
+	issuer  string
+	jwksURL string
+	client  *http.Client
+	keys    map[string]verificationKey
+	// fetched is the time of the last successful fetch
+	fetched time.Time
+	// lastAttempt is the time of the last fetch, successful or not
+	lastAttempt time.Time
+	// refreshing is closed once the fetch in flight is done, nil if none is
+	refreshing chan struct{}
+	// refreshErr is the error of the last fetch
+	refreshErr error
+	static     bool
+}
+
+// verificationKey is a public key of the key set & the algorithm it's
+// restricted to, if any
+type verificationKey struct {
+	pub crypto.PublicKey
+	alg string
+}
+
+// NewKeySet returns a key set of the given JWKS URL or, if jwksURL is empty, of
+// the JWKS URL discovered from the issuer
+func NewKeySet(issuer, jwksURL string) *KeySet {
+	return &KeySet{issuer: issuer, jwksURL: jwksURL, client: &http.Client{Timeout: 10 * time.Second}}
+}
+
+// NewStaticKeySet returns a key set of the given keys, which is never refetched
+func NewStaticKeySet(keys map[string]crypto.PublicKey) *KeySet {
+	ks := &KeySet{keys: map[string]verificationKey{}, static: true}
+	for kid, pub := range keys {
+		ks.keys[kid] = verificationKey{pub: pub}
+	}
+	return ks
+}
+
+// GetKey returns the public key with the given key ID for verifying a token
+// signed with the alg algorithm. Keys restricted to another algorithm are
+// rejected.
+//
+// The JWKS is refetched in the background if the cached JWKS expired, and
+// refetched & waited for if the key ID is unknown. Fetches happen at most
+// once per MinKeysRefreshInterval, and concurrent callers share one fetch.
+func (ks *KeySet) GetKey(kid string, alg string) (crypto.PublicKey, error) {
+	ks.mu.Lock()
+	key, ok := ks.findKey(kid)
+	if ok {
+		if !ks.static && time.Since(ks.fetched) > KeysCacheTTL {
+			ks.startRefresh()
+		}
+		ks.mu.Unlock()
+		return key.forAlgorithm(alg)
+	}
+	var done chan struct{}
+	if !ks.static {
+		done = ks.startRefresh()
+	}
+	refreshErr := ks.refreshErr
+	ks.mu.Unlock()
+	if done == nil {
+		if refreshErr != nil {
+			return nil, refreshErr
+		}
+		return nil, fmt.Errorf("Unknown key ID: %q", kid)
+	}
+
+	<-done
+	ks.mu.Lock()
+	key, ok = ks.findKey(kid)
+	refreshErr = ks.refreshErr
+	ks.mu.Unlock()
+	if !ok {
+		if refreshErr != nil {
+			return nil, refreshErr
+		}
+		return nil, fmt.Errorf("Unknown key ID: %q", kid)
+	}
+	return key.forAlgorithm(alg)
+}
+
+// forAlgorithm returns the public key if it can verify alg signatures
+func (key verificationKey) forAlgorithm(alg string) (crypto.PublicKey, error) {
+	if len(key.alg) > 0 && key.alg != alg {
+		return nil, fmt.Errorf("Token signing key is restricted to algorithm %s", key.alg)
+	}
+	return key.pub, nil
+}
+
+// findKey returns the key with the given ID or the only key if kid is empty
+func (ks *KeySet) findKey(kid string) (verificationKey, bool) {
+	if len(kid) == 0 && len(ks.keys) == 1 {
+		for _, key := range ks.keys {
+			return key, true
+		}
+	}
+	key, ok := ks.keys[kid]
+	return key, ok
+}
+
+// startRefresh starts fetching the JWKS, unless a fetch is already in flight
+// or the last one was less than MinKeysRefreshInterval ago. It returns a
+// channel closed once the fetch in flight is done, nil if none is. The caller
+// must hold the lock.
+func (ks *KeySet) startRefresh() chan struct{} {
+	if ks.refreshing != nil {
+		return ks.refreshing
+	}
+	if !ks.lastAttempt.IsZero() && time.Since(ks.lastAttempt) < MinKeysRefreshInterval {
+		return nil
+	}
+	ks.lastAttempt = time.Now()
+	done := make(chan struct{})
+	ks.refreshing = done
+	jwksURL := ks.jwksURL
+	go func() {
+		jwksURL, keys, err := ks.fetch(jwksURL)
+		ks.mu.Lock()
+		if err == nil {
+			ks.jwksURL = jwksURL
+			ks.keys = keys
+			ks.fetched = time.Now()
+		} else {
+			glog.Errorf("Failed to refresh JWKS: %s", err)
+		}
+		ks.refreshErr = err
+		ks.refreshing = nil
+		ks.mu.Unlock()
+		close(done)
+	}()
+	return done
+}
+
+// fetch fetches the JWKS from jwksURL, discovered if empty, and returns the
+// JWKS URL & its signing keys
+func (ks *KeySet) fetch(jwksURL string) (string, map[string]verificationKey, error) {
+	if len(jwksURL) == 0 {
+		var err error
+		jwksURL, err = ks.discoverJWKSURL()
+		if err != nil {
+			return "", nil, err
+		}
+	}
+	var jwks JSONWebKeySet
+	if err := ks.getJSON(jwksURL, &jwks); err != nil {
+		return "", nil, fmt.Errorf("Failed to fetch JWKS: %s", err)
+	}
+	keys := map[string]verificationKey{}
+	for _, jwk := range jwks.Keys {
+		// Keys published for another use, e.g. encryption, never verify tokens
+		if len(jwk.Use) > 0 && jwk.Use != "sig" {
+			continue
+		}
+		pub, err := jwk.PublicKey()
+		if err != nil {
+			glog.Warningf("Skipping JWK %q: %s", jwk.Kid, err)
+			continue
+		}
+		keys[jwk.Kid] = verificationKey{pub: pub, alg: jwk.Alg}
+	}
+	return jwksURL, keys, nil
+}
+
+func (ks *KeySet) discoverJWKSURL() (string, error) {
+	var providerConfig struct {
+		Issuer  string `json:"issuer"`
+		JWKSURI string `json:"jwks_uri"`
+	}
+	err := ks.getJSON(strings.TrimSuffix(ks.issuer, "/")+DiscoveryPath, &providerConfig)
+	if err != nil {
+		return "", fmt.Errorf("OpenID Provider discovery failed: %s", err)
+	}
+	if providerConfig.Issuer != ks.issuer {
+		return "", fmt.Errorf(
+			"OpenID Provider issuer mismatch, expected %s, got %s", ks.issuer, providerConfig.Issuer)
+	}
+	if len(providerConfig.JWKSURI) == 0 {
+		return "", fmt.Errorf("OpenID Provider configuration is missing jwks_uri")
+	}
+	return providerConfig.JWKSURI, nil
+}
+
+func (ks *KeySet) getJSON(url string, v interface{}) error {
+	resp, err := ks.client.Get(url)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("GET %s: %s", url, resp.Status)
+	}
+	body, err := ioutil.ReadAll(http.MaxBytesReader(nil, resp.Body, maxResponseSize))
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(body, v)
+}
+
+func encodeSegment(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodeSegment(s string) ([]byte, error) {
+	return base64.RawURLEncoding.DecodeString(strings.TrimRight(s, "="))
+}
+
+func padBytes(b []byte, size int) []byte {
+	if len(b) >= size {
+		return b
+	}
+	return append(make([]byte, size-len(b)), b...)
+}
@@ -0,0 +1,267 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+// Package oidc verifies OpenID Connect ID tokens & OAuth2 JWT access tokens
+// signed with the keys of the issuer's JWKS
+package oidc
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	_ "crypto/sha256"
+	_ "crypto/sha512"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+)
+
+// DefaultLeeway is the allowed clock skew of exp & nbf claims
+const DefaultLeeway = time.Minute
+
+// Claims are the claims of a verified token
+type Claims map[string]interface{}
+
+// GetString returns the string claim or an error if it's missing or not a string
+func (c Claims) GetString(name string) (string, error) {
+	v, ok := c[name]
+	if !ok {
+		return "", fmt.Errorf("Missing %s claim", name)
+	}
+	s, ok := v.(string)
+	if !ok || len(s) == 0 {
+		return "", fmt.Errorf("Invalid %s claim", name)
+	}
+	return s, nil
+}
+
+// Verifier verifies the signature & the iss, aud, azp, exp & nbf claims of JWTs
+type Verifier struct {
+	Issuer string
+	// Audience is the client ID, it must be one of the token's audiences and
+	// the token's authorized party, if any
+	Audience string
+	Keys     *KeySet
+	Leeway   time.Duration
+	// Now returns the current time, time.Now if nil
+	Now func() time.Time
+}
+
+// NewVerifier returns a verifier of tokens of the issuer for the audience (the
+// client ID). The issuer's signing keys are fetched from jwksURL or, if jwksURL
+// is empty, from the JWKS URL of the issuer's OpenID Provider configuration.
+func NewVerifier(issuer, audience, jwksURL string) (*Verifier, error) {
+	if len(issuer) == 0 {
+		return nil, fmt.Errorf("Empty OIDC issuer")
+	}
+	if len(audience) == 0 {
+		return nil, fmt.Errorf("Empty OIDC client ID")
+	}
+	return &Verifier{
+		Issuer:   issuer,
+		Audience: audience,
+		Keys:     NewKeySet(issuer, jwksURL),
+		Leeway:   DefaultLeeway,
+	}, nil
+}
+
+type header struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+	Typ string `json:"typ"`
+}
+
+type algorithm struct {
+	hash crypto.Hash
+	kty  string
+	pss  bool
+	// curve is the EC curve of the algorithm (RFC 7518, 3.4)
+	curve string
+}
+
+var algorithms = map[string]algorithm{
+	"RS256": {crypto.SHA256, "RSA", false, ""},
+	"RS384": {crypto.SHA384, "RSA", false, ""},
+	"RS512": {crypto.SHA512, "RSA", false, ""},
+	"PS256": {crypto.SHA256, "RSA", true, ""},
+	"PS384": {crypto.SHA384, "RSA", true, ""},
+	"PS512": {crypto.SHA512, "RSA", true, ""},
+	"ES256": {crypto.SHA256, "EC", false, "P-256"},
+	"ES384": {crypto.SHA384, "EC", false, "P-384"},
+	"ES512": {crypto.SHA512, "EC", false, "P-521"},
+}
+
+// Verify verifies the compact serialized JWS token & returns its claims
+func (v *Verifier) Verify(token string) (Claims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("Malformed token")
+	}
+	headerJSON, err := decodeSegment(parts[0])
+	if err != nil {
+		return nil, fmt.Errorf("Malformed token header: %s", err)
+	}
+	var hdr header
+	if err = json.Unmarshal(headerJSON, &hdr); err != nil {
+		return nil, fmt.Errorf("Malformed token header: %s", err)
+	}
+	alg, ok := algorithms[hdr.Alg]
+	if !ok {
+		return nil, fmt.Errorf("Unsupported token signing algorithm: %q", hdr.Alg)
+	}
+	sig, err := decodeSegment(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("Malformed token signature: %s", err)
+	}
+	pub, err := v.Keys.GetKey(hdr.Kid, hdr.Alg)
+	if err != nil {
+		return nil, err
+	}
+	if err = verifySignature(alg, pub, []byte(parts[0]+"."+parts[1]), sig); err != nil {
+		return nil, err
+	}
+
+	payload, err := decodeSegment(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("Malformed token payload: %s", err)
+	}
+	var claims Claims
+	decoder := json.NewDecoder(bytes.NewReader(payload))
+	decoder.UseNumber()
+	if err = decoder.Decode(&claims); err != nil {
+		return nil, fmt.Errorf("Malformed token payload: %s", err)
+	}
+	if err = v.verifyClaims(claims); err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+func (v *Verifier) verifyClaims(claims Claims) error {
+	iss, err := claims.GetString("iss")
+	if err != nil {
+		return err
+	}
+	if iss != v.Issuer {
+		return fmt.Errorf("Invalid token issuer: %s", iss)
+	}
+	if len(v.Audience) == 0 {
+		return fmt.Errorf("Empty OIDC client ID")
+	}
+	if !hasAudience(claims["aud"], v.Audience) {
+		return fmt.Errorf("Token audience does not include %s", v.Audience)
+	}
+	// OpenID Connect Core 1.0, 3.1.3.7: tokens with multiple audiences must
+	// have an azp claim, which must be the client ID
+	if azp, ok := claims["azp"]; ok || hasMultipleAudiences(claims["aud"]) {
+		if s, _ := azp.(string); s != v.Audience {
+			return fmt.Errorf("Token authorized party is not %s", v.Audience)
+		}
+	}
+
+	now := time.Now()
+	if v.Now != nil {
+		now = v.Now()
+	}
+	exp, ok, err := getTime(claims, "exp")
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf("Missing exp claim")
+	}
+	if now.After(exp.Add(v.Leeway)) {
+		return fmt.Errorf("Token expired at %s", exp.UTC())
+	}
+	nbf, ok, err := getTime(claims, "nbf")
+	if err != nil {
+		return err
+	}
+	if ok && now.Add(v.Leeway).Before(nbf) {
+		return fmt.Errorf("Token is not valid before %s", nbf.UTC())
+	}
+	return nil
+}
+
+func verifySignature(alg algorithm, pub crypto.PublicKey, signed, sig []byte) error {
+	h := alg.hash.New()
+	h.Write(signed)
+	digest := h.Sum(nil)
+	switch k := pub.(type) {
+	case *rsa.PublicKey:
+		if alg.kty != "RSA" {
+			break
+		}
+		var err error
+		if alg.pss {
+			err = rsa.VerifyPSS(k, alg.hash, digest, sig, nil)
+		} else {
+			err = rsa.VerifyPKCS1v15(k, alg.hash, digest, sig)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid token signature")
+		}
+		return nil
+	case *ecdsa.PublicKey:
+		if alg.kty != "EC" {
+			break
+		}
+		if k.Curve.Params().Name != alg.curve {
+			return fmt.Errorf("Token signing key curve %s does not match algorithm", k.Curve.Params().Name)
+		}
+		// JWS ECDSA signatures are R || S, each padded to the curve size
+		size := (k.Curve.Params().BitSize + 7) / 8
+		if len(sig) != 2*size {
+			return fmt.Errorf("Invalid token signature")
+		}
+		r, s := new(big.Int).SetBytes(sig[:size]), new(big.Int).SetBytes(sig[size:])
+		if !ecdsa.Verify(k, digest, r, s) {
+			return fmt.Errorf("Invalid token signature")
+		}
+		return nil
+	}
+	return fmt.Errorf("Token signing key type %T does not match algorithm", pub)
+}
+
+func hasAudience(aud interface{}, audience string) bool {
+	switch a := aud.(type) {
+	case string:
+		return a == audience
+	case []interface{}:
+		for _, v := range a {
+			if s, ok := v.(string); ok && s == audience {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func hasMultipleAudiences(aud interface{}) bool {
+	a, ok := aud.([]interface{})
+	return ok && len(a) > 1
+}
+
+func getTime(claims Claims, name string) (time.Time, bool, error) {
+	v, ok := claims[name]
+	if !ok {
+		return time.Time{}, false, nil
+	}
+	n, ok := v.(json.Number)
+	if !ok {
+		return time.Time{}, false, fmt.Errorf("Invalid %s claim", name)
+	}
+	secs, err := n.Float64()
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("Invalid %s claim", name)
+	}
+	return time.Unix(int64(secs), 0), true, nil
+}
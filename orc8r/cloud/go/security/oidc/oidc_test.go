@@ -0,0 +1,274 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package oidc_test
+
+import (
+	"crypto"
+	"encoding/base64"
+	"strings"
+	"testing"
+	"time"
+
+	"magma/orc8r/cloud/go/security/key"
+	"magma/orc8r/cloud/go/security/oidc"
+	"magma/orc8r/cloud/go/security/oidc/test_utils"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVerify(t *testing.T) {
+	for _, keyType := range []string{"", "P256"} {
+		provider, err := test_utils.NewProvider(keyType)
+		assert.NoError(t, err)
+		defer provider.Close()
+
+		verifier, err := oidc.NewVerifier(provider.Issuer(), test_utils.TestAudience, provider.JWKSURL())
+		assert.NoError(t, err)
+		token, err := provider.IssueToken("bob", map[string]interface{}{"email": "bob@magma.test"})
+		assert.NoError(t, err)
+		claims, err := verifier.Verify(token)
+		assert.NoError(t, err)
+		sub, err := claims.GetString("sub")
+		assert.NoError(t, err)
+		assert.Equal(t, "bob", sub)
+		email, err := claims.GetString("email")
+		assert.NoError(t, err)
+		assert.Equal(t, "bob@magma.test", email)
+		_, err = claims.GetString("name")
+		assert.EqualError(t, err, "Missing name claim")
+
+		// tampered payload
+		parts := strings.Split(token, ".")
+		otherToken, err := provider.IssueToken("admin", nil)
+		assert.NoError(t, err)
+		tampered := parts[0] + "." + strings.Split(otherToken, ".")[1] + "." + parts[2]
+		_, err = verifier.Verify(tampered)
+		assert.EqualError(t, err, "Invalid token signature")
+	}
+}
+
+func TestVerifyDiscovery(t *testing.T) {
+	provider, err := test_utils.NewProvider("P256")
+	assert.NoError(t, err)
+	defer provider.Close()
+
+	verifier, err := oidc.NewVerifier(provider.Issuer(), test_utils.TestAudience, "")
+	assert.NoError(t, err)
+	token, err := provider.IssueToken("bob", nil)
+	assert.NoError(t, err)
+	_, err = verifier.Verify(token)
+	assert.NoError(t, err)
+	_, err = verifier.Verify(token)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, provider.JWKSRequests())
+
+	// unknown key IDs refetch the JWKS at most once per MinKeysRefreshInterval
+	token, err = provider.Sign("rotated-key", map[string]interface{}{
+		"iss": provider.Issuer(),
+		"aud": test_utils.TestAudience,
+		"exp": time.Now().Add(time.Hour).Unix(),
+	})
+	assert.NoError(t, err)
+	_, err = verifier.Verify(token)
+	assert.EqualError(t, err, `Unknown key ID: "rotated-key"`)
+	assert.Equal(t, 1, provider.JWKSRequests())
+
+	_, err = oidc.NewVerifier("", "", "")
+	assert.EqualError(t, err, "Empty OIDC issuer")
+	_, err = oidc.NewVerifier(provider.Issuer(), "", "")
+	assert.EqualError(t, err, "Empty OIDC client ID")
+	verifier, err = oidc.NewVerifier(provider.Issuer()+"/other", test_utils.TestAudience, "")
+	assert.NoError(t, err)
+	_, err = verifier.Verify(token)
+	assert.Error(t, err)
+}
+
+func TestVerifyKeyRestrictions(t *testing.T) {
+	provider, err := test_utils.NewProvider("")
+	assert.NoError(t, err)
+	defer provider.Close()
+	// tokens are signed with RS256
+	assert.NoError(t, provider.AddJWK("rs256-key", "sig", "RS256"))
+	assert.NoError(t, provider.AddJWK("ps256-key", "sig", "PS256"))
+	assert.NoError(t, provider.AddJWK("enc-key", "enc", ""))
+
+	verifier, err := oidc.NewVerifier(provider.Issuer(), test_utils.TestAudience, provider.JWKSURL())
+	assert.NoError(t, err)
+	claims := map[string]interface{}{
+		"iss": provider.Issuer(),
+		"aud": test_utils.TestAudience,
+		"exp": time.Now().Add(time.Hour).Unix(),
+	}
+	token, err := provider.Sign("rs256-key", claims)
+	assert.NoError(t, err)
+	_, err = verifier.Verify(token)
+	assert.NoError(t, err)
+	token, err = provider.Sign("ps256-key", claims)
+	assert.NoError(t, err)
+	_, err = verifier.Verify(token)
+	assert.EqualError(t, err, "Token signing key is restricted to algorithm PS256")
+	token, err = provider.Sign("enc-key", claims)
+	assert.NoError(t, err)
+	_, err = verifier.Verify(token)
+	assert.EqualError(t, err, `Unknown key ID: "enc-key"`)
+}
+
+func TestVerifyDuringJWKSRefresh(t *testing.T) {
+	provider, err := test_utils.NewProvider("P256")
+	assert.NoError(t, err)
+	defer provider.Close()
+	defer func(interval time.Duration) { oidc.MinKeysRefreshInterval = interval }(oidc.MinKeysRefreshInterval)
+	oidc.MinKeysRefreshInterval = 0
+
+	verifier, err := oidc.NewVerifier(provider.Issuer(), test_utils.TestAudience, provider.JWKSURL())
+	assert.NoError(t, err)
+	token, err := provider.IssueToken("bob", nil)
+	assert.NoError(t, err)
+	_, err = verifier.Verify(token)
+	assert.NoError(t, err)
+
+	// tokens of unknown key IDs wait for one shared, slow refetch
+	provider.SetJWKSDelay(time.Second)
+	unknownToken, err := provider.Sign("rotated-key", map[string]interface{}{
+		"iss": provider.Issuer(),
+		"aud": test_utils.TestAudience,
+		"exp": time.Now().Add(time.Hour).Unix(),
+	})
+	assert.NoError(t, err)
+	errs := make(chan error, 3)
+	for i := 0; i < 3; i++ {
+		go func() {
+			_, err := verifier.Verify(unknownToken)
+			errs <- err
+		}()
+	}
+	time.Sleep(100 * time.Millisecond)
+
+	// tokens of cached keys don't wait for the refetch
+	start := time.Now()
+	_, err = verifier.Verify(token)
+	assert.NoError(t, err)
+	assert.True(t, time.Since(start) < 500*time.Millisecond)
+
+	for i := 0; i < 3; i++ {
+		assert.EqualError(t, <-errs, `Unknown key ID: "rotated-key"`)
+	}
+	assert.Equal(t, 2, provider.JWKSRequests())
+}
+
+func TestVerifyClaims(t *testing.T) {
+	provider, err := test_utils.NewProvider("P256")
+	assert.NoError(t, err)
+	defer provider.Close()
+	verifier, err := oidc.NewVerifier(provider.Issuer(), test_utils.TestAudience, provider.JWKSURL())
+	assert.NoError(t, err)
+	now := time.Now()
+
+	testCases := []struct {
+		claims      map[string]interface{}
+		expectedErr string
+	}{
+		{map[string]interface{}{"aud": []string{"other", test_utils.TestAudience}, "azp": test_utils.TestAudience}, ""},
+		{map[string]interface{}{"aud": []string{test_utils.TestAudience}}, ""},
+		{map[string]interface{}{"azp": test_utils.TestAudience}, ""},
+		{map[string]interface{}{"nbf": now.Add(30 * time.Second).Unix()}, ""},
+		{map[string]interface{}{"exp": now.Add(-30 * time.Second).Unix()}, ""},
+		{map[string]interface{}{"iss": "https://evil.test"}, "Invalid token issuer: https://evil.test"},
+		{map[string]interface{}{"aud": "other"}, "Token audience does not include magma-test"},
+		{map[string]interface{}{"aud": []string{"other"}}, "Token audience does not include magma-test"},
+		{map[string]interface{}{"aud": nil}, "Token audience does not include magma-test"},
+		{map[string]interface{}{"aud": []string{"other", test_utils.TestAudience}}, "Token authorized party is not magma-test"},
+		{map[string]interface{}{"azp": "other"}, "Token authorized party is not magma-test"},
+		{map[string]interface{}{"exp": "tomorrow"}, "Invalid exp claim"},
+		{map[string]interface{}{"exp": now.Add(-time.Hour).Unix()}, "Token expired at "},
+		{map[string]interface{}{"nbf": now.Add(time.Hour).Unix()}, "Token is not valid before "},
+	}
+	for _, tc := range testCases {
+		token, err := provider.IssueToken("bob", tc.claims)
+		assert.NoError(t, err)
+		_, err = verifier.Verify(token)
+		if len(tc.expectedErr) == 0 {
+			assert.NoError(t, err, "claims: %v", tc.claims)
+		} else if assert.Error(t, err, "claims: %v", tc.claims) {
+			assert.True(t, strings.HasPrefix(err.Error(), tc.expectedErr), err.Error())
+		}
+	}
+
+	token, err := provider.Sign(test_utils.TestKeyID, map[string]interface{}{"iss": provider.Issuer(), "aud": test_utils.TestAudience})
+	assert.NoError(t, err)
+	_, err = verifier.Verify(token)
+	assert.EqualError(t, err, "Missing exp claim")
+}
+
+func TestVerifyRejectsUnsupportedAlgorithms(t *testing.T) {
+	provider, err := test_utils.NewProvider("")
+	assert.NoError(t, err)
+	defer provider.Close()
+	verifier, err := oidc.NewVerifier(provider.Issuer(), test_utils.TestAudience, provider.JWKSURL())
+	assert.NoError(t, err)
+	token, err := provider.IssueToken("bob", nil)
+	assert.NoError(t, err)
+	parts := strings.Split(token, ".")
+
+	// "none" & HMAC (keyed with the public key) algorithms are never accepted
+	for _, hdr := range []string{`{"alg":"none"}`, `{"alg":"HS256","kid":"test-key"}`} {
+		forged := encode(hdr) + "." + parts[1] + "."
+		_, err = verifier.Verify(forged)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "Unsupported token signing algorithm")
+	}
+	// EC algorithm with an RSA key
+	_, err = verifier.Verify(encode(`{"alg":"ES256","kid":"test-key"}`) + "." + parts[1] + "." + parts[2])
+	assert.EqualError(t, err, "Token signing key type *rsa.PublicKey does not match algorithm")
+
+	_, err = verifier.Verify("not-a-token")
+	assert.EqualError(t, err, "Malformed token")
+
+	// EC algorithm of another curve
+	ecProvider, err := test_utils.NewProvider("P256")
+	assert.NoError(t, err)
+	defer ecProvider.Close()
+	verifier, err = oidc.NewVerifier(ecProvider.Issuer(), test_utils.TestAudience, ecProvider.JWKSURL())
+	assert.NoError(t, err)
+	token, err = ecProvider.IssueToken("bob", nil)
+	assert.NoError(t, err)
+	parts = strings.Split(token, ".")
+	_, err = verifier.Verify(token)
+	assert.NoError(t, err)
+	_, err = verifier.Verify(encode(`{"alg":"ES384","kid":"test-key"}`) + "." + parts[1] + "." + parts[2])
+	assert.EqualError(t, err, "Token signing key curve P-256 does not match algorithm")
+}
+
+func TestJSONWebKey(t *testing.T) {
+	for _, keyType := range []string{"", "P256", "P384", "P521"} {
+		bits := 0
+		if len(keyType) == 0 {
+			bits = 2048
+		}
+		privKey, err := key.GenerateKey(keyType, bits)
+		assert.NoError(t, err)
+		jwk, err := oidc.NewJSONWebKey("kid", key.PublicKey(privKey))
+		assert.NoError(t, err)
+		pub, err := jwk.PublicKey()
+		assert.NoError(t, err)
+		assert.Equal(t, key.PublicKey(privKey), pub)
+	}
+
+	keys := oidc.NewStaticKeySet(map[string]crypto.PublicKey{})
+	_, err := keys.GetKey("kid", "RS256")
+	assert.EqualError(t, err, `Unknown key ID: "kid"`)
+	_, err = oidc.JSONWebKey{Kty: "oct"}.PublicKey()
+	assert.EqualError(t, err, "Unsupported key type: oct")
+	_, err = oidc.JSONWebKey{Kty: "EC", Crv: "P-224"}.PublicKey()
+	assert.EqualError(t, err, "Unsupported EC curve: P-224")
+}
+
+func encode(s string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(s))
+}
@@ -0,0 +1,181 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+// Package test_utils provides a local OpenID Provider serving its discovery
+// document & a locally generated JWKS, and issuing signed tokens for OIDC tests
+package test_utils
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	"magma/orc8r/cloud/go/security/key"
+	"magma/orc8r/cloud/go/security/oidc"
+)
+
+const (
+	// TestAudience is the default audience (client ID) of issued tokens
+	TestAudience = "magma-test"
+	// TestKeyID is the key ID of the provider's signing key
+	TestKeyID = "test-key"
+)
+
+// Provider is a local OpenID Provider with a single signing key
+type Provider struct {
+	*httptest.Server
+	Key crypto.Signer
+
+	sync.Mutex
+	jwks         oidc.JSONWebKeySet
+	jwksDelay    time.Duration
+	jwksRequests int
+}
+
+// NewProvider starts a provider with a new signing key of the given key type
+// ("" for RSA 2048 & RS256 or "P256" for ECDSA & ES256)
+func NewProvider(keyType string) (*Provider, error) {
+	bits := 0
+	if len(keyType) == 0 {
+		bits = 2048
+	}
+	privKey, err := key.GenerateKey(keyType, bits)
+	if err != nil {
+		return nil, err
+	}
+	jwk, err := oidc.NewJSONWebKey(TestKeyID, key.PublicKey(privKey))
+	if err != nil {
+		return nil, err
+	}
+	p := &Provider{Key: privKey.(crypto.Signer), jwks: oidc.JSONWebKeySet{Keys: []oidc.JSONWebKey{jwk}}}
+	mux := http.NewServeMux()
+	mux.HandleFunc(oidc.DiscoveryPath, func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]string{"issuer": p.Issuer(), "jwks_uri": p.JWKSURL()})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		p.Lock()
+		p.jwksRequests++
+		jwks, delay := p.jwks, p.jwksDelay
+		p.Unlock()
+		time.Sleep(delay)
+		json.NewEncoder(w).Encode(jwks)
+	})
+	p.Server = httptest.NewServer(mux)
+	return p, nil
+}
+
+// Issuer returns the provider's issuer identifier
+func (p *Provider) Issuer() string {
+	return p.URL
+}
+
+// JWKSURL returns the URL of the provider's JWKS
+func (p *Provider) JWKSURL() string {
+	return p.URL + "/jwks"
+}
+
+// AddJWK publishes the provider's public key under another key ID, with the
+// given use & algorithm
+func (p *Provider) AddJWK(kid, use, alg string) error {
+	jwk, err := oidc.NewJSONWebKey(kid, p.Key.Public())
+	if err != nil {
+		return err
+	}
+	jwk.Use = use
+	jwk.Alg = alg
+	p.Lock()
+	defer p.Unlock()
+	p.jwks.Keys = append(p.jwks.Keys, jwk)
+	return nil
+}
+
+// SetJWKSDelay delays the provider's JWKS responses
+func (p *Provider) SetJWKSDelay(delay time.Duration) {
+	p.Lock()
+	defer p.Unlock()
+	p.jwksDelay = delay
+}
+
+// JWKSRequests returns the number of JWKS requests served
+func (p *Provider) JWKSRequests() int {
+	p.Lock()
+	defer p.Unlock()
+	return p.jwksRequests
+}
+
+// IssueToken returns a token of the subject valid for an hour, with the
+// provider's issuer, TestAudience & the extra claims
+func (p *Provider) IssueToken(subject string, extraClaims map[string]interface{}) (string, error) {
+	now := time.Now()
+	claims := map[string]interface{}{
+		"iss": p.Issuer(),
+		"sub": subject,
+		"aud": TestAudience,
+		"iat": now.Unix(),
+		"exp": now.Add(time.Hour).Unix(),
+	}
+	for k, v := range extraClaims {
+		claims[k] = v
+	}
+	return p.Sign(TestKeyID, claims)
+}
+
+// Sign returns the compact serialized JWS of the claims signed with the
+// provider's key, with the given key ID in the header
+func (p *Provider) Sign(kid string, claims map[string]interface{}) (string, error) {
+	alg := "RS256"
+	if _, ok := p.Key.Public().(*ecdsa.PublicKey); ok {
+		alg = "ES256"
+	}
+	headerJSON, err := json.Marshal(map[string]string{"alg": alg, "typ": "JWT", "kid": kid})
+	if err != nil {
+		return "", err
+	}
+	payloadJSON, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+	signed := encode(headerJSON) + "." + encode(payloadJSON)
+	digest := sha256.Sum256([]byte(signed))
+	sig, err := p.Key.Sign(rand.Reader, digest[:], crypto.SHA256)
+	if err != nil {
+		return "", err
+	}
+	if alg == "ES256" {
+		sig, err = toJWSSignature(sig)
+		if err != nil {
+			return "", err
+		}
+	}
+	return signed + "." + encode(sig), nil
+}
+
+// toJWSSignature converts an ASN.1 P-256 ECDSA signature to R || S
+func toJWSSignature(der []byte) ([]byte, error) {
+	var ecdsaSig struct{ R, S *big.Int }
+	if _, err := asn1.Unmarshal(der, &ecdsaSig); err != nil {
+		return nil, err
+	}
+	sig := make([]byte, 64)
+	ecdsaSig.R.FillBytes(sig[:32])
+	ecdsaSig.S.FillBytes(sig[32:])
+	return sig, nil
+}
+
+func encode(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
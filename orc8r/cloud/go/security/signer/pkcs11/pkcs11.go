@@ -0,0 +1,339 @@
+// +build pkcs11
+
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+// Package pkcs11 registers the PKCS#11 signer backend for pkcs11: URIs (RFC
+// 7512), e.g.
+//
+//	pkcs11:token=magma;object=bootstrap-ca?module-path=/usr/lib/softhsm/libsofthsm2.so&pin-source=/etc/magma/pin
+//
+// The token is selected by token label (token) or serial (serial), the key by
+// label (object) and/or ID (id). The user PIN is given by pin-value or read from
+// the pin-source file. The package requires cgo & is built with the pkcs11 build tag.
+package pkcs11
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/asn1"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/big"
+	"net/url"
+	"strings"
+	"sync"
+
+	"magma/orc8r/cloud/go/security/signer"
+
+	"github.com/miekg/pkcs11"
+)
+
+func init() {
+	signer.RegisterFactory("pkcs11", Open)
+}
+
+var (
+	oidNamedCurveP256 = asn1.ObjectIdentifier{1, 2, 840, 10045, 3, 1, 7}
+	oidNamedCurveP384 = asn1.ObjectIdentifier{1, 3, 132, 0, 34}
+	oidNamedCurveP521 = asn1.ObjectIdentifier{1, 3, 132, 0, 35}
+
+	// DER encoded DigestInfo prefixes of RSASSA-PKCS1-v1_5 signatures (RFC 8017, 9.2)
+	digestInfoPrefixes = map[crypto.Hash][]byte{
+		crypto.SHA1:   {0x30, 0x21, 0x30, 0x09, 0x06, 0x05, 0x2b, 0x0e, 0x03, 0x02, 0x1a, 0x05, 0x00, 0x04, 0x14},
+		crypto.SHA256: {0x30, 0x31, 0x30, 0x0d, 0x06, 0x09, 0x60, 0x86, 0x48, 0x01, 0x65, 0x03, 0x04, 0x02, 0x01, 0x05, 0x00, 0x04, 0x20},
+		crypto.SHA384: {0x30, 0x41, 0x30, 0x0d, 0x06, 0x09, 0x60, 0x86, 0x48, 0x01, 0x65, 0x03, 0x04, 0x02, 0x02, 0x05, 0x00, 0x04, 0x30},
+		crypto.SHA512: {0x30, 0x51, 0x30, 0x0d, 0x06, 0x09, 0x60, 0x86, 0x48, 0x01, 0x65, 0x03, 0x04, 0x02, 0x03, 0x05, 0x00, 0x04, 0x40},
+	}
+
+	pssParams = map[crypto.Hash][2]uint{
+		crypto.SHA1:   {pkcs11.CKM_SHA_1, pkcs11.CKG_MGF1_SHA1},
+		crypto.SHA256: {pkcs11.CKM_SHA256, pkcs11.CKG_MGF1_SHA256},
+		crypto.SHA384: {pkcs11.CKM_SHA384, pkcs11.CKG_MGF1_SHA384},
+		crypto.SHA512: {pkcs11.CKM_SHA512, pkcs11.CKG_MGF1_SHA512},
+	}
+)
+
+// pkcs11Signer is a crypto.Signer signing with a private key of a PKCS#11 token
+type pkcs11Signer struct {
+	// PKCS#11 sessions must not be used concurrently
+	sync.Mutex
+	ctx       *pkcs11.Ctx
+	session   pkcs11.SessionHandle
+	key       pkcs11.ObjectHandle
+	publicKey crypto.PublicKey
+}
+
+// Open opens the PKCS#11 signer of the given pkcs11: URI
+func Open(uri *url.URL) (crypto.Signer, error) {
+	attrs, err := parsePathAttributes(uri.Opaque)
+	if err != nil {
+		return nil, err
+	}
+	query := uri.Query()
+	modulePath := query.Get("module-path")
+	if len(modulePath) == 0 {
+		return nil, fmt.Errorf("Missing PKCS#11 module-path")
+	}
+	pin := query.Get("pin-value")
+	if pinSource := query.Get("pin-source"); len(pinSource) > 0 {
+		pinBytes, err := ioutil.ReadFile(strings.TrimPrefix(pinSource, "file:"))
+		if err != nil {
+			return nil, fmt.Errorf("Failed to read PKCS#11 PIN: %s", err)
+		}
+		pin = strings.TrimSpace(string(pinBytes))
+	}
+
+	ctx := pkcs11.New(modulePath)
+	if ctx == nil {
+		return nil, fmt.Errorf("Failed to load PKCS#11 module %s", modulePath)
+	}
+	if err = ctx.Initialize(); err != nil && !isError(err, pkcs11.CKR_CRYPTOKI_ALREADY_INITIALIZED) {
+		return nil, fmt.Errorf("Failed to initialize PKCS#11 module %s: %s", modulePath, err)
+	}
+	slot, err := findSlot(ctx, attrs["token"], attrs["serial"])
+	if err != nil {
+		return nil, err
+	}
+	session, err := ctx.OpenSession(slot, pkcs11.CKF_SERIAL_SESSION)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to open PKCS#11 session: %s", err)
+	}
+	s := &pkcs11Signer{ctx: ctx, session: session}
+	if err = s.init(pin, attrs["object"], attrs["id"]); err != nil {
+		ctx.CloseSession(session)
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *pkcs11Signer) init(pin, label, id string) error {
+	if len(pin) > 0 {
+		err := s.ctx.Login(s.session, pkcs11.CKU_USER, pin)
+		if err != nil && !isError(err, pkcs11.CKR_USER_ALREADY_LOGGED_IN) {
+			return fmt.Errorf("PKCS#11 login failed: %s", err)
+		}
+	}
+	if len(label) == 0 && len(id) == 0 {
+		return fmt.Errorf("PKCS#11 URI must specify the key object or id")
+	}
+	template := []*pkcs11.Attribute{}
+	if len(label) > 0 {
+		template = append(template, pkcs11.NewAttribute(pkcs11.CKA_LABEL, label))
+	}
+	if len(id) > 0 {
+		template = append(template, pkcs11.NewAttribute(pkcs11.CKA_ID, []byte(id)))
+	}
+	var err error
+	s.key, err = s.findObject(append(template, pkcs11.NewAttribute(pkcs11.CKA_CLASS, pkcs11.CKO_PRIVATE_KEY)))
+	if err != nil {
+		return fmt.Errorf("Failed to find PKCS#11 private key: %s", err)
+	}
+	pubKey, err := s.findObject(append(template, pkcs11.NewAttribute(pkcs11.CKA_CLASS, pkcs11.CKO_PUBLIC_KEY)))
+	if err != nil {
+		return fmt.Errorf("Failed to find PKCS#11 public key: %s", err)
+	}
+	s.publicKey, err = s.readPublicKey(pubKey)
+	return err
+}
+
+func (s *pkcs11Signer) Public() crypto.PublicKey {
+	return s.publicKey
+}
+
+func (s *pkcs11Signer) Sign(_ io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error) {
+	hash := opts.HashFunc()
+	if hash != 0 && len(digest) != hash.Size() {
+		return nil, fmt.Errorf("Invalid %v digest length: %d", hash, len(digest))
+	}
+	var mechanism *pkcs11.Mechanism
+	data := digest
+	switch s.publicKey.(type) {
+	case *rsa.PublicKey:
+		if pssOpts, ok := opts.(*rsa.PSSOptions); ok {
+			params, ok := pssParams[hash]
+			if !ok {
+				return nil, fmt.Errorf("Unsupported PSS hash function: %v", hash)
+			}
+			saltLength := pssOpts.SaltLength
+			if saltLength == rsa.PSSSaltLengthAuto || saltLength == rsa.PSSSaltLengthEqualsHash {
+				saltLength = hash.Size()
+			}
+			mechanism = pkcs11.NewMechanism(
+				pkcs11.CKM_RSA_PKCS_PSS, pkcs11.NewPSSParams(params[0], params[1], uint(saltLength)))
+		} else {
+			prefix, ok := digestInfoPrefixes[hash]
+			if !ok {
+				return nil, fmt.Errorf("Unsupported hash function: %v", hash)
+			}
+			mechanism = pkcs11.NewMechanism(pkcs11.CKM_RSA_PKCS, nil)
+			data = append(append([]byte{}, prefix...), digest...)
+		}
+	case *ecdsa.PublicKey:
+		mechanism = pkcs11.NewMechanism(pkcs11.CKM_ECDSA, nil)
+	default:
+		return nil, fmt.Errorf("Unsupported key type: %T", s.publicKey)
+	}
+
+	s.Lock()
+	defer s.Unlock()
+	if err := s.ctx.SignInit(s.session, []*pkcs11.Mechanism{mechanism}, s.key); err != nil {
+		return nil, fmt.Errorf("PKCS#11 sign init failed: %s", err)
+	}
+	sig, err := s.ctx.Sign(s.session, data)
+	if err != nil {
+		return nil, fmt.Errorf("PKCS#11 sign failed: %s", err)
+	}
+	if _, ok := s.publicKey.(*ecdsa.PublicKey); ok {
+		// CKM_ECDSA signatures are r || s
+		half := len(sig) / 2
+		return asn1.Marshal(struct{ R, S *big.Int }{
+			new(big.Int).SetBytes(sig[:half]), new(big.Int).SetBytes(sig[half:])})
+	}
+	return sig, nil
+}
+
+func (s *pkcs11Signer) findObject(template []*pkcs11.Attribute) (pkcs11.ObjectHandle, error) {
+	if err := s.ctx.FindObjectsInit(s.session, template); err != nil {
+		return 0, err
+	}
+	objects, _, err := s.ctx.FindObjects(s.session, 2)
+	s.ctx.FindObjectsFinal(s.session)
+	if err != nil {
+		return 0, err
+	}
+	switch len(objects) {
+	case 0:
+		return 0, fmt.Errorf("no matching object")
+	case 1:
+		return objects[0], nil
+	default:
+		return 0, fmt.Errorf("multiple matching objects")
+	}
+}
+
+func (s *pkcs11Signer) readPublicKey(pubKey pkcs11.ObjectHandle) (crypto.PublicKey, error) {
+	attrs, err := s.ctx.GetAttributeValue(s.session, pubKey, []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_KEY_TYPE, nil)})
+	if err != nil || len(attrs) != 1 {
+		return nil, fmt.Errorf("Failed to read PKCS#11 key type: %v", err)
+	}
+	keyType := new(big.Int).SetBytes(reverse(attrs[0].Value)).Uint64()
+	switch keyType {
+	case pkcs11.CKK_RSA:
+		attrs, err = s.ctx.GetAttributeValue(s.session, pubKey, []*pkcs11.Attribute{
+			pkcs11.NewAttribute(pkcs11.CKA_MODULUS, nil),
+			pkcs11.NewAttribute(pkcs11.CKA_PUBLIC_EXPONENT, nil)})
+		if err != nil || len(attrs) != 2 {
+			return nil, fmt.Errorf("Failed to read PKCS#11 RSA public key: %v", err)
+		}
+		return &rsa.PublicKey{
+			N: new(big.Int).SetBytes(attrs[0].Value),
+			E: int(new(big.Int).SetBytes(attrs[1].Value).Int64()),
+		}, nil
+	case pkcs11.CKK_EC:
+		attrs, err = s.ctx.GetAttributeValue(s.session, pubKey, []*pkcs11.Attribute{
+			pkcs11.NewAttribute(pkcs11.CKA_EC_PARAMS, nil),
+			pkcs11.NewAttribute(pkcs11.CKA_EC_POINT, nil)})
+		if err != nil || len(attrs) != 2 {
+			return nil, fmt.Errorf("Failed to read PKCS#11 EC public key: %v", err)
+		}
+		return parseECPublicKey(attrs[0].Value, attrs[1].Value)
+	default:
+		return nil, fmt.Errorf("Unsupported PKCS#11 key type: 0x%x", keyType)
+	}
+}
+
+func parseECPublicKey(params, point []byte) (*ecdsa.PublicKey, error) {
+	var curveOID asn1.ObjectIdentifier
+	if _, err := asn1.Unmarshal(params, &curveOID); err != nil {
+		return nil, fmt.Errorf("Failed to parse EC params: %s", err)
+	}
+	var curve elliptic.Curve
+	switch {
+	case curveOID.Equal(oidNamedCurveP256):
+		curve = elliptic.P256()
+	case curveOID.Equal(oidNamedCurveP384):
+		curve = elliptic.P384()
+	case curveOID.Equal(oidNamedCurveP521):
+		curve = elliptic.P521()
+	default:
+		return nil, fmt.Errorf("Unsupported EC curve: %s", curveOID)
+	}
+	// CKA_EC_POINT is a DER encoded OCTET STRING, some modules omit the encoding
+	var rawPoint []byte
+	if rest, err := asn1.Unmarshal(point, &rawPoint); err != nil || len(rest) > 0 {
+		rawPoint = point
+	}
+	x, y := elliptic.Unmarshal(curve, rawPoint)
+	if x == nil {
+		return nil, fmt.Errorf("Invalid EC point")
+	}
+	return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+}
+
+func findSlot(ctx *pkcs11.Ctx, tokenLabel, serial string) (uint, error) {
+	slots, err := ctx.GetSlotList(true)
+	if err != nil {
+		return 0, fmt.Errorf("Failed to list PKCS#11 slots: %s", err)
+	}
+	for _, slot := range slots {
+		info, err := ctx.GetTokenInfo(slot)
+		if err != nil {
+			continue
+		}
+		if len(tokenLabel) > 0 && strings.TrimSpace(info.Label) != tokenLabel {
+			continue
+		}
+		if len(serial) > 0 && strings.TrimSpace(info.SerialNumber) != serial {
+			continue
+		}
+		return slot, nil
+	}
+	return 0, fmt.Errorf("PKCS#11 token not found (token: %q, serial: %q)", tokenLabel, serial)
+}
+
+// parsePathAttributes parses the ';' separated, percent encoded path attributes
+// of a pkcs11: URI
+func parsePathAttributes(path string) (map[string]string, error) {
+	attrs := map[string]string{}
+	for _, attr := range strings.Split(path, ";") {
+		if len(attr) == 0 {
+			continue
+		}
+		kv := strings.SplitN(attr, "=", 2)
+		if len(kv) != 2 {
+			return nil, fmt.Errorf("Invalid PKCS#11 URI attribute: %s", attr)
+		}
+		value, err := url.PathUnescape(kv[1])
+		if err != nil {
+			return nil, fmt.Errorf("Invalid PKCS#11 URI attribute %s: %s", kv[0], err)
+		}
+		attrs[kv[0]] = value
+	}
+	return attrs, nil
+}
+
+func isError(err error, code uint) bool {
+	p11Err, ok := err.(pkcs11.Error)
+	return ok && uint(p11Err) == code
+}
+
+// reverse returns the bytes in reverse order, PKCS#11 CK_ULONG attributes are
+// in native (little endian on supported platforms) byte order
+func reverse(b []byte) []byte {
+	r := bytes.Repeat([]byte{0}, len(b))
+	for i := range b {
+		r[len(b)-1-i] = b[i]
+	}
+	return r
+}
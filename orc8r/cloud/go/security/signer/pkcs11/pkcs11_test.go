@@ -0,0 +1,84 @@
+// +build pkcs11
+
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package pkcs11
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/asn1"
+	"os"
+	"testing"
+
+	"magma/orc8r/cloud/go/security/signer"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePathAttributes(t *testing.T) {
+	attrs, err := parsePathAttributes("token=My%20Token;object=ca-key;id=%01%02")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"token": "My Token", "object": "ca-key", "id": "\x01\x02"}, attrs)
+
+	_, err = parsePathAttributes("token")
+	assert.Error(t, err)
+}
+
+func TestParseECPublicKey(t *testing.T) {
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	params, err := asn1.Marshal(oidNamedCurveP256)
+	assert.NoError(t, err)
+	rawPoint := elliptic.Marshal(elliptic.P256(), priv.X, priv.Y)
+	point, err := asn1.Marshal(rawPoint)
+	assert.NoError(t, err)
+
+	pub, err := parseECPublicKey(params, point)
+	assert.NoError(t, err)
+	assert.Equal(t, priv.PublicKey, *pub)
+	// not DER encoded point
+	pub, err = parseECPublicKey(params, rawPoint)
+	assert.NoError(t, err)
+	assert.Equal(t, priv.PublicKey, *pub)
+
+	params, err = asn1.Marshal(asn1.ObjectIdentifier{1, 2, 3})
+	assert.NoError(t, err)
+	_, err = parseECPublicKey(params, point)
+	assert.Error(t, err)
+}
+
+// TestSoftHSM signs with a key of a PKCS#11 token, e.g. SoftHSM, given by the
+// PKCS11_TEST_URI environment variable
+func TestSoftHSM(t *testing.T) {
+	uri := os.Getenv("PKCS11_TEST_URI")
+	if len(uri) == 0 {
+		t.Skip("PKCS11_TEST_URI not set")
+	}
+	s, err := signer.Open(uri)
+	assert.NoError(t, err)
+	digest := sha256.Sum256([]byte("data"))
+	sig, err := s.Sign(rand.Reader, digest[:], crypto.SHA256)
+	assert.NoError(t, err)
+	switch pub := s.Public().(type) {
+	case *rsa.PublicKey:
+		assert.NoError(t, rsa.VerifyPKCS1v15(pub, crypto.SHA256, digest[:], sig))
+		sig, err = s.Sign(rand.Reader, digest[:], &rsa.PSSOptions{Hash: crypto.SHA256})
+		assert.NoError(t, err)
+		assert.NoError(t, rsa.VerifyPSS(pub, crypto.SHA256, digest[:], sig, nil))
+	case *ecdsa.PublicKey:
+		assert.True(t, ecdsa.VerifyASN1(pub, digest[:], sig))
+	default:
+		t.Fatalf("Unexpected public key type: %T", pub)
+	}
+}
@@ -0,0 +1,388 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: signer.proto
+
+package protos
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type SignRequest_HashAlgorithm int32
+
+const (
+	SignRequest_NONE   SignRequest_HashAlgorithm = 0
+	SignRequest_SHA1   SignRequest_HashAlgorithm = 1
+	SignRequest_SHA256 SignRequest_HashAlgorithm = 2
+	SignRequest_SHA384 SignRequest_HashAlgorithm = 3
+	SignRequest_SHA512 SignRequest_HashAlgorithm = 4
+)
+
+var SignRequest_HashAlgorithm_name = map[int32]string{
+	0: "NONE",
+	1: "SHA1",
+	2: "SHA256",
+	3: "SHA384",
+	4: "SHA512",
+}
+var SignRequest_HashAlgorithm_value = map[string]int32{
+	"NONE":   0,
+	"SHA1":   1,
+	"SHA256": 2,
+	"SHA384": 3,
+	"SHA512": 4,
+}
+
+func (x SignRequest_HashAlgorithm) String() string {
+	return proto.EnumName(SignRequest_HashAlgorithm_name, int32(x))
+}
+func (SignRequest_HashAlgorithm) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_signer_af33a6fe010b69ee, []int{2, 0}
+}
+
+// KeyID identifies a private key held by the remote signing service
+type KeyID struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *KeyID) Reset()         { *m = KeyID{} }
+func (m *KeyID) String() string { return proto.CompactTextString(m) }
+func (*KeyID) ProtoMessage()    {}
+func (*KeyID) Descriptor() ([]byte, []int) {
+	return fileDescriptor_signer_af33a6fe010b69ee, []int{0}
+}
+func (m *KeyID) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_KeyID.Unmarshal(m, b)
+}
+func (m *KeyID) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_KeyID.Marshal(b, m, deterministic)
+}
+func (dst *KeyID) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeyID.Merge(dst, src)
+}
+func (m *KeyID) XXX_Size() int {
+	return xxx_messageInfo_KeyID.Size(m)
+}
+func (m *KeyID) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeyID.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KeyID proto.InternalMessageInfo
+
+func (m *KeyID) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+// PublicKey is the DER encoded PKIX public key of a remote private key
+type PublicKey struct {
+	Der                  []byte   `protobuf:"bytes,1,opt,name=der,proto3" json:"der,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PublicKey) Reset()         { *m = PublicKey{} }
+func (m *PublicKey) String() string { return proto.CompactTextString(m) }
+func (*PublicKey) ProtoMessage()    {}
+func (*PublicKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_signer_af33a6fe010b69ee, []int{1}
+}
+func (m *PublicKey) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PublicKey.Unmarshal(m, b)
+}
+func (m *PublicKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PublicKey.Marshal(b, m, deterministic)
+}
+func (dst *PublicKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PublicKey.Merge(dst, src)
+}
+func (m *PublicKey) XXX_Size() int {
+	return xxx_messageInfo_PublicKey.Size(m)
+}
+func (m *PublicKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_PublicKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PublicKey proto.InternalMessageInfo
+
+func (m *PublicKey) GetDer() []byte {
+	if m != nil {
+		return m.Der
+	}
+	return nil
+}
+
+type SignRequest struct {
+	KeyId string `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	// digest of the signed data, computed by the caller with hash
+	Digest []byte                    `protobuf:"bytes,2,opt,name=digest,proto3" json:"digest,omitempty"`
+	Hash   SignRequest_HashAlgorithm `protobuf:"varint,3,opt,name=hash,proto3,enum=magma.orc8r.signer.SignRequest_HashAlgorithm" json:"hash,omitempty"`
+	// use RSASSA-PSS instead of RSASSA-PKCS1-v1_5 for RSA keys
+	Pss bool `protobuf:"varint,4,opt,name=pss,proto3" json:"pss,omitempty"`
+	// PSS salt length, 0 for a salt as long as the digest
+	PssSaltLength        int32    `protobuf:"varint,5,opt,name=pss_salt_length,json=pssSaltLength,proto3" json:"pss_salt_length,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SignRequest) Reset()         { *m = SignRequest{} }
+func (m *SignRequest) String() string { return proto.CompactTextString(m) }
+func (*SignRequest) ProtoMessage()    {}
+func (*SignRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_signer_af33a6fe010b69ee, []int{2}
+}
+func (m *SignRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignRequest.Unmarshal(m, b)
+}
+func (m *SignRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignRequest.Marshal(b, m, deterministic)
+}
+func (dst *SignRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignRequest.Merge(dst, src)
+}
+func (m *SignRequest) XXX_Size() int {
+	return xxx_messageInfo_SignRequest.Size(m)
+}
+func (m *SignRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignRequest proto.InternalMessageInfo
+
+func (m *SignRequest) GetKeyId() string {
+	if m != nil {
+		return m.KeyId
+	}
+	return ""
+}
+
+func (m *SignRequest) GetDigest() []byte {
+	if m != nil {
+		return m.Digest
+	}
+	return nil
+}
+
+func (m *SignRequest) GetHash() SignRequest_HashAlgorithm {
+	if m != nil {
+		return m.Hash
+	}
+	return SignRequest_NONE
+}
+
+func (m *SignRequest) GetPss() bool {
+	if m != nil {
+		return m.Pss
+	}
+	return false
+}
+
+func (m *SignRequest) GetPssSaltLength() int32 {
+	if m != nil {
+		return m.PssSaltLength
+	}
+	return 0
+}
+
+// Signature is an ASN.1 DER encoded ECDSA signature or a raw RSA signature
+type Signature struct {
+	Signature            []byte   `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Signature) Reset()         { *m = Signature{} }
+func (m *Signature) String() string { return proto.CompactTextString(m) }
+func (*Signature) ProtoMessage()    {}
+func (*Signature) Descriptor() ([]byte, []int) {
+	return fileDescriptor_signer_af33a6fe010b69ee, []int{3}
+}
+func (m *Signature) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Signature.Unmarshal(m, b)
+}
+func (m *Signature) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Signature.Marshal(b, m, deterministic)
+}
+func (dst *Signature) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Signature.Merge(dst, src)
+}
+func (m *Signature) XXX_Size() int {
+	return xxx_messageInfo_Signature.Size(m)
+}
+func (m *Signature) XXX_DiscardUnknown() {
+	xxx_messageInfo_Signature.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Signature proto.InternalMessageInfo
+
+func (m *Signature) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*KeyID)(nil), "magma.orc8r.signer.KeyID")
+	proto.RegisterType((*PublicKey)(nil), "magma.orc8r.signer.PublicKey")
+	proto.RegisterType((*SignRequest)(nil), "magma.orc8r.signer.SignRequest")
+	proto.RegisterType((*Signature)(nil), "magma.orc8r.signer.Signature")
+	proto.RegisterEnum("magma.orc8r.signer.SignRequest_HashAlgorithm", SignRequest_HashAlgorithm_name, SignRequest_HashAlgorithm_value)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// RemoteSignerClient is the client API for RemoteSigner service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type RemoteSignerClient interface {
+	GetPublicKey(ctx context.Context, in *KeyID, opts ...grpc.CallOption) (*PublicKey, error)
+	Sign(ctx context.Context, in *SignRequest, opts ...grpc.CallOption) (*Signature, error)
+}
+
+type remoteSignerClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewRemoteSignerClient(cc *grpc.ClientConn) RemoteSignerClient {
+	return &remoteSignerClient{cc}
+}
+
+func (c *remoteSignerClient) GetPublicKey(ctx context.Context, in *KeyID, opts ...grpc.CallOption) (*PublicKey, error) {
+	out := new(PublicKey)
+	err := c.cc.Invoke(ctx, "/magma.orc8r.signer.RemoteSigner/GetPublicKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *remoteSignerClient) Sign(ctx context.Context, in *SignRequest, opts ...grpc.CallOption) (*Signature, error) {
+	out := new(Signature)
+	err := c.cc.Invoke(ctx, "/magma.orc8r.signer.RemoteSigner/Sign", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RemoteSignerServer is the server API for RemoteSigner service.
+type RemoteSignerServer interface {
+	GetPublicKey(context.Context, *KeyID) (*PublicKey, error)
+	Sign(context.Context, *SignRequest) (*Signature, error)
+}
+
+func RegisterRemoteSignerServer(s *grpc.Server, srv RemoteSignerServer) {
+	s.RegisterService(&_RemoteSigner_serviceDesc, srv)
+}
+
+func _RemoteSigner_GetPublicKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KeyID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RemoteSignerServer).GetPublicKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.orc8r.signer.RemoteSigner/GetPublicKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RemoteSignerServer).GetPublicKey(ctx, req.(*KeyID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RemoteSigner_Sign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RemoteSignerServer).Sign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.orc8r.signer.RemoteSigner/Sign",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RemoteSignerServer).Sign(ctx, req.(*SignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _RemoteSigner_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "magma.orc8r.signer.RemoteSigner",
+	HandlerType: (*RemoteSignerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetPublicKey",
+			Handler:    _RemoteSigner_GetPublicKey_Handler,
+		},
+		{
+			MethodName: "Sign",
+			Handler:    _RemoteSigner_Sign_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "signer.proto",
+}
+
+func init() { proto.RegisterFile("signer.proto", fileDescriptor_signer_af33a6fe010b69ee) }
+
+var fileDescriptor_signer_af33a6fe010b69ee = []byte{
+	// 363 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x52, 0x41, 0x6f, 0xa2, 0x40,
+	0x18, 0x15, 0x04, 0x22, 0xdf, 0xa2, 0x4b, 0x26, 0xd9, 0x5d, 0xd6, 0xac, 0x59, 0xc2, 0x61, 0xc3,
+	0x1e, 0x4a, 0x22, 0xd6, 0xc6, 0x2b, 0x4d, 0x1b, 0xb1, 0x36, 0xb6, 0x81, 0x5b, 0x2f, 0x06, 0x65,
+	0x02, 0x44, 0x10, 0xca, 0x8c, 0x07, 0xae, 0xfd, 0x2d, 0xfd, 0xa1, 0x0d, 0x23, 0xd5, 0x36, 0xb5,
+	0xe9, 0x89, 0xf7, 0x7d, 0x8f, 0xf7, 0xf2, 0xe6, 0xcd, 0x80, 0x42, 0x92, 0x68, 0x8b, 0x4b, 0xab,
+	0x28, 0x73, 0x9a, 0x23, 0x94, 0x05, 0x51, 0x16, 0x58, 0x79, 0xb9, 0x9e, 0x94, 0xd6, 0x9e, 0x31,
+	0x7e, 0x81, 0x38, 0xc7, 0xd5, 0xec, 0x0a, 0xf5, 0x80, 0x4f, 0x42, 0x8d, 0xd3, 0x39, 0x53, 0xf6,
+	0xf8, 0x24, 0x34, 0x06, 0x20, 0xdf, 0xef, 0x56, 0x69, 0xb2, 0x9e, 0xe3, 0x0a, 0xa9, 0xd0, 0x0e,
+	0x71, 0xc9, 0x58, 0xc5, 0xab, 0xa1, 0xf1, 0xc4, 0xc3, 0x37, 0x3f, 0x89, 0xb6, 0x1e, 0x7e, 0xdc,
+	0x61, 0x42, 0xd1, 0x0f, 0x90, 0x36, 0xb8, 0x5a, 0x1e, 0x2c, 0xc4, 0x0d, 0xae, 0x66, 0x21, 0xfa,
+	0x09, 0x52, 0x98, 0x44, 0x98, 0x50, 0x8d, 0x67, 0xda, 0x66, 0x42, 0x0e, 0x08, 0x71, 0x40, 0x62,
+	0xad, 0xad, 0x73, 0x66, 0xcf, 0x3e, 0xb3, 0x3e, 0x26, 0xb3, 0xde, 0xb8, 0x5b, 0x6e, 0x40, 0x62,
+	0x27, 0x8d, 0xf2, 0x32, 0xa1, 0x71, 0xe6, 0x31, 0x69, 0x9d, 0xa9, 0x20, 0x44, 0x13, 0x74, 0xce,
+	0xec, 0x78, 0x35, 0x44, 0xff, 0xe0, 0x7b, 0x41, 0xc8, 0x92, 0x04, 0x29, 0x5d, 0xa6, 0x78, 0x1b,
+	0xd1, 0x58, 0x13, 0x75, 0xce, 0x14, 0xbd, 0x6e, 0x41, 0x88, 0x1f, 0xa4, 0xf4, 0x96, 0x2d, 0x8d,
+	0x29, 0x74, 0xdf, 0x19, 0xa2, 0x0e, 0x08, 0x8b, 0xbb, 0xc5, 0xb5, 0xda, 0xaa, 0x91, 0xef, 0x3a,
+	0x43, 0x95, 0x43, 0x00, 0x92, 0xef, 0x3a, 0xf6, 0xf8, 0x42, 0xe5, 0x1b, 0x3c, 0x9a, 0x9c, 0xab,
+	0xed, 0x06, 0x8f, 0x87, 0xb6, 0x2a, 0x18, 0xff, 0x41, 0xae, 0x53, 0x06, 0x74, 0x57, 0x62, 0xf4,
+	0x07, 0x64, 0xf2, 0x3a, 0x34, 0x4d, 0x1d, 0x17, 0xf6, 0x33, 0x07, 0x8a, 0x87, 0xb3, 0x9c, 0x62,
+	0x9f, 0x1d, 0x0f, 0xdd, 0x80, 0x32, 0xc5, 0xf4, 0x58, 0xf1, 0xef, 0x53, 0x1d, 0xb0, 0xab, 0xe9,
+	0x0f, 0x4e, 0x51, 0x07, 0xa5, 0xd1, 0x42, 0x2e, 0x08, 0xb5, 0x2b, 0xfa, 0xfb, 0x45, 0x8f, 0xfd,
+	0xc1, 0x67, 0x3f, 0xb0, 0x90, 0x46, 0xeb, 0xb2, 0xf3, 0x20, 0xb1, 0xb7, 0x42, 0x56, 0xfb, 0xef,
+	0xe8, 0x65, 0x00, 0xa4, 0x82, 0x31, 0xbe, 0x43, 0x02, 0x00, 0x00,
+}
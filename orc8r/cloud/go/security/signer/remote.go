@@ -0,0 +1,148 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package signer
+
+import (
+	"crypto"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"strings"
+	"time"
+
+	"magma/orc8r/cloud/go/security/signer/protos"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// RemoteTimeout is the timeout of remote signing service RPCs
+var RemoteTimeout = 10 * time.Second
+
+var hashAlgorithms = map[crypto.Hash]protos.SignRequest_HashAlgorithm{
+	0:             protos.SignRequest_NONE,
+	crypto.SHA1:   protos.SignRequest_SHA1,
+	crypto.SHA256: protos.SignRequest_SHA256,
+	crypto.SHA384: protos.SignRequest_SHA384,
+	crypto.SHA512: protos.SignRequest_SHA512,
+}
+
+// remoteSigner is a crypto.Signer signing with a key of a remote signing service
+type remoteSigner struct {
+	client    protos.RemoteSignerClient
+	keyID     string
+	publicKey crypto.PublicKey
+}
+
+// NewRemoteSigner returns a signer using the key keyID of the remote signing
+// service of the connection
+func NewRemoteSigner(conn *grpc.ClientConn, keyID string) (crypto.Signer, error) {
+	client := protos.NewRemoteSignerClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), RemoteTimeout)
+	defer cancel()
+	publicKey, err := client.GetPublicKey(ctx, &protos.KeyID{Id: keyID})
+	if err != nil {
+		return nil, fmt.Errorf("Failed to get public key of remote key %s: %s", keyID, err)
+	}
+	pub, err := x509.ParsePKIXPublicKey(publicKey.Der)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to parse public key of remote key %s: %s", keyID, err)
+	}
+	return &remoteSigner{client: client, keyID: keyID, publicKey: pub}, nil
+}
+
+func (s *remoteSigner) Public() crypto.PublicKey {
+	return s.publicKey
+}
+
+func (s *remoteSigner) Sign(_ io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error) {
+	hash, ok := hashAlgorithms[opts.HashFunc()]
+	if !ok {
+		return nil, fmt.Errorf("Unsupported hash function: %v", opts.HashFunc())
+	}
+	req := &protos.SignRequest{KeyId: s.keyID, Digest: digest, Hash: hash}
+	if pssOpts, ok := opts.(*rsa.PSSOptions); ok {
+		req.Pss = true
+		req.PssSaltLength = int32(pssOpts.SaltLength)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), RemoteTimeout)
+	defer cancel()
+	sig, err := s.client.Sign(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("Remote signing with key %s failed: %s", s.keyID, err)
+	}
+	return sig.Signature, nil
+}
+
+// openRemote opens a remote signer of URI
+// remote://host:port/key-id?ca=...&cert=...&key=... The connection uses mutual
+// TLS, ca is the file of the CA certs trusted to issue the signing service's
+// certificate, cert & key are the files of the client certificate.
+func openRemote(uri *url.URL) (crypto.Signer, error) {
+	keyID := strings.TrimPrefix(uri.Path, "/")
+	if len(uri.Host) == 0 || len(keyID) == 0 {
+		return nil, fmt.Errorf("Invalid remote signer URI, expected remote://host:port/key-id")
+	}
+	opts, err := getRemoteDialOptions(uri.Query())
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), RemoteTimeout)
+	defer cancel()
+	conn, err := grpc.DialContext(ctx, uri.Host, append(opts, grpc.WithBlock())...)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to connect to remote signer %s: %s", uri.Host, err)
+	}
+	signer, err := NewRemoteSigner(conn, keyID)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return signer, nil
+}
+
+func getRemoteDialOptions(query url.Values) ([]grpc.DialOption, error) {
+	caFile, certFile, keyFile := query.Get("ca"), query.Get("cert"), query.Get("key")
+	if len(caFile) == 0 || len(certFile) == 0 || len(keyFile) == 0 {
+		return nil, fmt.Errorf("Remote signer URI requires ca, cert & key query parameters")
+	}
+	roots, clientCert, err := loadTLSFiles(caFile, certFile, keyFile)
+	if err != nil {
+		return nil, err
+	}
+	tlsConfig := &tls.Config{
+		RootCAs:      roots,
+		Certificates: []tls.Certificate{clientCert},
+		MinVersion:   tls.VersionTLS12,
+	}
+	return []grpc.DialOption{grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig))}, nil
+}
+
+// loadTLSFiles loads the trusted CA certs & the certificate of one end of
+// the remote signing service connection
+func loadTLSFiles(caFile, certFile, keyFile string) (*x509.CertPool, tls.Certificate, error) {
+	caPEM, err := ioutil.ReadFile(caFile)
+	if err != nil {
+		return nil, tls.Certificate{}, fmt.Errorf("Failed to read remote signer CA file: %s", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caPEM) {
+		return nil, tls.Certificate{}, fmt.Errorf("No certificates found in remote signer CA file %s", caFile)
+	}
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, tls.Certificate{}, fmt.Errorf("Failed to load remote signer certificate: %s", err)
+	}
+	return pool, cert, nil
+}
@@ -0,0 +1,118 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package signer
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+
+	"magma/orc8r/cloud/go/security/signer/protos"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// RemoteSignerServer is a remote signing service signing with the given signers,
+// which may themselves be backed by an HSM. It only serves clients
+// authenticated by a TLS client certificate, see NewServerCredentials.
+type RemoteSignerServer struct {
+	signers map[string]crypto.Signer
+}
+
+// NewRemoteSignerServer returns a remote signing service of the signers keyed by key ID
+func NewRemoteSignerServer(signers map[string]crypto.Signer) *RemoteSignerServer {
+	return &RemoteSignerServer{signers: signers}
+}
+
+// NewServerCredentials returns the transport credentials of a remote signing
+// service with the certificate of certFile & keyFile, requiring client
+// certificates issued by the CA certs of caFile
+func NewServerCredentials(caFile, certFile, keyFile string) (credentials.TransportCredentials, error) {
+	clientCAs, cert, err := loadTLSFiles(caFile, certFile, keyFile)
+	if err != nil {
+		return nil, err
+	}
+	return credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientCAs:    clientCAs,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		MinVersion:   tls.VersionTLS12,
+	}), nil
+}
+
+func (srv *RemoteSignerServer) GetPublicKey(ctx context.Context, keyID *protos.KeyID) (*protos.PublicKey, error) {
+	if err := authenticate(ctx); err != nil {
+		return nil, err
+	}
+	signer, err := srv.getSigner(keyID.GetId())
+	if err != nil {
+		return nil, err
+	}
+	der, err := x509.MarshalPKIXPublicKey(signer.Public())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to marshal public key of key %s: %s", keyID.GetId(), err)
+	}
+	return &protos.PublicKey{Der: der}, nil
+}
+
+func (srv *RemoteSignerServer) Sign(ctx context.Context, req *protos.SignRequest) (*protos.Signature, error) {
+	if err := authenticate(ctx); err != nil {
+		return nil, err
+	}
+	signer, err := srv.getSigner(req.GetKeyId())
+	if err != nil {
+		return nil, err
+	}
+	var hash crypto.Hash
+	for h, alg := range hashAlgorithms {
+		if alg == req.Hash {
+			hash = h
+		}
+	}
+	if hash != 0 && len(req.Digest) != hash.Size() {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid %v digest length: %d", hash, len(req.Digest))
+	}
+	var opts crypto.SignerOpts = hash
+	if req.Pss {
+		opts = &rsa.PSSOptions{SaltLength: int(req.PssSaltLength), Hash: hash}
+	}
+	sig, err := signer.Sign(rand.Reader, req.Digest, opts)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to sign with key %s: %s", req.GetKeyId(), err)
+	}
+	return &protos.Signature{Signature: sig}, nil
+}
+
+func (srv *RemoteSignerServer) getSigner(keyID string) (crypto.Signer, error) {
+	signer, ok := srv.signers[keyID]
+	if !ok || signer == nil {
+		return nil, status.Errorf(codes.NotFound, "Key %s not found", keyID)
+	}
+	return signer, nil
+}
+
+// authenticate verifies that the caller presented a verified TLS client
+// certificate, so the service never signs for clients of an insecure server
+func authenticate(ctx context.Context) error {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return status.Errorf(codes.Unauthenticated, "Missing peer info")
+	}
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.VerifiedChains) == 0 {
+		return status.Errorf(codes.Unauthenticated, "Missing verified client certificate")
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+// Package signer provides crypto.Signer implementations for private keys which
+// may be kept outside of the controller's filesystem. Signers are opened by
+// URI, the URI scheme selects the signer backend:
+//
+//	/path/to/key.pem or file:///path/to/key.pem - PEM encoded key file (default)
+//	remote://host:port/key-id?ca=...&cert=...&key=...
+//	                                            - remote signing service (mutual TLS)
+//	pkcs11:token=...;object=...?module-path=... - PKCS#11 module (RFC 7512),
+//	                                              requires the pkcs11 build tag
+package signer
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/x509"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+
+	"magma/orc8r/cloud/go/security/key"
+)
+
+// Factory opens the signer of the given URI
+type Factory func(uri *url.URL) (crypto.Signer, error)
+
+var (
+	factoriesMu sync.RWMutex
+	factories   = map[string]Factory{}
+)
+
+func init() {
+	RegisterFactory("file", openFile)
+	RegisterFactory("remote", openRemote)
+}
+
+// RegisterFactory registers the signer factory of the given URI scheme,
+// replacing any factory previously registered for the scheme
+func RegisterFactory(scheme string, factory Factory) {
+	factoriesMu.Lock()
+	defer factoriesMu.Unlock()
+	factories[strings.ToLower(scheme)] = factory
+}
+
+// Open returns the signer of the given URI. URIs without a scheme are paths
+// of PEM encoded private key files.
+func Open(uri string) (crypto.Signer, error) {
+	if len(uri) == 0 {
+		return nil, fmt.Errorf("Empty signer URI")
+	}
+	parsed, err := url.Parse(uri)
+	if err != nil || len(parsed.Scheme) <= 1 {
+		// not a URI or a Windows drive letter, treat as a file path
+		parsed = &url.URL{Scheme: "file", Path: uri}
+	}
+	factoriesMu.RLock()
+	factory, ok := factories[strings.ToLower(parsed.Scheme)]
+	factoriesMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("Unsupported signer URI scheme: %s", parsed.Scheme)
+	}
+	return factory(parsed)
+}
+
+// VerifyCertificateKey verifies that the signer holds the private key of the
+// certificate's public key
+func VerifyCertificateKey(cert *x509.Certificate, signer crypto.Signer) error {
+	if cert == nil || signer == nil {
+		return fmt.Errorf("Missing certificate or signer")
+	}
+	publicKeyDER, err := x509.MarshalPKIXPublicKey(signer.Public())
+	if err != nil {
+		return fmt.Errorf("Failed to marshal signer's public key: %s", err)
+	}
+	if !bytes.Equal(publicKeyDER, cert.RawSubjectPublicKeyInfo) {
+		return fmt.Errorf("Signer's public key does not match certificate %s", cert.Subject.CommonName)
+	}
+	return nil
+}
+
+func openFile(uri *url.URL) (crypto.Signer, error) {
+	path := uri.Path
+	if len(path) == 0 {
+		path = uri.Opaque
+	}
+	priv, err := key.ReadKey(path)
+	if err != nil {
+		return nil, err
+	}
+	signer, ok := priv.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("Key in %s cannot sign", path)
+	}
+	return signer, nil
+}
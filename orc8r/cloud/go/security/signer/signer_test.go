@@ -0,0 +1,178 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package signer_test
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"magma/orc8r/cloud/go/security/key"
+	"magma/orc8r/cloud/go/security/signer"
+	"magma/orc8r/cloud/go/security/signer/protos"
+	"magma/orc8r/cloud/go/security/signer/test_utils"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestOpenFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "signer_test")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	rsaKey, err := key.GenerateKey("", 2048)
+	assert.NoError(t, err)
+	rsaKeyFile := filepath.Join(dir, "rsa.key.pem")
+	assert.NoError(t, key.WriteKey(rsaKeyFile, rsaKey))
+
+	s, err := signer.Open(rsaKeyFile)
+	assert.NoError(t, err)
+	assert.Equal(t, key.PublicKey(rsaKey), s.Public())
+	assertSigns(t, s)
+
+	// PKCS#8 EC key preceded by EC PARAMETERS
+	ecKey, err := key.GenerateKey("P256", 0)
+	assert.NoError(t, err)
+	pkcs8, err := x509.MarshalPKCS8PrivateKey(ecKey)
+	assert.NoError(t, err)
+	ecKeyFile := filepath.Join(dir, "ec.key.pem")
+	ecPEM := append(
+		pem.EncodeToMemory(&pem.Block{Type: "EC PARAMETERS", Bytes: []byte{6, 8, 42, 134, 72, 206, 61, 3, 1, 7}}),
+		pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: pkcs8})...)
+	assert.NoError(t, ioutil.WriteFile(ecKeyFile, ecPEM, 0600))
+
+	s, err = signer.Open("file://" + ecKeyFile)
+	assert.NoError(t, err)
+	assert.Equal(t, key.PublicKey(ecKey), s.Public())
+	assertSigns(t, s)
+
+	_, err = signer.Open(filepath.Join(dir, "missing.pem"))
+	assert.Error(t, err)
+	_, err = signer.Open("")
+	assert.EqualError(t, err, "Empty signer URI")
+	_, err = signer.Open("unknown://key")
+	assert.EqualError(t, err, "Unsupported signer URI scheme: unknown")
+}
+
+func TestRemoteSigner(t *testing.T) {
+	rsaKey, err := key.GenerateKey("", 2048)
+	assert.NoError(t, err)
+	ecKey, err := key.GenerateKey("P384", 0)
+	assert.NoError(t, err)
+	addr, files, stop := test_utils.StartRemoteSigner(t, map[string]crypto.Signer{
+		"rsa": rsaKey.(crypto.Signer),
+		"ec":  ecKey.(crypto.Signer),
+	})
+	defer stop()
+
+	s, err := signer.Open(files.URI(addr, "rsa"))
+	assert.NoError(t, err)
+	assert.Equal(t, key.PublicKey(rsaKey), s.Public())
+	assertSigns(t, s)
+
+	s, err = signer.Open(files.URI(addr, "ec"))
+	assert.NoError(t, err)
+	assert.Equal(t, key.PublicKey(ecKey), s.Public())
+	assertSigns(t, s)
+
+	// wrong digest length
+	_, err = s.Sign(rand.Reader, []byte("digest"), crypto.SHA256)
+	assert.Error(t, err)
+
+	_, err = signer.Open(files.URI(addr, "unknown"))
+	assert.Error(t, err)
+	_, err = signer.Open(files.URI(addr, ""))
+	assert.Error(t, err)
+
+	// mutual TLS is required
+	_, err = signer.Open("remote://" + addr + "/ec")
+	assert.EqualError(t, err, "Remote signer URI requires ca, cert & key query parameters")
+	_, err = signer.Open(fmt.Sprintf("remote://%s/ec?ca=%s&cert=%s&key=%s", addr, files.CACert, files.ServerCert, files.ClientKey))
+	assert.Error(t, err)
+
+	// clients with an untrusted certificate are rejected
+	other := test_utils.CreateTLSFiles(t)
+	defer os.RemoveAll(other.Dir)
+	defer func(timeout time.Duration) { signer.RemoteTimeout = timeout }(signer.RemoteTimeout)
+	signer.RemoteTimeout = time.Second
+	_, err = signer.Open(fmt.Sprintf(
+		"remote://%s/ec?ca=%s&cert=%s&key=%s", addr, files.CACert, other.ClientCert, other.ClientKey))
+	assert.Error(t, err)
+}
+
+func TestRemoteSignerServerAuthentication(t *testing.T) {
+	ecKey, err := key.GenerateKey("P256", 0)
+	assert.NoError(t, err)
+	srv := signer.NewRemoteSignerServer(map[string]crypto.Signer{"ec": ecKey.(crypto.Signer)})
+
+	// callers without a verified client certificate, e.g. of an insecure server
+	_, err = srv.GetPublicKey(context.Background(), &protos.KeyID{Id: "ec"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	digest := sha256.Sum256([]byte("data"))
+	_, err = srv.Sign(context.Background(), &protos.SignRequest{KeyId: "ec", Digest: digest[:], Hash: protos.SignRequest_SHA256})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestVerifyCertificateKey(t *testing.T) {
+	caKey, err := key.GenerateKey("P256", 0)
+	assert.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "test CA"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, template, template, key.PublicKey(caKey), caKey)
+	assert.NoError(t, err)
+	caCert, err := x509.ParseCertificate(certDER)
+	assert.NoError(t, err)
+
+	assert.NoError(t, signer.VerifyCertificateKey(caCert, caKey.(crypto.Signer)))
+	otherKey, err := key.GenerateKey("P256", 0)
+	assert.NoError(t, err)
+	assert.EqualError(t,
+		signer.VerifyCertificateKey(caCert, otherKey.(crypto.Signer)),
+		"Signer's public key does not match certificate test CA")
+}
+
+func assertSigns(t *testing.T, s crypto.Signer) {
+	digest := sha256.Sum256([]byte("data"))
+	sig, err := s.Sign(rand.Reader, digest[:], crypto.SHA256)
+	assert.NoError(t, err)
+	switch pub := s.Public().(type) {
+	case *rsa.PublicKey:
+		assert.NoError(t, rsa.VerifyPKCS1v15(pub, crypto.SHA256, digest[:], sig))
+		sig, err = s.Sign(rand.Reader, digest[:], &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash, Hash: crypto.SHA256})
+		assert.NoError(t, err)
+		assert.NoError(t, rsa.VerifyPSS(pub, crypto.SHA256, digest[:], sig, nil))
+	case *ecdsa.PublicKey:
+		var ecdsaSig struct{ R, S *big.Int }
+		_, err = asn1.Unmarshal(sig, &ecdsaSig)
+		assert.NoError(t, err)
+		assert.True(t, ecdsa.Verify(pub, digest[:], ecdsaSig.R, ecdsaSig.S))
+	default:
+		t.Fatalf("Unexpected public key type: %T", pub)
+	}
+}
@@ -0,0 +1,134 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package test_utils
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"magma/orc8r/cloud/go/security/key"
+	"magma/orc8r/cloud/go/security/signer"
+	"magma/orc8r/cloud/go/security/signer/protos"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+// TLSFiles are the PEM files of a test CA and of a server & a client
+// certificate issued by it
+type TLSFiles struct {
+	Dir        string
+	CACert     string
+	ServerCert string
+	ServerKey  string
+	ClientCert string
+	ClientKey  string
+}
+
+// CreateTLSFiles creates the test CA, server & client certificate files in a
+// temporary directory, which the caller must remove
+func CreateTLSFiles(t *testing.T) *TLSFiles {
+	dir, err := ioutil.TempDir("", "remote_signer")
+	assert.NoError(t, err)
+	files := &TLSFiles{
+		Dir:        dir,
+		CACert:     filepath.Join(dir, "ca.pem"),
+		ServerCert: filepath.Join(dir, "server.pem"),
+		ServerKey:  filepath.Join(dir, "server.key.pem"),
+		ClientCert: filepath.Join(dir, "client.pem"),
+		ClientKey:  filepath.Join(dir, "client.key.pem"),
+	}
+
+	caKey, err := key.GenerateKey("P256", 0)
+	assert.NoError(t, err)
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test signer CA"},
+		NotBefore:             time.Now().Add(-time.Minute),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, key.PublicKey(caKey), caKey)
+	assert.NoError(t, err)
+	writeCert(t, files.CACert, caDER)
+	caCert, err := x509.ParseCertificate(caDER)
+	assert.NoError(t, err)
+
+	createLeaf(t, caCert, caKey, 2, x509.ExtKeyUsageServerAuth, files.ServerCert, files.ServerKey)
+	createLeaf(t, caCert, caKey, 3, x509.ExtKeyUsageClientAuth, files.ClientCert, files.ClientKey)
+	return files
+}
+
+// URI returns the remote signer URI of the key keyID of the signing service
+// listening on addr, authenticated with the client certificate
+func (files *TLSFiles) URI(addr, keyID string) string {
+	return fmt.Sprintf(
+		"remote://%s/%s?ca=%s&cert=%s&key=%s", addr, keyID, files.CACert, files.ClientCert, files.ClientKey)
+}
+
+// StartRemoteSigner starts a mutual TLS remote signing service of the signers
+// on a local port. It returns the address of the service, its certificate
+// files and a function stopping the service & removing the files.
+func StartRemoteSigner(t *testing.T, signers map[string]crypto.Signer) (string, *TLSFiles, func()) {
+	files := CreateTLSFiles(t)
+	creds, err := signer.NewServerCredentials(files.CACert, files.ServerCert, files.ServerKey)
+	assert.NoError(t, err)
+	lis, err := net.Listen("tcp", "localhost:0")
+	assert.NoError(t, err)
+	srv := grpc.NewServer(grpc.Creds(creds))
+	protos.RegisterRemoteSignerServer(srv, signer.NewRemoteSignerServer(signers))
+	go srv.Serve(lis)
+	return lis.Addr().String(), files, func() {
+		srv.Stop()
+		os.RemoveAll(files.Dir)
+	}
+}
+
+func createLeaf(
+	t *testing.T,
+	caCert *x509.Certificate,
+	caKey interface{},
+	serial int64,
+	usage x509.ExtKeyUsage,
+	certFile, keyFile string,
+) {
+	priv, err := key.GenerateKey("P256", 0)
+	assert.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: "localhost"},
+		DNSNames:     []string{"localhost"},
+		IPAddresses:  []net.IP{net.IPv4(127, 0, 0, 1), net.IPv6loopback},
+		NotBefore:    time.Now().Add(-time.Minute),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, caCert, key.PublicKey(priv), caKey)
+	assert.NoError(t, err)
+	writeCert(t, certFile, der)
+	assert.NoError(t, key.WriteKey(keyFile, priv))
+}
+
+func writeCert(t *testing.T, certFile string, der []byte) {
+	assert.NoError(t, ioutil.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+}
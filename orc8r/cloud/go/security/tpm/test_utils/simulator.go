@@ -0,0 +1,148 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+// Package test_utils provides a software TPM simulator signing quotes with an
+// enrolled attestation key for TPM attestation tests
+package test_utils
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"fmt"
+	"math/big"
+	"time"
+
+	"magma/orc8r/cloud/go/security/key"
+	"magma/orc8r/cloud/go/security/tpm"
+)
+
+// Simulator is a software TPM with an attestation key (AK) certified by an
+// intermediate (EK issuer) CA chaining to a TPM root CA
+type Simulator struct {
+	Root         *x509.Certificate
+	Intermediate *x509.Certificate
+	AKCert       *x509.Certificate
+	ak           crypto.Signer
+}
+
+// NewSimulator creates a simulator with a new root, intermediate and AK of the
+// given key type ("" for RSA 2048 or "P256" for ECDSA)
+func NewSimulator(akKeyType string) (*Simulator, error) {
+	rootKey, err := key.GenerateKey("P256", 0)
+	if err != nil {
+		return nil, err
+	}
+	root, err := createCert("Test TPM Root CA", true, key.PublicKey(rootKey), nil, rootKey)
+	if err != nil {
+		return nil, err
+	}
+	intermediateKey, err := key.GenerateKey("P256", 0)
+	if err != nil {
+		return nil, err
+	}
+	intermediate, err := createCert("Test TPM EK CA", true, key.PublicKey(intermediateKey), root, rootKey)
+	if err != nil {
+		return nil, err
+	}
+	bits := 0
+	if len(akKeyType) == 0 {
+		bits = 2048
+	}
+	ak, err := key.GenerateKey(akKeyType, bits)
+	if err != nil {
+		return nil, err
+	}
+	akCert, err := createCert("Test TPM AK", false, key.PublicKey(ak), intermediate, intermediateKey)
+	if err != nil {
+		return nil, err
+	}
+	return &Simulator{Root: root, Intermediate: intermediate, AKCert: akCert, ak: ak.(crypto.Signer)}, nil
+}
+
+// Roots returns a pool with the simulator's root CA
+func (sim *Simulator) Roots() *x509.CertPool {
+	roots := x509.NewCertPool()
+	roots.AddCert(sim.Root)
+	return roots
+}
+
+// Quote returns a marshaled TPMS_ATTEST quote of the nonce & its TPMT_SIGNATURE by the AK
+func (sim *Simulator) Quote(nonce []byte) ([]byte, []byte, error) {
+	return sim.Sign(&tpm.Attest{
+		Magic:           tpm.GeneratedValue,
+		Type:            tpm.AttestQuote,
+		QualifiedSigner: []byte("test AK name"),
+		ExtraData:       nonce,
+		ClockInfo:       tpm.ClockInfo{Clock: uint64(time.Now().Unix()), Safe: true},
+		FirmwareVersion: 1,
+		// TPML_PCR_SELECTION of sha256:0 & an empty PCR digest
+		Attested: []byte{0, 0, 0, 1, 0, 0x0b, 3, 1, 0, 0, 0, 0},
+	})
+}
+
+// Sign marshals the given attestation structure & signs it with the AK
+func (sim *Simulator) Sign(attest *tpm.Attest) ([]byte, []byte, error) {
+	attestBytes, err := attest.Encode()
+	if err != nil {
+		return nil, nil, err
+	}
+	hashed := sha256.Sum256(attestBytes)
+	sig := &tpm.Signature{Hash: tpm.AlgSHA256}
+	switch ak := sim.ak.(type) {
+	case *rsa.PrivateKey:
+		sig.Alg = tpm.AlgRSASSA
+		sig.RSA, err = rsa.SignPKCS1v15(rand.Reader, ak, crypto.SHA256, hashed[:])
+	case *ecdsa.PrivateKey:
+		sig.Alg = tpm.AlgECDSA
+		sig.R, sig.S, err = ecdsa.Sign(rand.Reader, ak, hashed[:])
+	default:
+		err = fmt.Errorf("Unsupported AK type: %T", ak)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	sigBytes, err := sig.Encode()
+	if err != nil {
+		return nil, nil, err
+	}
+	return attestBytes, sigBytes, nil
+}
+
+func createCert(
+	cn string, isCA bool, pub interface{}, parent *x509.Certificate, parentKey interface{}) (*x509.Certificate, error) {
+
+	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, err
+	}
+	template := &x509.Certificate{
+		SerialNumber:          serialNumber,
+		Subject:               pkix.Name{Organization: []string{"FB TEST TPM"}, CommonName: cn},
+		NotBefore:             time.Now().Add(-time.Hour).UTC(),
+		NotAfter:              time.Now().Add(time.Hour * 24).UTC(),
+		IsCA:                  isCA,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageDigitalSignature,
+	}
+	if isCA {
+		template.KeyUsage |= x509.KeyUsageCertSign
+	}
+	if parent == nil {
+		parent = template
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, pub, parentKey)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to create certificate: %s", err)
+	}
+	return x509.ParseCertificate(der)
+}
@@ -0,0 +1,298 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+// Package tpm implements verification of TPM 2.0 attestation key (AK) signed
+// quotes. Only the subset of the TPM 2.0 structures needed to verify a quote
+// signed by an AK is supported (see TPM 2.0 Library, Part 2: Structures).
+package tpm
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math/big"
+	"time"
+)
+
+const (
+	// GeneratedValue (TPM_GENERATED_VALUE) prefixes all TPM generated attestation
+	// structures. Restricted signing keys (AKs) refuse to sign external data
+	// starting with this value, so a signed TPMS_ATTEST can only originate from the TPM.
+	GeneratedValue uint32 = 0xff544347
+
+	// TPM_ST attestation structure tags
+	AttestCertify uint16 = 0x8017
+	AttestQuote   uint16 = 0x8018
+
+	// TPM_ALG algorithm IDs
+	AlgSHA256 uint16 = 0x000B
+	AlgRSASSA uint16 = 0x0014
+	AlgRSAPSS uint16 = 0x0016
+	AlgECDSA  uint16 = 0x0018
+)
+
+// ClockInfo is a TPMS_CLOCK_INFO structure
+type ClockInfo struct {
+	Clock        uint64
+	ResetCount   uint32
+	RestartCount uint32
+	Safe         bool
+}
+
+// Attest is a TPMS_ATTEST structure, the type specific attested information is
+// kept in its marshaled form
+type Attest struct {
+	Magic           uint32
+	Type            uint16
+	QualifiedSigner []byte
+	ExtraData       []byte
+	ClockInfo       ClockInfo
+	FirmwareVersion uint64
+	Attested        []byte
+}
+
+// Signature is a TPMT_SIGNATURE structure of an RSA or ECDSA signature scheme
+type Signature struct {
+	Alg  uint16
+	Hash uint16
+	RSA  []byte   // RSASSA & RSAPSS signature
+	R, S *big.Int // ECDSA signature
+}
+
+// DecodeAttest unmarshals a TPMS_ATTEST structure
+func DecodeAttest(b []byte) (*Attest, error) {
+	r := bytes.NewReader(b)
+	attest := &Attest{}
+	var safe uint8
+	err := readAll(r,
+		&attest.Magic,
+		&attest.Type,
+		&attest.QualifiedSigner,
+		&attest.ExtraData,
+		&attest.ClockInfo.Clock,
+		&attest.ClockInfo.ResetCount,
+		&attest.ClockInfo.RestartCount,
+		&safe,
+		&attest.FirmwareVersion)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to decode TPMS_ATTEST: %s", err)
+	}
+	attest.ClockInfo.Safe = safe != 0
+	attest.Attested = b[len(b)-r.Len():]
+	return attest, nil
+}
+
+// Encode marshals the attestation structure
+func (attest *Attest) Encode() ([]byte, error) {
+	var safe uint8
+	if attest.ClockInfo.Safe {
+		safe = 1
+	}
+	buf := &bytes.Buffer{}
+	err := writeAll(buf,
+		attest.Magic,
+		attest.Type,
+		attest.QualifiedSigner,
+		attest.ExtraData,
+		attest.ClockInfo.Clock,
+		attest.ClockInfo.ResetCount,
+		attest.ClockInfo.RestartCount,
+		safe,
+		attest.FirmwareVersion)
+	if err != nil {
+		return nil, err
+	}
+	buf.Write(attest.Attested)
+	return buf.Bytes(), nil
+}
+
+// DecodeSignature unmarshals a TPMT_SIGNATURE structure
+func DecodeSignature(b []byte) (*Signature, error) {
+	r := bytes.NewReader(b)
+	sig := &Signature{}
+	if err := readAll(r, &sig.Alg, &sig.Hash); err != nil {
+		return nil, fmt.Errorf("Failed to decode TPMT_SIGNATURE: %s", err)
+	}
+	switch sig.Alg {
+	case AlgRSASSA, AlgRSAPSS:
+		if err := readAll(r, &sig.RSA); err != nil {
+			return nil, fmt.Errorf("Failed to decode RSA signature: %s", err)
+		}
+	case AlgECDSA:
+		var rBytes, sBytes []byte
+		if err := readAll(r, &rBytes, &sBytes); err != nil {
+			return nil, fmt.Errorf("Failed to decode ECDSA signature: %s", err)
+		}
+		sig.R, sig.S = new(big.Int).SetBytes(rBytes), new(big.Int).SetBytes(sBytes)
+	default:
+		return nil, fmt.Errorf("Unsupported signature algorithm: 0x%04x", sig.Alg)
+	}
+	if r.Len() > 0 {
+		return nil, fmt.Errorf("Failed to decode TPMT_SIGNATURE: %d trailing bytes", r.Len())
+	}
+	return sig, nil
+}
+
+// Encode marshals the signature structure
+func (sig *Signature) Encode() ([]byte, error) {
+	buf := &bytes.Buffer{}
+	var err error
+	switch sig.Alg {
+	case AlgRSASSA, AlgRSAPSS:
+		err = writeAll(buf, sig.Alg, sig.Hash, sig.RSA)
+	case AlgECDSA:
+		if sig.R == nil || sig.S == nil {
+			return nil, fmt.Errorf("Missing ECDSA signature")
+		}
+		err = writeAll(buf, sig.Alg, sig.Hash, sig.R.Bytes(), sig.S.Bytes())
+	default:
+		err = fmt.Errorf("Unsupported signature algorithm: 0x%04x", sig.Alg)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Verify verifies the signature of data with the given public key
+func (sig *Signature) Verify(pub crypto.PublicKey, data []byte) error {
+	if sig.Hash != AlgSHA256 {
+		return fmt.Errorf("Unsupported signature hash algorithm: 0x%04x", sig.Hash)
+	}
+	hashed := sha256.Sum256(data)
+	switch sig.Alg {
+	case AlgRSASSA, AlgRSAPSS:
+		rsaKey, ok := pub.(*rsa.PublicKey)
+		if !ok {
+			return fmt.Errorf("Wrong type of public key for RSA signature: %T", pub)
+		}
+		if sig.Alg == AlgRSAPSS {
+			return rsa.VerifyPSS(rsaKey, crypto.SHA256, hashed[:], sig.RSA, nil)
+		}
+		return rsa.VerifyPKCS1v15(rsaKey, crypto.SHA256, hashed[:], sig.RSA)
+	case AlgECDSA:
+		ecdsaKey, ok := pub.(*ecdsa.PublicKey)
+		if !ok {
+			return fmt.Errorf("Wrong type of public key for ECDSA signature: %T", pub)
+		}
+		if !ecdsa.Verify(ecdsaKey, hashed[:], sig.R, sig.S) {
+			return fmt.Errorf("Invalid ECDSA signature")
+		}
+		return nil
+	default:
+		return fmt.Errorf("Unsupported signature algorithm: 0x%04x", sig.Alg)
+	}
+}
+
+// VerifyAKCertificate parses the DER encoded AK certificate & intermediates and
+// verifies that the AK certificate chains to one of the given roots at time now
+func VerifyAKCertificate(
+	akCertDER []byte, intermediatesDER [][]byte, roots *x509.CertPool, now time.Time) (*x509.Certificate, error) {
+
+	if roots == nil {
+		return nil, fmt.Errorf("No trusted TPM roots configured")
+	}
+	akCert, err := x509.ParseCertificate(akCertDER)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to parse AK certificate: %s", err)
+	}
+	intermediates := x509.NewCertPool()
+	for i, der := range intermediatesDER {
+		cert, err := x509.ParseCertificate(der)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to parse AK certificate chain #%d: %s", i, err)
+		}
+		intermediates.AddCert(cert)
+	}
+	_, err = akCert.Verify(x509.VerifyOptions{
+		Intermediates: intermediates,
+		Roots:         roots,
+		CurrentTime:   now,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Failed to verify AK certificate chain: %s", err)
+	}
+	return akCert, nil
+}
+
+// VerifyQuote verifies that the marshaled TPMS_ATTEST is a TPM generated quote of
+// the given nonce signed by the AK of akCert with the marshaled TPMT_SIGNATURE
+func VerifyQuote(akCert *x509.Certificate, attestBytes, signatureBytes, nonce []byte) error {
+	sig, err := DecodeSignature(signatureBytes)
+	if err != nil {
+		return err
+	}
+	if err = sig.Verify(akCert.PublicKey, attestBytes); err != nil {
+		return fmt.Errorf("Failed to verify quote signature: %s", err)
+	}
+	attest, err := DecodeAttest(attestBytes)
+	if err != nil {
+		return err
+	}
+	if attest.Magic != GeneratedValue {
+		return fmt.Errorf("Quote is not TPM generated, magic: 0x%08x", attest.Magic)
+	}
+	if attest.Type != AttestQuote {
+		return fmt.Errorf("Wrong attestation type, expected quote (0x%04x), got 0x%04x", AttestQuote, attest.Type)
+	}
+	if !bytes.Equal(attest.ExtraData, nonce) {
+		return fmt.Errorf("Quote qualifying data does not match the nonce")
+	}
+	return nil
+}
+
+// readAll reads the given fields in TPM wire format, []byte fields are TPM2B
+// (uint16 size prefixed) buffers
+func readAll(r io.Reader, fields ...interface{}) error {
+	for _, field := range fields {
+		if buf, ok := field.(*[]byte); ok {
+			var size uint16
+			if err := binary.Read(r, binary.BigEndian, &size); err != nil {
+				return err
+			}
+			*buf = make([]byte, size)
+			if _, err := io.ReadFull(r, *buf); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := binary.Read(r, binary.BigEndian, field); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeAll writes the given fields in TPM wire format, see readAll
+func writeAll(w io.Writer, fields ...interface{}) error {
+	for _, field := range fields {
+		if buf, ok := field.([]byte); ok {
+			if len(buf) > 0xffff {
+				return fmt.Errorf("TPM2B buffer too large: %d", len(buf))
+			}
+			if err := binary.Write(w, binary.BigEndian, uint16(len(buf))); err != nil {
+				return err
+			}
+			if _, err := w.Write(buf); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := binary.Write(w, binary.BigEndian, field); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package tpm_test
+
+import (
+	"crypto/sha256"
+	"testing"
+	"time"
+
+	"magma/orc8r/cloud/go/security/tpm"
+	"magma/orc8r/cloud/go/security/tpm/test_utils"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAttestEncoding(t *testing.T) {
+	attest := &tpm.Attest{
+		Magic:           tpm.GeneratedValue,
+		Type:            tpm.AttestQuote,
+		QualifiedSigner: []byte("signer"),
+		ExtraData:       []byte("nonce"),
+		ClockInfo:       tpm.ClockInfo{Clock: 1, ResetCount: 2, RestartCount: 3, Safe: true},
+		FirmwareVersion: 4,
+		Attested:        []byte{1, 2, 3},
+	}
+	b, err := attest.Encode()
+	assert.NoError(t, err)
+	decoded, err := tpm.DecodeAttest(b)
+	assert.NoError(t, err)
+	assert.Equal(t, attest, decoded)
+
+	_, err = tpm.DecodeAttest(b[:10])
+	assert.Error(t, err)
+}
+
+func TestVerifyQuote(t *testing.T) {
+	for _, akType := range []string{"", "P256"} {
+		sim, err := test_utils.NewSimulator(akType)
+		assert.NoError(t, err)
+		nonce := sha256.Sum256([]byte("challenge"))
+
+		akCert, err := tpm.VerifyAKCertificate(
+			sim.AKCert.Raw, [][]byte{sim.Intermediate.Raw}, sim.Roots(), time.Now())
+		assert.NoError(t, err)
+
+		attest, sig, err := sim.Quote(nonce[:])
+		assert.NoError(t, err)
+		assert.NoError(t, tpm.VerifyQuote(akCert, attest, sig, nonce[:]))
+
+		// wrong nonce
+		assert.Error(t, tpm.VerifyQuote(akCert, attest, sig, []byte("nonce")))
+		// tampered quote
+		attest[len(attest)-1] ^= 0xff
+		assert.Error(t, tpm.VerifyQuote(akCert, attest, sig, nonce[:]))
+
+		// signed by the AK, but not generated by the TPM
+		attest, sig, err = sim.Sign(&tpm.Attest{Magic: 0x12345678, Type: tpm.AttestQuote, ExtraData: nonce[:]})
+		assert.NoError(t, err)
+		assert.EqualError(t, tpm.VerifyQuote(akCert, attest, sig, nonce[:]), "Quote is not TPM generated, magic: 0x12345678")
+
+		attest, sig, err = sim.Sign(&tpm.Attest{Magic: tpm.GeneratedValue, Type: tpm.AttestCertify, ExtraData: nonce[:]})
+		assert.NoError(t, err)
+		assert.Error(t, tpm.VerifyQuote(akCert, attest, sig, nonce[:]))
+	}
+}
+
+func TestVerifyAKCertificate(t *testing.T) {
+	sim, err := test_utils.NewSimulator("P256")
+	assert.NoError(t, err)
+	otherSim, err := test_utils.NewSimulator("P256")
+	assert.NoError(t, err)
+
+	// missing intermediate
+	_, err = tpm.VerifyAKCertificate(sim.AKCert.Raw, nil, sim.Roots(), time.Now())
+	assert.Error(t, err)
+	// untrusted root
+	_, err = tpm.VerifyAKCertificate(sim.AKCert.Raw, [][]byte{sim.Intermediate.Raw}, otherSim.Roots(), time.Now())
+	assert.Error(t, err)
+	// expired
+	_, err = tpm.VerifyAKCertificate(
+		sim.AKCert.Raw, [][]byte{sim.Intermediate.Raw}, sim.Roots(), time.Now().Add(time.Hour*48))
+	assert.Error(t, err)
+	// no roots configured
+	_, err = tpm.VerifyAKCertificate(sim.AKCert.Raw, [][]byte{sim.Intermediate.Raw}, nil, time.Now())
+	assert.EqualError(t, err, "No trusted TPM roots configured")
+	// not a certificate
+	_, err = tpm.VerifyAKCertificate([]byte("not a cert"), nil, sim.Roots(), time.Now())
+	assert.Error(t, err)
+}
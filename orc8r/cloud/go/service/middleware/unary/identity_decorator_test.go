@@ -61,6 +61,16 @@ func (srv *testCheckindServer) GetStatus(
 	return new(protos.GatewayStatus), nil
 }
 
+// Gateway checkin history retrieval
+func (srv *testCheckindServer) GetStatusHistory(
+	ctx context.Context,
+	req *protos.GatewayStatusHistoryRequest) (*protos.GatewayStatusHistory, error) {
+
+	srv.lastClientIdentity =
+		proto.Clone(protos.GetClientIdentity(ctx)).(*protos.Identity)
+	return new(protos.GatewayStatusHistory), nil
+}
+
 // Removes Gateway status record from the Gateway's network table
 func (srv *testCheckindServer) DeleteGatewayStatus(
 	ctx context.Context,
@@ -199,12 +209,13 @@ func TestIdentityInjector(t *testing.T) {
 		context.Background(),
 		metadata.Pairs("x-magma-client-cert-serial", csn[0]))
 
-	// Expect PermissionDenied error now
+	// Expect PermissionDenied error now, RemoveGateway revokes the gateway's
+	// certificates, so the client certificate isn't known anymore
 	_, err = magmaCheckindClient.Checkin(ctx, &request)
 	assert.Error(t, err)
 	assert.Equal(t, int64(0), checkindServer.lastClientCertExpTime)
 	assert.Equal(
 		t,
-		"rpc error: code = PermissionDenied desc = Unregistered Gateway Test-AGW-Hw-Id",
+		"rpc error: code = PermissionDenied desc = Unknown Client Certificate",
 		err.Error())
 }
@@ -184,3 +184,114 @@ func ListOperators() ([]*protos.Identity, error) {
 	}
 	return opslist.List, nil
 }
+
+// SetRole creates or overwrites a role
+func SetRole(role *accessprotos.AccessControl_Role) error {
+	client, err := getAccessdClient()
+	if err != nil {
+		return err
+	}
+	_, err = client.SetRole(context.Background(), role)
+	if err != nil {
+		errMsg := fmt.Sprintf("Set Role %s error: %s", role.GetName(), err)
+		glog.Error(errMsg)
+		return errors.New(errMsg)
+	}
+	return nil
+}
+
+// GetRole returns the role with the given name
+func GetRole(name string) (*accessprotos.AccessControl_Role, error) {
+	client, err := getAccessdClient()
+	if err != nil {
+		return nil, err
+	}
+	role, err := client.GetRole(context.Background(), &accessprotos.AccessControl_RoleName{Name: name})
+	if err != nil {
+		errMsg := fmt.Sprintf("Get Role %s error: %s", name, err)
+		glog.Error(errMsg)
+		return nil, errors.New(errMsg)
+	}
+	return role, nil
+}
+
+// DeleteRole removes the role, roles bound to any operator cannot be removed
+func DeleteRole(name string) error {
+	client, err := getAccessdClient()
+	if err != nil {
+		return err
+	}
+	_, err = client.DeleteRole(context.Background(), &accessprotos.AccessControl_RoleName{Name: name})
+	if err != nil {
+		errMsg := fmt.Sprintf("Delete Role %s error: %s", name, err)
+		glog.Error(errMsg)
+		return errors.New(errMsg)
+	}
+	return nil
+}
+
+// ListRoles returns all roles
+func ListRoles() ([]*accessprotos.AccessControl_Role, error) {
+	client, err := getAccessdClient()
+	if err != nil {
+		return nil, err
+	}
+	roles, err := client.ListRoles(context.Background(), &protos.Void{})
+	if err != nil {
+		errMsg := fmt.Sprintf("List Roles error: %s", err)
+		glog.Error(errMsg)
+		return nil, errors.New(errMsg)
+	}
+	return roles.Roles, nil
+}
+
+// SetOperatorRoles overwrites the operator's role bindings
+func SetOperatorRoles(operator *protos.Identity, bindings []*accessprotos.AccessControl_RoleBinding) error {
+	client, err := getAccessdClient()
+	if err != nil {
+		return err
+	}
+	_, err = client.SetOperatorRoles(
+		context.Background(),
+		&accessprotos.AccessControl_RoleBindings{Operator: operator, Bindings: bindings})
+	if err != nil {
+		errMsg := fmt.Sprintf("Set Roles for Operator %s error: %s", operator.HashString(), err)
+		glog.Error(errMsg)
+		return errors.New(errMsg)
+	}
+	return nil
+}
+
+// GetOperatorRoles returns the operator's role bindings
+func GetOperatorRoles(operator *protos.Identity) ([]*accessprotos.AccessControl_RoleBinding, error) {
+	client, err := getAccessdClient()
+	if err != nil {
+		return nil, err
+	}
+	bindings, err := client.GetOperatorRoles(context.Background(), operator)
+	if err != nil {
+		errMsg := fmt.Sprintf("Get Roles for Operator %s error: %s", operator.HashString(), err)
+		glog.Error(errMsg)
+		return nil, errors.New(errMsg)
+	}
+	return bindings.Bindings, nil
+}
+
+// GetOperatorEffectiveACL returns the operator's ACL merged with the
+// permissions of all the operator's roles
+func GetOperatorEffectiveACL(
+	operator *protos.Identity,
+) (map[string]*accessprotos.AccessControl_Entity, error) {
+	client, err := getAccessdClient()
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.GetOperatorEffectiveACL(context.Background(), operator)
+	if err != nil {
+		errMsg := fmt.Sprintf("Get Effective Permissions for Operator %s error: %s",
+			operator.HashString(), err)
+		glog.Error(errMsg)
+		return nil, errors.New(errMsg)
+	}
+	return resp.Entities, nil
+}
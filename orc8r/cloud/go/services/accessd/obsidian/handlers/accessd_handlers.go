@@ -19,6 +19,9 @@ const (
 	operatorNetworkPath     = operatorEntitiesPath + "/network/:network_id"
 	operatorPermissionsPath = operatorNetworkPath + "/permissions"
 	operatorCertificatePath = operatorsDetailPath + "/certificate"
+	operatorRolesPath       = operatorsDetailPath + "/roles"
+	rolesRootPath           = handlers.ROLES_ROOT
+	rolesDetailPath         = rolesRootPath + "/:role_name"
 )
 
 // GetObsidianHandlers returns all the handlers for accessd
@@ -84,5 +87,42 @@ func GetObsidianHandlers() []handlers.Handler {
 			Methods:     handlers.DELETE,
 			HandlerFunc: DeleteOperatorCertificateHandler,
 		},
+
+		// role_handlers.go
+		{
+			Path:        operatorRolesPath,
+			Methods:     handlers.GET,
+			HandlerFunc: GetOperatorRolesHandler,
+		},
+		{
+			Path:        operatorRolesPath,
+			Methods:     handlers.PUT,
+			HandlerFunc: PutOperatorRolesHandler,
+		},
+		{
+			Path:        rolesRootPath,
+			Methods:     handlers.GET,
+			HandlerFunc: GetRolesRootHandler,
+		},
+		{
+			Path:        rolesRootPath,
+			Methods:     handlers.POST,
+			HandlerFunc: PostRolesRootHandler,
+		},
+		{
+			Path:        rolesDetailPath,
+			Methods:     handlers.GET,
+			HandlerFunc: GetRoleHandler,
+		},
+		{
+			Path:        rolesDetailPath,
+			Methods:     handlers.PUT,
+			HandlerFunc: PutRoleHandler,
+		},
+		{
+			Path:        rolesDetailPath,
+			Methods:     handlers.DELETE,
+			HandlerFunc: DeleteRoleHandler,
+		},
 	}
 }
@@ -51,6 +51,32 @@ func getOperatorForWrite(c echo.Context) (*protos.Identity, *echo.HTTPError) {
 	return operator, nil
 }
 
+// checkSupervisorRead verifies the caller's READ permissions for all entity
+// type wildcards
+func checkSupervisorRead(c echo.Context) *echo.HTTPError {
+	caller, err := access.RequestOperator(c)
+	if err != nil {
+		return handlers.HttpError(err)
+	}
+	if err := accessd.CheckReadPermission(caller, access.SupervisorWildcards()...); err != nil {
+		return handlers.HttpError(err, http.StatusForbidden)
+	}
+	return nil
+}
+
+// checkSupervisorWrite verifies the caller's WRITE permissions for all entity
+// type wildcards
+func checkSupervisorWrite(c echo.Context) *echo.HTTPError {
+	caller, err := access.RequestOperator(c)
+	if err != nil {
+		return handlers.HttpError(err)
+	}
+	if err := accessd.CheckWritePermission(caller, access.SupervisorWildcards()...); err != nil {
+		return handlers.HttpError(err, http.StatusForbidden)
+	}
+	return nil
+}
+
 func getOperator(c echo.Context) (*protos.Identity, *echo.HTTPError) {
 	operatorID, httpErr := handlers.GetOperatorId(c)
 	if httpErr != nil {
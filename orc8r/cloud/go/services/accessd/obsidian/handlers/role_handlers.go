@@ -0,0 +1,163 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo"
+
+	"magma/orc8r/cloud/go/obsidian/handlers"
+	"magma/orc8r/cloud/go/services/accessd"
+	"magma/orc8r/cloud/go/services/accessd/obsidian/models"
+)
+
+func GetRolesRootHandler(c echo.Context) error {
+	if httpErr := checkSupervisorRead(c); httpErr != nil {
+		return httpErr
+	}
+	roles, err := accessd.ListRoles()
+	if err != nil {
+		return handlers.HttpError(fmt.Errorf("Failed to list roles: %s", err.Error()))
+	}
+	modelRoles := make([]*models.Role, len(roles))
+	for i, role := range roles {
+		modelRoles[i] = models.RoleFromProto(role)
+	}
+	return c.JSON(http.StatusOK, modelRoles)
+}
+
+func PostRolesRootHandler(c echo.Context) error {
+	if httpErr := checkSupervisorWrite(c); httpErr != nil {
+		return httpErr
+	}
+	role, httpErr := bindRole(c)
+	if httpErr != nil {
+		return httpErr
+	}
+	if _, err := accessd.GetRole(string(role.Name)); err == nil {
+		return handlers.HttpError(fmt.Errorf("Role already exists"), http.StatusBadRequest)
+	}
+	if err := accessd.SetRole(models.RoleToProto(role)); err != nil {
+		return handlers.HttpError(fmt.Errorf("Failed to create role %s: %s", role.Name, err.Error()))
+	}
+	return c.NoContent(http.StatusCreated)
+}
+
+func GetRoleHandler(c echo.Context) error {
+	if httpErr := checkSupervisorRead(c); httpErr != nil {
+		return httpErr
+	}
+	name, httpErr := getRoleName(c)
+	if httpErr != nil {
+		return httpErr
+	}
+	role, err := accessd.GetRole(name)
+	if err != nil {
+		return handlers.HttpError(fmt.Errorf("Failed to get role %s: %s", name, err.Error()), http.StatusNotFound)
+	}
+	return c.JSON(http.StatusOK, models.RoleFromProto(role))
+}
+
+func PutRoleHandler(c echo.Context) error {
+	if httpErr := checkSupervisorWrite(c); httpErr != nil {
+		return httpErr
+	}
+	name, httpErr := getRoleName(c)
+	if httpErr != nil {
+		return httpErr
+	}
+	role, httpErr := bindRole(c)
+	if httpErr != nil {
+		return httpErr
+	}
+	if string(role.Name) != name {
+		return handlers.HttpError(
+			fmt.Errorf("Role name %s does not match URL role name %s", role.Name, name),
+			http.StatusBadRequest)
+	}
+	if _, err := accessd.GetRole(name); err != nil {
+		return handlers.HttpError(fmt.Errorf("Failed to get role %s: %s", name, err.Error()), http.StatusNotFound)
+	}
+	if err := accessd.SetRole(models.RoleToProto(role)); err != nil {
+		return handlers.HttpError(fmt.Errorf("Failed to update role %s: %s", name, err.Error()))
+	}
+	return c.NoContent(http.StatusOK)
+}
+
+func DeleteRoleHandler(c echo.Context) error {
+	if httpErr := checkSupervisorWrite(c); httpErr != nil {
+		return httpErr
+	}
+	name, httpErr := getRoleName(c)
+	if httpErr != nil {
+		return httpErr
+	}
+	if err := accessd.DeleteRole(name); err != nil {
+		return handlers.HttpError(fmt.Errorf("Failed to delete role %s: %s", name, err.Error()))
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+func GetOperatorRolesHandler(c echo.Context) error {
+	operator, httpErr := getOperatorForRead(c)
+	if httpErr != nil {
+		return httpErr
+	}
+	bindings, err := accessd.GetOperatorRoles(operator)
+	if err != nil {
+		return handlers.HttpError(fmt.Errorf("Failed to get roles for %s: %s",
+			operator.String(), err.Error()))
+	}
+	return c.JSON(http.StatusOK, models.RoleBindingsFromProto(bindings))
+}
+
+// PutOperatorRolesHandler overwrites the operator's role bindings. Since roles
+// may grant any permissions, binding roles requires supervisor permissions.
+func PutOperatorRolesHandler(c echo.Context) error {
+	if httpErr := checkSupervisorWrite(c); httpErr != nil {
+		return httpErr
+	}
+	operator, httpErr := getOperator(c)
+	if httpErr != nil {
+		return httpErr
+	}
+	bindings := models.RoleBindings{}
+	if err := c.Bind(&bindings); err != nil {
+		return handlers.HttpError(err, http.StatusBadRequest)
+	}
+	if err := bindings.ValidateModel(); err != nil {
+		return handlers.HttpError(err, http.StatusBadRequest)
+	}
+	if err := accessd.SetOperatorRoles(operator, models.RoleBindingsToProto(bindings)); err != nil {
+		return handlers.HttpError(fmt.Errorf("Failed to set roles for %s: %s",
+			operator.String(), err.Error()))
+	}
+	return c.NoContent(http.StatusOK)
+}
+
+func bindRole(c echo.Context) (*models.Role, *echo.HTTPError) {
+	role := &models.Role{}
+	if err := c.Bind(role); err != nil {
+		return nil, handlers.HttpError(err, http.StatusBadRequest)
+	}
+	if err := role.ValidateModel(); err != nil {
+		return nil, handlers.HttpError(err, http.StatusBadRequest)
+	}
+	return role, nil
+}
+
+func getRoleName(c echo.Context) (string, *echo.HTTPError) {
+	name := c.Param("role_name")
+	if name == "" {
+		return name, handlers.HttpError(fmt.Errorf("Invalid/Missing Role Name"), http.StatusBadRequest)
+	}
+	return name, nil
+}
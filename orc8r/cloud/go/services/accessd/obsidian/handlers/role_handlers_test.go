@@ -0,0 +1,156 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package handlers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo"
+	"github.com/stretchr/testify/assert"
+
+	"magma/orc8r/cloud/go/obsidian/access"
+	"magma/orc8r/cloud/go/services/accessd/obsidian/handlers"
+	"magma/orc8r/cloud/go/services/accessd/obsidian/models"
+	"magma/orc8r/cloud/go/test_utils"
+)
+
+func cleanupRoles(t *testing.T) {
+	cleanup(t)
+	err := test_utils.GetMockDatastoreInstance().DeleteTable("access_roles")
+	assert.NoError(t, err)
+	err = test_utils.GetMockDatastoreInstance().DeleteTable("access_role_bindings")
+	assert.NoError(t, err)
+}
+
+func TestRoles(t *testing.T) {
+	defer cleanupRoles(t)
+	testOperatorSN, certificates, _ := testInit(t)
+	operator1SN := string(certToSerialNumber(t, certificates[operator1ID]))
+	role := &models.Role{
+		Name:        "net_admin",
+		Description: "Network administrator",
+		Entities: models.ACLType{
+			&models.ACLEntity{
+				EntityType:  models.ACLEntityEntityTypeGATEWAYWILDCARD,
+				Permissions: models.PermissionsMask{models.PermissionTypeREAD, models.PermissionTypeWRITE},
+			},
+		},
+		NetworkPermissions: models.PermissionsMask{models.PermissionTypeREAD, models.PermissionTypeWRITE},
+	}
+
+	// Only supervisors may manage roles
+	_, err := sendRoleRequest(t, echo.POST, operator1SN, role, nil, handlers.PostRolesRootHandler)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "code=403")
+	rec, err := sendRoleRequest(t, echo.POST, testOperatorSN, role, nil, handlers.PostRolesRootHandler)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, rec.Code)
+	_, err = sendRoleRequest(t, echo.POST, testOperatorSN, role, nil, handlers.PostRolesRootHandler)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "code=400")
+	_, err = sendRoleRequest(t, echo.POST, testOperatorSN, &models.Role{Name: "bad name!"}, nil, handlers.PostRolesRootHandler)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "code=400")
+
+	rec, err = sendRoleRequest(t, echo.GET, testOperatorSN, nil, nil, handlers.GetRolesRootHandler)
+	assert.NoError(t, err)
+	var roles []*models.Role
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &roles))
+	assert.Equal(t, []*models.Role{role}, roles)
+
+	roleParams := map[string]string{"role_name": "net_admin"}
+	rec, err = sendRoleRequest(t, echo.GET, testOperatorSN, nil, roleParams, handlers.GetRoleHandler)
+	assert.NoError(t, err)
+	actualRole := &models.Role{}
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), actualRole))
+	assert.Equal(t, role, actualRole)
+
+	// Bind the role to operator 2 for network 4
+	bindings := models.RoleBindings{{Role: "net_admin", Networks: []string{"net4"}}}
+	op2Params := map[string]string{"operator_id": string(operator2ID)}
+	_, err = sendRoleRequest(t, echo.PUT, operator1SN, bindings, op2Params, handlers.PutOperatorRolesHandler)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "code=403")
+	rec, err = sendRoleRequest(t, echo.PUT, testOperatorSN, bindings, op2Params, handlers.PutOperatorRolesHandler)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	rec, err = sendRoleRequest(t, echo.GET, testOperatorSN, nil, op2Params, handlers.GetOperatorRolesHandler)
+	assert.NoError(t, err)
+	var actualBindings models.RoleBindings
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &actualBindings))
+	assert.Equal(t, bindings, actualBindings)
+
+	op2Net4Params := map[string]string{"operator_id": string(operator2ID), "network_id": "net4"}
+	rec, err = sendRoleRequest(t, echo.GET, testOperatorSN, nil, op2Net4Params, handlers.GetOperatorPermissionsHandler)
+	assert.NoError(t, err)
+	var permissions models.PermissionsMask
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &permissions))
+	assert.ElementsMatch(t, models.PermissionsMask{models.PermissionTypeREAD, models.PermissionTypeWRITE}, permissions)
+
+	// Role updates
+	role.NetworkPermissions = models.PermissionsMask{models.PermissionTypeREAD}
+	rec, err = sendRoleRequest(t, echo.PUT, testOperatorSN, role, roleParams, handlers.PutRoleHandler)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	rec, err = sendRoleRequest(t, echo.GET, testOperatorSN, nil, op2Net4Params, handlers.GetOperatorPermissionsHandler)
+	assert.NoError(t, err)
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &permissions))
+	assert.ElementsMatch(t, models.PermissionsMask{models.PermissionTypeREAD, models.PermissionTypeNONE}, permissions)
+	_, err = sendRoleRequest(t, echo.PUT, testOperatorSN, role, map[string]string{"role_name": "other"}, handlers.PutRoleHandler)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "code=400")
+
+	// Bound roles cannot be deleted
+	_, err = sendRoleRequest(t, echo.DELETE, testOperatorSN, nil, roleParams, handlers.DeleteRoleHandler)
+	assert.Error(t, err)
+	rec, err = sendRoleRequest(t, echo.PUT, testOperatorSN, models.RoleBindings{}, op2Params, handlers.PutOperatorRolesHandler)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	rec, err = sendRoleRequest(t, echo.DELETE, testOperatorSN, nil, roleParams, handlers.DeleteRoleHandler)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	_, err = sendRoleRequest(t, echo.GET, testOperatorSN, nil, roleParams, handlers.GetRoleHandler)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "code=404")
+}
+
+func sendRoleRequest(
+	t *testing.T,
+	method string,
+	certSN string,
+	body interface{},
+	params map[string]string,
+	handler echo.HandlerFunc,
+) (*httptest.ResponseRecorder, error) {
+	var req *http.Request
+	if body != nil {
+		bodyBytes, err := json.Marshal(body)
+		assert.NoError(t, err)
+		req = httptest.NewRequest(method, "/", strings.NewReader(string(bodyBytes)))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	} else {
+		req = httptest.NewRequest(method, "/", nil)
+	}
+	req.Header.Set(access.CLIENT_CERT_SN_KEY, certSN)
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+	names, values := []string{}, []string{}
+	for name, value := range params {
+		names = append(names, name)
+		values = append(values, value)
+	}
+	c.SetParamNames(names...)
+	c.SetParamValues(values...)
+	return rec, handler(c)
+}
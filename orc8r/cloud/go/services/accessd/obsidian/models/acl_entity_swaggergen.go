@@ -19,8 +19,8 @@ import (
 // swagger:model acl_entity
 type ACLEntity struct {
 
-	// entity type
-	// Enum: [OPERATOR NETWORK OPERATOR_WILDCARD NETWORK_WILDCARD]
+	// GATEWAY_WILDCARD entities with a network_id match the gateways of that network only
+	// Enum: [OPERATOR NETWORK OPERATOR_WILDCARD NETWORK_WILDCARD GATEWAY_WILDCARD]
 	EntityType string `json:"entity_type,omitempty"`
 
 	// network id
@@ -63,7 +63,7 @@ var aclEntityTypeEntityTypePropEnum []interface{}
 
 func init() {
 	var res []string
-	if err := json.Unmarshal([]byte(`["OPERATOR","NETWORK","OPERATOR_WILDCARD","NETWORK_WILDCARD"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["OPERATOR","NETWORK","OPERATOR_WILDCARD","NETWORK_WILDCARD","GATEWAY_WILDCARD"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -84,6 +84,9 @@ const (
 
 	// ACLEntityEntityTypeNETWORKWILDCARD captures enum value "NETWORK_WILDCARD"
 	ACLEntityEntityTypeNETWORKWILDCARD string = "NETWORK_WILDCARD"
+
+	// ACLEntityEntityTypeGATEWAYWILDCARD captures enum value "GATEWAY_WILDCARD"
+	ACLEntityEntityTypeGATEWAYWILDCARD string = "GATEWAY_WILDCARD"
 )
 
 // prop value enum
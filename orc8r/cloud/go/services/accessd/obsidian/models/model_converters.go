@@ -20,8 +20,10 @@ import (
 var formatsRegistry = strfmt.NewFormats()
 
 func PermissionsMaskToProto(mask PermissionsMask) accessprotos.AccessControl_Permission {
-	permissions := accessprotos.AccessControl_Permission_value[string(mask[0])]
-	permissions |= accessprotos.AccessControl_Permission_value[string(mask[1])]
+	var permissions int32
+	for _, permission := range mask {
+		permissions |= accessprotos.AccessControl_Permission_value[string(permission)]
+	}
 	return accessprotos.AccessControl_Permission(permissions)
 }
 
@@ -52,6 +54,9 @@ func ACLEntityFromProto(accessControlEntity *accessprotos.AccessControl_Entity)
 			aclEntity.EntityType = ACLEntityEntityTypeOPERATORWILDCARD
 		case protos.Identity_Wildcard_Network:
 			aclEntity.EntityType = ACLEntityEntityTypeNETWORKWILDCARD
+		case protos.Identity_Wildcard_Gateway:
+			aclEntity.EntityType = ACLEntityEntityTypeGATEWAYWILDCARD
+			aclEntity.NetworkID = NetworkID(wildcard.Wildcard.NetworkId)
 		}
 	} else if operator, ok := accessControlEntity.Id.Value.(*protos.Identity_Operator); ok {
 		aclEntity.EntityType = ACLEntityEntityTypeOPERATOR
@@ -80,6 +85,53 @@ func ACLFromProto(accessControlList []*accessprotos.AccessControl_Entity) ACLTyp
 	return ACLType(aclEntities)
 }
 
+func (m *Role) ValidateModel() error {
+	return m.Validate(formatsRegistry)
+}
+
+func (m RoleBindings) ValidateModel() error {
+	return m.Validate(formatsRegistry)
+}
+
+func RoleToProto(role *Role) *accessprotos.AccessControl_Role {
+	return &accessprotos.AccessControl_Role{
+		Name:               string(role.Name),
+		Description:        role.Description,
+		Entities:           ACLToProto(role.Entities),
+		NetworkPermissions: PermissionsMaskToProto(role.NetworkPermissions),
+	}
+}
+
+func RoleFromProto(role *accessprotos.AccessControl_Role) *Role {
+	return &Role{
+		Name:               RoleName(role.Name),
+		Description:        role.Description,
+		Entities:           ACLFromProto(role.Entities),
+		NetworkPermissions: PermissionsMaskFromProto(role.NetworkPermissions),
+	}
+}
+
+func RoleBindingsToProto(bindings RoleBindings) []*accessprotos.AccessControl_RoleBinding {
+	res := make([]*accessprotos.AccessControl_RoleBinding, 0, len(bindings))
+	for _, binding := range bindings {
+		if binding != nil {
+			res = append(res, &accessprotos.AccessControl_RoleBinding{
+				Role:     string(binding.Role),
+				Networks: binding.Networks,
+			})
+		}
+	}
+	return res
+}
+
+func RoleBindingsFromProto(bindings []*accessprotos.AccessControl_RoleBinding) RoleBindings {
+	res := make(RoleBindings, len(bindings))
+	for i, binding := range bindings {
+		res[i] = &RoleBinding{Role: RoleName(binding.Role), Networks: binding.Networks}
+	}
+	return res
+}
+
 func CSRToProto(csr *CsrType, operator *protos.Identity) *protos.CSR {
 	return &protos.CSR{
 		Id: operator,
@@ -117,6 +169,11 @@ func identityFromEntity(entity *ACLEntity) *protos.Identity {
 		return identity.NewOperator(string(entity.OperatorID))
 	case ACLEntityEntityTypeOPERATORWILDCARD:
 		return identity.NewOperatorWildcard()
+	case ACLEntityEntityTypeGATEWAYWILDCARD:
+		if len(entity.NetworkID) > 0 {
+			return identity.NewNetworkGatewayWildcard(string(entity.NetworkID))
+		}
+		return identity.NewGatewayWildcard()
 	}
 	return nil
 }
@@ -48,7 +48,7 @@ func (x AccessControl_Permission) String() string {
 	return proto.EnumName(AccessControl_Permission_name, int32(x))
 }
 func (AccessControl_Permission) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_access_599443aad1afe218, []int{0, 0}
+	return fileDescriptor_access_5d61c124f297e849, []int{0, 0}
 }
 
 // Access Control Data Structures & Definitions
@@ -62,7 +62,7 @@ func (m *AccessControl) Reset()         { *m = AccessControl{} }
 func (m *AccessControl) String() string { return proto.CompactTextString(m) }
 func (*AccessControl) ProtoMessage()    {}
 func (*AccessControl) Descriptor() ([]byte, []int) {
-	return fileDescriptor_access_599443aad1afe218, []int{0}
+	return fileDescriptor_access_5d61c124f297e849, []int{0}
 }
 func (m *AccessControl) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AccessControl.Unmarshal(m, b)
@@ -95,7 +95,7 @@ func (m *AccessControl_Entity) Reset()         { *m = AccessControl_Entity{} }
 func (m *AccessControl_Entity) String() string { return proto.CompactTextString(m) }
 func (*AccessControl_Entity) ProtoMessage()    {}
 func (*AccessControl_Entity) Descriptor() ([]byte, []int) {
-	return fileDescriptor_access_599443aad1afe218, []int{0, 0}
+	return fileDescriptor_access_5d61c124f297e849, []int{0, 0}
 }
 func (m *AccessControl_Entity) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AccessControl_Entity.Unmarshal(m, b)
@@ -142,7 +142,7 @@ func (m *AccessControl_List) Reset()         { *m = AccessControl_List{} }
 func (m *AccessControl_List) String() string { return proto.CompactTextString(m) }
 func (*AccessControl_List) ProtoMessage()    {}
 func (*AccessControl_List) Descriptor() ([]byte, []int) {
-	return fileDescriptor_access_599443aad1afe218, []int{0, 1}
+	return fileDescriptor_access_5d61c124f297e849, []int{0, 1}
 }
 func (m *AccessControl_List) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AccessControl_List.Unmarshal(m, b)
@@ -193,7 +193,7 @@ func (m *AccessControl_ListRequest) Reset()         { *m = AccessControl_ListReq
 func (m *AccessControl_ListRequest) String() string { return proto.CompactTextString(m) }
 func (*AccessControl_ListRequest) ProtoMessage()    {}
 func (*AccessControl_ListRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_access_599443aad1afe218, []int{0, 2}
+	return fileDescriptor_access_5d61c124f297e849, []int{0, 2}
 }
 func (m *AccessControl_ListRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AccessControl_ListRequest.Unmarshal(m, b)
@@ -240,7 +240,7 @@ func (m *AccessControl_PermissionsRequest) Reset()         { *m = AccessControl_
 func (m *AccessControl_PermissionsRequest) String() string { return proto.CompactTextString(m) }
 func (*AccessControl_PermissionsRequest) ProtoMessage()    {}
 func (*AccessControl_PermissionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_access_599443aad1afe218, []int{0, 3}
+	return fileDescriptor_access_5d61c124f297e849, []int{0, 3}
 }
 func (m *AccessControl_PermissionsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AccessControl_PermissionsRequest.Unmarshal(m, b)
@@ -285,7 +285,7 @@ func (m *AccessControl_Lists) Reset()         { *m = AccessControl_Lists{} }
 func (m *AccessControl_Lists) String() string { return proto.CompactTextString(m) }
 func (*AccessControl_Lists) ProtoMessage()    {}
 func (*AccessControl_Lists) Descriptor() ([]byte, []int) {
-	return fileDescriptor_access_599443aad1afe218, []int{0, 4}
+	return fileDescriptor_access_5d61c124f297e849, []int{0, 4}
 }
 func (m *AccessControl_Lists) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AccessControl_Lists.Unmarshal(m, b)
@@ -312,6 +312,243 @@ func (m *AccessControl_Lists) GetAcls() []*AccessControl_List {
 	return nil
 }
 
+// Role is a named, reusable template of entity permissions. Operators bound
+// to a role get the role's entities permissions (which may include entity
+// type wildcards) and network_permissions for each of the binding's networks
+type AccessControl_Role struct {
+	Name                 string                   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description          string                   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Entities             []*AccessControl_Entity  `protobuf:"bytes,3,rep,name=entities,proto3" json:"entities,omitempty"`
+	NetworkPermissions   AccessControl_Permission `protobuf:"varint,4,opt,name=network_permissions,json=networkPermissions,proto3,enum=magma.orc8r.accessd.AccessControl_Permission" json:"network_permissions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *AccessControl_Role) Reset()         { *m = AccessControl_Role{} }
+func (m *AccessControl_Role) String() string { return proto.CompactTextString(m) }
+func (*AccessControl_Role) ProtoMessage()    {}
+func (*AccessControl_Role) Descriptor() ([]byte, []int) {
+	return fileDescriptor_access_5d61c124f297e849, []int{0, 5}
+}
+func (m *AccessControl_Role) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccessControl_Role.Unmarshal(m, b)
+}
+func (m *AccessControl_Role) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccessControl_Role.Marshal(b, m, deterministic)
+}
+func (dst *AccessControl_Role) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccessControl_Role.Merge(dst, src)
+}
+func (m *AccessControl_Role) XXX_Size() int {
+	return xxx_messageInfo_AccessControl_Role.Size(m)
+}
+func (m *AccessControl_Role) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccessControl_Role.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccessControl_Role proto.InternalMessageInfo
+
+func (m *AccessControl_Role) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *AccessControl_Role) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *AccessControl_Role) GetEntities() []*AccessControl_Entity {
+	if m != nil {
+		return m.Entities
+	}
+	return nil
+}
+
+func (m *AccessControl_Role) GetNetworkPermissions() AccessControl_Permission {
+	if m != nil {
+		return m.NetworkPermissions
+	}
+	return AccessControl_NONE
+}
+
+type AccessControl_RoleName struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AccessControl_RoleName) Reset()         { *m = AccessControl_RoleName{} }
+func (m *AccessControl_RoleName) String() string { return proto.CompactTextString(m) }
+func (*AccessControl_RoleName) ProtoMessage()    {}
+func (*AccessControl_RoleName) Descriptor() ([]byte, []int) {
+	return fileDescriptor_access_5d61c124f297e849, []int{0, 6}
+}
+func (m *AccessControl_RoleName) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccessControl_RoleName.Unmarshal(m, b)
+}
+func (m *AccessControl_RoleName) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccessControl_RoleName.Marshal(b, m, deterministic)
+}
+func (dst *AccessControl_RoleName) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccessControl_RoleName.Merge(dst, src)
+}
+func (m *AccessControl_RoleName) XXX_Size() int {
+	return xxx_messageInfo_AccessControl_RoleName.Size(m)
+}
+func (m *AccessControl_RoleName) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccessControl_RoleName.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccessControl_RoleName proto.InternalMessageInfo
+
+func (m *AccessControl_RoleName) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type AccessControl_Roles struct {
+	Roles                []*AccessControl_Role `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *AccessControl_Roles) Reset()         { *m = AccessControl_Roles{} }
+func (m *AccessControl_Roles) String() string { return proto.CompactTextString(m) }
+func (*AccessControl_Roles) ProtoMessage()    {}
+func (*AccessControl_Roles) Descriptor() ([]byte, []int) {
+	return fileDescriptor_access_5d61c124f297e849, []int{0, 7}
+}
+func (m *AccessControl_Roles) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccessControl_Roles.Unmarshal(m, b)
+}
+func (m *AccessControl_Roles) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccessControl_Roles.Marshal(b, m, deterministic)
+}
+func (dst *AccessControl_Roles) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccessControl_Roles.Merge(dst, src)
+}
+func (m *AccessControl_Roles) XXX_Size() int {
+	return xxx_messageInfo_AccessControl_Roles.Size(m)
+}
+func (m *AccessControl_Roles) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccessControl_Roles.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccessControl_Roles proto.InternalMessageInfo
+
+func (m *AccessControl_Roles) GetRoles() []*AccessControl_Role {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
+// Binding of a role to an Operator
+type AccessControl_RoleBinding struct {
+	Role string `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	// Networks to grant the role's network_permissions for,
+	// "*" for all networks
+	Networks             []string `protobuf:"bytes,2,rep,name=networks,proto3" json:"networks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AccessControl_RoleBinding) Reset()         { *m = AccessControl_RoleBinding{} }
+func (m *AccessControl_RoleBinding) String() string { return proto.CompactTextString(m) }
+func (*AccessControl_RoleBinding) ProtoMessage()    {}
+func (*AccessControl_RoleBinding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_access_5d61c124f297e849, []int{0, 8}
+}
+func (m *AccessControl_RoleBinding) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccessControl_RoleBinding.Unmarshal(m, b)
+}
+func (m *AccessControl_RoleBinding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccessControl_RoleBinding.Marshal(b, m, deterministic)
+}
+func (dst *AccessControl_RoleBinding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccessControl_RoleBinding.Merge(dst, src)
+}
+func (m *AccessControl_RoleBinding) XXX_Size() int {
+	return xxx_messageInfo_AccessControl_RoleBinding.Size(m)
+}
+func (m *AccessControl_RoleBinding) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccessControl_RoleBinding.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccessControl_RoleBinding proto.InternalMessageInfo
+
+func (m *AccessControl_RoleBinding) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+func (m *AccessControl_RoleBinding) GetNetworks() []string {
+	if m != nil {
+		return m.Networks
+	}
+	return nil
+}
+
+// Operator's role bindings
+type AccessControl_RoleBindings struct {
+	Operator             *protos.Identity             `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	Bindings             []*AccessControl_RoleBinding `protobuf:"bytes,2,rep,name=bindings,proto3" json:"bindings,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *AccessControl_RoleBindings) Reset()         { *m = AccessControl_RoleBindings{} }
+func (m *AccessControl_RoleBindings) String() string { return proto.CompactTextString(m) }
+func (*AccessControl_RoleBindings) ProtoMessage()    {}
+func (*AccessControl_RoleBindings) Descriptor() ([]byte, []int) {
+	return fileDescriptor_access_5d61c124f297e849, []int{0, 9}
+}
+func (m *AccessControl_RoleBindings) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccessControl_RoleBindings.Unmarshal(m, b)
+}
+func (m *AccessControl_RoleBindings) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccessControl_RoleBindings.Marshal(b, m, deterministic)
+}
+func (dst *AccessControl_RoleBindings) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccessControl_RoleBindings.Merge(dst, src)
+}
+func (m *AccessControl_RoleBindings) XXX_Size() int {
+	return xxx_messageInfo_AccessControl_RoleBindings.Size(m)
+}
+func (m *AccessControl_RoleBindings) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccessControl_RoleBindings.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccessControl_RoleBindings proto.InternalMessageInfo
+
+func (m *AccessControl_RoleBindings) GetOperator() *protos.Identity {
+	if m != nil {
+		return m.Operator
+	}
+	return nil
+}
+
+func (m *AccessControl_RoleBindings) GetBindings() []*AccessControl_RoleBinding {
+	if m != nil {
+		return m.Bindings
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*AccessControl)(nil), "magma.orc8r.accessd.AccessControl")
 	proto.RegisterType((*AccessControl_Entity)(nil), "magma.orc8r.accessd.AccessControl.Entity")
@@ -320,6 +557,11 @@ func init() {
 	proto.RegisterType((*AccessControl_ListRequest)(nil), "magma.orc8r.accessd.AccessControl.ListRequest")
 	proto.RegisterType((*AccessControl_PermissionsRequest)(nil), "magma.orc8r.accessd.AccessControl.PermissionsRequest")
 	proto.RegisterType((*AccessControl_Lists)(nil), "magma.orc8r.accessd.AccessControl.Lists")
+	proto.RegisterType((*AccessControl_Role)(nil), "magma.orc8r.accessd.AccessControl.Role")
+	proto.RegisterType((*AccessControl_RoleName)(nil), "magma.orc8r.accessd.AccessControl.RoleName")
+	proto.RegisterType((*AccessControl_Roles)(nil), "magma.orc8r.accessd.AccessControl.Roles")
+	proto.RegisterType((*AccessControl_RoleBinding)(nil), "magma.orc8r.accessd.AccessControl.RoleBinding")
+	proto.RegisterType((*AccessControl_RoleBindings)(nil), "magma.orc8r.accessd.AccessControl.RoleBindings")
 	proto.RegisterEnum("magma.orc8r.accessd.AccessControl_Permission", AccessControl_Permission_name, AccessControl_Permission_value)
 }
 
@@ -363,6 +605,22 @@ type AccessControlManagerClient interface {
 	ListOperators(ctx context.Context, in *protos.Void, opts ...grpc.CallOption) (*protos.Identity_List, error)
 	// Cleanup a given entity from all Operators' ACLs
 	DeleteEntity(ctx context.Context, in *protos.Identity, opts ...grpc.CallOption) (*protos.Void, error)
+	// Creates or overwrites a role
+	SetRole(ctx context.Context, in *AccessControl_Role, opts ...grpc.CallOption) (*protos.Void, error)
+	// Returns the role with the given name
+	GetRole(ctx context.Context, in *AccessControl_RoleName, opts ...grpc.CallOption) (*AccessControl_Role, error)
+	// Removes a role, fails if the role is bound to any operator
+	DeleteRole(ctx context.Context, in *AccessControl_RoleName, opts ...grpc.CallOption) (*protos.Void, error)
+	// Lists all roles
+	ListRoles(ctx context.Context, in *protos.Void, opts ...grpc.CallOption) (*AccessControl_Roles, error)
+	// Overwrites the operator's role bindings, bound roles must exist
+	SetOperatorRoles(ctx context.Context, in *AccessControl_RoleBindings, opts ...grpc.CallOption) (*protos.Void, error)
+	// Returns the operator's role bindings
+	GetOperatorRoles(ctx context.Context, in *protos.Identity, opts ...grpc.CallOption) (*AccessControl_RoleBindings, error)
+	// Returns the operator's effective ACL: the operator's own ACL merged with
+	// the permissions of all the operator's roles. GetPermissions &
+	// CheckPermissions use the effective ACL.
+	GetOperatorEffectiveACL(ctx context.Context, in *protos.Identity, opts ...grpc.CallOption) (*AccessControl_List, error)
 }
 
 type accessControlManagerClient struct {
@@ -454,6 +712,69 @@ func (c *accessControlManagerClient) DeleteEntity(ctx context.Context, in *proto
 	return out, nil
 }
 
+func (c *accessControlManagerClient) SetRole(ctx context.Context, in *AccessControl_Role, opts ...grpc.CallOption) (*protos.Void, error) {
+	out := new(protos.Void)
+	err := c.cc.Invoke(ctx, "/magma.orc8r.accessd.AccessControlManager/SetRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accessControlManagerClient) GetRole(ctx context.Context, in *AccessControl_RoleName, opts ...grpc.CallOption) (*AccessControl_Role, error) {
+	out := new(AccessControl_Role)
+	err := c.cc.Invoke(ctx, "/magma.orc8r.accessd.AccessControlManager/GetRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accessControlManagerClient) DeleteRole(ctx context.Context, in *AccessControl_RoleName, opts ...grpc.CallOption) (*protos.Void, error) {
+	out := new(protos.Void)
+	err := c.cc.Invoke(ctx, "/magma.orc8r.accessd.AccessControlManager/DeleteRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accessControlManagerClient) ListRoles(ctx context.Context, in *protos.Void, opts ...grpc.CallOption) (*AccessControl_Roles, error) {
+	out := new(AccessControl_Roles)
+	err := c.cc.Invoke(ctx, "/magma.orc8r.accessd.AccessControlManager/ListRoles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accessControlManagerClient) SetOperatorRoles(ctx context.Context, in *AccessControl_RoleBindings, opts ...grpc.CallOption) (*protos.Void, error) {
+	out := new(protos.Void)
+	err := c.cc.Invoke(ctx, "/magma.orc8r.accessd.AccessControlManager/SetOperatorRoles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accessControlManagerClient) GetOperatorRoles(ctx context.Context, in *protos.Identity, opts ...grpc.CallOption) (*AccessControl_RoleBindings, error) {
+	out := new(AccessControl_RoleBindings)
+	err := c.cc.Invoke(ctx, "/magma.orc8r.accessd.AccessControlManager/GetOperatorRoles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accessControlManagerClient) GetOperatorEffectiveACL(ctx context.Context, in *protos.Identity, opts ...grpc.CallOption) (*AccessControl_List, error) {
+	out := new(AccessControl_List)
+	err := c.cc.Invoke(ctx, "/magma.orc8r.accessd.AccessControlManager/GetOperatorEffectiveACL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AccessControlManagerServer is the server API for AccessControlManager service.
 type AccessControlManagerServer interface {
 	// Overwrites Permissions for operator Identity to manage others
@@ -484,6 +805,22 @@ type AccessControlManagerServer interface {
 	ListOperators(context.Context, *protos.Void) (*protos.Identity_List, error)
 	// Cleanup a given entity from all Operators' ACLs
 	DeleteEntity(context.Context, *protos.Identity) (*protos.Void, error)
+	// Creates or overwrites a role
+	SetRole(context.Context, *AccessControl_Role) (*protos.Void, error)
+	// Returns the role with the given name
+	GetRole(context.Context, *AccessControl_RoleName) (*AccessControl_Role, error)
+	// Removes a role, fails if the role is bound to any operator
+	DeleteRole(context.Context, *AccessControl_RoleName) (*protos.Void, error)
+	// Lists all roles
+	ListRoles(context.Context, *protos.Void) (*AccessControl_Roles, error)
+	// Overwrites the operator's role bindings, bound roles must exist
+	SetOperatorRoles(context.Context, *AccessControl_RoleBindings) (*protos.Void, error)
+	// Returns the operator's role bindings
+	GetOperatorRoles(context.Context, *protos.Identity) (*AccessControl_RoleBindings, error)
+	// Returns the operator's effective ACL: the operator's own ACL merged with
+	// the permissions of all the operator's roles. GetPermissions &
+	// CheckPermissions use the effective ACL.
+	GetOperatorEffectiveACL(context.Context, *protos.Identity) (*AccessControl_List, error)
 }
 
 func RegisterAccessControlManagerServer(s *grpc.Server, srv AccessControlManagerServer) {
@@ -652,6 +989,132 @@ func _AccessControlManager_DeleteEntity_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AccessControlManager_SetRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccessControl_Role)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccessControlManagerServer).SetRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.orc8r.accessd.AccessControlManager/SetRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccessControlManagerServer).SetRole(ctx, req.(*AccessControl_Role))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccessControlManager_GetRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccessControl_RoleName)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccessControlManagerServer).GetRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.orc8r.accessd.AccessControlManager/GetRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccessControlManagerServer).GetRole(ctx, req.(*AccessControl_RoleName))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccessControlManager_DeleteRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccessControl_RoleName)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccessControlManagerServer).DeleteRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.orc8r.accessd.AccessControlManager/DeleteRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccessControlManagerServer).DeleteRole(ctx, req.(*AccessControl_RoleName))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccessControlManager_ListRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(protos.Void)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccessControlManagerServer).ListRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.orc8r.accessd.AccessControlManager/ListRoles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccessControlManagerServer).ListRoles(ctx, req.(*protos.Void))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccessControlManager_SetOperatorRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccessControl_RoleBindings)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccessControlManagerServer).SetOperatorRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.orc8r.accessd.AccessControlManager/SetOperatorRoles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccessControlManagerServer).SetOperatorRoles(ctx, req.(*AccessControl_RoleBindings))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccessControlManager_GetOperatorRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(protos.Identity)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccessControlManagerServer).GetOperatorRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.orc8r.accessd.AccessControlManager/GetOperatorRoles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccessControlManagerServer).GetOperatorRoles(ctx, req.(*protos.Identity))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccessControlManager_GetOperatorEffectiveACL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(protos.Identity)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccessControlManagerServer).GetOperatorEffectiveACL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.orc8r.accessd.AccessControlManager/GetOperatorEffectiveACL",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccessControlManagerServer).GetOperatorEffectiveACL(ctx, req.(*protos.Identity))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _AccessControlManager_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "magma.orc8r.accessd.AccessControlManager",
 	HandlerType: (*AccessControlManagerServer)(nil),
@@ -692,47 +1155,90 @@ var _AccessControlManager_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteEntity",
 			Handler:    _AccessControlManager_DeleteEntity_Handler,
 		},
+		{
+			MethodName: "SetRole",
+			Handler:    _AccessControlManager_SetRole_Handler,
+		},
+		{
+			MethodName: "GetRole",
+			Handler:    _AccessControlManager_GetRole_Handler,
+		},
+		{
+			MethodName: "DeleteRole",
+			Handler:    _AccessControlManager_DeleteRole_Handler,
+		},
+		{
+			MethodName: "ListRoles",
+			Handler:    _AccessControlManager_ListRoles_Handler,
+		},
+		{
+			MethodName: "SetOperatorRoles",
+			Handler:    _AccessControlManager_SetOperatorRoles_Handler,
+		},
+		{
+			MethodName: "GetOperatorRoles",
+			Handler:    _AccessControlManager_GetOperatorRoles_Handler,
+		},
+		{
+			MethodName: "GetOperatorEffectiveACL",
+			Handler:    _AccessControlManager_GetOperatorEffectiveACL_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "access.proto",
 }
 
-func init() { proto.RegisterFile("access.proto", fileDescriptor_access_599443aad1afe218) }
-
-var fileDescriptor_access_599443aad1afe218 = []byte{
-	// 531 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xe1, 0x6b, 0xd3, 0x40,
-	0x14, 0x4f, 0xba, 0xb5, 0x74, 0x2f, 0x6b, 0x89, 0x4f, 0x85, 0x7a, 0x7e, 0x19, 0x01, 0xb1, 0x22,
-	0xcb, 0xb0, 0x32, 0x18, 0x53, 0xd0, 0xda, 0x06, 0x19, 0xd4, 0x55, 0x4f, 0xe7, 0x60, 0xdf, 0x62,
-	0x72, 0xce, 0xb0, 0x26, 0x57, 0x73, 0xb7, 0x41, 0xbf, 0xf9, 0xcd, 0x7f, 0xd8, 0x8f, 0x22, 0x92,
-	0xbb, 0xac, 0x4d, 0x58, 0x37, 0x52, 0xdd, 0xa7, 0x5c, 0xee, 0xfd, 0x7e, 0xbf, 0xf7, 0x7b, 0xef,
-	0x5d, 0x2e, 0xb0, 0xe9, 0x07, 0x01, 0x13, 0xc2, 0x9d, 0xa6, 0x5c, 0x72, 0xbc, 0x1b, 0xfb, 0xa7,
-	0xb1, 0xef, 0xf2, 0x34, 0xd8, 0x4b, 0x5d, 0x1d, 0x09, 0xc9, 0x03, 0xf5, 0xba, 0xa3, 0x10, 0x62,
-	0x27, 0xe0, 0x71, 0xcc, 0x13, 0x8d, 0x27, 0x0f, 0x4b, 0xa1, 0x28, 0x64, 0x89, 0x8c, 0xe4, 0x4c,
-	0x07, 0x9d, 0x3f, 0x75, 0x68, 0xf5, 0x95, 0xc6, 0x80, 0x27, 0x32, 0xe5, 0x13, 0xf2, 0xc3, 0x84,
-	0x86, 0xa7, 0x20, 0xf8, 0x08, 0x6a, 0x51, 0xd8, 0x31, 0xb7, 0xcc, 0xae, 0xd5, 0xbb, 0xef, 0x16,
-	0xd3, 0x1e, 0xe4, 0x2a, 0xb4, 0x16, 0x85, 0x38, 0x06, 0x6b, 0xca, 0xd2, 0x38, 0x12, 0x22, 0xe2,
-	0x89, 0xe8, 0xd4, 0xb6, 0xcc, 0x6e, 0xbb, 0xb7, 0xed, 0x2e, 0xb1, 0xe9, 0x96, 0x52, 0xb9, 0xef,
-	0xe7, 0x2c, 0x5a, 0x54, 0x20, 0xbf, 0x4c, 0x58, 0x1f, 0x45, 0x42, 0xe2, 0x33, 0x68, 0xf2, 0x29,
-	0x4b, 0x7d, 0xc9, 0xd3, 0x9b, 0x6d, 0xcc, 0x61, 0xf8, 0x01, 0x9a, 0x6a, 0x2f, 0x62, 0x99, 0x93,
-	0xb5, 0xae, 0xd5, 0xdb, 0xad, 0xe0, 0x24, 0xcb, 0xe6, 0x7a, 0x39, 0xcf, 0x4b, 0x64, 0x3a, 0xa3,
-	0x73, 0x19, 0xf2, 0x15, 0x5a, 0xa5, 0x10, 0xda, 0xb0, 0x76, 0xc6, 0x66, 0xca, 0xd1, 0x06, 0xcd,
-	0x96, 0xf8, 0x0a, 0xea, 0x17, 0xfe, 0xe4, 0x9c, 0xa9, 0xe2, 0xad, 0xde, 0x93, 0x0a, 0x29, 0x75,
-	0x8f, 0xa9, 0xe6, 0xed, 0xd7, 0xf6, 0x4c, 0xf2, 0xd3, 0x04, 0x2b, 0x33, 0x42, 0xd9, 0xf7, 0x73,
-	0xf6, 0x6f, 0xd5, 0x7b, 0x57, 0xaa, 0x5f, 0xc1, 0xca, 0xa2, 0xe2, 0x0b, 0xc0, 0xc5, 0x6c, 0xc4,
-	0x7f, 0xf8, 0xd9, 0x86, 0x86, 0xde, 0xcb, 0x1b, 0x73, 0x0d, 0x21, 0x07, 0x91, 0x21, 0xd4, 0xb3,
-	0x06, 0x08, 0x7c, 0x01, 0xeb, 0x7e, 0x30, 0x11, 0x1d, 0x53, 0xd5, 0xf0, 0xb8, 0xe2, 0x04, 0xa9,
-	0x22, 0x39, 0x4f, 0x01, 0x16, 0xee, 0xb1, 0x09, 0xeb, 0x87, 0xe3, 0x43, 0xcf, 0x36, 0xb2, 0x15,
-	0xf5, 0xfa, 0x43, 0xdb, 0xc4, 0x0d, 0xa8, 0x1f, 0xd3, 0x83, 0x4f, 0x9e, 0x5d, 0xeb, 0xfd, 0xae,
-	0xc3, 0xbd, 0x92, 0xd2, 0x3b, 0x3f, 0xf1, 0x4f, 0x59, 0x8a, 0x14, 0xac, 0x8f, 0x4c, 0x8e, 0x2f,
-	0x2b, 0x71, 0xab, 0x7a, 0xd0, 0xcd, 0x22, 0x77, 0x4a, 0xf8, 0xcf, 0x3c, 0x0a, 0x1d, 0x03, 0x8f,
-	0xa0, 0x7d, 0x34, 0x0d, 0x7d, 0xc9, 0x6e, 0x57, 0xf6, 0x25, 0xb4, 0x87, 0x6c, 0xc2, 0x0a, 0xb2,
-	0xcb, 0xfb, 0xbc, 0x9c, 0x4d, 0xa1, 0xfd, 0x76, 0x51, 0x68, 0x7f, 0x30, 0xba, 0x8e, 0x5d, 0x75,
-	0x0c, 0x8e, 0x81, 0x27, 0x60, 0x17, 0x34, 0x45, 0x7f, 0x30, 0x12, 0x48, 0x96, 0xaa, 0x2a, 0x06,
-	0xe9, 0x56, 0x94, 0x16, 0x8e, 0x81, 0x52, 0xf9, 0x2d, 0x9c, 0x4f, 0xdc, 0x5d, 0xe9, 0xae, 0xb9,
-	0x3c, 0xcf, 0xa4, 0xfa, 0xa7, 0xe1, 0x18, 0x78, 0x0c, 0xf6, 0xe0, 0x1b, 0x0b, 0xce, 0x8a, 0x79,
-	0x6f, 0x65, 0x78, 0xaf, 0xa1, 0x95, 0x61, 0xe6, 0xbd, 0xc2, 0xab, 0x28, 0x72, 0x43, 0xeb, 0x1c,
-	0x03, 0xf7, 0x61, 0x53, 0x8f, 0x3f, 0xbf, 0xb6, 0x57, 0x18, 0xfe, 0x9b, 0xe6, 0x49, 0x43, 0xff,
-	0x18, 0xbe, 0xe8, 0xe7, 0xf3, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x3e, 0x04, 0xce, 0x33, 0x6d,
-	0x06, 0x00, 0x00,
+func init() { proto.RegisterFile("access.proto", fileDescriptor_access_5d61c124f297e849) }
+
+var fileDescriptor_access_5d61c124f297e849 = []byte{
+	// 770 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x5f, 0x4f, 0xdb, 0x48,
+	0x10, 0xb7, 0x43, 0x02, 0xc9, 0x18, 0xa2, 0xdc, 0x70, 0xa7, 0xcb, 0xed, 0x49, 0x27, 0x64, 0xe9,
+	0x74, 0x39, 0x21, 0x8c, 0x2e, 0x27, 0x24, 0x44, 0x8b, 0xda, 0x10, 0x5c, 0x04, 0xa2, 0x81, 0x2e,
+	0xa5, 0x54, 0xb4, 0x6a, 0x65, 0xec, 0x85, 0x5a, 0x24, 0x76, 0xea, 0x35, 0x54, 0xbc, 0xf5, 0xad,
+	0x4f, 0xfd, 0x04, 0xfd, 0x64, 0x7d, 0xee, 0x57, 0xe8, 0x07, 0xa8, 0x76, 0xd7, 0x24, 0x8e, 0x30,
+	0xc8, 0x49, 0x79, 0xca, 0xfe, 0x99, 0xdf, 0x6f, 0x7e, 0x33, 0xb3, 0x33, 0x31, 0xcc, 0x3a, 0xae,
+	0xcb, 0x38, 0xb7, 0xfa, 0x51, 0x18, 0x87, 0x38, 0xdf, 0x73, 0xce, 0x7a, 0x8e, 0x15, 0x46, 0xee,
+	0x6a, 0x64, 0xa9, 0x1b, 0x8f, 0xfc, 0x21, 0xb7, 0xcb, 0xd2, 0x82, 0x2f, 0xbb, 0x61, 0xaf, 0x17,
+	0x06, 0xca, 0x9e, 0xfc, 0x39, 0x72, 0xe5, 0x7b, 0x2c, 0x88, 0xfd, 0xf8, 0x4a, 0x5d, 0x9a, 0x5f,
+	0x00, 0xe6, 0x5a, 0x92, 0xa3, 0x1d, 0x06, 0x71, 0x14, 0x76, 0xc9, 0x47, 0x1d, 0xa6, 0x6d, 0x69,
+	0x82, 0x7f, 0x43, 0xc1, 0xf7, 0xea, 0xfa, 0x82, 0xde, 0x30, 0x9a, 0xbf, 0x59, 0x69, 0xb7, 0xdb,
+	0x09, 0x0b, 0x2d, 0xf8, 0x1e, 0xee, 0x81, 0xd1, 0x67, 0x51, 0xcf, 0xe7, 0xdc, 0x0f, 0x03, 0x5e,
+	0x2f, 0x2c, 0xe8, 0x8d, 0x6a, 0x73, 0xc9, 0xca, 0x90, 0x69, 0x8d, 0xb8, 0xb2, 0xf6, 0x07, 0x28,
+	0x9a, 0x66, 0x20, 0xdf, 0x75, 0x28, 0xee, 0xfa, 0x3c, 0xc6, 0xff, 0xa0, 0x1c, 0xf6, 0x59, 0xe4,
+	0xc4, 0x61, 0x74, 0xb7, 0x8c, 0x81, 0x19, 0x3e, 0x83, 0xb2, 0x3c, 0xf3, 0x99, 0x50, 0x32, 0xd5,
+	0x30, 0x9a, 0x2b, 0x39, 0x94, 0x08, 0x6f, 0x96, 0x9d, 0xe0, 0xec, 0x20, 0x8e, 0xae, 0xe8, 0x80,
+	0x86, 0x9c, 0xc2, 0xdc, 0xc8, 0x15, 0xd6, 0x60, 0xea, 0x9c, 0x5d, 0x49, 0x45, 0x15, 0x2a, 0x96,
+	0xf8, 0x08, 0x4a, 0x97, 0x4e, 0xf7, 0x82, 0xc9, 0xe0, 0x8d, 0xe6, 0xbf, 0x39, 0x5c, 0xaa, 0x1c,
+	0x53, 0x85, 0x5b, 0x2b, 0xac, 0xea, 0xe4, 0x93, 0x0e, 0x86, 0x10, 0x42, 0xd9, 0xfb, 0x0b, 0x36,
+	0x59, 0xf4, 0xf6, 0x8d, 0xe8, 0xc7, 0x90, 0x32, 0x8c, 0xf8, 0x12, 0x70, 0x58, 0x1b, 0xfe, 0x13,
+	0x7a, 0x96, 0x60, 0x5a, 0x9d, 0xd5, 0x0b, 0x77, 0x01, 0x12, 0x23, 0xb2, 0x09, 0x25, 0x91, 0x00,
+	0x8e, 0x0f, 0xa0, 0xe8, 0xb8, 0x5d, 0x5e, 0xd7, 0x65, 0x0c, 0xff, 0xe4, 0xac, 0x20, 0x95, 0x20,
+	0xf2, 0x4d, 0x87, 0x22, 0x0d, 0xbb, 0x0c, 0x11, 0x8a, 0x81, 0xd3, 0x63, 0x49, 0xa1, 0xe4, 0x1a,
+	0x17, 0xc0, 0xf0, 0x18, 0x77, 0x23, 0xbf, 0x1f, 0xfb, 0x61, 0x20, 0x65, 0x55, 0x68, 0xfa, 0x68,
+	0x24, 0x87, 0x53, 0x13, 0xe7, 0x10, 0xdf, 0xc0, 0x7c, 0xc0, 0xe2, 0x0f, 0x61, 0x74, 0xfe, 0x36,
+	0xdd, 0x1d, 0xc5, 0x49, 0xba, 0x03, 0x13, 0xa6, 0xfd, 0x54, 0x93, 0xfc, 0x05, 0x65, 0x11, 0x64,
+	0xc7, 0xe9, 0x65, 0x06, 0x4a, 0x9e, 0x40, 0x49, 0xdc, 0x73, 0x5c, 0x87, 0x52, 0x24, 0x16, 0x63,
+	0x24, 0x53, 0x00, 0xa9, 0x42, 0x91, 0x75, 0x30, 0xc4, 0x76, 0xc3, 0x0f, 0x3c, 0x3f, 0x38, 0x13,
+	0xae, 0xc4, 0xf9, 0xb5, 0x2b, 0xb1, 0x46, 0x02, 0xe5, 0x44, 0xa0, 0x7a, 0x75, 0x15, 0x3a, 0xd8,
+	0x93, 0xcf, 0x3a, 0xcc, 0xa6, 0xf0, 0x7c, 0x92, 0x57, 0xb4, 0x03, 0xe5, 0x93, 0x04, 0x9e, 0xbc,
+	0x6a, 0x2b, 0x67, 0x10, 0x89, 0x57, 0x3a, 0xc0, 0x9b, 0x8b, 0x00, 0xc3, 0x2c, 0x62, 0x19, 0x8a,
+	0x9d, 0xbd, 0x8e, 0x5d, 0xd3, 0xc4, 0x8a, 0xda, 0xad, 0xcd, 0x9a, 0x8e, 0x15, 0x28, 0x1d, 0xd1,
+	0xed, 0xe7, 0x76, 0xad, 0xd0, 0xfc, 0x0a, 0xf0, 0xeb, 0x08, 0xe9, 0x53, 0x27, 0x70, 0xce, 0x58,
+	0x84, 0x14, 0x8c, 0x03, 0x16, 0xef, 0x5d, 0x0b, 0xb4, 0xf2, 0x3e, 0x50, 0xd5, 0x49, 0xe4, 0x97,
+	0x11, 0xfb, 0x17, 0xa1, 0xef, 0x99, 0x1a, 0x1e, 0x42, 0xf5, 0xb0, 0xef, 0x39, 0x31, 0xbb, 0x5f,
+	0xda, 0x87, 0x50, 0xdd, 0x64, 0x5d, 0x96, 0xa2, 0xcd, 0xce, 0x77, 0x36, 0x9a, 0x42, 0x75, 0x6b,
+	0x18, 0x68, 0xab, 0xbd, 0x7b, 0x1b, 0x3a, 0x6f, 0x8f, 0x9a, 0x1a, 0x1e, 0x43, 0x2d, 0xc5, 0xc9,
+	0x5b, 0xed, 0x5d, 0x8e, 0x24, 0x93, 0x55, 0x22, 0x48, 0x23, 0x27, 0x35, 0x37, 0x35, 0x8c, 0xa5,
+	0xde, 0x54, 0x9f, 0xe0, 0xca, 0x58, 0xad, 0x76, 0x3d, 0xec, 0x48, 0xfe, 0x9e, 0x37, 0x35, 0x3c,
+	0x82, 0x5a, 0xfb, 0x1d, 0x73, 0xd3, 0xfd, 0x79, 0x3f, 0xc5, 0x7b, 0x0c, 0x73, 0xc2, 0x66, 0x90,
+	0x2b, 0xbc, 0x69, 0x45, 0xee, 0x48, 0x9d, 0xa9, 0xe1, 0x1a, 0xcc, 0xaa, 0xf2, 0x27, 0xff, 0xe9,
+	0xe3, 0x14, 0x7f, 0x0b, 0x66, 0x0e, 0x58, 0x2c, 0x47, 0x69, 0xde, 0xa9, 0x91, 0x4d, 0xe4, 0xc0,
+	0xcc, 0x56, 0x42, 0xb4, 0x98, 0x93, 0x48, 0xcc, 0x35, 0x92, 0xd7, 0xab, 0xa9, 0x61, 0x07, 0x40,
+	0xc5, 0x39, 0xbe, 0x97, 0x4c, 0xc9, 0x3b, 0x50, 0x91, 0xd5, 0x91, 0x23, 0x34, 0x23, 0xeb, 0x8d,
+	0x9c, 0x1e, 0xc4, 0xa3, 0x7c, 0x09, 0xb5, 0xd4, 0xb4, 0x50, 0x94, 0xcb, 0xe3, 0x4d, 0x30, 0x9e,
+	0xad, 0xf2, 0xf5, 0x48, 0x2b, 0x29, 0xe6, 0x5b, 0x2a, 0x3c, 0xae, 0x43, 0x53, 0xc3, 0x57, 0xf0,
+	0x7b, 0x8a, 0xdd, 0x3e, 0x3d, 0x65, 0x6e, 0xec, 0x5f, 0xb2, 0x7b, 0x99, 0x02, 0x1b, 0xe5, 0xe3,
+	0x69, 0xf5, 0x49, 0x7a, 0xa2, 0x7e, 0xff, 0xff, 0x31, 0x00, 0xd2, 0x04, 0xdf, 0xb0, 0xe7, 0x0a,
+	0x00, 0x00,
 }
@@ -0,0 +1,129 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package accessd_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"magma/orc8r/cloud/go/identity"
+	"magma/orc8r/cloud/go/services/accessd"
+	accessprotos "magma/orc8r/cloud/go/services/accessd/protos"
+	accessd_test_service "magma/orc8r/cloud/go/services/accessd/test_init"
+)
+
+func TestRoles(t *testing.T) {
+	accessd_test_service.StartTestService(t)
+
+	netAdmin := &accessprotos.AccessControl_Role{
+		Name:        "network-admin",
+		Description: "Manage networks & all gateways",
+		Entities: []*accessprotos.AccessControl_Entity{
+			{Id: identity.NewGatewayWildcard(), Permissions: accessprotos.ACCESS_CONTROL_ALL_PERMISSIONS},
+		},
+		NetworkPermissions: accessprotos.ACCESS_CONTROL_ALL_PERMISSIONS,
+	}
+	viewer := &accessprotos.AccessControl_Role{
+		Name:               "viewer",
+		NetworkPermissions: accessprotos.AccessControl_READ,
+	}
+	assert.NoError(t, accessd.SetRole(netAdmin))
+	assert.NoError(t, accessd.SetRole(viewer))
+	assert.Error(t, accessd.SetRole(&accessprotos.AccessControl_Role{}))
+
+	role, err := accessd.GetRole("network-admin")
+	assert.NoError(t, err)
+	assert.Equal(t, netAdmin.Description, role.Description)
+	assert.Len(t, role.Entities, 1)
+	_, err = accessd.GetRole("unknown")
+	assert.Error(t, err)
+
+	roles, err := accessd.ListRoles()
+	assert.NoError(t, err)
+	assert.Len(t, roles, 2)
+	assert.Equal(t, "network-admin", roles[0].Name)
+	assert.Equal(t, "viewer", roles[1].Name)
+
+	netA, netB, netC := identity.NewNetwork("A"), identity.NewNetwork("B"), identity.NewNetwork("C")
+	gw, gwB := identity.NewGateway("hw1", "A", "gw1"), identity.NewGateway("hw2", "B", "gw2")
+
+	// Operator with roles only, no ACL
+	engineer := identity.NewOperator("engineer")
+	assert.Error(t, accessd.CheckReadPermission(engineer, netA))
+	assert.Error(t, accessd.SetOperatorRoles(
+		engineer, []*accessprotos.AccessControl_RoleBinding{{Role: "unknown", Networks: []string{"A"}}}))
+	assert.NoError(t, accessd.SetOperatorRoles(engineer, []*accessprotos.AccessControl_RoleBinding{
+		{Role: "network-admin", Networks: []string{"A", "B"}},
+		{Role: "viewer", Networks: []string{"C"}},
+	}))
+	bindings, err := accessd.GetOperatorRoles(engineer)
+	assert.NoError(t, err)
+	assert.Len(t, bindings, 2)
+
+	assert.NoError(t, accessd.CheckWritePermission(engineer, netA, netB, gw, gwB))
+	assert.NoError(t, accessd.CheckReadPermission(engineer, netA, netB, netC))
+	assert.Error(t, accessd.CheckWritePermission(engineer, netC))
+	assert.Error(t, accessd.CheckReadPermission(engineer, identity.NewNetwork("D")))
+	// The role's gateway wildcard only grants the gateways of bound networks
+	assert.Error(t, accessd.CheckReadPermission(engineer, identity.NewGateway("hw3", "C", "gw3")))
+	assert.Error(t, accessd.CheckReadPermission(engineer, identity.NewGateway("hw4", "D", "gw4")))
+	assert.Error(t, accessd.CheckReadPermission(engineer, identity.NewOperatorWildcard()))
+	perm, err := accessd.GetPermissions(engineer, netC)
+	assert.NoError(t, err)
+	assert.Equal(t, accessprotos.AccessControl_READ, perm)
+
+	acl, err := accessd.GetOperatorEffectiveACL(engineer)
+	assert.NoError(t, err)
+	// Gateway wildcards of A & B, networks A, B & C
+	assert.Len(t, acl, 5)
+	_, err = accessd.GetOperatorACL(engineer)
+	assert.Error(t, err)
+
+	// Roles are ORed with the operator's own ACL
+	auditor := identity.NewOperator("auditor")
+	assert.NoError(t, accessd.SetOperator(auditor, []*accessprotos.AccessControl_Entity{
+		{Id: netC, Permissions: accessprotos.AccessControl_WRITE},
+	}))
+	assert.NoError(t, accessd.SetOperatorRoles(auditor, []*accessprotos.AccessControl_RoleBinding{
+		{Role: "viewer", Networks: []string{"*"}},
+	}))
+	assert.NoError(t, accessd.CheckPermissions(auditor, &accessprotos.AccessControl_Entity{
+		Id: netC, Permissions: accessprotos.ACCESS_CONTROL_ALL_PERMISSIONS}))
+	assert.NoError(t, accessd.CheckReadPermission(auditor, netA, identity.NewNetwork("D")))
+	assert.Error(t, accessd.CheckWritePermission(auditor, netA))
+
+	// Role updates apply to all bound operators
+	viewer.NetworkPermissions = accessprotos.ACCESS_CONTROL_ALL_PERMISSIONS
+	assert.NoError(t, accessd.SetRole(viewer))
+	assert.NoError(t, accessd.CheckWritePermission(auditor, netA))
+	assert.NoError(t, accessd.CheckWritePermission(engineer, netC))
+
+	// Bound roles cannot be deleted
+	assert.Error(t, accessd.DeleteRole("viewer"))
+	assert.NoError(t, accessd.SetOperatorRoles(engineer, []*accessprotos.AccessControl_RoleBinding{
+		{Role: "network-admin", Networks: []string{"A"}},
+	}))
+	assert.Error(t, accessd.CheckReadPermission(engineer, netB))
+	assert.Error(t, accessd.CheckReadPermission(engineer, gwB))
+	assert.NoError(t, accessd.CheckWritePermission(engineer, gw))
+	assert.NoError(t, accessd.DeleteOperator(auditor))
+	bindings, err = accessd.GetOperatorRoles(auditor)
+	assert.NoError(t, err)
+	assert.Empty(t, bindings)
+	assert.NoError(t, accessd.DeleteRole("viewer"))
+	assert.Error(t, accessd.DeleteRole("viewer"))
+
+	assert.NoError(t, accessd.SetOperatorRoles(engineer, nil))
+	assert.Error(t, accessd.CheckReadPermission(engineer, netA))
+	assert.NoError(t, accessd.DeleteRole("network-admin"))
+	roles, err = accessd.ListRoles()
+	assert.NoError(t, err)
+	assert.Empty(t, roles)
+}
@@ -91,6 +91,10 @@ func (srv *AccessControlServer) DeleteOperator(ctx context.Context, oper *protos
 	if err != nil {
 		return &protos.Void{}, status.Errorf(codes.NotFound, "Operator %s Delete from table %s error: %s", opkey, table, err)
 	}
+	err = srv.store.Delete(ROLE_BINDINGS_TABLE, opkey)
+	if err != nil {
+		return &protos.Void{}, status.Errorf(codes.Unknown, "Operator %s Delete from table %s error: %s", opkey, ROLE_BINDINGS_TABLE, err)
+	}
 	return &protos.Void{}, nil
 }
 
@@ -116,8 +120,9 @@ func (srv *AccessControlServer) GetPermissions(
 	return srv.getACLEntity(req)
 }
 
-// Returns the managing Identity's permissions for a given entity
-// NOTE: Takes into account wildcards for the entity's type in the ACL
+// CheckPermissions verifies Operator permissions for a list of given entities
+// NOTE: Takes into account wildcards for the entity's type in the ACL as well
+// as the permissions of the Operator's roles
 func (srv *AccessControlServer) CheckPermissions(
 	ctx context.Context,
 	req *accessprotos.AccessControl_ListRequest,
@@ -128,7 +133,7 @@ func (srv *AccessControlServer) CheckPermissions(
 	if err != nil {
 		return voidRes, err
 	}
-	acl, err := srv.getACL(req.Operator)
+	acl, err := srv.getEffectiveACL(req.Operator)
 	if err != nil {
 		return voidRes, err
 	}
@@ -208,7 +208,7 @@ func (srv *AccessControlServer) getACLEntity(
 	if err != nil {
 		return res, err
 	}
-	acl, err := srv.getACL(req.Operator)
+	acl, err := srv.getEffectiveACL(req.Operator)
 	if err != nil {
 		return res, err
 	}
@@ -224,6 +224,9 @@ func (srv *AccessControlServer) getACLEntity(
 // exact Identity match (if present):
 //     perm = permissions[Id Type Wildcard] | permissions[Id Of Entity]
 //
+// Gateways' permissions also include the permissions of the Gateway wildcard
+// scoped to their network.
+//
 // getEntityPermissions will return AccessControl_NONE if the entity's identity
 // is not in the list and the list doesn't have a corresponding to the entity
 // type wildcard.
@@ -240,6 +243,13 @@ func getEntityPermissions(
 				res = ent.Permissions
 			}
 		}
+		if gw := entity.GetGateway(); gw != nil && len(gw.NetworkId) > 0 {
+			hash := protos.NewNetworkGatewayWildcardIdentity(gw.NetworkId).HashString()
+			ent, ok := acl.Entities[hash]
+			if ok && ent.Id.Match(entity) {
+				res |= ent.Permissions
+			}
+		}
 		hash := entity.HashString()
 		ent, ok := acl.Entities[hash]
 		if ok {
@@ -0,0 +1,348 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package servicers
+
+import (
+	"sort"
+
+	"magma/orc8r/cloud/go/datastore"
+	"magma/orc8r/cloud/go/identity"
+	"magma/orc8r/cloud/go/protos"
+	accessprotos "magma/orc8r/cloud/go/services/accessd/protos"
+
+	"github.com/golang/glog"
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	ROLES_TABLE         = "access_roles"
+	ROLE_BINDINGS_TABLE = "access_role_bindings"
+
+	// AllNetworks is the role binding network matching all networks
+	AllNetworks = "*"
+)
+
+// SetRole creates or overwrites a role
+func (srv *AccessControlServer) SetRole(ctx context.Context, role *accessprotos.AccessControl_Role) (*protos.Void, error) {
+	if err := verifyRole(role); err != nil {
+		return &protos.Void{}, err
+	}
+	marshaledRole, err := proto.Marshal(role)
+	if err != nil {
+		return &protos.Void{}, protos.Errorf(codes.Unknown, "Role %s Marshal error: %s", role.Name, err)
+	}
+	err = srv.store.Put(ROLES_TABLE, role.Name, marshaledRole)
+	if err != nil {
+		return &protos.Void{}, protos.Errorf(
+			codes.Unknown, "Role PUT error '%s' for Role %s, table %s", err, role.Name, ROLES_TABLE)
+	}
+	return &protos.Void{}, nil
+}
+
+// GetRole returns the role with the given name
+func (srv *AccessControlServer) GetRole(ctx context.Context, name *accessprotos.AccessControl_RoleName) (*accessprotos.AccessControl_Role, error) {
+	if name == nil || len(name.Name) == 0 {
+		return &accessprotos.AccessControl_Role{}, protos.Errorf(codes.InvalidArgument, "Empty Role Name")
+	}
+	return srv.getRole(name.Name)
+}
+
+// DeleteRole removes a role, it fails if the role is bound to any operator
+func (srv *AccessControlServer) DeleteRole(ctx context.Context, name *accessprotos.AccessControl_RoleName) (*protos.Void, error) {
+	if name == nil || len(name.Name) == 0 {
+		return &protos.Void{}, protos.Errorf(codes.InvalidArgument, "Empty Role Name")
+	}
+	if _, err := srv.getRole(name.Name); err != nil {
+		return &protos.Void{}, err
+	}
+	allBindings, err := srv.getAllRoleBindings()
+	if err != nil {
+		return &protos.Void{}, err
+	}
+	for _, bindings := range allBindings {
+		for _, binding := range bindings.Bindings {
+			if binding.GetRole() == name.Name {
+				return &protos.Void{}, protos.Errorf(
+					codes.FailedPrecondition, "Role %s is bound to Operator %s",
+					name.Name, bindings.Operator.HashString())
+			}
+		}
+	}
+	err = srv.store.Delete(ROLES_TABLE, name.Name)
+	if err != nil {
+		return &protos.Void{}, protos.Errorf(
+			codes.Unknown, "Role %s Delete from table %s error: %s", name.Name, ROLES_TABLE, err)
+	}
+	return &protos.Void{}, nil
+}
+
+// ListRoles returns all roles sorted by name
+func (srv *AccessControlServer) ListRoles(ctx context.Context, _ *protos.Void) (*accessprotos.AccessControl_Roles, error) {
+	res := &accessprotos.AccessControl_Roles{}
+	names, err := srv.store.ListKeys(ROLES_TABLE)
+	if err != nil {
+		return res, protos.Errorf(codes.Unknown, "Error %s listing table %s keys", err, ROLES_TABLE)
+	}
+	marshaledRoles, err := srv.store.GetMany(ROLES_TABLE, names)
+	if err != nil {
+		return res, protos.Errorf(codes.Unknown, "Get Roles error '%s', table %s", err, ROLES_TABLE)
+	}
+	for name, marshaledRole := range marshaledRoles {
+		role := &accessprotos.AccessControl_Role{}
+		if err = proto.Unmarshal(marshaledRole.Value, role); err != nil {
+			return res, protos.Errorf(
+				codes.Unknown, "Role Unmarshal error '%s' for Role %s from table %s", err, name, ROLES_TABLE)
+		}
+		res.Roles = append(res.Roles, role)
+	}
+	sort.Slice(res.Roles, func(i, j int) bool { return res.Roles[i].Name < res.Roles[j].Name })
+	return res, nil
+}
+
+// SetOperatorRoles overwrites the operator's role bindings
+func (srv *AccessControlServer) SetOperatorRoles(ctx context.Context, bindings *accessprotos.AccessControl_RoleBindings) (*protos.Void, error) {
+	if bindings == nil || bindings.Operator == nil {
+		return &protos.Void{}, protos.Errorf(codes.InvalidArgument, "Nil Operator")
+	}
+	opkey := bindings.Operator.HashString()
+	if len(bindings.Bindings) == 0 {
+		err := srv.store.Delete(ROLE_BINDINGS_TABLE, opkey)
+		if err != nil {
+			return &protos.Void{}, protos.Errorf(
+				codes.Unknown, "Role Bindings Delete error '%s' for Operator %s", err, opkey)
+		}
+		return &protos.Void{}, nil
+	}
+	for i, binding := range bindings.Bindings {
+		if binding == nil || len(binding.Role) == 0 {
+			return &protos.Void{}, protos.Errorf(codes.InvalidArgument, "Invalid Role Binding @ index: %d", i)
+		}
+		for _, network := range binding.Networks {
+			if len(network) == 0 {
+				return &protos.Void{}, protos.Errorf(
+					codes.InvalidArgument, "Empty Network of Role %s Binding", binding.Role)
+			}
+		}
+		if _, err := srv.getRole(binding.Role); err != nil {
+			return &protos.Void{}, err
+		}
+	}
+	marshaledBindings, err := proto.Marshal(bindings)
+	if err != nil {
+		return &protos.Void{}, protos.Errorf(
+			codes.Unknown, "Role Bindings Marshal error '%s' for Operator %s", err, opkey)
+	}
+	err = srv.store.Put(ROLE_BINDINGS_TABLE, opkey, marshaledBindings)
+	if err != nil {
+		return &protos.Void{}, protos.Errorf(
+			codes.Unknown, "Role Bindings PUT error '%s' for Operator %s, table %s",
+			err, opkey, ROLE_BINDINGS_TABLE)
+	}
+	return &protos.Void{}, nil
+}
+
+// GetOperatorRoles returns the operator's role bindings
+func (srv *AccessControlServer) GetOperatorRoles(ctx context.Context, oper *protos.Identity) (*accessprotos.AccessControl_RoleBindings, error) {
+	if oper == nil {
+		return &accessprotos.AccessControl_RoleBindings{}, protos.Errorf(codes.InvalidArgument, "Nil Operator")
+	}
+	return srv.getRoleBindings(oper)
+}
+
+// GetOperatorEffectiveACL returns the operator's ACL merged with the
+// permissions of all the operator's roles
+func (srv *AccessControlServer) GetOperatorEffectiveACL(ctx context.Context, oper *protos.Identity) (*accessprotos.AccessControl_List, error) {
+	return srv.getEffectiveACL(oper)
+}
+
+// getEffectiveACL returns the operator's ACL merged with the permissions of
+// all the operator's roles. An operator without an ACL but with bound roles has
+// the roles' permissions only.
+func (srv *AccessControlServer) getEffectiveACL(oper *protos.Identity) (*accessprotos.AccessControl_List, error) {
+	if oper == nil {
+		return srv.getACL(oper)
+	}
+	bindings, err := srv.getRoleBindings(oper)
+	if err != nil {
+		return &accessprotos.AccessControl_List{}, err
+	}
+	if len(bindings.Bindings) == 0 {
+		return srv.getACL(oper)
+	}
+	acl, err := srv.getACL(oper)
+	if err != nil {
+		if status.Code(err) != codes.NotFound {
+			return acl, err
+		}
+		acl = &accessprotos.AccessControl_List{Operator: oper}
+	}
+	effectiveACL := &accessprotos.AccessControl_List{
+		Operator: acl.Operator,
+		Entities: make(map[string]*accessprotos.AccessControl_Entity, len(acl.Entities)),
+	}
+	for hash, ent := range acl.Entities {
+		effectiveACL.Entities[hash] = ent
+	}
+	for _, binding := range bindings.Bindings {
+		role, err := srv.getRole(binding.Role)
+		if err != nil {
+			glog.Errorf("Skipping Role %s of Operator %s: %s", binding.Role, oper.HashString(), err)
+			continue
+		}
+		mergeIntoACL(effectiveACL, getRoleEntities(role, binding)...)
+	}
+	return effectiveACL, nil
+}
+
+// getRoleEntities returns the entities permissions granted by the role
+// binding. The role's entities are scoped to the binding's networks: Gateway
+// wildcards are qualified by each bound network, Network wildcards are expanded
+// into the bound networks and entities of other networks are dropped. Only
+// bindings to AllNetworks grant the role's entities unscoped, including
+// Operator entities.
+func getRoleEntities(
+	role *accessprotos.AccessControl_Role,
+	binding *accessprotos.AccessControl_RoleBinding,
+) []*accessprotos.AccessControl_Entity {
+	entities := []*accessprotos.AccessControl_Entity{}
+	for _, network := range binding.Networks {
+		if network == AllNetworks {
+			entities = append(entities, role.Entities...)
+			if role.NetworkPermissions != accessprotos.AccessControl_NONE {
+				entities = append(entities, &accessprotos.AccessControl_Entity{
+					Id: identity.NewNetworkWildcard(), Permissions: role.NetworkPermissions})
+			}
+			continue
+		}
+		for _, ent := range role.Entities {
+			if id := scopeToNetwork(ent.Id, network); id != nil {
+				entities = append(entities, &accessprotos.AccessControl_Entity{Id: id, Permissions: ent.Permissions})
+			}
+		}
+		if role.NetworkPermissions != accessprotos.AccessControl_NONE {
+			entities = append(entities, &accessprotos.AccessControl_Entity{
+				Id: identity.NewNetwork(network), Permissions: role.NetworkPermissions})
+		}
+	}
+	return entities
+}
+
+// scopeToNetwork returns the identity of the role entity granted within the
+// network, nil if the entity is outside of the network
+func scopeToNetwork(id *protos.Identity, network string) *protos.Identity {
+	switch v := id.Value.(type) {
+	case *protos.Identity_Wildcard_:
+		switch v.Wildcard.GetType() {
+		case protos.Identity_Wildcard_Gateway:
+			if len(v.Wildcard.NetworkId) > 0 && v.Wildcard.NetworkId != network {
+				return nil
+			}
+			return identity.NewNetworkGatewayWildcard(network)
+		case protos.Identity_Wildcard_Network:
+			return identity.NewNetwork(network)
+		}
+	case *protos.Identity_Network:
+		if v.Network == network {
+			return id
+		}
+	case *protos.Identity_Gateway_:
+		if v.Gateway.GetNetworkId() == network {
+			return id
+		}
+	}
+	return nil
+}
+
+// mergeIntoACL ORs the entities permissions with the ACL's permissions
+func mergeIntoACL(acl *accessprotos.AccessControl_List, entities ...*accessprotos.AccessControl_Entity) {
+	for _, ent := range entities {
+		if ent == nil || ent.Id == nil {
+			continue
+		}
+		hash := ent.Id.HashString()
+		if existing, ok := acl.Entities[hash]; ok {
+			ent = &accessprotos.AccessControl_Entity{Id: existing.Id, Permissions: existing.Permissions | ent.Permissions}
+		}
+		acl.Entities[hash] = ent
+	}
+}
+
+func (srv *AccessControlServer) getRole(name string) (*accessprotos.AccessControl_Role, error) {
+	role := &accessprotos.AccessControl_Role{}
+	marshaledRole, _, err := srv.store.Get(ROLES_TABLE, name)
+	if err != nil {
+		return role, protos.Errorf(codes.NotFound, "Get Role error '%s' for Role %s, table %s", err, name, ROLES_TABLE)
+	}
+	if err = proto.Unmarshal(marshaledRole, role); err != nil {
+		return role, protos.Errorf(
+			codes.Unknown, "Role Unmarshal error '%s' for Role %s from table %s", err, name, ROLES_TABLE)
+	}
+	return role, nil
+}
+
+// getRoleBindings returns the operator's role bindings, empty bindings if the
+// operator has none
+func (srv *AccessControlServer) getRoleBindings(oper *protos.Identity) (*accessprotos.AccessControl_RoleBindings, error) {
+	bindings := &accessprotos.AccessControl_RoleBindings{Operator: oper}
+	marshaledBindings, _, err := srv.store.Get(ROLE_BINDINGS_TABLE, oper.HashString())
+	if err == datastore.ErrNotFound {
+		return bindings, nil
+	}
+	if err != nil {
+		return bindings, protos.Errorf(
+			codes.Unknown, "Get Role Bindings error '%s' for Operator %s, table %s",
+			err, oper.HashString(), ROLE_BINDINGS_TABLE)
+	}
+	if err = proto.Unmarshal(marshaledBindings, bindings); err != nil {
+		return bindings, protos.Errorf(
+			codes.Unknown, "Role Bindings Unmarshal error '%s' for Operator %s from table %s",
+			err, oper.HashString(), ROLE_BINDINGS_TABLE)
+	}
+	return bindings, nil
+}
+
+func (srv *AccessControlServer) getAllRoleBindings() ([]*accessprotos.AccessControl_RoleBindings, error) {
+	keys, err := srv.store.ListKeys(ROLE_BINDINGS_TABLE)
+	if err != nil {
+		return nil, protos.Errorf(codes.Unknown, "Error %s listing table %s keys", err, ROLE_BINDINGS_TABLE)
+	}
+	marshaledBindings, err := srv.store.GetMany(ROLE_BINDINGS_TABLE, keys)
+	if err != nil {
+		return nil, protos.Errorf(codes.Unknown, "Get Role Bindings error '%s', table %s", err, ROLE_BINDINGS_TABLE)
+	}
+	res := make([]*accessprotos.AccessControl_RoleBindings, 0, len(marshaledBindings))
+	for opkey, marshaled := range marshaledBindings {
+		bindings := &accessprotos.AccessControl_RoleBindings{}
+		if err = proto.Unmarshal(marshaled.Value, bindings); err != nil {
+			return nil, protos.Errorf(
+				codes.Unknown, "Role Bindings Unmarshal error '%s' for Operator %s from table %s",
+				err, opkey, ROLE_BINDINGS_TABLE)
+		}
+		res = append(res, bindings)
+	}
+	return res, nil
+}
+
+func verifyRole(role *accessprotos.AccessControl_Role) error {
+	if role == nil {
+		return protos.Errorf(codes.InvalidArgument, "Nil Role")
+	}
+	if len(role.Name) == 0 {
+		return protos.Errorf(codes.InvalidArgument, "Empty Role Name")
+	}
+	for i, ent := range role.Entities {
+		if ent == nil || ent.Id == nil {
+			return protos.Errorf(codes.InvalidArgument, "Invalid Role %s Entity @ index: %d", role.Name, i)
+		}
+	}
+	return nil
+}
@@ -10,7 +10,10 @@ package main
 
 import (
 	"crypto/rsa"
+	"crypto/x509"
 	"flag"
+	"fmt"
+	"io/ioutil"
 	"log"
 
 	"magma/orc8r/cloud/go/orc8r"
@@ -22,7 +25,9 @@ import (
 )
 
 var (
-	keyFile = flag.String("cak", "bootstrapper.key.pem", "Bootstrapper's Private Key file")
+	keyFile      = flag.String("cak", "bootstrapper.key.pem", "Bootstrapper's Private Key file")
+	tpmRootsFile = flag.String(
+		"tpm_roots", "", "PEM file of trusted TPM attestation key certificate roots, TPM challenge keys are disabled if empty")
 )
 
 func main() {
@@ -37,7 +42,11 @@ func main() {
 	if err != nil {
 		log.Fatalf("Failed to read private key: %s", err)
 	}
-	servicer, err := servicers.NewBootstrapperServer(privKey.(*rsa.PrivateKey))
+	tpmRoots, err := readTPMRoots(*tpmRootsFile)
+	if err != nil {
+		log.Fatalf("Failed to read TPM roots: %s", err)
+	}
+	servicer, err := servicers.NewBootstrapperServer(privKey.(*rsa.PrivateKey), tpmRoots)
 	if err != nil {
 		log.Fatalf("Failed to create bootstrapper servicer: %s", err)
 	}
@@ -50,3 +59,20 @@ func main() {
 		log.Fatalf("Error running service: %s", err)
 	}
 }
+
+// readTPMRoots returns a pool of the certificates in the given PEM file or nil
+// if no file is configured
+func readTPMRoots(rootsFile string) (*x509.CertPool, error) {
+	if len(rootsFile) == 0 {
+		return nil, nil
+	}
+	pemCerts, err := ioutil.ReadFile(rootsFile)
+	if err != nil {
+		return nil, err
+	}
+	roots := x509.NewCertPool()
+	if !roots.AppendCertsFromPEM(pemCerts) {
+		return nil, fmt.Errorf("No certificates found in %s", rootsFile)
+	}
+	return roots, nil
+}
@@ -23,6 +23,7 @@ import (
 	"time"
 
 	"magma/orc8r/cloud/go/protos"
+	"magma/orc8r/cloud/go/security/tpm"
 	"magma/orc8r/cloud/go/services/certifier"
 	"magma/orc8r/cloud/go/services/magmad"
 
@@ -40,14 +41,18 @@ const GatewayCertificateDuration = time.Hour * 97 // 4 days, lifetime of GW Cert
 
 type BootstrapperServer struct {
 	privKey *rsa.PrivateKey
+	// trusted roots of TPM attestation key certificates, TPM2_ATTESTATION_KEY
+	// challenge keys are not supported if nil
+	tpmRoots *x509.CertPool
 }
 
-func NewBootstrapperServer(privKey *rsa.PrivateKey) (*BootstrapperServer, error) {
+func NewBootstrapperServer(privKey *rsa.PrivateKey, tpmRoots *x509.CertPool) (*BootstrapperServer, error) {
 	srv := new(BootstrapperServer)
 	if privKey.N.BitLen() < MinKeyLength {
 		return nil, errorLogger(fmt.Errorf("Private key is too short"))
 	}
 	srv.privKey = privKey
+	srv.tpmRoots = tpmRoots
 	return srv, nil
 }
 
@@ -60,9 +65,7 @@ func (srv *BootstrapperServer) GetChallenge(ctx context.Context, hwId *protos.Ac
 		return nil, errorLogger(status.Errorf(codes.NotFound, "Failed to find gateway record: %s", err))
 	}
 
-	if gatewayRecord.Key.KeyType != protos.ChallengeKey_ECHO &&
-		gatewayRecord.Key.KeyType != protos.ChallengeKey_SOFTWARE_RSA_SHA256 &&
-		gatewayRecord.Key.KeyType != protos.ChallengeKey_SOFTWARE_ECDSA_SHA256 {
+	if !srv.isSupportedKeyType(gatewayRecord.Key.KeyType) {
 		return nil, errorLogger(status.Errorf(codes.Aborted, "Unsupported key type: %s", gatewayRecord.Key.KeyType))
 	}
 
@@ -113,6 +116,8 @@ func (srv *BootstrapperServer) RequestSign(
 		err = verifySoftwareRSASHA256(resp, gatewayRecord.Key.Key)
 	case protos.ChallengeKey_SOFTWARE_ECDSA_SHA256:
 		err = verifySoftwareECDSASHA256(resp, gatewayRecord.Key.Key)
+	case protos.ChallengeKey_TPM2_ATTESTATION_KEY:
+		err = verifyTPM2AttestationKey(resp, gatewayRecord.Key, srv.tpmRoots)
 	default:
 		err = fmt.Errorf("Unsupported key type: %s", gatewayRecord.Key.KeyType)
 	}
@@ -132,6 +137,19 @@ func (srv *BootstrapperServer) RequestSign(
 	return cert, nil
 }
 
+func (srv *BootstrapperServer) isSupportedKeyType(keyType protos.ChallengeKey_KeyType) bool {
+	switch keyType {
+	case protos.ChallengeKey_ECHO,
+		protos.ChallengeKey_SOFTWARE_RSA_SHA256,
+		protos.ChallengeKey_SOFTWARE_ECDSA_SHA256:
+		return true
+	case protos.ChallengeKey_TPM2_ATTESTATION_KEY:
+		return srv.tpmRoots != nil
+	default:
+		return false
+	}
+}
+
 // return the length of signature (number of bytes)
 func (srv *BootstrapperServer) signatureLength() int {
 	keyLength := srv.privKey.N.BitLen()
@@ -245,6 +263,23 @@ func verifySoftwareECDSASHA256(resp *protos.Response, key []byte) error {
 	return nil
 }
 
+// verify response with a TPM quote of the challenge's sha256 hash signed by the
+// attestation key of the enrolled AK certificate
+func verifyTPM2AttestationKey(resp *protos.Response, key *protos.ChallengeKey, roots *x509.CertPool) error {
+	akCert, err := tpm.VerifyAKCertificate(key.Key, key.CertificateChain, roots, time.Now())
+	if err != nil {
+		return err
+	}
+
+	response := resp.GetTpmResponse()
+	if response == nil {
+		return fmt.Errorf("Wrong type of response, expected TPM")
+	}
+
+	hashed := sha256.Sum256(resp.Challenge)
+	return tpm.VerifyQuote(akCert, response.Attest, response.Signature, hashed[:])
+}
+
 func errorLogger(err error) error {
 	log.Printf("Bootstrapper Error: %v", err)
 	return err
@@ -20,6 +20,7 @@ import (
 
 	"magma/orc8r/cloud/go/protos"
 	"magma/orc8r/cloud/go/security/key"
+	tpm_test_utils "magma/orc8r/cloud/go/security/tpm/test_utils"
 	"magma/orc8r/cloud/go/services/bootstrapper/servicers"
 	certifier_test_init "magma/orc8r/cloud/go/services/certifier/test_init"
 	certifier_test_utils "magma/orc8r/cloud/go/services/certifier/test_utils"
@@ -160,6 +161,78 @@ func testWithECDSA(
 	assert.NotNil(t, cert)
 }
 
+func testWithTPM(
+	t *testing.T,
+	networkId string,
+	srv *servicers.BootstrapperServer,
+	sim *tpm_test_utils.Simulator,
+	ctx context.Context) {
+
+	testAgHwId := "test_ag_tpm"
+	_, err := magmad.RegisterGateway(
+		networkId,
+		&magmad_protos.AccessGatewayRecord{
+			HwId: &protos.AccessGatewayID{Id: testAgHwId},
+			Name: "Test GW TPM",
+			Key: &protos.ChallengeKey{
+				KeyType:          protos.ChallengeKey_TPM2_ATTESTATION_KEY,
+				Key:              sim.AKCert.Raw,
+				CertificateChain: [][]byte{sim.Intermediate.Raw}},
+		})
+	assert.NoError(t, err)
+
+	challenge, err := srv.GetChallenge(ctx, &protos.AccessGatewayID{Id: testAgHwId})
+	assert.NoError(t, err)
+	assert.Equal(t, challenge.KeyType, protos.ChallengeKey_TPM2_ATTESTATION_KEY)
+
+	// quote the challenge's hash with the AK
+	hashed := sha256.Sum256(challenge.Challenge)
+	attest, signature, err := sim.Quote(hashed[:])
+	assert.NoError(t, err)
+
+	csr, err := certifier_test_utils.CreateCSR(time.Duration(time.Hour*24*10), "cn", "cn")
+	assert.NoError(t, err)
+	resp := protos.Response{
+		HwId:      &protos.AccessGatewayID{Id: testAgHwId},
+		Challenge: challenge.Challenge,
+		Response: &protos.Response_TpmResponse{
+			TpmResponse: &protos.Response_TPM{Attest: attest, Signature: signature},
+		},
+		Csr: csr,
+	}
+	cert, err := srv.RequestSign(ctx, &resp)
+	assert.NoError(t, err)
+	assert.NotNil(t, cert)
+
+	// quote of a different nonce
+	otherHash := sha256.Sum256([]byte("other challenge"))
+	attest, signature, err = sim.Quote(otherHash[:])
+	assert.NoError(t, err)
+	resp.Response = &protos.Response_TpmResponse{
+		TpmResponse: &protos.Response_TPM{Attest: attest, Signature: signature},
+	}
+	_, err = srv.RequestSign(ctx, &resp)
+	assert.Error(t, err)
+
+	// valid quote signed by an AK which doesn't chain to the trusted TPM roots
+	otherSim, err := tpm_test_utils.NewSimulator("P256")
+	assert.NoError(t, err)
+	attest, signature, err = otherSim.Quote(hashed[:])
+	assert.NoError(t, err)
+	resp.Response = &protos.Response_TpmResponse{
+		TpmResponse: &protos.Response_TPM{Attest: attest, Signature: signature},
+	}
+	_, err = srv.RequestSign(ctx, &resp)
+	assert.Error(t, err)
+
+	// software signature instead of a quote
+	resp.Response = &protos.Response_EcdsaResponse{
+		EcdsaResponse: &protos.Response_ECDSA{R: []byte("12344"), S: []byte("12344")},
+	}
+	_, err = srv.RequestSign(ctx, &resp)
+	assert.Error(t, err)
+}
+
 func testNegative(
 	t *testing.T, networkId string, srv *servicers.BootstrapperServer, ctx context.Context) {
 
@@ -265,13 +338,15 @@ func TestBootstrapperServer(t *testing.T) {
 	// create bootstrapper with short key
 	privateKey, err := key.GenerateKey("", 512)
 	assert.NoError(t, err)
-	_, err = servicers.NewBootstrapperServer(privateKey.(*rsa.PrivateKey))
+	_, err = servicers.NewBootstrapperServer(privateKey.(*rsa.PrivateKey), nil)
 	assert.Error(t, err)
 
 	// create bootstrapper server
 	privateKey, err = key.GenerateKey("", 2048)
 	assert.NoError(t, err)
-	srv, err := servicers.NewBootstrapperServer(privateKey.(*rsa.PrivateKey))
+	tpmSim, err := tpm_test_utils.NewSimulator("P256")
+	assert.NoError(t, err)
+	srv, err := servicers.NewBootstrapperServer(privateKey.(*rsa.PrivateKey), tpmSim.Roots())
 
 	// for signing csr
 	certifier_test_init.StartTestService(t)
@@ -285,8 +360,15 @@ func TestBootstrapperServer(t *testing.T) {
 		context.Background(),
 		metadata.Pairs("x-magma-client-cert-serial", ""))
 	testWithECDSA(t, testNetworkId, srv, ctx)
+	testWithTPM(t, testNetworkId, srv, tpmSim, ctx)
 	ctx = metadata.NewOutgoingContext(
 		context.Background(),
 		metadata.Pairs("x-magma-client-cert-cn", "bla"))
 	testNegative(t, testNetworkId, srv, ctx)
+
+	// TPM challenge keys are not supported without trusted TPM roots
+	srv, err = servicers.NewBootstrapperServer(privateKey.(*rsa.PrivateKey), nil)
+	assert.NoError(t, err)
+	_, err = srv.GetChallenge(ctx, &protos.AccessGatewayID{Id: "test_ag_tpm"})
+	assert.Error(t, err)
 }
@@ -9,14 +9,19 @@ LICENSE file in the root directory of this source tree.
 package main
 
 import (
+	"crypto"
+	"crypto/x509"
 	"flag"
+	"fmt"
 	"log"
+	"net/http"
 	"time"
 
 	"magma/orc8r/cloud/go/datastore"
 	"magma/orc8r/cloud/go/orc8r"
 	"magma/orc8r/cloud/go/protos"
 	"magma/orc8r/cloud/go/security/cert"
+	"magma/orc8r/cloud/go/security/signer"
 	"magma/orc8r/cloud/go/service"
 	"magma/orc8r/cloud/go/services/certifier"
 	certprotos "magma/orc8r/cloud/go/services/certifier/protos"
@@ -29,12 +34,17 @@ import (
 
 var (
 	bootstrapCACertFile = flag.String("cac", "server_cert.pem", "Signer CA's Certificate file")
-	bootstrapCAKeyFile  = flag.String("cak", "server_cert.key.pem", "Signer CA's Private Key file")
+	bootstrapCAKeyFile  = flag.String("cak", "server_cert.key.pem",
+		"Signer CA's Private Key file or signer URI (remote://host:port/key-id, pkcs11:...)")
 
 	vpnCertFile = flag.String("vpnc", "vpn_ca.crt", "VPN CA's Certificate file")
-	vpnKeyFile  = flag.String("vpnk", "vpn_ca.key", "VPN CA's Private Key file")
+	vpnKeyFile  = flag.String("vpnk", "vpn_ca.key",
+		"VPN CA's Private Key file or signer URI (remote://host:port/key-id, pkcs11:...)")
 
 	gcHours = flag.Int64("gc-hours", 12, "Garbage Collection time interval (in hours)")
+
+	renewFraction  = flag.Float64("renew-fraction", 2.0/3.0, "Fraction of certificate lifetime after which gateways renew it")
+	revocationPort = flag.Int("revocation-port", 9089, "CRL & OCSP HTTP responder port (0 disables the responder)")
 )
 
 func main() {
@@ -52,13 +62,13 @@ func main() {
 	caMap := map[protos.CertType]*servicers.CAInfo{}
 
 	// Add servicers to the service
-	bootstrapCert, bootstrapPrivKey, err := cert.LoadCertAndPrivKey(*bootstrapCACertFile, *bootstrapCAKeyFile)
+	bootstrapCert, bootstrapPrivKey, err := loadCA(*bootstrapCACertFile, *bootstrapCAKeyFile)
 	if err != nil {
 		log.Printf("ERROR: Failed to load bootstrap CA cert and key: %v", err)
 	} else {
 		caMap[protos.CertType_DEFAULT] = &servicers.CAInfo{Cert: bootstrapCert, PrivKey: bootstrapPrivKey}
 	}
-	vpnCert, vpnPrivKey, vpnErr := cert.LoadCertAndPrivKey(*vpnCertFile, *vpnKeyFile)
+	vpnCert, vpnPrivKey, vpnErr := loadCA(*vpnCertFile, *vpnKeyFile)
 	if vpnErr != nil {
 		fmtstr := "ERROR: Failed to load VPN cert and key: %v"
 		if err != nil {
@@ -69,6 +79,7 @@ func main() {
 	} else {
 		caMap[protos.CertType_VPN] = &servicers.CAInfo{Cert: vpnCert, PrivKey: vpnPrivKey}
 	}
+	servicers.RenewAfterFraction = *renewFraction
 	servicer, err := servicers.NewCertifierServer(store, caMap)
 	if err != nil {
 		log.Fatalf("Failed to create certifier server: %s", err)
@@ -87,9 +98,34 @@ func main() {
 		}
 	}()
 
+	// Start CRL & OCSP responder
+	if *revocationPort > 0 {
+		go func() {
+			addr := fmt.Sprintf(":%d", *revocationPort)
+			err := http.ListenAndServe(addr, servicer.NewRevocationHandler())
+			glog.Errorf("CRL & OCSP responder on %s stopped: %s", addr, err)
+		}()
+	}
+
 	// Run the service
 	err = srv.Run()
 	if err != nil {
 		log.Fatalf("Error running service: %s", err)
 	}
 }
+
+// loadCA loads the CA certificate & opens the signer of the CA's private key
+func loadCA(certFile, keyURI string) (*x509.Certificate, crypto.Signer, error) {
+	caCert, err := cert.LoadCert(certFile)
+	if err != nil {
+		return nil, nil, err
+	}
+	caSigner, err := signer.Open(keyURI)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Failed to open CA signer (%s): %s", keyURI, err)
+	}
+	if err = signer.VerifyCertificateKey(caCert, caSigner); err != nil {
+		return nil, nil, err
+	}
+	return caCert, caSigner, nil
+}
@@ -0,0 +1,14 @@
+// +build pkcs11
+
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package main
+
+// register the PKCS#11 CA signer backend
+import _ "magma/orc8r/cloud/go/security/signer/pkcs11"
@@ -188,6 +188,54 @@ func RevokeCertificateSN(sn string) error {
 	return RevokeCertificate(&protos.Certificate_SN{Sn: sn})
 }
 
+// RevokeIdentityCertificates revokes all certificates associated with the
+// given Identity & returns serial numbers of the revoked certificates
+func RevokeIdentityCertificates(id *protos.Identity) ([]string, error) {
+	sns, err := FindCertificates(id)
+	if err != nil {
+		glog.Errorf("Failed to find certificates of identity %s: %s", id.HashString(), err)
+		return []string{}, err
+	}
+	revoked := make([]string, 0, len(sns))
+	for _, sn := range sns {
+		err = RevokeCertificateSN(sn)
+		if err != nil {
+			return revoked, err
+		}
+		revoked = append(revoked, sn)
+	}
+	return revoked, nil
+}
+
+// GetCRL returns the current CRL of the CA of the given type
+func GetCRL(certType protos.CertType) (*certifierprotos.CRL, error) {
+	client, err := getCertifierClient()
+	if err != nil {
+		return nil, err
+	}
+	crl, err := client.GetCRL(context.Background(), &certifierprotos.GetCRLRequest{CertType: certType})
+	if err != nil {
+		glog.Errorf("Failed to get %s CRL: %s", certType.String(), err)
+		return nil, err
+	}
+	return crl, nil
+}
+
+// GetOCSPResponse returns the DER encoded OCSP response for the given DER
+// encoded OCSP request
+func GetOCSPResponse(requestDER []byte) ([]byte, error) {
+	client, err := getCertifierClient()
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.GetOCSPResponse(context.Background(), &certifierprotos.OCSPRequest{RequestDer: requestDER})
+	if err != nil {
+		glog.Errorf("Failed to get OCSP response: %s", err)
+		return nil, err
+	}
+	return resp.ResponseDer, nil
+}
+
 // Let certifier to remove expired certificates
 func CollectGarbage() error {
 	client, err := getCertifierClient()
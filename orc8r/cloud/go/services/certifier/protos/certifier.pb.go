@@ -39,7 +39,7 @@ func (m *CertificateInfo) Reset()         { *m = CertificateInfo{} }
 func (m *CertificateInfo) String() string { return proto.CompactTextString(m) }
 func (*CertificateInfo) ProtoMessage()    {}
 func (*CertificateInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_certifier_ebcdf080e50839d6, []int{0}
+	return fileDescriptor_certifier_c03712dfa763dee0, []int{0}
 }
 func (m *CertificateInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CertificateInfo.Unmarshal(m, b)
@@ -98,7 +98,7 @@ func (m *CertificateInfoMap) Reset()         { *m = CertificateInfoMap{} }
 func (m *CertificateInfoMap) String() string { return proto.CompactTextString(m) }
 func (*CertificateInfoMap) ProtoMessage()    {}
 func (*CertificateInfoMap) Descriptor() ([]byte, []int) {
-	return fileDescriptor_certifier_ebcdf080e50839d6, []int{1}
+	return fileDescriptor_certifier_c03712dfa763dee0, []int{1}
 }
 func (m *CertificateInfoMap) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CertificateInfoMap.Unmarshal(m, b)
@@ -138,7 +138,7 @@ func (m *AddCertRequest) Reset()         { *m = AddCertRequest{} }
 func (m *AddCertRequest) String() string { return proto.CompactTextString(m) }
 func (*AddCertRequest) ProtoMessage()    {}
 func (*AddCertRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_certifier_ebcdf080e50839d6, []int{2}
+	return fileDescriptor_certifier_c03712dfa763dee0, []int{2}
 }
 func (m *AddCertRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AddCertRequest.Unmarshal(m, b)
@@ -190,7 +190,7 @@ func (m *SerialNumbers) Reset()         { *m = SerialNumbers{} }
 func (m *SerialNumbers) String() string { return proto.CompactTextString(m) }
 func (*SerialNumbers) ProtoMessage()    {}
 func (*SerialNumbers) Descriptor() ([]byte, []int) {
-	return fileDescriptor_certifier_ebcdf080e50839d6, []int{3}
+	return fileDescriptor_certifier_c03712dfa763dee0, []int{3}
 }
 func (m *SerialNumbers) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SerialNumbers.Unmarshal(m, b)
@@ -228,7 +228,7 @@ func (m *GetCARequest) Reset()         { *m = GetCARequest{} }
 func (m *GetCARequest) String() string { return proto.CompactTextString(m) }
 func (*GetCARequest) ProtoMessage()    {}
 func (*GetCARequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_certifier_ebcdf080e50839d6, []int{4}
+	return fileDescriptor_certifier_c03712dfa763dee0, []int{4}
 }
 func (m *GetCARequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetCARequest.Unmarshal(m, b)
@@ -255,6 +255,220 @@ func (m *GetCARequest) GetCertType() protos.CertType {
 	return protos.CertType_DEFAULT
 }
 
+type RevokedCertificate struct {
+	Info                 *CertificateInfo     `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
+	RevocationTime       *timestamp.Timestamp `protobuf:"bytes,2,opt,name=revocation_time,json=revocationTime,proto3" json:"revocation_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *RevokedCertificate) Reset()         { *m = RevokedCertificate{} }
+func (m *RevokedCertificate) String() string { return proto.CompactTextString(m) }
+func (*RevokedCertificate) ProtoMessage()    {}
+func (*RevokedCertificate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_certifier_c03712dfa763dee0, []int{5}
+}
+func (m *RevokedCertificate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokedCertificate.Unmarshal(m, b)
+}
+func (m *RevokedCertificate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokedCertificate.Marshal(b, m, deterministic)
+}
+func (dst *RevokedCertificate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokedCertificate.Merge(dst, src)
+}
+func (m *RevokedCertificate) XXX_Size() int {
+	return xxx_messageInfo_RevokedCertificate.Size(m)
+}
+func (m *RevokedCertificate) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokedCertificate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokedCertificate proto.InternalMessageInfo
+
+func (m *RevokedCertificate) GetInfo() *CertificateInfo {
+	if m != nil {
+		return m.Info
+	}
+	return nil
+}
+
+func (m *RevokedCertificate) GetRevocationTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.RevocationTime
+	}
+	return nil
+}
+
+type GetCRLRequest struct {
+	CertType             protos.CertType `protobuf:"varint,1,opt,name=cert_type,json=certType,proto3,enum=magma.orc8r.CertType" json:"cert_type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *GetCRLRequest) Reset()         { *m = GetCRLRequest{} }
+func (m *GetCRLRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCRLRequest) ProtoMessage()    {}
+func (*GetCRLRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_certifier_c03712dfa763dee0, []int{6}
+}
+func (m *GetCRLRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCRLRequest.Unmarshal(m, b)
+}
+func (m *GetCRLRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCRLRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetCRLRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCRLRequest.Merge(dst, src)
+}
+func (m *GetCRLRequest) XXX_Size() int {
+	return xxx_messageInfo_GetCRLRequest.Size(m)
+}
+func (m *GetCRLRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCRLRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCRLRequest proto.InternalMessageInfo
+
+func (m *GetCRLRequest) GetCertType() protos.CertType {
+	if m != nil {
+		return m.CertType
+	}
+	return protos.CertType_DEFAULT
+}
+
+type CRL struct {
+	CrlDer               []byte               `protobuf:"bytes,1,opt,name=crl_der,json=crlDer,proto3" json:"crl_der,omitempty"`
+	ThisUpdate           *timestamp.Timestamp `protobuf:"bytes,2,opt,name=this_update,json=thisUpdate,proto3" json:"this_update,omitempty"`
+	NextUpdate           *timestamp.Timestamp `protobuf:"bytes,3,opt,name=next_update,json=nextUpdate,proto3" json:"next_update,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *CRL) Reset()         { *m = CRL{} }
+func (m *CRL) String() string { return proto.CompactTextString(m) }
+func (*CRL) ProtoMessage()    {}
+func (*CRL) Descriptor() ([]byte, []int) {
+	return fileDescriptor_certifier_c03712dfa763dee0, []int{7}
+}
+func (m *CRL) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CRL.Unmarshal(m, b)
+}
+func (m *CRL) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CRL.Marshal(b, m, deterministic)
+}
+func (dst *CRL) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CRL.Merge(dst, src)
+}
+func (m *CRL) XXX_Size() int {
+	return xxx_messageInfo_CRL.Size(m)
+}
+func (m *CRL) XXX_DiscardUnknown() {
+	xxx_messageInfo_CRL.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CRL proto.InternalMessageInfo
+
+func (m *CRL) GetCrlDer() []byte {
+	if m != nil {
+		return m.CrlDer
+	}
+	return nil
+}
+
+func (m *CRL) GetThisUpdate() *timestamp.Timestamp {
+	if m != nil {
+		return m.ThisUpdate
+	}
+	return nil
+}
+
+func (m *CRL) GetNextUpdate() *timestamp.Timestamp {
+	if m != nil {
+		return m.NextUpdate
+	}
+	return nil
+}
+
+type OCSPRequest struct {
+	RequestDer           []byte   `protobuf:"bytes,1,opt,name=request_der,json=requestDer,proto3" json:"request_der,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OCSPRequest) Reset()         { *m = OCSPRequest{} }
+func (m *OCSPRequest) String() string { return proto.CompactTextString(m) }
+func (*OCSPRequest) ProtoMessage()    {}
+func (*OCSPRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_certifier_c03712dfa763dee0, []int{8}
+}
+func (m *OCSPRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OCSPRequest.Unmarshal(m, b)
+}
+func (m *OCSPRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OCSPRequest.Marshal(b, m, deterministic)
+}
+func (dst *OCSPRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OCSPRequest.Merge(dst, src)
+}
+func (m *OCSPRequest) XXX_Size() int {
+	return xxx_messageInfo_OCSPRequest.Size(m)
+}
+func (m *OCSPRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OCSPRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OCSPRequest proto.InternalMessageInfo
+
+func (m *OCSPRequest) GetRequestDer() []byte {
+	if m != nil {
+		return m.RequestDer
+	}
+	return nil
+}
+
+type OCSPResponse struct {
+	ResponseDer          []byte   `protobuf:"bytes,1,opt,name=response_der,json=responseDer,proto3" json:"response_der,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OCSPResponse) Reset()         { *m = OCSPResponse{} }
+func (m *OCSPResponse) String() string { return proto.CompactTextString(m) }
+func (*OCSPResponse) ProtoMessage()    {}
+func (*OCSPResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_certifier_c03712dfa763dee0, []int{9}
+}
+func (m *OCSPResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OCSPResponse.Unmarshal(m, b)
+}
+func (m *OCSPResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OCSPResponse.Marshal(b, m, deterministic)
+}
+func (dst *OCSPResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OCSPResponse.Merge(dst, src)
+}
+func (m *OCSPResponse) XXX_Size() int {
+	return xxx_messageInfo_OCSPResponse.Size(m)
+}
+func (m *OCSPResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_OCSPResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OCSPResponse proto.InternalMessageInfo
+
+func (m *OCSPResponse) GetResponseDer() []byte {
+	if m != nil {
+		return m.ResponseDer
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*CertificateInfo)(nil), "magma.orc8r.certifier.CertificateInfo")
 	proto.RegisterType((*CertificateInfoMap)(nil), "magma.orc8r.certifier.CertificateInfoMap")
@@ -262,6 +476,11 @@ func init() {
 	proto.RegisterType((*AddCertRequest)(nil), "magma.orc8r.certifier.AddCertRequest")
 	proto.RegisterType((*SerialNumbers)(nil), "magma.orc8r.certifier.SerialNumbers")
 	proto.RegisterType((*GetCARequest)(nil), "magma.orc8r.certifier.GetCARequest")
+	proto.RegisterType((*RevokedCertificate)(nil), "magma.orc8r.certifier.RevokedCertificate")
+	proto.RegisterType((*GetCRLRequest)(nil), "magma.orc8r.certifier.GetCRLRequest")
+	proto.RegisterType((*CRL)(nil), "magma.orc8r.certifier.CRL")
+	proto.RegisterType((*OCSPRequest)(nil), "magma.orc8r.certifier.OCSPRequest")
+	proto.RegisterType((*OCSPResponse)(nil), "magma.orc8r.certifier.OCSPResponse")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -287,9 +506,15 @@ type CertifierClient interface {
 	//
 	GetIdentity(ctx context.Context, in *protos.Certificate_SN, opts ...grpc.CallOption) (*CertificateInfo, error)
 	// Revoke an existing certificate.
-	// If the certificate does not exist or is expired, this request is ignored.
+	// The certificate is listed in the CRL & reported revoked by OCSP until it
+	// expires.
+	// Throws NOT_FOUND if the certificate is missing.
 	//
 	RevokeCertificate(ctx context.Context, in *protos.Certificate_SN, opts ...grpc.CallOption) (*protos.Void, error)
+	// Returns the CRL of the requested CA signed by the CA
+	GetCRL(ctx context.Context, in *GetCRLRequest, opts ...grpc.CallOption) (*CRL, error)
+	// Returns the signed OCSP response for the given OCSP request
+	GetOCSPResponse(ctx context.Context, in *OCSPRequest, opts ...grpc.CallOption) (*OCSPResponse, error)
 	// Add provided Certificate (AddCertRequest.cert_der) into Certifier table and
 	// associates its Serial Number with given Identity (AddCertRequest.id)
 	AddCertificate(ctx context.Context, in *AddCertRequest, opts ...grpc.CallOption) (*protos.Void, error)
@@ -300,7 +525,7 @@ type CertifierClient interface {
 	ListCertificates(ctx context.Context, in *protos.Void, opts ...grpc.CallOption) (*SerialNumbers, error)
 	// Returns all registered Certificates
 	GetAll(ctx context.Context, in *protos.Void, opts ...grpc.CallOption) (*CertificateInfoMap, error)
-	// cleanup expired certificates
+	// cleanup expired certificates & revocation records
 	//
 	CollectGarbage(ctx context.Context, in *protos.Void, opts ...grpc.CallOption) (*protos.Void, error)
 }
@@ -349,6 +574,24 @@ func (c *certifierClient) RevokeCertificate(ctx context.Context, in *protos.Cert
 	return out, nil
 }
 
+func (c *certifierClient) GetCRL(ctx context.Context, in *GetCRLRequest, opts ...grpc.CallOption) (*CRL, error) {
+	out := new(CRL)
+	err := c.cc.Invoke(ctx, "/magma.orc8r.certifier.Certifier/GetCRL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *certifierClient) GetOCSPResponse(ctx context.Context, in *OCSPRequest, opts ...grpc.CallOption) (*OCSPResponse, error) {
+	out := new(OCSPResponse)
+	err := c.cc.Invoke(ctx, "/magma.orc8r.certifier.Certifier/GetOCSPResponse", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *certifierClient) AddCertificate(ctx context.Context, in *AddCertRequest, opts ...grpc.CallOption) (*protos.Void, error) {
 	out := new(protos.Void)
 	err := c.cc.Invoke(ctx, "/magma.orc8r.certifier.Certifier/AddCertificate", in, out, opts...)
@@ -407,9 +650,15 @@ type CertifierServer interface {
 	//
 	GetIdentity(context.Context, *protos.Certificate_SN) (*CertificateInfo, error)
 	// Revoke an existing certificate.
-	// If the certificate does not exist or is expired, this request is ignored.
+	// The certificate is listed in the CRL & reported revoked by OCSP until it
+	// expires.
+	// Throws NOT_FOUND if the certificate is missing.
 	//
 	RevokeCertificate(context.Context, *protos.Certificate_SN) (*protos.Void, error)
+	// Returns the CRL of the requested CA signed by the CA
+	GetCRL(context.Context, *GetCRLRequest) (*CRL, error)
+	// Returns the signed OCSP response for the given OCSP request
+	GetOCSPResponse(context.Context, *OCSPRequest) (*OCSPResponse, error)
 	// Add provided Certificate (AddCertRequest.cert_der) into Certifier table and
 	// associates its Serial Number with given Identity (AddCertRequest.id)
 	AddCertificate(context.Context, *AddCertRequest) (*protos.Void, error)
@@ -420,7 +669,7 @@ type CertifierServer interface {
 	ListCertificates(context.Context, *protos.Void) (*SerialNumbers, error)
 	// Returns all registered Certificates
 	GetAll(context.Context, *protos.Void) (*CertificateInfoMap, error)
-	// cleanup expired certificates
+	// cleanup expired certificates & revocation records
 	//
 	CollectGarbage(context.Context, *protos.Void) (*protos.Void, error)
 }
@@ -501,6 +750,42 @@ func _Certifier_RevokeCertificate_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Certifier_GetCRL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCRLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CertifierServer).GetCRL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.orc8r.certifier.Certifier/GetCRL",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CertifierServer).GetCRL(ctx, req.(*GetCRLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Certifier_GetOCSPResponse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OCSPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CertifierServer).GetOCSPResponse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.orc8r.certifier.Certifier/GetOCSPResponse",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CertifierServer).GetOCSPResponse(ctx, req.(*OCSPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Certifier_AddCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AddCertRequest)
 	if err := dec(in); err != nil {
@@ -611,6 +896,14 @@ var _Certifier_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RevokeCertificate",
 			Handler:    _Certifier_RevokeCertificate_Handler,
 		},
+		{
+			MethodName: "GetCRL",
+			Handler:    _Certifier_GetCRL_Handler,
+		},
+		{
+			MethodName: "GetOCSPResponse",
+			Handler:    _Certifier_GetOCSPResponse_Handler,
+		},
 		{
 			MethodName: "AddCertificate",
 			Handler:    _Certifier_AddCertificate_Handler,
@@ -636,45 +929,56 @@ var _Certifier_serviceDesc = grpc.ServiceDesc{
 	Metadata: "certifier.proto",
 }
 
-func init() { proto.RegisterFile("certifier.proto", fileDescriptor_certifier_ebcdf080e50839d6) }
-
-var fileDescriptor_certifier_ebcdf080e50839d6 = []byte{
-	// 579 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xdf, 0x8e, 0xd2, 0x40,
-	0x14, 0xc6, 0x5b, 0x90, 0x15, 0x0e, 0xc8, 0xc2, 0x98, 0x4d, 0xba, 0x5d, 0x13, 0xb1, 0xba, 0x06,
-	0x6f, 0x4a, 0x82, 0x17, 0xe2, 0x9f, 0x1b, 0xc0, 0x15, 0x37, 0xd9, 0xdd, 0xc4, 0x42, 0xbc, 0xf0,
-	0x86, 0x94, 0xf6, 0xd0, 0x4c, 0xb6, 0xed, 0xe0, 0x74, 0xd8, 0x84, 0x17, 0xf0, 0x4d, 0x7c, 0x2d,
-	0xe3, 0xa3, 0x98, 0xfe, 0x5b, 0x29, 0x14, 0xed, 0x15, 0x33, 0x73, 0xbe, 0xf9, 0x7d, 0x67, 0xbe,
-	0x19, 0x0a, 0xc7, 0x16, 0x72, 0x41, 0x97, 0x14, 0xb9, 0xbe, 0xe2, 0x4c, 0x30, 0x72, 0xe2, 0x99,
-	0x8e, 0x67, 0xea, 0x8c, 0x5b, 0x03, 0xae, 0xdf, 0x17, 0xd5, 0x27, 0xd1, 0x42, 0x2f, 0xd2, 0x04,
-	0xbd, 0x9d, 0x4d, 0xea, 0x69, 0xb6, 0xca, 0x3c, 0x8f, 0xf9, 0x49, 0xe9, 0x2c, 0x53, 0xa2, 0x36,
-	0xfa, 0x82, 0x8a, 0x4d, 0x52, 0x7c, 0xea, 0x30, 0xe6, 0xb8, 0x18, 0x57, 0x17, 0xeb, 0x65, 0x4f,
-	0x50, 0x0f, 0x03, 0x61, 0x7a, 0xab, 0x58, 0xa0, 0xfd, 0x96, 0xe1, 0x78, 0x1c, 0x9b, 0x59, 0xa6,
-	0xc0, 0x4b, 0x7f, 0xc9, 0xc8, 0x39, 0x94, 0xa8, 0xad, 0xc8, 0x1d, 0xb9, 0x5b, 0xef, 0x9f, 0xe8,
-	0xdb, 0xed, 0x5e, 0x26, 0x74, 0xa3, 0x44, 0x6d, 0xf2, 0x16, 0xc0, 0x67, 0x62, 0xbe, 0xc0, 0x25,
-	0xe3, 0xa8, 0x94, 0x22, 0xb9, 0xaa, 0xc7, 0x86, 0x7a, 0x6a, 0xa8, 0xcf, 0x52, 0x43, 0xa3, 0xe6,
-	0x33, 0x31, 0x8a, 0xc4, 0xe4, 0x0d, 0x84, 0x93, 0xb9, 0xb9, 0x14, 0xc8, 0x95, 0xf2, 0x7f, 0x77,
-	0x56, 0x7d, 0x26, 0x86, 0xa1, 0x96, 0xf4, 0xa1, 0x16, 0x46, 0x33, 0x17, 0x9b, 0x15, 0x2a, 0x0f,
-	0x3a, 0x72, 0xb7, 0xb9, 0xd3, 0x61, 0x78, 0x96, 0xd9, 0x66, 0x85, 0x46, 0xd5, 0x4a, 0x46, 0xda,
-	0x2f, 0x19, 0xc8, 0xce, 0x11, 0xaf, 0xcd, 0x15, 0x99, 0x43, 0xc3, 0xfa, 0xbb, 0x1a, 0x28, 0x72,
-	0xa7, 0xdc, 0xad, 0xf7, 0xdf, 0xeb, 0xb9, 0xd7, 0xa3, 0xef, 0x03, 0xb6, 0x97, 0x82, 0x0b, 0x5f,
-	0xf0, 0x8d, 0x91, 0x01, 0xaa, 0x0e, 0xb4, 0xf7, 0x24, 0xa4, 0x05, 0xe5, 0x5b, 0xdc, 0x44, 0xe1,
-	0xd6, 0x8c, 0x70, 0x48, 0x3e, 0x40, 0xe5, 0xce, 0x74, 0xd7, 0x69, 0x82, 0x2f, 0x8b, 0x35, 0x60,
-	0xc4, 0x9b, 0xde, 0x95, 0x06, 0xb2, 0xf6, 0x43, 0x86, 0xe6, 0xd0, 0xb6, 0x43, 0x85, 0x81, 0xdf,
-	0xd7, 0x18, 0x88, 0xa2, 0x57, 0x78, 0x0a, 0x51, 0x4c, 0x73, 0x1b, 0x79, 0x64, 0xdf, 0x30, 0x1e,
-	0x86, 0xf3, 0x8f, 0xbb, 0x49, 0x97, 0x8b, 0x25, 0xfd, 0x0c, 0x1e, 0x4d, 0x91, 0x53, 0xd3, 0xbd,
-	0x59, 0x7b, 0x0b, 0xe4, 0x41, 0x78, 0xda, 0xc0, 0x8f, 0xa3, 0xad, 0x19, 0xe1, 0x50, 0x1b, 0x41,
-	0x63, 0x82, 0x62, 0x3c, 0x4c, 0x1b, 0xcd, 0xd8, 0xc8, 0x85, 0x6c, 0xfa, 0x3f, 0x2b, 0x50, 0x1b,
-	0xa7, 0xc1, 0x90, 0x31, 0x54, 0x22, 0x22, 0x79, 0x7e, 0x20, 0xb9, 0x6d, 0x3f, 0xf5, 0x71, 0x16,
-	0x3e, 0x0c, 0x39, 0x9a, 0x44, 0x46, 0x40, 0xa6, 0xd4, 0xf1, 0x93, 0x14, 0x93, 0x9c, 0x49, 0x2b,
-	0x2b, 0x9e, 0x1a, 0xaa, 0xb2, 0xd7, 0x5b, 0xa2, 0xd5, 0x24, 0x32, 0x83, 0xfa, 0x04, 0x45, 0x9a,
-	0x2f, 0x39, 0x3b, 0x24, 0xd5, 0xa7, 0x37, 0x6a, 0xc1, 0x5b, 0xd6, 0x24, 0x72, 0x01, 0x6d, 0x03,
-	0xef, 0xd8, 0x2d, 0x6e, 0x37, 0xf6, 0x4f, 0x76, 0x3b, 0x53, 0xfc, 0xca, 0xa8, 0xad, 0x49, 0xe4,
-	0xea, 0xfe, 0x89, 0xa4, 0x8c, 0xf3, 0x03, 0x2d, 0x64, 0x5f, 0x52, 0x3e, 0xed, 0x0b, 0xb4, 0x3e,
-	0x51, 0x7f, 0x1b, 0x17, 0x90, 0xfc, 0x67, 0xa6, 0xbe, 0x38, 0x60, 0x93, 0x79, 0x28, 0x9a, 0x44,
-	0xae, 0xa1, 0x75, 0x45, 0x03, 0x91, 0x41, 0xee, 0x7b, 0x17, 0xc6, 0x7d, 0x86, 0xa3, 0x09, 0x8a,
-	0xa1, 0xeb, 0xe6, 0x41, 0x5e, 0x15, 0xfe, 0x93, 0x6b, 0x12, 0x19, 0x40, 0x73, 0xcc, 0x5c, 0x17,
-	0x2d, 0x31, 0x31, 0xf9, 0xc2, 0x74, 0x30, 0x8f, 0x98, 0x97, 0xd2, 0xa8, 0xfa, 0xed, 0x28, 0xfe,
-	0x2a, 0x2f, 0xe2, 0xdf, 0xd7, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0xfb, 0xa9, 0xfc, 0xb5, 0x0d,
-	0x06, 0x00, 0x00,
+func init() { proto.RegisterFile("certifier.proto", fileDescriptor_certifier_c03712dfa763dee0) }
+
+var fileDescriptor_certifier_c03712dfa763dee0 = []byte{
+	// 766 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xdb, 0x4e, 0xdb, 0x4a,
+	0x14, 0x8d, 0x13, 0x08, 0x64, 0x27, 0x24, 0x61, 0x8e, 0xd0, 0x09, 0xe6, 0x48, 0xc0, 0x70, 0xa8,
+	0xe8, 0x8b, 0x51, 0xd3, 0x87, 0x52, 0xe8, 0x4b, 0x62, 0x68, 0x8a, 0x14, 0x68, 0xeb, 0xd0, 0x3e,
+	0x54, 0x95, 0x22, 0xc7, 0x9e, 0xa4, 0x23, 0x1c, 0x4f, 0x3a, 0x9e, 0xa0, 0xe6, 0x07, 0xfa, 0x07,
+	0x95, 0xfa, 0x5b, 0xfd, 0x81, 0xaa, 0x9f, 0x52, 0x8d, 0x2f, 0x60, 0xe7, 0x02, 0xae, 0xfa, 0xc4,
+	0x78, 0xef, 0xb5, 0x6f, 0x6b, 0xed, 0x19, 0x02, 0x15, 0x8b, 0x70, 0x41, 0xfb, 0x94, 0x70, 0x6d,
+	0xc4, 0x99, 0x60, 0x68, 0x63, 0x68, 0x0e, 0x86, 0xa6, 0xc6, 0xb8, 0x75, 0xc4, 0xb5, 0x5b, 0xa7,
+	0xfa, 0x9f, 0x6f, 0x38, 0xf4, 0x31, 0xde, 0xe1, 0x54, 0x90, 0xba, 0x99, 0xf4, 0xb2, 0xe1, 0x90,
+	0xb9, 0xa1, 0x6b, 0x2b, 0xe1, 0xa2, 0x36, 0x71, 0x05, 0x15, 0x93, 0xd0, 0xb9, 0x3d, 0x60, 0x6c,
+	0xe0, 0x90, 0xc0, 0xdb, 0x1b, 0xf7, 0x0f, 0x05, 0x1d, 0x12, 0x4f, 0x98, 0xc3, 0x51, 0x00, 0xc0,
+	0xbf, 0x14, 0xa8, 0xe8, 0x41, 0x31, 0xcb, 0x14, 0xe4, 0xdc, 0xed, 0x33, 0xb4, 0x0f, 0x59, 0x6a,
+	0xd7, 0x94, 0x1d, 0xe5, 0xa0, 0x58, 0xdf, 0xd0, 0xe2, 0xed, 0x9e, 0x87, 0xd9, 0x8d, 0x2c, 0xb5,
+	0xd1, 0x73, 0x00, 0x97, 0x89, 0x6e, 0x8f, 0xf4, 0x19, 0x27, 0xb5, 0xac, 0x0f, 0x57, 0xb5, 0xa0,
+	0xa0, 0x16, 0x15, 0xd4, 0xae, 0xa2, 0x82, 0x46, 0xc1, 0x65, 0xa2, 0xe9, 0x83, 0xd1, 0x33, 0x90,
+	0x1f, 0x5d, 0xb3, 0x2f, 0x08, 0xaf, 0xe5, 0x1e, 0x8c, 0x5c, 0x75, 0x99, 0x68, 0x48, 0x2c, 0xaa,
+	0x43, 0x41, 0x52, 0xd3, 0x15, 0x93, 0x11, 0xa9, 0x2d, 0xed, 0x28, 0x07, 0xe5, 0xa9, 0x0e, 0xe5,
+	0x2c, 0x57, 0x93, 0x11, 0x31, 0x56, 0xad, 0xf0, 0x84, 0x7f, 0x2a, 0x80, 0xa6, 0x46, 0xbc, 0x30,
+	0x47, 0xa8, 0x0b, 0x25, 0xeb, 0xce, 0xea, 0xd5, 0x94, 0x9d, 0xdc, 0x41, 0xb1, 0x7e, 0xa2, 0xcd,
+	0x95, 0x47, 0x9b, 0x4d, 0x10, 0x37, 0x79, 0x67, 0xae, 0xe0, 0x13, 0x23, 0x91, 0x50, 0x1d, 0xc0,
+	0xfa, 0x0c, 0x04, 0x55, 0x21, 0x77, 0x4d, 0x26, 0x3e, 0xb9, 0x05, 0x43, 0x1e, 0xd1, 0x0b, 0x58,
+	0xbe, 0x31, 0x9d, 0x71, 0xc4, 0xe0, 0xa3, 0x74, 0x0d, 0x18, 0x41, 0xd0, 0x71, 0xf6, 0x48, 0xc1,
+	0x5f, 0x15, 0x28, 0x37, 0x6c, 0x5b, 0x22, 0x0c, 0xf2, 0x79, 0x4c, 0x3c, 0x91, 0x56, 0xc2, 0x4d,
+	0xf0, 0x69, 0xea, 0xda, 0x84, 0xfb, 0xe5, 0x4b, 0xc6, 0x8a, 0xfc, 0x3e, 0x9d, 0x66, 0x3a, 0x97,
+	0x8e, 0xe9, 0x5d, 0x58, 0xeb, 0x10, 0x4e, 0x4d, 0xe7, 0x72, 0x3c, 0xec, 0x11, 0xee, 0xc9, 0x69,
+	0x3d, 0x37, 0xa0, 0xb6, 0x60, 0xc8, 0x23, 0x6e, 0x42, 0xa9, 0x45, 0x84, 0xde, 0x88, 0x1a, 0x4d,
+	0x94, 0x51, 0xd2, 0x95, 0xf9, 0xa6, 0x00, 0x32, 0xc8, 0x0d, 0xbb, 0x26, 0x76, 0x8c, 0x15, 0x74,
+	0x0c, 0x4b, 0xd4, 0xed, 0xb3, 0x9a, 0xf2, 0x47, 0x3c, 0xfa, 0x31, 0x48, 0x87, 0x0a, 0x27, 0x37,
+	0xcc, 0x32, 0x05, 0x65, 0x6e, 0x57, 0x5e, 0x92, 0x14, 0x0b, 0x5d, 0xbe, 0x0b, 0x91, 0x46, 0xac,
+	0xc3, 0x9a, 0x9c, 0xcd, 0x68, 0xff, 0xcd, 0x70, 0xdf, 0x15, 0xc8, 0xe9, 0x46, 0x1b, 0xfd, 0x0b,
+	0x2b, 0x16, 0x77, 0x7c, 0x65, 0x14, 0x5f, 0x99, 0xbc, 0xc5, 0x1d, 0x29, 0xcc, 0x09, 0x14, 0xc5,
+	0x27, 0xea, 0x75, 0xc7, 0x23, 0xdb, 0x14, 0x69, 0xda, 0x04, 0x09, 0x7f, 0xe7, 0xa3, 0x65, 0xb0,
+	0x4b, 0xbe, 0x88, 0x28, 0xf8, 0xe1, 0xab, 0x07, 0x12, 0x1e, 0x04, 0x63, 0x0d, 0x8a, 0xaf, 0xf5,
+	0xce, 0x9b, 0x68, 0xba, 0x6d, 0x28, 0xf2, 0xe0, 0x18, 0xeb, 0x12, 0x42, 0xd3, 0x29, 0xe1, 0xf8,
+	0x09, 0x94, 0x02, 0xbc, 0x37, 0x62, 0xae, 0x47, 0xd0, 0x2e, 0x94, 0x78, 0x78, 0x8e, 0x45, 0x14,
+	0x23, 0xdb, 0x29, 0xe1, 0xf5, 0x1f, 0x79, 0x28, 0xe8, 0x91, 0x56, 0x48, 0x87, 0x65, 0x7f, 0x59,
+	0xd0, 0xde, 0x02, 0x31, 0xe3, 0xab, 0xa4, 0xfe, 0x93, 0xa4, 0xb6, 0x21, 0xf3, 0xe0, 0x0c, 0x6a,
+	0x02, 0xea, 0xd0, 0x81, 0x1b, 0x5e, 0x90, 0x68, 0x59, 0xaa, 0x49, 0x70, 0xc7, 0x50, 0x6b, 0x33,
+	0xca, 0x84, 0x58, 0x9c, 0x41, 0x57, 0x50, 0x6c, 0x11, 0x11, 0x5d, 0x1d, 0xb4, 0xb5, 0x08, 0xaa,
+	0x75, 0x2e, 0xd5, 0x94, 0x8b, 0x87, 0x33, 0xe8, 0x0c, 0xd6, 0x83, 0x35, 0x8e, 0x37, 0x76, 0x6f,
+	0xee, 0xf5, 0x84, 0xf3, 0x3d, 0xa3, 0x36, 0xce, 0xa0, 0x36, 0xe4, 0x83, 0xb5, 0x43, 0xff, 0xdf,
+	0x43, 0xd3, 0xed, 0x56, 0xaa, 0xea, 0xa2, 0x06, 0x8d, 0x36, 0xce, 0xa0, 0x8f, 0x50, 0x69, 0x11,
+	0x91, 0xd0, 0x0d, 0x2f, 0x08, 0x88, 0x2d, 0x83, 0xba, 0x77, 0x2f, 0x26, 0x48, 0xe4, 0xf7, 0x5a,
+	0x9e, 0x12, 0x62, 0x7f, 0x41, 0x60, 0xf2, 0x41, 0x9b, 0x3f, 0xf9, 0x5b, 0xa8, 0xbe, 0xa4, 0x6e,
+	0x3c, 0x9d, 0x87, 0xe6, 0xbf, 0x76, 0xea, 0x22, 0x6a, 0x12, 0xef, 0x15, 0xce, 0xa0, 0x0b, 0xa8,
+	0xb6, 0xa9, 0x27, 0x12, 0x29, 0x67, 0x6b, 0xa7, 0x4e, 0xf7, 0xca, 0xd7, 0xa6, 0xe1, 0x38, 0xf3,
+	0x92, 0x3c, 0x4e, 0xfd, 0xbf, 0x06, 0x67, 0xd0, 0x11, 0x94, 0x75, 0xe6, 0x38, 0xc4, 0x12, 0x2d,
+	0x93, 0xf7, 0xcc, 0x01, 0x99, 0x97, 0x71, 0x1e, 0x4b, 0xcd, 0xd5, 0x0f, 0xf9, 0xe0, 0xc7, 0x41,
+	0x2f, 0xf8, 0xfb, 0xf4, 0xf7, 0x00, 0x92, 0xf1, 0x85, 0xdb, 0x94, 0x08, 0x00, 0x00,
 }
@@ -9,6 +9,7 @@ LICENSE file in the root directory of this source tree.
 package servicers
 
 import (
+	"crypto"
 	"crypto/rand"
 	"crypto/x509"
 	"fmt"
@@ -20,6 +21,7 @@ import (
 	"magma/orc8r/cloud/go/identity"
 	"magma/orc8r/cloud/go/protos"
 	"magma/orc8r/cloud/go/security/cert"
+	"magma/orc8r/cloud/go/security/signer"
 	certprotos "magma/orc8r/cloud/go/services/certifier/protos"
 
 	"github.com/golang/glog"
@@ -33,13 +35,22 @@ import (
 var (
 	NumTrialsForSn      int
 	CollectGarbageAfter time.Duration // remove cert if expired for certain amount of time
+	RenewAfterFraction  float64       // fraction of a cert's lifetime after which it should be renewed
+	CRLValidity         time.Duration // time until the next CRL update
+	OCSPValidity        time.Duration // time until the next OCSP response update
 )
 
 func init() {
 	NumTrialsForSn = 1
 	CollectGarbageAfter = time.Duration(time.Hour * 24)
+	RenewAfterFraction = 2.0 / 3.0
+	CRLValidity = time.Duration(time.Hour)
+	OCSPValidity = time.Duration(time.Hour)
 }
 
+// CAInfo is a CA certificate & its private key. PrivKey must implement
+// crypto.Signer, it may be an in-memory key or a signer of the security/signer
+// package backed by a KMS/HSM.
 type CAInfo struct {
 	Cert    *x509.Certificate
 	PrivKey interface{}
@@ -59,6 +70,18 @@ func NewCertifierServer(store datastore.Api, CAs map[protos.CertType]*CAInfo) (s
 	if len(CAs) == 0 {
 		return nil, fmt.Errorf("No Certificates are provided to certifier")
 	}
+	for certType, ca := range CAs {
+		if ca == nil || ca.Cert == nil {
+			return nil, fmt.Errorf("Missing CA certificate for cert type %s", certType.String())
+		}
+		caSigner, ok := ca.PrivKey.(crypto.Signer)
+		if !ok {
+			return nil, fmt.Errorf("CA private key of cert type %s cannot sign", certType.String())
+		}
+		if err = signer.VerifyCertificateKey(ca.Cert, caSigner); err != nil {
+			return nil, fmt.Errorf("Invalid CA of cert type %s: %s", certType.String(), err)
+		}
+	}
 	srv.CAs = CAs
 	return srv, nil
 }
@@ -178,6 +201,35 @@ func (srv *CertifierServer) getCertInfo(sn string) (*certprotos.CertificateInfo,
 	return certInfo, err
 }
 
+func (srv *CertifierServer) getRevokedCert(sn string) (*certprotos.RevokedCertificate, error) {
+	revokedCert := &certprotos.RevokedCertificate{}
+	marshalledRevokedCert, _, err := srv.store.Get(CERTIFICATE_REVOCATION_TABLE, sn)
+	if err != nil && datastore.IsErrNotFound(err) {
+		return revokedCert, status.Errorf(
+			codes.NotFound, "Failed to load certificate revocation: %s", err)
+	}
+	if err != nil {
+		return revokedCert, status.Errorf(
+			codes.Internal, "Failed to load certificate revocation: %s", err)
+	}
+	err = proto.Unmarshal(marshalledRevokedCert, revokedCert)
+	if err != nil {
+		err = status.Errorf(
+			codes.Internal, "Failed to unmarshal certificate revocation record: %s", err)
+	}
+	return revokedCert, err
+}
+
+// getRenewAfter returns the time after which a certificate valid in the given
+// time range should be renewed
+func getRenewAfter(notBefore, notAfter time.Time) time.Time {
+	fraction := RenewAfterFraction
+	if fraction <= 0 || fraction > 1 {
+		fraction = 1
+	}
+	return notBefore.Add(time.Duration(float64(notAfter.Sub(notBefore)) * fraction))
+}
+
 // Verify that the certificate is signed by our CA
 func (srv *CertifierServer) verifyCert(clientCert *x509.Certificate, certType protos.CertType) error {
 	// Check if CAInfo / cert exists for requested cert type
@@ -247,6 +299,7 @@ func (srv *CertifierServer) SignAddCertificate(ctx context.Context, csrMsg *prot
 
 	notBeforeProto, _ := ptypes.TimestampProto(notBefore)
 	notAfterProto, _ := ptypes.TimestampProto(notAfter)
+	renewAfterProto, _ := ptypes.TimestampProto(getRenewAfter(notBefore, notAfter))
 
 	// create CertificateInfo
 	certInfo := certprotos.CertificateInfo{
@@ -270,10 +323,11 @@ func (srv *CertifierServer) SignAddCertificate(ctx context.Context, csrMsg *prot
 
 	// create Certificate
 	certMsg := protos.Certificate{
-		Sn:        &protos.Certificate_SN{Sn: snString},
-		NotBefore: notBeforeProto,
-		NotAfter:  notAfterProto,
-		CertDer:   certDER,
+		Sn:         &protos.Certificate_SN{Sn: snString},
+		NotBefore:  notBeforeProto,
+		NotAfter:   notAfterProto,
+		CertDer:    certDER,
+		RenewAfter: renewAfterProto,
 	}
 	return &certMsg, nil
 }
@@ -315,9 +369,23 @@ func (srv *CertifierServer) RevokeCertificate(
 	if snMsg != nil {
 		certSN = strings.TrimLeft(snMsg.Sn, "0")
 	}
-	_, _, err := srv.store.Get(CERTIFICATE_INFO_TABLE, certSN)
+	certInfo, err := srv.getCertInfo(certSN)
 	if err != nil {
-		return nil, status.Errorf(codes.NotFound, "Cannot find certificate with SN: %s", certSN)
+		if status.Code(err) == codes.NotFound {
+			return nil, status.Errorf(codes.NotFound, "Cannot find certificate with SN: %s", certSN)
+		}
+		return nil, err
+	}
+	// keep the revocation record for CRL & OCSP until the certificate expires
+	revocationTime, _ := ptypes.TimestampProto(time.Now().UTC())
+	marshaledRevokedCert, err := proto.Marshal(
+		&certprotos.RevokedCertificate{Info: certInfo, RevocationTime: revocationTime})
+	if err != nil {
+		return nil, status.Errorf(codes.Aborted, "Marshalling error in RevokedCertificate: %s", err)
+	}
+	err = srv.store.Put(CERTIFICATE_REVOCATION_TABLE, certSN, marshaledRevokedCert)
+	if err != nil {
+		return nil, status.Errorf(codes.Aborted, "Failed to add certificate revocation: %s", err)
 	}
 	err = srv.store.Delete(CERTIFICATE_INFO_TABLE, certSN)
 	if err != nil {
@@ -467,6 +535,34 @@ func (srv *CertifierServer) CollectGarbage(ctx context.Context, void *protos.Voi
 	if count > 0 {
 		glog.V(2).Infof("Removed %d stale certificates", count)
 	}
+	revokedSns, err := srv.store.ListKeys(CERTIFICATE_REVOCATION_TABLE)
+	if err != nil {
+		return res, status.Errorf(
+			codes.Internal, "Failed to list certificate revocations: %s", err)
+	}
+	count = 0
+	for _, sn := range revokedSns {
+		revokedCert, err := srv.getRevokedCert(sn)
+		if err != nil {
+			return res, err
+		}
+		notAfter, _ := ptypes.Timestamp(revokedCert.GetInfo().GetNotAfter())
+		notAfter = notAfter.Add(CollectGarbageAfter)
+		if time.Now().UTC().After(notAfter) {
+			err = srv.store.Delete(CERTIFICATE_REVOCATION_TABLE, sn)
+			if err != nil {
+				errorList = append(errorList, struct {
+					sn  string
+					err error
+				}{sn, err})
+			} else {
+				count += 1
+			}
+		}
+	}
+	if count > 0 {
+		glog.V(2).Infof("Removed %d stale certificate revocations", count)
+	}
 	if len(errorList) > 0 {
 		msg := "Failed to delete certificate[s]:"
 		for _, e := range errorList {
@@ -9,11 +9,15 @@ LICENSE file in the root directory of this source tree.
 package servicers_test
 
 import (
+	"crypto"
 	"crypto/x509"
 	"testing"
 	"time"
 
 	"magma/orc8r/cloud/go/protos"
+	"magma/orc8r/cloud/go/security/key"
+	"magma/orc8r/cloud/go/security/signer"
+	signer_test_utils "magma/orc8r/cloud/go/security/signer/test_utils"
 	"magma/orc8r/cloud/go/services/certifier/servicers"
 	certifier_test_utils "magma/orc8r/cloud/go/services/certifier/test_utils"
 	"magma/orc8r/cloud/go/test_utils"
@@ -116,3 +120,43 @@ func TestCertifier(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, cert.Subject.CommonName, *csrMsg.Id.ToCommonName())
 }
+
+func TestCertifierRemoteSigner(t *testing.T) {
+	ctx := context.Background()
+	caCert, caKey, err := certifier_test_utils.CreateSignedCertAndPrivKey(
+		time.Duration(time.Hour * 24 * 10))
+	assert.NoError(t, err)
+
+	// CA private key is only available through the remote signing service
+	addr, files, stop := signer_test_utils.StartRemoteSigner(
+		t, map[string]crypto.Signer{"ca": caKey.(crypto.Signer)})
+	defer stop()
+	caSigner, err := signer.Open(files.URI(addr, "ca"))
+	assert.NoError(t, err)
+
+	caMap := map[protos.CertType]*servicers.CAInfo{
+		protos.CertType_DEFAULT: {Cert: caCert, PrivKey: caSigner},
+	}
+	srv, err := servicers.NewCertifierServer(test_utils.NewMockDatastore(), caMap)
+	assert.NoError(t, err)
+
+	csrMsg, err := certifier_test_utils.CreateCSR(time.Duration(time.Hour*24), "cn", "cn")
+	assert.NoError(t, err)
+	certMsg, err := srv.SignAddCertificate(ctx, csrMsg)
+	assert.NoError(t, err)
+	cert, err := x509.ParseCertificate(certMsg.CertDer)
+	assert.NoError(t, err)
+	assert.NoError(t, cert.CheckSignatureFrom(caCert))
+
+	// signer not matching the CA certificate
+	otherKey, err := key.GenerateKey("P256", 0)
+	assert.NoError(t, err)
+	caMap[protos.CertType_DEFAULT].PrivKey = otherKey
+	_, err = servicers.NewCertifierServer(test_utils.NewMockDatastore(), caMap)
+	assert.Error(t, err)
+
+	// key which cannot sign
+	caMap[protos.CertType_DEFAULT].PrivKey = "not a key"
+	_, err = servicers.NewCertifierServer(test_utils.NewMockDatastore(), caMap)
+	assert.EqualError(t, err, "CA private key of cert type DEFAULT cannot sign")
+}
@@ -0,0 +1,252 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package servicers
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"fmt"
+	"math/big"
+	"time"
+
+	"magma/orc8r/cloud/go/datastore"
+	"magma/orc8r/cloud/go/protos"
+	"magma/orc8r/cloud/go/security/cert"
+	certprotos "magma/orc8r/cloud/go/services/certifier/protos"
+
+	"github.com/golang/glog"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"golang.org/x/crypto/ocsp"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetCRL returns a CRL of all revoked & not yet expired certificates of the
+// requested CA signed by the CA
+func (srv *CertifierServer) GetCRL(ctx context.Context, req *certprotos.GetCRLRequest) (*certprotos.CRL, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid CRL request")
+	}
+	ca, ok := srv.CAs[req.CertType]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "No CA found for given cert type: %s", req.CertType.String())
+	}
+	signer, ok := ca.PrivKey.(crypto.Signer)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "CA private key of cert type %s cannot sign", req.CertType.String())
+	}
+	issuer, err := getCRLIssuer(ca.Cert)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Invalid CRL issuer: %s", err)
+	}
+
+	now := time.Now().UTC()
+	revokedCerts, err := srv.getRevokedCerts(req.CertType, now)
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]x509.RevocationListEntry, 0, len(revokedCerts))
+	for sn, revokedCert := range revokedCerts {
+		serialNumber, ok := new(big.Int).SetString(sn, 16)
+		if !ok {
+			glog.Errorf("Invalid serial number of revoked certificate: %s", sn)
+			continue
+		}
+		revocationTime, _ := ptypes.Timestamp(revokedCert.RevocationTime)
+		entries = append(entries, x509.RevocationListEntry{SerialNumber: serialNumber, RevocationTime: revocationTime})
+	}
+	nextUpdate := now.Add(CRLValidity)
+	crlDER, err := x509.CreateRevocationList(
+		rand.Reader,
+		&x509.RevocationList{
+			Number:                    big.NewInt(now.UnixNano()),
+			ThisUpdate:                now,
+			NextUpdate:                nextUpdate,
+			RevokedCertificateEntries: entries,
+		},
+		issuer,
+		signer)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to create CRL: %s", err)
+	}
+	thisUpdateProto, _ := ptypes.TimestampProto(now)
+	nextUpdateProto, _ := ptypes.TimestampProto(nextUpdate)
+	return &certprotos.CRL{CrlDer: crlDER, ThisUpdate: thisUpdateProto, NextUpdate: nextUpdateProto}, nil
+}
+
+// GetOCSPResponse returns the signed OCSP response for the certificate status
+// request. Certificates in the store are reported good, revoked certificates are
+// reported revoked until they expire & any other certificate is reported unknown.
+// OCSP errors are returned as signed error responses.
+func (srv *CertifierServer) GetOCSPResponse(
+	ctx context.Context, req *certprotos.OCSPRequest) (*certprotos.OCSPResponse, error) {
+
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid OCSP request")
+	}
+	ocspReq, err := ocsp.ParseRequest(req.RequestDer)
+	if err != nil {
+		glog.V(2).Infof("Malformed OCSP request: %s", err)
+		return &certprotos.OCSPResponse{ResponseDer: ocsp.MalformedRequestErrorResponse}, nil
+	}
+	certType, ca, ok := srv.findOCSPIssuer(ocspReq)
+	if !ok {
+		return &certprotos.OCSPResponse{ResponseDer: ocsp.UnauthorizedErrorResponse}, nil
+	}
+	signer, ok := ca.PrivKey.(crypto.Signer)
+	if !ok {
+		glog.Errorf("CA private key of cert type %s cannot sign OCSP responses", certType.String())
+		return &certprotos.OCSPResponse{ResponseDer: ocsp.InternalErrorErrorResponse}, nil
+	}
+
+	now := time.Now().UTC()
+	template := ocsp.Response{
+		Status:       ocsp.Unknown,
+		SerialNumber: ocspReq.SerialNumber,
+		ThisUpdate:   now,
+		NextUpdate:   now.Add(OCSPValidity),
+		IssuerHash:   ocspReq.HashAlgorithm,
+	}
+	err = srv.fillOCSPStatus(&template, certType, now)
+	if err != nil {
+		glog.Errorf("Failed to get OCSP status of certificate SN %s: %s", ocspReq.SerialNumber.Text(16), err)
+		return &certprotos.OCSPResponse{ResponseDer: ocsp.InternalErrorErrorResponse}, nil
+	}
+	respDER, err := ocsp.CreateResponse(ca.Cert, ca.Cert, template, signer)
+	if err != nil {
+		glog.Errorf("Failed to create OCSP response: %s", err)
+		return &certprotos.OCSPResponse{ResponseDer: ocsp.InternalErrorErrorResponse}, nil
+	}
+	return &certprotos.OCSPResponse{ResponseDer: respDER}, nil
+}
+
+// fillOCSPStatus sets the status of the requested certificate of the given CA type
+func (srv *CertifierServer) fillOCSPStatus(resp *ocsp.Response, certType protos.CertType, now time.Time) error {
+	sn := cert.SerialToString(resp.SerialNumber)
+	marshaledCertInfo, _, err := srv.store.Get(CERTIFICATE_INFO_TABLE, sn)
+	if err == nil {
+		certInfo := &certprotos.CertificateInfo{}
+		if err = proto.Unmarshal(marshaledCertInfo, certInfo); err != nil {
+			return err
+		}
+		if certInfo.CertType == certType {
+			resp.Status = ocsp.Good
+		}
+		return nil
+	}
+	if !datastore.IsErrNotFound(err) {
+		return err
+	}
+	revokedCert, err := srv.getRevokedCert(sn)
+	if status.Code(err) == codes.NotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	notAfter, _ := ptypes.Timestamp(revokedCert.GetInfo().GetNotAfter())
+	if revokedCert.GetInfo().GetCertType() == certType && now.Before(notAfter) {
+		resp.Status = ocsp.Revoked
+		resp.RevokedAt, _ = ptypes.Timestamp(revokedCert.RevocationTime)
+		resp.RevocationReason = ocsp.Unspecified
+	}
+	return nil
+}
+
+// findOCSPIssuer returns the CA matching the issuer name & key hashes of the request
+func (srv *CertifierServer) findOCSPIssuer(req *ocsp.Request) (protos.CertType, *CAInfo, bool) {
+	if !req.HashAlgorithm.Available() {
+		return 0, nil, false
+	}
+	for certType, ca := range srv.CAs {
+		if ca == nil || ca.Cert == nil {
+			continue
+		}
+		publicKey, err := getSubjectPublicKey(ca.Cert)
+		if err != nil {
+			glog.Errorf("Failed to parse public key of %s CA: %s", certType.String(), err)
+			continue
+		}
+		nameHash := req.HashAlgorithm.New()
+		nameHash.Write(ca.Cert.RawSubject)
+		keyHash := req.HashAlgorithm.New()
+		keyHash.Write(publicKey)
+		if bytes.Equal(nameHash.Sum(nil), req.IssuerNameHash) && bytes.Equal(keyHash.Sum(nil), req.IssuerKeyHash) {
+			return certType, ca, true
+		}
+	}
+	return 0, nil, false
+}
+
+// getRevokedCerts returns revoked, not yet expired certificates of the given CA type
+func (srv *CertifierServer) getRevokedCerts(
+	certType protos.CertType, now time.Time) (map[string]*certprotos.RevokedCertificate, error) {
+
+	snList, err := srv.store.ListKeys(CERTIFICATE_REVOCATION_TABLE)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to list certificate revocations: %s", err)
+	}
+	res := map[string]*certprotos.RevokedCertificate{}
+	for _, sn := range snList {
+		revokedCert, err := srv.getRevokedCert(sn)
+		if err != nil {
+			return nil, err
+		}
+		notAfter, _ := ptypes.Timestamp(revokedCert.GetInfo().GetNotAfter())
+		if revokedCert.GetInfo().GetCertType() == certType && now.Before(notAfter) {
+			res[sn] = revokedCert
+		}
+	}
+	return res, nil
+}
+
+// getCRLIssuer returns the CA cert to issue CRLs with. CA certs without a key
+// usage extension may be used for any purpose (RFC 5280, 4.2.1.3) and CA certs
+// without a subject key identifier get one derived from their public key (RFC
+// 5280, 4.2.1.2 method 1), so both are filled in on a copy of the CA cert.
+func getCRLIssuer(caCert *x509.Certificate) (*x509.Certificate, error) {
+	if caCert == nil {
+		return nil, fmt.Errorf("Missing CA certificate")
+	}
+	issuer := *caCert
+	if issuer.KeyUsage == 0 {
+		issuer.KeyUsage = x509.KeyUsageCRLSign
+	}
+	if issuer.KeyUsage&x509.KeyUsageCRLSign == 0 {
+		return nil, fmt.Errorf("CA certificate is not allowed to sign CRLs")
+	}
+	if len(issuer.SubjectKeyId) == 0 {
+		publicKey, err := getSubjectPublicKey(caCert)
+		if err != nil {
+			return nil, err
+		}
+		ski := sha1.Sum(publicKey)
+		issuer.SubjectKeyId = ski[:]
+	}
+	return &issuer, nil
+}
+
+// getSubjectPublicKey returns the subject public key bits of the certificate
+func getSubjectPublicKey(cert *x509.Certificate) ([]byte, error) {
+	var publicKeyInfo struct {
+		Algorithm pkix.AlgorithmIdentifier
+		PublicKey asn1.BitString
+	}
+	if _, err := asn1.Unmarshal(cert.RawSubjectPublicKeyInfo, &publicKeyInfo); err != nil {
+		return nil, err
+	}
+	return publicKeyInfo.PublicKey.RightAlign(), nil
+}
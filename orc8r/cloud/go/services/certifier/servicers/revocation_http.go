@@ -0,0 +1,110 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package servicers
+
+import (
+	"encoding/base64"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"magma/orc8r/cloud/go/protos"
+	certprotos "magma/orc8r/cloud/go/services/certifier/protos"
+
+	"github.com/golang/glog"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	CRLPath  = "/crl/"
+	OCSPPath = "/ocsp"
+
+	crlContentType          = "application/pkix-crl"
+	ocspRequestContentType  = "application/ocsp-request"
+	ocspResponseContentType = "application/ocsp-response"
+
+	// maxOCSPRequestSize limits the size of POSTed OCSP requests
+	maxOCSPRequestSize = 16 * 1024
+)
+
+// NewRevocationHandler returns an HTTP handler distributing the certifier's
+// revocation information:
+//
+//	GET  /crl/<CERT_TYPE>         - DER encoded CRL of the CA (e.g. /crl/DEFAULT)
+//	POST /ocsp                    - OCSP responder (RFC 6960, A.1)
+//	GET  /ocsp/<base64 request>   - OCSP responder (RFC 6960, A.1)
+func (srv *CertifierServer) NewRevocationHandler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc(CRLPath, srv.crlHandler)
+	mux.HandleFunc(OCSPPath, srv.ocspHandler)
+	mux.HandleFunc(OCSPPath+"/", srv.ocspHandler)
+	return mux
+}
+
+func (srv *CertifierServer) crlHandler(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	certTypeName := strings.ToUpper(strings.TrimPrefix(req.URL.Path, CRLPath))
+	certType, ok := protos.CertType_value[certTypeName]
+	if !ok {
+		http.Error(w, "Unknown cert type: "+certTypeName, http.StatusNotFound)
+		return
+	}
+	crl, err := srv.GetCRL(context.Background(), &certprotos.GetCRLRequest{CertType: protos.CertType(certType)})
+	if err != nil {
+		glog.Errorf("Failed to get %s CRL: %s", certTypeName, err)
+		if status.Code(err) == codes.NotFound {
+			http.Error(w, status.Convert(err).Message(), http.StatusNotFound)
+		} else {
+			http.Error(w, "Failed to get CRL", http.StatusInternalServerError)
+		}
+		return
+	}
+	w.Header().Set("Content-Type", crlContentType)
+	w.Write(crl.CrlDer)
+}
+
+func (srv *CertifierServer) ocspHandler(w http.ResponseWriter, req *http.Request) {
+	var reqDER []byte
+	var err error
+	switch req.Method {
+	case http.MethodPost:
+		if ct := req.Header.Get("Content-Type"); len(ct) > 0 && ct != ocspRequestContentType {
+			http.Error(w, "Unsupported content type: "+ct, http.StatusUnsupportedMediaType)
+			return
+		}
+		reqDER, err = ioutil.ReadAll(http.MaxBytesReader(w, req.Body, maxOCSPRequestSize))
+	case http.MethodGet:
+		var encoded string
+		encoded, err = url.PathUnescape(strings.TrimPrefix(strings.TrimPrefix(req.URL.Path, OCSPPath), "/"))
+		if err == nil {
+			reqDER, err = base64.StdEncoding.DecodeString(encoded)
+		}
+	default:
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if err != nil {
+		http.Error(w, "Invalid OCSP request", http.StatusBadRequest)
+		return
+	}
+	resp, err := srv.GetOCSPResponse(context.Background(), &certprotos.OCSPRequest{RequestDer: reqDER})
+	if err != nil {
+		glog.Errorf("Failed to get OCSP response: %s", err)
+		http.Error(w, "Failed to get OCSP response", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", ocspResponseContentType)
+	w.Write(resp.ResponseDer)
+}
@@ -0,0 +1,218 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package servicers_test
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/x509"
+	"encoding/base64"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"magma/orc8r/cloud/go/protos"
+	certprotos "magma/orc8r/cloud/go/services/certifier/protos"
+	"magma/orc8r/cloud/go/services/certifier/servicers"
+	certifier_test_utils "magma/orc8r/cloud/go/services/certifier/test_utils"
+	"magma/orc8r/cloud/go/test_utils"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/ocsp"
+	"golang.org/x/net/context"
+)
+
+func newTestCertifier(t *testing.T) (*servicers.CertifierServer, *x509.Certificate) {
+	caCert, caKey, err := certifier_test_utils.CreateSignedCertAndPrivKey(
+		time.Duration(time.Hour * 24 * 10))
+	assert.NoError(t, err)
+	caMap := map[protos.CertType]*servicers.CAInfo{
+		protos.CertType_DEFAULT: {Cert: caCert, PrivKey: caKey},
+	}
+	srv, err := servicers.NewCertifierServer(test_utils.NewMockDatastore(), caMap)
+	assert.NoError(t, err)
+	return srv, caCert
+}
+
+func signTestCert(t *testing.T, srv *servicers.CertifierServer, validTime time.Duration) (*protos.Certificate, *x509.Certificate) {
+	csrMsg, err := certifier_test_utils.CreateCSR(validTime, "cn", "cn")
+	assert.NoError(t, err)
+	certMsg, err := srv.SignAddCertificate(context.Background(), csrMsg)
+	assert.NoError(t, err)
+	cert, err := x509.ParseCertificate(certMsg.CertDer)
+	assert.NoError(t, err)
+	return certMsg, cert
+}
+
+func getOCSPStatus(t *testing.T, srv *servicers.CertifierServer, cert, caCert *x509.Certificate) *ocsp.Response {
+	reqDER, err := ocsp.CreateRequest(cert, caCert, &ocsp.RequestOptions{Hash: crypto.SHA1})
+	assert.NoError(t, err)
+	resp, err := srv.GetOCSPResponse(context.Background(), &certprotos.OCSPRequest{RequestDer: reqDER})
+	assert.NoError(t, err)
+	ocspResp, err := ocsp.ParseResponseForCert(resp.ResponseDer, cert, caCert)
+	assert.NoError(t, err)
+	return ocspResp
+}
+
+func TestRenewAfter(t *testing.T) {
+	srv, _ := newTestCertifier(t)
+
+	certMsg, _ := signTestCert(t, srv, time.Hour*24*9)
+	notBefore, _ := ptypes.Timestamp(certMsg.NotBefore)
+	notAfter, _ := ptypes.Timestamp(certMsg.NotAfter)
+	renewAfter, err := ptypes.Timestamp(certMsg.RenewAfter)
+	assert.NoError(t, err)
+	assert.Equal(t, notBefore.Add(notAfter.Sub(notBefore)*2/3), renewAfter)
+
+	// invalid fractions renew at expiration
+	servicers.RenewAfterFraction = 0
+	defer func() { servicers.RenewAfterFraction = 2.0 / 3.0 }()
+	certMsg, _ = signTestCert(t, srv, time.Hour*24*9)
+	assert.True(t, proto.Equal(certMsg.NotAfter, certMsg.RenewAfter))
+}
+
+func TestGetCRL(t *testing.T) {
+	srv, caCert := newTestCertifier(t)
+	ctx := context.Background()
+
+	crl, err := srv.GetCRL(ctx, &certprotos.GetCRLRequest{CertType: protos.CertType_DEFAULT})
+	assert.NoError(t, err)
+	revocationList, err := x509.ParseRevocationList(crl.CrlDer)
+	assert.NoError(t, err)
+	assert.NoError(t, revocationList.CheckSignatureFrom(caCert))
+	assert.Empty(t, revocationList.RevokedCertificateEntries)
+
+	revokedMsg, revokedCert := signTestCert(t, srv, time.Hour*24)
+	signTestCert(t, srv, time.Hour*24)
+	_, err = srv.RevokeCertificate(ctx, revokedMsg.Sn)
+	assert.NoError(t, err)
+
+	crl, err = srv.GetCRL(ctx, &certprotos.GetCRLRequest{CertType: protos.CertType_DEFAULT})
+	assert.NoError(t, err)
+	revocationList, err = x509.ParseRevocationList(crl.CrlDer)
+	assert.NoError(t, err)
+	assert.NoError(t, revocationList.CheckSignatureFrom(caCert))
+	assert.Len(t, revocationList.RevokedCertificateEntries, 1)
+	assert.Equal(t, revokedCert.SerialNumber, revocationList.RevokedCertificateEntries[0].SerialNumber)
+	thisUpdate, _ := ptypes.Timestamp(crl.ThisUpdate)
+	nextUpdate, _ := ptypes.Timestamp(crl.NextUpdate)
+	assert.Equal(t, servicers.CRLValidity, nextUpdate.Sub(thisUpdate))
+
+	// no CA for VPN certs
+	_, err = srv.GetCRL(ctx, &certprotos.GetCRLRequest{CertType: protos.CertType_VPN})
+	assert.Error(t, err)
+
+	// revocation records are garbage collected after the certificate expires
+	servicers.CollectGarbageAfter = time.Duration(0)
+	defer func() { servicers.CollectGarbageAfter = time.Duration(time.Hour * 24) }()
+	expiredMsg, _ := signTestCert(t, srv, 0)
+	_, err = srv.RevokeCertificate(ctx, expiredMsg.Sn)
+	assert.NoError(t, err)
+	_, err = srv.CollectGarbage(ctx, &protos.Void{})
+	assert.NoError(t, err)
+	crl, err = srv.GetCRL(ctx, &certprotos.GetCRLRequest{CertType: protos.CertType_DEFAULT})
+	assert.NoError(t, err)
+	revocationList, err = x509.ParseRevocationList(crl.CrlDer)
+	assert.NoError(t, err)
+	assert.Len(t, revocationList.RevokedCertificateEntries, 1)
+}
+
+func TestGetOCSPResponse(t *testing.T) {
+	srv, caCert := newTestCertifier(t)
+	ctx := context.Background()
+
+	certMsg, cert := signTestCert(t, srv, time.Hour*24)
+	resp := getOCSPStatus(t, srv, cert, caCert)
+	assert.Equal(t, ocsp.Good, resp.Status)
+	assert.Equal(t, cert.SerialNumber, resp.SerialNumber)
+
+	_, err := srv.RevokeCertificate(ctx, certMsg.Sn)
+	assert.NoError(t, err)
+	resp = getOCSPStatus(t, srv, cert, caCert)
+	assert.Equal(t, ocsp.Revoked, resp.Status)
+	assert.False(t, resp.RevokedAt.IsZero())
+
+	// certificate unknown to the certifier
+	otherSrv, _ := newTestCertifier(t)
+	_, otherCert := signTestCert(t, otherSrv, time.Hour*24)
+	otherCert.SerialNumber.Add(otherCert.SerialNumber, otherCert.SerialNumber)
+	resp = getOCSPStatus(t, srv, otherCert, caCert)
+	assert.Equal(t, ocsp.Unknown, resp.Status)
+
+	// certificate of another CA
+	_, otherCA := newTestCertifier(t)
+	reqDER, err := ocsp.CreateRequest(cert, otherCA, nil)
+	assert.NoError(t, err)
+	ocspResp, err := srv.GetOCSPResponse(ctx, &certprotos.OCSPRequest{RequestDer: reqDER})
+	assert.NoError(t, err)
+	assert.Equal(t, ocsp.UnauthorizedErrorResponse, ocspResp.ResponseDer)
+
+	ocspResp, err = srv.GetOCSPResponse(ctx, &certprotos.OCSPRequest{RequestDer: []byte("garbage")})
+	assert.NoError(t, err)
+	assert.Equal(t, ocsp.MalformedRequestErrorResponse, ocspResp.ResponseDer)
+}
+
+func TestRevocationHandler(t *testing.T) {
+	srv, caCert := newTestCertifier(t)
+	httpSrv := httptest.NewServer(srv.NewRevocationHandler())
+	defer httpSrv.Close()
+
+	certMsg, cert := signTestCert(t, srv, time.Hour*24)
+	_, err := srv.RevokeCertificate(context.Background(), certMsg.Sn)
+	assert.NoError(t, err)
+
+	resp, err := http.Get(httpSrv.URL + "/crl/DEFAULT")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "application/pkix-crl", resp.Header.Get("Content-Type"))
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	assert.NoError(t, err)
+	revocationList, err := x509.ParseRevocationList(body)
+	assert.NoError(t, err)
+	assert.Len(t, revocationList.RevokedCertificateEntries, 1)
+
+	resp, err = http.Get(httpSrv.URL + "/crl/VPN")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	resp, err = http.Get(httpSrv.URL + "/crl/UNKNOWN")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	reqDER, err := ocsp.CreateRequest(cert, caCert, nil)
+	assert.NoError(t, err)
+	resp, err = http.Post(httpSrv.URL+"/ocsp", "application/ocsp-request", bytes.NewReader(reqDER))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "application/ocsp-response", resp.Header.Get("Content-Type"))
+	body, err = ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	assert.NoError(t, err)
+	ocspResp, err := ocsp.ParseResponseForCert(body, cert, caCert)
+	assert.NoError(t, err)
+	assert.Equal(t, ocsp.Revoked, ocspResp.Status)
+
+	resp, err = http.Get(httpSrv.URL + "/ocsp/" + base64.StdEncoding.EncodeToString(reqDER))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	body, err = ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	assert.NoError(t, err)
+	ocspResp, err = ocsp.ParseResponseForCert(body, cert, caCert)
+	assert.NoError(t, err)
+	assert.Equal(t, ocsp.Revoked, ocspResp.Status)
+
+	resp, err = http.Get(httpSrv.URL + "/ocsp/not-base64!")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
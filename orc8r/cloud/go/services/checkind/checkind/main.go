@@ -23,8 +23,10 @@ import (
 	"magma/orc8r/cloud/go/orc8r"
 	"magma/orc8r/cloud/go/protos"
 	"magma/orc8r/cloud/go/service"
+	"magma/orc8r/cloud/go/service/config"
 	"magma/orc8r/cloud/go/services/checkind"
 	"magma/orc8r/cloud/go/services/checkind/metrics"
+	"magma/orc8r/cloud/go/services/checkind/offline"
 	"magma/orc8r/cloud/go/services/checkind/servicers"
 	"magma/orc8r/cloud/go/services/checkind/store"
 	"magma/orc8r/cloud/go/sql_utils"
@@ -33,6 +35,16 @@ import (
 const (
 	// how often to report checkin status
 	GATEWAY_CHECKIN_STATUS_REPORT_INTERVAL = time.Second * 60
+	// how often to look for gateways which stopped checking in
+	GATEWAY_OFFLINE_DETECTION_INTERVAL = time.Second * 30
+
+	// status history retention config keys
+	STATUS_HISTORY_MAX_ENTRIES_CONFIG  = "status_history_max_entries"
+	STATUS_HISTORY_MAX_AGE_SECS_CONFIG = "status_history_max_age_secs"
+
+	// offline detection config keys
+	OFFLINE_MISSED_CHECKINS_CONFIG = "offline_missed_checkins"
+	OFFLINE_WEBHOOK_URL_CONFIG     = "offline_webhook_url"
 )
 
 func main() {
@@ -49,7 +61,7 @@ func main() {
 		log.Fatalf("Failed to initialize datastore: %s", err)
 	}
 
-	checkinStore, err := store.NewCheckinStore(ds)
+	checkinStore, err := store.NewCheckinStoreWithHistoryRetention(ds, getHistoryRetention(srv.Config))
 	if err != nil {
 		log.Fatalf("Failed to initialize checkin store: %s", err)
 	}
@@ -70,9 +82,50 @@ func main() {
 	}
 	go gwStatusReporter.ReportCheckinStatus(GATEWAY_CHECKIN_STATUS_REPORT_INTERVAL)
 
+	// flag gateways which missed too many checkins as offline and emit their
+	// online/offline transitions
+	offlineDetector, err := newOfflineDetector(checkinStore, srv.Config)
+	if err != nil {
+		log.Fatalf("Offline Detector Initialization Error: %s\n", err)
+	}
+	go offlineDetector.Run(GATEWAY_OFFLINE_DETECTION_INTERVAL)
+
 	// Run the service
 	err = srv.Run()
 	if err != nil {
 		log.Fatalf("Error running service: %s", err)
 	}
 }
+
+// getHistoryRetention reads the status history retention from the service
+// config, falling back to the defaults for missing params
+func getHistoryRetention(cfg *config.ConfigMap) store.HistoryRetention {
+	retention := store.DefaultHistoryRetention
+	if cfg == nil {
+		return retention
+	}
+	if maxEntries, err := cfg.GetIntParam(STATUS_HISTORY_MAX_ENTRIES_CONFIG); err == nil {
+		retention.MaxEntries = maxEntries
+	}
+	if maxAgeSecs, err := cfg.GetIntParam(STATUS_HISTORY_MAX_AGE_SECS_CONFIG); err == nil {
+		retention.MaxAge = time.Duration(maxAgeSecs) * time.Second
+	}
+	return retention
+}
+
+// newOfflineDetector creates the offline detector from the service config,
+// events are only sent to a webhook if its URL is configured, from a queue so
+// that a slow webhook doesn't delay detection
+func newOfflineDetector(checkinStore *store.CheckinStore, cfg *config.ConfigMap) (*offline.Detector, error) {
+	missedCheckins := offline.DefaultMissedCheckins
+	var sinks []offline.EventSink
+	if cfg != nil {
+		if configured, err := cfg.GetIntParam(OFFLINE_MISSED_CHECKINS_CONFIG); err == nil {
+			missedCheckins = configured
+		}
+		if webhookURL, err := cfg.GetStringParam(OFFLINE_WEBHOOK_URL_CONFIG); err == nil && webhookURL != "" {
+			sinks = append(sinks, offline.NewAsyncSink(offline.NewWebhookSink(webhookURL), offline.DefaultEventQueueSize))
+		}
+	}
+	return offline.NewDetector(checkinStore, offline.NewMagmadGatewayDirectory(), missedCheckins, sinks...)
+}
@@ -53,6 +53,30 @@ func GetStatus(networkID string, logicalID string) (*protos.GatewayStatus, error
 	}
 }
 
+// GetStatusHistory returns the recorded checkins of the gateway with logicalID
+// in the network specified by networkID, ordered from the oldest to the newest.
+// startTime and endTime (unix time in milliseconds) optionally bound the
+// returned checkins, pass 0 to leave a bound open.
+func GetStatusHistory(networkID string, logicalID string, startTime uint64, endTime uint64) (*protos.GatewayStatusHistory, error) {
+	client, err := getCheckindClient()
+	if err != nil {
+		return nil, err
+	}
+
+	ret, err := client.GetStatusHistory(context.Background(), &protos.GatewayStatusHistoryRequest{
+		NetworkId: networkID,
+		LogicalId: logicalID,
+		StartTime: startTime,
+		EndTime:   endTime,
+	})
+	switch status.Code(err) {
+	case codes.NotFound:
+		return nil, errors.ErrNotFound
+	default:
+		return ret, err
+	}
+}
+
 // DeleteGatewayStatus removes the gateway status record from the gateway's network table
 // NOTE: the record will be created again after next successful gateway checkin
 func DeleteGatewayStatus(networkID string, logicalID string) error {
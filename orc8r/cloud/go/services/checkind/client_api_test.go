@@ -53,6 +53,16 @@ func TestCheckinAPI(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, protos.TestMarshal(checkinRequests["gw2"]), protos.TestMarshal(gw2Status.Checkin))
 
+	gw1History, err := checkind.GetStatusHistory("net1", "gw1", 0, 0)
+	assert.NoError(t, err)
+	assert.Len(t, gw1History.Entries, 1)
+	assert.Equal(t, gw1Status.Time, gw1History.Entries[0].Time)
+	assert.Equal(t, protos.TestMarshal(checkinRequests["gw1"].SystemStatus), protos.TestMarshal(gw1History.Entries[0].SystemStatus))
+
+	gw1History, err = checkind.GetStatusHistory("net1", "gw1", gw1Status.Time+1, 0)
+	assert.NoError(t, err)
+	assert.Empty(t, gw1History.Entries)
+
 	err = checkind.DeleteNetwork("net1")
 	assert.Error(t, err)
 	assert.True(t, strings.Contains(err.Error(), "Status table for network net1 is not empty"))
@@ -64,6 +74,9 @@ func TestCheckinAPI(t *testing.T) {
 	assert.Error(t, err)
 	assert.Equal(t, errors.ErrNotFound, err)
 
+	_, err = checkind.GetStatusHistory("net1", "gw1", 0, 0)
+	assert.Equal(t, errors.ErrNotFound, err)
+
 	err = checkind.DeleteGatewayStatus("net1", "gw2")
 	assert.NoError(t, err)
 
@@ -39,13 +39,49 @@ var (
 		},
 		[]string{"networkId", "gatewayId"},
 	)
+	gwOffline = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "gateway_offline",
+			Help: "1 if the gateway missed too many checkin intervals, 0 otherwise",
+		},
+		[]string{"networkId", "gatewayId"},
+	)
+	gwConnectivityEvents = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "gateway_connectivity_events",
+			Help: "Number of times the gateway went offline or came back online",
+		},
+		[]string{"networkId", "gatewayId", "state"},
+	)
 )
 
+// ReportGatewayOffline sets whether the gateway is currently flagged offline
+func ReportGatewayOffline(networkID string, gatewayID string, offline bool) {
+	if offline {
+		gwOffline.WithLabelValues(networkID, gatewayID).Set(1)
+	} else {
+		gwOffline.WithLabelValues(networkID, gatewayID).Set(0)
+	}
+}
+
+// ClearGatewayOffline drops the offline gauge of a removed gateway
+func ClearGatewayOffline(networkID string, gatewayID string) {
+	gwOffline.DeleteLabelValues(networkID, gatewayID)
+}
+
+// ReportGatewayConnectivityEvent counts a transition of the gateway to the
+// given state
+func ReportGatewayConnectivityEvent(networkID string, gatewayID string, state string) {
+	gwConnectivityEvents.WithLabelValues(networkID, gatewayID, state).Inc()
+}
+
 func init() {
 	prometheus.MustRegister(
 		gwCheckinStatus,
 		upGwCount,
 		totalGwCount,
 		gwMconfigAge,
+		gwOffline,
+		gwConnectivityEvents,
 	)
 }
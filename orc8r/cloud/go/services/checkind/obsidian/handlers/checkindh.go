@@ -9,8 +9,13 @@ LICENSE file in the root directory of this source tree.
 package handlers
 
 import (
+	"fmt"
 	"net/http"
+	"strconv"
 
+	merrors "magma/orc8r/cloud/go/errors"
+	"magma/orc8r/cloud/go/services/checkind"
+	"magma/orc8r/cloud/go/services/checkind/obsidian/models"
 	"magma/orc8r/cloud/go/services/magmad"
 	stateh "magma/orc8r/cloud/go/services/state/obsidian/handlers"
 
@@ -19,7 +24,10 @@ import (
 	"magma/orc8r/cloud/go/obsidian/handlers"
 )
 
-const AgStatusUrl = handlers.NETWORKS_ROOT + "/:network_id/gateways/:logical_ag_id/status"
+const (
+	AgStatusUrl        = handlers.NETWORKS_ROOT + "/:network_id/gateways/:logical_ag_id/status"
+	AgStatusHistoryUrl = AgStatusUrl + "/history"
+)
 
 // GetObsidianHandlers returns all handlers for checkind
 func GetObsidianHandlers() []handlers.Handler {
@@ -47,5 +55,58 @@ func GetObsidianHandlers() []handlers.Handler {
 				return c.JSON(http.StatusOK, &gwStatus)
 			},
 		},
+		{
+			Path:        AgStatusHistoryUrl,
+			Methods:     handlers.GET,
+			HandlerFunc: getGatewayStatusHistory,
+		},
+	}
+}
+
+func getGatewayStatusHistory(c echo.Context) error {
+	networkID, nerr := handlers.GetNetworkId(c)
+	if nerr != nil {
+		return nerr
+	}
+	lid := c.Param("logical_ag_id")
+	startTime, nerr := getTimeQueryParam(c, "start_time")
+	if nerr != nil {
+		return nerr
+	}
+	endTime, nerr := getTimeQueryParam(c, "end_time")
+	if nerr != nil {
+		return nerr
+	}
+
+	if _, err := magmad.FindGatewayRecord(networkID, lid); err != nil {
+		return handlers.HttpError(err, http.StatusNotFound)
+	}
+	history, err := checkind.GetStatusHistory(networkID, lid, startTime, endTime)
+	if err == merrors.ErrNotFound {
+		return handlers.HttpError(err, http.StatusNotFound)
+	}
+	if err != nil {
+		return handlers.HttpError(err, http.StatusInternalServerError)
+	}
+
+	ret := make([]*models.CheckinHistoryEntry, 0, len(history.GetEntries()))
+	for _, entry := range history.GetEntries() {
+		ret = append(ret, models.CheckinHistoryEntryFromProto(entry))
+	}
+	return c.JSON(http.StatusOK, ret)
+}
+
+func getTimeQueryParam(c echo.Context, name string) (uint64, *echo.HTTPError) {
+	param := c.QueryParam(name)
+	if param == "" {
+		return 0, nil
+	}
+	ret, err := strconv.ParseUint(param, 10, 64)
+	if err != nil {
+		return 0, handlers.HttpError(
+			fmt.Errorf("Invalid %s '%s': must be a unix time in milliseconds", name, param),
+			http.StatusBadRequest,
+		)
 	}
+	return ret, nil
 }
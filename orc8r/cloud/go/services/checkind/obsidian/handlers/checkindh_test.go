@@ -9,7 +9,9 @@ LICENSE file in the root directory of this source tree.
 package handlers_test
 
 import (
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"testing"
 
 	"magma/orc8r/cloud/go/obsidian/handlers"
@@ -17,6 +19,7 @@ import (
 	"magma/orc8r/cloud/go/plugin"
 	"magma/orc8r/cloud/go/pluginimpl"
 	"magma/orc8r/cloud/go/protos"
+	"magma/orc8r/cloud/go/services/checkind/obsidian/models"
 	checkindTestInit "magma/orc8r/cloud/go/services/checkind/test_init"
 	"magma/orc8r/cloud/go/services/checkind/test_utils"
 	"magma/orc8r/cloud/go/services/magmad"
@@ -64,6 +67,62 @@ func TestCheckind(t *testing.T) {
 	magmad.ForceRemoveNetwork(testNetworkID)
 }
 
+func TestCheckindStatusHistory(t *testing.T) {
+	plugin.RegisterPluginForTests(t, &pluginimpl.BaseOrchestratorPlugin{})
+	magmadTestInit.StartTestService(t)
+	checkindTestInit.StartTestService(t)
+	restPort := tests.StartObsidian(t)
+
+	testNetworkID, err := magmad.RegisterNetwork(
+		&magmadProtos.MagmadNetworkRecord{Name: "Test Network 2"},
+		"checkind_obsidian_history_test_network")
+	assert.NoError(t, err)
+	hwID := protos.AccessGatewayID{Id: testAgHwId + "-history"}
+	logicalID, err := magmad.RegisterGateway(testNetworkID, &magmadProtos.AccessGatewayRecord{HwId: &hwID, Name: "Test GW Name"})
+	assert.NoError(t, err)
+
+	historyURL := getURL(restPort, testNetworkID, logicalID) + "/history"
+
+	// No checkins yet
+	status, _, err := tests.SendHttpRequest("GET", historyURL, "")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, status)
+
+	test_utils.Checkin(t, test_utils.GetCheckinRequestProtoFixture(hwID.Id))
+	test_utils.Checkin(t, test_utils.GetCheckinRequestProtoFixture(hwID.Id))
+
+	status, response, err := tests.SendHttpRequest("GET", historyURL, "")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, status)
+	var history []*models.CheckinHistoryEntry
+	assert.NoError(t, json.Unmarshal([]byte(response), &history))
+	assert.Len(t, history, 2)
+	assert.True(t, history[0].CheckinTime <= history[1].CheckinTime)
+	assert.Equal(t, []*models.Package{{Name: "magma", Version: "0.0.0.0"}}, history[1].Packages)
+	assert.Equal(t, "facebook.com", history[1].VpnIP)
+	assert.Equal(t, "42", history[1].KernelVersion)
+	assert.Equal(t, uint64(1234), history[1].SystemStatus.UptimeSecs)
+
+	url := fmt.Sprintf("%s?start_time=%d", historyURL, history[1].CheckinTime+1)
+	tests.RunTest(t, tests.Testcase{
+		Name:     "Get status history after the last checkin",
+		Method:   "GET",
+		Url:      url,
+		Payload:  "",
+		Expected: "[]",
+	})
+
+	status, _, err = tests.SendHttpRequest("GET", historyURL+"?end_time=yesterday", "")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, status)
+
+	status, _, err = tests.SendHttpRequest("GET", getURL(restPort, testNetworkID, "should-not-exist")+"/history", "")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, status)
+
+	magmad.ForceRemoveNetwork(testNetworkID)
+}
+
 func getURL(restPort int, networkID string, logicalID string) string {
 	url := fmt.Sprintf(
 		"http://localhost:%d%s/networks/%s/gateways/%s/status",
@@ -37,6 +37,12 @@ type GatewayStatus struct {
 	// meta
 	Meta map[string]string `json:"meta,omitempty"`
 
+	// Set once the gateway missed too many checkin intervals
+	Offline bool `json:"offline,omitempty"`
+
+	// Unix time (milliseconds) the gateway was flagged offline at
+	OfflineSince uint64 `json:"offline_since,omitempty"`
+
 	// platform info
 	PlatformInfo *PlatformInfo `json:"platform_info,omitempty"`
 
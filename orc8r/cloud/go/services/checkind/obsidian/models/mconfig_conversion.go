@@ -34,6 +34,8 @@ func (mstatus *GatewayStatus) FromMconfig(pm proto.Message) error {
 	}
 	if pstatus != nil && mstatus != nil {
 		mstatus.CheckinTime = pstatus.Time
+		mstatus.Offline = pstatus.Offline
+		mstatus.OfflineSince = pstatus.OfflineSince
 		if pstatus.Checkin == nil {
 			mstatus.SystemStatus = nil
 			mstatus.PlatformInfo = nil
@@ -126,6 +128,27 @@ func (mstatus *GatewayStatus) Verify() error {
 	return err
 }
 
+// CheckinHistoryEntryFromProto converts a checkin of a gateway's status
+// history to its REST model
+func CheckinHistoryEntryFromProto(pEntry *protos.CheckinHistoryEntry) *CheckinHistoryEntry {
+	mEntry := &CheckinHistoryEntry{
+		CheckinTime:        pEntry.Time,
+		KernelVersion:      pEntry.KernelVersion,
+		VpnIP:              pEntry.VpnIp,
+		CertExpirationTime: pEntry.CertExpirationTime,
+	}
+	for _, pPackage := range pEntry.Packages {
+		mPackage := &Package{}
+		protos.FillIn(pPackage, mPackage)
+		mEntry.Packages = append(mEntry.Packages, mPackage)
+	}
+	if pEntry.SystemStatus != nil {
+		mEntry.SystemStatus = new(SystemStatus)
+		mEntry.SystemStatus.fillSystemStatus(pEntry.SystemStatus)
+	}
+	return mEntry
+}
+
 func (mSystemStatus *SystemStatus) fillSystemStatus(pSystemStatus *protos.SystemStatus) {
 	protos.FillIn(pSystemStatus, mSystemStatus)
 
@@ -0,0 +1,164 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+/*
+Package offline flags gateways which stopped checking in as offline and emits
+their online/offline transitions as metrics and to optional event sinks.
+
+A gateway is offline once it missed a configured number of its checkin
+intervals. The flag is kept in the checkin store and shows in the gateway's
+status until its next checkin, the transition back online is emitted on the
+next detection pass. Detectors of several checkind replicas can share the
+checkin store, every transition is emitted by only one of them.
+*/
+package offline
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"magma/orc8r/cloud/go/protos"
+	"magma/orc8r/cloud/go/services/checkind/metrics"
+	"magma/orc8r/cloud/go/services/checkind/store"
+
+	"github.com/golang/glog"
+)
+
+// Number of checkin intervals a gateway can miss before it's flagged offline
+const DefaultMissedCheckins = 3
+
+type Detector struct {
+	store          *store.CheckinStore
+	gateways       GatewayDirectory
+	missedCheckins int
+	sinks          []EventSink
+}
+
+func NewDetector(store *store.CheckinStore, gateways GatewayDirectory, missedCheckins int, sinks ...EventSink) (*Detector, error) {
+	if store == nil {
+		return nil, fmt.Errorf("Cannot initialize offline detector with Nil store")
+	}
+	if missedCheckins <= 0 {
+		return nil, fmt.Errorf("Missed checkins must be positive, got %d", missedCheckins)
+	}
+	return &Detector{store: store, gateways: gateways, missedCheckins: missedCheckins, sinks: sinks}, nil
+}
+
+// Run periodically detects offline gateways, it never returns
+func (d *Detector) Run(interval time.Duration) {
+	for range time.Tick(interval) {
+		if err := d.DetectOffline(time.Now()); err != nil {
+			glog.Errorf("Error detecting offline gateways: %s", err)
+		}
+	}
+}
+
+// DetectOffline flags the gateways of all networks which missed too many
+// checkins as offline and clears the flags of gateways which checked in again
+func (d *Detector) DetectOffline(now time.Time) error {
+	networks, err := d.gateways.ListNetworks()
+	if err != nil {
+		return err
+	}
+	for _, networkID := range networks {
+		if err := d.detectNetwork(networkID, now); err != nil {
+			glog.Errorf("Error detecting offline gateways of network %s: %s", networkID, err)
+		}
+	}
+	return nil
+}
+
+func (d *Detector) detectNetwork(networkID string, now time.Time) error {
+	checkinIntervals, err := d.gateways.GetCheckinIntervals(networkID)
+	if err != nil {
+		return err
+	}
+	offlineGateways, err := d.store.GetOfflineGateways(networkID)
+	if err != nil {
+		return err
+	}
+	nowMs := uint64(now.UnixNano()) / uint64(time.Millisecond)
+
+	// Emit the events of a network in a stable order
+	gatewayIDs := make([]string, 0, len(checkinIntervals))
+	for gatewayID := range checkinIntervals {
+		gatewayIDs = append(gatewayIDs, gatewayID)
+	}
+	sort.Strings(gatewayIDs)
+	for _, gatewayID := range gatewayIDs {
+		checkinInterval := checkinIntervals[gatewayID]
+		status, err := d.store.GetGatewayStatus(&protos.GatewayStatusRequest{NetworkId: networkID, LogicalId: gatewayID})
+		if err == store.ErrNotFound {
+			// Gateways which never checked in can't go offline
+			continue
+		}
+		if err != nil {
+			glog.Errorf("Error getting status of gateway %s: %s", gatewayID, err)
+			continue
+		}
+
+		// Detectors of several checkind replicas may see the same transition,
+		// only the one whose store update takes effect emits it
+		offlineSince, flagged := offlineGateways[gatewayID]
+		if flagged && status.Time > offlineSince {
+			cleared, err := d.store.ClearGatewayOffline(networkID, gatewayID, offlineSince)
+			if err != nil {
+				glog.Errorf("Error clearing offline flag of gateway %s: %s", gatewayID, err)
+				continue
+			}
+			if cleared {
+				d.emit(networkID, gatewayID, StateOnline, nowMs, status.Time)
+			}
+			flagged = false
+		}
+
+		missedCheckinsDeadline := status.Time + uint64(d.missedCheckins)*uint64(checkinInterval/time.Millisecond)
+		if !flagged && nowMs > missedCheckinsDeadline {
+			marked, err := d.store.MarkGatewayOffline(networkID, gatewayID, nowMs)
+			if err != nil {
+				glog.Errorf("Error flagging gateway %s offline: %s", gatewayID, err)
+				continue
+			}
+			if marked {
+				d.emit(networkID, gatewayID, StateOffline, nowMs, status.Time)
+			}
+			flagged = true
+		}
+		metrics.ReportGatewayOffline(networkID, gatewayID, flagged)
+	}
+
+	// Flags of removed gateways are dropped without an event
+	for gatewayID := range offlineGateways {
+		if _, registered := checkinIntervals[gatewayID]; !registered {
+			if err := d.store.MarkGatewayOnline(networkID, gatewayID); err != nil {
+				glog.Errorf("Error clearing offline flag of removed gateway %s: %s", gatewayID, err)
+				continue
+			}
+			metrics.ClearGatewayOffline(networkID, gatewayID)
+		}
+	}
+	return nil
+}
+
+func (d *Detector) emit(networkID string, gatewayID string, state string, nowMs uint64, lastCheckinTime uint64) {
+	glog.Infof("Gateway %s of network %s is %s, last checkin at %d", gatewayID, networkID, state, lastCheckinTime)
+	metrics.ReportGatewayConnectivityEvent(networkID, gatewayID, state)
+	event := &ConnectivityEvent{
+		NetworkID:       networkID,
+		GatewayID:       gatewayID,
+		State:           state,
+		Time:            nowMs,
+		LastCheckinTime: lastCheckinTime,
+	}
+	for _, sink := range d.sinks {
+		if err := sink.Emit(event); err != nil {
+			glog.Errorf("Error emitting %s event of gateway %s: %s", state, gatewayID, err)
+		}
+	}
+}
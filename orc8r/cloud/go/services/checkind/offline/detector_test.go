@@ -0,0 +1,230 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package offline_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"magma/orc8r/cloud/go/protos"
+	"magma/orc8r/cloud/go/services/checkind/offline"
+	"magma/orc8r/cloud/go/services/checkind/store"
+	checkin_test_utils "magma/orc8r/cloud/go/services/checkind/test_utils"
+	logger_test_init "magma/orc8r/cloud/go/services/logger/test_init"
+	"magma/orc8r/cloud/go/test_utils"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockGatewayDirectory struct {
+	checkinIntervals map[string]map[string]time.Duration
+}
+
+func (m *mockGatewayDirectory) ListNetworks() ([]string, error) {
+	ret := []string{}
+	for networkID := range m.checkinIntervals {
+		ret = append(ret, networkID)
+	}
+	return ret, nil
+}
+
+func (m *mockGatewayDirectory) GetCheckinIntervals(networkID string) (map[string]time.Duration, error) {
+	return m.checkinIntervals[networkID], nil
+}
+
+type recordingSink struct {
+	events []offline.ConnectivityEvent
+}
+
+func (r *recordingSink) Emit(event *offline.ConnectivityEvent) error {
+	r.events = append(r.events, *event)
+	return nil
+}
+
+func TestDetector(t *testing.T) {
+	logger_test_init.StartTestService(t)
+	checkinStore, err := store.NewCheckinStore(test_utils.NewMockDatastore())
+	assert.NoError(t, err)
+	directory := &mockGatewayDirectory{
+		checkinIntervals: map[string]map[string]time.Duration{
+			"n1": {"g1": 10 * time.Second, "g2": time.Minute, "g3": 10 * time.Second},
+		},
+	}
+	sink := &recordingSink{}
+
+	_, err = offline.NewDetector(checkinStore, directory, 0, sink)
+	assert.Error(t, err)
+	detector, err := offline.NewDetector(checkinStore, directory, 3, sink)
+	assert.NoError(t, err)
+
+	start := time.Unix(1000000, 0)
+	checkinAt := func(gatewayID string, checkinTime time.Time) {
+		status := checkin_test_utils.GetGatewayStatusProtoFixture(gatewayID)
+		status.Time = toMs(checkinTime)
+		assert.NoError(t, checkinStore.UpdateRegisteredGatewayStatus("n1", gatewayID, status))
+	}
+	getStatus := func(gatewayID string) *protos.GatewayStatus {
+		status, err := checkinStore.GetGatewayStatus(&protos.GatewayStatusRequest{NetworkId: "n1", LogicalId: gatewayID})
+		assert.NoError(t, err)
+		return status
+	}
+	// g3 never checks in
+	checkinAt("g1", start)
+	checkinAt("g2", start)
+
+	// Nobody missed 3 intervals yet
+	assert.NoError(t, detector.DetectOffline(start.Add(30*time.Second)))
+	assert.Empty(t, sink.events)
+	assert.False(t, getStatus("g1").Offline)
+
+	// g1 missed 3 of its 10 second intervals, g2 didn't miss 3 minutes
+	now := start.Add(31 * time.Second)
+	assert.NoError(t, detector.DetectOffline(now))
+	assert.Equal(t, []offline.ConnectivityEvent{
+		{NetworkID: "n1", GatewayID: "g1", State: offline.StateOffline, Time: toMs(now), LastCheckinTime: toMs(start)},
+	}, sink.events)
+	assert.True(t, getStatus("g1").Offline)
+	assert.Equal(t, toMs(now), getStatus("g1").OfflineSince)
+	assert.False(t, getStatus("g2").Offline)
+
+	// Offline gateways are only reported once
+	assert.NoError(t, detector.DetectOffline(now.Add(time.Minute)))
+	assert.Len(t, sink.events, 1)
+
+	// The next checkin clears the flag, the transition is emitted on the next
+	// detection. g2 missed its 3 minutes meanwhile.
+	checkinAt("g1", start.Add(185*time.Second))
+	assert.False(t, getStatus("g1").Offline)
+	now = start.Add(190 * time.Second)
+	assert.NoError(t, detector.DetectOffline(now))
+	assert.Len(t, sink.events, 3)
+	assert.Equal(t, offline.ConnectivityEvent{
+		NetworkID: "n1", GatewayID: "g1", State: offline.StateOnline, Time: toMs(now), LastCheckinTime: toMs(start.Add(185 * time.Second)),
+	}, sink.events[1])
+	assert.Equal(t, "g2", sink.events[2].GatewayID)
+	assert.Equal(t, offline.StateOffline, sink.events[2].State)
+	offlineGateways, err := checkinStore.GetOfflineGateways("n1")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]uint64{"g2": toMs(now)}, offlineGateways)
+
+	// Flags of removed gateways are dropped
+	delete(directory.checkinIntervals["n1"], "g2")
+	assert.NoError(t, detector.DetectOffline(now))
+	offlineGateways, err = checkinStore.GetOfflineGateways("n1")
+	assert.NoError(t, err)
+	assert.Empty(t, offlineGateways)
+	assert.Len(t, sink.events, 3)
+}
+
+func TestDetectorReplicas(t *testing.T) {
+	logger_test_init.StartTestService(t)
+	// Detectors of two checkind replicas share the checkin store
+	checkinStore, err := store.NewCheckinStore(test_utils.NewMockDatastore())
+	assert.NoError(t, err)
+	directory := &mockGatewayDirectory{
+		checkinIntervals: map[string]map[string]time.Duration{"n1": {"g1": 10 * time.Second}},
+	}
+	sink1, sink2 := &recordingSink{}, &recordingSink{}
+	detector1, err := offline.NewDetector(checkinStore, directory, 3, sink1)
+	assert.NoError(t, err)
+	detector2, err := offline.NewDetector(checkinStore, directory, 3, sink2)
+	assert.NoError(t, err)
+
+	start := time.Unix(1000000, 0)
+	checkinAt := func(checkinTime time.Time) {
+		status := checkin_test_utils.GetGatewayStatusProtoFixture("g1")
+		status.Time = toMs(checkinTime)
+		assert.NoError(t, checkinStore.UpdateRegisteredGatewayStatus("n1", "g1", status))
+	}
+	checkinAt(start)
+
+	// Only one of the replicas emits the offline transition
+	now := start.Add(31 * time.Second)
+	assert.NoError(t, detector1.DetectOffline(now))
+	assert.NoError(t, detector2.DetectOffline(now.Add(time.Second)))
+	assert.Len(t, sink1.events, 1)
+	assert.Empty(t, sink2.events)
+
+	// Nor the online transition
+	checkinAt(start.Add(40 * time.Second))
+	assert.NoError(t, detector2.DetectOffline(start.Add(41*time.Second)))
+	assert.NoError(t, detector1.DetectOffline(start.Add(42*time.Second)))
+	assert.Len(t, sink1.events, 1)
+	assert.Equal(t, []offline.ConnectivityEvent{
+		{NetworkID: "n1", GatewayID: "g1", State: offline.StateOnline, Time: toMs(start.Add(41 * time.Second)), LastCheckinTime: toMs(start.Add(40 * time.Second))},
+	}, sink2.events)
+}
+
+func TestWebhookSink(t *testing.T) {
+	var received []offline.ConnectivityEvent
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		event := offline.ConnectivityEvent{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&event))
+		received = append(received, event)
+		if event.GatewayID == "bad" {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+
+	sink := offline.NewWebhookSink(server.URL)
+	event := offline.ConnectivityEvent{NetworkID: "n1", GatewayID: "g1", State: offline.StateOffline, Time: 2, LastCheckinTime: 1}
+	assert.NoError(t, sink.Emit(&event))
+	assert.Equal(t, []offline.ConnectivityEvent{event}, received)
+
+	assert.Error(t, sink.Emit(&offline.ConnectivityEvent{GatewayID: "bad"}))
+}
+
+// blockingSink blocks emitting events until it's released
+type blockingSink struct {
+	started chan struct{}
+	release chan struct{}
+	events  chan offline.ConnectivityEvent
+}
+
+func (b *blockingSink) Emit(event *offline.ConnectivityEvent) error {
+	b.started <- struct{}{}
+	<-b.release
+	b.events <- *event
+	return nil
+}
+
+func TestAsyncSink(t *testing.T) {
+	sink := &blockingSink{
+		started: make(chan struct{}, 10),
+		release: make(chan struct{}),
+		events:  make(chan offline.ConnectivityEvent, 10),
+	}
+	async := offline.NewAsyncSink(sink, 2)
+
+	// Events are queued without waiting for the busy sink
+	assert.NoError(t, async.Emit(&offline.ConnectivityEvent{GatewayID: "g1"}))
+	<-sink.started
+	assert.NoError(t, async.Emit(&offline.ConnectivityEvent{GatewayID: "g2"}))
+	assert.NoError(t, async.Emit(&offline.ConnectivityEvent{GatewayID: "g3"}))
+	// The sink holds g1, the queue g2 & g3
+	assert.Error(t, async.Emit(&offline.ConnectivityEvent{GatewayID: "g4"}))
+
+	close(sink.release)
+	for _, gatewayID := range []string{"g1", "g2", "g3"} {
+		select {
+		case event := <-sink.events:
+			assert.Equal(t, gatewayID, event.GatewayID)
+		case <-time.After(5 * time.Second):
+			assert.Fail(t, "timed out waiting for event")
+		}
+	}
+}
+
+func toMs(t time.Time) uint64 {
+	return uint64(t.UnixNano()) / uint64(time.Millisecond)
+}
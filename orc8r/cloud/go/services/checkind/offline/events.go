@@ -0,0 +1,106 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package offline
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/golang/glog"
+)
+
+const (
+	StateOffline = "offline"
+	StateOnline  = "online"
+
+	// Max time a webhook has to accept an event
+	WebhookTimeout = 10 * time.Second
+
+	// Default number of events an async sink holds while its sink is busy
+	DefaultEventQueueSize = 1024
+)
+
+// ConnectivityEvent is emitted when a gateway goes offline or comes back online
+type ConnectivityEvent struct {
+	NetworkID string `json:"network_id"`
+	GatewayID string `json:"gateway_id"`
+	// Either StateOffline or StateOnline
+	State string `json:"state"`
+	// Unix time (milliseconds) the transition was detected at
+	Time uint64 `json:"time"`
+	// Unix time (milliseconds) of the gateway's last checkin
+	LastCheckinTime uint64 `json:"last_checkin_time"`
+}
+
+// EventSink receives the connectivity events of all gateways
+type EventSink interface {
+	Emit(event *ConnectivityEvent) error
+}
+
+// webhookSink POSTs every event as JSON to an outbound webhook
+type webhookSink struct {
+	url    string
+	client *http.Client
+}
+
+func NewWebhookSink(url string) EventSink {
+	return &webhookSink{url: url, client: &http.Client{Timeout: WebhookTimeout}}
+}
+
+func (w *webhookSink) Emit(event *ConnectivityEvent) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	resp, err := w.client.Post(w.url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("Webhook %s responded with status %d", w.url, resp.StatusCode)
+	}
+	return nil
+}
+
+// asyncSink emits events to its sink from a worker goroutine, so that slow
+// sinks don't delay the detection of offline gateways. Events are emitted in
+// order and dropped while the queue is full.
+type asyncSink struct {
+	sink  EventSink
+	queue chan *ConnectivityEvent
+}
+
+// NewAsyncSink starts the worker emitting the events queued to the returned
+// sink to the given sink. At most queueSize events are queued.
+func NewAsyncSink(sink EventSink, queueSize int) EventSink {
+	async := &asyncSink{sink: sink, queue: make(chan *ConnectivityEvent, queueSize)}
+	go async.run()
+	return async
+}
+
+func (a *asyncSink) Emit(event *ConnectivityEvent) error {
+	select {
+	case a.queue <- event:
+		return nil
+	default:
+		return fmt.Errorf("Event queue is full, dropping %s event of gateway %s", event.State, event.GatewayID)
+	}
+}
+
+func (a *asyncSink) run() {
+	for event := range a.queue {
+		if err := a.sink.Emit(event); err != nil {
+			glog.Errorf("Error emitting %s event of gateway %s: %s", event.State, event.GatewayID, err)
+		}
+	}
+}
@@ -0,0 +1,76 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package offline
+
+import (
+	"time"
+
+	"magma/orc8r/cloud/go/services/config"
+	"magma/orc8r/cloud/go/services/magmad"
+	magmad_config "magma/orc8r/cloud/go/services/magmad/config"
+	magmad_protos "magma/orc8r/cloud/go/services/magmad/protos"
+)
+
+const (
+	// Checkin interval of gateways without a magmad gateway config
+	DefaultCheckinInterval = 60 * time.Second
+	// Gateways never check in more often than this, whatever their config says
+	MinCheckinInterval = 5 * time.Second
+)
+
+// GatewayDirectory lists the registered gateways and their checkin intervals
+type GatewayDirectory interface {
+	ListNetworks() ([]string, error)
+	// GetCheckinIntervals returns the checkin interval of every gateway
+	// registered in the network
+	GetCheckinIntervals(networkID string) (map[string]time.Duration, error)
+}
+
+// magmadGatewayDirectory looks up the gateways in magmad and their checkin
+// intervals in their magmad gateway configs
+type magmadGatewayDirectory struct{}
+
+func NewMagmadGatewayDirectory() GatewayDirectory {
+	return &magmadGatewayDirectory{}
+}
+
+func (*magmadGatewayDirectory) ListNetworks() ([]string, error) {
+	return magmad.ListNetworks()
+}
+
+func (*magmadGatewayDirectory) GetCheckinIntervals(networkID string) (map[string]time.Duration, error) {
+	gatewayIDs, err := magmad.ListGateways(networkID)
+	if err != nil {
+		return nil, err
+	}
+	configs, err := config.GetConfigsByType(networkID, magmad_config.MagmadGatewayType)
+	if err != nil {
+		return nil, err
+	}
+	checkinIntervals := map[string]time.Duration{}
+	for tk, iConfig := range configs {
+		if gatewayConfig, ok := iConfig.(*magmad_protos.MagmadGatewayConfig); ok {
+			checkinIntervals[tk.Key] = time.Duration(gatewayConfig.GetCheckinInterval()) * time.Second
+		}
+	}
+
+	ret := make(map[string]time.Duration, len(gatewayIDs))
+	for _, gatewayID := range gatewayIDs {
+		checkinInterval, ok := checkinIntervals[gatewayID]
+		if !ok {
+			checkinInterval = DefaultCheckinInterval
+		}
+		// Same lower bound as the gateway's checkin manager
+		if checkinInterval < MinCheckinInterval {
+			checkinInterval = MinCheckinInterval
+		}
+		ret[gatewayID] = checkinInterval
+	}
+	return ret, nil
+}
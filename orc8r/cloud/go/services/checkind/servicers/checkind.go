@@ -94,6 +94,21 @@ func (srv *checkindServer) GetStatus(ctx context.Context, req *protos.GatewaySta
 	return ret, err
 }
 
+// Gateway checkin history retrieval from the GW's network history table
+// The history is bounded by the retention the service was configured with and
+// is ordered from the oldest to the newest checkin.
+// History requests for gateways which never checked in will result in error
+func (srv *checkindServer) GetStatusHistory(ctx context.Context, req *protos.GatewayStatusHistoryRequest) (*protos.GatewayStatusHistory, error) {
+	if req == nil {
+		return new(protos.GatewayStatusHistory), fmt.Errorf("Nil GatewayStatusHistoryRequest")
+	}
+	ret, err := srv.Store.GetGatewayStatusHistory(req)
+	if err == store.ErrNotFound {
+		return nil, status.Errorf(codes.NotFound, "No status history found")
+	}
+	return ret, err
+}
+
 // Removes Gateway status record from the Gateway's network table
 // NOTE: the record will be created again after next successfull Gateway checkin
 func (srv *checkindServer) DeleteGatewayStatus(ctx context.Context, req *protos.GatewayStatusRequest) (*protos.Void, error) {
@@ -16,6 +16,8 @@ import (
 	"magma/orc8r/cloud/go/protos"
 	"magma/orc8r/cloud/go/services/checkind/scribe"
 	"magma/orc8r/cloud/go/services/magmad"
+
+	"github.com/golang/glog"
 )
 
 const GatewaysStatusTableName string = "gwstatus"
@@ -23,7 +25,8 @@ const GatewaysStatusTableName string = "gwstatus"
 var ErrNotFound = errors.New("Status not found")
 
 type CheckinStore struct {
-	store datastore.Api
+	store            datastore.Api
+	historyRetention HistoryRetention
 }
 
 // Validate checks if the store is properly initialized
@@ -34,12 +37,18 @@ func (s *CheckinStore) Validate() error {
 	if s.store == nil {
 		return fmt.Errorf("Nil CheckinStore datastore")
 	}
-	return nil
+	return s.historyRetention.Validate()
 }
 
-// Create a new Checkin Store
+// Create a new Checkin Store with the default status history retention
 func NewCheckinStore(ds datastore.Api) (*CheckinStore, error) {
-	s := &CheckinStore{ds}
+	return NewCheckinStoreWithHistoryRetention(ds, DefaultHistoryRetention)
+}
+
+// Create a new Checkin Store which keeps the status history of every gateway
+// within the given retention
+func NewCheckinStoreWithHistoryRetention(ds datastore.Api, retention HistoryRetention) (*CheckinStore, error) {
+	s := &CheckinStore{store: ds, historyRetention: retention}
 	return s, s.Validate()
 }
 
@@ -90,6 +99,14 @@ func (s *CheckinStore) UpdateRegisteredGatewayStatus(networkId, logicalId string
 			err, status.Checkin.GatewayId, logicalId,
 		)
 	}
+	// The latest status is already stored, a failure to record the history
+	// shouldn't fail the checkin
+	if err = s.appendStatusHistory(networkId, logicalId, status); err != nil {
+		glog.Errorf(
+			"Gateway Status History Write Error: %s for GW: %s > %s",
+			err, status.Checkin.GatewayId, logicalId,
+		)
+	}
 	// update checkin status successful, log status to Scribe
 	go scribe.LogGatewayStatusToScribe(status, networkId, logicalId)
 	return nil
@@ -114,7 +131,22 @@ func (s *CheckinStore) GetGatewayStatus(req *protos.GatewayStatusRequest) (*prot
 	}
 	status := new(protos.GatewayStatus)
 	err = protos.Unmarshal(marshaledStatus, status)
-	return status, err
+	if err != nil {
+		return nil, err
+	}
+	// An offline flag is stale once the gateway checked in again
+	offlineSince, err := s.getOfflineSince(req.NetworkId, req.LogicalId)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"Gateway Offline Flag Read Error: %s for network: %s, Gateway: %s",
+			err, req.NetworkId, req.LogicalId,
+		)
+	}
+	if offlineSince != 0 && status.Time <= offlineSince {
+		status.Offline = true
+		status.OfflineSince = offlineSince
+	}
+	return status, nil
 }
 
 // DeleteGatewayStatus deletes the status of given gateway based on its network
@@ -125,11 +157,20 @@ func (s *CheckinStore) DeleteGatewayStatus(req *protos.GatewayStatusRequest) err
 	if req == nil {
 		return fmt.Errorf("Nil Gateway Status Request")
 	}
-	return s.store.Delete(statusTable(req.NetworkId), req.LogicalId)
+	err := s.store.Delete(statusTable(req.NetworkId), req.LogicalId)
+	if err != nil {
+		return err
+	}
+	err = s.MarkGatewayOnline(req.NetworkId, req.LogicalId)
+	if err != nil {
+		return err
+	}
+	return s.DeleteGatewayStatusHistory(req.NetworkId, req.LogicalId)
 }
 
-// DeleteNetworkTable deletes the status table for a given network.
-// The table must be empty prior to call to DeleteNetworkTable
+// DeleteNetworkTable deletes the status, status history and offline tables for
+// a given network. The status table must be empty prior to call to
+// DeleteNetworkTable
 // DeleteNetworkTable relies only on it's own DB table and does not use any
 // external DBs or services
 func (s *CheckinStore) DeleteNetworkTable(networkId string) error {
@@ -140,7 +181,12 @@ func (s *CheckinStore) DeleteNetworkTable(networkId string) error {
 	if len(allKeys) > 0 {
 		return fmt.Errorf("Status table for network %s is not empty", networkId)
 	}
-	return s.store.DeleteTable(statusTable(networkId))
+	for _, table := range []string{statusTable(networkId), statusHistoryTable(networkId), offlineTable(networkId)} {
+		if err = s.store.DeleteTable(table); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // List all logical gateway IDs for a given network.
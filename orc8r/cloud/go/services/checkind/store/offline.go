@@ -0,0 +1,87 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package store
+
+import (
+	"fmt"
+	"strconv"
+
+	"magma/orc8r/cloud/go/datastore"
+)
+
+// GatewaysOfflineTableName is the table of the gateways flagged offline, the
+// time (unix milliseconds) a gateway was flagged at is stored as a decimal
+// string keyed by the gateway's logical ID
+const GatewaysOfflineTableName string = "gwoffline"
+
+func offlineTable(networkId string) string {
+	return datastore.GetTableName(networkId, GatewaysOfflineTableName)
+}
+
+// MarkGatewayOffline flags the given gateway offline since the given unix time
+// in milliseconds unless it's flagged already. The flag only shows in the
+// gateway's status until its next checkin.
+// MarkGatewayOffline returns whether the gateway was flagged by this call, so
+// of concurrent callers only one emits the transition.
+func (s *CheckinStore) MarkGatewayOffline(networkId, logicalId string, offlineSince uint64) (bool, error) {
+	return s.store.PutIfAbsent(offlineTable(networkId), logicalId, formatOfflineSince(offlineSince))
+}
+
+// ClearGatewayOffline clears the offline flag of the given gateway if it's
+// still the flag set at offlineSince. It returns whether the flag was cleared
+// by this call, so of concurrent callers only one emits the transition.
+func (s *CheckinStore) ClearGatewayOffline(networkId, logicalId string, offlineSince uint64) (bool, error) {
+	return s.store.DeleteIfValue(offlineTable(networkId), logicalId, formatOfflineSince(offlineSince))
+}
+
+// MarkGatewayOnline clears the offline flag of the given gateway
+func (s *CheckinStore) MarkGatewayOnline(networkId, logicalId string) error {
+	return s.store.Delete(offlineTable(networkId), logicalId)
+}
+
+// GetOfflineGateways returns the logical IDs of all gateways of the network
+// flagged offline, mapped to the time they were flagged at. Flags of gateways
+// which checked in since are included.
+func (s *CheckinStore) GetOfflineGateways(networkId string) (map[string]uint64, error) {
+	table := offlineTable(networkId)
+	keys, err := s.store.ListKeys(table)
+	if err != nil {
+		return nil, err
+	}
+	values, err := s.store.GetMany(table, keys)
+	if err != nil {
+		return nil, err
+	}
+	ret := make(map[string]uint64, len(values))
+	for logicalId, value := range values {
+		offlineSince, err := strconv.ParseUint(string(value.Value), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid offline flag for gateway %s: %s", logicalId, err)
+		}
+		ret[logicalId] = offlineSince
+	}
+	return ret, nil
+}
+
+// getOfflineSince returns the time the given gateway was flagged offline at,
+// or 0 if it isn't flagged
+func (s *CheckinStore) getOfflineSince(networkId, logicalId string) (uint64, error) {
+	value, _, err := s.store.Get(offlineTable(networkId), logicalId)
+	if err == datastore.ErrNotFound {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseUint(string(value), 10, 64)
+}
+
+func formatOfflineSince(offlineSince uint64) []byte {
+	return []byte(strconv.FormatUint(offlineSince, 10))
+}
@@ -0,0 +1,211 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package store
+
+import (
+	"fmt"
+	"time"
+
+	"magma/orc8r/cloud/go/datastore"
+	"magma/orc8r/cloud/go/protos"
+)
+
+// GatewaysStatusHistoryTableName is the table of the gateways' checkin
+// histories. Every gateway's history is a ring buffer of HistoryRetention.MaxEntries
+// slots keyed by <logical ID>/<slot>, the position of the buffer is kept
+// under the gateway's logical ID.
+const GatewaysStatusHistoryTableName string = "gwstatushistory"
+
+// HistoryRetention bounds the status history kept for every gateway
+type HistoryRetention struct {
+	// Max number of checkins kept per gateway
+	MaxEntries int
+	// Max age of the returned checkins, 0 means no age limit
+	MaxAge time.Duration
+}
+
+// DefaultHistoryRetention keeps a day of checkins at the default gateway
+// checkin interval of 60 seconds
+var DefaultHistoryRetention = HistoryRetention{MaxEntries: 1440, MaxAge: 24 * time.Hour}
+
+// Validate checks if the retention is usable
+func (r HistoryRetention) Validate() error {
+	if r.MaxEntries <= 0 {
+		return fmt.Errorf("Status history max entries must be positive, got %d", r.MaxEntries)
+	}
+	if r.MaxAge < 0 {
+		return fmt.Errorf("Status history max age must not be negative, got %s", r.MaxAge)
+	}
+	return nil
+}
+
+func statusHistoryTable(networkId string) string {
+	return datastore.GetTableName(networkId, GatewaysStatusHistoryTableName)
+}
+
+func statusHistoryEntryKey(logicalId string, slot uint32) string {
+	return fmt.Sprintf("%s/%d", logicalId, slot)
+}
+
+// GetGatewayStatusHistory returns the recorded checkins of the given gateway
+// ordered from the oldest to the newest. Checkins older than the retention's
+// max age or outside of the request's time bounds are left out.
+// GetGatewayStatusHistory relies only on it's own DB table and does not use
+// any external DBs or services
+func (s *CheckinStore) GetGatewayStatusHistory(req *protos.GatewayStatusHistoryRequest) (*protos.GatewayStatusHistory, error) {
+	if req == nil {
+		return nil, fmt.Errorf("Nil Gateway Status History Request")
+	}
+	table := statusHistoryTable(req.NetworkId)
+	index, err := s.getStatusHistoryIndex(table, req.LogicalId)
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]string, 0, index.NumEntries)
+	for i := uint32(0); i < index.NumEntries; i++ {
+		slot := (index.NextSlot + index.Capacity - index.NumEntries + i) % index.Capacity
+		keys = append(keys, statusHistoryEntryKey(req.LogicalId, slot))
+	}
+	marshaledEntries, err := s.store.GetMany(table, keys)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"Gateway Status History Read Error: %s for network: %s, Gateway: %s",
+			err, req.NetworkId, req.LogicalId,
+		)
+	}
+
+	startTime := req.StartTime
+	if s.historyRetention.MaxAge > 0 {
+		oldestTime := uint64(time.Now().Add(-s.historyRetention.MaxAge).UnixNano()) / uint64(time.Millisecond)
+		if oldestTime > startTime {
+			startTime = oldestTime
+		}
+	}
+	ret := &protos.GatewayStatusHistory{Entries: []*protos.CheckinHistoryEntry{}}
+	for _, key := range keys {
+		marshaledEntry, ok := marshaledEntries[key]
+		if !ok {
+			continue
+		}
+		entry := new(protos.CheckinHistoryEntry)
+		if err = protos.Unmarshal(marshaledEntry.Value, entry); err != nil {
+			return nil, err
+		}
+		if entry.Time < startTime || (req.EndTime != 0 && entry.Time > req.EndTime) {
+			continue
+		}
+		ret.Entries = append(ret.Entries, entry)
+	}
+	return ret, nil
+}
+
+// appendStatusHistory records the given status in the gateway's history,
+// overwriting the oldest checkin once the history is full
+func (s *CheckinStore) appendStatusHistory(networkId, logicalId string, status *protos.GatewayStatus) error {
+	table := statusHistoryTable(networkId)
+	index, err := s.getStatusHistoryIndex(table, logicalId)
+	if err == ErrNotFound {
+		index = &protos.GatewayStatusHistoryIndex{}
+	} else if err != nil {
+		return err
+	}
+
+	capacity := uint32(s.historyRetention.MaxEntries)
+	if index.Capacity != capacity {
+		// The slots of a history written with a different max entries
+		// don't line up anymore, start over
+		if err = s.deleteStatusHistoryEntries(table, logicalId, index); err != nil {
+			return err
+		}
+		index = &protos.GatewayStatusHistoryIndex{Capacity: capacity}
+	}
+
+	marshaledEntry, err := protos.MarshalIntern(newCheckinHistoryEntry(status))
+	if err != nil {
+		return err
+	}
+	slot := index.NextSlot
+	index.NextSlot = (slot + 1) % capacity
+	if index.NumEntries < capacity {
+		index.NumEntries++
+	}
+	marshaledIndex, err := protos.MarshalIntern(index)
+	if err != nil {
+		return err
+	}
+	_, err = s.store.PutMany(table, map[string][]byte{
+		statusHistoryEntryKey(logicalId, slot): marshaledEntry,
+		logicalId:                              marshaledIndex,
+	})
+	return err
+}
+
+// DeleteGatewayStatusHistory removes all recorded checkins of the given
+// gateway, i.e. its history's slots and index
+func (s *CheckinStore) DeleteGatewayStatusHistory(networkId, logicalId string) error {
+	table := statusHistoryTable(networkId)
+	index, err := s.getStatusHistoryIndex(table, logicalId)
+	if err == ErrNotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if err = s.deleteStatusHistoryEntries(table, logicalId, index); err != nil {
+		return err
+	}
+	return s.store.Delete(table, logicalId)
+}
+
+func (s *CheckinStore) deleteStatusHistoryEntries(table, logicalId string, index *protos.GatewayStatusHistoryIndex) error {
+	if index.Capacity == 0 {
+		return nil
+	}
+	keys := make([]string, 0, index.Capacity)
+	for slot := uint32(0); slot < index.Capacity; slot++ {
+		keys = append(keys, statusHistoryEntryKey(logicalId, slot))
+	}
+	_, err := s.store.DeleteMany(table, keys)
+	return err
+}
+
+func (s *CheckinStore) getStatusHistoryIndex(table, logicalId string) (*protos.GatewayStatusHistoryIndex, error) {
+	marshaledIndex, _, err := s.store.Get(table, logicalId)
+	if err == datastore.ErrNotFound {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	index := new(protos.GatewayStatusHistoryIndex)
+	err = protos.Unmarshal(marshaledIndex, index)
+	return index, err
+}
+
+func newCheckinHistoryEntry(status *protos.GatewayStatus) *protos.CheckinHistoryEntry {
+	checkin := status.GetCheckin()
+	entry := &protos.CheckinHistoryEntry{
+		Time:               status.GetTime(),
+		SystemStatus:       checkin.GetSystemStatus(),
+		CertExpirationTime: status.GetCertExpirationTime(),
+	}
+	if platformInfo := checkin.GetPlatformInfo(); platformInfo != nil {
+		entry.Packages = platformInfo.GetPackages()
+		entry.KernelVersion = platformInfo.GetKernelVersion()
+		entry.VpnIp = platformInfo.GetVpnIp()
+	} else {
+		// Fallback to the deprecated fields of older gateways
+		if checkin.GetMagmaPkgVersion() != "" {
+			entry.Packages = []*protos.Package{{Name: "magma", Version: checkin.GetMagmaPkgVersion()}}
+		}
+		entry.KernelVersion = checkin.GetKernelVersion()
+		entry.VpnIp = checkin.GetVpnIp()
+	}
+	return entry
+}
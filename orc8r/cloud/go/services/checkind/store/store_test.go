@@ -13,6 +13,7 @@ import (
 	"testing"
 	"time"
 
+	"magma/orc8r/cloud/go/datastore"
 	"magma/orc8r/cloud/go/protos"
 	checkin_store "magma/orc8r/cloud/go/services/checkind/store"
 	checkin_test_utils "magma/orc8r/cloud/go/services/checkind/test_utils"
@@ -91,3 +92,77 @@ func TestCheckinStore(t *testing.T) {
 	// Error since the network is deleted
 	assert.Error(t, err)
 }
+
+func TestCheckinStore_StatusHistory(t *testing.T) {
+	logger_test_init.StartTestService(t)
+	ds := test_utils.NewMockDatastore()
+	retention := checkin_store.HistoryRetention{MaxEntries: 3, MaxAge: time.Hour}
+	store, err := checkin_store.NewCheckinStoreWithHistoryRetention(ds, retention)
+	assert.NoError(t, err)
+
+	_, err = checkin_store.NewCheckinStoreWithHistoryRetention(ds, checkin_store.HistoryRetention{})
+	assert.Error(t, err)
+
+	historyReq := &protos.GatewayStatusHistoryRequest{NetworkId: "n1", LogicalId: "g1"}
+	_, err = store.GetGatewayStatusHistory(historyReq)
+	assert.Equal(t, checkin_store.ErrNotFound, err)
+
+	now := uint64(time.Now().UnixNano() / int64(time.Millisecond))
+	checkinAt := func(store *checkin_store.CheckinStore, checkinTime uint64) {
+		status := checkin_test_utils.GetGatewayStatusProtoFixture(testAgHwId)
+		status.Time = checkinTime
+		status.CertExpirationTime = 42
+		assert.NoError(t, store.UpdateRegisteredGatewayStatus("n1", "g1", status))
+	}
+	getTimes := func(store *checkin_store.CheckinStore, req *protos.GatewayStatusHistoryRequest) []uint64 {
+		history, err := store.GetGatewayStatusHistory(req)
+		assert.NoError(t, err)
+		ret := []uint64{}
+		for _, entry := range history.Entries {
+			ret = append(ret, entry.Time)
+		}
+		return ret
+	}
+
+	// The first checkin is older than the max age
+	checkinAt(store, now-uint64(2*time.Hour/time.Millisecond))
+	checkinAt(store, now-3000)
+	assert.Equal(t, []uint64{now - 3000}, getTimes(store, historyReq))
+
+	history, err := store.GetGatewayStatusHistory(historyReq)
+	assert.NoError(t, err)
+	expectedEntry := &protos.CheckinHistoryEntry{
+		Time:               now - 3000,
+		Packages:           []*protos.Package{{Name: "magma", Version: "0.0.0.0"}},
+		KernelVersion:      "42",
+		VpnIp:              "facebook.com",
+		SystemStatus:       checkin_test_utils.GetCheckinRequestProtoFixture(testAgHwId).SystemStatus,
+		CertExpirationTime: 42,
+	}
+	assert.Equal(t, protos.TestMarshal(expectedEntry), protos.TestMarshal(history.Entries[0]))
+
+	// Only the last 3 checkins are kept
+	checkinAt(store, now-2000)
+	checkinAt(store, now-1000)
+	checkinAt(store, now)
+	assert.Equal(t, []uint64{now - 2000, now - 1000, now}, getTimes(store, historyReq))
+
+	boundedReq := &protos.GatewayStatusHistoryRequest{NetworkId: "n1", LogicalId: "g1", StartTime: now - 1500, EndTime: now - 500}
+	assert.Equal(t, []uint64{now - 1000}, getTimes(store, boundedReq))
+
+	// Changing the max entries starts the history over
+	biggerStore, err := checkin_store.NewCheckinStoreWithHistoryRetention(
+		ds, checkin_store.HistoryRetention{MaxEntries: 5})
+	assert.NoError(t, err)
+	checkinAt(biggerStore, now+1000)
+	assert.Equal(t, []uint64{now + 1000}, getTimes(biggerStore, historyReq))
+
+	// Deleting the status deletes the history
+	err = biggerStore.DeleteGatewayStatus(&protos.GatewayStatusRequest{NetworkId: "n1", LogicalId: "g1"})
+	assert.NoError(t, err)
+	_, err = biggerStore.GetGatewayStatusHistory(historyReq)
+	assert.Equal(t, checkin_store.ErrNotFound, err)
+	keys, err := ds.ListKeys(datastore.GetTableName("n1", checkin_store.GatewaysStatusHistoryTableName))
+	assert.NoError(t, err)
+	assert.Empty(t, keys)
+}
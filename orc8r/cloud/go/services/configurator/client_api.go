@@ -18,6 +18,9 @@ import (
 	"magma/orc8r/cloud/go/services/configurator/protos"
 
 	"github.com/golang/glog"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func getNBConfiguratorClient() (protos.NorthboundConfiguratorClient, error) {
@@ -65,6 +68,44 @@ func DeleteNetworks(networkIDs []string) error {
 	return err
 }
 
+// DeleteNetworkIfVersion deletes the network specified by networkID only if
+// its current version is expectedVersion. Use IsVersionConflict to check
+// whether the returned error is due to a version mismatch.
+func DeleteNetworkIfVersion(networkID string, expectedVersion uint64) error {
+	client, err := getNBConfiguratorClient()
+	if err != nil {
+		return err
+	}
+	request := &protos.DeleteNetworksRequest{
+		NetworkIDs:       []string{networkID},
+		ExpectedVersions: map[string]uint64{networkID: expectedVersion},
+	}
+	_, err = client.DeleteNetworks(context.Background(), request)
+	return err
+}
+
+// IsVersionConflict returns true if err was returned by an update or deletion
+// because the expected version of a network or entity didn't match its
+// current version
+func IsVersionConflict(err error) bool {
+	st, ok := status.FromError(err)
+	if !ok || st.Code() != codes.Aborted {
+		return false
+	}
+	for _, detail := range st.Details() {
+		failure, ok := detail.(*errdetails.PreconditionFailure)
+		if !ok {
+			continue
+		}
+		for _, violation := range failure.Violations {
+			if violation.Type == protos.VersionConflictViolation {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // LoadNetworks loads networks specified by networks according to criteria specified and
 // returns the result
 func LoadNetworks(networks []string, loadMetadata bool, loadConfigs bool) (map[string]*protos.Network, []string, error) {
@@ -156,6 +197,24 @@ func DeleteEntities(networkID string, ids []*protos.EntityID) error {
 	return err
 }
 
+// DeleteEntityIfVersion deletes the entity specified by entityType and
+// entityKey only if its current version is expectedVersion. Use
+// IsVersionConflict to check whether the returned error is due to a version
+// mismatch.
+func DeleteEntityIfVersion(networkID string, entityType string, entityKey string, expectedVersion uint64) error {
+	client, err := getNBConfiguratorClient()
+	if err != nil {
+		return err
+	}
+	request := &protos.DeleteEntitiesRequest{
+		NetworkID:        networkID,
+		ID:               []*protos.EntityID{{Type: entityType, Id: entityKey}},
+		ExpectedVersions: []uint64{expectedVersion},
+	}
+	_, err = client.DeleteEntities(context.Background(), request)
+	return err
+}
+
 // LoadEntities loads entities specified by the parameters.
 func LoadEntities(networkID string, typeFilter *string, keyFilter *string, ids []*protos.EntityID,
 	criteria *protos.EntityLoadCriteria) ([]*protos.NetworkEntity, []*protos.EntityID, error) {
@@ -180,6 +239,22 @@ func LoadEntities(networkID string, typeFilter *string, keyFilter *string, ids [
 	return resp.Entities, resp.NotFound, err
 }
 
+// LoadEntitiesPage loads a page of the entities of a network matching the
+// filters of the request. The page size and token are set in the criteria of
+// the request. Returns the loaded entities and the token of the next page,
+// empty if this is the last page.
+func LoadEntitiesPage(request *protos.LoadEntitiesRequest) ([]*protos.NetworkEntity, string, error) {
+	client, err := getNBConfiguratorClient()
+	if err != nil {
+		return nil, "", err
+	}
+	resp, err := client.LoadEntities(context.Background(), request)
+	if err != nil {
+		return nil, "", err
+	}
+	return resp.Entities, resp.NextPageToken, nil
+}
+
 func LoadAllEntitiesInNetwork(networkID string, typeVal string, criteria *protos.EntityLoadCriteria) ([]*protos.NetworkEntity, error) {
 	client, err := getNBConfiguratorClient()
 	if err != nil {
@@ -201,3 +276,55 @@ func LoadAllEntitiesInNetwork(networkID string, typeVal string, criteria *protos
 	}
 	return resp.Entities, err
 }
+
+// WatchEntities watches committed changes of the entities matching networkID
+// (all networks if empty), typeFilter & keyFilter (nil filters match all).
+// If both filters are nil, creations, updates and deletions of the watched
+// networks are sent as well.
+// WatchEntities returns once the watch is established, all changes committed
+// after it returns through any configurator instance are sent to the returned
+// channel, in commit order per network. The channel is closed when ctx is
+// cancelled or the watch fails (e.g. the watcher fell behind or configurator
+// restarted), after which callers should reload the entities they track and
+// watch again.
+func WatchEntities(ctx context.Context, networkID string, typeFilter *string, keyFilter *string) (<-chan *protos.EntityChange, error) {
+	client, err := getNBConfiguratorClient()
+	if err != nil {
+		return nil, err
+	}
+	stream, err := client.WatchEntities(
+		ctx,
+		&protos.WatchEntitiesRequest{
+			NetworkID:  networkID,
+			TypeFilter: protos.GetStringWrapper(typeFilter),
+			KeyFilter:  protos.GetStringWrapper(keyFilter),
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	// Wait for the watch to be established
+	if _, err = stream.Header(); err != nil {
+		return nil, err
+	}
+
+	changes := make(chan *protos.EntityChange)
+	go func() {
+		defer close(changes)
+		for {
+			change, err := stream.Recv()
+			if err != nil {
+				if ctx.Err() == nil {
+					glog.Errorf("Entity watch of network '%s' ended: %s", networkID, err)
+				}
+				return
+			}
+			select {
+			case changes <- change:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return changes, nil
+}
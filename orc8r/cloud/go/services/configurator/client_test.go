@@ -8,9 +8,11 @@ LICENSE file in the root directory of this source tree.
 package configurator_test
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"testing"
+	"time"
 
 	"magma/orc8r/cloud/go/serde"
 	"magma/orc8r/cloud/go/services/configurator"
@@ -19,6 +21,8 @@ import (
 
 	"github.com/golang/protobuf/ptypes/wrappers"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 const (
@@ -128,6 +132,11 @@ func TestConfiguratorService(t *testing.T) {
 		LoadPermissions: true,
 	}
 
+	// Watch entity changes
+	ctx, cancel := context.WithCancel(context.Background())
+	changes, err := configurator.WatchEntities(ctx, networkID1, strPointer("foo"), nil)
+	assert.NoError(t, err)
+
 	// Create, Load
 	_, err = configurator.CreateEntities(networkID1, []*protos.NetworkEntity{entity1, entity2})
 	assert.NoError(t, err)
@@ -192,6 +201,60 @@ func TestConfiguratorService(t *testing.T) {
 	assert.Equal(t, 1, len(entities))
 	assert.Equal(t, 0, len(entitiesNotFound))
 	assert.Equal(t, "foobar", entities[0].Name)
+	assert.Equal(t, uint64(1), entities[0].Version)
+
+	// Stale versions
+	staleUpdate := &protos.EntityUpdateCriteria{
+		Type:            entityID1.Type,
+		Key:             entityID1.Id,
+		NewPhysicalID:   strToStringValue("8765"),
+		ExpectedVersion: &wrappers.UInt64Value{Value: 0},
+	}
+	_, err = configurator.UpdateEntities(networkID1, []*protos.EntityUpdateCriteria{staleUpdate})
+	assert.True(t, configurator.IsVersionConflict(err))
+	err = configurator.DeleteEntityIfVersion(networkID1, entityID1.Type, entityID1.Id, 0)
+	assert.True(t, configurator.IsVersionConflict(err))
+	err = configurator.DeleteNetworkIfVersion(networkID1, 0)
+	assert.True(t, configurator.IsVersionConflict(err))
+	// other ABORTED errors, e.g. of WatchEntities, aren't version conflicts
+	assert.False(t, configurator.IsVersionConflict(status.Error(codes.Aborted, "entity watcher fell behind")))
+	networks, _, err = configurator.LoadNetworks([]string{networkID1}, false, false)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1), networks[networkID1].Version)
+
+	// Watched changes
+	assertEntityChange(t, changes, protos.EntityChange_CREATED, entityID1, 0)
+	assertEntityChange(t, changes, protos.EntityChange_CREATED, entityID2, 0)
+	change := assertEntityChange(t, changes, protos.EntityChange_UPDATED, entityID1, 1)
+	assert.Equal(t, "4321", change.Entity.PhysicalId)
+	assertEntityChange(t, changes, protos.EntityChange_DELETED, entityID2, 0)
+	cancel()
+	for range changes {
+	}
+}
+
+func assertEntityChange(
+	t *testing.T,
+	changes <-chan *protos.EntityChange,
+	changeType protos.EntityChange_ChangeType,
+	id *protos.EntityID,
+	version uint64,
+) *protos.EntityChange {
+	select {
+	case change, ok := <-changes:
+		if !assert.True(t, ok) {
+			return &protos.EntityChange{Entity: &protos.NetworkEntity{}}
+		}
+		assert.Equal(t, changeType, change.ChangeType)
+		assert.Equal(t, networkID1, change.NetworkID)
+		assert.Equal(t, id.Type, change.Entity.Type)
+		assert.Equal(t, id.Id, change.Entity.Id)
+		assert.Equal(t, version, change.Version)
+		return change
+	case <-time.After(5 * time.Second):
+		assert.Fail(t, "timed out waiting for entity change")
+		return &protos.EntityChange{Entity: &protos.NetworkEntity{}}
+	}
 }
 
 func strToStringValue(str string) *wrappers.StringValue {
@@ -39,7 +39,12 @@ func main() {
 		glog.Fatalf("Failed to connect to database: %s", err)
 	}
 
-	factory := storage.NewSQLConfiguratorStorageFactory(db, &storage.DefaultIDGenerator{}, sql_utils.GetSqlBuilder())
+	factory := storage.NewWatchableSQLConfiguratorStorageFactory(
+		db,
+		&storage.DefaultIDGenerator{},
+		sql_utils.GetSqlBuilder(),
+		storage.DefaultMaxChangelogVersions,
+	)
 	err = factory.InitializeServiceStorage()
 
 	nbServicer, err := servicers.NewNorthboundConfiguratorServicer(factory)
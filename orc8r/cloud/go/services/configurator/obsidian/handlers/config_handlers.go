@@ -9,6 +9,7 @@ LICENSE file in the root directory of this source tree.
 package handlers
 
 import (
+	"fmt"
 	"net/http"
 	"path"
 	"reflect"
@@ -58,11 +59,16 @@ func GetReadNetworkConfigHandler(path string, serde serde.Serde) handlers.Handle
 			if err != nil {
 				return err
 			}
-			serializedConfig, err := configurator.GetNetworkConfigsByType(networkID, configType)
+			networks, _, err := configurator.LoadNetworks([]string{networkID}, false, true)
 			if err != nil {
 				return handlers.HttpError(err, http.StatusBadRequest)
 			}
-			model, err := serde.Deserialize(serializedConfig)
+			if len(networks) == 0 {
+				return handlers.HttpError(fmt.Errorf("Network %s not found", networkID), http.StatusBadRequest)
+			}
+			// Network configs are versioned with their network
+			setETag(c, networks[networkID].Version)
+			model, err := serde.Deserialize(networks[networkID].Configs[configType])
 			if err != nil {
 				return handlers.HttpError(err, http.StatusBadRequest)
 			}
@@ -100,15 +106,20 @@ func GetDeleteNetworkConfigHandler(path string) handlers.Handler {
 			if err != nil {
 				return err
 			}
+			expectedVersion, err := getIfMatchVersion(c)
+			if err != nil {
+				return err
+			}
 			updateCriteria := &protos.NetworkUpdateCriteria{
 				Id:              networkID,
 				ConfigsToDelete: []string{configType},
+				ExpectedVersion: expectedVersion,
 			}
 			err = configurator.UpdateNetworks(
 				[]*protos.NetworkUpdateCriteria{updateCriteria},
 			)
 			if err != nil {
-				return handlers.HttpError(err, http.StatusBadRequest)
+				return versionedUpdateError(err, http.StatusBadRequest)
 			}
 			return c.NoContent(http.StatusNoContent)
 		},
@@ -125,13 +136,18 @@ func createOrUpdateNetworkConfig(c echo.Context, path string, serde serde.Serde)
 	if err != nil {
 		return err
 	}
+	expectedVersion, err := getIfMatchVersion(c)
+	if err != nil {
+		return err
+	}
 	updateCriteria := &protos.NetworkUpdateCriteria{
 		Id:                   networkID,
 		ConfigsToAddOrUpdate: configMap,
+		ExpectedVersion:      expectedVersion,
 	}
 	err = configurator.UpdateNetworks([]*protos.NetworkUpdateCriteria{updateCriteria})
 	if err != nil {
-		return handlers.HttpError(err, http.StatusBadRequest)
+		return versionedUpdateError(err, http.StatusBadRequest)
 	}
 	return c.JSON(http.StatusOK, "Created Config")
 }
@@ -84,6 +84,8 @@ func TestGetNetworkConfigCRUDHandlers(t *testing.T) {
 	err = json.Unmarshal(rec.Body.Bytes(), &actual)
 	assert.NoError(t, err)
 	assert.Equal(t, config, actual)
+	// Network was updated once by the config creation
+	assert.Equal(t, `"1"`, rec.Header().Get(configuratorh.HeaderETag))
 
 	// Test GetUpdateNetworkConfigsHandler
 	updatedConfig := FooConfigs{
@@ -93,14 +95,28 @@ func TestGetNetworkConfigCRUDHandlers(t *testing.T) {
 	post, err = json.Marshal(updatedConfig)
 	assert.NoError(t, err)
 
+	// Stale and invalid If-Match
+	url = getURL(restPort, networkID, fooSerdeType)
+	for ifMatch, expectedStatus := range map[string]int{`"0"`: http.StatusConflict, "1": http.StatusBadRequest} {
+		req = httptest.NewRequest(echo.PUT, "/", strings.NewReader(string(post)))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set(configuratorh.HeaderIfMatch, ifMatch)
+		c = e.NewContext(req, httptest.NewRecorder())
+		addParametersToContext(c, networkID, fooSerdeType)
+		err = configuratorh.GetUpdateNetworkConfigHandler(url, &fooSerde).HandlerFunc(c)
+		assert.Error(t, err)
+		assert.Equal(t, expectedStatus, err.(*echo.HTTPError).Code)
+	}
+	assertConfigExists(t, &fooSerde, networkID, fooSerdeType, config)
+
 	req = httptest.NewRequest(echo.PUT, "/", strings.NewReader(string(post)))
 	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.Header.Set(configuratorh.HeaderIfMatch, `"1"`)
 	rec = httptest.NewRecorder()
 	c = e.NewContext(req, rec)
 	addParametersToContext(c, networkID, fooSerdeType)
 
 	// Success
-	url = getURL(restPort, networkID, fooSerdeType)
 	err = configuratorh.GetUpdateNetworkConfigHandler(url, &fooSerde).HandlerFunc(c)
 	assert.NoError(t, err)
 	assert.Equal(t, http.StatusOK, rec.Code)
@@ -109,6 +125,15 @@ func TestGetNetworkConfigCRUDHandlers(t *testing.T) {
 
 	// TestGetDeleteConfigHandler
 	req = httptest.NewRequest(echo.DELETE, "/", nil)
+	req.Header.Set(configuratorh.HeaderIfMatch, `"1"`)
+	c = e.NewContext(req, httptest.NewRecorder())
+	addParametersToContext(c, networkID, fooSerdeType)
+	err = configuratorh.GetDeleteNetworkConfigHandler(url).HandlerFunc(c)
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusConflict, err.(*echo.HTTPError).Code)
+
+	req = httptest.NewRequest(echo.DELETE, "/", nil)
+	req.Header.Set(configuratorh.HeaderIfMatch, `"2"`)
 	rec = httptest.NewRecorder()
 	c = e.NewContext(req, rec)
 	addParametersToContext(c, networkID, fooSerdeType)
@@ -0,0 +1,268 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"magma/orc8r/cloud/go/obsidian/handlers"
+	"magma/orc8r/cloud/go/serde"
+	"magma/orc8r/cloud/go/services/configurator"
+	configurator_models "magma/orc8r/cloud/go/services/configurator/obsidian/models"
+	"magma/orc8r/cloud/go/services/configurator/protos"
+
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/labstack/echo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	ListEntities = ManageNetwork + "/entities"
+	ManageEntity = ListEntities + "/:entity_type/:entity_key"
+
+	DefaultEntityPageSize = 100
+	MaxEntityPageSize     = 1000
+)
+
+func listEntities(c echo.Context) error {
+	networkID, nerr := handlers.GetNetworkId(c)
+	if nerr != nil {
+		return nerr
+	}
+	pageSize, err := getEntityPageSize(c)
+	if err != nil {
+		return err
+	}
+	configFilters, err := getConfigFilters(c)
+	if err != nil {
+		return err
+	}
+
+	request := &protos.LoadEntitiesRequest{
+		NetworkID:     networkID,
+		TypeFilter:    queryParamToStrWrapper(c, "type"),
+		KeyFilter:     queryParamToStrWrapper(c, "key"),
+		PhysicalID:    queryParamToStrWrapper(c, "physical_id"),
+		ConfigFilters: configFilters,
+		Criteria: &protos.EntityLoadCriteria{
+			LoadMetadata:   true,
+			LoadConfig:     true,
+			LoadAssocsFrom: true,
+			PageSize:       pageSize,
+			PageToken:      c.QueryParam("page_token"),
+		},
+	}
+	entities, nextPageToken, err := configurator.LoadEntitiesPage(request)
+	if status.Code(err) == codes.InvalidArgument {
+		return handlers.HttpError(err, http.StatusBadRequest)
+	}
+	if err != nil {
+		return handlers.HttpError(err, http.StatusInternalServerError)
+	}
+
+	ret := &configurator_models.PaginatedNetworkEntities{
+		Entities:      make([]*configurator_models.NetworkEntity, 0, len(entities)),
+		NextPageToken: nextPageToken,
+	}
+	for _, entity := range entities {
+		swaggerEntity, err := toSwaggerNetworkEntity(entity)
+		if err != nil {
+			return handlers.HttpError(err, http.StatusInternalServerError)
+		}
+		ret.Entities = append(ret.Entities, swaggerEntity)
+	}
+	return c.JSON(http.StatusOK, ret)
+}
+
+func getEntity(c echo.Context) error {
+	networkID, entityType, entityKey, nerr := getNetworkIDAndEntityID(c)
+	if nerr != nil {
+		return nerr
+	}
+	entities, _, err := configurator.LoadEntities(
+		networkID,
+		nil,
+		nil,
+		[]*protos.EntityID{{Type: entityType, Id: entityKey}},
+		&protos.EntityLoadCriteria{LoadMetadata: true, LoadConfig: true, LoadAssocsFrom: true},
+	)
+	if err != nil {
+		return handlers.HttpError(err, http.StatusInternalServerError)
+	}
+	if len(entities) == 0 {
+		return handlers.HttpError(fmt.Errorf("Entity (%s, %s) not found", entityType, entityKey), http.StatusNotFound)
+	}
+	return entityResponse(c, http.StatusOK, entities[0])
+}
+
+func updateEntity(c echo.Context) error {
+	networkID, entityType, entityKey, nerr := getNetworkIDAndEntityID(c)
+	if nerr != nil {
+		return nerr
+	}
+	// Bind network entity from swagger, its type, key & version come from
+	// the path and If-Match header
+	swaggerEntity := &configurator_models.NetworkEntity{}
+	err := c.Bind(swaggerEntity)
+	if err != nil {
+		return handlers.HttpError(err, http.StatusBadRequest)
+	}
+	expectedVersion, err := getIfMatchVersion(c)
+	if err != nil {
+		return err
+	}
+
+	updateCriteria := &protos.EntityUpdateCriteria{
+		Type:            entityType,
+		Key:             entityKey,
+		NewName:         inputStrToStrWrapper(swaggerEntity.Name),
+		NewDescription:  inputStrToStrWrapper(swaggerEntity.Description),
+		ExpectedVersion: expectedVersion,
+	}
+	if swaggerEntity.PhysicalID != "" {
+		updateCriteria.NewPhysicalID = inputStrToStrWrapper(swaggerEntity.PhysicalID)
+	}
+	if swaggerEntity.Config != nil {
+		config, err := serializeEntityConfig(entityType, swaggerEntity.Config)
+		if err != nil {
+			return handlers.HttpError(err, http.StatusBadRequest)
+		}
+		updateCriteria.NewConfig = &wrappers.BytesValue{Value: config}
+	}
+	updatedEntities, err := configurator.UpdateEntities(networkID, []*protos.EntityUpdateCriteria{updateCriteria})
+	if err != nil {
+		return versionedUpdateError(err, http.StatusBadRequest)
+	}
+	return entityResponse(c, http.StatusOK, updatedEntities[entityKey])
+}
+
+func deleteEntity(c echo.Context) error {
+	networkID, entityType, entityKey, nerr := getNetworkIDAndEntityID(c)
+	if nerr != nil {
+		return nerr
+	}
+	expectedVersion, err := getIfMatchVersion(c)
+	if err != nil {
+		return err
+	}
+
+	if expectedVersion != nil {
+		err = configurator.DeleteEntityIfVersion(networkID, entityType, entityKey, expectedVersion.Value)
+	} else {
+		err = configurator.DeleteEntities(networkID, []*protos.EntityID{{Type: entityType, Id: entityKey}})
+	}
+	if err != nil {
+		return versionedUpdateError(err, http.StatusBadRequest)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+func getNetworkIDAndEntityID(c echo.Context) (string, string, string, *echo.HTTPError) {
+	networkID, nerr := handlers.GetNetworkId(c)
+	if nerr != nil {
+		return "", "", "", nerr
+	}
+	entityType, entityKey := c.Param("entity_type"), c.Param("entity_key")
+	if entityType == "" || entityKey == "" {
+		return "", "", "", handlers.HttpError(fmt.Errorf("Invalid/Missing Entity Type or Key"), http.StatusBadRequest)
+	}
+	return networkID, entityType, entityKey, nil
+}
+
+// entityResponse writes the entity with its version as the ETag
+func entityResponse(c echo.Context, code int, entity *protos.NetworkEntity) error {
+	swaggerEntity, err := toSwaggerNetworkEntity(entity)
+	if err != nil {
+		return handlers.HttpError(err, http.StatusInternalServerError)
+	}
+	setETag(c, entity.Version)
+	return c.JSON(code, swaggerEntity)
+}
+
+// serializeEntityConfig converts the JSON config of a request to the model of
+// the entity type's serde and serializes it. This relies on entity config
+// serdes being JSON based, as the GET handlers return deserialized models.
+func serializeEntityConfig(entityType string, config interface{}) ([]byte, error) {
+	marshaledConfig, err := json.Marshal(config)
+	if err != nil {
+		return nil, err
+	}
+	model, err := serde.Deserialize(configurator.SerdeDomain, entityType, marshaledConfig)
+	if err != nil {
+		return nil, err
+	}
+	return serde.Serialize(configurator.SerdeDomain, entityType, model)
+}
+
+func getEntityPageSize(c echo.Context) (uint32, error) {
+	pageSizeParam := c.QueryParam("page_size")
+	if pageSizeParam == "" {
+		return DefaultEntityPageSize, nil
+	}
+	pageSize, err := strconv.ParseUint(pageSizeParam, 10, 32)
+	if err != nil || pageSize == 0 || pageSize > MaxEntityPageSize {
+		return 0, handlers.HttpError(
+			fmt.Errorf("Invalid page_size '%s': must be between 1 and %d", pageSizeParam, MaxEntityPageSize),
+			http.StatusBadRequest,
+		)
+	}
+	return uint32(pageSize), nil
+}
+
+// getConfigFilters parses the config_filter query params, formatted as
+// path=value
+func getConfigFilters(c echo.Context) (map[string]string, error) {
+	configFilters := map[string]string{}
+	for _, configFilter := range c.QueryParams()["config_filter"] {
+		split := strings.SplitN(configFilter, "=", 2)
+		if len(split) != 2 || split[0] == "" {
+			return nil, handlers.HttpError(
+				fmt.Errorf("Invalid config_filter '%s': expected path=value", configFilter),
+				http.StatusBadRequest,
+			)
+		}
+		configFilters[split[0]] = split[1]
+	}
+	return configFilters, nil
+}
+
+func toSwaggerNetworkEntity(entity *protos.NetworkEntity) (*configurator_models.NetworkEntity, error) {
+	ret := &configurator_models.NetworkEntity{
+		Type:         entity.Type,
+		Key:          entity.Id,
+		Name:         entity.Name,
+		Description:  entity.Description,
+		PhysicalID:   entity.PhysicalId,
+		Version:      entity.Version,
+		Associations: make([]*configurator_models.EntityID, 0, len(entity.Assocs)),
+	}
+	if len(entity.Config) > 0 {
+		config, err := serde.Deserialize(configurator.SerdeDomain, entity.Type, entity.Config)
+		if err != nil {
+			return nil, err
+		}
+		ret.Config = config
+	}
+	for _, assoc := range entity.Assocs {
+		ret.Associations = append(ret.Associations, &configurator_models.EntityID{Type: assoc.Type, Key: assoc.Id})
+	}
+	return ret, nil
+}
+
+func queryParamToStrWrapper(c echo.Context, name string) *wrappers.StringValue {
+	if _, ok := c.QueryParams()[name]; !ok {
+		return nil
+	}
+	return &wrappers.StringValue{Value: c.QueryParam(name)}
+}
@@ -0,0 +1,203 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ *  LICENSE file in the root directory of this source tree.
+ */
+
+package handlers_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"magma/orc8r/cloud/go/obsidian/handlers"
+	"magma/orc8r/cloud/go/serde"
+	"magma/orc8r/cloud/go/services/configurator"
+	configuratorh "magma/orc8r/cloud/go/services/configurator/obsidian/handlers"
+	"magma/orc8r/cloud/go/services/configurator/obsidian/models"
+	"magma/orc8r/cloud/go/services/configurator/protos"
+	"magma/orc8r/cloud/go/services/configurator/test_init"
+
+	"github.com/labstack/echo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListEntities(t *testing.T) {
+	test_init.StartTestService(t)
+	serde.UnregisterSerdesForDomain(t, configurator.SerdeDomain)
+	err := serde.RegisterSerdes(&fooSerde{})
+	assert.NoError(t, err)
+
+	_, err = configurator.CreateNetworks([]*protos.Network{{Id: networkID}})
+	assert.NoError(t, err)
+	entities := []*protos.NetworkEntity{}
+	for i := 1; i <= 3; i++ {
+		config, err := json.Marshal(FooConfigs{ConfigNum: i % 2, ConfigStr: fmt.Sprintf("config%d", i)})
+		assert.NoError(t, err)
+		entities = append(entities, &protos.NetworkEntity{
+			Type:       fooSerdeType,
+			Id:         fmt.Sprintf("foo%d", i),
+			PhysicalId: fmt.Sprintf("p%d", i%2),
+			Config:     config,
+		})
+	}
+	entities[0].Assocs = []*protos.EntityID{{Type: fooSerdeType, Id: "foo2"}}
+	_, err = configurator.CreateEntities(networkID, entities[1:])
+	assert.NoError(t, err)
+	_, err = configurator.CreateEntities(networkID, entities[:1])
+	assert.NoError(t, err)
+
+	var listHandler echo.HandlerFunc
+	for _, handler := range configuratorh.GetObsidianHandlers() {
+		if handler.Path == configuratorh.ListEntities && handler.Methods == handlers.GET {
+			listHandler = handler.HandlerFunc
+		}
+	}
+	listEntities := func(query string) (*models.PaginatedNetworkEntities, error) {
+		req := httptest.NewRequest(echo.GET, "/?"+query, nil)
+		rec := httptest.NewRecorder()
+		c := echo.New().NewContext(req, rec)
+		c.SetParamNames("network_id")
+		c.SetParamValues(networkID)
+		if err := listHandler(c); err != nil {
+			return nil, err
+		}
+		assert.Equal(t, http.StatusOK, rec.Code)
+		page := &models.PaginatedNetworkEntities{}
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), page))
+		return page, nil
+	}
+	keys := func(page *models.PaginatedNetworkEntities) []string {
+		ret := []string{}
+		for _, ent := range page.Entities {
+			ret = append(ret, ent.Key)
+		}
+		return ret
+	}
+
+	// Pagination
+	page, err := listEntities("page_size=2")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"foo1", "foo2"}, keys(page))
+	assert.NotEmpty(t, page.NextPageToken)
+	assert.Equal(t, "config1", page.Entities[0].Config.(map[string]interface{})["config_str"])
+	assert.Equal(t, []*models.EntityID{{Type: fooSerdeType, Key: "foo2"}}, page.Entities[0].Associations)
+	assert.Equal(t, "p1", page.Entities[0].PhysicalID)
+	page, err = listEntities("page_size=2&page_token=" + page.NextPageToken)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"foo3"}, keys(page))
+	assert.Empty(t, page.NextPageToken)
+
+	// Filters
+	page, err = listEntities("physical_id=p0")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"foo2"}, keys(page))
+	page, err = listEntities("type=bar")
+	assert.NoError(t, err)
+	assert.Empty(t, page.Entities)
+	page, err = listEntities("config_filter=config_num=1&page_size=1")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"foo1"}, keys(page))
+	page, err = listEntities("config_filter=config_num=1&page_size=1&page_token=" + page.NextPageToken)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"foo3"}, keys(page))
+	assert.Empty(t, page.NextPageToken)
+	page, err = listEntities("config_filter=config_num=1&config_filter=config_str=config3")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"foo3"}, keys(page))
+
+	// Bad requests
+	for _, query := range []string{"page_size=0", "page_size=1001", "page_size=foo", "page_token=foo", "config_filter=config_num"} {
+		_, err = listEntities(query)
+		assert.Error(t, err, query)
+		assert.Equal(t, http.StatusBadRequest, err.(*echo.HTTPError).Code, query)
+	}
+}
+
+func TestEntityVersions(t *testing.T) {
+	test_init.StartTestService(t)
+	serde.UnregisterSerdesForDomain(t, configurator.SerdeDomain)
+	err := serde.RegisterSerdes(&fooSerde{})
+	assert.NoError(t, err)
+
+	_, err = configurator.CreateNetworks([]*protos.Network{{Id: networkID}})
+	assert.NoError(t, err)
+	config, err := json.Marshal(FooConfigs{ConfigNum: 1, ConfigStr: "config"})
+	assert.NoError(t, err)
+	_, err = configurator.CreateEntities(
+		networkID,
+		[]*protos.NetworkEntity{{Type: fooSerdeType, Id: "foo1", PhysicalId: "p1", Config: config}},
+	)
+	assert.NoError(t, err)
+
+	entityHandlers := map[handlers.HttpMethod]echo.HandlerFunc{}
+	for _, handler := range configuratorh.GetObsidianHandlers() {
+		if handler.Path == configuratorh.ManageEntity {
+			entityHandlers[handler.Methods] = handler.HandlerFunc
+		}
+	}
+	methods := map[string]handlers.HttpMethod{echo.GET: handlers.GET, echo.PUT: handlers.PUT, echo.DELETE: handlers.DELETE}
+	callHandler := func(method string, body string, ifMatch string) (*httptest.ResponseRecorder, error) {
+		req := httptest.NewRequest(method, "/", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		if ifMatch != "" {
+			req.Header.Set(configuratorh.HeaderIfMatch, ifMatch)
+		}
+		rec := httptest.NewRecorder()
+		c := echo.New().NewContext(req, rec)
+		c.SetParamNames("network_id", "entity_type", "entity_key")
+		c.SetParamValues(networkID, fooSerdeType, "foo1")
+		return rec, entityHandlers[methods[method]](c)
+	}
+
+	// GET carries the version as ETag
+	rec, err := callHandler(echo.GET, "", "")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, `"0"`, rec.Header().Get(configuratorh.HeaderETag))
+	entity := &models.NetworkEntity{}
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), entity))
+	assert.Equal(t, "p1", entity.PhysicalID)
+	assert.Equal(t, "config", entity.Config.(map[string]interface{})["config_str"])
+
+	// PUT with matching If-Match
+	rec, err = callHandler(echo.PUT, `{"name": "foo", "config": {"config_num": 2, "config_str": "updated"}}`, `"0"`)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, `"1"`, rec.Header().Get(configuratorh.HeaderETag))
+	entities, _, err := configurator.LoadEntities(
+		networkID,
+		nil,
+		nil,
+		[]*protos.EntityID{{Type: fooSerdeType, Id: "foo1"}},
+		&protos.EntityLoadCriteria{LoadMetadata: true, LoadConfig: true},
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, "foo", entities[0].Name)
+	assert.Equal(t, "p1", entities[0].PhysicalId)
+	updatedConfig, err := serde.Deserialize(configurator.SerdeDomain, fooSerdeType, entities[0].Config)
+	assert.NoError(t, err)
+	assert.Equal(t, FooConfigs{ConfigNum: 2, ConfigStr: "updated"}, updatedConfig)
+
+	// Stale If-Match
+	_, err = callHandler(echo.PUT, `{"name": "bar"}`, `"0"`)
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusConflict, err.(*echo.HTTPError).Code)
+	_, err = callHandler(echo.DELETE, "", `"0"`)
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusConflict, err.(*echo.HTTPError).Code)
+
+	// DELETE with matching If-Match
+	rec, err = callHandler(echo.DELETE, "", `"1"`)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	_, err = callHandler(echo.GET, "", "")
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusNotFound, err.(*echo.HTTPError).Code)
+}
@@ -21,5 +21,11 @@ func GetObsidianHandlers() []handlers.Handler {
 		{Path: ManageNetwork, Methods: handlers.GET, HandlerFunc: getNetwork},
 		{Path: ManageNetwork, Methods: handlers.PUT, HandlerFunc: updateNetwork},
 		{Path: ManageNetwork, Methods: handlers.DELETE, HandlerFunc: deleteNetwork},
+
+		// Entity
+		{Path: ListEntities, Methods: handlers.GET, HandlerFunc: listEntities},
+		{Path: ManageEntity, Methods: handlers.GET, HandlerFunc: getEntity},
+		{Path: ManageEntity, Methods: handlers.PUT, HandlerFunc: updateEntity},
+		{Path: ManageEntity, Methods: handlers.DELETE, HandlerFunc: deleteEntity},
 	}
 }
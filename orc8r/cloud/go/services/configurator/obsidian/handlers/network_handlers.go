@@ -86,6 +86,7 @@ func getNetwork(c echo.Context) error {
 		return handlers.HttpError(fmt.Errorf("Network ID %s not found", networkID), http.StatusBadRequest)
 	}
 	network := networks[networkID]
+	setETag(c, network.Version)
 
 	swaggerRecord := &configurator_models.NetworkRecord{
 		Name:        network.Name,
@@ -106,14 +107,20 @@ func updateNetwork(c echo.Context) error {
 		return handlers.HttpError(err, http.StatusBadRequest)
 	}
 
+	expectedVersion, err := getIfMatchVersion(c)
+	if err != nil {
+		return err
+	}
+
 	updateCriteria := &protos.NetworkUpdateCriteria{
-		Id:             networkID,
-		NewName:        inputStrToStrWrapper(swaggerNetwork.Name),
-		NewDescription: inputStrToStrWrapper(swaggerNetwork.Description),
+		Id:              networkID,
+		NewName:         inputStrToStrWrapper(swaggerNetwork.Name),
+		NewDescription:  inputStrToStrWrapper(swaggerNetwork.Description),
+		ExpectedVersion: expectedVersion,
 	}
 	err = configurator.UpdateNetworks([]*protos.NetworkUpdateCriteria{updateCriteria})
 	if err != nil {
-		return handlers.HttpError(err, http.StatusBadRequest)
+		return versionedUpdateError(err, http.StatusBadRequest)
 	}
 	return c.JSON(http.StatusOK, fmt.Sprintf("Network:%s updated", networkID))
 }
@@ -124,9 +131,18 @@ func deleteNetwork(c echo.Context) error {
 		return nerr
 	}
 
-	err := configurator.DeleteNetworks([]string{networkID})
+	expectedVersion, err := getIfMatchVersion(c)
 	if err != nil {
-		return handlers.HttpError(err, http.StatusInternalServerError)
+		return err
+	}
+
+	if expectedVersion != nil {
+		err = configurator.DeleteNetworkIfVersion(networkID, expectedVersion.Value)
+	} else {
+		err = configurator.DeleteNetworks([]string{networkID})
+	}
+	if err != nil {
+		return versionedUpdateError(err, http.StatusInternalServerError)
 	}
 	return c.NoContent(http.StatusNoContent)
 }
@@ -0,0 +1,63 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"magma/orc8r/cloud/go/obsidian/handlers"
+	"magma/orc8r/cloud/go/services/configurator"
+
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/labstack/echo"
+)
+
+// Networks (and their configs) and entities are versioned, GET responses
+// carry the current version of the network or entity as an ETag and updates
+// or deletions can be made conditional with an If-Match header. A stale
+// If-Match fails with 409.
+const (
+	HeaderETag    = "ETag"
+	HeaderIfMatch = "If-Match"
+)
+
+// setETag sets the ETag header of the response to the given version
+func setETag(c echo.Context, version uint64) {
+	c.Response().Header().Set(HeaderETag, strconv.Quote(strconv.FormatUint(version, 10)))
+}
+
+// getIfMatchVersion returns the version of the If-Match header of the request
+// or nil if the request has no If-Match header or matches any version ("*").
+func getIfMatchVersion(c echo.Context) (*wrappers.UInt64Value, error) {
+	ifMatch := strings.TrimSpace(c.Request().Header.Get(HeaderIfMatch))
+	if ifMatch == "" || ifMatch == "*" {
+		return nil, nil
+	}
+	unquoted, err := strconv.Unquote(ifMatch)
+	if err != nil {
+		return nil, handlers.HttpError(fmt.Errorf("Invalid If-Match header '%s': expected a single ETag", ifMatch), http.StatusBadRequest)
+	}
+	version, err := strconv.ParseUint(unquoted, 10, 64)
+	if err != nil {
+		return nil, handlers.HttpError(fmt.Errorf("Invalid If-Match header '%s': unknown ETag", ifMatch), http.StatusBadRequest)
+	}
+	return &wrappers.UInt64Value{Value: version}, nil
+}
+
+// versionedUpdateError returns a 409 for version conflicts and the given
+// status code for other errors
+func versionedUpdateError(err error, status int) error {
+	if configurator.IsVersionConflict(err) {
+		return handlers.HttpError(err, http.StatusConflict)
+	}
+	return handlers.HttpError(err, status)
+}
@@ -15,6 +15,11 @@ import (
 	"github.com/golang/protobuf/ptypes/wrappers"
 )
 
+// VersionConflictViolation is the type of the PreconditionFailure violation
+// detailing the ABORTED status of an update or deletion whose expected version
+// didn't match the current version
+const VersionConflictViolation = "VERSION_CONFLICT"
+
 // ToNetwork translates protobuf struct to corresponding storage struct
 func (network *Network) ToNetwork() storage.Network {
 	return storage.Network{
@@ -66,6 +71,8 @@ func (criteria *EntityLoadCriteria) ToEntityLoadCriteria() storage.EntityLoadCri
 		LoadAssocsToThis:   criteria.LoadAssocsTo,
 		LoadAssocsFromThis: criteria.LoadAssocsFrom,
 		LoadPermissions:    criteria.LoadPermissions,
+		PageSize:           criteria.PageSize,
+		PageToken:          criteria.PageToken,
 	}
 }
 
@@ -78,15 +85,25 @@ func (criteria *NetworkLoadCriteria) ToNetworkLoadCriteria() storage.NetworkLoad
 }
 
 // ToEntityLoadFilter translates protobuf struct to corresponding storage struct
-func ToEntityLoadFilter(typeFilter *wrappers.StringValue, keyFilter *wrappers.StringValue, ids []*EntityID) storage.EntityLoadFilter {
+func ToEntityLoadFilter(typeFilter *wrappers.StringValue, keyFilter *wrappers.StringValue, physicalID *wrappers.StringValue, ids []*EntityID) storage.EntityLoadFilter {
 	entityLoadFilter := storage.EntityLoadFilter{
 		TypeFilter: getStringPointer(typeFilter),
 		KeyFilter:  getStringPointer(keyFilter),
+		PhysicalID: getStringPointer(physicalID),
 		IDs:        ToTypeAndKeys(ids),
 	}
 	return entityLoadFilter
 }
 
+// ToEntityWatchFilter translates protobuf struct to corresponding storage struct
+func (req *WatchEntitiesRequest) ToEntityWatchFilter() storage.EntityWatchFilter {
+	return storage.EntityWatchFilter{
+		NetworkID:  req.NetworkID,
+		TypeFilter: getStringPointer(req.TypeFilter),
+		KeyFilter:  getStringPointer(req.KeyFilter),
+	}
+}
+
 // ToNetworkUpdateCriteria translates protobuf struct to corresponding storage struct
 func (criteria *NetworkUpdateCriteria) ToNetworkUpdateCriteria() storage.NetworkUpdateCriteria {
 	return storage.NetworkUpdateCriteria{
@@ -96,6 +113,7 @@ func (criteria *NetworkUpdateCriteria) ToNetworkUpdateCriteria() storage.Network
 		NewDescription:       getStringPointer(criteria.NewDescription),
 		ConfigsToAddOrUpdate: criteria.ConfigsToAddOrUpdate,
 		ConfigsToDelete:      criteria.ConfigsToDelete,
+		ExpectedVersion:      getUint64Pointer(criteria.ExpectedVersion),
 	}
 }
 
@@ -113,6 +131,7 @@ func (criteria *EntityUpdateCriteria) ToEntityUpdateCriteria() storage.EntityUpd
 		PermissionsToCreate:  toStorageACLs(criteria.PermissionsToCreate),
 		PermissionsToUpdate:  toStorageACLs(criteria.PermissionsToUpdate),
 		PermissionsToDelete:  criteria.PermissionsToDelete,
+		ExpectedVersion:      getUint64Pointer(criteria.ExpectedVersion),
 	}
 }
 
@@ -123,6 +142,7 @@ func FromStorageNetwork(network storage.Network) *Network {
 		Name:        network.Name,
 		Description: network.Description,
 		Configs:     network.Configs,
+		Version:     network.Version,
 	}
 }
 
@@ -148,6 +168,7 @@ func FromStorageNetworkEntity(entity storage.NetworkEntity) *NetworkEntity {
 		Assocs:       FromTKs(entity.Associations),
 		ParentAssocs: FromTKs(entity.ParentAssociations),
 		Permissions:  fromStorageACLs(entity.Permissions),
+		Version:      entity.Version,
 	}
 }
 
@@ -160,6 +181,39 @@ func FromStorageNetworkEntities(entities []storage.NetworkEntity) []*NetworkEnti
 	return pEntities
 }
 
+// FromStorageEntityChange translates storage struct to corresponding protobuf struct
+func FromStorageEntityChange(change storage.EntityChange) *EntityChange {
+	changeType := EntityChange_UNKNOWN
+	switch change.Type {
+	case storage.EntityCreated:
+		changeType = EntityChange_CREATED
+	case storage.EntityUpdated:
+		changeType = EntityChange_UPDATED
+	case storage.EntityDeleted:
+		changeType = EntityChange_DELETED
+	case storage.NetworkCreated:
+		changeType = EntityChange_NETWORK_CREATED
+	case storage.NetworkUpdated:
+		changeType = EntityChange_NETWORK_UPDATED
+	case storage.NetworkDeleted:
+		changeType = EntityChange_NETWORK_DELETED
+	}
+	if change.IsNetworkChange() {
+		return &EntityChange{
+			ChangeType: changeType,
+			NetworkID:  change.NetworkID,
+			Network:    FromStorageNetwork(change.Network),
+			Version:    change.Network.Version,
+		}
+	}
+	return &EntityChange{
+		ChangeType: changeType,
+		NetworkID:  change.NetworkID,
+		Entity:     FromStorageNetworkEntity(change.Entity),
+		Version:    change.Entity.Version,
+	}
+}
+
 // GetStringWrapper wraps a pointer string value into protobuf StringValue
 func GetStringWrapper(pStr *string) *wrappers.StringValue {
 	if pStr == nil {
@@ -244,3 +298,10 @@ func getBytesPointer(bytesWrapper *wrappers.BytesValue) *[]byte {
 	}
 	return nil
 }
+
+func getUint64Pointer(uint64Wrapper *wrappers.UInt64Value) *uint64 {
+	if uint64Wrapper != nil {
+		return &(uint64Wrapper.Value)
+	}
+	return nil
+}
@@ -26,6 +26,44 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+type EntityChange_ChangeType int32
+
+const (
+	EntityChange_UNKNOWN         EntityChange_ChangeType = 0
+	EntityChange_CREATED         EntityChange_ChangeType = 1
+	EntityChange_UPDATED         EntityChange_ChangeType = 2
+	EntityChange_DELETED         EntityChange_ChangeType = 3
+	EntityChange_NETWORK_CREATED EntityChange_ChangeType = 4
+	EntityChange_NETWORK_UPDATED EntityChange_ChangeType = 5
+	EntityChange_NETWORK_DELETED EntityChange_ChangeType = 6
+)
+
+var EntityChange_ChangeType_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "CREATED",
+	2: "UPDATED",
+	3: "DELETED",
+	4: "NETWORK_CREATED",
+	5: "NETWORK_UPDATED",
+	6: "NETWORK_DELETED",
+}
+var EntityChange_ChangeType_value = map[string]int32{
+	"UNKNOWN":         0,
+	"CREATED":         1,
+	"UPDATED":         2,
+	"DELETED":         3,
+	"NETWORK_CREATED": 4,
+	"NETWORK_UPDATED": 5,
+	"NETWORK_DELETED": 6,
+}
+
+func (x EntityChange_ChangeType) String() string {
+	return proto.EnumName(EntityChange_ChangeType_name, int32(x))
+}
+func (EntityChange_ChangeType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_northbound_4c33534d5d90e938, []int{19, 0}
+}
+
 type ListNetworkIDsResponse struct {
 	NetworkIDs           []string `protobuf:"bytes,1,rep,name=networkIDs,proto3" json:"networkIDs,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -37,7 +75,7 @@ func (m *ListNetworkIDsResponse) Reset()         { *m = ListNetworkIDsResponse{}
 func (m *ListNetworkIDsResponse) String() string { return proto.CompactTextString(m) }
 func (*ListNetworkIDsResponse) ProtoMessage()    {}
 func (*ListNetworkIDsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_northbound_07d50f0696abd602, []int{0}
+	return fileDescriptor_northbound_4c33534d5d90e938, []int{0}
 }
 func (m *ListNetworkIDsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListNetworkIDsResponse.Unmarshal(m, b)
@@ -75,7 +113,7 @@ func (m *CreateNetworksRequest) Reset()         { *m = CreateNetworksRequest{} }
 func (m *CreateNetworksRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateNetworksRequest) ProtoMessage()    {}
 func (*CreateNetworksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_northbound_07d50f0696abd602, []int{1}
+	return fileDescriptor_northbound_4c33534d5d90e938, []int{1}
 }
 func (m *CreateNetworksRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateNetworksRequest.Unmarshal(m, b)
@@ -113,7 +151,7 @@ func (m *CreateNetworksResponse) Reset()         { *m = CreateNetworksResponse{}
 func (m *CreateNetworksResponse) String() string { return proto.CompactTextString(m) }
 func (*CreateNetworksResponse) ProtoMessage()    {}
 func (*CreateNetworksResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_northbound_07d50f0696abd602, []int{2}
+	return fileDescriptor_northbound_4c33534d5d90e938, []int{2}
 }
 func (m *CreateNetworksResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateNetworksResponse.Unmarshal(m, b)
@@ -142,7 +180,10 @@ func (m *CreateNetworksResponse) GetCreatedNetworks() []*Network {
 
 // NetworkUpdateCriteria specifies information needed to update a network
 type NetworkUpdateCriteria struct {
-	Id                   string                `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// expectedVersion fails the update with ABORTED if it doesn't match the
+	// network's current version
+	ExpectedVersion      *wrappers.UInt64Value `protobuf:"bytes,2,opt,name=expectedVersion,proto3" json:"expectedVersion,omitempty"`
 	NewName              *wrappers.StringValue `protobuf:"bytes,10,opt,name=newName,proto3" json:"newName,omitempty"`
 	NewDescription       *wrappers.StringValue `protobuf:"bytes,11,opt,name=newDescription,proto3" json:"newDescription,omitempty"`
 	ConfigsToAddOrUpdate map[string][]byte     `protobuf:"bytes,20,rep,name=configsToAddOrUpdate,proto3" json:"configsToAddOrUpdate,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
@@ -156,7 +197,7 @@ func (m *NetworkUpdateCriteria) Reset()         { *m = NetworkUpdateCriteria{} }
 func (m *NetworkUpdateCriteria) String() string { return proto.CompactTextString(m) }
 func (*NetworkUpdateCriteria) ProtoMessage()    {}
 func (*NetworkUpdateCriteria) Descriptor() ([]byte, []int) {
-	return fileDescriptor_northbound_07d50f0696abd602, []int{3}
+	return fileDescriptor_northbound_4c33534d5d90e938, []int{3}
 }
 func (m *NetworkUpdateCriteria) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NetworkUpdateCriteria.Unmarshal(m, b)
@@ -183,6 +224,13 @@ func (m *NetworkUpdateCriteria) GetId() string {
 	return ""
 }
 
+func (m *NetworkUpdateCriteria) GetExpectedVersion() *wrappers.UInt64Value {
+	if m != nil {
+		return m.ExpectedVersion
+	}
+	return nil
+}
+
 func (m *NetworkUpdateCriteria) GetNewName() *wrappers.StringValue {
 	if m != nil {
 		return m.NewName
@@ -222,7 +270,7 @@ func (m *UpdateNetworksRequest) Reset()         { *m = UpdateNetworksRequest{} }
 func (m *UpdateNetworksRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateNetworksRequest) ProtoMessage()    {}
 func (*UpdateNetworksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_northbound_07d50f0696abd602, []int{4}
+	return fileDescriptor_northbound_4c33534d5d90e938, []int{4}
 }
 func (m *UpdateNetworksRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateNetworksRequest.Unmarshal(m, b)
@@ -261,7 +309,7 @@ func (m *NetworkLoadCriteria) Reset()         { *m = NetworkLoadCriteria{} }
 func (m *NetworkLoadCriteria) String() string { return proto.CompactTextString(m) }
 func (*NetworkLoadCriteria) ProtoMessage()    {}
 func (*NetworkLoadCriteria) Descriptor() ([]byte, []int) {
-	return fileDescriptor_northbound_07d50f0696abd602, []int{5}
+	return fileDescriptor_northbound_4c33534d5d90e938, []int{5}
 }
 func (m *NetworkLoadCriteria) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NetworkLoadCriteria.Unmarshal(m, b)
@@ -307,7 +355,7 @@ func (m *LoadNetworksRequest) Reset()         { *m = LoadNetworksRequest{} }
 func (m *LoadNetworksRequest) String() string { return proto.CompactTextString(m) }
 func (*LoadNetworksRequest) ProtoMessage()    {}
 func (*LoadNetworksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_northbound_07d50f0696abd602, []int{6}
+	return fileDescriptor_northbound_4c33534d5d90e938, []int{6}
 }
 func (m *LoadNetworksRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LoadNetworksRequest.Unmarshal(m, b)
@@ -353,7 +401,7 @@ func (m *LoadNetworksResponse) Reset()         { *m = LoadNetworksResponse{} }
 func (m *LoadNetworksResponse) String() string { return proto.CompactTextString(m) }
 func (*LoadNetworksResponse) ProtoMessage()    {}
 func (*LoadNetworksResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_northbound_07d50f0696abd602, []int{7}
+	return fileDescriptor_northbound_4c33534d5d90e938, []int{7}
 }
 func (m *LoadNetworksResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LoadNetworksResponse.Unmarshal(m, b)
@@ -388,17 +436,20 @@ func (m *LoadNetworksResponse) GetNotFound() []string {
 }
 
 type DeleteNetworksRequest struct {
-	NetworkIDs           []string `protobuf:"bytes,1,rep,name=networkIDs,proto3" json:"networkIDs,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	NetworkIDs []string `protobuf:"bytes,1,rep,name=networkIDs,proto3" json:"networkIDs,omitempty"`
+	// expectedVersions by network ID fails the deletion with ABORTED if one
+	// of the networks' current version doesn't match
+	ExpectedVersions     map[string]uint64 `protobuf:"bytes,2,rep,name=expectedVersions,proto3" json:"expectedVersions,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *DeleteNetworksRequest) Reset()         { *m = DeleteNetworksRequest{} }
 func (m *DeleteNetworksRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteNetworksRequest) ProtoMessage()    {}
 func (*DeleteNetworksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_northbound_07d50f0696abd602, []int{8}
+	return fileDescriptor_northbound_4c33534d5d90e938, []int{8}
 }
 func (m *DeleteNetworksRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteNetworksRequest.Unmarshal(m, b)
@@ -425,6 +476,13 @@ func (m *DeleteNetworksRequest) GetNetworkIDs() []string {
 	return nil
 }
 
+func (m *DeleteNetworksRequest) GetExpectedVersions() map[string]uint64 {
+	if m != nil {
+		return m.ExpectedVersions
+	}
+	return nil
+}
+
 type CreateEntitiesRequest struct {
 	NetworkID            string           `protobuf:"bytes,1,opt,name=networkID,proto3" json:"networkID,omitempty"`
 	Entities             []*NetworkEntity `protobuf:"bytes,2,rep,name=entities,proto3" json:"entities,omitempty"`
@@ -437,7 +495,7 @@ func (m *CreateEntitiesRequest) Reset()         { *m = CreateEntitiesRequest{} }
 func (m *CreateEntitiesRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateEntitiesRequest) ProtoMessage()    {}
 func (*CreateEntitiesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_northbound_07d50f0696abd602, []int{9}
+	return fileDescriptor_northbound_4c33534d5d90e938, []int{9}
 }
 func (m *CreateEntitiesRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateEntitiesRequest.Unmarshal(m, b)
@@ -482,7 +540,7 @@ func (m *CreateEntitiesResponse) Reset()         { *m = CreateEntitiesResponse{}
 func (m *CreateEntitiesResponse) String() string { return proto.CompactTextString(m) }
 func (*CreateEntitiesResponse) ProtoMessage()    {}
 func (*CreateEntitiesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_northbound_07d50f0696abd602, []int{10}
+	return fileDescriptor_northbound_4c33534d5d90e938, []int{10}
 }
 func (m *CreateEntitiesResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateEntitiesResponse.Unmarshal(m, b)
@@ -510,8 +568,11 @@ func (m *CreateEntitiesResponse) GetCreatedEntities() []*NetworkEntity {
 }
 
 type EntityUpdateCriteria struct {
-	Key                  string                `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Type                 string                `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Key  string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// expectedVersion fails the update with ABORTED if it doesn't match the
+	// entity's current version
+	ExpectedVersion      *wrappers.UInt64Value `protobuf:"bytes,3,opt,name=expectedVersion,proto3" json:"expectedVersion,omitempty"`
 	NewName              *wrappers.StringValue `protobuf:"bytes,10,opt,name=newName,proto3" json:"newName,omitempty"`
 	NewDescription       *wrappers.StringValue `protobuf:"bytes,11,opt,name=newDescription,proto3" json:"newDescription,omitempty"`
 	NewPhysicalID        *wrappers.StringValue `protobuf:"bytes,12,opt,name=newPhysicalID,proto3" json:"newPhysicalID,omitempty"`
@@ -530,7 +591,7 @@ func (m *EntityUpdateCriteria) Reset()         { *m = EntityUpdateCriteria{} }
 func (m *EntityUpdateCriteria) String() string { return proto.CompactTextString(m) }
 func (*EntityUpdateCriteria) ProtoMessage()    {}
 func (*EntityUpdateCriteria) Descriptor() ([]byte, []int) {
-	return fileDescriptor_northbound_07d50f0696abd602, []int{11}
+	return fileDescriptor_northbound_4c33534d5d90e938, []int{11}
 }
 func (m *EntityUpdateCriteria) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EntityUpdateCriteria.Unmarshal(m, b)
@@ -564,6 +625,13 @@ func (m *EntityUpdateCriteria) GetType() string {
 	return ""
 }
 
+func (m *EntityUpdateCriteria) GetExpectedVersion() *wrappers.UInt64Value {
+	if m != nil {
+		return m.ExpectedVersion
+	}
+	return nil
+}
+
 func (m *EntityUpdateCriteria) GetNewName() *wrappers.StringValue {
 	if m != nil {
 		return m.NewName
@@ -639,7 +707,7 @@ func (m *UpdateEntitiesRequest) Reset()         { *m = UpdateEntitiesRequest{} }
 func (m *UpdateEntitiesRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateEntitiesRequest) ProtoMessage()    {}
 func (*UpdateEntitiesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_northbound_07d50f0696abd602, []int{12}
+	return fileDescriptor_northbound_4c33534d5d90e938, []int{12}
 }
 func (m *UpdateEntitiesRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateEntitiesRequest.Unmarshal(m, b)
@@ -684,7 +752,7 @@ func (m *UpdateEntitiesResponse) Reset()         { *m = UpdateEntitiesResponse{}
 func (m *UpdateEntitiesResponse) String() string { return proto.CompactTextString(m) }
 func (*UpdateEntitiesResponse) ProtoMessage()    {}
 func (*UpdateEntitiesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_northbound_07d50f0696abd602, []int{13}
+	return fileDescriptor_northbound_4c33534d5d90e938, []int{13}
 }
 func (m *UpdateEntitiesResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateEntitiesResponse.Unmarshal(m, b)
@@ -712,18 +780,22 @@ func (m *UpdateEntitiesResponse) GetUpdatedEntities() map[string]*NetworkEntity
 }
 
 type DeleteEntitiesRequest struct {
-	NetworkID            string      `protobuf:"bytes,1,opt,name=networkID,proto3" json:"networkID,omitempty"`
-	ID                   []*EntityID `protobuf:"bytes,2,rep,name=ID,proto3" json:"ID,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+	NetworkID string      `protobuf:"bytes,1,opt,name=networkID,proto3" json:"networkID,omitempty"`
+	ID        []*EntityID `protobuf:"bytes,2,rep,name=ID,proto3" json:"ID,omitempty"`
+	// expectedVersions, if set, holds the expected version of each entity in
+	// ID and fails the deletion with ABORTED if one of the entities' current
+	// version doesn't match
+	ExpectedVersions     []uint64 `protobuf:"varint,3,rep,packed,name=expectedVersions,proto3" json:"expectedVersions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *DeleteEntitiesRequest) Reset()         { *m = DeleteEntitiesRequest{} }
 func (m *DeleteEntitiesRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteEntitiesRequest) ProtoMessage()    {}
 func (*DeleteEntitiesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_northbound_07d50f0696abd602, []int{14}
+	return fileDescriptor_northbound_4c33534d5d90e938, []int{14}
 }
 func (m *DeleteEntitiesRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteEntitiesRequest.Unmarshal(m, b)
@@ -757,12 +829,24 @@ func (m *DeleteEntitiesRequest) GetID() []*EntityID {
 	return nil
 }
 
+func (m *DeleteEntitiesRequest) GetExpectedVersions() []uint64 {
+	if m != nil {
+		return m.ExpectedVersions
+	}
+	return nil
+}
+
 type EntityLoadCriteria struct {
-	LoadMetadata         bool     `protobuf:"varint,1,opt,name=loadMetadata,proto3" json:"loadMetadata,omitempty"`
-	LoadConfig           bool     `protobuf:"varint,2,opt,name=loadConfig,proto3" json:"loadConfig,omitempty"`
-	LoadAssocsTo         bool     `protobuf:"varint,3,opt,name=loadAssocsTo,proto3" json:"loadAssocsTo,omitempty"`
-	LoadAssocsFrom       bool     `protobuf:"varint,4,opt,name=loadAssocsFrom,proto3" json:"loadAssocsFrom,omitempty"`
-	LoadPermissions      bool     `protobuf:"varint,5,opt,name=loadPermissions,proto3" json:"loadPermissions,omitempty"`
+	LoadMetadata    bool `protobuf:"varint,1,opt,name=loadMetadata,proto3" json:"loadMetadata,omitempty"`
+	LoadConfig      bool `protobuf:"varint,2,opt,name=loadConfig,proto3" json:"loadConfig,omitempty"`
+	LoadAssocsTo    bool `protobuf:"varint,3,opt,name=loadAssocsTo,proto3" json:"loadAssocsTo,omitempty"`
+	LoadAssocsFrom  bool `protobuf:"varint,4,opt,name=loadAssocsFrom,proto3" json:"loadAssocsFrom,omitempty"`
+	LoadPermissions bool `protobuf:"varint,5,opt,name=loadPermissions,proto3" json:"loadPermissions,omitempty"`
+	// pageSize limits the number of loaded entities, ordered by (type, key).
+	// 0 loads all entities.
+	PageSize uint32 `protobuf:"varint,6,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	// pageToken is the nextPageToken of the previous page
+	PageToken            string   `protobuf:"bytes,7,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -772,7 +856,7 @@ func (m *EntityLoadCriteria) Reset()         { *m = EntityLoadCriteria{} }
 func (m *EntityLoadCriteria) String() string { return proto.CompactTextString(m) }
 func (*EntityLoadCriteria) ProtoMessage()    {}
 func (*EntityLoadCriteria) Descriptor() ([]byte, []int) {
-	return fileDescriptor_northbound_07d50f0696abd602, []int{15}
+	return fileDescriptor_northbound_4c33534d5d90e938, []int{15}
 }
 func (m *EntityLoadCriteria) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EntityLoadCriteria.Unmarshal(m, b)
@@ -827,22 +911,41 @@ func (m *EntityLoadCriteria) GetLoadPermissions() bool {
 	return false
 }
 
+func (m *EntityLoadCriteria) GetPageSize() uint32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *EntityLoadCriteria) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
 type LoadEntitiesRequest struct {
-	NetworkID            string                `protobuf:"bytes,1,opt,name=networkID,proto3" json:"networkID,omitempty"`
-	TypeFilter           *wrappers.StringValue `protobuf:"bytes,2,opt,name=TypeFilter,proto3" json:"TypeFilter,omitempty"`
-	KeyFilter            *wrappers.StringValue `protobuf:"bytes,3,opt,name=KeyFilter,proto3" json:"KeyFilter,omitempty"`
-	EntityIDs            []*EntityID           `protobuf:"bytes,4,rep,name=entityIDs,proto3" json:"entityIDs,omitempty"`
-	Criteria             *EntityLoadCriteria   `protobuf:"bytes,5,opt,name=criteria,proto3" json:"criteria,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+	NetworkID  string                `protobuf:"bytes,1,opt,name=networkID,proto3" json:"networkID,omitempty"`
+	TypeFilter *wrappers.StringValue `protobuf:"bytes,2,opt,name=TypeFilter,proto3" json:"TypeFilter,omitempty"`
+	KeyFilter  *wrappers.StringValue `protobuf:"bytes,3,opt,name=KeyFilter,proto3" json:"KeyFilter,omitempty"`
+	EntityIDs  []*EntityID           `protobuf:"bytes,4,rep,name=entityIDs,proto3" json:"entityIDs,omitempty"`
+	Criteria   *EntityLoadCriteria   `protobuf:"bytes,5,opt,name=criteria,proto3" json:"criteria,omitempty"`
+	PhysicalID *wrappers.StringValue `protobuf:"bytes,6,opt,name=physicalID,proto3" json:"physicalID,omitempty"`
+	// configFilters maps dot-separated paths of config fields (as serialized
+	// to JSON) to the value the fields must have, e.g. {"mesh.id": "mesh1"}.
+	// Non-string values are compared to their JSON serialization.
+	ConfigFilters        map[string]string `protobuf:"bytes,7,rep,name=configFilters,proto3" json:"configFilters,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *LoadEntitiesRequest) Reset()         { *m = LoadEntitiesRequest{} }
 func (m *LoadEntitiesRequest) String() string { return proto.CompactTextString(m) }
 func (*LoadEntitiesRequest) ProtoMessage()    {}
 func (*LoadEntitiesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_northbound_07d50f0696abd602, []int{16}
+	return fileDescriptor_northbound_4c33534d5d90e938, []int{16}
 }
 func (m *LoadEntitiesRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LoadEntitiesRequest.Unmarshal(m, b)
@@ -897,19 +1000,35 @@ func (m *LoadEntitiesRequest) GetCriteria() *EntityLoadCriteria {
 	return nil
 }
 
+func (m *LoadEntitiesRequest) GetPhysicalID() *wrappers.StringValue {
+	if m != nil {
+		return m.PhysicalID
+	}
+	return nil
+}
+
+func (m *LoadEntitiesRequest) GetConfigFilters() map[string]string {
+	if m != nil {
+		return m.ConfigFilters
+	}
+	return nil
+}
+
 type LoadEntitiesResponse struct {
-	Entities             []*NetworkEntity `protobuf:"bytes,1,rep,name=entities,proto3" json:"entities,omitempty"`
-	NotFound             []*EntityID      `protobuf:"bytes,2,rep,name=notFound,proto3" json:"notFound,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+	Entities []*NetworkEntity `protobuf:"bytes,1,rep,name=entities,proto3" json:"entities,omitempty"`
+	NotFound []*EntityID      `protobuf:"bytes,2,rep,name=notFound,proto3" json:"notFound,omitempty"`
+	// nextPageToken is empty if this is the last page
+	NextPageToken        string   `protobuf:"bytes,3,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *LoadEntitiesResponse) Reset()         { *m = LoadEntitiesResponse{} }
 func (m *LoadEntitiesResponse) String() string { return proto.CompactTextString(m) }
 func (*LoadEntitiesResponse) ProtoMessage()    {}
 func (*LoadEntitiesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_northbound_07d50f0696abd602, []int{17}
+	return fileDescriptor_northbound_4c33534d5d90e938, []int{17}
 }
 func (m *LoadEntitiesResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LoadEntitiesResponse.Unmarshal(m, b)
@@ -943,6 +1062,146 @@ func (m *LoadEntitiesResponse) GetNotFound() []*EntityID {
 	return nil
 }
 
+func (m *LoadEntitiesResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+type WatchEntitiesRequest struct {
+	// networkID of the entities (and network) to watch, all networks if empty
+	NetworkID            string                `protobuf:"bytes,1,opt,name=networkID,proto3" json:"networkID,omitempty"`
+	TypeFilter           *wrappers.StringValue `protobuf:"bytes,2,opt,name=typeFilter,proto3" json:"typeFilter,omitempty"`
+	KeyFilter            *wrappers.StringValue `protobuf:"bytes,3,opt,name=keyFilter,proto3" json:"keyFilter,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *WatchEntitiesRequest) Reset()         { *m = WatchEntitiesRequest{} }
+func (m *WatchEntitiesRequest) String() string { return proto.CompactTextString(m) }
+func (*WatchEntitiesRequest) ProtoMessage()    {}
+func (*WatchEntitiesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_northbound_4c33534d5d90e938, []int{18}
+}
+func (m *WatchEntitiesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchEntitiesRequest.Unmarshal(m, b)
+}
+func (m *WatchEntitiesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchEntitiesRequest.Marshal(b, m, deterministic)
+}
+func (dst *WatchEntitiesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchEntitiesRequest.Merge(dst, src)
+}
+func (m *WatchEntitiesRequest) XXX_Size() int {
+	return xxx_messageInfo_WatchEntitiesRequest.Size(m)
+}
+func (m *WatchEntitiesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchEntitiesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchEntitiesRequest proto.InternalMessageInfo
+
+func (m *WatchEntitiesRequest) GetNetworkID() string {
+	if m != nil {
+		return m.NetworkID
+	}
+	return ""
+}
+
+func (m *WatchEntitiesRequest) GetTypeFilter() *wrappers.StringValue {
+	if m != nil {
+		return m.TypeFilter
+	}
+	return nil
+}
+
+func (m *WatchEntitiesRequest) GetKeyFilter() *wrappers.StringValue {
+	if m != nil {
+		return m.KeyFilter
+	}
+	return nil
+}
+
+// EntityChange is a committed change of a network entity or of a network
+type EntityChange struct {
+	ChangeType EntityChange_ChangeType `protobuf:"varint,1,opt,name=changeType,proto3,enum=magma.orc8r.configurator.EntityChange_ChangeType" json:"changeType,omitempty"`
+	NetworkID  string                  `protobuf:"bytes,2,opt,name=networkID,proto3" json:"networkID,omitempty"`
+	// entity is the created entity, the updated fields (with identity fields)
+	// of an updated entity or the identity fields of a deleted entity.
+	// Empty for network changes.
+	Entity *NetworkEntity `protobuf:"bytes,3,opt,name=entity,proto3" json:"entity,omitempty"`
+	// version of the entity or network after the change (before deletion
+	// for DELETED and NETWORK_DELETED)
+	Version uint64 `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
+	// network is the created network, the network after an update or the ID
+	// of a deleted network. Empty for entity changes.
+	Network              *Network `protobuf:"bytes,5,opt,name=network,proto3" json:"network,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EntityChange) Reset()         { *m = EntityChange{} }
+func (m *EntityChange) String() string { return proto.CompactTextString(m) }
+func (*EntityChange) ProtoMessage()    {}
+func (*EntityChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_northbound_4c33534d5d90e938, []int{19}
+}
+func (m *EntityChange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EntityChange.Unmarshal(m, b)
+}
+func (m *EntityChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EntityChange.Marshal(b, m, deterministic)
+}
+func (dst *EntityChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EntityChange.Merge(dst, src)
+}
+func (m *EntityChange) XXX_Size() int {
+	return xxx_messageInfo_EntityChange.Size(m)
+}
+func (m *EntityChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_EntityChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EntityChange proto.InternalMessageInfo
+
+func (m *EntityChange) GetChangeType() EntityChange_ChangeType {
+	if m != nil {
+		return m.ChangeType
+	}
+	return EntityChange_UNKNOWN
+}
+
+func (m *EntityChange) GetNetworkID() string {
+	if m != nil {
+		return m.NetworkID
+	}
+	return ""
+}
+
+func (m *EntityChange) GetEntity() *NetworkEntity {
+	if m != nil {
+		return m.Entity
+	}
+	return nil
+}
+
+func (m *EntityChange) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *EntityChange) GetNetwork() *Network {
+	if m != nil {
+		return m.Network
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ListNetworkIDsResponse)(nil), "magma.orc8r.configurator.ListNetworkIDsResponse")
 	proto.RegisterType((*CreateNetworksRequest)(nil), "magma.orc8r.configurator.CreateNetworksRequest")
@@ -955,6 +1214,7 @@ func init() {
 	proto.RegisterType((*LoadNetworksResponse)(nil), "magma.orc8r.configurator.LoadNetworksResponse")
 	proto.RegisterMapType((map[string]*Network)(nil), "magma.orc8r.configurator.LoadNetworksResponse.NetworksEntry")
 	proto.RegisterType((*DeleteNetworksRequest)(nil), "magma.orc8r.configurator.DeleteNetworksRequest")
+	proto.RegisterMapType((map[string]uint64)(nil), "magma.orc8r.configurator.DeleteNetworksRequest.ExpectedVersionsEntry")
 	proto.RegisterType((*CreateEntitiesRequest)(nil), "magma.orc8r.configurator.CreateEntitiesRequest")
 	proto.RegisterType((*CreateEntitiesResponse)(nil), "magma.orc8r.configurator.CreateEntitiesResponse")
 	proto.RegisterType((*EntityUpdateCriteria)(nil), "magma.orc8r.configurator.EntityUpdateCriteria")
@@ -964,7 +1224,11 @@ func init() {
 	proto.RegisterType((*DeleteEntitiesRequest)(nil), "magma.orc8r.configurator.DeleteEntitiesRequest")
 	proto.RegisterType((*EntityLoadCriteria)(nil), "magma.orc8r.configurator.EntityLoadCriteria")
 	proto.RegisterType((*LoadEntitiesRequest)(nil), "magma.orc8r.configurator.LoadEntitiesRequest")
+	proto.RegisterMapType((map[string]string)(nil), "magma.orc8r.configurator.LoadEntitiesRequest.ConfigFiltersEntry")
 	proto.RegisterType((*LoadEntitiesResponse)(nil), "magma.orc8r.configurator.LoadEntitiesResponse")
+	proto.RegisterType((*WatchEntitiesRequest)(nil), "magma.orc8r.configurator.WatchEntitiesRequest")
+	proto.RegisterType((*EntityChange)(nil), "magma.orc8r.configurator.EntityChange")
+	proto.RegisterEnum("magma.orc8r.configurator.EntityChange_ChangeType", EntityChange_ChangeType_name, EntityChange_ChangeType_value)
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -997,6 +1261,10 @@ type NorthboundConfiguratorClient interface {
 	DeleteEntities(ctx context.Context, in *DeleteEntitiesRequest, opts ...grpc.CallOption) (*protos.Void, error)
 	// LoadEntities fetches the set of Entities specified by the request
 	LoadEntities(ctx context.Context, in *LoadEntitiesRequest, opts ...grpc.CallOption) (*LoadEntitiesResponse, error)
+	// WatchEntities streams committed changes of the Entities matching the request,
+	// and of their networks if the request has no type and key filters.
+	// Changes committed by any configurator instance are sent, in commit order per network.
+	WatchEntities(ctx context.Context, in *WatchEntitiesRequest, opts ...grpc.CallOption) (NorthboundConfigurator_WatchEntitiesClient, error)
 }
 
 type northboundConfiguratorClient struct {
@@ -1088,6 +1356,38 @@ func (c *northboundConfiguratorClient) LoadEntities(ctx context.Context, in *Loa
 	return out, nil
 }
 
+func (c *northboundConfiguratorClient) WatchEntities(ctx context.Context, in *WatchEntitiesRequest, opts ...grpc.CallOption) (NorthboundConfigurator_WatchEntitiesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_NorthboundConfigurator_serviceDesc.Streams[0], "/magma.orc8r.configurator.NorthboundConfigurator/WatchEntities", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &northboundConfiguratorWatchEntitiesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type NorthboundConfigurator_WatchEntitiesClient interface {
+	Recv() (*EntityChange, error)
+	grpc.ClientStream
+}
+
+type northboundConfiguratorWatchEntitiesClient struct {
+	grpc.ClientStream
+}
+
+func (x *northboundConfiguratorWatchEntitiesClient) Recv() (*EntityChange, error) {
+	m := new(EntityChange)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // NorthboundConfiguratorServer is the server API for NorthboundConfigurator service.
 type NorthboundConfiguratorServer interface {
 	// ListNetworkIDs fetches the list of networkIDs registered
@@ -1108,6 +1408,10 @@ type NorthboundConfiguratorServer interface {
 	DeleteEntities(context.Context, *DeleteEntitiesRequest) (*protos.Void, error)
 	// LoadEntities fetches the set of Entities specified by the request
 	LoadEntities(context.Context, *LoadEntitiesRequest) (*LoadEntitiesResponse, error)
+	// WatchEntities streams committed changes of the Entities matching the request,
+	// and of their networks if the request has no type and key filters.
+	// Changes committed by any configurator instance are sent, in commit order per network.
+	WatchEntities(*WatchEntitiesRequest, NorthboundConfigurator_WatchEntitiesServer) error
 }
 
 func RegisterNorthboundConfiguratorServer(s *grpc.Server, srv NorthboundConfiguratorServer) {
@@ -1276,6 +1580,27 @@ func _NorthboundConfigurator_LoadEntities_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NorthboundConfigurator_WatchEntities_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchEntitiesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(NorthboundConfiguratorServer).WatchEntities(m, &northboundConfiguratorWatchEntitiesServer{stream})
+}
+
+type NorthboundConfigurator_WatchEntitiesServer interface {
+	Send(*EntityChange) error
+	grpc.ServerStream
+}
+
+type northboundConfiguratorWatchEntitiesServer struct {
+	grpc.ServerStream
+}
+
+func (x *northboundConfiguratorWatchEntitiesServer) Send(m *EntityChange) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _NorthboundConfigurator_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "magma.orc8r.configurator.NorthboundConfigurator",
 	HandlerType: (*NorthboundConfiguratorServer)(nil),
@@ -1317,84 +1642,112 @@ var _NorthboundConfigurator_serviceDesc = grpc.ServiceDesc{
 			Handler:    _NorthboundConfigurator_LoadEntities_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchEntities",
+			Handler:       _NorthboundConfigurator_WatchEntities_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "northbound.proto",
 }
 
-func init() { proto.RegisterFile("northbound.proto", fileDescriptor_northbound_07d50f0696abd602) }
-
-var fileDescriptor_northbound_07d50f0696abd602 = []byte{
-	// 1150 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0x4f, 0x6f, 0xe3, 0x44,
-	0x14, 0x5f, 0x27, 0x2d, 0x4d, 0x5e, 0xda, 0x74, 0x77, 0x9a, 0x54, 0x6e, 0x58, 0x4a, 0xf0, 0x01,
-	0x72, 0x60, 0xdd, 0x55, 0x90, 0xd8, 0xb2, 0xda, 0x45, 0xb4, 0x49, 0xcb, 0x46, 0x5b, 0xba, 0xc5,
-	0x94, 0x80, 0x40, 0x42, 0x72, 0xe3, 0xd9, 0xd4, 0x6a, 0xe2, 0x09, 0x9e, 0x09, 0x51, 0x10, 0x88,
-	0x8f, 0xc1, 0x81, 0x0f, 0x82, 0xb8, 0xf1, 0x09, 0xf8, 0x24, 0x9c, 0x39, 0x23, 0x7b, 0xc6, 0xff,
-	0x26, 0x4e, 0xec, 0x70, 0xe1, 0xd4, 0xf8, 0xcd, 0xfb, 0xfd, 0xde, 0xbc, 0x79, 0xf3, 0xfe, 0x4c,
-	0xe1, 0xbe, 0x43, 0x5c, 0x76, 0x7b, 0x43, 0xa6, 0x8e, 0xa5, 0x4f, 0x5c, 0xc2, 0x08, 0x52, 0xc7,
-	0xe6, 0x70, 0x6c, 0xea, 0xc4, 0x1d, 0x1c, 0xbb, 0xfa, 0x80, 0x38, 0xaf, 0xed, 0xe1, 0xd4, 0x35,
-	0x19, 0x71, 0x1b, 0x07, 0x43, 0x42, 0x86, 0x23, 0x7c, 0xe4, 0xeb, 0xdd, 0x4c, 0x5f, 0x1f, 0x99,
-	0xce, 0x9c, 0x83, 0x1a, 0x07, 0xbe, 0x3a, 0x5f, 0xa1, 0x47, 0x03, 0x32, 0x1e, 0x13, 0x47, 0x2c,
-	0x1d, 0xca, 0xa8, 0x99, 0x6b, 0x4e, 0x26, 0xd8, 0xa5, 0x62, 0x1d, 0xc5, 0x6d, 0x70, 0x99, 0x76,
-	0x0c, 0xfb, 0x17, 0x36, 0x65, 0x97, 0x98, 0xcd, 0x88, 0x7b, 0xd7, 0xeb, 0x52, 0x03, 0xd3, 0x09,
-	0x71, 0x28, 0x46, 0x87, 0x00, 0x4e, 0x28, 0x55, 0x95, 0x66, 0xb1, 0x55, 0x36, 0x62, 0x12, 0xad,
-	0x0f, 0xf5, 0x8e, 0x8b, 0x4d, 0x86, 0x05, 0x96, 0x1a, 0xf8, 0xfb, 0x29, 0xa6, 0x0c, 0x3d, 0x87,
-	0x92, 0x50, 0xe3, 0xb0, 0x4a, 0xfb, 0x1d, 0x7d, 0x99, 0xa7, 0xba, 0x00, 0x1b, 0x21, 0x44, 0xc3,
-	0xb0, 0x2f, 0xf3, 0x8a, 0x1d, 0xbd, 0x84, 0xdd, 0x81, 0xbf, 0x62, 0x5d, 0xae, 0xcd, 0x2f, 0x23,
-	0xb5, 0x5f, 0x8b, 0x50, 0x17, 0x1f, 0x5f, 0x4e, 0x2c, 0x93, 0xe1, 0x8e, 0x6b, 0x33, 0xec, 0xda,
-	0x26, 0xaa, 0x42, 0xc1, 0xb6, 0x54, 0xa5, 0xa9, 0xb4, 0xca, 0x46, 0xc1, 0xb6, 0xd0, 0x87, 0xb0,
-	0xe5, 0xe0, 0xd9, 0xa5, 0x39, 0xc6, 0x2a, 0x34, 0x95, 0x56, 0xa5, 0xfd, 0x50, 0xe7, 0x07, 0xad,
-	0x07, 0x07, 0xad, 0x7f, 0xc1, 0x5c, 0xdb, 0x19, 0xf6, 0xcd, 0xd1, 0x14, 0x1b, 0x81, 0x32, 0xea,
-	0x42, 0xd5, 0xc1, 0xb3, 0x2e, 0xa6, 0x03, 0xd7, 0x9e, 0x30, 0x9b, 0x38, 0x6a, 0x25, 0x07, 0x5c,
-	0xc2, 0xa0, 0x9f, 0xa1, 0xc6, 0x1d, 0xa2, 0xd7, 0xe4, 0xc4, 0xb2, 0x5e, 0xb9, 0x7c, 0xb7, 0x6a,
-	0xcd, 0xf7, 0xbc, 0x97, 0xe9, 0x79, 0xd2, 0x39, 0xbd, 0x93, 0xc2, 0x75, 0xe6, 0x30, 0x77, 0x6e,
-	0xa4, 0x9a, 0x41, 0x2d, 0xd8, 0x0d, 0xe5, 0x5d, 0x3c, 0xc2, 0x0c, 0xab, 0x75, 0xff, 0x2a, 0xc8,
-	0xe2, 0xc6, 0xa7, 0x70, 0xb0, 0x94, 0x1c, 0xdd, 0x87, 0xe2, 0x1d, 0x9e, 0x8b, 0x43, 0xf5, 0x7e,
-	0xa2, 0x1a, 0x6c, 0xfe, 0xe0, 0x39, 0xac, 0x16, 0x9a, 0x4a, 0x6b, 0xdb, 0xe0, 0x1f, 0x4f, 0x0b,
-	0xc7, 0x8a, 0x76, 0x03, 0x75, 0x0e, 0x95, 0x2f, 0x56, 0x0f, 0xb6, 0xa6, 0xfe, 0x42, 0x10, 0xf7,
-	0xa3, 0x35, 0xbd, 0x37, 0x02, 0xbc, 0xf6, 0x2d, 0xec, 0x09, 0x8d, 0x0b, 0x62, 0x5a, 0x61, 0xe8,
-	0x35, 0xd8, 0x1e, 0x11, 0xd3, 0xfa, 0x0c, 0x33, 0xd3, 0x32, 0x99, 0xe9, 0xef, 0xb7, 0x64, 0x24,
-	0x64, 0xa8, 0x09, 0x15, 0xef, 0x5b, 0xf8, 0xea, 0x6f, 0xbf, 0x64, 0xc4, 0x45, 0xda, 0x4f, 0xb0,
-	0xe7, 0xb1, 0xca, 0xdb, 0x6f, 0x48, 0x79, 0x51, 0x8e, 0x2e, 0x3d, 0xea, 0x41, 0x69, 0x20, 0x36,
-	0xe1, 0x33, 0x56, 0xda, 0x8f, 0x32, 0x7d, 0x8b, 0xef, 0xdc, 0x08, 0xe1, 0xda, 0xdf, 0x0a, 0xd4,
-	0x92, 0xe6, 0x45, 0xfa, 0x7c, 0xbd, 0x90, 0x97, 0xcf, 0x96, 0xdb, 0x48, 0x63, 0x08, 0x0c, 0x53,
-	0x7e, 0x61, 0xa2, 0xdd, 0x7b, 0x9e, 0x11, 0x76, 0xee, 0x95, 0x36, 0xb5, 0x20, 0x3c, 0x13, 0xdf,
-	0x8d, 0xef, 0x60, 0x27, 0x01, 0x4b, 0xb9, 0x0a, 0x4f, 0xe2, 0x57, 0x21, 0x57, 0x36, 0xc7, 0x6e,
-	0xcb, 0x13, 0xa8, 0xf3, 0x0b, 0x28, 0x1f, 0x77, 0x56, 0xfd, 0xfa, 0x31, 0xa8, 0x5f, 0x67, 0x0e,
-	0xb3, 0x99, 0x8d, 0x43, 0xe0, 0x43, 0x28, 0x87, 0x6a, 0x62, 0x9b, 0x91, 0x00, 0x75, 0xa0, 0x84,
-	0x05, 0xc0, 0xf7, 0xb5, 0xd2, 0x7e, 0x2f, 0x73, 0xbf, 0xbe, 0x85, 0xb9, 0x11, 0x02, 0xb5, 0xbb,
-	0xa0, 0xc6, 0x45, 0xb6, 0x45, 0x90, 0x3e, 0x0f, 0x6b, 0x5c, 0xb0, 0x24, 0x62, 0x95, 0xdb, 0x8a,
-	0x8c, 0xd7, 0x7e, 0xdf, 0x84, 0x1a, 0x5f, 0x93, 0x0a, 0xdd, 0x62, 0x24, 0x10, 0x6c, 0xb0, 0xf9,
-	0x84, 0x07, 0xa2, 0x6c, 0xf8, 0xbf, 0xff, 0xe7, 0xf2, 0x77, 0x0a, 0x3b, 0x0e, 0x9e, 0x5d, 0xdd,
-	0xce, 0xa9, 0x3d, 0x30, 0x47, 0xbd, 0xae, 0xba, 0x9d, 0x83, 0x24, 0x09, 0x41, 0x1f, 0x79, 0x01,
-	0x9d, 0xf1, 0xec, 0x54, 0x77, 0x7c, 0xfc, 0x9b, 0x0b, 0xf8, 0xd3, 0x39, 0xc3, 0x94, 0xc3, 0x23,
-	0x6d, 0x74, 0x05, 0x0f, 0x4c, 0x4a, 0xc9, 0xc0, 0x36, 0xbd, 0xdd, 0xf0, 0xca, 0x26, 0x4a, 0xaf,
-	0xb6, 0x3c, 0x20, 0xfc, 0xb4, 0x7b, 0x5d, 0x63, 0x11, 0x8c, 0xfa, 0x50, 0x4b, 0x0a, 0x63, 0x55,
-	0x35, 0x1f, 0x69, 0x2a, 0x1e, 0xbd, 0x82, 0xbd, 0x09, 0x76, 0xc7, 0x36, 0xa5, 0x5c, 0xcc, 0xef,
-	0x97, 0x7a, 0xe8, 0xd3, 0xbe, 0xb5, 0x9c, 0xf6, 0xa4, 0x73, 0x61, 0xa4, 0x21, 0x17, 0x08, 0x45,
-	0xdf, 0x79, 0x7b, 0x7d, 0x42, 0xd1, 0x4a, 0x1e, 0x4b, 0x84, 0xc2, 0xf1, 0xa6, 0x9f, 0x99, 0x69,
-	0x4b, 0xda, 0x2f, 0x41, 0x27, 0x58, 0x2f, 0x45, 0x5f, 0x44, 0x7d, 0x82, 0x67, 0xa8, 0x9e, 0x75,
-	0xaa, 0xcb, 0xda, 0xc4, 0x3f, 0x0a, 0xec, 0xcb, 0x3b, 0x10, 0x89, 0x4a, 0x60, 0x97, 0x6b, 0xc9,
-	0x89, 0x7a, 0xb6, 0xdc, 0x58, 0x3a, 0x95, 0x10, 0x87, 0x3c, 0xbc, 0xba, 0xca, 0xec, 0x8d, 0x3b,
-	0xa8, 0xa5, 0x29, 0xa6, 0x64, 0xf1, 0xf3, 0x64, 0x3d, 0xcd, 0x5d, 0x39, 0x62, 0x55, 0xd5, 0x0e,
-	0xaa, 0xea, 0x7a, 0x27, 0xdf, 0x86, 0x42, 0xaf, 0x2b, 0x0e, 0x3d, 0xcf, 0x55, 0x2e, 0xf4, 0xba,
-	0xda, 0x5f, 0x0a, 0x20, 0x2e, 0x58, 0xbb, 0x15, 0x1f, 0x02, 0x44, 0x7d, 0x57, 0x74, 0xe2, 0x98,
-	0x24, 0xe0, 0x38, 0xf1, 0xf2, 0x85, 0x5e, 0x13, 0xb5, 0x18, 0x71, 0x04, 0x32, 0xf4, 0x2e, 0x54,
-	0xa3, 0xef, 0x73, 0x97, 0x8c, 0xd5, 0x0d, 0x5f, 0x4b, 0x92, 0x7a, 0x83, 0x90, 0x27, 0xb9, 0x8a,
-	0xae, 0xa9, 0xba, 0xe9, 0x2b, 0xca, 0x62, 0xed, 0x8f, 0x02, 0xef, 0xff, 0xeb, 0x1d, 0xdd, 0x33,
-	0x80, 0xeb, 0xf9, 0x04, 0x9f, 0xdb, 0x23, 0x86, 0x5d, 0x11, 0xb9, 0xd5, 0x55, 0x2e, 0xa6, 0x8f,
-	0x9e, 0x42, 0xf9, 0x25, 0x9e, 0x0b, 0x70, 0x31, 0x07, 0x38, 0x52, 0x47, 0x9f, 0x40, 0x19, 0x8b,
-	0x80, 0x50, 0x75, 0x23, 0x77, 0xec, 0x22, 0x10, 0x7a, 0x11, 0x9b, 0x5e, 0x36, 0x7d, 0xe3, 0xef,
-	0x67, 0x11, 0x2c, 0x19, 0x5e, 0x7e, 0x13, 0xc3, 0xcb, 0x42, 0xba, 0xc5, 0xdb, 0xae, 0xf2, 0x1f,
-	0xdb, 0x2e, 0xfa, 0x58, 0x9a, 0x53, 0xf2, 0x39, 0x1a, 0x62, 0xda, 0x7f, 0x6e, 0xc1, 0xfe, 0x65,
-	0xf8, 0x8a, 0xeb, 0xc4, 0xb4, 0xd1, 0x57, 0x50, 0x4d, 0xbe, 0xa3, 0xd0, 0x83, 0x04, 0x75, 0x9f,
-	0xd8, 0x56, 0xe3, 0xf1, 0x8a, 0x79, 0x2b, 0xf5, 0x11, 0xa6, 0xdd, 0x43, 0x53, 0xa8, 0x26, 0x9f,
-	0x43, 0x68, 0xc5, 0xd4, 0x9b, 0xfa, 0x20, 0x5b, 0x65, 0x36, 0xfd, 0xa5, 0xa5, 0xdd, 0x43, 0x7d,
-	0xa8, 0x26, 0x87, 0xf0, 0x55, 0x66, 0x53, 0xc7, 0xf5, 0xc6, 0xe2, 0x01, 0x70, 0xde, 0xe4, 0xb8,
-	0xb6, 0x8a, 0x37, 0x75, 0xb0, 0x4b, 0xe7, 0x25, 0xb0, 0x1d, 0x1f, 0x59, 0xd1, 0xa3, 0xbc, 0xa3,
-	0x2d, 0xe7, 0xd4, 0xd7, 0x9b, 0x84, 0xe3, 0x71, 0x09, 0xae, 0x6a, 0x76, 0x5c, 0xa4, 0x82, 0x90,
-	0x1d, 0x17, 0x39, 0x0b, 0xb8, 0xd9, 0x64, 0x17, 0xc9, 0x8e, 0xcb, 0x1a, 0x66, 0xd3, 0x1b, 0x54,
-	0x3c, 0x6c, 0x79, 0xcc, 0xa6, 0x76, 0x8e, 0x95, 0x61, 0x0b, 0x59, 0x33, 0xc2, 0x26, 0x73, 0xea,
-	0x79, 0xd5, 0x03, 0x47, 0x4e, 0x4b, 0xdf, 0xbc, 0xc1, 0xff, 0x75, 0x72, 0xc3, 0xff, 0x7e, 0xf0,
-	0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xa9, 0x2e, 0x36, 0xb7, 0x98, 0x11, 0x00, 0x00,
+func init() { proto.RegisterFile("northbound.proto", fileDescriptor_northbound_4c33534d5d90e938) }
+
+var fileDescriptor_northbound_4c33534d5d90e938 = []byte{
+	// 1501 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0x5f, 0x6f, 0x1b, 0xc5,
+	0x16, 0xef, 0xda, 0x4e, 0x62, 0x9f, 0x24, 0x4e, 0x3a, 0x71, 0xa2, 0xed, 0xde, 0xde, 0x5c, 0xdf,
+	0xd5, 0x55, 0x6f, 0x84, 0xe8, 0x26, 0x04, 0x54, 0x4a, 0x69, 0xa1, 0xa9, 0xed, 0x50, 0x2b, 0xc1,
+	0x09, 0xdb, 0xfc, 0x41, 0x20, 0x81, 0x36, 0xf6, 0xd4, 0x59, 0x39, 0xde, 0x71, 0x77, 0xc7, 0x4d,
+	0x5d, 0x81, 0xfa, 0xca, 0x17, 0x40, 0xe2, 0x95, 0x0f, 0x00, 0xdf, 0x81, 0x4f, 0xc2, 0x17, 0x40,
+	0xe2, 0x8d, 0x57, 0xd0, 0xce, 0xcc, 0xfe, 0xf5, 0xda, 0xde, 0xe5, 0x8d, 0x27, 0x7b, 0xce, 0x9c,
+	0xdf, 0x39, 0x73, 0xce, 0x9c, 0x7f, 0x3b, 0xb0, 0x6a, 0x11, 0x9b, 0x5e, 0x5e, 0x90, 0xa1, 0xd5,
+	0xd1, 0x06, 0x36, 0xa1, 0x04, 0xc9, 0x7d, 0xa3, 0xdb, 0x37, 0x34, 0x62, 0xb7, 0xef, 0xdb, 0x5a,
+	0x9b, 0x58, 0xcf, 0xcd, 0xee, 0xd0, 0x36, 0x28, 0xb1, 0x95, 0x5b, 0x5d, 0x42, 0xba, 0x57, 0x78,
+	0x9b, 0xf1, 0x5d, 0x0c, 0x9f, 0x6f, 0x1b, 0xd6, 0x88, 0x83, 0x94, 0x5b, 0x8c, 0x9d, 0xef, 0x38,
+	0xdb, 0x6d, 0xd2, 0xef, 0x13, 0x4b, 0x6c, 0x6d, 0xc6, 0x51, 0xd7, 0xb6, 0x31, 0x18, 0x60, 0xdb,
+	0x11, 0xfb, 0x28, 0xac, 0x83, 0xd3, 0xd4, 0xfb, 0xb0, 0x71, 0x68, 0x3a, 0xb4, 0x85, 0xe9, 0x35,
+	0xb1, 0x7b, 0xcd, 0xba, 0xa3, 0x63, 0x67, 0x40, 0x2c, 0x07, 0xa3, 0x4d, 0x00, 0xcb, 0xa7, 0xca,
+	0x52, 0x35, 0xbf, 0x55, 0xd2, 0x43, 0x14, 0xf5, 0x0c, 0xd6, 0x6b, 0x36, 0x36, 0x28, 0x16, 0x58,
+	0x47, 0xc7, 0x2f, 0x86, 0xd8, 0xa1, 0xe8, 0x11, 0x14, 0x05, 0x1b, 0x87, 0x2d, 0xee, 0xfe, 0x57,
+	0x9b, 0x64, 0xa9, 0x26, 0xc0, 0xba, 0x0f, 0x51, 0x31, 0x6c, 0xc4, 0xe5, 0x8a, 0x13, 0x1d, 0xc0,
+	0x4a, 0x9b, 0xed, 0x74, 0x5a, 0x99, 0xe5, 0xc7, 0x91, 0xea, 0xaf, 0x79, 0x58, 0x17, 0x8b, 0xd3,
+	0x41, 0xc7, 0xa0, 0xb8, 0x66, 0x9b, 0x14, 0xdb, 0xa6, 0x81, 0xca, 0x90, 0x33, 0x3b, 0xb2, 0x54,
+	0x95, 0xb6, 0x4a, 0x7a, 0xce, 0xec, 0xa0, 0x7d, 0x58, 0xc1, 0xaf, 0x06, 0xb8, 0x4d, 0x71, 0xe7,
+	0x0c, 0xdb, 0x8e, 0x49, 0x2c, 0x39, 0x57, 0x95, 0xb6, 0x16, 0x77, 0x6f, 0x6b, 0xdc, 0xe1, 0x9a,
+	0xe7, 0x70, 0xed, 0xb4, 0x69, 0xd1, 0x7b, 0xef, 0x9d, 0x19, 0x57, 0x43, 0xac, 0xc7, 0x41, 0xe8,
+	0x1e, 0x2c, 0x58, 0xf8, 0xba, 0x65, 0xf4, 0xb1, 0x0c, 0x13, 0xf0, 0xcf, 0xa8, 0x6d, 0x5a, 0x5d,
+	0x8e, 0xf7, 0x98, 0x51, 0x1d, 0xca, 0x16, 0xbe, 0xae, 0x63, 0xa7, 0x6d, 0x9b, 0x03, 0xea, 0xaa,
+	0x5f, 0x4c, 0x01, 0x8f, 0x61, 0xd0, 0xb7, 0x50, 0xe1, 0x8e, 0x71, 0x4e, 0xc8, 0x5e, 0xa7, 0x73,
+	0x64, 0x73, 0xab, 0xe5, 0x0a, 0xf3, 0x60, 0x73, 0xa6, 0x07, 0xa3, 0x4e, 0xd2, 0x6a, 0x09, 0xb2,
+	0x1a, 0x16, 0xb5, 0x47, 0x7a, 0xa2, 0x1a, 0xb4, 0x05, 0x2b, 0x3e, 0xbd, 0x8e, 0xaf, 0x30, 0xc5,
+	0xf2, 0x3a, 0x0b, 0xa9, 0x38, 0x59, 0xf9, 0x04, 0x6e, 0x4d, 0x14, 0x8e, 0x56, 0x21, 0xdf, 0xc3,
+	0x23, 0x71, 0x39, 0xee, 0x5f, 0x54, 0x81, 0xb9, 0x97, 0xae, 0xc1, 0xec, 0x4e, 0x96, 0x74, 0xbe,
+	0x78, 0x90, 0xbb, 0x2f, 0xa9, 0x17, 0xb0, 0xce, 0xa1, 0xf1, 0x00, 0x6d, 0xc2, 0xc2, 0x90, 0x6d,
+	0x78, 0xf1, 0xb3, 0x9d, 0xd1, 0x7a, 0xdd, 0xc3, 0xab, 0x5f, 0xc2, 0x9a, 0xe0, 0x38, 0x24, 0x46,
+	0xc7, 0x0f, 0x21, 0x15, 0x96, 0xae, 0x88, 0xd1, 0xf9, 0x14, 0x53, 0xa3, 0x63, 0x50, 0x83, 0x9d,
+	0xb7, 0xa8, 0x47, 0x68, 0xa8, 0x0a, 0x8b, 0xee, 0x5a, 0xd8, 0xca, 0x8e, 0x5f, 0xd4, 0xc3, 0x24,
+	0xf5, 0x1b, 0x58, 0x73, 0xa5, 0xc6, 0x8f, 0xaf, 0xc4, 0xf2, 0xab, 0x14, 0x24, 0x0f, 0x6a, 0x42,
+	0xb1, 0x2d, 0x0e, 0x21, 0x82, 0xf4, 0xee, 0x4c, 0xdb, 0xc2, 0x27, 0xd7, 0x7d, 0xb8, 0xfa, 0x9b,
+	0x04, 0x95, 0xa8, 0x7a, 0x91, 0x86, 0x9f, 0x8f, 0xe5, 0xf7, 0xc3, 0xc9, 0x3a, 0x92, 0x24, 0x78,
+	0x8a, 0x1d, 0x1e, 0x30, 0xc1, 0xe9, 0x5d, 0xcb, 0x08, 0xdd, 0x77, 0x4b, 0xa4, 0x9c, 0x13, 0x96,
+	0x89, 0xb5, 0xf2, 0x15, 0x2c, 0x47, 0x60, 0x09, 0xa1, 0xf0, 0x7e, 0x38, 0x14, 0x52, 0x55, 0x85,
+	0x50, 0xb4, 0xfc, 0x2e, 0xc1, 0x3a, 0x8f, 0xc0, 0xb8, 0xbf, 0x67, 0x14, 0x42, 0xf4, 0x02, 0x56,
+	0x63, 0xa9, 0xee, 0xb0, 0xd3, 0x2f, 0xee, 0x36, 0x26, 0x9f, 0x20, 0x51, 0x95, 0xd6, 0x88, 0xc9,
+	0xe1, 0x0e, 0x1a, 0x13, 0xaf, 0xd4, 0x60, 0x3d, 0x91, 0x75, 0x56, 0x7e, 0x14, 0xc2, 0x16, 0xbf,
+	0xf6, 0x0a, 0x78, 0xc3, 0xa2, 0x26, 0x35, 0xb1, 0x6f, 0xf0, 0x6d, 0x28, 0xf9, 0xe6, 0x09, 0x51,
+	0x01, 0x01, 0xd5, 0xa0, 0x88, 0x05, 0x40, 0x98, 0xf9, 0xff, 0x99, 0x8e, 0x66, 0x1a, 0x46, 0xba,
+	0x0f, 0x54, 0x7b, 0x5e, 0x91, 0x0f, 0x74, 0x8b, 0xe8, 0xfa, 0xcc, 0x2f, 0xf2, 0xde, 0x96, 0x2c,
+	0x65, 0xd3, 0x12, 0xc7, 0xab, 0x7f, 0xce, 0x41, 0x85, 0xef, 0xc5, 0x2a, 0xfd, 0xb8, 0xb7, 0x10,
+	0x14, 0xe8, 0x68, 0xc0, 0x9d, 0x55, 0xd2, 0xd9, 0xff, 0xa4, 0xfa, 0x9f, 0xff, 0xe7, 0xd5, 0xff,
+	0x27, 0xb0, 0x6c, 0xe1, 0xeb, 0xe3, 0xcb, 0x91, 0x63, 0xb6, 0x8d, 0xab, 0x66, 0x5d, 0x5e, 0x4a,
+	0x21, 0x24, 0x0a, 0x41, 0x1f, 0xb8, 0x81, 0x71, 0xcd, 0xcb, 0x93, 0xbc, 0xcc, 0xf0, 0xff, 0x1a,
+	0xc3, 0x3f, 0x19, 0x51, 0xec, 0x70, 0x78, 0xc0, 0x8d, 0x8e, 0xe1, 0xa6, 0xe1, 0x38, 0xa4, 0x6d,
+	0x1a, 0xee, 0x69, 0x78, 0x69, 0x17, 0xbd, 0x47, 0x9d, 0x7c, 0xb1, 0xfc, 0xd6, 0x9a, 0x75, 0x7d,
+	0x1c, 0x8c, 0xce, 0xa0, 0x12, 0x25, 0x86, 0xda, 0x4a, 0x3a, 0xa1, 0x89, 0x78, 0x74, 0x04, 0x6b,
+	0x03, 0x6c, 0xf7, 0x4d, 0xc7, 0xe1, 0x64, 0x1e, 0xa7, 0xf2, 0x26, 0x13, 0xfb, 0xef, 0xc9, 0x62,
+	0xf7, 0x6a, 0x87, 0x7a, 0x12, 0x72, 0x4c, 0xa0, 0x68, 0xbc, 0xff, 0xc9, 0x2e, 0x50, 0xf4, 0xd2,
+	0x9d, 0x98, 0x40, 0x61, 0x78, 0x95, 0x55, 0xa6, 0xa4, 0x2d, 0xf5, 0x8d, 0xd7, 0x0a, 0xb3, 0xa5,
+	0xfa, 0xd3, 0xa0, 0x51, 0xf2, 0x4c, 0xd7, 0x66, 0x79, 0x75, 0x52, 0x9f, 0xfc, 0x43, 0x82, 0x8d,
+	0xf8, 0x09, 0x44, 0xc2, 0x13, 0x58, 0xe1, 0x5c, 0xf1, 0x84, 0x9f, 0x52, 0x3d, 0x93, 0x45, 0x69,
+	0xa7, 0x51, 0x39, 0xbc, 0x7a, 0xc6, 0xa5, 0x2b, 0x3d, 0xa8, 0x24, 0x31, 0x26, 0x54, 0x83, 0x47,
+	0xd1, 0x86, 0x92, 0xba, 0x02, 0x85, 0x8a, 0xec, 0xf7, 0x7e, 0x5b, 0xc9, 0xe6, 0xfa, 0x5d, 0xc8,
+	0x35, 0xeb, 0x72, 0x2e, 0x75, 0x2c, 0xe7, 0x9a, 0x75, 0xf4, 0x56, 0x42, 0x23, 0xca, 0x57, 0xf3,
+	0x5b, 0x85, 0xf1, 0x0e, 0xa2, 0x7e, 0x97, 0x03, 0xc4, 0xc1, 0x99, 0x07, 0x97, 0x4d, 0x80, 0x60,
+	0x4a, 0x11, 0x73, 0x4b, 0x88, 0xe2, 0xc9, 0xd8, 0x73, 0x93, 0xcb, 0x39, 0x21, 0x72, 0x3e, 0x90,
+	0xe1, 0xd1, 0xd0, 0x1d, 0x28, 0x07, 0xeb, 0x7d, 0x9b, 0xf4, 0xe5, 0x02, 0xe3, 0x8a, 0x51, 0xdd,
+	0xb1, 0xd1, 0xa5, 0x1c, 0x07, 0x31, 0x2d, 0xcf, 0x31, 0xc6, 0x38, 0xd9, 0x9d, 0x1d, 0x06, 0x46,
+	0x17, 0x3f, 0x33, 0x5f, 0x63, 0x79, 0xbe, 0x2a, 0x6d, 0x2d, 0xeb, 0xfe, 0xda, 0x75, 0xb5, 0xfb,
+	0xff, 0x84, 0xf4, 0xb0, 0x25, 0x2f, 0x70, 0x57, 0xfb, 0x04, 0xf5, 0xc7, 0x02, 0x9f, 0xb3, 0xb2,
+	0x5d, 0xd0, 0x43, 0x80, 0x93, 0xd1, 0x00, 0xef, 0x9b, 0x57, 0x14, 0xdb, 0x72, 0x2e, 0x45, 0x31,
+	0x0d, 0xf1, 0xa3, 0x07, 0x50, 0x3a, 0xc0, 0x23, 0x01, 0xce, 0xa7, 0x00, 0x07, 0xec, 0xe8, 0x31,
+	0x94, 0xb0, 0xb8, 0x76, 0x47, 0x2e, 0xa4, 0x8e, 0x90, 0x00, 0x84, 0x9e, 0x86, 0xa6, 0xc4, 0x39,
+	0xa6, 0xfc, 0xed, 0x59, 0x02, 0x92, 0x87, 0x44, 0xd7, 0x0b, 0x83, 0xa0, 0xa5, 0xcc, 0xa7, 0xf1,
+	0x42, 0xc0, 0x8f, 0x9e, 0xc3, 0x32, 0x57, 0xc5, 0x2d, 0x73, 0xe4, 0x05, 0x66, 0xcd, 0xe3, 0xe9,
+	0xe3, 0x64, 0xec, 0x9e, 0xb4, 0x5a, 0x58, 0x04, 0xcf, 0xf9, 0xa8, 0x58, 0xe5, 0x31, 0xa0, 0x71,
+	0xa6, 0x59, 0xb3, 0x52, 0x29, 0x9c, 0xc6, 0xbf, 0x88, 0x61, 0x78, 0xac, 0x7a, 0x85, 0xa7, 0x21,
+	0xe9, 0x6f, 0x4e, 0x43, 0xe8, 0xa3, 0xd8, 0xdc, 0x9b, 0xee, 0x42, 0x7d, 0x0c, 0xfa, 0x9f, 0xdb,
+	0xdb, 0x5f, 0xd1, 0x63, 0x3f, 0xc6, 0xf3, 0xec, 0xfc, 0x51, 0xa2, 0xfa, 0xb3, 0x04, 0x95, 0x73,
+	0x83, 0xb6, 0x2f, 0x33, 0x07, 0x3a, 0xcd, 0x18, 0xe8, 0x34, 0x12, 0xe8, 0xbd, 0x6c, 0x81, 0xee,
+	0xb3, 0xab, 0x3f, 0xe4, 0x61, 0x89, 0x5b, 0x5b, 0xbb, 0x34, 0xac, 0xae, 0x3b, 0x1b, 0x42, 0x9b,
+	0xfd, 0x73, 0x33, 0x89, 0x9d, 0xb4, 0xbc, 0xfb, 0xce, 0x2c, 0x4f, 0x71, 0xac, 0x56, 0xf3, 0x81,
+	0x7a, 0x48, 0x48, 0xd4, 0xf6, 0x5c, 0xdc, 0xf6, 0x8f, 0x61, 0x9e, 0x67, 0x8d, 0x38, 0x7a, 0xea,
+	0xbb, 0x15, 0x30, 0x24, 0xc3, 0xc2, 0x4b, 0x31, 0x33, 0x16, 0xd8, 0xfc, 0xed, 0x2d, 0xd1, 0x87,
+	0xee, 0x34, 0xc8, 0x20, 0xf2, 0x5c, 0xda, 0xcf, 0x15, 0x0f, 0xa1, 0xbe, 0x01, 0x08, 0xec, 0x41,
+	0x8b, 0xb0, 0x70, 0xda, 0x3a, 0x68, 0x1d, 0x9d, 0xb7, 0x56, 0x6f, 0xb8, 0x8b, 0x9a, 0xde, 0xd8,
+	0x3b, 0x69, 0xd4, 0x57, 0x25, 0xb6, 0x73, 0x5c, 0x67, 0x8b, 0x9c, 0xbb, 0xa8, 0x37, 0x0e, 0x1b,
+	0xee, 0x22, 0x8f, 0xd6, 0x60, 0xa5, 0xd5, 0x38, 0x39, 0x3f, 0xd2, 0x0f, 0xbe, 0xf6, 0xd8, 0x0b,
+	0x61, 0xa2, 0x07, 0x9b, 0x0b, 0x13, 0x3d, 0xf8, 0xfc, 0xee, 0x4f, 0x45, 0xd8, 0x68, 0xf9, 0xef,
+	0x59, 0xb5, 0xd0, 0x61, 0xd1, 0x39, 0x94, 0xa3, 0x2f, 0x4a, 0xe8, 0x66, 0xc4, 0xb2, 0x33, 0x62,
+	0x76, 0x94, 0x9d, 0x29, 0x29, 0x9e, 0xf8, 0x1c, 0xa5, 0xde, 0x40, 0x43, 0x28, 0x47, 0x1f, 0x86,
+	0xd0, 0x94, 0xef, 0xf6, 0xc4, 0xa7, 0x29, 0x65, 0x27, 0x3d, 0xc0, 0x57, 0x7b, 0x06, 0xe5, 0xe8,
+	0x33, 0xc2, 0x34, 0xb5, 0x89, 0x0f, 0x0e, 0xca, 0xb8, 0x03, 0xb8, 0xdc, 0xe8, 0x47, 0xe0, 0x34,
+	0xb9, 0x89, 0x9f, 0x8b, 0xc9, 0x72, 0x09, 0x2c, 0x85, 0x3f, 0xba, 0xd1, 0xdd, 0xb4, 0x1f, 0xe7,
+	0x5c, 0xa6, 0x96, 0xed, 0x5b, 0x3e, 0x7c, 0x2f, 0x5e, 0x5d, 0x99, 0x7d, 0x2f, 0xb1, 0x0a, 0xa4,
+	0xec, 0xa4, 0x07, 0x84, 0xd5, 0x46, 0xc7, 0xc0, 0xd9, 0xf7, 0x92, 0x41, 0x6d, 0xf2, 0x84, 0x19,
+	0xbe, 0xb6, 0x34, 0x6a, 0x13, 0x27, 0xbf, 0xa9, 0xd7, 0xe6, 0x4b, 0xbd, 0x9b, 0xa9, 0x09, 0x2a,
+	0x5a, 0x5a, 0x76, 0xdf, 0x90, 0x1e, 0x2c, 0x47, 0xba, 0x01, 0x9a, 0x22, 0x22, 0xa9, 0x6d, 0x28,
+	0x77, 0xd2, 0x55, 0x5e, 0xf5, 0xc6, 0x8e, 0xf4, 0xa4, 0xf8, 0xc5, 0x3c, 0x7f, 0xb1, 0xbe, 0xe0,
+	0xbf, 0xef, 0xfe, 0x35, 0x00, 0x16, 0xb7, 0xb9, 0xfd, 0x0f, 0x17, 0x00, 0x00,
 }
@@ -0,0 +1,78 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package servicers
+
+import (
+	"encoding/json"
+	"strings"
+
+	"magma/orc8r/cloud/go/serde"
+	"magma/orc8r/cloud/go/services/configurator"
+
+	"github.com/pkg/errors"
+)
+
+// getConfigFilter returns a storage config filter matching the entities whose
+// config, deserialized with the serde of the entity type and serialized to
+// JSON, has the given values at the given dot-separated field paths.
+// Returns nil if there are no config filters.
+func getConfigFilter(configFilters map[string]string) func(entityType string, config []byte) (bool, error) {
+	if len(configFilters) == 0 {
+		return nil
+	}
+	return func(entityType string, config []byte) (bool, error) {
+		if len(config) == 0 {
+			return false, nil
+		}
+		model, err := serde.Deserialize(configurator.SerdeDomain, entityType, config)
+		if err != nil {
+			return false, err
+		}
+		marshaledModel, err := json.Marshal(model)
+		if err != nil {
+			return false, errors.Wrap(err, "failed to serialize config to JSON")
+		}
+		var fields interface{}
+		if err := json.Unmarshal(marshaledModel, &fields); err != nil {
+			return false, errors.Wrap(err, "failed to deserialize config JSON")
+		}
+
+		for path, expectedValue := range configFilters {
+			value, ok := getJSONField(fields, strings.Split(path, "."))
+			if !ok || !jsonValueEquals(value, expectedValue) {
+				return false, nil
+			}
+		}
+		return true, nil
+	}
+}
+
+func getJSONField(fields interface{}, path []string) (interface{}, bool) {
+	for _, fieldName := range path {
+		object, ok := fields.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		fields, ok = object[fieldName]
+		if !ok {
+			return nil, false
+		}
+	}
+	return fields, true
+}
+
+// jsonValueEquals compares strings to the expected value as is and other
+// values to their JSON serialization
+func jsonValueEquals(value interface{}, expectedValue string) bool {
+	if str, ok := value.(string); ok {
+		return str == expectedValue
+	}
+	marshaledValue, err := json.Marshal(value)
+	return err == nil && string(marshaledValue) == expectedValue
+}
@@ -17,13 +17,21 @@ import (
 	"magma/orc8r/cloud/go/services/configurator"
 	"magma/orc8r/cloud/go/services/configurator/protos"
 	"magma/orc8r/cloud/go/services/configurator/storage"
+
+	"github.com/pkg/errors"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
 type nbConfiguratorServicer struct {
 	factory storage.ConfiguratorStorageFactory
 }
 
-// NewNorthboundConfiguratorServicer returns a configurator server backed by storage passed in
+// NewNorthboundConfiguratorServicer returns a configurator server backed by storage passed in.
+// WatchEntities is only supported if the factory is a storage.EntityWatcher
+// (see storage.NewWatchableSQLConfiguratorStorageFactory)
 func NewNorthboundConfiguratorServicer(factory storage.ConfiguratorStorageFactory) (protos.NorthboundConfiguratorServer, error) {
 	if factory == nil {
 		return nil, fmt.Errorf("Storage factory is nil")
@@ -113,7 +121,7 @@ func (srv *nbConfiguratorServicer) UpdateNetworks(context context.Context, req *
 	err = store.UpdateNetworks(updates)
 	if err != nil {
 		store.Rollback()
-		return void, err
+		return void, toVersionConflictStatus(err)
 	}
 	return void, store.Commit()
 }
@@ -127,12 +135,16 @@ func (srv *nbConfiguratorServicer) DeleteNetworks(context context.Context, req *
 
 	deleteRequests := []storage.NetworkUpdateCriteria{}
 	for _, networkID := range req.NetworkIDs {
-		deleteRequests = append(deleteRequests, storage.NetworkUpdateCriteria{ID: networkID, DeleteNetwork: true})
+		request := storage.NetworkUpdateCriteria{ID: networkID, DeleteNetwork: true}
+		if expectedVersion, ok := req.ExpectedVersions[networkID]; ok {
+			request.ExpectedVersion = &expectedVersion
+		}
+		deleteRequests = append(deleteRequests, request)
 	}
 	err = store.UpdateNetworks(deleteRequests)
 	if err != nil {
 		store.Rollback()
-		return void, err
+		return void, toVersionConflictStatus(err)
 	}
 	return void, store.Commit()
 }
@@ -144,15 +156,20 @@ func (srv *nbConfiguratorServicer) LoadEntities(context context.Context, req *pr
 		return emptyRes, err
 	}
 
-	loadFilter := protos.ToEntityLoadFilter(req.TypeFilter, req.KeyFilter, req.EntityIDs)
+	loadFilter := protos.ToEntityLoadFilter(req.TypeFilter, req.KeyFilter, req.PhysicalID, req.EntityIDs)
+	loadFilter.ConfigFilter = getConfigFilter(req.ConfigFilters)
 	loadResult, err := store.LoadEntities(req.NetworkID, loadFilter, req.Criteria.ToEntityLoadCriteria())
 	if err != nil {
 		store.Rollback()
+		if errors.Cause(err) == storage.ErrInvalidPageToken {
+			return emptyRes, status.Error(codes.InvalidArgument, err.Error())
+		}
 		return emptyRes, err
 	}
 	return &protos.LoadEntitiesResponse{
-		Entities: protos.FromStorageNetworkEntities(loadResult.Entities),
-		NotFound: protos.FromTKs(loadResult.EntitiesNotFound),
+		Entities:      protos.FromStorageNetworkEntities(loadResult.Entities),
+		NotFound:      protos.FromTKs(loadResult.EntitiesNotFound),
+		NextPageToken: loadResult.NextPageToken,
 	}, store.Commit()
 }
 
@@ -196,7 +213,7 @@ func (srv *nbConfiguratorServicer) UpdateEntities(context context.Context, req *
 		updatedEntity, err := store.UpdateEntity(req.NetworkID, update.ToEntityUpdateCriteria())
 		if err != nil {
 			store.Rollback()
-			return emptyRes, err
+			return emptyRes, toVersionConflictStatus(err)
 		}
 		updatedEntities[update.Key] = protos.FromStorageNetworkEntity(updatedEntity)
 	}
@@ -205,26 +222,61 @@ func (srv *nbConfiguratorServicer) UpdateEntities(context context.Context, req *
 
 func (srv *nbConfiguratorServicer) DeleteEntities(context context.Context, req *protos.DeleteEntitiesRequest) (*commonProtos.Void, error) {
 	void := &commonProtos.Void{}
+	if len(req.ExpectedVersions) > 0 && len(req.ExpectedVersions) != len(req.ID) {
+		return void, status.Error(codes.InvalidArgument, "expected versions must be given for all or none of the entities")
+	}
 	store, err := srv.factory.StartTransaction(context, &storage.TxOptions{ReadOnly: false})
 	if err != nil {
 		return void, err
 	}
 
-	for _, entityID := range req.ID {
+	for i, entityID := range req.ID {
 		request := storage.EntityUpdateCriteria{
 			Type:         entityID.Type,
 			Key:          entityID.Id,
 			DeleteEntity: true,
 		}
+		if len(req.ExpectedVersions) > 0 {
+			request.ExpectedVersion = &req.ExpectedVersions[i]
+		}
 		_, err = store.UpdateEntity(req.NetworkID, request)
 		if err != nil {
 			store.Rollback()
-			return void, err
+			return void, toVersionConflictStatus(err)
 		}
 	}
 	return void, store.Commit()
 }
 
+func (srv *nbConfiguratorServicer) WatchEntities(req *protos.WatchEntitiesRequest, stream protos.NorthboundConfigurator_WatchEntitiesServer) error {
+	watcher, ok := srv.factory.(storage.EntityWatcher)
+	if !ok {
+		return status.Error(codes.Unimplemented, "configurator storage does not support watching entities")
+	}
+	changes, cancel, err := watcher.WatchEntities(req.ToEntityWatchFilter())
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to watch entities: %s", err)
+	}
+	defer cancel()
+	// Headers tell the client that the watch is established
+	if err := stream.SendHeader(metadata.MD{}); err != nil {
+		return err
+	}
+	for {
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case change, ok := <-changes:
+			if !ok {
+				return status.Error(codes.Aborted, "entity watch failed or fell behind, reload entities and watch again")
+			}
+			if err := stream.Send(protos.FromStorageEntityChange(change)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
 func networkConfigsAreValid(configs map[string][]byte) error {
 	for typeVal, config := range configs {
 		_, err := serde.Deserialize(configurator.SerdeDomain, typeVal, config)
@@ -242,3 +294,22 @@ func entityConfigIsValid(typeVal string, config []byte) error {
 	}
 	return nil
 }
+
+// toVersionConflictStatus translates storage version conflicts to ABORTED
+// with a VersionConflictViolation detail so clients can tell them apart from
+// other update failures
+func toVersionConflictStatus(err error) error {
+	if !storage.IsVersionConflict(err) {
+		return err
+	}
+	st := status.New(codes.Aborted, err.Error())
+	detailed, detailErr := st.WithDetails(&errdetails.PreconditionFailure{
+		Violations: []*errdetails.PreconditionFailure_Violation{
+			{Type: protos.VersionConflictViolation, Description: err.Error()},
+		},
+	})
+	if detailErr != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
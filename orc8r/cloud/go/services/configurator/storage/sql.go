@@ -36,6 +36,11 @@ const (
 	wildcardAllString = "*"
 )
 
+// errConcurrentWrite is returned when a write conditioned on a row's version
+// didn't match the row, i.e. the row was changed since it was loaded.
+// It should be translated into a VersionConflictError by the caller.
+var errConcurrentWrite = errors.New("row was concurrently modified")
+
 type IDGenerator interface {
 	New() string
 }
@@ -338,9 +343,12 @@ func (store *sqlConfiguratorStorage) UpdateNetworks(updates []NetworkUpdateCrite
 	}
 
 	networksToDelete := []string{}
+	versionedNetworksToDelete := []NetworkUpdateCriteria{}
 	networksToUpdate := []NetworkUpdateCriteria{}
 	for _, update := range updates {
-		if update.DeleteNetwork {
+		if update.DeleteNetwork && update.ExpectedVersion != nil {
+			versionedNetworksToDelete = append(versionedNetworksToDelete, update)
+		} else if update.DeleteNetwork {
 			networksToDelete = append(networksToDelete, update.ID)
 		} else {
 			networksToUpdate = append(networksToUpdate, update)
@@ -358,7 +366,22 @@ func (store *sqlConfiguratorStorage) UpdateNetworks(updates []NetworkUpdateCrite
 		}
 	}
 
-	// Then delete all networks requested for deletion
+	// Then delete all networks requested for deletion, checking versions
+	// one network at a time if requested
+	for _, update := range versionedNetworksToDelete {
+		res, err := store.builder.Delete(networksTable).
+			Where(sq.Eq{"id": update.ID, "version": *update.ExpectedVersion}).
+			RunWith(store.tx).
+			Exec()
+		if err != nil {
+			return errors.Wrapf(err, "failed to delete network %s", update.ID)
+		}
+		if err := checkVersionedWrite(res); err == errConcurrentWrite {
+			return store.getNetworkVersionConflict(update)
+		} else if err != nil {
+			return err
+		}
+	}
 	_, err := store.builder.Delete(networksTable).Where(sq.Eq{"id": networksToDelete}).
 		RunWith(store.tx).
 		Exec()
@@ -382,6 +405,19 @@ func (store *sqlConfiguratorStorage) LoadEntities(networkID string, filter Entit
 	// be smart here and only load (type, key) for PKs which we don't know.
 	// Finally, we will update the entity objects to return with their edges.
 
+	// Paginated or config-filtered loads first select the PKs of the entities
+	// to load
+	requestedIDs := filter.IDs
+	if loadCriteria.PageSize > 0 || filter.ConfigFilter != nil {
+		pks, nextPageToken, err := store.loadEntityPage(networkID, filter, loadCriteria)
+		if err != nil {
+			return ret, err
+		}
+		ret.NextPageToken = nextPageToken
+		filter = EntityLoadFilter{pks: pks}
+		requestedIDs = nil
+	}
+
 	entsByPk, err := store.loadFromEntitiesTable(networkID, filter, loadCriteria)
 	if err != nil {
 		return ret, err
@@ -403,7 +439,7 @@ func (store *sqlConfiguratorStorage) LoadEntities(networkID string, filter Entit
 	for _, ent := range entsByPk {
 		ret.Entities = append(ret.Entities, *ent)
 	}
-	ret.EntitiesNotFound = calculateEntitiesNotFound(entsByPk, filter.IDs)
+	ret.EntitiesNotFound = calculateEntitiesNotFound(entsByPk, requestedIDs)
 
 	// Sort entities for deterministic returns
 	entComparator := func(a, b NetworkEntity) bool {
@@ -467,14 +503,29 @@ func (store *sqlConfiguratorStorage) UpdateEntity(networkID string, update Entit
 	if err != nil {
 		return emptyRet, errors.Wrap(err, "failed to load entity being updated")
 	}
+	if update.ExpectedVersion != nil && *update.ExpectedVersion != entToUpdate.Version {
+		return emptyRet, store.getEntityVersionConflict(networkID, update)
+	}
 
 	if update.DeleteEntity {
 		// Cascading FK relations in the schema will handle the other tables
 		exec := fmt.Sprintf("DELETE FROM %s WHERE (network_id, type, key) = ($1, $2, $3)", entityTable)
-		_, err := store.tx.Exec(exec, networkID, update.Type, update.Key)
+		args := []interface{}{networkID, update.Type, update.Key}
+		if update.ExpectedVersion != nil {
+			exec += " AND version = $4"
+			args = append(args, *update.ExpectedVersion)
+		}
+		res, err := store.tx.Exec(exec, args...)
 		if err != nil {
 			return emptyRet, errors.Wrapf(err, "failed to delete entity (%s, %s)", update.Type, update.Key)
 		}
+		if update.ExpectedVersion != nil {
+			if err := checkVersionedWrite(res); err == errConcurrentWrite {
+				return emptyRet, store.getEntityVersionConflict(networkID, update)
+			} else if err != nil {
+				return emptyRet, err
+			}
+		}
 
 		// Deleting a node could partition its graph
 		err = store.fixGraph(networkID, entToUpdate.GraphID, &entToUpdate)
@@ -487,6 +538,9 @@ func (store *sqlConfiguratorStorage) UpdateEntity(networkID string, update Entit
 
 	// Then, update the fields on the entity table
 	err = store.processEntityFieldsUpdate(entToUpdate.pk, update, &entToUpdate.NetworkEntity)
+	if err == errConcurrentWrite {
+		return emptyRet, store.getEntityVersionConflict(networkID, update)
+	}
 	if err != nil {
 		return entToUpdate.NetworkEntity, errors.WithStack(err)
 	}
@@ -10,6 +10,8 @@ package storage
 
 import (
 	"database/sql"
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"sort"
 	"strings"
@@ -59,6 +61,15 @@ func (store *sqlConfiguratorStorage) getLoadEntitiesSelectBuilder(networkID stri
 		selectBuilder = selectBuilder.LeftJoin(fmt.Sprintf("%s AS acl ON acl.entity_pk = ent.pk", entityAclTable))
 	}
 
+	return applyEntityLoadFilter(selectBuilder, networkID, filter)
+}
+
+func applyEntityLoadFilter(selectBuilder sq.SelectBuilder, networkID string, filter EntityLoadFilter) sq.SelectBuilder {
+	// PKs are only set for entities which were already filtered
+	if filter.pks != nil {
+		return selectBuilder.Where(sq.Eq{"ent.pk": filter.pks})
+	}
+
 	// The WHERE has ORs if specific IDs are provided
 	if !funk.IsEmpty(filter.IDs) {
 		orClause := make(sq.Or, 0, len(filter.IDs))
@@ -81,6 +92,9 @@ func (store *sqlConfiguratorStorage) getLoadEntitiesSelectBuilder(networkID stri
 			if filter.TypeFilter != nil {
 				andClause = append(andClause, sq.Eq{"ent.type": *filter.TypeFilter})
 			}
+			if filter.PhysicalID != nil {
+				andClause = append(andClause, sq.Eq{"ent.physical_id": *filter.PhysicalID})
+			}
 			selectBuilder = selectBuilder.Where(andClause)
 		}
 	}
@@ -88,6 +102,111 @@ func (store *sqlConfiguratorStorage) getLoadEntitiesSelectBuilder(networkID stri
 	return selectBuilder
 }
 
+// loadEntityPage returns the PKs of the entities matching the filter in the
+// page specified by the criteria, ordered by (type, key), and the token of
+// the next page. Entities are loaded in batches of the page size until the
+// page is full, the config filter possibly excluding entities of a batch.
+func (store *sqlConfiguratorStorage) loadEntityPage(networkID string, filter EntityLoadFilter, criteria EntityLoadCriteria) ([]string, string, error) {
+	cursor, err := decodeEntityPageToken(criteria.PageToken)
+	if err != nil {
+		return nil, "", err
+	}
+
+	pks := []string{}
+	var lastIncluded storage.TypeAndKey
+	for {
+		// SELECT ent.pk, ent.type, ent.key [[, ent.config ]] FROM cfg_entities AS ent
+		// WHERE ... [[ AND (ent.type > $1 OR (ent.type = $2 AND ent.key > $3)) ]]
+		// ORDER BY ent.type, ent.key [[ LIMIT $4 ]]
+		columns := []string{"ent.pk", "ent.type", "ent.key"}
+		if filter.ConfigFilter != nil {
+			columns = append(columns, "ent.config")
+		}
+		selectBuilder := store.builder.Select(columns...).
+			From(fmt.Sprintf("%s AS ent", entityTable)).
+			OrderBy("ent.type", "ent.key")
+		selectBuilder = applyEntityLoadFilter(selectBuilder, networkID, filter)
+		if cursor != nil {
+			selectBuilder = selectBuilder.Where(sq.Or{
+				sq.Gt{"ent.type": cursor.Type},
+				sq.And{sq.Eq{"ent.type": cursor.Type}, sq.Gt{"ent.key": cursor.Key}},
+			})
+		}
+		// Load one more entity to know whether there is a next page
+		if criteria.PageSize > 0 {
+			selectBuilder = selectBuilder.Limit(uint64(criteria.PageSize) + 1)
+		}
+		batch, err := store.loadEntityPageBatch(selectBuilder, filter.ConfigFilter != nil)
+		if err != nil {
+			return nil, "", err
+		}
+
+		for _, ent := range batch {
+			tk := storage.TypeAndKey{Type: ent.Type, Key: ent.Key}
+			cursor = &tk
+			if filter.ConfigFilter != nil {
+				matches, err := filter.ConfigFilter(ent.Type, ent.Config)
+				if err != nil {
+					return nil, "", errors.Wrapf(err, "failed to filter config of entity %s", tk)
+				}
+				if !matches {
+					continue
+				}
+			}
+			if criteria.PageSize > 0 && len(pks) == int(criteria.PageSize) {
+				return pks, encodeEntityPageToken(lastIncluded), nil
+			}
+			pks = append(pks, ent.pk)
+			lastIncluded = tk
+		}
+		if criteria.PageSize == 0 || len(batch) <= int(criteria.PageSize) {
+			return pks, "", nil
+		}
+	}
+}
+
+func (store *sqlConfiguratorStorage) loadEntityPageBatch(selectBuilder sq.SelectBuilder, loadConfig bool) ([]entWithPk, error) {
+	rows, err := selectBuilder.RunWith(store.tx).Query()
+	if err != nil {
+		return nil, errors.Wrap(err, "error querying for entity page")
+	}
+	defer sql_utils.CloseRowsLogOnError(rows, "LoadEntities")
+
+	ret := []entWithPk{}
+	for rows.Next() {
+		var ent entWithPk
+		scanArgs := []interface{}{&ent.pk, &ent.Type, &ent.Key}
+		if loadConfig {
+			scanArgs = append(scanArgs, &ent.Config)
+		}
+		if err := rows.Scan(scanArgs...); err != nil {
+			return nil, errors.Wrap(err, "error scanning entity page row")
+		}
+		ret = append(ret, ent)
+	}
+	return ret, errors.Wrap(rows.Err(), "error iterating over entity page rows")
+}
+
+func encodeEntityPageToken(lastIncluded storage.TypeAndKey) string {
+	marshaled, _ := json.Marshal(lastIncluded)
+	return base64.RawURLEncoding.EncodeToString(marshaled)
+}
+
+func decodeEntityPageToken(token string) (*storage.TypeAndKey, error) {
+	if token == "" {
+		return nil, nil
+	}
+	decoded, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidPageToken
+	}
+	tk := &storage.TypeAndKey{}
+	if err := json.Unmarshal(decoded, tk); err != nil {
+		return nil, ErrInvalidPageToken
+	}
+	return tk, nil
+}
+
 func getLoadEntitiesColumns(criteria EntityLoadCriteria) []string {
 	fields := []string{"ent.pk", "ent.key", "ent.type", "ent.physical_id", "ent.version", "ent.graph_id"}
 	if criteria.LoadMetadata {
@@ -220,6 +220,22 @@ func (store *sqlConfiguratorStorage) mergeGraphs(createdEntity entWithPk, allAss
 	return targetGraphID, nil
 }
 
+// getEntityVersionConflict returns a VersionConflictError with the current
+// version of the entity targeted by the update
+func (store *sqlConfiguratorStorage) getEntityVersionConflict(networkID string, update EntityUpdateCriteria) error {
+	ent, err := store.loadEntToUpdate(networkID, update)
+	if err != nil {
+		return errors.Wrap(err, "failed to load entity for version conflict")
+	}
+	tk := update.GetTypeAndKey()
+	return VersionConflictError{
+		NetworkID:       networkID,
+		Entity:          &tk,
+		ExpectedVersion: *update.ExpectedVersion,
+		ActualVersion:   ent.Version,
+	}
+}
+
 func (store *sqlConfiguratorStorage) loadEntToUpdate(networkID string, update EntityUpdateCriteria) (entWithPk, error) {
 	loadedEntByPk, err := store.loadFromEntitiesTable(
 		networkID,
@@ -244,10 +260,15 @@ func (store *sqlConfiguratorStorage) processEntityFieldsUpdate(pk string, update
 	if err != nil {
 		return errors.WithStack(err)
 	}
-	_, err = store.tx.Exec(exec, args...)
+	res, err := store.tx.Exec(exec, args...)
 	if err != nil {
 		return errors.Wrap(err, "failed to update entity fields")
 	}
+	if update.ExpectedVersion != nil {
+		if err := checkVersionedWrite(res); err != nil {
+			return err
+		}
+	}
 
 	if update.NewName != nil {
 		entOut.Name = *update.NewName
@@ -341,15 +362,15 @@ func (store *sqlConfiguratorStorage) processEdgeUpdates(networkID string, update
 }
 
 type updateEntityExecTemplateArgs struct {
-	TableName, Fields, FieldsPlaceholder, ConditionPlaceholder string
+	TableName, Fields, FieldsPlaceholder, ConditionPlaceholder, VersionPlaceholder string
 }
 
 func getUpdateEntityExec(pk string, update EntityUpdateCriteria) (string, []interface{}, error) {
 	// UPDATE cfg_entities SET (name, description, physical_id, config, version) = ($1, $2, $3, $4, cfg_entities.version + 1)
-	// WHERE pk = $5
+	// WHERE pk = $5 [AND version = $6]
 	tmpl := template.Must(template.New("update_ent_exec").Parse(`
 		UPDATE {{.TableName}} SET {{.Fields}} = {{.FieldsPlaceholder}}
-		WHERE pk = {{.ConditionPlaceholder}}
+		WHERE pk = {{.ConditionPlaceholder}}{{if .VersionPlaceholder}} AND version = {{.VersionPlaceholder}}{{end}}
 	`))
 	tmplArgs, sqlArgs := getUpdateEntityExecTemplateArgsAndSQLArgs(pk, update)
 
@@ -394,8 +415,13 @@ func getUpdateEntityExecTemplateArgsAndSQLArgs(pk string, update EntityUpdateCri
 		"version + 1",
 	)
 	tmplArgs.ConditionPlaceholder = fmt.Sprintf("$%d", len(fields))
-
 	args = append(args, pk)
+
+	// Only update the expected version of the entity if specified
+	if update.ExpectedVersion != nil {
+		tmplArgs.VersionPlaceholder = fmt.Sprintf("$%d", len(fields)+1)
+		args = append(args, *update.ExpectedVersion)
+	}
 	return tmplArgs, args
 }
 
@@ -18,6 +18,7 @@ import (
 	storage2 "magma/orc8r/cloud/go/storage"
 
 	_ "github.com/mattn/go-sqlite3"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -688,3 +689,178 @@ func TestSqlConfiguratorStorage_Integration(t *testing.T) {
 		allEnts,
 	)
 }
+
+func TestSqlConfiguratorStorage_VersionConflicts(t *testing.T) {
+	db, err := sql_utils.Open("sqlite3", ":memory:?_foreign_keys=1")
+	if err != nil {
+		t.Fatalf("Could not initialize sqlite DB: %s", err)
+	}
+	factory := storage.NewSQLConfiguratorStorageFactory(db, &mockIDGenerator{}, sql_utils.GetSqlBuilder())
+	assert.NoError(t, factory.InitializeServiceStorage())
+
+	store, err := factory.StartTransaction(context.Background(), nil)
+	assert.NoError(t, err)
+	_, err = store.CreateNetwork(storage.Network{ID: "n1"})
+	assert.NoError(t, err)
+	_, err = store.CreateNetwork(storage.Network{ID: "n2"})
+	assert.NoError(t, err)
+	_, err = store.CreateEntity("n1", storage.NetworkEntity{Type: "foo", Key: "bar"})
+	assert.NoError(t, err)
+	assert.NoError(t, store.Commit())
+
+	version := func(v uint64) *uint64 { return &v }
+	newName := "newName"
+
+	// Entity updates
+	store, err = factory.StartTransaction(context.Background(), nil)
+	assert.NoError(t, err)
+	updated, err := store.UpdateEntity("n1", storage.EntityUpdateCriteria{Type: "foo", Key: "bar", NewName: &newName, ExpectedVersion: version(0)})
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1), updated.Version)
+	_, err = store.UpdateEntity("n1", storage.EntityUpdateCriteria{Type: "foo", Key: "bar", NewName: &newName, ExpectedVersion: version(0)})
+	assert.True(t, storage.IsVersionConflict(err))
+	tk := storage2.TypeAndKey{Type: "foo", Key: "bar"}
+	assert.Equal(t, storage.VersionConflictError{NetworkID: "n1", Entity: &tk, ExpectedVersion: 0, ActualVersion: 1}, errors.Cause(err))
+	_, err = store.UpdateEntity("n1", storage.EntityUpdateCriteria{Type: "foo", Key: "bar", DeleteEntity: true, ExpectedVersion: version(2)})
+	assert.True(t, storage.IsVersionConflict(err))
+	_, err = store.UpdateEntity("n1", storage.EntityUpdateCriteria{Type: "foo", Key: "bar", DeleteEntity: true, ExpectedVersion: version(1)})
+	assert.NoError(t, err)
+	assert.NoError(t, store.Commit())
+
+	// Network updates
+	store, err = factory.StartTransaction(context.Background(), nil)
+	assert.NoError(t, err)
+	assert.NoError(t, store.UpdateNetworks([]storage.NetworkUpdateCriteria{{ID: "n1", NewName: &newName, ExpectedVersion: version(0)}}))
+	err = store.UpdateNetworks([]storage.NetworkUpdateCriteria{{ID: "n1", NewName: &newName, ExpectedVersion: version(0)}})
+	assert.True(t, storage.IsVersionConflict(err))
+	assert.Equal(t, storage.VersionConflictError{NetworkID: "n1", ExpectedVersion: 0, ActualVersion: 1}, errors.Cause(err))
+	err = store.UpdateNetworks([]storage.NetworkUpdateCriteria{{ID: "n3", NewName: &newName, ExpectedVersion: version(0)}})
+	assert.EqualError(t, err, "network n3 does not exist")
+	err = store.UpdateNetworks([]storage.NetworkUpdateCriteria{{ID: "n1", DeleteNetwork: true, ExpectedVersion: version(0)}})
+	assert.True(t, storage.IsVersionConflict(err))
+	// Updates without an expected version are unconditional
+	assert.NoError(t, store.UpdateNetworks([]storage.NetworkUpdateCriteria{{ID: "n2", NewName: &newName}}))
+	assert.NoError(t, store.UpdateNetworks([]storage.NetworkUpdateCriteria{
+		{ID: "n1", DeleteNetwork: true, ExpectedVersion: version(1)},
+		{ID: "n2", DeleteNetwork: true},
+	}))
+	loaded, err := store.LoadNetworks([]string{"n1", "n2"}, storage.NetworkLoadCriteria{})
+	assert.NoError(t, err)
+	assert.Empty(t, loaded.Networks)
+	assert.NoError(t, store.Commit())
+}
+
+func TestSqlConfiguratorStorage_LoadEntitiesPaginated(t *testing.T) {
+	db, err := sql_utils.Open("sqlite3", ":memory:?_foreign_keys=1")
+	if err != nil {
+		t.Fatalf("Could not initialize sqlite DB: %s", err)
+	}
+	factory := storage.NewSQLConfiguratorStorageFactory(db, &mockIDGenerator{}, sql_utils.GetSqlBuilder())
+	assert.NoError(t, factory.InitializeServiceStorage())
+
+	store, err := factory.StartTransaction(context.Background(), nil)
+	assert.NoError(t, err)
+	_, err = store.CreateNetwork(storage.Network{ID: "n1"})
+	assert.NoError(t, err)
+	acls := []storage.ACL{
+		{Permission: storage.WritePermission, Scope: storage.ACLScope{NetworkIDs: []string{"n1"}}, Type: storage.ACLType{EntityType: "foo"}},
+		{Permission: storage.ReadPermission, Scope: storage.ACLScope{NetworkIDs: []string{"n1"}}, Type: storage.ACLType{EntityType: "bar"}},
+	}
+	for _, ent := range []storage.NetworkEntity{
+		{Type: "foo", Key: "3", PhysicalID: "p3", Config: []byte("match")},
+		{Type: "foo", Key: "1", PhysicalID: "p1", Config: []byte("match"), Permissions: acls},
+		{Type: "bar", Key: "1", PhysicalID: "p2"},
+		{Type: "foo", Key: "2", PhysicalID: "p2", Permissions: acls},
+		{Type: "baz", Key: "1", Config: []byte("match")},
+	} {
+		_, err = store.CreateEntity("n1", ent)
+		assert.NoError(t, err)
+	}
+
+	loadPages := func(filter storage.EntityLoadFilter, criteria storage.EntityLoadCriteria) [][]storage2.TypeAndKey {
+		pages := [][]storage2.TypeAndKey{}
+		for {
+			res, err := store.LoadEntities("n1", filter, criteria)
+			assert.NoError(t, err)
+			assert.Empty(t, res.EntitiesNotFound)
+			page := []storage2.TypeAndKey{}
+			for _, ent := range res.Entities {
+				page = append(page, ent.GetTypeAndKey())
+				if criteria.LoadPermissions && ent.Type == "foo" && ent.Key != "3" {
+					assert.Len(t, ent.Permissions, 2)
+				}
+			}
+			pages = append(pages, page)
+			if res.NextPageToken == "" {
+				return pages
+			}
+			criteria.PageToken = res.NextPageToken
+		}
+	}
+	tks := func(tks ...string) []storage2.TypeAndKey {
+		ret := []storage2.TypeAndKey{}
+		for i := 0; i < len(tks); i += 2 {
+			ret = append(ret, storage2.TypeAndKey{Type: tks[i], Key: tks[i+1]})
+		}
+		return ret
+	}
+
+	// Pages are ordered by (type, key), ACLs don't count toward the page size
+	assert.Equal(
+		t,
+		[][]storage2.TypeAndKey{tks("bar", "1", "baz", "1"), tks("foo", "1", "foo", "2"), tks("foo", "3")},
+		loadPages(storage.EntityLoadFilter{}, storage.EntityLoadCriteria{PageSize: 2, LoadPermissions: true}),
+	)
+	assert.Equal(
+		t,
+		[][]storage2.TypeAndKey{tks("bar", "1", "baz", "1", "foo", "1", "foo", "2", "foo", "3")},
+		loadPages(storage.EntityLoadFilter{}, storage.EntityLoadCriteria{PageSize: 5}),
+	)
+	foo := "foo"
+	assert.Equal(
+		t,
+		[][]storage2.TypeAndKey{tks("foo", "1", "foo", "2"), tks("foo", "3")},
+		loadPages(storage.EntityLoadFilter{TypeFilter: &foo}, storage.EntityLoadCriteria{PageSize: 2}),
+	)
+
+	// Physical ID filter, with and without pagination
+	p2 := "p2"
+	assert.Equal(
+		t,
+		[][]storage2.TypeAndKey{tks("bar", "1"), tks("foo", "2")},
+		loadPages(storage.EntityLoadFilter{PhysicalID: &p2}, storage.EntityLoadCriteria{PageSize: 1}),
+	)
+	assert.Equal(
+		t,
+		[][]storage2.TypeAndKey{tks("bar", "1", "foo", "2")},
+		loadPages(storage.EntityLoadFilter{PhysicalID: &p2}, storage.EntityLoadCriteria{}),
+	)
+
+	// Config filter, with and without pagination
+	configFilter := func(entType string, config []byte) (bool, error) {
+		return string(config) == "match", nil
+	}
+	assert.Equal(
+		t,
+		[][]storage2.TypeAndKey{tks("baz", "1", "foo", "1"), tks("foo", "3")},
+		loadPages(storage.EntityLoadFilter{ConfigFilter: configFilter}, storage.EntityLoadCriteria{PageSize: 2}),
+	)
+	assert.Equal(
+		t,
+		[][]storage2.TypeAndKey{tks("baz", "1"), tks("foo", "1"), tks("foo", "3")},
+		loadPages(storage.EntityLoadFilter{ConfigFilter: configFilter}, storage.EntityLoadCriteria{PageSize: 1}),
+	)
+	assert.Equal(
+		t,
+		[][]storage2.TypeAndKey{tks("foo", "1", "foo", "3")},
+		loadPages(storage.EntityLoadFilter{TypeFilter: &foo, ConfigFilter: configFilter}, storage.EntityLoadCriteria{}),
+	)
+	_, err = store.LoadEntities("n1", storage.EntityLoadFilter{
+		ConfigFilter: func(string, []byte) (bool, error) { return false, errors.New("mock error") },
+	}, storage.EntityLoadCriteria{})
+	assert.EqualError(t, err, "failed to filter config of entity bar-1: mock error")
+
+	_, err = store.LoadEntities("n1", storage.EntityLoadFilter{}, storage.EntityLoadCriteria{PageSize: 2, PageToken: "garbage"})
+	assert.Equal(t, storage.ErrInvalidPageToken, err)
+	assert.NoError(t, store.Commit())
+}
@@ -137,10 +137,20 @@ func (store *sqlConfiguratorStorage) updateNetwork(update NetworkUpdateCriteria,
 		updateBuilder = updateBuilder.Set("description", stringPtrToVal(update.NewDescription))
 	}
 	updateBuilder = updateBuilder.Set("version", sq.Expr(fmt.Sprintf("%s.version+1", networksTable)))
-	_, err := updateBuilder.RunWith(stmtCache).Exec()
+	if update.ExpectedVersion != nil {
+		updateBuilder = updateBuilder.Where(sq.Eq{"version": *update.ExpectedVersion})
+	}
+	res, err := updateBuilder.RunWith(stmtCache).Exec()
 	if err != nil {
 		return errors.Wrapf(err, "error updating network %s", update.ID)
 	}
+	if update.ExpectedVersion != nil {
+		if err := checkVersionedWrite(res); err == errConcurrentWrite {
+			return store.getNetworkVersionConflict(update)
+		} else if err != nil {
+			return err
+		}
+	}
 
 	// Sort config keys for deterministic behavior on upserts
 	configUpdateTypes := funk.Keys(update.ConfigsToAddOrUpdate).([]string)
@@ -181,6 +191,36 @@ func (store *sqlConfiguratorStorage) updateNetwork(update NetworkUpdateCriteria,
 	return nil
 }
 
+// getNetworkVersionConflict returns a VersionConflictError with the current
+// version of the network targeted by the update
+func (store *sqlConfiguratorStorage) getNetworkVersionConflict(update NetworkUpdateCriteria) error {
+	var version uint64
+	err := store.builder.Select("version").From(networksTable).
+		Where(sq.Eq{"id": update.ID}).
+		RunWith(store.tx).
+		QueryRow().Scan(&version)
+	if err == sql.ErrNoRows {
+		return errors.Errorf("network %s does not exist", update.ID)
+	}
+	if err != nil {
+		return errors.Wrapf(err, "failed to load version of network %s", update.ID)
+	}
+	return VersionConflictError{NetworkID: update.ID, ExpectedVersion: *update.ExpectedVersion, ActualVersion: version}
+}
+
+// checkVersionedWrite returns errConcurrentWrite if a write conditioned on a
+// row's version didn't affect any row
+func checkVersionedWrite(res sql.Result) error {
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "failed to get rows affected by versioned write")
+	}
+	if rowsAffected == 0 {
+		return errConcurrentWrite
+	}
+	return nil
+}
+
 func stringPtrToVal(in *string) interface{} {
 	if *in == "" {
 		return nil
@@ -0,0 +1,470 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"magma/orc8r/cloud/go/sql_utils"
+	"magma/orc8r/cloud/go/storage"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	"github.com/thoas/go-funk"
+)
+
+const (
+	// changelogVersionsTable holds the version of the latest committed
+	// transaction with changes of each network. Rows are kept after their
+	// network is deleted, so a recreated network continues its changelog.
+	changelogVersionsTable = "cfg_changelog_versions"
+	// changelogTable holds the changes of the latest committed transactions
+	// of each network
+	changelogTable = "cfg_changelog"
+)
+
+// DefaultMaxChangelogVersions is the default number of committed transactions
+// whose changes are kept per network for watchers
+const DefaultMaxChangelogVersions = 1000
+
+// errChangesTrimmed is returned when changes a watcher hasn't received yet
+// were trimmed from the changelog
+var errChangesTrimmed = errors.New("changes were trimmed from the changelog")
+
+// NewWatchableSQLConfiguratorStorageFactory returns a SQL backed
+// ConfiguratorStorageFactory whose committed changes can be watched.
+// Each transaction records its changes in a changelog in the database when it
+// is committed, versioned per network. The version is incremented under the
+// network's changelog row lock, so versions follow commit order.
+// Watchers poll the changelog, so they receive the changes committed through
+// any factory backed by the same database, e.g. by other configurator
+// replicas. The changes of the latest maxVersions transactions are kept per
+// network, if maxVersions <= 0, DefaultMaxChangelogVersions is used.
+// All writers of the database must use a watchable factory.
+func NewWatchableSQLConfiguratorStorageFactory(
+	db *sql.DB,
+	generator IDGenerator,
+	sqlBuilder sql_utils.StatementBuilder,
+	maxVersions int,
+) WatchableConfiguratorStorageFactory {
+	if maxVersions <= 0 {
+		maxVersions = DefaultMaxChangelogVersions
+	}
+	return &watchableSQLStorageFactory{
+		sqlConfiguratorStorageFactory: &sqlConfiguratorStorageFactory{db: db, idGenerator: generator, builder: sqlBuilder},
+		maxVersions:                   uint64(maxVersions),
+		committed:                     make(chan struct{}),
+	}
+}
+
+type watchableSQLStorageFactory struct {
+	*sqlConfiguratorStorageFactory
+	maxVersions uint64
+
+	sync.Mutex
+	// committed is closed and replaced after every commit of changes through
+	// this factory, to wake up its watchers
+	committed chan struct{}
+}
+
+func (fact *watchableSQLStorageFactory) InitializeServiceStorage() error {
+	err := fact.sqlConfiguratorStorageFactory.InitializeServiceStorage()
+	if err != nil {
+		return err
+	}
+
+	tablesToCreate := []string{
+		fmt.Sprintf(`
+			CREATE TABLE IF NOT EXISTS %s
+			(
+				network_id TEXT PRIMARY KEY,
+				version INTEGER NOT NULL DEFAULT 0
+			)
+		`, changelogVersionsTable),
+		// No FK to the networks table, changes of deleted networks must be
+		// kept for watchers
+		fmt.Sprintf(`
+			CREATE TABLE IF NOT EXISTS %s
+			(
+				network_id TEXT NOT NULL,
+				version INTEGER NOT NULL,
+				seq INTEGER NOT NULL,
+				value BYTEA,
+
+				PRIMARY KEY (network_id, version, seq)
+			)
+		`, changelogTable),
+	}
+	for _, execQuery := range tablesToCreate {
+		if _, err := fact.db.Exec(execQuery); err != nil {
+			return errors.Wrap(err, "failed to create changelog tables")
+		}
+	}
+	return nil
+}
+
+func (fact *watchableSQLStorageFactory) StartTransaction(ctx context.Context, opts *TxOptions) (ConfiguratorStorage, error) {
+	store, err := fact.sqlConfiguratorStorageFactory.StartTransaction(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &watchableSQLStorage{sqlConfiguratorStorage: store.(*sqlConfiguratorStorage), factory: fact}, nil
+}
+
+func (fact *watchableSQLStorageFactory) WatchEntities(filter EntityWatchFilter) (<-chan EntityChange, func(), error) {
+	versions, err := fact.loadChangelogVersions(filter.NetworkID)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to load changelog versions")
+	}
+
+	changes := make(chan EntityChange)
+	done := make(chan struct{})
+	go fact.runWatch(filter, versions, changes, done)
+
+	var once sync.Once
+	cancel := func() { once.Do(func() { close(done) }) }
+	return changes, cancel, nil
+}
+
+// runWatch sends the changes matching the filter committed after the given
+// changelog versions until done is closed or the changelog can't be read
+func (fact *watchableSQLStorageFactory) runWatch(
+	filter EntityWatchFilter,
+	versions map[string]uint64,
+	changes chan<- EntityChange,
+	done <-chan struct{},
+) {
+	defer close(changes)
+	ticker := time.NewTicker(WatchPollInterval)
+	defer ticker.Stop()
+
+	for {
+		// Taken before polling, so changes committed during the poll wake
+		// the watcher up again
+		committed := fact.getCommitted()
+		newChanges, err := fact.loadChangesSince(filter.NetworkID, versions)
+		if err != nil {
+			glog.Errorf("Closing entity watcher of network '%s': %s", filter.NetworkID, err)
+			return
+		}
+		for _, change := range newChanges {
+			if !filter.matches(change) {
+				continue
+			}
+			select {
+			case changes <- change:
+			case <-done:
+				return
+			}
+		}
+
+		select {
+		case <-done:
+			return
+		case <-committed:
+		case <-ticker.C:
+		}
+	}
+}
+
+func (fact *watchableSQLStorageFactory) getCommitted() <-chan struct{} {
+	fact.Lock()
+	defer fact.Unlock()
+	return fact.committed
+}
+
+func (fact *watchableSQLStorageFactory) notifyCommitted() {
+	fact.Lock()
+	defer fact.Unlock()
+	close(fact.committed)
+	fact.committed = make(chan struct{})
+}
+
+// loadChangelogVersions returns the changelog version of the network, or of
+// all networks if networkID is empty
+func (fact *watchableSQLStorageFactory) loadChangelogVersions(networkID string) (map[string]uint64, error) {
+	selectBuilder := fact.builder.Select("network_id", "version").From(changelogVersionsTable)
+	if networkID != "" {
+		selectBuilder = selectBuilder.Where(sq.Eq{"network_id": networkID})
+	}
+	rows, err := selectBuilder.RunWith(fact.db).Query()
+	if err != nil {
+		return nil, err
+	}
+	defer sql_utils.CloseRowsLogOnError(rows, "loadChangelogVersions")
+
+	versions := map[string]uint64{}
+	for rows.Next() {
+		var nid string
+		var version uint64
+		if err := rows.Scan(&nid, &version); err != nil {
+			return nil, err
+		}
+		versions[nid] = version
+	}
+	return versions, rows.Err()
+}
+
+// loadChangesSince returns the changes committed after the given changelog
+// versions of the network (or of all networks if networkID is empty) and
+// advances the versions past them. Changes of each network are returned in
+// commit order.
+func (fact *watchableSQLStorageFactory) loadChangesSince(networkID string, versions map[string]uint64) ([]EntityChange, error) {
+	latestVersions, err := fact.loadChangelogVersions(networkID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load changelog versions")
+	}
+
+	var changes []EntityChange
+	networkIDs := funk.Keys(latestVersions).([]string)
+	sort.Strings(networkIDs)
+	for _, nid := range networkIDs {
+		if latestVersions[nid] <= versions[nid] {
+			continue
+		}
+		networkChanges, version, err := fact.loadNetworkChangesSince(nid, versions[nid])
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, networkChanges...)
+		versions[nid] = version
+	}
+	return changes, nil
+}
+
+func (fact *watchableSQLStorageFactory) loadNetworkChangesSince(networkID string, version uint64) ([]EntityChange, uint64, error) {
+	rows, err := fact.builder.Select("version", "value").
+		From(changelogTable).
+		Where(sq.And{sq.Eq{"network_id": networkID}, sq.Gt{"version": version}}).
+		OrderBy("version", "seq").
+		RunWith(fact.db).
+		Query()
+	if err != nil {
+		return nil, version, errors.Wrapf(err, "failed to load changes of network %s", networkID)
+	}
+	defer sql_utils.CloseRowsLogOnError(rows, "loadNetworkChangesSince")
+
+	var changes []EntityChange
+	latestVersion := version
+	for rows.Next() {
+		var changeVersion uint64
+		var value []byte
+		if err := rows.Scan(&changeVersion, &value); err != nil {
+			return nil, version, errors.Wrap(err, "failed to scan change")
+		}
+		// Every version has changes, so a gap means the changes were trimmed
+		if changeVersion != latestVersion && changeVersion != latestVersion+1 {
+			return nil, version, errChangesTrimmed
+		}
+		change := EntityChange{}
+		if err := json.Unmarshal(value, &change); err != nil {
+			return nil, version, errors.Wrap(err, "failed to unmarshal change")
+		}
+		changes = append(changes, change)
+		latestVersion = changeVersion
+	}
+	if err := rows.Err(); err != nil {
+		return nil, version, err
+	}
+	if latestVersion == version {
+		return nil, version, errChangesTrimmed
+	}
+	return changes, latestVersion, nil
+}
+
+// watchableSQLStorage records the changes of a transaction & appends them to
+// the changelog when the transaction is committed
+type watchableSQLStorage struct {
+	*sqlConfiguratorStorage
+	factory *watchableSQLStorageFactory
+	changes []EntityChange
+}
+
+func (store *watchableSQLStorage) Commit() error {
+	changes := store.changes
+	store.changes = nil
+	if len(changes) > 0 {
+		err := store.appendToChangelog(changes)
+		if err != nil {
+			rollbackErr := store.sqlConfiguratorStorage.Rollback()
+			if rollbackErr != nil {
+				err = fmt.Errorf("%s; rollback error: %s", err, rollbackErr)
+			}
+			return err
+		}
+	}
+
+	err := store.sqlConfiguratorStorage.Commit()
+	if err == nil && len(changes) > 0 {
+		store.factory.notifyCommitted()
+	}
+	return err
+}
+
+func (store *watchableSQLStorage) Rollback() error {
+	store.changes = nil
+	return store.sqlConfiguratorStorage.Rollback()
+}
+
+// appendToChangelog appends the changes of each network with the next
+// version of the network's changelog. Networks are locked in ID order to
+// avoid deadlocks between transactions changing several networks.
+func (store *watchableSQLStorage) appendToChangelog(changes []EntityChange) error {
+	changesByNetwork := map[string][]EntityChange{}
+	for _, change := range changes {
+		changesByNetwork[change.NetworkID] = append(changesByNetwork[change.NetworkID], change)
+	}
+	networkIDs := funk.Keys(changesByNetwork).([]string)
+	sort.Strings(networkIDs)
+
+	for _, networkID := range networkIDs {
+		version, err := store.incrementChangelogVersion(networkID)
+		if err != nil {
+			return errors.Wrapf(err, "failed to increment changelog version of network %s", networkID)
+		}
+
+		insertBuilder := store.builder.Insert(changelogTable).Columns("network_id", "version", "seq", "value")
+		for seq, change := range changesByNetwork[networkID] {
+			value, err := json.Marshal(change)
+			if err != nil {
+				return errors.Wrap(err, "failed to marshal change")
+			}
+			insertBuilder = insertBuilder.Values(networkID, version, seq, value)
+		}
+		_, err = insertBuilder.RunWith(store.tx).Exec()
+		if err != nil {
+			return errors.Wrapf(err, "failed to append changes of network %s", networkID)
+		}
+
+		if version > store.factory.maxVersions {
+			_, err = store.builder.Delete(changelogTable).
+				Where(sq.And{sq.Eq{"network_id": networkID}, sq.LtOrEq{"version": version - store.factory.maxVersions}}).
+				RunWith(store.tx).
+				Exec()
+			if err != nil {
+				return errors.Wrapf(err, "failed to trim changelog of network %s", networkID)
+			}
+		}
+	}
+	return nil
+}
+
+// incrementChangelogVersion increments and returns the changelog version of
+// the network. The update locks the version row until the transaction ends,
+// so concurrent transactions get their versions in commit order.
+func (store *watchableSQLStorage) incrementChangelogVersion(networkID string) (uint64, error) {
+	_, err := store.builder.Insert(changelogVersionsTable).
+		Columns("network_id").
+		Values(networkID).
+		OnConflict(nil, "network_id").
+		RunWith(store.tx).
+		Exec()
+	if err != nil {
+		return 0, err
+	}
+	_, err = store.builder.Update(changelogVersionsTable).
+		Set("version", sq.Expr("version + 1")).
+		Where(sq.Eq{"network_id": networkID}).
+		RunWith(store.tx).
+		Exec()
+	if err != nil {
+		return 0, err
+	}
+	var version uint64
+	err = store.builder.Select("version").
+		From(changelogVersionsTable).
+		Where(sq.Eq{"network_id": networkID}).
+		RunWith(store.tx).
+		QueryRow().
+		Scan(&version)
+	return version, err
+}
+
+func (store *watchableSQLStorage) CreateNetwork(network Network) (Network, error) {
+	created, err := store.sqlConfiguratorStorage.CreateNetwork(network)
+	if err == nil {
+		store.changes = append(store.changes, EntityChange{Type: NetworkCreated, NetworkID: created.ID, Network: created})
+	}
+	return created, err
+}
+
+func (store *watchableSQLStorage) UpdateNetworks(updates []NetworkUpdateCriteria) error {
+	// Entities of deleted networks are deleted as well
+	var deleted []EntityChange
+	var updatedIDs []string
+	for _, update := range updates {
+		if !update.DeleteNetwork {
+			updatedIDs = append(updatedIDs, update.ID)
+			continue
+		}
+		loaded, err := store.LoadEntities(update.ID, EntityLoadFilter{}, EntityLoadCriteria{})
+		if err != nil {
+			return errors.Wrapf(err, "failed to load entities of network %s", update.ID)
+		}
+		for _, ent := range loaded.Entities {
+			deleted = append(deleted, EntityChange{Type: EntityDeleted, NetworkID: update.ID, Entity: ent})
+		}
+		networks, err := store.LoadNetworks([]string{update.ID}, NetworkLoadCriteria{})
+		if err != nil {
+			return errors.Wrapf(err, "failed to load network %s", update.ID)
+		}
+		for _, network := range networks.Networks {
+			deleted = append(deleted, EntityChange{Type: NetworkDeleted, NetworkID: update.ID, Network: network})
+		}
+	}
+
+	err := store.sqlConfiguratorStorage.UpdateNetworks(updates)
+	if err != nil {
+		return err
+	}
+	updatedNetworks, err := store.LoadNetworks(updatedIDs, NetworkLoadCriteria{LoadMetadata: true, LoadConfigs: true})
+	if err != nil {
+		return errors.Wrap(err, "failed to load updated networks")
+	}
+	for _, network := range updatedNetworks.Networks {
+		store.changes = append(store.changes, EntityChange{Type: NetworkUpdated, NetworkID: network.ID, Network: network})
+	}
+	store.changes = append(store.changes, deleted...)
+	return nil
+}
+
+func (store *watchableSQLStorage) CreateEntity(networkID string, entity NetworkEntity) (NetworkEntity, error) {
+	created, err := store.sqlConfiguratorStorage.CreateEntity(networkID, entity)
+	if err == nil {
+		store.changes = append(store.changes, EntityChange{Type: EntityCreated, NetworkID: networkID, Entity: created})
+	}
+	return created, err
+}
+
+func (store *watchableSQLStorage) UpdateEntity(networkID string, update EntityUpdateCriteria) (NetworkEntity, error) {
+	if update.DeleteEntity {
+		// Load the entity first to publish its last version
+		loaded, err := store.LoadEntities(networkID, EntityLoadFilter{IDs: []storage.TypeAndKey{update.GetTypeAndKey()}}, EntityLoadCriteria{})
+		if err != nil {
+			return NetworkEntity{Type: update.Type, Key: update.Key}, errors.Wrap(err, "failed to load entity being deleted")
+		}
+		deleted, err := store.sqlConfiguratorStorage.UpdateEntity(networkID, update)
+		if err == nil && len(loaded.Entities) > 0 {
+			store.changes = append(store.changes, EntityChange{Type: EntityDeleted, NetworkID: networkID, Entity: loaded.Entities[0]})
+		}
+		return deleted, err
+	}
+	updated, err := store.sqlConfiguratorStorage.UpdateEntity(networkID, update)
+	if err == nil {
+		store.changes = append(store.changes, EntityChange{Type: EntityUpdated, NetworkID: networkID, Entity: updated})
+	}
+	return updated, err
+}
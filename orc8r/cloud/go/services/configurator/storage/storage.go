@@ -14,6 +14,7 @@ import (
 
 	"magma/orc8r/cloud/go/storage"
 
+	"github.com/pkg/errors"
 	"github.com/thoas/go-funk"
 )
 
@@ -154,6 +155,11 @@ type NetworkUpdateCriteria struct {
 
 	// Config values to delete
 	ConfigsToDelete []string
+
+	// Set ExpectedVersion to only apply the update (or deletion) if the
+	// network's current version matches. A mismatch fails the update with a
+	// VersionConflictError.
+	ExpectedVersion *uint64
 }
 
 // NetworkEntity is the storage representation of a logical component of a
@@ -286,19 +292,31 @@ type EntityLoadFilter struct {
 	// given ID.
 	KeyFilter *string
 
+	// If PhysicalID is provided, the query will return all entities matching
+	// the given physical ID.
+	PhysicalID *string
+
 	// If IDs is provided, the query will return all entities matching the
-	// provided TypeAndKeys. TypeFilter and KeyFilter are ignored if IDs is
-	// provided.
+	// provided TypeAndKeys. TypeFilter, KeyFilter and PhysicalID are ignored
+	// if IDs is provided.
 	IDs []storage.TypeAndKey
 
+	// If ConfigFilter is provided, the query will only return entities for
+	// which it returns true. Configs are opaque to storage, so ConfigFilter is
+	// evaluated on each entity matching the other filters after loading its
+	// config.
+	ConfigFilter func(entityType string, config []byte) (bool, error)
+
 	// Unexported for internal use
 	graphID *string
+	pks     []string
 }
 
 // IsLoadAllEntities return true if the EntityLoadFilter is specifying to load
 // all entities in a network, false if there are any filter conditions.
 func (elf EntityLoadFilter) IsLoadAllEntities() bool {
-	return elf.TypeFilter == nil && elf.KeyFilter == nil && elf.graphID == nil && funk.IsEmpty(elf.IDs)
+	return elf.TypeFilter == nil && elf.KeyFilter == nil && elf.PhysicalID == nil && elf.ConfigFilter == nil &&
+		elf.graphID == nil && funk.IsEmpty(elf.IDs) && elf.pks == nil
 }
 
 // EntityLoadCriteria specifies how much of an entity to load
@@ -312,6 +330,12 @@ type EntityLoadCriteria struct {
 	LoadAssocsFromThis bool
 
 	LoadPermissions bool
+
+	// Set PageSize to load at most PageSize entities, ordered by (type, key).
+	// The next page is loaded by setting PageToken to the NextPageToken of the
+	// previous page. A PageSize of 0 loads all entities.
+	PageSize  uint32
+	PageToken string
 }
 
 // FullEntityLoadCriteria is an EntityLoadCriteria which loads everything
@@ -327,10 +351,19 @@ var FullEntityLoadCriteria = EntityLoadCriteria{
 type EntityLoadResult struct {
 	// Loaded entities
 	Entities []NetworkEntity
-	// Entities which were not found
+	// Entities which were not found. Only set for loads without pagination
+	// or config filter.
 	EntitiesNotFound []storage.TypeAndKey
+
+	// NextPageToken is the token to load the next page of a paginated load,
+	// empty if this is the last page.
+	NextPageToken string
 }
 
+// ErrInvalidPageToken is returned by LoadEntities if the page token is not a
+// NextPageToken returned by a previous load
+var ErrInvalidPageToken = errors.New("invalid page token")
+
 // EntityUpdateCriteria specifies a patch operation on a network entity.
 type EntityUpdateCriteria struct {
 	// (Type, Key) of the entity to update
@@ -360,12 +393,49 @@ type EntityUpdateCriteria struct {
 
 	// ACL IDs to delete
 	PermissionsToDelete []string
+
+	// Set ExpectedVersion to only apply the update (or deletion) if the
+	// entity's current version matches. A mismatch fails the update with a
+	// VersionConflictError.
+	ExpectedVersion *uint64
 }
 
 func (euc EntityUpdateCriteria) GetTypeAndKey() storage.TypeAndKey {
 	return storage.TypeAndKey{Type: euc.Type, Key: euc.Key}
 }
 
+// VersionConflictError is returned by updates whose ExpectedVersion doesn't
+// match the current version of the network or entity being updated.
+// Storage implementations may wrap it, use IsVersionConflict to check for it.
+type VersionConflictError struct {
+	// NetworkID of the conflicting network or entity
+	NetworkID string
+	// Entity is the (type, key) of the conflicting entity, nil for networks
+	Entity *storage.TypeAndKey
+
+	ExpectedVersion uint64
+	ActualVersion   uint64
+}
+
+func (e VersionConflictError) Error() string {
+	if e.Entity != nil {
+		return fmt.Sprintf(
+			"version conflict on entity %s in network %s: expected version %d, actual version %d",
+			e.Entity, e.NetworkID, e.ExpectedVersion, e.ActualVersion,
+		)
+	}
+	return fmt.Sprintf(
+		"version conflict on network %s: expected version %d, actual version %d",
+		e.NetworkID, e.ExpectedVersion, e.ActualVersion,
+	)
+}
+
+// IsVersionConflict returns true if the cause of err is a VersionConflictError
+func IsVersionConflict(err error) bool {
+	_, ok := errors.Cause(err).(VersionConflictError)
+	return ok
+}
+
 // EntityGraph represents a DAG of associated network entities.
 type EntityGraph struct {
 	// All nodes in the graph
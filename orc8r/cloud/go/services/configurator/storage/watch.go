@@ -0,0 +1,102 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+package storage
+
+import (
+	"time"
+)
+
+// DefaultWatchPollInterval is the default interval between checks of the
+// changelog by entity watchers
+const DefaultWatchPollInterval = time.Second * 5
+
+// WatchPollInterval is the interval between checks of the changelog by entity
+// watchers. Changes committed through the watcher's own storage factory are
+// sent immediately, the poll picks up the changes committed by other
+// configurator replicas.
+var WatchPollInterval = DefaultWatchPollInterval
+
+// EntityChangeType is the type of an EntityChange
+type EntityChangeType int
+
+const (
+	EntityCreated EntityChangeType = iota + 1
+	EntityUpdated
+	EntityDeleted
+	NetworkCreated
+	NetworkUpdated
+	NetworkDeleted
+)
+
+// EntityChange is a committed change of a network entity or of a network
+type EntityChange struct {
+	Type      EntityChangeType
+	NetworkID string
+	// Entity is the created entity, the updated fields (with identity fields)
+	// of an updated entity (see ConfiguratorStorage.UpdateEntity) or the
+	// identity fields of a deleted entity.
+	// Entity.Version is the version after the change or, for deleted
+	// entities, the last version before the deletion.
+	// Entity is empty for network changes.
+	Entity NetworkEntity
+	// Network is the created network, the network (with metadata and
+	// configs) after an update or the ID and last version of a deleted
+	// network. Network is empty for entity changes.
+	Network Network
+}
+
+// IsNetworkChange returns true if the change is a change of a network rather
+// than of an entity
+func (change EntityChange) IsNetworkChange() bool {
+	return change.Type == NetworkCreated || change.Type == NetworkUpdated || change.Type == NetworkDeleted
+}
+
+// EntityWatchFilter selects the changes a watcher receives. Empty fields
+// match all changes. Network changes only match filters without TypeFilter
+// and KeyFilter.
+type EntityWatchFilter struct {
+	NetworkID  string
+	TypeFilter *string
+	KeyFilter  *string
+}
+
+func (filter EntityWatchFilter) matches(change EntityChange) bool {
+	if filter.NetworkID != "" && filter.NetworkID != change.NetworkID {
+		return false
+	}
+	if change.IsNetworkChange() {
+		return filter.TypeFilter == nil && filter.KeyFilter == nil
+	}
+	if filter.TypeFilter != nil && *filter.TypeFilter != change.Entity.Type {
+		return false
+	}
+	if filter.KeyFilter != nil && *filter.KeyFilter != change.Entity.Key {
+		return false
+	}
+	return true
+}
+
+// EntityWatcher publishes committed entity and network changes to subscribers
+type EntityWatcher interface {
+	// WatchEntities subscribes to the changes matching the filter & committed
+	// after the call by any writer of the storage. Changes of a network are
+	// received in commit order.
+	// The returned channel is closed when the returned cancel function is
+	// called or when the watch fails, e.g. because the subscriber fell behind
+	// the retained changes, in which case the subscriber should reload the
+	// entities it tracks & subscribe again.
+	WatchEntities(filter EntityWatchFilter) (<-chan EntityChange, func(), error)
+}
+
+// WatchableConfiguratorStorageFactory is a ConfiguratorStorageFactory whose
+// committed changes can be watched
+type WatchableConfiguratorStorageFactory interface {
+	ConfiguratorStorageFactory
+	EntityWatcher
+}
@@ -0,0 +1,255 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+package storage_test
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+	"time"
+
+	"magma/orc8r/cloud/go/services/configurator/storage"
+	"magma/orc8r/cloud/go/sql_utils"
+	storage2 "magma/orc8r/cloud/go/storage"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWatchableSQLConfiguratorStorage(t *testing.T) {
+	// Rely on the notifications of local commits only
+	storage.WatchPollInterval = time.Hour
+	defer func() { storage.WatchPollInterval = storage.DefaultWatchPollInterval }()
+
+	db := openWatchTestDB(t)
+	idGenerator := &mockIDGenerator{}
+	factory := storage.NewWatchableSQLConfiguratorStorageFactory(db, idGenerator, sql_utils.GetSqlBuilder(), 3)
+	assert.NoError(t, factory.InitializeServiceStorage())
+
+	fooType := "foo"
+	key1 := "1"
+	all := watch(t, factory, storage.EntityWatchFilter{})
+	n1Foo, cancelN1Foo, err := factory.WatchEntities(storage.EntityWatchFilter{NetworkID: "n1", TypeFilter: &fooType})
+	assert.NoError(t, err)
+	key1Changes := watch(t, factory, storage.EntityWatchFilter{KeyFilter: &key1})
+
+	store := startTx(t, factory)
+	_, err = store.CreateNetwork(storage.Network{ID: "n1", Name: "network 1"})
+	assert.NoError(t, err)
+	_, err = store.CreateNetwork(storage.Network{ID: "n2"})
+	assert.NoError(t, err)
+	_, err = store.CreateEntity("n1", storage.NetworkEntity{Type: "foo", Key: "1", Config: []byte("v1")})
+	assert.NoError(t, err)
+	_, err = store.CreateEntity("n1", storage.NetworkEntity{
+		Type: "bar", Key: "2", Associations: []storage2.TypeAndKey{{Type: "foo", Key: "1"}},
+	})
+	assert.NoError(t, err)
+	_, err = store.CreateEntity("n2", storage.NetworkEntity{Type: "foo", Key: "3"})
+	assert.NoError(t, err)
+	// Nothing is published before commit
+	assertNoChanges(t, all)
+	assert.NoError(t, store.Commit())
+
+	change := assertNetworkChange(t, all, storage.NetworkCreated, "n1", 0)
+	assert.Equal(t, "network 1", change.Network.Name)
+	assertChange(t, all, storage.EntityCreated, "n1", "foo", "1", 0)
+	assertChange(t, all, storage.EntityCreated, "n1", "bar", "2", 0)
+	assertNetworkChange(t, all, storage.NetworkCreated, "n2", 0)
+	assertChange(t, all, storage.EntityCreated, "n2", "foo", "3", 0)
+	change = assertChange(t, n1Foo, storage.EntityCreated, "n1", "foo", "1", 0)
+	assert.Equal(t, []byte("v1"), change.Entity.Config)
+	assertChange(t, key1Changes, storage.EntityCreated, "n1", "foo", "1", 0)
+	assertNoChanges(t, all)
+	assertNoChanges(t, n1Foo)
+	assertNoChanges(t, key1Changes)
+
+	// Rolled back and failed changes aren't published
+	store = startTx(t, factory)
+	_, err = store.CreateEntity("n1", storage.NetworkEntity{Type: "foo", Key: "4"})
+	assert.NoError(t, err)
+	assert.NoError(t, store.Rollback())
+	store = startTx(t, factory)
+	_, err = store.CreateEntity("n1", storage.NetworkEntity{Type: "foo", Key: "1"})
+	assert.Error(t, err)
+	assert.NoError(t, store.Commit())
+	assertNoChanges(t, all)
+
+	// Updates
+	newConfig := []byte("v2")
+	newName := "network 1 renamed"
+	store = startTx(t, factory)
+	_, err = store.UpdateEntity("n1", storage.EntityUpdateCriteria{Type: "foo", Key: "1", NewConfig: &newConfig})
+	assert.NoError(t, err)
+	assert.NoError(t, store.UpdateNetworks([]storage.NetworkUpdateCriteria{{ID: "n1", NewName: &newName}}))
+	assert.NoError(t, store.Commit())
+	change = assertChange(t, all, storage.EntityUpdated, "n1", "foo", "1", 1)
+	assert.Equal(t, newConfig, change.Entity.Config)
+	change = assertNetworkChange(t, all, storage.NetworkUpdated, "n1", 1)
+	assert.Equal(t, newName, change.Network.Name)
+	assertChange(t, n1Foo, storage.EntityUpdated, "n1", "foo", "1", 1)
+	assertChange(t, key1Changes, storage.EntityUpdated, "n1", "foo", "1", 1)
+
+	// Deletes
+	cancelN1Foo()
+	for range n1Foo {
+	}
+	store = startTx(t, factory)
+	_, err = store.UpdateEntity("n1", storage.EntityUpdateCriteria{Type: "foo", Key: "1", DeleteEntity: true})
+	assert.NoError(t, err)
+	assert.NoError(t, store.Commit())
+	assertChange(t, all, storage.EntityDeleted, "n1", "foo", "1", 1)
+	assertChange(t, key1Changes, storage.EntityDeleted, "n1", "foo", "1", 1)
+
+	store = startTx(t, factory)
+	assert.NoError(t, store.UpdateNetworks([]storage.NetworkUpdateCriteria{{ID: "n1", DeleteNetwork: true}}))
+	assert.NoError(t, store.Commit())
+	assertChange(t, all, storage.EntityDeleted, "n1", "bar", "2", 0)
+	assertNetworkChange(t, all, storage.NetworkDeleted, "n1", 1)
+	assertNoChanges(t, all)
+	assertNoChanges(t, key1Changes)
+
+	// Watchers falling behind the retained changes are closed. The watcher
+	// of another factory only polls when that factory commits.
+	otherFactory := storage.NewWatchableSQLConfiguratorStorageFactory(db, idGenerator, sql_utils.GetSqlBuilder(), 3)
+	slow, cancelSlow, err := otherFactory.WatchEntities(storage.EntityWatchFilter{NetworkID: "n2"})
+	assert.NoError(t, err)
+	defer cancelSlow()
+	time.Sleep(100 * time.Millisecond) // let the watcher finish its first poll
+	for i := 0; i < 4; i++ {
+		store = startTx(t, factory)
+		_, err = store.CreateEntity("n2", storage.NetworkEntity{Type: "baz", Key: fmt.Sprintf("%d", i)})
+		assert.NoError(t, err)
+		assert.NoError(t, store.Commit())
+		assertChange(t, all, storage.EntityCreated, "n2", "baz", fmt.Sprintf("%d", i), 0)
+	}
+	store = startTx(t, otherFactory)
+	_, err = store.CreateEntity("n2", storage.NetworkEntity{Type: "baz", Key: "4"})
+	assert.NoError(t, err)
+	assert.NoError(t, store.Commit())
+	assertClosed(t, slow)
+}
+
+func TestWatchableSQLConfiguratorStorage_Replicas(t *testing.T) {
+	storage.WatchPollInterval = 10 * time.Millisecond
+	defer func() { storage.WatchPollInterval = storage.DefaultWatchPollInterval }()
+
+	// Factories sharing a database, like configurator replicas
+	db := openWatchTestDB(t)
+	idGenerator := &mockIDGenerator{}
+	factory1 := storage.NewWatchableSQLConfiguratorStorageFactory(db, idGenerator, sql_utils.GetSqlBuilder(), 0)
+	assert.NoError(t, factory1.InitializeServiceStorage())
+	factory2 := storage.NewWatchableSQLConfiguratorStorageFactory(db, idGenerator, sql_utils.GetSqlBuilder(), 0)
+	assert.NoError(t, factory2.InitializeServiceStorage())
+
+	store := startTx(t, factory1)
+	_, err := store.CreateNetwork(storage.Network{ID: "n1"})
+	assert.NoError(t, err)
+	assert.NoError(t, store.Commit())
+
+	changes := watch(t, factory2, storage.EntityWatchFilter{NetworkID: "n1"})
+
+	// Changes committed through both factories are received in commit order
+	for i, factory := range []storage.ConfiguratorStorageFactory{factory1, factory2, factory1} {
+		store = startTx(t, factory)
+		_, err = store.CreateEntity("n1", storage.NetworkEntity{Type: "foo", Key: fmt.Sprintf("%d", i)})
+		assert.NoError(t, err)
+		assert.NoError(t, store.Commit())
+	}
+	newName := "renamed"
+	store = startTx(t, factory1)
+	assert.NoError(t, store.UpdateNetworks([]storage.NetworkUpdateCriteria{{ID: "n1", NewName: &newName}}))
+	assert.NoError(t, store.Commit())
+
+	for i := 0; i < 3; i++ {
+		assertChange(t, changes, storage.EntityCreated, "n1", "foo", fmt.Sprintf("%d", i), 0)
+	}
+	change := assertNetworkChange(t, changes, storage.NetworkUpdated, "n1", 1)
+	assert.Equal(t, newName, change.Network.Name)
+	assertNoChanges(t, changes)
+}
+
+func openWatchTestDB(t *testing.T) *sql.DB {
+	db, err := sql_utils.Open("sqlite3", ":memory:?_foreign_keys=1")
+	if err != nil {
+		t.Fatalf("Could not initialize sqlite DB: %s", err)
+	}
+	return db
+}
+
+func watch(t *testing.T, watcher storage.EntityWatcher, filter storage.EntityWatchFilter) <-chan storage.EntityChange {
+	changes, cancel, err := watcher.WatchEntities(filter)
+	assert.NoError(t, err)
+	t.Cleanup(cancel)
+	return changes
+}
+
+func startTx(t *testing.T, factory storage.ConfiguratorStorageFactory) storage.ConfiguratorStorage {
+	store, err := factory.StartTransaction(context.Background(), nil)
+	assert.NoError(t, err)
+	return store
+}
+
+func receiveChange(t *testing.T, changes <-chan storage.EntityChange) storage.EntityChange {
+	select {
+	case change, ok := <-changes:
+		assert.True(t, ok, "watcher closed")
+		return change
+	case <-time.After(5 * time.Second):
+		assert.Fail(t, "timed out waiting for a change")
+		return storage.EntityChange{}
+	}
+}
+
+func assertChange(
+	t *testing.T,
+	changes <-chan storage.EntityChange,
+	changeType storage.EntityChangeType,
+	networkID, entType, key string,
+	version uint64,
+) storage.EntityChange {
+	change := receiveChange(t, changes)
+	assert.Equal(t, changeType, change.Type)
+	assert.Equal(t, networkID, change.NetworkID)
+	assert.Equal(t, entType, change.Entity.Type)
+	assert.Equal(t, key, change.Entity.Key)
+	assert.Equal(t, version, change.Entity.Version)
+	return change
+}
+
+func assertNetworkChange(
+	t *testing.T,
+	changes <-chan storage.EntityChange,
+	changeType storage.EntityChangeType,
+	networkID string,
+	version uint64,
+) storage.EntityChange {
+	change := receiveChange(t, changes)
+	assert.Equal(t, changeType, change.Type)
+	assert.Equal(t, networkID, change.NetworkID)
+	assert.Equal(t, networkID, change.Network.ID)
+	assert.Equal(t, version, change.Network.Version)
+	return change
+}
+
+func assertNoChanges(t *testing.T, changes <-chan storage.EntityChange) {
+	select {
+	case change := <-changes:
+		assert.Fail(t, "unexpected entity change", "%+v", change)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func assertClosed(t *testing.T, changes <-chan storage.EntityChange) {
+	select {
+	case change, ok := <-changes:
+		assert.False(t, ok, "unexpected entity change %+v", change)
+	case <-time.After(5 * time.Second):
+		assert.Fail(t, "timed out waiting for the watcher to close")
+	}
+}
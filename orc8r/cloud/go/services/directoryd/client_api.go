@@ -40,6 +40,12 @@ func GetHostNameByIMSI(hwId string) (string, error) {
 	return getLocation(protos.TableID_HWID_TO_HOSTNAME, hwId)
 }
 
+// GetDispatcherByHwId returns the location record of the dispatcher replica
+// holding the SyncRPC stream of the gateway
+func GetDispatcherByHwId(hwId string) (string, error) {
+	return getLocation(protos.TableID_HWID_TO_DISPATCHER, hwId)
+}
+
 func getLocation(tableId protos.TableID, recordId string) (string, error) {
 	client, err := GetDirectorydClient()
 	if err != nil {
@@ -66,6 +72,10 @@ func UpdateHostNameByHwId(hwId string, hostName string) error {
 	return updateLocation(protos.TableID_HWID_TO_HOSTNAME, hwId, hostName)
 }
 
+func UpdateDispatcherByHwId(hwId string, dispatcher string) error {
+	return updateLocation(protos.TableID_HWID_TO_DISPATCHER, hwId, dispatcher)
+}
+
 func updateLocation(tableId protos.TableID, recordId string, location string) error {
 	client, err := GetDirectorydClient()
 	if err != nil {
@@ -90,6 +100,10 @@ func DeleteHostNameByIMSI(hwId string) error {
 	return deleteLocation(protos.TableID_HWID_TO_HOSTNAME, hwId)
 }
 
+func DeleteDispatcherByHwId(hwId string) error {
+	return deleteLocation(protos.TableID_HWID_TO_DISPATCHER, hwId)
+}
+
 func deleteLocation(tableId protos.TableID, recordId string) error {
 	client, err := GetDirectorydClient()
 	if err != nil {
@@ -67,4 +67,18 @@ func TestDirectorydControllerClientMethods(t *testing.T) {
 	// Delete unknown
 	err = directoryd.DeleteHostNameByIMSI(testSubId3)
 	assert.EqualError(t, err, "rpc error: code = Unknown desc = Error finding location record: No record for query")
+
+	// Dispatcher table is separate from the host name table
+	err = directoryd.UpdateDispatcherByHwId(testSubId2, "dispatcher1:9080")
+	assert.NoError(t, err)
+	record, err = directoryd.GetDispatcherByHwId(testSubId2)
+	assert.NoError(t, err)
+	assert.Equal(t, "dispatcher1:9080", record)
+	record, err = directoryd.GetHostNameByIMSI(testSubId2)
+	assert.NoError(t, err)
+	assert.Equal(t, testGwId2, record)
+	err = directoryd.DeleteDispatcherByHwId(testSubId2)
+	assert.NoError(t, err)
+	_, err = directoryd.GetDispatcherByHwId(testSubId2)
+	assert.EqualError(t, err, "rpc error: code = Unknown desc = Error getting location record: No record for query")
 }
@@ -50,14 +50,17 @@ func main() {
 	// get ec2 public host name
 	hostName := getHostName()
 	glog.V(2).Infof("hostName is: %v\n", hostName)
+	// the http server of this instance is recorded as the owner of the
+	// gateways connected to it, so other instances can forward to it
+	httpServerAddress := fmt.Sprintf("%s:%d", hostName, HTTP_SERVER_PORT)
 	// create servicer
-	syncRpcServicer, err := servicers.NewSyncRPCService(hostName, broker)
+	syncRpcServicer, err := servicers.NewSyncRPCService(hostName, httpServerAddress, broker)
 	if err != nil {
 		glog.Fatalf("SyncRPCService Initialization Error: %s", err)
 	}
 
 	// create http server
-	httpServer := httpserver.NewSyncRPCHttpServer(broker)
+	httpServer := httpserver.NewSyncRPCHttpServer(broker, httpServerAddress)
 
 	protos.RegisterSyncRPCServiceServer(srv.GrpcServer, syncRpcServicer)
 	srv.GrpcServer.RegisterService(protos.GetLegacyDispatcherDesc(), syncRpcServicer)
@@ -31,6 +31,7 @@ const (
 	GwS6aService      GwServiceType = "s6a_service"
 	GwSgsService      GwServiceType = "sgs_service"
 	GwSessiondService GwServiceType = "sessiond"
+	GwAAAService      GwServiceType = "aaa_server"
 
 	// SyncRPC gateway header key
 	GatewayIdHeaderKey = "Gatewayid"
@@ -54,6 +55,7 @@ var services = []GwServiceType{
 	GwS6aService,
 	GwSgsService,
 	GwSessiondService,
+	GwAAAService,
 }
 
 var config = httpServerConfig{HttpServerAddressPort, &sync.RWMutex{}}
@@ -71,7 +73,14 @@ func SetPort(port int) error {
 // SyncRPCHTTPServer instance, which is in the same process
 // of the Dispatcher grpc server who has an open bidirectional
 // stream with the gateway with hwId.
+//
+// The address is taken from the gateway's recorded owner, and falls back to
+// the host name last reported by a heartbeat for gateways connected to
+// dispatchers which don't record ownership.
 func GetServiceAddressForGateway(hwId string) (string, error) {
+	if owner, err := GetGatewayOwner(hwId); err == nil {
+		return owner.Address, nil
+	}
 	hostName, err := directoryd.GetHostNameByIMSI(hwId)
 	if err != nil {
 		fmt.Printf("err getting hostName in GetServiceAddressForGateway for hwId %v: %v\n", hwId, err)
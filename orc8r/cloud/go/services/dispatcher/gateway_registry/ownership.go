@@ -0,0 +1,88 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package gateway_registry
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"magma/orc8r/cloud/go/services/directoryd"
+
+	"github.com/google/uuid"
+)
+
+// OwnershipLease is how long a claim of a gateway is valid for. Owners renew
+// their claim on every SyncRPC heartbeat, sent every minute, so the lease
+// outlives a couple of missed heartbeats. The ownership of a replica which
+// dies without releasing its gateways expires after the lease.
+var OwnershipLease = 3 * time.Minute
+
+// GatewayOwner identifies the dispatcher replica holding the SyncRPC stream of
+// a gateway. Ownership is recorded in directoryd so that every dispatcher
+// replica and every service calling gateways can find the owner.
+type GatewayOwner struct {
+	// Address of the owning replica's SyncRPC HTTP server
+	Address string `json:"address"`
+	// StreamID tells apart the streams a gateway established with the same
+	// replica, so a closed stream can't release the ownership of a newer one
+	StreamID string `json:"stream_id"`
+	// ExpiresAt is the unix time in seconds at which the owner's lease ends,
+	// set when the gateway is claimed
+	ExpiresAt int64 `json:"expires_at"`
+}
+
+// NewGatewayOwner returns the owner of a new SyncRPC stream established with
+// the replica whose SyncRPC HTTP server is at the given address
+func NewGatewayOwner(address string) *GatewayOwner {
+	return &GatewayOwner{Address: address, StreamID: uuid.New().String()}
+}
+
+// ClaimGateway records the owner as the replica holding the gateway's stream
+// for the next OwnershipLease, overriding any previous owner
+func ClaimGateway(hwId string, owner *GatewayOwner) error {
+	record := *owner
+	record.ExpiresAt = time.Now().Add(OwnershipLease).Unix()
+	location, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	return directoryd.UpdateDispatcherByHwId(hwId, string(location))
+}
+
+// ReleaseGateway clears the ownership of the gateway if it's still held by
+// the owner. A gateway which reconnects concurrently may lose its new
+// ownership, which is claimed again on its next heartbeat.
+func ReleaseGateway(hwId string, owner *GatewayOwner) error {
+	currentOwner, err := GetGatewayOwner(hwId)
+	if err != nil {
+		return err
+	}
+	if currentOwner.Address != owner.Address || currentOwner.StreamID != owner.StreamID {
+		return nil
+	}
+	return directoryd.DeleteDispatcherByHwId(hwId)
+}
+
+// GetGatewayOwner returns the replica holding the gateway's stream. Expired
+// ownerships are returned as errors, like missing ones.
+func GetGatewayOwner(hwId string) (*GatewayOwner, error) {
+	location, err := directoryd.GetDispatcherByHwId(hwId)
+	if err != nil {
+		return nil, err
+	}
+	owner := &GatewayOwner{}
+	if err := json.Unmarshal([]byte(location), owner); err != nil {
+		return nil, fmt.Errorf("Invalid owner of gateway %s: %s", hwId, err)
+	}
+	if time.Now().Unix() >= owner.ExpiresAt {
+		return nil, fmt.Errorf("Ownership of gateway %s by %s expired", hwId, owner.Address)
+	}
+	return owner, nil
+}
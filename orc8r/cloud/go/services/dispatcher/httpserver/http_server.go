@@ -17,6 +17,11 @@ LICENSE file in the root directory of this source tree.
 // This httpServer converts httpRequest to GatewayRequest, send it over to grpc
 // servicer using GatewayRPCBroker, waits for a response, and converts the
 // GatewayResponse to a HttpResponse and send it back to the client.
+//
+// With several dispatcher replicas, a request can reach a httpServer whose
+// grpc servicer doesn't hold the gateway's stream. The request is then
+// forwarded once to the httpServer of the replica recorded as the gateway's
+// owner.
 package httpserver
 
 import (
@@ -42,17 +47,40 @@ import (
 const (
 	DefaultHttpResponseStatus = 200
 
+	// ForwardedHeaderKey marks requests forwarded by another replica, which
+	// are never forwarded again
+	ForwardedHeaderKey = "Magma-Dispatcher-Forwarded"
+
 	responseTimeoutSecs = 15
 	maxCancelAttempts   = 5
 )
 
+// hopByHopHeaders only apply to a single connection, so they aren't copied
+// between forwarded requests & responses (RFC 7230, section 6.1)
+var hopByHopHeaders = []string{
+	"Connection",
+	"Proxy-Connection",
+	"Keep-Alive",
+	"Proxy-Authenticate",
+	"Proxy-Authorization",
+	"Te",
+	"Trailer",
+	"Transfer-Encoding",
+	"Upgrade",
+}
+
 type SyncRPCHttpServer struct {
 	*http2.H2CServer
 	broker broker.GatewayRPCBroker
+	// address of this server, as recorded in the ownership of the gateways
+	// connected to this replica
+	address string
+	// client forwards requests to the replicas owning their gateway
+	client *http2.H2CClient
 }
 
-func NewSyncRPCHttpServer(broker broker.GatewayRPCBroker) *SyncRPCHttpServer {
-	return &SyncRPCHttpServer{http2.NewH2CServer(), broker}
+func NewSyncRPCHttpServer(broker broker.GatewayRPCBroker, address string) *SyncRPCHttpServer {
+	return &SyncRPCHttpServer{http2.NewH2CServer(), broker, address, http2.NewH2CClient()}
 }
 
 func (server *SyncRPCHttpServer) Run(addr string) {
@@ -65,6 +93,12 @@ func (server *SyncRPCHttpServer) Serve(listener net.Listener) {
 
 func (server *SyncRPCHttpServer) rootHandler(responseWriter http.ResponseWriter, req *http.Request) {
 	http2.LogRequestWithVerbosity(req, 4)
+	if ownerAddr, ok := server.getOwnerToForwardTo(req); ok {
+		server.forwardRequest(responseWriter, req, ownerAddr)
+		return
+	}
+	req.Header.Del(ForwardedHeaderKey)
+
 	respChan, err := server.sendRequest(req)
 	if err != nil {
 		glog.Errorf(err.Msg)
@@ -95,6 +129,120 @@ func (server *SyncRPCHttpServer) rootHandler(responseWriter http.ResponseWriter,
 	}
 }
 
+// getOwnerToForwardTo returns the address of the replica owning the
+// request's gateway if it's another replica. Requests are handled locally if
+// the owner is unknown.
+func (server *SyncRPCHttpServer) getOwnerToForwardTo(req *http.Request) (string, bool) {
+	gwId := req.Header.Get(gateway_registry.GatewayIdHeaderKey)
+	if len(gwId) == 0 || len(req.Header.Get(ForwardedHeaderKey)) != 0 {
+		return "", false
+	}
+	owner, err := gateway_registry.GetGatewayOwner(gwId)
+	if err != nil {
+		glog.V(2).Infof("No owner found for hwId %v, handling request locally: %v\n", gwId, err)
+		return "", false
+	}
+	if len(owner.Address) == 0 || owner.Address == server.address {
+		return "", false
+	}
+	return owner.Address, true
+}
+
+// forwardRequest proxies the request to the httpServer of the replica at
+// ownerAddr and streams its response, trailers included, back to the client.
+func (server *SyncRPCHttpServer) forwardRequest(responseWriter http.ResponseWriter, req *http.Request, ownerAddr string) {
+	glog.V(2).Infof("Forwarding request %v to owner %v\n", req.URL.Path, ownerAddr)
+	fwdURL := url.URL{Scheme: "http", Host: ownerAddr, Path: req.URL.Path, RawQuery: req.URL.RawQuery}
+	fwdReq, err := http.NewRequest(req.Method, fwdURL.String(), req.Body)
+	if err != nil {
+		errMsg := fmt.Sprintf("err creating request to forward to %v: %v", ownerAddr, err)
+		http2.WriteErrResponse(responseWriter, http2.NewHTTPGrpcError(errMsg, int(codes.Internal), http.StatusInternalServerError))
+		return
+	}
+	fwdReq = fwdReq.WithContext(req.Context())
+	// The authority identifies the gateway service to call
+	fwdReq.Host = req.Host
+	copyEndToEndHeaders(fwdReq.Header, req.Header)
+	// gRPC requires its clients to accept trailers
+	if headerContainsToken(req.Header, "Te", "trailers") {
+		fwdReq.Header.Set("Te", "trailers")
+	}
+	fwdReq.Header.Set(ForwardedHeaderKey, server.address)
+
+	resp, err := server.client.Do(fwdReq)
+	if err != nil {
+		errMsg := fmt.Sprintf("err forwarding request to %v: %v", ownerAddr, err)
+		glog.Error(errMsg)
+		http2.WriteErrResponse(responseWriter, http2.NewHTTPGrpcError(errMsg, int(codes.Unavailable), http.StatusServiceUnavailable))
+		return
+	}
+	defer resp.Body.Close()
+
+	// Trailers are set once the body is copied
+	copyEndToEndHeaders(responseWriter.Header(), resp.Header)
+	responseWriter.WriteHeader(resp.StatusCode)
+	if _, err := io.Copy(flushWriter{responseWriter}, resp.Body); err != nil {
+		glog.Errorf("err copying forwarded response from %v: %v\n", ownerAddr, err)
+		return
+	}
+	for k, vals := range resp.Trailer {
+		for _, val := range vals {
+			responseWriter.Header().Add(http.TrailerPrefix+k, val)
+		}
+	}
+}
+
+// copyEndToEndHeaders adds the headers of src to dst, except for the
+// hop-by-hop headers and the headers listed in src's Connection header
+func copyEndToEndHeaders(dst http.Header, src http.Header) {
+	skipped := map[string]bool{}
+	for _, k := range hopByHopHeaders {
+		skipped[k] = true
+	}
+	for _, v := range src["Connection"] {
+		for _, k := range strings.Split(v, ",") {
+			if k = strings.TrimSpace(k); len(k) != 0 {
+				skipped[http.CanonicalHeaderKey(k)] = true
+			}
+		}
+	}
+	for k, vals := range src {
+		if skipped[http.CanonicalHeaderKey(k)] {
+			continue
+		}
+		for _, val := range vals {
+			dst.Add(k, val)
+		}
+	}
+}
+
+// headerContainsToken returns whether the comma separated values of the
+// header contain the token, case insensitively
+func headerContainsToken(header http.Header, key string, token string) bool {
+	for _, v := range header[http.CanonicalHeaderKey(key)] {
+		for _, t := range strings.Split(v, ",") {
+			if strings.EqualFold(strings.TrimSpace(t), token) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// flushWriter flushes every write so forwarded responses are streamed. Writes
+// are only buffered by response writers which can't be flushed.
+type flushWriter struct {
+	http.ResponseWriter
+}
+
+func (w flushWriter) Write(p []byte) (int, error) {
+	n, err := w.ResponseWriter.Write(p)
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+	return n, err
+}
+
 // sendRequest sends a SyncRPCRequest to the gateway and creates
 // a goroutine to notify the gateway when the context is done.
 func (server *SyncRPCHttpServer) sendRequest(req *http.Request) (chan *protos.GatewayResponse, *http2.HTTPGrpcError) {
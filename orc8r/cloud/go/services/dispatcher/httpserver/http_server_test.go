@@ -8,6 +8,105 @@ LICENSE file in the root directory of this source tree.
 
 package httpserver_test
 
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"magma/orc8r/cloud/go/http2"
+	"magma/orc8r/cloud/go/protos"
+	directoryd_test_init "magma/orc8r/cloud/go/services/directoryd/test_init"
+	"magma/orc8r/cloud/go/services/dispatcher/broker"
+	"magma/orc8r/cloud/go/services/dispatcher/gateway_registry"
+	"magma/orc8r/cloud/go/services/dispatcher/httpserver"
+	"magma/orc8r/cloud/go/services/dispatcher/test_init"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSyncRPCHttpServerForwarding(t *testing.T) {
+	directoryd_test_init.StartTestService(t)
+	addrA, brokerA := test_init.StartTestHttpServer(t)
+	addrB, brokerB := test_init.StartTestHttpServer(t)
+
+	// The gateway is connected to replica B
+	err := gateway_registry.ClaimGateway("gw1", &gateway_registry.GatewayOwner{Address: addrB.String(), StreamID: "stream1"})
+	assert.NoError(t, err)
+	respChan := make(chan *protos.GatewayResponse, 2)
+	respChan <- &protos.GatewayResponse{
+		Status:  "200",
+		Headers: map[string]string{"content-type": "application/grpc"},
+		Payload: []byte("test payload"),
+	}
+	respChan <- &protos.GatewayResponse{Status: "200", Headers: map[string]string{"grpc-status": "0"}}
+	isForwardedRequest := func(req *protos.GatewayRequest) bool {
+		_, forwardedHeader := req.Headers[httpserver.ForwardedHeaderKey]
+		// hop-by-hop headers aren't forwarded, apart from gRPC's TE: trailers
+		_, proxyAuthHeader := req.Headers["Proxy-Authorization"]
+		return req.GwId == "gw1" && req.Authority == "magmad" && req.Path == "/magma.Test/Call" &&
+			string(req.Payload) == "test request" && !forwardedHeader && !proxyAuthHeader &&
+			req.Headers["Te"] == "trailers" && req.Headers["Test-Header"] == "test value"
+	}
+	brokerB.On("SendRequestToGateway", mock.MatchedBy(isForwardedRequest)).
+		Return(&broker.GatewayResponseChannel{RespChan: respChan, ReqId: 1}, nil)
+	brokerB.On("CancelGatewayRequest", "gw1", uint32(1)).Return(nil).Maybe()
+
+	// Requests reaching replica A are forwarded to B
+	resp, body := sendRequest(t, addrA.String(), "gw1", false)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "test payload", body)
+	assert.Equal(t, "0", resp.Trailer.Get("Grpc-Status"))
+	brokerA.AssertNotCalled(t, "SendRequestToGateway", mock.Anything)
+	brokerB.AssertNumberOfCalls(t, "SendRequestToGateway", 1)
+
+	// Forwarded requests are handled locally, whatever the owner
+	brokerA.On("SendRequestToGateway", mock.Anything).Return(nil, errors.New("gateway not connected"))
+	resp, _ = sendRequest(t, addrA.String(), "gw1", true)
+	assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+	brokerA.AssertNumberOfCalls(t, "SendRequestToGateway", 1)
+	brokerB.AssertNumberOfCalls(t, "SendRequestToGateway", 1)
+
+	// Requests of gateways without an owner are handled locally
+	resp, _ = sendRequest(t, addrA.String(), "gw2", false)
+	assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+	brokerA.AssertNumberOfCalls(t, "SendRequestToGateway", 2)
+
+	// Requests of gateways whose owner's lease expired, e.g. as the owner
+	// died, are handled locally
+	defer func(lease time.Duration) { gateway_registry.OwnershipLease = lease }(gateway_registry.OwnershipLease)
+	gateway_registry.OwnershipLease = 0
+	err = gateway_registry.ClaimGateway("gw3", &gateway_registry.GatewayOwner{Address: addrB.String(), StreamID: "stream3"})
+	assert.NoError(t, err)
+	resp, _ = sendRequest(t, addrA.String(), "gw3", false)
+	assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+	brokerA.AssertNumberOfCalls(t, "SendRequestToGateway", 3)
+	brokerB.AssertNumberOfCalls(t, "SendRequestToGateway", 1)
+}
+
+func sendRequest(t *testing.T, addr string, gwId string, forwarded bool) (*http.Response, string) {
+	req, err := http.NewRequest("POST", fmt.Sprintf("http://%s/magma.Test/Call", addr), strings.NewReader("test request"))
+	assert.NoError(t, err)
+	req.Host = "magmad"
+	req.Header.Set(gateway_registry.GatewayIdHeaderKey, gwId)
+	req.Header.Set("Te", "trailers")
+	req.Header.Set("Proxy-Authorization", "Basic dGVzdA==")
+	req.Header.Set("Test-Header", "test value")
+	if forwarded {
+		req.Header.Set(httpserver.ForwardedHeaderKey, "other replica")
+	}
+	resp, err := http2.NewH2CClient().Do(req)
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	assert.NoError(t, err)
+	return resp, string(body)
+}
+
 // Everything commented out until we can write some tests that don't depend
 // on mobilityd, which is an lte service
 
@@ -18,6 +18,7 @@ import (
 	"magma/orc8r/cloud/go/protos"
 	"magma/orc8r/cloud/go/services/directoryd"
 	"magma/orc8r/cloud/go/services/dispatcher/broker"
+	"magma/orc8r/cloud/go/services/dispatcher/gateway_registry"
 
 	"github.com/golang/glog"
 	"golang.org/x/net/context"
@@ -31,11 +32,14 @@ const heartBeatInterval = time.Minute
 type SyncRPCService struct {
 	// hostName is the host at which this service instance is running on
 	hostName string
-	broker   broker.GatewayRPCBroker
+	// httpServerAddress is the address of the SyncRPC HTTP server running
+	// with this service instance, recorded as the owner of its gateways
+	httpServerAddress string
+	broker            broker.GatewayRPCBroker
 }
 
-func NewSyncRPCService(hostName string, broker broker.GatewayRPCBroker) (*SyncRPCService, error) {
-	return &SyncRPCService{hostName: hostName, broker: broker}, nil
+func NewSyncRPCService(hostName string, httpServerAddress string, broker broker.GatewayRPCBroker) (*SyncRPCService, error) {
+	return &SyncRPCService{hostName: hostName, httpServerAddress: httpServerAddress, broker: broker}, nil
 }
 
 // SyncRPC exists for backwards compatibility.
@@ -65,17 +69,18 @@ func (srv *SyncRPCService) EstablishSyncRPCStream(stream protos.SyncRPCService_E
 // streamCoordinator manages a SyncRPC bidirectional stream.
 type streamCoordinator struct {
 	GwID    string
+	Owner   *gateway_registry.GatewayOwner
 	ErrChan chan error
 	Wg      *sync.WaitGroup
 	Ctx     context.Context
 	Cancel  context.CancelFunc
 }
 
-func newStreamCoordinator(gwId string, streamCtx context.Context) *streamCoordinator {
+func newStreamCoordinator(gwId string, owner *gateway_registry.GatewayOwner, streamCtx context.Context) *streamCoordinator {
 	errChan := make(chan error, 1)
 	wg := &sync.WaitGroup{}
 	ctx, cancel := context.WithCancel(streamCtx)
-	return &streamCoordinator{gwId, errChan, wg, ctx, cancel}
+	return &streamCoordinator{gwId, owner, errChan, wg, ctx, cancel}
 }
 
 // serveGwId handles the SyncRPC bidirectional stream for a particular gateway.
@@ -83,9 +88,15 @@ func newStreamCoordinator(gwId string, streamCtx context.Context) *streamCoordin
 //
 // It is called directly by the test service.
 func (srv *SyncRPCService) serveGwId(stream protos.SyncRPCService_EstablishSyncRPCStreamServer, gwId string) error {
-	coordinator := newStreamCoordinator(gwId, stream.Context())
+	coordinator := newStreamCoordinator(gwId, gateway_registry.NewGatewayOwner(srv.httpServerAddress), stream.Context())
 	queue := srv.broker.InitializeGateway(gwId)
 	glog.V(2).Infof("Initialized gateway for hwId %v\n", gwId)
+	// Route the gateway's requests to this instance from now on, even if its
+	// previous stream to another instance isn't closed yet
+	if err := gateway_registry.ClaimGateway(gwId, coordinator.Owner); err != nil {
+		srv.broker.CleanupGateway(gwId)
+		return status.Errorf(codes.Unavailable, "Failed to claim gateway %v: %v", gwId, err)
+	}
 	coordinator.Wg.Add(1)
 	go srv.receiveFromStream(stream, coordinator)
 	coordinator.Wg.Add(1)
@@ -101,6 +112,9 @@ func (srv *SyncRPCService) serveGwId(stream protos.SyncRPCService_EstablishSyncR
 	coordinator.Cancel()
 	coordinator.Wg.Wait()
 	srv.broker.CleanupGateway(gwId)
+	if releaseErr := gateway_registry.ReleaseGateway(gwId, coordinator.Owner); releaseErr != nil {
+		glog.Errorf("Failed to release gateway %v: %v\n", gwId, releaseErr)
+	}
 	glog.V(2).Infof("Cleaned up gateway for hwId %v\n", gwId)
 	return err
 }
@@ -161,7 +175,7 @@ func (srv *SyncRPCService) receiveFromStream(
 			return
 		} else {
 			glog.V(2).Infof("processing response for hwId %v\n", coordinator.GwID)
-			err := srv.processSyncRPCResp(syncRPCResp, coordinator.GwID)
+			err := srv.processSyncRPCResp(syncRPCResp, coordinator.GwID, coordinator.Owner)
 			if err != nil {
 				coordinator.sendErrOrLog(fmt.Errorf("procesSyncRPCResp err: %v\n", err))
 				return
@@ -174,7 +188,7 @@ func (srv *SyncRPCService) receiveFromStream(
 // heartbeat or call upon the broker to send the response to the HTTP server.
 //
 // Returning err indicates to end the bidirectional stream.
-func (srv *SyncRPCService) processSyncRPCResp(resp *protos.SyncRPCResponse, hwId string) error {
+func (srv *SyncRPCService) processSyncRPCResp(resp *protos.SyncRPCResponse, hwId string, owner *gateway_registry.GatewayOwner) error {
 	if resp.HeartBeat {
 		err := directoryd.UpdateHostNameByHwId(hwId, srv.hostName)
 		if err != nil {
@@ -182,6 +196,11 @@ func (srv *SyncRPCService) processSyncRPCResp(resp *protos.SyncRPCResponse, hwId
 			// gateway use the stream, therefore return err to end the stream.
 			return err
 		}
+		// Claim the gateway again in case a closing stream released it
+		err = gateway_registry.ClaimGateway(hwId, owner)
+		if err != nil {
+			return err
+		}
 	} else if resp.ReqId > 0 {
 		err := srv.broker.ProcessGatewayResponse(resp)
 		if err != nil {
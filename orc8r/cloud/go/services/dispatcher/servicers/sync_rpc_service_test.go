@@ -18,6 +18,7 @@ import (
 	"magma/orc8r/cloud/go/registry"
 	directoryd_test_init "magma/orc8r/cloud/go/services/directoryd/test_init"
 	"magma/orc8r/cloud/go/services/dispatcher"
+	"magma/orc8r/cloud/go/services/dispatcher/gateway_registry"
 	dispatcher_test_init "magma/orc8r/cloud/go/services/dispatcher/test_init"
 	"magma/orc8r/cloud/go/services/magmad"
 	magmad_protos "magma/orc8r/cloud/go/services/magmad/protos"
@@ -104,3 +105,70 @@ func TestSyncRPC(t *testing.T) {
 	mockBroker.AssertNumberOfCalls(t, "ProcessGatewayResponse", 2)
 	mockBroker.AssertExpectations(t)
 }
+
+func TestSyncRPCOwnership(t *testing.T) {
+	directoryd_test_init.StartTestService(t)
+	getOwnerAddress := func() string {
+		owner, err := gateway_registry.GetGatewayOwner(TestSyncRPCAgHwId)
+		if err != nil {
+			return ""
+		}
+		return owner.Address
+	}
+	waitForOwnerAddress := func(expected string) {
+		for i := 0; i < 50 && getOwnerAddress() != expected; i++ {
+			time.Sleep(100 * time.Millisecond)
+		}
+		assert.Equal(t, expected, getOwnerAddress())
+	}
+
+	// The gateway connects to replica A
+	brokerA := dispatcher_test_init.StartTestReplica(t, "replicaA:9080")
+	brokerA.On("InitializeGateway", TestSyncRPCAgHwId).Return(make(chan *protos.SyncRPCRequest, 10))
+	brokerA.On("CleanupGateway", TestSyncRPCAgHwId).Return(nil)
+	streamA := establishStream(t)
+	waitForOwnerAddress("replicaA:9080")
+	addr, err := gateway_registry.GetServiceAddressForGateway(TestSyncRPCAgHwId)
+	assert.NoError(t, err)
+	assert.Equal(t, "replicaA:9080", addr)
+
+	// The gateway reconnects to replica B before its stream to A is closed
+	brokerB := dispatcher_test_init.StartTestReplica(t, "replicaB:9080")
+	brokerB.On("InitializeGateway", TestSyncRPCAgHwId).Return(make(chan *protos.SyncRPCRequest, 10))
+	brokerB.On("CleanupGateway", TestSyncRPCAgHwId).Return(nil)
+	streamB := establishStream(t)
+	waitForOwnerAddress("replicaB:9080")
+
+	// Closing the stale stream to A doesn't release B's ownership
+	closeStream(t, streamA)
+	time.Sleep(time.Second)
+	brokerA.AssertCalled(t, "CleanupGateway", TestSyncRPCAgHwId)
+	assert.Equal(t, "replicaB:9080", getOwnerAddress())
+	addr, err = gateway_registry.GetServiceAddressForGateway(TestSyncRPCAgHwId)
+	assert.NoError(t, err)
+	assert.Equal(t, "replicaB:9080", addr)
+
+	// Heartbeats refresh the ownership
+	assert.NoError(t, gateway_registry.ClaimGateway(TestSyncRPCAgHwId, &gateway_registry.GatewayOwner{Address: "replicaA:9080"}))
+	assert.NoError(t, streamB.Send(&protos.SyncRPCResponse{HeartBeat: true}))
+	waitForOwnerAddress("replicaB:9080")
+
+	// Closing the owning stream releases the gateway
+	closeStream(t, streamB)
+	waitForOwnerAddress("")
+	brokerB.AssertCalled(t, "CleanupGateway", TestSyncRPCAgHwId)
+}
+
+func establishStream(t *testing.T) protos.SyncRPCService_EstablishSyncRPCStreamClient {
+	conn, err := registry.GetConnection(dispatcher.ServiceName)
+	assert.NoError(t, err)
+	stream, err := protos.NewSyncRPCServiceClient(conn).EstablishSyncRPCStream(context.Background())
+	assert.NoError(t, err)
+	return stream
+}
+
+func closeStream(t *testing.T, stream protos.SyncRPCService_EstablishSyncRPCStreamClient) {
+	assert.NoError(t, stream.CloseSend())
+	_, err := stream.Recv()
+	assert.Equal(t, io.EOF, err)
+}
@@ -29,6 +29,6 @@ func (srv *testSyncRPCServer) EstablishSyncRPCStream(stream protos.SyncRPCServic
 	return srv.SyncRPCService.EstablishSyncRPCStream(stream)
 }
 
-func NewTestSyncRPCServer(hostName string, broker broker.GatewayRPCBroker) (*testSyncRPCServer, error) {
-	return &testSyncRPCServer{SyncRPCService{hostName, broker}}, nil
+func NewTestSyncRPCServer(hostName string, httpServerAddress string, broker broker.GatewayRPCBroker) (*testSyncRPCServer, error) {
+	return &testSyncRPCServer{SyncRPCService{hostName, httpServerAddress, broker}}, nil
 }
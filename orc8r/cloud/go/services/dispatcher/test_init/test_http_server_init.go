@@ -23,7 +23,7 @@ func StartTestHttpServer(t *testing.T) (net.Addr, *mocks.GatewayRPCBroker) {
 	}
 
 	broker := new(mocks.GatewayRPCBroker)
-	server := httpserver.NewSyncRPCHttpServer(broker)
+	server := httpserver.NewSyncRPCHttpServer(broker, lis.Addr().String())
 	go server.Serve(lis)
 	return lis.Addr(), broker
 }
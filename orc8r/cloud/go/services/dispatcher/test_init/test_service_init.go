@@ -20,9 +20,16 @@ import (
 )
 
 func StartTestService(t *testing.T) *mocks.GatewayRPCBroker {
+	return StartTestReplica(t, "test host name:9080")
+}
+
+// StartTestReplica starts a dispatcher instance which records its SyncRPC
+// HTTP server at httpServerAddress as the owner of its gateways. Starting
+// another replica routes the following dispatcher connections to it.
+func StartTestReplica(t *testing.T, httpServerAddress string) *mocks.GatewayRPCBroker {
 	srv, lis := test_utils.NewTestService(t, orc8r.ModuleName, dispatcher.ServiceName)
 	mockBroker := new(mocks.GatewayRPCBroker)
-	servicer, err := servicers.NewTestSyncRPCServer("test host name", mockBroker)
+	servicer, err := servicers.NewTestSyncRPCServer("test host name", httpServerAddress, mockBroker)
 	if err != nil {
 		t.Fatalf("Failed to create syncRPCService servicer: %s", err)
 	}
@@ -36,9 +36,9 @@ func TestLoggingServiceClientMethods(t *testing.T) {
 	assert.NoError(t, err)
 	mockExporter.AssertNotCalled(t, "Submit", entries)
 
-	err = logger.LogEntriesToDest(entries, 1, 1)
+	err = logger.LogEntriesToDest(entries, 42, 1)
 	assert.Error(t, err)
-	assert.EqualError(t, err, "rpc error: code = Unknown desc = LoggerDestination 1 not supported")
+	assert.EqualError(t, err, "rpc error: code = Unknown desc = LoggerDestination 42 not supported")
 	mockExporter.AssertNotCalled(t, "Submit", entries)
 
 	mockExporter.On("Submit", mock.MatchedBy(logEntriesMatcher(matchEntries))).Return(nil)
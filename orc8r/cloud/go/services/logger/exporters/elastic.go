@@ -0,0 +1,367 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package exporters
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+
+	"magma/orc8r/cloud/go/protos"
+
+	"github.com/golang/glog"
+)
+
+// ElasticFormat is the wire format the ElasticExporter ships log documents in
+type ElasticFormat int
+
+const (
+	// ElasticBulkFormat is the Elasticsearch bulk API format: an index action line before every document
+	ElasticBulkFormat ElasticFormat = iota
+	// NDJSONFormat is one JSON document per line, as accepted by Fluentd & Fluent Bit HTTP inputs
+	NDJSONFormat
+)
+
+const (
+	DefaultElasticQueueLength    = 100000
+	DefaultElasticBatchSize      = 1000
+	DefaultElasticExportInterval = time.Second * 10
+	DefaultElasticMaxRetries     = 3
+	DefaultElasticRetryBackoff   = time.Second
+	DefaultElasticRequestTimeout = time.Second * 30
+)
+
+// ParseElasticFormat returns the ElasticFormat of the given name ("bulk" or "ndjson")
+func ParseElasticFormat(name string) (ElasticFormat, error) {
+	switch name {
+	case "", "bulk":
+		return ElasticBulkFormat, nil
+	case "ndjson":
+		return NDJSONFormat, nil
+	}
+	return ElasticBulkFormat, fmt.Errorf("Unknown elastic export format: %s", name)
+}
+
+// HttpRequestClient sends HTTP requests, it is implemented by *http.Client
+type HttpRequestClient interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// ElasticExporterConfig configures an ElasticExporter, zero values are replaced with defaults
+type ElasticExporterConfig struct {
+	URL            string // bulk endpoint (e.g. http://es:9200/_bulk) or NDJSON HTTP input URL
+	Index          string // index of the bulk index actions, may be empty if it's part of the URL
+	Format         ElasticFormat
+	Username       string // optional basic auth credentials
+	Password       string
+	QueueLength    int // max number of buffered documents, Submit fails once it is reached
+	BatchSize      int // max number of documents per request
+	ExportInterval time.Duration
+	MaxRetries     int           // number of retries of a failed batch per export, negative disables retries
+	RetryBackoff   time.Duration // delay before the first retry, doubled for every further retry
+	RequestTimeout time.Duration // timeout of export requests sent by the default client
+}
+
+// ElasticLogDocument is the JSON document exported for every LogEntry
+type ElasticLogDocument struct {
+	Timestamp string            `json:"@timestamp"`
+	Category  string            `json:"category"`
+	HwId      string            `json:"hw_id,omitempty"`
+	NetworkId string            `json:"network_id,omitempty"`
+	GatewayId string            `json:"gateway_id,omitempty"`
+	Int       map[string]int64  `json:"int,omitempty"`
+	Normal    map[string]string `json:"normal,omitempty"`
+	TagSet    []string          `json:"tagset,omitempty"`
+	NormVec   []string          `json:"normvector,omitempty"`
+}
+
+// ElasticExporter batches log entries & exports them as NDJSON to an
+// Elasticsearch bulk endpoint or an NDJSON HTTP input. Entries are buffered in
+// a bounded queue, once it's full Submit returns an error instead of dropping
+// already buffered entries.
+type ElasticExporter struct {
+	cfg        ElasticExporterConfig
+	client     HttpRequestClient
+	queue      [][]byte // JSON encoded ElasticLogDocuments
+	queueMutex sync.Mutex
+	exportLock sync.Mutex    // serializes exports
+	flush      chan struct{} // signals a full batch is queued
+}
+
+// NewElasticExporter creates a new exporter sending requests with the given client,
+// an http.Client with the config's request timeout is used if client is nil
+func NewElasticExporter(cfg ElasticExporterConfig, client HttpRequestClient) *ElasticExporter {
+	if cfg.QueueLength <= 0 {
+		cfg.QueueLength = DefaultElasticQueueLength
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = DefaultElasticBatchSize
+	}
+	if cfg.ExportInterval <= 0 {
+		cfg.ExportInterval = DefaultElasticExportInterval
+	}
+	if cfg.MaxRetries == 0 {
+		cfg.MaxRetries = DefaultElasticMaxRetries
+	}
+	if cfg.RetryBackoff <= 0 {
+		cfg.RetryBackoff = DefaultElasticRetryBackoff
+	}
+	if cfg.RequestTimeout <= 0 {
+		cfg.RequestTimeout = DefaultElasticRequestTimeout
+	}
+	if client == nil {
+		client = &http.Client{Timeout: cfg.RequestTimeout}
+	}
+	return &ElasticExporter{cfg: cfg, client: client, flush: make(chan struct{}, 1)}
+}
+
+// Start exports queued entries every export interval or as soon as a full batch is queued
+func (e *ElasticExporter) Start() {
+	go e.exportLoop()
+}
+
+func (e *ElasticExporter) exportLoop() {
+	ticker := time.NewTicker(e.cfg.ExportInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+		case <-e.flush:
+		}
+		err := e.Export()
+		if err != nil {
+			glog.Errorf("Error in exporting to elastic: %v\n", err)
+		}
+	}
+}
+
+// Submit converts & queues the given entries for export. It fails without
+// queuing any of them if they don't fit into the queue.
+func (e *ElasticExporter) Submit(logEntries []*protos.LogEntry) error {
+	docs, err := ConvertToElasticLogDocuments(logEntries)
+	if err != nil {
+		return err
+	}
+	encoded := make([][]byte, 0, len(docs))
+	for _, doc := range docs {
+		docJson, err := json.Marshal(doc)
+		if err != nil {
+			glog.Errorf("Error formatting document %v in elasticExporter: %v\n", doc, err)
+			continue
+		}
+		encoded = append(encoded, docJson)
+	}
+	e.queueMutex.Lock()
+	if len(e.queue)+len(encoded) > e.cfg.QueueLength {
+		e.queueMutex.Unlock()
+		return fmt.Errorf(
+			"elastic export queue is full (%d of %d), rejecting %d logEntries",
+			len(e.queue), e.cfg.QueueLength, len(encoded))
+	}
+	e.queue = append(e.queue, encoded...)
+	full := len(e.queue) >= e.cfg.BatchSize
+	e.queueMutex.Unlock()
+	if full {
+		select {
+		case e.flush <- struct{}{}:
+		default: // flush is already pending
+		}
+	}
+	return nil
+}
+
+// Export sends all queued documents in batches. A batch failing after all
+// retries is put back at the head of the queue and the export is stopped.
+func (e *ElasticExporter) Export() error {
+	e.exportLock.Lock()
+	defer e.exportLock.Unlock()
+	for {
+		e.queueMutex.Lock()
+		n := len(e.queue)
+		if n > e.cfg.BatchSize {
+			n = e.cfg.BatchSize
+		}
+		batch := e.queue[:n:n]
+		e.queue = e.queue[n:]
+		e.queueMutex.Unlock()
+		if len(batch) == 0 {
+			return nil
+		}
+		pending, err := e.writeWithRetries(batch)
+		if len(pending) > 0 {
+			e.requeue(pending)
+			return fmt.Errorf("Failed to export %d documents to elastic: %v", len(pending), err)
+		}
+		if err != nil {
+			glog.Errorf("Dropped documents rejected by elastic: %v", err)
+		}
+	}
+}
+
+func (e *ElasticExporter) writeWithRetries(batch [][]byte) ([][]byte, error) {
+	pending, err := e.write(batch)
+	backoff := e.cfg.RetryBackoff
+	for retry := 0; retry < e.cfg.MaxRetries && len(pending) > 0; retry++ {
+		glog.V(1).Infof("Retrying export of %d documents to elastic in %v: %v", len(pending), backoff, err)
+		time.Sleep(backoff)
+		backoff *= 2
+		pending, err = e.write(pending)
+	}
+	return pending, err
+}
+
+// requeue puts the given documents back at the head of the queue, dropping the
+// oldest ones if entries submitted in the meantime don't leave enough room
+func (e *ElasticExporter) requeue(docs [][]byte) {
+	e.queueMutex.Lock()
+	defer e.queueMutex.Unlock()
+	if overflow := len(docs) + len(e.queue) - e.cfg.QueueLength; overflow > 0 {
+		glog.Warningf("Elastic export queue is full, dropping %d documents", overflow)
+		docs = docs[overflow:]
+	}
+	e.queue = append(docs, e.queue...)
+}
+
+// write sends the documents in a single request and returns the documents which
+// should be retried. Documents rejected for non transient reasons are dropped
+// and reported by the returned error.
+func (e *ElasticExporter) write(docs [][]byte) ([][]byte, error) {
+	req, err := http.NewRequest(http.MethodPost, e.cfg.URL, bytes.NewReader(e.encodeBody(docs)))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-ndjson")
+	if len(e.cfg.Username) > 0 {
+		req.SetBasicAuth(e.cfg.Username, e.cfg.Password)
+	}
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return docs, err
+	}
+	body, _ := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		err = fmt.Errorf("Elastic status code %d: %s", resp.StatusCode, body)
+		if isRetryableStatus(resp.StatusCode) {
+			return docs, err
+		}
+		return nil, err
+	}
+	if e.cfg.Format != ElasticBulkFormat {
+		return nil, nil
+	}
+	return getRetryableBulkItems(docs, body)
+}
+
+func (e *ElasticExporter) encodeBody(docs [][]byte) []byte {
+	var action []byte
+	if e.cfg.Format == ElasticBulkFormat {
+		if len(e.cfg.Index) > 0 {
+			action, _ = json.Marshal(map[string]interface{}{"index": map[string]string{"_index": e.cfg.Index}})
+		} else {
+			action = []byte(`{"index":{}}`)
+		}
+	}
+	var buf bytes.Buffer
+	for _, doc := range docs {
+		if action != nil {
+			buf.Write(action)
+			buf.WriteByte('\n')
+		}
+		buf.Write(doc)
+		buf.WriteByte('\n')
+	}
+	return buf.Bytes()
+}
+
+// bulkResponse is the part of the Elasticsearch bulk API response needed to find failed items
+type bulkResponse struct {
+	Errors bool                        `json:"errors"`
+	Items  []map[string]bulkItemResult `json:"items"`
+}
+
+type bulkItemResult struct {
+	Status int             `json:"status"`
+	Error  json.RawMessage `json:"error,omitempty"`
+}
+
+// getRetryableBulkItems returns the documents of a bulk request which failed with
+// a transient error, other failed documents are reported in the returned error
+func getRetryableBulkItems(docs [][]byte, body []byte) ([][]byte, error) {
+	resp := bulkResponse{}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		// the documents were accepted, the response is only needed to find partial failures
+		return nil, fmt.Errorf("Failed to parse elastic bulk response: %v", err)
+	}
+	if !resp.Errors {
+		return nil, nil
+	}
+	var (
+		retry    [][]byte
+		rejected int
+		lastErr  json.RawMessage
+	)
+	for i, item := range resp.Items {
+		if i >= len(docs) {
+			break
+		}
+		for _, result := range item {
+			if result.Status >= 200 && result.Status <= 299 {
+				continue
+			}
+			if isRetryableStatus(result.Status) {
+				retry = append(retry, docs[i])
+			} else {
+				rejected++
+				lastErr = result.Error
+			}
+		}
+	}
+	if rejected > 0 {
+		return retry, fmt.Errorf("%d documents rejected, last error: %s", rejected, lastErr)
+	}
+	return retry, nil
+}
+
+func isRetryableStatus(status int) bool {
+	return status == http.StatusTooManyRequests || status >= 500
+}
+
+// ConvertToElasticLogDocuments converts the given entries into documents, adding
+// networkId and gatewayId if the entry has a valid hardware_id
+func ConvertToElasticLogDocuments(entries []*protos.LogEntry) ([]*ElasticLogDocument, error) {
+	docs := []*ElasticLogDocument{}
+	for _, entry := range entries {
+		if entry.Time == 0 {
+			return nil, fmt.Errorf("LogEntry %v doesn't have time field set", entry)
+		}
+		doc := &ElasticLogDocument{
+			Timestamp: time.Unix(entry.Time, 0).UTC().Format(time.RFC3339),
+			Category:  entry.Category,
+			HwId:      entry.HwId,
+			Int:       entry.IntMap,
+			Normal:    entry.NormalMap,
+			TagSet:    entry.TagSet,
+			NormVec:   entry.Normvector,
+		}
+		// add gatewayId and networkId if it's a logEntry logged from a gateway
+		nwId, gwId, err := getNwIdGwId(entry.HwId)
+		if err != nil {
+			glog.Errorf("Error retrieving nwId and gwId for hwId %s in elasticExporter: %v\n", entry.HwId, err)
+		} else {
+			doc.NetworkId, doc.GatewayId = nwId, gwId
+		}
+		docs = append(docs, doc)
+	}
+	return docs, nil
+}
@@ -0,0 +1,216 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package exporters_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"magma/orc8r/cloud/go/protos"
+	"magma/orc8r/cloud/go/services/logger/exporters"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testElasticServer records received request bodies and replies with the queued responses
+type testElasticServer struct {
+	*httptest.Server
+	sync.Mutex
+	bodies    []string
+	responses []func(w http.ResponseWriter)
+}
+
+func newTestElasticServer() *testElasticServer {
+	srv := &testElasticServer{}
+	srv.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		srv.Lock()
+		defer srv.Unlock()
+		srv.bodies = append(srv.bodies, string(body))
+		if len(srv.responses) == 0 {
+			w.Write([]byte(`{"errors":false}`))
+			return
+		}
+		respond := srv.responses[0]
+		srv.responses = srv.responses[1:]
+		respond(w)
+	}))
+	return srv
+}
+
+func (srv *testElasticServer) getBodies() []string {
+	srv.Lock()
+	defer srv.Unlock()
+	return srv.bodies
+}
+
+func TestConvertToElasticLogDocuments(t *testing.T) {
+	_, err := exporters.ConvertToElasticLogDocuments([]*protos.LogEntry{{Category: "test"}})
+	assert.Error(t, err)
+
+	docs, err := exporters.ConvertToElasticLogDocuments([]*protos.LogEntry{{
+		Category:  "test",
+		Time:      1546300800,
+		NormalMap: map[string]string{"status": "ACTIVE"},
+		IntMap:    map[string]int64{"port": 443},
+		TagSet:    []string{"tag"},
+	}})
+	assert.NoError(t, err)
+	assert.Equal(t, []*exporters.ElasticLogDocument{{
+		Timestamp: "2019-01-01T00:00:00Z",
+		Category:  "test",
+		Normal:    map[string]string{"status": "ACTIVE"},
+		Int:       map[string]int64{"port": 443},
+		TagSet:    []string{"tag"},
+	}}, docs)
+}
+
+func TestElasticExporter_Export(t *testing.T) {
+	srv := newTestElasticServer()
+	defer srv.Close()
+	exporter := exporters.NewElasticExporter(
+		exporters.ElasticExporterConfig{URL: srv.URL, Index: "magma", BatchSize: 2}, nil)
+
+	err := exporter.Submit([]*protos.LogEntry{
+		{Category: "test1", Time: 1546300800},
+		{Category: "test2", Time: 1546300801},
+		{Category: "test3", Time: 1546300802},
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, exporter.Export())
+	action := `{"index":{"_index":"magma"}}`
+	assert.Equal(t, []string{
+		action + "\n" + `{"@timestamp":"2019-01-01T00:00:00Z","category":"test1"}` + "\n" +
+			action + "\n" + `{"@timestamp":"2019-01-01T00:00:01Z","category":"test2"}` + "\n",
+		action + "\n" + `{"@timestamp":"2019-01-01T00:00:02Z","category":"test3"}` + "\n",
+	}, srv.getBodies())
+
+	// nothing left to export
+	assert.NoError(t, exporter.Export())
+	assert.Len(t, srv.getBodies(), 2)
+}
+
+func TestElasticExporter_NDJSON(t *testing.T) {
+	srv := newTestElasticServer()
+	defer srv.Close()
+	exporter := exporters.NewElasticExporter(
+		exporters.ElasticExporterConfig{URL: srv.URL, Format: exporters.NDJSONFormat}, nil)
+	assert.NoError(t, exporter.Submit([]*protos.LogEntry{
+		{Category: "test1", Time: 1546300800},
+		{Category: "test2", Time: 1546300801},
+	}))
+	assert.NoError(t, exporter.Export())
+	assert.Equal(t, []string{
+		`{"@timestamp":"2019-01-01T00:00:00Z","category":"test1"}` + "\n" +
+			`{"@timestamp":"2019-01-01T00:00:01Z","category":"test2"}` + "\n",
+	}, srv.getBodies())
+}
+
+func TestElasticExporter_Retry(t *testing.T) {
+	srv := newTestElasticServer()
+	defer srv.Close()
+	srv.responses = []func(w http.ResponseWriter){
+		func(w http.ResponseWriter) { w.WriteHeader(http.StatusServiceUnavailable) },
+		// second document is retried, third one is rejected & dropped
+		func(w http.ResponseWriter) {
+			w.Write([]byte(`{"errors":true,"items":[` +
+				`{"index":{"status":201}},` +
+				`{"index":{"status":429,"error":{"type":"es_rejected_execution_exception"}}},` +
+				`{"index":{"status":400,"error":{"type":"mapper_parsing_exception"}}}]}`))
+		},
+	}
+	exporter := exporters.NewElasticExporter(
+		exporters.ElasticExporterConfig{URL: srv.URL, RetryBackoff: time.Millisecond}, nil)
+	assert.NoError(t, exporter.Submit([]*protos.LogEntry{
+		{Category: "test1", Time: 1546300800},
+		{Category: "test2", Time: 1546300801},
+		{Category: "test3", Time: 1546300802},
+	}))
+	assert.NoError(t, exporter.Export())
+	bodies := srv.getBodies()
+	assert.Len(t, bodies, 3)
+	assert.Equal(t, bodies[0], bodies[1])
+	assert.Equal(t,
+		`{"index":{}}`+"\n"+`{"@timestamp":"2019-01-01T00:00:01Z","category":"test2"}`+"\n",
+		bodies[2])
+}
+
+func TestElasticExporter_RequestTimeout(t *testing.T) {
+	srv := newTestElasticServer()
+	defer srv.Close()
+	unblock := make(chan struct{})
+	defer close(unblock)
+	srv.responses = []func(w http.ResponseWriter){
+		func(w http.ResponseWriter) { <-unblock },
+	}
+	exporter := exporters.NewElasticExporter(
+		exporters.ElasticExporterConfig{URL: srv.URL, MaxRetries: -1, RequestTimeout: time.Millisecond * 50}, nil)
+	assert.NoError(t, exporter.Submit([]*protos.LogEntry{{Category: "test1", Time: 1546300800}}))
+	done := make(chan error, 1)
+	go func() { done <- exporter.Export() }()
+	select {
+	case err := <-done:
+		assert.Error(t, err)
+	case <-time.After(time.Second * 5):
+		t.Fatal("Export did not time out")
+	}
+}
+
+func TestElasticExporter_BackPressure(t *testing.T) {
+	srv := newTestElasticServer()
+	defer srv.Close()
+	for i := 0; i < 3; i++ {
+		srv.responses = append(srv.responses, func(w http.ResponseWriter) { w.WriteHeader(http.StatusBadGateway) })
+	}
+	exporter := exporters.NewElasticExporter(
+		exporters.ElasticExporterConfig{
+			URL:          srv.URL,
+			QueueLength:  3,
+			MaxRetries:   -1,
+			RetryBackoff: time.Millisecond,
+		},
+		nil)
+	entries := []*protos.LogEntry{{Category: "test1", Time: 1546300800}, {Category: "test2", Time: 1546300801}}
+	assert.NoError(t, exporter.Submit(entries))
+	err := exporter.Submit(entries)
+	assert.EqualError(t, err, "elastic export queue is full (2 of 3), rejecting 2 logEntries")
+
+	// failed batches stay queued
+	err = exporter.Export()
+	assert.Error(t, err)
+	assert.True(t, strings.HasPrefix(err.Error(), "Failed to export 2 documents to elastic"), err.Error())
+	assert.Error(t, exporter.Submit(entries))
+
+	// a rejected request is not retried
+	srv.responses = []func(w http.ResponseWriter){func(w http.ResponseWriter) { w.WriteHeader(http.StatusBadRequest) }}
+	assert.NoError(t, exporter.Export())
+	assert.NoError(t, exporter.Submit(entries))
+	assert.NoError(t, exporter.Export())
+	assert.Len(t, srv.getBodies(), 3)
+}
+
+func TestParseElasticFormat(t *testing.T) {
+	for name, expected := range map[string]exporters.ElasticFormat{
+		"":       exporters.ElasticBulkFormat,
+		"bulk":   exporters.ElasticBulkFormat,
+		"ndjson": exporters.NDJSONFormat,
+	} {
+		format, err := exporters.ParseElasticFormat(name)
+		assert.NoError(t, err, name)
+		assert.Equal(t, expected, format, name)
+	}
+	_, err := exporters.ParseElasticFormat("msgpack")
+	assert.EqualError(t, err, fmt.Sprintf("Unknown elastic export format: %s", "msgpack"))
+}
@@ -15,6 +15,7 @@ import (
 	"magma/orc8r/cloud/go/orc8r"
 	"magma/orc8r/cloud/go/protos"
 	"magma/orc8r/cloud/go/service"
+	"magma/orc8r/cloud/go/service/config"
 	"magma/orc8r/cloud/go/services/logger"
 	"magma/orc8r/cloud/go/services/logger/exporters"
 	"magma/orc8r/cloud/go/services/logger/nghttpxlogger"
@@ -69,6 +70,11 @@ func main() {
 	logExporters := make(map[protos.LoggerDestination]exporters.Exporter)
 	logExporters[protos.LoggerDestination_SCRIBE] = scribeExporter
 
+	elasticExporter := newElasticExporter(srv.Config)
+	if elasticExporter != nil {
+		logExporters[protos.LoggerDestination_ELASTIC] = elasticExporter
+	}
+
 	// Add servicers to the service
 	loggingServ, err := servicers.NewLoggingService(logExporters)
 	if err != nil {
@@ -76,6 +82,9 @@ func main() {
 	}
 	// start exporting asynchronously
 	scribeExporter.Start()
+	if elasticExporter != nil {
+		elasticExporter.Start()
+	}
 
 	protos.RegisterLoggingServiceServer(srv.GrpcServer, loggingServ)
 	srv.GrpcServer.RegisterService(protos.GetLegacyLoggerDesc(), loggingServ)
@@ -85,3 +94,32 @@ func main() {
 		glog.Fatalf("Error running service: %s", err)
 	}
 }
+
+// newElasticExporter creates the ELASTIC destination exporter if an elastic
+// export URL is configured, returns nil otherwise
+func newElasticExporter(cfg *config.ConfigMap) *exporters.ElasticExporter {
+	url, err := cfg.GetStringParam("elastic_export_url")
+	if err != nil || len(url) == 0 {
+		glog.Info("No elastic_export_url configured, ELASTIC logger destination is disabled")
+		return nil
+	}
+	exporterCfg := exporters.ElasticExporterConfig{URL: url}
+	exporterCfg.Index, _ = cfg.GetStringParam("elastic_index")
+	exporterCfg.Username, _ = cfg.GetStringParam("elastic_username")
+	exporterCfg.Password, _ = cfg.GetStringParam("elastic_password")
+	formatName, _ := cfg.GetStringParam("elastic_export_format")
+	exporterCfg.Format, err = exporters.ParseElasticFormat(formatName)
+	if err != nil {
+		glog.Fatal(err)
+	}
+	exporterCfg.QueueLength, _ = cfg.GetIntParam("elastic_queue_length")
+	exporterCfg.BatchSize, _ = cfg.GetIntParam("elastic_batch_size")
+	exporterCfg.MaxRetries, _ = cfg.GetIntParam("elastic_max_retries")
+	if interval, err := cfg.GetIntParam("elastic_export_interval_secs"); err == nil {
+		exporterCfg.ExportInterval = time.Second * time.Duration(interval)
+	}
+	if timeout, err := cfg.GetIntParam("elastic_request_timeout_secs"); err == nil {
+		exporterCfg.RequestTimeout = time.Second * time.Duration(timeout)
+	}
+	return exporters.NewElasticExporter(exporterCfg, nil)
+}
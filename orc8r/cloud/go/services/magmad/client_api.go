@@ -16,6 +16,7 @@ import (
 	"magma/orc8r/cloud/go/identity"
 	"magma/orc8r/cloud/go/protos"
 	"magma/orc8r/cloud/go/registry"
+	"magma/orc8r/cloud/go/services/certifier"
 	"magma/orc8r/cloud/go/services/config"
 	mdprotos "magma/orc8r/cloud/go/services/magmad/protos"
 
@@ -234,11 +235,25 @@ func RemoveGateway(networkId string, gatewayId string) error {
 	if err != nil {
 		return err
 	}
-	_, err = md.RemoveGateway(context.Background(), identity.NewGateway("", networkId, gatewayId))
+	gwId := identity.NewGateway("", networkId, gatewayId)
+	record, err := md.FindGatewayRecord(context.Background(), gwId)
+	if err != nil {
+		glog.Errorf("Failed to find record of gateway %s in network %s: %s", gatewayId, networkId, err)
+	}
+	_, err = md.RemoveGateway(context.Background(), gwId)
 	if err != nil {
 		return err
 	}
 
+	// Revoke certificates of the decommissioned gateway, so they can't be used
+	// until their natural expiration
+	if hwId := record.GetHwId().GetId(); len(hwId) > 0 {
+		_, err = certifier.RevokeIdentityCertificates(identity.NewGateway(hwId, "", ""))
+		if err != nil {
+			glog.Errorf("Failed to revoke certificates of gateway %s: %s", hwId, err)
+		}
+	}
+
 	// Delete all configs associated with this gateway
 	return config.DeleteConfigsByKey(networkId, gatewayId)
 }
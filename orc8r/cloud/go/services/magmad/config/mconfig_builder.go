@@ -12,11 +12,13 @@ import (
 	"fmt"
 	"reflect"
 
+	merrors "magma/orc8r/cloud/go/errors"
 	"magma/orc8r/cloud/go/protos"
 	"magma/orc8r/cloud/go/protos/mconfig"
 	"magma/orc8r/cloud/go/services/config"
 	magmad_protos "magma/orc8r/cloud/go/services/magmad/protos"
 	"magma/orc8r/cloud/go/services/upgrade"
+	upgrade_protos "magma/orc8r/cloud/go/services/upgrade/protos"
 
 	"github.com/golang/glog"
 	"github.com/golang/protobuf/proto"
@@ -33,7 +35,7 @@ func (builder *MagmadMconfigBuilder) Build(networkId string, gatewayId string) (
 		return map[string]proto.Message{}, nil
 	}
 
-	packageVersion, images, err := getPackageVersionAndImagesForGateway(networkId, magmadGatewayConfig.GetTier())
+	packageVersion, images, err := getPackageVersionAndImagesForGateway(networkId, gatewayId, magmadGatewayConfig.GetTier())
 	if err != nil {
 		return nil, err
 	}
@@ -73,8 +75,9 @@ func getMagmadGatewayConfig(networkId string, logicalId string) (*magmad_protos.
 }
 
 // Returns 0.0.0-0 if a nonexistent tier is queried because we don't validate
-// tier IDs in magmad configs yet
-func getPackageVersionAndImagesForGateway(networkId string, tierId string) (string, []*mconfig.ImageSpec, error) {
+// tier IDs in magmad configs yet. If the tier has an active rollout, the
+// package version depends on whether the gateway has been upgraded yet.
+func getPackageVersionAndImagesForGateway(networkId string, gatewayId string, tierId string) (string, []*mconfig.ImageSpec, error) {
 	// Load all tiers so the request doesn't error out if we're looking for
 	// a nonexistent tier. Tier scale for a network will be small so this
 	// should be fine from a performance standpoint.
@@ -88,9 +91,14 @@ func getPackageVersionAndImagesForGateway(networkId string, tierId string) (stri
 		return "0.0.0-0", []*mconfig.ImageSpec{}, nil
 	}
 
+	rollout, err := upgrade.GetTierRollout(networkId, tierId)
+	if err != nil && err != merrors.ErrNotFound {
+		return "0.0.0-0", []*mconfig.ImageSpec{}, err
+	}
+
 	retImages := make([]*mconfig.ImageSpec, 0, len(tier.GetImages()))
 	for _, image := range tier.GetImages() {
 		retImages = append(retImages, &mconfig.ImageSpec{Name: image.GetName(), Order: image.GetOrder()})
 	}
-	return tier.GetVersion(), retImages, nil
+	return upgrade_protos.GetGatewayPackageVersion(tier, rollout, gatewayId), retImages, nil
 }
@@ -10,7 +10,9 @@ package config_test
 
 import (
 	"testing"
+	"time"
 
+	"magma/orc8r/cloud/go/errors"
 	"magma/orc8r/cloud/go/plugin"
 	"magma/orc8r/cloud/go/pluginimpl"
 	"magma/orc8r/cloud/go/protos"
@@ -21,7 +23,9 @@ import (
 	magmadprotos "magma/orc8r/cloud/go/services/magmad/protos"
 	"magma/orc8r/cloud/go/services/upgrade"
 	upgrade_protos "magma/orc8r/cloud/go/services/upgrade/protos"
+	upgrade_servicers "magma/orc8r/cloud/go/services/upgrade/servicers"
 	upgrade_test_init "magma/orc8r/cloud/go/services/upgrade/test_init"
+	"magma/orc8r/cloud/go/test_utils"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/stretchr/testify/assert"
@@ -103,4 +107,61 @@ func TestMagmadMconfigBuilder_Build(t *testing.T) {
 		"metricsd": &mconfig_protos.MetricsD{LogLevel: protos.LogLevel_INFO},
 	}
 	assert.Equal(t, expected, actual)
+
+	// Gateways in a started wave of a rollout get the target version
+	err = upgrade.StartTierRollout("network", "default", &upgrade_protos.RolloutPlan{
+		TargetVersion: "1.1.0-0",
+		Waves: []*upgrade_protos.RolloutWave{
+			{GatewayIds: []string{"gw1"}},
+			{Percentage: 100},
+		},
+	})
+	assert.NoError(t, err)
+	// The evaluator starts the first wave
+	evaluator := upgrade_servicers.NewTierRolloutEvaluator(
+		upgrade_servicers.NewUpgradeService(test_utils.GetMockDatastoreInstance()),
+		&tierGatewayDirectory{gatewayIDs: []string{"gw1", "gw2"}},
+	)
+	assert.NoError(t, evaluator.EvaluateRollouts(time.Now()))
+	actual, err = builder.Build("network", "gw1")
+	assert.NoError(t, err)
+	expected["magmad"].(*mconfig_protos.MagmaD).PackageVersion = "1.1.0-0"
+	assert.Equal(t, expected, actual)
+
+	err = config.CreateConfig("network", magmad_config.MagmadGatewayType, "gw2", &magmadprotos.MagmadGatewayConfig{
+		AutoupgradeEnabled:      true,
+		AutoupgradePollInterval: 300,
+		CheckinInterval:         60,
+		CheckinTimeout:          10,
+		DynamicServices:         []string{},
+		Tier:                    "default",
+	})
+	assert.NoError(t, err)
+	actual, err = builder.Build("network", "gw2")
+	assert.NoError(t, err)
+	assert.Equal(t, "1.0.0-0", actual["magmad"].(*mconfig_protos.MagmaD).PackageVersion)
+
+	// Rolled back rollouts return gateways to the version of the tier
+	err = upgrade.RollbackTierRollout("network", "default")
+	assert.NoError(t, err)
+	actual, err = builder.Build("network", "gw1")
+	assert.NoError(t, err)
+	assert.Equal(t, "1.0.0-0", actual["magmad"].(*mconfig_protos.MagmaD).PackageVersion)
+}
+
+// tierGatewayDirectory puts the gateways in all tiers of the test network
+type tierGatewayDirectory struct {
+	gatewayIDs []string
+}
+
+func (d *tierGatewayDirectory) ListNetworks() ([]string, error) {
+	return []string{"network"}, nil
+}
+
+func (d *tierGatewayDirectory) ListTierGateways(networkID string, tierID string) ([]string, error) {
+	return d.gatewayIDs, nil
+}
+
+func (d *tierGatewayDirectory) GetGatewayStatus(networkID string, gatewayID string) (*protos.GatewayStatus, error) {
+	return nil, errors.ErrNotFound
 }
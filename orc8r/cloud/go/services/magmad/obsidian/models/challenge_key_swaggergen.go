@@ -19,13 +19,16 @@ import (
 // swagger:model challenge_key
 type ChallengeKey struct {
 
-	// key
+	// DER encoded intermediate certificates chaining the AK certificate to a trusted TPM root
+	CertificateChain []strfmt.Base64 `json:"certificate_chain,omitempty"`
+
+	// DER encoded public key, or DER encoded AK certificate for TPM2_ATTESTATION_KEY
 	// Format: byte
 	Key *strfmt.Base64 `json:"key,omitempty"`
 
 	// key type
 	// Required: true
-	// Enum: [ECHO SOFTWARE_ECDSA_SHA256]
+	// Enum: [ECHO SOFTWARE_ECDSA_SHA256 TPM2_ATTESTATION_KEY]
 	KeyType string `json:"key_type"`
 }
 
@@ -33,6 +36,10 @@ type ChallengeKey struct {
 func (m *ChallengeKey) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateCertificateChain(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateKey(formats); err != nil {
 		res = append(res, err)
 	}
@@ -47,6 +54,21 @@ func (m *ChallengeKey) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *ChallengeKey) validateCertificateChain(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.CertificateChain) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.CertificateChain); i++ {
+
+		// Format "byte" (base64 string) is already validated when unmarshalled
+
+	}
+
+	return nil
+}
+
 func (m *ChallengeKey) validateKey(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.Key) { // not required
@@ -62,7 +84,7 @@ var challengeKeyTypeKeyTypePropEnum []interface{}
 
 func init() {
 	var res []string
-	if err := json.Unmarshal([]byte(`["ECHO","SOFTWARE_ECDSA_SHA256"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["ECHO","SOFTWARE_ECDSA_SHA256","TPM2_ATTESTATION_KEY"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -77,6 +99,9 @@ const (
 
 	// ChallengeKeyKeyTypeSOFTWAREECDSASHA256 captures enum value "SOFTWARE_ECDSA_SHA256"
 	ChallengeKeyKeyTypeSOFTWAREECDSASHA256 string = "SOFTWARE_ECDSA_SHA256"
+
+	// ChallengeKeyKeyTypeTPM2ATTESTATIONKEY captures enum value "TPM2_ATTESTATION_KEY"
+	ChallengeKeyKeyTypeTPM2ATTESTATIONKEY string = "TPM2_ATTESTATION_KEY"
 )
 
 // prop value enum
@@ -210,6 +210,10 @@ func fillKeyFromMconfig(mkey *protos.ChallengeKey, key *ChallengeKey) error {
 	} else {
 		key.Key = nil
 	}
+	key.CertificateChain = nil
+	for _, cert := range mkey.CertificateChain {
+		key.CertificateChain = append(key.CertificateChain, strfmt.Base64(cert))
+	}
 	return nil
 }
 
@@ -227,6 +231,9 @@ func fillKeyToMconfig(key *ChallengeKey) (*protos.ChallengeKey, error) {
 	if key.Key != nil {
 		mkey.Key = []byte(*key.Key)
 	}
+	for _, cert := range key.CertificateChain {
+		mkey.CertificateChain = append(mkey.CertificateChain, []byte(cert))
+	}
 	return mkey, nil
 }
 
@@ -250,6 +257,21 @@ func verifyKey(key *ChallengeKey) error {
 			return fmt.Errorf("Failed to parse key: %s", err)
 		}
 		return nil
+	case "TPM2_ATTESTATION_KEY":
+		if key.Key == nil {
+			return fmt.Errorf("No AK certificate supplied")
+		}
+		_, err := x509.ParseCertificate([]byte(*key.Key))
+		if err != nil {
+			return fmt.Errorf("Failed to parse AK certificate: %s", err)
+		}
+		for i, cert := range key.CertificateChain {
+			_, err = x509.ParseCertificate([]byte(cert))
+			if err != nil {
+				return fmt.Errorf("Failed to parse certificate chain #%d: %s", i, err)
+			}
+		}
+		return nil
 	default:
 		return fmt.Errorf("Unknown key type: %s", key.KeyType)
 	}
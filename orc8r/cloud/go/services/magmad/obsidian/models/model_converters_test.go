@@ -13,11 +13,13 @@ import (
 	"testing"
 
 	"magma/orc8r/cloud/go/protos"
+	tpm_test_utils "magma/orc8r/cloud/go/security/tpm/test_utils"
 	checkind_models "magma/orc8r/cloud/go/services/checkind/obsidian/models"
 	"magma/orc8r/cloud/go/services/magmad/obsidian/handlers/view_factory"
 	magmad_models "magma/orc8r/cloud/go/services/magmad/obsidian/models"
 	magmadprotos "magma/orc8r/cloud/go/services/magmad/protos"
 
+	"github.com/go-openapi/strfmt"
 	"github.com/golang/protobuf/jsonpb"
 	"github.com/golang/protobuf/ptypes/struct"
 	"github.com/stretchr/testify/assert"
@@ -291,3 +293,38 @@ func TestProtobufStructToJSONMap(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, expectedJsonMap, actualJsonMap)
 }
+
+func TestAccessGatewayRecordTPMKey(t *testing.T) {
+	sim, err := tpm_test_utils.NewSimulator("P256")
+	assert.NoError(t, err)
+	akCert, intermediate := strfmt.Base64(sim.AKCert.Raw), strfmt.Base64(sim.Intermediate.Raw)
+	record := &magmad_models.AccessGatewayRecord{
+		HwID: &magmad_models.HwGatewayID{ID: "gw0"},
+		Key: &magmad_models.ChallengeKey{
+			KeyType:          magmad_models.ChallengeKeyKeyTypeTPM2ATTESTATIONKEY,
+			Key:              &akCert,
+			CertificateChain: []strfmt.Base64{intermediate},
+		},
+		Name: "Gateway 0",
+	}
+	assert.NoError(t, record.Verify())
+
+	mconfig, err := record.ToMconfig()
+	assert.NoError(t, err)
+	assert.Equal(t, &protos.ChallengeKey{
+		KeyType:          protos.ChallengeKey_TPM2_ATTESTATION_KEY,
+		Key:              sim.AKCert.Raw,
+		CertificateChain: [][]byte{sim.Intermediate.Raw},
+	}, mconfig.Key)
+
+	actual := &magmad_models.AccessGatewayRecord{Key: &magmad_models.ChallengeKey{}}
+	assert.NoError(t, actual.FromMconfig(mconfig))
+	assert.Equal(t, record, actual)
+
+	// AK certificate chain must be DER encoded certificates
+	record.Key.CertificateChain = []strfmt.Base64{strfmt.Base64("not a certificate")}
+	assert.Error(t, record.Verify())
+	record.Key.CertificateChain = nil
+	record.Key.Key = nil
+	assert.Error(t, record.Verify())
+}
@@ -28,6 +28,7 @@ import (
 
 	"magma/orc8r/cloud/go/datastore"
 	"magma/orc8r/cloud/go/protos"
+	checkind_store "magma/orc8r/cloud/go/services/checkind/store"
 	magmadprotos "magma/orc8r/cloud/go/services/magmad/protos"
 )
 
@@ -60,9 +61,11 @@ const (
 
 	// the following tables are currently used for cleanup (network delete)
 	// until we have cloud service registry to manage inter service connections
-	SubscribersTableName    = "subscriberdb"
-	GatewaysStatusTableName = "gwstatus"
-	TierTableName           = "tierVersions"
+	SubscribersTableName           = "subscriberdb"
+	GatewaysStatusTableName        = "gwstatus"
+	GatewaysStatusHistoryTableName = "gwstatushistory"
+	GatewaysOfflineTableName       = "gwoffline"
+	TierTableName                  = "tierVersions"
 )
 
 func (md MagmadConfigurator) deleteIfExists(tableName string, key string) error {
@@ -76,12 +79,24 @@ func (md MagmadConfigurator) deleteIfExists(tableName string, key string) error
 	}
 	return nil
 }
+
+// deleteStatusHistory deletes the gateway's checkin status history, both the
+// history's index and the <logical ID>/<slot> checkin entries
+func (md MagmadConfigurator) deleteStatusHistory(networkId string, logicalId string) error {
+	checkinStore, err := checkind_store.NewCheckinStore(md.Store)
+	if err != nil {
+		return err
+	}
+	return checkinStore.DeleteGatewayStatusHistory(networkId, logicalId)
+}
 func getTablesToDropForNetworkDeletion(networkId string) []string {
 	return []string{
 		datastore.GetTableName(networkId, AgRecordTableName),
 		datastore.GetTableName(networkId, HwIdTableName),
 		datastore.GetTableName(networkId, SubscribersTableName),
 		datastore.GetTableName(networkId, GatewaysStatusTableName),
+		datastore.GetTableName(networkId, GatewaysStatusHistoryTableName),
+		datastore.GetTableName(networkId, GatewaysOfflineTableName),
 		datastore.GetTableName(networkId, TierTableName),
 	}
 }
@@ -208,6 +208,11 @@ func (md MagmadConfigurator) RemoveGateway(ctx context.Context, id *protos.Ident
 		glog.Error(msg)
 		allOperationErrors = append(allOperationErrors, msg)
 	}
+	if err := md.deleteStatusHistory(networkId, logicalId); err != nil {
+		msg := fmt.Sprintf("Failed to clean up gateway checkin status history. Error: %s", err)
+		glog.Error(msg)
+		allOperationErrors = append(allOperationErrors, msg)
+	}
 
 	// Only remove the gateway record if all cleanup steps are successful
 	// This way the gateway ID still shows up on a LIST request
@@ -11,10 +11,14 @@ package magmad
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"magma/orc8r/cloud/go/datastore"
 	"magma/orc8r/cloud/go/datastore/mocks"
+	"magma/orc8r/cloud/go/identity"
 	"magma/orc8r/cloud/go/protos"
+	"magma/orc8r/cloud/go/services/certifier"
+	certifier_test_utils "magma/orc8r/cloud/go/services/certifier/test_utils"
 	"magma/orc8r/cloud/go/services/magmad"
 	magmad_protos "magma/orc8r/cloud/go/services/magmad/protos"
 	"magma/orc8r/cloud/go/services/magmad/servicers"
@@ -204,6 +208,9 @@ func TestMagmad_RemoveGateway_DeleteErrors(t *testing.T) {
 		Return(true, nil)
 	mockeryStore.On("DoesKeyExist", datastore.GetTableName(networkId, servicers.GatewaysStatusTableName), gwId).
 		Return(true, nil)
+	// No status history
+	mockeryStore.On("Get", datastore.GetTableName(networkId, servicers.GatewaysStatusHistoryTableName), gwId).
+		Return(nil, uint64(0), datastore.ErrNotFound)
 
 	// One error on delete
 	// Shouldn't be any other deletes
@@ -245,14 +252,22 @@ func TestMagmad_RemoveGateway(t *testing.T) {
 		Return(true, nil)
 	mockeryStore.On("DoesKeyExist", datastore.GetTableName(networkId, servicers.GatewaysStatusTableName), gwId).
 		Return(true, nil)
+	historyIndex, err := protos.MarshalIntern(&protos.GatewayStatusHistoryIndex{Capacity: 2, NextSlot: 1, NumEntries: 1})
+	assert.NoError(t, err)
+	mockeryStore.On("Get", datastore.GetTableName(networkId, servicers.GatewaysStatusHistoryTableName), gwId).
+		Return(historyIndex, uint64(1), nil)
 
-	// Should only be 3 deletes
+	// Should only be 4 deletes and the deletion of all history slots
 	mockeryStore.On("Delete", datastore.GetTableName(networkId, servicers.HwIdTableName), hwId).
 		Return(nil)
 	mockeryStore.On("Delete", servicers.GatewaysTableName, hwId).
 		Return(nil)
 	mockeryStore.On("Delete", datastore.GetTableName(networkId, servicers.GatewaysStatusTableName), gwId).
 		Return(nil)
+	mockeryStore.On("DeleteMany", datastore.GetTableName(networkId, servicers.GatewaysStatusHistoryTableName), []string{gwId + "/0", gwId + "/1"}).
+		Return(map[string]error{}, nil)
+	mockeryStore.On("Delete", datastore.GetTableName(networkId, servicers.GatewaysStatusHistoryTableName), gwId).
+		Return(nil)
 
 	// Record delete
 	mockeryStore.On("Delete", datastore.GetTableName(networkId, servicers.AgRecordTableName), gwId).
@@ -264,6 +279,36 @@ func TestMagmad_RemoveGateway(t *testing.T) {
 	mockeryStore.AssertExpectations(t)
 }
 
+func TestRemoveGatewayRevokesCertificates(t *testing.T) {
+	magmad_test_service.StartTestService(t)
+
+	testNetworkId, err := magmad.RegisterNetwork(
+		&magmad_protos.MagmadNetworkRecord{Name: "Test Network Name"},
+		"magmad_test_network")
+	assert.NoError(t, err)
+	logicalId, err := magmad.RegisterGateway(
+		testNetworkId,
+		&magmad_protos.AccessGatewayRecord{HwId: &protos.AccessGatewayID{Id: testAgHwId}},
+	)
+	assert.NoError(t, err)
+
+	gwIdentity := identity.NewGateway(testAgHwId, "", "")
+	csr, err := certifier_test_utils.CreateCSRForId(time.Hour*24, gwIdentity)
+	assert.NoError(t, err)
+	_, err = certifier.SignCSR(csr)
+	assert.NoError(t, err)
+	sns, err := certifier.FindCertificates(gwIdentity)
+	assert.NoError(t, err)
+	assert.Len(t, sns, 1)
+
+	err = magmad.RemoveGateway(testNetworkId, logicalId)
+	assert.NoError(t, err)
+
+	sns, err = certifier.FindCertificates(gwIdentity)
+	assert.NoError(t, err)
+	assert.Empty(t, sns)
+}
+
 func TestRemoveNetwork(t *testing.T) {
 	magmad_test_service.StartTestService(t)
 
@@ -320,7 +365,7 @@ func TestForceRemoveNetworkSuccess(t *testing.T) {
 
 	err := magmad.ForceRemoveNetwork(networkId)
 	assert.NoError(t, err)
-	mockeryStore.AssertNumberOfCalls(t, "DeleteTable", 5)
+	mockeryStore.AssertNumberOfCalls(t, "DeleteTable", 7)
 	mockeryStore.AssertExpectations(t)
 }
 
@@ -399,7 +444,7 @@ func TestForceRemoveNetworkErrorDropTables(t *testing.T) {
 			"\tError while deleting table NETWORK_hwIds: DeleteTable error 1\n",
 	)
 	mockeryStore.AssertNotCalled(t, "Delete", servicers.NetworksTableName, mock.AnythingOfType("string"))
-	mockeryStore.AssertNumberOfCalls(t, "DeleteTable", 5)
+	mockeryStore.AssertNumberOfCalls(t, "DeleteTable", 7)
 	mockeryStore.AssertExpectations(t)
 }
 
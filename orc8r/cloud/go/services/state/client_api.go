@@ -11,14 +11,12 @@ package state
 import (
 	"context"
 	"encoding/json"
-	"sync"
 
 	"magma/orc8r/cloud/go/errors"
 	"magma/orc8r/cloud/go/protos"
 	"magma/orc8r/cloud/go/registry"
 
 	"github.com/golang/glog"
-	"google.golang.org/grpc"
 )
 
 // StateValue includes reported operational states and additional info
@@ -30,6 +28,9 @@ type StateValue struct {
 	// Cert expiration Time
 	CertExpirationTime int64
 	ReportedValue      []byte
+	// Set when reading a state which wasn't reported within the TTL of its
+	// type, it's never stored
+	Stale bool `json:",omitempty"`
 }
 
 // StateID contains the identifying information of a state
@@ -38,27 +39,14 @@ type StateID struct {
 	DeviceID string
 }
 
-// Global clientconn that can be reused for this service
-var connSingleton = (*grpc.ClientConn)(nil)
-var connGuard = sync.Mutex{}
-
 func getStateClient() (protos.StateServiceClient, error) {
-	if connSingleton == nil {
-		// Reading the conn optimistically to avoid unnecessary overhead
-		connGuard.Lock()
-		if connSingleton == nil {
-			conn, err := registry.GetConnection(ServiceName)
-			if err != nil {
-				initErr := errors.NewInitError(err, ServiceName)
-				glog.Error(initErr)
-				connGuard.Unlock()
-				return nil, initErr
-			}
-			connSingleton = conn
-		}
-		connGuard.Unlock()
+	conn, err := registry.GetConnection(ServiceName)
+	if err != nil {
+		initErr := errors.NewInitError(err, ServiceName)
+		glog.Error(initErr)
+		return nil, initErr
 	}
-	return protos.NewStateServiceClient(connSingleton), nil
+	return protos.NewStateServiceClient(conn), nil
 }
 
 // GetState returns the state specified by the networkID, typeVal, and hwID
@@ -117,6 +105,43 @@ func GetStates(networkID string, stateIDs []StateID) (map[StateID]StateValue, er
 	return idToValue, nil
 }
 
+// ListStates returns a page of the states of the given type in the network,
+// ordered by device ID, and the token of the next page. If reporterID is not
+// empty only the states reported by the gateway with that hardware ID are
+// listed. A pageSize of 0 lists all states.
+func ListStates(networkID string, typeVal string, reporterID string, pageSize uint32, pageToken string) (map[StateID]StateValue, []StateID, string, error) {
+	client, err := getStateClient()
+	if err != nil {
+		return nil, nil, "", err
+	}
+	res, err := client.ListStates(
+		context.Background(),
+		&protos.ListStatesRequest{
+			NetworkID:  networkID,
+			Type:       typeVal,
+			ReporterID: reporterID,
+			PageSize:   pageSize,
+			PageToken:  pageToken,
+		},
+	)
+	if err != nil {
+		return nil, nil, "", err
+	}
+
+	idToValue := map[StateID]StateValue{}
+	ids := make([]StateID, 0, len(res.States))
+	for _, state := range res.States {
+		stateID := StateID{Type: state.Type, DeviceID: state.DeviceID}
+		stateValue := StateValue{}
+		if err := json.Unmarshal(state.Value, &stateValue); err != nil {
+			return nil, nil, "", err
+		}
+		idToValue[stateID] = stateValue
+		ids = append(ids, stateID)
+	}
+	return idToValue, ids, res.NextPageToken, nil
+}
+
 // DeleteStates deletes states specified by the networkID and a list of type and key
 func DeleteStates(networkID string, stateIDs []StateID) error {
 	client, err := getStateClient()
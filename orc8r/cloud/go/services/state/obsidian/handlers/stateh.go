@@ -9,17 +9,28 @@ LICENSE file in the root directory of this source tree.
 package handlers
 
 import (
+	"fmt"
 	"net/http"
+	"strconv"
 
 	"magma/orc8r/cloud/go/obsidian/handlers"
 	"magma/orc8r/cloud/go/serde"
 	checkind_models "magma/orc8r/cloud/go/services/checkind/obsidian/models"
 	stateservice "magma/orc8r/cloud/go/services/state"
+	state_models "magma/orc8r/cloud/go/services/state/obsidian/models"
 
 	"github.com/labstack/echo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
-const AgStatusUrl = handlers.NETWORKS_ROOT + "/:network_id/gateways/:device_id/gateway_status"
+const (
+	AgStatusUrl   = handlers.NETWORKS_ROOT + "/:network_id/gateways/:device_id/gateway_status"
+	ListStatesUrl = handlers.NETWORKS_ROOT + "/:network_id/states"
+
+	DefaultStatePageSize = 100
+	MaxStatePageSize     = 1000
+)
 
 // GetObsidianHandlers returns all handlers for state
 func GetObsidianHandlers() []handlers.Handler {
@@ -29,6 +40,11 @@ func GetObsidianHandlers() []handlers.Handler {
 			Methods:     handlers.GET,
 			HandlerFunc: AGStatusByDeviceIDHandler,
 		},
+		{
+			Path:        ListStatesUrl,
+			Methods:     handlers.GET,
+			HandlerFunc: ListStatesHandler,
+		},
 	}
 }
 
@@ -66,3 +82,80 @@ func GetGWStatus(networkID string, deviceID string) (*checkind_models.GatewaySta
 	gwStatus.FillDeprecatedFields()
 	return &gwStatus, nil
 }
+
+// ListStatesHandler returns a page of the states of a type in the network
+func ListStatesHandler(c echo.Context) error {
+	networkID, nerr := handlers.GetNetworkId(c)
+	if nerr != nil {
+		return nerr
+	}
+	typeVal := c.QueryParam("type")
+	if typeVal == "" {
+		return handlers.HttpError(fmt.Errorf("Missing state type"), http.StatusBadRequest)
+	}
+	pageSize, err := getStatePageSize(c)
+	if err != nil {
+		return err
+	}
+
+	values, ids, nextPageToken, err := stateservice.ListStates(
+		networkID,
+		typeVal,
+		c.QueryParam("reporter_id"),
+		pageSize,
+		c.QueryParam("page_token"),
+	)
+	if status.Code(err) == codes.InvalidArgument {
+		return handlers.HttpError(err, http.StatusBadRequest)
+	}
+	if err != nil {
+		return handlers.HttpError(err, http.StatusInternalServerError)
+	}
+
+	ret := &state_models.PaginatedStates{
+		States:        make([]*state_models.State, 0, len(ids)),
+		NextPageToken: nextPageToken,
+	}
+	for _, id := range ids {
+		swaggerState, err := toSwaggerState(id, values[id])
+		if err != nil {
+			return handlers.HttpError(err, http.StatusInternalServerError)
+		}
+		ret.States = append(ret.States, swaggerState)
+	}
+	return c.JSON(http.StatusOK, ret)
+}
+
+func getStatePageSize(c echo.Context) (uint32, error) {
+	pageSizeParam := c.QueryParam("page_size")
+	if pageSizeParam == "" {
+		return DefaultStatePageSize, nil
+	}
+	pageSize, err := strconv.ParseUint(pageSizeParam, 10, 32)
+	if err != nil || pageSize == 0 || pageSize > MaxStatePageSize {
+		return 0, handlers.HttpError(
+			fmt.Errorf("Invalid page_size '%s': must be between 1 and %d", pageSizeParam, MaxStatePageSize),
+			http.StatusBadRequest,
+		)
+	}
+	return uint32(pageSize), nil
+}
+
+func toSwaggerState(id stateservice.StateID, value stateservice.StateValue) (*state_models.State, error) {
+	ret := &state_models.State{
+		Type:               id.Type,
+		DeviceID:           id.DeviceID,
+		ReporterID:         value.ReporterID,
+		Time:               value.Time,
+		CertExpirationTime: value.CertExpirationTime,
+		Stale:              value.Stale,
+	}
+	if len(value.ReportedValue) > 0 {
+		reportedValue, err := serde.Deserialize(stateservice.SerdeDomain, id.Type, value.ReportedValue)
+		if err != nil {
+			return nil, err
+		}
+		ret.Value = reportedValue
+	}
+	return ret, nil
+}
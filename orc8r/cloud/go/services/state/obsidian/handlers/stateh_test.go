@@ -8,6 +8,7 @@
 package handlers_test
 
 import (
+	"encoding/json"
 	"fmt"
 	"testing"
 
@@ -20,6 +21,7 @@ import (
 	"magma/orc8r/cloud/go/services/magmad"
 	magmadProtos "magma/orc8r/cloud/go/services/magmad/protos"
 	magmadTestInit "magma/orc8r/cloud/go/services/magmad/test_init"
+	stateModels "magma/orc8r/cloud/go/services/state/obsidian/models"
 	stateTestInit "magma/orc8r/cloud/go/services/state/test_init"
 	"magma/orc8r/cloud/go/services/state/test_utils"
 
@@ -57,6 +59,7 @@ func TestState(t *testing.T) {
 
 	getStateNoError(t, restPort, testNetworkId)
 	getStateNotFoundError(t, restPort, testNetworkId)
+	listStates(t, restPort, testNetworkId)
 }
 
 func getURL(restPort int, networkID string, hwID string) string {
@@ -79,3 +82,33 @@ func getStateNotFoundError(t *testing.T, restPort int, networkID string) {
 	url := getURL(restPort, networkID, "should-not-exist")
 	test_utils.GetGWStatusExpectNotFound(t, url)
 }
+
+func listStates(t *testing.T, restPort int, networkID string) {
+	url := fmt.Sprintf("http://localhost:%d%s/networks/%s/states", restPort, handlers.REST_ROOT, networkID)
+
+	status, response, err := tests.SendHttpRequest("GET", url+"?type=gw_state&page_size=1", "")
+	assert.NoError(t, err)
+	assert.Equal(t, 200, status)
+	page := stateModels.PaginatedStates{}
+	assert.NoError(t, json.Unmarshal([]byte(response), &page))
+	assert.Empty(t, page.NextPageToken)
+	assert.Len(t, page.States, 1)
+	assert.Equal(t, "gw_state", page.States[0].Type)
+	assert.Equal(t, testAgHwId, page.States[0].DeviceID)
+	assert.Equal(t, testAgHwId, page.States[0].ReporterID)
+	assert.False(t, page.States[0].Stale)
+	assert.NotNil(t, page.States[0].Value)
+
+	status, response, err = tests.SendHttpRequest("GET", url+"?type=gw_state&reporter_id=should-not-exist", "")
+	assert.NoError(t, err)
+	assert.Equal(t, 200, status)
+	page = stateModels.PaginatedStates{}
+	assert.NoError(t, json.Unmarshal([]byte(response), &page))
+	assert.Empty(t, page.States)
+
+	for _, query := range []string{"", "?type=gw_state&page_size=0", "?type=gw_state&page_token=!"} {
+		status, _, err = tests.SendHttpRequest("GET", url+query, "")
+		assert.NoError(t, err)
+		assert.Equal(t, 400, status, query)
+	}
+}
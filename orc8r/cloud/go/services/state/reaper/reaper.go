@@ -0,0 +1,138 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+// Package reaper deletes the states which weren't reported within the TTL of
+// their type, e.g. the states of removed or re-provisioned gateways.
+package reaper
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"magma/orc8r/cloud/go/blobstore"
+	stateservice "magma/orc8r/cloud/go/services/state"
+	"magma/orc8r/cloud/go/storage"
+
+	"github.com/golang/glog"
+)
+
+// ReapBatchSize is the number of states loaded per transaction when reaping
+const ReapBatchSize = 100
+
+// NetworkLister lists the IDs of all networks
+type NetworkLister func() ([]string, error)
+
+type Reaper struct {
+	factory      blobstore.BlobStorageFactory
+	ttls         stateservice.TTLs
+	listNetworks NetworkLister
+}
+
+func NewReaper(factory blobstore.BlobStorageFactory, ttls stateservice.TTLs, listNetworks NetworkLister) (*Reaper, error) {
+	if factory == nil {
+		return nil, fmt.Errorf("Storage factory is nil")
+	}
+	if listNetworks == nil {
+		return nil, fmt.Errorf("Network lister is nil")
+	}
+	return &Reaper{factory: factory, ttls: ttls, listNetworks: listNetworks}, nil
+}
+
+// Run periodically reaps expired states, it never returns
+func (r *Reaper) Run(interval time.Duration) {
+	for range time.Tick(interval) {
+		if err := r.ReapExpiredStates(time.Now()); err != nil {
+			glog.Errorf("Error reaping expired states: %s", err)
+		}
+	}
+}
+
+// ReapExpiredStates deletes the states of all networks which expired as of now
+func (r *Reaper) ReapExpiredStates(now time.Time) error {
+	networks, err := r.listNetworks()
+	if err != nil {
+		return err
+	}
+	for _, networkID := range networks {
+		for typeVal := range r.ttls {
+			if err := r.reap(networkID, typeVal, now); err != nil {
+				glog.Errorf("Error reaping %s states of network %s: %s", typeVal, networkID, err)
+			}
+		}
+	}
+	return nil
+}
+
+// reap deletes the expired states of a type in a network, loading and
+// deleting ReapBatchSize states per transaction
+func (r *Reaper) reap(networkID string, typeVal string, now time.Time) error {
+	cursor := ""
+	reaped := 0
+	for {
+		keys, n, err := r.reapBatch(networkID, typeVal, cursor, now)
+		reaped += n
+		if err != nil {
+			return err
+		}
+		if len(keys) < ReapBatchSize {
+			break
+		}
+		cursor = keys[len(keys)-1]
+	}
+	if reaped > 0 {
+		glog.Infof("Reaped %d expired %s states of network %s", reaped, typeVal, networkID)
+	}
+	return nil
+}
+
+// reapBatch deletes the expired states among the batch of states after the
+// cursor. It returns the keys of the batch and the number of reaped states.
+func (r *Reaper) reapBatch(networkID string, typeVal string, cursor string, now time.Time) ([]string, int, error) {
+	store, err := r.factory.StartTransaction()
+	if err != nil {
+		return nil, 0, err
+	}
+	keys, err := store.ListKeysPage(networkID, typeVal, cursor, ReapBatchSize)
+	if err != nil {
+		store.Rollback()
+		return nil, 0, err
+	}
+	if len(keys) == 0 {
+		return keys, 0, store.Commit()
+	}
+	tks := make([]storage.TypeAndKey, 0, len(keys))
+	for _, key := range keys {
+		tks = append(tks, storage.TypeAndKey{Type: typeVal, Key: key})
+	}
+	states, err := store.GetMany(networkID, tks)
+	if err != nil {
+		store.Rollback()
+		return nil, 0, err
+	}
+
+	expired := []storage.TypeAndKey{}
+	for _, state := range states {
+		value := stateservice.StateValue{}
+		if err := json.Unmarshal(state.Value, &value); err != nil {
+			glog.Errorf("Invalid value of %s state %s: %s", typeVal, state.Key, err)
+			continue
+		}
+		if r.ttls.IsExpired(typeVal, &value, now) {
+			expired = append(expired, storage.TypeAndKey{Type: typeVal, Key: state.Key})
+		}
+	}
+	if len(expired) == 0 {
+		return keys, 0, store.Commit()
+	}
+	if err := store.Delete(networkID, expired); err != nil {
+		store.Rollback()
+		return nil, 0, err
+	}
+	return keys, len(expired), store.Commit()
+}
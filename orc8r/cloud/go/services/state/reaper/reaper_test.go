@@ -0,0 +1,92 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package reaper_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"magma/orc8r/cloud/go/blobstore"
+	stateservice "magma/orc8r/cloud/go/services/state"
+	"magma/orc8r/cloud/go/services/state/reaper"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReaper(t *testing.T) {
+	factory := blobstore.NewMemoryBlobStorageFactory()
+	ttls := stateservice.TTLs{"gw_state": time.Minute}
+	_, err := reaper.NewReaper(factory, ttls, nil)
+	assert.Error(t, err)
+	stateReaper, err := reaper.NewReaper(factory, ttls, func() ([]string, error) {
+		return []string{"n1", "n2"}, nil
+	})
+	assert.NoError(t, err)
+
+	now := time.Unix(1000000, 0)
+	putStates(t, factory, "n1",
+		makeState(t, "gw_state", "fresh", now.Add(-30*time.Second)),
+		makeState(t, "gw_state", "expired", now.Add(-2*time.Minute)),
+		// Types without a TTL never expire
+		makeState(t, "other", "old", now.Add(-time.Hour)),
+	)
+	putStates(t, factory, "n2", makeState(t, "gw_state", "expired", now.Add(-2*time.Minute)))
+
+	assert.NoError(t, stateReaper.ReapExpiredStates(now))
+	assert.Equal(t, []string{"fresh"}, listKeys(t, factory, "n1", "gw_state"))
+	assert.Equal(t, []string{"old"}, listKeys(t, factory, "n1", "other"))
+	assert.Empty(t, listKeys(t, factory, "n2", "gw_state"))
+
+	// States are reaped in batches
+	states := []blobstore.Blob{}
+	for i := 0; i < 2*reaper.ReapBatchSize+10; i++ {
+		reportTime := now.Add(-2 * time.Minute)
+		if i%2 == 0 {
+			reportTime = now
+		}
+		states = append(states, makeState(t, "gw_state", fmt.Sprintf("gw%03d", i), reportTime))
+	}
+	putStates(t, factory, "n2", states...)
+	assert.NoError(t, stateReaper.ReapExpiredStates(now))
+	keys := listKeys(t, factory, "n2", "gw_state")
+	assert.Equal(t, reaper.ReapBatchSize+5, len(keys))
+	for _, key := range keys {
+		var i int
+		fmt.Sscanf(key, "gw%03d", &i)
+		assert.Equal(t, 0, i%2)
+	}
+}
+
+func makeState(t *testing.T, typeVal string, key string, reportTime time.Time) blobstore.Blob {
+	value, err := json.Marshal(stateservice.StateValue{
+		ReporterID:    "hw1",
+		Time:          uint64(reportTime.UnixNano()) / uint64(time.Millisecond),
+		ReportedValue: []byte("{}"),
+	})
+	assert.NoError(t, err)
+	return blobstore.Blob{Type: typeVal, Key: key, Value: value}
+}
+
+func putStates(t *testing.T, factory blobstore.BlobStorageFactory, networkID string, states ...blobstore.Blob) {
+	store, err := factory.StartTransaction()
+	assert.NoError(t, err)
+	assert.NoError(t, store.CreateOrUpdate(networkID, states))
+	assert.NoError(t, store.Commit())
+}
+
+func listKeys(t *testing.T, factory blobstore.BlobStorageFactory, networkID string, typeVal string) []string {
+	store, err := factory.StartTransaction()
+	assert.NoError(t, err)
+	keys, err := store.ListKeys(networkID, typeVal)
+	assert.NoError(t, err)
+	assert.NoError(t, store.Commit())
+	return keys
+}
@@ -37,6 +37,17 @@ func ValidateDeleteStatesRequest(req *protos.DeleteStatesRequest) error {
 	return nil
 }
 
+// ValidateListStatesRequest checks that all required fields exist
+func ValidateListStatesRequest(req *protos.ListStatesRequest) error {
+	if len(req.GetNetworkID()) == 0 {
+		return errors.New("Network ID must be specified")
+	}
+	if len(req.GetType()) == 0 {
+		return errors.New("State type must be specified")
+	}
+	return nil
+}
+
 func validateStates(req *protos.ReportStatesRequest) error {
 	states := req.GetStates()
 	if states == nil || len(states) == 0 {
@@ -9,6 +9,7 @@ LICENSE file in the root directory of this source tree.
 package servicers
 
 import (
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"time"
@@ -16,6 +17,7 @@ import (
 	"magma/orc8r/cloud/go/blobstore"
 	"magma/orc8r/cloud/go/protos"
 	stateservice "magma/orc8r/cloud/go/services/state"
+	"magma/orc8r/cloud/go/storage"
 
 	"golang.org/x/net/context"
 	"google.golang.org/grpc/codes"
@@ -24,14 +26,16 @@ import (
 
 type stateServicer struct {
 	factory blobstore.BlobStorageFactory
+	ttls    stateservice.TTLs
 }
 
-// NewStateServicer returns a state server backed by storage passed in
-func NewStateServicer(factory blobstore.BlobStorageFactory) (protos.StateServiceServer, error) {
+// NewStateServicer returns a state server backed by storage passed in.
+// States not reported within the TTL of their type are returned as stale.
+func NewStateServicer(factory blobstore.BlobStorageFactory, ttls stateservice.TTLs) (protos.StateServiceServer, error) {
 	if factory == nil {
 		return nil, fmt.Errorf("Storage factory is nil")
 	}
-	return &stateServicer{factory}, nil
+	return &stateServicer{factory: factory, ttls: ttls}, nil
 }
 
 // GetStates retrieves states from blobstorage
@@ -48,9 +52,94 @@ func (srv *stateServicer) GetStates(context context.Context, req *protos.GetStat
 	}
 	states, err := store.GetMany(req.GetNetworkID(), ids)
 	store.Commit()
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	for i, state := range states {
+		if states[i], err = srv.markIfStale(state, now); err != nil {
+			return nil, err
+		}
+	}
 	return &protos.GetStatesResponse{States: protos.BlobsToStates(states)}, nil
 }
 
+// ListStates lists the states of a type in a network ordered by device ID,
+// optionally only the ones reported by a given gateway
+func (srv *stateServicer) ListStates(context context.Context, req *protos.ListStatesRequest) (*protos.ListStatesResponse, error) {
+	if err := ValidateListStatesRequest(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	cursor, err := decodeStatePageToken(req.GetPageToken())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	networkID := req.GetNetworkID()
+	typeVal := req.GetType()
+
+	store, err := srv.factory.StartTransaction()
+	if err != nil {
+		return nil, err
+	}
+	defer store.Commit()
+
+	ret := &protos.ListStatesResponse{States: []*protos.State{}}
+	pageSize := int(req.GetPageSize())
+	now := time.Now()
+	// States are loaded in batches of the page size, ordered by the blobstore
+	// and starting after the cursor, until the page is full. The reporter
+	// filter possibly excludes states of a batch.
+	for {
+		keys, err := store.ListKeysPage(networkID, typeVal, cursor, uint64(pageSize))
+		if err != nil {
+			return nil, err
+		}
+		if len(keys) == 0 {
+			break
+		}
+		tks := make([]storage.TypeAndKey, 0, len(keys))
+		for _, key := range keys {
+			tks = append(tks, storage.TypeAndKey{Type: typeVal, Key: key})
+		}
+		blobs, err := store.GetMany(networkID, tks)
+		if err != nil {
+			return nil, err
+		}
+		blobsByTK := blobstore.GetBlobsByTypeAndKey(blobs)
+
+		for _, tk := range tks {
+			blob, ok := blobsByTK[tk]
+			if !ok {
+				// Deleted since its key was listed
+				continue
+			}
+			if req.GetReporterID() != "" {
+				value := stateservice.StateValue{}
+				if err := json.Unmarshal(blob.Value, &value); err != nil {
+					return nil, fmt.Errorf("Invalid value of state %s: %s", tk, err)
+				}
+				if value.ReporterID != req.GetReporterID() {
+					continue
+				}
+			}
+			if pageSize > 0 && len(ret.States) == pageSize {
+				ret.NextPageToken = encodeStatePageToken(ret.States[pageSize-1].DeviceID)
+				return ret, nil
+			}
+			blob, err = srv.markIfStale(blob, now)
+			if err != nil {
+				return nil, err
+			}
+			ret.States = append(ret.States, &protos.State{Type: blob.Type, DeviceID: blob.Key, Value: blob.Value})
+		}
+		if pageSize == 0 || len(keys) < pageSize {
+			break
+		}
+		cursor = keys[len(keys)-1]
+	}
+	return ret, nil
+}
+
 // ReportStates saves states into blobstorage
 func (srv *stateServicer) ReportStates(context context.Context, req *protos.ReportStatesRequest) (*protos.Void, error) {
 	ret := &protos.Void{}
@@ -109,6 +198,41 @@ func (srv *stateServicer) DeleteStates(context context.Context, req *protos.Dele
 	return ret, store.Commit()
 }
 
+// markIfStale sets the stale flag of the wrapped value of a state which
+// wasn't reported within the TTL of its type
+func (srv *stateServicer) markIfStale(state blobstore.Blob, now time.Time) (blobstore.Blob, error) {
+	if _, ok := srv.ttls[state.Type]; !ok {
+		return state, nil
+	}
+	value := stateservice.StateValue{}
+	if err := json.Unmarshal(state.Value, &value); err != nil {
+		return state, fmt.Errorf("Invalid value of state %s: %s", storage.TypeAndKey{Type: state.Type, Key: state.Key}, err)
+	}
+	if !srv.ttls.IsExpired(state.Type, &value, now) {
+		return state, nil
+	}
+	value.Stale = true
+	marshaledValue, err := json.Marshal(value)
+	if err != nil {
+		return state, err
+	}
+	state.Value = marshaledValue
+	return state, nil
+}
+
+// The page token is the device ID of the last state of the previous page
+func encodeStatePageToken(deviceID string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(deviceID))
+}
+
+func decodeStatePageToken(token string) (string, error) {
+	deviceID, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return "", fmt.Errorf("Invalid page token")
+	}
+	return string(deviceID), nil
+}
+
 func addAdditionalInfo(state *protos.State, hwID string, time uint64, certExpiry int64) ([]byte, error) {
 	wrap := stateservice.StateValue{
 		ReporterID:         hwID,
@@ -10,19 +10,33 @@ package main
 
 import (
 	"database/sql"
+	"fmt"
+	"time"
 
 	"magma/orc8r/cloud/go/blobstore"
 	"magma/orc8r/cloud/go/datastore"
 	"magma/orc8r/cloud/go/orc8r"
 	"magma/orc8r/cloud/go/protos"
 	"magma/orc8r/cloud/go/service"
+	"magma/orc8r/cloud/go/service/config"
+	"magma/orc8r/cloud/go/services/magmad"
 	"magma/orc8r/cloud/go/services/state"
+	"magma/orc8r/cloud/go/services/state/reaper"
 	"magma/orc8r/cloud/go/services/state/servicers"
 	"magma/orc8r/cloud/go/sql_utils"
 
 	"github.com/golang/glog"
 )
 
+const (
+	// how often to look for expired states to reap
+	STATE_REAP_INTERVAL = time.Minute * 5
+
+	// TTL config keys
+	STATE_TTL_SECS_CONFIG      = "state_ttl_secs"
+	REAP_EXPIRED_STATES_CONFIG = "reap_expired_states"
+)
+
 func main() {
 	srv, err := service.NewOrchestratorService(orc8r.ModuleName, state.ServiceName)
 	if err != nil {
@@ -39,13 +53,47 @@ func main() {
 		glog.Fatalf("Error initializing state database: %s", err)
 	}
 
-	server, err := servicers.NewStateServicer(store)
+	ttls, err := getTTLs(srv.Config)
+	if err != nil {
+		glog.Fatalf("Error reading state TTLs: %s", err)
+	}
+	server, err := servicers.NewStateServicer(store, ttls)
 	if err != nil {
 		glog.Fatalf("Error creating state server: %s", err)
 	}
 	protos.RegisterStateServiceServer(srv.GrpcServer, server)
+
+	// delete expired states instead of only returning them as stale if
+	// configured
+	if len(ttls) > 0 && srv.Config != nil {
+		if reapExpired, err := srv.Config.GetBoolParam(REAP_EXPIRED_STATES_CONFIG); err == nil && reapExpired {
+			stateReaper, err := reaper.NewReaper(store, ttls, magmad.ListNetworks)
+			if err != nil {
+				glog.Fatalf("Error creating state reaper: %s", err)
+			}
+			go stateReaper.Run(STATE_REAP_INTERVAL)
+		}
+	}
+
 	err = srv.Run()
 	if err != nil {
 		glog.Fatalf("Error running service: %s", err)
 	}
 }
+
+// getTTLs reads the TTLs of the state types from the service config, states
+// of types without a TTL never expire
+func getTTLs(cfg *config.ConfigMap) (state.TTLs, error) {
+	if cfg == nil {
+		return state.TTLs{}, nil
+	}
+	rawTTLs, ok := cfg.RawMap[STATE_TTL_SECS_CONFIG]
+	if !ok || rawTTLs == nil {
+		return state.TTLs{}, nil
+	}
+	ttlMap, ok := rawTTLs.(map[interface{}]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%s must map state types to TTLs", STATE_TTL_SECS_CONFIG)
+	}
+	return state.NewTTLsFromConfig(ttlMap)
+}
@@ -21,9 +21,15 @@ import (
 
 // StartTestService instantiates a service backed by an in-memory storage
 func StartTestService(t *testing.T) {
+	StartTestServiceWithTTLs(t, state.TTLs{})
+}
+
+// StartTestServiceWithTTLs instantiates a service backed by an in-memory
+// storage which returns states not reported within the given TTLs as stale
+func StartTestServiceWithTTLs(t *testing.T, ttls state.TTLs) {
 	factory := blobstore.NewMemoryBlobStorageFactory()
 	srv, lis := test_utils.NewTestService(t, orc8r.ModuleName, state.ServiceName)
-	server, err := servicers.NewStateServicer(factory)
+	server, err := servicers.NewStateServicer(factory, ttls)
 	if err != nil {
 		t.Fatalf("Failure to start state test service: %v", err)
 	}
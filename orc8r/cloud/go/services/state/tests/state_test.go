@@ -12,6 +12,7 @@ import (
 	"context"
 	"encoding/json"
 	"testing"
+	"time"
 
 	"magma/orc8r/cloud/go/errors"
 	"magma/orc8r/cloud/go/protos"
@@ -26,6 +27,8 @@ import (
 
 	"github.com/golang/glog"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 const (
@@ -98,6 +101,119 @@ func TestStateService(t *testing.T) {
 	testGetStatesResponse(t, states, bundle1)
 }
 
+func TestListStates(t *testing.T) {
+	magmad_test_init.StartTestService(t)
+	networkID, err := magmad.RegisterNetwork(
+		&magmad_protos.MagmadNetworkRecord{Name: "State Service Test"},
+		"state_service_list_test_network")
+	assert.NoError(t, err)
+	for _, hwID := range []string{testAgHwId, "Other-AGW-Hw-Id"} {
+		_, err = magmad.RegisterGateway(
+			networkID,
+			&magmad_protos.AccessGatewayRecord{HwId: &protos.AccessGatewayID{Id: hwID}, Name: hwID})
+		assert.NoError(t, err)
+	}
+	ctx := test_utils.GetContextWithCertificate(t, testAgHwId)
+	otherCtx := test_utils.GetContextWithCertificate(t, "Other-AGW-Hw-Id")
+
+	test_service.StartTestService(t)
+	serde.UnregisterSerdesForDomain(t, state.SerdeDomain)
+	err = serde.RegisterSerdes(&Serde{})
+	assert.NoError(t, err)
+
+	// Empty network
+	values, ids, nextPageToken, err := state.ListStates(networkID, typeName, "", 0, "")
+	assert.NoError(t, err)
+	assert.Empty(t, values)
+	assert.Empty(t, ids)
+	assert.Empty(t, nextPageToken)
+
+	bundle0 := makeStateBundle(typeName, "key0", Name{Name: "name0"})
+	bundle1 := makeStateBundle(typeName, "key1", Name{Name: "name1"})
+	bundle2 := makeStateBundle(typeName, "key2", Name{Name: "name2"})
+	bundle3 := makeStateBundle(typeName, "key3", Name{Name: "name3"})
+	assert.NoError(t, reportStates(ctx, bundle0, bundle2, bundle3))
+	assert.NoError(t, reportStates(otherCtx, bundle1))
+
+	// All states, ordered by device ID
+	values, ids, nextPageToken, err = state.ListStates(networkID, typeName, "", 0, "")
+	assert.NoError(t, err)
+	assert.Equal(t, []state.StateID{bundle0.ID, bundle1.ID, bundle2.ID, bundle3.ID}, ids)
+	assert.Empty(t, nextPageToken)
+	testGetStatesResponse(t, values, bundle0, bundle1, bundle2, bundle3)
+	assert.Equal(t, "Other-AGW-Hw-Id", values[bundle1.ID].ReporterID)
+
+	// Filtered by reporter, a page at a time
+	_, ids, nextPageToken, err = state.ListStates(networkID, typeName, testAgHwId, 2, "")
+	assert.NoError(t, err)
+	assert.Equal(t, []state.StateID{bundle0.ID, bundle2.ID}, ids)
+	assert.NotEmpty(t, nextPageToken)
+	_, ids, nextPageToken, err = state.ListStates(networkID, typeName, testAgHwId, 2, nextPageToken)
+	assert.NoError(t, err)
+	assert.Equal(t, []state.StateID{bundle3.ID}, ids)
+	assert.Empty(t, nextPageToken)
+
+	// A full last page has no next page
+	_, ids, nextPageToken, err = state.ListStates(networkID, typeName, "", 4, "")
+	assert.NoError(t, err)
+	assert.Len(t, ids, 4)
+	assert.Empty(t, nextPageToken)
+
+	// Other types and networks are not listed
+	_, ids, _, err = state.ListStates(networkID, "otherType", "", 0, "")
+	assert.NoError(t, err)
+	assert.Empty(t, ids)
+	_, ids, _, err = state.ListStates("otherNetwork", typeName, "", 0, "")
+	assert.NoError(t, err)
+	assert.Empty(t, ids)
+
+	// Invalid requests
+	_, _, _, err = state.ListStates(networkID, "", "", 0, "")
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, _, _, err = state.ListStates(networkID, typeName, "", 0, "!")
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestStateTTL(t *testing.T) {
+	magmad_test_init.StartTestService(t)
+	networkID, err := magmad.RegisterNetwork(
+		&magmad_protos.MagmadNetworkRecord{Name: "State Service Test"},
+		"state_service_ttl_test_network")
+	assert.NoError(t, err)
+	_, err = magmad.RegisterGateway(
+		networkID,
+		&magmad_protos.AccessGatewayRecord{HwId: &protos.AccessGatewayID{Id: testAgHwId}, Name: "Test GW Name"})
+	assert.NoError(t, err)
+	ctx := test_utils.GetContextWithCertificate(t, testAgHwId)
+
+	test_service.StartTestServiceWithTTLs(t, state.TTLs{typeName: 50 * time.Millisecond})
+	serde.UnregisterSerdesForDomain(t, state.SerdeDomain)
+	err = serde.RegisterSerdes(&Serde{})
+	assert.NoError(t, err)
+
+	bundle0 := makeStateBundle(typeName, "key0", Name{Name: "name0"})
+	assert.NoError(t, reportStates(ctx, bundle0))
+	value, err := state.GetState(networkID, typeName, "key0")
+	assert.NoError(t, err)
+	assert.False(t, value.Stale)
+
+	// Expired states are returned as stale until they're reported again
+	time.Sleep(100 * time.Millisecond)
+	value, err = state.GetState(networkID, typeName, "key0")
+	assert.NoError(t, err)
+	assert.True(t, value.Stale)
+	assert.Equal(t, bundle0.state.Value, value.ReportedValue)
+	values, _, _, err := state.ListStates(networkID, typeName, "", 0, "")
+	assert.NoError(t, err)
+	assert.True(t, values[bundle0.ID].Stale)
+
+	bundle0 = makeStateBundle(typeName, "key0", Name{Name: "name0"})
+	assert.NoError(t, reportStates(ctx, bundle0))
+	value, err = state.GetState(networkID, typeName, "key0")
+	assert.NoError(t, err)
+	assert.False(t, value.Stale)
+}
+
 type NameAndAge struct {
 	// name
 	Name string `json:"name"`
@@ -0,0 +1,47 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package state
+
+import (
+	"fmt"
+	"time"
+)
+
+// TTLs maps state types to how long states of the type are kept fresh after
+// they were last reported. States of types without a TTL never expire.
+type TTLs map[string]time.Duration
+
+// NewTTLsFromConfig parses the TTLs from a service config map of state types
+// to TTLs in seconds
+func NewTTLsFromConfig(rawTTLs map[interface{}]interface{}) (TTLs, error) {
+	ttls := TTLs{}
+	for k, v := range rawTTLs {
+		typeVal, ok := k.(string)
+		if !ok {
+			return nil, fmt.Errorf("State type is not a string: %v", k)
+		}
+		ttlSecs, ok := v.(int)
+		if !ok || ttlSecs <= 0 {
+			return nil, fmt.Errorf("TTL of state type %s must be a positive number of seconds, got %v", typeVal, v)
+		}
+		ttls[typeVal] = time.Duration(ttlSecs) * time.Second
+	}
+	return ttls, nil
+}
+
+// IsExpired returns whether the state of the given type wasn't reported within
+// its TTL as of now
+func (ttls TTLs) IsExpired(typeVal string, value *StateValue, now time.Time) bool {
+	ttl, ok := ttls[typeVal]
+	if !ok {
+		return false
+	}
+	nowMs := uint64(now.UnixNano()) / uint64(time.Millisecond)
+	return value.Time+uint64(ttl/time.Millisecond) < nowMs
+}
@@ -0,0 +1,44 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package streamer
+
+import (
+	"magma/orc8r/cloud/go/errors"
+	"magma/orc8r/cloud/go/protos"
+	"magma/orc8r/cloud/go/registry"
+
+	"github.com/golang/glog"
+	"golang.org/x/net/context"
+)
+
+func getStreamerClient() (protos.StreamerClient, error) {
+	conn, err := registry.GetConnection(ServiceName)
+	if err != nil {
+		initErr := errors.NewInitError(err, ServiceName)
+		glog.Error(initErr)
+		return nil, initErr
+	}
+	return protos.NewStreamerClient(conn), err
+}
+
+// NotifyStreamUpdate records that the items with the given keys of the stream
+// in the network changed. Delta streams only push the changes recorded here, so
+// writers of a stream's data must call it after each successful write and
+// report the write as failed if it returns an error.
+func NotifyStreamUpdate(streamName string, networkID string, keys []string) error {
+	client, err := getStreamerClient()
+	if err != nil {
+		return err
+	}
+	_, err = client.NotifyStreamUpdate(
+		context.Background(),
+		&protos.StreamUpdateNotification{StreamName: streamName, NetworkId: networkID, Keys: keys},
+	)
+	return err
+}
@@ -0,0 +1,34 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package providers
+
+import (
+	"magma/orc8r/cloud/go/protos"
+
+	"github.com/golang/protobuf/ptypes/any"
+)
+
+// DeltaStreamProvider is a StreamProvider capable of incremental updates.
+// Streams served by a DeltaStreamProvider stay open and the streamer pushes
+// the items changed since the gateway's cursor as the stream's data changes.
+// Writers of the stream's data must record every change with
+// streamer.NotifyStreamUpdate, the streamer reads the keys of the changed
+// items from the changelog of the stream and the gateway's network.
+type DeltaStreamProvider interface {
+	StreamProvider
+
+	// GetNetworkId returns the network whose changelog holds the changes of
+	// the gateway's stream
+	GetNetworkId(gatewayId string) (string, error)
+
+	// GetUpdatesOf returns the current items of the gateway's stream with
+	// the given keys. Keys of items which don't exist (anymore) are omitted
+	// and streamed as deleted keys.
+	GetUpdatesOf(gatewayId string, extraArgs *any.Any, keys []string) ([]*protos.DataUpdate, error)
+}
@@ -0,0 +1,73 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package providers
+
+import (
+	"sync"
+)
+
+type subscription chan struct{}
+
+// streamNetwork identifies the data of a stream in a network
+type streamNetwork struct {
+	streamName string
+	networkID  string
+}
+
+type notifier struct {
+	sync.Mutex
+	subscriptions map[streamNetwork]map[subscription]struct{}
+}
+
+var streamNotifier = &notifier{subscriptions: map[streamNetwork]map[subscription]struct{}{}}
+
+// NotifyStreamUpdate wakes up the open streams of this process with the given
+// name and network so they can push pending changes to their gateways without
+// waiting for the next poll. Services writing the stream's data notify the
+// streamer service via streamer.NotifyStreamUpdate instead.
+// This function is thread-safe and never blocks.
+func NotifyStreamUpdate(streamName string, networkID string) {
+	streamNotifier.Lock()
+	defer streamNotifier.Unlock()
+	for sub := range streamNotifier.subscriptions[streamNetwork{streamName, networkID}] {
+		select {
+		case sub <- struct{}{}:
+		default: // a notification is already pending
+		}
+	}
+}
+
+// SubscribeStreamUpdates returns a channel receiving a notification after
+// every NotifyStreamUpdate call for the given stream and network.
+// Notifications are coalesced - multiple updates may result in a single
+// notification. The returned function must be called to release the
+// subscription.
+func SubscribeStreamUpdates(streamName string, networkID string) (<-chan struct{}, func()) {
+	key := streamNetwork{streamName, networkID}
+	sub := make(subscription, 1)
+	streamNotifier.Lock()
+	subs, ok := streamNotifier.subscriptions[key]
+	if !ok {
+		subs = map[subscription]struct{}{}
+		streamNotifier.subscriptions[key] = subs
+	}
+	subs[sub] = struct{}{}
+	streamNotifier.Unlock()
+
+	return sub, func() {
+		streamNotifier.Lock()
+		defer streamNotifier.Unlock()
+		if subs, ok := streamNotifier.subscriptions[key]; ok {
+			delete(subs, sub)
+			if len(subs) == 0 {
+				delete(streamNotifier.subscriptions, key)
+			}
+		}
+	}
+}
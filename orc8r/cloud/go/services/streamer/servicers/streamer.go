@@ -16,17 +16,39 @@ the updates would be pushed through that channel.
 package servicers
 
 import (
+	"time"
+
 	"magma/orc8r/cloud/go/identity"
 	"magma/orc8r/cloud/go/protos"
 	"magma/orc8r/cloud/go/services/streamer/providers"
+	"magma/orc8r/cloud/go/services/streamer/store"
 
+	"golang.org/x/net/context"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
-type StreamingServer struct{}
+// DefaultDeltaPollInterval is the default interval between checks for changes
+// of streams served by delta stream providers
+const DefaultDeltaPollInterval = time.Second * 15
+
+// DeltaPollInterval is the interval between checks of the changelogs of open
+// delta streams. Changes recorded via NotifyStreamUpdate are pushed immediately
+// by the notified streamer replica, the poll catches the changes recorded by
+// the other replicas.
+var DeltaPollInterval = DefaultDeltaPollInterval
 
-func GetUpdatesUnverified(
+type StreamingServer struct {
+	changelogs *store.ChangelogStore
+}
+
+// NewStreamingServer returns a streaming server computing the incremental
+// updates of delta streams from the given changelogs
+func NewStreamingServer(changelogs *store.ChangelogStore) *StreamingServer {
+	return &StreamingServer{changelogs: changelogs}
+}
+
+func (srv *StreamingServer) GetUpdatesUnverified(
 	request *protos.StreamRequest,
 	stream protos.Streamer_GetUpdatesServer,
 ) error {
@@ -34,6 +56,9 @@ func GetUpdatesUnverified(
 	if err != nil {
 		return status.Errorf(codes.Unavailable, "Stream %s does not exist", request.GetStreamName())
 	}
+	if deltaProvider, ok := streamProvider.(providers.DeltaStreamProvider); ok {
+		return srv.streamDeltas(request, deltaProvider, stream)
+	}
 	updates, err := streamProvider.GetUpdates(request.GetGatewayId(), request.ExtraArgs)
 	if err != nil {
 		return status.Errorf(codes.Aborted, "Error while streaming updates: %s", err)
@@ -45,6 +70,85 @@ func GetUpdatesUnverified(
 	return nil
 }
 
+// streamDeltas keeps the stream open and sends a batch every time the
+// changelog of the stream and the gateway's network has new changes, starting
+// from the gateway supplied cursor. Only the changed items are loaded from the
+// provider, unless the cursor falls back to resync. The stream is closed when
+// the gateway cancels it or on error.
+func (srv *StreamingServer) streamDeltas(
+	request *protos.StreamRequest,
+	provider providers.DeltaStreamProvider,
+	stream protos.Streamer_GetUpdatesServer,
+) error {
+	networkID, err := provider.GetNetworkId(request.GetGatewayId())
+	if err != nil {
+		return status.Errorf(codes.Aborted, "Error while streaming updates: %s", err)
+	}
+	notifications, unsubscribe := providers.SubscribeStreamUpdates(request.GetStreamName(), networkID)
+	defer unsubscribe()
+
+	ticker := time.NewTicker(DeltaPollInterval)
+	defer ticker.Stop()
+
+	cursor := request.GetCursor()
+	for {
+		// The changelog is read before the items, so items changed in
+		// between are sent again with the next batch rather than missed
+		keys, latestCursor, resync, err := srv.changelogs.GetChangesSince(request.GetStreamName(), networkID, cursor)
+		if err != nil {
+			return status.Errorf(codes.Aborted, "Error while streaming updates: %s", err)
+		}
+		if resync || len(keys) > 0 {
+			batch, err := getDeltaBatch(request, provider, keys, resync)
+			if err != nil {
+				return status.Errorf(codes.Aborted, "Error while streaming updates: %s", err)
+			}
+			batch.Cursor = latestCursor
+			err = stream.Send(batch)
+			if err != nil {
+				return err
+			}
+		}
+		cursor = latestCursor
+
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case <-notifications:
+		case <-ticker.C:
+		}
+	}
+}
+
+// getDeltaBatch returns all items of the stream on resync, otherwise the
+// current items with the changed keys and the keys of the deleted items
+func getDeltaBatch(
+	request *protos.StreamRequest,
+	provider providers.DeltaStreamProvider,
+	changedKeys []string,
+	resync bool,
+) (*protos.DataUpdateBatch, error) {
+	if resync {
+		updates, err := provider.GetUpdates(request.GetGatewayId(), request.ExtraArgs)
+		return &protos.DataUpdateBatch{Updates: updates, Resync: true}, err
+	}
+	updates, err := provider.GetUpdatesOf(request.GetGatewayId(), request.ExtraArgs, changedKeys)
+	if err != nil {
+		return nil, err
+	}
+	updated := make(map[string]bool, len(updates))
+	for _, update := range updates {
+		updated[update.GetKey()] = true
+	}
+	batch := &protos.DataUpdateBatch{Updates: updates}
+	for _, key := range changedKeys {
+		if !updated[key] {
+			batch.DeletedKeys = append(batch.DeletedKeys, key)
+		}
+	}
+	return batch, nil
+}
+
 func (srv *StreamingServer) GetUpdates(
 	request *protos.StreamRequest,
 	stream protos.Streamer_GetUpdatesServer,
@@ -65,5 +169,29 @@ func (srv *StreamingServer) GetUpdates(
 	// Gateways may avoid doing so. We should be working with verified
 	// identities in both cases or reject the request if there is none.
 	request.GatewayId = gwIdentity.HardwareId
-	return GetUpdatesUnverified(request, stream)
+	return srv.GetUpdatesUnverified(request, stream)
+}
+
+// NotifyStreamUpdate records the change in the changelog of the stream and
+// network and wakes up their open streams on this streamer replica, only cloud
+// services are allowed to call it
+func (srv *StreamingServer) NotifyStreamUpdate(
+	ctx context.Context,
+	notification *protos.StreamUpdateNotification,
+) (*protos.Void, error) {
+	if protos.GetClientGateway(ctx) != nil {
+		return nil, status.Error(codes.PermissionDenied, "Gateways cannot notify stream updates")
+	}
+	if len(notification.GetStreamName()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Stream name is empty")
+	}
+	if len(notification.GetNetworkId()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Network ID is empty")
+	}
+	err := srv.changelogs.Append(notification.GetStreamName(), notification.GetNetworkId(), notification.GetKeys())
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "Error while recording stream update: %s", err)
+	}
+	providers.NotifyStreamUpdate(notification.GetStreamName(), notification.GetNetworkId())
+	return &protos.Void{}, nil
 }
@@ -10,18 +10,26 @@ package servicers_test
 
 import (
 	"errors"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
+	"magma/orc8r/cloud/go/identity"
 	"magma/orc8r/cloud/go/protos"
 	"magma/orc8r/cloud/go/registry"
 	"magma/orc8r/cloud/go/services/streamer"
 	"magma/orc8r/cloud/go/services/streamer/providers"
+	"magma/orc8r/cloud/go/services/streamer/servicers"
+	"magma/orc8r/cloud/go/services/streamer/store"
 	streamer_test_init "magma/orc8r/cloud/go/services/streamer/test_init"
+	"magma/orc8r/cloud/go/test_utils"
 
 	"github.com/golang/protobuf/ptypes/any"
 	"github.com/stretchr/testify/assert"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type mockStreamProvider struct {
@@ -84,3 +92,152 @@ func TestStreamingServer_GetUpdates(t *testing.T) {
 	_, err = streamerClient.Recv()
 	assert.Error(t, err, "Stream stream_dne does not exist", codes.Unavailable)
 }
+
+type mockDeltaProvider struct {
+	sync.Mutex
+	name  string
+	items map[string][]byte
+}
+
+func (m *mockDeltaProvider) GetStreamName() string {
+	return m.name
+}
+
+func (m *mockDeltaProvider) GetNetworkId(gatewayId string) (string, error) {
+	if gatewayId != "hwId" {
+		return "", errors.New("Unknown gateway")
+	}
+	return "network", nil
+}
+
+func (m *mockDeltaProvider) GetUpdates(gatewayId string, extraArgs *any.Any) ([]*protos.DataUpdate, error) {
+	m.Lock()
+	defer m.Unlock()
+	updates := []*protos.DataUpdate{}
+	for key, value := range m.items {
+		updates = append(updates, &protos.DataUpdate{Key: key, Value: value})
+	}
+	return updates, nil
+}
+
+func (m *mockDeltaProvider) GetUpdatesOf(gatewayId string, extraArgs *any.Any, keys []string) ([]*protos.DataUpdate, error) {
+	m.Lock()
+	defer m.Unlock()
+	updates := []*protos.DataUpdate{}
+	for _, key := range keys {
+		if value, ok := m.items[key]; ok {
+			updates = append(updates, &protos.DataUpdate{Key: key, Value: value})
+		}
+	}
+	return updates, nil
+}
+
+func (m *mockDeltaProvider) setItems(items map[string][]byte) {
+	m.Lock()
+	m.items = items
+	m.Unlock()
+}
+
+func TestStreamingServer_GetUpdatesDelta(t *testing.T) {
+	servicers.DeltaPollInterval = time.Hour // rely on notifications only
+	defer func() { servicers.DeltaPollInterval = servicers.DefaultDeltaPollInterval }()
+
+	streamer_test_init.StartTestService(t)
+	conn, err := registry.GetConnection(streamer.ServiceName)
+	assert.NoError(t, err)
+	grpcClient := protos.NewStreamerClient(conn)
+
+	mock := &mockDeltaProvider{name: "delta1", items: map[string][]byte{"a": []byte("123"), "b": []byte("456")}}
+	err = providers.RegisterStreamProvider(mock)
+	assert.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	streamerClient, err := grpcClient.GetUpdates(ctx, &protos.StreamRequest{GatewayId: "hwId", StreamName: "delta1"})
+	assert.NoError(t, err)
+
+	// First batch is a resync
+	batch, err := streamerClient.Recv()
+	assert.NoError(t, err)
+	assert.True(t, batch.GetResync())
+	assert.Equal(t, 2, len(batch.GetUpdates()))
+	assert.NotEmpty(t, batch.GetCursor())
+	cursor := batch.GetCursor()
+
+	// The stream stays open & pushes only the recorded changes
+	mock.setItems(map[string][]byte{"a": []byte("789"), "c": []byte("000")})
+	assert.NoError(t, streamer.NotifyStreamUpdate("delta1", "network", []string{"a", "b", "a"}))
+	batch, err = streamerClient.Recv()
+	assert.NoError(t, err)
+	assert.False(t, batch.GetResync())
+	assert.Equal(t, 1, len(batch.GetUpdates()))
+	assert.Equal(t, protos.TestMarshal(&protos.DataUpdate{Key: "a", Value: []byte("789")}), protos.TestMarshal(batch.Updates[0]))
+	assert.Equal(t, []string{"b"}, batch.GetDeletedKeys())
+	assert.NotEqual(t, cursor, batch.GetCursor())
+	cursor = batch.GetCursor()
+	cancel()
+
+	// Changes of other networks are not streamed, reconnecting with the last
+	// cursor only sends the changes since then
+	assert.NoError(t, streamer.NotifyStreamUpdate("delta1", "other_network", []string{"c"}))
+	assert.NoError(t, streamer.NotifyStreamUpdate("delta1", "network", []string{"c"}))
+	streamerClient, err = grpcClient.GetUpdates(
+		context.Background(),
+		&protos.StreamRequest{GatewayId: "hwId", StreamName: "delta1", Cursor: cursor},
+	)
+	assert.NoError(t, err)
+	batch, err = streamerClient.Recv()
+	assert.NoError(t, err)
+	assert.False(t, batch.GetResync())
+	assert.Equal(t, 1, len(batch.GetUpdates()))
+	assert.Equal(t, "c", batch.Updates[0].GetKey())
+	assert.Empty(t, batch.GetDeletedKeys())
+
+	// Invalid cursor falls back to resync
+	streamerClient, err = grpcClient.GetUpdates(
+		context.Background(),
+		&protos.StreamRequest{GatewayId: "hwId", StreamName: "delta1", Cursor: "expired"},
+	)
+	assert.NoError(t, err)
+	batch, err = streamerClient.Recv()
+	assert.NoError(t, err)
+	assert.True(t, batch.GetResync())
+	assert.Equal(t, 2, len(batch.GetUpdates()))
+
+	// Unknown gateway
+	streamerClient, err = grpcClient.GetUpdates(
+		context.Background(),
+		&protos.StreamRequest{GatewayId: "hwId2", StreamName: "delta1"},
+	)
+	assert.NoError(t, err)
+	_, err = streamerClient.Recv()
+	assert.Equal(t, codes.Aborted, status.Code(err))
+}
+
+func TestStreamingServer_NotifyStreamUpdate(t *testing.T) {
+	changelogs := store.NewChangelogStore(test_utils.NewMockDatastore(), 0)
+	srv := servicers.NewStreamingServer(changelogs)
+	notifications, unsubscribe := providers.SubscribeStreamUpdates("notified", "network")
+	defer unsubscribe()
+	otherNotifications, unsubscribeOther := providers.SubscribeStreamUpdates("notified", "other_network")
+	defer unsubscribeOther()
+
+	// Gateways can't notify updates
+	notification := &protos.StreamUpdateNotification{StreamName: "notified", NetworkId: "network", Keys: []string{"a"}}
+	gwCtx := identity.NewGateway("hwId", "network", "logicalId").NewContextWithIdentity(context.Background())
+	_, err := srv.NotifyStreamUpdate(gwCtx, notification)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = srv.NotifyStreamUpdate(context.Background(), &protos.StreamUpdateNotification{NetworkId: "network"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = srv.NotifyStreamUpdate(context.Background(), &protos.StreamUpdateNotification{StreamName: "notified"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Empty(t, notifications)
+
+	_, err = srv.NotifyStreamUpdate(context.Background(), notification)
+	assert.NoError(t, err)
+	assert.Len(t, notifications, 1)
+	assert.Empty(t, otherNotifications)
+	_, cursor, resync, err := changelogs.GetChangesSince("notified", "network", "")
+	assert.NoError(t, err)
+	assert.True(t, resync)
+	assert.True(t, strings.HasSuffix(cursor, ":1"))
+}
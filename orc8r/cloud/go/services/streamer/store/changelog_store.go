@@ -0,0 +1,172 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+// Package store implements the changelogs the streamer computes the
+// incremental updates of delta streams from
+package store
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"magma/orc8r/cloud/go/datastore"
+	"magma/orc8r/cloud/go/protos"
+)
+
+const (
+	// NetworkID-partitioned table: stream name -> protos.StreamChangelog
+	ChangelogTableName = "streamChangelogs"
+
+	// DefaultMaxChanges is the default number of changes kept per stream and
+	// network
+	DefaultMaxChanges = 1000
+
+	// Attempts to append to a changelog which is appended to concurrently
+	maxAppendAttempts = 10
+)
+
+// ChangelogStore keeps the keys of the latest changed items of every stream
+// and network in the datastore, with a version per change. Changelogs are only
+// updated with conditional writes, so all replicas of the streamer share them
+// and cursors (the version of the last change applied by a gateway) are valid
+// on every replica.
+type ChangelogStore struct {
+	store      datastore.Api
+	maxChanges int
+}
+
+// NewChangelogStore returns a store keeping at most maxChanges changes per
+// stream and network, older cursors fall back to resync. If maxChanges <= 0,
+// DefaultMaxChanges is used.
+func NewChangelogStore(ds datastore.Api, maxChanges int) *ChangelogStore {
+	if maxChanges <= 0 {
+		maxChanges = DefaultMaxChanges
+	}
+	return &ChangelogStore{store: ds, maxChanges: maxChanges}
+}
+
+// Append records the change of the items with the given keys of the stream in
+// the network
+func (s *ChangelogStore) Append(streamName string, networkID string, keys []string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	for attempt := 0; attempt < maxAppendAttempts; attempt++ {
+		changelog, marshaled, err := s.getOrCreate(streamName, networkID)
+		if err != nil {
+			return err
+		}
+		changelog.Keys = append(changelog.Keys, keys...)
+		if trimmed := len(changelog.Keys) - s.maxChanges; trimmed > 0 {
+			changelog.Keys = changelog.Keys[trimmed:]
+			changelog.FirstVersion += uint64(trimmed)
+		}
+		updated, err := s.compareAndPut(streamName, networkID, marshaled, changelog)
+		if err != nil || updated {
+			return err
+		}
+	}
+	return fmt.Errorf("changelog of stream %s in network %s is updated concurrently", streamName, networkID)
+}
+
+// GetChangesSince returns the unique keys of the items of the stream in the
+// network changed since the given cursor, and the cursor of the latest
+// change. If the cursor is empty, invalid or older than the changelog, resync
+// is true and no keys are returned.
+func (s *ChangelogStore) GetChangesSince(
+	streamName string,
+	networkID string,
+	cursor string,
+) (keys []string, latestCursor string, resync bool, err error) {
+	changelog, _, err := s.getOrCreate(streamName, networkID)
+	if err != nil {
+		return nil, "", false, err
+	}
+	latestVersion := changelog.FirstVersion + uint64(len(changelog.Keys)) - 1
+	latestCursor = fmt.Sprintf("%s:%d", changelog.Epoch, latestVersion)
+
+	epoch, version, ok := parseCursor(cursor)
+	if !ok || epoch != changelog.Epoch || version > latestVersion || version+1 < changelog.FirstVersion {
+		return nil, latestCursor, true, nil
+	}
+	seen := map[string]bool{}
+	for _, key := range changelog.Keys[version+1-changelog.FirstVersion:] {
+		if !seen[key] {
+			seen[key] = true
+			keys = append(keys, key)
+		}
+	}
+	return keys, latestCursor, false, nil
+}
+
+// getOrCreate also returns the marshaled changelog to update it with
+// compareAndPut
+func (s *ChangelogStore) getOrCreate(streamName string, networkID string) (*protos.StreamChangelog, []byte, error) {
+	table := getChangelogTableName(networkID)
+	marshaled, _, err := s.store.Get(table, streamName)
+	if err == datastore.ErrNotFound {
+		marshaled, err = newMarshaledChangelog()
+		if err != nil {
+			return nil, nil, err
+		}
+		created, err := s.store.PutIfAbsent(table, streamName, marshaled)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !created {
+			marshaled, _, err = s.store.Get(table, streamName)
+		}
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	changelog := &protos.StreamChangelog{}
+	err = protos.Unmarshal(marshaled, changelog)
+	return changelog, marshaled, err
+}
+
+func (s *ChangelogStore) compareAndPut(
+	streamName string,
+	networkID string,
+	expected []byte,
+	changelog *protos.StreamChangelog,
+) (bool, error) {
+	marshaled, err := protos.MarshalIntern(changelog)
+	if err != nil {
+		return false, err
+	}
+	return s.store.PutIfValue(getChangelogTableName(networkID), streamName, expected, marshaled)
+}
+
+func getChangelogTableName(networkID string) string {
+	return datastore.GetTableName(networkID, ChangelogTableName)
+}
+
+// newMarshaledChangelog returns an empty changelog with a new random epoch
+func newMarshaledChangelog() ([]byte, error) {
+	epoch := make([]byte, 8)
+	if _, err := rand.Read(epoch); err != nil {
+		return nil, err
+	}
+	return protos.MarshalIntern(&protos.StreamChangelog{Epoch: hex.EncodeToString(epoch), FirstVersion: 1})
+}
+
+func parseCursor(cursor string) (string, uint64, bool) {
+	parts := strings.Split(cursor, ":")
+	if len(parts) != 2 {
+		return "", 0, false
+	}
+	version, err := strconv.ParseUint(parts[1], 10, 64)
+	if err != nil {
+		return "", 0, false
+	}
+	return parts[0], version, true
+}
@@ -0,0 +1,92 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package store_test
+
+import (
+	"testing"
+
+	"magma/orc8r/cloud/go/datastore"
+	"magma/orc8r/cloud/go/services/streamer/store"
+	"magma/orc8r/cloud/go/sql_utils"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChangelogStore(t *testing.T) {
+	ds, err := datastore.NewSqlDb("sqlite3", ":memory:", sql_utils.GetSqlBuilder())
+	assert.NoError(t, err)
+	changelogs := store.NewChangelogStore(ds, 3)
+
+	// Empty cursor resyncs
+	keys, cursor, resync, err := changelogs.GetChangesSince("stream", "network", "")
+	assert.NoError(t, err)
+	assert.True(t, resync)
+	assert.Empty(t, keys)
+
+	assert.NoError(t, changelogs.Append("stream", "network", []string{"a", "b"}))
+	assert.NoError(t, changelogs.Append("stream", "network", []string{"a"}))
+	assert.NoError(t, changelogs.Append("stream", "network", nil))
+	keys, latestCursor, resync, err := changelogs.GetChangesSince("stream", "network", cursor)
+	assert.NoError(t, err)
+	assert.False(t, resync)
+	assert.Equal(t, []string{"a", "b"}, keys)
+	assert.NotEqual(t, cursor, latestCursor)
+
+	// No changes since the latest cursor
+	keys, cursor, resync, err = changelogs.GetChangesSince("stream", "network", latestCursor)
+	assert.NoError(t, err)
+	assert.False(t, resync)
+	assert.Empty(t, keys)
+	assert.Equal(t, latestCursor, cursor)
+
+	// Changelogs of other streams and networks are separate, and cursors
+	// aren't valid across changelogs
+	assert.NoError(t, changelogs.Append("stream", "network2", []string{"c"}))
+	assert.NoError(t, changelogs.Append("stream2", "network", []string{"d"}))
+	keys, _, resync, err = changelogs.GetChangesSince("stream", "network", cursor)
+	assert.NoError(t, err)
+	assert.False(t, resync)
+	assert.Empty(t, keys)
+	_, _, resync, err = changelogs.GetChangesSince("stream2", "network", cursor)
+	assert.NoError(t, err)
+	assert.True(t, resync)
+
+	// Changes beyond maxChanges are trimmed, older cursors resync
+	assert.NoError(t, changelogs.Append("stream", "network", []string{"e"}))
+	keys, _, resync, err = changelogs.GetChangesSince("stream", "network", cursor)
+	assert.NoError(t, err)
+	assert.False(t, resync)
+	assert.Equal(t, []string{"e"}, keys)
+	assert.NoError(t, changelogs.Append("stream", "network", []string{"f", "g", "h"}))
+	keys, latestCursor, resync, err = changelogs.GetChangesSince("stream", "network", cursor)
+	assert.NoError(t, err)
+	assert.True(t, resync)
+	assert.Empty(t, keys)
+
+	// Invalid cursors resync
+	for _, invalid := range []string{"expired", "epoch:1", cursor + "0"} {
+		_, _, resync, err = changelogs.GetChangesSince("stream", "network", invalid)
+		assert.NoError(t, err)
+		assert.True(t, resync, invalid)
+	}
+
+	// Changelogs are shared by all stores of the datastore
+	otherChangelogs := store.NewChangelogStore(ds, 0)
+	keys, cursor, resync, err = otherChangelogs.GetChangesSince("stream", "network", latestCursor)
+	assert.NoError(t, err)
+	assert.False(t, resync)
+	assert.Empty(t, keys)
+	assert.Equal(t, latestCursor, cursor)
+	assert.NoError(t, otherChangelogs.Append("stream", "network", []string{"i"}))
+	keys, _, resync, err = changelogs.GetChangesSince("stream", "network", latestCursor)
+	assert.NoError(t, err)
+	assert.False(t, resync)
+	assert.Equal(t, []string{"i"}, keys)
+}
@@ -11,11 +11,14 @@ package main
 import (
 	"log"
 
+	"magma/orc8r/cloud/go/datastore"
 	"magma/orc8r/cloud/go/orc8r"
 	"magma/orc8r/cloud/go/protos"
 	"magma/orc8r/cloud/go/service"
 	"magma/orc8r/cloud/go/services/streamer"
 	"magma/orc8r/cloud/go/services/streamer/servicers"
+	"magma/orc8r/cloud/go/services/streamer/store"
+	"magma/orc8r/cloud/go/sql_utils"
 )
 
 func main() {
@@ -25,8 +28,15 @@ func main() {
 		log.Fatalf("Error creating service: %s", err)
 	}
 
+	// Init the Datastore
+	ds, err :=
+		datastore.NewSqlDb(datastore.SQL_DRIVER, datastore.DATABASE_SOURCE, sql_utils.GetSqlBuilder())
+	if err != nil {
+		log.Fatalf("Failed to initialize datastore: %s", err)
+	}
+
 	// Add servicers to the service
-	servicer := &servicers.StreamingServer{}
+	servicer := servicers.NewStreamingServer(store.NewChangelogStore(ds, store.DefaultMaxChanges))
 	protos.RegisterStreamerServer(srv.GrpcServer, servicer)
 	srv.GrpcServer.RegisterService(protos.GetLegacyStreamerDesc(), servicer)
 
@@ -15,23 +15,25 @@ import (
 	"magma/orc8r/cloud/go/protos"
 	"magma/orc8r/cloud/go/services/streamer"
 	"magma/orc8r/cloud/go/services/streamer/servicers"
+	"magma/orc8r/cloud/go/services/streamer/store"
 	"magma/orc8r/cloud/go/test_utils"
 )
 
 // A little Go "polymorphism" magic for testing
 type testStreamingServer struct {
-	servicers.StreamingServer
+	*servicers.StreamingServer
 }
 
 func (srv *testStreamingServer) GetUpdates(
 	request *protos.StreamRequest,
 	stream protos.Streamer_GetUpdatesServer,
 ) error {
-	return servicers.GetUpdatesUnverified(request, stream)
+	return srv.GetUpdatesUnverified(request, stream)
 }
 
 func StartTestService(t *testing.T) {
 	srv, lis := test_utils.NewTestService(t, orc8r.ModuleName, streamer.ServiceName)
-	protos.RegisterStreamerServer(srv.GrpcServer, &testStreamingServer{})
+	changelogs := store.NewChangelogStore(test_utils.NewMockDatastore(), 0)
+	protos.RegisterStreamerServer(srv.GrpcServer, &testStreamingServer{servicers.NewStreamingServer(changelogs)})
 	go srv.RunTest(lis)
 }
@@ -16,6 +16,8 @@ import (
 
 	"github.com/golang/glog"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 const ServiceName = "UPGRADE"
@@ -170,3 +172,72 @@ func DeleteTier(networkId string, tierId string) error {
 	_, err = client.DeleteTier(context.Background(), req)
 	return err
 }
+
+// A rollout upgrades the gateways of a tier to a new version in waves.
+// Gateways which aren't part of a started wave stay on the version of the
+// tier, see upgrade_protos.GetGatewayPackageVersion.
+
+// Start rolling out a new version to the gateways of a tier.
+func StartTierRollout(networkId string, tierId string, plan *upgrade_protos.RolloutPlan) error {
+	client, err := getUpgradeServiceClient()
+	if err != nil {
+		return err
+	}
+
+	req := &upgrade_protos.StartTierRolloutRequest{
+		NetworkId: networkId,
+		TierId:    tierId,
+		Plan:      plan,
+	}
+	_, err = client.StartTierRollout(context.Background(), req)
+	return err
+}
+
+// Get the latest rollout of a tier. Returns errors.ErrNotFound if the tier
+// never had a rollout.
+func GetTierRollout(networkId string, tierId string) (*upgrade_protos.TierRollout, error) {
+	client, err := getUpgradeServiceClient()
+	if err != nil {
+		return nil, err
+	}
+
+	req := &upgrade_protos.TierRolloutRequest{NetworkId: networkId, TierId: tierId}
+	res, err := client.GetTierRollout(context.Background(), req)
+	if status.Code(err) == codes.NotFound {
+		return nil, errors.ErrNotFound
+	}
+	return res, err
+}
+
+func PauseTierRollout(networkId string, tierId string) error {
+	client, err := getUpgradeServiceClient()
+	if err != nil {
+		return err
+	}
+
+	req := &upgrade_protos.TierRolloutRequest{NetworkId: networkId, TierId: tierId}
+	_, err = client.PauseTierRollout(context.Background(), req)
+	return err
+}
+
+func ResumeTierRollout(networkId string, tierId string) error {
+	client, err := getUpgradeServiceClient()
+	if err != nil {
+		return err
+	}
+
+	req := &upgrade_protos.TierRolloutRequest{NetworkId: networkId, TierId: tierId}
+	_, err = client.ResumeTierRollout(context.Background(), req)
+	return err
+}
+
+func RollbackTierRollout(networkId string, tierId string) error {
+	client, err := getUpgradeServiceClient()
+	if err != nil {
+		return err
+	}
+
+	req := &upgrade_protos.TierRolloutRequest{NetworkId: networkId, TierId: tierId}
+	_, err = client.RollbackTierRollout(context.Background(), req)
+	return err
+}
@@ -26,6 +26,10 @@ const (
 	ReleaseChannelsManagePath = ReleaseChannelsRootPath + "/:channel_id"
 	TiersRootPath             = handlers.REST_ROOT + "/networks/:network_id/tiers"
 	TiersManagePath           = TiersRootPath + "/:tier_id"
+	TierRolloutPath           = TiersManagePath + "/rollout"
+	PauseTierRolloutPath      = TierRolloutPath + "/pause"
+	ResumeTierRolloutPath     = TierRolloutPath + "/resume"
+	RollbackTierRolloutPath   = TierRolloutPath + "/rollback"
 )
 
 // GetObsidianHandlers returns the obsidian handlers for upgrade
@@ -41,6 +45,11 @@ func GetObsidianHandlers() []handlers.Handler {
 		{Path: TiersManagePath, Methods: handlers.GET, HandlerFunc: getTierHandler},
 		{Path: TiersManagePath, Methods: handlers.PUT, HandlerFunc: updateTierHandler},
 		{Path: TiersManagePath, Methods: handlers.DELETE, HandlerFunc: deleteTierHandler},
+		{Path: TierRolloutPath, Methods: handlers.GET, HandlerFunc: getTierRolloutHandler},
+		{Path: TierRolloutPath, Methods: handlers.POST, HandlerFunc: startTierRolloutHandler},
+		{Path: PauseTierRolloutPath, Methods: handlers.POST, HandlerFunc: pauseTierRolloutHandler},
+		{Path: ResumeTierRolloutPath, Methods: handlers.POST, HandlerFunc: resumeTierRolloutHandler},
+		{Path: RollbackTierRolloutPath, Methods: handlers.POST, HandlerFunc: rollbackTierRolloutHandler},
 	}
 }
 
@@ -18,6 +18,7 @@ import (
 	"magma/orc8r/cloud/go/plugin"
 	"magma/orc8r/cloud/go/pluginimpl"
 	magmad_test_init "magma/orc8r/cloud/go/services/magmad/test_init"
+	"magma/orc8r/cloud/go/services/upgrade/obsidian/models"
 	upgrade_test_init "magma/orc8r/cloud/go/services/upgrade/test_init"
 
 	"github.com/stretchr/testify/assert"
@@ -253,3 +254,130 @@ func TestTiers(t *testing.T) {
 	}
 	tests.RunTest(t, removeNetworkTestCase)
 }
+
+func TestTierRollouts(t *testing.T) {
+	plugin.RegisterPluginForTests(t, &pluginimpl.BaseOrchestratorPlugin{})
+	magmad_test_init.StartTestService(t)
+	upgrade_test_init.StartTestService(t)
+	restPort := tests.StartObsidian(t)
+	netUrlRoot := fmt.Sprintf("http://localhost:%d%s/networks", restPort, handlers.REST_ROOT)
+
+	registerNetworkTestCase := tests.Testcase{
+		Name:                      "Register Network",
+		Method:                    "POST",
+		Url:                       fmt.Sprintf("%s?requested_id=upgrade_obsidian_rollout_network", netUrlRoot),
+		Payload:                   `{"name":"This Is A Test Network Name"}`,
+		Skip_payload_verification: true,
+	}
+	_, networkId, err := tests.RunTest(t, registerNetworkTestCase)
+	assert.NoError(t, err)
+	json.Unmarshal([]byte(networkId), &networkId)
+
+	tierUrl := fmt.Sprintf("%s/%s/tiers/t1", netUrlRoot, networkId)
+	rolloutUrl := fmt.Sprintf("%s/rollout", tierUrl)
+	createTierTestCase := tests.Testcase{
+		Name:                      "Create Tier",
+		Method:                    "POST",
+		Url:                       fmt.Sprintf("%s/%s/tiers", netUrlRoot, networkId),
+		Payload:                   `{"id": "t1", "name": "t1", "version": "1.0.0-0"}`,
+		Skip_payload_verification: true,
+	}
+	tests.RunTest(t, createTierTestCase)
+
+	// Tier never had a rollout
+	status, _, err := tests.SendHttpRequest("GET", rolloutUrl, "")
+	assert.NoError(t, err)
+	assert.Equal(t, 404, status)
+
+	// Invalid plans
+	status, _, err = tests.SendHttpRequest("POST", rolloutUrl, `{"target_version": "1.1.0-0", "waves": []}`)
+	assert.NoError(t, err)
+	assert.Equal(t, 400, status)
+	status, _, err = tests.SendHttpRequest("POST", rolloutUrl, `{"target_version": "1.1.0-0", "waves": [{"percentage": 50}]}`)
+	assert.NoError(t, err)
+	assert.Equal(t, 400, status)
+	status, _, err = tests.SendHttpRequest(
+		"POST",
+		rolloutUrl,
+		`{"target_version": "1.1.0-0", "waves": [{"percentage": 100}], "failure_action": "IGNORE"}`,
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, 400, status)
+
+	startRolloutTestCase := tests.Testcase{
+		Name:   "Start Tier Rollout",
+		Method: "POST",
+		Url:    rolloutUrl,
+		Payload: `{
+			"target_version": "1.1.0-0",
+			"waves": [{"gateway_ids": ["gw1"]}, {"percentage": 100}],
+			"health_check": {"soak_time_secs": 600, "required_status_meta": ["mme"]},
+			"failure_action": "ROLLBACK"
+		}`,
+		Expected: "",
+	}
+	tests.RunTest(t, startRolloutTestCase)
+
+	// Tier already has an active rollout
+	status, _, err = tests.SendHttpRequest("POST", rolloutUrl, startRolloutTestCase.Payload)
+	assert.NoError(t, err)
+	assert.Equal(t, 409, status)
+
+	status, body, err := tests.SendHttpRequest("GET", rolloutUrl, "")
+	assert.NoError(t, err)
+	assert.Equal(t, 200, status)
+	rollout := &models.TierRollout{}
+	assert.NoError(t, json.Unmarshal([]byte(body), rollout))
+	assert.Equal(t, models.TierRolloutStateINPROGRESS, rollout.State)
+	assert.Equal(t, uint32(0), rollout.CurrentWave)
+	assert.Equal(t, "1.1.0-0", rollout.Plan.TargetVersion)
+	assert.Equal(t, []string{"gw1"}, rollout.Plan.Waves[0].GatewayIds)
+	assert.Equal(t, uint32(100), rollout.Plan.Waves[1].Percentage)
+	assert.Equal(t, models.RolloutPlanFailureActionROLLBACK, rollout.Plan.FailureAction)
+	assert.Equal(t, &models.RolloutHealthCheck{
+		MaxCheckinAgeSecs:  300,
+		RequiredStatusMeta: []string{"mme"},
+		SoakTimeSecs:       600,
+		WaveTimeoutSecs:    3600,
+	}, rollout.Plan.HealthCheck)
+
+	// The version of the tier can't change during the rollout
+	status, _, err = tests.SendHttpRequest("PUT", tierUrl, `{"id": "t1", "name": "t1", "version": "1.2.0-0"}`)
+	assert.NoError(t, err)
+	assert.Equal(t, 500, status)
+
+	// State transitions
+	status, _, err = tests.SendHttpRequest("POST", rolloutUrl+"/resume", "")
+	assert.NoError(t, err)
+	assert.Equal(t, 409, status)
+	status, _, err = tests.SendHttpRequest("POST", rolloutUrl+"/pause", "")
+	assert.NoError(t, err)
+	assert.Equal(t, 200, status)
+	status, body, err = tests.SendHttpRequest("GET", rolloutUrl, "")
+	assert.NoError(t, err)
+	assert.NoError(t, json.Unmarshal([]byte(body), rollout))
+	assert.Equal(t, models.TierRolloutStatePAUSED, rollout.State)
+	status, _, err = tests.SendHttpRequest("POST", rolloutUrl+"/resume", "")
+	assert.NoError(t, err)
+	assert.Equal(t, 200, status)
+	status, _, err = tests.SendHttpRequest("POST", rolloutUrl+"/rollback", "")
+	assert.NoError(t, err)
+	assert.Equal(t, 200, status)
+	status, body, err = tests.SendHttpRequest("GET", rolloutUrl, "")
+	assert.NoError(t, err)
+	assert.NoError(t, json.Unmarshal([]byte(body), rollout))
+	assert.Equal(t, models.TierRolloutStateROLLEDBACK, rollout.State)
+	status, _, err = tests.SendHttpRequest("POST", rolloutUrl+"/pause", "")
+	assert.NoError(t, err)
+	assert.Equal(t, 409, status)
+
+	// Remove network
+	removeNetworkTestCase := tests.Testcase{
+		Name:     "Force Remove Non Empty Network",
+		Method:   "DELETE",
+		Url:      fmt.Sprintf("%s/%s?mode=force", netUrlRoot, networkId),
+		Payload:  "",
+		Expected: "",
+	}
+	tests.RunTest(t, removeNetworkTestCase)
+}
@@ -0,0 +1,107 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package handlers
+
+import (
+	"net/http"
+
+	merrors "magma/orc8r/cloud/go/errors"
+	"magma/orc8r/cloud/go/obsidian/handlers"
+	upgrade_client "magma/orc8r/cloud/go/services/upgrade"
+	"magma/orc8r/cloud/go/services/upgrade/obsidian/models"
+
+	"github.com/labstack/echo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func getTierRolloutHandler(c echo.Context) error {
+	networkId, tierId, err := getNetworkAndTierIds(c)
+	if err != nil {
+		return err
+	}
+
+	rollout, err := upgrade_client.GetTierRollout(networkId, tierId)
+	if err == merrors.ErrNotFound {
+		return handlers.HttpError(err, http.StatusNotFound)
+	}
+	if err != nil {
+		return handlers.HttpError(err, http.StatusInternalServerError)
+	}
+	return c.JSON(http.StatusOK, models.TierRolloutFromProto(rollout))
+}
+
+func startTierRolloutHandler(c echo.Context) error {
+	networkId, tierId, err := getNetworkAndTierIds(c)
+	if err != nil {
+		return err
+	}
+	restPlan := new(models.RolloutPlan)
+	if err := c.Bind(restPlan); err != nil {
+		return handlers.HttpError(err, http.StatusBadRequest)
+	}
+	if err := restPlan.ValidateModel(); err != nil {
+		return handlers.HttpError(err, http.StatusBadRequest)
+	}
+
+	err = upgrade_client.StartTierRollout(networkId, tierId, models.RolloutPlanToProto(restPlan))
+	if err != nil {
+		return rolloutError(err)
+	}
+	return c.NoContent(http.StatusCreated)
+}
+
+func pauseTierRolloutHandler(c echo.Context) error {
+	return updateTierRolloutState(c, upgrade_client.PauseTierRollout)
+}
+
+func resumeTierRolloutHandler(c echo.Context) error {
+	return updateTierRolloutState(c, upgrade_client.ResumeTierRollout)
+}
+
+func rollbackTierRolloutHandler(c echo.Context) error {
+	return updateTierRolloutState(c, upgrade_client.RollbackTierRollout)
+}
+
+func updateTierRolloutState(c echo.Context, update func(networkId string, tierId string) error) error {
+	networkId, tierId, err := getNetworkAndTierIds(c)
+	if err != nil {
+		return err
+	}
+	if err := update(networkId, tierId); err != nil {
+		return rolloutError(err)
+	}
+	return c.NoContent(http.StatusOK)
+}
+
+func getNetworkAndTierIds(c echo.Context) (string, string, error) {
+	networkId, nerr := handlers.GetNetworkId(c)
+	if nerr != nil {
+		return "", "", nerr
+	}
+	tierId := c.Param("tier_id")
+	if tierId == "" {
+		return "", "", noTierIdError()
+	}
+	return networkId, tierId, nil
+}
+
+// rolloutError maps the errors of rollout operations to HTTP errors. The
+// upgrade service returns FAILED_PRECONDITION if the state of the tier or its
+// rollout doesn't allow the operation (plans are validated before).
+func rolloutError(err error) error {
+	switch status.Code(err) {
+	case codes.NotFound:
+		return handlers.HttpError(err, http.StatusNotFound)
+	case codes.FailedPrecondition:
+		return handlers.HttpError(err, http.StatusConflict)
+	default:
+		return handlers.HttpError(err, http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,82 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package models
+
+import (
+	"magma/orc8r/cloud/go/services/upgrade/protos"
+
+	"github.com/go-openapi/strfmt"
+)
+
+var formatsRegistry = strfmt.NewFormats()
+
+func (m *RolloutPlan) ValidateModel() error {
+	if err := m.Validate(formatsRegistry); err != nil {
+		return err
+	}
+	return protos.ValidateRolloutPlan(RolloutPlanToProto(m))
+}
+
+func RolloutPlanToProto(plan *RolloutPlan) *protos.RolloutPlan {
+	waves := make([]*protos.RolloutWave, 0, len(plan.Waves))
+	for _, wave := range plan.Waves {
+		if wave == nil {
+			continue
+		}
+		waves = append(waves, &protos.RolloutWave{Percentage: wave.Percentage, GatewayIds: wave.GatewayIds})
+	}
+	ret := &protos.RolloutPlan{
+		TargetVersion: plan.TargetVersion,
+		Waves:         waves,
+		FailureAction: protos.RolloutPlan_FailureAction(protos.RolloutPlan_FailureAction_value[plan.FailureAction]),
+	}
+	if plan.HealthCheck != nil {
+		ret.HealthCheck = &protos.RolloutHealthCheck{
+			MaxCheckinAgeSecs:  plan.HealthCheck.MaxCheckinAgeSecs,
+			RequiredStatusMeta: plan.HealthCheck.RequiredStatusMeta,
+			SoakTimeSecs:       plan.HealthCheck.SoakTimeSecs,
+			WaveTimeoutSecs:    plan.HealthCheck.WaveTimeoutSecs,
+		}
+	}
+	return ret
+}
+
+func RolloutPlanFromProto(plan *protos.RolloutPlan) *RolloutPlan {
+	waves := make([]*RolloutWave, 0, len(plan.GetWaves()))
+	for _, wave := range plan.GetWaves() {
+		waves = append(waves, &RolloutWave{Percentage: wave.Percentage, GatewayIds: wave.GatewayIds})
+	}
+	ret := &RolloutPlan{
+		TargetVersion: plan.GetTargetVersion(),
+		Waves:         waves,
+		FailureAction: plan.GetFailureAction().String(),
+	}
+	if plan.GetHealthCheck() != nil {
+		ret.HealthCheck = &RolloutHealthCheck{
+			MaxCheckinAgeSecs:  plan.HealthCheck.MaxCheckinAgeSecs,
+			RequiredStatusMeta: plan.HealthCheck.RequiredStatusMeta,
+			SoakTimeSecs:       plan.HealthCheck.SoakTimeSecs,
+			WaveTimeoutSecs:    plan.HealthCheck.WaveTimeoutSecs,
+		}
+	}
+	return ret
+}
+
+func TierRolloutFromProto(rollout *protos.TierRollout) *TierRollout {
+	return &TierRollout{
+		Plan:              RolloutPlanFromProto(rollout.GetPlan()),
+		State:             rollout.GetState().String(),
+		CurrentWave:       rollout.GetCurrentWave(),
+		WaveStartedAt:     rollout.GetWaveStartedAt(),
+		WaveHealthySince:  rollout.GetWaveHealthySince(),
+		UnhealthyGateways: rollout.GetUnhealthyGateways(),
+		Message:           rollout.GetMessage(),
+		UpgradedGateways:  rollout.GetUpgradedGateways(),
+	}
+}
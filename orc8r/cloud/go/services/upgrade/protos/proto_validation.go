@@ -10,6 +10,7 @@ package protos
 
 import (
 	"errors"
+	"fmt"
 )
 
 func ValidateCreateOrUpdateReleaseChannelReq(req *CreateOrUpdateReleaseChannelRequest) error {
@@ -112,3 +113,56 @@ func ValidateTierInfo(info *TierInfo) error {
 	}
 	return nil
 }
+
+func ValidateStartTierRolloutReq(req *StartTierRolloutRequest) error {
+	if req == nil {
+		return errors.New("Request is nil")
+	}
+	if req.GetNetworkId() == "" {
+		return errors.New("NetworkID must be specified")
+	}
+	if req.GetTierId() == "" {
+		return errors.New("Tier ID must be specified")
+	}
+	return ValidateRolloutPlan(req.GetPlan())
+}
+
+func ValidateTierRolloutReq(req *TierRolloutRequest) error {
+	if req == nil {
+		return errors.New("Request is nil")
+	}
+	if req.GetNetworkId() == "" {
+		return errors.New("NetworkID must be specified")
+	}
+	if req.GetTierId() == "" {
+		return errors.New("Tier ID must be specified")
+	}
+	return nil
+}
+
+func ValidateRolloutPlan(plan *RolloutPlan) error {
+	if plan == nil {
+		return errors.New("Rollout plan must be specified")
+	}
+	if plan.GetTargetVersion() == "" {
+		return errors.New("Target version must be specified")
+	}
+	waves := plan.GetWaves()
+	if len(waves) == 0 {
+		return errors.New("At least one wave must be specified")
+	}
+	previousPercentage := uint32(0)
+	for i, wave := range waves {
+		if wave.GetPercentage() > 100 {
+			return fmt.Errorf("Percentage of wave %d must be at most 100", i)
+		}
+		if wave.GetPercentage() < previousPercentage {
+			return fmt.Errorf("Percentage of wave %d must not be lower than the percentage of the previous wave", i)
+		}
+		previousPercentage = wave.GetPercentage()
+	}
+	if previousPercentage != 100 {
+		return errors.New("Percentage of the last wave must be 100")
+	}
+	return nil
+}
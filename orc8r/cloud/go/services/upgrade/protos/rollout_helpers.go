@@ -0,0 +1,74 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package protos
+
+import (
+	"sort"
+)
+
+// GetGatewayPackageVersion returns the package version a gateway of a tier
+// should run given the latest rollout of the tier, which may be nil.
+func GetGatewayPackageVersion(tier *TierInfo, rollout *TierRollout, gatewayID string) string {
+	if rollout.IsGatewayUpgraded(gatewayID) {
+		return rollout.GetPlan().GetTargetVersion()
+	}
+	return tier.GetVersion()
+}
+
+// IsActive returns true if the rollout is in progress or paused
+func (rollout *TierRollout) IsActive() bool {
+	if rollout == nil {
+		return false
+	}
+	return rollout.State == TierRollout_IN_PROGRESS || rollout.State == TierRollout_PAUSED
+}
+
+// IsGatewayUpgraded returns true if the gateway was picked by a started wave
+// of an active rollout, i.e. the gateway should run the target version of the
+// rollout rather than the version of its tier.
+func (rollout *TierRollout) IsGatewayUpgraded(gatewayID string) bool {
+	if !rollout.IsActive() {
+		return false
+	}
+	for _, id := range rollout.GetUpgradedGateways() {
+		if id == gatewayID {
+			return true
+		}
+	}
+	return false
+}
+
+// SelectGateways returns the gateways of the tier the wave includes: the
+// first ceil(percentage * N / 100) of the N gateways of the tier sorted by ID,
+// at least one if the percentage isn't 0, and the listed gateways of the tier.
+func (wave *RolloutWave) SelectGateways(tierGatewayIDs []string) []string {
+	sortedIDs := append([]string{}, tierGatewayIDs...)
+	sort.Strings(sortedIDs)
+	count := (int(wave.GetPercentage())*len(sortedIDs) + 99) / 100
+	if count > len(sortedIDs) {
+		count = len(sortedIDs)
+	}
+
+	selected := append([]string{}, sortedIDs[:count]...)
+	isSelected := make(map[string]bool, len(sortedIDs))
+	for _, id := range selected {
+		isSelected[id] = true
+	}
+	isTierGateway := make(map[string]bool, len(sortedIDs))
+	for _, id := range sortedIDs {
+		isTierGateway[id] = true
+	}
+	for _, id := range wave.GetGatewayIds() {
+		if isTierGateway[id] && !isSelected[id] {
+			selected = append(selected, id)
+			isSelected[id] = true
+		}
+	}
+	return selected
+}
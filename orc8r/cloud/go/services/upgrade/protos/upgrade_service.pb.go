@@ -1,5 +1,5 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
-// source: upgrade_service.proto
+// source: orc8r/cloud/go/services/upgrade/protos/upgrade_service.proto
 
 package protos
 
@@ -24,6 +24,61 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+type RolloutPlan_FailureAction int32
+
+const (
+	// Stop advancing the rollout, upgraded gateways stay on the target
+	// version
+	RolloutPlan_PAUSE RolloutPlan_FailureAction = 0
+	// Return all gateways of the tier to the version of the tier
+	RolloutPlan_ROLLBACK RolloutPlan_FailureAction = 1
+)
+
+var RolloutPlan_FailureAction_name = map[int32]string{
+	0: "PAUSE",
+	1: "ROLLBACK",
+}
+var RolloutPlan_FailureAction_value = map[string]int32{
+	"PAUSE":    0,
+	"ROLLBACK": 1,
+}
+
+func (x RolloutPlan_FailureAction) String() string {
+	return proto.EnumName(RolloutPlan_FailureAction_name, int32(x))
+}
+func (RolloutPlan_FailureAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_upgrade_service_bbc15c19ff666f7a, []int{14, 0}
+}
+
+type TierRollout_State int32
+
+const (
+	TierRollout_IN_PROGRESS TierRollout_State = 0
+	TierRollout_PAUSED      TierRollout_State = 1
+	TierRollout_ROLLED_BACK TierRollout_State = 2
+	TierRollout_COMPLETED   TierRollout_State = 3
+)
+
+var TierRollout_State_name = map[int32]string{
+	0: "IN_PROGRESS",
+	1: "PAUSED",
+	2: "ROLLED_BACK",
+	3: "COMPLETED",
+}
+var TierRollout_State_value = map[string]int32{
+	"IN_PROGRESS": 0,
+	"PAUSED":      1,
+	"ROLLED_BACK": 2,
+	"COMPLETED":   3,
+}
+
+func (x TierRollout_State) String() string {
+	return proto.EnumName(TierRollout_State_name, int32(x))
+}
+func (TierRollout_State) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_upgrade_service_bbc15c19ff666f7a, []int{17, 0}
+}
+
 type ListReleaseChannelsResponse struct {
 	ChannelIds           []string `protobuf:"bytes,1,rep,name=channel_ids,json=channelIds,proto3" json:"channel_ids,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -35,7 +90,7 @@ func (m *ListReleaseChannelsResponse) Reset()         { *m = ListReleaseChannels
 func (m *ListReleaseChannelsResponse) String() string { return proto.CompactTextString(m) }
 func (*ListReleaseChannelsResponse) ProtoMessage()    {}
 func (*ListReleaseChannelsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_upgrade_service_e8f4c9695f70c9a6, []int{0}
+	return fileDescriptor_upgrade_service_bbc15c19ff666f7a, []int{0}
 }
 func (m *ListReleaseChannelsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListReleaseChannelsResponse.Unmarshal(m, b)
@@ -74,7 +129,7 @@ func (m *CreateOrUpdateReleaseChannelRequest) Reset()         { *m = CreateOrUpd
 func (m *CreateOrUpdateReleaseChannelRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateOrUpdateReleaseChannelRequest) ProtoMessage()    {}
 func (*CreateOrUpdateReleaseChannelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_upgrade_service_e8f4c9695f70c9a6, []int{1}
+	return fileDescriptor_upgrade_service_bbc15c19ff666f7a, []int{1}
 }
 func (m *CreateOrUpdateReleaseChannelRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateOrUpdateReleaseChannelRequest.Unmarshal(m, b)
@@ -119,7 +174,7 @@ func (m *GetReleaseChannelRequest) Reset()         { *m = GetReleaseChannelReque
 func (m *GetReleaseChannelRequest) String() string { return proto.CompactTextString(m) }
 func (*GetReleaseChannelRequest) ProtoMessage()    {}
 func (*GetReleaseChannelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_upgrade_service_e8f4c9695f70c9a6, []int{2}
+	return fileDescriptor_upgrade_service_bbc15c19ff666f7a, []int{2}
 }
 func (m *GetReleaseChannelRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetReleaseChannelRequest.Unmarshal(m, b)
@@ -157,7 +212,7 @@ func (m *DeleteReleaseChannelRequest) Reset()         { *m = DeleteReleaseChanne
 func (m *DeleteReleaseChannelRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteReleaseChannelRequest) ProtoMessage()    {}
 func (*DeleteReleaseChannelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_upgrade_service_e8f4c9695f70c9a6, []int{3}
+	return fileDescriptor_upgrade_service_bbc15c19ff666f7a, []int{3}
 }
 func (m *DeleteReleaseChannelRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteReleaseChannelRequest.Unmarshal(m, b)
@@ -198,7 +253,7 @@ func (m *GetTiersRequest) Reset()         { *m = GetTiersRequest{} }
 func (m *GetTiersRequest) String() string { return proto.CompactTextString(m) }
 func (*GetTiersRequest) ProtoMessage()    {}
 func (*GetTiersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_upgrade_service_e8f4c9695f70c9a6, []int{4}
+	return fileDescriptor_upgrade_service_bbc15c19ff666f7a, []int{4}
 }
 func (m *GetTiersRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetTiersRequest.Unmarshal(m, b)
@@ -244,7 +299,7 @@ func (m *GetTiersResponse) Reset()         { *m = GetTiersResponse{} }
 func (m *GetTiersResponse) String() string { return proto.CompactTextString(m) }
 func (*GetTiersResponse) ProtoMessage()    {}
 func (*GetTiersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_upgrade_service_e8f4c9695f70c9a6, []int{5}
+	return fileDescriptor_upgrade_service_bbc15c19ff666f7a, []int{5}
 }
 func (m *GetTiersResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetTiersResponse.Unmarshal(m, b)
@@ -286,7 +341,7 @@ func (m *CreateTierRequest) Reset()         { *m = CreateTierRequest{} }
 func (m *CreateTierRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateTierRequest) ProtoMessage()    {}
 func (*CreateTierRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_upgrade_service_e8f4c9695f70c9a6, []int{6}
+	return fileDescriptor_upgrade_service_bbc15c19ff666f7a, []int{6}
 }
 func (m *CreateTierRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateTierRequest.Unmarshal(m, b)
@@ -342,7 +397,7 @@ func (m *UpdateTierRequest) Reset()         { *m = UpdateTierRequest{} }
 func (m *UpdateTierRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateTierRequest) ProtoMessage()    {}
 func (*UpdateTierRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_upgrade_service_e8f4c9695f70c9a6, []int{7}
+	return fileDescriptor_upgrade_service_bbc15c19ff666f7a, []int{7}
 }
 func (m *UpdateTierRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateTierRequest.Unmarshal(m, b)
@@ -396,7 +451,7 @@ func (m *DeleteTierRequest) Reset()         { *m = DeleteTierRequest{} }
 func (m *DeleteTierRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteTierRequest) ProtoMessage()    {}
 func (*DeleteTierRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_upgrade_service_e8f4c9695f70c9a6, []int{8}
+	return fileDescriptor_upgrade_service_bbc15c19ff666f7a, []int{8}
 }
 func (m *DeleteTierRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteTierRequest.Unmarshal(m, b)
@@ -430,6 +485,107 @@ func (m *DeleteTierRequest) GetTierIdToDelete() string {
 	return ""
 }
 
+type StartTierRolloutRequest struct {
+	NetworkId string `protobuf:"bytes,1,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+	// ID of the tier to roll out a new version to
+	TierId               string       `protobuf:"bytes,2,opt,name=tier_id,json=tierId,proto3" json:"tier_id,omitempty"`
+	Plan                 *RolloutPlan `protobuf:"bytes,3,opt,name=plan,proto3" json:"plan,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *StartTierRolloutRequest) Reset()         { *m = StartTierRolloutRequest{} }
+func (m *StartTierRolloutRequest) String() string { return proto.CompactTextString(m) }
+func (*StartTierRolloutRequest) ProtoMessage()    {}
+func (*StartTierRolloutRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_upgrade_service_bbc15c19ff666f7a, []int{9}
+}
+func (m *StartTierRolloutRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StartTierRolloutRequest.Unmarshal(m, b)
+}
+func (m *StartTierRolloutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StartTierRolloutRequest.Marshal(b, m, deterministic)
+}
+func (dst *StartTierRolloutRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StartTierRolloutRequest.Merge(dst, src)
+}
+func (m *StartTierRolloutRequest) XXX_Size() int {
+	return xxx_messageInfo_StartTierRolloutRequest.Size(m)
+}
+func (m *StartTierRolloutRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StartTierRolloutRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StartTierRolloutRequest proto.InternalMessageInfo
+
+func (m *StartTierRolloutRequest) GetNetworkId() string {
+	if m != nil {
+		return m.NetworkId
+	}
+	return ""
+}
+
+func (m *StartTierRolloutRequest) GetTierId() string {
+	if m != nil {
+		return m.TierId
+	}
+	return ""
+}
+
+func (m *StartTierRolloutRequest) GetPlan() *RolloutPlan {
+	if m != nil {
+		return m.Plan
+	}
+	return nil
+}
+
+type TierRolloutRequest struct {
+	NetworkId            string   `protobuf:"bytes,1,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+	TierId               string   `protobuf:"bytes,2,opt,name=tier_id,json=tierId,proto3" json:"tier_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TierRolloutRequest) Reset()         { *m = TierRolloutRequest{} }
+func (m *TierRolloutRequest) String() string { return proto.CompactTextString(m) }
+func (*TierRolloutRequest) ProtoMessage()    {}
+func (*TierRolloutRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_upgrade_service_bbc15c19ff666f7a, []int{10}
+}
+func (m *TierRolloutRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TierRolloutRequest.Unmarshal(m, b)
+}
+func (m *TierRolloutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TierRolloutRequest.Marshal(b, m, deterministic)
+}
+func (dst *TierRolloutRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TierRolloutRequest.Merge(dst, src)
+}
+func (m *TierRolloutRequest) XXX_Size() int {
+	return xxx_messageInfo_TierRolloutRequest.Size(m)
+}
+func (m *TierRolloutRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TierRolloutRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TierRolloutRequest proto.InternalMessageInfo
+
+func (m *TierRolloutRequest) GetNetworkId() string {
+	if m != nil {
+		return m.NetworkId
+	}
+	return ""
+}
+
+func (m *TierRolloutRequest) GetTierId() string {
+	if m != nil {
+		return m.TierId
+	}
+	return ""
+}
+
 type ReleaseChannel struct {
 	SupportedVersions    []string `protobuf:"bytes,1,rep,name=supported_versions,json=supportedVersions,proto3" json:"supported_versions,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -441,7 +597,7 @@ func (m *ReleaseChannel) Reset()         { *m = ReleaseChannel{} }
 func (m *ReleaseChannel) String() string { return proto.CompactTextString(m) }
 func (*ReleaseChannel) ProtoMessage()    {}
 func (*ReleaseChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_upgrade_service_e8f4c9695f70c9a6, []int{9}
+	return fileDescriptor_upgrade_service_bbc15c19ff666f7a, []int{11}
 }
 func (m *ReleaseChannel) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReleaseChannel.Unmarshal(m, b)
@@ -480,7 +636,7 @@ func (m *ImageSpec) Reset()         { *m = ImageSpec{} }
 func (m *ImageSpec) String() string { return proto.CompactTextString(m) }
 func (*ImageSpec) ProtoMessage()    {}
 func (*ImageSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_upgrade_service_e8f4c9695f70c9a6, []int{10}
+	return fileDescriptor_upgrade_service_bbc15c19ff666f7a, []int{12}
 }
 func (m *ImageSpec) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ImageSpec.Unmarshal(m, b)
@@ -527,7 +683,7 @@ func (m *TierInfo) Reset()         { *m = TierInfo{} }
 func (m *TierInfo) String() string { return proto.CompactTextString(m) }
 func (*TierInfo) ProtoMessage()    {}
 func (*TierInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_upgrade_service_e8f4c9695f70c9a6, []int{11}
+	return fileDescriptor_upgrade_service_bbc15c19ff666f7a, []int{13}
 }
 func (m *TierInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TierInfo.Unmarshal(m, b)
@@ -568,6 +724,299 @@ func (m *TierInfo) GetImages() []*ImageSpec {
 	return nil
 }
 
+// A rollout upgrades the gateways of a tier to a new version in waves instead
+// of all at once. Gateways which aren't part of a started wave yet stay on the
+// version of the tier until the rollout completes, at which point the version
+// of the tier is set to the target version of the rollout.
+type RolloutPlan struct {
+	// Version to roll out to the gateways of the tier
+	TargetVersion string `protobuf:"bytes,1,opt,name=target_version,json=targetVersion,proto3" json:"target_version,omitempty"`
+	// Waves of gateways to upgrade, in order. Waves are cumulative: a wave
+	// includes all gateways of the waves before it. The last wave must cover
+	// the whole tier (100 percent).
+	Waves       []*RolloutWave      `protobuf:"bytes,2,rep,name=waves,proto3" json:"waves,omitempty"`
+	HealthCheck *RolloutHealthCheck `protobuf:"bytes,3,opt,name=health_check,json=healthCheck,proto3" json:"health_check,omitempty"`
+	// What to do if the gateways of a wave don't become healthy in time
+	FailureAction        RolloutPlan_FailureAction `protobuf:"varint,4,opt,name=failure_action,json=failureAction,proto3,enum=magma.orc8r.upgrade.RolloutPlan_FailureAction" json:"failure_action,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *RolloutPlan) Reset()         { *m = RolloutPlan{} }
+func (m *RolloutPlan) String() string { return proto.CompactTextString(m) }
+func (*RolloutPlan) ProtoMessage()    {}
+func (*RolloutPlan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_upgrade_service_bbc15c19ff666f7a, []int{14}
+}
+func (m *RolloutPlan) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RolloutPlan.Unmarshal(m, b)
+}
+func (m *RolloutPlan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RolloutPlan.Marshal(b, m, deterministic)
+}
+func (dst *RolloutPlan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RolloutPlan.Merge(dst, src)
+}
+func (m *RolloutPlan) XXX_Size() int {
+	return xxx_messageInfo_RolloutPlan.Size(m)
+}
+func (m *RolloutPlan) XXX_DiscardUnknown() {
+	xxx_messageInfo_RolloutPlan.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RolloutPlan proto.InternalMessageInfo
+
+func (m *RolloutPlan) GetTargetVersion() string {
+	if m != nil {
+		return m.TargetVersion
+	}
+	return ""
+}
+
+func (m *RolloutPlan) GetWaves() []*RolloutWave {
+	if m != nil {
+		return m.Waves
+	}
+	return nil
+}
+
+func (m *RolloutPlan) GetHealthCheck() *RolloutHealthCheck {
+	if m != nil {
+		return m.HealthCheck
+	}
+	return nil
+}
+
+func (m *RolloutPlan) GetFailureAction() RolloutPlan_FailureAction {
+	if m != nil {
+		return m.FailureAction
+	}
+	return RolloutPlan_PAUSE
+}
+
+type RolloutWave struct {
+	// Percentage of the gateways of the tier to include in the wave. The wave
+	// includes the first ceil(percentage * N / 100) of the N gateways of the
+	// tier sorted by ID, at least one gateway if the percentage isn't 0.
+	Percentage uint32 `protobuf:"varint,1,opt,name=percentage,proto3" json:"percentage,omitempty"`
+	// Gateways to include in the wave regardless of the percentage, e.g. to
+	// canary specific gateways first
+	GatewayIds           []string `protobuf:"bytes,2,rep,name=gateway_ids,json=gatewayIds,proto3" json:"gateway_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RolloutWave) Reset()         { *m = RolloutWave{} }
+func (m *RolloutWave) String() string { return proto.CompactTextString(m) }
+func (*RolloutWave) ProtoMessage()    {}
+func (*RolloutWave) Descriptor() ([]byte, []int) {
+	return fileDescriptor_upgrade_service_bbc15c19ff666f7a, []int{15}
+}
+func (m *RolloutWave) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RolloutWave.Unmarshal(m, b)
+}
+func (m *RolloutWave) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RolloutWave.Marshal(b, m, deterministic)
+}
+func (dst *RolloutWave) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RolloutWave.Merge(dst, src)
+}
+func (m *RolloutWave) XXX_Size() int {
+	return xxx_messageInfo_RolloutWave.Size(m)
+}
+func (m *RolloutWave) XXX_DiscardUnknown() {
+	xxx_messageInfo_RolloutWave.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RolloutWave proto.InternalMessageInfo
+
+func (m *RolloutWave) GetPercentage() uint32 {
+	if m != nil {
+		return m.Percentage
+	}
+	return 0
+}
+
+func (m *RolloutWave) GetGatewayIds() []string {
+	if m != nil {
+		return m.GatewayIds
+	}
+	return nil
+}
+
+// Conditions the upgraded gateways must meet for a wave to be healthy
+type RolloutHealthCheck struct {
+	// Max time since the last checkin of a gateway. Defaults to 5 minutes.
+	MaxCheckinAgeSecs uint32 `protobuf:"varint,1,opt,name=max_checkin_age_secs,json=maxCheckinAgeSecs,proto3" json:"max_checkin_age_secs,omitempty"`
+	// Service status meta keys every gateway must report in its checkin.
+	// Gateways only report status meta of services which are running.
+	RequiredStatusMeta []string `protobuf:"bytes,2,rep,name=required_status_meta,json=requiredStatusMeta,proto3" json:"required_status_meta,omitempty"`
+	// Time a wave must stay healthy before the next wave starts
+	SoakTimeSecs uint32 `protobuf:"varint,3,opt,name=soak_time_secs,json=soakTimeSecs,proto3" json:"soak_time_secs,omitempty"`
+	// Time the gateways of a wave have to become healthy after the wave
+	// started before the failure action is taken. Defaults to 1 hour.
+	WaveTimeoutSecs      uint32   `protobuf:"varint,4,opt,name=wave_timeout_secs,json=waveTimeoutSecs,proto3" json:"wave_timeout_secs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RolloutHealthCheck) Reset()         { *m = RolloutHealthCheck{} }
+func (m *RolloutHealthCheck) String() string { return proto.CompactTextString(m) }
+func (*RolloutHealthCheck) ProtoMessage()    {}
+func (*RolloutHealthCheck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_upgrade_service_bbc15c19ff666f7a, []int{16}
+}
+func (m *RolloutHealthCheck) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RolloutHealthCheck.Unmarshal(m, b)
+}
+func (m *RolloutHealthCheck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RolloutHealthCheck.Marshal(b, m, deterministic)
+}
+func (dst *RolloutHealthCheck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RolloutHealthCheck.Merge(dst, src)
+}
+func (m *RolloutHealthCheck) XXX_Size() int {
+	return xxx_messageInfo_RolloutHealthCheck.Size(m)
+}
+func (m *RolloutHealthCheck) XXX_DiscardUnknown() {
+	xxx_messageInfo_RolloutHealthCheck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RolloutHealthCheck proto.InternalMessageInfo
+
+func (m *RolloutHealthCheck) GetMaxCheckinAgeSecs() uint32 {
+	if m != nil {
+		return m.MaxCheckinAgeSecs
+	}
+	return 0
+}
+
+func (m *RolloutHealthCheck) GetRequiredStatusMeta() []string {
+	if m != nil {
+		return m.RequiredStatusMeta
+	}
+	return nil
+}
+
+func (m *RolloutHealthCheck) GetSoakTimeSecs() uint32 {
+	if m != nil {
+		return m.SoakTimeSecs
+	}
+	return 0
+}
+
+func (m *RolloutHealthCheck) GetWaveTimeoutSecs() uint32 {
+	if m != nil {
+		return m.WaveTimeoutSecs
+	}
+	return 0
+}
+
+type TierRollout struct {
+	Plan  *RolloutPlan      `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan,omitempty"`
+	State TierRollout_State `protobuf:"varint,2,opt,name=state,proto3,enum=magma.orc8r.upgrade.TierRollout_State" json:"state,omitempty"`
+	// Index of the latest started wave
+	CurrentWave uint32 `protobuf:"varint,3,opt,name=current_wave,json=currentWave,proto3" json:"current_wave,omitempty"`
+	// Unix time (seconds) the current wave started at
+	WaveStartedAt int64 `protobuf:"varint,4,opt,name=wave_started_at,json=waveStartedAt,proto3" json:"wave_started_at,omitempty"`
+	// Unix time (seconds) since which all upgraded gateways are healthy, 0 if
+	// some gateways aren't healthy
+	WaveHealthySince int64 `protobuf:"varint,5,opt,name=wave_healthy_since,json=waveHealthySince,proto3" json:"wave_healthy_since,omitempty"`
+	// Upgraded gateways which failed the last health check with the reason
+	UnhealthyGateways map[string]string `protobuf:"bytes,6,rep,name=unhealthy_gateways,json=unhealthyGateways,proto3" json:"unhealthy_gateways,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Reason of the last state change
+	Message string `protobuf:"bytes,7,opt,name=message,proto3" json:"message,omitempty"`
+	// Gateways of the started waves, which should run the target version.
+	// Gateways are only ever added while the rollout is in progress.
+	UpgradedGateways     []string `protobuf:"bytes,8,rep,name=upgraded_gateways,json=upgradedGateways,proto3" json:"upgraded_gateways,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TierRollout) Reset()         { *m = TierRollout{} }
+func (m *TierRollout) String() string { return proto.CompactTextString(m) }
+func (*TierRollout) ProtoMessage()    {}
+func (*TierRollout) Descriptor() ([]byte, []int) {
+	return fileDescriptor_upgrade_service_bbc15c19ff666f7a, []int{17}
+}
+func (m *TierRollout) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TierRollout.Unmarshal(m, b)
+}
+func (m *TierRollout) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TierRollout.Marshal(b, m, deterministic)
+}
+func (dst *TierRollout) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TierRollout.Merge(dst, src)
+}
+func (m *TierRollout) XXX_Size() int {
+	return xxx_messageInfo_TierRollout.Size(m)
+}
+func (m *TierRollout) XXX_DiscardUnknown() {
+	xxx_messageInfo_TierRollout.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TierRollout proto.InternalMessageInfo
+
+func (m *TierRollout) GetPlan() *RolloutPlan {
+	if m != nil {
+		return m.Plan
+	}
+	return nil
+}
+
+func (m *TierRollout) GetState() TierRollout_State {
+	if m != nil {
+		return m.State
+	}
+	return TierRollout_IN_PROGRESS
+}
+
+func (m *TierRollout) GetCurrentWave() uint32 {
+	if m != nil {
+		return m.CurrentWave
+	}
+	return 0
+}
+
+func (m *TierRollout) GetWaveStartedAt() int64 {
+	if m != nil {
+		return m.WaveStartedAt
+	}
+	return 0
+}
+
+func (m *TierRollout) GetWaveHealthySince() int64 {
+	if m != nil {
+		return m.WaveHealthySince
+	}
+	return 0
+}
+
+func (m *TierRollout) GetUnhealthyGateways() map[string]string {
+	if m != nil {
+		return m.UnhealthyGateways
+	}
+	return nil
+}
+
+func (m *TierRollout) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *TierRollout) GetUpgradedGateways() []string {
+	if m != nil {
+		return m.UpgradedGateways
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ListReleaseChannelsResponse)(nil), "magma.orc8r.upgrade.ListReleaseChannelsResponse")
 	proto.RegisterType((*CreateOrUpdateReleaseChannelRequest)(nil), "magma.orc8r.upgrade.CreateOrUpdateReleaseChannelRequest")
@@ -579,9 +1028,18 @@ func init() {
 	proto.RegisterType((*CreateTierRequest)(nil), "magma.orc8r.upgrade.CreateTierRequest")
 	proto.RegisterType((*UpdateTierRequest)(nil), "magma.orc8r.upgrade.UpdateTierRequest")
 	proto.RegisterType((*DeleteTierRequest)(nil), "magma.orc8r.upgrade.DeleteTierRequest")
+	proto.RegisterType((*StartTierRolloutRequest)(nil), "magma.orc8r.upgrade.StartTierRolloutRequest")
+	proto.RegisterType((*TierRolloutRequest)(nil), "magma.orc8r.upgrade.TierRolloutRequest")
 	proto.RegisterType((*ReleaseChannel)(nil), "magma.orc8r.upgrade.ReleaseChannel")
 	proto.RegisterType((*ImageSpec)(nil), "magma.orc8r.upgrade.ImageSpec")
 	proto.RegisterType((*TierInfo)(nil), "magma.orc8r.upgrade.TierInfo")
+	proto.RegisterType((*RolloutPlan)(nil), "magma.orc8r.upgrade.RolloutPlan")
+	proto.RegisterType((*RolloutWave)(nil), "magma.orc8r.upgrade.RolloutWave")
+	proto.RegisterType((*RolloutHealthCheck)(nil), "magma.orc8r.upgrade.RolloutHealthCheck")
+	proto.RegisterType((*TierRollout)(nil), "magma.orc8r.upgrade.TierRollout")
+	proto.RegisterMapType((map[string]string)(nil), "magma.orc8r.upgrade.TierRollout.UnhealthyGatewaysEntry")
+	proto.RegisterEnum("magma.orc8r.upgrade.RolloutPlan_FailureAction", RolloutPlan_FailureAction_name, RolloutPlan_FailureAction_value)
+	proto.RegisterEnum("magma.orc8r.upgrade.TierRollout_State", TierRollout_State_name, TierRollout_State_value)
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -610,6 +1068,19 @@ type UpgradeServiceClient interface {
 	UpdateTier(ctx context.Context, in *UpdateTierRequest, opts ...grpc.CallOption) (*protos.Void, error)
 	// Delete a tier in a network.
 	DeleteTier(ctx context.Context, in *DeleteTierRequest, opts ...grpc.CallOption) (*protos.Void, error)
+	// Start rolling out a new version to a tier. Fails if the tier already has
+	// a rollout in progress or paused.
+	StartTierRollout(ctx context.Context, in *StartTierRolloutRequest, opts ...grpc.CallOption) (*protos.Void, error)
+	// Get the latest rollout of a tier. Returns NOT_FOUND if the tier never
+	// had a rollout.
+	GetTierRollout(ctx context.Context, in *TierRolloutRequest, opts ...grpc.CallOption) (*TierRollout, error)
+	// Pause a rollout in progress.
+	PauseTierRollout(ctx context.Context, in *TierRolloutRequest, opts ...grpc.CallOption) (*protos.Void, error)
+	// Resume a paused rollout, restarting the timeout of the current wave.
+	ResumeTierRollout(ctx context.Context, in *TierRolloutRequest, opts ...grpc.CallOption) (*protos.Void, error)
+	// Roll back a rollout in progress or paused, returning all gateways of
+	// the tier to the version of the tier.
+	RollbackTierRollout(ctx context.Context, in *TierRolloutRequest, opts ...grpc.CallOption) (*protos.Void, error)
 }
 
 type upgradeServiceClient struct {
@@ -701,6 +1172,51 @@ func (c *upgradeServiceClient) DeleteTier(ctx context.Context, in *DeleteTierReq
 	return out, nil
 }
 
+func (c *upgradeServiceClient) StartTierRollout(ctx context.Context, in *StartTierRolloutRequest, opts ...grpc.CallOption) (*protos.Void, error) {
+	out := new(protos.Void)
+	err := c.cc.Invoke(ctx, "/magma.orc8r.upgrade.UpgradeService/StartTierRollout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *upgradeServiceClient) GetTierRollout(ctx context.Context, in *TierRolloutRequest, opts ...grpc.CallOption) (*TierRollout, error) {
+	out := new(TierRollout)
+	err := c.cc.Invoke(ctx, "/magma.orc8r.upgrade.UpgradeService/GetTierRollout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *upgradeServiceClient) PauseTierRollout(ctx context.Context, in *TierRolloutRequest, opts ...grpc.CallOption) (*protos.Void, error) {
+	out := new(protos.Void)
+	err := c.cc.Invoke(ctx, "/magma.orc8r.upgrade.UpgradeService/PauseTierRollout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *upgradeServiceClient) ResumeTierRollout(ctx context.Context, in *TierRolloutRequest, opts ...grpc.CallOption) (*protos.Void, error) {
+	out := new(protos.Void)
+	err := c.cc.Invoke(ctx, "/magma.orc8r.upgrade.UpgradeService/ResumeTierRollout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *upgradeServiceClient) RollbackTierRollout(ctx context.Context, in *TierRolloutRequest, opts ...grpc.CallOption) (*protos.Void, error) {
+	out := new(protos.Void)
+	err := c.cc.Invoke(ctx, "/magma.orc8r.upgrade.UpgradeService/RollbackTierRollout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UpgradeServiceServer is the server API for UpgradeService service.
 type UpgradeServiceServer interface {
 	CreateReleaseChannel(context.Context, *CreateOrUpdateReleaseChannelRequest) (*protos.Void, error)
@@ -717,6 +1233,19 @@ type UpgradeServiceServer interface {
 	UpdateTier(context.Context, *UpdateTierRequest) (*protos.Void, error)
 	// Delete a tier in a network.
 	DeleteTier(context.Context, *DeleteTierRequest) (*protos.Void, error)
+	// Start rolling out a new version to a tier. Fails if the tier already has
+	// a rollout in progress or paused.
+	StartTierRollout(context.Context, *StartTierRolloutRequest) (*protos.Void, error)
+	// Get the latest rollout of a tier. Returns NOT_FOUND if the tier never
+	// had a rollout.
+	GetTierRollout(context.Context, *TierRolloutRequest) (*TierRollout, error)
+	// Pause a rollout in progress.
+	PauseTierRollout(context.Context, *TierRolloutRequest) (*protos.Void, error)
+	// Resume a paused rollout, restarting the timeout of the current wave.
+	ResumeTierRollout(context.Context, *TierRolloutRequest) (*protos.Void, error)
+	// Roll back a rollout in progress or paused, returning all gateways of
+	// the tier to the version of the tier.
+	RollbackTierRollout(context.Context, *TierRolloutRequest) (*protos.Void, error)
 }
 
 func RegisterUpgradeServiceServer(s *grpc.Server, srv UpgradeServiceServer) {
@@ -885,6 +1414,96 @@ func _UpgradeService_DeleteTier_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UpgradeService_StartTierRollout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartTierRolloutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UpgradeServiceServer).StartTierRollout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.orc8r.upgrade.UpgradeService/StartTierRollout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UpgradeServiceServer).StartTierRollout(ctx, req.(*StartTierRolloutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UpgradeService_GetTierRollout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TierRolloutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UpgradeServiceServer).GetTierRollout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.orc8r.upgrade.UpgradeService/GetTierRollout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UpgradeServiceServer).GetTierRollout(ctx, req.(*TierRolloutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UpgradeService_PauseTierRollout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TierRolloutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UpgradeServiceServer).PauseTierRollout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.orc8r.upgrade.UpgradeService/PauseTierRollout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UpgradeServiceServer).PauseTierRollout(ctx, req.(*TierRolloutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UpgradeService_ResumeTierRollout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TierRolloutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UpgradeServiceServer).ResumeTierRollout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.orc8r.upgrade.UpgradeService/ResumeTierRollout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UpgradeServiceServer).ResumeTierRollout(ctx, req.(*TierRolloutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UpgradeService_RollbackTierRollout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TierRolloutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UpgradeServiceServer).RollbackTierRollout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.orc8r.upgrade.UpgradeService/RollbackTierRollout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UpgradeServiceServer).RollbackTierRollout(ctx, req.(*TierRolloutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _UpgradeService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "magma.orc8r.upgrade.UpgradeService",
 	HandlerType: (*UpgradeServiceServer)(nil),
@@ -925,58 +1544,116 @@ var _UpgradeService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteTier",
 			Handler:    _UpgradeService_DeleteTier_Handler,
 		},
+		{
+			MethodName: "StartTierRollout",
+			Handler:    _UpgradeService_StartTierRollout_Handler,
+		},
+		{
+			MethodName: "GetTierRollout",
+			Handler:    _UpgradeService_GetTierRollout_Handler,
+		},
+		{
+			MethodName: "PauseTierRollout",
+			Handler:    _UpgradeService_PauseTierRollout_Handler,
+		},
+		{
+			MethodName: "ResumeTierRollout",
+			Handler:    _UpgradeService_ResumeTierRollout_Handler,
+		},
+		{
+			MethodName: "RollbackTierRollout",
+			Handler:    _UpgradeService_RollbackTierRollout_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
-	Metadata: "upgrade_service.proto",
+	Metadata: "orc8r/cloud/go/services/upgrade/protos/upgrade_service.proto",
 }
 
 func init() {
-	proto.RegisterFile("upgrade_service.proto", fileDescriptor_upgrade_service_e8f4c9695f70c9a6)
-}
-
-var fileDescriptor_upgrade_service_e8f4c9695f70c9a6 = []byte{
-	// 687 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xef, 0x4e, 0x13, 0x4f,
-	0x14, 0xed, 0xd2, 0x1f, 0xa5, 0xbd, 0x25, 0xfc, 0xd8, 0x01, 0xe3, 0x5a, 0x82, 0xe2, 0xa0, 0xa6,
-	0x7e, 0xa0, 0x18, 0x88, 0x86, 0x90, 0xa0, 0x44, 0x14, 0xb2, 0x89, 0xd1, 0xb8, 0xfc, 0x31, 0x1a,
-	0xc9, 0x66, 0xed, 0x5e, 0x70, 0x43, 0x77, 0x67, 0x9d, 0xd9, 0x62, 0x78, 0x02, 0x3f, 0xf9, 0x34,
-	0xbe, 0x81, 0x4f, 0x66, 0x66, 0x67, 0x96, 0x02, 0x9d, 0xd6, 0x4a, 0xf8, 0xd4, 0xbd, 0x77, 0xee,
-	0x3d, 0x73, 0xe6, 0xcc, 0x9d, 0x93, 0xc2, 0xad, 0x6e, 0x7a, 0xcc, 0x83, 0x10, 0x7d, 0x81, 0xfc,
-	0x34, 0x6a, 0x63, 0x2b, 0xe5, 0x2c, 0x63, 0x64, 0x26, 0x0e, 0x8e, 0xe3, 0xa0, 0xc5, 0x78, 0x7b,
-	0x8d, 0xb7, 0x74, 0x49, 0xe3, 0x4e, 0x1e, 0x2e, 0xe7, 0x15, 0x62, 0xb9, 0xcd, 0xe2, 0x98, 0x25,
-	0xaa, 0x9e, 0x3e, 0x87, 0xb9, 0x37, 0x91, 0xc8, 0x3c, 0xec, 0x60, 0x20, 0x70, 0xeb, 0x6b, 0x90,
-	0x24, 0xd8, 0x11, 0x1e, 0x8a, 0x94, 0x25, 0x02, 0xc9, 0x3d, 0xa8, 0xb7, 0x55, 0xce, 0x8f, 0x42,
-	0xe1, 0x58, 0x0b, 0xe5, 0x66, 0xcd, 0x03, 0x9d, 0x72, 0x43, 0x41, 0x7f, 0x58, 0xb0, 0xb8, 0xc5,
-	0x31, 0xc8, 0xf0, 0x1d, 0xdf, 0x4f, 0xc3, 0x20, 0xc3, 0xcb, 0x50, 0x1e, 0x7e, 0xeb, 0xa2, 0xc8,
-	0xc8, 0x7d, 0x98, 0x2c, 0x80, 0x92, 0x20, 0x46, 0xc7, 0x5a, 0xb0, 0x9a, 0x35, 0xaf, 0x00, 0x7f,
-	0x1b, 0xc4, 0x48, 0x36, 0x60, 0x42, 0x87, 0xce, 0xd8, 0x82, 0xd5, 0xac, 0xaf, 0x2c, 0xb6, 0x0c,
-	0x87, 0x69, 0x5d, 0xc1, 0x2f, 0x7a, 0xe8, 0x06, 0x38, 0x3b, 0x98, 0x5d, 0x77, 0x77, 0xba, 0x09,
-	0x73, 0xaf, 0xb0, 0x83, 0xd7, 0xe7, 0x4f, 0xdf, 0xc3, 0xff, 0x3b, 0x98, 0xed, 0x45, 0xc8, 0x45,
-	0xd1, 0x35, 0x0f, 0x90, 0x60, 0xf6, 0x9d, 0xf1, 0x13, 0x3f, 0x0a, 0x75, 0x4f, 0x4d, 0x67, 0xdc,
-	0x50, 0xaa, 0x9b, 0x45, 0xc8, 0xfd, 0xa3, 0xa8, 0x93, 0x21, 0x77, 0xc6, 0x94, 0xba, 0x32, 0xb5,
-	0x9d, 0x67, 0xe8, 0x2f, 0x0b, 0xa6, 0x7b, 0x98, 0xfa, 0x4e, 0xb6, 0x61, 0x5c, 0x96, 0xa8, 0xdb,
-	0xa8, 0xaf, 0x3c, 0x31, 0xaa, 0x74, 0xb5, 0xab, 0x95, 0x47, 0xaf, 0x93, 0x8c, 0x9f, 0x79, 0xaa,
-	0xbd, 0xf1, 0x01, 0xa0, 0x97, 0x24, 0xd3, 0x50, 0x3e, 0xc1, 0x33, 0xcd, 0x51, 0x7e, 0x92, 0x55,
-	0x18, 0x3f, 0x0d, 0x3a, 0x5d, 0xd4, 0xb7, 0x31, 0x6f, 0xdc, 0x47, 0x22, 0xb8, 0xc9, 0x11, 0xf3,
-	0x54, 0xed, 0xfa, 0xd8, 0x9a, 0x25, 0x67, 0xc2, 0x56, 0x33, 0x21, 0x57, 0x47, 0xd4, 0xe2, 0x36,
-	0x4c, 0xe4, 0x5a, 0x44, 0x61, 0xbe, 0x5f, 0xcd, 0xab, 0xc8, 0xd0, 0x0d, 0xc9, 0x3a, 0xd4, 0xd4,
-	0x42, 0x72, 0xc4, 0x9c, 0xf2, 0x28, 0x54, 0xaa, 0x99, 0xfe, 0xa2, 0x3f, 0x2d, 0xb0, 0xd5, 0x54,
-	0xde, 0x04, 0x93, 0x4d, 0x98, 0xec, 0xe6, 0x60, 0xa1, 0x2f, 0x33, 0xa3, 0x91, 0xa9, 0xeb, 0x16,
-	0x99, 0xa0, 0x87, 0x60, 0xab, 0x21, 0xfb, 0x07, 0x3a, 0x8f, 0xc1, 0xd6, 0x74, 0xfc, 0x8c, 0xf9,
-	0x61, 0xde, 0xae, 0x89, 0x4d, 0x29, 0x62, 0x7b, 0x4c, 0x81, 0xd2, 0x17, 0x30, 0x75, 0x79, 0x7a,
-	0xc9, 0x12, 0x10, 0xd1, 0x4d, 0x53, 0xc6, 0x25, 0xe9, 0x53, 0xe4, 0x22, 0x62, 0x49, 0xf1, 0x8c,
-	0xed, 0xf3, 0x95, 0x03, 0xbd, 0x40, 0x9f, 0x42, 0xcd, 0x8d, 0x83, 0x63, 0xdc, 0x4d, 0xb1, 0x4d,
-	0x08, 0xfc, 0x77, 0x61, 0xd4, 0xf3, 0x6f, 0x32, 0x0b, 0xe3, 0x8c, 0x87, 0xf9, 0xac, 0x5a, 0xcd,
-	0xb2, 0xa7, 0x02, 0x9a, 0x42, 0xb5, 0x38, 0xaf, 0xb1, 0xcb, 0x81, 0x09, 0xbd, 0xb7, 0x26, 0x5e,
-	0x84, 0xe4, 0x19, 0x54, 0x22, 0xb9, 0xa1, 0x70, 0xca, 0xf9, 0x30, 0xdf, 0x35, 0x8a, 0x79, 0xce,
-	0xc9, 0xd3, 0xd5, 0x2b, 0xbf, 0x2b, 0x30, 0xb5, 0xaf, 0x56, 0x77, 0x95, 0xff, 0x11, 0x84, 0x59,
-	0x35, 0x74, 0x57, 0x24, 0x58, 0x33, 0x42, 0x8e, 0xe0, 0x59, 0x0d, 0xfb, 0x52, 0xe7, 0x01, 0x8b,
-	0x42, 0x5a, 0x22, 0x11, 0xd8, 0x7d, 0x36, 0x43, 0x96, 0x06, 0xbd, 0x41, 0x33, 0xf0, 0x28, 0xc6,
-	0x46, 0x4b, 0xe4, 0x33, 0xcc, 0x18, 0xbc, 0x99, 0xf4, 0xd3, 0x6a, 0x98, 0x3d, 0x60, 0x88, 0xb1,
-	0xd3, 0x92, 0xd4, 0xcb, 0x74, 0xf8, 0x9b, 0xd6, 0xeb, 0x10, 0x66, 0x4d, 0xbe, 0x4a, 0xcc, 0x94,
-	0x87, 0x58, 0xb0, 0x19, 0xfe, 0x23, 0x54, 0x0b, 0xab, 0x23, 0x0f, 0xfe, 0xe2, 0x84, 0x0a, 0xe6,
-	0xe1, 0x48, 0x7e, 0x49, 0x4b, 0xc4, 0x05, 0xe8, 0xb9, 0x18, 0x79, 0x34, 0x44, 0x96, 0x0b, 0xaf,
-	0xd9, 0xcc, 0xd2, 0x05, 0xe8, 0xd9, 0xd0, 0x00, 0xa8, 0x3e, 0x9f, 0x1a, 0x08, 0xd5, 0xb3, 0x90,
-	0x01, 0x50, 0x7d, 0x1e, 0x63, 0x84, 0x7a, 0x59, 0xfd, 0x54, 0x51, 0x7f, 0x09, 0xbe, 0xa8, 0xdf,
-	0xd5, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x45, 0xa8, 0x91, 0x54, 0x55, 0x08, 0x00, 0x00,
+	proto.RegisterFile("orc8r/cloud/go/services/upgrade/protos/upgrade_service.proto", fileDescriptor_upgrade_service_bbc15c19ff666f7a)
+}
+
+var fileDescriptor_upgrade_service_bbc15c19ff666f7a = []byte{
+	// 1291 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xeb, 0x52, 0xdb, 0x46,
+	0x14, 0xb6, 0x30, 0x06, 0x7c, 0x0c, 0x8e, 0xb5, 0x61, 0x1a, 0x97, 0x4c, 0x52, 0xaa, 0xdc, 0x68,
+	0x9b, 0x98, 0x0c, 0xe9, 0x25, 0x93, 0x49, 0xda, 0x10, 0x20, 0xd4, 0x2d, 0x09, 0x44, 0xc6, 0xc9,
+	0xb4, 0xd3, 0x8c, 0x66, 0x23, 0x1d, 0x1b, 0x0d, 0xba, 0x38, 0xda, 0x15, 0x09, 0x4f, 0xd0, 0x5f,
+	0x7d, 0x9a, 0x4e, 0x7f, 0xf4, 0x11, 0xfa, 0x3a, 0x7d, 0x82, 0xce, 0x5e, 0x84, 0x0d, 0xc8, 0xd8,
+	0x49, 0xf9, 0x65, 0xed, 0xb9, 0x7c, 0xe7, 0xb6, 0xe7, 0xec, 0x31, 0x3c, 0x8c, 0x13, 0xf7, 0x7e,
+	0xb2, 0xec, 0x06, 0x71, 0xea, 0x2d, 0x77, 0xe3, 0x65, 0x86, 0xc9, 0x81, 0xef, 0x22, 0x5b, 0x4e,
+	0x7b, 0xdd, 0x84, 0x7a, 0xb8, 0xdc, 0x4b, 0x62, 0x1e, 0x1f, 0x1d, 0x1d, 0xcd, 0x6f, 0x48, 0x32,
+	0xb9, 0x18, 0xd2, 0x6e, 0x48, 0x1b, 0x12, 0xa3, 0xa1, 0x45, 0x16, 0x3e, 0x55, 0x90, 0x5a, 0xd1,
+	0x8d, 0xc3, 0x30, 0x8e, 0x94, 0xbc, 0xf5, 0x3d, 0x5c, 0xde, 0xf2, 0x19, 0xb7, 0x31, 0x40, 0xca,
+	0x70, 0x6d, 0x8f, 0x46, 0x11, 0x06, 0xcc, 0x46, 0xd6, 0x8b, 0x23, 0x86, 0xe4, 0x33, 0xa8, 0xb8,
+	0x8a, 0xe6, 0xf8, 0x1e, 0xab, 0x1b, 0x8b, 0xc5, 0xa5, 0xb2, 0x0d, 0x9a, 0xd4, 0xf4, 0x98, 0xf5,
+	0xbb, 0x01, 0xd7, 0xd6, 0x12, 0xa4, 0x1c, 0xb7, 0x93, 0x76, 0xcf, 0xa3, 0x1c, 0x8f, 0x43, 0xd9,
+	0xf8, 0x36, 0x45, 0xc6, 0xc9, 0xe7, 0x30, 0x9b, 0x01, 0x45, 0x34, 0xc4, 0xba, 0xb1, 0x68, 0x2c,
+	0x95, 0xed, 0x0c, 0xfc, 0x39, 0x0d, 0x91, 0x3c, 0x82, 0x69, 0x7d, 0xac, 0x4f, 0x2c, 0x1a, 0x4b,
+	0x95, 0x95, 0x6b, 0x8d, 0x9c, 0x60, 0x1a, 0x27, 0xf0, 0x33, 0x1d, 0xeb, 0x11, 0xd4, 0x37, 0x91,
+	0x7f, 0xac, 0x75, 0xeb, 0x31, 0x5c, 0x5e, 0xc7, 0x00, 0x3f, 0xde, 0x7f, 0xeb, 0x05, 0x5c, 0xd8,
+	0x44, 0xbe, 0xeb, 0x63, 0xc2, 0x32, 0xad, 0x2b, 0x00, 0x11, 0xf2, 0x77, 0x71, 0xb2, 0xef, 0xf8,
+	0x9e, 0xd6, 0x29, 0x6b, 0x4a, 0xd3, 0x13, 0xd9, 0xe5, 0x3e, 0x26, 0x4e, 0xc7, 0x0f, 0x38, 0x26,
+	0xf5, 0x09, 0x95, 0x5d, 0x41, 0x7a, 0x2a, 0x29, 0xd6, 0x9f, 0x06, 0xd4, 0xfa, 0x98, 0xba, 0x26,
+	0x4f, 0xa1, 0x24, 0x44, 0x54, 0x35, 0x2a, 0x2b, 0x77, 0x73, 0xb3, 0x74, 0x52, 0xab, 0x21, 0x4f,
+	0x1b, 0x11, 0x4f, 0x0e, 0x6d, 0xa5, 0xbe, 0xf0, 0x0a, 0xa0, 0x4f, 0x24, 0x35, 0x28, 0xee, 0xe3,
+	0xa1, 0xf6, 0x51, 0x7c, 0x92, 0x7b, 0x50, 0x3a, 0xa0, 0x41, 0x8a, 0xba, 0x1a, 0x57, 0x72, 0xed,
+	0x08, 0x84, 0x66, 0xd4, 0x89, 0x6d, 0x25, 0xfb, 0x60, 0xe2, 0xbe, 0x21, 0xee, 0x84, 0xa9, 0xee,
+	0x84, 0xe0, 0x8e, 0x99, 0x8b, 0x4b, 0x30, 0x2d, 0x73, 0xe1, 0x7b, 0xd2, 0x5e, 0xd9, 0x9e, 0x12,
+	0xc7, 0xa6, 0x47, 0x1e, 0x40, 0x59, 0x31, 0xa2, 0x4e, 0x5c, 0x2f, 0x8e, 0xe3, 0xca, 0x0c, 0xd7,
+	0x5f, 0xd6, 0x1f, 0x06, 0x98, 0xea, 0x56, 0x9e, 0x87, 0x27, 0x8f, 0x61, 0x36, 0x95, 0x60, 0x9e,
+	0x23, 0x28, 0xe3, 0x39, 0x53, 0xd1, 0x2a, 0x82, 0x60, 0xbd, 0x06, 0x53, 0x5d, 0xb2, 0x0f, 0x70,
+	0xe7, 0x0b, 0x30, 0xb5, 0x3b, 0x0e, 0x8f, 0x1d, 0x4f, 0xaa, 0x6b, 0xc7, 0xaa, 0xca, 0xb1, 0xdd,
+	0x58, 0x81, 0x8a, 0xc4, 0x5f, 0x6a, 0x71, 0x9a, 0xc8, 0xd2, 0xdb, 0x71, 0x10, 0xc4, 0x29, 0xff,
+	0xbf, 0x41, 0x7f, 0x0d, 0x93, 0xbd, 0x80, 0x46, 0x3a, 0xd8, 0xc5, 0xfc, 0x96, 0x54, 0xa6, 0x76,
+	0x02, 0x1a, 0xd9, 0x52, 0xda, 0xda, 0x02, 0x72, 0x7e, 0x3e, 0x58, 0x3f, 0x40, 0xf5, 0x78, 0x57,
+	0x92, 0x3b, 0x40, 0x58, 0xda, 0xeb, 0xc5, 0x89, 0x28, 0xc6, 0x01, 0x26, 0xcc, 0x8f, 0xa3, 0x6c,
+	0x3c, 0x99, 0x47, 0x9c, 0x97, 0x9a, 0x61, 0x7d, 0x03, 0xe5, 0x66, 0x48, 0xbb, 0xd8, 0xea, 0xa1,
+	0x4b, 0x08, 0x4c, 0x0e, 0xb4, 0xb0, 0xfc, 0x26, 0xf3, 0x50, 0x8a, 0x13, 0x4f, 0xf6, 0xa0, 0xb1,
+	0x54, 0xb4, 0xd5, 0xc1, 0xea, 0xc1, 0x4c, 0x56, 0xc7, 0x5c, 0xad, 0x3a, 0x4c, 0x6b, 0xdb, 0xda,
+	0xe1, 0xec, 0x48, 0xbe, 0x85, 0x29, 0x5f, 0x18, 0x64, 0xf5, 0xa2, 0x6c, 0xd2, 0xab, 0xb9, 0x79,
+	0x3b, 0xf2, 0xc9, 0xd6, 0xd2, 0xd6, 0xdf, 0x13, 0x50, 0x19, 0xc8, 0x26, 0xb9, 0x01, 0x55, 0x4e,
+	0x93, 0x2e, 0xf2, 0x2c, 0x48, 0x6d, 0x7f, 0x4e, 0x51, 0x5f, 0x1e, 0x99, 0x2b, 0xbd, 0xa3, 0x07,
+	0xc8, 0xe4, 0x08, 0x19, 0x51, 0xa5, 0x57, 0xf4, 0x00, 0x6d, 0x25, 0x4e, 0x7e, 0x82, 0xd9, 0x3d,
+	0xa4, 0x01, 0xdf, 0x73, 0xdc, 0x3d, 0x74, 0xf7, 0x75, 0x91, 0x6f, 0x9d, 0xa5, 0xfe, 0xa3, 0x94,
+	0x5f, 0x13, 0xe2, 0x76, 0x65, 0xaf, 0x7f, 0x20, 0x6d, 0xa8, 0x76, 0xa8, 0x1f, 0xa4, 0x09, 0x3a,
+	0xd4, 0xe5, 0xc2, 0xd5, 0xc9, 0x45, 0x63, 0xa9, 0xba, 0xd2, 0x18, 0x75, 0x65, 0x1a, 0x4f, 0x95,
+	0xda, 0xaa, 0xd4, 0xb2, 0xe7, 0x3a, 0x83, 0x47, 0x6b, 0x09, 0xe6, 0x8e, 0xf1, 0x49, 0x19, 0x4a,
+	0x3b, 0xab, 0xed, 0xd6, 0x46, 0xad, 0x40, 0x66, 0x61, 0xc6, 0xde, 0xde, 0xda, 0x7a, 0xb2, 0xba,
+	0xf6, 0x73, 0xcd, 0xb0, 0x9e, 0x43, 0x65, 0x20, 0x44, 0x72, 0x15, 0xa0, 0x87, 0x89, 0x8b, 0x11,
+	0xa7, 0x5d, 0x55, 0xb6, 0x39, 0x7b, 0x80, 0x22, 0x86, 0x6f, 0x97, 0x72, 0x7c, 0x47, 0x0f, 0xe5,
+	0xd3, 0xa6, 0x87, 0xaf, 0x26, 0x89, 0xa7, 0xed, 0x1f, 0x03, 0xc8, 0xe9, 0xa0, 0xc9, 0x32, 0xcc,
+	0x87, 0xf4, 0xbd, 0x4a, 0x98, 0x1f, 0x39, 0xb4, 0x2b, 0x9e, 0x60, 0x97, 0x69, 0x0b, 0x66, 0x48,
+	0xdf, 0xaf, 0x29, 0xd6, 0x6a, 0x17, 0x5b, 0xe8, 0x32, 0x72, 0x17, 0xe6, 0x13, 0x7c, 0x9b, 0xfa,
+	0x09, 0x7a, 0x0e, 0xe3, 0x94, 0xa7, 0xcc, 0x09, 0x91, 0x53, 0x6d, 0x91, 0x64, 0xbc, 0x96, 0x64,
+	0x3d, 0x43, 0x4e, 0xc9, 0x75, 0xa8, 0xb2, 0x98, 0xee, 0x3b, 0xdc, 0x0f, 0x35, 0x78, 0x51, 0x82,
+	0xcf, 0x0a, 0xea, 0xae, 0x1f, 0x2a, 0xdc, 0x2f, 0xc1, 0x14, 0x55, 0x94, 0x52, 0x71, 0xca, 0x95,
+	0xe0, 0xa4, 0x14, 0xbc, 0x20, 0x18, 0xbb, 0x8a, 0x2e, 0x64, 0xad, 0xbf, 0x26, 0xa1, 0x32, 0xd0,
+	0x90, 0x47, 0x5d, 0x6d, 0x7c, 0x48, 0x57, 0x93, 0x87, 0x50, 0x12, 0x01, 0xa8, 0xf1, 0x53, 0x5d,
+	0xb9, 0x39, 0x74, 0xf2, 0x69, 0xd5, 0x86, 0x88, 0x09, 0x6d, 0xa5, 0x24, 0x9f, 0xd0, 0x34, 0x49,
+	0x30, 0xe2, 0x8e, 0x70, 0x4f, 0xc7, 0x54, 0xd1, 0x34, 0x59, 0xb3, 0x9b, 0x20, 0x3d, 0x17, 0x69,
+	0x92, 0x9d, 0x4d, 0xb9, 0x0c, 0xa8, 0x68, 0xcf, 0x09, 0x72, 0x4b, 0x51, 0x57, 0x39, 0xb9, 0x0d,
+	0x44, 0xca, 0xa9, 0xfb, 0x77, 0xe8, 0x30, 0x3f, 0x72, 0xb1, 0x5e, 0x92, 0xa2, 0x35, 0xc1, 0x51,
+	0x05, 0x3b, 0x6c, 0x09, 0x3a, 0xe9, 0x00, 0x49, 0xa3, 0x4c, 0x54, 0x17, 0x98, 0xd5, 0xa7, 0x64,
+	0xab, 0x7c, 0x37, 0x32, 0x86, 0x76, 0xa6, 0xba, 0xa9, 0x35, 0xd5, 0x23, 0x6a, 0xa6, 0x27, 0xe9,
+	0x62, 0x1c, 0x84, 0xc8, 0x98, 0xb8, 0x6e, 0xd3, 0x6a, 0x1c, 0xe8, 0x23, 0xf9, 0x0a, 0x4c, 0x0d,
+	0xed, 0xf5, 0x1d, 0x98, 0x91, 0xf5, 0xaf, 0x65, 0x8c, 0x0c, 0x66, 0x61, 0x1d, 0x3e, 0xc9, 0xb7,
+	0x99, 0xf3, 0x46, 0xcf, 0x0f, 0xbe, 0xd1, 0xe5, 0xc1, 0x47, 0x78, 0x1d, 0x4a, 0x32, 0xfb, 0xe4,
+	0x02, 0x54, 0x9a, 0xcf, 0x9d, 0x1d, 0x7b, 0x7b, 0xd3, 0xde, 0x68, 0xb5, 0x6a, 0x05, 0x02, 0x30,
+	0x25, 0x1b, 0x68, 0xbd, 0x66, 0x08, 0xa6, 0xe8, 0xa0, 0x8d, 0x75, 0x47, 0x36, 0xd1, 0x04, 0x99,
+	0x83, 0xf2, 0xda, 0xf6, 0xb3, 0x9d, 0xad, 0x8d, 0xdd, 0x8d, 0xf5, 0x5a, 0x71, 0xe5, 0xdf, 0x32,
+	0x54, 0xdb, 0xca, 0xc1, 0x96, 0xda, 0x33, 0x09, 0xc2, 0xbc, 0x7a, 0xdc, 0x4f, 0x8c, 0xe4, 0xfb,
+	0xb9, 0x99, 0x1c, 0x63, 0x37, 0x5c, 0x30, 0x8f, 0x69, 0xbe, 0x8c, 0x7d, 0xcf, 0x2a, 0x10, 0x1f,
+	0xcc, 0x53, 0xeb, 0x1c, 0xb9, 0x33, 0x6c, 0xd7, 0xc9, 0x07, 0x1e, 0x67, 0x81, 0xb4, 0x0a, 0xe4,
+	0x37, 0xb8, 0x98, 0xb3, 0x03, 0x93, 0xd3, 0x6e, 0x2d, 0xe4, 0xef, 0x5a, 0x67, 0x2c, 0xd0, 0x56,
+	0x41, 0xe4, 0x2b, 0x2f, 0xf8, 0xf3, 0xce, 0xd7, 0x6b, 0x98, 0xcf, 0xdb, 0x5f, 0x49, 0xbe, 0xcb,
+	0x67, 0xac, 0xba, 0xf9, 0xf0, 0xbf, 0xc0, 0x4c, 0xb6, 0x52, 0x92, 0xeb, 0x23, 0x36, 0x4e, 0x05,
+	0x73, 0x63, 0xac, 0xbd, 0xd4, 0x2a, 0x90, 0x26, 0x40, 0x7f, 0x5b, 0x24, 0x37, 0xcf, 0x48, 0xcb,
+	0xc0, 0xd6, 0x94, 0xef, 0x65, 0x13, 0xa0, 0xbf, 0xee, 0x0d, 0x81, 0x3a, 0xb5, 0x0f, 0x0e, 0x85,
+	0xea, 0xaf, 0x6a, 0x43, 0xa0, 0x4e, 0xed, 0x72, 0xf9, 0x50, 0x6d, 0xa8, 0x9d, 0xdc, 0xca, 0xc8,
+	0xed, 0x5c, 0xc0, 0x21, 0xcb, 0xdb, 0xb0, 0x8a, 0x57, 0x37, 0x71, 0x50, 0x9a, 0xdc, 0x1a, 0x35,
+	0xcc, 0x32, 0xbc, 0xc5, 0x51, 0x82, 0x56, 0x81, 0xec, 0x40, 0x6d, 0x87, 0xa6, 0x0c, 0x3f, 0xca,
+	0x40, 0xae, 0xc3, 0x2f, 0xc0, 0xb4, 0x91, 0xa5, 0xe1, 0x39, 0x42, 0xb6, 0xe0, 0xa2, 0x10, 0x7b,
+	0x43, 0xdd, 0xfd, 0x73, 0x03, 0x7d, 0x32, 0xf3, 0xeb, 0x94, 0xfa, 0xab, 0xfc, 0x46, 0xfd, 0xde,
+	0xfb, 0x6f, 0x00, 0xc9, 0x1b, 0x2c, 0x1f, 0x94, 0x0f, 0x00, 0x00,
 }
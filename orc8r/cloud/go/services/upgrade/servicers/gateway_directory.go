@@ -0,0 +1,52 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package servicers
+
+import (
+	"sort"
+
+	"magma/orc8r/cloud/go/protos"
+	"magma/orc8r/cloud/go/services/checkind"
+	"magma/orc8r/cloud/go/services/config"
+	"magma/orc8r/cloud/go/services/magmad"
+	magmad_config "magma/orc8r/cloud/go/services/magmad/config"
+	magmad_protos "magma/orc8r/cloud/go/services/magmad/protos"
+)
+
+// magmadGatewayDirectory looks up the tiers of gateways in their magmad
+// gateway configs and their status in checkind
+type magmadGatewayDirectory struct{}
+
+func NewMagmadGatewayDirectory() GatewayDirectory {
+	return &magmadGatewayDirectory{}
+}
+
+func (*magmadGatewayDirectory) ListNetworks() ([]string, error) {
+	return magmad.ListNetworks()
+}
+
+func (*magmadGatewayDirectory) ListTierGateways(networkID string, tierID string) ([]string, error) {
+	configs, err := config.GetConfigsByType(networkID, magmad_config.MagmadGatewayType)
+	if err != nil {
+		return nil, err
+	}
+	gatewayIDs := []string{}
+	for tk, iConfig := range configs {
+		gatewayConfig, ok := iConfig.(*magmad_protos.MagmadGatewayConfig)
+		if ok && gatewayConfig.GetTier() == tierID {
+			gatewayIDs = append(gatewayIDs, tk.Key)
+		}
+	}
+	sort.Strings(gatewayIDs)
+	return gatewayIDs, nil
+}
+
+func (*magmadGatewayDirectory) GetGatewayStatus(networkID string, gatewayID string) (*protos.GatewayStatus, error) {
+	return checkind.GetStatus(networkID, gatewayID)
+}
@@ -0,0 +1,282 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package servicers
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"magma/orc8r/cloud/go/datastore"
+	"magma/orc8r/cloud/go/errors"
+	"magma/orc8r/cloud/go/protos"
+	upgrade_protos "magma/orc8r/cloud/go/services/upgrade/protos"
+
+	"github.com/golang/glog"
+)
+
+// GatewayDirectory provides the gateways of the tiers of a network and their
+// checkin status to the rollout evaluator
+type GatewayDirectory interface {
+	ListNetworks() ([]string, error)
+	// ListTierGateways returns the IDs of the gateways in the tier
+	ListTierGateways(networkID string, tierID string) ([]string, error)
+	// GetGatewayStatus returns errors.ErrNotFound if the gateway never
+	// checked in
+	GetGatewayStatus(networkID string, gatewayID string) (*protos.GatewayStatus, error)
+}
+
+// TierRolloutEvaluator periodically advances the rollouts in progress.
+//
+// The upgraded gateways of a rollout (the gateways of all waves up to the
+// current one, recorded in the rollout as the waves start) are healthy if they
+// checked in recently, their checkin reports the required status meta and the
+// package version they run is the target version of the rollout. Once all
+// upgraded gateways have been healthy for the soak time of the rollout, the
+// next wave starts. After the last wave the rollout completes and the version
+// of the tier is set to the target version. If the upgraded gateways aren't
+// healthy within the wave timeout, or the wave has no gateways, the rollout is
+// paused or rolled back depending on its failure action.
+//
+// Every replica of the service runs an evaluator, rollouts and tiers are only
+// updated with conditional writes so that concurrent evaluations of a rollout
+// don't conflict.
+type TierRolloutEvaluator struct {
+	service  *UpgradeService
+	gateways GatewayDirectory
+}
+
+func NewTierRolloutEvaluator(service *UpgradeService, gateways GatewayDirectory) *TierRolloutEvaluator {
+	return &TierRolloutEvaluator{service: service, gateways: gateways}
+}
+
+// Run evaluates the rollouts of all networks every interval, forever
+func (evaluator *TierRolloutEvaluator) Run(interval time.Duration) {
+	for range time.Tick(interval) {
+		err := evaluator.EvaluateRollouts(time.Now())
+		if err != nil {
+			glog.Errorf("Error while evaluating tier rollouts: %s", err)
+		}
+	}
+}
+
+// EvaluateRollouts evaluates the rollouts in progress of all networks at time
+// now. Errors evaluating the rollouts of a network are logged and don't stop
+// the evaluation of other networks.
+func (evaluator *TierRolloutEvaluator) EvaluateRollouts(now time.Time) error {
+	networks, err := evaluator.gateways.ListNetworks()
+	if err != nil {
+		return err
+	}
+	for _, networkID := range networks {
+		err = evaluator.evaluateNetworkRollouts(networkID, now)
+		if err != nil {
+			glog.Errorf("Error while evaluating tier rollouts of network %s: %s", networkID, err)
+		}
+	}
+	return nil
+}
+
+// evaluateNetworkRollouts logs errors evaluating the rollout of a tier and
+// continues with the other tiers
+func (evaluator *TierRolloutEvaluator) evaluateNetworkRollouts(networkID string, now time.Time) error {
+	tierIDs, err := evaluator.service.store.ListKeys(getTierRolloutTableName(networkID))
+	if err != nil {
+		return err
+	}
+	for _, tierID := range tierIDs {
+		err = evaluator.evaluateTierRollout(networkID, tierID, now)
+		if err != nil {
+			glog.Errorf("Error while evaluating rollout of tier %s in network %s: %s", tierID, networkID, err)
+		}
+	}
+	return nil
+}
+
+// evaluateTierRollout updates the rollout of the tier only if it didn't change
+// since it was loaded, so that the evaluators of other replicas of the service
+// and pauses or rollbacks through the API are never overwritten. Once the
+// rollout is completed, the version of the tier is set to its target version.
+func (evaluator *TierRolloutEvaluator) evaluateTierRollout(networkID string, tierID string, now time.Time) error {
+	srv := evaluator.service
+	rollout, marshaledRollout, err := srv.getTierRollout(networkID, tierID)
+	if err == datastore.ErrNotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if rollout.State != upgrade_protos.TierRollout_IN_PROGRESS {
+		return nil
+	}
+	_, _, err = srv.getTier(networkID, tierID)
+	if err == datastore.ErrNotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	err = evaluator.evaluateRollout(networkID, tierID, rollout, now)
+	if err != nil {
+		return err
+	}
+	updated, err := srv.compareAndPutTierRollout(networkID, tierID, marshaledRollout, rollout)
+	if err != nil {
+		return err
+	}
+	if !updated {
+		glog.V(2).Infof("Rollout of tier %s in network %s was updated concurrently, skipping it", tierID, networkID)
+		return nil
+	}
+	if rollout.State == upgrade_protos.TierRollout_COMPLETED {
+		return srv.setTierVersion(networkID, tierID, rollout.GetPlan().GetTargetVersion())
+	}
+	return nil
+}
+
+// evaluateRollout updates the rollout in place. The gateways of the current
+// wave are added to the upgraded gateways of the rollout, and a wave without
+// any upgraded gateway in the tier is never healthy.
+func (evaluator *TierRolloutEvaluator) evaluateRollout(
+	networkID string,
+	tierID string,
+	rollout *upgrade_protos.TierRollout,
+	now time.Time,
+) error {
+	gatewayIDs, err := evaluator.gateways.ListTierGateways(networkID, tierID)
+	if err != nil {
+		return err
+	}
+	addUpgradedGateways(rollout, gatewayIDs)
+
+	upgradedCount := 0
+	unhealthyGateways := map[string]string{}
+	for _, gatewayID := range gatewayIDs {
+		if !rollout.IsGatewayUpgraded(gatewayID) {
+			continue
+		}
+		upgradedCount++
+		reason, err := evaluator.getUnhealthyReason(networkID, gatewayID, rollout.GetPlan(), now)
+		if err != nil {
+			return err
+		}
+		if reason != "" {
+			unhealthyGateways[gatewayID] = reason
+		}
+	}
+	rollout.UnhealthyGateways = unhealthyGateways
+
+	healthCheck := rollout.GetPlan().GetHealthCheck()
+	if upgradedCount == 0 || len(unhealthyGateways) > 0 {
+		rollout.WaveHealthySince = 0
+		if now.Unix()-rollout.WaveStartedAt < int64(healthCheck.GetWaveTimeoutSecs()) {
+			return nil
+		}
+		message := fmt.Sprintf(
+			"Wave %d timed out with unhealthy gateways %s",
+			rollout.CurrentWave, getSortedKeys(unhealthyGateways),
+		)
+		if upgradedCount == 0 {
+			message = fmt.Sprintf("Wave %d timed out without gateways", rollout.CurrentWave)
+		}
+		if rollout.GetPlan().GetFailureAction() == upgrade_protos.RolloutPlan_ROLLBACK {
+			rollout.State = upgrade_protos.TierRollout_ROLLED_BACK
+		} else {
+			rollout.State = upgrade_protos.TierRollout_PAUSED
+		}
+		rollout.Message = message
+		glog.Warningf("Rollout of tier %s in network %s %s: %s", tierID, networkID, rollout.State, message)
+		return nil
+	}
+
+	if rollout.WaveHealthySince == 0 {
+		rollout.WaveHealthySince = now.Unix()
+	}
+	if now.Unix()-rollout.WaveHealthySince < int64(healthCheck.GetSoakTimeSecs()) {
+		return nil
+	}
+	if int(rollout.CurrentWave) >= len(rollout.GetPlan().GetWaves())-1 {
+		rollout.State = upgrade_protos.TierRollout_COMPLETED
+		rollout.Message = "Rollout completed"
+		return nil
+	}
+	rollout.CurrentWave++
+	rollout.WaveStartedAt = now.Unix()
+	rollout.WaveHealthySince = 0
+	rollout.Message = fmt.Sprintf("Wave %d started", rollout.CurrentWave)
+	addUpgradedGateways(rollout, gatewayIDs)
+	return nil
+}
+
+// addUpgradedGateways adds the gateways the current wave of the rollout
+// selects among the gateways of the tier to the upgraded gateways
+func addUpgradedGateways(rollout *upgrade_protos.TierRollout, tierGatewayIDs []string) {
+	waves := rollout.GetPlan().GetWaves()
+	if int(rollout.CurrentWave) >= len(waves) {
+		return
+	}
+	for _, gatewayID := range waves[rollout.CurrentWave].SelectGateways(tierGatewayIDs) {
+		if !rollout.IsGatewayUpgraded(gatewayID) {
+			rollout.UpgradedGateways = append(rollout.UpgradedGateways, gatewayID)
+		}
+	}
+}
+
+// getUnhealthyReason returns why the gateway fails the health check of the
+// rollout plan, or an empty string if the gateway is healthy
+func (evaluator *TierRolloutEvaluator) getUnhealthyReason(
+	networkID string,
+	gatewayID string,
+	plan *upgrade_protos.RolloutPlan,
+	now time.Time,
+) (string, error) {
+	status, err := evaluator.gateways.GetGatewayStatus(networkID, gatewayID)
+	if err == errors.ErrNotFound {
+		return "gateway never checked in", nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	healthCheck := plan.GetHealthCheck()
+	checkinAge := now.Unix() - int64(status.Time/1000)
+	if checkinAge > int64(healthCheck.GetMaxCheckinAgeSecs()) {
+		return fmt.Sprintf("last checkin was %ds ago", checkinAge), nil
+	}
+	version := getMagmaPackageVersion(status.Checkin)
+	if version != plan.GetTargetVersion() {
+		return fmt.Sprintf("gateway runs version %s", version), nil
+	}
+	meta := status.Checkin.GetStatus().GetMeta()
+	for _, key := range healthCheck.GetRequiredStatusMeta() {
+		if _, ok := meta[key]; !ok {
+			return fmt.Sprintf("status meta %s is missing", key), nil
+		}
+	}
+	return "", nil
+}
+
+func getMagmaPackageVersion(checkin *protos.CheckinRequest) string {
+	for _, pkg := range checkin.GetPlatformInfo().GetPackages() {
+		if pkg.Name == "magma" {
+			return pkg.Version
+		}
+	}
+	return checkin.GetMagmaPkgVersion()
+}
+
+func getSortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,262 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package servicers
+
+import (
+	"fmt"
+	"time"
+
+	"magma/orc8r/cloud/go/datastore"
+	"magma/orc8r/cloud/go/protos"
+	upgrade_protos "magma/orc8r/cloud/go/services/upgrade/protos"
+
+	"github.com/golang/glog"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// NetworkID-partitioned tables: tier string -> protos.TierRollout
+	TierRolloutTableName = "tierRollouts"
+
+	DefaultRolloutMaxCheckinAgeSecs = 5 * 60
+	DefaultRolloutWaveTimeoutSecs   = 60 * 60
+
+	// Attempts to update a rollout which is updated concurrently
+	maxRolloutUpdateAttempts = 3
+)
+
+//------------------------------------------------------------------------------
+// Tier rollout APIs
+//------------------------------------------------------------------------------
+
+func (srv *UpgradeService) StartTierRollout(
+	context context.Context,
+	request *upgrade_protos.StartTierRolloutRequest,
+) (*protos.Void, error) {
+	ret := &protos.Void{}
+	if err := upgrade_protos.ValidateStartTierRolloutReq(request); err != nil {
+		return ret, protos.NewGrpcValidationError(err)
+	}
+
+	networkID, tierID := request.GetNetworkId(), request.GetTierId()
+	tier, _, err := srv.getTier(networkID, tierID)
+	if err == datastore.ErrNotFound {
+		return ret, status.Errorf(codes.FailedPrecondition, "Can't roll out to tier that doesn't exist")
+	}
+	if err != nil {
+		glog.Errorf("Error while loading tier: %s", err)
+		return ret, status.Errorf(codes.Aborted, "Error while starting rollout")
+	}
+	if tier.GetVersion() == request.GetPlan().GetTargetVersion() {
+		return ret, status.Errorf(codes.FailedPrecondition, "Tier %s is already on version %s", tierID, tier.GetVersion())
+	}
+
+	rollout, marshaledRollout, err := srv.getTierRollout(networkID, tierID)
+	if err != nil && err != datastore.ErrNotFound {
+		glog.Errorf("Error while loading tier rollout: %s", err)
+		return ret, status.Errorf(codes.Aborted, "Error while starting rollout")
+	}
+	if rollout.IsActive() {
+		return ret, status.Errorf(codes.FailedPrecondition, "Tier %s already has an active rollout", tierID)
+	}
+
+	plan := request.GetPlan()
+	if plan.HealthCheck == nil {
+		plan.HealthCheck = &upgrade_protos.RolloutHealthCheck{}
+	}
+	if plan.HealthCheck.MaxCheckinAgeSecs == 0 {
+		plan.HealthCheck.MaxCheckinAgeSecs = DefaultRolloutMaxCheckinAgeSecs
+	}
+	if plan.HealthCheck.WaveTimeoutSecs == 0 {
+		plan.HealthCheck.WaveTimeoutSecs = DefaultRolloutWaveTimeoutSecs
+	}
+	rollout = &upgrade_protos.TierRollout{
+		Plan:          plan,
+		State:         upgrade_protos.TierRollout_IN_PROGRESS,
+		CurrentWave:   0,
+		WaveStartedAt: time.Now().Unix(),
+		Message:       "Rollout started",
+	}
+	updated, err := srv.compareAndPutTierRollout(networkID, tierID, marshaledRollout, rollout)
+	if err != nil {
+		glog.Errorf("Error while creating tier rollout: %s", err)
+		return ret, status.Errorf(codes.Unavailable, "Error while starting rollout")
+	}
+	if !updated {
+		return ret, status.Errorf(codes.Aborted, "Rollout of tier %s was updated concurrently", tierID)
+	}
+	return ret, nil
+}
+
+func (srv *UpgradeService) GetTierRollout(
+	context context.Context,
+	request *upgrade_protos.TierRolloutRequest,
+) (*upgrade_protos.TierRollout, error) {
+	if err := upgrade_protos.ValidateTierRolloutReq(request); err != nil {
+		return &upgrade_protos.TierRollout{}, protos.NewGrpcValidationError(err)
+	}
+
+	rollout, _, err := srv.getTierRollout(request.GetNetworkId(), request.GetTierId())
+	if err == datastore.ErrNotFound {
+		return &upgrade_protos.TierRollout{}, status.Errorf(codes.NotFound, "Tier %s has no rollout", request.GetTierId())
+	}
+	if err != nil {
+		glog.Errorf("Error while loading tier rollout: %s", err)
+		return &upgrade_protos.TierRollout{}, status.Errorf(codes.Aborted, "Error while getting rollout")
+	}
+	return rollout, nil
+}
+
+func (srv *UpgradeService) PauseTierRollout(
+	context context.Context,
+	request *upgrade_protos.TierRolloutRequest,
+) (*protos.Void, error) {
+	return srv.updateTierRolloutState(request, func(rollout *upgrade_protos.TierRollout) error {
+		if rollout.State != upgrade_protos.TierRollout_IN_PROGRESS {
+			return status.Errorf(codes.FailedPrecondition, "Can't pause rollout which is %s", rollout.State)
+		}
+		rollout.State = upgrade_protos.TierRollout_PAUSED
+		rollout.Message = "Rollout paused"
+		return nil
+	})
+}
+
+func (srv *UpgradeService) ResumeTierRollout(
+	context context.Context,
+	request *upgrade_protos.TierRolloutRequest,
+) (*protos.Void, error) {
+	return srv.updateTierRolloutState(request, func(rollout *upgrade_protos.TierRollout) error {
+		if rollout.State != upgrade_protos.TierRollout_PAUSED {
+			return status.Errorf(codes.FailedPrecondition, "Can't resume rollout which is %s", rollout.State)
+		}
+		rollout.State = upgrade_protos.TierRollout_IN_PROGRESS
+		rollout.WaveStartedAt = time.Now().Unix()
+		rollout.WaveHealthySince = 0
+		rollout.Message = "Rollout resumed"
+		return nil
+	})
+}
+
+func (srv *UpgradeService) RollbackTierRollout(
+	context context.Context,
+	request *upgrade_protos.TierRolloutRequest,
+) (*protos.Void, error) {
+	return srv.updateTierRolloutState(request, func(rollout *upgrade_protos.TierRollout) error {
+		if !rollout.IsActive() {
+			return status.Errorf(codes.FailedPrecondition, "Can't roll back rollout which is %s", rollout.State)
+		}
+		rollout.State = upgrade_protos.TierRollout_ROLLED_BACK
+		rollout.Message = "Rollout rolled back"
+		return nil
+	})
+}
+
+func (srv *UpgradeService) updateTierRolloutState(
+	request *upgrade_protos.TierRolloutRequest,
+	update func(rollout *upgrade_protos.TierRollout) error,
+) (*protos.Void, error) {
+	ret := &protos.Void{}
+	if err := upgrade_protos.ValidateTierRolloutReq(request); err != nil {
+		return ret, protos.NewGrpcValidationError(err)
+	}
+
+	// The evaluator may update the rollout concurrently, retry the update on
+	// the latest rollout rather than overwriting it
+	networkID, tierID := request.GetNetworkId(), request.GetTierId()
+	for attempt := 0; attempt < maxRolloutUpdateAttempts; attempt++ {
+		rollout, marshaledRollout, err := srv.getTierRollout(networkID, tierID)
+		if err == datastore.ErrNotFound {
+			return ret, status.Errorf(codes.NotFound, "Tier %s has no rollout", tierID)
+		}
+		if err != nil {
+			glog.Errorf("Error while loading tier rollout: %s", err)
+			return ret, status.Errorf(codes.Aborted, "Error while updating rollout")
+		}
+		if err := update(rollout); err != nil {
+			return ret, err
+		}
+		updated, err := srv.compareAndPutTierRollout(networkID, tierID, marshaledRollout, rollout)
+		if err != nil {
+			glog.Errorf("Error while updating tier rollout: %s", err)
+			return ret, status.Errorf(codes.Unavailable, "Error while updating rollout")
+		}
+		if updated {
+			return ret, nil
+		}
+	}
+	return ret, status.Errorf(codes.Aborted, "Rollout of tier %s was updated concurrently", tierID)
+}
+
+func getTierRolloutTableName(networkID string) string {
+	return datastore.GetTableName(networkID, TierRolloutTableName)
+}
+
+// setTierVersion sets the version of the tier to the target version of its
+// completed rollout, retrying if the tier is updated concurrently
+func (srv *UpgradeService) setTierVersion(networkID string, tierID string, version string) error {
+	for attempt := 0; attempt < maxRolloutUpdateAttempts; attempt++ {
+		tier, marshaledTier, err := srv.getTier(networkID, tierID)
+		if err != nil {
+			return err
+		}
+		tier.Version = version
+		updated, err := srv.compareAndPutTier(networkID, tierID, marshaledTier, tier)
+		if err != nil || updated {
+			return err
+		}
+	}
+	return fmt.Errorf("tier %s was updated concurrently", tierID)
+}
+
+// getTier also returns the marshaled tier to update it with
+// store.PutIfValue
+func (srv *UpgradeService) getTier(networkID string, tierID string) (*upgrade_protos.TierInfo, []byte, error) {
+	marshaledTier, _, err := srv.store.Get(getTierTableName(networkID), tierID)
+	if err != nil {
+		return nil, nil, err
+	}
+	tier := &upgrade_protos.TierInfo{}
+	err = protos.Unmarshal(marshaledTier, tier)
+	return tier, marshaledTier, err
+}
+
+// getTierRollout returns datastore.ErrNotFound if the tier has no rollout. It
+// also returns the marshaled rollout to update it with compareAndPutTierRollout.
+func (srv *UpgradeService) getTierRollout(networkID string, tierID string) (*upgrade_protos.TierRollout, []byte, error) {
+	marshaledRollout, _, err := srv.store.Get(getTierRolloutTableName(networkID), tierID)
+	if err != nil {
+		return nil, nil, err
+	}
+	rollout := &upgrade_protos.TierRollout{}
+	err = protos.Unmarshal(marshaledRollout, rollout)
+	return rollout, marshaledRollout, err
+}
+
+// compareAndPutTierRollout puts the rollout only if the stored rollout of the
+// tier is still the expected marshaled rollout, or the tier has no rollout if
+// expected is nil, and returns whether it was put. Rollouts are updated by the
+// API and the evaluators of all replicas of the service, so they're never
+// overwritten blindly.
+func (srv *UpgradeService) compareAndPutTierRollout(
+	networkID string,
+	tierID string,
+	expected []byte,
+	rollout *upgrade_protos.TierRollout,
+) (bool, error) {
+	marshaledRollout, err := protos.MarshalIntern(rollout)
+	if err != nil {
+		return false, err
+	}
+	if expected == nil {
+		return srv.store.PutIfAbsent(getTierRolloutTableName(networkID), tierID, marshaledRollout)
+	}
+	return srv.store.PutIfValue(getTierRolloutTableName(networkID), tierID, expected, marshaledRollout)
+}
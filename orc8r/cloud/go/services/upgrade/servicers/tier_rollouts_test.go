@@ -0,0 +1,385 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package servicers_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"magma/orc8r/cloud/go/errors"
+	"magma/orc8r/cloud/go/protos"
+	upgrade_protos "magma/orc8r/cloud/go/services/upgrade/protos"
+	"magma/orc8r/cloud/go/services/upgrade/servicers"
+	"magma/orc8r/cloud/go/test_utils"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestUpgradeService_TierRollouts(t *testing.T) {
+	ctx := context.Background()
+	ds := test_utils.NewMockDatastore()
+	setupTierVersioningFixtures(t, ds, "network_tierVersions", map[string]*upgrade_protos.TierInfo{
+		"t1": {Name: "t1", Version: "1.0.0-0"},
+	})
+	srv := servicers.NewUpgradeService(ds)
+	tierReq := &upgrade_protos.TierRolloutRequest{NetworkId: "network", TierId: "t1"}
+
+	_, err := srv.GetTierRollout(ctx, tierReq)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = srv.PauseTierRollout(ctx, tierReq)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// Invalid plans are rejected as validation errors
+	plan := &upgrade_protos.RolloutPlan{
+		TargetVersion: "1.1.0-0",
+		Waves:         []*upgrade_protos.RolloutWave{{GatewayIds: []string{"gw1"}}, {Percentage: 50}},
+	}
+	_, err = srv.StartTierRollout(ctx, &upgrade_protos.StartTierRolloutRequest{NetworkId: "network", TierId: "t1", Plan: plan})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	plan.Waves = []*upgrade_protos.RolloutWave{{Percentage: 50}, {Percentage: 10}, {Percentage: 100}}
+	_, err = srv.StartTierRollout(ctx, &upgrade_protos.StartTierRolloutRequest{NetworkId: "network", TierId: "t1", Plan: plan})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// Nonexistent tier and tier already on the target version
+	plan.Waves = []*upgrade_protos.RolloutWave{{GatewayIds: []string{"gw1"}}, {Percentage: 100}}
+	_, err = srv.StartTierRollout(ctx, &upgrade_protos.StartTierRolloutRequest{NetworkId: "network", TierId: "t2", Plan: plan})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	_, err = srv.StartTierRollout(ctx, &upgrade_protos.StartTierRolloutRequest{
+		NetworkId: "network",
+		TierId:    "t1",
+		Plan:      &upgrade_protos.RolloutPlan{TargetVersion: "1.0.0-0", Waves: plan.Waves},
+	})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	_, err = srv.StartTierRollout(ctx, &upgrade_protos.StartTierRolloutRequest{NetworkId: "network", TierId: "t1", Plan: plan})
+	assert.NoError(t, err)
+	rollout, err := srv.GetTierRollout(ctx, tierReq)
+	assert.NoError(t, err)
+	assert.Equal(t, upgrade_protos.TierRollout_IN_PROGRESS, rollout.State)
+	assert.Equal(t, uint32(0), rollout.CurrentWave)
+	// Defaults are filled in
+	assert.Equal(t, uint32(servicers.DefaultRolloutMaxCheckinAgeSecs), rollout.Plan.HealthCheck.MaxCheckinAgeSecs)
+	assert.Equal(t, uint32(servicers.DefaultRolloutWaveTimeoutSecs), rollout.Plan.HealthCheck.WaveTimeoutSecs)
+
+	// No gateway is upgraded until the evaluator starts the first wave, then
+	// only the canary is
+	tier := &upgrade_protos.TierInfo{Name: "t1", Version: "1.0.0-0"}
+	assert.Equal(t, "1.0.0-0", upgrade_protos.GetGatewayPackageVersion(tier, rollout, "gw1"))
+	evaluator := servicers.NewTierRolloutEvaluator(srv, &mockGatewayDirectory{
+		tierGateways: map[string][]string{"t1": {"gw1", "gw2"}},
+		statuses:     map[string]*protos.GatewayStatus{},
+	})
+	assert.NoError(t, evaluator.EvaluateRollouts(time.Now()))
+	rollout, err = srv.GetTierRollout(ctx, tierReq)
+	assert.NoError(t, err)
+	assert.Equal(t, "1.1.0-0", upgrade_protos.GetGatewayPackageVersion(tier, rollout, "gw1"))
+	assert.Equal(t, "1.0.0-0", upgrade_protos.GetGatewayPackageVersion(tier, rollout, "gw2"))
+
+	// Tier can't get another rollout or change versions while the rollout is
+	// active
+	_, err = srv.StartTierRollout(ctx, &upgrade_protos.StartTierRolloutRequest{NetworkId: "network", TierId: "t1", Plan: plan})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	_, err = srv.UpdateTier(ctx, &upgrade_protos.UpdateTierRequest{
+		NetworkId:   "network",
+		TierId:      "t1",
+		UpdatedTier: &upgrade_protos.TierInfo{Name: "t1", Version: "1.2.0-0"},
+	})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	_, err = srv.UpdateTier(ctx, &upgrade_protos.UpdateTierRequest{
+		NetworkId:   "network",
+		TierId:      "t1",
+		UpdatedTier: &upgrade_protos.TierInfo{Name: "t1 renamed", Version: "1.0.0-0"},
+	})
+	assert.NoError(t, err)
+
+	// State transitions
+	_, err = srv.ResumeTierRollout(ctx, tierReq)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	_, err = srv.PauseTierRollout(ctx, tierReq)
+	assert.NoError(t, err)
+	rollout, err = srv.GetTierRollout(ctx, tierReq)
+	assert.NoError(t, err)
+	assert.Equal(t, upgrade_protos.TierRollout_PAUSED, rollout.State)
+	assert.Equal(t, "1.1.0-0", upgrade_protos.GetGatewayPackageVersion(tier, rollout, "gw1"))
+	_, err = srv.PauseTierRollout(ctx, tierReq)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	_, err = srv.ResumeTierRollout(ctx, tierReq)
+	assert.NoError(t, err)
+	_, err = srv.RollbackTierRollout(ctx, tierReq)
+	assert.NoError(t, err)
+	rollout, err = srv.GetTierRollout(ctx, tierReq)
+	assert.NoError(t, err)
+	assert.Equal(t, upgrade_protos.TierRollout_ROLLED_BACK, rollout.State)
+	assert.Equal(t, "1.0.0-0", upgrade_protos.GetGatewayPackageVersion(tier, rollout, "gw1"))
+	_, err = srv.RollbackTierRollout(ctx, tierReq)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// A new rollout can start after a rollback, deleting the tier deletes it
+	_, err = srv.StartTierRollout(ctx, &upgrade_protos.StartTierRolloutRequest{NetworkId: "network", TierId: "t1", Plan: plan})
+	assert.NoError(t, err)
+	_, err = srv.DeleteTier(ctx, &upgrade_protos.DeleteTierRequest{NetworkId: "network", TierIdToDelete: "t1"})
+	assert.NoError(t, err)
+	_, err = srv.GetTierRollout(ctx, tierReq)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestTierRolloutEvaluator(t *testing.T) {
+	ctx := context.Background()
+	ds := test_utils.NewMockDatastore()
+	setupTierVersioningFixtures(t, ds, "network_tierVersions", map[string]*upgrade_protos.TierInfo{
+		"t1": {Name: "t1", Version: "1.0.0-0"},
+	})
+	srv := servicers.NewUpgradeService(ds)
+	gateways := &mockGatewayDirectory{
+		tierGateways: map[string][]string{"t1": {"gw1", "gw2", "gw3"}},
+		statuses:     map[string]*protos.GatewayStatus{},
+	}
+	evaluator := servicers.NewTierRolloutEvaluator(srv, gateways)
+	tierReq := &upgrade_protos.TierRolloutRequest{NetworkId: "network", TierId: "t1"}
+
+	now := time.Now()
+	_, err := srv.StartTierRollout(ctx, &upgrade_protos.StartTierRolloutRequest{
+		NetworkId: "network",
+		TierId:    "t1",
+		Plan: &upgrade_protos.RolloutPlan{
+			TargetVersion: "1.1.0-0",
+			Waves:         []*upgrade_protos.RolloutWave{{GatewayIds: []string{"gw1"}}, {Percentage: 100}},
+			HealthCheck:   &upgrade_protos.RolloutHealthCheck{SoakTimeSecs: 60, WaveTimeoutSecs: 600},
+		},
+	})
+	assert.NoError(t, err)
+
+	// Canary hasn't checked in yet
+	assert.NoError(t, evaluator.EvaluateRollouts(now))
+	rollout := getTierRollout(t, srv, tierReq)
+	assert.Equal(t, upgrade_protos.TierRollout_IN_PROGRESS, rollout.State)
+	assert.Equal(t, uint32(0), rollout.CurrentWave)
+	assert.Equal(t, map[string]string{"gw1": "gateway never checked in"}, rollout.UnhealthyGateways)
+	assert.Equal(t, []string{"gw1"}, rollout.UpgradedGateways)
+
+	// Canary upgraded, the wave has to soak before the next wave starts
+	gateways.statuses["gw1"] = getGatewayStatus(now, "1.1.0-0")
+	gateways.statuses["gw2"] = getGatewayStatus(now, "1.0.0-0")
+	gateways.statuses["gw3"] = getGatewayStatus(now, "1.0.0-0")
+	assert.NoError(t, evaluator.EvaluateRollouts(now))
+	rollout = getTierRollout(t, srv, tierReq)
+	assert.Equal(t, uint32(0), rollout.CurrentWave)
+	assert.Empty(t, rollout.UnhealthyGateways)
+	assert.Equal(t, now.Unix(), rollout.WaveHealthySince)
+
+	now = now.Add(61 * time.Second)
+	gateways.statuses["gw1"] = getGatewayStatus(now, "1.1.0-0")
+	assert.NoError(t, evaluator.EvaluateRollouts(now))
+	rollout = getTierRollout(t, srv, tierReq)
+	assert.Equal(t, upgrade_protos.TierRollout_IN_PROGRESS, rollout.State)
+	assert.Equal(t, uint32(1), rollout.CurrentWave)
+	assert.Equal(t, now.Unix(), rollout.WaveStartedAt)
+	assert.Equal(t, int64(0), rollout.WaveHealthySince)
+	assert.Equal(t, []string{"gw1", "gw2", "gw3"}, rollout.UpgradedGateways)
+
+	// The rest of the tier doesn't upgrade within the wave timeout
+	now = now.Add(601 * time.Second)
+	gateways.statuses["gw1"] = getGatewayStatus(now, "1.1.0-0")
+	gateways.statuses["gw2"] = getGatewayStatus(now, "1.0.0-0")
+	assert.NoError(t, evaluator.EvaluateRollouts(now))
+	rollout = getTierRollout(t, srv, tierReq)
+	assert.Equal(t, upgrade_protos.TierRollout_PAUSED, rollout.State)
+	assert.Equal(t, uint32(1), rollout.CurrentWave)
+	assert.Equal(
+		t,
+		map[string]string{"gw2": "gateway runs version 1.0.0-0", "gw3": "last checkin was 662s ago"},
+		rollout.UnhealthyGateways,
+	)
+	assert.Equal(t, "Wave 1 timed out with unhealthy gateways [gw2 gw3]", rollout.Message)
+
+	// Paused rollouts aren't evaluated
+	now = time.Now()
+	gateways.statuses["gw2"] = getGatewayStatus(now, "1.1.0-0")
+	gateways.statuses["gw3"] = getGatewayStatus(now, "1.1.0-0")
+	assert.NoError(t, evaluator.EvaluateRollouts(now))
+	assert.Equal(t, upgrade_protos.TierRollout_PAUSED, getTierRollout(t, srv, tierReq).State)
+
+	// Resumed rollout completes after the last wave soaked
+	gateways.statuses["gw1"] = getGatewayStatus(now, "1.1.0-0")
+	_, err = srv.ResumeTierRollout(ctx, tierReq)
+	assert.NoError(t, err)
+	assert.NoError(t, evaluator.EvaluateRollouts(now))
+	assert.Equal(t, upgrade_protos.TierRollout_IN_PROGRESS, getTierRollout(t, srv, tierReq).State)
+	assert.NoError(t, evaluator.EvaluateRollouts(now.Add(60*time.Second)))
+	rollout = getTierRollout(t, srv, tierReq)
+	assert.Equal(t, upgrade_protos.TierRollout_COMPLETED, rollout.State)
+	tiers, err := srv.GetTiers(ctx, &upgrade_protos.GetTiersRequest{NetworkId: "network"})
+	assert.NoError(t, err)
+	assert.Equal(t, "1.1.0-0", tiers.Tiers["t1"].Version)
+	assert.Equal(t, "1.1.0-0", upgrade_protos.GetGatewayPackageVersion(tiers.Tiers["t1"], rollout, "gw2"))
+
+	// Failing rollout with rollback as failure action
+	_, err = srv.StartTierRollout(ctx, &upgrade_protos.StartTierRolloutRequest{
+		NetworkId: "network",
+		TierId:    "t1",
+		Plan: &upgrade_protos.RolloutPlan{
+			TargetVersion: "1.2.0-0",
+			Waves:         []*upgrade_protos.RolloutWave{{Percentage: 100}},
+			HealthCheck:   &upgrade_protos.RolloutHealthCheck{RequiredStatusMeta: []string{"mme"}, WaveTimeoutSecs: 600},
+			FailureAction: upgrade_protos.RolloutPlan_ROLLBACK,
+		},
+	})
+	assert.NoError(t, err)
+	now = time.Now().Add(601 * time.Second)
+	for _, gatewayID := range []string{"gw1", "gw2", "gw3"} {
+		gateways.statuses[gatewayID] = getGatewayStatus(now, "1.2.0-0")
+	}
+	gateways.statuses["gw1"].Checkin.Status = &protos.ServiceStatus{Meta: map[string]string{"mme": "up"}}
+	assert.NoError(t, evaluator.EvaluateRollouts(now))
+	rollout = getTierRollout(t, srv, tierReq)
+	assert.Equal(t, upgrade_protos.TierRollout_ROLLED_BACK, rollout.State)
+	assert.Equal(
+		t,
+		map[string]string{"gw2": "status meta mme is missing", "gw3": "status meta mme is missing"},
+		rollout.UnhealthyGateways,
+	)
+	tiers, err = srv.GetTiers(ctx, &upgrade_protos.GetTiersRequest{NetworkId: "network"})
+	assert.NoError(t, err)
+	assert.Equal(t, "1.1.0-0", tiers.Tiers["t1"].Version)
+	assert.Equal(t, "1.1.0-0", upgrade_protos.GetGatewayPackageVersion(tiers.Tiers["t1"], rollout, "gw1"))
+}
+
+func TestTierRolloutEvaluator_Conflicts(t *testing.T) {
+	ctx := context.Background()
+	ds := test_utils.NewMockDatastore()
+	setupTierVersioningFixtures(t, ds, "network_tierVersions", map[string]*upgrade_protos.TierInfo{
+		"t1": {Name: "t1", Version: "1.0.0-0"},
+		"t2": {Name: "t2", Version: "1.0.0-0"},
+		"t3": {Name: "t3", Version: "1.0.0-0"},
+	})
+	srv := servicers.NewUpgradeService(ds)
+	gateways := &mockGatewayDirectory{
+		tierGateways: map[string][]string{"t1": {"gw1"}, "t3": {"gw3"}},
+		statuses:     map[string]*protos.GatewayStatus{},
+		listErrs:     map[string]error{"t3": fmt.Errorf("directory unavailable")},
+	}
+	evaluator := servicers.NewTierRolloutEvaluator(srv, gateways)
+	plan := &upgrade_protos.RolloutPlan{
+		TargetVersion: "1.1.0-0",
+		Waves:         []*upgrade_protos.RolloutWave{{Percentage: 100}},
+		HealthCheck:   &upgrade_protos.RolloutHealthCheck{WaveTimeoutSecs: 600},
+	}
+	for _, tierID := range []string{"t1", "t2", "t3"} {
+		_, err := srv.StartTierRollout(ctx, &upgrade_protos.StartTierRolloutRequest{NetworkId: "network", TierId: tierID, Plan: plan})
+		assert.NoError(t, err)
+	}
+
+	// The rollout of t1 is paused while it's evaluated, the evaluation
+	// doesn't overwrite the pause. Errors evaluating t3 don't stop the
+	// evaluation of t2, whose wave has no gateways and times out.
+	t1Req := &upgrade_protos.TierRolloutRequest{NetworkId: "network", TierId: "t1"}
+	gateways.onListTierGateways = func(tierID string) {
+		if tierID == "t1" {
+			_, err := srv.PauseTierRollout(ctx, t1Req)
+			assert.NoError(t, err)
+		}
+	}
+	now := time.Now().Add(601 * time.Second)
+	assert.NoError(t, evaluator.EvaluateRollouts(now))
+	rollout := getTierRollout(t, srv, t1Req)
+	assert.Equal(t, upgrade_protos.TierRollout_PAUSED, rollout.State)
+	assert.Equal(t, "Rollout paused", rollout.Message)
+	assert.Empty(t, rollout.UpgradedGateways)
+
+	rollout = getTierRollout(t, srv, &upgrade_protos.TierRolloutRequest{NetworkId: "network", TierId: "t2"})
+	assert.Equal(t, upgrade_protos.TierRollout_PAUSED, rollout.State)
+	assert.Equal(t, "Wave 0 timed out without gateways", rollout.Message)
+
+	rollout = getTierRollout(t, srv, &upgrade_protos.TierRolloutRequest{NetworkId: "network", TierId: "t3"})
+	assert.Equal(t, upgrade_protos.TierRollout_IN_PROGRESS, rollout.State)
+	assert.Equal(t, "Rollout started", rollout.Message)
+}
+
+func TestRolloutWave_SelectGateways(t *testing.T) {
+	tierGatewayIDs := []string{"gw5", "gw3", "gw1", "gw4", "gw2"}
+	testCases := []struct {
+		wave     *upgrade_protos.RolloutWave
+		expected []string
+	}{
+		{&upgrade_protos.RolloutWave{}, []string{}},
+		{&upgrade_protos.RolloutWave{Percentage: 1}, []string{"gw1"}},
+		{&upgrade_protos.RolloutWave{Percentage: 20}, []string{"gw1"}},
+		{&upgrade_protos.RolloutWave{Percentage: 30}, []string{"gw1", "gw2"}},
+		{&upgrade_protos.RolloutWave{Percentage: 100}, []string{"gw1", "gw2", "gw3", "gw4", "gw5"}},
+		// Listed gateways outside of the tier aren't selected
+		{&upgrade_protos.RolloutWave{Percentage: 20, GatewayIds: []string{"gw4", "gw1", "gw9"}}, []string{"gw1", "gw4"}},
+	}
+	for _, tc := range testCases {
+		assert.Equal(t, tc.expected, tc.wave.SelectGateways(tierGatewayIDs), "wave: %v", tc.wave)
+	}
+	assert.Equal(t, []string{}, (&upgrade_protos.RolloutWave{Percentage: 50}).SelectGateways(nil))
+	// The tier's gateway IDs aren't reordered
+	assert.Equal(t, []string{"gw5", "gw3", "gw1", "gw4", "gw2"}, tierGatewayIDs)
+}
+
+func TestGetGatewayPackageVersion(t *testing.T) {
+	tier := &upgrade_protos.TierInfo{Version: "1.0.0-0"}
+	assert.Equal(t, "1.0.0-0", upgrade_protos.GetGatewayPackageVersion(tier, nil, "gw1"))
+
+	// Only the upgraded gateways of active rollouts run the target version
+	rollout := &upgrade_protos.TierRollout{
+		Plan:             &upgrade_protos.RolloutPlan{TargetVersion: "1.1.0-0"},
+		State:            upgrade_protos.TierRollout_IN_PROGRESS,
+		UpgradedGateways: []string{"gw1"},
+	}
+	assert.Equal(t, "1.1.0-0", upgrade_protos.GetGatewayPackageVersion(tier, rollout, "gw1"))
+	assert.Equal(t, "1.0.0-0", upgrade_protos.GetGatewayPackageVersion(tier, rollout, "gw2"))
+	rollout.State = upgrade_protos.TierRollout_ROLLED_BACK
+	assert.Equal(t, "1.0.0-0", upgrade_protos.GetGatewayPackageVersion(tier, rollout, "gw1"))
+}
+
+type mockGatewayDirectory struct {
+	tierGateways       map[string][]string
+	statuses           map[string]*protos.GatewayStatus
+	listErrs           map[string]error
+	onListTierGateways func(tierID string)
+}
+
+func (m *mockGatewayDirectory) ListNetworks() ([]string, error) {
+	return []string{"network"}, nil
+}
+
+func (m *mockGatewayDirectory) ListTierGateways(networkID string, tierID string) ([]string, error) {
+	if m.onListTierGateways != nil {
+		m.onListTierGateways(tierID)
+	}
+	return m.tierGateways[tierID], m.listErrs[tierID]
+}
+
+func (m *mockGatewayDirectory) GetGatewayStatus(networkID string, gatewayID string) (*protos.GatewayStatus, error) {
+	status, ok := m.statuses[gatewayID]
+	if !ok {
+		return nil, errors.ErrNotFound
+	}
+	return status, nil
+}
+
+func getGatewayStatus(checkinTime time.Time, version string) *protos.GatewayStatus {
+	return &protos.GatewayStatus{
+		Time: uint64(checkinTime.Unix() * 1000),
+		Checkin: &protos.CheckinRequest{
+			PlatformInfo: &protos.PlatformInfo{Packages: []*protos.Package{{Name: "magma", Version: version}}},
+		},
+	}
+}
+
+func getTierRollout(t *testing.T, srv *servicers.UpgradeService, req *upgrade_protos.TierRolloutRequest) *upgrade_protos.TierRollout {
+	rollout, err := srv.GetTierRollout(context.Background(), req)
+	assert.NoError(t, err)
+	return rollout
+}
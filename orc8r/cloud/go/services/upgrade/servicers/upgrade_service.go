@@ -18,6 +18,10 @@ LICENSE file in the root directory of this source tree.
 //	A per-network table that maps a tier to its model. Tiers are a way to
 //	partition a network into groups of gateways which can be targeted to
 //	update to a specific version in order to implement a rolling upgrade.
+// 3. tier => TierRollout
+//	A per-network table that maps a tier to its latest rollout. Rollouts
+//	upgrade the gateways of a tier to a new version in waves, see
+//	TierRolloutEvaluator.
 //
 // UpgradeService implements the UpgradeServiceServer interface defined in the
 // .go file generated by upgrade_service.proto. See .proto file for interface
@@ -291,11 +295,34 @@ func (srv *UpgradeService) UpdateTier(
 		return ret, status.Errorf(codes.FailedPrecondition, "Can't update tier that doesn't exist")
 	}
 
-	err := srv.putTier(networkID, request.GetTierId(), request.GetUpdatedTier())
+	// The version of a tier with an active rollout is changed by the rollout,
+	// which may complete concurrently
+	tier, marshaledTier, err := srv.getTier(networkID, request.GetTierId())
+	if err != nil {
+		glog.Errorf("Error while loading tier: %s", err)
+		return ret, status.Errorf(codes.Aborted, "Error while updating tiers")
+	}
+	rollout, _, err := srv.getTierRollout(networkID, request.GetTierId())
+	if err != nil && err != datastore.ErrNotFound {
+		glog.Errorf("Error while loading tier rollout: %s", err)
+		return ret, status.Errorf(codes.Aborted, "Error while updating tiers")
+	}
+	if rollout.IsActive() && tier.GetVersion() != request.GetUpdatedTier().GetVersion() {
+		return ret, status.Errorf(
+			codes.FailedPrecondition,
+			"Can't change version of tier %s while it has an active rollout",
+			request.GetTierId(),
+		)
+	}
+
+	updated, err := srv.compareAndPutTier(networkID, request.GetTierId(), marshaledTier, request.GetUpdatedTier())
 	if err != nil {
 		glog.Errorf("Error while updating tier: %s", err)
 		return ret, status.Errorf(codes.Unavailable, "Error while updating tiers")
 	}
+	if !updated {
+		return ret, status.Errorf(codes.Aborted, "Tier %s was updated concurrently", request.GetTierId())
+	}
 	return ret, nil
 }
 
@@ -317,7 +344,12 @@ func (srv *UpgradeService) DeleteTier(
 		return ret, status.Errorf(codes.FailedPrecondition, "Can't delete tier that doesn't exist")
 	}
 
-	err := srv.store.Delete(getTierTableName(networkID), request.GetTierIdToDelete())
+	err := srv.store.Delete(getTierRolloutTableName(networkID), request.GetTierIdToDelete())
+	if err != nil {
+		glog.Errorf("Error while deleting tier rollout: %s", err)
+		return ret, status.Errorf(codes.Unavailable, "Error while deleting tier")
+	}
+	err = srv.store.Delete(getTierTableName(networkID), request.GetTierIdToDelete())
 	if err != nil {
 		glog.Errorf("Error while deleting tier: %s", err)
 		return ret, status.Errorf(codes.Unavailable, "Error while deleting tier")
@@ -353,3 +385,18 @@ func (srv *UpgradeService) putTier(networkID string, tierName string, tierInfo *
 	}
 	return srv.store.Put(getTierTableName(networkID), tierName, marshalledTier)
 }
+
+// compareAndPutTier puts the tier only if the stored tier is still the
+// expected marshaled tier and returns whether it was put
+func (srv *UpgradeService) compareAndPutTier(
+	networkID string,
+	tierName string,
+	expected []byte,
+	tierInfo *upgrade_protos.TierInfo,
+) (bool, error) {
+	marshalledTier, err := protos.MarshalIntern(tierInfo)
+	if err != nil {
+		return false, err
+	}
+	return srv.store.PutIfValue(getTierTableName(networkID), tierName, expected, marshalledTier)
+}
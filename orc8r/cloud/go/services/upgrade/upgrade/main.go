@@ -10,6 +10,7 @@ package main
 
 import (
 	"log"
+	"time"
 
 	"magma/orc8r/cloud/go/datastore"
 	"magma/orc8r/cloud/go/orc8r"
@@ -20,6 +21,11 @@ import (
 	"magma/orc8r/cloud/go/sql_utils"
 )
 
+const (
+	// how often to advance the tier rollouts in progress
+	TIER_ROLLOUT_EVALUATION_INTERVAL = time.Second * 60
+)
+
 func main() {
 	// Create the service
 	srv, err := service.NewOrchestratorService(orc8r.ModuleName, upgrade.ServiceName)
@@ -37,6 +43,10 @@ func main() {
 	servicer := servicers.NewUpgradeService(store)
 	protos.RegisterUpgradeServiceServer(srv.GrpcServer, servicer)
 
+	// Advance tier rollouts based on the health of the upgraded gateways
+	rolloutEvaluator := servicers.NewTierRolloutEvaluator(servicer, servicers.NewMagmadGatewayDirectory())
+	go rolloutEvaluator.Run(TIER_ROLLOUT_EVALUATION_INTERVAL)
+
 	// Run the service
 	err = srv.Run()
 	if err != nil {
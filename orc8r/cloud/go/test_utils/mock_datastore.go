@@ -9,6 +9,7 @@ LICENSE file in the root directory of this source tree.
 package test_utils
 
 import (
+	"bytes"
 	"sync"
 
 	"magma/orc8r/cloud/go/datastore"
@@ -122,3 +123,32 @@ func (m *MockDatastore) DoesKeyExist(table string, key string) (bool, error) {
 		return false, nil
 	}
 }
+
+func (m *MockDatastore) PutIfAbsent(table string, key string, value []byte) (bool, error) {
+	m.initTable(table)
+	if _, ok := m.store[table][key]; ok {
+		return false, nil
+	}
+	m.store[table][key] = value
+	return true, nil
+}
+
+func (m *MockDatastore) PutIfValue(table string, key string, expected []byte, value []byte) (bool, error) {
+	m.initTable(table)
+	current, ok := m.store[table][key]
+	if !ok || !bytes.Equal(current, expected) {
+		return false, nil
+	}
+	m.store[table][key] = value
+	return true, nil
+}
+
+func (m *MockDatastore) DeleteIfValue(table string, key string, value []byte) (bool, error) {
+	m.initTable(table)
+	current, ok := m.store[table][key]
+	if !ok || !bytes.Equal(current, value) {
+		return false, nil
+	}
+	delete(m.store[table], key)
+	return true, nil
+}
@@ -60,14 +60,22 @@ func (ents *Entities) Set(value string) error {
 			"Invalid Entity Specification for '%s', Id cannot be empty",
 			value)
 	}
-	permStr := strings.ToUpper(strings.TrimSpace(value[sepIdx+1:]))
-	// Check if it's in the form: R, W, RW or R&W, R+W, R|W or any combination
-	// of them
+	perm, err := ParsePermissions(value[sepIdx+1:])
+	if err != nil {
+		return fmt.Errorf("Invalid Entity Specification for '%s': %s", value, err)
+	}
+	*ents = append(*ents, Entity{id, int32(perm)})
+	return nil
+}
+
+// ParsePermissions parses permissions in the form R, W, RW or R&W, R+W, R|W
+// or any combination of them
+func ParsePermissions(value string) (accessprotos.AccessControl_Permission, error) {
+	permStr := strings.ToUpper(strings.TrimSpace(value))
 	match, err := regexp.MatchString("^[RW+&|]+$", permStr)
 	if err != nil || (!match) {
-		return fmt.Errorf(
-			"Invalid Entity Permissions for '%s': %s. Expected <id>:R|W|RW",
-			id, permStr)
+		return accessprotos.AccessControl_NONE, fmt.Errorf(
+			"invalid permissions '%s', expected R|W|RW", permStr)
 	}
 	perm := accessprotos.AccessControl_NONE
 	if strings.Contains(permStr, "R") {
@@ -77,10 +85,7 @@ func (ents *Entities) Set(value string) error {
 		perm |= accessprotos.AccessControl_WRITE
 	}
 	if perm == accessprotos.AccessControl_NONE {
-		return fmt.Errorf(
-			"Invalid Entity Specification for '%s', at least one R/W permission must be specified",
-			value)
+		return perm, fmt.Errorf("at least one R/W permission must be specified")
 	}
-	*ents = append(*ents, Entity{id, int32(perm)})
-	return nil
+	return perm, nil
 }
@@ -24,7 +24,7 @@ import (
 func init() {
 	cmd := CommandRegistry.Add(
 		"list",
-		"List all Operators, their Certificate Serial Numbers and ACLs",
+		"List all Operators, their Certificate Serial Numbers, ACLs and Roles",
 		list)
 	cmd.Flags().Usage = func() {
 		fmt.Fprintf(os.Stderr, "\tUsage: %s %s\n", os.Args[0], cmd.Name())
@@ -77,6 +77,15 @@ func list(cmd *commands.Command, args []string) int {
 			log.Printf("Error Finding certificates for %s", opname)
 		}
 		PrintACL(acl, certSNs)
+		if bindings, err := accessd.GetOperatorRoles(acl.GetOperator()); err != nil {
+			log.Printf("Error Getting roles for %s: %s", opname, err)
+		} else if len(bindings) > 0 {
+			fmt.Printf("\t\tRoles:\n")
+			for _, b := range bindings {
+				fmt.Printf("\t\t  %s: %s\n", b.Role, b.Networks)
+			}
+			fmt.Println()
+		}
 	}
 	return 0
 }
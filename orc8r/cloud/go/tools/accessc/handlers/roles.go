@@ -0,0 +1,254 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+// Package handlers implements individual accessc commands as well as common
+// across multiple commands functionality
+package handlers
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"magma/orc8r/cloud/go/identity"
+	"magma/orc8r/cloud/go/protos"
+	"magma/orc8r/cloud/go/services/accessd"
+	accessprotos "magma/orc8r/cloud/go/services/accessd/protos"
+	"magma/orc8r/cloud/go/tools/commands"
+)
+
+// Role commands - manage named roles (reusable permission sets) and their
+// bindings to Operators
+
+var (
+	roleDescription string
+	roleNetworkPerm string
+	roleBindings    RoleBindings
+)
+
+// RoleBindings - list of role bindings compiled from command line flags
+type RoleBindings []*accessprotos.AccessControl_RoleBinding
+
+// String - stringer for role bindings
+func (bindings *RoleBindings) String() string {
+	if bindings == nil {
+		return "<nil>"
+	}
+	res := []string{}
+	for _, b := range *bindings {
+		res = append(res, fmt.Sprintf("%s:%s", b.Role, strings.Join(b.Networks, ",")))
+	}
+	return strings.Join(res, "; ")
+}
+
+// Set adds a new role binding from provided flag value string in the form:
+// <role>[:<network Id|*>,...]. Bindings without networks apply to all networks
+func (bindings *RoleBindings) Set(value string) error {
+	role, nets := value, "*"
+	if sepIdx := strings.Index(value, ":"); sepIdx >= 0 {
+		role, nets = value[:sepIdx], value[sepIdx+1:]
+	}
+	role = strings.TrimSpace(role)
+	if len(role) == 0 {
+		return fmt.Errorf("Invalid Role Binding '%s', role cannot be empty", value)
+	}
+	binding := &accessprotos.AccessControl_RoleBinding{Role: role}
+	for _, nid := range strings.Split(nets, ",") {
+		nid = strings.TrimSpace(nid)
+		if len(nid) == 0 {
+			return fmt.Errorf("Invalid Role Binding '%s', empty network Id", value)
+		}
+		binding.Networks = append(binding.Networks, nid)
+	}
+	*bindings = append(*bindings, binding)
+	return nil
+}
+
+func init() {
+	entHelp := "%s with required permissions in the form: <id|*>:R|W|RW"
+
+	cmd := CommandRegistry.Add(
+		"role-add",
+		"Add a new Role or update an existing one",
+		roleAdd)
+	f := cmd.Flags()
+	f.Usage = func() {
+		fmt.Fprintf(os.Stderr, // std Usage() & PrintDefaults() use Stderr
+			"\tUsage: %s %s [OPTIONS] <Role Name>\n", os.Args[0], cmd.Name())
+		f.PrintDefaults()
+	}
+	f.StringVar(&roleDescription, "description", "", "Role description")
+	f.StringVar(&roleNetworkPerm, "network-perm", "",
+		"Permissions (R|W|RW) granted on every network the role is bound to")
+	f.Var(&networks, "n", fmt.Sprintf(entHelp, "Networks"))
+	f.Var(&operators, "o", fmt.Sprintf(entHelp, "Operators"))
+	f.Var(&gateways, "g", fmt.Sprintf(entHelp, "Gateways"))
+
+	cmd = CommandRegistry.Add("role-list", "List all Roles", roleList)
+	cmd.Flags().Usage = func() {
+		fmt.Fprintf(os.Stderr, "\tUsage: %s role-list\n", os.Args[0])
+	}
+
+	cmd = CommandRegistry.Add(
+		"role-delete",
+		"Delete given Role, the Role must not be bound to any Operator",
+		roleDelete)
+	cmd.Flags().Usage = func() {
+		fmt.Fprintf(os.Stderr, "\tUsage: %s role-delete <Role Name>\n", os.Args[0])
+	}
+
+	cmd = CommandRegistry.Add(
+		"bind",
+		"Bind Roles to given Operator, replaces the Operator's existing bindings of the same Roles",
+		bind)
+	bf := cmd.Flags()
+	bf.Usage = func() {
+		fmt.Fprintf(os.Stderr, // std Usage() & PrintDefaults() use Stderr
+			"\tUsage: %s bind -r <role>[:<network Id|*>,...] ... <Operator ID>\n", os.Args[0])
+		bf.PrintDefaults()
+	}
+	bf.Var(&roleBindings, "r",
+		"Role binding in the form: <role>[:<network Id|*>,...]. "+
+			"The role applies to all networks if none are given")
+
+	cmd = CommandRegistry.Add("unbind", "Remove Role bindings from given Operator", unbind)
+	cmd.Flags().Usage = func() {
+		fmt.Fprintf(os.Stderr,
+			"\tUsage: %s unbind <Operator ID> <Role Name> [<Role Name> ...]\n", os.Args[0])
+	}
+}
+
+func roleAdd(cmd *commands.Command, args []string) int {
+	f := cmd.Flags()
+	name := strings.TrimSpace(f.Arg(0))
+	if f.NArg() != 1 || len(name) == 0 {
+		f.Usage()
+		log.Fatalf("A single Role Name must be specified.")
+	}
+	role := &accessprotos.AccessControl_Role{
+		Name:        name,
+		Description: roleDescription,
+		Entities:    BuildACLForEntities(networks, operators, gateways),
+	}
+	if len(roleNetworkPerm) > 0 {
+		perm, err := ParsePermissions(roleNetworkPerm)
+		if err != nil {
+			f.Usage()
+			log.Fatalf("Invalid network permissions: %s", err)
+		}
+		role.NetworkPermissions = perm
+	}
+	if len(role.Entities) == 0 && role.NetworkPermissions == accessprotos.AccessControl_NONE {
+		f.Usage()
+		log.Fatal("At least one ACL entity or network permission must be provided")
+	}
+	if err := accessd.SetRole(role); err != nil {
+		log.Fatalf("Set Role %s Error: %s", name, err)
+	}
+	return 0
+}
+
+func roleList(cmd *commands.Command, args []string) int {
+	roles, err := accessd.ListRoles()
+	if err != nil {
+		log.Fatalf("List Roles Error: %s", err)
+	}
+	fmt.Println("Roles:")
+	for _, role := range roles {
+		PrintRole(role)
+	}
+	return 0
+}
+
+func roleDelete(cmd *commands.Command, args []string) int {
+	f := cmd.Flags()
+	name := strings.TrimSpace(f.Arg(0))
+	if f.NArg() != 1 || len(name) == 0 {
+		f.Usage()
+		log.Fatalf("A single Role Name must be specified.")
+	}
+	if err := accessd.DeleteRole(name); err != nil {
+		log.Fatalf("Delete Role %s Error: %s", name, err)
+	}
+	return 0
+}
+
+func bind(cmd *commands.Command, args []string) int {
+	f := cmd.Flags()
+	oid := strings.TrimSpace(f.Arg(0))
+	if f.NArg() != 1 || len(oid) == 0 {
+		f.Usage()
+		log.Fatalf("A single Operator Id must be specified.")
+	}
+	if len(roleBindings) == 0 {
+		f.Usage()
+		log.Fatal("At least one Role binding must be provided")
+	}
+	operator := identity.NewOperator(oid)
+	bound := map[string]bool{}
+	for _, b := range roleBindings {
+		bound[b.Role] = true
+	}
+	bindings := roleBindings
+	for _, b := range getOperatorRoles(operator) {
+		if !bound[b.Role] {
+			bindings = append(bindings, b)
+		}
+	}
+	if err := accessd.SetOperatorRoles(operator, bindings); err != nil {
+		log.Fatalf("Bind Roles for %s Error: %s", oid, err)
+	}
+	return 0
+}
+
+func unbind(cmd *commands.Command, args []string) int {
+	f := cmd.Flags()
+	oid := strings.TrimSpace(f.Arg(0))
+	if f.NArg() < 2 || len(oid) == 0 {
+		f.Usage()
+		log.Fatalf("An Operator Id and at least one Role Name must be specified.")
+	}
+	operator := identity.NewOperator(oid)
+	unbound := map[string]bool{}
+	for _, name := range f.Args()[1:] {
+		unbound[strings.TrimSpace(name)] = true
+	}
+	var bindings []*accessprotos.AccessControl_RoleBinding
+	for _, b := range getOperatorRoles(operator) {
+		if !unbound[b.Role] {
+			bindings = append(bindings, b)
+		}
+	}
+	if err := accessd.SetOperatorRoles(operator, bindings); err != nil {
+		log.Fatalf("Unbind Roles for %s Error: %s", oid, err)
+	}
+	return 0
+}
+
+func getOperatorRoles(operator *protos.Identity) []*accessprotos.AccessControl_RoleBinding {
+	bindings, err := accessd.GetOperatorRoles(operator)
+	if err != nil {
+		log.Fatalf("Get Roles for %s Error: %s", operator.HashString(), err)
+	}
+	return bindings
+}
+
+// PrintRole - prints role name, description, network permissions & ACL
+func PrintRole(role *accessprotos.AccessControl_Role) {
+	fmt.Printf("\t%s: %s\n\t\tNetwork Permissions: %s (%d)\n\t\tACL:\n",
+		role.Name, role.Description, role.NetworkPermissions.ToString(), role.NetworkPermissions)
+	for _, ent := range role.Entities {
+		fmt.Printf(
+			"\t\t  %s: %s (%d)\n",
+			ent.Id.HashString(),
+			ent.Permissions.ToString(),
+			ent.Permissions)
+	}
+	fmt.Println()
+}
@@ -0,0 +1,91 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+// Remote signing service, signing with keys kept on its host or in an HSM on
+// behalf of controller services opening remote:// signer URIs, e.g.
+//
+//	remote_signer -ca clients_ca.pem -cert signer.pem -key signer.key.pem \
+//		-signer bootstrap-ca=pkcs11:token=magma;object=bootstrap-ca?module-path=...
+//
+// Clients must present a certificate issued by the CA certs of -ca. PKCS#11
+// signers require building with the pkcs11 build tag.
+package main
+
+import (
+	"crypto"
+	"flag"
+	"fmt"
+	"log"
+	"net"
+	"strings"
+
+	"magma/orc8r/cloud/go/security/signer"
+	"magma/orc8r/cloud/go/security/signer/protos"
+
+	"google.golang.org/grpc"
+)
+
+// signerURIs are the signer URIs keyed by key ID, given as -signer id=uri
+type signerURIs map[string]string
+
+func (s signerURIs) String() string {
+	var res []string
+	for id, uri := range s {
+		res = append(res, id+"="+uri)
+	}
+	return strings.Join(res, ",")
+}
+
+func (s signerURIs) Set(value string) error {
+	parts := strings.SplitN(value, "=", 2)
+	if len(parts) != 2 || len(parts[0]) == 0 || len(parts[1]) == 0 {
+		return fmt.Errorf("Invalid signer %s, expected key-id=uri", value)
+	}
+	s[parts[0]] = parts[1]
+	return nil
+}
+
+func main() {
+	uris := signerURIs{}
+	port := flag.Int("port", 9443, "Port to serve the remote signing service on")
+	caFile := flag.String("ca", "", "CA certs trusted to issue client certificates")
+	certFile := flag.String("cert", "", "Certificate of the service")
+	keyFile := flag.String("key", "", "Private key of the service's certificate")
+	flag.Var(uris, "signer", "Signer URI of a key as key-id=uri, may be repeated")
+	flag.Parse()
+
+	if len(*caFile) == 0 || len(*certFile) == 0 || len(*keyFile) == 0 {
+		log.Fatal("-ca, -cert & -key are required, the service only serves mutual TLS clients")
+	}
+	if len(uris) == 0 {
+		log.Fatal("No signers given")
+	}
+	signers := map[string]crypto.Signer{}
+	for keyID, uri := range uris {
+		s, err := signer.Open(uri)
+		if err != nil {
+			log.Fatalf("Failed to open signer of key %s: %s", keyID, err)
+		}
+		signers[keyID] = s
+	}
+	creds, err := signer.NewServerCredentials(*caFile, *certFile, *keyFile)
+	if err != nil {
+		log.Fatalf("Failed to load TLS credentials: %s", err)
+	}
+
+	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", *port))
+	if err != nil {
+		log.Fatalf("Failed to listen on port %d: %s", *port, err)
+	}
+	srv := grpc.NewServer(grpc.Creds(creds))
+	protos.RegisterRemoteSignerServer(srv, signer.NewRemoteSignerServer(signers))
+	log.Printf("Serving %d signers on %s", len(signers), lis.Addr())
+	if err = srv.Serve(lis); err != nil {
+		log.Fatalf("Remote signing service stopped: %s", err)
+	}
+}
@@ -36,6 +36,10 @@ class StreamerClient(threading.Thread):
 
     If the connection to the cloud gets terminated, the StreamerClient
     would retry (TBD: with exponential backoff) to connect back to the cloud.
+
+    Streams supporting incremental updates stay open until stream_timeout
+    expires, the StreamerClient then reopens them right away with the cursor
+    of the last received batch to get only the changes since then.
     """
 
     class Callback:
@@ -68,6 +72,20 @@ class StreamerClient(threading.Thread):
             """
             raise NotImplementedError()
 
+        def process_deletes(self, stream_name, deleted_keys):
+            """
+            Called when the cloud reports keys deleted since the previous
+            update of a stream supporting incremental updates. This method
+            will be called in the event loop provided to the StreamerClient,
+            after process_update of the same batch.
+
+            Args:
+                stream_name (string): Name of the stream
+                deleted_keys (string[]): Keys of the deleted items
+            """
+            logging.warning('Ignoring %d deleted keys of stream %s',
+                            len(deleted_keys), stream_name)
+
     def __init__(self, stream_callbacks, loop):
         """
         Args:
@@ -78,6 +96,9 @@ class StreamerClient(threading.Thread):
         threading.Thread.__init__(self)
         self._stream_callbacks = stream_callbacks
         self._loop = loop
+        # Cursors of the last batches received on streams supporting
+        # incremental updates, keyed by stream name
+        self._cursors = {}
         # Set this thread as daemon thread. We can kill this background
         # thread abruptly since we handle all updates (and database
         # transactions) in the asyncio event loop.
@@ -98,7 +119,8 @@ class StreamerClient(threading.Thread):
                 channel = ServiceRegistry.get_rpc_channel(
                         'streamer', ServiceRegistry.CLOUD)
                 client = StreamerStub(channel)
-                self.process_all_streams(client)
+                if self.process_all_streams(client):
+                    continue
             except Exception as exp:  # pylint: disable=broad-except
                 logging.error("Error with streamer: %s", exp)
 
@@ -108,25 +130,41 @@ class StreamerClient(threading.Thread):
             time.sleep(self._reconnect_pause)
 
     def process_all_streams(self, client):
+        """
+        Processes all streams once. Returns True if all of them are open
+        incremental streams which timed out and can be reopened right away.
+        """
+        reopen = bool(self._stream_callbacks)
         for stream_name, callback in self._stream_callbacks.items():
             try:
                 self.process_stream_updates(client, stream_name, callback)
 
                 STREAMER_RESPONSES.labels(result='Success').inc()
+                reopen = False
             except grpc.RpcError as err:
+                if err.code() == grpc.StatusCode.DEADLINE_EXCEEDED and \
+                        stream_name in self._cursors:
+                    # Incremental streams stay open until the timeout
+                    logging.debug("Reopening stream %s", stream_name)
+                    STREAMER_RESPONSES.labels(result='Success').inc()
+                    continue
                 logging.error(
                     "Error! Streaming from the cloud failed! [%s] %s",
                     err.code(), err.details())
                 STREAMER_RESPONSES.labels(result='RpcError').inc()
+                reopen = False
             except ValueError as err:
                 logging.error("Error! Streaming from cloud failed! %s", err)
                 STREAMER_RESPONSES.labels(result='ValueError').inc()
+                reopen = False
+        return reopen
 
     def process_stream_updates(self, client, stream_name, callback):
         extra_args = self._get_extra_args_any(callback, stream_name)
         request = StreamRequest(gatewayId=snowflake.snowflake(),
                                 stream_name=stream_name,
-                                extra_args=extra_args)
+                                extra_args=extra_args,
+                                cursor=self._cursors.get(stream_name, ''))
         for update_batch in client.GetUpdates(
                 request, timeout=self._stream_timeout):
             self._loop.call_soon_threadsafe(
@@ -135,6 +173,14 @@ class StreamerClient(threading.Thread):
                 update_batch.updates,
                 update_batch.resync,
             )
+            if update_batch.deleted_keys:
+                self._loop.call_soon_threadsafe(
+                    callback.process_deletes,
+                    stream_name,
+                    list(update_batch.deleted_keys),
+                )
+            if update_batch.cursor:
+                self._cursors[stream_name] = update_batch.cursor
 
     @staticmethod
     def _get_extra_args_any(callback, stream_name):
@@ -10,7 +10,10 @@ of patent rights can be found in the PATENTS file in the same directory.
 
 import datetime
 import enum
+import hashlib
 import logging
+import subprocess
+import tempfile
 
 import grpc
 import os
@@ -64,6 +67,10 @@ class BootstrapManager(SDWatchdogTask):
     PREEXPIRY_BOOTSTRAP_INTERVAL = datetime.timedelta(hours=20)
     SHORT_BOOTSTRAP_RETRY_INTERVAL = datetime.timedelta(seconds=30)
     LONG_BOOTSTRAP_RETRY_INTERVAL = datetime.timedelta(minutes=1)
+    # persistent handle of the TPM attestation key, see TCG TPM v2.0
+    # Provisioning Guidance for the AK handle range
+    DEFAULT_TPM_AK_HANDLE = '0x81010002'
+    TPM_QUOTE_TIMEOUT_SECS = 30
 
     def __init__(self, service, bootstrap_success_cb):
         super().__init__(
@@ -75,10 +82,17 @@ class BootstrapManager(SDWatchdogTask):
 
         self._challenge_key_file \
             = service.config['bootstrap_config']['challenge_key']
+        # AK handle or context file used for TPM2_ATTESTATION_KEY challenges
+        self._tpm_ak_context = service.config['bootstrap_config'].get(
+            'tpm_ak_context', self.DEFAULT_TPM_AK_HANDLE)
         self._hw_id = snowflake.snowflake()
         self._gateway_key_file = control_proxy_config['gateway_key']
         self._gateway_cert_file = control_proxy_config['gateway_cert']
         self._gateway_key = None
+        # renewal time of the current cert suggested by the cloud, if any,
+        # persisted next to the cert to survive magmad restarts
+        self._renew_after_file = self._gateway_cert_file + '.renew_after'
+        self._renew_after = self._load_renew_after()
         self._state = BootstrapState.INITIAL
         self._bootstrap_success_cb = bootstrap_success_cb
 
@@ -178,6 +192,12 @@ class BootstrapManager(SDWatchdogTask):
                 cert.not_valid_after)
             await self._bootstrap_now()
             return
+        if self._renew_after is not None and now > self._renew_after:
+            logging.info(
+                'Certificate is due for renewal since %s, start bootstrapping',
+                self._renew_after)
+            await self._bootstrap_now()
+            return
         if now < cert.not_valid_before:
             logging.error(
                 'Certificate is not valid until %s', cert.not_valid_before)
@@ -289,6 +309,9 @@ class BootstrapManager(SDWatchdogTask):
         try:
             cert_utils.write_key(self._gateway_key, self._gateway_key_file)
             cert_utils.write_cert(cert.cert_der, self._gateway_cert_file)
+            self._renew_after = cert.renew_after.ToDatetime() \
+                if cert.HasField('renew_after') else None
+            self._write_renew_after()
         except Exception as exp:
             BOOTSTRAP_EXCEPTION.labels(cause='RequestSignDoneWriteCert:%s' % type(exp).__name__).inc()
             logging.error('Failed to write cert: %s', exp)
@@ -375,6 +398,15 @@ class BootstrapManager(SDWatchdogTask):
                 ecdsa_response=ecdsa_resp,
                 csr=csr,
             )
+        elif challenge.key_type == ChallengeKey.TPM2_ATTESTATION_KEY:
+            attest, signature = self._tpm2_quote_response(challenge.challenge)
+            tpm_resp = Response.TPM(attest=attest, signature=signature)
+            response = Response(
+                hw_id=AccessGatewayID(id=self._hw_id),
+                challenge=challenge.challenge,
+                tpm_response=tpm_resp,
+                csr=csr,
+            )
         else:
             raise BootstrapError('Unknown key type: %s' % challenge.key_type)
         return response
@@ -432,3 +464,79 @@ class BootstrapManager(SDWatchdogTask):
         r_bytes = r_int.to_bytes((r_int.bit_length() + 7) // 8, 'big')
         s_bytes = s_int.to_bytes((s_int.bit_length() + 7) // 8, 'big')
         return r_bytes, s_bytes
+
+    def _tpm2_quote_response(self, challenge):
+        """Sign the challenge with the TPM attestation key
+
+        The SHA256 digest of the challenge is the qualifying data of a
+        TPM2_Quote signed by the AK, generated with tpm2-tools' tpm2_quote.
+
+        Args:
+            challenge: content of challenge in bytes
+
+        Returns:
+            attest, signature: marshaled TPMS_ATTEST and TPMT_SIGNATURE
+
+        Raises:
+            BootstrapError: if the TPM quote cannot be generated
+        """
+        nonce = hashlib.sha256(challenge).hexdigest()
+        with tempfile.TemporaryDirectory() as tmp_dir:
+            attest_file = os.path.join(tmp_dir, 'quote.attest')
+            signature_file = os.path.join(tmp_dir, 'quote.sig')
+            try:
+                subprocess.run(
+                    ['tpm2_quote',
+                     '--key-context', self._tpm_ak_context,
+                     '--pcr-list', 'sha256:0',
+                     '--qualification', nonce,
+                     '--hash-algorithm', 'sha256',
+                     '--message', attest_file,
+                     '--signature', signature_file],
+                    stdout=subprocess.DEVNULL,
+                    stderr=subprocess.PIPE,
+                    timeout=self.TPM_QUOTE_TIMEOUT_SECS,
+                    check=True,
+                )
+                with open(attest_file, 'rb') as f:
+                    attest = f.read()
+                with open(signature_file, 'rb') as f:
+                    signature = f.read()
+            except subprocess.CalledProcessError as e:
+                raise BootstrapError(
+                    'tpm2_quote failed: %s' % e.stderr.decode(errors='replace'))
+            except (OSError, subprocess.TimeoutExpired) as e:
+                raise BootstrapError('Cannot generate TPM quote: %s' % e)
+        return attest, signature
+
+    def _load_renew_after(self):
+        """Load the renewal time of the current cert
+
+        Returns:
+            renewal time as naive UTC datetime, or None if there's none
+        """
+        try:
+            with open(self._renew_after_file) as f:
+                return datetime.datetime.utcfromtimestamp(int(f.read()))
+        except FileNotFoundError:
+            return None
+        except (OSError, ValueError) as e:
+            logging.warning('Cannot load cert renewal time: %s', e)
+            return None
+
+    def _write_renew_after(self):
+        """Persist the renewal time of the current cert
+
+        Raises:
+            OSError: if the renewal time cannot be written or removed
+        """
+        if self._renew_after is None:
+            try:
+                os.remove(self._renew_after_file)
+            except FileNotFoundError:
+                pass
+            return
+        timestamp = int(self._renew_after.replace(
+            tzinfo=datetime.timezone.utc).timestamp())
+        with open(self._renew_after_file, 'w') as f:
+            f.write(str(timestamp))
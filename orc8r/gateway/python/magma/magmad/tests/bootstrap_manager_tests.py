@@ -9,6 +9,10 @@ of patent rights can be found in the PATENTS file in the same directory.
 
 import asyncio
 import datetime
+import hashlib
+import os
+import subprocess
+import tempfile
 from concurrent import futures
 from unittest import TestCase
 from unittest.mock import ANY, MagicMock, call, patch
@@ -82,7 +86,8 @@ class BootstrapManagerTest(TestCase):
               write_key_mock):
 
         self.gateway_key_file = '__test_gw.key'
-        self.gateway_cert_file = '__test_hw_cert'
+        self.tmp_dir = tempfile.TemporaryDirectory()
+        self.gateway_cert_file = os.path.join(self.tmp_dir.name, 'hw_cert')
         self.hw_id = 'hwid_test'
 
         load_service_config_mock.return_value = {
@@ -136,6 +141,7 @@ class BootstrapManagerTest(TestCase):
         self._rpc_server.stop(None)
         self.manager.stop_bootstrap_manager()
         self.loop.close()
+        self.tmp_dir.cleanup()
 
     @patch('%s.BootstrapManager._bootstrap_now' % BM)
     def test__bootstrap(self, _bootstrap_now_mock):
@@ -201,6 +207,14 @@ class BootstrapManagerTest(TestCase):
             await self.manager._bootstrap_check()
             schedule_bootstrap_check_mock.assert_has_calls([call()])
 
+            # cert is valid, but past its renewal time
+            bootstrap_now_mock.reset_mock()
+            self.manager._renew_after = \
+                datetime.datetime.utcnow() - datetime.timedelta(hours=1)
+            await self.manager._bootstrap_check()
+            bootstrap_now_mock.assert_has_calls([call()])
+            self.manager._renew_after = None
+
         # Cancel the loop so that there's no periodic bootstrap/bootstrap_check
         self.manager._task.cancel()
         self.loop.run_until_complete(test())
@@ -406,8 +420,26 @@ class BootstrapManagerTest(TestCase):
                 [call(ANY, self.manager._gateway_key_file)])
             write_cert_mock.assert_has_calls(
                 [call(ANY, self.manager._gateway_cert_file)])
+            self.assertIsNone(self.manager._renew_after)
 
             schedule_bootstrap_check_mock.assert_has_calls([call()])
+
+            # certificate with a renewal time
+            renew_after = datetime.datetime.utcnow() + datetime.timedelta(
+                days=6)
+            renew_after_cert = create_cert_message()
+            renew_after_cert.renew_after.FromDatetime(renew_after)
+            await self.manager._request_sign_done_success(renew_after_cert)
+            self.assertEqual(self.manager._renew_after, renew_after)
+            # renewal time is restored after a restart
+            self.assertEqual(
+                self.manager._load_renew_after(),
+                renew_after.replace(microsecond=0))
+
+            # renewal time of the previous cert is cleared
+            await self.manager._request_sign_done_success(valid_cert)
+            self.assertIsNone(self.manager._renew_after)
+            self.assertIsNone(self.manager._load_renew_after())
         # Cancel the loop so that there's no periodic bootstrap/bootstrap_check
         self.manager._task.cancel()
         self.manager._loop.run_until_complete(test())
@@ -507,6 +539,46 @@ class BootstrapManagerTest(TestCase):
                 msg='Challenge key cannot be used for ECDSA signature'):
             self.manager._ecdsa_sha256_response(challenge)
 
+    @patch(BM + '.subprocess.run')
+    def test__tpm2_quote_response(self, run_mock):
+        challenge = b'challenge'
+
+        def tpm2_quote(args, **kwargs):
+            files = {'--message': b'attest', '--signature': b'signature'}
+            for flag, content in files.items():
+                with open(args[args.index(flag) + 1], 'wb') as f:
+                    f.write(content)
+        run_mock.side_effect = tpm2_quote
+
+        attest, signature = self.manager._tpm2_quote_response(challenge)
+        self.assertEqual(attest, b'attest')
+        self.assertEqual(signature, b'signature')
+        args = run_mock.call_args[0][0]
+        self.assertEqual(
+            args[args.index('--qualification') + 1],
+            hashlib.sha256(challenge).hexdigest())
+        self.assertEqual(
+            args[args.index('--key-context') + 1],
+            bm.BootstrapManager.DEFAULT_TPM_AK_HANDLE)
+
+        response = self.manager._construct_response(
+            Challenge(key_type=ChallengeKey.TPM2_ATTESTATION_KEY,
+                      challenge=challenge),
+            CSR())
+        self.assertEqual(response.tpm_response.attest, b'attest')
+        self.assertEqual(response.tpm_response.signature, b'signature')
+
+        # tpm2_quote failure
+        run_mock.side_effect = subprocess.CalledProcessError(
+            1, 'tpm2_quote', stderr=b'ERROR: Esys_Quote')
+        with self.assertRaises(bm.BootstrapError):
+            self.manager._tpm2_quote_response(challenge)
+
+        # tpm2-tools not installed
+        run_mock.side_effect = FileNotFoundError
+        with self.assertRaises(bm.BootstrapError):
+            self.manager._tpm2_quote_response(challenge)
+
     def test__is_valid_certificate(self):
         # not-yet-valid
         not_before = datetime.datetime.utcnow() + datetime.timedelta(hours=1)